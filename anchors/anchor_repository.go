@@ -9,19 +9,34 @@ import (
 
 var log = logging.Logger("anchorRepository")
 
+// CommittedAnchor is an anchor committed by an identity, as recorded by the anchor repository.
+type CommittedAnchor struct {
+	AnchorID     AnchorID
+	DocumentRoot DocumentRoot
+	BlockNumber  uint64
+}
+
 // AnchorRepository defines a set of functions that can be
 // implemented by any type that stores and retrieves the anchoring, and pre anchoring details.
 type AnchorRepository interface {
 
-	// PreCommitAnchor will call the transaction PreCommit on the smart contract, to pre commit a document update
-	PreCommitAnchor(ctx context.Context, anchorID AnchorID, signingRoot DocumentRoot) (confirmations chan bool, err error)
+	// PreCommitAnchor will call the transaction PreCommit on the smart contract, to pre commit a document update.
+	// The returned future holds the result of the transaction.
+	PreCommitAnchor(ctx context.Context, anchorID AnchorID, signingRoot DocumentRoot) (Future, error)
 
 	// CommitAnchor will send a commit transaction to Ethereum.
-	CommitAnchor(ctx context.Context, anchorID AnchorID, documentRoot DocumentRoot, documentProofs [][32]byte) (chan bool, error)
+	// The returned future holds the result of the transaction.
+	CommitAnchor(ctx context.Context, anchorID AnchorID, documentRoot DocumentRoot, documentProofs [][32]byte) (Future, error)
+
+	// InFlight returns the future of the pre-commit or commit transaction of the anchor that is yet to finish.
+	InFlight(anchorID AnchorID) (Future, bool)
 
 	// GetAnchorData takes an anchorID and returns the corresponding documentRoot from the chain.
 	GetAnchorData(anchorID AnchorID) (docRoot DocumentRoot, anchoredTime time.Time, err error)
 
 	// HasValidPreCommit checks if the given anchorID has a valid pre-commit
 	HasValidPreCommit(anchorID AnchorID) bool
+
+	// CommittedAnchors returns the anchors committed by the account in ctx since fromBlock, oldest first.
+	CommittedAnchors(ctx context.Context, fromBlock uint64) ([]CommittedAnchor, error)
 }
@@ -114,13 +114,10 @@ func commitAnchor(t *testing.T, anchorID, documentRoot []byte, documentProofs []
 	docRootTyped, _ := anchors.ToDocumentRoot(documentRoot)
 
 	ctx := testingconfig.CreateAccountContext(t, cfg)
-	done, err := anchorRepo.CommitAnchor(ctx, anchorIDTyped, docRootTyped, documentProofs)
-
-	isDone := <-done
-
-	assert.True(t, isDone, "isDone should be true")
-
+	f, err := anchorRepo.CommitAnchor(ctx, anchorIDTyped, docRootTyped, documentProofs)
 	assert.Nil(t, err)
+	assert.NoError(t, f.Result(0))
+	assert.Equal(t, anchors.StatusSuccess, f.Status())
 }
 
 func preCommitAnchor(t *testing.T, anchorID, documentRoot []byte) {
@@ -129,18 +126,16 @@ func preCommitAnchor(t *testing.T, anchorID, documentRoot []byte) {
 	docRootTyped, _ := anchors.ToDocumentRoot(documentRoot)
 
 	ctx := testingconfig.CreateAccountContext(t, cfg)
-	done, err := anchorRepo.PreCommitAnchor(ctx, anchorIDTyped, docRootTyped)
-
-	isDone := <-done
-
-	assert.True(t, isDone, "isDone should be true")
+	f, err := anchorRepo.PreCommitAnchor(ctx, anchorIDTyped, docRootTyped)
 	assert.Nil(t, err)
+	assert.NoError(t, f.Result(0))
+	assert.Equal(t, anchors.StatusSuccess, f.Status())
 }
 
 func TestCommitAnchor_Integration_Concurrent(t *testing.T) {
 	t.Parallel()
 	var commitDataList [5]*anchors.CommitData
-	var doneList [5]chan bool
+	var futures [5]anchors.Future
 
 	for ix := 0; ix < 5; ix++ {
 		anchorIDPreImage := utils.RandomSlice(32)
@@ -159,7 +154,7 @@ func TestCommitAnchor_Integration_Concurrent(t *testing.T) {
 		assert.Nil(t, err, " error must be nil")
 		commitDataList[ix] = anchors.NewCommitData(hd.Number.Uint64(), currentAnchorId, currentDocumentRoot, documentProofs)
 		ctx := testingconfig.CreateAccountContext(t, cfg)
-		doneList[ix], err = anchorRepo.CommitAnchor(ctx, anchorIDPreImageID, currentDocumentRoot, documentProofs)
+		futures[ix], err = anchorRepo.CommitAnchor(ctx, anchorIDPreImageID, currentDocumentRoot, documentProofs)
 		if err != nil {
 			t.Fatalf("Error commit Anchor %v", err)
 		}
@@ -167,8 +162,7 @@ func TestCommitAnchor_Integration_Concurrent(t *testing.T) {
 	}
 
 	for ix := 0; ix < 5; ix++ {
-		isDone := <-doneList[ix]
-		assert.True(t, isDone)
+		assert.NoError(t, futures[ix].Result(0))
 		anchorID := commitDataList[ix].AnchorID
 		docRoot := commitDataList[ix].DocumentRoot
 		gotDocRoot, _, err := anchorRepo.GetAnchorData(anchorID)
@@ -0,0 +1,14 @@
+package anchors
+
+import "github.com/centrifuge/go-centrifuge/errors"
+
+const (
+	// ErrAnchorNotFound must be used when the anchor doesn't exist on chain
+	ErrAnchorNotFound = errors.Error("anchor not found")
+
+	// ErrAnchorTimeout must be used when the anchor transaction didn't finish in time
+	ErrAnchorTimeout = errors.Error("timed out waiting for the anchor transaction")
+
+	// ErrAnchorInterrupted must be used when the anchor transaction was stopped before it finished
+	ErrAnchorInterrupted = errors.Error("anchor transaction interrupted")
+)
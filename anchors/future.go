@@ -0,0 +1,176 @@
+package anchors
+
+import (
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/transactions"
+)
+
+// Status is the status of a pre-commit or commit anchor transaction.
+type Status string
+
+const (
+	// StatusPending is the status of an anchor transaction that is still in flight.
+	StatusPending Status = "pending"
+
+	// StatusSuccess is the status of an anchor transaction that was mined.
+	StatusSuccess Status = "success"
+
+	// StatusFailed is the status of an anchor transaction that failed or was interrupted.
+	StatusFailed Status = "failed"
+)
+
+// Future is the result of a pre-commit or commit anchor transaction that is yet to finish.
+// A Future is safe to use from multiple goroutines.
+type Future interface {
+	// AnchorID returns the anchor ID the transaction was submitted for.
+	AnchorID() AnchorID
+
+	// TxID returns the ID of the transaction tracking the anchoring.
+	TxID() transactions.TxID
+
+	// Done returns a channel that is closed once the transaction has finished.
+	Done() <-chan struct{}
+
+	// Status returns the current status of the transaction without blocking.
+	Status() Status
+
+	// Result waits for the transaction to finish and returns its error.
+	// Returns ErrAnchorTimeout if the transaction didn't finish within the timeout. Waits forever if the timeout is zero.
+	Result(timeout time.Duration) error
+}
+
+// future implements Future.
+type future struct {
+	anchorID AnchorID
+	txID     transactions.TxID
+	done     chan struct{}
+
+	mu       sync.RWMutex
+	status   Status
+	err      error
+	reported bool
+}
+
+func newFuture(anchorID AnchorID) *future {
+	return &future{anchorID: anchorID, status: StatusPending, done: make(chan struct{})}
+}
+
+// NewFinishedFuture returns a future of the anchor that has already finished with the given error.
+func NewFinishedFuture(anchorID AnchorID, err error) Future {
+	f := newFuture(anchorID)
+	f.report(err)
+	f.finish(nil)
+	return f
+}
+
+// AnchorID returns the anchor ID the transaction was submitted for.
+func (f *future) AnchorID() AnchorID {
+	return f.anchorID
+}
+
+// TxID returns the ID of the transaction tracking the anchoring.
+func (f *future) TxID() transactions.TxID {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.txID
+}
+
+// Done returns a channel that is closed once the transaction has finished.
+func (f *future) Done() <-chan struct{} {
+	return f.done
+}
+
+// Status returns the current status of the transaction without blocking.
+func (f *future) Status() Status {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.status
+}
+
+// Result waits for the transaction to finish and returns its error.
+func (f *future) Result(timeout time.Duration) error {
+	if timeout > 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		select {
+		case <-f.done:
+		case <-t.C:
+			return errors.NewTypedError(ErrAnchorTimeout, errors.New("anchor %s after %s", f.anchorID.String(), timeout))
+		}
+	}
+
+	<-f.done
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.err
+}
+
+func (f *future) setTxID(txID transactions.TxID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.txID = txID
+}
+
+// report records the result of the ethereum transaction.
+func (f *future) report(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+	f.reported = true
+}
+
+// finish completes the future once the transaction manager is done with the transaction.
+// If the ethereum transaction didn't report a result, the transaction was interrupted with the given cause.
+func (f *future) finish(cause error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.reported {
+		f.err = errors.NewTypedError(ErrAnchorInterrupted, errors.New("anchor %s: %v", f.anchorID.String(), cause))
+	}
+
+	f.status = StatusSuccess
+	if f.err != nil {
+		f.status = StatusFailed
+	}
+
+	close(f.done)
+}
+
+// inFlight tracks the anchor transactions that are yet to finish.
+type inFlight struct {
+	mu      sync.RWMutex
+	futures map[AnchorID]*future
+}
+
+func (i *inFlight) add(f *future) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.futures == nil {
+		i.futures = make(map[AnchorID]*future)
+	}
+
+	i.futures[f.anchorID] = f
+}
+
+func (i *inFlight) remove(f *future) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	// a newer transaction for the same anchor might have replaced the future
+	if i.futures[f.anchorID] == f {
+		delete(i.futures, f.anchorID)
+	}
+}
+
+func (i *inFlight) get(anchorID AnchorID) (Future, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	f, ok := i.futures[anchorID]
+	if !ok {
+		return nil, false
+	}
+
+	return f, true
+}
@@ -0,0 +1,87 @@
+// +build unit
+
+package anchors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomAnchorID(t *testing.T) AnchorID {
+	anchorID, err := ToAnchorID(utils.RandomSlice(AnchorIDLength))
+	assert.NoError(t, err)
+	return anchorID
+}
+
+func TestFuture_Result(t *testing.T) {
+	anchorID := randomAnchorID(t)
+	f := newFuture(anchorID)
+	txID := transactions.NewTxID()
+	f.setTxID(txID)
+	assert.Equal(t, anchorID, f.AnchorID())
+	assert.Equal(t, txID, f.TxID())
+	assert.Equal(t, StatusPending, f.Status())
+
+	// timeout
+	err := f.Result(10 * time.Millisecond)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrAnchorTimeout, err))
+	assert.Equal(t, StatusPending, f.Status())
+
+	// success
+	go func() {
+		f.report(nil)
+		f.finish(nil)
+	}()
+	assert.NoError(t, f.Result(0))
+	<-f.Done()
+	assert.Equal(t, StatusSuccess, f.Status())
+
+	// failed
+	f = newFuture(anchorID)
+	f.report(errors.New("tx failed"))
+	f.finish(nil)
+	err = f.Result(time.Second)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "tx failed")
+	assert.Equal(t, StatusFailed, f.Status())
+
+	// interrupted
+	f = newFuture(anchorID)
+	f.finish(context.Canceled)
+	err = f.Result(time.Second)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrAnchorInterrupted, err))
+	assert.Equal(t, StatusFailed, f.Status())
+}
+
+func TestInFlight(t *testing.T) {
+	var i inFlight
+	anchorID := randomAnchorID(t)
+	_, ok := i.get(anchorID)
+	assert.False(t, ok)
+
+	f := newFuture(anchorID)
+	i.add(f)
+	g, ok := i.get(anchorID)
+	assert.True(t, ok)
+	assert.Equal(t, f, g)
+
+	// newer future replaces the older one
+	nf := newFuture(anchorID)
+	i.add(nf)
+	i.remove(f)
+	g, ok = i.get(anchorID)
+	assert.True(t, ok)
+	assert.Equal(t, nf, g)
+
+	i.remove(nf)
+	_, ok = i.get(anchorID)
+	assert.False(t, ok)
+}
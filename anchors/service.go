@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/ethereum"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/queue"
 	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -24,6 +26,7 @@ type anchorRepositoryContract interface {
 		BlockNumber  uint32
 	}, error)
 	HasValidPreCommit(opts *bind.CallOpts, anchorId *big.Int) (bool, error)
+	FilterAnchorCommitted(opts *bind.FilterOpts, from []common.Address, anchorId []*big.Int) (*AnchorContractAnchorCommittedIterator, error)
 }
 
 type service struct {
@@ -32,6 +35,7 @@ type service struct {
 	client                   ethereum.Client
 	queue                    *queue.Server
 	txManager                transactions.Manager
+	inFlight                 inFlight
 }
 
 func newService(config Config, anchorContract anchorRepositoryContract, queue *queue.Server, client ethereum.Client, txManager transactions.Manager) AnchorRepository {
@@ -50,16 +54,29 @@ func (s *service) HasValidPreCommit(anchorID AnchorID) bool {
 }
 
 // GetAnchorData takes an anchorID and returns the corresponding documentRoot from the chain.
+// Returns ErrAnchorNotFound if the anchor is not yet on chain.
 func (s *service) GetAnchorData(anchorID AnchorID) (docRoot DocumentRoot, anchoredTime time.Time, err error) {
 	// Ignoring cancelFunc as code will block until response or timeout is triggered
 	opts, _ := s.client.GetGethCallOpts(false)
 	r, err := s.anchorRepositoryContract.GetAnchorById(opts, anchorID.BigInt())
+	if err != nil {
+		return docRoot, anchoredTime, err
+	}
+
+	if utils.IsEmptyByteSlice(r.DocumentRoot[:]) {
+		return docRoot, anchoredTime, errors.NewTypedError(ErrAnchorNotFound, errors.New("anchor %s", anchorID.String()))
+	}
+
 	blk, err := s.client.GetEthClient().BlockByNumber(context.Background(), big.NewInt(int64(r.BlockNumber)))
-	return r.DocumentRoot, time.Unix(blk.Time().Int64(), 0), err
+	if err != nil {
+		return docRoot, anchoredTime, err
+	}
+
+	return r.DocumentRoot, time.Unix(blk.Time().Int64(), 0), nil
 }
 
 // PreCommitAnchor will call the transaction PreCommit on the smart contract
-func (s *service) PreCommitAnchor(ctx context.Context, anchorID AnchorID, signingRoot DocumentRoot) (confirmations chan bool, err error) {
+func (s *service) PreCommitAnchor(ctx context.Context, anchorID AnchorID, signingRoot DocumentRoot) (Future, error) {
 	did, err := getDID(ctx)
 	if err != nil {
 		return nil, err
@@ -70,52 +87,66 @@ func (s *service) PreCommitAnchor(ctx context.Context, anchorID AnchorID, signin
 		return nil, err
 	}
 
-	txID := contextutil.TX(ctx)
-
-	conn := s.client
-	opts, err := conn.GetTxOpts(tc.GetEthereumDefaultAccountName())
+	opts, err := s.client.GetTxOpts(tc.GetEthereumDefaultAccountName())
 	if err != nil {
 		return nil, err
 	}
 
 	pc := newPreCommitData(anchorID, signingRoot)
-	if err != nil {
-		return confirmations, err
-	}
-
 	log.Infof("Add Anchor to Pre-commit %s from did:%s", anchorID.String(), did.ToAddress().String())
-	_, done, err := s.txManager.ExecuteWithinTX(ctx, did, txID, "Check TX for anchor commit",
-		s.ethereumTX(opts, s.anchorRepositoryContract.PreCommit, pc.AnchorID.BigInt(), pc.SigningRoot))
+	return s.anchorWithinTX(ctx, did, anchorID, "Check TX for anchor pre-commit",
+		opts, s.anchorRepositoryContract.PreCommit, pc.AnchorID.BigInt(), pc.SigningRoot)
+}
+
+// InFlight returns the future of the pre-commit or commit transaction of the anchor that is yet to finish.
+func (s *service) InFlight(anchorID AnchorID) (Future, bool) {
+	return s.inFlight.get(anchorID)
+}
+
+// anchorWithinTX submits the ethereum transaction within a transaction and returns the future of its result.
+// The future is tracked as in flight until the transaction manager is done with the transaction.
+func (s *service) anchorWithinTX(ctx context.Context, did identity.DID, anchorID AnchorID, desc string, opts *bind.TransactOpts, contractMethod interface{}, params ...interface{}) (Future, error) {
+	f := newFuture(anchorID)
+	txID, done, err := s.txManager.ExecuteWithinTX(ctx, did, contextutil.TX(ctx), desc, s.ethereumTX(f, opts, contractMethod, params...))
 	if err != nil {
 		return nil, err
 	}
 
-	return done, nil
+	f.setTxID(txID)
+	s.inFlight.add(f)
+	go func() {
+		<-done
+		s.inFlight.remove(f)
+		f.finish(ctx.Err())
+	}()
+
+	return f, nil
 }
 
-// ethereumTX is submitting an Ethereum transaction and starts a task to wait for the transaction result
-func (s service) ethereumTX(opts *bind.TransactOpts, contractMethod interface{}, params ...interface{}) func(accountID identity.DID, txID transactions.TxID, txMan transactions.Manager, errOut chan<- error) {
+// ethereumTX is submitting an Ethereum transaction and starts a task to wait for the transaction result.
+// The result is reported to the future before it is handed to the transaction manager.
+func (s *service) ethereumTX(f *future, opts *bind.TransactOpts, contractMethod interface{}, params ...interface{}) func(accountID identity.DID, txID transactions.TxID, txMan transactions.Manager, errOut chan<- error) {
 	return func(accountID identity.DID, txID transactions.TxID, txMan transactions.Manager, errOut chan<- error) {
+		err := s.submitAndWait(accountID, txID, txMan, opts, contractMethod, params...)
+		f.report(err)
+		errOut <- err
+	}
+}
+
+// submitAndWait submits the Ethereum transaction and waits for the task checking its status.
+func (s *service) submitAndWait(accountID identity.DID, txID transactions.TxID, txMan transactions.Manager, opts *bind.TransactOpts, contractMethod interface{}, params ...interface{}) error {
+	ethTX, err := s.client.SubmitTransactionWithRetries(contractMethod, opts, params...)
+	if err != nil {
+		return err
+	}
 
-		ethTX, err := s.client.SubmitTransactionWithRetries(contractMethod, opts, params...)
-		if err != nil {
-			errOut <- err
-			return
-		}
-
-		res, err := ethereum.QueueEthTXStatusTask(accountID, txID, ethTX.Hash(), s.queue)
-		if err != nil {
-			errOut <- err
-			return
-		}
-
-		_, err = res.Get(txMan.GetDefaultTaskTimeout())
-		if err != nil {
-			errOut <- err
-			return
-		}
-		errOut <- nil
+	res, err := ethereum.QueueEthTXStatusTask(accountID, txID, ethTX.Hash(), s.queue)
+	if err != nil {
+		return err
 	}
+
+	_, err = res.Get(txMan.GetDefaultTaskTimeout())
+	return err
 }
 
 // getDID returns DID from context.Account
@@ -134,7 +165,7 @@ func getDID(ctx context.Context) (identity.DID, error) {
 }
 
 // CommitAnchor will send a commit transaction to Ethereum.
-func (s *service) CommitAnchor(ctx context.Context, anchorID AnchorID, documentRoot DocumentRoot, documentProofs [][32]byte) (chan bool, error) {
+func (s *service) CommitAnchor(ctx context.Context, anchorID AnchorID, documentRoot DocumentRoot, documentProofs [][32]byte) (Future, error) {
 	did, err := getDID(ctx)
 	if err != nil {
 		return nil, err
@@ -145,8 +176,6 @@ func (s *service) CommitAnchor(ctx context.Context, anchorID AnchorID, documentR
 		return nil, err
 	}
 
-	txID := contextutil.TX(ctx)
-
 	conn := s.client
 	opts, err := conn.GetTxOpts(tc.GetEthereumDefaultAccountName())
 	if err != nil {
@@ -161,12 +190,56 @@ func (s *service) CommitAnchor(ctx context.Context, anchorID AnchorID, documentR
 	cd := NewCommitData(h.Number.Uint64(), anchorID, documentRoot, documentProofs)
 
 	log.Infof("Add Anchor to Commit %s from did:%s", anchorID.String(), did.ToAddress().String())
-	_, done, err := s.txManager.ExecuteWithinTX(ctx, did, txID, "Check TX for anchor commit",
-		s.ethereumTX(opts, s.anchorRepositoryContract.Commit, cd.AnchorID.BigInt(), cd.DocumentRoot, cd.DocumentProofs))
+	return s.anchorWithinTX(ctx, did, anchorID, "Check TX for anchor commit",
+		opts, s.anchorRepositoryContract.Commit, cd.AnchorID.BigInt(), cd.DocumentRoot, cd.DocumentProofs)
+}
+
+// CommittedAnchors returns the anchors committed by the ethereum account of the account in ctx since fromBlock, oldest first.
+// The anchors are read from the AnchorCommitted events of the anchor repository contract. The events don't carry the
+// identity of the anchor, so accounts sharing an ethereum account get the anchors of each other as well.
+func (s *service) CommittedAnchors(ctx context.Context, fromBlock uint64) ([]CommittedAnchor, error) {
+	tc, err := contextutil.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &bind.FilterOpts{Start: fromBlock, Context: ctx}
+	from := common.HexToAddress(tc.GetEthereumAccount().Address)
+	it, err := s.anchorRepositoryContract.FilterAnchorCommitted(opts, []common.Address{from}, nil)
 	if err != nil {
 		return nil, err
 	}
+	defer it.Close()
+
+	var committed []CommittedAnchor
+	for it.Next() {
+		committed = append(committed, CommittedAnchor{
+			AnchorID:     AnchorID(common.BigToHash(it.Event.AnchorId)),
+			DocumentRoot: it.Event.DocumentRoot,
+			BlockNumber:  it.Event.Raw.BlockNumber,
+		})
+	}
+
+	return committed, it.Error()
+}
 
-	return done, nil
+// GetAnchoredRoot returns the document root anchored with the anchorID by the anchor repository contract at address.
+// Unlike the AnchorRepository, it only needs a contract caller and can be used without a running node.
+// Returns ErrAnchorNotFound if the anchor is not on chain.
+func GetAnchoredRoot(ctx context.Context, caller bind.ContractCaller, address common.Address, anchorID AnchorID) (docRoot DocumentRoot, err error) {
+	contract, err := NewAnchorContractCaller(address, caller)
+	if err != nil {
+		return docRoot, err
+	}
+
+	r, err := contract.GetAnchorById(&bind.CallOpts{Context: ctx}, anchorID.BigInt())
+	if err != nil {
+		return docRoot, err
+	}
+
+	if utils.IsEmptyByteSlice(r.DocumentRoot[:]) {
+		return docRoot, errors.NewTypedError(ErrAnchorNotFound, errors.New("anchor %s", anchorID.String()))
+	}
 
+	return r.DocumentRoot, nil
 }
@@ -7,7 +7,9 @@ import (
 	"testing"
 
 	"github.com/centrifuge/go-centrifuge/crypto/secp256k1"
+	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -76,3 +78,25 @@ func TestGenerateAnchor(t *testing.T) {
 	assert.Equal(t, commitData.DocumentProofs, documentProofs, "Anchor should have the document proofs")
 
 }
+
+func TestGetAnchorData_notFound(t *testing.T) {
+	anchorID, err := ToAnchorID(utils.RandomSlice(AnchorIDLength))
+	assert.NoError(t, err)
+	client := new(testingcommons.MockEthClient)
+	client.On("GetGethCallOpts").Return(nil)
+	contract := new(mockAnchorRepo)
+	srv := &service{anchorRepositoryContract: contract, client: client}
+
+	// contract error
+	contract.On("GetAnchorById", mock.Anything, anchorID.BigInt()).Return([32]byte{}, errors.New("contract error")).Once()
+	_, _, err = srv.GetAnchorData(anchorID)
+	assert.Error(t, err)
+	assert.False(t, errors.IsOfType(ErrAnchorNotFound, err))
+
+	// anchor not on chain yet
+	contract.On("GetAnchorById", mock.Anything, anchorID.BigInt()).Return([32]byte{}, nil).Once()
+	_, _, err = srv.GetAnchorData(anchorID)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrAnchorNotFound, err))
+	contract.AssertExpectations(t)
+}
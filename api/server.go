@@ -11,10 +11,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/centrifuge/go-centrifuge/bootstrap"
 	"github.com/centrifuge/go-centrifuge/config"
-
+	"github.com/centrifuge/go-centrifuge/contextutil"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
+	"github.com/centrifuge/go-centrifuge/serviceaccount"
 	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/centrifuge/go-centrifuge/verification"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	logging "github.com/ipfs/go-log"
 	"golang.org/x/net/context"
@@ -23,14 +27,25 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
-// ErrNoAuthHeader used for requests when header is not passed.
-const ErrNoAuthHeader = errors.Error("'authorization' header missing")
+const (
+	// ErrNoAuthHeader used for requests when header is not passed.
+	ErrNoAuthHeader = errors.Error("'authorization' header missing")
+
+	// ErrInvalidAccountID used for requests when the header doesn't hold a valid account identifier.
+	ErrInvalidAccountID = errors.Error("invalid account identifier")
+
+	// ErrAccountNotFound used for requests when the account of the header doesn't exist.
+	ErrAccountNotFound = errors.Error("account not found")
+
+	// bearerPrefix is the prefix of the header when the account identifier is passed as a bearer token.
+	bearerPrefix = "Bearer "
+)
 
 var (
 	log = logging.Logger("api-server")
 
 	// noAuthPaths holds the paths that doesn't require header to be passed.
-	noAuthPaths = [...]string{"/health.HealthCheckService/Ping"}
+	noAuthPaths = [...]string{"/health.HealthCheckService/Ping", "/verification.VerificationService/Verify"}
 )
 
 // Config defines methods required for the package api
@@ -39,6 +54,7 @@ type Config interface {
 	GetServerPort() int
 	GetNetworkString() string
 	IsPProfEnabled() bool
+	GetPublicVerificationEnabled() bool
 }
 
 // apiServer is an implementation of node.Server interface for serving HTTP based Centrifuge API
@@ -73,9 +89,22 @@ func (c apiServer) Start(ctx context.Context, wg *sync.WaitGroup, startupErr cha
 	// set http error interceptor
 	runtime.HTTPError = httpResponseInterceptor
 
+	configService, err := getConfigService(ctx)
+	if err != nil {
+		startupErr <- err
+		return
+	}
+
+	saSrv, err := getServiceAccountService(ctx)
+	if err != nil {
+		startupErr <- err
+		return
+	}
+
 	opts := []grpc.ServerOption{
 		grpc.Creds(creds),
-		grpcInterceptor(),
+		grpcInterceptor(configService, saSrv),
+		grpcStreamInterceptor(configService, saSrv),
 	}
 
 	grpcServer := grpc.NewServer(opts...)
@@ -100,6 +129,17 @@ func (c apiServer) Start(ctx context.Context, wg *sync.WaitGroup, startupErr cha
 		mux.Handle("/debug/", http.DefaultServeMux)
 	}
 
+	if c.config.GetPublicVerificationEnabled() {
+		verificationSrv, err := getVerificationService(ctx)
+		if err != nil {
+			startupErr <- err
+			return
+		}
+
+		log.Info("added the public verification page to the server")
+		mux.Handle(verification.PagePath, verification.PageHandler(verificationSrv))
+	}
+
 	mux.Handle("/", gwmux)
 	srv := &http.Server{
 		Addr:    addr,
@@ -182,37 +222,179 @@ func loadKeyPair() (keyPair tls.Certificate, err error) {
 	return pair, nil
 }
 
+// getConfigService returns the config service from the node object registry.
+func getConfigService(ctx context.Context) (config.Service, error) {
+	nodeObjReg, ok := ctx.Value(bootstrap.NodeObjRegistry).(map[string]interface{})
+	if !ok {
+		return nil, errors.New("failed to get %s", bootstrap.NodeObjRegistry)
+	}
+
+	configService, ok := nodeObjReg[config.BootstrappedConfigStorage].(config.Service)
+	if !ok {
+		return nil, errors.New("failed to get %s", config.BootstrappedConfigStorage)
+	}
+
+	return configService, nil
+}
+
+// getVerificationService returns the verification service from the node object registry.
+func getVerificationService(ctx context.Context) (verification.Service, error) {
+	nodeObjReg, ok := ctx.Value(bootstrap.NodeObjRegistry).(map[string]interface{})
+	if !ok {
+		return nil, errors.New("failed to get %s", bootstrap.NodeObjRegistry)
+	}
+
+	srv, ok := nodeObjReg[verification.BootstrappedService].(verification.Service)
+	if !ok {
+		return nil, errors.New("failed to get %s", verification.BootstrappedService)
+	}
+
+	return srv, nil
+}
+
+// getServiceAccountService returns the service account service from the node object registry.
+func getServiceAccountService(ctx context.Context) (serviceaccount.Service, error) {
+	nodeObjReg, ok := ctx.Value(bootstrap.NodeObjRegistry).(map[string]interface{})
+	if !ok {
+		return nil, errors.New("failed to get %s", bootstrap.NodeObjRegistry)
+	}
+
+	srv, ok := nodeObjReg[serviceaccount.BootstrappedService].(serviceaccount.Service)
+	if !ok {
+		return nil, errors.New("failed to get %s", serviceaccount.BootstrappedService)
+	}
+
+	return srv, nil
+}
+
 // grpcInterceptor returns a GRPC UnaryInterceptor for all grpc/http requests.
-func grpcInterceptor() grpc.ServerOption {
-	return grpc.UnaryInterceptor(auth)
+// REST requests are proxied to the grpc server by the gateway, which forwards the 'authorization' header.
+func grpcInterceptor(configService config.Service, saSrv serviceaccount.Service) grpc.ServerOption {
+	return grpc.UnaryInterceptor(auth(configService, saSrv))
 }
 
-// auth is the grpc unary interceptor to to check if the account ID is passed in the header.
-// interceptor will check "authorisation" header. If not set, we return an error.
+// grpcStreamInterceptor returns a GRPC StreamInterceptor authenticating the streaming requests like the unary ones.
+func grpcStreamInterceptor(configService config.Service, saSrv serviceaccount.Service) grpc.ServerOption {
+	return grpc.StreamInterceptor(streamAuth(configService, saSrv))
+}
+
+// auth returns the grpc unary interceptor that loads the account of the request into the context.
+// interceptor will check "authorization" header. If not set, we return an error.
+// The header holds the hex encoded account identity, either as is or as a bearer token.
+// It can hold a service account credential instead, then the method must be in the scope of the service account
+// and the account of the service account is loaded.
+// The account is loaded from the config service once per request.
 //
 // at this point we are going with one interceptor. Once we have more than one interceptor,
 // we can write a wrapper interceptor that will call the chain of interceptor
 //
-// Note: each handler can access accountID from the context: ctx.Value(api.AccountHeaderKey)
-func auth(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-	// if this request is for ping
-	if utils.ContainsString(noAuthPaths[:], info.FullMethod) {
+// Note: each handler can access the account from the context: contextutil.Account(ctx)
+func auth(configService config.Service, saSrv serviceaccount.Service) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		// if this request is for ping
+		if utils.ContainsString(noAuthPaths[:], info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err = authenticate(ctx, configService, saSrv, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
 		return handler(ctx, req)
 	}
+}
+
+// streamAuth returns the grpc stream interceptor that loads the account of the request into the context of the stream.
+// The request is authenticated like the unary ones, see auth.
+func streamAuth(configService config.Service, saSrv serviceaccount.Service) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if utils.ContainsString(noAuthPaths[:], info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticate(ss.Context(), configService, saSrv, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, accountStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// accountStream is a server stream whose context holds the account of the request.
+type accountStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream with the account.
+func (s accountStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate loads the account of the 'authorization' header into the context of the request to the method.
+func authenticate(ctx context.Context, configService config.Service, saSrv serviceaccount.Service, method string) (context.Context, error) {
+	header, err := authHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := strings.TrimPrefix(header, bearerPrefix)
+	if serviceaccount.IsCredential(value) {
+		sa, err := saSrv.Authenticate(value, method)
+		if err != nil {
+			return nil, serviceAccountAuthError(err)
+		}
+
+		// the credential is a secret, only the account of the service account is passed on
+		header = sa.AccountID.String()
+		value = header
+	}
+
+	accountID, err := identifiers.DecodeDID(value)
+	if err != nil {
+		return nil, errors.NewHTTPError(http.StatusBadRequest, errors.NewTypedError(ErrInvalidAccountID, err))
+	}
+
+	acc, err := configService.GetAccount(accountID[:])
+	if err != nil {
+		return nil, errors.NewHTTPError(http.StatusForbidden, errors.NewTypedError(ErrAccountNotFound, errors.New("%s", accountID.String())))
+	}
 
-	err = errors.NewHTTPError(http.StatusBadRequest, ErrNoAuthHeader)
+	ctx = context.WithValue(ctx, config.AccountHeaderKey, header)
+	ctx, err = contextutil.New(ctx, acc)
+	if err != nil {
+		return nil, errors.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return ctx, nil
+}
+
+// serviceAccountAuthError returns forbidden if the method is out of the scope of the service account,
+// unauthorized otherwise.
+func serviceAccountAuthError(err error) error {
+	if errors.IsOfType(serviceaccount.ErrOutOfScope, err) {
+		return errors.NewHTTPError(http.StatusForbidden, err)
+	}
+
+	return errors.NewHTTPError(http.StatusUnauthorized, err)
+}
+
+// authHeader returns the value of the 'authorization' header of the request.
+func authHeader(ctx context.Context) (string, error) {
+	err := errors.NewHTTPError(http.StatusBadRequest, ErrNoAuthHeader)
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, err
+		return "", err
 	}
 
 	auth := md.Get("authorization")
 	if len(auth) < 1 {
-		return nil, err
+		return "", err
 	}
 
-	ctx = context.WithValue(ctx, config.AccountHeaderKey, auth[0])
-	return handler(ctx, req)
+	return auth[0], nil
 }
 
 // httpResponseInterceptor will intercept if the we return an error from the grpc handler.
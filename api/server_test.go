@@ -5,6 +5,7 @@ package api
 import (
 	"context"
 	"flag"
+	"net/http"
 	"os"
 	"sync"
 	"testing"
@@ -14,18 +15,29 @@ import (
 	"github.com/centrifuge/go-centrifuge/bootstrap/bootstrappers/testlogging"
 	"github.com/centrifuge/go-centrifuge/config"
 	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/connectors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
 	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/documents/entity"
+	"github.com/centrifuge/go-centrifuge/documents/generic"
 	"github.com/centrifuge/go-centrifuge/documents/invoice"
 	"github.com/centrifuge/go-centrifuge/documents/purchaseorder"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/ethereum"
+	"github.com/centrifuge/go-centrifuge/forensics"
+	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/identity/ideth"
+	"github.com/centrifuge/go-centrifuge/maintenance"
 	"github.com/centrifuge/go-centrifuge/nft"
 	"github.com/centrifuge/go-centrifuge/p2p"
 	"github.com/centrifuge/go-centrifuge/queue"
+	"github.com/centrifuge/go-centrifuge/serviceaccount"
 	"github.com/centrifuge/go-centrifuge/storage/leveldb"
 	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
 	"github.com/centrifuge/go-centrifuge/transactions/txv1"
+	"github.com/centrifuge/go-centrifuge/verification"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -47,10 +59,17 @@ func TestMain(m *testing.M) {
 		&queue.Bootstrapper{},
 		&ideth.Bootstrapper{},
 		&configstore.Bootstrapper{},
+		maintenance.Bootstrapper{},
+		forensics.Bootstrapper{},
+		serviceaccount.Bootstrapper{},
 		anchors.Bootstrapper{},
 		documents.Bootstrapper{},
+		verification.Bootstrapper{},
 		&invoice.Bootstrapper{},
 		&purchaseorder.Bootstrapper{},
+		&generic.Bootstrapper{},
+		&entity.Bootstrapper{},
+		connectors.Bootstrapper{},
 		&ethereum.Bootstrapper{},
 		&nft.Bootstrapper{},
 		&queue.Starter{},
@@ -117,21 +136,30 @@ func TestCentAPIServer_FailedToGetRegistry(t *testing.T) {
 
 func Test_auth(t *testing.T) {
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return ctx.Value(config.AccountHeaderKey), nil
+		return contextutil.Account(ctx)
 	}
 
+	cs := ctx[config.BootstrappedConfigStorage].(config.Service)
+	accs, err := cs.GetAllAccounts()
+	assert.NoError(t, err)
+	accID, err := accs[0].GetIdentityID()
+	assert.NoError(t, err)
+	saSrv := ctx[serviceaccount.BootstrappedService].(serviceaccount.Service)
+	interceptor := auth(cs, saSrv)
+
 	// send ping path
-	resp, err := auth(
+	resp, err := interceptor(
 		context.Background(),
 		nil,
 		&grpc.UnaryServerInfo{FullMethod: noAuthPaths[0]},
 		handler,
 	)
 	assert.Nil(t, resp)
-	assert.Nil(t, err)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(contextutil.ErrSelfNotFound, err))
 
 	// send no auth
-	resp, err = auth(
+	resp, err = interceptor(
 		context.Background(),
 		nil,
 		&grpc.UnaryServerInfo{FullMethod: "some method"},
@@ -141,18 +169,179 @@ func Test_auth(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.True(t, errors.IsOfType(ErrNoAuthHeader, err))
 
-	// send Auth
-	ctx := metadata.NewIncomingContext(
+	// invalid account ID
+	actx := metadata.NewIncomingContext(
 		context.Background(),
 		map[string][]string{"authorization": {"1234567890"}})
 
-	resp, err = auth(
-		ctx,
+	resp, err = interceptor(
+		actx,
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: "some method"},
+		handler,
+	)
+
+	assert.Nil(t, resp)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), ErrInvalidAccountID.Error())
+
+	// unknown account
+	actx = metadata.NewIncomingContext(
+		context.Background(),
+		map[string][]string{"authorization": {testingidentity.GenerateRandomDID().String()}})
+
+	resp, err = interceptor(
+		actx,
 		nil,
 		&grpc.UnaryServerInfo{FullMethod: "some method"},
 		handler,
 	)
 
+	assert.Nil(t, resp)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), ErrAccountNotFound.Error())
+
+	// send Auth
+	actx = metadata.NewIncomingContext(
+		context.Background(),
+		map[string][]string{"authorization": {hexutil.Encode(accID)}})
+
+	resp, err = interceptor(
+		actx,
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: "some method"},
+		handler,
+	)
+
+	assert.Nil(t, err)
+	acc, ok := resp.(config.Account)
+	assert.True(t, ok)
+	gotID, err := acc.GetIdentityID()
+	assert.NoError(t, err)
+	assert.Equal(t, accID, gotID)
+
+	// send Auth as bearer token
+	actx = metadata.NewIncomingContext(
+		context.Background(),
+		map[string][]string{"authorization": {bearerPrefix + hexutil.Encode(accID)}})
+
+	resp, err = interceptor(
+		actx,
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: "some method"},
+		handler,
+	)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+
+	// service account in scope loads the account of the service account
+	did := identity.NewDIDFromBytes(accID)
+	_, credential, err := saSrv.Create(did, "erp", []string{"invoice"}, []string{serviceaccount.OperationRead})
+	assert.NoError(t, err)
+	actx = metadata.NewIncomingContext(
+		context.Background(),
+		map[string][]string{"authorization": {bearerPrefix + credential}})
+
+	resp, err = interceptor(
+		actx,
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: "/invoice.DocumentService/Get"},
+		handler,
+	)
+
 	assert.Nil(t, err)
-	assert.Equal(t, "1234567890", resp)
+	acc, ok = resp.(config.Account)
+	assert.True(t, ok)
+	gotID, err = acc.GetIdentityID()
+	assert.NoError(t, err)
+	assert.Equal(t, accID, gotID)
+
+	// service account out of scope
+	resp, err = interceptor(
+		actx,
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: "/account.AccountService/GenerateAccount"},
+		handler,
+	)
+
+	assert.Nil(t, resp)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusForbidden, code)
+
+	// invalid service account credential
+	actx = metadata.NewIncomingContext(
+		context.Background(),
+		map[string][]string{"authorization": {credential + "0"}})
+
+	resp, err = interceptor(
+		actx,
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: "/invoice.DocumentService/Get"},
+		handler,
+	)
+
+	assert.Nil(t, resp)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusUnauthorized, code)
+}
+
+// testServerStream is a server stream of the context of the request.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func Test_streamAuth(t *testing.T) {
+	var acc config.Account
+	handler := func(srv interface{}, stream grpc.ServerStream) (err error) {
+		acc, err = contextutil.Account(stream.Context())
+		return err
+	}
+
+	cs := ctx[config.BootstrappedConfigStorage].(config.Service)
+	accs, err := cs.GetAllAccounts()
+	assert.NoError(t, err)
+	accID, err := accs[0].GetIdentityID()
+	assert.NoError(t, err)
+	saSrv := ctx[serviceaccount.BootstrappedService].(serviceaccount.Service)
+	interceptor := streamAuth(cs, saSrv)
+	info := &grpc.StreamServerInfo{FullMethod: "/document.DocumentService/ValidateDocument", IsServerStream: true}
+
+	// send no auth
+	err = interceptor(nil, testServerStream{ctx: context.Background()}, info, handler)
+	assert.True(t, errors.IsOfType(ErrNoAuthHeader, err))
+
+	// unknown account
+	actx := metadata.NewIncomingContext(
+		context.Background(),
+		map[string][]string{"authorization": {testingidentity.GenerateRandomDID().String()}})
+	err = interceptor(nil, testServerStream{ctx: actx}, info, handler)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), ErrAccountNotFound.Error())
+
+	// send Auth, the stream holds the account
+	actx = metadata.NewIncomingContext(
+		context.Background(),
+		map[string][]string{"authorization": {bearerPrefix + hexutil.Encode(accID)}})
+	err = interceptor(nil, testServerStream{ctx: actx}, info, handler)
+	assert.NoError(t, err)
+	gotID, err := acc.GetIdentityID()
+	assert.NoError(t, err)
+	assert.Equal(t, accID, gotID)
+
+	// service account out of scope
+	did := identity.NewDIDFromBytes(accID)
+	_, credential, err := saSrv.Create(did, "monitoring", []string{"invoice"}, []string{serviceaccount.OperationRead})
+	assert.NoError(t, err)
+	actx = metadata.NewIncomingContext(
+		context.Background(),
+		map[string][]string{"authorization": {bearerPrefix + credential}})
+	err = interceptor(nil, testServerStream{ctx: actx}, info, handler)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusForbidden, code)
 }
@@ -4,22 +4,40 @@ import (
 	"github.com/centrifuge/go-centrifuge/bootstrap"
 	"github.com/centrifuge/go-centrifuge/config"
 	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/connectors"
 	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/documents/entity"
+	"github.com/centrifuge/go-centrifuge/documents/generic"
 	"github.com/centrifuge/go-centrifuge/documents/invoice"
 	"github.com/centrifuge/go-centrifuge/documents/purchaseorder"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/forensics"
 	"github.com/centrifuge/go-centrifuge/healthcheck"
+	"github.com/centrifuge/go-centrifuge/maintenance"
 	"github.com/centrifuge/go-centrifuge/nft"
+	"github.com/centrifuge/go-centrifuge/p2p"
+	"github.com/centrifuge/go-centrifuge/p2p/traffic"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/account"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/collaborator"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/config"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/connectors"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/entity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/forensics"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/generic"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/health"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoice"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/maintenance"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/nft"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/purchaseorder"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/serviceaccount"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/traffic"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/transactions"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/verification"
+	"github.com/centrifuge/go-centrifuge/serviceaccount"
 	"github.com/centrifuge/go-centrifuge/transactions"
 	"github.com/centrifuge/go-centrifuge/transactions/txv1"
+	"github.com/centrifuge/go-centrifuge/verification"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -44,13 +62,38 @@ func registerServices(ctx context.Context, cfg Config, grpcServer *grpc.Server,
 		return errors.New("failed to get %s", config.BootstrappedConfigStorage)
 	}
 
+	notary, ok := nodeObjReg[documents.BootstrappedProofNotary].(documents.ProofNotary)
+	if !ok {
+		return errors.New("failed to get %s", documents.BootstrappedProofNotary)
+	}
+
+	docSrv, ok := nodeObjReg[documents.BootstrappedDocumentService].(documents.Service)
+	if !ok {
+		return errors.New("failed to get %s", documents.BootstrappedDocumentService)
+	}
+
 	payObService, ok := nodeObjReg[nft.BootstrappedPayObService].(nft.PaymentObligation)
 	if !ok {
 		return errors.New("failed to get %s", nft.BootstrappedPayObService)
 	}
 
+	tokenRegistry, ok := nodeObjReg[nft.BootstrappedPayObService].(documents.TokenRegistry)
+	if !ok {
+		return errors.New("failed to get %s", nft.BootstrappedPayObService)
+	}
+
+	oracleService, ok := nodeObjReg[nft.BootstrappedOracleService].(nft.OracleService)
+	if !ok {
+		return errors.New("failed to get %s", nft.BootstrappedOracleService)
+	}
+
+	usage, ok := nodeObjReg[documents.BootstrappedStorageUsage].(*documents.UsageTracker)
+	if !ok {
+		return errors.New("failed to get %s", documents.BootstrappedStorageUsage)
+	}
+
 	// documents (common)
-	documentpb.RegisterDocumentServiceServer(grpcServer, documents.GRPCHandler(configService, registry))
+	documentpb.RegisterDocumentServiceServer(grpcServer, documents.GRPCHandler(configService, registry, notary, tokenRegistry, docSrv, usage))
 	err := documentpb.RegisterDocumentServiceHandlerFromEndpoint(ctx, gwmux, addr, dopts)
 	if err != nil {
 		return err
@@ -79,6 +122,28 @@ func registerServices(ctx context.Context, cfg Config, grpcServer *grpc.Server,
 		return err
 	}
 
+	genericHandler, ok := nodeObjReg[generic.BootstrappedGenericHandler].(genericpb.DocumentServiceServer)
+	if !ok {
+		return errors.New("generic document grpc handler not registered")
+	}
+
+	genericpb.RegisterDocumentServiceServer(grpcServer, genericHandler)
+	err = genericpb.RegisterDocumentServiceHandlerFromEndpoint(ctx, gwmux, addr, dopts)
+	if err != nil {
+		return err
+	}
+
+	entityHandler, ok := nodeObjReg[entity.BootstrappedEntityHandler].(entitypb.DocumentServiceServer)
+	if !ok {
+		return errors.New("entity grpc handler not registered")
+	}
+
+	entitypb.RegisterDocumentServiceServer(grpcServer, entityHandler)
+	err = entitypb.RegisterDocumentServiceHandlerFromEndpoint(ctx, gwmux, addr, dopts)
+	if err != nil {
+		return err
+	}
+
 	// healthcheck
 	hcCfg := cfg.(healthcheck.Config)
 	healthpb.RegisterHealthCheckServiceServer(grpcServer, healthcheck.GRPCHandler(hcCfg))
@@ -88,7 +153,7 @@ func registerServices(ctx context.Context, cfg Config, grpcServer *grpc.Server,
 	}
 
 	// nft api
-	nftpb.RegisterNFTServiceServer(grpcServer, nft.GRPCHandler(configService, payObService))
+	nftpb.RegisterNFTServiceServer(grpcServer, nft.GRPCHandler(configService, payObService, oracleService))
 	err = nftpb.RegisterNFTServiceHandlerFromEndpoint(ctx, gwmux, addr, dopts)
 	if err != nil {
 		return err
@@ -108,6 +173,92 @@ func registerServices(ctx context.Context, cfg Config, grpcServer *grpc.Server,
 		return err
 	}
 
+	// maintenance
+	maintenanceSrv, ok := nodeObjReg[maintenance.BootstrappedService].(maintenance.Service)
+	if !ok {
+		return errors.New("failed to get %s", maintenance.BootstrappedService)
+	}
+
+	maintenancepb.RegisterMaintenanceServiceServer(grpcServer, maintenance.GRPCHandler(maintenanceSrv))
+	err = maintenancepb.RegisterMaintenanceServiceHandlerFromEndpoint(ctx, gwmux, addr, dopts)
+	if err != nil {
+		return err
+	}
+
+	// forensics
+	forensicsSrv, ok := nodeObjReg[forensics.BootstrappedService].(forensics.Service)
+	if !ok {
+		return errors.New("failed to get %s", forensics.BootstrappedService)
+	}
+
+	forensicspb.RegisterForensicsServiceServer(grpcServer, forensics.GRPCHandler(forensicsSrv))
+	err = forensicspb.RegisterForensicsServiceHandlerFromEndpoint(ctx, gwmux, addr, dopts)
+	if err != nil {
+		return err
+	}
+
+	// service accounts
+	saSrv, err := getServiceAccountService(ctx)
+	if err != nil {
+		return err
+	}
+
+	serviceaccountpb.RegisterServiceAccountServiceServer(grpcServer, serviceaccount.GRPCHandler(saSrv))
+	err = serviceaccountpb.RegisterServiceAccountServiceHandlerFromEndpoint(ctx, gwmux, addr, dopts)
+	if err != nil {
+		return err
+	}
+
+	// connectors
+	connectorSrv, ok := nodeObjReg[connectors.BootstrappedService].(connectors.Service)
+	if !ok {
+		return errors.New("failed to get %s", connectors.BootstrappedService)
+	}
+
+	connectorspb.RegisterConnectorServiceServer(grpcServer, connectors.GRPCHandler(connectorSrv))
+	err = connectorspb.RegisterConnectorServiceHandlerFromEndpoint(ctx, gwmux, addr, dopts)
+	if err != nil {
+		return err
+	}
+
+	// collaborator checklist and summaries
+	checker, ok := nodeObjReg[bootstrap.BootstrappedPeer].(p2p.CollaboratorChecker)
+	if !ok {
+		return errors.New("failed to get %s", bootstrap.BootstrappedPeer)
+	}
+
+	collaboratorpb.RegisterCollaboratorServiceServer(grpcServer, p2p.GRPCHandler(checker, docSrv))
+	err = collaboratorpb.RegisterCollaboratorServiceHandlerFromEndpoint(ctx, gwmux, addr, dopts)
+	if err != nil {
+		return err
+	}
+
+	// p2p traffic
+	recorder, ok := nodeObjReg[traffic.BootstrappedRecorder].(*traffic.Recorder)
+	if !ok {
+		return errors.New("failed to get %s", traffic.BootstrappedRecorder)
+	}
+
+	trafficpb.RegisterTrafficServiceServer(grpcServer, traffic.GRPCHandler(recorder))
+	err = trafficpb.RegisterTrafficServiceHandlerFromEndpoint(ctx, gwmux, addr, dopts)
+	if err != nil {
+		return err
+	}
+
+	// public verification
+	if cfg.GetPublicVerificationEnabled() {
+		verificationSrv, err := getVerificationService(ctx)
+		if err != nil {
+			return err
+		}
+
+		verificationpb.RegisterVerificationServiceServer(grpcServer, verification.GRPCHandler(verificationSrv))
+		err = verificationpb.RegisterVerificationServiceHandlerFromEndpoint(ctx, gwmux, addr, dopts)
+		if err != nil {
+			return err
+		}
+	}
+
 	// transactions
 	txSrv := nodeObjReg[transactions.BootstrappedService].(transactions.Manager)
 	h := txv1.GRPCHandler(txSrv, configService)
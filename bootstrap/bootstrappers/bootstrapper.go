@@ -6,17 +6,26 @@ import (
 	"github.com/centrifuge/go-centrifuge/bootstrap"
 	"github.com/centrifuge/go-centrifuge/config"
 	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/connectors"
 	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/documents/entity"
+	"github.com/centrifuge/go-centrifuge/documents/generic"
 	"github.com/centrifuge/go-centrifuge/documents/invoice"
 	"github.com/centrifuge/go-centrifuge/documents/purchaseorder"
 	"github.com/centrifuge/go-centrifuge/ethereum"
+	"github.com/centrifuge/go-centrifuge/faults"
+	"github.com/centrifuge/go-centrifuge/forensics"
 	"github.com/centrifuge/go-centrifuge/identity/ideth"
+	"github.com/centrifuge/go-centrifuge/maintenance"
 	"github.com/centrifuge/go-centrifuge/nft"
 	"github.com/centrifuge/go-centrifuge/node"
 	"github.com/centrifuge/go-centrifuge/p2p"
 	"github.com/centrifuge/go-centrifuge/queue"
+	"github.com/centrifuge/go-centrifuge/sandbox"
+	"github.com/centrifuge/go-centrifuge/serviceaccount"
 	"github.com/centrifuge/go-centrifuge/storage/leveldb"
 	"github.com/centrifuge/go-centrifuge/transactions/txv1"
+	"github.com/centrifuge/go-centrifuge/verification"
 	"github.com/centrifuge/go-centrifuge/version"
 	log2 "github.com/ipfs/go-log"
 )
@@ -36,14 +45,23 @@ func (m *MainBootstrapper) PopulateBaseBootstrappers() {
 		&leveldb.Bootstrapper{},
 		txv1.Bootstrapper{},
 		&queue.Bootstrapper{},
+		faults.Bootstrapper{},
 		ethereum.Bootstrapper{},
 		&ideth.Bootstrapper{},
-		&configstore.Bootstrapper{},
 		&anchors.Bootstrapper{},
+		sandbox.Bootstrapper{},
+		&configstore.Bootstrapper{},
+		maintenance.Bootstrapper{},
+		forensics.Bootstrapper{},
+		serviceaccount.Bootstrapper{},
 		documents.Bootstrapper{},
+		verification.Bootstrapper{},
 		api.Bootstrapper{},
 		&invoice.Bootstrapper{},
 		&purchaseorder.Bootstrapper{},
+		&generic.Bootstrapper{},
+		&entity.Bootstrapper{},
+		connectors.Bootstrapper{},
 		&nft.Bootstrapper{},
 		p2p.Bootstrapper{},
 		documents.PostBootstrapper{},
@@ -58,9 +76,11 @@ func (m *MainBootstrapper) PopulateCommandBootstrappers() {
 		&leveldb.Bootstrapper{},
 		txv1.Bootstrapper{},
 		&queue.Bootstrapper{},
+		faults.Bootstrapper{},
 		ethereum.Bootstrapper{},
 		&ideth.Bootstrapper{},
 		&anchors.Bootstrapper{},
+		sandbox.Bootstrapper{},
 	}
 }
 
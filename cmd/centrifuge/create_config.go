@@ -17,6 +17,7 @@ var apiPort int64
 var p2pPort int64
 var bootstraps []string
 var txPoolAccess bool
+var sandbox bool
 
 func init() {
 	home, err := homedir.Dir()
@@ -30,7 +31,7 @@ func init() {
 		Short: "Configures Node",
 		Long:  ``,
 		Run: func(c *cobra.Command, args []string) {
-			err := cmd.CreateConfig(targetDataDir, ethNodeURL, accountKeyPath, accountPassword, network, apiPort, p2pPort, bootstraps, txPoolAccess, false, "", nil)
+			err := cmd.CreateConfig(targetDataDir, ethNodeURL, accountKeyPath, accountPassword, network, apiPort, p2pPort, bootstraps, txPoolAccess, false, sandbox, "", nil)
 			if err != nil {
 				log.Info(targetDataDir,
 					accountKeyPath,
@@ -40,7 +41,8 @@ func init() {
 					apiPort,
 					p2pPort,
 					bootstraps,
-					txPoolAccess)
+					txPoolAccess,
+					sandbox)
 				log.Fatalf("error: %v", err)
 			}
 		},
@@ -55,5 +57,6 @@ func init() {
 	createConfigCmd.Flags().StringVarP(&network, "network", "n", "russianhill", "Default Network")
 	createConfigCmd.Flags().StringSliceVarP(&bootstraps, "bootstraps", "b", nil, "Bootstrap P2P Nodes")
 	createConfigCmd.Flags().BoolVarP(&txPoolAccess, "txpoolaccess", "x", true, "Transaction Pool access")
+	createConfigCmd.Flags().BoolVar(&sandbox, "sandbox", false, "Simulate anchors and identities in process instead of using Ethereum, for local development only")
 	rootCmd.AddCommand(createConfigCmd)
 }
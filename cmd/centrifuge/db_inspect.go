@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/documents/entity"
+	"github.com/centrifuge/go-centrifuge/documents/generic"
+	"github.com/centrifuge/go-centrifuge/documents/invoice"
+	"github.com/centrifuge/go-centrifuge/documents/purchaseorder"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+)
+
+// printInspectedVersion prints the dump of the document version in a readable form.
+func printInspectedVersion(v documents.InspectedVersion) {
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Printf("  %-17s %s\n", name+":", value)
+		}
+	}
+
+	fmt.Printf("version %s\n", v.VersionID)
+	field("account", v.AccountID)
+	if v.Encrypted {
+		field("encrypted", v.ModelType)
+		fmt.Println()
+		return
+	}
+
+	field("document", v.DocumentID)
+	field("type", v.DocumentType)
+	field("status", v.Status)
+	field("previous version", v.PreviousVersion)
+	field("next version", v.NextVersion)
+	field("author", v.Author)
+	field("timestamp", v.Timestamp)
+	field("data root", v.DataRoot)
+	field("signing root", v.SigningRoot)
+	field("document root", v.DocumentRoot)
+	for _, s := range v.Signatures {
+		field("signature", s.SignerID)
+		field("  public key", s.PublicKey)
+		field("  value", s.Signature)
+	}
+
+	field("data", string(v.Data))
+	fmt.Println()
+}
+
+func init() {
+
+	//specific param
+	var pathParam string
+	var accountParam string
+	var documentParam string
+	var redactDataParam bool
+	var redactSignaturesParam bool
+	var jsonParam bool
+
+	var dbCmd = &cobra.Command{
+		Use:   "db",
+		Short: "offline tools for the storage of a node",
+		Long:  ``,
+	}
+
+	var inspectCmd = &cobra.Command{
+		Use:   "inspect",
+		Short: "dump the document versions stored by a node that is not running",
+		Long: `Opens the document storage of the node of the config file read-only, or the storage at the path, and prints the
+stored document versions with their roots and signatures, ordered by account and version. The node must not be running.
+The versions encrypted at rest can't be decrypted offline and are listed without their content.`,
+		Run: func(cm *cobra.Command, args []string) {
+			if pathParam == "" {
+				cfgFile = ensureConfigFile()
+				pathParam = config.LoadConfiguration(cfgFile).GetStoragePath()
+			}
+
+			opts := documents.InspectOptions{RedactData: redactDataParam, RedactSignatures: redactSignaturesParam}
+			var err error
+			if accountParam != "" {
+				opts.AccountID, err = hexutil.Decode(accountParam)
+				if err != nil {
+					log.Fatalf("invalid account: %v", err)
+				}
+			}
+
+			if documentParam != "" {
+				opts.DocumentID, err = hexutil.Decode(documentParam)
+				if err != nil {
+					log.Fatalf("invalid document: %v", err)
+				}
+			}
+
+			db, err := leveldb.NewLevelDBReadOnlyStorage(pathParam)
+			if err != nil {
+				log.Fatalf("failed to open the storage at %s: %v", pathParam, err)
+			}
+			defer db.Close()
+
+			ldb := leveldb.NewLevelDBRepository(db)
+			repo := documents.NewDBRepository(ldb)
+			for _, m := range []documents.Model{&invoice.Invoice{}, &purchaseorder.PurchaseOrder{}, &generic.Generic{}, &entity.Entity{}} {
+				repo.Register(m)
+			}
+
+			err = documents.Inspect(ldb, opts, func(v documents.InspectedVersion) error {
+				if !jsonParam {
+					printInspectedVersion(v)
+					return nil
+				}
+
+				out, err := json.Marshal(v)
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(out))
+				return nil
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	rootCmd.AddCommand(dbCmd)
+	dbCmd.AddCommand(inspectCmd)
+	inspectCmd.Flags().StringVar(&pathParam, "path", "", "path of the document storage, the storage of the config file if not given")
+	inspectCmd.Flags().StringVar(&accountParam, "account", "", "hex encoded identity of the account to dump the documents of")
+	inspectCmd.Flags().StringVar(&documentParam, "document", "", "hex encoded identifier of the document to dump the versions of")
+	inspectCmd.Flags().BoolVar(&redactDataParam, "redact-data", false, "leave the document data out")
+	inspectCmd.Flags().BoolVar(&redactSignaturesParam, "redact-signatures", false, "leave the signature values and the public keys out")
+	inspectCmd.Flags().BoolVar(&jsonParam, "json", false, "print every version as a json object on its own line")
+}
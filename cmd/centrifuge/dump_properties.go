@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/documents/entity"
+	"github.com/centrifuge/go-centrifuge/documents/generic"
+	"github.com/centrifuge/go-centrifuge/documents/invoice"
+	"github.com/centrifuge/go-centrifuge/documents/purchaseorder"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var dumpPropertiesCmd = &cobra.Command{
+		Use:   "properties",
+		Short: "dump the readable to compact property mappings of the core document and all document types",
+		Long:  ``,
+		Run: func(cmd *cobra.Command, args []string) {
+			cdMappings, err := documents.CoreDocumentPropertyMappings()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			var docMappings []documents.DocumentPropertyMappings
+			for _, d := range []struct {
+				docType  string
+				mappings func() ([]documents.PropertyMapping, error)
+			}{
+				{documenttypes.InvoiceDataTypeUrl, invoice.PropertyMappings},
+				{documenttypes.PurchaseOrderDataTypeUrl, purchaseorder.PropertyMappings},
+				{generic.DocumentTypeURL, generic.PropertyMappings},
+				{entity.DocumentTypeURL, entity.PropertyMappings},
+			} {
+				props, err := d.mappings()
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				docMappings = append(docMappings, documents.DocumentPropertyMappings{DocumentType: d.docType, Properties: props})
+			}
+
+			out, err := json.MarshalIndent(documents.ConvertPropertyMappingsToClientFormat(cdMappings, docMappings), "", "  ")
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fmt.Println(string(out))
+		},
+	}
+
+	rootCmd.AddCommand(dumpPropertiesCmd)
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/maintenance"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/maintenance"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// jobPollInterval is the interval between the polls of the report of a running job
+const jobPollInterval = time.Second
+
+func init() {
+
+	//specific param
+	var jobParam string
+	var accountParam string
+
+	var runJobCmd = &cobra.Command{
+		Use:   "run-job",
+		Short: "run a maintenance job, such as document-retention, for all the accounts of a running node",
+		Long: `Starts the maintenance job on the node of the config file through its api, waits for the job to finish and
+prints its report. The requests are authorized with the identity of the config file unless an account is given.
+The maintenance jobs of the node are listed if no job is given.`,
+		Run: func(cm *cobra.Command, args []string) {
+			cfgFile = ensureConfigFile()
+			cfg := config.LoadConfiguration(cfgFile)
+			if accountParam == "" {
+				id, err := cfg.GetIdentityID()
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				accountParam = identity.NewDIDFromBytes(id).String()
+			}
+
+			// the node serves the api with a self-signed certificate
+			creds := credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})
+			conn, err := grpc.Dial(cfg.GetServerAddress(), grpc.WithTransportCredentials(creds))
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer conn.Close()
+
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", accountParam)
+			client := maintenancepb.NewMaintenanceServiceClient(conn)
+			if jobParam == "" {
+				jobs, err := client.ListJobs(ctx, &empty.Empty{})
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				for _, j := range jobs.Jobs {
+					fmt.Println(j)
+				}
+				return
+			}
+
+			report, err := client.StartJob(ctx, &maintenancepb.StartJobRequest{Job: jobParam})
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			for report.Status == maintenance.StatusRunning {
+				time.Sleep(jobPollInterval)
+				report, err = client.GetJobReport(ctx, &maintenancepb.GetJobReportRequest{RunId: report.RunId})
+				if err != nil {
+					log.Fatal(err)
+				}
+			}
+
+			out, err := (&jsonpb.Marshaler{Indent: "  "}).MarshalToString(report)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fmt.Println(out)
+			if report.Error != "" || report.Failed > 0 {
+				log.Fatalf("job %s failed for %d accounts", jobParam, report.Failed)
+			}
+		},
+	}
+
+	rootCmd.AddCommand(runJobCmd)
+	runJobCmd.Flags().StringVar(&jobParam, "job", "", "name of the maintenance job to run")
+	runJobCmd.Flags().StringVar(&accountParam, "account", "", "hex encoded identity of an account of the node authorizing the requests")
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/spf13/cobra"
+)
+
+// readProofBundle reads the proof bundle exported from the document proof api.
+func readProofBundle(path string) (*documents.DocumentProof, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := new(documentpb.DocumentProof)
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	err = u.Unmarshal(bytes.NewReader(data), bundle)
+	if err != nil {
+		return nil, errors.New("failed to parse proof bundle: %v", err)
+	}
+
+	return documents.ConvertClientProofToDocProof(bundle)
+}
+
+// readFieldProofs reads a json list of field proofs in the client api format.
+func readFieldProofs(path, root, version string) (*documents.DocumentProof, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []json.RawMessage
+	err = json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, errors.New("failed to parse proofs: %v", err)
+	}
+
+	bundle := &documentpb.DocumentProof{DocumentRoot: root, Header: &documentpb.ResponseHeader{VersionId: version}}
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	for i, r := range raw {
+		p := new(documentpb.Proof)
+		err = u.Unmarshal(bytes.NewReader(r), p)
+		if err != nil {
+			return nil, errors.New("failed to parse proof %d: %v", i, err)
+		}
+
+		bundle.FieldProofs = append(bundle.FieldProofs, p)
+	}
+
+	return documents.ConvertClientProofToDocProof(bundle)
+}
+
+func init() {
+
+	//specific param
+	var bundleParam string
+	var proofsParam string
+	var rootParam string
+	var versionParam string
+	var ethNodeParam string
+	var anchorRepositoryParam string
+
+	var verifyProofCmd = &cobra.Command{
+		Use:   "verify-proof",
+		Short: "verify a proof bundle offline, optionally checking its anchor against an ethereum node",
+		Long: `Verifies the field proofs of a proof bundle exported from the document proof api, or of a json list of proofs
+and a document root. Bundle signatures are verified against their public keys.
+If an ethereum node is given, the document root is checked against the anchor of the document version.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			var proof *documents.DocumentProof
+			var err error
+			switch {
+			case bundleParam != "":
+				proof, err = readProofBundle(bundleParam)
+			case proofsParam != "" && rootParam != "":
+				proof, err = readFieldProofs(proofsParam, rootParam, versionParam)
+			default:
+				err = errors.New("either a proof bundle or proofs and a document root are required")
+			}
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			err = documents.VerifyProofBundle(proof)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fmt.Printf("%d field proofs and %d signatures valid for document root %s\n", len(proof.FieldProofs), len(proof.Signatures), hexutil.Encode(proof.DocumentRoot))
+			if ethNodeParam == "" {
+				return
+			}
+
+			if !common.IsHexAddress(anchorRepositoryParam) {
+				log.Fatalf("invalid anchor repository address %q", anchorRepositoryParam)
+			}
+
+			client, err := ethclient.Dial(ethNodeParam)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			err = documents.ValidateProofAnchor(proof, func(anchorID anchors.AnchorID) (anchors.DocumentRoot, error) {
+				return anchors.GetAnchoredRoot(ctx, client, common.HexToAddress(anchorRepositoryParam), anchorID)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fmt.Printf("document root anchored for version %s\n", hexutil.Encode(proof.VersionID))
+		},
+	}
+
+	rootCmd.AddCommand(verifyProofCmd)
+	verifyProofCmd.Flags().StringVarP(&bundleParam, "bundle", "b", "", "path to the proof bundle exported from the document proof api")
+	verifyProofCmd.Flags().StringVarP(&proofsParam, "proofs", "p", "", "path to a json list of field proofs, requires the document root")
+	verifyProofCmd.Flags().StringVarP(&rootParam, "root", "r", "", "hex encoded document root of the field proofs")
+	verifyProofCmd.Flags().StringVar(&versionParam, "version", "", "hex encoded document version of the field proofs, required to verify the anchor")
+	verifyProofCmd.Flags().StringVar(&ethNodeParam, "eth-node", "", "ethereum node rpc url to verify the anchor of the document root")
+	verifyProofCmd.Flags().StringVar(&anchorRepositoryParam, "anchor-repository", "", "address of the anchor repository contract")
+}
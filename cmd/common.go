@@ -30,7 +30,7 @@ func generateKeys(config config.Configuration) {
 }
 
 // CreateConfig creates a config file using provide parameters and the default config
-func CreateConfig(targetDataDir, ethNodeURL, accountKeyPath, accountPassword, network string, apiPort, p2pPort int64, bootstraps []string, txPoolAccess bool, preCommitEnabled bool, p2pConnectionTimeout string, smartContractAddrs *config.SmartContractAddresses) error {
+func CreateConfig(targetDataDir, ethNodeURL, accountKeyPath, accountPassword, network string, apiPort, p2pPort int64, bootstraps []string, txPoolAccess bool, preCommitEnabled bool, sandbox bool, p2pConnectionTimeout string, smartContractAddrs *config.SmartContractAddresses) error {
 
 	data := map[string]interface{}{
 		"targetDataDir":     targetDataDir,
@@ -44,6 +44,7 @@ func CreateConfig(targetDataDir, ethNodeURL, accountKeyPath, accountPassword, ne
 		"p2pConnectTimeout": p2pConnectionTimeout,
 		"txpoolaccess":      txPoolAccess,
 		"preCommitEnabled":  preCommitEnabled,
+		"sandbox":           sandbox,
 	}
 	if smartContractAddrs != nil {
 		data["smartContractAddresses"] = smartContractAddrs
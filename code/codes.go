@@ -31,8 +31,20 @@ const (
 	// DocumentNotFound operation cancelled due to missing document
 	DocumentNotFound Code = 7
 
+	// ReceiverBusy operation cancelled since the receiver can't accept more requests at the moment, retry later
+	ReceiverBusy Code = 8
+
+	// AnchorNotMined operation cancelled since the anchor of the document is not yet mined, retry later
+	AnchorNotMined Code = 9
+
+	// AnchorRootMismatch operation cancelled since the anchored document root doesn't match the document
+	AnchorRootMismatch Code = 10
+
+	// Throttled operation cancelled since the sender exceeded its rate limit on the receiver, retry later
+	Throttled Code = 11
+
 	// maxCode for boundary limit. increment this to add new error code
-	maxCode Code = 8
+	maxCode Code = 12
 )
 
 // httpMapping maps known error codes to HTTP codes
@@ -45,6 +57,10 @@ var httpMapping = map[Code]int{
 	AuthenticationFailed: http.StatusUnauthorized,
 	AuthorizationFailed:  http.StatusForbidden,
 	DocumentNotFound:     http.StatusNotFound,
+	ReceiverBusy:         http.StatusServiceUnavailable,
+	AnchorNotMined:       http.StatusServiceUnavailable,
+	AnchorRootMismatch:   http.StatusBadRequest,
+	Throttled:            http.StatusTooManyRequests,
 }
 
 // HTTPCode returns mapped HTTP code for error code
@@ -52,6 +52,11 @@ func TestToCode(t *testing.T) {
 
 		{
 			code: 10,
+			want: AnchorRootMismatch,
+		},
+
+		{
+			code: int32(maxCode),
 			want: Unknown,
 		},
 	}
@@ -0,0 +1,144 @@
+package configstore
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/config"
+)
+
+// accountCache keeps the most recently used accounts unlocked, i.e. with their keys loaded from the keystore.
+// The cached accounts are never handed out, callers get copies sharing the loaded keys, which are only read once loaded.
+// A nil accountCache caches nothing.
+type accountCache struct {
+	size int
+
+	mu      sync.Mutex
+	order   *list.List               // most recently used first
+	entries map[string]*list.Element // by identity ID
+}
+
+// newAccountCache returns an accountCache of size accounts, nil if size is not positive.
+func newAccountCache(size int) *accountCache {
+	if size <= 0 {
+		return nil
+	}
+
+	return &accountCache{size: size, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// get returns a copy of the unlocked account with the identity ID.
+func (c *accountCache) get(id []byte) (config.Account, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[string(id)]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(e)
+	return copyAccount(e.Value.(*Account)), true
+}
+
+// unlock loads the keys of the account and caches it, evicting the least recently used account if the cache is full.
+// Accounts whose keys can't be loaded are returned as is, the error surfaces when the keys are used.
+func (c *accountCache) unlock(acc config.Account) config.Account {
+	a, ok := acc.(*Account)
+	if c == nil || !ok {
+		return acc
+	}
+
+	if _, err := a.GetKeys(); err != nil {
+		return acc
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := string(a.IdentityID)
+	if e, ok := c.entries[id]; ok {
+		e.Value = a
+		c.order.MoveToFront(e)
+		return copyAccount(a)
+	}
+
+	c.entries[id] = c.order.PushFront(a)
+	if c.order.Len() > c.size {
+		last := c.order.Back()
+		c.order.Remove(last)
+		delete(c.entries, string(last.Value.(*Account).IdentityID))
+	}
+
+	return copyAccount(a)
+}
+
+// remove drops the account with the identity ID, so it is read and unlocked again on its next use.
+func (c *accountCache) remove(id []byte) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[string(id)]; ok {
+		c.order.Remove(e)
+		delete(c.entries, string(id))
+	}
+}
+
+// len returns the number of unlocked accounts.
+func (c *accountCache) len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func copyAccount(acc *Account) *Account {
+	cp := *acc
+	return &cp
+}
+
+// warmUp unlocks the accounts of the node until the cache is full, with at most concurrency accounts in parallel.
+// Accounts that are not unlocked on start are unlocked on their first use.
+func (s service) warmUp(concurrency int) {
+	if s.accounts == nil || concurrency <= 0 {
+		return
+	}
+
+	start := time.Now()
+	accs, err := s.repo.GetAllAccounts()
+	if err != nil {
+		apiLog.Warningf("failed to load the accounts to unlock: %v", err)
+		return
+	}
+
+	if len(accs) > s.accounts.size {
+		accs = accs[:s.accounts.size]
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, acc := range accs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(acc config.Account) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			s.accounts.unlock(acc)
+		}(acc)
+	}
+
+	wg.Wait()
+	apiLog.Infof("unlocked %d accounts in %s", s.accounts.len(), time.Since(start))
+}
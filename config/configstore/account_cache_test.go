@@ -0,0 +1,104 @@
+// +build unit
+
+package configstore
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomAccount(t *testing.T) *Account {
+	acc, err := NewAccount("main", cfg)
+	assert.NoError(t, err)
+	a := acc.(*Account)
+	a.IdentityID = utils.RandomSlice(20)
+	return a
+}
+
+func TestAccountCache(t *testing.T) {
+	// a nil cache caches nothing
+	var c *accountCache
+	assert.Nil(t, newAccountCache(0))
+	acc := randomAccount(t)
+	assert.Equal(t, acc, c.unlock(acc))
+	_, ok := c.get(acc.IdentityID)
+	assert.False(t, ok)
+	c.remove(acc.IdentityID)
+	assert.Equal(t, 0, c.len())
+
+	c = newAccountCache(2)
+	accs := []*Account{randomAccount(t), randomAccount(t), randomAccount(t)}
+	for _, acc := range accs[:2] {
+		c.unlock(acc)
+	}
+
+	// cached accounts are unlocked and handed out as copies
+	got, ok := c.get(accs[0].IdentityID)
+	assert.True(t, ok)
+	assert.False(t, got == config.Account(accs[0]))
+	assert.NotNil(t, got.(*Account).keys)
+	got.(*Account).Tier = config.AccountTierPremium
+	got, _ = c.get(accs[0].IdentityID)
+	assert.Equal(t, config.AccountTierStandard, got.GetTier())
+
+	// the least recently used account is evicted
+	c.unlock(accs[2])
+	assert.Equal(t, 2, c.len())
+	_, ok = c.get(accs[1].IdentityID)
+	assert.False(t, ok)
+	_, ok = c.get(accs[0].IdentityID)
+	assert.True(t, ok)
+
+	c.remove(accs[0].IdentityID)
+	_, ok = c.get(accs[0].IdentityID)
+	assert.False(t, ok)
+	assert.Equal(t, 1, c.len())
+
+	// accounts whose keys can't be loaded are not cached
+	acc = randomAccount(t)
+	acc.SigningKeyPair = KeyPair{Pub: "missing.pub.pem", Priv: "missing.key.pem"}
+	assert.Equal(t, acc, c.unlock(acc))
+	_, ok = c.get(acc.IdentityID)
+	assert.False(t, ok)
+}
+
+func TestService_GetAccount_Cached(t *testing.T) {
+	repo, _, err := getRandomStorage()
+	assert.NoError(t, err)
+	repo.RegisterAccount(&Account{})
+	svc := service{repo: repo, accounts: newAccountCache(2)}
+	accs := []*Account{randomAccount(t), randomAccount(t), randomAccount(t)}
+	for _, acc := range accs {
+		assert.NoError(t, repo.CreateAccount(acc.IdentityID, acc))
+	}
+
+	// unlocked on first use
+	acc, err := svc.GetAccount(accs[0].IdentityID)
+	assert.NoError(t, err)
+	assert.Equal(t, accs[0].IdentityID, acc.(*Account).IdentityID)
+	_, ok := svc.accounts.get(accs[0].IdentityID)
+	assert.True(t, ok)
+
+	// updates are read again
+	accs[0].Tier = config.AccountTierPremium
+	_, err = svc.UpdateAccount(accs[0])
+	assert.NoError(t, err)
+	acc, err = svc.GetAccount(accs[0].IdentityID)
+	assert.NoError(t, err)
+	assert.Equal(t, config.AccountTierPremium, acc.GetTier())
+
+	assert.NoError(t, svc.DeleteAccount(accs[0].IdentityID))
+	_, err = svc.GetAccount(accs[0].IdentityID)
+	assert.Error(t, err)
+
+	// warm up fills the cache
+	svc.accounts = newAccountCache(1)
+	svc.warmUp(2)
+	assert.Equal(t, 1, svc.accounts.len())
+	svc.accounts = newAccountCache(5)
+	svc.warmUp(2)
+	assert.Equal(t, 2, svc.accounts.len())
+}
@@ -33,7 +33,9 @@ func (*Bootstrapper) Bootstrap(context map[string]interface{}) error {
 	repo := &repo{configdb}
 	service := &service{repo, idFactory, idService, func() ProtocolSetter {
 		return context[bootstrap.BootstrappedPeer].(ProtocolSetter)
-	}}
+	}, func() AddressSetter {
+		return context[bootstrap.BootstrappedPeer].(AddressSetter)
+	}, newAccountCache(cfg.GetAccountsCacheSize())}
 
 	nc := NewNodeConfig(cfg)
 	configdb.Register(nc)
@@ -61,6 +63,8 @@ func (*Bootstrapper) Bootstrap(context map[string]interface{}) error {
 			return errors.NewTypedError(config.ErrConfigBootstrap, errors.New("%v", err))
 		}
 	}
+	// accounts are unlocked in the background, the ones not unlocked yet are unlocked on their first use
+	go service.warmUp(cfg.GetAccountsWarmUpConcurrency())
 	context[config.BootstrappedConfigStorage] = service
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/errors"
 
 	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/identifiers"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/account"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/config"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -58,12 +59,22 @@ func (h grpcHandler) GetConfig(ctx context.Context, _ *empty.Empty) (*configpb.C
 	return nodeConfig.CreateProtobuf(), nil
 }
 
+// UpdateP2PAddresses changes the p2p listen and external addresses of the node.
+func (h grpcHandler) UpdateP2PAddresses(ctx context.Context, req *configpb.UpdateP2PAddressesRequest) (*configpb.ConfigData, error) {
+	nodeConfig, err := h.service.UpdateP2PAddresses(req.ListenAddresses, req.ExternalAddresses)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, err
+	}
+	return nodeConfig.CreateProtobuf(), nil
+}
+
 func (h grpcHandler) GetAccount(ctx context.Context, req *accountpb.GetAccountRequest) (*accountpb.AccountData, error) {
-	id, err := hexutil.Decode(req.Identifier)
+	id, err := identifiers.DecodeDID(req.Identifier)
 	if err != nil {
 		return nil, err
 	}
-	accountConfig, err := h.service.GetAccount(id)
+	accountConfig, err := h.service.GetAccount(id[:])
 	if err != nil {
 		return nil, err
 	}
@@ -51,3 +51,9 @@ func (m MockService) DeleteAccount(identifier []byte) error {
 	args := m.Called(identifier)
 	return args.Error(0)
 }
+
+func (m MockService) UpdateP2PAddresses(listen, external []string) (config.Configuration, error) {
+	args := m.Called(listen, external)
+	c, _ := args.Get(0).(config.Configuration)
+	return c, args.Error(1)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"math/big"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
@@ -18,6 +19,8 @@ import (
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/account"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/config"
+	"github.com/centrifuge/go-centrifuge/ratelimit"
+	"github.com/centrifuge/go-centrifuge/retry"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/protobuf/ptypes/duration"
@@ -40,26 +43,79 @@ func NewKeyPair(pub, priv string) KeyPair {
 type NodeConfig struct {
 	MainIdentity                   Account
 	StoragePath                    string
+	ResidencyStoragePaths          map[string]string
 	AccountsKeystore               string
+	AccountsCacheSize              int
+	AccountsWarmUpConcurrency      int
 	P2PPort                        int
 	P2PExternalIP                  string
+	P2PListenAddresses             []string
+	P2PExternalAddresses           []string
 	P2PConnectionTimeout           time.Duration
+	P2PReceiveWorkers              int
+	P2PReceiveQueueSize            int
+	P2PPinnedPeers                 map[string]string
+	P2PEndpointRefreshInterval     time.Duration
+	P2PSessionTTL                  time.Duration
+	P2PMailboxID                   string
+	P2PMailboxDrainInterval        time.Duration
+	P2PMailboxServeEnabled         bool
+	P2PCircuitBreakerThreshold     int
+	P2PCircuitBreakerCooldown      time.Duration
+	P2PConnectionLowWater          int
+	P2PConnectionHighWater         int
+	P2PConnectionIdleTimeout       time.Duration
+	P2PRelayEnabled                bool
+	P2PRelayHop                    bool
+	P2PRelayNodes                  []string
+	P2PRateLimits                  map[string]ratelimit.Limit
+	NotaryID                       string
 	ServerPort                     int
 	ServerAddress                  string
 	NumWorkers                     int
-	TaskRetries                    int
 	WorkerWaitTimeMS               int
+	QueueLanes                     map[string]int
 	EthereumNodeURL                string
 	EthereumContextReadWaitTimeout time.Duration
 	EthereumContextWaitTimeout     time.Duration
-	EthereumIntervalRetry          time.Duration
-	EthereumMaxRetries             int
+	RetryPolicies                  map[string]retry.Policy
 	EthereumGasPrice               *big.Int
 	EthereumGasLimit               uint64
+	EthereumOracleAddress          string
 	TxPoolAccessEnabled            bool
+	AnchorGracePeriod              time.Duration
+	AnchorLinkFormat               string
+	AnchorTimeout                  time.Duration
+	AnchorTimestampTolerance       time.Duration
+	IdentityCacheSyncInterval      time.Duration
+	StrictCodeValidation           bool
+	ShareDocumentHistory           bool
+	DocumentEncryptionEnabled      bool
+	PublicVerificationEnabled      bool
+	UBLAttributeMapping            map[string]string
+	ImportMappings                 map[string]map[string]string
+	JSONSchemas                    map[string]string
+	DocumentStorageQuota           int
+	RetentionKeepVersions          int
+	RetentionMaxAge                time.Duration
+	RetentionArchivePath           string
+	AttachmentsIPFSNodeURL         string
+	AttachmentsPinTimeout          time.Duration
+	MaintenanceConcurrency         int
+	RecoveryFromBlock              uint64
+	RecoveryCollaborators          []string
+	ConnectorPollInterval          time.Duration
+	RESTConnectors                 map[string]map[string]string
+	FaultInjectionEnabled          bool
+	FaultLatency                   time.Duration
+	FaultLatencyRate               float64
+	FaultP2PDropRate               float64
+	FaultEthSubmitFailureRate      float64
+	SandboxEnabled                 bool
 	NetworkString                  string
 	BootstrapPeers                 []string
 	NetworkID                      uint32
+	TreeHashAlgorithm              string
 	SmartContractAddresses         map[config.ContractName]common.Address
 	SmartContractBytecode          map[config.ContractName]string
 	PprofEnabled                   bool
@@ -115,6 +171,11 @@ func (nc *NodeConfig) GetStoragePath() string {
 	return nc.StoragePath
 }
 
+// GetResidencyStoragePaths refer the interface
+func (nc *NodeConfig) GetResidencyStoragePaths() map[string]string {
+	return nc.ResidencyStoragePaths
+}
+
 // GetConfigStoragePath refer the interface
 func (nc *NodeConfig) GetConfigStoragePath() string {
 	panic("irrelevant, NodeConfig#GetConfigStoragePath must not be used")
@@ -125,6 +186,16 @@ func (nc *NodeConfig) GetAccountsKeystore() string {
 	return nc.AccountsKeystore
 }
 
+// GetAccountsCacheSize refer the interface
+func (nc *NodeConfig) GetAccountsCacheSize() int {
+	return nc.AccountsCacheSize
+}
+
+// GetAccountsWarmUpConcurrency refer the interface
+func (nc *NodeConfig) GetAccountsWarmUpConcurrency() int {
+	return nc.AccountsWarmUpConcurrency
+}
+
 // GetP2PPort refer the interface
 func (nc *NodeConfig) GetP2PPort() int {
 	return nc.P2PPort
@@ -135,11 +206,111 @@ func (nc *NodeConfig) GetP2PExternalIP() string {
 	return nc.P2PExternalIP
 }
 
+// GetP2PListenAddresses refer the interface
+func (nc *NodeConfig) GetP2PListenAddresses() []string {
+	return nc.P2PListenAddresses
+}
+
+// GetP2PExternalAddresses refer the interface
+func (nc *NodeConfig) GetP2PExternalAddresses() []string {
+	return nc.P2PExternalAddresses
+}
+
 // GetP2PConnectionTimeout refer the interface
 func (nc *NodeConfig) GetP2PConnectionTimeout() time.Duration {
 	return nc.P2PConnectionTimeout
 }
 
+// GetP2PReceiveWorkers refer the interface
+func (nc *NodeConfig) GetP2PReceiveWorkers() int {
+	return nc.P2PReceiveWorkers
+}
+
+// GetP2PReceiveQueueSize refer the interface
+func (nc *NodeConfig) GetP2PReceiveQueueSize() int {
+	return nc.P2PReceiveQueueSize
+}
+
+// GetP2PPinnedPeers refer the interface
+func (nc *NodeConfig) GetP2PPinnedPeers() map[string]string {
+	return nc.P2PPinnedPeers
+}
+
+// GetP2PEndpointRefreshInterval refer the interface
+func (nc *NodeConfig) GetP2PEndpointRefreshInterval() time.Duration {
+	return nc.P2PEndpointRefreshInterval
+}
+
+// GetP2PSessionTTL refer the interface
+func (nc *NodeConfig) GetP2PSessionTTL() time.Duration {
+	return nc.P2PSessionTTL
+}
+
+// GetP2PMailboxID refer the interface
+func (nc *NodeConfig) GetP2PMailboxID() string {
+	return nc.P2PMailboxID
+}
+
+// GetP2PMailboxDrainInterval refer the interface
+func (nc *NodeConfig) GetP2PMailboxDrainInterval() time.Duration {
+	return nc.P2PMailboxDrainInterval
+}
+
+// GetP2PMailboxServeEnabled refer the interface
+func (nc *NodeConfig) GetP2PMailboxServeEnabled() bool {
+	return nc.P2PMailboxServeEnabled
+}
+
+// GetP2PCircuitBreakerThreshold refer the interface
+func (nc *NodeConfig) GetP2PCircuitBreakerThreshold() int {
+	return nc.P2PCircuitBreakerThreshold
+}
+
+// GetP2PCircuitBreakerCooldown refer the interface
+func (nc *NodeConfig) GetP2PCircuitBreakerCooldown() time.Duration {
+	return nc.P2PCircuitBreakerCooldown
+}
+
+// GetP2PConnectionLowWater refer the interface
+func (nc *NodeConfig) GetP2PConnectionLowWater() int {
+	return nc.P2PConnectionLowWater
+}
+
+// GetP2PConnectionHighWater refer the interface
+func (nc *NodeConfig) GetP2PConnectionHighWater() int {
+	return nc.P2PConnectionHighWater
+}
+
+// GetP2PConnectionIdleTimeout refer the interface
+func (nc *NodeConfig) GetP2PConnectionIdleTimeout() time.Duration {
+	return nc.P2PConnectionIdleTimeout
+}
+
+// GetP2PRelayEnabled refer the interface
+func (nc *NodeConfig) GetP2PRelayEnabled() bool {
+	return nc.P2PRelayEnabled
+}
+
+// GetP2PRelayHop refer the interface
+func (nc *NodeConfig) GetP2PRelayHop() bool {
+	return nc.P2PRelayHop
+}
+
+// GetP2PRelayNodes refer the interface
+func (nc *NodeConfig) GetP2PRelayNodes() []string {
+	return nc.P2PRelayNodes
+}
+
+// GetP2PRateLimits refer the interface
+func (nc *NodeConfig) GetP2PRateLimits() map[string]ratelimit.Limit {
+	return nc.P2PRateLimits
+}
+
+// GetNotaryID refer the interface
+func (nc *NodeConfig) GetNotaryID() string {
+	return nc.NotaryID
+}
+
 // GetServerPort refer the interface
 func (nc *NodeConfig) GetServerPort() int {
 	return nc.ServerPort
@@ -155,16 +326,16 @@ func (nc *NodeConfig) GetNumWorkers() int {
 	return nc.NumWorkers
 }
 
-// GetTaskRetries returns the number of retries allowed for a queued task
-func (nc *NodeConfig) GetTaskRetries() int {
-	return nc.TaskRetries
-}
-
 // GetWorkerWaitTimeMS refer the interface
 func (nc *NodeConfig) GetWorkerWaitTimeMS() int {
 	return nc.WorkerWaitTimeMS
 }
 
+// GetQueueLanes refer the interface
+func (nc *NodeConfig) GetQueueLanes() map[string]int {
+	return nc.QueueLanes
+}
+
 // GetEthereumNodeURL refer the interface
 func (nc *NodeConfig) GetEthereumNodeURL() string {
 	return nc.EthereumNodeURL
@@ -182,12 +353,22 @@ func (nc *NodeConfig) GetEthereumContextWaitTimeout() time.Duration {
 
 // GetEthereumIntervalRetry refer the interface
 func (nc *NodeConfig) GetEthereumIntervalRetry() time.Duration {
-	return nc.EthereumIntervalRetry
+	return nc.GetRetryPolicy(retry.ClassEthereum).Backoff
 }
 
 // GetEthereumMaxRetries refer the interface
 func (nc *NodeConfig) GetEthereumMaxRetries() int {
-	return nc.EthereumMaxRetries
+	return nc.GetRetryPolicy(retry.ClassEthereum).Attempts
+}
+
+// GetRetryPolicy refer the interface
+// Node configs stored before the retry policies were added have none, the default policy of the class is used for them.
+func (nc *NodeConfig) GetRetryPolicy(class string) retry.Policy {
+	if policy, ok := nc.RetryPolicies[class]; ok {
+		return policy
+	}
+
+	return defaultRetryPolicy(class)
 }
 
 // GetEthereumGasPrice refer the interface
@@ -200,11 +381,161 @@ func (nc *NodeConfig) GetEthereumGasLimit() uint64 {
 	return nc.EthereumGasLimit
 }
 
+// GetEthereumOracleAddress refer the interface
+func (nc *NodeConfig) GetEthereumOracleAddress() string {
+	return nc.EthereumOracleAddress
+}
+
 // GetTxPoolAccessEnabled refer the interface
 func (nc *NodeConfig) GetTxPoolAccessEnabled() bool {
 	return nc.TxPoolAccessEnabled
 }
 
+// GetAnchorGracePeriod refer the interface
+func (nc *NodeConfig) GetAnchorGracePeriod() time.Duration {
+	return nc.AnchorGracePeriod
+}
+
+// GetAnchorLinkFormat refer the interface
+func (nc *NodeConfig) GetAnchorLinkFormat() string {
+	return nc.AnchorLinkFormat
+}
+
+// GetAnchorTimeout refer the interface
+func (nc *NodeConfig) GetAnchorTimeout() time.Duration {
+	return nc.AnchorTimeout
+}
+
+// GetAnchorTimestampTolerance refer the interface
+func (nc *NodeConfig) GetAnchorTimestampTolerance() time.Duration {
+	return nc.AnchorTimestampTolerance
+}
+
+// GetIdentityCacheSyncInterval refer the interface
+func (nc *NodeConfig) GetIdentityCacheSyncInterval() time.Duration {
+	return nc.IdentityCacheSyncInterval
+}
+
+// GetStrictCodeValidation refer the interface
+func (nc *NodeConfig) GetStrictCodeValidation() bool {
+	return nc.StrictCodeValidation
+}
+
+// GetShareDocumentHistory refer the interface
+func (nc *NodeConfig) GetShareDocumentHistory() bool {
+	return nc.ShareDocumentHistory
+}
+
+// GetDocumentEncryptionEnabled refer the interface
+func (nc *NodeConfig) GetDocumentEncryptionEnabled() bool {
+	return nc.DocumentEncryptionEnabled
+}
+
+// GetPublicVerificationEnabled refer the interface
+func (nc *NodeConfig) GetPublicVerificationEnabled() bool {
+	return nc.PublicVerificationEnabled
+}
+
+// GetUBLAttributeMapping refer the interface
+func (nc *NodeConfig) GetUBLAttributeMapping() map[string]string {
+	return nc.UBLAttributeMapping
+}
+
+// GetImportMappings refer the interface
+func (nc *NodeConfig) GetImportMappings() map[string]map[string]string {
+	return nc.ImportMappings
+}
+
+// GetJSONSchemas refer the interface
+func (nc *NodeConfig) GetJSONSchemas() map[string]string {
+	return nc.JSONSchemas
+}
+
+// GetDocumentStorageQuota refer the interface
+func (nc *NodeConfig) GetDocumentStorageQuota() int {
+	return nc.DocumentStorageQuota
+}
+
+// GetRetentionKeepVersions refer the interface
+func (nc *NodeConfig) GetRetentionKeepVersions() int {
+	return nc.RetentionKeepVersions
+}
+
+// GetRetentionMaxAge refer the interface
+func (nc *NodeConfig) GetRetentionMaxAge() time.Duration {
+	return nc.RetentionMaxAge
+}
+
+// GetRetentionArchivePath refer the interface
+func (nc *NodeConfig) GetRetentionArchivePath() string {
+	return nc.RetentionArchivePath
+}
+
+// GetAttachmentsIPFSNodeURL refer the interface
+func (nc *NodeConfig) GetAttachmentsIPFSNodeURL() string {
+	return nc.AttachmentsIPFSNodeURL
+}
+
+// GetAttachmentsPinTimeout refer the interface
+func (nc *NodeConfig) GetAttachmentsPinTimeout() time.Duration {
+	return nc.AttachmentsPinTimeout
+}
+
+// GetMaintenanceConcurrency refer the interface
+func (nc *NodeConfig) GetMaintenanceConcurrency() int {
+	return nc.MaintenanceConcurrency
+}
+
+// GetRecoveryFromBlock refer the interface
+func (nc *NodeConfig) GetRecoveryFromBlock() uint64 {
+	return nc.RecoveryFromBlock
+}
+
+// GetRecoveryCollaborators refer the interface
+func (nc *NodeConfig) GetRecoveryCollaborators() []string {
+	return nc.RecoveryCollaborators
+}
+
+// GetConnectorPollInterval refer the interface
+func (nc *NodeConfig) GetConnectorPollInterval() time.Duration {
+	return nc.ConnectorPollInterval
+}
+
+// GetRESTConnectors refer the interface
+func (nc *NodeConfig) GetRESTConnectors() map[string]map[string]string {
+	return nc.RESTConnectors
+}
+
+// GetFaultInjectionEnabled refer the interface
+func (nc *NodeConfig) GetFaultInjectionEnabled() bool {
+	return nc.FaultInjectionEnabled
+}
+
+// GetFaultLatency refer the interface
+func (nc *NodeConfig) GetFaultLatency() time.Duration {
+	return nc.FaultLatency
+}
+
+// GetFaultLatencyRate refer the interface
+func (nc *NodeConfig) GetFaultLatencyRate() float64 {
+	return nc.FaultLatencyRate
+}
+
+// GetFaultP2PDropRate refer the interface
+func (nc *NodeConfig) GetFaultP2PDropRate() float64 {
+	return nc.FaultP2PDropRate
+}
+
+// GetFaultEthSubmitFailureRate refer the interface
+func (nc *NodeConfig) GetFaultEthSubmitFailureRate() float64 {
+	return nc.FaultEthSubmitFailureRate
+}
+
+// GetSandboxEnabled refer the interface
+func (nc *NodeConfig) GetSandboxEnabled() bool {
+	return nc.SandboxEnabled
+}
+
 // GetNetworkString refer the interface
 func (nc *NodeConfig) GetNetworkString() string {
 	return nc.NetworkString
@@ -235,6 +566,11 @@ func (nc *NodeConfig) GetNetworkID() uint32 {
 	return nc.NetworkID
 }
 
+// GetTreeHashAlgorithm refer the interface
+func (nc *NodeConfig) GetTreeHashAlgorithm() string {
+	return nc.TreeHashAlgorithm
+}
+
 // GetEthereumAccount refer the interface
 func (nc *NodeConfig) GetEthereumAccount(accountName string) (account *config.AccountConfig, err error) {
 	return nc.MainIdentity.EthereumAccount, nil
@@ -315,6 +651,8 @@ func (nc *NodeConfig) CreateProtobuf() *configpb.ConfigData {
 		StoragePath:               nc.StoragePath,
 		P2PPort:                   int32(nc.P2PPort),
 		P2PExternalIp:             nc.P2PExternalIP,
+		P2PListenAddresses:        nc.P2PListenAddresses,
+		P2PExternalAddresses:      nc.P2PExternalAddresses,
 		P2PConnectionTimeout:      &duration.Duration{Seconds: int64(nc.P2PConnectionTimeout.Seconds())},
 		ServerPort:                int32(nc.ServerPort),
 		ServerAddress:             nc.ServerAddress,
@@ -322,7 +660,8 @@ func (nc *NodeConfig) CreateProtobuf() *configpb.ConfigData {
 		WorkerWaitTimeMs:          int32(nc.WorkerWaitTimeMS),
 		EthContextReadWaitTimeout: &duration.Duration{Seconds: int64(nc.EthereumContextReadWaitTimeout.Seconds())},
 		EthContextWaitTimeout:     &duration.Duration{Seconds: int64(nc.EthereumContextWaitTimeout.Seconds())},
-		EthIntervalRetry:          &duration.Duration{Seconds: int64(nc.EthereumIntervalRetry.Seconds())},
+		EthIntervalRetry:          &duration.Duration{Seconds: int64(nc.GetEthereumIntervalRetry().Seconds())},
+		EthMaxRetries:             uint32(nc.GetEthereumMaxRetries()),
 		EthGasPrice:               nc.EthereumGasPrice.Uint64(),
 		EthGasLimit:               nc.EthereumGasLimit,
 		TxPoolEnabled:             nc.TxPoolAccessEnabled,
@@ -370,6 +709,8 @@ func (nc *NodeConfig) loadFromProtobuf(data *configpb.ConfigData) error {
 	nc.StoragePath = data.StoragePath
 	nc.P2PPort = int(data.P2PPort)
 	nc.P2PExternalIP = data.P2PExternalIp
+	nc.P2PListenAddresses = data.P2PListenAddresses
+	nc.P2PExternalAddresses = data.P2PExternalAddresses
 	nc.P2PConnectionTimeout = time.Duration(data.P2PConnectionTimeout.Seconds)
 	nc.ServerPort = int(data.ServerPort)
 	nc.ServerAddress = data.ServerAddress
@@ -378,8 +719,13 @@ func (nc *NodeConfig) loadFromProtobuf(data *configpb.ConfigData) error {
 	nc.EthereumNodeURL = data.EthNodeUrl
 	nc.EthereumContextReadWaitTimeout = time.Duration(data.EthContextReadWaitTimeout.Seconds)
 	nc.EthereumContextWaitTimeout = time.Duration(data.EthContextWaitTimeout.Seconds)
-	nc.EthereumIntervalRetry = time.Duration(data.EthIntervalRetry.Seconds)
-	nc.EthereumMaxRetries = int(data.EthMaxRetries)
+	if nc.RetryPolicies == nil {
+		nc.RetryPolicies = make(map[string]retry.Policy)
+	}
+	ethRetries := nc.RetryPolicies[retry.ClassEthereum]
+	ethRetries.Backoff = time.Duration(data.EthIntervalRetry.Seconds)
+	ethRetries.Attempts = int(data.EthMaxRetries)
+	nc.RetryPolicies[retry.ClassEthereum] = ethRetries
 	nc.EthereumGasPrice = big.NewInt(int64(data.EthGasPrice))
 	nc.EthereumGasLimit = data.EthGasLimit
 	nc.TxPoolAccessEnabled = data.TxPoolEnabled
@@ -419,6 +765,30 @@ func convertStringMapToSmartContractBytecode(bcode map[string]string) (map[confi
 	return m, nil
 }
 
+// retryPolicies returns the retry policies of the operation classes.
+func retryPolicies(c config.Configuration) map[string]retry.Policy {
+	policies := make(map[string]retry.Policy)
+	for _, class := range retry.Classes {
+		policies[class] = c.GetRetryPolicy(class)
+	}
+
+	return policies
+}
+
+var (
+	defaultPoliciesOnce sync.Once
+	defaultPolicies     map[string]retry.Policy
+)
+
+// defaultRetryPolicy returns the retry policy of the class from the default config.
+func defaultRetryPolicy(class string) retry.Policy {
+	defaultPoliciesOnce.Do(func() {
+		defaultPolicies = retryPolicies(config.LoadConfiguration(""))
+	})
+
+	return defaultPolicies[class]
+}
+
 // NewNodeConfig creates a new NodeConfig instance with configs
 func NewNodeConfig(c config.Configuration) config.Configuration {
 	mainAccount, _ := c.GetEthereumAccount(c.GetEthereumDefaultAccountName())
@@ -446,25 +816,79 @@ func NewNodeConfig(c config.Configuration) config.Configuration {
 			},
 		},
 		StoragePath:                    c.GetStoragePath(),
+		ResidencyStoragePaths:          c.GetResidencyStoragePaths(),
 		AccountsKeystore:               c.GetAccountsKeystore(),
+		AccountsCacheSize:              c.GetAccountsCacheSize(),
+		AccountsWarmUpConcurrency:      c.GetAccountsWarmUpConcurrency(),
 		P2PPort:                        c.GetP2PPort(),
 		P2PExternalIP:                  c.GetP2PExternalIP(),
+		P2PListenAddresses:             c.GetP2PListenAddresses(),
+		P2PExternalAddresses:           c.GetP2PExternalAddresses(),
 		P2PConnectionTimeout:           c.GetP2PConnectionTimeout(),
+		P2PReceiveWorkers:              c.GetP2PReceiveWorkers(),
+		P2PReceiveQueueSize:            c.GetP2PReceiveQueueSize(),
+		P2PPinnedPeers:                 c.GetP2PPinnedPeers(),
+		P2PEndpointRefreshInterval:     c.GetP2PEndpointRefreshInterval(),
+		P2PSessionTTL:                  c.GetP2PSessionTTL(),
+		P2PMailboxID:                   c.GetP2PMailboxID(),
+		P2PMailboxDrainInterval:        c.GetP2PMailboxDrainInterval(),
+		P2PMailboxServeEnabled:         c.GetP2PMailboxServeEnabled(),
+		P2PCircuitBreakerThreshold:     c.GetP2PCircuitBreakerThreshold(),
+		P2PCircuitBreakerCooldown:      c.GetP2PCircuitBreakerCooldown(),
+		P2PConnectionLowWater:          c.GetP2PConnectionLowWater(),
+		P2PConnectionHighWater:         c.GetP2PConnectionHighWater(),
+		P2PConnectionIdleTimeout:       c.GetP2PConnectionIdleTimeout(),
+		P2PRelayEnabled:                c.GetP2PRelayEnabled(),
+		P2PRelayHop:                    c.GetP2PRelayHop(),
+		P2PRelayNodes:                  c.GetP2PRelayNodes(),
+		P2PRateLimits:                  c.GetP2PRateLimits(),
+		NotaryID:                       c.GetNotaryID(),
 		ServerPort:                     c.GetServerPort(),
 		ServerAddress:                  c.GetServerAddress(),
 		NumWorkers:                     c.GetNumWorkers(),
 		WorkerWaitTimeMS:               c.GetWorkerWaitTimeMS(),
+		QueueLanes:                     c.GetQueueLanes(),
 		EthereumNodeURL:                c.GetEthereumNodeURL(),
 		EthereumContextReadWaitTimeout: c.GetEthereumContextReadWaitTimeout(),
 		EthereumContextWaitTimeout:     c.GetEthereumContextWaitTimeout(),
-		EthereumIntervalRetry:          c.GetEthereumIntervalRetry(),
-		EthereumMaxRetries:             c.GetEthereumMaxRetries(),
+		RetryPolicies:                  retryPolicies(c),
 		EthereumGasPrice:               c.GetEthereumGasPrice(),
 		EthereumGasLimit:               c.GetEthereumGasLimit(),
+		EthereumOracleAddress:          c.GetEthereumOracleAddress(),
 		TxPoolAccessEnabled:            c.GetTxPoolAccessEnabled(),
+		AnchorGracePeriod:              c.GetAnchorGracePeriod(),
+		AnchorLinkFormat:               c.GetAnchorLinkFormat(),
+		AnchorTimeout:                  c.GetAnchorTimeout(),
+		AnchorTimestampTolerance:       c.GetAnchorTimestampTolerance(),
+		IdentityCacheSyncInterval:      c.GetIdentityCacheSyncInterval(),
+		StrictCodeValidation:           c.GetStrictCodeValidation(),
+		ShareDocumentHistory:           c.GetShareDocumentHistory(),
+		DocumentEncryptionEnabled:      c.GetDocumentEncryptionEnabled(),
+		PublicVerificationEnabled:      c.GetPublicVerificationEnabled(),
+		UBLAttributeMapping:            c.GetUBLAttributeMapping(),
+		ImportMappings:                 c.GetImportMappings(),
+		JSONSchemas:                    c.GetJSONSchemas(),
+		DocumentStorageQuota:           c.GetDocumentStorageQuota(),
+		RetentionKeepVersions:          c.GetRetentionKeepVersions(),
+		RetentionMaxAge:                c.GetRetentionMaxAge(),
+		RetentionArchivePath:           c.GetRetentionArchivePath(),
+		AttachmentsIPFSNodeURL:         c.GetAttachmentsIPFSNodeURL(),
+		AttachmentsPinTimeout:          c.GetAttachmentsPinTimeout(),
+		MaintenanceConcurrency:         c.GetMaintenanceConcurrency(),
+		RecoveryFromBlock:              c.GetRecoveryFromBlock(),
+		RecoveryCollaborators:          c.GetRecoveryCollaborators(),
+		ConnectorPollInterval:          c.GetConnectorPollInterval(),
+		RESTConnectors:                 c.GetRESTConnectors(),
+		FaultInjectionEnabled:          c.GetFaultInjectionEnabled(),
+		FaultLatency:                   c.GetFaultLatency(),
+		FaultLatencyRate:               c.GetFaultLatencyRate(),
+		FaultP2PDropRate:               c.GetFaultP2PDropRate(),
+		FaultEthSubmitFailureRate:      c.GetFaultEthSubmitFailureRate(),
+		SandboxEnabled:                 c.GetSandboxEnabled(),
 		NetworkString:                  c.GetNetworkString(),
 		BootstrapPeers:                 c.GetBootstrapPeers(),
 		NetworkID:                      c.GetNetworkID(),
+		TreeHashAlgorithm:              c.GetTreeHashAlgorithm(),
 		SmartContractAddresses:         extractSmartContractAddresses(c),
 		PprofEnabled:                   c.IsPProfEnabled(),
 	}
@@ -490,6 +914,12 @@ type Account struct {
 	P2PKeyPair                       KeyPair
 	keys                             map[string]config.IDKey
 	PrecommitEnabled                 bool
+	Tier                             string
+	Residency                        string
+
+	// ApproverKeyPairs are additional signing keys of the identity, such as the keys of the finance and the legal
+	// approvers. Every version is signed with the signing key and each approver key.
+	ApproverKeyPairs []KeyPair
 }
 
 // GetPrecommitEnabled gets the enable pre commit value
@@ -497,6 +927,20 @@ func (acc *Account) GetPrecommitEnabled() bool {
 	return acc.PrecommitEnabled
 }
 
+// GetTier gets the tier of the account, accounts without a tier are standard accounts
+func (acc *Account) GetTier() string {
+	if acc.Tier == "" {
+		return config.AccountTierStandard
+	}
+
+	return acc.Tier
+}
+
+// GetResidency gets the residency tag of the account, documents of accounts without a tag are stored in the default storage
+func (acc *Account) GetResidency() string {
+	return acc.Residency
+}
+
 // GetEthereumAccount gets EthereumAccount
 func (acc *Account) GetEthereumAccount() *config.AccountConfig {
 	return acc.EthereumAccount
@@ -557,6 +1001,43 @@ func (acc *Account) SignMsg(msg []byte) (*coredocumentpb.Signature, error) {
 	}, nil
 }
 
+// SignMsgWithApprovers signs a message with the signing key and each of the approver keys.
+// The signature of the signing key comes first.
+func (acc *Account) SignMsgWithApprovers(msg []byte) ([]*coredocumentpb.Signature, error) {
+	sig, err := acc.SignMsg(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	did, err := acc.GetIdentityID()
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := []*coredocumentpb.Signature{sig}
+	for _, kp := range acc.ApproverKeyPairs {
+		pk, sk, err := secp256k1.GetSigningKeyPair(kp.Pub, kp.Priv)
+		if err != nil {
+			return nil, errors.New("failed to load approver key %s: %v", kp.Pub, err)
+		}
+
+		signature, err := crypto.SignMessage(sk, msg, crypto.CurveSecp256K1)
+		if err != nil {
+			return nil, err
+		}
+
+		address32Bytes := utils.AddressTo32Bytes(common.HexToAddress(secp256k1.GetAddress(pk)))
+		sigs = append(sigs, &coredocumentpb.Signature{
+			SignatureId: append(append([]byte(nil), did...), address32Bytes[:]...),
+			SignerId:    did,
+			PublicKey:   address32Bytes[:],
+			Signature:   signature,
+		})
+	}
+
+	return sigs, nil
+}
+
 func (acc *Account) getEthereumAccountAddress() ([]byte, error) {
 	var ethAddr struct {
 		Address string `json:"address"`
@@ -650,6 +1131,10 @@ func (acc *Account) CreateProtobuf() (*accountpb.AccountData, error) {
 	if acc.EthereumAccount == nil {
 		return nil, errors.New("nil EthereumAccount field")
 	}
+	var approverKeyPairs []*accountpb.KeyPair
+	for _, kp := range acc.ApproverKeyPairs {
+		approverKeyPairs = append(approverKeyPairs, &accountpb.KeyPair{Pub: kp.Pub, Pvt: kp.Priv})
+	}
 	return &accountpb.AccountData{
 		EthAccount: &accountpb.EthereumAccount{
 			Address:  acc.EthereumAccount.Address,
@@ -667,6 +1152,9 @@ func (acc *Account) CreateProtobuf() (*accountpb.AccountData, error) {
 			Pub: acc.SigningKeyPair.Pub,
 			Pvt: acc.SigningKeyPair.Priv,
 		},
+		Tier:             acc.GetTier(),
+		Residency:        acc.Residency,
+		ApproverKeyPairs: approverKeyPairs,
 	}, nil
 }
 
@@ -699,6 +1187,12 @@ func (acc *Account) loadFromProtobuf(data *accountpb.AccountData) error {
 		Pub:  data.SigningKeyPair.Pub,
 		Priv: data.SigningKeyPair.Pvt,
 	}
+	acc.Tier = data.Tier
+	acc.Residency = data.Residency
+	acc.ApproverKeyPairs = nil
+	for _, kp := range data.ApproverKeyPairs {
+		acc.ApproverKeyPairs = append(acc.ApproverKeyPairs, KeyPair{Pub: kp.Pub, Priv: kp.Pvt})
+	}
 
 	return nil
 }
@@ -725,6 +1219,7 @@ func NewAccount(ethAccountName string, c config.Configuration) (config.Account,
 		P2PKeyPair:                       NewKeyPair(c.GetP2PKeyPair()),
 		SigningKeyPair:                   NewKeyPair(c.GetSigningKeyPair()),
 		PrecommitEnabled:                 c.GetPrecommitEnabled(),
+		Tier:                             config.AccountTierStandard,
 	}, nil
 }
 
@@ -745,5 +1240,6 @@ func TempAccount(ethAccountName string, c config.Configuration) (config.Account,
 		P2PKeyPair:                       NewKeyPair(c.GetP2PKeyPair()),
 		SigningKeyPair:                   NewKeyPair(c.GetSigningKeyPair()),
 		PrecommitEnabled:                 c.GetPrecommitEnabled(),
+		Tier:                             config.AccountTierStandard,
 	}, nil
 }
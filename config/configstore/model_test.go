@@ -11,10 +11,14 @@ import (
 	"github.com/centrifuge/go-centrifuge/identity"
 
 	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/crypto/secp256k1"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/account"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/config"
+	"github.com/centrifuge/go-centrifuge/ratelimit"
+	"github.com/centrifuge/go-centrifuge/retry"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -101,6 +105,11 @@ func (m *mockConfig) GetStoragePath() string {
 	return args.Get(0).(string)
 }
 
+func (m *mockConfig) GetResidencyStoragePaths() map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]string)
+}
+
 func (m *mockConfig) GetConfigStoragePath() string {
 	args := m.Called()
 	return args.Get(0).(string)
@@ -121,11 +130,111 @@ func (m *mockConfig) GetP2PExternalIP() string {
 	return args.Get(0).(string)
 }
 
+func (m *mockConfig) GetP2PListenAddresses() []string {
+	args := m.Called()
+	return args.Get(0).([]string)
+}
+
+func (m *mockConfig) GetP2PExternalAddresses() []string {
+	args := m.Called()
+	return args.Get(0).([]string)
+}
+
 func (m *mockConfig) GetP2PConnectionTimeout() time.Duration {
 	args := m.Called()
 	return args.Get(0).(time.Duration)
 }
 
+func (m *mockConfig) GetP2PReceiveWorkers() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *mockConfig) GetP2PReceiveQueueSize() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *mockConfig) GetP2PPinnedPeers() map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]string)
+}
+
+func (m *mockConfig) GetP2PEndpointRefreshInterval() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetP2PSessionTTL() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetP2PMailboxID() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
+func (m *mockConfig) GetP2PMailboxDrainInterval() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetP2PMailboxServeEnabled() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *mockConfig) GetP2PCircuitBreakerThreshold() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *mockConfig) GetP2PCircuitBreakerCooldown() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetP2PConnectionLowWater() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *mockConfig) GetP2PConnectionHighWater() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *mockConfig) GetP2PConnectionIdleTimeout() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetP2PRelayEnabled() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *mockConfig) GetP2PRelayHop() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *mockConfig) GetP2PRelayNodes() []string {
+	args := m.Called()
+	return args.Get(0).([]string)
+}
+
+func (m *mockConfig) GetP2PRateLimits() map[string]ratelimit.Limit {
+	args := m.Called()
+	return args.Get(0).(map[string]ratelimit.Limit)
+}
+
+func (m *mockConfig) GetNotaryID() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
 func (m *mockConfig) GetReceiveEventNotificationEndpoint() string {
 	args := m.Called()
 	return args.Get(0).(string)
@@ -146,15 +255,14 @@ func (m *mockConfig) GetNumWorkers() int {
 	return args.Get(0).(int)
 }
 
-// GetTaskRetries returns the number of retries allowed for a queued task
-func (m *mockConfig) GetTaskRetries() int {
+func (m *mockConfig) GetWorkerWaitTimeMS() int {
 	args := m.Called()
 	return args.Get(0).(int)
 }
 
-func (m *mockConfig) GetWorkerWaitTimeMS() int {
+func (m *mockConfig) GetQueueLanes() map[string]int {
 	args := m.Called()
-	return args.Get(0).(int)
+	return args.Get(0).(map[string]int)
 }
 
 func (m *mockConfig) GetEthereumNodeURL() string {
@@ -182,6 +290,11 @@ func (m *mockConfig) GetEthereumMaxRetries() int {
 	return args.Get(0).(int)
 }
 
+func (m *mockConfig) GetRetryPolicy(class string) retry.Policy {
+	args := m.Called(class)
+	return args.Get(0).(retry.Policy)
+}
+
 func (m *mockConfig) GetEthereumGasPrice() *big.Int {
 	args := m.Called()
 	return args.Get(0).(*big.Int)
@@ -192,6 +305,11 @@ func (m *mockConfig) GetEthereumGasLimit() uint64 {
 	return args.Get(0).(uint64)
 }
 
+func (m *mockConfig) GetEthereumOracleAddress() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
 func (m *mockConfig) GetEthereumDefaultAccountName() string {
 	args := m.Called()
 	return args.Get(0).(string)
@@ -207,6 +325,161 @@ func (m *mockConfig) GetTxPoolAccessEnabled() bool {
 	return args.Get(0).(bool)
 }
 
+func (m *mockConfig) GetAnchorGracePeriod() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetAnchorLinkFormat() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
+func (m *mockConfig) GetAnchorTimeout() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetAnchorTimestampTolerance() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetIdentityCacheSyncInterval() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetStrictCodeValidation() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *mockConfig) GetShareDocumentHistory() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *mockConfig) GetDocumentEncryptionEnabled() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *mockConfig) GetPublicVerificationEnabled() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *mockConfig) GetUBLAttributeMapping() map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]string)
+}
+
+func (m *mockConfig) GetImportMappings() map[string]map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]map[string]string)
+}
+
+func (m *mockConfig) GetJSONSchemas() map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]string)
+}
+
+func (m *mockConfig) GetDocumentStorageQuota() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *mockConfig) GetRetentionKeepVersions() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *mockConfig) GetRetentionMaxAge() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetRetentionArchivePath() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
+func (m *mockConfig) GetAttachmentsIPFSNodeURL() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
+func (m *mockConfig) GetAttachmentsPinTimeout() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetMaintenanceConcurrency() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *mockConfig) GetRecoveryFromBlock() uint64 {
+	args := m.Called()
+	return args.Get(0).(uint64)
+}
+
+func (m *mockConfig) GetRecoveryCollaborators() []string {
+	args := m.Called()
+	return args.Get(0).([]string)
+}
+
+func (m *mockConfig) GetAccountsCacheSize() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *mockConfig) GetAccountsWarmUpConcurrency() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *mockConfig) GetConnectorPollInterval() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetRESTConnectors() map[string]map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]map[string]string)
+}
+
+func (m *mockConfig) GetFaultInjectionEnabled() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *mockConfig) GetFaultLatency() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *mockConfig) GetFaultLatencyRate() float64 {
+	args := m.Called()
+	return args.Get(0).(float64)
+}
+
+func (m *mockConfig) GetFaultP2PDropRate() float64 {
+	args := m.Called()
+	return args.Get(0).(float64)
+}
+
+func (m *mockConfig) GetFaultEthSubmitFailureRate() float64 {
+	args := m.Called()
+	return args.Get(0).(float64)
+}
+
+func (m *mockConfig) GetSandboxEnabled() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
 func (m *mockConfig) GetNetworkString() string {
 	args := m.Called()
 	return args.Get(0).(string)
@@ -237,6 +510,11 @@ func (m *mockConfig) GetNetworkID() uint32 {
 	return args.Get(0).(uint32)
 }
 
+func (m *mockConfig) GetTreeHashAlgorithm() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
 func (m *mockConfig) GetIdentityID() ([]byte, error) {
 	args := m.Called()
 	return args.Get(0).([]byte), args.Error(1)
@@ -291,9 +569,22 @@ func TestNodeConfigProtobuf(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, ncpb.StoragePath, ncCopy.StoragePath)
 	assert.Equal(t, int(ncpb.ServerPort), ncCopy.ServerPort)
+	assert.Equal(t, nc.GetEthereumMaxRetries(), ncCopy.GetRetryPolicy(retry.ClassEthereum).Attempts)
 	assert.Equal(t, ncpb.MainIdentity.IdentityId, common.HexToAddress(ncpb.MainIdentity.IdentityId).Hex())
 }
 
+func TestNodeConfig_GetRetryPolicy(t *testing.T) {
+	// stored before the retry policies
+	nc := new(NodeConfig)
+	assert.Equal(t, retry.Policy{Attempts: 3, Backoff: 500 * time.Millisecond, MaxBackoff: 5 * time.Second, Jitter: 0.2, Budget: 600}, nc.GetRetryPolicy(retry.ClassP2P))
+	assert.Equal(t, 200, nc.GetEthereumMaxRetries())
+	assert.Equal(t, 10, nc.GetRetryPolicy(retry.ClassQueue).Attempts)
+
+	nc.RetryPolicies = map[string]retry.Policy{retry.ClassQueue: {Attempts: 1}}
+	assert.Equal(t, retry.Policy{Attempts: 1}, nc.GetRetryPolicy(retry.ClassQueue))
+	assert.Equal(t, 3, nc.GetRetryPolicy(retry.ClassP2P).Attempts)
+}
+
 func TestAccountProtobuf_validationFailures(t *testing.T) {
 	c := &mockConfig{}
 	c.On("GetEthereumAccount", "name").Return(&config.AccountConfig{}, nil)
@@ -377,19 +668,108 @@ func TestAccountConfigProtobuf(t *testing.T) {
 	assert.Equal(t, accpb.ReceiveEventNotificationEndpoint, tcCopy.ReceiveEventNotificationEndpoint)
 	assert.Equal(t, common.HexToAddress(accpb.IdentityId).Hex(), common.BytesToAddress(tcCopy.IdentityID).Hex())
 	assert.Equal(t, accpb.SigningKeyPair.Pvt, tcCopy.SigningKeyPair.Priv)
+	assert.Equal(t, config.AccountTierStandard, accpb.Tier)
+	assert.Equal(t, config.AccountTierStandard, tcCopy.GetTier())
+
+	// accounts without a tier are standard accounts
+	accpb.Tier = ""
+	err = tcCopy.loadFromProtobuf(accpb)
+	assert.NoError(t, err)
+	assert.Equal(t, config.AccountTierStandard, tcCopy.GetTier())
+
+	accpb.Tier = config.AccountTierPremium
+	err = tcCopy.loadFromProtobuf(accpb)
+	assert.NoError(t, err)
+	assert.Equal(t, config.AccountTierPremium, tcCopy.GetTier())
+
+	// residency tag
+	assert.Empty(t, tcCopy.GetResidency())
+	accpb.Residency = "eu"
+	err = tcCopy.loadFromProtobuf(accpb)
+	assert.NoError(t, err)
+	assert.Equal(t, "eu", tcCopy.GetResidency())
+	accpb, err = tcCopy.CreateProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, "eu", accpb.Residency)
+
+	// approver keys
+	assert.Empty(t, tcCopy.ApproverKeyPairs)
+	accpb.ApproverKeyPairs = []*accountpb.KeyPair{{Pub: "finance.pub", Pvt: "finance.key"}, {Pub: "legal.pub", Pvt: "legal.key"}}
+	err = tcCopy.loadFromProtobuf(accpb)
+	assert.NoError(t, err)
+	assert.Equal(t, []KeyPair{NewKeyPair("finance.pub", "finance.key"), NewKeyPair("legal.pub", "legal.key")}, tcCopy.ApproverKeyPairs)
+	accpb, err = tcCopy.CreateProtobuf()
+	assert.NoError(t, err)
+	assert.Len(t, accpb.ApproverKeyPairs, 2)
+	assert.Equal(t, "legal.key", accpb.ApproverKeyPairs[1].Pvt)
+}
+
+func TestAccount_SignMsgWithApprovers(t *testing.T) {
+	signingKeyPair := NewKeyPair("../../build/resources/signingKey.pub.pem", "../../build/resources/signingKey.key.pem")
+	acc := &Account{
+		EthereumAccount: &config.AccountConfig{Key: `{"address":"f8a2bb7d3b9f3d3ff1b4a1c0e7ccd4d1dd9d7a0b"}`},
+		IdentityID:      utils.RandomSlice(identity.DIDLength),
+		SigningKeyPair:  signingKeyPair,
+		P2PKeyPair:      NewKeyPair("../../build/resources/p2pKey.pub.pem", "../../build/resources/p2pKey.key.pem"),
+	}
+
+	// no approver keys
+	msg := utils.RandomSlice(32)
+	sigs, err := acc.SignMsgWithApprovers(msg)
+	assert.NoError(t, err)
+	assert.Len(t, sigs, 1)
+
+	// invalid approver key
+	acc.ApproverKeyPairs = []KeyPair{NewKeyPair("finance.pub", "finance.key")}
+	_, err = acc.SignMsgWithApprovers(msg)
+	assert.Error(t, err)
+
+	acc.ApproverKeyPairs = []KeyPair{signingKeyPair}
+	sigs, err = acc.SignMsgWithApprovers(msg)
+	assert.NoError(t, err)
+	assert.Len(t, sigs, 2)
+	for _, sig := range sigs {
+		assert.Equal(t, acc.IdentityID, sig.SignerId)
+		assert.Equal(t, append(acc.IdentityID, sig.PublicKey...), sig.SignatureId)
+		assert.True(t, secp256k1.VerifySignatureWithAddress(common.BytesToAddress(sig.PublicKey).String(), hexutil.Encode(sig.Signature), msg))
+	}
 }
 
 func createMockConfig() *mockConfig {
 	c := &mockConfig{}
 	c.On("GetStoragePath").Return("dummyStorage").Once()
+	c.On("GetResidencyStoragePaths").Return(map[string]string{"eu": "dummyEUStorage"}).Once()
 	c.On("GetAccountsKeystore").Return("dummyKeyStorage").Once()
+	c.On("GetAccountsCacheSize").Return(256).Once()
+	c.On("GetAccountsWarmUpConcurrency").Return(8).Once()
 	c.On("GetP2PPort").Return(30000).Once()
 	c.On("GetP2PExternalIP").Return("ip").Once()
+	c.On("GetP2PListenAddresses").Return([]string{"/ip4/0.0.0.0/tcp/30000"}).Once()
+	c.On("GetP2PExternalAddresses").Return([]string{"/ip4/1.2.3.4/tcp/30000"}).Once()
 	c.On("GetP2PConnectionTimeout").Return(time.Second).Once()
+	c.On("GetP2PReceiveWorkers").Return(4).Once()
+	c.On("GetP2PReceiveQueueSize").Return(100).Once()
+	c.On("GetP2PPinnedPeers").Return(map[string]string{}).Once()
+	c.On("GetP2PEndpointRefreshInterval").Return(time.Minute).Once()
+	c.On("GetP2PSessionTTL").Return(10 * time.Minute).Once()
+	c.On("GetP2PMailboxID").Return("").Once()
+	c.On("GetP2PMailboxDrainInterval").Return(time.Minute).Once()
+	c.On("GetP2PMailboxServeEnabled").Return(false).Once()
+	c.On("GetP2PCircuitBreakerThreshold").Return(5).Once()
+	c.On("GetP2PCircuitBreakerCooldown").Return(time.Minute).Once()
+	c.On("GetP2PConnectionLowWater").Return(20).Once()
+	c.On("GetP2PConnectionHighWater").Return(50).Once()
+	c.On("GetP2PConnectionIdleTimeout").Return(10 * time.Minute).Once()
+	c.On("GetP2PRelayEnabled").Return(true).Once()
+	c.On("GetP2PRelayHop").Return(false).Once()
+	c.On("GetP2PRelayNodes").Return([]string{}).Once()
+	c.On("GetP2PRateLimits").Return(map[string]ratelimit.Limit{"getdocument": {Rate: 10, Burst: 50}}).Once()
+	c.On("GetNotaryID").Return("").Once()
 	c.On("GetServerPort").Return(8080).Once()
 	c.On("GetServerAddress").Return("dummyServer").Once()
 	c.On("GetNumWorkers").Return(2).Once()
 	c.On("GetWorkerWaitTimeMS").Return(1).Once()
+	c.On("GetQueueLanes").Return(map[string]int{config.AccountTierPremium: 2}).Once()
 	c.On("GetEthereumNodeURL").Return("dummyNode").Once()
 	c.On("GetIdentityID").Return(utils.RandomSlice(identity.DIDLength), nil).Once()
 	c.On("GetP2PKeyPair").Return("pub", "priv").Once()
@@ -399,14 +779,46 @@ func createMockConfig() *mockConfig {
 	c.On("GetEthereumDefaultAccountName").Return("dummyAcc").Twice()
 	c.On("GetEthereumContextReadWaitTimeout").Return(time.Second).Once()
 	c.On("GetEthereumContextWaitTimeout").Return(time.Second).Once()
-	c.On("GetEthereumIntervalRetry").Return(time.Second).Once()
-	c.On("GetEthereumMaxRetries").Return(1).Once()
+	c.On("GetRetryPolicy", retry.ClassEthereum).Return(retry.Policy{Attempts: 1, Backoff: time.Second}).Once()
+	c.On("GetRetryPolicy", retry.ClassP2P).Return(retry.Policy{Attempts: 3, Backoff: 500 * time.Millisecond, MaxBackoff: 5 * time.Second}).Once()
+	c.On("GetRetryPolicy", retry.ClassQueue).Return(retry.Policy{Attempts: 10}).Once()
 	c.On("GetEthereumGasPrice").Return(big.NewInt(1)).Once()
 	c.On("GetEthereumGasLimit").Return(uint64(100)).Once()
+	c.On("GetEthereumOracleAddress").Return("").Once()
 	c.On("GetTxPoolAccessEnabled").Return(true).Once()
+	c.On("GetAnchorGracePeriod").Return(time.Duration(0)).Once()
+	c.On("GetAnchorLinkFormat").Return("").Once()
+	c.On("GetAnchorTimeout").Return(10 * time.Minute).Once()
+	c.On("GetAnchorTimestampTolerance").Return(10 * time.Minute).Once()
+	c.On("GetIdentityCacheSyncInterval").Return(15 * time.Second).Once()
+	c.On("GetStrictCodeValidation").Return(false).Once()
+	c.On("GetShareDocumentHistory").Return(false).Once()
+	c.On("GetDocumentEncryptionEnabled").Return(true).Once()
+	c.On("GetPublicVerificationEnabled").Return(false).Once()
+	c.On("GetUBLAttributeMapping").Return(map[string]string{"sender": "sender_did"}).Once()
+	c.On("GetImportMappings").Return(map[string]map[string]string{"invoice": {"invoice no": "invoice_number"}}).Once()
+	c.On("GetJSONSchemas").Return(map[string]string{"generic": "/etc/centrifuge/generic.schema.json"}).Once()
+	c.On("GetDocumentStorageQuota").Return(0).Once()
+	c.On("GetRetentionKeepVersions").Return(10).Once()
+	c.On("GetRetentionMaxAge").Return(time.Duration(0)).Once()
+	c.On("GetRetentionArchivePath").Return("").Once()
+	c.On("GetAttachmentsIPFSNodeURL").Return("").Once()
+	c.On("GetAttachmentsPinTimeout").Return(30 * time.Second).Once()
+	c.On("GetMaintenanceConcurrency").Return(4).Once()
+	c.On("GetRecoveryFromBlock").Return(uint64(0)).Once()
+	c.On("GetRecoveryCollaborators").Return([]string{"0x010101010101"}).Once()
+	c.On("GetConnectorPollInterval").Return(time.Minute).Once()
+	c.On("GetRESTConnectors").Return(map[string]map[string]string{"erp": {"account": "0x010101010101"}}).Once()
+	c.On("GetFaultInjectionEnabled").Return(false).Once()
+	c.On("GetFaultLatency").Return(time.Duration(0)).Once()
+	c.On("GetFaultLatencyRate").Return(float64(0)).Once()
+	c.On("GetFaultP2PDropRate").Return(float64(0)).Once()
+	c.On("GetFaultEthSubmitFailureRate").Return(float64(0)).Once()
+	c.On("GetSandboxEnabled").Return(false).Once()
 	c.On("GetNetworkString").Return("somehill").Once()
 	c.On("GetBootstrapPeers").Return([]string{"p1", "p2"}).Once()
 	c.On("GetNetworkID").Return(uint32(1)).Once()
+	c.On("GetTreeHashAlgorithm").Return("sha256").Once()
 	c.On("GetContractAddress", mock.Anything).Return(common.Address{})
 	c.On("IsPProfEnabled", mock.Anything).Return(true)
 	return c
@@ -24,11 +24,18 @@ type ProtocolSetter interface {
 	InitProtocolForDID(DID *identity.DID)
 }
 
+// AddressSetter sets the addresses the host listens on and advertises
+type AddressSetter interface {
+	SetAddresses(listen, external []string) error
+}
+
 type service struct {
 	repo                 repository
 	idFactory            identity.Factory
 	idService            identity.ServiceDID
 	protocolSetterFinder func() ProtocolSetter
+	addressSetterFinder  func() AddressSetter
+	accounts             *accountCache
 }
 
 // DefaultService returns an implementation of the config.Service
@@ -40,8 +47,18 @@ func (s service) GetConfig() (config.Configuration, error) {
 	return s.repo.GetConfig()
 }
 
+// GetAccount returns the account with its keys loaded. The keys of accounts that are not unlocked yet are loaded on the first use.
 func (s service) GetAccount(identifier []byte) (config.Account, error) {
-	return s.repo.GetAccount(identifier)
+	if acc, ok := s.accounts.get(identifier); ok {
+		return acc, nil
+	}
+
+	acc, err := s.repo.GetAccount(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.accounts.unlock(acc), nil
 }
 
 func (s service) GetAllAccounts() ([]config.Account, error) {
@@ -61,6 +78,7 @@ func (s service) CreateAccount(data config.Account) (config.Account, error) {
 	if err != nil {
 		return nil, err
 	}
+	s.accounts.remove(id)
 	return data, s.repo.CreateAccount(id, data)
 }
 
@@ -145,13 +163,37 @@ func (s service) UpdateAccount(data config.Account) (config.Account, error) {
 	if err != nil {
 		return nil, err
 	}
+	s.accounts.remove(id)
 	return data, s.repo.UpdateAccount(id, data)
 }
 
 func (s service) DeleteAccount(identifier []byte) error {
+	s.accounts.remove(identifier)
 	return s.repo.DeleteAccount(identifier)
 }
 
+// UpdateP2PAddresses changes the p2p listen and external addresses of the running host and stores them in the node config.
+// The addresses of the config file are restored on restart.
+func (s service) UpdateP2PAddresses(listen, external []string) (config.Configuration, error) {
+	cfg, err := s.repo.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	nc, ok := cfg.(*NodeConfig)
+	if !ok {
+		return nil, errors.New("unexpected node config type %T", cfg)
+	}
+
+	err = s.addressSetterFinder().SetAddresses(listen, external)
+	if err != nil {
+		return nil, err
+	}
+
+	nc.P2PListenAddresses, nc.P2PExternalAddresses = listen, external
+	return nc, s.repo.UpdateConfig(nc)
+}
+
 // RetrieveConfig retrieves system config giving priority to db stored config
 func RetrieveConfig(dbOnly bool, ctx map[string]interface{}) (config.Configuration, error) {
 	var cfg config.Configuration
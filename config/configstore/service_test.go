@@ -6,6 +6,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
 
 	"github.com/centrifuge/go-centrifuge/testingutils/commons"
@@ -154,6 +155,52 @@ func TestService_Deleteaccount(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+type mockAddressSetter struct {
+	listen, external []string
+	err              error
+}
+
+func (m *mockAddressSetter) SetAddresses(listen, external []string) error {
+	m.listen, m.external = listen, external
+	return m.err
+}
+
+func TestService_UpdateP2PAddresses(t *testing.T) {
+	repo, _, err := getRandomStorage()
+	assert.Nil(t, err)
+	repo.RegisterConfig(&NodeConfig{})
+	setter := &mockAddressSetter{err: errors.New("failed to listen")}
+	svc := service{repo: repo, addressSetterFinder: func() AddressSetter { return setter }}
+	listen := []string{"/ip4/0.0.0.0/tcp/38300"}
+	external := []string{"/ip4/1.2.3.4/tcp/38300"}
+
+	// no config
+	_, err = svc.UpdateP2PAddresses(listen, external)
+	assert.Error(t, err)
+	assert.Nil(t, setter.listen)
+
+	// host failed to change the addresses
+	err = repo.CreateConfig(NewNodeConfig(cfg))
+	assert.Nil(t, err)
+	_, err = svc.UpdateP2PAddresses(listen, external)
+	assert.EqualError(t, err, "failed to listen")
+	nc, err := svc.GetConfig()
+	assert.Nil(t, err)
+	assert.Empty(t, nc.GetP2PListenAddresses())
+
+	// success
+	setter.err = nil
+	nc, err = svc.UpdateP2PAddresses(listen, external)
+	assert.Nil(t, err)
+	assert.Equal(t, listen, setter.listen)
+	assert.Equal(t, external, setter.external)
+	assert.Equal(t, listen, nc.GetP2PListenAddresses())
+	nc, err = svc.GetConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, listen, nc.GetP2PListenAddresses())
+	assert.Equal(t, external, nc.GetP2PExternalAddresses())
+}
+
 func TestGenerateaccountKeys(t *testing.T) {
 	DID, err := identity.NewDIDFromString("0xDcF1695B8a0df44c60825eCD0A8A833dA3875F13")
 	assert.NoError(t, err)
@@ -21,7 +21,9 @@ import (
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/account"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/config"
+	"github.com/centrifuge/go-centrifuge/ratelimit"
 	"github.com/centrifuge/go-centrifuge/resources"
+	"github.com/centrifuge/go-centrifuge/retry"
 	"github.com/centrifuge/go-centrifuge/storage"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -55,6 +57,14 @@ const (
 	PaymentObligation ContractName = "paymentObligation"
 )
 
+const (
+	// AccountTierStandard is the default account tier, jobs of standard accounts are run on the default queue lane
+	AccountTierStandard = "standard"
+
+	// AccountTierPremium is the account tier with a dedicated queue lane, if one is configured
+	AccountTierPremium = "premium"
+)
+
 // ContractNames returns the list of smart contract names currently used in the system, please update this when adding new contracts
 func ContractNames() [5]ContractName {
 	return [5]ContractName{AnchorRepo, IdentityFactory, Identity, IdentityRegistry, PaymentObligation}
@@ -76,30 +86,85 @@ type Configuration interface {
 	GetDuration(key string) time.Duration
 
 	GetStoragePath() string
+	GetResidencyStoragePaths() map[string]string
 	GetConfigStoragePath() string
 	GetAccountsKeystore() string
+	GetAccountsCacheSize() int
+	GetAccountsWarmUpConcurrency() int
 	GetP2PPort() int
 	GetP2PExternalIP() string
+	GetP2PListenAddresses() []string
+	GetP2PExternalAddresses() []string
 	GetP2PConnectionTimeout() time.Duration
+	GetP2PReceiveWorkers() int
+	GetP2PReceiveQueueSize() int
+	GetP2PPinnedPeers() map[string]string
+	GetP2PEndpointRefreshInterval() time.Duration
+	GetP2PSessionTTL() time.Duration
+	GetP2PMailboxID() string
+	GetP2PMailboxDrainInterval() time.Duration
+	GetP2PMailboxServeEnabled() bool
+	GetP2PCircuitBreakerThreshold() int
+	GetP2PCircuitBreakerCooldown() time.Duration
+	GetP2PConnectionLowWater() int
+	GetP2PConnectionHighWater() int
+	GetP2PConnectionIdleTimeout() time.Duration
+	GetP2PRelayEnabled() bool
+	GetP2PRelayHop() bool
+	GetP2PRelayNodes() []string
+	GetP2PRateLimits() map[string]ratelimit.Limit
+	GetNotaryID() string
 	GetServerPort() int
 	GetServerAddress() string
 	GetNumWorkers() int
 	GetWorkerWaitTimeMS() int
-	GetTaskRetries() int
+	GetQueueLanes() map[string]int
 	GetEthereumNodeURL() string
 	GetEthereumContextReadWaitTimeout() time.Duration
 	GetEthereumContextWaitTimeout() time.Duration
 	GetEthereumIntervalRetry() time.Duration
 	GetEthereumMaxRetries() int
+	GetRetryPolicy(class string) retry.Policy
 	GetEthereumGasPrice() *big.Int
 	GetEthereumGasLimit() uint64
+	GetEthereumOracleAddress() string
 	GetTxPoolAccessEnabled() bool
+	GetAnchorGracePeriod() time.Duration
+	GetAnchorLinkFormat() string
+	GetAnchorTimeout() time.Duration
+	GetAnchorTimestampTolerance() time.Duration
+	GetIdentityCacheSyncInterval() time.Duration
+	GetStrictCodeValidation() bool
+	GetShareDocumentHistory() bool
+	GetDocumentEncryptionEnabled() bool
+	GetPublicVerificationEnabled() bool
+	GetUBLAttributeMapping() map[string]string
+	GetImportMappings() map[string]map[string]string
+	GetJSONSchemas() map[string]string
+	GetDocumentStorageQuota() int
+	GetRetentionKeepVersions() int
+	GetRetentionMaxAge() time.Duration
+	GetRetentionArchivePath() string
+	GetAttachmentsIPFSNodeURL() string
+	GetAttachmentsPinTimeout() time.Duration
+	GetMaintenanceConcurrency() int
+	GetRecoveryFromBlock() uint64
+	GetRecoveryCollaborators() []string
+	GetConnectorPollInterval() time.Duration
+	GetRESTConnectors() map[string]map[string]string
+	GetFaultInjectionEnabled() bool
+	GetFaultLatency() time.Duration
+	GetFaultLatencyRate() float64
+	GetFaultP2PDropRate() float64
+	GetFaultEthSubmitFailureRate() float64
+	GetSandboxEnabled() bool
 	GetNetworkString() string
 	GetNetworkKey(k string) string
 	GetContractAddressString(address string) string
 	GetContractAddress(contractName ContractName) common.Address
 	GetBootstrapPeers() []string
 	GetNetworkID() uint32
+	GetTreeHashAlgorithm() string
 
 	// CentID specific configs (eg: for multi tenancy)
 	GetEthereumAccount(accountName string) (account *AccountConfig, err error)
@@ -122,6 +187,7 @@ type Account interface {
 	storage.Model
 	GetKeys() (map[string]IDKey, error)
 	SignMsg(msg []byte) (*coredocumentpb.Signature, error)
+	SignMsgWithApprovers(msg []byte) ([]*coredocumentpb.Signature, error)
 	GetEthereumAccount() *AccountConfig
 	GetEthereumDefaultAccountName() string
 	GetReceiveEventNotificationEndpoint() string
@@ -130,6 +196,8 @@ type Account interface {
 	GetSigningKeyPair() (pub, priv string)
 	GetEthereumContextWaitTimeout() time.Duration
 	GetPrecommitEnabled() bool
+	GetTier() string
+	GetResidency() string
 
 	// CreateProtobuf creates protobuf
 	CreateProtobuf() (*accountpb.AccountData, error)
@@ -145,6 +213,7 @@ type Service interface {
 	GenerateAccount() (Account, error)
 	UpdateAccount(data Account) (Account, error)
 	DeleteAccount(identifier []byte) error
+	UpdateP2PAddresses(listen, external []string) (Configuration, error)
 }
 
 // IDKey represents a key pair
@@ -240,6 +309,11 @@ func (c *configuration) GetStoragePath() string {
 	return c.GetString("storage.path")
 }
 
+// GetResidencyStoragePaths returns the data storage backends of the document payloads per residency tag.
+func (c *configuration) GetResidencyStoragePaths() map[string]string {
+	return cast.ToStringMapString(c.get("storage.residency"))
+}
+
 // GetConfigStoragePath returns the config storage backend.
 func (c *configuration) GetConfigStoragePath() string {
 	return c.GetString("configStorage.path")
@@ -250,6 +324,16 @@ func (c *configuration) GetAccountsKeystore() string {
 	return c.GetString("accounts.keystore")
 }
 
+// GetAccountsCacheSize returns the number of accounts kept unlocked with their keys loaded.
+func (c *configuration) GetAccountsCacheSize() int {
+	return c.GetInt("accounts.unlockedCacheSize")
+}
+
+// GetAccountsWarmUpConcurrency returns the number of accounts unlocked in parallel on start.
+func (c *configuration) GetAccountsWarmUpConcurrency() int {
+	return c.GetInt("accounts.warmUpConcurrency")
+}
+
 // GetP2PPort returns P2P Port.
 func (c *configuration) GetP2PPort() int {
 	return c.GetInt("p2p.port")
@@ -260,16 +344,124 @@ func (c *configuration) GetP2PExternalIP() string {
 	return c.GetString("p2p.externalIP")
 }
 
+// GetP2PListenAddresses returns the multiaddresses the p2p host listens on.
+func (c *configuration) GetP2PListenAddresses() []string {
+	return cast.ToStringSlice(c.get("p2p.listenAddresses"))
+}
+
+// GetP2PExternalAddresses returns the multiaddresses advertised to the peers instead of the listen addresses.
+func (c *configuration) GetP2PExternalAddresses() []string {
+	return cast.ToStringSlice(c.get("p2p.externalAddresses"))
+}
+
 // GetP2PConnectionTimeout returns P2P Connect Timeout.
 func (c *configuration) GetP2PConnectionTimeout() time.Duration {
 	return c.GetDuration("p2p.connectTimeout")
 }
 
+// GetP2PReceiveWorkers returns the number of workers processing the received anchored documents.
+func (c *configuration) GetP2PReceiveWorkers() int {
+	return c.GetInt("p2p.receiveWorkers")
+}
+
+// GetP2PReceiveQueueSize returns the number of received anchored documents that can wait for a worker.
+func (c *configuration) GetP2PReceiveQueueSize() int {
+	return c.GetInt("p2p.receiveQueueSize")
+}
+
+// GetP2PPinnedPeers returns the expected p2p peer IDs per DID of the collaborators.
+func (c *configuration) GetP2PPinnedPeers() map[string]string {
+	return cast.ToStringMapString(c.get("p2p.pinnedPeers"))
+}
+
+// GetP2PEndpointRefreshInterval returns the minimum time between the refreshes of the p2p endpoint of a collaborator.
+func (c *configuration) GetP2PEndpointRefreshInterval() time.Duration {
+	return c.GetDuration("p2p.endpointRefreshInterval")
+}
+
+// GetP2PSessionTTL returns how long the resolved and validated p2p endpoint of a collaborator is reused.
+func (c *configuration) GetP2PSessionTTL() time.Duration {
+	return c.GetDuration("p2p.sessionTTL")
+}
+
+// GetP2PMailboxID returns the DID of the mailbox holding the messages of the collaborators while the node is offline.
+func (c *configuration) GetP2PMailboxID() string {
+	return c.GetString("p2p.mailbox.id")
+}
+
+// GetP2PMailboxDrainInterval returns the time between the drains of the mailbox of the node.
+func (c *configuration) GetP2PMailboxDrainInterval() time.Duration {
+	return c.GetDuration("p2p.mailbox.drainInterval")
+}
+
+// GetP2PMailboxServeEnabled returns true if the node holds the messages deposited for the nodes using it as their mailbox.
+func (c *configuration) GetP2PMailboxServeEnabled() bool {
+	return c.GetBool("p2p.mailbox.serve")
+}
+
+// GetP2PCircuitBreakerThreshold returns the number of consecutive failed sends after which a collaborator isn't contacted.
+func (c *configuration) GetP2PCircuitBreakerThreshold() int {
+	return c.GetInt("p2p.circuitBreaker.threshold")
+}
+
+// GetP2PCircuitBreakerCooldown returns the time an unreachable collaborator isn't contacted for.
+func (c *configuration) GetP2PCircuitBreakerCooldown() time.Duration {
+	return c.GetDuration("p2p.circuitBreaker.cooldown")
+}
+
+// GetP2PConnectionLowWater returns the number of connections the node trims its connections down to.
+func (c *configuration) GetP2PConnectionLowWater() int {
+	return c.GetInt("p2p.connectionPool.lowWater")
+}
+
+// GetP2PConnectionHighWater returns the number of connections above which the node trims its connections.
+func (c *configuration) GetP2PConnectionHighWater() int {
+	return c.GetInt("p2p.connectionPool.highWater")
+}
+
+// GetP2PConnectionIdleTimeout returns the time the connection to a collaborator is kept open after the last message.
+func (c *configuration) GetP2PConnectionIdleTimeout() time.Duration {
+	return c.GetDuration("p2p.connectionPool.idleTimeout")
+}
+
+// GetP2PRelayEnabled returns true if the connections through circuit relays are enabled.
+func (c *configuration) GetP2PRelayEnabled() bool {
+	return c.GetBool("p2p.relay.enabled")
+}
+
+// GetP2PRelayHop returns true if the node relays the connections of other nodes.
+func (c *configuration) GetP2PRelayHop() bool {
+	return c.GetBool("p2p.relay.hop")
+}
+
+// GetP2PRelayNodes returns the multiaddresses of the relays the node is reachable through.
+func (c *configuration) GetP2PRelayNodes() []string {
+	return cast.ToStringSlice(c.get("p2p.relay.nodes"))
+}
+
+// GetP2PRateLimits returns the per peer and per collaborator rate limits of the received messages, per message.
+func (c *configuration) GetP2PRateLimits() map[string]ratelimit.Limit {
+	limits := make(map[string]ratelimit.Limit)
+	for msg := range cast.ToStringMap(c.get("p2p.rateLimits")) {
+		limits[msg] = ratelimit.Limit{
+			Rate:  cast.ToFloat64(c.get(fmt.Sprintf("p2p.rateLimits.%s.rate", msg))),
+			Burst: c.GetInt(fmt.Sprintf("p2p.rateLimits.%s.burst", msg)),
+		}
+	}
+
+	return limits
+}
+
 // GetReceiveEventNotificationEndpoint returns the webhook endpoint defined in the config.
 func (c *configuration) GetReceiveEventNotificationEndpoint() string {
 	return c.GetString("notifications.endpoint")
 }
 
+// GetNotaryID returns the DID of the notary co-signing the proof bundles.
+func (c *configuration) GetNotaryID() string {
+	return c.GetString("notary.id")
+}
+
 // GetServerPort returns the defined server port in the config.
 func (c *configuration) GetServerPort() int {
 	return c.GetInt("nodePort")
@@ -285,16 +477,21 @@ func (c *configuration) GetNumWorkers() int {
 	return c.GetInt("queue.numWorkers")
 }
 
-// GetTaskRetries returns the number of retries allowed for a queued task
-func (c *configuration) GetTaskRetries() int {
-	return c.GetInt("queue.taskRetries")
-}
-
 // GetWorkerWaitTimeMS returns the queue worker sleep time between cycles.
 func (c *configuration) GetWorkerWaitTimeMS() int {
 	return c.GetInt("queue.workerWaitTimeMS")
 }
 
+// GetQueueLanes returns the number of queue workers of the dedicated lanes per account tier.
+func (c *configuration) GetQueueLanes() map[string]int {
+	lanes := make(map[string]int)
+	for tier, workers := range cast.ToStringMap(c.get("queue.lanes")) {
+		lanes[tier] = cast.ToInt(workers)
+	}
+
+	return lanes
+}
+
 // GetEthereumNodeURL returns the URL of the Ethereum Node.
 func (c *configuration) GetEthereumNodeURL() string {
 	return c.GetString("ethereum.nodeURL")
@@ -310,14 +507,52 @@ func (c *configuration) GetEthereumContextWaitTimeout() time.Duration {
 	return c.GetDuration("ethereum.contextWaitTimeout")
 }
 
-// GetEthereumIntervalRetry returns duration to wait between retries.
+// GetEthereumIntervalRetry returns duration to wait before the first retry of the ethereum retry policy.
 func (c *configuration) GetEthereumIntervalRetry() time.Duration {
-	return c.GetDuration("ethereum.intervalRetry")
+	return c.GetRetryPolicy(retry.ClassEthereum).Backoff
 }
 
-// GetEthereumMaxRetries returns the max acceptable retries.
+// GetEthereumMaxRetries returns the max attempts of the ethereum retry policy.
 func (c *configuration) GetEthereumMaxRetries() int {
-	return c.GetInt("ethereum.maxRetries")
+	return c.GetRetryPolicy(retry.ClassEthereum).Attempts
+}
+
+// legacyRetryKeys are the settings replaced by the retries section, keyed by class and policy setting.
+// Config files still setting them keep their values.
+var legacyRetryKeys = map[string]map[string]string{
+	retry.ClassEthereum: {
+		"attempts":   "ethereum.maxRetries",
+		"backoff":    "ethereum.intervalRetry",
+		"maxBackoff": "ethereum.intervalRetry",
+	},
+	retry.ClassP2P: {
+		"attempts":   "p2p.retry.attempts",
+		"backoff":    "p2p.retry.backoff",
+		"maxBackoff": "p2p.retry.maxBackoff",
+	},
+	retry.ClassQueue: {
+		"attempts": "queue.taskRetries",
+	},
+}
+
+// retryKey returns the key of the retry policy setting of the class, the legacy key if it is set.
+func (c *configuration) retryKey(class, setting string) string {
+	if key, ok := legacyRetryKeys[class][setting]; ok && c.IsSet(key) {
+		return key
+	}
+
+	return fmt.Sprintf("retries.%s.%s", class, setting)
+}
+
+// GetRetryPolicy returns the retry policy of the operation class.
+func (c *configuration) GetRetryPolicy(class string) retry.Policy {
+	return retry.Policy{
+		Attempts:   c.GetInt(c.retryKey(class, "attempts")),
+		Backoff:    c.GetDuration(c.retryKey(class, "backoff")),
+		MaxBackoff: c.GetDuration(c.retryKey(class, "maxBackoff")),
+		Jitter:     cast.ToFloat64(c.get(c.retryKey(class, "jitter"))),
+		Budget:     c.GetInt(c.retryKey(class, "budget")),
+	}
 }
 
 // GetEthereumGasPrice returns the gas price to use for a ethereum transaction.
@@ -330,6 +565,12 @@ func (c *configuration) GetEthereumGasLimit() uint64 {
 	return cast.ToUint64(c.get("ethereum.gasLimit"))
 }
 
+// GetEthereumOracleAddress returns the address of the oracle contract the proven document fields are pushed to by
+// default, empty if there is none.
+func (c *configuration) GetEthereumOracleAddress() string {
+	return c.GetString("ethereum.oracleAddress")
+}
+
 // GetEthereumDefaultAccountName returns the default account to use for the transaction.
 func (c *configuration) GetEthereumDefaultAccountName() string {
 	return c.GetString("ethereum.defaultAccountName")
@@ -389,6 +630,17 @@ func (c *configuration) GetNetworkID() uint32 {
 	return uint32(c.GetInt(c.GetNetworkKey("id")))
 }
 
+// GetTreeHashAlgorithm returns the name of the hash algorithm of the document trees of the network.
+// Networks without one hash their trees with sha256.
+func (c *configuration) GetTreeHashAlgorithm() string {
+	algo := c.GetString(c.GetNetworkKey("treeHashAlgorithm"))
+	if algo == "" {
+		return "sha256"
+	}
+
+	return algo
+}
+
 // GetIdentityID returns the self centID in bytes.
 func (c *configuration) GetIdentityID() ([]byte, error) {
 	id, err := hexutil.Decode(c.GetString("identityId"))
@@ -413,6 +665,164 @@ func (c *configuration) IsPProfEnabled() bool {
 	return c.GetBool("debug.pprof")
 }
 
+// GetAnchorGracePeriod returns the time to wait for the anchor of a received document to be mined.
+func (c *configuration) GetAnchorGracePeriod() time.Duration {
+	return c.GetDuration("anchoring.receiveGracePeriod")
+}
+
+// GetAnchorLinkFormat returns the format of the link to an anchor, %s is replaced with the anchor ID.
+func (c *configuration) GetAnchorLinkFormat() string {
+	return c.GetString("anchoring.linkFormat")
+}
+
+// GetAnchorTimeout returns the time to wait for a pre-commit or commit anchor transaction to finish.
+func (c *configuration) GetAnchorTimeout() time.Duration {
+	return c.GetDuration("anchoring.timeout")
+}
+
+// GetAnchorTimestampTolerance returns how far the timestamp of a received document may be ahead of the time it was anchored at.
+func (c *configuration) GetAnchorTimestampTolerance() time.Duration {
+	return c.GetDuration("anchoring.timestampTolerance")
+}
+
+// GetIdentityCacheSyncInterval returns the interval between the checks for key changes of the cached identity lookups.
+// Identity lookups are not cached if 0.
+func (c *configuration) GetIdentityCacheSyncInterval() time.Duration {
+	return c.GetDuration("identity.cacheSyncInterval")
+}
+
+// GetStrictCodeValidation returns true if currency and country codes of documents must be assigned ISO codes.
+func (c *configuration) GetStrictCodeValidation() bool {
+	return c.GetBool("documents.strictCodeValidation")
+}
+
+// GetShareDocumentHistory returns true if the prior versions of a document are shared with any collaborator of a later version.
+func (c *configuration) GetShareDocumentHistory() bool {
+	return c.GetBool("documents.shareHistory")
+}
+
+// GetDocumentEncryptionEnabled returns true if the documents are stored encrypted with the data-encryption keys of the accounts.
+func (c *configuration) GetDocumentEncryptionEnabled() bool {
+	return c.GetBool("documents.encryptAtRest")
+}
+
+// GetPublicVerificationEnabled returns true if anyone with a share token or a document root can verify the anchor of a
+// shared document version without authorization.
+func (c *configuration) GetPublicVerificationEnabled() bool {
+	return c.GetBool("documents.publicVerification")
+}
+
+// GetUBLAttributeMapping returns the UBL document types of the invoice fields without a UBL counterpart by their field names.
+func (c *configuration) GetUBLAttributeMapping() map[string]string {
+	return cast.ToStringMapString(c.get("documents.ublAttributes"))
+}
+
+// GetImportMappings returns the column to field mappings of the batch imports by document scheme.
+// Column names are lower cased.
+func (c *configuration) GetImportMappings() map[string]map[string]string {
+	mappings := make(map[string]map[string]string)
+	for scheme, mapping := range cast.ToStringMap(c.get("documents.importMappings")) {
+		mappings[scheme] = cast.ToStringMapString(mapping)
+	}
+
+	return mappings
+}
+
+// GetJSONSchemas returns the paths of the JSON Schema files the client data of the documents is validated against by document scheme.
+func (c *configuration) GetJSONSchemas() map[string]string {
+	return cast.ToStringMapString(c.get("documents.jsonSchemas"))
+}
+
+// GetDocumentStorageQuota returns the maximum bytes of document versions stored per account, 0 if not limited.
+func (c *configuration) GetDocumentStorageQuota() int {
+	return c.GetInt("documents.storageQuota")
+}
+
+// GetRetentionKeepVersions returns the number of latest versions of a document that are never pruned.
+func (c *configuration) GetRetentionKeepVersions() int {
+	return c.GetInt("documents.retention.keepVersions")
+}
+
+// GetRetentionMaxAge returns the age after which the versions of a document can be pruned.
+func (c *configuration) GetRetentionMaxAge() time.Duration {
+	return c.GetDuration("documents.retention.maxAge")
+}
+
+// GetRetentionArchivePath returns the directory the pruned versions are archived to.
+func (c *configuration) GetRetentionArchivePath() string {
+	return c.GetString("documents.retention.archivePath")
+}
+
+// GetAttachmentsIPFSNodeURL returns the URL of the API of the IPFS node the document attachments are pinned to.
+func (c *configuration) GetAttachmentsIPFSNodeURL() string {
+	return c.GetString("documents.attachments.ipfsNodeURL")
+}
+
+// GetAttachmentsPinTimeout returns the timeout of pinning an attachment to the IPFS node.
+func (c *configuration) GetAttachmentsPinTimeout() time.Duration {
+	return c.GetDuration("documents.attachments.pinTimeout")
+}
+
+// GetMaintenanceConcurrency returns the number of accounts a maintenance job runs for concurrently.
+func (c *configuration) GetMaintenanceConcurrency() int {
+	return c.GetInt("maintenance.accountConcurrency")
+}
+
+// GetRecoveryFromBlock returns the block the anchors committed by the accounts are recovered from.
+func (c *configuration) GetRecoveryFromBlock() uint64 {
+	return cast.ToUint64(c.get("recovery.fromBlock"))
+}
+
+// GetRecoveryCollaborators returns the DIDs of the collaborators the anchored documents are recovered from.
+func (c *configuration) GetRecoveryCollaborators() []string {
+	return cast.ToStringSlice(c.get("recovery.collaborators"))
+}
+
+// GetConnectorPollInterval returns the interval between the polls of the ERP connectors.
+func (c *configuration) GetConnectorPollInterval() time.Duration {
+	return c.GetDuration("connectors.pollInterval")
+}
+
+// GetRESTConnectors returns the settings of the REST connectors by connector name.
+func (c *configuration) GetRESTConnectors() map[string]map[string]string {
+	connectors := make(map[string]map[string]string)
+	for name, settings := range cast.ToStringMap(c.get("connectors.rest")) {
+		connectors[name] = cast.ToStringMapString(settings)
+	}
+
+	return connectors
+}
+
+// GetFaultInjectionEnabled returns true if faults are injected into the p2p messages and ethereum submissions.
+func (c *configuration) GetFaultInjectionEnabled() bool {
+	return c.GetBool("faults.enabled")
+}
+
+// GetFaultLatency returns the latency injected into the p2p messages and ethereum submissions.
+func (c *configuration) GetFaultLatency() time.Duration {
+	return c.GetDuration("faults.latency")
+}
+
+// GetFaultLatencyRate returns the rate at which the latency is injected.
+func (c *configuration) GetFaultLatencyRate() float64 {
+	return cast.ToFloat64(c.get("faults.latencyRate"))
+}
+
+// GetFaultP2PDropRate returns the rate at which outgoing p2p messages are dropped.
+func (c *configuration) GetFaultP2PDropRate() float64 {
+	return cast.ToFloat64(c.get("faults.p2pDropRate"))
+}
+
+// GetFaultEthSubmitFailureRate returns the rate at which ethereum submissions fail.
+func (c *configuration) GetFaultEthSubmitFailureRate() float64 {
+	return cast.ToFloat64(c.get("faults.ethSubmitFailureRate"))
+}
+
+// GetSandboxEnabled returns true if anchors and identities are simulated by an in-process chain.
+func (c *configuration) GetSandboxEnabled() bool {
+	return c.GetBool("sandbox.enabled")
+}
+
 // GetPrecommitEnabled returns true if precommit for anchors is enabled
 func (c *configuration) GetPrecommitEnabled() bool {
 	return c.GetBool("anchoring.precommit")
@@ -489,6 +899,7 @@ func CreateConfigFile(args map[string]interface{}) (*viper.Viper, error) {
 	p2pConnectTimeout := args["p2pConnectTimeout"].(string)
 	txPoolAccess := args["txpoolaccess"].(bool)
 	preCommitEnabled := args["preCommitEnabled"].(bool)
+	sandbox := args["sandbox"].(bool)
 
 	if targetDataDir == "" {
 		return nil, errors.New("targetDataDir not provided")
@@ -519,6 +930,7 @@ func CreateConfigFile(args map[string]interface{}) (*viper.Viper, error) {
 	v.Set("configStorage.path", targetDataDir+"/db/centrifuge_config_data.leveldb")
 	v.Set("accounts.keystore", targetDataDir+"/accounts")
 	v.Set("anchoring.precommit", preCommitEnabled)
+	v.Set("sandbox.enabled", sandbox)
 	v.Set("identityId", "")
 	v.Set("centrifugeNetwork", network)
 	v.Set("nodeHostname", "0.0.0.0")
@@ -7,7 +7,9 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/centrifuge/go-centrifuge/retry"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,6 +35,7 @@ func TestConfiguration_CreateConfigFile(t *testing.T) {
 		"txpoolaccess":      false,
 		"p2pConnectTimeout": "",
 		"preCommitEnabled":  false,
+		"sandbox":           true,
 	}
 
 	v, err := CreateConfigFile(data)
@@ -42,5 +45,21 @@ func TestConfiguration_CreateConfigFile(t *testing.T) {
 	assert.Nil(t, err, "must be nil, config file should be created")
 	c := LoadConfiguration(v.ConfigFileUsed())
 	assert.False(t, c.IsPProfEnabled(), "pprof is disabled by default")
+	assert.Equal(t, map[string]int{AccountTierPremium: 2}, c.GetQueueLanes(), "premium lane by default")
+	assert.Equal(t, retry.Policy{Attempts: 3, Backoff: 500 * time.Millisecond, MaxBackoff: 5 * time.Second, Jitter: 0.2, Budget: 600}, c.GetRetryPolicy(retry.ClassP2P), "p2p retry policy")
+	assert.Equal(t, 200, c.GetEthereumMaxRetries(), "ethereum attempts from the ethereum retry policy")
+	assert.True(t, c.GetSandboxEnabled(), "sandbox mode enabled")
 	os.Remove(targetDir)
 }
+
+func TestConfiguration_GetRetryPolicy_legacyKeys(t *testing.T) {
+	c := LoadConfiguration("")
+	assert.Equal(t, 10, c.GetRetryPolicy(retry.ClassQueue).Attempts)
+
+	c.Set("queue.taskRetries", 4)
+	c.Set("p2p.retry.backoff", "1s")
+	c.Set("ethereum.intervalRetry", "3s")
+	assert.Equal(t, 4, c.GetRetryPolicy(retry.ClassQueue).Attempts)
+	assert.Equal(t, retry.Policy{Attempts: 3, Backoff: time.Second, MaxBackoff: 5 * time.Second, Jitter: 0.2, Budget: 600}, c.GetRetryPolicy(retry.ClassP2P))
+	assert.Equal(t, retry.Policy{Attempts: 200, Backoff: 3 * time.Second, MaxBackoff: 3 * time.Second, Jitter: 0.1}, c.GetRetryPolicy(retry.ClassEthereum))
+}
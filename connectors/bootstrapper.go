@@ -0,0 +1,55 @@
+package connectors
+
+import (
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/transactions"
+)
+
+// Bootstrapper implements bootstrap.Bootstrapper.
+type Bootstrapper struct{}
+
+// Bootstrap initialises the connectors service with the configured connectors and the poller if polling is enabled.
+func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
+	cfg, ok := ctx[bootstrap.BootstrappedConfig].(Config)
+	if !ok {
+		return errors.New("connectors config not initialised")
+	}
+
+	cfgService, ok := ctx[config.BootstrappedConfigStorage].(config.Service)
+	if !ok {
+		return errors.New("config storage not initialised")
+	}
+
+	registry, ok := ctx[documents.BootstrappedRegistry].(*documents.ServiceRegistry)
+	if !ok {
+		return errors.New("document registry not initialised")
+	}
+
+	txMan, ok := ctx[transactions.BootstrappedService].(transactions.Manager)
+	if !ok {
+		return errors.New("transaction service not initialised")
+	}
+
+	cs, err := restConnectors(cfg.GetRESTConnectors())
+	if err != nil {
+		return err
+	}
+
+	srv := DefaultService(registry, txMan)
+	for _, c := range cs {
+		err = srv.Register(c)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx[BootstrappedService] = srv
+	if cfg.GetConnectorPollInterval() > 0 {
+		ctx[BootstrappedPoller] = pollServer{srv: srv, accounts: cfgService, interval: cfg.GetConnectorPollInterval()}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/centrifuge/go-centrifuge/identity"
+)
+
+// State is the state of an ingested document reported back to the ERP system.
+type State string
+
+const (
+	// StateFailed is the state of a document that could not be created or anchored.
+	StateFailed State = "failed"
+
+	// StatePending is the state of a created document that is being anchored.
+	StatePending State = "pending"
+
+	// StateAnchored is the state of a created document that is anchored.
+	StateAnchored State = "anchored"
+)
+
+// Document is a document pushed by an ERP system.
+type Document struct {
+	// ExternalID identifies the document in the ERP system, such as the number of an SAP billing document.
+	ExternalID string `json:"external_id"`
+
+	// Scheme is the scheme of the document type, such as invoice.
+	Scheme        string   `json:"scheme"`
+	Collaborators []string `json:"collaborators"`
+
+	// Data is the client data of the document in the json format of the scheme.
+	Data json.RawMessage `json:"data"`
+}
+
+// Status is the status update of an ingested document written back to the ERP system.
+type Status struct {
+	ExternalID    string `json:"external_id"`
+	DocumentID    string `json:"document_id,omitempty"`
+	TransactionID string `json:"transaction_id,omitempty"`
+	State         State  `json:"state"`
+
+	// Error is the reason the document failed, empty otherwise.
+	Error string `json:"error,omitempty"`
+}
+
+// Connector integrates an ERP system with the node.
+// Connectors ingest documents by implementing Poller, WebhookReceiver or both.
+type Connector interface {
+	// Name returns the unique name of the connector.
+	Name() string
+
+	// Account returns the account the documents of the ERP system are created for.
+	Account() identity.DID
+
+	// WriteStatus writes the status update of an ingested document back to the ERP system.
+	WriteStatus(ctx context.Context, status Status) error
+}
+
+// Poller is a Connector pulling the documents from its ERP system.
+type Poller interface {
+	Connector
+
+	// Poll returns the documents the ERP system released since the last poll.
+	Poll(ctx context.Context) ([]Document, error)
+}
+
+// WebhookReceiver is a Connector its ERP system pushes the documents to through a webhook.
+type WebhookReceiver interface {
+	Connector
+
+	// ParseWebhook returns the documents of the webhook payload.
+	ParseWebhook(payload []byte) ([]Document, error)
+}
@@ -0,0 +1,99 @@
+package connectors
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/centrifuge/go-centrifuge/centerrors"
+	"github.com/centrifuge/go-centrifuge/code"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/connectors"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+type grpcHandler struct {
+	srv Service
+}
+
+// GRPCHandler returns an implementation of connectorspb.ConnectorServiceServer
+func GRPCHandler(srv Service) connectorspb.ConnectorServiceServer {
+	return grpcHandler{srv: srv}
+}
+
+// ListConnectors returns the registered connectors and the ways they ingest the documents.
+func (h grpcHandler) ListConnectors(ctx context.Context, _ *empty.Empty) (*connectorspb.ListConnectorsResponse, error) {
+	resp := new(connectorspb.ListConnectorsResponse)
+	for _, c := range h.srv.Connectors() {
+		_, poll := c.(Poller)
+		_, webhook := c.(WebhookReceiver)
+		resp.Connectors = append(resp.Connectors, &connectorspb.ConnectorInfo{
+			Name:      c.Name(),
+			AccountId: c.Account().String(),
+			Poll:      poll,
+			Webhook:   webhook,
+		})
+	}
+
+	return resp, nil
+}
+
+// ReceiveWebhook creates and anchors the documents of the webhook payload of the connector.
+func (h grpcHandler) ReceiveWebhook(ctx context.Context, req *connectorspb.WebhookRequest) (*connectorspb.IngestResponse, error) {
+	if req.Payload == nil {
+		return nil, errors.NewHTTPError(http.StatusBadRequest, errors.New("payload is required"))
+	}
+
+	payload, err := new(jsonpb.Marshaler).MarshalToString(req.Payload)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	statuses, err := h.srv.ReceiveWebhook(ctx, req.Connector, []byte(payload))
+	if err != nil {
+		log.Error(err)
+		return nil, toHTTPError(err)
+	}
+
+	return toIngestResponse(statuses), nil
+}
+
+// Poll polls the connector and creates and anchors the released documents.
+func (h grpcHandler) Poll(ctx context.Context, req *connectorspb.PollRequest) (*connectorspb.IngestResponse, error) {
+	statuses, err := h.srv.Poll(ctx, req.Connector)
+	if err != nil {
+		log.Error(err)
+		return nil, toHTTPError(err)
+	}
+
+	return toIngestResponse(statuses), nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.IsOfType(ErrUnknownConnector, err):
+		return errors.NewHTTPError(http.StatusNotFound, err)
+	case errors.IsOfType(ErrConnectorAccount, err):
+		return errors.NewHTTPError(http.StatusForbidden, err)
+	case errors.IsOfType(ErrIngestionUnsupported, err), errors.IsOfType(ErrInvalidPayload, err):
+		return errors.NewHTTPError(http.StatusBadRequest, err)
+	default:
+		return centerrors.New(code.Unknown, err.Error())
+	}
+}
+
+func toIngestResponse(statuses []Status) *connectorspb.IngestResponse {
+	resp := new(connectorspb.IngestResponse)
+	for _, s := range statuses {
+		resp.Documents = append(resp.Documents, &connectorspb.DocumentStatus{
+			ExternalId:    s.ExternalID,
+			DocumentId:    s.DocumentID,
+			TransactionId: s.TransactionID,
+			State:         string(s.State),
+			Error:         s.Error,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,60 @@
+// +build unit
+
+package connectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/connectors"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/struct"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGRPCHandler(t *testing.T) {
+	srv := testService(t)
+	did := testingidentity.GenerateRandomDID()
+	c := &mockConnector{name: "erp", account: did}
+	assert.NoError(t, srv.Register(c))
+	assert.NoError(t, srv.Register(statusConnector{name: "status", account: did}))
+	h := GRPCHandler(srv)
+	ctx := accountContext(t, did)
+
+	resp, err := h.ListConnectors(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*connectorspb.ConnectorInfo{
+		{Name: "erp", AccountId: did.String(), Poll: true, Webhook: true},
+		{Name: "status", AccountId: did.String()},
+	}, resp.Connectors)
+
+	payload := new(structpb.Struct)
+	assert.NoError(t, jsonpb.UnmarshalString(`{"documents": [{"external_id": "1", "scheme": "test", "data": {"number": "doc"}}]}`, payload))
+
+	// missing payload
+	_, err = h.ReceiveWebhook(ctx, &connectorspb.WebhookRequest{Connector: "erp"})
+	assert.Error(t, err)
+
+	// unknown connector
+	_, err = h.ReceiveWebhook(ctx, &connectorspb.WebhookRequest{Connector: "unknown", Payload: payload})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), ErrUnknownConnector.Error())
+
+	docs, err := h.ReceiveWebhook(ctx, &connectorspb.WebhookRequest{Connector: "erp", Payload: payload})
+	assert.NoError(t, err)
+	assert.Len(t, docs.Documents, 1)
+	assert.Equal(t, "1", docs.Documents[0].ExternalId)
+	assert.Equal(t, string(StatePending), docs.Documents[0].State)
+	assert.NotEmpty(t, docs.Documents[0].DocumentId)
+	assert.NotEmpty(t, docs.Documents[0].TransactionId)
+
+	// not polled
+	_, err = h.Poll(ctx, &connectorspb.PollRequest{Connector: "status"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), ErrIngestionUnsupported.Error())
+
+	docs, err = h.Poll(ctx, &connectorspb.PollRequest{Connector: "erp"})
+	assert.NoError(t, err)
+	assert.Empty(t, docs.Documents)
+}
@@ -0,0 +1,70 @@
+package connectors
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+)
+
+// pollServer polls the connectors pulling the documents from their ERP systems every interval.
+type pollServer struct {
+	srv      Service
+	accounts config.Service
+	interval time.Duration
+}
+
+// Name returns the name of the connector poll service.
+func (p pollServer) Name() string {
+	return "ConnectorPoller"
+}
+
+// Start polls the connectors every interval until the context is done.
+func (p pollServer) Start(ctx context.Context, wg *sync.WaitGroup, startupErr chan<- error) {
+	defer wg.Done()
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Shutting down connector poller with context done")
+			return
+		case <-ticker.C:
+			p.pollAll(ctx)
+		}
+	}
+}
+
+// pollAll polls the connectors one after the other. A failing connector doesn't stop the polls of the others.
+func (p pollServer) pollAll(ctx context.Context) {
+	for _, c := range p.srv.Connectors() {
+		if _, ok := c.(Poller); !ok {
+			continue
+		}
+
+		did := c.Account()
+		acc, err := p.accounts.GetAccount(did[:])
+		if err != nil {
+			log.Warningf("connector %s: failed to get account %s: %v", c.Name(), did.String(), err)
+			continue
+		}
+
+		actx, err := contextutil.New(ctx, acc)
+		if err != nil {
+			log.Warningf("connector %s: %v", c.Name(), err)
+			continue
+		}
+
+		statuses, err := p.srv.Poll(actx, c.Name())
+		if err != nil {
+			log.Warningf("connector %s: %v", c.Name(), err)
+			continue
+		}
+
+		if len(statuses) > 0 {
+			log.Infof("connector %s: ingested %d documents", c.Name(), len(statuses))
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+)
+
+// restPayload is the body of the polled documents and the webhook payloads of the REST connector.
+type restPayload struct {
+	Documents []Document `json:"documents"`
+}
+
+// restConnector is the reference connector of ERP systems pushing the documents to its webhook as json.
+// Status updates are posted as json to the status URL, they are dropped if no status URL is set.
+type restConnector struct {
+	name      string
+	account   identity.DID
+	statusURL string
+}
+
+// restPoller is a restConnector that also polls the documents from the poll URL of the ERP system.
+type restPoller struct {
+	restConnector
+	pollURL string
+}
+
+// NewRESTConnector returns the reference connector integrating an ERP system over REST.
+// The connector is polled only if pollURL is set.
+func NewRESTConnector(name string, account identity.DID, pollURL, statusURL string) Connector {
+	c := restConnector{name: name, account: account, statusURL: statusURL}
+	if pollURL == "" {
+		return c
+	}
+
+	return restPoller{restConnector: c, pollURL: pollURL}
+}
+
+// Name returns the name of the connector.
+func (c restConnector) Name() string {
+	return c.name
+}
+
+// Account returns the account the documents are created for.
+func (c restConnector) Account() identity.DID {
+	return c.account
+}
+
+// ParseWebhook decodes the documents of the payload.
+func (c restConnector) ParseWebhook(payload []byte) ([]Document, error) {
+	return decodeRESTPayload(payload)
+}
+
+// WriteStatus posts the status update to the status URL.
+func (c restConnector) WriteStatus(ctx context.Context, status Status) error {
+	if c.statusURL == "" {
+		return nil
+	}
+
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	statusCode, err := utils.SendPOSTRequest(c.statusURL, "application/json", payload)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusOK {
+		return errors.New("failed to post status: status = %v", statusCode)
+	}
+
+	return nil
+}
+
+// Poll fetches the documents released since the last poll from the poll URL.
+func (c restPoller) Poll(ctx context.Context) ([]Document, error) {
+	statusCode, body, err := utils.SendGETRequest(c.pollURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		return nil, errors.New("failed to fetch documents: status = %v", statusCode)
+	}
+
+	return decodeRESTPayload(body)
+}
+
+func decodeRESTPayload(payload []byte) ([]Document, error) {
+	var p restPayload
+	err := json.Unmarshal(payload, &p)
+	if err != nil {
+		return nil, errors.New("failed to decode documents: %v", err)
+	}
+
+	return p.Documents, nil
+}
+
+// restConnectors returns the REST connectors of the settings by connector name, ordered by name.
+func restConnectors(settings map[string]map[string]string) ([]Connector, error) {
+	var names []string
+	for name := range settings {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	var cs []Connector
+	for _, name := range names {
+		account, err := identity.NewDIDFromString(setting(settings[name], "account"))
+		if err != nil {
+			return nil, errors.New("connector %s: invalid account: %v", name, err)
+		}
+
+		cs = append(cs, NewRESTConnector(name, account, setting(settings[name], "pollURL"), setting(settings[name], "statusURL")))
+	}
+
+	return cs, nil
+}
+
+// setting returns the value of the key. Keys match case-insensitively as the config lower cases them.
+func setting(settings map[string]string, key string) string {
+	for k, v := range settings {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+
+	return ""
+}
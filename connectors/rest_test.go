@@ -0,0 +1,111 @@
+// +build unit
+
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRESTConnectors(t *testing.T) {
+	did := testingidentity.GenerateRandomDID()
+	cs, err := restConnectors(map[string]map[string]string{
+		"push": {"account": did.String()},
+		"erp":  {"account": did.String(), "pollurl": "http://erp/released", "statusurl": "http://erp/status"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, cs, 2)
+	assert.Equal(t, "erp", cs[0].Name())
+	assert.Equal(t, did, cs[0].Account())
+	p, ok := cs[0].(restPoller)
+	assert.True(t, ok)
+	assert.Equal(t, "http://erp/released", p.pollURL)
+	assert.Equal(t, "http://erp/status", p.statusURL)
+	_, ok = cs[0].(WebhookReceiver)
+	assert.True(t, ok)
+
+	assert.Equal(t, "push", cs[1].Name())
+	_, ok = cs[1].(Poller)
+	assert.False(t, ok)
+	_, ok = cs[1].(WebhookReceiver)
+	assert.True(t, ok)
+
+	// invalid account
+	_, err = restConnectors(map[string]map[string]string{"erp": {"account": "0x01"}})
+	assert.Error(t, err)
+}
+
+func TestRESTConnector_ParseWebhook(t *testing.T) {
+	c := NewRESTConnector("erp", testingidentity.GenerateRandomDID(), "", "").(WebhookReceiver)
+	docs, err := c.ParseWebhook([]byte(`{"documents": [{"external_id": "1", "scheme": "invoice", "collaborators": ["0x010101010101"], "data": {"number": "1"}}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []Document{{
+		ExternalID:    "1",
+		Scheme:        "invoice",
+		Collaborators: []string{"0x010101010101"},
+		Data:          json.RawMessage(`{"number": "1"}`),
+	}}, docs)
+
+	_, err = c.ParseWebhook([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestRESTConnector_WriteStatus(t *testing.T) {
+	var received Status
+	code := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(body, &received))
+		w.WriteHeader(code)
+	}))
+	defer srv.Close()
+
+	status := Status{ExternalID: "1", DocumentID: "0x01", TransactionID: "0x02", State: StateAnchored}
+
+	// no status URL
+	c := NewRESTConnector("erp", testingidentity.GenerateRandomDID(), "", "")
+	assert.NoError(t, c.WriteStatus(context.Background(), status))
+
+	c = NewRESTConnector("erp", testingidentity.GenerateRandomDID(), "", srv.URL)
+	assert.NoError(t, c.WriteStatus(context.Background(), status))
+	assert.Equal(t, status, received)
+
+	code = http.StatusInternalServerError
+	assert.Error(t, c.WriteStatus(context.Background(), status))
+}
+
+func TestRESTPoller_Poll(t *testing.T) {
+	body := `{"documents": [{"external_id": "1", "scheme": "invoice", "data": {}}]}`
+	code := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(code)
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c := NewRESTConnector("erp", testingidentity.GenerateRandomDID(), srv.URL, "").(Poller)
+	docs, err := c.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, docs, 1)
+	assert.Equal(t, "1", docs[0].ExternalID)
+
+	// invalid payload
+	body = "invalid"
+	_, err = c.Poll(context.Background())
+	assert.Error(t, err)
+
+	// failed request
+	code = http.StatusNotFound
+	_, err = c.Poll(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,243 @@
+package connectors
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	logging "github.com/ipfs/go-log"
+)
+
+const (
+	// BootstrappedService is the key to the connectors Service in the bootstrap context.
+	BootstrappedService = "BootstrappedConnectorService"
+
+	// BootstrappedPoller is the key to the server polling the connectors in the bootstrap context.
+	BootstrappedPoller = "BootstrappedConnectorPoller"
+
+	// ErrUnknownConnector must be used when no connector is registered with the given name.
+	ErrUnknownConnector = errors.Error("unknown connector")
+
+	// ErrConnectorAccount must be used when the account of the request is not the account of the connector.
+	ErrConnectorAccount = errors.Error("account is not the account of the connector")
+
+	// ErrIngestionUnsupported must be used when the connector doesn't support the requested way of ingestion.
+	ErrIngestionUnsupported = errors.Error("ingestion is not supported by the connector")
+
+	// ErrInvalidPayload must be used when the documents pushed or polled from an ERP system can't be decoded.
+	ErrInvalidPayload = errors.Error("invalid connector payload")
+)
+
+var log = logging.Logger("connectors")
+
+// Config defines the configuration required by the connectors.
+type Config interface {
+	GetConnectorPollInterval() time.Duration
+	GetRESTConnectors() map[string]map[string]string
+}
+
+// Service ingests the documents of the registered connectors and writes their status back to the ERP systems.
+type Service interface {
+	// Register registers the connector under its name.
+	Register(connector Connector) error
+
+	// Connectors returns the registered connectors ordered by name.
+	Connectors() []Connector
+
+	// ReceiveWebhook creates the documents of the webhook payload of the connector.
+	// The context must carry the account of the connector.
+	ReceiveWebhook(ctx context.Context, name string, payload []byte) ([]Status, error)
+
+	// Poll creates the documents polled from the connector.
+	// The context must carry the account of the connector.
+	Poll(ctx context.Context, name string) ([]Status, error)
+}
+
+type service struct {
+	registry *documents.ServiceRegistry
+	txMan    transactions.Manager
+
+	mu         sync.RWMutex
+	connectors map[string]Connector
+}
+
+// DefaultService returns the default connectors service creating the documents through the services of the registry.
+func DefaultService(registry *documents.ServiceRegistry, txMan transactions.Manager) Service {
+	return &service{
+		registry:   registry,
+		txMan:      txMan,
+		connectors: make(map[string]Connector),
+	}
+}
+
+func (s *service) Register(connector Connector) error {
+	if connector == nil || connector.Name() == "" {
+		return errors.New("connector name is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.connectors[connector.Name()]; ok {
+		return errors.New("connector %s is already registered", connector.Name())
+	}
+
+	s.connectors[connector.Name()] = connector
+	return nil
+}
+
+func (s *service) Connectors() []Connector {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var cs []Connector
+	for _, c := range s.connectors {
+		cs = append(cs, c)
+	}
+
+	sort.Slice(cs, func(i, j int) bool {
+		return cs[i].Name() < cs[j].Name()
+	})
+
+	return cs
+}
+
+func (s *service) ReceiveWebhook(ctx context.Context, name string, payload []byte) ([]Status, error) {
+	c, err := s.connector(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	wr, ok := c.(WebhookReceiver)
+	if !ok {
+		return nil, errors.NewTypedError(ErrIngestionUnsupported, errors.New("%s doesn't receive webhooks", name))
+	}
+
+	docs, err := wr.ParseWebhook(payload)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrInvalidPayload, err)
+	}
+
+	return s.ingest(ctx, c, docs), nil
+}
+
+func (s *service) Poll(ctx context.Context, name string) ([]Status, error) {
+	c, err := s.connector(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := c.(Poller)
+	if !ok {
+		return nil, errors.NewTypedError(ErrIngestionUnsupported, errors.New("%s is not polled", name))
+	}
+
+	docs, err := p.Poll(ctx)
+	if err != nil {
+		return nil, errors.New("failed to poll %s: %v", name, err)
+	}
+
+	return s.ingest(ctx, c, docs), nil
+}
+
+// connector returns the connector of the name if the context carries its account.
+func (s *service) connector(ctx context.Context, name string) (Connector, error) {
+	s.mu.RLock()
+	c, ok := s.connectors[name]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, errors.NewTypedError(ErrUnknownConnector, errors.New("%s", name))
+	}
+
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrConnectorAccount, err)
+	}
+
+	if !did.Equal(c.Account()) {
+		return nil, errors.NewTypedError(ErrConnectorAccount, errors.New("%s belongs to %s", name, c.Account().String()))
+	}
+
+	return c, nil
+}
+
+// ingest creates the documents and writes their status back to the ERP system.
+// A document that fails doesn't stop the ingestion of the other documents.
+func (s *service) ingest(ctx context.Context, c Connector, docs []Document) []Status {
+	statuses := make([]Status, len(docs))
+	for i, doc := range docs {
+		status, txID, done := s.create(ctx, doc)
+		statuses[i] = status
+		s.writeStatus(c, status)
+		if done != nil {
+			go s.track(c, status, txID, done)
+		}
+	}
+
+	return statuses
+}
+
+// create derives the document from its client data and starts anchoring it.
+// The returned channel receives once the anchoring is done, it is nil if the document failed.
+func (s *service) create(ctx context.Context, doc Document) (Status, transactions.TxID, chan bool) {
+	status := Status{ExternalID: doc.ExternalID, State: StateFailed}
+	if doc.ExternalID == "" {
+		status.Error = "external ID is required"
+		return status, transactions.NilTxID(), nil
+	}
+
+	srv, err := s.registry.LocateSchemeService(doc.Scheme)
+	if err != nil {
+		status.Error = err.Error()
+		return status, transactions.NilTxID(), nil
+	}
+
+	err = s.registry.ValidateClientData(doc.Scheme, doc.Data)
+	if err != nil {
+		status.Error = err.Error()
+		return status, transactions.NilTxID(), nil
+	}
+
+	model, err := srv.DeriveFromClientData(ctx, documents.CollaboratorsAccess{ReadWriteCollaborators: doc.Collaborators}, doc.Data)
+	if err != nil {
+		status.Error = err.Error()
+		return status, transactions.NilTxID(), nil
+	}
+
+	model, txID, done, err := srv.Create(ctx, model)
+	if err != nil {
+		status.Error = err.Error()
+		return status, transactions.NilTxID(), nil
+	}
+
+	status.DocumentID = hexutil.Encode(model.ID())
+	status.TransactionID = txID.String()
+	status.State = StatePending
+	return status, txID, done
+}
+
+// track writes the anchored or failed status of the document back once its anchoring is done.
+func (s *service) track(c Connector, status Status, txID transactions.TxID, done chan bool) {
+	<-done
+	status.State = StateAnchored
+	resp, err := s.txMan.GetTransactionStatus(c.Account(), txID)
+	switch {
+	case err != nil:
+		status.State, status.Error = StateFailed, err.Error()
+	case transactions.Status(resp.Status) != transactions.Success:
+		status.State, status.Error = StateFailed, resp.Message
+	}
+
+	s.writeStatus(c, status)
+}
+
+func (s *service) writeStatus(c Connector, status Status) {
+	err := c.WriteStatus(context.Background(), status)
+	if err != nil {
+		log.Warningf("connector %s: failed to write the status of %s: %v", c.Name(), status.ExternalID, err)
+	}
+}
@@ -0,0 +1,242 @@
+// +build unit
+
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/transactions"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockConnector receives the webhooks and is polled for the documents, it records the written statuses.
+type mockConnector struct {
+	name    string
+	account identity.DID
+	docs    []Document
+	pollErr error
+
+	mu       sync.Mutex
+	statuses []Status
+}
+
+func (c *mockConnector) Name() string { return c.name }
+
+func (c *mockConnector) Account() identity.DID { return c.account }
+
+func (c *mockConnector) WriteStatus(ctx context.Context, status Status) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.statuses = append(c.statuses, status)
+	return nil
+}
+
+func (c *mockConnector) ParseWebhook(payload []byte) ([]Document, error) {
+	return decodeRESTPayload(payload)
+}
+
+func (c *mockConnector) Poll(ctx context.Context) ([]Document, error) {
+	return c.docs, c.pollErr
+}
+
+func (c *mockConnector) written() []Status {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Status(nil), c.statuses...)
+}
+
+// statusConnector only writes the statuses.
+type statusConnector struct {
+	name    string
+	account identity.DID
+}
+
+func (c statusConnector) Name() string { return c.name }
+
+func (c statusConnector) Account() identity.DID { return c.account }
+
+func (c statusConnector) WriteStatus(ctx context.Context, status Status) error { return nil }
+
+type testModel struct {
+	documents.Model
+	id []byte
+}
+
+func (m *testModel) ID() []byte { return m.id }
+
+// schemeService derives the documents identified by the number field of the client data and anchors them right away.
+type schemeService struct {
+	documents.SchemeService
+}
+
+func (s schemeService) Scheme() string { return "test" }
+
+func (s schemeService) DeriveFromClientData(ctx context.Context, collaborators documents.CollaboratorsAccess, data []byte) (documents.Model, error) {
+	var fields map[string]string
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if fields["number"] == "" {
+		return nil, errors.New("number is required")
+	}
+
+	return &testModel{id: []byte(fields["number"])}, nil
+}
+
+func (s schemeService) Create(ctx context.Context, model documents.Model) (documents.Model, transactions.TxID, chan bool, error) {
+	if string(model.ID()) == "fail" {
+		return nil, transactions.NilTxID(), nil, errors.New("failed to create")
+	}
+
+	done := make(chan bool)
+	go func() { done <- true }()
+	return model, transactions.NewTxID(), done, nil
+}
+
+// txManager reports every transaction as successful.
+type txManager struct {
+	transactions.Manager
+}
+
+func (txManager) GetTransactionStatus(accountID identity.DID, id transactions.TxID) (*transactionspb.TransactionStatusResponse, error) {
+	return &transactionspb.TransactionStatusResponse{Status: string(transactions.Success)}, nil
+}
+
+func testService(t *testing.T) Service {
+	registry := documents.NewServiceRegistry()
+	assert.NoError(t, registry.Register("test", schemeService{}))
+	return DefaultService(registry, txManager{})
+}
+
+func accountContext(t *testing.T, did identity.DID) context.Context {
+	ctx, err := contextutil.New(context.Background(), &configstore.Account{IdentityID: did[:]})
+	assert.NoError(t, err)
+	return ctx
+}
+
+func waitForStatuses(t *testing.T, c *mockConnector, n int) []Status {
+	for i := 0; i < 100; i++ {
+		statuses := c.written()
+		if len(statuses) >= n {
+			return statuses
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("connector %s did not receive %d statuses", c.name, n)
+	return nil
+}
+
+func TestService_Register(t *testing.T) {
+	srv := testService(t)
+	did := testingidentity.GenerateRandomDID()
+
+	// missing name
+	assert.Error(t, srv.Register(&mockConnector{account: did}))
+	assert.Error(t, srv.Register(nil))
+
+	assert.NoError(t, srv.Register(&mockConnector{name: "b", account: did}))
+	assert.NoError(t, srv.Register(&mockConnector{name: "a", account: did}))
+
+	// already registered
+	assert.Error(t, srv.Register(&mockConnector{name: "a", account: did}))
+
+	cs := srv.Connectors()
+	assert.Len(t, cs, 2)
+	assert.Equal(t, "a", cs[0].Name())
+	assert.Equal(t, "b", cs[1].Name())
+}
+
+func TestService_ReceiveWebhook(t *testing.T) {
+	srv := testService(t)
+	did := testingidentity.GenerateRandomDID()
+	c := &mockConnector{name: "erp", account: did}
+	assert.NoError(t, srv.Register(c))
+	assert.NoError(t, srv.Register(statusConnector{name: "status", account: did}))
+	ctx := accountContext(t, did)
+
+	// unknown connector
+	_, err := srv.ReceiveWebhook(ctx, "unknown", nil)
+	assert.True(t, errors.IsOfType(ErrUnknownConnector, err))
+
+	// missing account
+	_, err = srv.ReceiveWebhook(context.Background(), "erp", nil)
+	assert.True(t, errors.IsOfType(ErrConnectorAccount, err))
+
+	// account of another identity
+	_, err = srv.ReceiveWebhook(accountContext(t, testingidentity.GenerateRandomDID()), "erp", nil)
+	assert.True(t, errors.IsOfType(ErrConnectorAccount, err))
+
+	// webhooks not supported
+	_, err = srv.ReceiveWebhook(ctx, "status", nil)
+	assert.True(t, errors.IsOfType(ErrIngestionUnsupported, err))
+
+	// invalid payload
+	_, err = srv.ReceiveWebhook(ctx, "erp", []byte("invalid"))
+	assert.True(t, errors.IsOfType(ErrInvalidPayload, err))
+
+	statuses, err := srv.ReceiveWebhook(ctx, "erp", []byte(`{"documents": [
+		{"external_id": "1", "scheme": "test", "data": {"number": "doc"}},
+		{"scheme": "test", "data": {"number": "doc"}},
+		{"external_id": "3", "scheme": "unknown", "data": {"number": "doc"}},
+		{"external_id": "4", "scheme": "test", "data": {}},
+		{"external_id": "5", "scheme": "test", "data": {"number": "fail"}}
+	]}`))
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 5)
+	assert.Equal(t, StatePending, statuses[0].State)
+	assert.Equal(t, hexutil.Encode([]byte("doc")), statuses[0].DocumentID)
+	assert.NotEmpty(t, statuses[0].TransactionID)
+	for _, s := range statuses[1:] {
+		assert.Equal(t, StateFailed, s.State)
+		assert.NotEmpty(t, s.Error)
+		assert.Empty(t, s.DocumentID)
+	}
+
+	// the anchored status is written once the document is anchored
+	anchored := statuses[0]
+	anchored.State = StateAnchored
+	assert.ElementsMatch(t, append(statuses, anchored), waitForStatuses(t, c, 6))
+}
+
+func TestService_Poll(t *testing.T) {
+	srv := testService(t)
+	did := testingidentity.GenerateRandomDID()
+	c := &mockConnector{name: "erp", account: did, docs: []Document{
+		{ExternalID: "1", Scheme: "test", Data: json.RawMessage(`{"number": "doc"}`)},
+	}}
+	assert.NoError(t, srv.Register(c))
+	assert.NoError(t, srv.Register(statusConnector{name: "status", account: did}))
+	ctx := accountContext(t, did)
+
+	// not polled
+	_, err := srv.Poll(ctx, "status")
+	assert.True(t, errors.IsOfType(ErrIngestionUnsupported, err))
+
+	statuses, err := srv.Poll(ctx, "erp")
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, StatePending, statuses[0].State)
+	written := waitForStatuses(t, c, 2)
+	assert.Equal(t, StateAnchored, written[1].State)
+
+	// failed poll
+	c.pollErr = errors.New("unavailable")
+	_, err = srv.Poll(ctx, "erp")
+	assert.Error(t, err)
+}
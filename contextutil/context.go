@@ -2,15 +2,11 @@ package contextutil
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/centrifuge/go-centrifuge/centerrors"
-	"github.com/centrifuge/go-centrifuge/code"
 	"github.com/centrifuge/go-centrifuge/config"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/transactions"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type contextKey string
@@ -64,27 +60,3 @@ func Account(ctx context.Context) (config.Account, error) {
 	}
 	return tc, nil
 }
-
-// Context updates a context with account info using the configstore, must only be used for api handlers
-func Context(ctx context.Context, cs config.Service) (context.Context, error) {
-	tcIDHex, ok := ctx.Value(config.AccountHeaderKey).(string)
-	if !ok {
-		return nil, centerrors.New(code.Unknown, fmt.Sprintf("failed to get header %v", config.AccountHeaderKey))
-	}
-
-	tcID, err := hexutil.Decode(tcIDHex)
-	if err != nil {
-		return nil, centerrors.New(code.Unknown, fmt.Sprintf("failed to get header: %v", err))
-	}
-
-	tc, err := cs.GetAccount(tcID)
-	if err != nil {
-		return nil, centerrors.New(code.Unknown, fmt.Sprintf("failed to get header: %v", err))
-	}
-
-	ctxHeader, err := New(ctx, tc)
-	if err != nil {
-		return nil, centerrors.New(code.Unknown, fmt.Sprintf("failed to get header: %v", err))
-	}
-	return ctxHeader, nil
-}
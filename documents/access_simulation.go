@@ -0,0 +1,120 @@
+package documents
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AccessType is the way a DID is granted access to a document, as checked by the collaborators sharing the document.
+type AccessType string
+
+const (
+	// AccessTypeAccount is the access of a DID that is in the read rules of the document.
+	AccessTypeAccount AccessType = "account"
+
+	// AccessTypeAccessToken is the access of the grantee of an access token on a delegating document.
+	AccessTypeAccessToken AccessType = "access_token"
+
+	// AccessTypeNFTOwner is the access of the owner of an NFT that is in the read rules of the document.
+	AccessTypeNFTOwner AccessType = "nft_owner"
+)
+
+// AccessSimulation is a request to access a document, as it would be made by a DID over the p2p layer.
+// The access token and NFT owner checks are only simulated if their token IDs are set.
+type AccessSimulation struct {
+	Account identity.DID
+
+	// AccessTokenID is the ID of the access token on the delegating document
+	AccessTokenID []byte
+
+	// DelegatingDocumentID is the ID of the document holding the access token, the document itself if empty
+	DelegatingDocumentID []byte
+
+	// NFTRegistry and NFTTokenID identify the NFT the account owns
+	NFTRegistry common.Address
+	NFTTokenID  []byte
+}
+
+// AccessCheck is the outcome of the access check of a type.
+type AccessCheck struct {
+	Type    AccessType
+	Granted bool
+
+	// Reason is the reason the access is denied, empty if granted
+	Reason string
+}
+
+// AccessReport is the outcome of an access simulation on the latest version of a document.
+type AccessReport struct {
+	DocumentID []byte
+	VersionID  []byte
+	Checks     []AccessCheck
+}
+
+// Granted returns true if the access is granted by any of the checks.
+func (r *AccessReport) Granted() bool {
+	for _, c := range r.Checks {
+		if c.Granted {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newAccessCheck returns the check of the access type, denied with the reason if err is not nil.
+func newAccessCheck(tp AccessType, err error) AccessCheck {
+	if err != nil {
+		return AccessCheck{Type: tp, Reason: err.Error()}
+	}
+
+	return AccessCheck{Type: tp, Granted: true}
+}
+
+// SimulateAccess runs the checks the collaborators run when the account requests the latest version of the document,
+// without requesting it. The NFT owners are looked up in tokenRegistry. The checks are run independently, so the
+// report lists the reason each type of access is denied for.
+func (s service) SimulateAccess(ctx context.Context, documentID []byte, tokenRegistry TokenRegistry, sim AccessSimulation) (*AccessReport, error) {
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &AccessReport{DocumentID: model.ID(), VersionID: model.CurrentVersion()}
+	var accountErr error
+	if !model.AccountCanRead(sim.Account) {
+		accountErr = errors.New("account %s is not in the read rules of the document", sim.Account.String())
+	}
+	report.Checks = append(report.Checks, newAccessCheck(AccessTypeAccount, accountErr))
+
+	if len(sim.AccessTokenID) > 0 {
+		report.Checks = append(report.Checks, newAccessCheck(AccessTypeAccessToken, s.checkAccessToken(ctx, model, sim)))
+	}
+
+	if len(sim.NFTTokenID) > 0 {
+		nftErr := errors.New("token registry not initialised")
+		if tokenRegistry != nil {
+			nftErr = model.NFTOwnerCanRead(tokenRegistry, sim.NFTRegistry, sim.NFTTokenID, sim.Account)
+		}
+		report.Checks = append(report.Checks, newAccessCheck(AccessTypeNFTOwner, nftErr))
+	}
+
+	return report, nil
+}
+
+// checkAccessToken checks the access token of the delegating document grants the account access to the model.
+func (s service) checkAccessToken(ctx context.Context, model Model, sim AccessSimulation) error {
+	delegating := model
+	if len(sim.DelegatingDocumentID) > 0 {
+		var err error
+		delegating, err = s.GetCurrentVersion(ctx, sim.DelegatingDocumentID)
+		if err != nil {
+			return errors.New("failed to get delegating document: %v", err)
+		}
+	}
+
+	return delegating.ATGranteeCanRead(ctx, s.idService, sim.AccessTokenID, model.ID(), sim.Account)
+}
@@ -0,0 +1,119 @@
+// +build unit
+
+package documents
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func (m *historyDoc) AccountCanRead(account identity.DID) bool {
+	for _, c := range m.Collaborators {
+		if c.Equal(account) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ATGranteeCanRead grants the author access to any document with the token whose ID is the root of the version.
+func (m *historyDoc) ATGranteeCanRead(ctx context.Context, idSrv identity.ServiceDID, tokenID, docID []byte, grantee identity.DID) error {
+	if !bytes.Equal(tokenID, m.Root) {
+		return ErrAccessTokenNotFound
+	}
+
+	if !grantee.Equal(m.AuthorDID) {
+		return ErrRequesterNotGrantee
+	}
+
+	return nil
+}
+
+func (m *historyDoc) NFTOwnerCanRead(tr TokenRegistry, registry common.Address, tokenID []byte, account identity.DID) error {
+	owner, err := tr.OwnerOf(registry, tokenID)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(owner.Bytes(), account[:]) {
+		return errors.New("account (%v) not owner of the NFT", account.String())
+	}
+
+	return nil
+}
+
+func TestService_SimulateAccess(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&historyDoc{})
+	srv := DefaultService(repo, nil, nil, nil, 0, "", 0, nil, nil, nil, nil, nil)
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+	collaborator, requester := testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID()
+	doc, delegating := historyChain(1)[0], historyChain(1)[0]
+	doc.Collaborators = []identity.DID{collaborator}
+	delegating.AuthorDID = requester
+	for _, d := range []*historyDoc{doc, delegating} {
+		assert.NoError(t, repo.Create(did[:], d.Version, d))
+	}
+
+	// unknown document
+	_, err = srv.SimulateAccess(actx, utils.RandomSlice(32), nil, AccessSimulation{Account: requester})
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+
+	// collaborator
+	report, err := srv.SimulateAccess(actx, doc.DocID, nil, AccessSimulation{Account: collaborator})
+	assert.NoError(t, err)
+	assert.Equal(t, doc.Version, report.VersionID)
+	assert.True(t, report.Granted())
+	assert.Equal(t, []AccessCheck{{Type: AccessTypeAccount, Granted: true}}, report.Checks)
+
+	// access token of the delegating document, no token registry
+	registry, tokenID := common.BytesToAddress(utils.RandomSlice(20)), utils.RandomSlice(32)
+	sim := AccessSimulation{
+		Account:              requester,
+		AccessTokenID:        delegating.Root,
+		DelegatingDocumentID: delegating.DocID,
+		NFTRegistry:          registry,
+		NFTTokenID:           tokenID,
+	}
+	report, err = srv.SimulateAccess(actx, doc.DocID, nil, sim)
+	assert.NoError(t, err)
+	assert.True(t, report.Granted())
+	assert.Len(t, report.Checks, 3)
+	assert.Equal(t, AccessTypeAccount, report.Checks[0].Type)
+	assert.False(t, report.Checks[0].Granted)
+	assert.Contains(t, report.Checks[0].Reason, "not in the read rules")
+	assert.Equal(t, AccessCheck{Type: AccessTypeAccessToken, Granted: true}, report.Checks[1])
+	assert.Equal(t, AccessCheck{Type: AccessTypeNFTOwner, Reason: "token registry not initialised"}, report.Checks[2])
+
+	// unknown delegating document, NFT owned by another account
+	sim.DelegatingDocumentID = utils.RandomSlice(32)
+	tr := mockRegistry{}
+	tr.On("OwnerOf", registry, tokenID).Return(collaborator.ToAddress(), nil).Once()
+	report, err = srv.SimulateAccess(actx, doc.DocID, tr, sim)
+	assert.NoError(t, err)
+	assert.False(t, report.Granted())
+	assert.Contains(t, report.Checks[1].Reason, "failed to get delegating document")
+	assert.Contains(t, report.Checks[2].Reason, "not owner of the NFT")
+	tr.AssertExpectations(t)
+
+	// access token of the document itself
+	sim.DelegatingDocumentID = nil
+	sim.NFTTokenID = nil
+	report, err = srv.SimulateAccess(actx, doc.DocID, nil, sim)
+	assert.NoError(t, err)
+	assert.Len(t, report.Checks, 2)
+	assert.Equal(t, AccessCheck{Type: AccessTypeAccessToken, Reason: ErrAccessTokenNotFound.Error()}, report.Checks[1])
+}
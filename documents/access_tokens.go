@@ -0,0 +1,82 @@
+package documents
+
+import (
+	"context"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+)
+
+// AccessTokenScope is what an access token grants to its grantee.
+type AccessTokenScope string
+
+const (
+	// AccessTokenScopeRead grants read access to the document.
+	AccessTokenScopeRead AccessTokenScope = "read"
+
+	// AccessTokenScopeProofs only grants proofs of the fields the token was issued for.
+	AccessTokenScopeProofs AccessTokenScope = "proofs"
+)
+
+// AccessToken is an access token on a document.
+type AccessToken struct {
+	Identifier []byte
+	Granter    identity.DID
+	Grantee    identity.DID
+	Scope      AccessTokenScope
+
+	// ExpiresAt is zero if the token doesn't expire
+	ExpiresAt time.Time
+}
+
+// ActiveAccessTokens returns the access tokens of the document that have not expired at now.
+func (cd *CoreDocument) ActiveAccessTokens(now time.Time) ([]AccessToken, error) {
+	var tokens []AccessToken
+	for _, at := range cd.Document.AccessTokens {
+		exp := cd.atExpiry(at)
+		if isExpiredAT(exp, now) {
+			continue
+		}
+
+		token := AccessToken{
+			Identifier: at.Identifier,
+			Granter:    identity.NewDIDFromBytes(at.Granter),
+			Grantee:    identity.NewDIDFromBytes(at.Grantee),
+			Scope:      AccessTokenScopeRead,
+		}
+
+		if cd.isProofOnlyAT(at) {
+			token.Scope = AccessTokenScopeProofs
+		}
+
+		if exp != nil {
+			expiresAt, err := utils.FromTimestamp(exp)
+			if err != nil {
+				return nil, err
+			}
+
+			token.ExpiresAt = expiresAt
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+// ListAccessTokens returns the access tokens of the latest version of the document that have not expired.
+func (s service) ListAccessTokens(ctx context.Context, documentID []byte) ([]AccessToken, error) {
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := model.ActiveAccessTokens(time.Now().UTC())
+	if err != nil {
+		return nil, errors.NewTypedError(ErrAccessTokenInvalid, err)
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,120 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCoreDocument_AccessTokenExpiry(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	granterID, err := contextutil.AccountDID(ctx)
+	assert.NoError(t, err)
+	granteeID := testingidentity.GenerateRandomDID()
+	srv := new(testingcommons.MockIdentityService)
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: []string{granterID.String()}}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(32)
+
+	// expiry in the past
+	past, err := utils.ToTimestamp(time.Now().UTC().Add(-time.Hour))
+	assert.NoError(t, err)
+	payload := documentpb.AccessTokenParams{
+		Grantee:            hexutil.Encode(granteeID[:]),
+		DocumentIdentifier: hexutil.Encode(cd.Document.DocumentIdentifier),
+		ExpiresAt:          past,
+	}
+	_, err = cd.AddAccessToken(ctx, payload)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), ErrAccessTokenExpired.Error())
+
+	expiresAt := time.Now().UTC().Add(time.Hour)
+	payload.ExpiresAt, err = utils.ToTimestamp(expiresAt)
+	assert.NoError(t, err)
+	ncd, err := cd.AddAccessToken(ctx, payload)
+	assert.NoError(t, err)
+	at := ncd.Document.AccessTokens[0]
+	assert.Len(t, ncd.Extension.AccessTokenExpiries, 1)
+	exp := ncd.Extension.AccessTokenExpiries[0]
+	assert.Equal(t, at.Identifier, exp.Identifier)
+	assert.Equal(t, payload.ExpiresAt, exp.ExpiresAt)
+
+	// the signature covers the expiry
+	srv.On("ValidateKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+	assert.NoError(t, ncd.ATGranteeCanRead(ctx, srv, at.Identifier, ncd.Document.DocumentIdentifier, granteeID))
+	exp.ExpiresAt = &timestamp.Timestamp{Seconds: exp.ExpiresAt.Seconds + 3600}
+	assert.Equal(t, ErrAccessTokenInvalid, ncd.ATGranteeCanRead(ctx, srv, at.Identifier, ncd.Document.DocumentIdentifier, granteeID))
+
+	// expired token
+	exp.ExpiresAt = past
+	assert.Equal(t, ErrAccessTokenExpired, ncd.ATGranteeCanRead(ctx, srv, at.Identifier, ncd.Document.DocumentIdentifier, granteeID))
+	srv.AssertExpectations(t)
+}
+
+func TestCoreDocument_ActiveAccessTokens(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	granterID, err := contextutil.AccountDID(ctx)
+	assert.NoError(t, err)
+	granteeID := testingidentity.GenerateRandomDID()
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: []string{granterID.String()}}, nil)
+	assert.NoError(t, err)
+	tokens, err := cd.ActiveAccessTokens(time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	// read token without expiry
+	cd.Document.DocumentRoot = utils.RandomSlice(32)
+	payload := documentpb.AccessTokenParams{
+		Grantee:            hexutil.Encode(granteeID[:]),
+		DocumentIdentifier: hexutil.Encode(cd.Document.DocumentIdentifier),
+	}
+	cd, err = cd.AddAccessToken(ctx, payload)
+	assert.NoError(t, err)
+
+	// proof-only token expiring in an hour
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Hour)
+	cd.Document.DocumentRoot = utils.RandomSlice(32)
+	payload.ProofFields = []string{"invoice.gross_amount_decimal"}
+	payload.ExpiresAt, err = utils.ToTimestamp(expiresAt)
+	assert.NoError(t, err)
+	cd, err = cd.AddAccessToken(ctx, payload)
+	assert.NoError(t, err)
+
+	tokens, err = cd.ActiveAccessTokens(now)
+	assert.NoError(t, err)
+	assert.Equal(t, []AccessToken{
+		{
+			Identifier: cd.Document.AccessTokens[0].Identifier,
+			Granter:    granterID,
+			Grantee:    granteeID,
+			Scope:      AccessTokenScopeRead,
+		},
+		{
+			Identifier: cd.Document.AccessTokens[1].Identifier,
+			Granter:    granterID,
+			Grantee:    granteeID,
+			Scope:      AccessTokenScopeProofs,
+			ExpiresAt:  expiresAt,
+		},
+	}, tokens)
+
+	// expired
+	tokens, err = cd.ActiveAccessTokens(expiresAt)
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, identity.NewDIDFromBytes(cd.Document.AccessTokens[0].Grantee), tokens[0].Grantee)
+}
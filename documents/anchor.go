@@ -25,9 +25,18 @@ type updaterFunc func(id []byte, model Model) error
 
 // AnchorDocument add signature, requests signatures, anchors document, and sends the anchored document
 // to collaborators
-func AnchorDocument(ctx context.Context, model Model, proc AnchorProcessor, updater updaterFunc, preAnchor bool) (Model, error) {
+// The status of the model is committing until the document is anchored and committed afterwards.
+// If anchoring fails, the status is set to failed. The completed steps are emitted to events.
+func AnchorDocument(ctx context.Context, model Model, proc AnchorProcessor, updater updaterFunc, preAnchor bool, events *EventLog) (m Model, err error) {
 	id := model.CurrentVersion()
-	err := proc.PrepareForSignatureRequests(ctx, model)
+	defer func() {
+		if err != nil && model.GetStatus() != StatusCommitted {
+			setFailedStatus(id, model, updater)
+		}
+	}()
+
+	model.SetStatus(StatusCommitting)
+	err = proc.PrepareForSignatureRequests(ctx, model)
 	if err != nil {
 		return nil, errors.NewTypedError(ErrDocumentAnchoring, errors.New("failed to prepare document for signatures: %v", err))
 	}
@@ -37,6 +46,8 @@ func AnchorDocument(ctx context.Context, model Model, proc AnchorProcessor, upda
 		return nil, err
 	}
 
+	emitEvent(ctx, events, EventVersionPrepared, model)
+
 	if preAnchor {
 		err = proc.PreAnchorDocument(ctx, model)
 		if err != nil {
@@ -54,6 +65,8 @@ func AnchorDocument(ctx context.Context, model Model, proc AnchorProcessor, upda
 		return nil, errors.NewTypedError(ErrDocumentAnchoring, err)
 	}
 
+	emitSignatureEvents(ctx, events, model)
+
 	err = proc.PrepareForAnchoring(model)
 	if err != nil {
 		return nil, errors.NewTypedError(ErrDocumentAnchoring, errors.New("failed to prepare for anchoring: %v", err))
@@ -70,11 +83,14 @@ func AnchorDocument(ctx context.Context, model Model, proc AnchorProcessor, upda
 		return nil, errors.NewTypedError(ErrDocumentAnchoring, errors.New("failed to anchor document: %v", err))
 	}
 
+	model.SetStatus(StatusCommitted)
 	err = updater(id, model)
 	if err != nil {
 		return nil, errors.NewTypedError(ErrDocumentAnchoring, err)
 	}
 
+	emitEvent(ctx, events, EventAnchored, model)
+
 	err = proc.SendDocument(ctx, model)
 	if err != nil {
 		return nil, errors.NewTypedError(ErrDocumentAnchoring, errors.New("failed to send anchored document: %v", err))
@@ -85,5 +101,15 @@ func AnchorDocument(ctx context.Context, model Model, proc AnchorProcessor, upda
 		return nil, errors.NewTypedError(ErrDocumentAnchoring, err)
 	}
 
+	emitEvent(ctx, events, EventSent, model)
 	return model, nil
 }
+
+// setFailedStatus sets the status of the model to failed and saves it.
+func setFailedStatus(id []byte, model Model, updater updaterFunc) {
+	model.SetStatus(StatusFailed)
+	err := updater(id, model)
+	if err != nil {
+		log.Errorf("failed to save the failed status of document version %x: %v", id, err)
+	}
+}
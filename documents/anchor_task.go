@@ -41,6 +41,8 @@ type documentAnchorTask struct {
 	processor     AnchorProcessor
 	modelGetFunc  func(tenantID, id []byte) (Model, error)
 	modelSaveFunc func(tenantID, id []byte, model Model) error
+	hooksRunFunc  func(ctx context.Context, event HookEvent, model Model) error
+	events        *EventLog
 }
 
 // TaskTypeName returns the name of the task.
@@ -48,6 +50,11 @@ func (d *documentAnchorTask) TaskTypeName() string {
 	return documentAnchorTaskName
 }
 
+// AccountIDParam returns the name of the param holding the account ID.
+func (d *documentAnchorTask) AccountIDParam() string {
+	return AccountIDParam
+}
+
 // ParseKwargs parses the kwargs.
 func (d *documentAnchorTask) ParseKwargs(kwargs map[string]interface{}) error {
 	err := d.ParseTransactionID(d.TaskTypeName(), kwargs)
@@ -85,6 +92,8 @@ func (d *documentAnchorTask) Copy() (gocelery.CeleryTask, error) {
 		processor:     d.processor,
 		modelGetFunc:  d.modelGetFunc,
 		modelSaveFunc: d.modelSaveFunc,
+		hooksRunFunc:  d.hooksRunFunc,
+		events:        d.events,
 	}, nil
 }
 
@@ -111,9 +120,19 @@ func (d *documentAnchorTask) RunTask() (res interface{}, err error) {
 		return false, errors.New("failed to get model: %v", err)
 	}
 
-	if _, err = AnchorDocument(ctxh, model, d.processor, func(id []byte, model Model) error {
+	updater := func(id []byte, model Model) error {
 		return d.modelSaveFunc(d.accountID[:], id, model)
-	}, tc.GetPrecommitEnabled()); err != nil {
+	}
+
+	if d.hooksRunFunc != nil {
+		err = d.hooksRunFunc(ctxh, HookPreAnchor, model)
+		if err != nil {
+			setFailedStatus(d.id, model, updater)
+			return false, errors.New("failed to anchor document: %v", err)
+		}
+	}
+
+	if _, err = AnchorDocument(ctxh, model, d.processor, updater, tc.GetPrecommitEnabled(), d.events); err != nil {
 		return false, errors.New("failed to anchor document: %v", err)
 	}
 
@@ -158,3 +177,47 @@ func CreateAnchorTransaction(txMan transactions.Manager, tq queue.TaskQueuer, se
 	})
 	return txID, done, err
 }
+
+// CommitDraft starts anchoring the current version of the document fetched from srv.
+// Only drafts and versions that failed to anchor can be committed.
+func CommitDraft(ctx context.Context, srv Service, txMan transactions.Manager, tq queue.TaskQueuer, documentID []byte) (Model, transactions.TxID, chan bool, error) {
+	selfDID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentConfigAccountID, err)
+	}
+
+	model, err := srv.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentNotFound, err)
+	}
+
+	switch model.GetStatus() {
+	case StatusDraft, StatusFailed:
+	default:
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentNotDraft, errors.New("version %x is %s", model.CurrentVersion(), model.GetStatus()))
+	}
+
+	txID, done, err := CreateAnchorTransaction(txMan, tq, selfDID, contextutil.TX(ctx), model.CurrentVersion())
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	return model, txID, done, nil
+}
+
+// CalculateDraftRoots calculates the data, signing and document roots of a draft.
+// Drafts are persisted without being anchored, the document root lets the next version of a draft refer to it.
+func CalculateDraftRoots(model Model) error {
+	_, err := model.CalculateDataRoot()
+	if err != nil {
+		return err
+	}
+
+	_, err = model.CalculateSigningRoot()
+	if err != nil {
+		return err
+	}
+
+	_, err = model.CalculateDocumentRoot()
+	return err
+}
@@ -0,0 +1,219 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/ipfs/go-cid"
+	"gopkg.in/resty.v1"
+)
+
+// AttachmentsField is the core document field holding the attachments of the document
+const AttachmentsField = "attachments"
+
+// BlobPinner pins the files attached to the documents so that they can be fetched by the collaborators.
+type BlobPinner interface {
+	// Pin stores and pins the blob and returns its content ID.
+	Pin(ctx context.Context, name string, blob []byte) (string, error)
+}
+
+// ipfsAddResponse is the response of the add endpoint of the IPFS API.
+type ipfsAddResponse struct {
+	Name string
+	Hash string
+	Size string
+}
+
+// ipfsPinner pins the blobs to an IPFS node over its HTTP API.
+type ipfsPinner struct {
+	client  *resty.Client
+	nodeURL string
+}
+
+// NewIPFSPinner returns the pinner adding the blobs to the IPFS node with the API at nodeURL.
+func NewIPFSPinner(nodeURL string, timeout time.Duration) BlobPinner {
+	return ipfsPinner{
+		client:  resty.New().SetTimeout(timeout),
+		nodeURL: strings.TrimSuffix(nodeURL, "/"),
+	}
+}
+
+// Pin adds the blob to the IPFS node with the pin flag set and returns its content ID.
+func (p ipfsPinner) Pin(ctx context.Context, name string, blob []byte) (string, error) {
+	res := new(ipfsAddResponse)
+	resp, err := p.client.R().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{"pin": "true", "cid-version": "1"}).
+		SetFileReader("file", name, bytes.NewReader(blob)).
+		SetResult(res).
+		Post(p.nodeURL + "/api/v0/add")
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return "", errors.New("failed to pin %s: status = %v", name, resp.StatusCode())
+	}
+
+	if res.Hash == "" {
+		return "", errors.New("failed to pin %s: no content ID returned", name)
+	}
+
+	return res.Hash, nil
+}
+
+// validateAttachment validates the fields of the attachment.
+func validateAttachment(attachment *coredocumentextpb.Attachment) error {
+	if attachment == nil {
+		return errors.New("no attachment provided")
+	}
+
+	if attachment.Name == "" {
+		return errors.New("attachment without name")
+	}
+
+	if len(attachment.Checksum) != sha256.Size {
+		return errors.New("attachment checksum must be %d bytes", sha256.Size)
+	}
+
+	if _, err := cid.Decode(attachment.Cid); err != nil {
+		return errors.New("invalid attachment content ID: %v", err)
+	}
+
+	if attachment.Size == 0 {
+		return errors.New("empty attachment")
+	}
+
+	return nil
+}
+
+// AddAttachment returns the next version of the core document with the attachment.
+// Attachments are unique by name, an earlier attachment with the same name is replaced.
+// The attachments are part of the cd_tree, so they can be proven against the anchored root of the next version.
+func (cd *CoreDocument) AddAttachment(attachment *coredocumentextpb.Attachment) (*CoreDocument, error) {
+	err := validateAttachment(attachment)
+	if err != nil {
+		return nil, err
+	}
+
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{}, false, nil)
+	if err != nil {
+		return nil, errors.New("failed to prepare new version: %v", err)
+	}
+
+	// attachments are shared with the previous version, replace instead of updating in place
+	attachments := make([]*coredocumentextpb.Attachment, len(ncd.Extension.Attachments))
+	copy(attachments, ncd.Extension.Attachments)
+	if i := attachmentIndex(attachments, attachment.Name); i >= 0 {
+		attachments[i] = attachment
+	} else {
+		attachments = append(attachments, attachment)
+	}
+
+	ncd.Extension.Attachments = attachments
+	return ncd, ncd.setSalts()
+}
+
+// attachmentIndex returns the index of the attachment with the name, or -1 if there is none.
+func attachmentIndex(attachments []*coredocumentextpb.Attachment, name string) int {
+	for i, a := range attachments {
+		if a.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Attachments returns the attachments of the document, in the order they were first added.
+func (cd *CoreDocument) Attachments() []*coredocumentextpb.Attachment {
+	return cd.Extension.Attachments
+}
+
+// Attachment returns the attachment of the document with the name.
+func (cd *CoreDocument) Attachment(name string) (*coredocumentextpb.Attachment, error) {
+	i := attachmentIndex(cd.Extension.Attachments, name)
+	if i < 0 {
+		return nil, errors.NewTypedError(ErrAttachmentNotFound, errors.New("attachment %s", name))
+	}
+
+	return cd.Extension.Attachments[i], nil
+}
+
+// AttachmentProofFields returns the fields to request to prove the attachment with the name.
+func (cd *CoreDocument) AttachmentProofFields(name string) ([]string, error) {
+	i := attachmentIndex(cd.Extension.Attachments, name)
+	if i < 0 {
+		return nil, errors.NewTypedError(ErrAttachmentNotFound, errors.New("attachment %s", name))
+	}
+
+	prefix := fmt.Sprintf("%s.%s[%d]", CDTreePrefix, AttachmentsField, i)
+	return []string{
+		prefix + ".name",
+		prefix + ".checksum",
+		prefix + ".cid",
+		prefix + ".size",
+	}, nil
+}
+
+// AddAttachment adds the attachment to the document and anchors the new version.
+// If blob is set, the checksum and size of the attachment are derived from it and the blob is pinned to the IPFS node
+// for its content ID. Blobs are rejected if no IPFS node is configured.
+func (s service) AddAttachment(ctx context.Context, documentID []byte, attachment *coredocumentextpb.Attachment, blob []byte) (Model, transactions.TxID, chan bool, error) {
+	if attachment == nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, errors.New("no attachment provided"))
+	}
+
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	if len(blob) > 0 {
+		err = s.pinAttachment(ctx, attachment, blob)
+		if err != nil {
+			return nil, transactions.NilTxID(), nil, err
+		}
+	}
+
+	err = model.AddAttachment(attachment)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return s.Update(ctx, model)
+}
+
+// pinAttachment pins the blob and sets the checksum, content ID and size of the attachment from it.
+func (s service) pinAttachment(ctx context.Context, attachment *coredocumentextpb.Attachment, blob []byte) error {
+	if s.pinner == nil {
+		return errors.NewTypedError(ErrDocumentInvalid, errors.New("no IPFS node configured to pin the attachment"))
+	}
+
+	if attachment.Name == "" {
+		return errors.NewTypedError(ErrDocumentInvalid, errors.New("attachment without name"))
+	}
+
+	checksum := sha256.Sum256(blob)
+	if len(attachment.Checksum) > 0 && !bytes.Equal(attachment.Checksum, checksum[:]) {
+		return errors.NewTypedError(ErrDocumentInvalid, errors.New("attachment checksum doesn't match the file"))
+	}
+
+	id, err := s.pinner.Pin(ctx, attachment.Name, blob)
+	if err != nil {
+		return errors.NewTypedError(ErrAttachmentPinning, err)
+	}
+
+	attachment.Checksum = checksum[:]
+	attachment.Cid = id
+	attachment.Size = uint64(len(blob))
+	return nil
+}
@@ -0,0 +1,164 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"crypto/sha256"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ipfs/go-cid"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPinner struct {
+	mock.Mock
+}
+
+func (m *mockPinner) Pin(ctx context.Context, name string, blob []byte) (string, error) {
+	args := m.Called(name, blob)
+	return args.String(0), args.Error(1)
+}
+
+func newAttachment(t *testing.T, name string, blob []byte) *coredocumentextpb.Attachment {
+	id, err := cid.NewPrefixV1(cid.Raw, mh.SHA2_256).Sum(blob)
+	assert.NoError(t, err)
+	checksum := sha256.Sum256(blob)
+	return &coredocumentextpb.Attachment{
+		Name:     name,
+		Checksum: checksum[:],
+		Cid:      id.String(),
+		Size:     uint64(len(blob)),
+	}
+}
+
+func TestValidateAttachment(t *testing.T) {
+	assert.Error(t, validateAttachment(nil))
+	assert.NoError(t, validateAttachment(newAttachment(t, "invoice.pdf", utils.RandomSlice(64))))
+
+	for _, change := range []func(a *coredocumentextpb.Attachment){
+		func(a *coredocumentextpb.Attachment) { a.Name = "" },
+		func(a *coredocumentextpb.Attachment) { a.Checksum = utils.RandomSlice(20) },
+		func(a *coredocumentextpb.Attachment) { a.Cid = "invalid" },
+		func(a *coredocumentextpb.Attachment) { a.Size = 0 },
+	} {
+		a := newAttachment(t, "invoice.pdf", utils.RandomSlice(64))
+		change(a)
+		assert.Error(t, validateAttachment(a))
+	}
+}
+
+func TestCoreDocument_AddAttachment(t *testing.T) {
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	attachment := newAttachment(t, "invoice.pdf", utils.RandomSlice(64))
+	_, err = cd.Attachment(attachment.Name)
+	assert.True(t, errors.IsOfType(ErrAttachmentNotFound, err))
+
+	// invalid attachment
+	_, err = cd.AddAttachment(&coredocumentextpb.Attachment{Name: "invoice.pdf"})
+	assert.Error(t, err)
+
+	ncd, err := cd.AddAttachment(attachment)
+	assert.NoError(t, err)
+	assert.Empty(t, cd.Attachments())
+	assert.Len(t, ncd.Attachments(), 1)
+	assert.Equal(t, cd.NextVersion(), ncd.CurrentVersion())
+	fields, err := ncd.AttachmentProofFields(attachment.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"cd_tree.attachments[0].name",
+		"cd_tree.attachments[0].checksum",
+		"cd_tree.attachments[0].cid",
+		"cd_tree.attachments[0].size",
+	}, fields)
+
+	// attachments are carried over to the next versions
+	ncd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	nncd, err := ncd.PrepareNewVersion(CollaboratorsAccess{}, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, ncd.Attachments(), nncd.Attachments())
+
+	// another attachment and a new file with the same name
+	other := newAttachment(t, "delivery-note.pdf", utils.RandomSlice(64))
+	nncd, err = ncd.AddAttachment(other)
+	assert.NoError(t, err)
+	nncd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	replaced := newAttachment(t, attachment.Name, utils.RandomSlice(128))
+	nncd, err = nncd.AddAttachment(replaced)
+	assert.NoError(t, err)
+	assert.Equal(t, []*coredocumentextpb.Attachment{replaced, other}, nncd.Attachments())
+	fields, err = nncd.AttachmentProofFields(other.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, "cd_tree.attachments[1].name", fields[0])
+
+	// previous versions are not changed
+	assert.Equal(t, []*coredocumentextpb.Attachment{attachment}, ncd.Attachments())
+}
+
+func TestService_pinAttachment(t *testing.T) {
+	blob := utils.RandomSlice(64)
+	expected := newAttachment(t, "invoice.pdf", blob)
+
+	// no IPFS node
+	srv := service{}
+	err := srv.pinAttachment(context.Background(), &coredocumentextpb.Attachment{Name: "invoice.pdf"}, blob)
+	assert.True(t, errors.IsOfType(ErrDocumentInvalid, err))
+
+	// checksum of another file
+	pinner := new(mockPinner)
+	srv.pinner = pinner
+	err = srv.pinAttachment(context.Background(), &coredocumentextpb.Attachment{Name: "invoice.pdf", Checksum: utils.RandomSlice(32)}, blob)
+	assert.True(t, errors.IsOfType(ErrDocumentInvalid, err))
+
+	// failed to pin
+	pinner.On("Pin", "invoice.pdf", blob).Return("", errors.New("connection refused")).Once()
+	err = srv.pinAttachment(context.Background(), &coredocumentextpb.Attachment{Name: "invoice.pdf"}, blob)
+	assert.True(t, errors.IsOfType(ErrAttachmentPinning, err))
+
+	// success
+	pinner.On("Pin", "invoice.pdf", blob).Return(expected.Cid, nil).Once()
+	attachment := &coredocumentextpb.Attachment{Name: "invoice.pdf", Checksum: expected.Checksum}
+	assert.NoError(t, srv.pinAttachment(context.Background(), attachment, blob))
+	assert.Equal(t, expected, attachment)
+	pinner.AssertExpectations(t)
+}
+
+func TestIPFSPinner_Pin(t *testing.T) {
+	blob := utils.RandomSlice(64)
+	id := newAttachment(t, "invoice.pdf", blob).Cid
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v0/add", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("pin"))
+		f, h, err := r.FormFile("file")
+		assert.NoError(t, err)
+		assert.Equal(t, "invoice.pdf", h.Filename)
+		data, err := ioutil.ReadAll(f)
+		assert.NoError(t, err)
+		assert.Equal(t, blob, data)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"Name":"invoice.pdf","Hash":"` + id + `","Size":"75"}`))
+	}))
+	defer server.Close()
+
+	p := NewIPFSPinner(server.URL+"/", 0)
+	status = http.StatusInternalServerError
+	_, err := p.Pin(context.Background(), "invoice.pdf", blob)
+	assert.Error(t, err)
+
+	status = http.StatusOK
+	got, err := p.Pin(context.Background(), "invoice.pdf", blob)
+	assert.NoError(t, err)
+	assert.Equal(t, id, got)
+}
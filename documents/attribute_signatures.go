@@ -0,0 +1,207 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// AttributeSignaturesField is the core document field holding the signatures of third parties over single attributes
+const AttributeSignaturesField = "attribute_signatures"
+
+// AttributeSignaturePayload returns the message signed by the signer of an attribute. It binds the signature to the
+// document, the readable property and the value of the attribute, and the time of signing.
+func AttributeSignaturePayload(documentID []byte, property string, value []byte, timestamp time.Time) []byte {
+	ts := make([]byte, 8)
+	binary.BigEndian.PutUint64(ts, uint64(timestamp.Unix()))
+	h := sha256.New()
+	h.Write(documentID)
+	h.Write(ts)
+	h.Write([]byte(property))
+	// property names never contain a zero byte, so the property and the value can't be shifted
+	h.Write([]byte{0})
+	h.Write(value)
+	return h.Sum(nil)
+}
+
+// ValidateAttributeSignature validates the signature of the attribute of the document against the signing keys of the
+// signer identity at the time of signing.
+func ValidateAttributeSignature(idService identity.ServiceDID, documentID []byte, sig *coredocumentextpb.AttributeSignature) error {
+	if sig.Property == "" {
+		return errors.New("attribute signature without property")
+	}
+
+	tm, err := utils.FromTimestamp(sig.Timestamp)
+	if err != nil {
+		return errors.New("invalid attribute signature timestamp: %v", err)
+	}
+
+	payload := AttributeSignaturePayload(documentID, sig.Property, sig.Value, tm)
+	return idService.ValidateSignature(identity.NewDIDFromBytes(sig.SignerId), sig.PublicKey, sig.Signature, payload, tm)
+}
+
+// AddAttributeSignature returns the next version of the core document with the signature over one of its attributes.
+// An earlier signature of the signer over the same attribute is replaced.
+// The signature is part of the cd_tree, so it can be proven against the anchored root of the next version.
+func (cd *CoreDocument) AddAttributeSignature(sig *coredocumentextpb.AttributeSignature) (*CoreDocument, error) {
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{}, false, nil)
+	if err != nil {
+		return nil, errors.New("failed to prepare new version: %v", err)
+	}
+
+	// signatures are shared with the previous version, replace instead of updating in place
+	var sigs []*coredocumentextpb.AttributeSignature
+	for _, s := range ncd.Extension.AttributeSignatures {
+		if bytes.Equal(s.SignerId, sig.SignerId) && s.Property == sig.Property {
+			continue
+		}
+
+		sigs = append(sigs, s)
+	}
+
+	ncd.Extension.AttributeSignatures = append(sigs, sig)
+	return ncd, ncd.setSalts()
+}
+
+// AttributeSignatures returns the signatures of third parties over single attributes of the document.
+func (cd *CoreDocument) AttributeSignatures() []*coredocumentextpb.AttributeSignature {
+	return cd.Extension.AttributeSignatures
+}
+
+// AttributeSignatureProofFields returns the fields to request to prove the signature of the signer over the attribute.
+func (cd *CoreDocument) AttributeSignatureProofFields(signer identity.DID, property string) ([]string, error) {
+	for i, s := range cd.Extension.AttributeSignatures {
+		if !bytes.Equal(s.SignerId, signer[:]) || s.Property != property {
+			continue
+		}
+
+		prefix := fmt.Sprintf("%s.%s[%d]", CDTreePrefix, AttributeSignaturesField, i)
+		return []string{
+			prefix + ".signer_id",
+			prefix + ".public_key",
+			prefix + ".property",
+			prefix + ".value",
+			prefix + ".timestamp",
+			prefix + ".signature",
+		}, nil
+	}
+
+	return nil, errors.New("attribute %s is not signed by %s", property, signer.String())
+}
+
+// attributeSigner is implemented by the models holding attribute signatures.
+type attributeSigner interface {
+	ID() []byte
+	AttributeSignatures() []*coredocumentextpb.AttributeSignature
+}
+
+// attributeSignaturesValidator validates the attribute signatures of the document.
+// A signature attests the value of the attribute at the time of signing, later versions can change the value.
+func attributeSignaturesValidator(idService identity.ServiceDID) Validator {
+	return ValidatorFunc(func(_, model Model) (err error) {
+		as, ok := model.(attributeSigner)
+		if !ok {
+			return nil
+		}
+
+		for _, sig := range as.AttributeSignatures() {
+			if erri := ValidateAttributeSignature(idService, as.ID(), sig); erri != nil {
+				err = errors.AppendError(
+					err,
+					errors.New("attribute_signature_%s_%s verification failed: %v", hexutil.Encode(sig.SignerId), sig.Property, erri))
+			}
+		}
+
+		return err
+	})
+}
+
+// attributeValue returns the value of the attribute of the model as it is proven against the document root.
+func attributeValue(model Model, property string) ([]byte, error) {
+	proofs, err := model.CreateProofs([]string{property})
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentProof, err)
+	}
+
+	return proofs[0].Value, nil
+}
+
+// SignAttribute signs the current value of the attribute of the latest version of the document with the signing key
+// of the account. The signature is handed to the document owner to be added to the document.
+func (s service) SignAttribute(ctx context.Context, documentID []byte, property string) (*coredocumentextpb.AttributeSignature, error) {
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentConfigAccountID, err)
+	}
+
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := attributeValue(model, property)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	ts, err := utils.ToTimestamp(now)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := acc.SignMsg(AttributeSignaturePayload(documentID, property, value, now))
+	if err != nil {
+		return nil, err
+	}
+
+	return &coredocumentextpb.AttributeSignature{
+		SignerId:  sig.SignerId,
+		PublicKey: sig.PublicKey,
+		Property:  property,
+		Value:     value,
+		Timestamp: ts,
+		Signature: sig.Signature,
+	}, nil
+}
+
+// AddAttributeSignature validates the signature over the current value of the attribute and adds it to the next version
+// of the document.
+func (s service) AddAttributeSignature(ctx context.Context, documentID []byte, sig *coredocumentextpb.AttributeSignature) (Model, transactions.TxID, chan bool, error) {
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	value, err := attributeValue(model, sig.Property)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	if !bytes.Equal(value, sig.Value) {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrAttributeSignatureInvalid, errors.New("signed value of %s doesn't match the document", sig.Property))
+	}
+
+	err = ValidateAttributeSignature(s.idService, documentID, sig)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrAttributeSignatureInvalid, err)
+	}
+
+	err = model.AddAttributeSignature(sig)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return s.Update(ctx, model)
+}
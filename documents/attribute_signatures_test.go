@@ -0,0 +1,128 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// attributeSignedModel is a model holding the attribute signatures of the core document.
+type attributeSignedModel struct {
+	Model
+	cd *CoreDocument
+}
+
+func (m attributeSignedModel) ID() []byte {
+	return m.cd.ID()
+}
+
+func (m attributeSignedModel) AttributeSignatures() []*coredocumentextpb.AttributeSignature {
+	return m.cd.AttributeSignatures()
+}
+
+func TestAttributeSignaturePayload(t *testing.T) {
+	id, value := utils.RandomSlice(idSize), utils.RandomSlice(32)
+	now := time.Now()
+	payload := AttributeSignaturePayload(id, "invoice.gross_amount", value, now)
+	assert.Len(t, payload, 32)
+	assert.Equal(t, payload, AttributeSignaturePayload(id, "invoice.gross_amount", value, now))
+
+	// every part of the attribute is signed
+	assert.NotEqual(t, payload, AttributeSignaturePayload(utils.RandomSlice(idSize), "invoice.gross_amount", value, now))
+	assert.NotEqual(t, payload, AttributeSignaturePayload(id, "invoice.net_amount", value, now))
+	assert.NotEqual(t, payload, AttributeSignaturePayload(id, "invoice.gross_amount", utils.RandomSlice(32), now))
+	assert.NotEqual(t, payload, AttributeSignaturePayload(id, "invoice.gross_amount", value, now.Add(time.Hour)))
+}
+
+func TestCoreDocument_AddAttributeSignature(t *testing.T) {
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	signer := testingidentity.GenerateRandomDID()
+	sig := &coredocumentextpb.AttributeSignature{SignerId: signer[:], Property: "invoice.gross_amount", Value: utils.RandomSlice(32)}
+	_, err = cd.AttributeSignatureProofFields(signer, sig.Property)
+	assert.Error(t, err)
+
+	ncd, err := cd.AddAttributeSignature(sig)
+	assert.NoError(t, err)
+	assert.Empty(t, cd.AttributeSignatures())
+	assert.Len(t, ncd.AttributeSignatures(), 1)
+	assert.Equal(t, cd.NextVersion(), ncd.CurrentVersion())
+	fields, err := ncd.AttributeSignatureProofFields(signer, sig.Property)
+	assert.NoError(t, err)
+	assert.Len(t, fields, 6)
+	assert.Equal(t, "cd_tree.attribute_signatures[0].signer_id", fields[0])
+
+	// signatures are carried over to the next versions
+	ncd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	nncd, err := ncd.PrepareNewVersion(CollaboratorsAccess{}, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, ncd.AttributeSignatures(), nncd.AttributeSignatures())
+
+	// another attribute and a new signature over the first one
+	other := &coredocumentextpb.AttributeSignature{SignerId: signer[:], Property: "invoice.net_amount", Value: utils.RandomSlice(32)}
+	nncd, err = ncd.AddAttributeSignature(other)
+	assert.NoError(t, err)
+	nncd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	resigned := &coredocumentextpb.AttributeSignature{SignerId: signer[:], Property: sig.Property, Value: utils.RandomSlice(32)}
+	nncd, err = nncd.AddAttributeSignature(resigned)
+	assert.NoError(t, err)
+	assert.Equal(t, []*coredocumentextpb.AttributeSignature{other, resigned}, nncd.AttributeSignatures())
+	fields, err = nncd.AttributeSignatureProofFields(signer, sig.Property)
+	assert.NoError(t, err)
+	assert.Equal(t, "cd_tree.attribute_signatures[1].signer_id", fields[0])
+
+	// previous versions are not changed
+	assert.Equal(t, []*coredocumentextpb.AttributeSignature{sig}, ncd.AttributeSignatures())
+}
+
+func TestAttributeSignaturesValidator(t *testing.T) {
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	idService := new(testingcommons.MockIdentityService)
+	v := attributeSignaturesValidator(idService)
+
+	// models without attribute signatures
+	assert.NoError(t, v.Validate(nil, new(mockModel)))
+	assert.NoError(t, v.Validate(nil, attributeSignedModel{cd: cd}))
+
+	signer := testingidentity.GenerateRandomDID()
+	ts, err := utils.ToTimestamp(time.Now())
+	assert.NoError(t, err)
+	sig := &coredocumentextpb.AttributeSignature{
+		SignerId:  signer[:],
+		PublicKey: utils.RandomSlice(32),
+		Property:  "invoice.gross_amount",
+		Value:     utils.RandomSlice(32),
+		Timestamp: ts,
+		Signature: utils.RandomSlice(65),
+	}
+	cd, err = cd.AddAttributeSignature(sig)
+	assert.NoError(t, err)
+	model := attributeSignedModel{cd: cd}
+
+	// invalid signature
+	idService.On("ValidateSignature", signer, sig.PublicKey, sig.Signature, mock.Anything, mock.Anything).Return(errors.New("invalid signature")).Once()
+	err = v.Validate(nil, model)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invoice.gross_amount")
+
+	// valid signature
+	idService.On("ValidateSignature", signer, sig.PublicKey, sig.Signature, mock.Anything, mock.Anything).Return(nil).Once()
+	assert.NoError(t, v.Validate(nil, model))
+
+	// missing timestamp
+	sig.Timestamp = nil
+	assert.Error(t, v.Validate(nil, model))
+	idService.AssertExpectations(t)
+}
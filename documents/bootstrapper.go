@@ -5,7 +5,9 @@ import (
 	"github.com/centrifuge/go-centrifuge/bootstrap"
 	"github.com/centrifuge/go-centrifuge/config"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/forensics"
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/maintenance"
 	"github.com/centrifuge/go-centrifuge/queue"
 	"github.com/centrifuge/go-centrifuge/storage"
 	"github.com/centrifuge/go-centrifuge/transactions"
@@ -24,6 +26,12 @@ const (
 
 	// BootstrappedAnchorProcessor is the key to bootstrapped anchor processor
 	BootstrappedAnchorProcessor = "BootstrappedAnchorProcessor"
+
+	// BootstrappedProofNotary is the key to bootstrapped proof notary
+	BootstrappedProofNotary = "BootstrappedProofNotary"
+
+	// BootstrappedEventLog is the key to the event log of the documents
+	BootstrappedEventLog = "BootstrappedEventLog"
 )
 
 // Bootstrapper implements bootstrap.Bootstrapper.
@@ -38,7 +46,39 @@ func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		return ErrDocumentBootstrap
 	}
 
+	cfg, ok := ctx[bootstrap.BootstrappedConfig].(config.Configuration)
+	if !ok {
+		return errors.New("config not initialised")
+	}
+
+	err := SetTreeHashAlgorithm(cfg.GetTreeHashAlgorithm())
+	if err != nil {
+		return err
+	}
+
 	repo := NewDBRepository(ldb)
+	residencyDBs, _ := ctx[storage.BootstrappedResidencyDBs].(map[string]storage.Repository)
+	if len(residencyDBs) > 0 || cfg.GetDocumentEncryptionEnabled() {
+		cfgService, ok := ctx[config.BootstrappedConfigStorage].(config.Service)
+		if !ok {
+			return errors.New("config service not initialised")
+		}
+
+		repo = NewResidencyRepository(ldb, residencyDBs, cfgService)
+		if cfg.GetDocumentEncryptionEnabled() {
+			repo = NewEncryptedRepository(ldb, residencyDBs, cfgService)
+		}
+	}
+
+	// the storage used is accounted for every write, the writes are only limited if a quota is configured
+	quota := cfg.GetDocumentStorageQuota()
+	if quota < 0 {
+		return errors.New("invalid document storage quota: %d", quota)
+	}
+
+	usage := NewUsageTracker(repo, uint64(quota))
+	PublishUsageMetrics(usage)
+	repo = usage.Repository()
 
 	anchorRepo, ok := ctx[anchors.BootstrappedAnchorRepo].(anchors.AnchorRepository)
 	if !ok {
@@ -50,9 +90,33 @@ func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		return errors.New("identity service not initialized")
 	}
 
-	ctx[BootstrappedDocumentService] = DefaultService(repo, anchorRepo, registry, didService)
+	// the received documents failing the validation are not recorded if the forensics service is not bootstrapped
+	forensicsSrv, _ := ctx[forensics.BootstrappedService].(forensics.Service)
+	events := NewEventLog(ldb)
+
+	// the files of the attachments can't be uploaded if no IPFS node is configured
+	var pinner BlobPinner
+	if cfg.GetAttachmentsIPFSNodeURL() != "" {
+		pinner = NewIPFSPinner(cfg.GetAttachmentsIPFSNodeURL(), cfg.GetAttachmentsPinTimeout())
+	}
+
+	for scheme, path := range cfg.GetJSONSchemas() {
+		schema, err := LoadJSONSchema(path)
+		if err != nil {
+			return errors.New("failed to load JSON schema of %s: %v", scheme, err)
+		}
+
+		err = registry.RegisterJSONSchema(scheme, schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx[BootstrappedDocumentService] = DefaultService(repo, anchorRepo, registry, didService, cfg.GetAnchorGracePeriod(), cfg.GetAnchorLinkFormat(), cfg.GetAnchorTimestampTolerance(), cfg.GetImportMappings(), forensicsSrv, events, pinner, NewTemplateStore(ldb))
+	ctx[BootstrappedEventLog] = events
 	ctx[BootstrappedRegistry] = registry
 	ctx[BootstrappedDocumentRepository] = repo
+	ctx[BootstrappedStorageUsage] = usage
 	return nil
 }
 
@@ -96,8 +160,95 @@ func (PostBootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		return errors.New("identity service not initialized")
 	}
 
-	dp := DefaultProcessor(didService, p2pClient, anchorRepo, cfg)
+	docSrv, ok := ctx[BootstrappedDocumentService].(Service)
+	if !ok {
+		return errors.New("document service not initialised")
+	}
+
+	events, ok := ctx[BootstrappedEventLog].(*EventLog)
+	if !ok {
+		return errors.New("event log not initialised")
+	}
+
+	err := docSrv.RegisterHook(HookPostReceive, newHistorySyncHook(repo, anchorRepo, docSrv, p2pClient))
+	if err != nil {
+		return err
+	}
+
+	h := newHistorySync(repo, anchorRepo, docSrv, p2pClient)
+	err = docSrv.RegisterVersionFetcher(h)
+	if err != nil {
+		return err
+	}
+
+	requester, ok := ctx[bootstrap.BootstrappedPeer].(ProofRequester)
+	if !ok {
+		return errors.New("p2p proof requester not initialised")
+	}
+
+	err = docSrv.RegisterProofRequester(requester)
+	if err != nil {
+		return err
+	}
+
+	// the anchored documents are recovered, reindexed, reconciled with their anchors and the old versions pruned on demand,
+	// if the maintenance service is bootstrapped
+	if maintenanceSrv, ok := ctx[maintenance.BootstrappedService].(maintenance.Service); ok {
+		ldb, ok := ctx[storage.BootstrappedDB].(storage.Repository)
+		if !ok {
+			return ErrDocumentBootstrap
+		}
+
+		var collaborators []identity.DID
+		for _, c := range cfg.GetRecoveryCollaborators() {
+			did, err := identity.NewDIDFromString(c)
+			if err != nil {
+				return errors.New("invalid recovery collaborator: %v", err)
+			}
+
+			collaborators = append(collaborators, did)
+		}
+
+		err = maintenanceSrv.RegisterJob(newRecoveryJob(ldb, h, cfg.GetRecoveryFromBlock(), collaborators))
+		if err != nil {
+			return err
+		}
+
+		err = maintenanceSrv.RegisterJob(newReindexJob(repo))
+		if err != nil {
+			return err
+		}
+
+		err = maintenanceSrv.RegisterJob(newReconcileAnchorsJob(repo, anchorRepo))
+		if err != nil {
+			return err
+		}
+
+		err = maintenanceSrv.RegisterJob(newRetentionJob(repo, RetentionPolicy{
+			KeepVersions: cfg.GetRetentionKeepVersions(),
+			MaxAge:       cfg.GetRetentionMaxAge(),
+			ArchivePath:  cfg.GetRetentionArchivePath(),
+		}))
+		if err != nil {
+			return err
+		}
+	}
+
+	var notaryID *identity.DID
+	if cfg.GetNotaryID() != "" {
+		did, err := identity.NewDIDFromString(cfg.GetNotaryID())
+		if err != nil {
+			return errors.New("invalid notary ID: %v", err)
+		}
+
+		notaryID = &did
+	}
+
+	// the validators registered per document type are not run if the registry is not bootstrapped
+	registry, _ := ctx[BootstrappedRegistry].(*ServiceRegistry)
+	dp := DefaultProcessor(didService, p2pClient, anchorRepo, cfg, registry)
 	ctx[BootstrappedAnchorProcessor] = dp
+	ctx[BootstrappedProofNotary] = NewProofNotary(notaryID, p2pClient, didService)
 
 	txMan := ctx[transactions.BootstrappedService].(transactions.Manager)
 	anchorTask := &documentAnchorTask{
@@ -108,6 +259,8 @@ func (PostBootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		processor:     dp,
 		modelGetFunc:  repo.Get,
 		modelSaveFunc: repo.Update,
+		hooksRunFunc:  docSrv.RunHooks,
+		events:        events,
 	}
 
 	queueSrv.RegisterTaskType(documentAnchorTaskName, anchorTask)
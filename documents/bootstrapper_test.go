@@ -4,6 +4,7 @@ package documents
 
 import (
 	"testing"
+	"time"
 
 	"github.com/centrifuge/go-centrifuge/identity"
 
@@ -25,7 +26,17 @@ func TestBootstrapper_Bootstrap(t *testing.T) {
 	db, err := leveldb.NewLevelDBStorage(randomPath)
 	assert.Nil(t, err)
 	repo := leveldb.NewLevelDBRepository(db)
-	ctx[bootstrap.BootstrappedConfig] = &testingconfig.MockConfig{}
+	cfg := new(testingconfig.MockConfig)
+	cfg.On("GetAnchorGracePeriod").Return(time.Duration(0))
+	cfg.On("GetAnchorLinkFormat").Return("")
+	cfg.On("GetAnchorTimestampTolerance").Return(time.Duration(0))
+	cfg.On("GetTreeHashAlgorithm").Return(TreeHashAlgorithm())
+	cfg.On("GetDocumentEncryptionEnabled").Return(false)
+	cfg.On("GetImportMappings").Return(map[string]map[string]string(nil))
+	cfg.On("GetJSONSchemas").Return(map[string]string(nil))
+	cfg.On("GetDocumentStorageQuota").Return(0)
+	cfg.On("GetAttachmentsIPFSNodeURL").Return("")
+	ctx[bootstrap.BootstrappedConfig] = cfg
 	ctx[storage.BootstrappedDB] = repo
 	ctx[transactions.BootstrappedService] = txv1.NewManager(&testingconfig.MockConfig{}, txv1.NewRepository(repo))
 	ctx[anchors.BootstrappedAnchorRepo] = new(testinganchors.MockAnchorRepo)
@@ -36,4 +47,9 @@ func TestBootstrapper_Bootstrap(t *testing.T) {
 	assert.NotNil(t, ctx[BootstrappedRegistry])
 	_, ok := ctx[BootstrappedRegistry].(*ServiceRegistry)
 	assert.True(t, ok)
+	_, ok = ctx[BootstrappedEventLog].(*EventLog)
+	assert.True(t, ok)
+	_, ok = ctx[BootstrappedStorageUsage].(*UsageTracker)
+	assert.True(t, ok)
+	cfg.AssertExpectations(t)
 }
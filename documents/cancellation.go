@@ -0,0 +1,95 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Cancel returns the final version of the core document, cancelling the document.
+// If supersededBy is set, the document is superseded by the document with that ID.
+// The cancellation is part of the cd_tree, so collaborators can prove it against the anchored root of the final version.
+func (cd *CoreDocument) Cancel(supersededBy []byte) (*CoreDocument, error) {
+	if cd.Cancelled() {
+		return nil, errors.NewTypedError(ErrDocumentCancelled, errors.New("document %s", hexutil.Encode(cd.ID())))
+	}
+
+	if len(supersededBy) > 0 {
+		if len(supersededBy) != idSize {
+			return nil, errors.New("invalid superseding document identifier length %d", len(supersededBy))
+		}
+
+		if bytes.Equal(supersededBy, cd.ID()) {
+			return nil, errors.New("document can't supersede itself")
+		}
+	}
+
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{}, false, nil)
+	if err != nil {
+		return nil, errors.New("failed to prepare new version: %v", err)
+	}
+
+	ncd.Extension.CancelledAt, err = utils.ToTimestamp(time.Now().UTC())
+	if err != nil {
+		return nil, errors.New("failed to set the cancellation time: %v", err)
+	}
+
+	ncd.Extension.SupersededBy = supersededBy
+	return ncd, ncd.setSalts()
+}
+
+// Cancelled returns true if the document was cancelled by this version.
+func (cd *CoreDocument) Cancelled() bool {
+	return cd.Extension.CancelledAt != nil
+}
+
+// SupersededBy returns the ID of the document superseding the cancelled document, nil if it is not superseded.
+func (cd *CoreDocument) SupersededBy() []byte {
+	return cd.Extension.SupersededBy
+}
+
+// cancellable is implemented by the models that can be cancelled.
+type cancellable interface {
+	Cancelled() bool
+	SupersededBy() []byte
+}
+
+// checkNotCancelled returns ErrDocumentCancelled if the model is a cancelled version, no version can follow it.
+func checkNotCancelled(model Model) error {
+	c, ok := model.(cancellable)
+	if !ok || !c.Cancelled() {
+		return nil
+	}
+
+	if len(c.SupersededBy()) > 0 {
+		return errors.NewTypedError(ErrDocumentCancelled, errors.New("document is superseded by %s", hexutil.Encode(c.SupersededBy())))
+	}
+
+	return errors.NewTypedError(ErrDocumentCancelled, errors.New("document %s", hexutil.Encode(model.ID())))
+}
+
+// Cancel cancels the document, optionally superseded by the document with supersededBy, and anchors the final version.
+// No further versions of the document are accepted once cancelled.
+func (s service) Cancel(ctx context.Context, documentID, supersededBy []byte) (Model, transactions.TxID, chan bool, error) {
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	err = checkNotCancelled(model)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	err = model.Cancel(supersededBy)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return s.Update(ctx, model)
+}
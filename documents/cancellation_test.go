@@ -0,0 +1,90 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// cancellableModel is a model holding the cancellation of the core document.
+type cancellableModel struct {
+	Model
+	cd *CoreDocument
+}
+
+func (m cancellableModel) ID() []byte {
+	return m.cd.ID()
+}
+
+func (m cancellableModel) Cancelled() bool {
+	return m.cd.Cancelled()
+}
+
+func (m cancellableModel) SupersededBy() []byte {
+	return m.cd.SupersededBy()
+}
+
+func TestCoreDocument_Cancel(t *testing.T) {
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(idSize)
+
+	// invalid replacements
+	_, err = cd.Cancel(utils.RandomSlice(20))
+	assert.Error(t, err)
+	_, err = cd.Cancel(cd.ID())
+	assert.Error(t, err)
+
+	// cancelled without replacement
+	ncd, err := cd.Cancel(nil)
+	assert.NoError(t, err)
+	assert.False(t, cd.Cancelled())
+	assert.True(t, ncd.Cancelled())
+	assert.Nil(t, ncd.SupersededBy())
+	assert.Equal(t, cd.NextVersion(), ncd.CurrentVersion())
+
+	// a cancelled document can't be cancelled again
+	ncd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	_, err = ncd.Cancel(nil)
+	assert.True(t, errors.IsOfType(ErrDocumentCancelled, err))
+
+	// superseded
+	replacement := utils.RandomSlice(idSize)
+	ncd, err = cd.Cancel(replacement)
+	assert.NoError(t, err)
+	assert.True(t, ncd.Cancelled())
+	assert.Equal(t, replacement, ncd.SupersededBy())
+}
+
+func TestCheckNotCancelled(t *testing.T) {
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(idSize)
+
+	// models that can't be cancelled and documents that are not cancelled
+	assert.NoError(t, checkNotCancelled(new(mockModel)))
+	assert.NoError(t, checkNotCancelled(cancellableModel{cd: cd}))
+
+	ncd, err := cd.Cancel(nil)
+	assert.NoError(t, err)
+	err = checkNotCancelled(cancellableModel{cd: ncd})
+	assert.True(t, errors.IsOfType(ErrDocumentCancelled, err))
+
+	replacement := utils.RandomSlice(idSize)
+	ncd, err = cd.Cancel(replacement)
+	assert.NoError(t, err)
+	err = checkNotCancelled(cancellableModel{cd: ncd})
+	assert.True(t, errors.IsOfType(ErrDocumentCancelled, err))
+	assert.Contains(t, err.Error(), "superseded")
+
+	// no version follows a cancelled document
+	err = UpdateVersionValidator().Validate(cancellableModel{cd: ncd}, new(mockModel))
+	assert.True(t, errors.IsOfType(ErrDocumentCancelled, err))
+	err = transitionValidator(testingidentity.GenerateRandomDID()).Validate(cancellableModel{cd: ncd}, new(mockModel))
+	assert.True(t, errors.IsOfType(ErrDocumentCancelled, err))
+}
@@ -0,0 +1,77 @@
+package documents
+
+import (
+	"strings"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/utils"
+)
+
+// currencyCodes are the active ISO 4217 currency codes, excluding the testing and no currency codes.
+var currencyCodes = codeSet(`
+AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB BOV BRL BSD BTN BWP BYN BZD
+CAD CDF CHE CHF CHW CLF CLP CNY COP COU CRC CUC CUP CVE CZK DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP
+GBP GEL GHS GIP GMD GNF GTQ GYD HKD HNL HTG HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES KGS KHR
+KMF KPW KRW KWD KYD KZT LAK LBP LKR LRD LSL LYD MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV
+MYR MZN NAD NGN NIO NOK NPR NZD OMR PAB PEN PGK PHP PKR PLN PYG QAR RON RSD RUB RWF SAR SBD SCR SDG
+SEK SGD SHP SLE SOS SRD SSP STN SVC SYP SZL THB TJS TMT TND TOP TRY TTD TWD TZS UAH UGX USD USN UYI
+UYU UYW UZS VED VES VND VUV WST XAF XAG XAU XBA XBB XBC XBD XCD XDR XOF XPD XPF XPT XSU XUA YER ZAR ZMW
+ZWL`)
+
+// countryCodes are the officially assigned ISO 3166-1 alpha-2 country codes.
+var countryCodes = codeSet(`
+AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV
+BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES
+ET FI FJ FK FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU ID IE
+IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY
+MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU
+NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM
+SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE
+VG VI VN VU WF WS YE YT ZA ZM ZW`)
+
+func codeSet(codes string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, c := range strings.Fields(codes) {
+		set[c] = struct{}{}
+	}
+
+	return set
+}
+
+// validateCode checks the code against the table in strict mode and its length otherwise.
+// The error suggests the upper case code if only the case is wrong.
+func validateCode(field, standard, code string, length int, table map[string]struct{}, strict bool) error {
+	if !utils.IsStringOfLength(code, length) {
+		return errors.New("%s is invalid: %q is not a %d letter %s code", field, code, length, standard)
+	}
+
+	if !strict {
+		return nil
+	}
+
+	if _, ok := table[code]; ok {
+		return nil
+	}
+
+	if _, ok := table[strings.ToUpper(code)]; ok {
+		return errors.New("%s is invalid: %q is not an %s code, did you mean %q?", field, code, standard, strings.ToUpper(code))
+	}
+
+	return errors.New("%s is invalid: %q is not an %s code", field, code, standard)
+}
+
+// ValidateCurrency checks that the currency is a 3 letter code.
+// In strict mode the currency must be an active ISO 4217 code.
+func ValidateCurrency(cur string, strict bool) error {
+	return validateCode("currency", "ISO 4217", cur, 3, currencyCodes, strict)
+}
+
+// ValidateCountry checks that the country is empty or an assigned ISO 3166-1 alpha-2 code in strict mode.
+// The country is not checked otherwise, as it was free text before the strict mode.
+func ValidateCountry(country string, strict bool) error {
+	if country == "" || !strict {
+		return nil
+	}
+
+	return validateCode("country", "ISO 3166-1 alpha-2", country, 2, countryCodes, strict)
+}
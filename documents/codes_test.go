@@ -0,0 +1,67 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCurrency(t *testing.T) {
+	tests := []struct {
+		cur    string
+		strict bool
+		err    string
+	}{
+		{cur: "EUR"},
+		{cur: "EUR", strict: true},
+		{cur: "abc"},
+		{cur: "", err: `currency is invalid: "" is not a 3 letter ISO 4217 code`},
+		{cur: "EURO", strict: true, err: `currency is invalid: "EURO" is not a 3 letter ISO 4217 code`},
+		{cur: "ABC", strict: true, err: `currency is invalid: "ABC" is not an ISO 4217 code`},
+		{cur: "SLE", strict: true},
+		{cur: "VED", strict: true},
+		{cur: "HRK", strict: true, err: `currency is invalid: "HRK" is not an ISO 4217 code`},
+		{cur: "SLL", strict: true, err: `currency is invalid: "SLL" is not an ISO 4217 code`},
+		{cur: "usd", strict: true, err: `currency is invalid: "usd" is not an ISO 4217 code, did you mean "USD"?`},
+	}
+
+	for _, c := range tests {
+		err := ValidateCurrency(c.cur, c.strict)
+		if c.err == "" {
+			assert.NoError(t, err, c.cur)
+			continue
+		}
+
+		assert.EqualError(t, err, c.err)
+	}
+}
+
+func TestValidateCountry(t *testing.T) {
+	tests := []struct {
+		country string
+		strict  bool
+		err     string
+	}{
+		{country: ""},
+		{country: "", strict: true},
+		{country: "DE", strict: true},
+		{country: "ZZ"},
+		{country: "DEU"},
+		{country: "Germany"},
+		{country: "DEU", strict: true, err: `country is invalid: "DEU" is not a 2 letter ISO 3166-1 alpha-2 code`},
+		{country: "ZZ", strict: true, err: `country is invalid: "ZZ" is not an ISO 3166-1 alpha-2 code`},
+		{country: "gb", strict: true, err: `country is invalid: "gb" is not an ISO 3166-1 alpha-2 code, did you mean "GB"?`},
+	}
+
+	for _, c := range tests {
+		err := ValidateCountry(c.country, c.strict)
+		if c.err == "" {
+			assert.NoError(t, err, c.country)
+			continue
+		}
+
+		assert.EqualError(t, err, c.err)
+	}
+}
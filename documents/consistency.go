@@ -0,0 +1,101 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+)
+
+// FieldReference references a field of a version of a document, the latest version if VersionID is empty.
+type FieldReference struct {
+	DocumentID []byte
+	VersionID  []byte
+	Field      string
+}
+
+// ConsistencyProof proves that a field of a document equals a field of another document, such as the total of an
+// invoice and the principal of its funding agreement. It holds a proof bundle of each field, verifiable against the
+// anchored document roots, and the signature of the node over the equality assertion.
+type ConsistencyProof struct {
+	Left  *DocumentProof
+	Right *DocumentProof
+
+	// Signature is the signature of the node over the ConsistencyProofMessage of the proof
+	Signature *coredocumentpb.Signature
+}
+
+// ConsistencyProofMessage returns the message signed by the node asserting that the fields of the proof are equal.
+// The message is the sha256 hash of the messages of the left and the right proof bundles, which cover the values
+// of the fields, so the assertion can be checked on and off chain.
+func ConsistencyProofMessage(proof *ConsistencyProof) ([]byte, error) {
+	if proof.Left == nil || proof.Right == nil {
+		return nil, errors.New("consistency proof without field proofs")
+	}
+
+	left, err := ProofBundleMessage(proof.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := ProofBundleMessage(proof.Right)
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.Sum256(append(left, right...))
+	return h[:], nil
+}
+
+// CreateConsistencyProof proves that the left and the right fields have the same value and signs the assertion.
+// Both versions must be anchored, as for any proof. Values are compared by their proven encoding, so the fields should
+// be of the same type.
+func (s service) CreateConsistencyProof(ctx context.Context, left, right FieldReference) (*ConsistencyProof, error) {
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		return nil, ErrDocumentConfigAccountID
+	}
+
+	lp, err := s.fieldProof(ctx, left)
+	if err != nil {
+		return nil, err
+	}
+
+	rp, err := s.fieldProof(ctx, right)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(lp.FieldProofs[0].Value, rp.FieldProofs[0].Value) {
+		return nil, errors.NewTypedError(ErrInconsistentFields, errors.New("%s of %x and %s of %x", left.Field, lp.DocumentID, right.Field, rp.DocumentID))
+	}
+
+	proof := &ConsistencyProof{Left: lp, Right: rp}
+	msg, err := ConsistencyProofMessage(proof)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentProof, err)
+	}
+
+	proof.Signature, err = acc.SignMsg(msg)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentProof, err)
+	}
+
+	return proof, nil
+}
+
+// fieldProof returns the proof bundle of the referenced field.
+func (s service) fieldProof(ctx context.Context, ref FieldReference) (*DocumentProof, error) {
+	if ref.Field == "" {
+		return nil, errors.NewTypedError(ErrDocumentProof, errors.New("no field provided for document %x", ref.DocumentID))
+	}
+
+	if len(ref.VersionID) == 0 {
+		return s.CreateProofs(ctx, ref.DocumentID, []string{ref.Field})
+	}
+
+	return s.CreateProofsForVersion(ctx, ref.DocumentID, ref.VersionID, []string{ref.Field})
+}
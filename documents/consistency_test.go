@@ -0,0 +1,49 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsistencyProofMessage(t *testing.T) {
+	_, err := ConsistencyProofMessage(&ConsistencyProof{Left: testProofBundle(t)})
+	assert.Error(t, err)
+
+	left, right := testProofBundle(t), testProofBundle(t)
+	msg, err := ConsistencyProofMessage(&ConsistencyProof{Left: left, Right: right})
+	assert.NoError(t, err)
+	assert.Len(t, msg, 32)
+
+	// the order of the proofs is part of the message
+	msg1, err := ConsistencyProofMessage(&ConsistencyProof{Left: right, Right: left})
+	assert.NoError(t, err)
+	assert.NotEqual(t, msg, msg1)
+
+	// the field proofs are part of the message
+	left.FieldProofs = left.FieldProofs[1:]
+	msg1, err = ConsistencyProofMessage(&ConsistencyProof{Left: left, Right: right})
+	assert.NoError(t, err)
+	assert.NotEqual(t, msg, msg1)
+}
+
+func TestService_CreateConsistencyProof(t *testing.T) {
+	srv := service{}
+	left := FieldReference{DocumentID: utils.RandomSlice(32), Field: "invoice.gross_amount"}
+	right := FieldReference{DocumentID: utils.RandomSlice(32), Field: "funding_agreement.amount"}
+
+	// missing account
+	_, err := srv.CreateConsistencyProof(context.Background(), left, right)
+	assert.Equal(t, ErrDocumentConfigAccountID, err)
+
+	// missing field
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	_, err = srv.CreateConsistencyProof(ctx, FieldReference{DocumentID: left.DocumentID}, right)
+	assert.True(t, errors.IsOfType(ErrDocumentProof, err))
+}
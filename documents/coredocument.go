@@ -1,7 +1,7 @@
 package documents
 
 import (
-	"crypto/sha256"
+	"bytes"
 	"fmt"
 	"strings"
 	"time"
@@ -10,9 +10,11 @@ import (
 	"github.com/centrifuge/go-centrifuge/crypto"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/centrifuge/precise-proofs/proofs"
 	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
 )
 
@@ -49,6 +51,9 @@ const (
 
 	// SignaturesTreePrefix is the human readable prefix for signature props
 	SignaturesTreePrefix = "signatures_tree"
+
+	// DocumentTypeProofField is the field to request to prove the document type of a document
+	DocumentTypeProofField = CDTreePrefix + "." + DocumentTypeField
 )
 
 func compactProperties(key string) []byte {
@@ -71,6 +76,60 @@ func compactProperties(key string) []byte {
 // CoreDocument is a wrapper for CoreDocument Protobuf.
 type CoreDocument struct {
 	Document coredocumentpb.CoreDocument
+
+	// Extension holds the fields the centrifuge-protobufs core document doesn't have.
+	// It is serialised into the unknown fields of the packed core document, the Document keeps the other unknown fields.
+	Extension coredocumentextpb.CoreDocumentExtension
+
+	// DocumentStatus is the local lifecycle status of the version, it is not shared with the collaborators.
+	DocumentStatus Status
+
+	// RemovedCollaborators are the collaborators removed by the author of the version.
+	// They are part of the local update log of the version, the collaborators see the removal in the roles only.
+	RemovedCollaborators []identity.DID
+
+	// trees caches the trees generated from the document
+	trees treeCache
+}
+
+// CollaboratorsAccess holds the collaborators of a document version by their access.
+type CollaboratorsAccess struct {
+	// ReadCollaborators can read the document, they don't sign and can't update it
+	ReadCollaborators []string
+
+	// ReadWriteCollaborators can read, sign and update the document
+	ReadWriteCollaborators []string
+
+	// RequiredSignatures is the number of signatures the read write collaborators must add to every version,
+	// signing with several keys if they are fewer. 0 doesn't require any signature.
+	RequiredSignatures uint32
+}
+
+// NewCollaboratorsAccess returns the access of the collaborators of a client payload.
+// The collaborators and the write access collaborators get read and write access.
+func NewCollaboratorsAccess(collaborators, readAccess, writeAccess []string) CollaboratorsAccess {
+	var rwcs []string
+	rwcs = append(rwcs, collaborators...)
+	rwcs = append(rwcs, writeAccess...)
+	return CollaboratorsAccess{
+		ReadCollaborators:      readAccess,
+		ReadWriteCollaborators: rwcs,
+	}
+}
+
+// dids decodes the collaborators. Read collaborators who also have write access are dropped from the read collaborators.
+func (ca CollaboratorsAccess) dids() (rcs, rwcs []identity.DID, err error) {
+	rwcs, err = identity.NewDIDsFromStrings(ca.ReadWriteCollaborators)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rcs, err = identity.NewDIDsFromStrings(ca.ReadCollaborators)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return filterCollaborators(rcs, rwcs...), rwcs, nil
 }
 
 // newCoreDocument returns a new CoreDocument.
@@ -83,31 +142,42 @@ func newCoreDocument() (*CoreDocument, error) {
 		return nil, err
 	}
 
-	return &CoreDocument{cd}, nil
+	return &CoreDocument{Document: cd, DocumentStatus: StatusDraft}, nil
 }
 
 // NewCoreDocumentFromProtobuf returns CoreDocument from the CoreDocument Protobuf.
-func NewCoreDocumentFromProtobuf(cd coredocumentpb.CoreDocument) *CoreDocument {
+// The extension is read from the unknown fields of the core document.
+func NewCoreDocumentFromProtobuf(cd coredocumentpb.CoreDocument) (*CoreDocument, error) {
+	var ext coredocumentextpb.CoreDocumentExtension
+	err := proto.Unmarshal(cd.XXX_unrecognized, &ext)
+	if err != nil {
+		return nil, errors.New("failed to decode the core document extension: %v", err)
+	}
+
 	cd.EmbeddedDataSalts = nil
 	cd.EmbeddedData = nil
-	return &CoreDocument{Document: cd}
+	cd.XXX_unrecognized = ext.XXX_unrecognized
+	ext.XXX_unrecognized = nil
+	return &CoreDocument{Document: cd, Extension: ext}, nil
 }
 
 // NewCoreDocumentWithCollaborators generates new core Document with a document type specified by the prefix: po or invoice.
 // It then adds collaborators, adds read rules and fills salts.
-func NewCoreDocumentWithCollaborators(collaborators []string, documentPrefix []byte) (*CoreDocument, error) {
+// Read and write collaborators get read sign and transition rules, read collaborators get read rules only.
+func NewCoreDocumentWithCollaborators(collaborators CollaboratorsAccess, documentPrefix []byte) (*CoreDocument, error) {
 	cd, err := newCoreDocument()
 	if err != nil {
 		return nil, errors.New("failed to create coredoc: %v", err)
 	}
 
-	ids, err := identity.NewDIDsFromStrings(collaborators)
+	rcs, rwcs, err := collaborators.dids()
 	if err != nil {
 		return nil, errors.New("failed to decode collaborators: %v", err)
 	}
 
-	cd.initReadRules(ids)
-	cd.initTransitionRules(ids, documentPrefix)
+	cd.initReadRules(rwcs, collaborators.RequiredSignatures)
+	cd.initTransitionRules(rwcs, documentPrefix)
+	cd.addCollaboratorsToReadRules(rcs)
 	if err := cd.setSalts(); err != nil {
 		return nil, err
 	}
@@ -160,7 +230,7 @@ func (cd *CoreDocument) setSalts() error {
 		return nil
 	}
 
-	pSalts, err := GenerateNewSalts(&cd.Document, CDTreePrefix, compactProperties(CDTreePrefix))
+	pSalts, err := GenerateNewSalts()
 	if err != nil {
 		return err
 	}
@@ -169,14 +239,37 @@ func (cd *CoreDocument) setSalts() error {
 	return nil
 }
 
+// documentTypeCompact returns the compact property of the document type leaf.
+func documentTypeCompact() []byte {
+	return append(compactProperties(CDTreePrefix), compactProperties(DocumentTypeField)...)
+}
+
+// documentTypeSalt returns the salt of the document type leaf.
+// Document type is not a field of the core document, so documents listing a salt per leaf list its salt separately.
+// Documents salted before the document type was salted use a zero salt.
+func (cd *CoreDocument) documentTypeSalt() []byte {
+	compact := documentTypeCompact()
+	if secret := saltSecret(ConvertToProofSalts(cd.Document.CoredocumentSalts)); secret != nil {
+		return DeriveSalt(secret, compact)
+	}
+
+	for _, salt := range cd.Document.CoredocumentSalts {
+		if bytes.Equal(salt.Compact, compact) {
+			return salt.Value
+		}
+	}
+
+	return make([]byte, idSize)
+}
+
 // PrepareNewVersion prepares the next version of the CoreDocument
 // if initSalts is true, salts will be generated for new version.
-func (cd *CoreDocument) PrepareNewVersion(collaborators []string, initSalts bool, documentPrefix []byte) (*CoreDocument, error) {
+func (cd *CoreDocument) PrepareNewVersion(collaborators CollaboratorsAccess, initSalts bool, documentPrefix []byte) (*CoreDocument, error) {
 	if len(cd.Document.DocumentRoot) != idSize {
 		return nil, errors.New("Document root is invalid")
 	}
 
-	cs, err := identity.NewDIDsFromStrings(collaborators)
+	rcs, rwcs, err := collaborators.dids()
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +280,14 @@ func (cd *CoreDocument) PrepareNewVersion(collaborators []string, initSalts bool
 		return nil, err
 	}
 
-	ucs := filterCollaborators(cs, oldCs...)
+	// read collaborators given write access become signers
+	oldSigners, err := cd.GetSignerCollaborators()
+	if err != nil {
+		return nil, err
+	}
+
+	urwcs := filterCollaborators(rwcs, oldSigners...)
+	urcs := filterCollaborators(rcs, oldCs...)
 	cdp := coredocumentpb.CoreDocument{
 		DocumentIdentifier: cd.Document.DocumentIdentifier,
 		PreviousRoot:       cd.Document.DocumentRoot,
@@ -204,9 +304,20 @@ func (cd *CoreDocument) PrepareNewVersion(collaborators []string, initSalts bool
 		return nil, err
 	}
 
-	ncd := &CoreDocument{Document: cdp}
-	ncd.addCollaboratorsToReadSignRules(ucs)
-	ncd.addCollaboratorsToTransitionRules(ucs, documentPrefix)
+	ext := coredocumentextpb.CoreDocumentExtension{
+		LinkedDocuments:     cd.Extension.LinkedDocuments,
+		AccessTokenExpiries: cd.Extension.AccessTokenExpiries,
+		AttributeSignatures: cd.Extension.AttributeSignatures,
+		TransferDetails:     cd.Extension.TransferDetails,
+		Attachments:         cd.Extension.Attachments,
+		Tags:                cd.Extension.Tags,
+		ReadRuleSignatures:  cd.Extension.ReadRuleSignatures,
+	}
+
+	ncd := &CoreDocument{Document: cdp, Extension: ext, DocumentStatus: StatusDraft}
+	ncd.addCollaboratorsToReadSignRules(urwcs, collaborators.RequiredSignatures)
+	ncd.addCollaboratorsToTransitionRules(urwcs, documentPrefix)
+	ncd.addCollaboratorsToReadRules(urcs)
 
 	if !initSalts {
 		return ncd, nil
@@ -220,6 +331,54 @@ func (cd *CoreDocument) PrepareNewVersion(collaborators []string, initSalts bool
 	return ncd, nil
 }
 
+// RemoveCollaborators returns the next version of the core document without the collaborators in its roles, revoking
+// their read and transition rules. The removed collaborators don't receive the next versions of the document.
+func (cd *CoreDocument) RemoveCollaborators(collaborators []identity.DID) (*CoreDocument, error) {
+	if len(collaborators) == 0 {
+		return nil, ErrEmptyCollabs
+	}
+
+	cs, err := cd.GetCollaborators()
+	if err != nil {
+		return nil, err
+	}
+
+	if unknown := filterCollaborators(collaborators, cs...); len(unknown) > 0 {
+		return nil, errors.New("%s is not a collaborator of the document", unknown[0].String())
+	}
+
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{}, false, nil)
+	if err != nil {
+		return nil, errors.New("failed to prepare new version: %v", err)
+	}
+
+	remove := make(map[identity.DID]bool)
+	for _, c := range collaborators {
+		remove[c] = true
+	}
+
+	// roles are shared with the previous version, replace instead of updating in place
+	var roles []*coredocumentpb.Role
+	for _, role := range ncd.Document.Roles {
+		var rcs [][]byte
+		for _, c := range role.Collaborators {
+			if !remove[identity.NewDIDFromBytes(c)] {
+				rcs = append(rcs, c)
+			}
+		}
+
+		if len(rcs) != len(role.Collaborators) {
+			role = &coredocumentpb.Role{RoleKey: role.RoleKey, Collaborators: rcs, Nfts: role.Nfts}
+		}
+
+		roles = append(roles, role)
+	}
+
+	ncd.Document.Roles = roles
+	ncd.RemovedCollaborators = collaborators
+	return ncd, ncd.setSalts()
+}
+
 // newRole returns a new role with random role key
 func newRole() *coredocumentpb.Role {
 	return &coredocumentpb.Role{RoleKey: utils.RandomSlice(idSize)}
@@ -353,7 +512,7 @@ func (cd *CoreDocument) GetSignaturesRootHash() (hash []byte, err error) {
 // This is no-op if the salts are already generated.
 func (cd *CoreDocument) setSignatureDataSalts() ([]*coredocumentpb.DocumentSalt, error) {
 	if cd.Document.SignatureDataSalts == nil {
-		proofSalts, err := GenerateNewSalts(cd.Document.SignatureData, SignaturesTreePrefix, compactProperties(SignaturesTreePrefix))
+		proofSalts, err := GenerateNewSalts()
 		if err != nil {
 			return nil, err
 		}
@@ -363,14 +522,28 @@ func (cd *CoreDocument) setSignatureDataSalts() ([]*coredocumentpb.DocumentSalt,
 }
 
 // getSignatureDataTree returns the merkle tree for the Signature Data root.
+// The tree is cached until the signature data or its salts change.
 func (cd *CoreDocument) getSignatureDataTree() (*proofs.DocumentTree, error) {
 	signatureSalts, err := cd.setSignatureDataSalts()
 	if err != nil {
 		return nil, err
 	}
+
+	data, err := marshalInput(&coredocumentpb.CoreDocument{SignatureData: cd.Document.SignatureData, SignatureDataSalts: signatureSalts})
+	if err != nil {
+		return nil, err
+	}
+
+	return cd.trees.tree(SignaturesTreePrefix, [][]byte{data}, func() (*proofs.DocumentTree, error) {
+		return cd.generateSignatureDataTree(signatureSalts)
+	})
+}
+
+// generateSignatureDataTree generates the merkle tree for the Signature Data root.
+func (cd *CoreDocument) generateSignatureDataTree(signatureSalts []*coredocumentpb.DocumentSalt) (*proofs.DocumentTree, error) {
 	tree := NewDefaultTreeWithPrefix(ConvertToProofSalts(signatureSalts), SignaturesTreePrefix, compactProperties(SignaturesTreePrefix))
 
-	err = tree.AddLeavesFromDocument(cd.Document.SignatureData)
+	err := tree.AddLeavesFromDocument(cd.Document.SignatureData)
 	if err != nil {
 		return nil, err
 	}
@@ -383,11 +556,24 @@ func (cd *CoreDocument) getSignatureDataTree() (*proofs.DocumentTree, error) {
 }
 
 // DocumentRootTree returns the merkle tree for the Document root.
+// The tree is cached until the signing root or the signature data change, it is shared and must not be modified.
 func (cd *CoreDocument) DocumentRootTree() (tree *proofs.DocumentTree, err error) {
 	if len(cd.Document.SigningRoot) != idSize {
 		return nil, errors.New("signing root is invalid")
 	}
 
+	signatureTree, err := cd.getSignatureDataTree()
+	if err != nil {
+		return nil, err
+	}
+
+	return cd.trees.tree(DRTreePrefix, [][]byte{cd.Document.SigningRoot, signatureTree.RootHash()}, func() (*proofs.DocumentTree, error) {
+		return cd.generateDocumentRootTree(signatureTree)
+	})
+}
+
+// generateDocumentRootTree generates the merkle tree for the Document root from the signing root and the signature data tree.
+func (cd *CoreDocument) generateDocumentRootTree(signatureTree *proofs.DocumentTree) (tree *proofs.DocumentTree, err error) {
 	tree = NewDefaultTreeWithPrefix(ConvertToProofSalts(cd.Document.CoredocumentSalts), DRTreePrefix, compactProperties(DRTreePrefix))
 
 	// The first leave added is the signing_root
@@ -400,10 +586,6 @@ func (cd *CoreDocument) DocumentRootTree() (tree *proofs.DocumentTree, err error
 	}
 
 	// Second leaf from the signature data tree
-	signatureTree, err := cd.getSignatureDataTree()
-	if err != nil {
-		return nil, err
-	}
 	err = tree.AddLeaf(proofs.LeafNode{
 		Hash:     signatureTree.RootHash(),
 		Hashed:   true,
@@ -459,22 +641,55 @@ func (cd *CoreDocument) signingRootTree(docType string) (tree *proofs.DocumentTr
 }
 
 // documentTree returns the merkle tree of the core Document.
-func (cd *CoreDocument) documentTree(docType string) (tree *proofs.DocumentTree, err error) {
+// The tree is cached until the core Document, its extension or the document type change.
+func (cd *CoreDocument) documentTree(docType string) (*proofs.DocumentTree, error) {
+	data, err := marshalInput(&cd.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	extData, err := marshalInput(&cd.Extension)
+	if err != nil {
+		return nil, err
+	}
+
+	return cd.trees.tree(CDTreePrefix, [][]byte{[]byte(docType), data, extData}, func() (*proofs.DocumentTree, error) {
+		return cd.generateDocumentTree(docType)
+	})
+}
+
+// coreDocumentLeaves returns the number of leaves of the core Document tree.
+func (cd *CoreDocument) coreDocumentLeaves(docType string) (int, error) {
+	tree, err := cd.documentTree(docType)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(tree.PropertyOrder()), nil
+}
+
+// generateDocumentTree generates the merkle tree of the core Document.
+func (cd *CoreDocument) generateDocumentTree(docType string) (tree *proofs.DocumentTree, err error) {
 	tree = NewDefaultTreeWithPrefix(ConvertToProofSalts(cd.Document.CoredocumentSalts), CDTreePrefix, compactProperties(CDTreePrefix))
 	err = tree.AddLeavesFromDocument(&cd.Document)
 	if err != nil {
 		return nil, err
 	}
 
-	dtProp := NewLeafProperty(fmt.Sprintf("%s.%s", CDTreePrefix, DocumentTypeField), append(compactProperties(CDTreePrefix), compactProperties(DocumentTypeField)...))
+	err = tree.AddLeavesFromDocument(&cd.Extension)
+	if err != nil {
+		return nil, err
+	}
+
+	dtProp := NewLeafProperty(DocumentTypeProofField, documentTypeCompact())
 	// Adding document type as it is an excluded field in the tree
 	documentTypeNode := proofs.LeafNode{
 		Property: dtProp,
-		Salt:     make([]byte, 32),
+		Salt:     cd.documentTypeSalt(),
 		Value:    []byte(docType),
 	}
 
-	err = documentTypeNode.HashNode(sha256.New(), true)
+	err = documentTypeNode.HashNode(newTreeHash(), true)
 	if err != nil {
 		return nil, err
 	}
@@ -517,6 +732,8 @@ func (cd *CoreDocument) GetCollaborators(filterIDs ...identity.DID) ([]identity.
 
 // getCollaborators returns all the collaborators who belongs to the actions passed.
 func (cd *CoreDocument) getCollaborators(actions ...coredocumentpb.Action) (ids []identity.DID, err error) {
+	// a read collaborator given write access later is in a read and a read sign role
+	seen := make(map[identity.DID]bool)
 	findRole(cd.Document, func(_, _ int, role *coredocumentpb.Role) bool {
 		if len(role.Collaborators) < 1 {
 			return false
@@ -525,7 +742,13 @@ func (cd *CoreDocument) getCollaborators(actions ...coredocumentpb.Action) (ids
 		for _, c := range role.Collaborators {
 			// TODO(ved): we should ideally check the address length of 20
 			// we will still keep the error return to the function so that once check is in, we don't have to refactor this function
-			ids = append(ids, identity.NewDIDFromBytes(c))
+			id := identity.NewDIDFromBytes(c)
+			if seen[id] {
+				continue
+			}
+
+			seen[id] = true
+			ids = append(ids, id)
 		}
 
 		return false
@@ -585,12 +808,19 @@ func (cd *CoreDocument) CalculateSigningRoot(docType string) ([]byte, error) {
 }
 
 // PackCoreDocument prepares the document into a core document.
-func (cd *CoreDocument) PackCoreDocument(data *any.Any, salts []*coredocumentpb.DocumentSalt) coredocumentpb.CoreDocument {
+// The extension is serialised into the unknown fields of the core document, ahead of the fields of newer schemas.
+func (cd *CoreDocument) PackCoreDocument(data *any.Any, salts []*coredocumentpb.DocumentSalt) (coredocumentpb.CoreDocument, error) {
+	extData, err := proto.Marshal(&cd.Extension)
+	if err != nil {
+		return coredocumentpb.CoreDocument{}, errors.New("failed to serialise the core document extension: %v", err)
+	}
+
 	// lets copy the value so that mutations on the returned doc wont be reflected on Document we are holding
 	cdp := cd.Document
 	cdp.EmbeddedData = data
 	cdp.EmbeddedDataSalts = salts
-	return cdp
+	cdp.XXX_unrecognized = append(extData, cd.Document.XXX_unrecognized...)
+	return cdp, nil
 }
 
 // Signatures returns the copy of the signatures on the Document.
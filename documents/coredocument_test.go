@@ -26,6 +26,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/centrifuge/precise-proofs/proofs"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/stretchr/testify/assert"
 )
@@ -120,7 +121,7 @@ func TestCoreDocument_PrepareNewVersion(t *testing.T) {
 	// missing DocumentRoot
 	c1 := testingidentity.GenerateRandomDID()
 	c2 := testingidentity.GenerateRandomDID()
-	c := []string{c1.String(), c2.String()}
+	c := CollaboratorsAccess{ReadWriteCollaborators: []string{c1.String(), c2.String()}}
 	ncd, err := cd.PrepareNewVersion(c, false, nil)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "Document root is invalid")
@@ -128,7 +129,7 @@ func TestCoreDocument_PrepareNewVersion(t *testing.T) {
 
 	//collaborators need to be hex string
 	cd.Document.DocumentRoot = utils.RandomSlice(32)
-	collabs := []string{"some ID"}
+	collabs := CollaboratorsAccess{ReadWriteCollaborators: []string{"some ID"}}
 	ncd, err = cd.PrepareNewVersion(collabs, false, nil)
 	assert.Error(t, err)
 	assert.True(t, errors.IsOfType(identity.ErrMalformedAddress, err))
@@ -178,6 +179,83 @@ func TestCoreDocument_PrepareNewVersion(t *testing.T) {
 	assert.Equal(t, ncd.Document.Roles[1].Collaborators[1], c2[:])
 }
 
+func TestCoreDocument_CollaboratorsAccess(t *testing.T) {
+	c1 := testingidentity.GenerateRandomDID()
+	c2 := testingidentity.GenerateRandomDID()
+	c3 := testingidentity.GenerateRandomDID()
+	c4 := testingidentity.GenerateRandomDID()
+
+	ca := NewCollaboratorsAccess([]string{c2.String()}, []string{c1.String(), c3.String()}, []string{c3.String()})
+	assert.Equal(t, []string{c1.String(), c3.String()}, ca.ReadCollaborators)
+	assert.Equal(t, []string{c2.String(), c3.String()}, ca.ReadWriteCollaborators)
+
+	// c3 has write access
+	cd, err := NewCoreDocumentWithCollaborators(ca, []byte{1, 0, 0, 0})
+	assert.NoError(t, err)
+	cs, err := cd.GetSignerCollaborators()
+	assert.NoError(t, err)
+	assert.Equal(t, []identity.DID{c2, c3}, cs)
+	cs, err = cd.GetCollaborators()
+	assert.NoError(t, err)
+	assert.Len(t, cs, 3)
+	assert.Contains(t, cs, c1)
+	assert.True(t, cd.AccountCanRead(c1))
+	assert.Len(t, cd.TransitionRulesFor(c1), 0)
+	assert.Len(t, cd.TransitionRulesFor(c3), 2)
+
+	// invalid read collaborator
+	_, err = NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadCollaborators: []string{"some ID"}}, nil)
+	assert.Error(t, err)
+
+	// c1 is given write access, c4 read access
+	cd.Document.DocumentRoot = utils.RandomSlice(32)
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{ReadCollaborators: []string{c2.String(), c4.String()}, ReadWriteCollaborators: []string{c1.String()}}, true, []byte{1, 0, 0, 0})
+	assert.NoError(t, err)
+	cs, err = ncd.GetSignerCollaborators()
+	assert.NoError(t, err)
+	assert.Equal(t, []identity.DID{c2, c3, c1}, cs)
+	assert.Len(t, ncd.TransitionRulesFor(c1), 2)
+	assert.True(t, ncd.AccountCanRead(c4))
+	assert.Len(t, ncd.TransitionRulesFor(c4), 0)
+	cs, err = ncd.GetCollaborators()
+	assert.NoError(t, err)
+	assert.Equal(t, []identity.DID{c2, c3, c1, c4}, cs)
+}
+
+func TestCoreDocument_RemoveCollaborators(t *testing.T) {
+	c1 := testingidentity.GenerateRandomDID()
+	c2 := testingidentity.GenerateRandomDID()
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: []string{c1.String(), c2.String()}}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(32)
+
+	// no collaborators
+	_, err = cd.RemoveCollaborators(nil)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrEmptyCollabs, err))
+
+	// not a collaborator
+	_, err = cd.RemoveCollaborators([]identity.DID{testingidentity.GenerateRandomDID()})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a collaborator of the document")
+
+	ncd, err := cd.RemoveCollaborators([]identity.DID{c2})
+	assert.NoError(t, err)
+	assert.Equal(t, cd.Document.NextVersion, ncd.Document.CurrentVersion)
+	assert.Equal(t, []identity.DID{c2}, ncd.RemovedCollaborators)
+	assert.NotNil(t, ncd.Document.CoredocumentSalts)
+	cs, err := ncd.GetCollaborators()
+	assert.NoError(t, err)
+	assert.Equal(t, []identity.DID{c1}, cs)
+
+	// previous version is untouched
+	cs, err = cd.GetCollaborators()
+	assert.NoError(t, err)
+	assert.Len(t, cs, 2)
+	assert.Contains(t, cs, c2)
+	assert.Nil(t, cd.RemovedCollaborators)
+}
+
 func TestGetSigningProofHash(t *testing.T) {
 	docAny := &any.Any{
 		TypeUrl: documenttypes.InvoiceDataTypeUrl,
@@ -339,7 +417,7 @@ func TestCoreDocument_GenerateProofs(t *testing.T) {
 		{
 			CDTreePrefix + ".document_identifier",
 			true,
-			6,
+			7,
 		},
 		{
 			"prefix.sample_field2",
@@ -349,7 +427,7 @@ func TestCoreDocument_GenerateProofs(t *testing.T) {
 		{
 			CDTreePrefix + ".next_version",
 			true,
-			6,
+			7,
 		},
 	}
 	for _, test := range tests {
@@ -360,7 +438,7 @@ func TestCoreDocument_GenerateProofs(t *testing.T) {
 			var l *proofs.LeafNode
 			if test.fromCoreDoc {
 				_, l = cdTree.GetLeafByProperty(test.fieldName)
-				valid, err := proofs.ValidateProofSortedHashes(l.Hash, p[0].SortedHashes[:4], cdTree.RootHash(), h)
+				valid, err := proofs.ValidateProofSortedHashes(l.Hash, p[0].SortedHashes[:5], cdTree.RootHash(), h)
 				assert.NoError(t, err)
 				assert.True(t, valid)
 			} else {
@@ -384,15 +462,101 @@ func TestCoreDocument_setSalts(t *testing.T) {
 
 	assert.NoError(t, cd.setSalts())
 	salts := cd.Document.CoredocumentSalts
+	assert.Len(t, salts, 1)
 	assert.Nil(t, cd.setSalts())
 	assert.Equal(t, salts, cd.Document.CoredocumentSalts)
 }
 
+func TestCoreDocument_PackCoreDocument_extension(t *testing.T) {
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	cd, err = cd.AddLink(utils.RandomSlice(idSize), utils.RandomSlice(idSize))
+	assert.NoError(t, err)
+	cdTree, err := cd.documentTree(documenttypes.InvoiceDataTypeUrl)
+	assert.NoError(t, err)
+
+	// field 1000 of a newer schema
+	unknown := append(proto.EncodeVarint(1000<<3|2), proto.EncodeVarint(9)...)
+	unknown = append(unknown, []byte("new field")...)
+	cd.Document.XXX_unrecognized = unknown
+
+	// the extension is an unknown field of the core document for the nodes that don't know it
+	cdp, err := cd.PackCoreDocument(nil, nil)
+	assert.NoError(t, err)
+	data, err := proto.Marshal(&cdp)
+	assert.NoError(t, err)
+	cdp = coredocumentpb.CoreDocument{}
+	assert.NoError(t, proto.Unmarshal(data, &cdp))
+	assert.NotEqual(t, unknown, cdp.XXX_unrecognized)
+
+	ncd, err := NewCoreDocumentFromProtobuf(cdp)
+	assert.NoError(t, err)
+	assert.Len(t, ncd.LinkedDocuments(), 1)
+	assert.Equal(t, cd.LinkedDocuments()[0].DocumentIdentifier, ncd.LinkedDocuments()[0].DocumentIdentifier)
+	assert.Equal(t, cd.LinkedDocuments()[0].DocumentRoot, ncd.LinkedDocuments()[0].DocumentRoot)
+	assert.Equal(t, unknown, ncd.Document.XXX_unrecognized)
+	ncdTree, err := ncd.documentTree(documenttypes.InvoiceDataTypeUrl)
+	assert.NoError(t, err)
+	assert.Equal(t, cdTree.RootHash(), ncdTree.RootHash())
+
+	// invalid extension
+	cdp.XXX_unrecognized = append(proto.EncodeVarint(27<<3|2), 100)
+	_, err = NewCoreDocumentFromProtobuf(cdp)
+	assert.Error(t, err)
+}
+
+func TestCoreDocument_documentTypeProof(t *testing.T) {
+	cd, err := newCoreDocument()
+	assert.NoError(t, err)
+
+	// documents salted before the document type was salted use a zero salt
+	assert.Equal(t, make([]byte, idSize), cd.documentTypeSalt())
+
+	assert.NoError(t, cd.setSalts())
+	salt := cd.documentTypeSalt()
+	assert.Len(t, salt, idSize)
+	assert.False(t, utils.IsEmptyByteSlice(salt))
+
+	dataTree := NewDefaultTreeWithPrefix(nil, "prefix", []byte{1, 0, 0, 0})
+	assert.NoError(t, dataTree.AddLeaf(proofs.LeafNode{Hash: utils.RandomSlice(32), Hashed: true, Property: NewLeafProperty("prefix.sample_field", []byte{1, 0, 0, 0, 0, 0, 0, 200})}))
+	assert.NoError(t, dataTree.Generate())
+	cd.Document.DataRoot = dataTree.RootHash()
+	_, err = cd.CalculateSigningRoot(documenttypes.InvoiceDataTypeUrl)
+	assert.NoError(t, err)
+	dr, err := cd.CalculateDocumentRoot()
+	assert.NoError(t, err)
+
+	prfs, err := cd.CreateProofs(documenttypes.InvoiceDataTypeUrl, dataTree, []string{DocumentTypeProofField, "prefix.sample_field"})
+	assert.NoError(t, err)
+	assert.Equal(t, salt, prfs[0].Salt)
+	assert.NoError(t, ValidateDocumentTypeProof(dr, documenttypes.InvoiceDataTypeUrl, prfs[0]))
+
+	// wrong document type
+	err = ValidateDocumentTypeProof(dr, documenttypes.PurchaseOrderDataTypeUrl, prfs[0])
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "document type mismatch")
+
+	// not a document type proof
+	err = ValidateDocumentTypeProof(dr, documenttypes.InvoiceDataTypeUrl, prfs[1])
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not a document type proof")
+
+	// tampered value with original hash
+	p := *prfs[0]
+	p.Value = []byte(documenttypes.PurchaseOrderDataTypeUrl)
+	assert.Error(t, ValidateDocumentTypeProof(dr, documenttypes.PurchaseOrderDataTypeUrl, &p))
+
+	// wrong root
+	assert.Error(t, ValidateDocumentTypeProof(utils.RandomSlice(32), documenttypes.InvoiceDataTypeUrl, prfs[0]))
+	assert.Error(t, ValidateDocumentTypeProof(dr, documenttypes.InvoiceDataTypeUrl, nil))
+}
+
 func TestCoreDocument_getCollaborators(t *testing.T) {
 	id1 := testingidentity.GenerateRandomDID()
 	id2 := testingidentity.GenerateRandomDID()
 	ids := []string{id1.String()}
-	cd, err := NewCoreDocumentWithCollaborators(ids, nil)
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: ids}, nil)
 	assert.NoError(t, err)
 	cs, err := cd.getCollaborators(coredocumentpb.Action_ACTION_READ_SIGN)
 	assert.NoError(t, err)
@@ -424,7 +588,7 @@ func TestCoreDocument_GetCollaborators(t *testing.T) {
 	id2 := testingidentity.GenerateRandomDID()
 	id3 := testingidentity.GenerateRandomDID()
 	ids := []string{id1.String()}
-	cd, err := NewCoreDocumentWithCollaborators(ids, nil)
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: ids}, nil)
 	assert.NoError(t, err)
 	cs, err := cd.GetCollaborators()
 	assert.NoError(t, err)
@@ -461,7 +625,7 @@ func TestCoreDocument_GetSignCollaborators(t *testing.T) {
 	id1 := testingidentity.GenerateRandomDID()
 	id2 := testingidentity.GenerateRandomDID()
 	ids := []string{id1.String()}
-	cd, err := NewCoreDocumentWithCollaborators(ids, nil)
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: ids}, nil)
 	assert.NoError(t, err)
 	cs, err := cd.GetSignerCollaborators()
 	assert.NoError(t, err)
@@ -0,0 +1,201 @@
+package documents
+
+import (
+	"encoding/json"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+)
+
+const (
+	// DecimalPrecision is the maximum number of fractional digits of a Decimal.
+	DecimalPrecision = 18
+
+	// DecimalByteLength is the length of the byte encoding of a Decimal.
+	// The first byte holds the sign, the rest the big endian magnitude of the scaled value.
+	DecimalByteLength = 32
+)
+
+var (
+	decimalRegex = regexp.MustCompile(`^([+-]?)([0-9]+)(?:\.([0-9]+))?$`)
+	decimalScale = new(big.Int).Exp(big.NewInt(10), big.NewInt(DecimalPrecision), nil)
+	decimalMax   = new(big.Int).Lsh(big.NewInt(1), 8*(DecimalByteLength-1))
+)
+
+// Decimal is a fixed-precision decimal number used for monetary amounts.
+// The value is kept as an integer scaled by 10^DecimalPrecision, so amounts with cents
+// and large values neither overflow nor lose precision.
+// The zero value is a Decimal of value 0.
+type Decimal struct {
+	v *big.Int
+}
+
+// NewDecimal parses the decimal string s, such as "1234.56", into a Decimal.
+func NewDecimal(s string) (*Decimal, error) {
+	d := new(Decimal)
+	err := d.SetString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// SetString sets the decimal to the value of the decimal string s.
+// Returns an error if s has more than DecimalPrecision fractional digits or doesn't fit into the byte encoding.
+func (d *Decimal) SetString(s string) error {
+	m := decimalRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return errors.NewTypedError(ErrInvalidDecimal, errors.New("%q is not a decimal number", s))
+	}
+
+	frac := m[3]
+	if len(frac) > DecimalPrecision {
+		return errors.NewTypedError(ErrInvalidDecimal, errors.New("%q has more than %d fractional digits", s, DecimalPrecision))
+	}
+
+	v, ok := new(big.Int).SetString(m[2]+frac+strings.Repeat("0", DecimalPrecision-len(frac)), 10)
+	if !ok {
+		return errors.NewTypedError(ErrInvalidDecimal, errors.New("%q is not a decimal number", s))
+	}
+
+	if v.Cmp(decimalMax) >= 0 {
+		return errors.NewTypedError(ErrInvalidDecimal, errors.New("%q is out of range", s))
+	}
+
+	if m[1] == "-" {
+		v.Neg(v)
+	}
+
+	d.v = v
+	return nil
+}
+
+// String returns the decimal string of the decimal without trailing fractional zeros.
+func (d *Decimal) String() string {
+	v := d.value()
+	abs := new(big.Int).Abs(v)
+	i, f := new(big.Int).QuoRem(abs, decimalScale, new(big.Int))
+	s := i.String()
+	if f.Sign() != 0 {
+		fs := f.String()
+		fs = strings.Repeat("0", DecimalPrecision-len(fs)) + fs
+		s += "." + strings.TrimRight(fs, "0")
+	}
+
+	if v.Sign() < 0 {
+		s = "-" + s
+	}
+
+	return s
+}
+
+// Bytes returns the DecimalByteLength bytes encoding of the decimal.
+func (d *Decimal) Bytes() ([]byte, error) {
+	v := d.value()
+	abs := new(big.Int).Abs(v)
+	if abs.Cmp(decimalMax) >= 0 {
+		return nil, errors.NewTypedError(ErrInvalidDecimal, errors.New("%s is out of range", d.String()))
+	}
+
+	b := make([]byte, DecimalByteLength)
+	if v.Sign() < 0 {
+		b[0] = 1
+	}
+
+	ab := abs.Bytes()
+	copy(b[DecimalByteLength-len(ab):], ab)
+	return b, nil
+}
+
+// SetBytes sets the decimal to the value of the DecimalByteLength bytes encoding b.
+func (d *Decimal) SetBytes(b []byte) error {
+	if len(b) != DecimalByteLength {
+		return errors.NewTypedError(ErrInvalidDecimal, errors.New("expected %d bytes but got %d", DecimalByteLength, len(b)))
+	}
+
+	if b[0] > 1 {
+		return errors.NewTypedError(ErrInvalidDecimal, errors.New("invalid sign byte %d", b[0]))
+	}
+
+	v := new(big.Int).SetBytes(b[1:])
+	if b[0] == 1 {
+		v.Neg(v)
+	}
+
+	d.v = v
+	return nil
+}
+
+// Cmp compares the decimal with o and returns -1, 0 or +1 like big.Int.Cmp.
+func (d *Decimal) Cmp(o *Decimal) int {
+	return d.value().Cmp(o.value())
+}
+
+// MarshalJSON marshals the decimal into a json string.
+func (d *Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON unmarshals the json string into the decimal.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return errors.NewTypedError(ErrInvalidDecimal, err)
+	}
+
+	return d.SetString(s)
+}
+
+func (d *Decimal) value() *big.Int {
+	if d == nil || d.v == nil {
+		return new(big.Int)
+	}
+
+	return d.v
+}
+
+// StringToDecimal parses the client api string into a decimal. Returns nil if the string is empty.
+func StringToDecimal(s string) (*Decimal, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	return NewDecimal(s)
+}
+
+// DecimalToString returns the client api string of the decimal. Returns an empty string if the decimal is nil.
+func DecimalToString(d *Decimal) string {
+	if d == nil {
+		return ""
+	}
+
+	return d.String()
+}
+
+// BytesToDecimal decodes the p2p protobuf bytes into a decimal. Returns nil if the bytes are empty.
+func BytesToDecimal(b []byte) (*Decimal, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	d := new(Decimal)
+	err := d.SetBytes(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecimalToBytes encodes the decimal into the p2p protobuf bytes. Returns nil if the decimal is nil.
+func DecimalToBytes(d *Decimal) ([]byte, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	return d.Bytes()
+}
@@ -0,0 +1,124 @@
+// +build unit
+
+package documents
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_SetString(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+		err bool
+	}{
+		{in: "0", out: "0"},
+		{in: "-0", out: "0"},
+		{in: "42", out: "42"},
+		{in: "+42", out: "42"},
+		{in: "1234.56", out: "1234.56"},
+		{in: "1234.5600", out: "1234.56"},
+		{in: "-0.01", out: "-0.01"},
+		{in: "0.000000000000000001", out: "0.000000000000000001"},
+		{in: "92233720368547758070.99", out: "92233720368547758070.99"},
+		{in: "0.0000000000000000001", err: true},
+		{in: "", err: true},
+		{in: "abc", err: true},
+		{in: "1.", err: true},
+		{in: ".5", err: true},
+		{in: "1e10", err: true},
+		{in: strings.Repeat("9", 60), err: true},
+	}
+
+	for _, c := range tests {
+		d, err := NewDecimal(c.in)
+		if c.err {
+			assert.Error(t, err, c.in)
+			assert.True(t, errors.IsOfType(ErrInvalidDecimal, err))
+			continue
+		}
+
+		assert.NoError(t, err, c.in)
+		assert.Equal(t, c.out, d.String())
+	}
+}
+
+func TestDecimal_Bytes(t *testing.T) {
+	for _, s := range []string{"0", "42", "-1234.56", "0.000000000000000001", "92233720368547758070.99"} {
+		d, err := NewDecimal(s)
+		assert.NoError(t, err)
+		b, err := d.Bytes()
+		assert.NoError(t, err)
+		assert.Len(t, b, DecimalByteLength)
+
+		nd := new(Decimal)
+		assert.NoError(t, nd.SetBytes(b))
+		assert.Equal(t, 0, d.Cmp(nd))
+		assert.Equal(t, s, nd.String())
+	}
+
+	// invalid length
+	err := new(Decimal).SetBytes([]byte{0, 1})
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrInvalidDecimal, err))
+
+	// invalid sign
+	b := make([]byte, DecimalByteLength)
+	b[0] = 2
+	err = new(Decimal).SetBytes(b)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrInvalidDecimal, err))
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	type amounts struct {
+		Gross *Decimal
+		Net   *Decimal
+	}
+
+	d, err := NewDecimal("1234.56")
+	assert.NoError(t, err)
+	data, err := json.Marshal(amounts{Gross: d})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Gross":"1234.56","Net":null}`, string(data))
+
+	var a amounts
+	assert.NoError(t, json.Unmarshal(data, &a))
+	assert.Nil(t, a.Net)
+	assert.Equal(t, 0, d.Cmp(a.Gross))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"Gross":"12,34"}`), &a))
+}
+
+func TestDecimal_conversions(t *testing.T) {
+	d, err := StringToDecimal("")
+	assert.NoError(t, err)
+	assert.Nil(t, d)
+	assert.Equal(t, "", DecimalToString(nil))
+
+	b, err := DecimalToBytes(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+	d, err = BytesToDecimal(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, d)
+
+	d, err = StringToDecimal("10.5")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.5", DecimalToString(d))
+	b, err = DecimalToBytes(d)
+	assert.NoError(t, err)
+	nd, err := BytesToDecimal(b)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, d.Cmp(nd))
+
+	_, err = StringToDecimal("ten")
+	assert.Error(t, err)
+	_, err = BytesToDecimal([]byte{1})
+	assert.Error(t, err)
+}
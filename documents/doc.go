@@ -41,7 +41,7 @@ would depend on database access such as BI (Although we do NOT recommend directl
 Model Package Hierarchy Specification
 
 In the new package structure the package `documents` includes all model relevant implementations and interfaces.
-The actual implementation can be found at the package `invoice` or `purchaseorder`.
+The actual implementations can be found at the packages `invoice`, `purchaseorder`, `generic` and `entity`.
 The package `documents` should not include any of the actual implementations to avoid cycle dependencies.
 
 Validation
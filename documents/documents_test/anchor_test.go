@@ -8,10 +8,12 @@ import (
 	"testing"
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/contextutil"
 	"github.com/centrifuge/go-centrifuge/documents"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/testingutils/config"
 	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -82,12 +84,13 @@ func TestAnchorDocument(t *testing.T) {
 	m.On("CurrentVersion").Return(id).Once()
 	proc := &mockAnchorProcessor{}
 	proc.On("PrepareForSignatureRequests", m).Return(errors.New("error")).Once()
-	model, err := documents.AnchorDocument(ctxh, m, proc, updater, false)
+	model, err := documents.AnchorDocument(ctxh, m, proc, updater, false, nil)
 	m.AssertExpectations(t)
 	proc.AssertExpectations(t)
 	assert.Nil(t, model)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to prepare document for signatures")
+	assert.Equal(t, documents.StatusFailed, m.GetStatus())
 
 	// request signatures failed
 	m = &testingdocuments.MockModel{}
@@ -96,7 +99,7 @@ func TestAnchorDocument(t *testing.T) {
 	proc.On("PrepareForSignatureRequests", m).Return(nil).Once()
 	proc.On("RequestSignatures", ctxh, m).Return(errors.New("error")).Once()
 	proc.On("PreAnchorDocument", ctxh, m).Return(nil).Once()
-	model, err = documents.AnchorDocument(ctxh, m, proc, updater, true)
+	model, err = documents.AnchorDocument(ctxh, m, proc, updater, true, nil)
 	m.AssertExpectations(t)
 	proc.AssertExpectations(t)
 	assert.Nil(t, model)
@@ -111,7 +114,7 @@ func TestAnchorDocument(t *testing.T) {
 	proc.On("RequestSignatures", ctxh, m).Return(nil).Once()
 	proc.On("PrepareForAnchoring", m).Return(errors.New("error")).Once()
 	proc.On("PreAnchorDocument", ctxh, m).Return(nil).Once()
-	model, err = documents.AnchorDocument(ctxh, m, proc, updater, true)
+	model, err = documents.AnchorDocument(ctxh, m, proc, updater, true, nil)
 	m.AssertExpectations(t)
 	proc.AssertExpectations(t)
 	assert.Nil(t, model)
@@ -127,12 +130,13 @@ func TestAnchorDocument(t *testing.T) {
 	proc.On("PrepareForAnchoring", m).Return(nil).Once()
 	proc.On("PreAnchorDocument", ctxh, m).Return(nil).Once()
 	proc.On("AnchorDocument", m).Return(errors.New("error")).Once()
-	model, err = documents.AnchorDocument(ctxh, m, proc, updater, true)
+	model, err = documents.AnchorDocument(ctxh, m, proc, updater, true, nil)
 	m.AssertExpectations(t)
 	proc.AssertExpectations(t)
 	assert.Nil(t, model)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to anchor document")
+	assert.Equal(t, documents.StatusFailed, m.GetStatus())
 
 	// send failed
 	m = &testingdocuments.MockModel{}
@@ -144,12 +148,13 @@ func TestAnchorDocument(t *testing.T) {
 	proc.On("PreAnchorDocument", ctxh, m).Return(nil).Once()
 	proc.On("AnchorDocument", m).Return(nil).Once()
 	proc.On("SendDocument", ctxh, m).Return(errors.New("error")).Once()
-	model, err = documents.AnchorDocument(ctxh, m, proc, updater, true)
+	model, err = documents.AnchorDocument(ctxh, m, proc, updater, true, nil)
 	m.AssertExpectations(t)
 	proc.AssertExpectations(t)
 	assert.Nil(t, model)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to send anchored document")
+	assert.Equal(t, documents.StatusCommitted, m.GetStatus())
 
 	// success
 	m = &testingdocuments.MockModel{}
@@ -160,9 +165,52 @@ func TestAnchorDocument(t *testing.T) {
 	proc.On("PrepareForAnchoring", m).Return(nil).Once()
 	proc.On("AnchorDocument", m).Return(nil).Once()
 	proc.On("SendDocument", ctxh, m).Return(nil).Once()
-	model, err = documents.AnchorDocument(ctxh, m, proc, updater, false)
+	var statuses []documents.Status
+	model, err = documents.AnchorDocument(ctxh, m, proc, func(id []byte, model documents.Model) error {
+		statuses = append(statuses, model.GetStatus())
+		return nil
+	}, false, nil)
 	m.AssertExpectations(t)
 	proc.AssertExpectations(t)
 	assert.Nil(t, err)
 	assert.NotNil(t, model)
+	assert.Equal(t, documents.StatusCommitted, model.GetStatus())
+	assert.Equal(t, []documents.Status{
+		documents.StatusCommitting, documents.StatusCommitting, documents.StatusCommitting,
+		documents.StatusCommitted, documents.StatusCommitted}, statuses)
+
+	// the completed steps are emitted
+	self, err := contextutil.AccountDID(ctxh)
+	assert.NoError(t, err)
+	collaborator := testingidentity.GenerateRandomDID()
+	m = &testingdocuments.MockModel{}
+	m.On("ID").Return(id)
+	m.On("CurrentVersion").Return(id)
+	m.On("DocumentType").Return("document")
+	m.On("Signatures").Return([]coredocumentpb.Signature{{SignerId: self[:]}, {SignerId: collaborator[:]}}).Once()
+	proc = &mockAnchorProcessor{}
+	proc.On("PrepareForSignatureRequests", m).Return(nil).Once()
+	proc.On("RequestSignatures", ctxh, m).Return(nil).Once()
+	proc.On("PrepareForAnchoring", m).Return(nil).Once()
+	proc.On("AnchorDocument", m).Return(nil).Once()
+	proc.On("SendDocument", ctxh, m).Return(nil).Once()
+	events := documents.NewEventLog(nil)
+	var emitted []documents.Event
+	assert.NoError(t, events.Subscribe(func(ctx context.Context, event documents.Event) {
+		emitted = append(emitted, event)
+	}))
+	_, err = documents.AnchorDocument(ctxh, m, proc, updater, false, events)
+	assert.NoError(t, err)
+	m.AssertExpectations(t)
+	assert.Len(t, emitted, 4)
+	assert.Equal(t, documents.EventVersionPrepared, emitted[0].EventType)
+	assert.Equal(t, documents.EventSignatureAdded, emitted[1].EventType)
+	assert.Equal(t, collaborator, *emitted[1].Collaborator)
+	assert.Equal(t, documents.EventAnchored, emitted[2].EventType)
+	assert.Equal(t, documents.EventSent, emitted[3].EventType)
+	for _, e := range emitted {
+		assert.Equal(t, self, e.AccountID)
+		assert.Equal(t, id, e.VersionID)
+		assert.Equal(t, "document", e.DocumentType)
+	}
 }
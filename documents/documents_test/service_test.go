@@ -5,6 +5,7 @@ package documents_test
 import (
 	"context"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/documents/invoice"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/ethereum"
+	"github.com/centrifuge/go-centrifuge/forensics"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/storage/leveldb"
 	"github.com/centrifuge/go-centrifuge/testingutils/commons"
@@ -56,7 +58,7 @@ func TestMain(m *testing.M) {
 }
 
 func TestService_ReceiveAnchoredDocument(t *testing.T) {
-	srv := documents.DefaultService(nil, nil, documents.NewServiceRegistry(), nil)
+	srv := documents.DefaultService(nil, nil, documents.NewServiceRegistry(), nil, 0, "", 0, nil, nil, nil, nil, nil)
 
 	// self failed
 	err := srv.ReceiveAnchoredDocument(context.Background(), nil, did)
@@ -80,7 +82,7 @@ func TestService_ReceiveAnchoredDocument(t *testing.T) {
 	dr, err := anchors.ToDocumentRoot(cd.DocumentRoot)
 	assert.NoError(t, err)
 	ar.On("GetAnchorData", mock.Anything).Return(dr, time.Now(), nil)
-	srv = documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), idSrv)
+	srv = documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), idSrv, 0, "", 0, nil, nil, nil, nil, nil)
 	err = srv.ReceiveAnchoredDocument(ctxh, doc, did)
 	assert.Error(t, err)
 	assert.True(t, errors.IsOfType(documents.ErrDocumentPersistence, err))
@@ -95,7 +97,7 @@ func TestService_ReceiveAnchoredDocument(t *testing.T) {
 	dr, err = anchors.ToDocumentRoot(cd.DocumentRoot)
 	assert.NoError(t, err)
 	ar.On("GetAnchorData", mock.Anything).Return(dr, time.Now(), nil)
-	srv = documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), idSrv)
+	srv = documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), idSrv, 0, "", 0, nil, nil, nil, nil, nil)
 	err = srv.ReceiveAnchoredDocument(ctxh, doc, did)
 	assert.NoError(t, err)
 	ar.AssertExpectations(t)
@@ -119,7 +121,18 @@ func TestService_ReceiveAnchoredDocument(t *testing.T) {
 	err = testRepo().Create(did[:], doc.CurrentVersion(), doc)
 	assert.NoError(t, err)
 
+	// anchor still holds the old root
+	err = srv.ReceiveAnchoredDocument(ctxh, doc, id2)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentAnchorRootMismatch, err))
+
 	// invalid transition for id3
+	idSrv.On("ValidateSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	ar = new(mockAnchorRepo)
+	dr, err = anchors.ToDocumentRoot(ndr)
+	assert.NoError(t, err)
+	ar.On("GetAnchorData", mock.Anything).Return(dr, time.Now(), nil)
+	srv = documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), idSrv, 0, "", 0, nil, nil, nil, nil, nil)
 	id3 := testingidentity.GenerateRandomDID()
 	err = srv.ReceiveAnchoredDocument(ctxh, doc, id3)
 	assert.Error(t, err)
@@ -127,24 +140,163 @@ func TestService_ReceiveAnchoredDocument(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid document state transition")
 
 	// valid transition for id2
+	var received []documents.Event
+	assert.NoError(t, srv.SubscribeEvents(func(ctx context.Context, event documents.Event) {
+		received = append(received, event)
+	}))
+	err = srv.ReceiveAnchoredDocument(ctxh, doc, id2)
+	assert.NoError(t, err)
+	assert.Equal(t, documents.StatusCommitted, doc.GetStatus())
+	assert.Len(t, received, 1)
+	assert.Equal(t, documents.EventReceived, received[0].EventType)
+	assert.Equal(t, doc.CurrentVersion(), received[0].VersionID)
+	assert.Equal(t, id2, *received[0].Collaborator)
+	ar.AssertExpectations(t)
+	idSrv.AssertExpectations(t)
+}
+
+func TestService_ReceiveAnchoredDocument_anchorNotMined(t *testing.T) {
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	id2 := testingidentity.GenerateRandomDID()
+	doc, cd := createCDWithEmbeddedInvoice(t, ctxh, []identity.DID{id2}, false)
+	dr, err := anchors.ToDocumentRoot(cd.DocumentRoot)
+	assert.NoError(t, err)
+	notFound := errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor"))
+
+	// no grace period
+	ar := new(mockAnchorRepo)
+	ar.On("GetAnchorData", mock.Anything).Return(nil, time.Time{}, notFound).Once()
+	srv := documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), nil, 0, "", 0, nil, nil, nil, nil, nil)
+	err = srv.ReceiveAnchoredDocument(ctxh, doc, did)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentAnchorNotMined, err))
+	ar.AssertExpectations(t)
+
+	// anchor mined during the grace period
+	idSrv := new(testingcommons.MockIdentityService)
 	idSrv.On("ValidateSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	ar = new(mockAnchorRepo)
-	dr, err = anchors.ToDocumentRoot(ndr)
-	assert.NoError(t, err)
+	ar.On("GetAnchorData", mock.Anything).Return(nil, time.Time{}, notFound).Once()
 	ar.On("GetAnchorData", mock.Anything).Return(dr, time.Now(), nil)
-	srv = documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), idSrv)
-	err = srv.ReceiveAnchoredDocument(ctxh, doc, id2)
+	srv = documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), idSrv, 1500*time.Millisecond, "", 0, nil, nil, nil, nil, nil)
+	err = srv.ReceiveAnchoredDocument(ctxh, doc, did)
 	assert.NoError(t, err)
 	ar.AssertExpectations(t)
 	idSrv.AssertExpectations(t)
 }
 
+func TestService_CheckAnchoredDocument(t *testing.T) {
+	srv := documents.DefaultService(nil, nil, documents.NewServiceRegistry(), nil, 0, "", 0, nil, nil, nil, nil, nil)
+	err := srv.CheckAnchoredDocument(context.Background(), nil, did)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentConfigAccountID, err))
+
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	err = srv.CheckAnchoredDocument(ctxh, nil, did)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentNil, err))
+
+	id2 := testingidentity.GenerateRandomDID()
+	doc, cd := createCDWithEmbeddedInvoice(t, ctxh, []identity.DID{id2}, true)
+	dr, err := anchors.ToDocumentRoot(cd.DocumentRoot)
+	assert.NoError(t, err)
+
+	// anchor not mined
+	ar := new(mockAnchorRepo)
+	ar.On("GetAnchorData", mock.Anything).Return(nil, time.Time{}, errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor"))).Once()
+	srv = documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), nil, 0, "", 0, nil, nil, nil, nil, nil)
+	err = srv.CheckAnchoredDocument(ctxh, doc, did)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentAnchorNotMined, err))
+	ar.AssertExpectations(t)
+
+	// anchored, nothing is stored
+	ar = new(mockAnchorRepo)
+	ar.On("GetAnchorData", mock.Anything).Return(dr, time.Now(), nil).Once()
+	repo := testRepo()
+	srv = documents.DefaultService(repo, ar, documents.NewServiceRegistry(), nil, 0, "", 0, nil, nil, nil, nil, nil)
+	assert.NoError(t, srv.CheckAnchoredDocument(ctxh, doc, did))
+	accID, err := contextutil.AccountDID(ctxh)
+	assert.NoError(t, err)
+	assert.False(t, repo.Exists(accID[:], doc.CurrentVersion()))
+	ar.AssertExpectations(t)
+}
+
+type mockForensics struct {
+	mock.Mock
+	forensics.Service
+}
+
+func (m *mockForensics) Record(b *forensics.Bundle) error {
+	args := m.Called(b)
+	return args.Error(0)
+}
+
+func TestService_ReceiveAnchoredDocument_forensics(t *testing.T) {
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	id2 := testingidentity.GenerateRandomDID()
+	doc, cd := createCDWithEmbeddedInvoice(t, ctxh, []identity.DID{id2}, false)
+	anchored, err := anchors.ToDocumentRoot(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	// anchor holds a different root
+	ar := new(mockAnchorRepo)
+	ar.On("GetAnchorData", mock.Anything).Return(anchored, time.Now(), nil)
+	fs := new(mockForensics)
+	fs.On("Record", mock.Anything).Return(nil).Once()
+	srv := documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), nil, 0, "", 0, nil, fs, nil, nil, nil)
+	err = srv.ReceiveAnchoredDocument(ctxh, doc, id2)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentAnchorRootMismatch, err))
+	ar.AssertExpectations(t)
+	fs.AssertExpectations(t)
+
+	b := fs.Calls[0].Arguments.Get(0).(*forensics.Bundle)
+	assert.Equal(t, did, b.AccountID)
+	assert.Equal(t, id2, b.Collaborator)
+	assert.Equal(t, forensics.OperationReceiveAnchoredDocument, b.Operation)
+	assert.Equal(t, doc.ID(), b.DocumentID)
+	assert.Equal(t, doc.CurrentVersion(), b.VersionID)
+	assert.Equal(t, "anchoredValidator", b.Validator)
+	assert.Len(t, b.Failures, 1)
+	assert.NotEmpty(t, b.CoreDocument)
+	assert.NotEmpty(t, b.Model)
+
+	roots := make(map[string]forensics.Root)
+	for _, r := range b.Roots {
+		roots[r.Name] = r
+	}
+	assert.Len(t, roots, 4)
+	assert.True(t, roots["document_root"].Matches())
+	assert.Equal(t, cd.DocumentRoot, roots["document_root"].Expected)
+	assert.False(t, roots["anchored_root"].Matches())
+	assert.Equal(t, anchored[:], roots["anchored_root"].Computed)
+
+	// anchor not mined is not recorded
+	notFound := errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor"))
+	ar = new(mockAnchorRepo)
+	ar.On("GetAnchorData", mock.Anything).Return(nil, time.Time{}, notFound).Once()
+	fs = new(mockForensics)
+	srv = documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), nil, 0, "", 0, nil, fs, nil, nil, nil)
+	err = srv.ReceiveAnchoredDocument(ctxh, doc, id2)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentAnchorNotMined, err))
+	ar.AssertExpectations(t)
+	fs.AssertNotCalled(t, "Record", mock.Anything)
+}
+
+func decimal(t *testing.T, s string) *documents.Decimal {
+	d, err := documents.NewDecimal(s)
+	assert.NoError(t, err)
+	return d
+}
+
 func getServiceWithMockedLayers() (documents.Service, testingcommons.MockIdentityService) {
 	repo := testRepo()
 	idService := testingcommons.MockIdentityService{}
 	idService.On("ValidateSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockAnchor = &mockAnchorRepo{}
-	return documents.DefaultService(repo, mockAnchor, documents.NewServiceRegistry(), &idService), idService
+	return documents.DefaultService(repo, mockAnchor, documents.NewServiceRegistry(), &idService, 0, "", 0, nil, nil, nil, nil, nil), idService
 }
 
 type mockAnchorRepo struct {
@@ -256,7 +408,7 @@ func TestService_RequestDocumentSignature(t *testing.T) {
 	dr, err := anchors.ToDocumentRoot(cd.DocumentRoot)
 	assert.NoError(t, err)
 	ar.On("GetDocumentRootOf", mock.Anything).Return(dr, nil)
-	srv = documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), idSrv)
+	srv = documents.DefaultService(testRepo(), ar, documents.NewServiceRegistry(), idSrv, 0, "", 0, nil, nil, nil, nil, nil)
 
 	// prepare a new version
 	err = doc.AddNFT(true, testingidentity.GenerateRandomDID().ToAddress(), utils.RandomSlice(32))
@@ -320,8 +472,8 @@ func TestService_GetCurrentVersion_successful(t *testing.T) {
 		}
 
 		inv := &invoice.Invoice{
-			GrossAmount:  int64(i + 1),
-			CoreDocument: documents.NewCoreDocumentFromProtobuf(cd),
+			GrossAmount:  decimal(t, strconv.Itoa(i+1)),
+			CoreDocument: &documents.CoreDocument{Document: cd},
 		}
 
 		err := testRepo().Create(accountID, version, inv)
@@ -348,8 +500,8 @@ func TestService_GetVersion_successful(t *testing.T) {
 		CurrentVersion:     currentVersion,
 	}
 	inv := &invoice.Invoice{
-		GrossAmount:  60,
-		CoreDocument: documents.NewCoreDocumentFromProtobuf(cd),
+		GrossAmount:  decimal(t, "60"),
+		CoreDocument: &documents.CoreDocument{Document: cd},
 	}
 
 	ctxh := testingconfig.CreateAccountContext(t, cfg)
@@ -378,8 +530,8 @@ func TestService_GetCurrentVersion_error(t *testing.T) {
 	}
 
 	inv := &invoice.Invoice{
-		GrossAmount:  60,
-		CoreDocument: documents.NewCoreDocumentFromProtobuf(cd),
+		GrossAmount:  decimal(t, "60"),
+		CoreDocument: &documents.CoreDocument{Document: cd},
 	}
 
 	err = testRepo().Create(accountID, documentIdentifier, inv)
@@ -406,8 +558,8 @@ func TestService_GetVersion_error(t *testing.T) {
 		CurrentVersion:     currentVersion,
 	}
 	inv := &invoice.Invoice{
-		GrossAmount:  60,
-		CoreDocument: documents.NewCoreDocumentFromProtobuf(cd),
+		GrossAmount:  decimal(t, "60"),
+		CoreDocument: &documents.CoreDocument{Document: cd},
 	}
 	err = testRepo().Create(accountID, currentVersion, inv)
 	assert.Nil(t, err)
@@ -447,8 +599,8 @@ func TestService_Exists(t *testing.T) {
 		CurrentVersion:     documentIdentifier,
 	}
 	inv := &invoice.Invoice{
-		GrossAmount:  60,
-		CoreDocument: documents.NewCoreDocumentFromProtobuf(cd),
+		GrossAmount:  decimal(t, "60"),
+		CoreDocument: &documents.CoreDocument{Document: cd},
 	}
 
 	err = testRepo().Create(accountID, documentIdentifier, inv)
@@ -499,3 +651,50 @@ func createCDWithEmbeddedInvoice(t *testing.T, ctx context.Context, collaborator
 	}
 	return i, cd
 }
+
+func TestService_GetDocumentSize(t *testing.T) {
+	service, _ := getServiceWithMockedLayers()
+	documentIdentifier := utils.RandomSlice(32)
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+
+	// document doesn't exist
+	_, err := service.GetDocumentSize(ctxh, documentIdentifier)
+	assert.Error(t, err)
+
+	const amountVersions = 3
+	var prev []byte
+	version := documentIdentifier
+	for i := 0; i < amountVersions; i++ {
+		next := utils.RandomSlice(32)
+		cd := coredocumentpb.CoreDocument{
+			DocumentIdentifier: documentIdentifier,
+			CurrentVersion:     version,
+			PreviousVersion:    prev,
+			NextVersion:        next,
+		}
+
+		inv := &invoice.Invoice{
+			GrossAmount:  decimal(t, strconv.Itoa(i+1)),
+			CoreDocument: &documents.CoreDocument{Document: cd},
+		}
+
+		err := testRepo().Create(accountID, version, inv)
+		assert.Nil(t, err)
+		prev, version = version, next
+	}
+
+	size, err := service.GetDocumentSize(ctxh, documentIdentifier)
+	assert.Nil(t, err)
+	assert.Equal(t, documentIdentifier, size.DocumentID)
+	assert.Len(t, size.Versions, amountVersions)
+	assert.Equal(t, prev, size.Versions[0].VersionID, "must start with the latest version")
+	assert.Equal(t, documentIdentifier, size.Versions[amountVersions-1].VersionID)
+	total := 0
+	for _, v := range size.Versions {
+		assert.True(t, v.Bytes > v.EmbeddedDataBytes)
+		assert.True(t, v.EmbeddedDataLeaves > 0)
+		total += v.Bytes
+	}
+	assert.Equal(t, total, size.TotalBytes)
+	assert.True(t, size.MaxMessageBytes > 0)
+}
@@ -0,0 +1,343 @@
+package documents
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/utils"
+)
+
+const (
+	// dataKeyLabel labels the derivation of the key-encryption keys from the private keys of the accounts
+	dataKeyLabel = "centrifuge document encryption"
+
+	// dataKeyPrefix is the key prefix of the wrapped data-encryption keys of the accounts
+	dataKeyPrefix = "docdek_"
+
+	// dataKeyLength is the length of a data-encryption key
+	dataKeyLength = 32
+
+	// keyIDLength is the length of the identifier of a data-encryption or key-encryption key
+	keyIDLength = 8
+)
+
+// encryptedModel is a document model encrypted with the data-encryption key of the account owning it.
+// The storage key of the model is authenticated along with the ciphertext, so a ciphertext can't be moved to another key.
+type encryptedModel struct {
+	ModelType  string
+	KeyID      []byte
+	Nonce      []byte
+	Ciphertext []byte
+}
+
+// Type returns the reflect type of the encryptedModel.
+func (m *encryptedModel) Type() reflect.Type {
+	return reflect.TypeOf(m)
+}
+
+// JSON returns the json representation of the encryptedModel.
+func (m *encryptedModel) JSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// FromJSON loads the encryptedModel from the json.
+func (m *encryptedModel) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// modelTypeName returns the name of the type of the model without pointers.
+func modelTypeName(tp reflect.Type) string {
+	for tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+
+	return tp.String()
+}
+
+// keyID returns the identifier of the key.
+func keyID(key []byte) []byte {
+	id := sha256.Sum256(key)
+	return id[:keyIDLength]
+}
+
+// wrappedKey is the data-encryption key of an account encrypted with the key-encryption key of one of the account keys.
+type wrappedKey struct {
+	Purpose    string
+	WrapperID  []byte
+	Nonce      []byte
+	Ciphertext []byte
+}
+
+// wrappedDataKey is the data-encryption key of an account, wrapped with every private key of the account.
+type wrappedDataKey struct {
+	KeyID []byte
+	Wraps []wrappedKey
+}
+
+// Type returns the reflect type of the wrappedDataKey.
+func (k *wrappedDataKey) Type() reflect.Type {
+	return reflect.TypeOf(k)
+}
+
+// JSON returns the json representation of the wrappedDataKey.
+func (k *wrappedDataKey) JSON() ([]byte, error) {
+	return json.Marshal(k)
+}
+
+// FromJSON loads the wrappedDataKey from the json.
+func (k *wrappedDataKey) FromJSON(data []byte) error {
+	return json.Unmarshal(data, k)
+}
+
+// wraps returns true if the data-encryption key is wrapped with every key-encryption key.
+func (k *wrappedDataKey) wraps(keks map[string][]byte) bool {
+	for purpose, kek := range keks {
+		var found bool
+		for _, w := range k.Wraps {
+			if w.Purpose == purpose && bytes.Equal(w.WrapperID, keyID(kek)) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getDataKeyKey returns the key of the wrapped data-encryption key of the account.
+func getDataKeyKey(accountID []byte) []byte {
+	return append([]byte(dataKeyPrefix), accountID...)
+}
+
+// dataKeys stores the data-encryption keys of the accounts. The key of an account is random and stored wrapped with a
+// key-encryption key per private key of the account, the HMAC-SHA256 of the label and the account ID keyed with the
+// private key. The documents stay readable as long as one of the account keys is unchanged, the key is wrapped with
+// the current keys again once one of them was changed. Documents can't be decrypted if every account key is changed at once.
+type dataKeys struct {
+	db       storage.Repository
+	accounts config.Service
+
+	// mu serialises the creation and rewrapping of the keys, so a key is never replaced by a concurrent one
+	mu sync.Mutex
+}
+
+// keyEncryptionKeys returns the key-encryption keys of the account by key purpose.
+func (d *dataKeys) keyEncryptionKeys(accountID []byte) (map[string][]byte, error) {
+	acc, err := d.accounts.GetAccount(accountID)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentEncryption, err)
+	}
+
+	keys, err := acc.GetKeys()
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentEncryption, err)
+	}
+
+	keks := make(map[string][]byte)
+	for _, purpose := range []identity.Purpose{identity.KeyPurposeSigning, identity.KeyPurposeP2PDiscovery} {
+		sk := keys[purpose.Name].PrivateKey
+		if len(sk) == 0 {
+			continue
+		}
+
+		mac := hmac.New(sha256.New, sk)
+		mac.Write([]byte(dataKeyLabel))
+		mac.Write(accountID)
+		keks[purpose.Name] = mac.Sum(nil)
+	}
+
+	if len(keks) == 0 {
+		return nil, errors.NewTypedError(ErrDocumentEncryption, errors.New("account %x has no private keys", accountID))
+	}
+
+	return keks, nil
+}
+
+// sealer returns the sealer of the documents owned by accountID.
+// The data-encryption key of the account is created on first use.
+func (d *dataKeys) sealer(accountID []byte) (*sealer, error) {
+	keks, err := d.keyEncryptionKeys(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := getDataKeyKey(accountID)
+	if !d.db.Exists(key) {
+		dek := utils.RandomSlice(dataKeyLength)
+		wdk, err := wrapDataKey(accountID, dek, keks)
+		if err != nil {
+			return nil, err
+		}
+
+		err = d.db.Create(key, wdk)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrDocumentEncryption, errors.New("failed to store data-encryption key: %v", err))
+		}
+
+		return newSealer(dek)
+	}
+
+	m, err := d.db.Get(key)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentEncryption, err)
+	}
+
+	wdk, ok := m.(*wrappedDataKey)
+	if !ok {
+		return nil, errors.NewTypedError(ErrDocumentEncryption, errors.New("data-encryption key of account %x is of invalid type", accountID))
+	}
+
+	dek, err := unwrapDataKey(accountID, wdk, keks)
+	if err != nil {
+		return nil, err
+	}
+
+	// one of the account keys was changed, the key is wrapped with the current keys only
+	if !wdk.wraps(keks) {
+		wdk, err = wrapDataKey(accountID, dek, keks)
+		if err != nil {
+			return nil, err
+		}
+
+		err = d.db.Update(key, wdk)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrDocumentEncryption, errors.New("failed to rewrap data-encryption key: %v", err))
+		}
+	}
+
+	return newSealer(dek)
+}
+
+// wrapDataKey encrypts the data-encryption key with every key-encryption key. The account ID is authenticated along with it.
+func wrapDataKey(accountID, dek []byte, keks map[string][]byte) (*wrappedDataKey, error) {
+	wdk := &wrappedDataKey{KeyID: keyID(dek)}
+	for purpose, kek := range keks {
+		aead, err := newAEAD(kek)
+		if err != nil {
+			return nil, err
+		}
+
+		nonce := utils.RandomSlice(aead.NonceSize())
+		wdk.Wraps = append(wdk.Wraps, wrappedKey{
+			Purpose:    purpose,
+			WrapperID:  keyID(kek),
+			Nonce:      nonce,
+			Ciphertext: aead.Seal(nil, nonce, dek, accountID),
+		})
+	}
+
+	sort.Slice(wdk.Wraps, func(i, j int) bool {
+		return wdk.Wraps[i].Purpose < wdk.Wraps[j].Purpose
+	})
+	return wdk, nil
+}
+
+// unwrapDataKey decrypts the data-encryption key with the first key-encryption key it is wrapped with.
+func unwrapDataKey(accountID []byte, wdk *wrappedDataKey, keks map[string][]byte) ([]byte, error) {
+	for _, w := range wdk.Wraps {
+		kek, ok := keks[w.Purpose]
+		if !ok || !bytes.Equal(w.WrapperID, keyID(kek)) {
+			continue
+		}
+
+		aead, err := newAEAD(kek)
+		if err != nil {
+			return nil, err
+		}
+
+		dek, err := aead.Open(nil, w.Nonce, w.Ciphertext, accountID)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrDocumentEncryption, errors.New("failed to unwrap data-encryption key of account %x: %v", accountID, err))
+		}
+
+		if !bytes.Equal(keyID(dek), wdk.KeyID) {
+			return nil, errors.NewTypedError(ErrDocumentEncryption, errors.New("data-encryption key of account %x doesn't match its identifier", accountID))
+		}
+
+		return dek, nil
+	}
+
+	return nil, errors.NewTypedError(ErrDocumentEncryption, errors.New("data-encryption key of account %x is not wrapped with any of the account keys", accountID))
+}
+
+// newAEAD returns the AES-256-GCM cipher with the key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentEncryption, err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentEncryption, err)
+	}
+
+	return aead, nil
+}
+
+// sealer encrypts and decrypts models with AES-256-GCM.
+type sealer struct {
+	aead  cipher.AEAD
+	keyID []byte
+}
+
+func newSealer(key []byte) (*sealer, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sealer{aead: aead, keyID: keyID(key)}, nil
+}
+
+// seal encrypts the model to be stored at key.
+func (s *sealer) seal(key []byte, model Model) (*encryptedModel, error) {
+	data, err := model.JSON()
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentEncryption, err)
+	}
+
+	nonce := utils.RandomSlice(s.aead.NonceSize())
+	return &encryptedModel{
+		ModelType:  modelTypeName(model.Type()),
+		KeyID:      s.keyID,
+		Nonce:      nonce,
+		Ciphertext: s.aead.Seal(nil, nonce, data, key),
+	}, nil
+}
+
+// open decrypts the model stored at key into the model.
+func (s *sealer) open(key []byte, em *encryptedModel, model Model) error {
+	if !bytes.Equal(em.KeyID, s.keyID) {
+		return errors.NewTypedError(ErrDocumentEncryption, errors.New("document at %x is encrypted with another key", key))
+	}
+
+	data, err := s.aead.Open(nil, em.Nonce, em.Ciphertext, key)
+	if err != nil {
+		return errors.NewTypedError(ErrDocumentEncryption, errors.New("failed to decrypt document at %x: %v", key, err))
+	}
+
+	err = model.FromJSON(data)
+	if err != nil {
+		return errors.NewTypedError(ErrDocumentEncryption, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+// +build unit
+
+package documents
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/crypto"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSealer(t *testing.T) {
+	s, err := newSealer(utils.RandomSlice(32))
+	assert.NoError(t, err)
+	key := utils.RandomSlice(64)
+	d := &doc{SomeString: "Hello, World!"}
+	em, err := s.seal(key, d)
+	assert.NoError(t, err)
+	assert.Equal(t, "documents.doc", em.ModelType)
+	assert.False(t, bytes.Contains(em.Ciphertext, []byte(d.SomeString)))
+
+	m := new(doc)
+	assert.NoError(t, s.open(key, em, m))
+	assert.Equal(t, d, m)
+
+	// ciphertext moved to another key
+	err = s.open(utils.RandomSlice(64), em, new(doc))
+	assert.True(t, errors.IsOfType(ErrDocumentEncryption, err))
+
+	// tampered ciphertext
+	em.Ciphertext[0] ^= 1
+	err = s.open(key, em, new(doc))
+	assert.True(t, errors.IsOfType(ErrDocumentEncryption, err))
+
+	// another key
+	other, err := newSealer(utils.RandomSlice(32))
+	assert.NoError(t, err)
+	err = other.open(key, em, new(doc))
+	assert.True(t, errors.IsOfType(ErrDocumentEncryption, err))
+	assert.Contains(t, err.Error(), "encrypted with another key")
+}
+
+func TestEncryptedRepo(t *testing.T) {
+	ldb, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	db := leveldb.NewLevelDBRepository(ldb)
+	acc, err := configstore.NewAccount("main", cfg)
+	assert.NoError(t, err)
+	accounts := new(configstore.MockService)
+	accountID, unknownID := utils.RandomSlice(32), utils.RandomSlice(32)
+	accounts.On("GetAccount", accountID).Return(acc, nil)
+	accounts.On("GetAccount", unknownID).Return(&configstore.Account{}, errors.New("account not found"))
+	repo := NewEncryptedRepository(db, nil, accounts)
+	repo.Register(&doc{})
+
+	// documents are stored encrypted
+	id := utils.RandomSlice(32)
+	d := &doc{SomeString: "Hello, World!"}
+	assert.NoError(t, repo.Create(accountID, id, d))
+	stored, err := db.Get(append(accountID, id...))
+	assert.NoError(t, err)
+	em, ok := stored.(*encryptedModel)
+	assert.True(t, ok)
+	assert.False(t, bytes.Contains(em.Ciphertext, []byte(d.SomeString)))
+	m, err := repo.Get(accountID, id)
+	assert.NoError(t, err)
+	assert.Equal(t, d, m)
+
+	d.SomeString = "Hello, Repo!"
+	err = repo.Atomic(func(repo Repository) error {
+		return repo.Update(accountID, id, d)
+	})
+	assert.NoError(t, err)
+	m, err = repo.Get(accountID, id)
+	assert.NoError(t, err)
+	assert.Equal(t, d, m)
+
+	// documents stored in plaintext are still read
+	plainID := utils.RandomSlice(32)
+	plain := &doc{SomeString: "Hello, Plaintext!"}
+	assert.NoError(t, NewDBRepository(db).Create(accountID, plainID, plain))
+	m, err = repo.Get(accountID, plainID)
+	assert.NoError(t, err)
+	assert.Equal(t, plain, m)
+
+	var count int
+	err = repo.Iterate(accountID, func(model Model) error {
+		assert.Contains(t, []string{d.SomeString, plain.SomeString}, model.(*doc).SomeString)
+		count++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// encrypted documents can't be read without encryption
+	plainRepo := NewDBRepository(db)
+	plainRepo.Register(&doc{})
+	_, err = plainRepo.Get(accountID, id)
+	assert.True(t, errors.IsOfType(ErrDocumentEncryption, err))
+
+	// unknown account
+	err = repo.Create(unknownID, id, d)
+	assert.True(t, errors.IsOfType(ErrDocumentEncryption, err))
+	assert.False(t, repo.Exists(unknownID, id))
+}
+
+// newKeyPair generates a key pair of the curve in dir.
+func newKeyPair(t *testing.T, dir, curve string) *configstore.KeyPair {
+	name := filepath.Join(dir, hex.EncodeToString(utils.RandomSlice(8)))
+	kp := &configstore.KeyPair{Pub: name + ".pub", Priv: name + ".key"}
+	assert.NoError(t, crypto.GenerateSigningKeyPair(kp.Pub, kp.Priv, curve))
+	return kp
+}
+
+// accountWithKeys returns the main account with the signing and p2p key pairs, the configured ones if nil.
+func accountWithKeys(t *testing.T, signing, p2p *configstore.KeyPair) config.Account {
+	acc, err := configstore.NewAccount("main", cfg)
+	assert.NoError(t, err)
+	if signing != nil {
+		acc.(*configstore.Account).SigningKeyPair = *signing
+	}
+
+	if p2p != nil {
+		acc.(*configstore.Account).P2PKeyPair = *p2p
+	}
+
+	return acc
+}
+
+func TestDataKeys_sealer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datakeys")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ldb, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	db := leveldb.NewLevelDBRepository(ldb)
+	db.Register(&wrappedDataKey{})
+	accounts := new(configstore.MockService)
+	keys := &dataKeys{db: db, accounts: accounts}
+	accountID := utils.RandomSlice(32)
+	key := utils.RandomSlice(64)
+	d := &doc{SomeString: "Hello, World!"}
+
+	// the key is created on first use and stored wrapped with the signing and the p2p keys
+	accounts.On("GetAccount", accountID).Return(accountWithKeys(t, nil, nil), nil).Once()
+	s, err := keys.sealer(accountID)
+	assert.NoError(t, err)
+	em, err := s.seal(key, d)
+	assert.NoError(t, err)
+	m, err := db.Get(getDataKeyKey(accountID))
+	assert.NoError(t, err)
+	wdk := m.(*wrappedDataKey)
+	assert.Equal(t, s.keyID, wdk.KeyID)
+	assert.Len(t, wdk.Wraps, 2)
+
+	// the signing key is changed, the key is unwrapped with the p2p key and wrapped with the new signing key
+	signing := newKeyPair(t, dir, crypto.CurveSecp256K1)
+	accounts.On("GetAccount", accountID).Return(accountWithKeys(t, signing, nil), nil).Once()
+	s, err = keys.sealer(accountID)
+	assert.NoError(t, err)
+	assert.NoError(t, s.open(key, em, new(doc)))
+	m, err = db.Get(getDataKeyKey(accountID))
+	assert.NoError(t, err)
+	assert.Equal(t, wdk.KeyID, m.(*wrappedDataKey).KeyID)
+	assert.NotEqual(t, wdk.Wraps, m.(*wrappedDataKey).Wraps)
+
+	// the p2p key is changed later, the key is unwrapped with the new signing key
+	p2p := newKeyPair(t, dir, crypto.CurveEd25519)
+	accounts.On("GetAccount", accountID).Return(accountWithKeys(t, signing, p2p), nil).Once()
+	s, err = keys.sealer(accountID)
+	assert.NoError(t, err)
+	assert.NoError(t, s.open(key, em, new(doc)))
+
+	// every key is changed at once
+	signing, p2p = newKeyPair(t, dir, crypto.CurveSecp256K1), newKeyPair(t, dir, crypto.CurveEd25519)
+	accounts.On("GetAccount", accountID).Return(accountWithKeys(t, signing, p2p), nil).Once()
+	_, err = keys.sealer(accountID)
+	assert.True(t, errors.IsOfType(ErrDocumentEncryption, err))
+	assert.Contains(t, err.Error(), "not wrapped with any of the account keys")
+	accounts.AssertExpectations(t)
+}
@@ -0,0 +1,602 @@
+package entity
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/entity"
+	"github.com/centrifuge/precise-proofs/proofs"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+const prefix string = "entity"
+
+// DocumentTypeURL is the type url of the embedded data of entity documents.
+const DocumentTypeURL = "http://github.com/centrifuge/go-centrifuge/entity/#entity.EntityDocumentData"
+
+const (
+	// PaymentMethodBank is a bank transfer to the bank account of the payment detail.
+	PaymentMethodBank = "bank"
+
+	// PaymentMethodCrypto is a crypto currency transfer to the address of the payment detail.
+	PaymentMethodCrypto = "crypto"
+
+	// PaymentMethodOther is a payment method described by the other details of the payment detail.
+	PaymentMethodOther = "other"
+)
+
+// tree prefixes for specific to documents use the second byte of a 4 byte slice by convention
+func compactPrefix() []byte { return []byte{0, 4, 0, 0} }
+
+// PropertyMappings returns the readable to compact property mappings of the entity data tree.
+func PropertyMappings() ([]documents.PropertyMapping, error) {
+	return documents.GetPropertyMappings(new(entitypb.EntityDocumentData), prefix, compactPrefix())
+}
+
+// Entity implements the documents.Model and keeps track of the master data of an identity
+// such as the legal name, addresses, payment details and contacts.
+type Entity struct {
+	*documents.CoreDocument
+	Identity       *identity.DID // identity the master data belongs to
+	LegalName      string
+	Addresses      []*entitypb.Address
+	PaymentDetails []*entitypb.PaymentDetail
+	Contacts       []*entitypb.Contact
+	EntitySalts    *proofs.Salts
+}
+
+// getClientData returns the client data from the entity model
+func (e *Entity) getClientData() *entitypb.EntityData {
+	var did string
+	if e.Identity != nil {
+		did = e.Identity.String()
+	}
+
+	return &entitypb.EntityData{
+		Identity:       did,
+		LegalName:      e.LegalName,
+		Addresses:      e.Addresses,
+		PaymentDetails: e.PaymentDetails,
+		Contacts:       e.Contacts,
+	}
+}
+
+// createP2PProtobuf returns centrifuge protobuf specific entity data
+func (e *Entity) createP2PProtobuf() *entitypb.EntityDocumentData {
+	var did []byte
+	if e.Identity != nil {
+		did = e.Identity[:]
+	}
+
+	return &entitypb.EntityDocumentData{
+		Identity:       did,
+		LegalName:      e.LegalName,
+		Addresses:      addressesToP2PProtobuf(e.Addresses),
+		PaymentDetails: paymentDetailsToP2PProtobuf(e.PaymentDetails),
+		Contacts:       copyContacts(e.Contacts),
+	}
+}
+
+// copyContacts copies the contacts, so that serialising the embedded data leaves the contacts of the entity as they are.
+func copyContacts(contacts []*entitypb.Contact) []*entitypb.Contact {
+	var data []*entitypb.Contact
+	for _, c := range contacts {
+		data = append(data, &entitypb.Contact{
+			Name:  c.Name,
+			Title: c.Title,
+			Email: c.Email,
+			Phone: c.Phone,
+			Fax:   c.Fax,
+		})
+	}
+
+	return data
+}
+
+// toFlag returns the flag of the boolean.
+func toFlag(b bool) entitypb.Flag {
+	if b {
+		return entitypb.Flag_FLAG_SET
+	}
+
+	return entitypb.Flag_FLAG_UNSET
+}
+
+// addressesToP2PProtobuf converts the addresses to the addresses of the embedded data.
+func addressesToP2PProtobuf(addrs []*entitypb.Address) []*entitypb.AddressData {
+	var data []*entitypb.AddressData
+	for _, a := range addrs {
+		data = append(data, &entitypb.AddressData{
+			IsMain:        toFlag(a.IsMain),
+			IsRemitTo:     toFlag(a.IsRemitTo),
+			IsShipTo:      toFlag(a.IsShipTo),
+			IsPayTo:       toFlag(a.IsPayTo),
+			Label:         a.Label,
+			Zip:           a.Zip,
+			State:         a.State,
+			Country:       a.Country,
+			AddressLine1:  a.AddressLine1,
+			AddressLine2:  a.AddressLine2,
+			ContactPerson: a.ContactPerson,
+		})
+	}
+
+	return data
+}
+
+// addressesFromP2PProtobuf converts the addresses of the embedded data.
+func addressesFromP2PProtobuf(data []*entitypb.AddressData) []*entitypb.Address {
+	var addrs []*entitypb.Address
+	for _, a := range data {
+		addrs = append(addrs, &entitypb.Address{
+			IsMain:        a.IsMain == entitypb.Flag_FLAG_SET,
+			IsRemitTo:     a.IsRemitTo == entitypb.Flag_FLAG_SET,
+			IsShipTo:      a.IsShipTo == entitypb.Flag_FLAG_SET,
+			IsPayTo:       a.IsPayTo == entitypb.Flag_FLAG_SET,
+			Label:         a.Label,
+			Zip:           a.Zip,
+			State:         a.State,
+			Country:       a.Country,
+			AddressLine1:  a.AddressLine1,
+			AddressLine2:  a.AddressLine2,
+			ContactPerson: a.ContactPerson,
+		})
+	}
+
+	return addrs
+}
+
+// paymentDetailsToP2PProtobuf converts the payment details to the payment details of the embedded data.
+func paymentDetailsToP2PProtobuf(details []*entitypb.PaymentDetail) []*entitypb.PaymentDetailData {
+	var data []*entitypb.PaymentDetailData
+	for _, pd := range details {
+		data = append(data, &entitypb.PaymentDetailData{
+			Predefined:        toFlag(pd.Predefined),
+			PaymentMethod:     pd.PaymentMethod,
+			BankName:          pd.BankName,
+			BankAddress:       pd.BankAddress,
+			BankCountry:       pd.BankCountry,
+			BankAccountNumber: pd.BankAccountNumber,
+			BankIban:          pd.BankIban,
+			BankBic:           pd.BankBic,
+			BankHolderName:    pd.BankHolderName,
+			CryptoTo:          pd.CryptoTo,
+			CryptoChainUri:    pd.CryptoChainUri,
+			OtherDetails:      pd.OtherDetails,
+			Currency:          pd.Currency,
+		})
+	}
+
+	return data
+}
+
+// paymentDetailsFromP2PProtobuf converts the payment details of the embedded data.
+func paymentDetailsFromP2PProtobuf(data []*entitypb.PaymentDetailData) []*entitypb.PaymentDetail {
+	var details []*entitypb.PaymentDetail
+	for _, pd := range data {
+		details = append(details, &entitypb.PaymentDetail{
+			Predefined:        pd.Predefined == entitypb.Flag_FLAG_SET,
+			PaymentMethod:     pd.PaymentMethod,
+			BankName:          pd.BankName,
+			BankAddress:       pd.BankAddress,
+			BankCountry:       pd.BankCountry,
+			BankAccountNumber: pd.BankAccountNumber,
+			BankIban:          pd.BankIban,
+			BankBic:           pd.BankBic,
+			BankHolderName:    pd.BankHolderName,
+			CryptoTo:          pd.CryptoTo,
+			CryptoChainUri:    pd.CryptoChainUri,
+			OtherDetails:      pd.OtherDetails,
+			Currency:          pd.Currency,
+		})
+	}
+
+	return details
+}
+
+// InitEntityInput initialize the model based on the received parameters from the rest api call
+func (e *Entity) InitEntityInput(payload *entitypb.EntityCreatePayload, self string) error {
+	err := e.initEntityFromData(payload.Data)
+	if err != nil {
+		return err
+	}
+
+	collaborators := documents.NewCollaboratorsAccess(append([]string{self}, payload.Collaborators...), payload.ReadAccess, payload.WriteAccess)
+	cd, err := documents.NewCoreDocumentWithCollaborators(collaborators, compactPrefix())
+	if err != nil {
+		return errors.New("failed to init core document: %v", err)
+	}
+
+	e.CoreDocument = cd
+	return nil
+}
+
+// initEntityFromData initialises the entity from the client data
+func (e *Entity) initEntityFromData(data *entitypb.EntityData) error {
+	e.Identity = nil
+	if data.Identity != "" {
+		did, err := identity.NewDIDFromString(data.Identity)
+		if err != nil {
+			return errors.New("failed to decode identity: %v", err)
+		}
+
+		e.Identity = &did
+	}
+
+	e.LegalName = data.LegalName
+	e.Addresses = data.Addresses
+	e.PaymentDetails = data.PaymentDetails
+	e.Contacts = data.Contacts
+	return nil
+}
+
+// loadFromP2PProtobuf loads the entity from centrifuge protobuf entity data
+func (e *Entity) loadFromP2PProtobuf(data *entitypb.EntityDocumentData) {
+	e.Identity = nil
+	if data.Identity != nil {
+		did := identity.NewDIDFromBytes(data.Identity)
+		e.Identity = &did
+	}
+
+	e.LegalName = data.LegalName
+	e.Addresses = addressesFromP2PProtobuf(data.Addresses)
+	e.PaymentDetails = paymentDetailsFromP2PProtobuf(data.PaymentDetails)
+	e.Contacts = data.Contacts
+}
+
+// getEntitySalts returns the entity salts. Initialises if not present
+func (e *Entity) getEntitySalts() (*proofs.Salts, error) {
+	if e.EntitySalts == nil {
+		salts, err := documents.GenerateNewSalts()
+		if err != nil {
+			return nil, errors.New("getEntitySalts error %v", err)
+		}
+		e.EntitySalts = salts
+	}
+
+	return e.EntitySalts, nil
+}
+
+// PackCoreDocument packs the Entity into a Core Document
+func (e *Entity) PackCoreDocument() (cd coredocumentpb.CoreDocument, err error) {
+	entityData := e.createP2PProtobuf()
+	data, err := proto.Marshal(entityData)
+	if err != nil {
+		return cd, errors.New("failed to marshal entity data: %v", err)
+	}
+
+	embedData := &any.Any{
+		TypeUrl: e.DocumentType(),
+		Value:   data,
+	}
+
+	salts, err := e.getEntitySalts()
+	if err != nil {
+		return cd, errors.New("failed to get entity salts: %v", err)
+	}
+
+	return e.CoreDocument.PackCoreDocument(embedData, documents.ConvertToProtoSalts(salts))
+}
+
+// UnpackCoreDocument unpacks the core document into Entity
+func (e *Entity) UnpackCoreDocument(cd coredocumentpb.CoreDocument) error {
+	if cd.EmbeddedData == nil ||
+		cd.EmbeddedData.TypeUrl != e.DocumentType() {
+		return errors.New("trying to convert document with incorrect schema")
+	}
+
+	entityData := new(entitypb.EntityDocumentData)
+	err := proto.Unmarshal(cd.EmbeddedData.Value, entityData)
+	if err != nil {
+		return err
+	}
+
+	e.loadFromP2PProtobuf(entityData)
+	if cd.EmbeddedDataSalts == nil {
+		e.EntitySalts, err = e.getEntitySalts()
+		if err != nil {
+			return err
+		}
+	} else {
+		e.EntitySalts = documents.ConvertToProofSalts(cd.EmbeddedDataSalts)
+	}
+
+	e.CoreDocument, err = documents.NewCoreDocumentFromProtobuf(cd)
+	return err
+}
+
+// JSON marshals Entity into a json bytes
+func (e *Entity) JSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// FromJSON unmarshals the json bytes into Entity
+func (e *Entity) FromJSON(jsonData []byte) error {
+	return json.Unmarshal(jsonData, e)
+}
+
+// Type gives the Entity type
+func (e *Entity) Type() reflect.Type {
+	return reflect.TypeOf(e)
+}
+
+// CalculateDataRoot calculates the data root and sets the root to core document
+func (e *Entity) CalculateDataRoot() ([]byte, error) {
+	t, err := e.getDocumentDataTree()
+	if err != nil {
+		return nil, errors.New("failed to get data tree: %v", err)
+	}
+
+	dr := t.RootHash()
+	e.CoreDocument.SetDataRoot(dr)
+	return dr, nil
+}
+
+// getDocumentDataTree creates precise-proofs data tree for the model
+func (e *Entity) getDocumentDataTree() (tree *proofs.DocumentTree, err error) {
+	entityProto := e.createP2PProtobuf()
+	salts, err := e.getEntitySalts()
+	if err != nil {
+		return nil, err
+	}
+	t := documents.NewDefaultTreeWithPrefix(salts, prefix, compactPrefix())
+	err = t.AddLeavesFromDocument(entityProto)
+	if err != nil {
+		return nil, errors.New("getDocumentDataTree error %v", err)
+	}
+	err = t.Generate()
+	if err != nil {
+		return nil, errors.New("getDocumentDataTree error %v", err)
+	}
+	return t, nil
+}
+
+// CreateProofs generates proofs for given fields.
+func (e *Entity) CreateProofs(fields []string) (proofs []*proofspb.Proof, err error) {
+	tree, err := e.getDocumentDataTree()
+	if err != nil {
+		return nil, errors.New("createProofs error %v", err)
+	}
+
+	return e.CoreDocument.CreateProofs(e.DocumentType(), tree, fields)
+}
+
+// DocumentType returns the entity document type.
+func (*Entity) DocumentType() string {
+	return DocumentTypeURL
+}
+
+// DataTreePrefix returns the readable and compact prefix of the entity data tree.
+func (*Entity) DataTreePrefix() (string, []byte) {
+	return prefix, compactPrefix()
+}
+
+// DataTreeLeaves returns the number of leaves of the data tree of the entity.
+func (e *Entity) DataTreeLeaves() (int, error) {
+	t, err := e.getDocumentDataTree()
+	if err != nil {
+		return 0, err
+	}
+
+	return len(t.PropertyOrder()), nil
+}
+
+// PrepareNewVersion prepares new version from the old entity.
+func (e *Entity) PrepareNewVersion(old documents.Model, data *entitypb.EntityData, collaborators documents.CollaboratorsAccess) error {
+	err := e.initEntityFromData(data)
+	if err != nil {
+		return err
+	}
+
+	oldEntity, ok := old.(*Entity)
+	if !ok {
+		return errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("expecting an entity but got %T", old))
+	}
+
+	e.CoreDocument, err = oldEntity.CoreDocument.PrepareNewVersion(collaborators, true, compactPrefix())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AddNFT adds NFT to the Entity.
+func (e *Entity) AddNFT(grantReadAccess bool, registry common.Address, tokenID []byte) error {
+	cd, err := e.CoreDocument.AddNFT(grantReadAccess, registry, tokenID)
+	if err != nil {
+		return err
+	}
+
+	e.CoreDocument = cd
+	return nil
+}
+
+// LinkDocument links the anchored document root of another document to the Entity.
+func (e *Entity) LinkDocument(documentID, documentRoot []byte) error {
+	cd, err := e.CoreDocument.AddLink(documentID, documentRoot)
+	if err != nil {
+		return err
+	}
+
+	e.CoreDocument = cd
+	return nil
+}
+
+// AddAttributeSignature adds the signature of a third party over one of the attributes to the Entity.
+func (e *Entity) AddAttributeSignature(sig *coredocumentextpb.AttributeSignature) error {
+	cd, err := e.CoreDocument.AddAttributeSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	e.CoreDocument = cd
+	return nil
+}
+
+// AddTransferDetail adds the detail of a transfer against the Entity, replacing an earlier detail of the transfer.
+func (e *Entity) AddTransferDetail(detail *coredocumentextpb.TransferDetail) error {
+	cd, err := e.CoreDocument.AddTransferDetail(detail)
+	if err != nil {
+		return err
+	}
+
+	e.CoreDocument = cd
+	return nil
+}
+
+// AddAttachment adds the attachment to the Entity, replacing an earlier attachment with the same name.
+func (e *Entity) AddAttachment(attachment *coredocumentextpb.Attachment) error {
+	cd, err := e.CoreDocument.AddAttachment(attachment)
+	if err != nil {
+		return err
+	}
+
+	e.CoreDocument = cd
+	return nil
+}
+
+// UpdateTags adds and removes the tags of the Entity on behalf of the account.
+func (e *Entity) UpdateTags(account identity.DID, add, remove []string) error {
+	cd, err := e.CoreDocument.UpdateTags(account, e.DocumentType(), add, remove)
+	if err != nil {
+		return err
+	}
+
+	e.CoreDocument = cd
+	return nil
+}
+
+// Cancel cancels the Entity, optionally superseded by the document with supersededBy.
+func (e *Entity) Cancel(supersededBy []byte) error {
+	cd, err := e.CoreDocument.Cancel(supersededBy)
+	if err != nil {
+		return err
+	}
+
+	e.CoreDocument = cd
+	return nil
+}
+
+// RemoveCollaborators removes the collaborators from the read and transition rules of the next version of the Entity.
+func (e *Entity) RemoveCollaborators(collaborators []identity.DID) error {
+	cd, err := e.CoreDocument.RemoveCollaborators(collaborators)
+	if err != nil {
+		return err
+	}
+
+	e.CoreDocument = cd
+	return nil
+}
+
+// CalculateSigningRoot returns the signing root of the document.
+// Calculates it if not generated yet.
+func (e *Entity) CalculateSigningRoot() ([]byte, error) {
+	return e.CoreDocument.CalculateSigningRoot(e.DocumentType())
+}
+
+// CreateNFTProofs creates proofs specific to NFT minting.
+func (e *Entity) CreateNFTProofs(
+	account identity.DID,
+	registry common.Address,
+	tokenID []byte,
+	nftUniqueProof, readAccessProof bool) (proofs []*proofspb.Proof, err error) {
+	return e.CoreDocument.CreateNFTProofs(
+		e.DocumentType(),
+		account, registry, tokenID, nftUniqueProof, readAccessProof)
+}
+
+// CollaboratorCanUpdate checks if the account can update the document.
+func (e *Entity) CollaboratorCanUpdate(updated documents.Model, collaborator identity.DID) error {
+	cf, err := e.ChangedFields(updated)
+	if err != nil {
+		return err
+	}
+
+	rules := e.CoreDocument.TransitionRulesFor(collaborator)
+	return documents.ValidateTransitions(rules, cf)
+}
+
+// ChangedFields returns the core document and entity fields changed in the updated entity.
+func (e *Entity) ChangedFields(updated documents.Model) ([]documents.ChangedField, error) {
+	newEntity, ok := updated.(*Entity)
+	if !ok {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("expecting an entity but got %T", updated))
+	}
+
+	cf, err := e.CoreDocument.GetChangedFields(newEntity.CoreDocument, e.DocumentType())
+	if err != nil {
+		return nil, err
+	}
+
+	oldTree, err := e.getDocumentDataTree()
+	if err != nil {
+		return nil, err
+	}
+
+	newTree, err := newEntity.getDocumentDataTree()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(cf, documents.GetChangedFields(oldTree, newTree, proofs.DefaultSaltsLengthSuffix)...), nil
+}
+
+// AddUpdateLog adds a log to the model to persist an update related meta data such as author
+func (e *Entity) AddUpdateLog(account identity.DID) (err error) {
+	return e.CoreDocument.AddUpdateLog(account)
+}
+
+// Author is the author of the document version represented by the model
+func (e *Entity) Author() identity.DID {
+	return e.CoreDocument.Author()
+}
+
+// Timestamp is the time of update in UTC of the document version represented by the model
+func (e *Entity) Timestamp() (time.Time, error) {
+	return e.CoreDocument.Timestamp()
+}
+
+// SummaryStatus returns the status of the entity. Entities have no status.
+func (e *Entity) SummaryStatus() string {
+	return ""
+}
+
+// SummaryFields returns the key fields of the entity.
+func (e *Entity) SummaryFields() (fields []documents.SummaryField) {
+	var did string
+	if e.Identity != nil {
+		did = e.Identity.String()
+	}
+
+	fields = documents.AppendSummaryField(fields, "Legal Name", e.LegalName)
+	fields = documents.AppendSummaryField(fields, "Identity", did)
+	for _, a := range e.Addresses {
+		if a.IsMain {
+			fields = documents.AppendSummaryField(fields, "Country", a.Country)
+			break
+		}
+	}
+
+	return fields
+}
+
+// SearchAttributes returns the searchable fields of the entity.
+func (e *Entity) SearchAttributes() map[string]string {
+	attrs := make(map[string]string)
+	documents.SetSearchAttribute(attrs, "legal_name", e.LegalName)
+	if e.Identity != nil {
+		documents.SetSearchAttribute(attrs, "identity", e.Identity.String())
+	}
+
+	return attrs
+}
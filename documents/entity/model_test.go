@@ -0,0 +1,248 @@
+// +build unit
+
+package entity
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/bootstrap/bootstrappers/testlogging"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/ethereum"
+	"github.com/centrifuge/go-centrifuge/identity/ideth"
+	"github.com/centrifuge/go-centrifuge/nft"
+	"github.com/centrifuge/go-centrifuge/p2p"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/entity"
+	"github.com/centrifuge/go-centrifuge/queue"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/testingutils/testingtx"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var ctx = map[string]interface{}{}
+var cfg config.Configuration
+var defaultDID = testingidentity.GenerateRandomDID()
+
+func TestMain(m *testing.M) {
+	ethClient := &testingcommons.MockEthClient{}
+	ethClient.On("GetEthClient").Return(nil)
+	ctx[ethereum.BootstrappedEthereumClient] = ethClient
+	txMan := &testingtx.MockTxManager{}
+	ctx[transactions.BootstrappedService] = txMan
+	done := make(chan bool)
+	txMan.On("ExecuteWithinTX", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(transactions.NilTxID(), done, nil)
+	ctx[nft.BootstrappedPayObService] = new(testingdocuments.MockRegistry)
+	ibootstrappers := []bootstrap.TestBootstrapper{
+		&testlogging.TestLoggingBootstrapper{},
+		&config.Bootstrapper{},
+		&leveldb.Bootstrapper{},
+		&queue.Bootstrapper{},
+		&ideth.Bootstrapper{},
+		&configstore.Bootstrapper{},
+		anchors.Bootstrapper{},
+		documents.Bootstrapper{},
+		p2p.Bootstrapper{},
+		documents.PostBootstrapper{},
+		&Bootstrapper{},
+		&queue.Starter{},
+	}
+	bootstrap.RunTestBootstrappers(ibootstrappers, ctx)
+	cfg = ctx[bootstrap.BootstrappedConfig].(config.Configuration)
+	cfg.Set("identityId", cid.String())
+	result := m.Run()
+	bootstrap.RunTestTeardown(ibootstrappers)
+	os.Exit(result)
+}
+
+func createPayload() *entitypb.EntityCreatePayload {
+	return &entitypb.EntityCreatePayload{
+		Collaborators: []string{testingidentity.GenerateRandomDID().String()},
+		Data: &entitypb.EntityData{
+			Identity:  testingidentity.GenerateRandomDID().String(),
+			LegalName: "Acme GmbH",
+			Addresses: []*entitypb.Address{
+				{IsMain: true, Label: "HQ", AddressLine1: "Main street 1", Zip: "10115", Country: "DE"},
+				{IsShipTo: true, Label: "Warehouse", AddressLine1: "Harbour 3", Zip: "20457", Country: "DE"},
+			},
+			PaymentDetails: []*entitypb.PaymentDetail{
+				{Predefined: true, PaymentMethod: PaymentMethodBank, BankName: "Bank", BankIban: "DE89370400440532013000", BankCountry: "DE", Currency: "EUR"},
+			},
+			Contacts: []*entitypb.Contact{
+				{Name: "Alice", Title: "CFO", Email: "alice@acme.example"},
+			},
+		},
+	}
+}
+
+func createEntity(t *testing.T) *Entity {
+	e := new(Entity)
+	err := e.InitEntityInput(createPayload(), defaultDID.String())
+	assert.NoError(t, err)
+	_, err = e.CalculateDataRoot()
+	assert.NoError(t, err)
+	_, err = e.CalculateSigningRoot()
+	assert.NoError(t, err)
+	_, err = e.CalculateDocumentRoot()
+	assert.NoError(t, err)
+	return e
+}
+
+func TestEntity_InitEntityInput(t *testing.T) {
+	// invalid identity
+	payload := createPayload()
+	payload.Data.Identity = "some identity"
+	e := new(Entity)
+	err := e.InitEntityInput(payload, defaultDID.String())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to decode identity")
+
+	// invalid collaborator
+	payload = createPayload()
+	payload.Collaborators = []string{"some id"}
+	err = e.InitEntityInput(payload, defaultDID.String())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to decode collaborator")
+
+	// success
+	payload = createPayload()
+	err = e.InitEntityInput(payload, defaultDID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, payload.Data.Identity, e.Identity.String())
+	assert.Equal(t, payload.Data, e.getClientData())
+	cs, err := e.GetCollaborators()
+	assert.NoError(t, err)
+	assert.Len(t, cs, 2)
+}
+
+func TestEntity_PackUnpackCoreDocument(t *testing.T) {
+	model := new(Entity)
+
+	// embed data missing
+	err := model.UnpackCoreDocument(coredocumentpb.CoreDocument{})
+	assert.Error(t, err)
+
+	// embed data type is wrong
+	err = model.UnpackCoreDocument(coredocumentpb.CoreDocument{EmbeddedData: new(any.Any)})
+	assert.Error(t, err)
+
+	// embed data is wrong
+	err = model.UnpackCoreDocument(coredocumentpb.CoreDocument{
+		EmbeddedData: &any.Any{
+			Value:   utils.RandomSlice(32),
+			TypeUrl: DocumentTypeURL,
+		},
+	})
+	assert.Error(t, err)
+
+	// successful
+	e, cd := createCDWithEmbeddedEntity(t)
+	assert.NotNil(t, cd.EmbeddedDataSalts)
+	err = model.UnpackCoreDocument(cd)
+	assert.NoError(t, err)
+	assert.Equal(t, e.(*Entity).getClientData(), model.getClientData())
+	assert.Equal(t, e.(*Entity).EntitySalts, model.EntitySalts)
+	assert.Equal(t, e.ID(), model.ID())
+	assert.Equal(t, e.CurrentVersion(), model.CurrentVersion())
+}
+
+func TestEntity_JSON(t *testing.T) {
+	e := createEntity(t)
+	cd, err := e.PackCoreDocument()
+	assert.NoError(t, err)
+	jsonBytes, err := e.JSON()
+	assert.NoError(t, err)
+	assert.True(t, json.Valid(jsonBytes))
+
+	e = new(Entity)
+	assert.NoError(t, e.FromJSON(jsonBytes))
+	ncd, err := e.PackCoreDocument()
+	assert.NoError(t, err)
+	assert.Equal(t, cd, ncd)
+}
+
+func TestEntity_CreateProofs(t *testing.T) {
+	e := createEntity(t)
+	proof, err := e.CreateProofs([]string{"entity.legal_name", "entity.addresses[0].country", "entity.payment_details[0].bank_iban"})
+	assert.NoError(t, err)
+	assert.Len(t, proof, 3)
+	tree, err := e.DocumentRootTree()
+	assert.NoError(t, err)
+	for _, p := range proof {
+		valid, err := tree.ValidateProof(p)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+
+	// field does not exist
+	_, err = e.CreateProofs([]string{"entity.contacts[1].name"})
+	assert.Error(t, err)
+}
+
+func TestEntity_CollaboratorCanUpdate(t *testing.T) {
+	e := createEntity(t)
+	id1 := defaultDID
+	id2 := testingidentity.GenerateRandomDID()
+
+	// wrong type
+	err := e.CollaboratorCanUpdate(new(testingdocuments.MockModel), id1)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalidType, err))
+
+	data := e.getClientData()
+	data.LegalName = "Acme AG"
+	newE := new(Entity)
+	assert.NoError(t, newE.PrepareNewVersion(e, data, documents.CollaboratorsAccess{}))
+
+	// id1 should have permission
+	assert.NoError(t, e.CollaboratorCanUpdate(newE, id1))
+
+	// id2 should fail since it is not a collaborator
+	assert.Error(t, e.CollaboratorCanUpdate(newE, id2))
+}
+
+func TestPropertyMappings(t *testing.T) {
+	mappings, err := PropertyMappings()
+	assert.NoError(t, err)
+	names := make(map[string]string)
+	for _, m := range mappings {
+		names[m.ReadableName] = m.CompactName
+	}
+
+	// every leaf of the data tree must be described by the mappings
+	e := createEntity(t)
+	tree, err := e.getDocumentDataTree()
+	assert.NoError(t, err)
+	for _, p := range tree.PropertyOrder() {
+		if strings.Contains(p.ReadableName(), "[") {
+			continue
+		}
+
+		assert.Equal(t, hexutil.Encode(p.CompactName()), names[p.ReadableName()], p.ReadableName())
+	}
+}
+
+func TestEntity_Summary(t *testing.T) {
+	e := createEntity(t)
+	assert.Empty(t, e.SummaryStatus())
+	assert.Equal(t, []documents.SummaryField{
+		{Name: "Legal Name", Value: "Acme GmbH"},
+		{Name: "Identity", Value: e.Identity.String()},
+		{Name: "Country", Value: "DE"},
+	}, e.SummaryFields())
+}
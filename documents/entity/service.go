@@ -0,0 +1,273 @@
+package entity
+
+import (
+	"context"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/entity"
+	"github.com/centrifuge/go-centrifuge/queue"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Service defines specific functions for entity
+type Service interface {
+	documents.Service
+
+	// DeriveFromCreatePayload derives entity from create payload
+	DeriveFromCreatePayload(ctx context.Context, payload *entitypb.EntityCreatePayload) (documents.Model, error)
+
+	// DeriveFromUpdatePayload derives entity from update payload
+	DeriveFromUpdatePayload(ctx context.Context, payload *entitypb.EntityUpdatePayload) (documents.Model, error)
+
+	// DeriveEntityData returns the entity data as client data
+	DeriveEntityData(e documents.Model) (*entitypb.EntityData, error)
+
+	// DeriveEntityResponse returns the entity in our standard client format
+	DeriveEntityResponse(e documents.Model) (*entitypb.EntityResponse, error)
+}
+
+// service implements Service and handles all entity related persistence and validations
+// service always returns errors of type `errors.Error` or `errors.TypedError`
+type service struct {
+	documents.Service
+	repo      documents.Repository
+	queueSrv  queue.TaskQueuer
+	txManager transactions.Manager
+
+	// strictCodes requires country and currency codes to be assigned ISO codes
+	strictCodes bool
+}
+
+// DefaultService returns the default implementation of the service
+func DefaultService(
+	srv documents.Service,
+	repo documents.Repository,
+	queueSrv queue.TaskQueuer,
+	txManager transactions.Manager,
+	strictCodes bool,
+) Service {
+	return service{
+		repo:        repo,
+		queueSrv:    queueSrv,
+		txManager:   txManager,
+		Service:     srv,
+		strictCodes: strictCodes,
+	}
+}
+
+// PropertyMappings returns the readable to compact property mappings of the entity data tree.
+func (s service) PropertyMappings() ([]documents.PropertyMapping, error) {
+	return PropertyMappings()
+}
+
+// ValidateFields validates the data fields of the entity.
+func (s service) ValidateFields(model documents.Model) error {
+	return fieldValidator(s.strictCodes).Validate(nil, model)
+}
+
+// DeriveFromCoreDocument takes a core document model and returns an entity
+func (s service) DeriveFromCoreDocument(cd coredocumentpb.CoreDocument) (documents.Model, error) {
+	e := new(Entity)
+	err := e.UnpackCoreDocument(cd)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentUnPackingCoreDocument, err)
+	}
+
+	return e, nil
+}
+
+// validateAndPersist validates the document, and persists to DB
+func (s service) validateAndPersist(ctx context.Context, old, new documents.Model, validator documents.Validator) (documents.Model, error) {
+	selfDID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentConfigAccountID, err)
+	}
+
+	e, ok := new.(*Entity)
+	if !ok {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("unknown document type: %T", new))
+	}
+
+	// validate the entity
+	err = validator.Validate(old, e)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
+	}
+
+	// new versions are persisted as drafts, their roots let the next versions refer to them
+	err = documents.CalculateDraftRoots(e)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
+	}
+
+	// we use CurrentVersion as the id since that will be unique across multiple versions of the same document
+	err = s.repo.Create(selfDID[:], e.CurrentVersion(), e)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentPersistence, err)
+	}
+
+	return e, nil
+}
+
+// CreateDraft validates and persists an entity as a draft without anchoring it
+func (s service) CreateDraft(ctx context.Context, e documents.Model) (documents.Model, error) {
+	err := s.RunHooks(ctx, documents.HookPreCreate, e)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err = s.validateAndPersist(ctx, nil, e, CreateValidator(s.strictCodes))
+	if err != nil {
+		return nil, err
+	}
+
+	s.EmitEvent(ctx, documents.NewEvent(documents.EventDocumentCreated, e))
+	return e, nil
+}
+
+// UpdateDraft validates and persists a new version of an entity as a draft without anchoring it
+func (s service) UpdateDraft(ctx context.Context, new documents.Model) (documents.Model, error) {
+	old, err := s.GetCurrentVersion(ctx, new.ID())
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentNotFound, err)
+	}
+
+	return s.validateAndPersist(ctx, old, new, UpdateValidator(s.strictCodes))
+}
+
+// Commit anchors the current version of an entity if it is a draft
+func (s service) Commit(ctx context.Context, documentID []byte) (documents.Model, transactions.TxID, chan bool, error) {
+	return documents.CommitDraft(ctx, s, s.txManager, s.queueSrv, documentID)
+}
+
+// Create validates, persists, and anchors an entity
+func (s service) Create(ctx context.Context, e documents.Model) (documents.Model, transactions.TxID, chan bool, error) {
+	selfDID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(documents.ErrDocumentConfigAccountID, err)
+	}
+
+	e, err = s.CreateDraft(ctx, e)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	txID := contextutil.TX(ctx)
+	txID, done, err := documents.CreateAnchorTransaction(s.txManager, s.queueSrv, selfDID, txID, e.CurrentVersion())
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+	return e, txID, done, nil
+}
+
+// Update validates, persists, and anchors a new version of entity
+func (s service) Update(ctx context.Context, new documents.Model) (documents.Model, transactions.TxID, chan bool, error) {
+	selfDID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(documents.ErrDocumentConfigAccountID, err)
+	}
+
+	new, err = s.UpdateDraft(ctx, new)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	txID := contextutil.TX(ctx)
+	txID, done, err := documents.CreateAnchorTransaction(s.txManager, s.queueSrv, selfDID, txID, new.CurrentVersion())
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+	return new, txID, done, nil
+}
+
+// DeriveFromCreatePayload derives entity from create payload
+func (s service) DeriveFromCreatePayload(ctx context.Context, payload *entitypb.EntityCreatePayload) (documents.Model, error) {
+	if payload == nil || payload.Data == nil {
+		return nil, documents.ErrDocumentNil
+	}
+
+	selfDID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, documents.ErrDocumentConfigAccountID
+	}
+
+	e := new(Entity)
+	err = e.InitEntityInput(payload, selfDID.String())
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
+	}
+
+	return e, nil
+}
+
+// DeriveFromUpdatePayload derives entity from update payload
+func (s service) DeriveFromUpdatePayload(ctx context.Context, payload *entitypb.EntityUpdatePayload) (documents.Model, error) {
+	if payload == nil || payload.Data == nil {
+		return nil, documents.ErrDocumentNil
+	}
+
+	// get latest old version of the document
+	id, err := identifiers.DecodeDocumentID(payload.Identifier)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentIdentifier, errors.New("failed to decode identifier: %v", err))
+	}
+
+	old, err := s.GetCurrentVersion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// load entity data
+	e := new(Entity)
+	err = e.PrepareNewVersion(old, payload.Data, documents.NewCollaboratorsAccess(payload.Collaborators, payload.ReadAccess, payload.WriteAccess))
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, errors.New("failed to load entity from data: %v", err))
+	}
+
+	return e, nil
+}
+
+// DeriveEntityData returns the entity data from the model
+func (s service) DeriveEntityData(doc documents.Model) (*entitypb.EntityData, error) {
+	e, ok := doc.(*Entity)
+	if !ok {
+		return nil, documents.ErrDocumentInvalidType
+	}
+
+	return e.getClientData(), nil
+}
+
+// DeriveEntityResponse returns the entity response from the model
+func (s service) DeriveEntityResponse(doc documents.Model) (*entitypb.EntityResponse, error) {
+	data, err := s.DeriveEntityData(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	cs, err := doc.GetCollaborators()
+	if err != nil {
+		return nil, err
+	}
+
+	var css []string
+	for _, c := range cs {
+		css = append(css, c.String())
+	}
+
+	h := &entitypb.ResponseHeader{
+		DocumentId:    hexutil.Encode(doc.ID()),
+		VersionId:     hexutil.Encode(doc.CurrentVersion()),
+		State:         string(doc.GetStatus()),
+		Collaborators: css,
+	}
+
+	return &entitypb.EntityResponse{
+		Header: h,
+		Data:   data,
+	}, nil
+}
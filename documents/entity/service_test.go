@@ -0,0 +1,183 @@
+// +build unit
+
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/entity"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/centrifuge/gocelery"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	cid       = testingidentity.GenerateRandomDID()
+	accountID = cid[:]
+)
+
+type mockAnchorRepo struct {
+	mock.Mock
+	anchors.AnchorRepository
+}
+
+func (r *mockAnchorRepo) GetAnchorData(anchorID anchors.AnchorID) (docRoot anchors.DocumentRoot, anchoredTime time.Time, err error) {
+	args := r.Called(anchorID)
+	docRoot, _ = args.Get(0).(anchors.DocumentRoot)
+	anchoredTime, _ = args.Get(1).(time.Time)
+	return docRoot, anchoredTime, args.Error(2)
+}
+
+func getServiceWithMockedLayers() Service {
+	idService := &testingcommons.MockIdentityService{}
+	queueSrv := new(testingutils.MockQueue)
+	queueSrv.On("EnqueueJob", mock.Anything, mock.Anything).Return(&gocelery.AsyncResult{}, nil)
+	txManager := ctx[transactions.BootstrappedService].(transactions.Manager)
+	repo := testRepo()
+	docSrv := documents.DefaultService(repo, &mockAnchorRepo{}, documents.NewServiceRegistry(), idService, 0, "", 0, nil, nil, nil, nil, nil)
+	return DefaultService(docSrv, repo, queueSrv, txManager, false)
+}
+
+func TestService_DeriveFromCreatePayload(t *testing.T) {
+	srv := service{}
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+
+	// nil payload
+	m, err := srv.DeriveFromCreatePayload(ctxh, nil)
+	assert.Nil(t, m)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentNil, err))
+
+	// nil data payload
+	m, err = srv.DeriveFromCreatePayload(ctxh, &entitypb.EntityCreatePayload{})
+	assert.Nil(t, m)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentNil, err))
+
+	// invalid identity
+	payload := createPayload()
+	payload.Data.Identity = "some identity"
+	m, err = srv.DeriveFromCreatePayload(ctxh, payload)
+	assert.Nil(t, m)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalid, err))
+
+	// success
+	m, err = srv.DeriveFromCreatePayload(ctxh, createPayload())
+	assert.NoError(t, err)
+	assert.Equal(t, "Acme GmbH", m.(*Entity).LegalName)
+}
+
+func TestService_Create(t *testing.T) {
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	srv := getServiceWithMockedLayers()
+
+	// unknown type
+	m, _, _, err := srv.Create(ctxh, &testingdocuments.MockModel{})
+	assert.Nil(t, m)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown document type")
+
+	// missing legal name
+	payload := createPayload()
+	payload.Data.LegalName = ""
+	e, err := srv.DeriveFromCreatePayload(ctxh, payload)
+	assert.NoError(t, err)
+	m, _, _, err = srv.Create(ctxh, e)
+	assert.Nil(t, m)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalid, err))
+
+	// success
+	e, err = srv.DeriveFromCreatePayload(ctxh, createPayload())
+	assert.NoError(t, err)
+	m, _, _, err = srv.Create(ctxh, e)
+	assert.NoError(t, err)
+	assert.NotNil(t, m)
+	assert.True(t, testRepo().Exists(accountID, e.CurrentVersion()))
+}
+
+func TestService_Update(t *testing.T) {
+	srv := getServiceWithMockedLayers()
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+
+	// missing last version
+	e, _ := createCDWithEmbeddedEntity(t)
+	_, _, _, err := srv.Update(ctxh, e)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentNotFound, err))
+	assert.NoError(t, testRepo().Create(accountID, e.CurrentVersion(), e))
+
+	// invalid identifier
+	data, err := srv.DeriveEntityData(e)
+	assert.NoError(t, err)
+	_, err = srv.DeriveFromUpdatePayload(ctxh, &entitypb.EntityUpdatePayload{Identifier: "some identifier", Data: data})
+	assert.True(t, errors.IsOfType(documents.ErrDocumentIdentifier, err))
+
+	// success
+	data.Contacts = append(data.Contacts, &entitypb.Contact{Name: "Bob", Email: "bob@acme.example"})
+	newE, err := srv.DeriveFromUpdatePayload(ctxh, &entitypb.EntityUpdatePayload{
+		Identifier: hexutil.Encode(e.ID()),
+		Data:       data,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, e.CurrentVersion(), newE.PreviousVersion())
+	newE, _, _, err = srv.Update(ctxh, newE)
+	assert.NoError(t, err)
+	assert.True(t, testRepo().Exists(accountID, newE.CurrentVersion()))
+
+	resp, err := srv.DeriveEntityResponse(newE)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(e.ID()), resp.Header.DocumentId)
+	assert.Len(t, resp.Data.Contacts, 2)
+	assert.Equal(t, "Bob", resp.Data.Contacts[1].Name)
+}
+
+func TestService_DeriveFromCoreDocument(t *testing.T) {
+	srv := service{}
+	e, cd := createCDWithEmbeddedEntity(t)
+	m, err := srv.DeriveFromCoreDocument(cd)
+	assert.NoError(t, err)
+	assert.Equal(t, e.(*Entity).getClientData(), m.(*Entity).getClientData())
+
+	cd.EmbeddedData.TypeUrl = "wrong type"
+	_, err = srv.DeriveFromCoreDocument(cd)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentUnPackingCoreDocument, err))
+}
+
+var testRepoGlobal documents.Repository
+
+func testRepo() documents.Repository {
+	if testRepoGlobal == nil {
+		ldb, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+		if err != nil {
+			panic(err)
+		}
+		testRepoGlobal = documents.NewDBRepository(leveldb.NewLevelDBRepository(ldb))
+		testRepoGlobal.Register(&Entity{})
+	}
+	return testRepoGlobal
+}
+
+func createCDWithEmbeddedEntity(t *testing.T) (documents.Model, coredocumentpb.CoreDocument) {
+	e := new(Entity)
+	err := e.InitEntityInput(createPayload(), cid.String())
+	assert.NoError(t, err)
+	_, err = e.CalculateDataRoot()
+	assert.NoError(t, err)
+	_, err = e.CalculateSigningRoot()
+	assert.NoError(t, err)
+	_, err = e.CalculateDocumentRoot()
+	assert.NoError(t, err)
+	cd, err := e.PackCoreDocument()
+	assert.NoError(t, err)
+	return e, cd
+}
@@ -0,0 +1,11 @@
+// +build integration unit
+
+package entity
+
+func (b *Bootstrapper) TestBootstrap(context map[string]interface{}) error {
+	return b.Bootstrap(context)
+}
+
+func (*Bootstrapper) TestTearDown() error {
+	return nil
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+)
+
+// fieldValidator validates the fields of the entity model.
+// Country and currency codes must be assigned ISO codes in strict mode.
+func fieldValidator(strictCodes bool) documents.Validator {
+	return documents.ValidatorFunc(func(_, new documents.Model) error {
+		if new == nil {
+			return errors.New("nil document")
+		}
+
+		e, ok := new.(*Entity)
+		if !ok {
+			return errors.New("unknown document type")
+		}
+
+		var err error
+		if e.LegalName == "" {
+			err = errors.AppendError(err, documents.NewError("entity_legal_name", "legal name is required"))
+		}
+
+		for i, a := range e.Addresses {
+			if cerr := documents.ValidateCountry(a.Country, strictCodes); cerr != nil {
+				err = errors.AppendError(err, documents.NewError(fmt.Sprintf("entity_addresses[%d]_country", i), cerr.Error()))
+			}
+		}
+
+		for i, pd := range e.PaymentDetails {
+			key := fmt.Sprintf("entity_payment_details[%d]", i)
+			switch pd.PaymentMethod {
+			case PaymentMethodBank, PaymentMethodCrypto, PaymentMethodOther:
+			default:
+				err = errors.AppendError(err, documents.NewError(key+"_payment_method", fmt.Sprintf("unknown payment method %q", pd.PaymentMethod)))
+			}
+
+			if cerr := documents.ValidateCountry(pd.BankCountry, strictCodes); cerr != nil {
+				err = errors.AppendError(err, documents.NewError(key+"_bank_country", cerr.Error()))
+			}
+
+			if pd.Currency == "" {
+				continue
+			}
+
+			if cerr := documents.ValidateCurrency(pd.Currency, strictCodes); cerr != nil {
+				err = errors.AppendError(err, documents.NewError(key+"_currency", cerr.Error()))
+			}
+		}
+
+		return err
+	})
+}
+
+// CreateValidator returns a validator group that should be run before creating the entity and persisting it to DB
+func CreateValidator(strictCodes bool) documents.ValidatorGroup {
+	return documents.ValidatorGroup{
+		fieldValidator(strictCodes),
+	}
+}
+
+// UpdateValidator returns a validator group that should be run before updating the entity
+func UpdateValidator(strictCodes bool) documents.ValidatorGroup {
+	return documents.ValidatorGroup{
+		fieldValidator(strictCodes),
+		documents.UpdateVersionValidator(),
+	}
+}
@@ -0,0 +1,51 @@
+// +build unit
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/entity"
+	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldValidator_Validate(t *testing.T) {
+	fv := fieldValidator(true)
+
+	// nil
+	err := fv.Validate(nil, nil)
+	assert.Error(t, err)
+
+	// unknown type
+	err = fv.Validate(nil, &testingdocuments.MockModel{})
+	assert.Error(t, err)
+
+	// missing legal name, bad codes and unknown payment method
+	e := &Entity{
+		Addresses: []*entitypb.Address{{Country: "de"}},
+		PaymentDetails: []*entitypb.PaymentDetail{
+			{PaymentMethod: "cash", BankCountry: "XX", Currency: "EURO"},
+			{PaymentMethod: PaymentMethodCrypto},
+		},
+	}
+	err = fv.Validate(nil, e)
+	assert.Error(t, err)
+	assert.Equal(t, 5, errors.Len(err))
+	assert.Contains(t, err.Error(), "did you mean \"DE\"")
+
+	// lower case codes pass without strict codes
+	e.LegalName = "Acme GmbH"
+	e.PaymentDetails = e.PaymentDetails[1:]
+	assert.Error(t, fv.Validate(nil, e))
+	assert.NoError(t, fieldValidator(false).Validate(nil, e))
+}
+
+func TestCreateValidator(t *testing.T) {
+	assert.Len(t, CreateValidator(false), 1)
+}
+
+func TestUpdateValidator(t *testing.T) {
+	assert.Len(t, UpdateValidator(false), 2)
+}
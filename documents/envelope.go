@@ -0,0 +1,161 @@
+package documents
+
+import (
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
+	"github.com/centrifuge/go-centrifuge/version"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// ProofEnvelopeV1 is the envelope version of the proofs of trees with sorted hashes and compact properties
+	ProofEnvelopeV1 = 1
+
+	// CurrentProofEnvelopeVersion is the envelope version of the proofs generated by this node
+	CurrentProofEnvelopeVersion = ProofEnvelopeV1
+
+	// HashAlgorithmSHA256 is the name of the sha256 hash algorithm in the proof envelopes
+	HashAlgorithmSHA256 = "sha256"
+
+	// HashAlgorithmBlake2b256 is the name of the blake2b-256 hash algorithm in the proof envelopes
+	HashAlgorithmBlake2b256 = "blake2b-256"
+)
+
+// TreePrefix is the readable prefix of the properties of a tree with its compact form.
+type TreePrefix struct {
+	Readable string
+	Compact  []byte
+}
+
+// ProofEnvelope records the parameters the proofs of a bundle were generated with.
+// Verifiers dispatch on the envelope version, so proofs generated today remain verifiable after the trees change.
+type ProofEnvelope struct {
+	Version           int
+	HashAlgorithm     string
+	CompactProperties bool
+	HashSorting       bool
+
+	// Prefixes are the prefixes of the trees the properties of the proofs belong to
+	Prefixes []TreePrefix
+
+	// SoftwareVersion is the version of the node that generated the proofs
+	SoftwareVersion string
+}
+
+// dataTreePrefixer is implemented by the models that can describe the prefix of their data tree.
+type dataTreePrefixer interface {
+	DataTreePrefix() (readable string, compact []byte)
+}
+
+// NewProofEnvelope returns the envelope of the proofs generated by this node from the trees of the model.
+func NewProofEnvelope(model Model) *ProofEnvelope {
+	var prefixes []TreePrefix
+	for _, p := range []string{CDTreePrefix, SigningTreePrefix, SignaturesTreePrefix, DRTreePrefix} {
+		prefixes = append(prefixes, TreePrefix{Readable: p, Compact: compactProperties(p)})
+	}
+
+	if dp, ok := model.(dataTreePrefixer); ok {
+		readable, compact := dp.DataTreePrefix()
+		prefixes = append(prefixes, TreePrefix{Readable: readable, Compact: compact})
+	}
+
+	opts := defaultTreeOptions("", nil)
+	return &ProofEnvelope{
+		Version:           CurrentProofEnvelopeVersion,
+		HashAlgorithm:     treeHashAlgorithm,
+		CompactProperties: opts.CompactProperties,
+		HashSorting:       opts.EnableHashSorting,
+		Prefixes:          prefixes,
+		SoftwareVersion:   version.GetVersion().String(),
+	}
+}
+
+// FieldProofVerifier checks that the field proof resolves to the document root.
+type FieldProofVerifier func(documentRoot []byte, p *proofspb.Proof) error
+
+// proofVerifiers return the field proof verifiers by envelope version.
+// The verifier of a released version must not change, proofs of changed trees get a new envelope version.
+var proofVerifiers = map[int]func(env *ProofEnvelope) (FieldProofVerifier, error){
+	ProofEnvelopeV1: proofVerifierV1,
+}
+
+// proofVerifierV1 returns the verifier of the proofs of trees with sorted hashes, hashed with the algorithm of the envelope.
+func proofVerifierV1(env *ProofEnvelope) (FieldProofVerifier, error) {
+	if !env.HashSorting {
+		return nil, errors.NewTypedError(ErrUnsupportedProofEnvelope, errors.New("version %d requires sorted hashes", ProofEnvelopeV1))
+	}
+
+	newHash, err := TreeHash(env.HashAlgorithm)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrUnsupportedProofEnvelope, err)
+	}
+
+	return func(documentRoot []byte, p *proofspb.Proof) error {
+		return ValidateProofWithHash(documentRoot, p, newHash)
+	}, nil
+}
+
+// ProofVerifier returns the verifier of the field proofs of the bundle for the version of its envelope.
+// Bundles without an envelope were generated before envelopes were introduced, or received from the peers of the network,
+// and are verified as version 1 with the tree hash algorithm of the network.
+func ProofVerifier(proof *DocumentProof) (FieldProofVerifier, error) {
+	env := proof.Envelope
+	if env == nil {
+		env = &ProofEnvelope{Version: ProofEnvelopeV1, HashAlgorithm: treeHashAlgorithm, CompactProperties: true, HashSorting: true}
+	}
+
+	verifier, ok := proofVerifiers[env.Version]
+	if !ok {
+		return nil, errors.NewTypedError(ErrUnsupportedProofEnvelope, errors.New("unknown version %d", env.Version))
+	}
+
+	return verifier(env)
+}
+
+// ConvertProofEnvelopeToClientFormat converts the proof envelope to client api format.
+func ConvertProofEnvelopeToClientFormat(env *ProofEnvelope) *documentpb.ProofEnvelope {
+	if env == nil {
+		return nil
+	}
+
+	cenv := &documentpb.ProofEnvelope{
+		Version:           int32(env.Version),
+		HashAlgorithm:     env.HashAlgorithm,
+		CompactProperties: env.CompactProperties,
+		HashSorting:       env.HashSorting,
+		SoftwareVersion:   env.SoftwareVersion,
+	}
+
+	for _, p := range env.Prefixes {
+		cenv.Prefixes = append(cenv.Prefixes, &documentpb.TreePrefix{Readable: p.Readable, Compact: hexutil.Encode(p.Compact)})
+	}
+
+	return cenv
+}
+
+// ConvertProofEnvelopeFromClientFormat converts the proof envelope in client api format.
+func ConvertProofEnvelopeFromClientFormat(cenv *documentpb.ProofEnvelope) (*ProofEnvelope, error) {
+	if cenv == nil {
+		return nil, nil
+	}
+
+	env := &ProofEnvelope{
+		Version:           int(cenv.Version),
+		HashAlgorithm:     cenv.HashAlgorithm,
+		CompactProperties: cenv.CompactProperties,
+		HashSorting:       cenv.HashSorting,
+		SoftwareVersion:   cenv.SoftwareVersion,
+	}
+
+	for _, p := range cenv.Prefixes {
+		compact, err := decodeHex(p.Compact)
+		if err != nil {
+			return nil, errors.New("failed to decode compact prefix of %s: %v", p.Readable, err)
+		}
+
+		env.Prefixes = append(env.Prefixes, TreePrefix{Readable: p.Readable, Compact: compact})
+	}
+
+	return env, nil
+}
@@ -0,0 +1,95 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/centrifuge/go-centrifuge/version"
+	"github.com/stretchr/testify/assert"
+)
+
+// prefixedModel is a model describing the prefix of its data tree.
+type prefixedModel struct {
+	Model
+}
+
+func (prefixedModel) DataTreePrefix() (string, []byte) {
+	return "prefix", []byte{0, 9, 0, 0}
+}
+
+func TestNewProofEnvelope(t *testing.T) {
+	env := NewProofEnvelope(new(mockModel))
+	assert.Equal(t, CurrentProofEnvelopeVersion, env.Version)
+	assert.Equal(t, HashAlgorithmSHA256, env.HashAlgorithm)
+	assert.True(t, env.CompactProperties)
+	assert.True(t, env.HashSorting)
+	assert.Equal(t, version.GetVersion().String(), env.SoftwareVersion)
+	assert.Len(t, env.Prefixes, 4)
+	assert.Equal(t, TreePrefix{Readable: CDTreePrefix, Compact: []byte{1, 0, 0, 0}}, env.Prefixes[0])
+
+	env = NewProofEnvelope(prefixedModel{})
+	assert.Len(t, env.Prefixes, 5)
+	assert.Equal(t, TreePrefix{Readable: "prefix", Compact: []byte{0, 9, 0, 0}}, env.Prefixes[4])
+}
+
+func TestProofVerifier(t *testing.T) {
+	// bundles without an envelope are verified as version 1
+	proof := testProofBundle(t)
+	verify, err := ProofVerifier(proof)
+	assert.NoError(t, err)
+	assert.NoError(t, verify(proof.DocumentRoot, proof.FieldProofs[0]))
+	assert.NoError(t, validateFieldProofs(proof))
+
+	proof.Envelope = NewProofEnvelope(new(mockModel))
+	assert.NoError(t, validateFieldProofs(proof))
+	proof.FieldProofs[1].SortedHashes[0] = utils.RandomSlice(32)
+	assert.Error(t, validateFieldProofs(proof))
+
+	// unknown version
+	proof.Envelope.Version = 2
+	_, err = ProofVerifier(proof)
+	assert.True(t, errors.IsOfType(ErrUnsupportedProofEnvelope, err))
+	assert.True(t, errors.IsOfType(ErrUnsupportedProofEnvelope, validateFieldProofs(proof)))
+
+	// unsupported parameters
+	proof.Envelope.Version = ProofEnvelopeV1
+	proof.Envelope.HashAlgorithm = "keccak256"
+	_, err = ProofVerifier(proof)
+	assert.True(t, errors.IsOfType(ErrUnsupportedProofEnvelope, err))
+	proof.Envelope.HashAlgorithm = HashAlgorithmSHA256
+	proof.Envelope.HashSorting = false
+	_, err = ProofVerifier(proof)
+	assert.True(t, errors.IsOfType(ErrUnsupportedProofEnvelope, err))
+}
+
+func TestConvertProofEnvelope(t *testing.T) {
+	assert.Nil(t, ConvertProofEnvelopeToClientFormat(nil))
+	env, err := ConvertProofEnvelopeFromClientFormat(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, env)
+
+	env = NewProofEnvelope(prefixedModel{})
+	cenv := ConvertProofEnvelopeToClientFormat(env)
+	assert.Equal(t, int32(ProofEnvelopeV1), cenv.Version)
+	assert.Equal(t, "0x01000000", cenv.Prefixes[0].Compact)
+	got, err := ConvertProofEnvelopeFromClientFormat(cenv)
+	assert.NoError(t, err)
+	assert.Equal(t, env, got)
+
+	// bundles keep their envelope
+	proof := testProofBundle(t)
+	proof.Envelope = env
+	cp, err := ConvertDocProofToClientFormat(proof)
+	assert.NoError(t, err)
+	dp, err := ConvertClientProofToDocProof(cp)
+	assert.NoError(t, err)
+	assert.Equal(t, env, dp.Envelope)
+
+	// invalid compact prefix
+	_, err = ConvertProofEnvelopeFromClientFormat(&documentpb.ProofEnvelope{Prefixes: []*documentpb.TreePrefix{{Readable: "prefix", Compact: "0xzz"}}})
+	assert.Error(t, err)
+}
@@ -29,6 +29,12 @@ const (
 	// ErrDocumentInvalid must only be used when the reason for invalidity is impossible to determine or the invalidity is caused by validation errors
 	ErrDocumentInvalid = errors.Error("document is invalid")
 
+	// ErrDocumentSchemaInvalid must be used when the client data of a document doesn't match the JSON Schema of its scheme
+	ErrDocumentSchemaInvalid = errors.Error("client data doesn't match the JSON schema")
+
+	// ErrDocumentStorageQuota must be used when storing a document would exceed the storage quota of the account
+	ErrDocumentStorageQuota = errors.Error("storage quota exceeded")
+
 	// ErrDocumentNotFound must be used to indicate that the document for provided id is not found in the system
 	ErrDocumentNotFound = errors.Error("document not found in the system database")
 
@@ -38,15 +44,90 @@ const (
 	// ErrDocumentPersistence must be used when creating or updating a document in the system database failed
 	ErrDocumentPersistence = errors.Error("error encountered when storing document in the system database")
 
+	// ErrDocumentEncryption must be used when encrypting or decrypting a stored document failed
+	ErrDocumentEncryption = errors.Error("document encryption failed")
+
 	// ErrDocumentUnPackingCoreDocument must be used when unpacking of core document for the given document failed
 	ErrDocumentUnPackingCoreDocument = errors.Error("core document unpacking failed")
 
+	// ErrDocumentPackingCoreDocument must be used when packing of core document for the given document failed
+	ErrDocumentPackingCoreDocument = errors.Error("core document packing failed")
+
+	// ErrDocumentAnchorNotMined must be used when the anchor of a received document is not yet mined
+	ErrDocumentAnchorNotMined = errors.Error("document anchor not yet mined")
+
+	// ErrDocumentAnchorRootMismatch must be used when the anchored document root doesn't match the received document
+	ErrDocumentAnchorRootMismatch = errors.Error("anchored document root mismatch")
+
+	// ErrPropertyMappings must be used when the property mappings of a document tree cannot be derived
+	ErrPropertyMappings = errors.Error("failed to derive property mappings")
+
+	// ErrDocumentSummary must be used when the summary of a document cannot be rendered
+	ErrDocumentSummary = errors.Error("failed to render document summary")
+
+	// ErrDocumentHook must be used when a lifecycle hook of a document fails
+	ErrDocumentHook = errors.Error("document hook failed")
+
+	// ErrDocumentSchemeUnknown must be used when no registered document type is served under the scheme
+	ErrDocumentSchemeUnknown = errors.Error("unknown document scheme")
+
+	// ErrDocumentResidency must be used when the storage of the documents of an account can't be resolved from its residency tag
+	ErrDocumentResidency = errors.Error("failed to resolve document storage of the account residency")
+
+	// ErrDocumentPagination must be used when the requested page of a document listing is invalid
+	ErrDocumentPagination = errors.Error("invalid document listing page")
+
+	// ErrDocumentHistory must be used when the prior versions of a received document can't be synced or verified
+	ErrDocumentHistory = errors.Error("failed to sync document history")
+
+	// ErrDocumentUBL must be used when a document can't be converted to or from a UBL document
+	ErrDocumentUBL = errors.Error("failed to convert UBL document")
+
+	// ErrDocumentImport must be used when a batch of documents can't be imported
+	ErrDocumentImport = errors.Error("failed to import documents")
+
+	// ErrDocumentLedger must be used when the ledger events can't be exported
+	ErrDocumentLedger = errors.Error("failed to export ledger")
+
+	// ErrSignatureAudit must be used when the signatures of the account can't be exported
+	ErrSignatureAudit = errors.Error("failed to export signature audit")
+
+	// ErrNotaryNotConfigured must be used when a proof bundle is to be notarized but the notary is not configured
+	ErrNotaryNotConfigured = errors.Error("notary not configured")
+
+	// ErrProofNotarization must be used when the notarization of a proof bundle fails
+	ErrProofNotarization = errors.Error("failed to notarize proof bundle")
+
+	// ErrDocumentRecovery must be used when the documents anchored by an account can't be recovered
+	ErrDocumentRecovery = errors.Error("failed to recover anchored documents")
+
+	// ErrDocumentRetention must be used when the versions of the documents can't be pruned as per the retention policy
+	ErrDocumentRetention = errors.Error("failed to apply retention policy")
+
+	// ErrDocumentReindex must be used when the index entries of the documents can't be rebuilt
+	ErrDocumentReindex = errors.Error("failed to reindex documents")
+
+	// ErrDocumentAnchorReconciliation must be used when the stored versions don't match their anchors
+	ErrDocumentAnchorReconciliation = errors.Error("failed to reconcile anchored documents")
+
+	// ErrInvalidProofBundle must be used when the verification of a proof bundle fails
+	ErrInvalidProofBundle = errors.Error("invalid proof bundle")
+
+	// ErrUnsupportedProofEnvelope must be used when the proofs of a bundle were generated with an unknown envelope version or parameters
+	ErrUnsupportedProofEnvelope = errors.Error("unsupported proof envelope")
+
 	// ErrDocumentPrepareCoreDocument must be used when preparing a new core document fails for the given document
 	ErrDocumentPrepareCoreDocument = errors.Error("core document preparation failed")
 
 	// ErrDocumentAnchoring must be used when document anchoring fails
 	ErrDocumentAnchoring = errors.Error("document anchoring failed")
 
+	// ErrDocumentNotDraft must be used when a document is to be committed but its current version is not a draft
+	ErrDocumentNotDraft = errors.Error("document is not a draft")
+
+	// ErrDocumentNotAnchored must be used when none of the versions of a document is anchored
+	ErrDocumentNotAnchored = errors.Error("document has no anchored version")
+
 	// ErrDocumentProof must be used when document proof creation fails
 	ErrDocumentProof = errors.Error("document proof error")
 
@@ -76,6 +157,42 @@ const (
 	// ErrReqDocNotMatch must be used when the requested document does not match the access granted by the access token
 	ErrReqDocNotMatch = errors.Error("the document requested does not match the document to which the access token grants access")
 
+	// ErrAccessTokenProofOnly must be used when a proof-only access token is used to read the document
+	ErrAccessTokenProofOnly = errors.Error("access token only grants access to field proofs")
+
+	// ErrAccessTokenExpired must be used when the access token has expired
+	ErrAccessTokenExpired = errors.Error("access token has expired")
+
+	// ErrAccessTokenFieldsNotGranted must be used when the requested fields are not granted by the access token
+	ErrAccessTokenFieldsNotGranted = errors.Error("access token does not grant proofs for the requested fields")
+
+	// ErrAttributeSignatureInvalid must be used when an attribute signature doesn't verify against the attribute or the signer identity
+	ErrAttributeSignatureInvalid = errors.Error("invalid attribute signature")
+
+	// ErrDocumentCancelled must be used when a new version of a cancelled document is requested
+	ErrDocumentCancelled = errors.Error("document is cancelled")
+
+	// ErrDocumentTransition must be used when the transition rules don't allow the account to make the changes
+	ErrDocumentTransition = errors.Error("document state transition not allowed")
+
+	// ErrTransferDetailNotFound must be used when a transfer is not recorded on the document
+	ErrTransferDetailNotFound = errors.Error("transfer detail not found")
+
+	// ErrAttachmentNotFound must be used when the document has no attachment with the requested name
+	ErrAttachmentNotFound = errors.Error("attachment not found")
+
+	// ErrAttachmentPinning must be used when the file of an attachment can't be pinned to the IPFS node
+	ErrAttachmentPinning = errors.Error("failed to pin attachment")
+
+	// ErrTemplateNotFound must be used when the account has no document template with the requested name
+	ErrTemplateNotFound = errors.Error("document template not found")
+
+	// ErrTemplateInvalid must be used when a document template can't be saved as it is invalid
+	ErrTemplateInvalid = errors.Error("invalid document template")
+
+	// ErrInconsistentFields must be used when the fields of a consistency proof have different values
+	ErrInconsistentFields = errors.Error("fields are not equal")
+
 	// ErrNFTRoleMissing errors when role to generate proof doesn't exist
 	ErrNFTRoleMissing = errors.Error("NFT Role doesn't exist")
 
@@ -84,6 +201,9 @@ const (
 
 	// ErrEmptyCollabs must be used when a given collaborators array is empty
 	ErrEmptyCollabs = errors.Error("empty collaborators")
+
+	// ErrInvalidDecimal must be used when a decimal can't be parsed or encoded
+	ErrInvalidDecimal = errors.Error("invalid decimal")
 )
 
 // Error wraps an error with specific key
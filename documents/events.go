@@ -0,0 +1,361 @@
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/notification"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/notification"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// eventPrefix is the key prefix of the events of the documents
+	eventPrefix = "docevent_"
+
+	// eventSequencePrefix is the key prefix of the last event sequence of an account
+	eventSequencePrefix = "doceventseq_"
+)
+
+// EventType is the type of a state change of a document version.
+type EventType string
+
+const (
+	// EventDocumentCreated is emitted once the first version of a document is persisted.
+	EventDocumentCreated EventType = "document-created"
+
+	// EventVersionPrepared is emitted once a version is signed by the account and ready for the signature requests.
+	EventVersionPrepared EventType = "version-prepared"
+
+	// EventSignatureAdded is emitted for every collaborator signature collected for a version.
+	EventSignatureAdded EventType = "signature-added"
+
+	// EventAnchored is emitted once a version is anchored and committed.
+	EventAnchored EventType = "anchored"
+
+	// EventSent is emitted once an anchored version is sent to the collaborators.
+	EventSent EventType = "sent"
+
+	// EventReceived is emitted once an anchored version received from a collaborator is persisted.
+	EventReceived EventType = "received"
+)
+
+// Event is an immutable record of a state change of a document version.
+// Events of an account are ordered by their sequence, starting at 1.
+type Event struct {
+	Sequence     uint64       `json:"sequence"`
+	EventType    EventType    `json:"type"`
+	AccountID    identity.DID `json:"account_id"`
+	DocumentID   []byte       `json:"document_id"`
+	VersionID    []byte       `json:"version_id"`
+	DocumentType string       `json:"document_type"`
+
+	// Collaborator is the signer of a signature-added event and the sender of a received event.
+	Collaborator *identity.DID `json:"collaborator,omitempty"`
+	Recorded     time.Time     `json:"recorded"`
+}
+
+// NewEvent returns the event of the type for the current version of the model.
+func NewEvent(tp EventType, model Model) Event {
+	return Event{
+		EventType:    tp,
+		DocumentID:   model.ID(),
+		VersionID:    model.CurrentVersion(),
+		DocumentType: model.DocumentType(),
+	}
+}
+
+// JSON returns the json encoding of the event.
+func (e *Event) JSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// FromJSON loads the event from its json encoding.
+func (e *Event) FromJSON(data []byte) error {
+	return json.Unmarshal(data, e)
+}
+
+// Type returns the reflect type of the event.
+func (e *Event) Type() reflect.Type {
+	return reflect.TypeOf(e)
+}
+
+// eventSequence is the last event sequence assigned for an account.
+type eventSequence struct {
+	Last uint64 `json:"last"`
+}
+
+// JSON returns the json encoding of the sequence.
+func (s *eventSequence) JSON() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// FromJSON loads the sequence from its json encoding.
+func (s *eventSequence) FromJSON(data []byte) error {
+	return json.Unmarshal(data, s)
+}
+
+// Type returns the reflect type of the sequence.
+func (s *eventSequence) Type() reflect.Type {
+	return reflect.TypeOf(s)
+}
+
+// EventSubscriber is called with every event appended to the log.
+// The context carries the account the event is emitted for.
+type EventSubscriber func(ctx context.Context, event Event)
+
+// EventLog persists the events of the document versions and dispatches them to the subscribers.
+// Webhooks, audits and indexes subscribe to the log instead of hooking into the services, and replay the log to
+// catch up with the events they missed.
+type EventLog struct {
+	repo        storage.Repository
+	subscribers []EventSubscriber
+
+	// mutex serialises the appends so that the sequences of an account are assigned once
+	mutex sync.RWMutex
+}
+
+// NewEventLog registers the event models and returns the log persisting the events to repo.
+// Events are dispatched without being persisted if repo is nil.
+func NewEventLog(repo storage.Repository) *EventLog {
+	if repo != nil {
+		repo.Register(&Event{})
+		repo.Register(&eventSequence{})
+	}
+
+	return &EventLog{repo: repo}
+}
+
+// accountEventPrefix returns the key prefix of the events of the account.
+func accountEventPrefix(accountID identity.DID) []byte {
+	return []byte(fmt.Sprintf("%s%s_", eventPrefix, accountID.String()))
+}
+
+// getEventKey returns the key of the event, the sequence is zero padded so the keys are in the sequence order.
+func getEventKey(accountID identity.DID, sequence uint64) []byte {
+	return append(accountEventPrefix(accountID), fmt.Sprintf("%020d", sequence)...)
+}
+
+func getEventSequenceKey(accountID identity.DID) []byte {
+	return []byte(eventSequencePrefix + accountID.String())
+}
+
+// Subscribe adds the subscriber. Subscribers are called in the order of subscription.
+func (l *EventLog) Subscribe(sub EventSubscriber) error {
+	if l == nil {
+		return errors.New("event log not initialised")
+	}
+
+	if sub == nil {
+		return errors.New("nil event subscriber provided")
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.subscribers = append(l.subscribers, sub)
+	return nil
+}
+
+// Append assigns the next sequence of the account of the context to the event, persists it and dispatches it
+// to the subscribers. Returns the appended event.
+func (l *EventLog) Append(ctx context.Context, event Event) (Event, error) {
+	if l == nil {
+		return event, nil
+	}
+
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return event, ErrDocumentConfigAccountID
+	}
+
+	event.AccountID = did
+	event.Recorded = time.Now().UTC()
+	event, err = l.persist(event)
+	if err != nil {
+		return event, errors.NewTypedError(ErrDocumentPersistence, errors.New("failed to persist %s event: %v", event.EventType, err))
+	}
+
+	l.mutex.RLock()
+	subscribers := l.subscribers
+	l.mutex.RUnlock()
+	for _, sub := range subscribers {
+		sub(ctx, event)
+	}
+
+	return event, nil
+}
+
+// persist assigns the next sequence of the account to the event and writes the event along with the sequence.
+func (l *EventLog) persist(event Event) (Event, error) {
+	if l.repo == nil {
+		return event, nil
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	seq := new(eventSequence)
+	seqKey := getEventSequenceKey(event.AccountID)
+	exists := l.repo.Exists(seqKey)
+	if exists {
+		m, err := l.repo.Get(seqKey)
+		if err != nil {
+			return event, err
+		}
+
+		seq = m.(*eventSequence)
+	}
+
+	seq.Last++
+	event.Sequence = seq.Last
+	batch := l.repo.NewBatch()
+	err := batch.Create(getEventKey(event.AccountID, event.Sequence), &event)
+	if err != nil {
+		return event, err
+	}
+
+	if exists {
+		err = batch.Update(seqKey, seq)
+	} else {
+		err = batch.Create(seqKey, seq)
+	}
+	if err != nil {
+		return event, err
+	}
+
+	return event, batch.Commit()
+}
+
+// Replay calls the subscriber with the persisted events of the account of the context, from the event with the
+// given sequence on, in the sequence order.
+func (l *EventLog) Replay(ctx context.Context, from uint64, sub EventSubscriber) error {
+	if l == nil || l.repo == nil {
+		return errors.New("event log not initialised")
+	}
+
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return ErrDocumentConfigAccountID
+	}
+
+	iter := l.repo.NewIteratorFrom(accountEventPrefix(did), getEventKey(did, from))
+	defer iter.Release()
+	for iter.Next() {
+		event, ok := iter.Model().(*Event)
+		if !ok {
+			return errors.New("unexpected model %T at %s", iter.Model(), iter.Key())
+		}
+
+		sub(ctx, *event)
+	}
+
+	return iter.Error()
+}
+
+// emitEvent appends the event of the type for the model to events, if any.
+// Failing appends are logged as the state change already happened.
+func emitEvent(ctx context.Context, events *EventLog, tp EventType, model Model) {
+	if events == nil {
+		return
+	}
+
+	appendEvent(ctx, events, NewEvent(tp, model))
+}
+
+// emitSignatureEvents appends a signature-added event to events for every collaborator signature of the model.
+func emitSignatureEvents(ctx context.Context, events *EventLog, model Model) {
+	if events == nil {
+		return
+	}
+
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		srvLog.Errorf("failed to emit %s events of document version %x: %v", EventSignatureAdded, model.CurrentVersion(), err)
+		return
+	}
+
+	for _, sig := range model.Signatures() {
+		signer := identity.NewDIDFromBytes(sig.SignerId)
+		if signer.Equal(did) {
+			continue
+		}
+
+		event := NewEvent(EventSignatureAdded, model)
+		event.Collaborator = &signer
+		appendEvent(ctx, events, event)
+	}
+}
+
+func appendEvent(ctx context.Context, events *EventLog, event Event) {
+	_, err := events.Append(ctx, event)
+	if err != nil {
+		srvLog.Errorf("failed to emit %s event of document version %x: %v", event.EventType, event.VersionID, err)
+	}
+}
+
+// EmitEvent appends the event to the event log of the documents.
+// Failing appends are logged as the state change already happened.
+func (s service) EmitEvent(ctx context.Context, event Event) {
+	appendEvent(ctx, s.events, event)
+}
+
+// SubscribeEvents subscribes to the events of the documents.
+func (s service) SubscribeEvents(sub EventSubscriber) error {
+	return s.events.Subscribe(sub)
+}
+
+// ReplayEvents calls the subscriber with the persisted events of the account of the context from the given sequence on.
+func (s service) ReplayEvents(ctx context.Context, from uint64, sub EventSubscriber) error {
+	return s.events.Replay(ctx, from, sub)
+}
+
+// notifyReceived notifies the webhook of the account of the received event.
+func (s service) notifyReceived(ctx context.Context, event Event) {
+	if event.EventType != EventReceived {
+		return
+	}
+
+	ts, err := utils.ToTimestamp(event.Recorded)
+	if err != nil {
+		srvLog.Errorf("failed to notify of document %x: %v", event.DocumentID, errors.NewTypedError(ErrDocumentNotification, err))
+		return
+	}
+
+	notificationMsg := &notification.Message{
+		NotificationMessage: &notificationpb.NotificationMessage{
+			EventType:    uint32(notification.ReceivedPayload),
+			AccountId:    event.AccountID.String(),
+			ToId:         event.AccountID.String(),
+			Recorded:     ts,
+			DocumentType: event.DocumentType,
+			DocumentId:   hexutil.Encode(event.DocumentID),
+		},
+	}
+	if event.Collaborator != nil {
+		notificationMsg.FromId = hexutil.Encode(event.Collaborator[:])
+	}
+
+	// the notification is still sent if the summary fails
+	model, err := s.repo.Get(event.AccountID[:], event.VersionID)
+	if err == nil {
+		var summary *Summary
+		summary, err = NewSummary(model, s.anchorLinkFormat)
+		if err == nil {
+			notificationMsg.DocumentSummary, err = RenderSummary(summary, SummaryFormatJSON)
+		}
+	}
+	if err != nil {
+		srvLog.Warningf("failed to render summary of document %x: %v", event.DocumentID, err)
+	}
+
+	// Async until we add queuing
+	go s.notifier.Send(ctx, notificationMsg)
+}
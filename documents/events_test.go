@@ -0,0 +1,93 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventLog_Subscribe(t *testing.T) {
+	var l *EventLog
+	assert.Error(t, l.Subscribe(func(ctx context.Context, event Event) {}))
+
+	l = NewEventLog(nil)
+	assert.Error(t, l.Subscribe(nil))
+	assert.NoError(t, l.Subscribe(func(ctx context.Context, event Event) {}))
+	assert.Len(t, l.subscribers, 1)
+}
+
+func TestEventLog_Append_Replay(t *testing.T) {
+	db, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	l := NewEventLog(leveldb.NewLevelDBRepository(db))
+	doc := historyChain(1)[0]
+
+	// nil log
+	var nl *EventLog
+	_, err = nl.Append(context.Background(), NewEvent(EventDocumentCreated, doc))
+	assert.NoError(t, err)
+	assert.Error(t, nl.Replay(context.Background(), 0, func(ctx context.Context, event Event) {}))
+
+	// missing account
+	_, err = l.Append(context.Background(), NewEvent(EventDocumentCreated, doc))
+	assert.Equal(t, ErrDocumentConfigAccountID, err)
+
+	// subscribers are called in order with the persisted events
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+	var calls []string
+	var dispatched []Event
+	assert.NoError(t, l.Subscribe(func(ctx context.Context, event Event) {
+		calls = append(calls, "first")
+		dispatched = append(dispatched, event)
+	}))
+	assert.NoError(t, l.Subscribe(func(ctx context.Context, event Event) {
+		calls = append(calls, "second")
+	}))
+
+	collaborator := testingidentity.GenerateRandomDID()
+	for _, tp := range []EventType{EventDocumentCreated, EventVersionPrepared, EventSignatureAdded} {
+		event := NewEvent(tp, doc)
+		if tp == EventSignatureAdded {
+			event.Collaborator = &collaborator
+		}
+
+		e, err := l.Append(actx, event)
+		assert.NoError(t, err)
+		assert.Equal(t, did, e.AccountID)
+		assert.False(t, e.Recorded.IsZero())
+	}
+	assert.Equal(t, []string{"first", "second", "first", "second", "first", "second"}, calls)
+	for i, e := range dispatched {
+		assert.Equal(t, uint64(i+1), e.Sequence)
+		assert.Equal(t, doc.DocID, e.DocumentID)
+		assert.Equal(t, doc.Version, e.VersionID)
+		assert.Equal(t, doc.DocumentType(), e.DocumentType)
+	}
+
+	// replay from a sequence
+	var replayed []Event
+	err = l.Replay(actx, 2, func(ctx context.Context, event Event) {
+		replayed = append(replayed, event)
+	})
+	assert.NoError(t, err)
+	assert.Len(t, replayed, 2)
+	assert.Equal(t, EventVersionPrepared, replayed[0].EventType)
+	assert.Equal(t, EventSignatureAdded, replayed[1].EventType)
+	assert.Equal(t, collaborator, *replayed[1].Collaborator)
+	assert.True(t, dispatched[2].Recorded.Equal(replayed[1].Recorded))
+
+	// sequences continue on a reopened log
+	l = NewEventLog(leveldb.NewLevelDBRepository(db))
+	e, err := l.Append(actx, NewEvent(EventAnchored, doc))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), e.Sequence)
+}
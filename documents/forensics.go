@@ -0,0 +1,110 @@
+package documents
+
+import (
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/forensics"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/golang/protobuf/proto"
+)
+
+// receivedCoreDocument packs the core document of the received model before the validators recalculate its roots.
+// Returns nil if no forensic bundles are recorded.
+func (s service) receivedCoreDocument(model Model) *coredocumentpb.CoreDocument {
+	if s.forensics == nil || model == nil {
+		return nil
+	}
+
+	cd, err := model.PackCoreDocument()
+	if err != nil {
+		srvLog.Warningf("failed to pack received document %x: %v", model.ID(), err)
+		return nil
+	}
+
+	return &cd
+}
+
+// recordValidationFailure records the forensic bundle of the received document that failed the validator.
+// Failing to record is logged only, the validation error is returned to the collaborator regardless.
+func (s service) recordValidationFailure(accountID identity.DID, operation string, received *coredocumentpb.CoreDocument, model Model, collaborator identity.DID, validator string, verr error) {
+	if s.forensics == nil || received == nil {
+		return
+	}
+
+	b := &forensics.Bundle{
+		AccountID:    accountID,
+		Collaborator: collaborator,
+		Operation:    operation,
+		DocumentID:   model.ID(),
+		VersionID:    model.CurrentVersion(),
+		DocumentType: model.DocumentType(),
+		Validator:    validator,
+	}
+
+	for _, err := range errors.GetErrs(verr) {
+		b.Failures = append(b.Failures, err.Error())
+	}
+
+	var err error
+	b.CoreDocument, err = proto.Marshal(received)
+	if err != nil {
+		srvLog.Warningf("failed to marshal received document %x: %v", model.ID(), err)
+	}
+
+	b.Model, err = model.JSON()
+	if err != nil {
+		srvLog.Warningf("failed to marshal model of received document %x: %v", model.ID(), err)
+	}
+
+	b.Roots = s.compareRoots(operation, received, model)
+	err = s.forensics.Record(b)
+	if err != nil {
+		srvLog.Warningf("failed to record forensic bundle of document %x: %v", model.ID(), err)
+		return
+	}
+
+	srvLog.Infof("recorded forensic bundle %s of document %x received from %s", b.ID, model.ID(), collaborator.String())
+}
+
+// compareRoots compares the roots computed from the model with the roots of the received core document.
+// The document root of an anchored document is compared with the anchored root as well.
+func (s service) compareRoots(operation string, received *coredocumentpb.CoreDocument, model Model) []forensics.Root {
+	roots := []forensics.Root{
+		compareRoot("data_root", received.DataRoot, model.CalculateDataRoot),
+		compareRoot("signing_root", received.SigningRoot, model.CalculateSigningRoot),
+		compareRoot("document_root", received.DocumentRoot, model.CalculateDocumentRoot),
+	}
+
+	if operation != forensics.OperationReceiveAnchoredDocument {
+		return roots
+	}
+
+	anchored := forensics.Root{Name: "anchored_root", Expected: received.DocumentRoot}
+	anchorID, err := anchors.ToAnchorID(model.CurrentVersion())
+	if err != nil {
+		anchored.Error = err.Error()
+		return append(roots, anchored)
+	}
+
+	docRoot, _, err := s.anchorRepository.GetAnchorData(anchorID)
+	if err != nil {
+		anchored.Error = err.Error()
+		return append(roots, anchored)
+	}
+
+	anchored.Computed = docRoot[:]
+	return append(roots, anchored)
+}
+
+func compareRoot(name string, expected []byte, calculate func() ([]byte, error)) forensics.Root {
+	r := forensics.Root{Name: name, Expected: expected}
+	computed, err := calculate()
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+
+	r.Computed = computed
+	return r
+}
@@ -0,0 +1,63 @@
+package generic
+
+import (
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/queue"
+	"github.com/centrifuge/go-centrifuge/transactions"
+)
+
+const (
+	// BootstrappedGenericHandler maps to grpc handler for generic documents
+	BootstrappedGenericHandler = "BootstrappedGenericHandler"
+)
+
+// Bootstrapper implements bootstrap.Bootstrapper.
+type Bootstrapper struct{}
+
+// Bootstrap initialises required services for generic documents.
+func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
+	docSrv, ok := ctx[documents.BootstrappedDocumentService].(documents.Service)
+	if !ok {
+		return errors.New("document service not initialised")
+	}
+
+	registry, ok := ctx[documents.BootstrappedRegistry].(*documents.ServiceRegistry)
+	if !ok {
+		return errors.New("service registry not initialised")
+	}
+
+	repo, ok := ctx[documents.BootstrappedDocumentRepository].(documents.Repository)
+	if !ok {
+		return errors.New("document db repository not initialised")
+	}
+	repo.Register(&Generic{})
+
+	queueSrv, ok := ctx[bootstrap.BootstrappedQueueServer].(*queue.Server)
+	if !ok {
+		return errors.New("queue server not initialised")
+	}
+
+	txManager, ok := ctx[transactions.BootstrappedService].(transactions.Manager)
+	if !ok {
+		return errors.New("transaction service not initialised")
+	}
+
+	cfgSrv, ok := ctx[config.BootstrappedConfigStorage].(config.Service)
+	if !ok {
+		return errors.New("config service not initialised")
+	}
+
+	// register service
+	srv := DefaultService(docSrv, repo, queueSrv, txManager)
+	err := registry.Register(DocumentTypeURL, srv)
+	if err != nil {
+		return errors.New("failed to register generic document service")
+	}
+
+	ctx[BootstrappedGenericHandler] = GRPCHandler(cfgSrv, srv)
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package generic
+
+import (
+	"github.com/centrifuge/go-centrifuge/centerrors"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/identifiers"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/generic"
+	logging "github.com/ipfs/go-log"
+	"golang.org/x/net/context"
+)
+
+var apiLog = logging.Logger("generic-api")
+
+// grpcHandler handles all the generic document related actions
+// anchoring, sending, finding stored generic document
+type grpcHandler struct {
+	service Service
+	config  config.Service
+}
+
+// GRPCHandler returns an implementation of the generic DocumentServiceServer
+func GRPCHandler(config config.Service, srv Service) genericpb.DocumentServiceServer {
+	return grpcHandler{
+		service: srv,
+		config:  config,
+	}
+}
+
+// Create validates the generic document, persists it to DB, and anchors it the chain
+func (h grpcHandler) Create(ctx context.Context, req *genericpb.GenericCreatePayload) (*genericpb.GenericResponse, error) {
+	apiLog.Debugf("Create request %v", req)
+	doc, err := h.service.DeriveFromCreatePayload(ctx, req)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive create payload")
+	}
+
+	// validate, persist, and anchor
+	doc, txID, _, err := h.service.Create(ctx, doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not create document")
+	}
+
+	resp, err := h.service.DeriveGenericResponse(doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive response")
+	}
+
+	resp.Header.TransactionId = txID.String()
+	return resp, nil
+}
+
+// Update handles the document update and anchoring
+func (h grpcHandler) Update(ctx context.Context, payload *genericpb.GenericUpdatePayload) (*genericpb.GenericResponse, error) {
+	apiLog.Debugf("Update request %v", payload)
+	doc, err := h.service.DeriveFromUpdatePayload(ctx, payload)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive update payload")
+	}
+
+	doc, txID, _, err := h.service.Update(ctx, doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not update document")
+	}
+
+	resp, err := h.service.DeriveGenericResponse(doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive response")
+	}
+
+	resp.Header.TransactionId = txID.String()
+	return resp, nil
+}
+
+// GetVersion returns the requested version of a generic document
+func (h grpcHandler) GetVersion(ctx context.Context, req *genericpb.GetVersionRequest) (*genericpb.GenericResponse, error) {
+	apiLog.Debugf("GetVersion request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "identifier is invalid")
+	}
+
+	version, err := identifiers.DecodeVersionID(req.Version)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "version is invalid")
+	}
+
+	model, err := h.service.GetVersion(ctx, identifier, version)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "document not found")
+	}
+
+	resp, err := h.service.DeriveGenericResponse(model)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive response")
+	}
+
+	return resp, nil
+}
+
+// Get returns the latest version of the document with given identifier
+func (h grpcHandler) Get(ctx context.Context, getRequest *genericpb.GetRequest) (*genericpb.GenericResponse, error) {
+	apiLog.Debugf("Get request %v", getRequest)
+	identifier, err := identifiers.DecodeDocumentID(getRequest.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "identifier is an invalid hex string")
+	}
+
+	model, err := h.service.GetCurrentVersion(ctx, identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "document not found")
+	}
+
+	resp, err := h.service.DeriveGenericResponse(model)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive response")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,563 @@
+package generic
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/generic"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/centrifuge/precise-proofs/proofs"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+const prefix string = "generic"
+
+// DocumentTypeURL is the type url of the embedded data of generic documents.
+const DocumentTypeURL = "http://github.com/centrifuge/go-centrifuge/generic/#generic.GenericDocumentData"
+
+// Scheme is the name of generic documents in the document api paths, /documents/generic.
+const Scheme = "generic"
+
+// tree prefixes for specific to documents use the second byte of a 4 byte slice by convention
+func compactPrefix() []byte { return []byte{0, 3, 0, 0} }
+
+// PropertyMappings returns the readable to compact property mappings of the generic document data tree.
+func PropertyMappings() ([]documents.PropertyMapping, error) {
+	return documents.GetPropertyMappings(new(genericpb.GenericDocumentData), prefix, compactPrefix())
+}
+
+// AttributeType is the type of a user defined attribute.
+type AttributeType string
+
+const (
+	// AttrString is a plain string attribute.
+	AttrString AttributeType = "string"
+
+	// AttrBytes is a byte slice attribute. The client value is hex encoded.
+	AttrBytes AttributeType = "bytes"
+
+	// AttrDecimal is a decimal attribute such as 100.25.
+	AttrDecimal AttributeType = "decimal"
+
+	// AttrTimestamp is a timestamp attribute. The client value is in RFC3339 format.
+	AttrTimestamp AttributeType = "timestamp"
+)
+
+var decimalRegex = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// Attribute is a user defined field of the generic document.
+// Only the value matching the type is set.
+type Attribute struct {
+	Key          string
+	Type         AttributeType
+	StringValue  string
+	BytesValue   []byte
+	DecimalValue string
+	TimeValue    *timestamp.Timestamp
+}
+
+// newAttribute parses the client value of the attribute as per the type.
+func newAttribute(key, attrType, value string) (attr Attribute, err error) {
+	attr = Attribute{Key: key, Type: AttributeType(attrType)}
+	switch attr.Type {
+	case AttrString:
+		attr.StringValue = value
+	case AttrBytes:
+		attr.BytesValue, err = hexutil.Decode(value)
+		if err != nil {
+			return attr, errors.New("failed to decode attribute %s: %v", key, err)
+		}
+	case AttrDecimal:
+		if !decimalRegex.MatchString(value) {
+			return attr, errors.New("attribute %s is not a valid decimal: %s", key, value)
+		}
+
+		attr.DecimalValue = value
+	case AttrTimestamp:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return attr, errors.New("failed to parse attribute %s: %v", key, err)
+		}
+
+		attr.TimeValue, err = utils.ToTimestamp(t.UTC())
+		if err != nil {
+			return attr, errors.New("failed to convert attribute %s: %v", key, err)
+		}
+	default:
+		return attr, errors.New("attribute %s has unknown type %s", key, attrType)
+	}
+
+	return attr, nil
+}
+
+// clientValue returns the value of the attribute in the client format.
+func (a Attribute) clientValue() string {
+	switch a.Type {
+	case AttrString:
+		return a.StringValue
+	case AttrBytes:
+		if a.BytesValue == nil {
+			return ""
+		}
+
+		return hexutil.Encode(a.BytesValue)
+	case AttrDecimal:
+		return a.DecimalValue
+	case AttrTimestamp:
+		if a.TimeValue == nil {
+			return ""
+		}
+
+		t, err := utils.FromTimestamp(a.TimeValue)
+		if err != nil {
+			return ""
+		}
+
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	return ""
+}
+
+// Generic implements the documents.Model and keeps track of the user defined attributes of the document.
+type Generic struct {
+	*documents.CoreDocument
+	Attributes   []Attribute // sorted by key
+	GenericSalts *proofs.Salts
+}
+
+// getClientData returns the client data from the generic model
+func (g *Generic) getClientData() *genericpb.GenericData {
+	data := new(genericpb.GenericData)
+	for _, attr := range g.Attributes {
+		data.Attributes = append(data.Attributes, &genericpb.Attribute{
+			Key:   attr.Key,
+			Type:  string(attr.Type),
+			Value: attr.clientValue(),
+		})
+	}
+
+	return data
+}
+
+// createP2PProtobuf returns the protobuf of the generic document data
+func (g *Generic) createP2PProtobuf() *genericpb.GenericDocumentData {
+	data := new(genericpb.GenericDocumentData)
+	for _, attr := range g.Attributes {
+		data.Attributes = append(data.Attributes, &genericpb.TypedAttribute{
+			Key:            attr.Key,
+			Type:           string(attr.Type),
+			StringValue:    attr.StringValue,
+			BytesValue:     attr.BytesValue,
+			DecimalValue:   attr.DecimalValue,
+			TimestampValue: attr.TimeValue,
+		})
+	}
+
+	return data
+}
+
+// InitGenericInput initialize the model based on the received parameters from the rest api call
+func (g *Generic) InitGenericInput(payload *genericpb.GenericCreatePayload, self string) error {
+	err := g.initGenericFromData(payload.Data)
+	if err != nil {
+		return err
+	}
+
+	collaborators := documents.NewCollaboratorsAccess(append([]string{self}, payload.Collaborators...), payload.ReadAccess, payload.WriteAccess)
+	cd, err := documents.NewCoreDocumentWithCollaborators(collaborators, compactPrefix())
+	if err != nil {
+		return errors.New("failed to init core document: %v", err)
+	}
+
+	g.CoreDocument = cd
+	return nil
+}
+
+// initGenericFromData initialises the attributes from the client data.
+// Attributes are sorted by key so that the data tree does not depend on the order sent by the client.
+func (g *Generic) initGenericFromData(data *genericpb.GenericData) error {
+	var attrs []Attribute
+	for _, a := range data.Attributes {
+		attr, err := newAttribute(a.Key, a.Type, a.Value)
+		if err != nil {
+			return err
+		}
+
+		attrs = append(attrs, attr)
+	}
+
+	sort.SliceStable(attrs, func(i, j int) bool {
+		return attrs[i].Key < attrs[j].Key
+	})
+
+	g.Attributes = attrs
+	return nil
+}
+
+// loadFromP2PProtobuf loads the generic document from the protobuf of the generic document data
+func (g *Generic) loadFromP2PProtobuf(data *genericpb.GenericDocumentData) {
+	var attrs []Attribute
+	for _, a := range data.Attributes {
+		attrs = append(attrs, Attribute{
+			Key:          a.Key,
+			Type:         AttributeType(a.Type),
+			StringValue:  a.StringValue,
+			BytesValue:   a.BytesValue,
+			DecimalValue: a.DecimalValue,
+			TimeValue:    a.TimestampValue,
+		})
+	}
+
+	g.Attributes = attrs
+}
+
+// getGenericSalts returns the generic document salts. Initialises if not present
+func (g *Generic) getGenericSalts() (*proofs.Salts, error) {
+	if g.GenericSalts == nil {
+		salts, err := documents.GenerateNewSalts()
+		if err != nil {
+			return nil, errors.New("getGenericSalts error %v", err)
+		}
+		g.GenericSalts = salts
+	}
+
+	return g.GenericSalts, nil
+}
+
+// PackCoreDocument packs the Generic document into a Core Document
+func (g *Generic) PackCoreDocument() (cd coredocumentpb.CoreDocument, err error) {
+	genericData := g.createP2PProtobuf()
+	data, err := proto.Marshal(genericData)
+	if err != nil {
+		return cd, errors.New("failed to marshal generic data: %v", err)
+	}
+
+	embedData := &any.Any{
+		TypeUrl: g.DocumentType(),
+		Value:   data,
+	}
+
+	salts, err := g.getGenericSalts()
+	if err != nil {
+		return cd, errors.New("failed to get generic salts: %v", err)
+	}
+
+	return g.CoreDocument.PackCoreDocument(embedData, documents.ConvertToProtoSalts(salts))
+}
+
+// UnpackCoreDocument unpacks the core document into the Generic document
+func (g *Generic) UnpackCoreDocument(cd coredocumentpb.CoreDocument) error {
+	if cd.EmbeddedData == nil ||
+		cd.EmbeddedData.TypeUrl != g.DocumentType() {
+		return errors.New("trying to convert document with incorrect schema")
+	}
+
+	genericData := new(genericpb.GenericDocumentData)
+	err := proto.Unmarshal(cd.EmbeddedData.Value, genericData)
+	if err != nil {
+		return err
+	}
+
+	g.loadFromP2PProtobuf(genericData)
+	if cd.EmbeddedDataSalts == nil {
+		g.GenericSalts, err = g.getGenericSalts()
+		if err != nil {
+			return err
+		}
+	} else {
+		g.GenericSalts = documents.ConvertToProofSalts(cd.EmbeddedDataSalts)
+	}
+
+	g.CoreDocument, err = documents.NewCoreDocumentFromProtobuf(cd)
+	return err
+}
+
+// JSON marshals the Generic document into a json bytes
+func (g *Generic) JSON() ([]byte, error) {
+	return json.Marshal(g)
+}
+
+// FromJSON unmarshals the json bytes into the Generic document
+func (g *Generic) FromJSON(jsonData []byte) error {
+	return json.Unmarshal(jsonData, g)
+}
+
+// Type gives the Generic document type
+func (g *Generic) Type() reflect.Type {
+	return reflect.TypeOf(g)
+}
+
+// CalculateDataRoot calculates the data root and sets the root to core document
+func (g *Generic) CalculateDataRoot() ([]byte, error) {
+	t, err := g.getDocumentDataTree()
+	if err != nil {
+		return nil, errors.New("failed to get data tree: %v", err)
+	}
+
+	dr := t.RootHash()
+	g.CoreDocument.SetDataRoot(dr)
+	return dr, nil
+}
+
+// getDocumentDataTree creates precise-proofs data tree for the model
+func (g *Generic) getDocumentDataTree() (tree *proofs.DocumentTree, err error) {
+	genericProto := g.createP2PProtobuf()
+	salts, err := g.getGenericSalts()
+	if err != nil {
+		return nil, err
+	}
+	t := documents.NewDefaultTreeWithPrefix(salts, prefix, compactPrefix())
+	err = t.AddLeavesFromDocument(genericProto)
+	if err != nil {
+		return nil, errors.New("getDocumentDataTree error %v", err)
+	}
+	err = t.Generate()
+	if err != nil {
+		return nil, errors.New("getDocumentDataTree error %v", err)
+	}
+	return t, nil
+}
+
+// CreateProofs generates proofs for given fields.
+func (g *Generic) CreateProofs(fields []string) (proofs []*proofspb.Proof, err error) {
+	tree, err := g.getDocumentDataTree()
+	if err != nil {
+		return nil, errors.New("createProofs error %v", err)
+	}
+
+	return g.CoreDocument.CreateProofs(g.DocumentType(), tree, fields)
+}
+
+// DocumentType returns the generic document type.
+func (*Generic) DocumentType() string {
+	return DocumentTypeURL
+}
+
+// DataTreePrefix returns the readable and compact prefix of the generic data tree.
+func (*Generic) DataTreePrefix() (string, []byte) {
+	return prefix, compactPrefix()
+}
+
+// DataTreeLeaves returns the number of leaves of the data tree of the generic document.
+func (g *Generic) DataTreeLeaves() (int, error) {
+	t, err := g.getDocumentDataTree()
+	if err != nil {
+		return 0, err
+	}
+
+	return len(t.PropertyOrder()), nil
+}
+
+// PrepareNewVersion prepares new version from the old generic document.
+func (g *Generic) PrepareNewVersion(old documents.Model, data *genericpb.GenericData, collaborators documents.CollaboratorsAccess) error {
+	err := g.initGenericFromData(data)
+	if err != nil {
+		return err
+	}
+
+	oldGeneric, ok := old.(*Generic)
+	if !ok {
+		return errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("expecting a generic document but got %T", old))
+	}
+
+	g.CoreDocument, err = oldGeneric.CoreDocument.PrepareNewVersion(collaborators, true, compactPrefix())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AddNFT adds NFT to the Generic document.
+func (g *Generic) AddNFT(grantReadAccess bool, registry common.Address, tokenID []byte) error {
+	cd, err := g.CoreDocument.AddNFT(grantReadAccess, registry, tokenID)
+	if err != nil {
+		return err
+	}
+
+	g.CoreDocument = cd
+	return nil
+}
+
+// LinkDocument links the anchored document root of another document to the Generic.
+func (g *Generic) LinkDocument(documentID, documentRoot []byte) error {
+	cd, err := g.CoreDocument.AddLink(documentID, documentRoot)
+	if err != nil {
+		return err
+	}
+
+	g.CoreDocument = cd
+	return nil
+}
+
+// AddAttributeSignature adds the signature of a third party over one of the attributes to the Generic.
+func (g *Generic) AddAttributeSignature(sig *coredocumentextpb.AttributeSignature) error {
+	cd, err := g.CoreDocument.AddAttributeSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	g.CoreDocument = cd
+	return nil
+}
+
+// AddTransferDetail adds the detail of a transfer against the Generic, replacing an earlier detail of the transfer.
+func (g *Generic) AddTransferDetail(detail *coredocumentextpb.TransferDetail) error {
+	cd, err := g.CoreDocument.AddTransferDetail(detail)
+	if err != nil {
+		return err
+	}
+
+	g.CoreDocument = cd
+	return nil
+}
+
+// AddAttachment adds the attachment to the Generic, replacing an earlier attachment with the same name.
+func (g *Generic) AddAttachment(attachment *coredocumentextpb.Attachment) error {
+	cd, err := g.CoreDocument.AddAttachment(attachment)
+	if err != nil {
+		return err
+	}
+
+	g.CoreDocument = cd
+	return nil
+}
+
+// UpdateTags adds and removes the tags of the Generic on behalf of the account.
+func (g *Generic) UpdateTags(account identity.DID, add, remove []string) error {
+	cd, err := g.CoreDocument.UpdateTags(account, g.DocumentType(), add, remove)
+	if err != nil {
+		return err
+	}
+
+	g.CoreDocument = cd
+	return nil
+}
+
+// Cancel cancels the Generic, optionally superseded by the document with supersededBy.
+func (g *Generic) Cancel(supersededBy []byte) error {
+	cd, err := g.CoreDocument.Cancel(supersededBy)
+	if err != nil {
+		return err
+	}
+
+	g.CoreDocument = cd
+	return nil
+}
+
+// RemoveCollaborators removes the collaborators from the read and transition rules of the next version of the Generic.
+func (g *Generic) RemoveCollaborators(collaborators []identity.DID) error {
+	cd, err := g.CoreDocument.RemoveCollaborators(collaborators)
+	if err != nil {
+		return err
+	}
+
+	g.CoreDocument = cd
+	return nil
+}
+
+// CalculateSigningRoot returns the signing root of the document.
+// Calculates it if not generated yet.
+func (g *Generic) CalculateSigningRoot() ([]byte, error) {
+	return g.CoreDocument.CalculateSigningRoot(g.DocumentType())
+}
+
+// CreateNFTProofs creates proofs specific to NFT minting.
+func (g *Generic) CreateNFTProofs(
+	account identity.DID,
+	registry common.Address,
+	tokenID []byte,
+	nftUniqueProof, readAccessProof bool) (proofs []*proofspb.Proof, err error) {
+	return g.CoreDocument.CreateNFTProofs(
+		g.DocumentType(),
+		account, registry, tokenID, nftUniqueProof, readAccessProof)
+}
+
+// CollaboratorCanUpdate checks if the account can update the document.
+func (g *Generic) CollaboratorCanUpdate(updated documents.Model, collaborator identity.DID) error {
+	cf, err := g.ChangedFields(updated)
+	if err != nil {
+		return err
+	}
+
+	rules := g.CoreDocument.TransitionRulesFor(collaborator)
+	return documents.ValidateTransitions(rules, cf)
+}
+
+// ChangedFields returns the core document and generic document fields changed in the updated generic document.
+func (g *Generic) ChangedFields(updated documents.Model) ([]documents.ChangedField, error) {
+	newGeneric, ok := updated.(*Generic)
+	if !ok {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("expecting a generic document but got %T", updated))
+	}
+
+	cf, err := g.CoreDocument.GetChangedFields(newGeneric.CoreDocument, g.DocumentType())
+	if err != nil {
+		return nil, err
+	}
+
+	oldTree, err := g.getDocumentDataTree()
+	if err != nil {
+		return nil, err
+	}
+
+	newTree, err := newGeneric.getDocumentDataTree()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(cf, documents.GetChangedFields(oldTree, newTree, proofs.DefaultSaltsLengthSuffix)...), nil
+}
+
+// AddUpdateLog adds a log to the model to persist an update related meta data such as author
+func (g *Generic) AddUpdateLog(account identity.DID) (err error) {
+	return g.CoreDocument.AddUpdateLog(account)
+}
+
+// Author is the author of the document version represented by the model
+func (g *Generic) Author() identity.DID {
+	return g.CoreDocument.Author()
+}
+
+// Timestamp is the time of update in UTC of the document version represented by the model
+func (g *Generic) Timestamp() (time.Time, error) {
+	return g.CoreDocument.Timestamp()
+}
+
+// SummaryStatus returns the status of the generic document. Generic documents have no status.
+func (g *Generic) SummaryStatus() string {
+	return ""
+}
+
+// SummaryFields returns the attributes of the generic document.
+func (g *Generic) SummaryFields() (fields []documents.SummaryField) {
+	for _, attr := range g.Attributes {
+		fields = documents.AppendSummaryField(fields, attr.Key, attr.clientValue())
+	}
+
+	return fields
+}
+
+// SearchAttributes returns the attributes of the generic document in the client format.
+func (g *Generic) SearchAttributes() map[string]string {
+	attrs := make(map[string]string)
+	for _, attr := range g.Attributes {
+		documents.SetSearchAttribute(attrs, attr.Key, attr.clientValue())
+	}
+
+	return attrs
+}
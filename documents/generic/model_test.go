@@ -0,0 +1,298 @@
+// +build unit
+
+package generic
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/bootstrap/bootstrappers/testlogging"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/ethereum"
+	"github.com/centrifuge/go-centrifuge/identity/ideth"
+	"github.com/centrifuge/go-centrifuge/nft"
+	"github.com/centrifuge/go-centrifuge/p2p"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/generic"
+	"github.com/centrifuge/go-centrifuge/queue"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/testingutils/testingtx"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/centrifuge/precise-proofs/proofs"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var ctx = map[string]interface{}{}
+var cfg config.Configuration
+var defaultDID = testingidentity.GenerateRandomDID()
+
+func TestMain(m *testing.M) {
+	ethClient := &testingcommons.MockEthClient{}
+	ethClient.On("GetEthClient").Return(nil)
+	ctx[ethereum.BootstrappedEthereumClient] = ethClient
+	txMan := &testingtx.MockTxManager{}
+	ctx[transactions.BootstrappedService] = txMan
+	done := make(chan bool)
+	txMan.On("ExecuteWithinTX", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(transactions.NilTxID(), done, nil)
+	ctx[nft.BootstrappedPayObService] = new(testingdocuments.MockRegistry)
+	ibootstrappers := []bootstrap.TestBootstrapper{
+		&testlogging.TestLoggingBootstrapper{},
+		&config.Bootstrapper{},
+		&leveldb.Bootstrapper{},
+		&queue.Bootstrapper{},
+		&ideth.Bootstrapper{},
+		&configstore.Bootstrapper{},
+		anchors.Bootstrapper{},
+		documents.Bootstrapper{},
+		p2p.Bootstrapper{},
+		documents.PostBootstrapper{},
+		&Bootstrapper{},
+		&queue.Starter{},
+	}
+	bootstrap.RunTestBootstrappers(ibootstrappers, ctx)
+	cfg = ctx[bootstrap.BootstrappedConfig].(config.Configuration)
+	cfg.Set("identityId", cid.String())
+	result := m.Run()
+	bootstrap.RunTestTeardown(ibootstrappers)
+	os.Exit(result)
+}
+
+func createPayload() *genericpb.GenericCreatePayload {
+	return &genericpb.GenericCreatePayload{
+		Collaborators: []string{testingidentity.GenerateRandomDID().String()},
+		Data: &genericpb.GenericData{
+			Attributes: []*genericpb.Attribute{
+				{Key: "reference", Type: "string", Value: "REF-1"},
+				{Key: "amount", Type: "decimal", Value: "1000.25"},
+				{Key: "payload", Type: "bytes", Value: "0x010203"},
+				{Key: "due_date", Type: "timestamp", Value: "2019-05-01T10:00:00Z"},
+			},
+		},
+	}
+}
+
+func createGeneric(t *testing.T) *Generic {
+	g := new(Generic)
+	err := g.InitGenericInput(createPayload(), defaultDID.String())
+	assert.NoError(t, err)
+	_, err = g.CalculateDataRoot()
+	assert.NoError(t, err)
+	_, err = g.CalculateSigningRoot()
+	assert.NoError(t, err)
+	_, err = g.CalculateDocumentRoot()
+	assert.NoError(t, err)
+	return g
+}
+
+func TestNewAttribute(t *testing.T) {
+	tests := []struct {
+		attrType, value string
+		valid           bool
+	}{
+		{"string", "", true},
+		{"string", "some value", true},
+		{"bytes", "0x0102", true},
+		{"bytes", "some value", false},
+		{"decimal", "100", true},
+		{"decimal", "-100.001", true},
+		{"decimal", "1e10", false},
+		{"decimal", "100.", false},
+		{"timestamp", "2019-05-01T10:00:00+02:00", true},
+		{"timestamp", "2019-05-01", false},
+		{"int", "1", false},
+	}
+
+	for _, c := range tests {
+		attr, err := newAttribute("key", c.attrType, c.value)
+		if !c.valid {
+			assert.Error(t, err, c.attrType+" "+c.value)
+			continue
+		}
+
+		assert.NoError(t, err, c.attrType+" "+c.value)
+		if c.attrType == "timestamp" {
+			assert.Equal(t, "2019-05-01T08:00:00Z", attr.clientValue())
+			continue
+		}
+
+		assert.Equal(t, c.value, attr.clientValue())
+	}
+}
+
+func TestGeneric_InitGenericInput(t *testing.T) {
+	// invalid attribute
+	payload := createPayload()
+	payload.Data.Attributes[1].Value = "some amount"
+	g := new(Generic)
+	err := g.InitGenericInput(payload, defaultDID.String())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "attribute amount is not a valid decimal")
+
+	// invalid collaborator
+	payload = createPayload()
+	payload.Collaborators = []string{"some id"}
+	err = g.InitGenericInput(payload, defaultDID.String())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to decode collaborator")
+
+	// success, attributes are sorted by key
+	err = g.InitGenericInput(createPayload(), defaultDID.String())
+	assert.NoError(t, err)
+	var keys []string
+	for _, attr := range g.Attributes {
+		keys = append(keys, attr.Key)
+	}
+	assert.Equal(t, []string{"amount", "due_date", "payload", "reference"}, keys)
+	assert.Equal(t, []byte{1, 2, 3}, g.Attributes[2].BytesValue)
+	assert.Equal(t, int64(1556704800), g.Attributes[1].TimeValue.Seconds)
+}
+
+func TestGeneric_getClientData(t *testing.T) {
+	g := new(Generic)
+	payload := createPayload()
+	assert.NoError(t, g.InitGenericInput(payload, defaultDID.String()))
+	data := g.getClientData()
+	assert.Len(t, data.Attributes, len(payload.Data.Attributes))
+	for _, a := range payload.Data.Attributes {
+		assert.Contains(t, data.Attributes, a)
+	}
+
+	// same data tree regardless of the order sent by the client
+	g1 := new(Generic)
+	assert.NoError(t, g1.InitGenericInput(&genericpb.GenericCreatePayload{Data: data}, defaultDID.String()))
+	assert.Equal(t, g.createP2PProtobuf(), g1.createP2PProtobuf())
+}
+
+func TestGeneric_PackUnpackCoreDocument(t *testing.T) {
+	model := new(Generic)
+
+	// embed data missing
+	err := model.UnpackCoreDocument(coredocumentpb.CoreDocument{})
+	assert.Error(t, err)
+
+	// embed data type is wrong
+	err = model.UnpackCoreDocument(coredocumentpb.CoreDocument{EmbeddedData: new(any.Any)})
+	assert.Error(t, err)
+
+	// embed data is wrong
+	err = model.UnpackCoreDocument(coredocumentpb.CoreDocument{
+		EmbeddedData: &any.Any{
+			Value:   utils.RandomSlice(32),
+			TypeUrl: DocumentTypeURL,
+		},
+	})
+	assert.Error(t, err)
+
+	// successful
+	g, cd := createCDWithEmbeddedGeneric(t)
+	assert.NotNil(t, cd.EmbeddedDataSalts)
+	err = model.UnpackCoreDocument(cd)
+	assert.NoError(t, err)
+	assert.Equal(t, g.(*Generic).getClientData(), model.getClientData())
+	assert.Equal(t, g.(*Generic).GenericSalts, model.GenericSalts)
+	assert.Equal(t, g.ID(), model.ID())
+	assert.Equal(t, g.CurrentVersion(), model.CurrentVersion())
+}
+
+func TestGeneric_JSON(t *testing.T) {
+	g := createGeneric(t)
+	cd, err := g.PackCoreDocument()
+	assert.NoError(t, err)
+	jsonBytes, err := g.JSON()
+	assert.NoError(t, err)
+	assert.True(t, json.Valid(jsonBytes))
+
+	g = new(Generic)
+	assert.NoError(t, g.FromJSON(jsonBytes))
+	ncd, err := g.PackCoreDocument()
+	assert.NoError(t, err)
+	assert.Equal(t, cd, ncd)
+}
+
+func TestGeneric_CreateProofs(t *testing.T) {
+	g := createGeneric(t)
+	proof, err := g.CreateProofs([]string{"generic.attributes[0].decimal_value", "generic.attributes[3].string_value"})
+	assert.NoError(t, err)
+	assert.Len(t, proof, 2)
+	tree, err := g.DocumentRootTree()
+	assert.NoError(t, err)
+	for _, p := range proof {
+		valid, err := tree.ValidateProof(p)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+
+	// field does not exist
+	_, err = g.CreateProofs([]string{"generic.attributes[4].key"})
+	assert.Error(t, err)
+}
+
+func TestGeneric_CollaboratorCanUpdate(t *testing.T) {
+	g := createGeneric(t)
+	id1 := defaultDID
+	id2 := testingidentity.GenerateRandomDID()
+
+	// wrong type
+	err := g.CollaboratorCanUpdate(new(testingdocuments.MockModel), id1)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalidType, err))
+
+	data := g.getClientData()
+	data.Attributes[0].Value = "2000"
+	newG := new(Generic)
+	assert.NoError(t, newG.PrepareNewVersion(g, data, documents.CollaboratorsAccess{}))
+
+	// id1 should have permission
+	assert.NoError(t, g.CollaboratorCanUpdate(newG, id1))
+
+	// id2 should fail since it is not a collaborator
+	assert.Error(t, g.CollaboratorCanUpdate(newG, id2))
+}
+
+func TestPropertyMappings(t *testing.T) {
+	mappings, err := PropertyMappings()
+	assert.NoError(t, err)
+	names := make(map[string]string)
+	for _, m := range mappings {
+		names[m.ReadableName] = m.CompactName
+	}
+
+	assert.Equal(t, hexutil.Encode(append(compactPrefix(), 0, 0, 0, 1)), names["generic.attributes.length"])
+	elem := fmt.Sprintf(proofs.ElemFormat, "generic.attributes", documents.IndexPlaceholder)
+	assert.Equal(t, hexutil.Encode(append(compactPrefix(), 0, 0, 0, 1))+documents.IndexPlaceholder+"00000005", names[elem+".decimal_value"])
+}
+
+func TestGeneric_Summary(t *testing.T) {
+	g := createGeneric(t)
+	assert.Empty(t, g.SummaryStatus())
+	assert.Equal(t, []documents.SummaryField{
+		{Name: "amount", Value: "1000.25"},
+		{Name: "due_date", Value: "2019-05-01T10:00:00Z"},
+		{Name: "payload", Value: "0x010203"},
+		{Name: "reference", Value: "REF-1"},
+	}, g.SummaryFields())
+}
+
+func TestGeneric_SearchAttributes(t *testing.T) {
+	g := createGeneric(t)
+	assert.Equal(t, map[string]string{
+		"amount":    "1000.25",
+		"due_date":  "2019-05-01T10:00:00Z",
+		"payload":   "0x010203",
+		"reference": "REF-1",
+	}, g.SearchAttributes())
+}
@@ -0,0 +1,330 @@
+package generic
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/generic"
+	"github.com/centrifuge/go-centrifuge/queue"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/jsonpb"
+)
+
+// Service defines specific functions for generic document
+type Service interface {
+	documents.SchemeService
+
+	// DeriveFromCreatePayload derives generic document from create payload
+	DeriveFromCreatePayload(ctx context.Context, payload *genericpb.GenericCreatePayload) (documents.Model, error)
+
+	// DeriveFromUpdatePayload derives generic document from update payload
+	DeriveFromUpdatePayload(ctx context.Context, payload *genericpb.GenericUpdatePayload) (documents.Model, error)
+
+	// DeriveGenericData returns the generic document data as client data
+	DeriveGenericData(g documents.Model) (*genericpb.GenericData, error)
+
+	// DeriveGenericResponse returns the generic document in our standard client format
+	DeriveGenericResponse(g documents.Model) (*genericpb.GenericResponse, error)
+}
+
+// service implements Service and handles all generic document related persistence and validations
+// service always returns errors of type `errors.Error` or `errors.TypedError`
+type service struct {
+	documents.Service
+	repo      documents.Repository
+	queueSrv  queue.TaskQueuer
+	txManager transactions.Manager
+}
+
+// DefaultService returns the default implementation of the service
+func DefaultService(
+	srv documents.Service,
+	repo documents.Repository,
+	queueSrv queue.TaskQueuer,
+	txManager transactions.Manager,
+) Service {
+	return service{
+		repo:      repo,
+		queueSrv:  queueSrv,
+		txManager: txManager,
+		Service:   srv,
+	}
+}
+
+// Scheme returns the scheme of the generic documents in the document api
+func (s service) Scheme() string {
+	return Scheme
+}
+
+// PropertyMappings returns the readable to compact property mappings of the generic document data tree.
+func (s service) PropertyMappings() ([]documents.PropertyMapping, error) {
+	return PropertyMappings()
+}
+
+// ValidateFields validates the data fields of the generic document.
+func (s service) ValidateFields(model documents.Model) error {
+	return fieldValidator().Validate(nil, model)
+}
+
+// DeriveFromCoreDocument takes a core document model and returns a generic document
+func (s service) DeriveFromCoreDocument(cd coredocumentpb.CoreDocument) (documents.Model, error) {
+	g := new(Generic)
+	err := g.UnpackCoreDocument(cd)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentUnPackingCoreDocument, err)
+	}
+
+	return g, nil
+}
+
+// validateAndPersist validates the document, and persists to DB
+func (s service) validateAndPersist(ctx context.Context, old, new documents.Model, validator documents.Validator) (documents.Model, error) {
+	selfDID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentConfigAccountID, err)
+	}
+
+	g, ok := new.(*Generic)
+	if !ok {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("unknown document type: %T", new))
+	}
+
+	// validate the generic document
+	err = validator.Validate(old, g)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
+	}
+
+	// new versions are persisted as drafts, their roots let the next versions refer to them
+	err = documents.CalculateDraftRoots(g)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
+	}
+
+	// we use CurrentVersion as the id since that will be unique across multiple versions of the same document
+	err = s.repo.Create(selfDID[:], g.CurrentVersion(), g)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentPersistence, err)
+	}
+
+	return g, nil
+}
+
+// CreateDraft validates and persists a generic document as a draft without anchoring it
+func (s service) CreateDraft(ctx context.Context, g documents.Model) (documents.Model, error) {
+	err := s.RunHooks(ctx, documents.HookPreCreate, g)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err = s.validateAndPersist(ctx, nil, g, CreateValidator())
+	if err != nil {
+		return nil, err
+	}
+
+	s.EmitEvent(ctx, documents.NewEvent(documents.EventDocumentCreated, g))
+	return g, nil
+}
+
+// UpdateDraft validates and persists a new version of a generic document as a draft without anchoring it
+func (s service) UpdateDraft(ctx context.Context, new documents.Model) (documents.Model, error) {
+	old, err := s.GetCurrentVersion(ctx, new.ID())
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentNotFound, err)
+	}
+
+	return s.validateAndPersist(ctx, old, new, UpdateValidator())
+}
+
+// Commit anchors the current version of a generic document if it is a draft
+func (s service) Commit(ctx context.Context, documentID []byte) (documents.Model, transactions.TxID, chan bool, error) {
+	return documents.CommitDraft(ctx, s, s.txManager, s.queueSrv, documentID)
+}
+
+// Create validates, persists, and anchors a generic document
+func (s service) Create(ctx context.Context, g documents.Model) (documents.Model, transactions.TxID, chan bool, error) {
+	selfDID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(documents.ErrDocumentConfigAccountID, err)
+	}
+
+	g, err = s.CreateDraft(ctx, g)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	txID := contextutil.TX(ctx)
+	txID, done, err := documents.CreateAnchorTransaction(s.txManager, s.queueSrv, selfDID, txID, g.CurrentVersion())
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+	return g, txID, done, nil
+}
+
+// Update validates, persists, and anchors a new version of generic document
+func (s service) Update(ctx context.Context, new documents.Model) (documents.Model, transactions.TxID, chan bool, error) {
+	selfDID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(documents.ErrDocumentConfigAccountID, err)
+	}
+
+	new, err = s.UpdateDraft(ctx, new)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	txID := contextutil.TX(ctx)
+	txID, done, err := documents.CreateAnchorTransaction(s.txManager, s.queueSrv, selfDID, txID, new.CurrentVersion())
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+	return new, txID, done, nil
+}
+
+// DeriveFromCreatePayload derives generic document from create payload
+func (s service) DeriveFromCreatePayload(ctx context.Context, payload *genericpb.GenericCreatePayload) (documents.Model, error) {
+	if payload == nil || payload.Data == nil {
+		return nil, documents.ErrDocumentNil
+	}
+
+	selfDID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, documents.ErrDocumentConfigAccountID
+	}
+
+	g := new(Generic)
+	err = g.InitGenericInput(payload, selfDID.String())
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
+	}
+
+	return g, nil
+}
+
+// DeriveFromUpdatePayload derives generic document from update payload
+func (s service) DeriveFromUpdatePayload(ctx context.Context, payload *genericpb.GenericUpdatePayload) (documents.Model, error) {
+	if payload == nil || payload.Data == nil {
+		return nil, documents.ErrDocumentNil
+	}
+
+	// get latest old version of the document
+	id, err := identifiers.DecodeDocumentID(payload.Identifier)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentIdentifier, errors.New("failed to decode identifier: %v", err))
+	}
+
+	old, err := s.GetCurrentVersion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// load generic document data
+	g := new(Generic)
+	err = g.PrepareNewVersion(old, payload.Data, documents.NewCollaboratorsAccess(payload.Collaborators, payload.ReadAccess, payload.WriteAccess))
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, errors.New("failed to load generic document from data: %v", err))
+	}
+
+	return g, nil
+}
+
+// DeriveGenericData returns the generic data from the model
+func (s service) DeriveGenericData(doc documents.Model) (*genericpb.GenericData, error) {
+	g, ok := doc.(*Generic)
+	if !ok {
+		return nil, documents.ErrDocumentInvalidType
+	}
+
+	return g.getClientData(), nil
+}
+
+// DeriveGenericResponse returns the generic response from the model
+func (s service) DeriveGenericResponse(doc documents.Model) (*genericpb.GenericResponse, error) {
+	data, err := s.DeriveGenericData(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	cs, err := doc.GetCollaborators()
+	if err != nil {
+		return nil, err
+	}
+
+	var css []string
+	for _, c := range cs {
+		css = append(css, c.String())
+	}
+
+	h := &genericpb.ResponseHeader{
+		DocumentId:    hexutil.Encode(doc.ID()),
+		VersionId:     hexutil.Encode(doc.CurrentVersion()),
+		State:         string(doc.GetStatus()),
+		Collaborators: css,
+	}
+
+	return &genericpb.GenericResponse{
+		Header: h,
+		Data:   data,
+	}, nil
+}
+
+// DeriveFromClientData derives generic document from the json encoded generic data
+func (s service) DeriveFromClientData(ctx context.Context, collaborators documents.CollaboratorsAccess, data []byte) (documents.Model, error) {
+	gd, err := unmarshalGenericData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DeriveFromCreatePayload(ctx, &genericpb.GenericCreatePayload{
+		Collaborators: collaborators.ReadWriteCollaborators,
+		ReadAccess:    collaborators.ReadCollaborators,
+		Data:          gd,
+	})
+}
+
+// DeriveFromUpdateClientData derives the next version of the generic document from the json encoded generic data
+func (s service) DeriveFromUpdateClientData(ctx context.Context, identifier string, collaborators documents.CollaboratorsAccess, data []byte) (documents.Model, error) {
+	gd, err := unmarshalGenericData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DeriveFromUpdatePayload(ctx, &genericpb.GenericUpdatePayload{
+		Identifier:    identifier,
+		Collaborators: collaborators.ReadWriteCollaborators,
+		ReadAccess:    collaborators.ReadCollaborators,
+		Data:          gd,
+	})
+}
+
+// DeriveClientData returns the json encoded generic data of the model
+func (s service) DeriveClientData(doc documents.Model) ([]byte, error) {
+	data, err := s.DeriveGenericData(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = (&jsonpb.Marshaler{OrigName: true}).Marshal(&buf, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func unmarshalGenericData(data []byte) (*genericpb.GenericData, error) {
+	gd := new(genericpb.GenericData)
+	err := jsonpb.Unmarshal(bytes.NewReader(data), gd)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
+	}
+
+	return gd, nil
+}
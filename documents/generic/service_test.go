@@ -0,0 +1,210 @@
+// +build unit
+
+package generic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/generic"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/centrifuge/gocelery"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	cid       = testingidentity.GenerateRandomDID()
+	accountID = cid[:]
+)
+
+type mockAnchorRepo struct {
+	mock.Mock
+	anchors.AnchorRepository
+}
+
+func (r *mockAnchorRepo) GetAnchorData(anchorID anchors.AnchorID) (docRoot anchors.DocumentRoot, anchoredTime time.Time, err error) {
+	args := r.Called(anchorID)
+	docRoot, _ = args.Get(0).(anchors.DocumentRoot)
+	anchoredTime, _ = args.Get(1).(time.Time)
+	return docRoot, anchoredTime, args.Error(2)
+}
+
+func getServiceWithMockedLayers() Service {
+	idService := &testingcommons.MockIdentityService{}
+	queueSrv := new(testingutils.MockQueue)
+	queueSrv.On("EnqueueJob", mock.Anything, mock.Anything).Return(&gocelery.AsyncResult{}, nil)
+	txManager := ctx[transactions.BootstrappedService].(transactions.Manager)
+	repo := testRepo()
+	docSrv := documents.DefaultService(repo, &mockAnchorRepo{}, documents.NewServiceRegistry(), idService, 0, "", 0, nil, nil, nil, nil, nil)
+	return DefaultService(docSrv, repo, queueSrv, txManager)
+}
+
+func TestService_DeriveFromCreatePayload(t *testing.T) {
+	srv := service{}
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+
+	// nil payload
+	m, err := srv.DeriveFromCreatePayload(ctxh, nil)
+	assert.Nil(t, m)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentNil, err))
+
+	// nil data payload
+	m, err = srv.DeriveFromCreatePayload(ctxh, &genericpb.GenericCreatePayload{})
+	assert.Nil(t, m)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentNil, err))
+
+	// invalid attribute
+	payload := createPayload()
+	payload.Data.Attributes[0].Type = "int"
+	m, err = srv.DeriveFromCreatePayload(ctxh, payload)
+	assert.Nil(t, m)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalid, err))
+
+	// success
+	m, err = srv.DeriveFromCreatePayload(ctxh, createPayload())
+	assert.NoError(t, err)
+	assert.Len(t, m.(*Generic).Attributes, 4)
+}
+
+func TestService_Create(t *testing.T) {
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	srv := getServiceWithMockedLayers()
+
+	// unknown type
+	m, _, _, err := srv.Create(ctxh, &testingdocuments.MockModel{})
+	assert.Nil(t, m)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown document type")
+
+	// duplicate keys
+	payload := createPayload()
+	payload.Data.Attributes[1].Key = payload.Data.Attributes[0].Key
+	g, err := srv.DeriveFromCreatePayload(ctxh, payload)
+	assert.NoError(t, err)
+	m, _, _, err = srv.Create(ctxh, g)
+	assert.Nil(t, m)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalid, err))
+
+	// success
+	g, err = srv.DeriveFromCreatePayload(ctxh, createPayload())
+	assert.NoError(t, err)
+	m, _, _, err = srv.Create(ctxh, g)
+	assert.NoError(t, err)
+	assert.NotNil(t, m)
+	assert.True(t, testRepo().Exists(accountID, g.CurrentVersion()))
+}
+
+func TestService_Update(t *testing.T) {
+	srv := getServiceWithMockedLayers()
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+
+	// missing last version
+	g, _ := createCDWithEmbeddedGeneric(t)
+	_, _, _, err := srv.Update(ctxh, g)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentNotFound, err))
+	assert.NoError(t, testRepo().Create(accountID, g.CurrentVersion(), g))
+
+	// invalid identifier
+	data, err := srv.DeriveGenericData(g)
+	assert.NoError(t, err)
+	_, err = srv.DeriveFromUpdatePayload(ctxh, &genericpb.GenericUpdatePayload{Identifier: "some identifier", Data: data})
+	assert.True(t, errors.IsOfType(documents.ErrDocumentIdentifier, err))
+
+	// success
+	data.Attributes = append(data.Attributes, &genericpb.Attribute{Key: "comment", Type: "string", Value: "new attribute"})
+	newG, err := srv.DeriveFromUpdatePayload(ctxh, &genericpb.GenericUpdatePayload{
+		Identifier: hexutil.Encode(g.ID()),
+		Data:       data,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, g.CurrentVersion(), newG.PreviousVersion())
+	newG, _, _, err = srv.Update(ctxh, newG)
+	assert.NoError(t, err)
+	assert.True(t, testRepo().Exists(accountID, newG.CurrentVersion()))
+
+	resp, err := srv.DeriveGenericResponse(newG)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(g.ID()), resp.Header.DocumentId)
+	assert.Len(t, resp.Data.Attributes, 5)
+	assert.Equal(t, "comment", resp.Data.Attributes[1].Key)
+}
+
+func TestService_ClientData(t *testing.T) {
+	srv := service{}
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	assert.Equal(t, Scheme, srv.Scheme())
+
+	// invalid json
+	_, err := srv.DeriveFromClientData(ctxh, documents.CollaboratorsAccess{}, []byte(`{"attributes": "none"}`))
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalid, err))
+
+	// success
+	m, err := srv.DeriveFromClientData(ctxh, documents.CollaboratorsAccess{}, []byte(`{"attributes": [{"key": "comment", "type": "string", "value": "hello"}]}`))
+	assert.NoError(t, err)
+	assert.Len(t, m.(*Generic).Attributes, 1)
+
+	data, err := srv.DeriveClientData(m)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"attributes": [{"key": "comment", "type": "string", "value": "hello"}]}`, string(data))
+
+	// unknown type
+	_, err = srv.DeriveClientData(&testingdocuments.MockModel{})
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalidType, err))
+
+	// invalid identifier
+	_, err = srv.DeriveFromUpdateClientData(ctxh, "some identifier", documents.CollaboratorsAccess{}, data)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentIdentifier, err))
+}
+
+func TestService_DeriveFromCoreDocument(t *testing.T) {
+	srv := service{}
+	g, cd := createCDWithEmbeddedGeneric(t)
+	m, err := srv.DeriveFromCoreDocument(cd)
+	assert.NoError(t, err)
+	assert.Equal(t, g.(*Generic).getClientData(), m.(*Generic).getClientData())
+
+	cd.EmbeddedData.TypeUrl = "wrong type"
+	_, err = srv.DeriveFromCoreDocument(cd)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentUnPackingCoreDocument, err))
+}
+
+var testRepoGlobal documents.Repository
+
+func testRepo() documents.Repository {
+	if testRepoGlobal == nil {
+		ldb, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+		if err != nil {
+			panic(err)
+		}
+		testRepoGlobal = documents.NewDBRepository(leveldb.NewLevelDBRepository(ldb))
+		testRepoGlobal.Register(&Generic{})
+	}
+	return testRepoGlobal
+}
+
+func createCDWithEmbeddedGeneric(t *testing.T) (documents.Model, coredocumentpb.CoreDocument) {
+	g := new(Generic)
+	err := g.InitGenericInput(createPayload(), cid.String())
+	assert.NoError(t, err)
+	_, err = g.CalculateDataRoot()
+	assert.NoError(t, err)
+	_, err = g.CalculateSigningRoot()
+	assert.NoError(t, err)
+	_, err = g.CalculateDocumentRoot()
+	assert.NoError(t, err)
+	cd, err := g.PackCoreDocument()
+	assert.NoError(t, err)
+	return g, cd
+}
@@ -0,0 +1,57 @@
+package generic
+
+import (
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+)
+
+// fieldValidator validates the attributes of the generic document.
+// Keys must be set and unique, and the value must match the type of the attribute.
+func fieldValidator() documents.Validator {
+	return documents.ValidatorFunc(func(_, new documents.Model) error {
+		if new == nil {
+			return errors.New("nil document")
+		}
+
+		g, ok := new.(*Generic)
+		if !ok {
+			return errors.New("unknown document type")
+		}
+
+		var err error
+		keys := make(map[string]struct{})
+		for _, attr := range g.Attributes {
+			if attr.Key == "" {
+				err = errors.AppendError(err, documents.NewError("generic_attribute_key", "empty attribute key"))
+				continue
+			}
+
+			if _, ok := keys[attr.Key]; ok {
+				err = errors.AppendError(err, documents.NewError("generic_attribute_key", "duplicate attribute key "+attr.Key))
+				continue
+			}
+			keys[attr.Key] = struct{}{}
+
+			if _, aerr := newAttribute(attr.Key, string(attr.Type), attr.clientValue()); aerr != nil {
+				err = errors.AppendError(err, documents.NewError("generic_attribute_value", aerr.Error()))
+			}
+		}
+
+		return err
+	})
+}
+
+// CreateValidator returns a validator group that should be run before creating the generic document and persisting it to DB
+func CreateValidator() documents.ValidatorGroup {
+	return documents.ValidatorGroup{
+		fieldValidator(),
+	}
+}
+
+// UpdateValidator returns a validator group that should be run before updating the generic document
+func UpdateValidator() documents.ValidatorGroup {
+	return documents.ValidatorGroup{
+		fieldValidator(),
+		documents.UpdateVersionValidator(),
+	}
+}
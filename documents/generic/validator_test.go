@@ -0,0 +1,47 @@
+// +build unit
+
+package generic
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldValidator_Validate(t *testing.T) {
+	fv := fieldValidator()
+
+	// nil
+	err := fv.Validate(nil, nil)
+	assert.Error(t, err)
+
+	// unknown type
+	err = fv.Validate(nil, &testingdocuments.MockModel{})
+	assert.Error(t, err)
+
+	// empty, duplicate and invalid attributes
+	g := &Generic{Attributes: []Attribute{
+		{Key: "", Type: AttrString},
+		{Key: "amount", Type: AttrDecimal, DecimalValue: "1.5"},
+		{Key: "amount", Type: AttrDecimal, DecimalValue: "2"},
+		{Key: "total", Type: AttrDecimal, DecimalValue: "two"},
+		{Key: "count", Type: "int"},
+	}}
+	err = fv.Validate(nil, g)
+	assert.Error(t, err)
+	assert.Equal(t, 4, errors.Len(err))
+
+	// success
+	g.Attributes = g.Attributes[1:2]
+	assert.NoError(t, fv.Validate(nil, g))
+}
+
+func TestCreateValidator(t *testing.T) {
+	assert.Len(t, CreateValidator(), 1)
+}
+
+func TestUpdateValidator(t *testing.T) {
+	assert.Len(t, UpdateValidator(), 2)
+}
@@ -0,0 +1,206 @@
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// linkIndexPrefix is the key prefix of the link index entries
+const linkIndexPrefix = "doclink_"
+
+// LinkType is the type of a link between a document and a related document or NFT.
+type LinkType string
+
+const (
+	// LinkTypeDocument is a link to the anchored document root of another document.
+	LinkTypeDocument LinkType = "document"
+
+	// LinkTypeNFT is an NFT minted on the document.
+	LinkTypeNFT LinkType = "nft"
+)
+
+// LinkDirection is the direction of a link as seen from the document the link is indexed for.
+type LinkDirection string
+
+const (
+	// LinkOutgoing is a link from the document to the related document or NFT.
+	LinkOutgoing LinkDirection = "outgoing"
+
+	// LinkIncoming is a link from the related document to the document.
+	LinkIncoming LinkDirection = "incoming"
+)
+
+// Link is an entry of the link index of a document.
+type Link struct {
+	LinkType  LinkType      `json:"type"`
+	Direction LinkDirection `json:"direction"`
+
+	// DocumentID is the related document of a document link.
+	DocumentID []byte `json:"document_id,omitempty"`
+
+	// Registry and TokenID identify the NFT of an NFT link.
+	Registry common.Address `json:"registry,omitempty"`
+	TokenID  []byte         `json:"token_id,omitempty"`
+}
+
+// JSON returns the json encoding of the link.
+func (l *Link) JSON() ([]byte, error) {
+	return json.Marshal(l)
+}
+
+// FromJSON loads the link from its json encoding.
+func (l *Link) FromJSON(data []byte) error {
+	return json.Unmarshal(data, l)
+}
+
+// Type returns the reflect type of the link.
+func (l *Link) Type() reflect.Type {
+	return reflect.TypeOf(l)
+}
+
+// relatedKey returns the part of the index key identifying the related document or NFT of the link.
+func (l *Link) relatedKey() []byte {
+	if l.LinkType == LinkTypeNFT {
+		return append(l.Registry.Bytes(), l.TokenID...)
+	}
+
+	return l.DocumentID
+}
+
+// GraphLink is a link of the document graph with the type of the related document.
+type GraphLink struct {
+	Link
+
+	// DocumentType is the type of the related document, empty if the document is not known locally.
+	DocumentType string
+}
+
+// DocumentGraph holds the documents and NFTs related to a document.
+type DocumentGraph struct {
+	DocumentID   []byte
+	DocumentType string
+
+	// Links are ordered by direction and related document or NFT.
+	Links []GraphLink
+}
+
+// linker is implemented by the models whose links are indexed.
+type linker interface {
+	ID() []byte
+	LinkedDocuments() []*coredocumentextpb.LinkedDocument
+	NFTs() []*coredocumentpb.NFT
+}
+
+// indexWriter writes the index entries, either directly to the DB or to a batch.
+type indexWriter interface {
+	Exists(key []byte) bool
+	Create(key []byte, model storage.Model) error
+	Update(key []byte, model storage.Model) error
+}
+
+// getLinkPrefix returns the key prefix of the links of the document owned by accountID.
+func getLinkPrefix(accountID, documentID []byte) []byte {
+	prefix := append([]byte(linkIndexPrefix), accountID...)
+	return append(prefix, documentID...)
+}
+
+// getLinkKey returns the index key of the link of the document owned by accountID.
+// The direction is part of the key since two documents can link to each other.
+func getLinkKey(accountID, documentID []byte, link *Link) []byte {
+	key := append(getLinkPrefix(accountID, documentID), link.Direction...)
+	return append(key, link.relatedKey()...)
+}
+
+// saveIndexEntry creates or updates the index entry.
+func saveIndexEntry(w indexWriter, key []byte, entry storage.Model) error {
+	if w.Exists(key) {
+		return w.Update(key, entry)
+	}
+
+	return w.Create(key, entry)
+}
+
+// saveLink creates or updates the link of the document.
+func saveLink(w indexWriter, accountID, documentID []byte, link *Link) error {
+	return saveIndexEntry(w, getLinkKey(accountID, documentID, link), link)
+}
+
+// indexLinks indexes the links of the model, both as outgoing links of the model and as incoming links of the linked documents.
+// Links are never removed from a document, so the entries of the previous versions remain valid.
+func indexLinks(w indexWriter, accountID []byte, model Model) error {
+	l, ok := model.(linker)
+	if !ok {
+		return nil
+	}
+
+	for _, ld := range l.LinkedDocuments() {
+		err := saveLink(w, accountID, l.ID(), &Link{LinkType: LinkTypeDocument, Direction: LinkOutgoing, DocumentID: ld.DocumentIdentifier})
+		if err != nil {
+			return err
+		}
+
+		err = saveLink(w, accountID, ld.DocumentIdentifier, &Link{LinkType: LinkTypeDocument, Direction: LinkIncoming, DocumentID: l.ID()})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, nft := range l.NFTs() {
+		if len(nft.RegistryId) < common.AddressLength {
+			continue
+		}
+
+		err := saveLink(w, accountID, l.ID(), &Link{
+			LinkType:  LinkTypeNFT,
+			Direction: LinkOutgoing,
+			Registry:  common.BytesToAddress(nft.RegistryId[:common.AddressLength]),
+			TokenID:   nft.TokenId,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetDocumentGraph returns the documents linked to and from the document, and the NFTs minted on it.
+func (s service) GetDocumentGraph(ctx context.Context, documentID []byte) (*DocumentGraph, error) {
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentConfigAccountID, err)
+	}
+
+	links, err := s.repo.Links(did[:], documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &DocumentGraph{DocumentID: model.ID(), DocumentType: model.DocumentType()}
+	for _, l := range links {
+		gl := GraphLink{Link: *l}
+		if l.LinkType == LinkTypeDocument {
+			related, err := s.repo.Get(did[:], l.DocumentID)
+			if err == nil {
+				gl.DocumentType = related.DocumentType()
+			}
+		}
+
+		graph.Links = append(graph.Links, gl)
+	}
+
+	return graph, nil
+}
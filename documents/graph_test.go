@@ -0,0 +1,111 @@
+// +build unit
+
+package documents
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type graphDoc struct {
+	Model
+	DocID   []byte
+	DocType string
+	Links   []*coredocumentextpb.LinkedDocument
+	Nfts    []*coredocumentpb.NFT
+}
+
+func (m *graphDoc) ID() []byte                                           { return m.DocID }
+func (m *graphDoc) CurrentVersion() []byte                               { return m.DocID }
+func (m *graphDoc) NextVersion() []byte                                  { return nil }
+func (m *graphDoc) DocumentType() string                                 { return m.DocType }
+func (m *graphDoc) LinkedDocuments() []*coredocumentextpb.LinkedDocument { return m.Links }
+func (m *graphDoc) NFTs() []*coredocumentpb.NFT                          { return m.Nfts }
+
+func (m *graphDoc) JSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *graphDoc) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+func (m *graphDoc) Type() reflect.Type {
+	return reflect.TypeOf(m)
+}
+
+func TestService_GetDocumentGraph(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&graphDoc{})
+	srv := service{repo: repo}
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+
+	invID, poID, fundingID, unknownID := utils.RandomSlice(32), utils.RandomSlice(32), utils.RandomSlice(32), utils.RandomSlice(32)
+	registry, tokenID := common.BytesToAddress(utils.RandomSlice(20)), utils.RandomSlice(32)
+	po := &graphDoc{DocID: poID, DocType: "purchaseorder"}
+	inv := &graphDoc{
+		DocID:   invID,
+		DocType: "invoice",
+		Links: []*coredocumentextpb.LinkedDocument{
+			{DocumentIdentifier: poID, DocumentRoot: utils.RandomSlice(32)},
+			{DocumentIdentifier: unknownID, DocumentRoot: utils.RandomSlice(32)},
+		},
+		Nfts: []*coredocumentpb.NFT{{RegistryId: append(registry.Bytes(), make([]byte, 12)...), TokenId: tokenID}},
+	}
+	funding := &graphDoc{
+		DocID:   fundingID,
+		DocType: "generic",
+		Links:   []*coredocumentextpb.LinkedDocument{{DocumentIdentifier: invID, DocumentRoot: utils.RandomSlice(32)}},
+	}
+	assert.NoError(t, repo.Create(did[:], poID, po))
+	assert.NoError(t, repo.Create(did[:], invID, inv))
+	assert.NoError(t, repo.Create(did[:], fundingID, funding))
+
+	// unknown document
+	_, err = srv.GetDocumentGraph(actx, unknownID)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+
+	graph, err := srv.GetDocumentGraph(actx, invID)
+	assert.NoError(t, err)
+	assert.Equal(t, invID, graph.DocumentID)
+	assert.Equal(t, "invoice", graph.DocumentType)
+	assert.Len(t, graph.Links, 4)
+	assert.Contains(t, graph.Links, GraphLink{Link: Link{LinkType: LinkTypeDocument, Direction: LinkOutgoing, DocumentID: poID}, DocumentType: "purchaseorder"})
+	assert.Contains(t, graph.Links, GraphLink{Link: Link{LinkType: LinkTypeDocument, Direction: LinkOutgoing, DocumentID: unknownID}})
+	assert.Contains(t, graph.Links, GraphLink{Link: Link{LinkType: LinkTypeNFT, Direction: LinkOutgoing, Registry: registry, TokenID: tokenID}})
+	assert.Contains(t, graph.Links, GraphLink{Link: Link{LinkType: LinkTypeDocument, Direction: LinkIncoming, DocumentID: fundingID}, DocumentType: "generic"})
+
+	graph, err = srv.GetDocumentGraph(actx, poID)
+	assert.NoError(t, err)
+	assert.Equal(t, []GraphLink{{Link: Link{LinkType: LinkTypeDocument, Direction: LinkIncoming, DocumentID: invID}, DocumentType: "invoice"}}, graph.Links)
+
+	// links of batched writes are indexed with the document
+	po.Links = []*coredocumentextpb.LinkedDocument{{DocumentIdentifier: invID, DocumentRoot: utils.RandomSlice(32)}}
+	assert.NoError(t, repo.Atomic(func(repo Repository) error {
+		return repo.Update(did[:], poID, po)
+	}))
+
+	graph, err = srv.GetDocumentGraph(actx, poID)
+	assert.NoError(t, err)
+	assert.Equal(t, []GraphLink{
+		{Link: Link{LinkType: LinkTypeDocument, Direction: LinkIncoming, DocumentID: invID}, DocumentType: "invoice"},
+		{Link: Link{LinkType: LinkTypeDocument, Direction: LinkOutgoing, DocumentID: invID}, DocumentType: "invoice"},
+	}, graph.Links)
+
+	graph, err = srv.GetDocumentGraph(actx, invID)
+	assert.NoError(t, err)
+	assert.Len(t, graph.Links, 5)
+}
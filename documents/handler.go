@@ -1,14 +1,28 @@
 package documents
 
 import (
+	"bytes"
+	"net/http"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
 	"github.com/centrifuge/go-centrifuge/centerrors"
 	"github.com/centrifuge/go-centrifuge/code"
 	"github.com/centrifuge/go-centrifuge/config"
 	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/struct"
 	logging "github.com/ipfs/go-log"
 	"golang.org/x/net/context"
 )
@@ -17,79 +31,2465 @@ var apiLog = logging.Logger("document-api")
 
 // grpcHandler handles all the common document related actions: proof generation
 type grpcHandler struct {
-	config   config.Service
-	registry *ServiceRegistry
+	config        config.Service
+	registry      *ServiceRegistry
+	notary        ProofNotary
+	tokenRegistry TokenRegistry
+	srv           Service
+
+	// usage tracks the storage used by the accounts, nil if the storage usage is not served
+	usage *UsageTracker
 }
 
 // GRPCHandler returns an implementation of documentpb.DocumentServiceServer
-func GRPCHandler(config config.Service, registry *ServiceRegistry) documentpb.DocumentServiceServer {
-	return grpcHandler{config: config, registry: registry}
+func GRPCHandler(config config.Service, registry *ServiceRegistry, notary ProofNotary, tokenRegistry TokenRegistry, srv Service, usage *UsageTracker) documentpb.DocumentServiceServer {
+	return grpcHandler{config: config, registry: registry, notary: notary, tokenRegistry: tokenRegistry, srv: srv, usage: usage}
 }
 
 // CreateDocumentProof creates precise proofs for the given fields
 func (h grpcHandler) CreateDocumentProof(ctx context.Context, createDocumentProofEnvelope *documentpb.CreateDocumentProofRequest) (*documentpb.DocumentProof, error) {
 	apiLog.Infof("Document proof request %v", createDocumentProofEnvelope)
-	cctx, err := contextutil.Context(ctx, h.config)
-	if err != nil {
-		return &documentpb.DocumentProof{}, err
-	}
-
 	service, err := h.registry.LocateService(createDocumentProofEnvelope.Type)
 	if err != nil {
 		return &documentpb.DocumentProof{}, centerrors.Wrap(err, "could not locate service for document type")
 	}
 
-	identifier, err := hexutil.Decode(createDocumentProofEnvelope.Identifier)
+	identifier, err := identifiers.DecodeDocumentID(createDocumentProofEnvelope.Identifier)
 	if err != nil {
 		return &documentpb.DocumentProof{}, centerrors.New(code.Unknown, err.Error())
 	}
 
-	proof, err := service.CreateProofs(cctx, identifier, createDocumentProofEnvelope.Fields)
+	proof, err := service.CreateProofs(ctx, identifier, proofFields(createDocumentProofEnvelope.Fields, createDocumentProofEnvelope.ProveDocumentType))
 	if err != nil {
 		return &documentpb.DocumentProof{}, centerrors.New(code.Unknown, err.Error())
 	}
+
+	if createDocumentProofEnvelope.Notarize {
+		err = h.notary.Notarize(ctx, proof)
+		if err != nil {
+			return &documentpb.DocumentProof{}, centerrors.New(code.Unknown, err.Error())
+		}
+	}
+
 	return ConvertDocProofToClientFormat(proof)
 }
 
+// proofFields returns the requested fields with the document type field if the document type must be proven.
+func proofFields(fields []string, proveDocType bool) []string {
+	if !proveDocType {
+		return fields
+	}
+
+	for _, f := range fields {
+		if f == DocumentTypeProofField {
+			return fields
+		}
+	}
+
+	return append(fields, DocumentTypeProofField)
+}
+
 // CreateDocumentProofForVersion creates precise proofs for the given fields for the given version of the document
 func (h grpcHandler) CreateDocumentProofForVersion(ctx context.Context, createDocumentProofForVersionEnvelope *documentpb.CreateDocumentProofForVersionRequest) (*documentpb.DocumentProof, error) {
 	apiLog.Infof("Document proof request %v", createDocumentProofForVersionEnvelope)
-	cctx, err := contextutil.Context(ctx, h.config)
-	if err != nil {
-		return &documentpb.DocumentProof{}, err
-	}
-
 	service, err := h.registry.LocateService(createDocumentProofForVersionEnvelope.Type)
 	if err != nil {
 		return &documentpb.DocumentProof{}, centerrors.Wrap(err, "could not locate service for document type")
 	}
 
-	identifier, err := hexutil.Decode(createDocumentProofForVersionEnvelope.Identifier)
+	identifier, err := identifiers.DecodeDocumentID(createDocumentProofForVersionEnvelope.Identifier)
 	if err != nil {
 		return &documentpb.DocumentProof{}, centerrors.New(code.Unknown, err.Error())
 	}
 
-	version, err := hexutil.Decode(createDocumentProofForVersionEnvelope.Version)
+	version, err := identifiers.DecodeVersionID(createDocumentProofForVersionEnvelope.Version)
 	if err != nil {
 		return &documentpb.DocumentProof{}, centerrors.New(code.Unknown, err.Error())
 	}
 
-	proof, err := service.CreateProofsForVersion(cctx, identifier, version, createDocumentProofForVersionEnvelope.Fields)
+	proof, err := service.CreateProofsForVersion(ctx, identifier, version, proofFields(createDocumentProofForVersionEnvelope.Fields, createDocumentProofForVersionEnvelope.ProveDocumentType))
 	if err != nil {
 		return &documentpb.DocumentProof{}, centerrors.New(code.Unknown, err.Error())
 	}
+
+	if createDocumentProofForVersionEnvelope.Notarize {
+		err = h.notary.Notarize(ctx, proof)
+		if err != nil {
+			return &documentpb.DocumentProof{}, centerrors.New(code.Unknown, err.Error())
+		}
+	}
+
 	return ConvertDocProofToClientFormat(proof)
 }
 
-// ConvertDocProofToClientFormat converts a DocumentProof to client api format
-func ConvertDocProofToClientFormat(proof *DocumentProof) (*documentpb.DocumentProof, error) {
-	return &documentpb.DocumentProof{
+// GetDocumentSize reports the storage footprint of the document
+func (h grpcHandler) GetDocumentSize(ctx context.Context, req *documentpb.GetDocumentSizeRequest) (*documentpb.DocumentSize, error) {
+	apiLog.Infof("Document size request %v", req)
+	service, err := h.registry.LocateService(req.Type)
+	if err != nil {
+		return &documentpb.DocumentSize{}, centerrors.Wrap(err, "could not locate service for document type")
+	}
+
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		return &documentpb.DocumentSize{}, centerrors.New(code.Unknown, err.Error())
+	}
+
+	size, err := service.GetDocumentSize(ctx, identifier)
+	if err != nil {
+		return &documentpb.DocumentSize{}, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertDocSizeToClientFormat(size), nil
+}
+
+// LinkDocument links the latest anchored version of the linked document to the document and anchors the new version
+func (h grpcHandler) LinkDocument(ctx context.Context, req *documentpb.LinkDocumentRequest) (*documentpb.LinkDocumentResponse, error) {
+	apiLog.Debugf("Link document request %v", req)
+	service, err := h.registry.LocateService(req.Type)
+	if err != nil {
+		return nil, centerrors.Wrap(err, "could not locate service for document type")
+	}
+
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	linkedID, err := identifiers.DecodeDocumentID(req.LinkedIdentifier)
+	if err != nil {
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, txID, _, err := service.LinkDocument(ctx, identifier, linkedID)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not link document")
+	}
+
+	fields, err := doc.LinkProofFields(linkedID)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return &documentpb.LinkDocumentResponse{
 		Header: &documentpb.ResponseHeader{
-			DocumentId: hexutil.Encode(proof.DocumentID),
-			VersionId:  hexutil.Encode(proof.VersionID),
-			State:      proof.State,
+			DocumentId:    hexutil.Encode(doc.ID()),
+			VersionId:     hexutil.Encode(doc.CurrentVersion()),
+			State:         string(doc.GetStatus()),
+			TransactionId: txID.String(),
+		},
+		ProofFields: fields,
+	}, nil
+}
+
+// RemoveCollaborators removes the collaborators from the read and transition rules of the next version of the document
+func (h grpcHandler) RemoveCollaborators(ctx context.Context, req *documentpb.RemoveCollaboratorsRequest) (*documentpb.RemoveCollaboratorsResponse, error) {
+	apiLog.Debugf("Remove collaborators request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	collaborators, err := identity.NewDIDsFromStrings(req.Collaborators)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, txID, _, err := h.srv.RemoveCollaborators(ctx, identifier, collaborators)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		if errors.IsOfType(ErrDocumentInvalid, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	cs, err := doc.GetCollaborators()
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	var css []string
+	for _, c := range cs {
+		css = append(css, c.String())
+	}
+
+	return &documentpb.RemoveCollaboratorsResponse{
+		Header: &documentpb.ResponseHeader{
+			DocumentId:    hexutil.Encode(doc.ID()),
+			VersionId:     hexutil.Encode(doc.CurrentVersion()),
+			State:         string(doc.GetStatus()),
+			Collaborators: css,
+			TransactionId: txID.String(),
 		},
-		FieldProofs: ConvertProofsToClientFormat(proof.FieldProofs)}, nil
+	}, nil
+}
+
+// GetPropertyMappings returns the readable to compact property mappings of the core document and every registered document type
+func (h grpcHandler) GetPropertyMappings(ctx context.Context, _ *empty.Empty) (*documentpb.PropertyMappingsResponse, error) {
+	cdMappings, err := CoreDocumentPropertyMappings()
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	docMappings, err := h.registry.PropertyMappings()
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertPropertyMappingsToClientFormat(cdMappings, docMappings), nil
+}
+
+// CreateDocument derives a document of the scheme from the client data, persists it to DB, and anchors it the chain
+func (h grpcHandler) CreateDocument(ctx context.Context, req *documentpb.DocumentCreatePayload) (*documentpb.DocumentResponse, error) {
+	apiLog.Debugf("Create document request %v", req)
+	srv, err := h.registry.LocateSchemeService(req.Scheme)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	data, err := structToJSON(req.Data)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	err = h.registry.ValidateClientData(req.Scheme, data)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	collaborators := NewCollaboratorsAccess(req.Collaborators, req.ReadAccess, req.WriteAccess)
+	collaborators.RequiredSignatures = req.RequiredSignatures
+	doc, err := srv.DeriveFromClientData(ctx, collaborators, data)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive create payload")
+	}
+
+	err = setFieldRules(srv, doc, req.FieldRules)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// validate, persist, and anchor
+	doc, txID, _, err := srv.Create(ctx, doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not create document")
+	}
+
+	resp, err := deriveDocumentResponse(srv, doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive response")
+	}
+
+	resp.Header.TransactionId = txID.String()
+	return resp, nil
+}
+
+// UpdateDocument derives the next version of a document of the scheme from the client data, persists it to DB, and anchors it the chain
+func (h grpcHandler) UpdateDocument(ctx context.Context, req *documentpb.DocumentUpdatePayload) (*documentpb.DocumentResponse, error) {
+	apiLog.Debugf("Update document request %v", req)
+	srv, err := h.registry.LocateSchemeService(req.Scheme)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	data, err := structToJSON(req.Data)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	err = h.registry.ValidateClientData(req.Scheme, data)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	collaborators := NewCollaboratorsAccess(req.Collaborators, req.ReadAccess, req.WriteAccess)
+	collaborators.RequiredSignatures = req.RequiredSignatures
+	doc, err := srv.DeriveFromUpdateClientData(ctx, req.Identifier, collaborators, data)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive update payload")
+	}
+
+	err = setFieldRules(srv, doc, req.FieldRules)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, txID, _, err := srv.Update(ctx, doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not update document")
+	}
+
+	resp, err := deriveDocumentResponse(srv, doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive response")
+	}
+
+	resp.Header.TransactionId = txID.String()
+	return resp, nil
+}
+
+// ImportDocuments creates and anchors a document of the scheme from every row of the batch, reporting the outcome per row
+func (h grpcHandler) ImportDocuments(ctx context.Context, req *documentpb.ImportDocumentsRequest) (*documentpb.ImportDocumentsResponse, error) {
+	apiLog.Debugf("Import documents request of scheme %s and format %s", req.Scheme, req.Format)
+	results, err := h.srv.Import(ctx, req.Scheme, ImportFormat(req.Format), req.Collaborators, []byte(req.Data))
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentSchemeUnknown, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		if errors.IsOfType(ErrDocumentImport, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertImportResultsToClientFormat(results), nil
+}
+
+// GetDocument returns the current version of a document of the scheme
+func (h grpcHandler) GetDocument(ctx context.Context, req *documentpb.GetDocumentRequest) (*documentpb.DocumentResponse, error) {
+	apiLog.Debugf("Get document request %v", req)
+	srv, err := h.registry.LocateSchemeService(req.Scheme)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, err := srv.GetCurrentVersion(ctx, identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	resp, err := deriveDocumentView(srv, doc, documentView{
+		headerOnly:        req.HeaderOnly,
+		includeSalts:      req.IncludeSalts,
+		includeSignatures: req.IncludeSignatures,
+	})
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive response")
+	}
+
+	return resp, nil
+}
+
+// GetDocumentVersion returns a specific version of a document of the scheme
+func (h grpcHandler) GetDocumentVersion(ctx context.Context, req *documentpb.GetDocumentVersionRequest) (*documentpb.DocumentResponse, error) {
+	apiLog.Debugf("Get document version request %v", req)
+	srv, err := h.registry.LocateSchemeService(req.Scheme)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	version, err := identifiers.DecodeVersionID(req.Version)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, err := srv.GetVersion(ctx, identifier, version)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	resp, err := deriveDocumentView(srv, doc, documentView{
+		headerOnly:        req.HeaderOnly,
+		includeSalts:      req.IncludeSalts,
+		includeSignatures: req.IncludeSignatures,
+	})
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive response")
+	}
+
+	return resp, nil
+}
+
+// CreateProof creates precise proofs for the given fields of the current version of a document of the scheme
+func (h grpcHandler) CreateProof(ctx context.Context, req *documentpb.CreateProofRequest) (*documentpb.DocumentProof, error) {
+	apiLog.Debugf("Create proof request %v", req)
+	srv, err := h.registry.LocateSchemeService(req.Scheme)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	proof, err := srv.CreateProofs(ctx, identifier, proofFields(req.Fields, req.ProveDocumentType))
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return h.convertProof(ctx, proof, req.Notarize, req.Multiproof)
+}
+
+// CreateProofForVersion creates precise proofs for the given fields of a specific version of a document of the scheme
+func (h grpcHandler) CreateProofForVersion(ctx context.Context, req *documentpb.CreateProofForVersionRequest) (*documentpb.DocumentProof, error) {
+	apiLog.Debugf("Create proof for version request %v", req)
+	srv, err := h.registry.LocateSchemeService(req.Scheme)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	version, err := identifiers.DecodeVersionID(req.Version)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	proof, err := srv.CreateProofsForVersion(ctx, identifier, version, proofFields(req.Fields, req.ProveDocumentType))
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return h.convertProof(ctx, proof, req.Notarize, req.Multiproof)
+}
+
+// ListDocuments returns the requested page of the documents of the account with their latest locally known versions
+func (h grpcHandler) ListDocuments(ctx context.Context, req *documentpb.ListDocumentsRequest) (*documentpb.ListDocumentsResponse, error) {
+	apiLog.Debugf("List documents request %v", req)
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	page := int(req.Page)
+	if page == 0 {
+		page = 1
+	}
+
+	dp, err := h.srv.List(ctx, accountID, page, int(req.PerPage))
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentPagination, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertDocPageToClientFormat(dp), nil
+}
+
+// ledgerDateFormat is the format of the days of the ledger export range
+const ledgerDateFormat = "2006-01-02"
+
+// parseLedgerRange returns the time range [from, to) of the days of the export range. The last day is part of the range.
+// An empty day leaves the range open on that side.
+func parseLedgerRange(fromDate, toDate string) (from, to time.Time, err error) {
+	if fromDate != "" {
+		from, err = time.Parse(ledgerDateFormat, fromDate)
+		if err != nil {
+			return from, to, errors.New("invalid from date: %v", err)
+		}
+	}
+
+	if toDate != "" {
+		to, err = time.Parse(ledgerDateFormat, toDate)
+		if err != nil {
+			return from, to, errors.New("invalid to date: %v", err)
+		}
+
+		to = to.AddDate(0, 0, 1)
+	}
+
+	return from, to, nil
+}
+
+// ExportLedger exports the created, accepted and paid events of the anchored documents of the account over the days of the range
+func (h grpcHandler) ExportLedger(ctx context.Context, req *documentpb.ExportLedgerRequest) (*documentpb.ExportLedgerResponse, error) {
+	apiLog.Debugf("Export ledger request %v", req)
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	format := LedgerFormat(req.Format)
+	if format == "" {
+		format = LedgerFormatCSV
+	}
+
+	from, to, err := parseLedgerRange(req.FromDate, req.ToDate)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	events, err := h.srv.ExportLedger(ctx, accountID, from, to)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentLedger, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	data, err := RenderLedger(events, format)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	return &documentpb.ExportLedgerResponse{
+		Format: string(format),
+		Events: int32(len(events)),
+		Data:   string(data),
+	}, nil
+}
+
+// ExportSignatureAudit exports the signatures of the account over the documents and their attributes over the days of
+// the range. The export is signed by the account so that auditors can check it wasn't altered.
+func (h grpcHandler) ExportSignatureAudit(ctx context.Context, req *documentpb.ExportSignatureAuditRequest) (*documentpb.ExportSignatureAuditResponse, error) {
+	apiLog.Debugf("Export signature audit request %v", req)
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	format := LedgerFormat(req.Format)
+	if format == "" {
+		format = LedgerFormatCSV
+	}
+
+	from, to, err := parseLedgerRange(req.FromDate, req.ToDate)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	sigs, err := h.srv.ExportSignatureAudit(ctx, accountID, from, to)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrSignatureAudit, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	data, err := RenderSignatureAudit(sigs, format)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	sig, err := acc.SignMsg(data)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return &documentpb.ExportSignatureAuditResponse{
+		Format:     string(format),
+		Signatures: int32(len(sigs)),
+		Data:       string(data),
+		SignerId:   hexutil.Encode(sig.SignerId),
+		PublicKey:  hexutil.Encode(sig.PublicKey),
+		Signature:  hexutil.Encode(sig.Signature),
+	}, nil
+}
+
+// ValidateDocument runs the validation groups on the document version and streams the result of each group as it completes
+func (h grpcHandler) ValidateDocument(req *documentpb.ValidateDocumentRequest, stream documentpb.DocumentService_ValidateDocumentServer) error {
+	apiLog.Debugf("Validate document request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	version, err := identifiers.DecodeVersionID(req.Version)
+	if err != nil {
+		apiLog.Error(err)
+		return errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	err = h.srv.ValidateVersion(stream.Context(), identifier, version, func(res ValidationResult) error {
+		return stream.Send(&documentpb.ValidationResult{
+			Group:      string(res.Group),
+			Valid:      res.Valid,
+			Skipped:    res.Skipped,
+			Errors:     res.Errors,
+			DurationMs: int32(res.Duration / time.Millisecond),
+		})
+	})
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentVersionNotFound, err) {
+			return errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return centerrors.New(code.Unknown, err.Error())
+	}
+
+	return nil
+}
+
+// GetDocumentSummary returns the summary of the document version, rendered as HTML if the format is html
+func (h grpcHandler) GetDocumentSummary(ctx context.Context, req *documentpb.GetDocumentSummaryRequest) (*documentpb.DocumentSummary, error) {
+	apiLog.Debugf("Get document summary request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	version, err := identifiers.DecodeVersionID(req.Version)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	format := SummaryFormat(req.Format)
+	if format == "" {
+		format = SummaryFormatJSON
+	}
+
+	if format != SummaryFormatJSON && format != SummaryFormatHTML {
+		err = errors.New("unknown summary format %q", req.Format)
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	summary, err := h.srv.GetVersionSummary(ctx, identifier, version)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentVersionNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	resp := ConvertSummaryToClientFormat(summary)
+	if format == SummaryFormatHTML {
+		data, err := RenderSummary(summary, format)
+		if err != nil {
+			apiLog.Error(err)
+			return nil, centerrors.New(code.Unknown, err.Error())
+		}
+
+		resp.Html = string(data)
+	}
+
+	return resp, nil
+}
+
+// GetStorageUsage returns the storage used by the documents of the account per document type
+func (h grpcHandler) GetStorageUsage(ctx context.Context, req *empty.Empty) (*documentpb.StorageUsage, error) {
+	apiLog.Debugf("Get storage usage request %v", req)
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	if h.usage == nil {
+		return nil, centerrors.New(code.Unknown, "storage usage is not tracked")
+	}
+
+	usage, err := h.usage.Usage(accountID[:])
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertStorageUsageToClientFormat(usage), nil
+}
+
+// GetDocumentGraph returns the documents linked to and from the document and the NFTs minted on it
+func (h grpcHandler) GetDocumentGraph(ctx context.Context, req *documentpb.GetDocumentGraphRequest) (*documentpb.DocumentGraph, error) {
+	apiLog.Debugf("Get document graph request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	graph, err := h.srv.GetDocumentGraph(ctx, identifier)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertDocGraphToClientFormat(graph), nil
+}
+
+// GetTransitionGraph exports the roles of the document and the fields their transition rules allow to change
+func (h grpcHandler) GetTransitionGraph(ctx context.Context, req *documentpb.GetTransitionGraphRequest) (*documentpb.TransitionGraph, error) {
+	apiLog.Debugf("Get transition graph request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	graph, err := h.srv.GetTransitionGraph(ctx, identifier)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertTransitionGraphToClientFormat(graph), nil
+}
+
+// GetVersionHistory returns the locally known versions of the document with their authors, timestamps and anchored roots
+func (h grpcHandler) GetVersionHistory(ctx context.Context, req *documentpb.GetVersionHistoryRequest) (*documentpb.VersionHistoryResponse, error) {
+	apiLog.Debugf("Get version history request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	history, err := h.srv.GetVersionHistory(ctx, identifier)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	resp, err := ConvertVersionHistoryToClientFormat(history)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return resp, nil
+}
+
+// ListAccessTokens returns the access tokens of the latest version of the document that have not expired
+func (h grpcHandler) ListAccessTokens(ctx context.Context, req *documentpb.ListAccessTokensRequest) (*documentpb.ListAccessTokensResponse, error) {
+	apiLog.Debugf("List access tokens request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	tokens, err := h.srv.ListAccessTokens(ctx, identifier)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	resp, err := ConvertAccessTokensToClientFormat(identifier, tokens)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return resp, nil
+}
+
+// GetVersionDiff returns the fields changed between two versions of the document
+func (h grpcHandler) GetVersionDiff(ctx context.Context, req *documentpb.GetVersionDiffRequest) (*documentpb.VersionDiffResponse, error) {
+	apiLog.Debugf("Get version diff request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	from, err := identifiers.DecodeVersionID(req.FromVersion)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	to, err := identifiers.DecodeVersionID(req.ToVersion)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	diff, err := h.srv.GetVersionDiff(ctx, identifier, from, to)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentVersionNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertVersionDiffToClientFormat(diff), nil
+}
+
+// SignAttribute signs the current value of the attribute of the document with the signing key of the account
+func (h grpcHandler) SignAttribute(ctx context.Context, req *documentpb.SignAttributeRequest) (*documentpb.AttributeSignature, error) {
+	apiLog.Debugf("Sign attribute request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	sig, err := h.srv.SignAttribute(ctx, identifier, req.Property)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		if errors.IsOfType(ErrDocumentProof, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertAttributeSignatureToClientFormat(sig), nil
+}
+
+// AddAttributeSignature adds the signature of a third party over an attribute to the next version of the document
+func (h grpcHandler) AddAttributeSignature(ctx context.Context, req *documentpb.AddAttributeSignatureRequest) (*documentpb.AddAttributeSignatureResponse, error) {
+	apiLog.Debugf("Add attribute signature request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	sig, err := ConvertAttributeSignatureFromClientFormat(req.Signature)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, txID, _, err := h.srv.AddAttributeSignature(ctx, identifier, sig)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		if errors.IsOfType(ErrDocumentInvalid, err) || errors.IsOfType(ErrAttributeSignatureInvalid, err) ||
+			errors.IsOfType(ErrDocumentProof, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header, err := deriveResponseHeader(doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	fields, err := doc.AttributeSignatureProofFields(identity.NewDIDFromBytes(sig.SignerId), sig.Property)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header.TransactionId = txID.String()
+	return &documentpb.AddAttributeSignatureResponse{
+		Header:      header,
+		ProofFields: fields,
+	}, nil
+}
+
+// CancelDocument cancels the document, optionally superseded by a replacement document, and anchors the final version
+func (h grpcHandler) CancelDocument(ctx context.Context, req *documentpb.CancelDocumentRequest) (*documentpb.CancelDocumentResponse, error) {
+	apiLog.Debugf("Cancel document request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	var supersededBy []byte
+	if req.SupersededBy != "" {
+		supersededBy, err = identifiers.DecodeDocumentID(req.SupersededBy)
+		if err != nil {
+			apiLog.Error(err)
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+	}
+
+	doc, txID, _, err := h.srv.Cancel(ctx, identifier, supersededBy)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		if errors.IsOfType(ErrDocumentInvalid, err) || errors.IsOfType(ErrDocumentCancelled, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header, err := deriveResponseHeader(doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header.TransactionId = txID.String()
+	return &documentpb.CancelDocumentResponse{Header: header}, nil
+}
+
+// AddNFT adds an NFT minted outside of the node to the document and anchors the new version
+func (h grpcHandler) AddNFT(ctx context.Context, req *documentpb.AddNFTRequest) (*documentpb.AddNFTResponse, error) {
+	apiLog.Debugf("Add NFT request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	if !common.IsHexAddress(req.RegistryAddress) {
+		err = errors.New("registry address is not a valid Ethereum address")
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	tokenID, err := identifiers.DecodeTokenID(req.TokenId)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, txID, _, err := h.srv.AddNFT(ctx, identifier, req.GrantNftAccess, common.HexToAddress(req.RegistryAddress), tokenID)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		if errors.IsOfType(ErrDocumentInvalid, err) || errors.IsOfType(ErrDocumentCancelled, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header, err := deriveResponseHeader(doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header.TransactionId = txID.String()
+	return &documentpb.AddNFTResponse{Header: header}, nil
+}
+
+// SimulateAccess runs the read access checks of the collaborators on the document and returns the reasons the access is denied
+func (h grpcHandler) SimulateAccess(ctx context.Context, req *documentpb.SimulateAccessRequest) (*documentpb.SimulateAccessResponse, error) {
+	apiLog.Debugf("Simulate access request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	sim, err := accessSimulationFromClientFormat(req)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	report, err := h.srv.SimulateAccess(ctx, identifier, h.tokenRegistry, sim)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertAccessReportToClientFormat(report), nil
+}
+
+// accessSimulationFromClientFormat decodes the identifiers of the access simulation request.
+func accessSimulationFromClientFormat(req *documentpb.SimulateAccessRequest) (sim AccessSimulation, err error) {
+	sim.Account, err = identifiers.DecodeDID(req.Account)
+	if err != nil {
+		return sim, err
+	}
+
+	if req.AccessTokenId != "" {
+		sim.AccessTokenID, err = hexutil.Decode(req.AccessTokenId)
+		if err != nil {
+			return sim, err
+		}
+	}
+
+	if req.DelegatingDocumentIdentifier != "" {
+		sim.DelegatingDocumentID, err = identifiers.DecodeDocumentID(req.DelegatingDocumentIdentifier)
+		if err != nil {
+			return sim, err
+		}
+	}
+
+	if req.NftTokenId != "" {
+		if !common.IsHexAddress(req.NftRegistryAddress) {
+			return sim, errors.New("registry address is not a valid Ethereum address")
+		}
+
+		sim.NFTRegistry = common.HexToAddress(req.NftRegistryAddress)
+		sim.NFTTokenID, err = identifiers.DecodeTokenID(req.NftTokenId)
+		if err != nil {
+			return sim, err
+		}
+	}
+
+	return sim, nil
+}
+
+// AddTransferDetail records a transfer, such as a payment, against the document and anchors the new version
+func (h grpcHandler) AddTransferDetail(ctx context.Context, req *documentpb.AddTransferDetailRequest) (*documentpb.TransferDetailResponse, error) {
+	apiLog.Debugf("Add transfer detail request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	detail, err := ConvertTransferDetailFromClientFormat(req.Data)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, txID, _, err := h.srv.AddTransferDetail(ctx, identifier, detail)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, transferDetailError(err)
+	}
+
+	return deriveTransferDetailResponse(doc, txID.String(), detail.TransferId)
+}
+
+// UpdateTransferDetail updates the detail of a transfer recorded on the document and anchors the new version
+func (h grpcHandler) UpdateTransferDetail(ctx context.Context, req *documentpb.UpdateTransferDetailRequest) (*documentpb.TransferDetailResponse, error) {
+	apiLog.Debugf("Update transfer detail request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	transferID, err := identifiers.DecodeTransferID(req.TransferId)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	detail, err := ConvertTransferDetailFromClientFormat(req.Data)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// the transfer is identified by the path
+	detail.TransferId = transferID
+	doc, txID, _, err := h.srv.UpdateTransferDetail(ctx, identifier, detail)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, transferDetailError(err)
+	}
+
+	return deriveTransferDetailResponse(doc, txID.String(), transferID)
+}
+
+// ListTransferDetails lists the transfer details recorded on the latest version of the document
+func (h grpcHandler) ListTransferDetails(ctx context.Context, req *documentpb.ListTransferDetailsRequest) (*documentpb.ListTransferDetailsResponse, error) {
+	apiLog.Debugf("List transfer details request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, err := h.srv.GetCurrentVersion(ctx, identifier)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header, err := deriveResponseHeader(doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	resp := &documentpb.ListTransferDetailsResponse{Header: header}
+	for _, detail := range doc.TransferDetails() {
+		proof, err := convertTransferDetailProof(doc, detail)
+		if err != nil {
+			apiLog.Error(err)
+			return nil, centerrors.New(code.Unknown, err.Error())
+		}
+
+		resp.Details = append(resp.Details, proof)
+	}
+
+	return resp, nil
+}
+
+// AddAttachment adds an attachment to the document, pinning the uploaded file to the IPFS node, and anchors the new version
+func (h grpcHandler) AddAttachment(ctx context.Context, req *documentpb.AddAttachmentRequest) (*documentpb.AttachmentResponse, error) {
+	apiLog.Debugf("Add attachment request for document %s", req.Identifier)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	attachment, err := ConvertAttachmentFromClientFormat(req.Attachment)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, txID, _, err := h.srv.AddAttachment(ctx, identifier, attachment, req.Data)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		if errors.IsOfType(ErrDocumentInvalid, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		if errors.IsOfType(ErrAttachmentPinning, err) {
+			return nil, errors.NewHTTPError(http.StatusBadGateway, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header, err := deriveResponseHeader(doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	proof, err := convertAttachmentProof(doc, attachment.Name)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header.TransactionId = txID.String()
+	return &documentpb.AttachmentResponse{Header: header, Attachment: proof}, nil
+}
+
+// ListAttachments lists the attachments of the latest version of the document
+func (h grpcHandler) ListAttachments(ctx context.Context, req *documentpb.ListAttachmentsRequest) (*documentpb.ListAttachmentsResponse, error) {
+	apiLog.Debugf("List attachments request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, err := h.srv.GetCurrentVersion(ctx, identifier)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header, err := deriveResponseHeader(doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	resp := &documentpb.ListAttachmentsResponse{Header: header}
+	for _, attachment := range doc.Attachments() {
+		proof, err := convertAttachmentProof(doc, attachment.Name)
+		if err != nil {
+			apiLog.Error(err)
+			return nil, centerrors.New(code.Unknown, err.Error())
+		}
+
+		resp.Attachments = append(resp.Attachments, proof)
+	}
+
+	return resp, nil
+}
+
+// AddTags adds the tags to the document and anchors the new version
+func (h grpcHandler) AddTags(ctx context.Context, req *documentpb.UpdateTagsRequest) (*documentpb.TagsResponse, error) {
+	apiLog.Debugf("Add tags request %v", req)
+	return h.updateTags(ctx, req.Identifier, req.Tags, nil)
+}
+
+// RemoveTags removes the tags from the document and anchors the new version
+func (h grpcHandler) RemoveTags(ctx context.Context, req *documentpb.UpdateTagsRequest) (*documentpb.TagsResponse, error) {
+	apiLog.Debugf("Remove tags request %v", req)
+	return h.updateTags(ctx, req.Identifier, nil, req.Tags)
+}
+
+// updateTags adds and removes the tags of the document given by the hex encoded identifier.
+func (h grpcHandler) updateTags(ctx context.Context, id string, add, remove []string) (*documentpb.TagsResponse, error) {
+	identifier, err := identifiers.DecodeDocumentID(id)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	if len(add) == 0 && len(remove) == 0 {
+		err = errors.New("no tags provided")
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	doc, txID, _, err := h.srv.UpdateTags(ctx, identifier, add, remove)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		if errors.IsOfType(ErrDocumentTransition, err) {
+			return nil, errors.NewHTTPError(http.StatusForbidden, err)
+		}
+
+		if errors.IsOfType(ErrDocumentInvalid, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header, err := deriveResponseHeader(doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header.TransactionId = txID.String()
+	return &documentpb.TagsResponse{
+		Header:      header,
+		Tags:        doc.Tags(),
+		ProofFields: doc.TagProofFields(),
+	}, nil
+}
+
+// SaveTemplate creates or replaces the document template of the account with the name
+func (h grpcHandler) SaveTemplate(ctx context.Context, req *documentpb.DocumentTemplate) (*documentpb.DocumentTemplate, error) {
+	apiLog.Debugf("Save template request %v", req)
+	tpl, err := ConvertTemplateFromClientFormat(req)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	err = h.srv.SaveTemplate(ctx, tpl)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrTemplateInvalid, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return h.convertTemplate(tpl)
+}
+
+// GetTemplate returns the document template of the account with the name
+func (h grpcHandler) GetTemplate(ctx context.Context, req *documentpb.GetTemplateRequest) (*documentpb.DocumentTemplate, error) {
+	apiLog.Debugf("Get template request %v", req)
+	tpl, err := h.srv.GetTemplate(ctx, req.Name)
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	return h.convertTemplate(tpl)
+}
+
+// ListTemplates returns the document templates of the account
+func (h grpcHandler) ListTemplates(ctx context.Context, _ *empty.Empty) (*documentpb.ListTemplatesResponse, error) {
+	tpls, err := h.srv.ListTemplates(ctx)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	resp := new(documentpb.ListTemplatesResponse)
+	for _, tpl := range tpls {
+		ctpl, err := h.convertTemplate(tpl)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Templates = append(resp.Templates, ctpl)
+	}
+
+	return resp, nil
+}
+
+// DeleteTemplate deletes the document template of the account with the name and returns it
+func (h grpcHandler) DeleteTemplate(ctx context.Context, req *documentpb.GetTemplateRequest) (*documentpb.DocumentTemplate, error) {
+	apiLog.Debugf("Delete template request %v", req)
+	tpl, err := h.srv.GetTemplate(ctx, req.Name)
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	err = h.srv.DeleteTemplate(ctx, req.Name)
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	return h.convertTemplate(tpl)
+}
+
+// CreateFromTemplate creates a document from the client data and the document template with the name, persists it to
+// DB, and anchors it the chain
+func (h grpcHandler) CreateFromTemplate(ctx context.Context, req *documentpb.CreateFromTemplateRequest) (*documentpb.DocumentResponse, error) {
+	apiLog.Debugf("Create from template request %v", req)
+	var data []byte
+	if req.Data != nil {
+		var err error
+		data, err = structToJSON(req.Data)
+		if err != nil {
+			apiLog.Error(err)
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+	}
+
+	doc, txID, _, err := h.srv.CreateFromTemplate(ctx, req.Name, NewCollaboratorsAccess(req.Collaborators, req.ReadAccess, nil), data)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrTemplateNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		if errors.IsOfType(ErrDocumentInvalid, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, centerrors.Wrap(err, "could not create document")
+	}
+
+	srv, err := h.registry.LocateService(doc.DocumentType())
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	ss, ok := srv.(SchemeService)
+	if !ok {
+		apiLog.Errorf("no scheme service for %s", doc.DocumentType())
+		return nil, centerrors.New(code.Unknown, "no scheme service for the document type")
+	}
+
+	resp, err := deriveDocumentResponse(ss, doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive response")
+	}
+
+	resp.Header.TransactionId = txID.String()
+	return resp, nil
+}
+
+// templateError returns the http error of the failed template lookup
+func templateError(err error) error {
+	apiLog.Error(err)
+	if errors.IsOfType(ErrTemplateNotFound, err) {
+		return errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	return centerrors.New(code.Unknown, err.Error())
+}
+
+// convertTemplate converts the template to client api format
+func (h grpcHandler) convertTemplate(tpl *Template) (*documentpb.DocumentTemplate, error) {
+	ctpl, err := ConvertTemplateToClientFormat(tpl)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ctpl, nil
+}
+
+// convertAttachmentProof converts the attachment with the name along with the fields to prove it on the document.
+func convertAttachmentProof(doc Model, name string) (*documentpb.AttachmentProof, error) {
+	attachment, err := doc.Attachment(name)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := doc.AttachmentProofFields(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &documentpb.AttachmentProof{Data: ConvertAttachmentToClientFormat(attachment), ProofFields: fields}, nil
+}
+
+// transferDetailError maps the error of a transfer detail operation to the http error.
+func transferDetailError(err error) error {
+	if errors.IsOfType(ErrDocumentNotFound, err) || errors.IsOfType(ErrTransferDetailNotFound, err) {
+		return errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	if errors.IsOfType(ErrDocumentInvalid, err) {
+		return errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	return centerrors.New(code.Unknown, err.Error())
+}
+
+// deriveTransferDetailResponse returns the response with the detail of the transfer recorded on the new version.
+func deriveTransferDetailResponse(doc Model, txID string, transferID []byte) (*documentpb.TransferDetailResponse, error) {
+	header, err := deriveResponseHeader(doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	detail, err := doc.TransferDetail(transferID)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	proof, err := convertTransferDetailProof(doc, detail)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	header.TransactionId = txID
+	return &documentpb.TransferDetailResponse{Header: header, Detail: proof}, nil
+}
+
+// convertTransferDetailProof converts the transfer detail along with the fields to prove it on the document.
+func convertTransferDetailProof(doc Model, detail *coredocumentextpb.TransferDetail) (*documentpb.TransferDetailProof, error) {
+	data, err := ConvertTransferDetailToClientFormat(detail)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := doc.TransferDetailProofFields(detail.TransferId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &documentpb.TransferDetailProof{Data: data, ProofFields: fields}, nil
+}
+
+// VerifyProof verifies the field proofs of the proof bundle against its document root and the root against the anchor repository
+func (h grpcHandler) VerifyProof(ctx context.Context, req *documentpb.VerifyProofRequest) (*documentpb.VerifyProofResponse, error) {
+	apiLog.Debugf("Verify proof request %v", req)
+	if req.Proof == nil {
+		err := errors.NewTypedError(ErrInvalidProofBundle, errors.New("proof bundle missing"))
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	proof, err := ConvertClientProofToDocProof(req.Proof)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	if req.DocumentRoot != "" {
+		proof.DocumentRoot, err = identifiers.Decode(req.DocumentRoot, anchors.DocumentRootLength)
+		if err != nil {
+			apiLog.Error(err)
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+	}
+
+	if req.AnchorId != "" {
+		proof.VersionID, err = identifiers.DecodeVersionID(req.AnchorId)
+		if err != nil {
+			apiLog.Error(err)
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+	}
+
+	pv, err := h.srv.VerifyProof(ctx, proof)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrInvalidProofBundle, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		if errors.IsOfType(ErrDocumentAnchorNotMined, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertProofVerificationToClientFormat(pv), nil
+}
+
+// RequestDocumentProof requests the granter of the access token for the proofs of the fields of the document and
+// verifies them against the anchored document root
+func (h grpcHandler) RequestDocumentProof(ctx context.Context, req *documentpb.RequestDocumentProofRequest) (*documentpb.DocumentProof, error) {
+	apiLog.Debugf("Request document proof request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	granter, err := identity.NewDIDFromString(req.GranterId)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	delegatingID, err := identifiers.DecodeDocumentID(req.DelegatingDocumentIdentifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	tokenID, err := hexutil.Decode(req.AccessTokenId)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	if len(req.Fields) == 0 {
+		err = errors.New("no fields to prove")
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	proof, err := h.srv.RequestProofs(ctx, ProofRequest{
+		Granter:              granter,
+		DocumentID:           identifier,
+		DelegatingDocumentID: delegatingID,
+		AccessTokenID:        tokenID,
+		Fields:               req.Fields,
+	})
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrDocumentAnchorNotMined, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return ConvertDocProofToClientFormat(proof)
+}
+
+// CreateConsistencyProof proves that the referenced fields of two documents are equal with the proofs of both fields
+// and the signature of the node over the equality
+func (h grpcHandler) CreateConsistencyProof(ctx context.Context, req *documentpb.CreateConsistencyProofRequest) (*documentpb.ConsistencyProof, error) {
+	apiLog.Debugf("Create consistency proof request %v", req)
+	left, err := ConvertFieldReferenceFromClientFormat(req.Left)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	right, err := ConvertFieldReferenceFromClientFormat(req.Right)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	proof, err := h.srv.CreateConsistencyProof(ctx, left, right)
+	if err != nil {
+		apiLog.Error(err)
+		if errors.IsOfType(ErrInconsistentFields, err) || errors.IsOfType(ErrDocumentInvalid, err) || errors.IsOfType(ErrDocumentProof, err) {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		if errors.IsOfType(ErrDocumentNotFound, err) || errors.IsOfType(ErrDocumentVersionNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	resp, err := ConvertConsistencyProofToClientFormat(proof)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return resp, nil
+}
+
+// convertProof notarizes the proof if requested and converts it to client api format, as a multiproof if requested
+func (h grpcHandler) convertProof(ctx context.Context, proof *DocumentProof, notarize, multiproof bool) (*documentpb.DocumentProof, error) {
+	if notarize {
+		err := h.notary.Notarize(ctx, proof)
+		if err != nil {
+			apiLog.Error(err)
+			return nil, centerrors.New(code.Unknown, err.Error())
+		}
+	}
+
+	if !multiproof {
+		return ConvertDocProofToClientFormat(proof)
+	}
+
+	cp, err := ConvertDocProofToMultiProofClientFormat(proof)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return cp, nil
+}
+
+// deriveDocumentResponse returns the document in the client format of the scheme based document api
+func deriveDocumentResponse(srv SchemeService, doc Model) (*documentpb.DocumentResponse, error) {
+	header, err := deriveResponseHeader(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := srv.DeriveClientData(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	st := new(structpb.Struct)
+	err = jsonpb.Unmarshal(bytes.NewReader(data), st)
+	if err != nil {
+		return nil, err
+	}
+
+	return &documentpb.DocumentResponse{
+		Header: header,
+		Data:   st,
+	}, nil
+}
+
+// deriveResponseHeader returns the header of the document in the client format
+func deriveResponseHeader(doc Model) (*documentpb.ResponseHeader, error) {
+	cs, err := doc.GetCollaborators()
+	if err != nil {
+		return nil, err
+	}
+
+	var css []string
+	for _, c := range cs {
+		css = append(css, c.String())
+	}
+
+	header := &documentpb.ResponseHeader{
+		DocumentId:    hexutil.Encode(doc.ID()),
+		VersionId:     hexutil.Encode(doc.CurrentVersion()),
+		State:         string(doc.GetStatus()),
+		Collaborators: css,
+	}
+
+	if c, ok := doc.(cancellable); ok && c.Cancelled() {
+		header.Cancelled = true
+		header.SupersededBy = encodeSupersededBy(c.SupersededBy())
+	}
+
+	return header, nil
+}
+
+// encodeSupersededBy returns the hex encoded ID of the superseding document, empty if the document is not superseded
+func encodeSupersededBy(supersededBy []byte) string {
+	if len(supersededBy) == 0 {
+		return ""
+	}
+
+	return hexutil.Encode(supersededBy)
+}
+
+// documentView selects the parts of a document returned by the get document requests.
+type documentView struct {
+	headerOnly        bool
+	includeSalts      bool
+	includeSignatures bool
+}
+
+// deriveDocumentView derives the client response with the parts of the document selected by the view.
+// Dashboards only interested in the status and the collaborators of a document request the header only.
+func deriveDocumentView(srv SchemeService, doc Model, view documentView) (resp *documentpb.DocumentResponse, err error) {
+	if view.headerOnly {
+		resp = new(documentpb.DocumentResponse)
+		resp.Header, err = deriveResponseHeader(doc)
+	} else {
+		resp, err = deriveDocumentResponse(srv, doc)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !view.includeSalts && !view.includeSignatures {
+		return resp, nil
+	}
+
+	cd, err := doc.PackCoreDocument()
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentPackingCoreDocument, err)
+	}
+
+	if view.includeSalts {
+		for _, salts := range [][]*coredocumentpb.DocumentSalt{cd.CoredocumentSalts, cd.EmbeddedDataSalts, cd.SignatureDataSalts} {
+			resp.Salts = append(resp.Salts, ConvertSaltsToClientFormat(salts)...)
+		}
+	}
+
+	if view.includeSignatures && cd.SignatureData != nil {
+		resp.Signatures = ConvertBundleSignaturesToClientFormat(cd.SignatureData.Signatures)
+	}
+
+	return resp, nil
+}
+
+// setFieldRules restricts the collaborators of the client field rules on the derived draft
+func setFieldRules(srv SchemeService, doc Model, rules []*documentpb.FieldRule) error {
+	frs, err := ConvertFieldRulesFromClientFormat(rules)
+	if err != nil {
+		return err
+	}
+
+	return applyFieldRules(srv, doc, frs)
+}
+
+// ConvertFieldRulesFromClientFormat converts the client field rules, decoding the collaborators
+func ConvertFieldRulesFromClientFormat(rules []*documentpb.FieldRule) ([]FieldRule, error) {
+	var frs []FieldRule
+	for _, r := range rules {
+		cs, err := identity.NewDIDsFromStrings(r.Collaborators)
+		if err != nil {
+			return nil, err
+		}
+
+		frs = append(frs, FieldRule{Collaborators: cs, Fields: r.Fields})
+	}
+
+	return frs, nil
+}
+
+// ConvertFieldRulesToClientFormat converts the field rules to client api format
+func ConvertFieldRulesToClientFormat(rules []FieldRule) []*documentpb.FieldRule {
+	var frs []*documentpb.FieldRule
+	for _, r := range rules {
+		var cs []string
+		for _, c := range r.Collaborators {
+			cs = append(cs, c.String())
+		}
+
+		frs = append(frs, &documentpb.FieldRule{Collaborators: cs, Fields: r.Fields})
+	}
+
+	return frs
+}
+
+// structToJSON returns the json encoding of the client data
+func structToJSON(data *structpb.Struct) ([]byte, error) {
+	if data == nil {
+		return nil, ErrDocumentNil
+	}
+
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, data)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ConvertPropertyMappingsToClientFormat converts the core document and document property mappings to client api format
+func ConvertPropertyMappingsToClientFormat(cdMappings []PropertyMapping, docMappings []DocumentPropertyMappings) *documentpb.PropertyMappingsResponse {
+	docs := make([]*documentpb.DocumentPropertyMappings, len(docMappings))
+	for i, dm := range docMappings {
+		docs[i] = &documentpb.DocumentPropertyMappings{
+			DocumentType: dm.DocumentType,
+			Properties:   convertPropertyMappings(dm.Properties),
+		}
+	}
+
+	return &documentpb.PropertyMappingsResponse{
+		CoreDocument: convertPropertyMappings(cdMappings),
+		Documents:    docs,
+	}
+}
+
+func convertPropertyMappings(mappings []PropertyMapping) []*documentpb.PropertyMapping {
+	converted := make([]*documentpb.PropertyMapping, len(mappings))
+	for i, m := range mappings {
+		converted[i] = &documentpb.PropertyMapping{
+			ReadableName: m.ReadableName,
+			CompactName:  m.CompactName,
+			Deprecated:   m.Deprecated,
+		}
+	}
+
+	return converted
+}
+
+// ConvertDocPageToClientFormat converts a DocumentPage to client api format
+func ConvertDocPageToClientFormat(dp *DocumentPage) *documentpb.ListDocumentsResponse {
+	items := make([]*documentpb.DocumentListItem, len(dp.Documents))
+	for i, d := range dp.Documents {
+		items[i] = &documentpb.DocumentListItem{
+			DocumentId:    hexutil.Encode(d.DocumentID),
+			DocumentType:  d.DocumentType,
+			Status:        string(d.Status),
+			LatestVersion: hexutil.Encode(d.LatestVersion),
+			Cancelled:     d.Cancelled,
+			SupersededBy:  encodeSupersededBy(d.SupersededBy),
+		}
+	}
+
+	return &documentpb.ListDocumentsResponse{
+		Data:    items,
+		Page:    int32(dp.Page),
+		PerPage: int32(dp.PerPage),
+		Total:   int32(dp.Total),
+	}
+}
+
+// ConvertDocGraphToClientFormat converts a DocumentGraph to client api format
+func ConvertDocGraphToClientFormat(graph *DocumentGraph) *documentpb.DocumentGraph {
+	links := make([]*documentpb.DocumentGraphLink, len(graph.Links))
+	for i, l := range graph.Links {
+		link := &documentpb.DocumentGraphLink{
+			LinkType:     string(l.LinkType),
+			Direction:    string(l.Direction),
+			DocumentType: l.DocumentType,
+		}
+
+		if l.LinkType == LinkTypeNFT {
+			link.Registry = l.Registry.Hex()
+			link.TokenId = hexutil.Encode(l.TokenID)
+		} else {
+			link.DocumentId = hexutil.Encode(l.DocumentID)
+		}
+
+		links[i] = link
+	}
+
+	return &documentpb.DocumentGraph{
+		DocumentId:   hexutil.Encode(graph.DocumentID),
+		DocumentType: graph.DocumentType,
+		Links:        links,
+	}
+}
+
+// ConvertTransitionGraphToClientFormat converts a TransitionGraph to client api format, along with its DOT rendering
+func ConvertTransitionGraphToClientFormat(graph *TransitionGraph) *documentpb.TransitionGraph {
+	roles := make([]*documentpb.TransitionGraphRole, len(graph.Roles))
+	for i, r := range graph.Roles {
+		role := &documentpb.TransitionGraphRole{RoleKey: hexutil.Encode(r.RoleKey)}
+		for _, c := range r.Collaborators {
+			role.Collaborators = append(role.Collaborators, c.String())
+		}
+
+		for _, n := range r.NFTs {
+			role.Nfts = append(role.Nfts, hexutil.Encode(n))
+		}
+
+		roles[i] = role
+	}
+
+	rules := make([]*documentpb.TransitionGraphRule, len(graph.Rules))
+	for i, e := range graph.Rules {
+		rule := &documentpb.TransitionGraphRule{
+			RuleKey:   hexutil.Encode(e.RuleKey),
+			MatchType: e.MatchType,
+			Field:     e.Field,
+			Action:    e.Action,
+		}
+
+		for _, rk := range e.Roles {
+			rule.Roles = append(rule.Roles, hexutil.Encode(rk))
+		}
+
+		rules[i] = rule
+	}
+
+	return &documentpb.TransitionGraph{
+		DocumentId:   hexutil.Encode(graph.DocumentID),
+		VersionId:    hexutil.Encode(graph.VersionID),
+		DocumentType: graph.DocumentType,
+		Roles:        roles,
+		Rules:        rules,
+		Dot:          graph.DOT(),
+	}
+}
+
+// ConvertVersionHistoryToClientFormat converts a VersionHistory to client api format
+func ConvertVersionHistoryToClientFormat(history *VersionHistory) (*documentpb.VersionHistoryResponse, error) {
+	versions := make([]*documentpb.VersionHistoryEntry, len(history.Versions))
+	for i, v := range history.Versions {
+		entry := &documentpb.VersionHistoryEntry{
+			VersionId: hexutil.Encode(v.Version),
+			Author:    v.Author.String(),
+			Status:    string(v.Status),
+		}
+
+		if !utils.IsEmptyByteSlice(v.PreviousVersion) {
+			entry.PreviousVersionId = hexutil.Encode(v.PreviousVersion)
+		}
+
+		if len(v.DocumentRoot) > 0 {
+			entry.DocumentRoot = hexutil.Encode(v.DocumentRoot)
+		}
+
+		if !v.Timestamp.IsZero() {
+			ts, err := utils.ToTimestamp(v.Timestamp)
+			if err != nil {
+				return nil, err
+			}
+
+			entry.Timestamp = ts
+		}
+
+		versions[i] = entry
+	}
+
+	return &documentpb.VersionHistoryResponse{
+		DocumentId:   hexutil.Encode(history.DocumentID),
+		DocumentType: history.DocumentType,
+		Versions:     versions,
+	}, nil
+}
+
+// ConvertAccessTokensToClientFormat converts the access tokens of the document to client api format
+func ConvertAccessTokensToClientFormat(documentID []byte, tokens []AccessToken) (*documentpb.ListAccessTokensResponse, error) {
+	entries := make([]*documentpb.AccessTokenEntry, len(tokens))
+	for i, t := range tokens {
+		entry := &documentpb.AccessTokenEntry{
+			Identifier: hexutil.Encode(t.Identifier),
+			Granter:    t.Granter.String(),
+			Grantee:    t.Grantee.String(),
+			Scope:      string(t.Scope),
+		}
+
+		if !t.ExpiresAt.IsZero() {
+			ts, err := utils.ToTimestamp(t.ExpiresAt)
+			if err != nil {
+				return nil, err
+			}
+
+			entry.ExpiresAt = ts
+		}
+
+		entries[i] = entry
+	}
+
+	return &documentpb.ListAccessTokensResponse{
+		DocumentId: hexutil.Encode(documentID),
+		Tokens:     entries,
+	}, nil
+}
+
+// ConvertAttributeSignatureToClientFormat converts an attribute signature to client api format
+func ConvertAttributeSignatureToClientFormat(sig *coredocumentextpb.AttributeSignature) *documentpb.AttributeSignature {
+	return &documentpb.AttributeSignature{
+		SignerId:  identity.NewDIDFromBytes(sig.SignerId).String(),
+		PublicKey: hexutil.Encode(sig.PublicKey),
+		Property:  sig.Property,
+		Value:     hexutil.Encode(sig.Value),
+		Timestamp: sig.Timestamp,
+		Signature: hexutil.Encode(sig.Signature),
+	}
+}
+
+// ConvertAttributeSignatureFromClientFormat converts an attribute signature in client api format
+func ConvertAttributeSignatureFromClientFormat(sig *documentpb.AttributeSignature) (*coredocumentextpb.AttributeSignature, error) {
+	if sig == nil {
+		return nil, errors.New("attribute signature is required")
+	}
+
+	signer, err := identity.NewDIDFromString(sig.SignerId)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := hexutil.Decode(sig.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := hexutil.Decode(sig.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := hexutil.Decode(sig.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coredocumentextpb.AttributeSignature{
+		SignerId:  signer[:],
+		PublicKey: pk,
+		Property:  sig.Property,
+		Value:     value,
+		Timestamp: sig.Timestamp,
+		Signature: s,
+	}, nil
+}
+
+// ConvertVersionDiffToClientFormat converts a VersionDiff to client api format
+func ConvertVersionDiffToClientFormat(diff *VersionDiff) *documentpb.VersionDiffResponse {
+	changes := make([]*documentpb.FieldChange, len(diff.Changes))
+	for i, cf := range diff.Changes {
+		change := &documentpb.FieldChange{
+			Property: hexutil.Encode(cf.Property),
+			Name:     cf.Name,
+		}
+
+		if cf.Old != nil {
+			change.OldValue = hexutil.Encode(cf.Old)
+		}
+
+		if cf.New != nil {
+			change.NewValue = hexutil.Encode(cf.New)
+		}
+
+		changes[i] = change
+	}
+
+	return &documentpb.VersionDiffResponse{
+		DocumentId:  hexutil.Encode(diff.DocumentID),
+		FromVersion: hexutil.Encode(diff.FromVersion),
+		ToVersion:   hexutil.Encode(diff.ToVersion),
+		Changes:     changes,
+	}
+}
+
+// ConvertAccessReportToClientFormat converts the access report to the client format
+func ConvertAccessReportToClientFormat(report *AccessReport) *documentpb.SimulateAccessResponse {
+	resp := &documentpb.SimulateAccessResponse{
+		DocumentId: hexutil.Encode(report.DocumentID),
+		VersionId:  hexutil.Encode(report.VersionID),
+		Granted:    report.Granted(),
+	}
+
+	for _, c := range report.Checks {
+		resp.Checks = append(resp.Checks, &documentpb.AccessCheck{
+			AccessType: string(c.Type),
+			Granted:    c.Granted,
+			Reason:     c.Reason,
+		})
+	}
+
+	return resp
+}
+
+// ConvertTransferDetailToClientFormat converts a transfer detail to client api format
+func ConvertTransferDetailToClientFormat(detail *coredocumentextpb.TransferDetail) (*documentpb.TransferDetail, error) {
+	amount, err := BytesToDecimal(detail.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &documentpb.TransferDetail{
+		TransferId:       hexutil.Encode(detail.TransferId),
+		PaymentReference: detail.PaymentReference,
+		Amount:           DecimalToString(amount),
+		Currency:         detail.Currency,
+		Status:           detail.Status,
+		SettlementDate:   detail.SettlementDate,
+	}, nil
+}
+
+// ConvertTransferDetailFromClientFormat converts a transfer detail in client api format.
+// The transfer ID is not converted as it is either generated or given by the request.
+func ConvertTransferDetailFromClientFormat(detail *documentpb.TransferDetail) (*coredocumentextpb.TransferDetail, error) {
+	if detail == nil {
+		return nil, errors.New("transfer detail is required")
+	}
+
+	amount, err := StringToDecimal(detail.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	amountBytes, err := DecimalToBytes(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coredocumentextpb.TransferDetail{
+		PaymentReference: detail.PaymentReference,
+		Amount:           amountBytes,
+		Currency:         detail.Currency,
+		Status:           detail.Status,
+		SettlementDate:   detail.SettlementDate,
+	}, nil
+}
+
+// ConvertAttachmentToClientFormat converts an attachment to client api format
+func ConvertAttachmentToClientFormat(attachment *coredocumentextpb.Attachment) *documentpb.Attachment {
+	return &documentpb.Attachment{
+		Name:     attachment.Name,
+		Checksum: hexutil.Encode(attachment.Checksum),
+		Cid:      attachment.Cid,
+		Size:     attachment.Size,
+	}
+}
+
+// ConvertAttachmentFromClientFormat converts an attachment in client api format
+func ConvertAttachmentFromClientFormat(attachment *documentpb.Attachment) (*coredocumentextpb.Attachment, error) {
+	if attachment == nil {
+		return nil, errors.New("attachment is required")
+	}
+
+	var checksum []byte
+	if attachment.Checksum != "" {
+		var err error
+		checksum, err = hexutil.Decode(attachment.Checksum)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &coredocumentextpb.Attachment{
+		Name:     attachment.Name,
+		Checksum: checksum,
+		Cid:      attachment.Cid,
+		Size:     attachment.Size,
+	}, nil
+}
+
+// ConvertTemplateToClientFormat converts a document template to client api format
+func ConvertTemplateToClientFormat(tpl *Template) (*documentpb.DocumentTemplate, error) {
+	ctpl := &documentpb.DocumentTemplate{
+		Name:          tpl.Name,
+		Scheme:        tpl.Scheme,
+		Collaborators: tpl.Collaborators.ReadWriteCollaborators,
+		ReadAccess:    tpl.Collaborators.ReadCollaborators,
+		FieldRules:    ConvertFieldRulesToClientFormat(tpl.FieldRules),
+	}
+
+	for _, a := range tpl.Attributes {
+		var value *structpb.Value
+		if len(a.Default) > 0 {
+			value = new(structpb.Value)
+			err := jsonpb.Unmarshal(bytes.NewReader(a.Default), value)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		ctpl.Attributes = append(ctpl.Attributes, &documentpb.TemplateAttribute{
+			Name:         a.Name,
+			Type:         string(a.Type),
+			Required:     a.Required,
+			DefaultValue: value,
+		})
+	}
+
+	return ctpl, nil
+}
+
+// ConvertTemplateFromClientFormat converts a document template in client api format
+func ConvertTemplateFromClientFormat(tpl *documentpb.DocumentTemplate) (*Template, error) {
+	rules, err := ConvertFieldRulesFromClientFormat(tpl.FieldRules)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Template{
+		Name:   tpl.Name,
+		Scheme: tpl.Scheme,
+		Collaborators: CollaboratorsAccess{
+			ReadCollaborators:      tpl.ReadAccess,
+			ReadWriteCollaborators: tpl.Collaborators,
+		},
+		FieldRules: rules,
+	}
+
+	for _, a := range tpl.Attributes {
+		var value []byte
+		if a.DefaultValue != nil {
+			var buf bytes.Buffer
+			err := new(jsonpb.Marshaler).Marshal(&buf, a.DefaultValue)
+			if err != nil {
+				return nil, err
+			}
+
+			value = buf.Bytes()
+		}
+
+		t.Attributes = append(t.Attributes, TemplateAttribute{
+			Name:     a.Name,
+			Type:     TemplateAttributeType(a.Type),
+			Required: a.Required,
+			Default:  value,
+		})
+	}
+
+	return t, nil
+}
+
+// ConvertImportResultsToClientFormat converts the results of a batch import to client api format
+func ConvertImportResultsToClientFormat(results []ImportResult) *documentpb.ImportDocumentsResponse {
+	resp := &documentpb.ImportDocumentsResponse{Results: make([]*documentpb.ImportRowResult, len(results))}
+	for i, r := range results {
+		row := &documentpb.ImportRowResult{Row: int32(r.Row)}
+		if r.Err != nil {
+			row.Error = r.Err.Error()
+			resp.Failed++
+		} else {
+			row.DocumentId = hexutil.Encode(r.DocumentID)
+			row.TransactionId = r.TxID.String()
+			resp.Imported++
+		}
+
+		resp.Results[i] = row
+	}
+
+	return resp
+}
+
+// ConvertDocSizeToClientFormat converts a DocumentSize to client api format
+func ConvertDocSizeToClientFormat(size *DocumentSize) *documentpb.DocumentSize {
+	versions := make([]*documentpb.VersionSize, len(size.Versions))
+	for i, v := range size.Versions {
+		versions[i] = &documentpb.VersionSize{
+			VersionId:          hexutil.Encode(v.VersionID),
+			Bytes:              uint64(v.Bytes),
+			EmbeddedDataBytes:  uint64(v.EmbeddedDataBytes),
+			SignaturesBytes:    uint64(v.SignaturesBytes),
+			SaltsBytes:         uint64(v.SaltsBytes),
+			CoreDocumentLeaves: uint64(v.CoreDocumentLeaves),
+			EmbeddedDataLeaves: uint64(v.EmbeddedDataLeaves),
+		}
+	}
+
+	return &documentpb.DocumentSize{
+		DocumentId:      hexutil.Encode(size.DocumentID),
+		TotalBytes:      uint64(size.TotalBytes),
+		MaxMessageBytes: uint64(size.MaxMessageBytes),
+		Versions:        versions,
+	}
+}
+
+// ConvertStorageUsageToClientFormat converts a StorageUsage to client api format
+func ConvertStorageUsageToClientFormat(usage *StorageUsage) *documentpb.StorageUsage {
+	types := make([]*documentpb.DocumentTypeStorageUsage, len(usage.DocumentTypes))
+	for i, tu := range usage.DocumentTypes {
+		types[i] = &documentpb.DocumentTypeStorageUsage{
+			DocumentType: tu.DocumentType,
+			Versions:     tu.Versions,
+			Bytes:        tu.Bytes,
+		}
+	}
+
+	return &documentpb.StorageUsage{
+		AccountId:     hexutil.Encode(usage.AccountID),
+		TotalBytes:    usage.TotalBytes,
+		QuotaBytes:    usage.QuotaBytes,
+		DocumentTypes: types,
+	}
+}
+
+// ConvertSummaryToClientFormat converts a Summary to client api format
+func ConvertSummaryToClientFormat(summary *Summary) *documentpb.DocumentSummary {
+	fields := make([]*documentpb.SummaryField, len(summary.Fields))
+	for i, f := range summary.Fields {
+		fields[i] = &documentpb.SummaryField{Name: f.Name, Value: f.Value}
+	}
+
+	return &documentpb.DocumentSummary{
+		DocumentId:    summary.DocumentID,
+		VersionId:     summary.VersionID,
+		DocumentType:  summary.DocumentType,
+		Status:        summary.Status,
+		Author:        summary.Author,
+		Timestamp:     summary.Timestamp,
+		Fields:        fields,
+		Collaborators: summary.Collaborators,
+		AnchorId:      summary.AnchorID,
+		AnchorLink:    summary.AnchorLink,
+	}
+}
+
+// ConvertDocProofToClientFormat converts a DocumentProof to client api format
+func ConvertDocProofToClientFormat(proof *DocumentProof) (*documentpb.DocumentProof, error) {
+	return &documentpb.DocumentProof{
+		Header: &documentpb.ResponseHeader{
+			DocumentId: hexutil.Encode(proof.DocumentID),
+			VersionId:  hexutil.Encode(proof.VersionID),
+			State:      proof.State,
+		},
+		FieldProofs:  ConvertProofsToClientFormat(proof.FieldProofs),
+		DocumentRoot: hexutil.Encode(proof.DocumentRoot),
+		Signatures:   ConvertBundleSignaturesToClientFormat(proof.Signatures),
+		Envelope:     ConvertProofEnvelopeToClientFormat(proof.Envelope),
+	}, nil
+}
+
+// ConvertFieldReferenceFromClientFormat converts a field reference from client api format
+func ConvertFieldReferenceFromClientFormat(ref *documentpb.FieldReference) (r FieldReference, err error) {
+	if ref == nil {
+		return r, errors.New("field reference missing")
+	}
+
+	r.DocumentID, err = identifiers.DecodeDocumentID(ref.Identifier)
+	if err != nil {
+		return r, err
+	}
+
+	if ref.Version != "" {
+		r.VersionID, err = identifiers.DecodeVersionID(ref.Version)
+		if err != nil {
+			return r, err
+		}
+	}
+
+	r.Field = ref.Field
+	return r, nil
+}
+
+// ConvertConsistencyProofToClientFormat converts a ConsistencyProof to client api format
+func ConvertConsistencyProofToClientFormat(proof *ConsistencyProof) (*documentpb.ConsistencyProof, error) {
+	msg, err := ConsistencyProofMessage(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	left, err := ConvertDocProofToClientFormat(proof.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := ConvertDocProofToClientFormat(proof.Right)
+	if err != nil {
+		return nil, err
+	}
+
+	return &documentpb.ConsistencyProof{
+		Left:      left,
+		Right:     right,
+		Message:   hexutil.Encode(msg),
+		Signature: ConvertBundleSignaturesToClientFormat([]*coredocumentpb.Signature{proof.Signature})[0],
+	}, nil
+}
+
+// ConvertBundleSignaturesToClientFormat converts the signatures of a proof bundle to client api format
+func ConvertBundleSignaturesToClientFormat(sigs []*coredocumentpb.Signature) []*documentpb.BundleSignature {
+	converted := make([]*documentpb.BundleSignature, len(sigs))
+	for i, sig := range sigs {
+		converted[i] = &documentpb.BundleSignature{
+			SignerId:  hexutil.Encode(sig.SignerId),
+			PublicKey: hexutil.Encode(sig.PublicKey),
+			Signature: hexutil.Encode(sig.Signature),
+		}
+	}
+	return converted
+}
+
+// ConvertSaltsToClientFormat converts the salts of a document to client api format
+func ConvertSaltsToClientFormat(salts []*coredocumentpb.DocumentSalt) []*documentpb.DocumentSalt {
+	converted := make([]*documentpb.DocumentSalt, len(salts))
+	for i, salt := range salts {
+		converted[i] = &documentpb.DocumentSalt{
+			Compact: hexutil.Encode(salt.Compact),
+			Value:   hexutil.Encode(salt.Value),
+		}
+	}
+	return converted
 }
 
 // ConvertProofsToClientFormat converts a proof protobuf from precise proofs into a client protobuf proof format
@@ -3,17 +3,36 @@
 package documents_test
 
 import (
+	"context"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/crypto"
 	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
 	"github.com/centrifuge/go-centrifuge/testingutils/config"
 	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/transactions"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/centrifuge/precise-proofs/proofs"
 	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/struct"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
 )
 
 func TestGrpcHandler_CreateDocumentProof(t *testing.T) {
@@ -29,13 +48,86 @@ func TestGrpcHandler_CreateDocumentProof(t *testing.T) {
 	id, _ := hexutil.Decode(req.Identifier)
 	doc := &documents.DocumentProof{}
 	service.On("CreateProofs", id, req.Fields).Return(doc, nil)
-	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry)
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
 	retDoc, _ := grpcHandler.CreateDocumentProof(testingconfig.HandlerContext(documents.ConfigService), req)
 	service.AssertExpectations(t)
 	conv, _ := documents.ConvertDocProofToClientFormat(doc)
 	assert.Equal(t, conv, retDoc)
 }
 
+func TestGrpcHandler_CreateDocumentProof_documentType(t *testing.T) {
+	registry := documents.NewServiceRegistry()
+	serviceName := "CreateDocumentProof"
+	service := &testingdocuments.MockService{}
+	registry.Register(serviceName, service)
+	req := &documentpb.CreateDocumentProofRequest{
+		Identifier:        "0xc32b1400b8c66e54448bec863233682d19c770b94ea8d90e1cf02f3bb8ca7da4",
+		Type:              serviceName,
+		ProveDocumentType: true,
+	}
+	id, _ := hexutil.Decode(req.Identifier)
+	service.On("CreateProofs", id, []string{documents.DocumentTypeProofField}).Return(&documents.DocumentProof{}, nil).Once()
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
+	_, err := grpcHandler.CreateDocumentProof(testingconfig.HandlerContext(documents.ConfigService), req)
+	assert.NoError(t, err)
+
+	// document type field is not duplicated
+	req.Fields = []string{"field1", documents.DocumentTypeProofField}
+	service.On("CreateProofs", id, req.Fields).Return(&documents.DocumentProof{}, nil).Once()
+	_, err = grpcHandler.CreateDocumentProof(testingconfig.HandlerContext(documents.ConfigService), req)
+	assert.NoError(t, err)
+	service.AssertExpectations(t)
+}
+
+type mockNotary struct {
+	mock.Mock
+}
+
+func (m *mockNotary) Notarize(ctx context.Context, proof *documents.DocumentProof) error {
+	args := m.Called(proof)
+	return args.Error(0)
+}
+
+func TestGrpcHandler_CreateDocumentProof_notarize(t *testing.T) {
+	registry := documents.NewServiceRegistry()
+	serviceName := "CreateDocumentProofNotarize"
+	service := &testingdocuments.MockService{}
+	registry.Register(serviceName, service)
+	notary := &mockNotary{}
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, notary, nil, nil, nil)
+	req := &documentpb.CreateDocumentProofRequest{
+		Identifier: "0xc32b1400b8c66e54448bec863233682d19c770b94ea8d90e1cf02f3bb8ca7da4",
+		Type:       serviceName,
+		Fields:     []string{"field1"},
+		Notarize:   true,
+	}
+	id, _ := hexutil.Decode(req.Identifier)
+
+	// notarization failed
+	doc := &documents.DocumentProof{DocumentID: id, DocumentRoot: utils.RandomSlice(32)}
+	service.On("CreateProofs", id, req.Fields).Return(doc, nil).Once()
+	notary.On("Notarize", doc).Return(documents.ErrNotaryNotConfigured).Once()
+	_, err := grpcHandler.CreateDocumentProof(testingconfig.HandlerContext(documents.ConfigService), req)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), documents.ErrNotaryNotConfigured.Error())
+
+	// success
+	sig := &coredocumentpb.Signature{SignerId: utils.RandomSlice(20), PublicKey: utils.RandomSlice(32), Signature: utils.RandomSlice(64)}
+	service.On("CreateProofs", id, req.Fields).Return(doc, nil).Once()
+	notary.On("Notarize", doc).Return(nil).Run(func(args mock.Arguments) {
+		proof := args.Get(0).(*documents.DocumentProof)
+		proof.Signatures = []*coredocumentpb.Signature{sig}
+	}).Once()
+	retDoc, err := grpcHandler.CreateDocumentProof(testingconfig.HandlerContext(documents.ConfigService), req)
+	assert.NoError(t, err)
+	service.AssertExpectations(t)
+	notary.AssertExpectations(t)
+	assert.Equal(t, hexutil.Encode(doc.DocumentRoot), retDoc.DocumentRoot)
+	assert.Len(t, retDoc.Signatures, 1)
+	assert.Equal(t, hexutil.Encode(sig.SignerId), retDoc.Signatures[0].SignerId)
+	assert.Equal(t, hexutil.Encode(sig.Signature), retDoc.Signatures[0].Signature)
+}
+
 func TestGrpcHandler_CreateDocumentProofUnableToLocateService(t *testing.T) {
 	registry := documents.NewServiceRegistry()
 	serviceName := "CreateDocumentProofUnableToLocateService"
@@ -46,7 +138,7 @@ func TestGrpcHandler_CreateDocumentProofUnableToLocateService(t *testing.T) {
 		Type:       "wrongService",
 		Fields:     []string{"field1"},
 	}
-	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry)
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
 	_, err := grpcHandler.CreateDocumentProof(testingconfig.HandlerContext(documents.ConfigService), req)
 	assert.NotNil(t, err)
 	service.AssertNotCalled(t, "CreateProofs")
@@ -62,7 +154,7 @@ func TestGrpcHandler_CreateDocumentProofInvalidHex(t *testing.T) {
 		Type:       serviceName,
 		Fields:     []string{"field1"},
 	}
-	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry)
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
 	_, err := grpcHandler.CreateDocumentProof(testingconfig.HandlerContext(documents.ConfigService), req)
 	assert.NotNil(t, err)
 	service.AssertNotCalled(t, "CreateProofs")
@@ -74,8 +166,8 @@ func TestGrpcHandler_CreateDocumentProofForVersion(t *testing.T) {
 	service := &testingdocuments.MockService{}
 	registry.Register(serviceName, service)
 	req := &documentpb.CreateDocumentProofForVersionRequest{
-		Identifier: "0x11111111111111",
-		Version:    "0x1212121212",
+		Identifier: "0x1111111111111111111111111111111111111111111111111111111111111111",
+		Version:    "0x1212121212121212121212121212121212121212121212121212121212121212",
 		Type:       serviceName,
 		Fields:     []string{"field1"},
 	}
@@ -83,7 +175,7 @@ func TestGrpcHandler_CreateDocumentProofForVersion(t *testing.T) {
 	version, _ := hexutil.Decode(req.Version)
 	doc := &documents.DocumentProof{DocumentID: utils.RandomSlice(32)}
 	service.On("CreateProofsForVersion", id, version, req.Fields).Return(doc, nil)
-	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry)
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
 	retDoc, _ := grpcHandler.CreateDocumentProofForVersion(testingconfig.HandlerContext(documents.ConfigService), req)
 	service.AssertExpectations(t)
 	conv, _ := documents.ConvertDocProofToClientFormat(doc)
@@ -101,7 +193,7 @@ func TestGrpcHandler_CreateDocumentProofForVersionUnableToLocateService(t *testi
 		Type:       "wrongService",
 		Fields:     []string{"field1"},
 	}
-	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry)
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
 	_, err := grpcHandler.CreateDocumentProofForVersion(testingconfig.HandlerContext(documents.ConfigService), req)
 	assert.NotNil(t, err)
 	service.AssertNotCalled(t, "CreateProofsForVersion")
@@ -118,7 +210,7 @@ func TestGrpcHandler_CreateDocumentProofForVersionInvalidHexForId(t *testing.T)
 		Type:       serviceName,
 		Fields:     []string{"field1"},
 	}
-	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry)
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
 	_, err := grpcHandler.CreateDocumentProofForVersion(testingconfig.HandlerContext(documents.ConfigService), req)
 	assert.NotNil(t, err)
 	service.AssertNotCalled(t, "CreateProofsForVersion")
@@ -130,12 +222,12 @@ func TestGrpcHandler_CreateDocumentProofForVersionInvalidHexForVersion(t *testin
 	service := &testingdocuments.MockService{}
 	registry.Register(serviceName, service)
 	req := &documentpb.CreateDocumentProofForVersionRequest{
-		Identifier: "0x11111111111111",
+		Identifier: "0x1111111111111111111111111111111111111111111111111111111111111111",
 		Version:    "0x12121212121",
 		Type:       serviceName,
 		Fields:     []string{"field1"},
 	}
-	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry)
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
 	_, err := grpcHandler.CreateDocumentProofForVersion(testingconfig.HandlerContext(documents.ConfigService), req)
 	assert.NotNil(t, err)
 	service.AssertNotCalled(t, "CreateProofsForVersion")
@@ -151,9 +243,13 @@ func TestConvertDocProofToClientFormat(t *testing.T) {
 		{
 			name: "happy",
 			input: &documents.DocumentProof{
-				DocumentID: []byte{1, 2, 1},
-				VersionID:  []byte{1, 2, 2},
-				State:      "state",
+				DocumentID:   []byte{1, 2, 1},
+				VersionID:    []byte{1, 2, 2},
+				State:        "state",
+				DocumentRoot: []byte{1, 2, 3},
+				Signatures: []*coredocumentpb.Signature{
+					{SignerId: []byte{1, 2, 4}, PublicKey: []byte{1, 2, 5}, Signature: []byte{1, 2, 6}},
+				},
 				FieldProofs: []*proofspb.Proof{
 					{
 						Property: proofs.CompactName([]byte{0, 0, 1}...),
@@ -174,6 +270,10 @@ func TestConvertDocProofToClientFormat(t *testing.T) {
 					VersionId:  "0x010202",
 					State:      "state",
 				},
+				DocumentRoot: "0x010203",
+				Signatures: []*documentpb.BundleSignature{
+					{SignerId: "0x010204", PublicKey: "0x010205", Signature: "0x010206"},
+				},
 				FieldProofs: []*documentpb.Proof{
 					{
 						Property: "0x000001",
@@ -197,6 +297,8 @@ func TestConvertDocProofToClientFormat(t *testing.T) {
 			assert.Equal(t, test.output.Header.DocumentId, out.Header.DocumentId)
 			assert.Equal(t, test.output.Header.VersionId, out.Header.VersionId)
 			assert.Equal(t, test.output.Header.State, out.Header.State)
+			assert.Equal(t, test.output.DocumentRoot, out.DocumentRoot)
+			assert.Equal(t, test.output.Signatures, out.Signatures)
 			assert.Equal(t, len(test.output.FieldProofs), len(out.FieldProofs))
 			for i, converted := range test.output.FieldProofs {
 				assert.Equal(t, converted.Hash, out.FieldProofs[i].Hash)
@@ -254,3 +356,1805 @@ func verifyHex(t *testing.T, val string) {
 	_, err := hexutil.Decode(val)
 	assert.Nil(t, err)
 }
+
+func TestGrpcHandler_GetDocumentSize(t *testing.T) {
+	registry := documents.NewServiceRegistry()
+	serviceName := "GetDocumentSize"
+	service := &testingdocuments.MockService{}
+	registry.Register(serviceName, service)
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
+
+	// unknown service
+	req := &documentpb.GetDocumentSizeRequest{
+		Identifier: "0x11111111111111",
+		Type:       "wrongService",
+	}
+	_, err := grpcHandler.GetDocumentSize(testingconfig.HandlerContext(documents.ConfigService), req)
+	assert.Error(t, err)
+
+	// invalid hex
+	req.Type = serviceName
+	req.Identifier = "0x1111111111111"
+	_, err = grpcHandler.GetDocumentSize(testingconfig.HandlerContext(documents.ConfigService), req)
+	assert.Error(t, err)
+	service.AssertNotCalled(t, "GetDocumentSize")
+
+	// invalid length
+	req.Identifier = "0x11111111111111"
+	_, err = grpcHandler.GetDocumentSize(testingconfig.HandlerContext(documents.ConfigService), req)
+	assert.Error(t, err)
+	service.AssertNotCalled(t, "GetDocumentSize")
+
+	// success
+	req.Identifier = "0x1111111111111111111111111111111111111111111111111111111111111111"
+	id, _ := hexutil.Decode(req.Identifier)
+	size := &documents.DocumentSize{
+		DocumentID:      id,
+		TotalBytes:      300,
+		MaxMessageBytes: 1000,
+		Versions: []documents.VersionSize{
+			{VersionID: utils.RandomSlice(32), Bytes: 200, EmbeddedDataLeaves: 5},
+			{VersionID: id, Bytes: 100, EmbeddedDataLeaves: 4},
+		},
+	}
+	service.On("GetDocumentSize", id).Return(size, nil).Once()
+	resp, err := grpcHandler.GetDocumentSize(testingconfig.HandlerContext(documents.ConfigService), req)
+	assert.NoError(t, err)
+	service.AssertExpectations(t)
+	assert.Equal(t, documents.ConvertDocSizeToClientFormat(size), resp)
+	assert.Len(t, resp.Versions, 2)
+	assert.Equal(t, uint64(300), resp.TotalBytes)
+	assert.Equal(t, hexutil.Encode(id), resp.Versions[1].VersionId)
+	assert.Equal(t, uint64(5), resp.Versions[0].EmbeddedDataLeaves)
+}
+
+func TestGrpcHandler_LinkDocument(t *testing.T) {
+	registry := documents.NewServiceRegistry()
+	serviceName := "LinkDocument"
+	service := &testingdocuments.MockService{}
+	registry.Register(serviceName, service)
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+
+	// unknown service
+	req := &documentpb.LinkDocumentRequest{
+		Identifier:       hexutil.Encode(utils.RandomSlice(32)),
+		Type:             "wrongService",
+		LinkedIdentifier: hexutil.Encode(utils.RandomSlice(32)),
+	}
+	_, err := grpcHandler.LinkDocument(ctx, req)
+	assert.Error(t, err)
+
+	// invalid identifier
+	req.Type = serviceName
+	req.Identifier = "0x1111"
+	_, err = grpcHandler.LinkDocument(ctx, req)
+	assert.Error(t, err)
+
+	// invalid linked identifier
+	req.Identifier = hexutil.Encode(utils.RandomSlice(32))
+	req.LinkedIdentifier = "0x1111"
+	_, err = grpcHandler.LinkDocument(ctx, req)
+	assert.Error(t, err)
+	service.AssertNotCalled(t, "LinkDocument")
+
+	// failed to link
+	req.LinkedIdentifier = hexutil.Encode(utils.RandomSlice(32))
+	id, _ := hexutil.Decode(req.Identifier)
+	linkedID, _ := hexutil.Decode(req.LinkedIdentifier)
+	service.On("LinkDocument", id, linkedID).Return(nil, transactions.NilTxID(), errors.New("anchor not mined")).Once()
+	_, err = grpcHandler.LinkDocument(ctx, req)
+	assert.Error(t, err)
+
+	// success
+	version := utils.RandomSlice(32)
+	fields := []string{"cd_tree.linked_documents[0].document_identifier", "cd_tree.linked_documents[0].document_root"}
+	model := new(testingdocuments.MockModel)
+	model.On("ID").Return(id)
+	model.On("CurrentVersion").Return(version)
+	model.On("LinkProofFields", linkedID).Return(fields, nil)
+	txID := transactions.NewTxID()
+	service.On("LinkDocument", id, linkedID).Return(model, txID, nil).Once()
+	resp, err := grpcHandler.LinkDocument(ctx, req)
+	assert.NoError(t, err)
+	service.AssertExpectations(t)
+	model.AssertExpectations(t)
+	assert.Equal(t, req.Identifier, resp.Header.DocumentId)
+	assert.Equal(t, hexutil.Encode(version), resp.Header.VersionId)
+	assert.Equal(t, txID.String(), resp.Header.TransactionId)
+	assert.Equal(t, fields, resp.ProofFields)
+}
+
+func TestGrpcHandler_GetPropertyMappings(t *testing.T) {
+	registry := documents.NewServiceRegistry()
+	grpcHandler := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
+	resp, err := grpcHandler.GetPropertyMappings(context.Background(), &empty.Empty{})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.CoreDocument)
+	assert.Empty(t, resp.Documents)
+
+	cdMappings, err := documents.CoreDocumentPropertyMappings()
+	assert.NoError(t, err)
+	assert.Equal(t, documents.ConvertPropertyMappingsToClientFormat(cdMappings, nil), resp)
+}
+
+type mockSchemeService struct {
+	testingdocuments.MockService
+}
+
+func (m *mockSchemeService) Scheme() string {
+	return "mock"
+}
+
+func (m *mockSchemeService) DeriveFromClientData(ctx context.Context, collaborators documents.CollaboratorsAccess, data []byte) (documents.Model, error) {
+	args := m.Called(collaborators, data)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Error(1)
+}
+
+func (m *mockSchemeService) DeriveFromUpdateClientData(ctx context.Context, identifier string, collaborators documents.CollaboratorsAccess, data []byte) (documents.Model, error) {
+	args := m.Called(identifier, collaborators, data)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Error(1)
+}
+
+func (m *mockSchemeService) DeriveClientData(model documents.Model) ([]byte, error) {
+	args := m.Called(model)
+	data, _ := args.Get(0).([]byte)
+	return data, args.Error(1)
+}
+
+func (m *mockSchemeService) Create(ctx context.Context, model documents.Model) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(model)
+	model, _ = args.Get(0).(documents.Model)
+	return model, transactions.NilTxID(), nil, args.Error(1)
+}
+
+func (m *mockSchemeService) Update(ctx context.Context, model documents.Model) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(model)
+	model, _ = args.Get(0).(documents.Model)
+	return model, transactions.NilTxID(), nil, args.Error(1)
+}
+
+type mockSchemeModel struct {
+	testingdocuments.MockModel
+	id []byte
+}
+
+func (m *mockSchemeModel) ID() []byte {
+	return m.id
+}
+
+func (m *mockSchemeModel) CurrentVersion() []byte {
+	return m.id
+}
+
+func (m *mockSchemeModel) GetCollaborators(filterIDs ...identity.DID) ([]identity.DID, error) {
+	return nil, nil
+}
+
+func schemeHandler(t *testing.T) (*mockSchemeService, documentpb.DocumentServiceServer) {
+	registry := documents.NewServiceRegistry()
+	srv := new(mockSchemeService)
+	assert.NoError(t, registry.Register("mock document", srv))
+	return srv, documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
+}
+
+func TestGrpcHandler_CreateDocument(t *testing.T) {
+	srv, h := schemeHandler(t)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	data := new(structpb.Struct)
+	assert.NoError(t, jsonpb.UnmarshalString(`{"comment":"hello"}`, data))
+
+	// unknown scheme
+	_, err := h.CreateDocument(ctx, &documentpb.DocumentCreatePayload{Scheme: "invoice", Data: data})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), documents.ErrDocumentSchemeUnknown.Error())
+
+	// missing data
+	_, err = h.CreateDocument(ctx, &documentpb.DocumentCreatePayload{Scheme: "mock"})
+	assert.Error(t, err)
+
+	// derive fails
+	srv.On("DeriveFromClientData", documents.CollaboratorsAccess{ReadWriteCollaborators: []string{"0x010203040506"}, RequiredSignatures: 2}, []byte(`{"comment":"hello"}`)).Return(nil, errors.New("invalid data")).Once()
+	_, err = h.CreateDocument(ctx, &documentpb.DocumentCreatePayload{Scheme: "mock", Collaborators: []string{"0x010203040506"}, Data: data, RequiredSignatures: 2})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid data")
+
+	// field rules not supported by the scheme
+	model := &mockSchemeModel{id: utils.RandomSlice(32)}
+	srv.On("DeriveFromClientData", documents.CollaboratorsAccess{}, []byte(`{"comment":"hello"}`)).Return(model, nil).Once()
+	_, err = h.CreateDocument(ctx, &documentpb.DocumentCreatePayload{Scheme: "mock", Data: data, FieldRules: []*documentpb.FieldRule{
+		{Collaborators: []string{"0x010203040506"}, Fields: []string{"comment"}},
+	}})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// success
+	srv.On("DeriveFromClientData", documents.CollaboratorsAccess{}, []byte(`{"comment":"hello"}`)).Return(model, nil).Once()
+	srv.On("Create", model).Return(model, nil).Once()
+	srv.On("DeriveClientData", model).Return([]byte(`{"comment":"hello"}`), nil).Once()
+	resp, err := h.CreateDocument(ctx, &documentpb.DocumentCreatePayload{Scheme: "mock", Data: data})
+	assert.NoError(t, err)
+	srv.AssertExpectations(t)
+	assert.Equal(t, hexutil.Encode(model.id), resp.Header.DocumentId)
+	assert.Equal(t, "hello", resp.Data.Fields["comment"].GetStringValue())
+}
+
+func TestGrpcHandler_UpdateDocument(t *testing.T) {
+	srv, h := schemeHandler(t)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	data := new(structpb.Struct)
+	assert.NoError(t, jsonpb.UnmarshalString(`{"comment":"hello"}`, data))
+	model := &mockSchemeModel{id: utils.RandomSlice(32)}
+	id := hexutil.Encode(model.id)
+
+	// update fails
+	collaborators := documents.CollaboratorsAccess{ReadWriteCollaborators: []string{"0x010203040506"}, RequiredSignatures: 2}
+	srv.On("DeriveFromUpdateClientData", id, collaborators, []byte(`{"comment":"hello"}`)).Return(model, nil).Once()
+	srv.On("Update", model).Return(nil, errors.New("update failed")).Once()
+	_, err := h.UpdateDocument(ctx, &documentpb.DocumentUpdatePayload{Scheme: "mock", Identifier: id, Data: data, WriteAccess: []string{"0x010203040506"}, RequiredSignatures: 2})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "update failed")
+
+	// success
+	srv.On("DeriveFromUpdateClientData", id, documents.CollaboratorsAccess{}, []byte(`{"comment":"hello"}`)).Return(model, nil).Once()
+	srv.On("Update", model).Return(model, nil).Once()
+	srv.On("DeriveClientData", model).Return([]byte(`{"comment":"hello"}`), nil).Once()
+	resp, err := h.UpdateDocument(ctx, &documentpb.DocumentUpdatePayload{Scheme: "mock", Identifier: id, Data: data})
+	assert.NoError(t, err)
+	srv.AssertExpectations(t)
+	assert.Equal(t, id, resp.Header.VersionId)
+}
+
+func TestGrpcHandler_JSONSchema(t *testing.T) {
+	registry := documents.NewServiceRegistry()
+	srv := new(mockSchemeService)
+	assert.NoError(t, registry.Register("mock document", srv))
+	schema, err := documents.ParseJSONSchema([]byte(`{"type": "object", "properties": {"comment": {"type": "string", "maxLength": 4}}}`))
+	assert.NoError(t, err)
+	assert.NoError(t, registry.RegisterJSONSchema("mock", schema))
+	h := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, nil, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	data := new(structpb.Struct)
+	assert.NoError(t, jsonpb.UnmarshalString(`{"comment":"hello"}`, data))
+
+	// the documents are not derived from client data not matching the schema
+	_, err = h.CreateDocument(ctx, &documentpb.DocumentCreatePayload{Scheme: "mock", Data: data})
+	assert.Error(t, err)
+	code, msg := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, msg, "comment must be at most 4 characters long")
+
+	_, err = h.UpdateDocument(ctx, &documentpb.DocumentUpdatePayload{Scheme: "mock", Identifier: hexutil.Encode(utils.RandomSlice(32)), Data: data})
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_GetDocument(t *testing.T) {
+	srv, h := schemeHandler(t)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	model := &mockSchemeModel{id: utils.RandomSlice(32)}
+	id := hexutil.Encode(model.id)
+
+	// invalid identifier
+	_, err := h.GetDocument(ctx, &documentpb.GetDocumentRequest{Scheme: "mock", Identifier: "0x01"})
+	assert.Error(t, err)
+
+	// not found
+	srv.On("GetCurrentVersion", model.id).Return(new(mockSchemeModel), documents.ErrDocumentNotFound).Once()
+	_, err = h.GetDocument(ctx, &documentpb.GetDocumentRequest{Scheme: "mock", Identifier: id})
+	assert.Error(t, err)
+
+	// success
+	srv.On("GetCurrentVersion", model.id).Return(model, nil).Once()
+	srv.On("DeriveClientData", model).Return([]byte(`{"comment":"hello"}`), nil).Twice()
+	resp, err := h.GetDocument(ctx, &documentpb.GetDocumentRequest{Scheme: "mock", Identifier: id})
+	assert.NoError(t, err)
+	assert.Equal(t, id, resp.Header.DocumentId)
+
+	// version
+	srv.On("GetVersion", model.id, model.id).Return(model, nil).Once()
+	resp, err = h.GetDocumentVersion(ctx, &documentpb.GetDocumentVersionRequest{Scheme: "mock", Identifier: id, Version: id})
+	assert.NoError(t, err)
+	assert.Equal(t, id, resp.Header.VersionId)
+	assert.NotNil(t, resp.Data)
+	assert.Empty(t, resp.Salts)
+	assert.Empty(t, resp.Signatures)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_GetDocument_View(t *testing.T) {
+	srv, h := schemeHandler(t)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	model := &mockSchemeModel{id: utils.RandomSlice(32)}
+	id := hexutil.Encode(model.id)
+	salt := &coredocumentpb.DocumentSalt{Compact: []byte{0, 0, 0, 1}, Value: utils.RandomSlice(32)}
+	sig := &coredocumentpb.Signature{SignerId: utils.RandomSlice(20), PublicKey: utils.RandomSlice(32), Signature: utils.RandomSlice(65)}
+	model.On("PackCoreDocument").Return(coredocumentpb.CoreDocument{
+		CoredocumentSalts: []*coredocumentpb.DocumentSalt{salt},
+		EmbeddedDataSalts: []*coredocumentpb.DocumentSalt{salt},
+		SignatureData:     &coredocumentpb.SignatureData{Signatures: []*coredocumentpb.Signature{sig}},
+	}, nil).Twice()
+
+	// header only, the client data is not derived
+	srv.On("GetCurrentVersion", model.id).Return(model, nil).Once()
+	resp, err := h.GetDocument(ctx, &documentpb.GetDocumentRequest{Scheme: "mock", Identifier: id, HeaderOnly: true})
+	assert.NoError(t, err)
+	assert.Equal(t, id, resp.Header.DocumentId)
+	assert.Nil(t, resp.Data)
+	assert.Empty(t, resp.Salts)
+
+	// header with salts
+	srv.On("GetCurrentVersion", model.id).Return(model, nil).Once()
+	resp, err = h.GetDocument(ctx, &documentpb.GetDocumentRequest{Scheme: "mock", Identifier: id, HeaderOnly: true, IncludeSalts: true})
+	assert.NoError(t, err)
+	assert.Nil(t, resp.Data)
+	assert.Len(t, resp.Salts, 2)
+	assert.Equal(t, "0x00000001", resp.Salts[0].Compact)
+	assert.Equal(t, hexutil.Encode(salt.Value), resp.Salts[0].Value)
+	assert.Empty(t, resp.Signatures)
+
+	// data with signatures
+	srv.On("GetVersion", model.id, model.id).Return(model, nil).Once()
+	srv.On("DeriveClientData", model).Return([]byte(`{"comment":"hello"}`), nil).Once()
+	resp, err = h.GetDocumentVersion(ctx, &documentpb.GetDocumentVersionRequest{Scheme: "mock", Identifier: id, Version: id, IncludeSignatures: true})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp.Data)
+	assert.Empty(t, resp.Salts)
+	assert.Len(t, resp.Signatures, 1)
+	assert.Equal(t, hexutil.Encode(sig.SignerId), resp.Signatures[0].SignerId)
+	srv.AssertExpectations(t)
+	model.AssertExpectations(t)
+}
+
+func TestGrpcHandler_CreateProof(t *testing.T) {
+	srv, h := schemeHandler(t)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id := utils.RandomSlice(32)
+	proof := &documents.DocumentProof{DocumentID: id, VersionID: id}
+
+	// unknown scheme
+	_, err := h.CreateProof(ctx, &documentpb.CreateProofRequest{Scheme: "invoice", Identifier: hexutil.Encode(id)})
+	assert.Error(t, err)
+
+	srv.On("CreateProofs", id, []string{"field1", documents.DocumentTypeProofField}).Return(proof, nil).Once()
+	resp, err := h.CreateProof(ctx, &documentpb.CreateProofRequest{
+		Scheme:            "mock",
+		Identifier:        hexutil.Encode(id),
+		Fields:            []string{"field1"},
+		ProveDocumentType: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.Header.DocumentId)
+
+	srv.On("CreateProofsForVersion", id, id, []string{"field1"}).Return(proof, nil).Once()
+	resp, err = h.CreateProofForVersion(ctx, &documentpb.CreateProofForVersionRequest{
+		Scheme:     "mock",
+		Identifier: hexutil.Encode(id),
+		Version:    hexutil.Encode(id),
+		Fields:     []string{"field1"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.Header.VersionId)
+
+	// multiproof
+	proof.FieldProofs = []*proofspb.Proof{
+		{Property: proofs.CompactName(1, 0, 0, 0, 0, 0, 0, 1), Value: []byte{1}, SortedHashes: [][]byte{utils.RandomSlice(32), id}},
+		{Property: proofs.CompactName(1, 0, 0, 0, 0, 0, 0, 2), Value: []byte{2}, SortedHashes: [][]byte{utils.RandomSlice(32), id}},
+	}
+	srv.On("CreateProofs", id, []string{"field1", "field2"}).Return(proof, nil).Once()
+	resp, err = h.CreateProof(ctx, &documentpb.CreateProofRequest{
+		Scheme:     "mock",
+		Identifier: hexutil.Encode(id),
+		Fields:     []string{"field1", "field2"},
+		Multiproof: true,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.FieldProofs)
+	assert.Len(t, resp.MultiProof.Fields, 2)
+	assert.Len(t, resp.MultiProof.Hashes, 3)
+	assert.Equal(t, []uint32{2, 1}, resp.MultiProof.Fields[1].HashIndexes)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_ListDocuments(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	accountID, err := contextutil.AccountDID(ctx)
+	assert.NoError(t, err)
+
+	// missing account
+	_, err = h.ListDocuments(context.Background(), &documentpb.ListDocumentsRequest{})
+	assert.Error(t, err)
+
+	// invalid page size
+	srv.On("List", accountID, 1, -1).Return(nil, errors.NewTypedError(documents.ErrDocumentPagination, errors.New("invalid"))).Once()
+	_, err = h.ListDocuments(ctx, &documentpb.ListDocumentsRequest{PerPage: -1})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// success, page defaults to 1
+	id := utils.RandomSlice(32)
+	srv.On("List", accountID, 1, 0).Return(&documents.DocumentPage{
+		Documents: []documents.DocumentItem{{DocumentID: id, DocumentType: "invoice", Status: documents.StatusCommitted, LatestVersion: id}},
+		Page:      1,
+		PerPage:   documents.DefaultPerPage,
+		Total:     1,
+	}, nil).Once()
+	resp, err := h.ListDocuments(ctx, &documentpb.ListDocumentsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Total)
+	assert.Equal(t, int32(documents.DefaultPerPage), resp.PerPage)
+	assert.Len(t, resp.Data, 1)
+	assert.Equal(t, hexutil.Encode(id), resp.Data[0].DocumentId)
+	assert.Equal(t, hexutil.Encode(id), resp.Data[0].LatestVersion)
+	assert.Equal(t, "committed", resp.Data[0].Status)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_ExportLedger(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	accountID, err := contextutil.AccountDID(ctx)
+	assert.NoError(t, err)
+
+	// missing account
+	_, err = h.ExportLedger(context.Background(), &documentpb.ExportLedgerRequest{})
+	assert.Error(t, err)
+
+	// invalid dates
+	for _, req := range []*documentpb.ExportLedgerRequest{{FromDate: "01/01/2019"}, {ToDate: "2019-13-01"}} {
+		_, err = h.ExportLedger(ctx, req)
+		assert.Error(t, err)
+		code, _ := errors.GetHTTPDetails(err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	}
+
+	// empty range
+	from := time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)
+	srv.On("ExportLedger", accountID, from, to).Return(nil, errors.NewTypedError(documents.ErrDocumentLedger, errors.New("empty range"))).Once()
+	_, err = h.ExportLedger(ctx, &documentpb.ExportLedgerRequest{FromDate: "2019-01-02", ToDate: "2019-01-01"})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// csv by default, the last day is part of the range
+	id := utils.RandomSlice(32)
+	events := []documents.LedgerEvent{{
+		EventID:      hexutil.Encode(id) + "-created",
+		Type:         documents.LedgerEventCreated,
+		Timestamp:    time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC),
+		DocumentID:   id,
+		Version:      id,
+		DocumentType: "invoice",
+		LedgerAmount: documents.LedgerAmount{Number: "INV-1", Currency: "EUR", Amount: "42.5"},
+	}}
+	from = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv.On("ExportLedger", accountID, from, to).Return(events, nil).Once()
+	resp, err := h.ExportLedger(ctx, &documentpb.ExportLedgerRequest{FromDate: "2019-01-01", ToDate: "2019-01-01"})
+	assert.NoError(t, err)
+	assert.Equal(t, "csv", resp.Format)
+	assert.Equal(t, int32(1), resp.Events)
+	assert.Contains(t, resp.Data, "INV-1,EUR,42.5")
+
+	// open range, unknown format
+	srv.On("ExportLedger", accountID, time.Time{}, time.Time{}).Return(events, nil).Once()
+	_, err = h.ExportLedger(ctx, &documentpb.ExportLedgerRequest{Format: "xml"})
+	assert.Error(t, err)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_ExportSignatureAudit(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	accountID, err := contextutil.AccountDID(ctx)
+	assert.NoError(t, err)
+
+	// the stored account points to the keys relative to the testing config
+	acc, err := contextutil.Account(ctx)
+	assert.NoError(t, err)
+	acc.(*configstore.Account).P2PKeyPair = configstore.NewKeyPair("../build/resources/p2pKey.pub.pem", "../build/resources/p2pKey.key.pem")
+	acc.(*configstore.Account).SigningKeyPair = configstore.NewKeyPair("../build/resources/signingKey.pub.pem", "../build/resources/signingKey.key.pem")
+
+	// missing account
+	_, err = h.ExportSignatureAudit(context.Background(), &documentpb.ExportSignatureAuditRequest{})
+	assert.Error(t, err)
+
+	// invalid dates
+	_, err = h.ExportSignatureAudit(ctx, &documentpb.ExportSignatureAuditRequest{FromDate: "01/01/2019"})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// csv by default, the last day is part of the range
+	id := utils.RandomSlice(32)
+	sigs := []documents.AuditedSignature{{
+		Kind:         documents.SignatureKindDocument,
+		Timestamp:    time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC),
+		DocumentID:   id,
+		Version:      id,
+		DocumentType: "invoice",
+		SigningRoot:  utils.RandomSlice(32),
+		PublicKey:    utils.RandomSlice(32),
+		Signature:    utils.RandomSlice(65),
+	}}
+	from := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)
+	srv.On("ExportSignatureAudit", accountID, from, to).Return(sigs, nil).Once()
+	resp, err := h.ExportSignatureAudit(ctx, &documentpb.ExportSignatureAuditRequest{FromDate: "2019-01-01", ToDate: "2019-01-01"})
+	assert.NoError(t, err)
+	assert.Equal(t, "csv", resp.Format)
+	assert.Equal(t, int32(1), resp.Signatures)
+	assert.Contains(t, resp.Data, hexutil.Encode(sigs[0].Signature))
+
+	// the export is signed by the account
+	assert.Equal(t, hexutil.Encode(accountID[:]), resp.SignerId)
+	pk, err := hexutil.Decode(resp.PublicKey)
+	assert.NoError(t, err)
+	signature, err := hexutil.Decode(resp.Signature)
+	assert.NoError(t, err)
+	assert.True(t, crypto.VerifyMessage(pk, []byte(resp.Data), signature, crypto.CurveSecp256K1))
+
+	// service error
+	srv.On("ExportSignatureAudit", accountID, time.Time{}, time.Time{}).Return(nil, errors.NewTypedError(documents.ErrSignatureAudit, errors.New("failed"))).Once()
+	_, err = h.ExportSignatureAudit(ctx, &documentpb.ExportSignatureAuditRequest{Format: "json"})
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_GetStorageUsage(t *testing.T) {
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	accountID, err := contextutil.AccountDID(ctx)
+	assert.NoError(t, err)
+
+	// usage not tracked
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, nil, nil)
+	_, err = h.GetStorageUsage(ctx, &empty.Empty{})
+	assert.Error(t, err)
+
+	db, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	usage := documents.NewUsageTracker(documents.NewDBRepository(leveldb.NewLevelDBRepository(db)), 1024)
+	h = documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, nil, usage)
+
+	// missing account
+	_, err = h.GetStorageUsage(context.Background(), &empty.Empty{})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	resp, err := h.GetStorageUsage(ctx, &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(accountID[:]), resp.AccountId)
+	assert.Equal(t, uint64(0), resp.TotalBytes)
+	assert.Equal(t, uint64(1024), resp.QuotaBytes)
+	assert.Empty(t, resp.DocumentTypes)
+}
+
+func TestGrpcHandler_GetDocumentGraph(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+
+	// invalid identifier
+	_, err := h.GetDocumentGraph(ctx, &documentpb.GetDocumentGraphRequest{Identifier: "invalid"})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// document not found
+	id := utils.RandomSlice(32)
+	srv.On("GetDocumentGraph", id).Return(nil, documents.ErrDocumentNotFound).Once()
+	_, err = h.GetDocumentGraph(ctx, &documentpb.GetDocumentGraphRequest{Identifier: hexutil.Encode(id)})
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	linkedID, tokenID := utils.RandomSlice(32), utils.RandomSlice(32)
+	registry := common.BytesToAddress(utils.RandomSlice(20))
+	srv.On("GetDocumentGraph", id).Return(&documents.DocumentGraph{
+		DocumentID:   id,
+		DocumentType: "invoice",
+		Links: []documents.GraphLink{
+			{Link: documents.Link{LinkType: documents.LinkTypeDocument, Direction: documents.LinkIncoming, DocumentID: linkedID}, DocumentType: "purchaseorder"},
+			{Link: documents.Link{LinkType: documents.LinkTypeNFT, Direction: documents.LinkOutgoing, Registry: registry, TokenID: tokenID}},
+		},
+	}, nil).Once()
+	resp, err := h.GetDocumentGraph(ctx, &documentpb.GetDocumentGraphRequest{Identifier: hexutil.Encode(id)})
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.DocumentId)
+	assert.Equal(t, "invoice", resp.DocumentType)
+	assert.Len(t, resp.Links, 2)
+	assert.Equal(t, &documentpb.DocumentGraphLink{LinkType: "document", Direction: "incoming", DocumentId: hexutil.Encode(linkedID), DocumentType: "purchaseorder"}, resp.Links[0])
+	assert.Equal(t, &documentpb.DocumentGraphLink{LinkType: "nft", Direction: "outgoing", Registry: registry.Hex(), TokenId: hexutil.Encode(tokenID)}, resp.Links[1])
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_GetTransitionGraph(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+
+	// invalid identifier
+	_, err := h.GetTransitionGraph(ctx, &documentpb.GetTransitionGraphRequest{Identifier: "invalid"})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// document not found
+	id := utils.RandomSlice(32)
+	srv.On("GetTransitionGraph", id).Return(nil, documents.ErrDocumentNotFound).Once()
+	_, err = h.GetTransitionGraph(ctx, &documentpb.GetTransitionGraphRequest{Identifier: hexutil.Encode(id)})
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	roleKey, ruleKey := utils.RandomSlice(32), utils.RandomSlice(32)
+	did := testingidentity.GenerateRandomDID()
+	graph := &documents.TransitionGraph{
+		DocumentID:   id,
+		VersionID:    id,
+		DocumentType: "invoice",
+		Roles:        []documents.TransitionRole{{RoleKey: roleKey, Collaborators: []identity.DID{did}}},
+		Rules:        []documents.TransitionEdge{{RuleKey: ruleKey, Roles: [][]byte{roleKey}, MatchType: "prefix", Field: "invoice", Action: "edit"}},
+	}
+	srv.On("GetTransitionGraph", id).Return(graph, nil).Once()
+	resp, err := h.GetTransitionGraph(ctx, &documentpb.GetTransitionGraphRequest{Identifier: hexutil.Encode(id)})
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.DocumentId)
+	assert.Equal(t, []*documentpb.TransitionGraphRole{{RoleKey: hexutil.Encode(roleKey), Collaborators: []string{did.String()}}}, resp.Roles)
+	assert.Equal(t, []*documentpb.TransitionGraphRule{{
+		RuleKey: hexutil.Encode(ruleKey), Roles: []string{hexutil.Encode(roleKey)}, MatchType: "prefix", Field: "invoice", Action: "edit",
+	}}, resp.Rules)
+	assert.Equal(t, graph.DOT(), resp.Dot)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_GetVersionHistory(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+
+	// invalid identifier
+	_, err := h.GetVersionHistory(ctx, &documentpb.GetVersionHistoryRequest{Identifier: "invalid"})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// document not found
+	id := utils.RandomSlice(32)
+	srv.On("GetVersionHistory", id).Return(nil, documents.ErrDocumentNotFound).Once()
+	_, err = h.GetVersionHistory(ctx, &documentpb.GetVersionHistoryRequest{Identifier: hexutil.Encode(id)})
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	author := testingidentity.GenerateRandomDID()
+	next, root := utils.RandomSlice(32), utils.RandomSlice(32)
+	ts := time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC)
+	srv.On("GetVersionHistory", id).Return(&documents.VersionHistory{
+		DocumentID:   id,
+		DocumentType: "invoice",
+		Versions: []documents.VersionEntry{
+			{Version: id, Author: author, Timestamp: ts, Status: documents.StatusCommitted, DocumentRoot: root},
+			{Version: next, PreviousVersion: id, Author: author, Status: documents.StatusDraft},
+		},
+	}, nil).Once()
+	resp, err := h.GetVersionHistory(ctx, &documentpb.GetVersionHistoryRequest{Identifier: hexutil.Encode(id)})
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.DocumentId)
+	assert.Equal(t, "invoice", resp.DocumentType)
+	assert.Len(t, resp.Versions, 2)
+	assert.Equal(t, hexutil.Encode(id), resp.Versions[0].VersionId)
+	assert.Empty(t, resp.Versions[0].PreviousVersionId)
+	assert.Equal(t, author.String(), resp.Versions[0].Author)
+	assert.Equal(t, ts.Unix(), resp.Versions[0].Timestamp.Seconds)
+	assert.Equal(t, hexutil.Encode(root), resp.Versions[0].DocumentRoot)
+	assert.Equal(t, &documentpb.VersionHistoryEntry{VersionId: hexutil.Encode(next), PreviousVersionId: hexutil.Encode(id), Author: author.String(), Status: "draft"}, resp.Versions[1])
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_ListAccessTokens(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+
+	// invalid identifier
+	_, err := h.ListAccessTokens(ctx, &documentpb.ListAccessTokensRequest{Identifier: "invalid"})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// document not found
+	id := utils.RandomSlice(32)
+	srv.On("ListAccessTokens", id).Return(nil, documents.ErrDocumentNotFound).Once()
+	_, err = h.ListAccessTokens(ctx, &documentpb.ListAccessTokensRequest{Identifier: hexutil.Encode(id)})
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	granter, grantee := testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID()
+	tokenID := utils.RandomSlice(32)
+	expiresAt := time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC)
+	srv.On("ListAccessTokens", id).Return([]documents.AccessToken{
+		{Identifier: tokenID, Granter: granter, Grantee: grantee, Scope: documents.AccessTokenScopeRead},
+		{Identifier: tokenID, Granter: granter, Grantee: grantee, Scope: documents.AccessTokenScopeProofs, ExpiresAt: expiresAt},
+	}, nil).Once()
+	resp, err := h.ListAccessTokens(ctx, &documentpb.ListAccessTokensRequest{Identifier: hexutil.Encode(id)})
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.DocumentId)
+	assert.Len(t, resp.Tokens, 2)
+	assert.Equal(t, &documentpb.AccessTokenEntry{Identifier: hexutil.Encode(tokenID), Granter: granter.String(), Grantee: grantee.String(), Scope: "read"}, resp.Tokens[0])
+	assert.Equal(t, "proofs", resp.Tokens[1].Scope)
+	assert.Equal(t, expiresAt.Unix(), resp.Tokens[1].ExpiresAt.Seconds)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_GetVersionDiff(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id, from, to := utils.RandomSlice(32), utils.RandomSlice(32), utils.RandomSlice(32)
+
+	// invalid identifier
+	_, err := h.GetVersionDiff(ctx, &documentpb.GetVersionDiffRequest{Identifier: "invalid", FromVersion: hexutil.Encode(from), ToVersion: hexutil.Encode(to)})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// invalid version
+	_, err = h.GetVersionDiff(ctx, &documentpb.GetVersionDiffRequest{Identifier: hexutil.Encode(id), FromVersion: hexutil.Encode(from), ToVersion: "invalid"})
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// version not found
+	req := &documentpb.GetVersionDiffRequest{Identifier: hexutil.Encode(id), FromVersion: hexutil.Encode(from), ToVersion: hexutil.Encode(to)}
+	srv.On("GetVersionDiff", id, from, to).Return(nil, errors.NewTypedError(documents.ErrDocumentVersionNotFound, errors.New("missing"))).Once()
+	_, err = h.GetVersionDiff(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	srv.On("GetVersionDiff", id, from, to).Return(&documents.VersionDiff{
+		DocumentID:  id,
+		FromVersion: from,
+		ToVersion:   to,
+		Changes: []documents.ChangedField{
+			{Property: []byte{0, 0, 0, 1}, Name: "invoice.currency", Old: []byte("EUR"), New: []byte("INR")},
+			{Property: []byte{0, 0, 0, 2}, Name: "invoice.comment", New: []byte("added")},
+		},
+	}, nil).Once()
+	resp, err := h.GetVersionDiff(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.DocumentId)
+	assert.Equal(t, hexutil.Encode(from), resp.FromVersion)
+	assert.Equal(t, hexutil.Encode(to), resp.ToVersion)
+	assert.Equal(t, []*documentpb.FieldChange{
+		{Property: "0x00000001", Name: "invoice.currency", OldValue: hexutil.Encode([]byte("EUR")), NewValue: hexutil.Encode([]byte("INR"))},
+		{Property: "0x00000002", Name: "invoice.comment", NewValue: hexutil.Encode([]byte("added"))},
+	}, resp.Changes)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_ImportDocuments(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	req := &documentpb.ImportDocumentsRequest{Scheme: "invoice", Format: "csv", Data: "invoice_number\nINV-1\n\n"}
+
+	// unknown scheme
+	srv.On("Import", "invoice", documents.ImportFormatCSV, []string(nil), []byte(req.Data)).Return(nil, errors.NewTypedError(documents.ErrDocumentSchemeUnknown, errors.New("invoice"))).Once()
+	_, err := h.ImportDocuments(ctx, req)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// malformed batch
+	srv.On("Import", "invoice", documents.ImportFormatCSV, []string(nil), []byte(req.Data)).Return(nil, errors.NewTypedError(documents.ErrDocumentImport, errors.New("malformed csv"))).Once()
+	_, err = h.ImportDocuments(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// success
+	id, txID := utils.RandomSlice(32), transactions.NewTxID()
+	srv.On("Import", "invoice", documents.ImportFormatCSV, []string(nil), []byte(req.Data)).Return([]documents.ImportResult{
+		{Row: 1, DocumentID: id, TxID: txID},
+		{Row: 2, Err: errors.New("invalid data")},
+	}, nil).Once()
+	resp, err := h.ImportDocuments(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, &documentpb.ImportDocumentsResponse{
+		Imported: 1,
+		Failed:   1,
+		Results: []*documentpb.ImportRowResult{
+			{Row: 1, DocumentId: hexutil.Encode(id), TransactionId: txID.String()},
+			{Row: 2, Error: "invalid data"},
+		},
+	}, resp)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_RemoveCollaborators(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id := utils.RandomSlice(32)
+	c := testingidentity.GenerateRandomDID()
+
+	// invalid identifier
+	_, err := h.RemoveCollaborators(ctx, &documentpb.RemoveCollaboratorsRequest{Identifier: "invalid", Collaborators: []string{c.String()}})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// invalid collaborator
+	_, err = h.RemoveCollaborators(ctx, &documentpb.RemoveCollaboratorsRequest{Identifier: hexutil.Encode(id), Collaborators: []string{"invalid"}})
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// document not found
+	req := &documentpb.RemoveCollaboratorsRequest{Identifier: hexutil.Encode(id), Collaborators: []string{c.String()}}
+	srv.On("RemoveCollaborators", id, []identity.DID{c}).Return(nil, transactions.NilTxID(), documents.ErrDocumentNotFound).Once()
+	_, err = h.RemoveCollaborators(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// not a collaborator
+	srv.On("RemoveCollaborators", id, []identity.DID{c}).Return(nil, transactions.NilTxID(), errors.NewTypedError(documents.ErrDocumentInvalid, errors.New("not a collaborator"))).Once()
+	_, err = h.RemoveCollaborators(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// success
+	model := &mockSchemeModel{id: id}
+	txID := transactions.NewTxID()
+	srv.On("RemoveCollaborators", id, []identity.DID{c}).Return(model, txID, nil).Once()
+	resp, err := h.RemoveCollaborators(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.Header.DocumentId)
+	assert.Equal(t, txID.String(), resp.Header.TransactionId)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_SignAttribute(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id := utils.RandomSlice(32)
+	property := "invoice.gross_amount_decimal"
+
+	// invalid identifier
+	_, err := h.SignAttribute(ctx, &documentpb.SignAttributeRequest{Identifier: "invalid", Property: property})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// unknown property
+	req := &documentpb.SignAttributeRequest{Identifier: hexutil.Encode(id), Property: property}
+	srv.On("SignAttribute", id, property).Return(nil, errors.NewTypedError(documents.ErrDocumentProof, errors.New("unknown property"))).Once()
+	_, err = h.SignAttribute(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// success
+	signer := testingidentity.GenerateRandomDID()
+	sig := &coredocumentextpb.AttributeSignature{
+		SignerId:  signer[:],
+		PublicKey: utils.RandomSlice(32),
+		Property:  property,
+		Value:     utils.RandomSlice(32),
+		Signature: utils.RandomSlice(65),
+	}
+	srv.On("SignAttribute", id, property).Return(sig, nil).Once()
+	resp, err := h.SignAttribute(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, signer.String(), resp.SignerId)
+	assert.Equal(t, hexutil.Encode(sig.Value), resp.Value)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_AddAttributeSignature(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id := utils.RandomSlice(32)
+	signer := testingidentity.GenerateRandomDID()
+	sig := &coredocumentextpb.AttributeSignature{
+		SignerId:  signer[:],
+		PublicKey: utils.RandomSlice(32),
+		Property:  "invoice.gross_amount_decimal",
+		Value:     utils.RandomSlice(32),
+		Signature: utils.RandomSlice(65),
+	}
+
+	// missing signature
+	_, err := h.AddAttributeSignature(ctx, &documentpb.AddAttributeSignatureRequest{Identifier: hexutil.Encode(id)})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// invalid signature
+	req := &documentpb.AddAttributeSignatureRequest{Identifier: hexutil.Encode(id), Signature: documents.ConvertAttributeSignatureToClientFormat(sig)}
+	srv.On("AddAttributeSignature", id, sig).Return(nil, transactions.NilTxID(), errors.NewTypedError(documents.ErrAttributeSignatureInvalid, errors.New("invalid"))).Once()
+	_, err = h.AddAttributeSignature(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// success
+	model := &mockSchemeModel{id: id}
+	fields := []string{"cd_tree.attribute_signatures[0].signature"}
+	model.On("AttributeSignatureProofFields", signer, sig.Property).Return(fields, nil).Once()
+	txID := transactions.NewTxID()
+	srv.On("AddAttributeSignature", id, sig).Return(model, txID, nil).Once()
+	resp, err := h.AddAttributeSignature(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, txID.String(), resp.Header.TransactionId)
+	assert.Equal(t, fields, resp.ProofFields)
+	srv.AssertExpectations(t)
+	model.AssertExpectations(t)
+}
+
+func TestGrpcHandler_CancelDocument(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id, replacement := utils.RandomSlice(32), utils.RandomSlice(32)
+
+	// invalid identifiers
+	_, err := h.CancelDocument(ctx, &documentpb.CancelDocumentRequest{Identifier: "invalid"})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+	_, err = h.CancelDocument(ctx, &documentpb.CancelDocumentRequest{Identifier: hexutil.Encode(id), SupersededBy: "invalid"})
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// already cancelled
+	req := &documentpb.CancelDocumentRequest{Identifier: hexutil.Encode(id), SupersededBy: hexutil.Encode(replacement)}
+	srv.On("Cancel", id, replacement).Return(nil, transactions.NilTxID(), documents.ErrDocumentCancelled).Once()
+	_, err = h.CancelDocument(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// success
+	model := &mockSchemeModel{id: id}
+	txID := transactions.NewTxID()
+	srv.On("Cancel", id, []byte(nil)).Return(model, txID, nil).Once()
+	resp, err := h.CancelDocument(ctx, &documentpb.CancelDocumentRequest{Identifier: hexutil.Encode(id)})
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.Header.DocumentId)
+	assert.Equal(t, txID.String(), resp.Header.TransactionId)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_AddNFT(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id, tokenID := utils.RandomSlice(32), utils.RandomSlice(32)
+	registry := common.BytesToAddress(utils.RandomSlice(20))
+
+	// invalid parameters
+	for _, req := range []*documentpb.AddNFTRequest{
+		{Identifier: "invalid", RegistryAddress: registry.Hex(), TokenId: hexutil.Encode(tokenID)},
+		{Identifier: hexutil.Encode(id), RegistryAddress: "invalid", TokenId: hexutil.Encode(tokenID)},
+		{Identifier: hexutil.Encode(id), RegistryAddress: registry.Hex(), TokenId: hexutil.Encode(tokenID[:16])},
+	} {
+		_, err := h.AddNFT(ctx, req)
+		assert.Error(t, err)
+		code, _ := errors.GetHTTPDetails(err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	}
+
+	// document not found
+	req := &documentpb.AddNFTRequest{Identifier: hexutil.Encode(id), RegistryAddress: registry.Hex(), TokenId: hexutil.Encode(tokenID), GrantNftAccess: true}
+	srv.On("AddNFT", id, true, registry, tokenID).Return(nil, transactions.NilTxID(), documents.ErrDocumentNotFound).Once()
+	_, err := h.AddNFT(ctx, req)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	model := &mockSchemeModel{id: id}
+	txID := transactions.NewTxID()
+	srv.On("AddNFT", id, true, registry, tokenID).Return(model, txID, nil).Once()
+	resp, err := h.AddNFT(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.Header.DocumentId)
+	assert.Equal(t, txID.String(), resp.Header.TransactionId)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_SimulateAccess(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id, tokenID := utils.RandomSlice(32), utils.RandomSlice(32)
+	account := testingidentity.GenerateRandomDID()
+	registry := common.BytesToAddress(utils.RandomSlice(20))
+
+	// invalid parameters
+	for _, req := range []*documentpb.SimulateAccessRequest{
+		{Identifier: "invalid", Account: account.String()},
+		{Identifier: hexutil.Encode(id), Account: "invalid"},
+		{Identifier: hexutil.Encode(id), Account: account.String(), AccessTokenId: "invalid"},
+		{Identifier: hexutil.Encode(id), Account: account.String(), DelegatingDocumentIdentifier: "invalid"},
+		{Identifier: hexutil.Encode(id), Account: account.String(), NftRegistryAddress: "invalid", NftTokenId: hexutil.Encode(tokenID)},
+		{Identifier: hexutil.Encode(id), Account: account.String(), NftRegistryAddress: registry.Hex(), NftTokenId: "0x01"},
+	} {
+		_, err := h.SimulateAccess(ctx, req)
+		assert.Error(t, err)
+		code, _ := errors.GetHTTPDetails(err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	}
+
+	// document not found
+	sim := documents.AccessSimulation{Account: account, NFTRegistry: registry, NFTTokenID: tokenID}
+	req := &documentpb.SimulateAccessRequest{Identifier: hexutil.Encode(id), Account: account.String(), NftRegistryAddress: registry.Hex(), NftTokenId: hexutil.Encode(tokenID)}
+	srv.On("SimulateAccess", id, sim).Return(nil, documents.ErrDocumentNotFound).Once()
+	_, err := h.SimulateAccess(ctx, req)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	srv.On("SimulateAccess", id, sim).Return(&documents.AccessReport{
+		DocumentID: id,
+		VersionID:  id,
+		Checks: []documents.AccessCheck{
+			{Type: documents.AccessTypeAccount, Reason: "account is not in the read rules of the document"},
+			{Type: documents.AccessTypeNFTOwner, Granted: true},
+		},
+	}, nil).Once()
+	resp, err := h.SimulateAccess(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.DocumentId)
+	assert.True(t, resp.Granted)
+	assert.Len(t, resp.Checks, 2)
+	assert.Equal(t, "account", resp.Checks[0].AccessType)
+	assert.False(t, resp.Checks[0].Granted)
+	assert.Equal(t, "account is not in the read rules of the document", resp.Checks[0].Reason)
+	assert.Equal(t, "nft_owner", resp.Checks[1].AccessType)
+	assert.True(t, resp.Checks[1].Granted)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_AddTransferDetail(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id, transferID := utils.RandomSlice(32), utils.RandomSlice(32)
+	data := &documentpb.TransferDetail{PaymentReference: "SEPA-0001", Amount: "100.25", Currency: "EUR", Status: "opened"}
+
+	// invalid requests
+	for _, req := range []*documentpb.AddTransferDetailRequest{
+		{Identifier: "invalid", Data: data},
+		{Identifier: hexutil.Encode(id)},
+		{Identifier: hexutil.Encode(id), Data: &documentpb.TransferDetail{Amount: "invalid"}},
+	} {
+		_, err := h.AddTransferDetail(ctx, req)
+		assert.Error(t, err)
+		code, _ := errors.GetHTTPDetails(err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	}
+
+	// invalid detail
+	req := &documentpb.AddTransferDetailRequest{Identifier: hexutil.Encode(id), Data: data}
+	detail, err := documents.ConvertTransferDetailFromClientFormat(data)
+	assert.NoError(t, err)
+	srv.On("AddTransferDetail", id, detail).Return(nil, transactions.NilTxID(), errors.NewTypedError(documents.ErrDocumentInvalid, errors.New("invalid"))).Once()
+	_, err = h.AddTransferDetail(ctx, req)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// success
+	model := &mockSchemeModel{id: id}
+	recorded := &coredocumentextpb.TransferDetail{TransferId: transferID, PaymentReference: detail.PaymentReference, Amount: detail.Amount, Currency: detail.Currency, Status: detail.Status}
+	fields := []string{"cd_tree.transfer_details[0].transfer_id"}
+	model.On("TransferDetail", transferID).Return(recorded, nil).Once()
+	model.On("TransferDetailProofFields", transferID).Return(fields, nil).Once()
+	txID := transactions.NewTxID()
+	srv.On("AddTransferDetail", id, detail).Return(model, txID, nil).Run(func(args mock.Arguments) {
+		args.Get(1).(*coredocumentextpb.TransferDetail).TransferId = transferID
+	}).Once()
+	resp, err := h.AddTransferDetail(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, txID.String(), resp.Header.TransactionId)
+	assert.Equal(t, hexutil.Encode(transferID), resp.Detail.Data.TransferId)
+	assert.Equal(t, "100.25", resp.Detail.Data.Amount)
+	assert.Equal(t, fields, resp.Detail.ProofFields)
+	srv.AssertExpectations(t)
+	model.AssertExpectations(t)
+}
+
+func TestGrpcHandler_UpdateTransferDetail(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id, transferID := utils.RandomSlice(32), utils.RandomSlice(32)
+	settlement, err := utils.ToTimestamp(time.Now().UTC())
+	assert.NoError(t, err)
+	data := &documentpb.TransferDetail{PaymentReference: "SEPA-0001", Amount: "100.25", Currency: "EUR", Status: "settled", SettlementDate: settlement}
+
+	// invalid transfer ID
+	_, err = h.UpdateTransferDetail(ctx, &documentpb.UpdateTransferDetailRequest{Identifier: hexutil.Encode(id), TransferId: "invalid", Data: data})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// unknown transfer
+	req := &documentpb.UpdateTransferDetailRequest{Identifier: hexutil.Encode(id), TransferId: hexutil.Encode(transferID), Data: data}
+	detail, err := documents.ConvertTransferDetailFromClientFormat(data)
+	assert.NoError(t, err)
+	detail.TransferId = transferID
+	srv.On("UpdateTransferDetail", id, detail).Return(nil, transactions.NilTxID(), errors.NewTypedError(documents.ErrTransferDetailNotFound, errors.New("not found"))).Once()
+	_, err = h.UpdateTransferDetail(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	model := &mockSchemeModel{id: id}
+	model.On("TransferDetail", transferID).Return(detail, nil).Once()
+	model.On("TransferDetailProofFields", transferID).Return([]string{"cd_tree.transfer_details[0].status"}, nil).Once()
+	txID := transactions.NewTxID()
+	srv.On("UpdateTransferDetail", id, detail).Return(model, txID, nil).Once()
+	resp, err := h.UpdateTransferDetail(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, txID.String(), resp.Header.TransactionId)
+	assert.Equal(t, "settled", resp.Detail.Data.Status)
+	assert.Equal(t, settlement, resp.Detail.Data.SettlementDate)
+	srv.AssertExpectations(t)
+	model.AssertExpectations(t)
+}
+
+func TestGrpcHandler_ListTransferDetails(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id := utils.RandomSlice(32)
+
+	// document not found
+	req := &documentpb.ListTransferDetailsRequest{Identifier: hexutil.Encode(id)}
+	srv.On("GetCurrentVersion", id).Return(new(mockSchemeModel), documents.ErrDocumentNotFound).Once()
+	_, err := h.ListTransferDetails(ctx, req)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	model := &mockSchemeModel{id: id}
+	var details []*coredocumentextpb.TransferDetail
+	for _, amount := range []string{"10", "20.5"} {
+		detail, err := documents.ConvertTransferDetailFromClientFormat(&documentpb.TransferDetail{Amount: amount, Currency: "EUR", Status: "opened"})
+		assert.NoError(t, err)
+		detail.TransferId = utils.RandomSlice(32)
+		model.On("TransferDetailProofFields", detail.TransferId).Return([]string{"cd_tree.transfer_details"}, nil).Once()
+		details = append(details, detail)
+	}
+	model.On("TransferDetails").Return(details).Once()
+	srv.On("GetCurrentVersion", id).Return(model, nil).Once()
+	resp, err := h.ListTransferDetails(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.Header.DocumentId)
+	assert.Len(t, resp.Details, 2)
+	assert.Equal(t, hexutil.Encode(details[1].TransferId), resp.Details[1].Data.TransferId)
+	assert.Equal(t, "20.5", resp.Details[1].Data.Amount)
+	srv.AssertExpectations(t)
+	model.AssertExpectations(t)
+}
+
+func TestGrpcHandler_AddAttachment(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id, blob := utils.RandomSlice(32), utils.RandomSlice(64)
+
+	// invalid requests
+	for _, req := range []*documentpb.AddAttachmentRequest{
+		{Identifier: "invalid", Attachment: &documentpb.Attachment{Name: "invoice.pdf"}},
+		{Identifier: hexutil.Encode(id)},
+		{Identifier: hexutil.Encode(id), Attachment: &documentpb.Attachment{Name: "invoice.pdf", Checksum: "invalid"}},
+	} {
+		_, err := h.AddAttachment(ctx, req)
+		assert.Error(t, err)
+		code, _ := errors.GetHTTPDetails(err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	}
+
+	// failed to pin
+	req := &documentpb.AddAttachmentRequest{Identifier: hexutil.Encode(id), Attachment: &documentpb.Attachment{Name: "invoice.pdf"}, Data: blob}
+	attachment := &coredocumentextpb.Attachment{Name: "invoice.pdf"}
+	srv.On("AddAttachment", id, attachment, blob).Return(nil, transactions.NilTxID(), errors.NewTypedError(documents.ErrAttachmentPinning, errors.New("connection refused"))).Once()
+	_, err := h.AddAttachment(ctx, req)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadGateway, code)
+
+	// success
+	model := &mockSchemeModel{id: id}
+	pinned := &coredocumentextpb.Attachment{Name: "invoice.pdf", Checksum: utils.RandomSlice(32), Cid: "bafkreid", Size: uint64(len(blob))}
+	fields := []string{"cd_tree.attachments[0].cid"}
+	model.On("Attachment", "invoice.pdf").Return(pinned, nil).Once()
+	model.On("AttachmentProofFields", "invoice.pdf").Return(fields, nil).Once()
+	txID := transactions.NewTxID()
+	srv.On("AddAttachment", id, attachment, blob).Return(model, txID, nil).Once()
+	resp, err := h.AddAttachment(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, txID.String(), resp.Header.TransactionId)
+	assert.Equal(t, hexutil.Encode(pinned.Checksum), resp.Attachment.Data.Checksum)
+	assert.Equal(t, pinned.Cid, resp.Attachment.Data.Cid)
+	assert.Equal(t, pinned.Size, resp.Attachment.Data.Size)
+	assert.Equal(t, fields, resp.Attachment.ProofFields)
+	srv.AssertExpectations(t)
+	model.AssertExpectations(t)
+}
+
+func TestGrpcHandler_ListAttachments(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id := utils.RandomSlice(32)
+
+	// document not found
+	req := &documentpb.ListAttachmentsRequest{Identifier: hexutil.Encode(id)}
+	srv.On("GetCurrentVersion", id).Return(new(mockSchemeModel), documents.ErrDocumentNotFound).Once()
+	_, err := h.ListAttachments(ctx, req)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	model := &mockSchemeModel{id: id}
+	var attachments []*coredocumentextpb.Attachment
+	for _, name := range []string{"invoice.pdf", "delivery-note.pdf"} {
+		attachment := &coredocumentextpb.Attachment{Name: name, Checksum: utils.RandomSlice(32), Cid: "bafkreid", Size: 64}
+		model.On("Attachment", name).Return(attachment, nil).Once()
+		model.On("AttachmentProofFields", name).Return([]string{"cd_tree.attachments"}, nil).Once()
+		attachments = append(attachments, attachment)
+	}
+	model.On("Attachments").Return(attachments).Once()
+	srv.On("GetCurrentVersion", id).Return(model, nil).Once()
+	resp, err := h.ListAttachments(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.Header.DocumentId)
+	assert.Len(t, resp.Attachments, 2)
+	assert.Equal(t, "delivery-note.pdf", resp.Attachments[1].Data.Name)
+	srv.AssertExpectations(t)
+	model.AssertExpectations(t)
+}
+
+func TestGrpcHandler_AddTags(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id := utils.RandomSlice(32)
+
+	// invalid requests
+	for _, req := range []*documentpb.UpdateTagsRequest{
+		{Identifier: "invalid", Tags: []string{"CC-100"}},
+		{Identifier: hexutil.Encode(id)},
+	} {
+		_, err := h.AddTags(ctx, req)
+		assert.Error(t, err)
+		code, _ := errors.GetHTTPDetails(err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	}
+
+	// transition not allowed
+	tags := []string{"CC-100"}
+	req := &documentpb.UpdateTagsRequest{Identifier: hexutil.Encode(id), Tags: tags}
+	srv.On("UpdateTags", id, tags, []string(nil)).Return(nil, transactions.NilTxID(), errors.NewTypedError(documents.ErrDocumentTransition, errors.New("invalid transition"))).Once()
+	_, err := h.AddTags(ctx, req)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusForbidden, code)
+
+	// success
+	model := &mockSchemeModel{id: id}
+	model.On("Tags").Return([]string{"PRJ-7", "CC-100"}).Once()
+	model.On("TagProofFields").Return([]string{"cd_tree.tags[0]", "cd_tree.tags[1]"}).Once()
+	txID := transactions.NewTxID()
+	srv.On("UpdateTags", id, tags, []string(nil)).Return(model, txID, nil).Once()
+	resp, err := h.AddTags(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, txID.String(), resp.Header.TransactionId)
+	assert.Equal(t, []string{"PRJ-7", "CC-100"}, resp.Tags)
+	assert.Equal(t, []string{"cd_tree.tags[0]", "cd_tree.tags[1]"}, resp.ProofFields)
+	srv.AssertExpectations(t)
+	model.AssertExpectations(t)
+}
+
+func TestGrpcHandler_RemoveTags(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id := utils.RandomSlice(32)
+
+	// tag not on the document
+	tags := []string{"CC-100"}
+	req := &documentpb.UpdateTagsRequest{Identifier: hexutil.Encode(id), Tags: tags}
+	srv.On("UpdateTags", id, []string(nil), tags).Return(nil, transactions.NilTxID(), errors.NewTypedError(documents.ErrDocumentInvalid, errors.New("tag is not on the document"))).Once()
+	_, err := h.RemoveTags(ctx, req)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// success
+	model := &mockSchemeModel{id: id}
+	model.On("Tags").Return([]string(nil)).Once()
+	model.On("TagProofFields").Return([]string{}).Once()
+	txID := transactions.NewTxID()
+	srv.On("UpdateTags", id, []string(nil), tags).Return(model, txID, nil).Once()
+	resp, err := h.RemoveTags(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, txID.String(), resp.Header.TransactionId)
+	assert.Empty(t, resp.Tags)
+	srv.AssertExpectations(t)
+	model.AssertExpectations(t)
+}
+
+func TestGrpcHandler_SaveTemplate(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	req := &documentpb.DocumentTemplate{
+		Name:   "monthly-invoice",
+		Scheme: "generic",
+		Attributes: []*documentpb.TemplateAttribute{
+			{Name: "number", Type: "string", Required: true},
+			{Name: "comment", Type: "string", DefaultValue: &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: "monthly invoice"}}},
+		},
+		Collaborators: []string{"0x010203040506"},
+	}
+	tpl := &documents.Template{
+		Name:   "monthly-invoice",
+		Scheme: "generic",
+		Attributes: []documents.TemplateAttribute{
+			{Name: "number", Type: documents.TemplateAttributeString, Required: true},
+			{Name: "comment", Type: documents.TemplateAttributeString, Default: []byte(`"monthly invoice"`)},
+		},
+		Collaborators: documents.CollaboratorsAccess{ReadWriteCollaborators: []string{"0x010203040506"}},
+	}
+
+	// invalid field rule collaborators
+	_, err := h.SaveTemplate(ctx, &documentpb.DocumentTemplate{Name: "monthly-invoice", FieldRules: []*documentpb.FieldRule{{Collaborators: []string{"invalid"}}}})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// invalid template
+	srv.On("SaveTemplate", tpl).Return(errors.NewTypedError(documents.ErrTemplateInvalid, errors.New("unknown scheme"))).Once()
+	_, err = h.SaveTemplate(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// success
+	srv.On("SaveTemplate", tpl).Return(nil).Once()
+	resp, err := h.SaveTemplate(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, req.Name, resp.Name)
+	assert.Equal(t, "monthly invoice", resp.Attributes[1].DefaultValue.GetStringValue())
+	assert.Nil(t, resp.Attributes[0].DefaultValue)
+	assert.Equal(t, req.Collaborators, resp.Collaborators)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_GetTemplate(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	req := &documentpb.GetTemplateRequest{Name: "monthly-invoice"}
+
+	// not found
+	srv.On("GetTemplate", req.Name).Return(nil, errors.NewTypedError(documents.ErrTemplateNotFound, errors.New("template %s", req.Name))).Once()
+	_, err := h.GetTemplate(ctx, req)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	did := testingidentity.GenerateRandomDID()
+	tpl := &documents.Template{
+		Name:       req.Name,
+		Scheme:     "generic",
+		FieldRules: []documents.FieldRule{{Collaborators: []identity.DID{did}, Fields: []string{"generic.comment"}}},
+	}
+	srv.On("GetTemplate", req.Name).Return(tpl, nil).Once()
+	resp, err := h.GetTemplate(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "generic", resp.Scheme)
+	assert.Equal(t, []*documentpb.FieldRule{{Collaborators: []string{did.String()}, Fields: []string{"generic.comment"}}}, resp.FieldRules)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_ListTemplates(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	srv.On("ListTemplates").Return([]*documents.Template{{Name: "monthly-invoice"}, {Name: "purchase"}}, nil).Once()
+	resp, err := h.ListTemplates(ctx, new(empty.Empty))
+	assert.NoError(t, err)
+	assert.Len(t, resp.Templates, 2)
+	assert.Equal(t, "purchase", resp.Templates[1].Name)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_DeleteTemplate(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	req := &documentpb.GetTemplateRequest{Name: "monthly-invoice"}
+
+	// not found
+	srv.On("GetTemplate", req.Name).Return(nil, errors.NewTypedError(documents.ErrTemplateNotFound, errors.New("template %s", req.Name))).Once()
+	_, err := h.DeleteTemplate(ctx, req)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	srv.On("GetTemplate", req.Name).Return(&documents.Template{Name: req.Name}, nil).Once()
+	srv.On("DeleteTemplate", req.Name).Return(nil).Once()
+	resp, err := h.DeleteTemplate(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, req.Name, resp.Name)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_CreateFromTemplate(t *testing.T) {
+	registry := documents.NewServiceRegistry()
+	ssrv := new(mockSchemeService)
+	assert.NoError(t, registry.Register("mock document", ssrv))
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, registry, nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	data := new(structpb.Struct)
+	assert.NoError(t, jsonpb.UnmarshalString(`{"comment":"hello"}`, data))
+	req := &documentpb.CreateFromTemplateRequest{Name: "monthly-invoice", Collaborators: []string{"0x010203040506"}, Data: data}
+	ca := documents.CollaboratorsAccess{ReadWriteCollaborators: []string{"0x010203040506"}}
+
+	// template not found
+	srv.On("CreateFromTemplate", req.Name, ca, []byte(`{"comment":"hello"}`)).Return(nil, transactions.NilTxID(), errors.NewTypedError(documents.ErrTemplateNotFound, errors.New("template %s", req.Name))).Once()
+	_, err := h.CreateFromTemplate(ctx, req)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// data doesn't match the template
+	srv.On("CreateFromTemplate", req.Name, ca, []byte(`{"comment":"hello"}`)).Return(nil, transactions.NilTxID(), errors.NewTypedError(documents.ErrDocumentInvalid, errors.New("required attribute number is missing"))).Once()
+	_, err = h.CreateFromTemplate(ctx, req)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// success
+	model := &mockSchemeModel{id: utils.RandomSlice(32)}
+	model.On("DocumentType").Return("mock document")
+	txID := transactions.NewTxID()
+	srv.On("CreateFromTemplate", req.Name, ca, []byte(`{"comment":"hello"}`)).Return(model, txID, nil).Once()
+	ssrv.On("DeriveClientData", model).Return([]byte(`{"comment":"hello","number":"INV-1"}`), nil).Once()
+	resp, err := h.CreateFromTemplate(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, txID.String(), resp.Header.TransactionId)
+	assert.Equal(t, "INV-1", resp.Data.Fields["number"].GetStringValue())
+	srv.AssertExpectations(t)
+	ssrv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_VerifyProof(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	root, anchorID := utils.RandomSlice(32), utils.RandomSlice(32)
+	bundle := &documentpb.DocumentProof{
+		Header:       &documentpb.ResponseHeader{VersionId: hexutil.Encode(utils.RandomSlice(32))},
+		DocumentRoot: hexutil.Encode(utils.RandomSlice(32)),
+		FieldProofs:  []*documentpb.Proof{{Property: "0x0100000000000001", Value: "0x01", Salt: hexutil.Encode(utils.RandomSlice(32))}},
+	}
+
+	// invalid requests
+	for _, req := range []*documentpb.VerifyProofRequest{
+		{},
+		{Proof: &documentpb.DocumentProof{DocumentRoot: "root"}},
+		{Proof: bundle, DocumentRoot: "0x01"},
+		{Proof: bundle, AnchorId: "invalid"},
+	} {
+		_, err := h.VerifyProof(ctx, req)
+		assert.Error(t, err)
+		code, _ := errors.GetHTTPDetails(err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	}
+
+	// not anchored
+	overridden := mock.MatchedBy(func(proof *documents.DocumentProof) bool {
+		return utils.IsSameByteSlice(root, proof.DocumentRoot) && utils.IsSameByteSlice(anchorID, proof.VersionID)
+	})
+	req := &documentpb.VerifyProofRequest{Proof: bundle, DocumentRoot: hexutil.Encode(root), AnchorId: hexutil.Encode(anchorID)}
+	srv.On("VerifyProof", overridden).Return(nil, documents.ErrDocumentAnchorNotMined).Once()
+	_, err := h.VerifyProof(ctx, req)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// verified
+	srv.On("VerifyProof", overridden).Return(&documents.ProofVerification{
+		AnchorID:     anchorID,
+		DocumentRoot: root,
+		Anchored:     true,
+		Fields:       []documents.FieldProofResult{{Property: []byte{1, 0, 0, 0, 0, 0, 0, 1}, Valid: true}},
+	}, nil).Once()
+	resp, err := h.VerifyProof(ctx, req)
+	assert.NoError(t, err)
+	assert.True(t, resp.Valid)
+	assert.Equal(t, hexutil.Encode(anchorID), resp.AnchorId)
+	assert.Equal(t, "0x0100000000000001", resp.FieldProofs[0].Property)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_RequestDocumentProof(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	granter := testingidentity.GenerateRandomDID()
+	id, delegatingID, tokenID := utils.RandomSlice(32), utils.RandomSlice(32), utils.RandomSlice(32)
+	fields := []string{"invoice.gross_amount_decimal", "invoice.currency"}
+	valid := documentpb.RequestDocumentProofRequest{
+		Identifier:                   hexutil.Encode(id),
+		GranterId:                    granter.String(),
+		DelegatingDocumentIdentifier: hexutil.Encode(delegatingID),
+		AccessTokenId:                hexutil.Encode(tokenID),
+		Fields:                       fields,
+	}
+
+	// invalid requests
+	for _, update := range []func(req *documentpb.RequestDocumentProofRequest){
+		func(req *documentpb.RequestDocumentProofRequest) { req.Identifier = "invalid" },
+		func(req *documentpb.RequestDocumentProofRequest) { req.GranterId = "invalid" },
+		func(req *documentpb.RequestDocumentProofRequest) { req.DelegatingDocumentIdentifier = "" },
+		func(req *documentpb.RequestDocumentProofRequest) { req.AccessTokenId = "token" },
+		func(req *documentpb.RequestDocumentProofRequest) { req.Fields = nil },
+	} {
+		req := valid
+		update(&req)
+		_, err := h.RequestDocumentProof(ctx, &req)
+		assert.Error(t, err)
+		code, _ := errors.GetHTTPDetails(err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	}
+
+	preq := documents.ProofRequest{Granter: granter, DocumentID: id, DelegatingDocumentID: delegatingID, AccessTokenID: tokenID, Fields: fields}
+
+	// not anchored
+	srv.On("RequestProofs", preq).Return(nil, errors.NewTypedError(documents.ErrDocumentAnchorNotMined, errors.New("anchor"))).Once()
+	_, err := h.RequestDocumentProof(ctx, &valid)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// fields not granted
+	srv.On("RequestProofs", preq).Return(nil, errors.NewTypedError(documents.ErrDocumentProof, documents.ErrAccessTokenFieldsNotGranted)).Once()
+	_, err = h.RequestDocumentProof(ctx, &valid)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), documents.ErrAccessTokenFieldsNotGranted.Error())
+
+	// verified proofs
+	srv.On("RequestProofs", preq).Return(&documents.DocumentProof{
+		DocumentID:   id,
+		VersionID:    utils.RandomSlice(32),
+		DocumentRoot: utils.RandomSlice(32),
+		FieldProofs:  []*proofspb.Proof{{Property: proofs.CompactName(1, 0, 0, 0, 0, 0, 0, 1), Value: []byte{1}, Salt: utils.RandomSlice(32)}},
+	}, nil).Once()
+	resp, err := h.RequestDocumentProof(ctx, &valid)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.Header.DocumentId)
+	assert.Len(t, resp.FieldProofs, 1)
+	assert.Equal(t, "0x0100000000000001", resp.FieldProofs[0].Property)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_CreateConsistencyProof(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	leftID, rightID, rightVersion := utils.RandomSlice(32), utils.RandomSlice(32), utils.RandomSlice(32)
+	leftRef := &documentpb.FieldReference{Identifier: hexutil.Encode(leftID), Field: "invoice.gross_amount_decimal"}
+	rightRef := &documentpb.FieldReference{Identifier: hexutil.Encode(rightID), Version: hexutil.Encode(rightVersion), Field: "funding_agreement.amount"}
+
+	// invalid requests
+	for _, req := range []*documentpb.CreateConsistencyProofRequest{
+		{},
+		{Left: leftRef},
+		{Left: &documentpb.FieldReference{Identifier: "invalid", Field: "invoice.gross_amount_decimal"}, Right: rightRef},
+		{Left: leftRef, Right: &documentpb.FieldReference{Identifier: hexutil.Encode(rightID), Version: "invalid"}},
+	} {
+		_, err := h.CreateConsistencyProof(ctx, req)
+		assert.Error(t, err)
+		code, _ := errors.GetHTTPDetails(err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	}
+
+	left := documents.FieldReference{DocumentID: leftID, Field: leftRef.Field}
+	right := documents.FieldReference{DocumentID: rightID, VersionID: rightVersion, Field: rightRef.Field}
+	req := &documentpb.CreateConsistencyProofRequest{Left: leftRef, Right: rightRef}
+
+	// values are not equal
+	srv.On("CreateConsistencyProof", left, right).Return(nil, errors.NewTypedError(documents.ErrInconsistentFields, errors.New("different values"))).Once()
+	_, err := h.CreateConsistencyProof(ctx, req)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// unknown document
+	srv.On("CreateConsistencyProof", left, right).Return(nil, documents.ErrDocumentNotFound).Once()
+	_, err = h.CreateConsistencyProof(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// success
+	proof := &documents.ConsistencyProof{
+		Left:      &documents.DocumentProof{DocumentID: leftID, VersionID: utils.RandomSlice(32), DocumentRoot: utils.RandomSlice(32)},
+		Right:     &documents.DocumentProof{DocumentID: rightID, VersionID: rightVersion, DocumentRoot: utils.RandomSlice(32)},
+		Signature: &coredocumentpb.Signature{SignerId: utils.RandomSlice(20), PublicKey: utils.RandomSlice(32), Signature: utils.RandomSlice(64)},
+	}
+	msg, err := documents.ConsistencyProofMessage(proof)
+	assert.NoError(t, err)
+	srv.On("CreateConsistencyProof", left, right).Return(proof, nil).Once()
+	resp, err := h.CreateConsistencyProof(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(leftID), resp.Left.Header.DocumentId)
+	assert.Equal(t, hexutil.Encode(rightVersion), resp.Right.Header.VersionId)
+	assert.Equal(t, hexutil.Encode(msg), resp.Message)
+	assert.Equal(t, hexutil.Encode(proof.Signature.Signature), resp.Signature.Signature)
+	srv.AssertExpectations(t)
+}
+
+// validateDocumentStream collects the results sent on the stream.
+type validateDocumentStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	results []*documentpb.ValidationResult
+}
+
+func (s *validateDocumentStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *validateDocumentStream) Send(res *documentpb.ValidationResult) error {
+	s.results = append(s.results, res)
+	return nil
+}
+
+func TestGrpcHandler_ValidateDocument(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	stream := &validateDocumentStream{ctx: testingconfig.HandlerContext(documents.ConfigService)}
+	id, version := utils.RandomSlice(32), utils.RandomSlice(32)
+
+	// invalid identifier
+	err := h.ValidateDocument(&documentpb.ValidateDocumentRequest{Identifier: "invalid", Version: hexutil.Encode(version)}, stream)
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// invalid version
+	err = h.ValidateDocument(&documentpb.ValidateDocumentRequest{Identifier: hexutil.Encode(id), Version: "invalid"}, stream)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// version not found
+	req := &documentpb.ValidateDocumentRequest{Identifier: hexutil.Encode(id), Version: hexutil.Encode(version)}
+	srv.On("ValidateVersion", id, version).Return(nil, errors.NewTypedError(documents.ErrDocumentVersionNotFound, errors.New("missing"))).Once()
+	err = h.ValidateDocument(req, stream)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Empty(t, stream.results)
+
+	// results are sent as the groups complete
+	srv.On("ValidateVersion", id, version).Return([]documents.ValidationResult{
+		{Group: documents.ValidationGroupFields, Valid: true, Duration: 3 * time.Millisecond},
+		{Group: documents.ValidationGroupSignatures, Errors: []string{"atleast one signature expected"}},
+		{Group: documents.ValidationGroupAnchors, Valid: true},
+		{Group: documents.ValidationGroupTransitions, Valid: true, Skipped: true},
+	}, nil).Once()
+	err = h.ValidateDocument(req, stream)
+	assert.NoError(t, err)
+	assert.Len(t, stream.results, 4)
+	assert.Equal(t, &documentpb.ValidationResult{Group: "fields", Valid: true, DurationMs: 3}, stream.results[0])
+	assert.Equal(t, "signatures", stream.results[1].Group)
+	assert.False(t, stream.results[1].Valid)
+	assert.Equal(t, []string{"atleast one signature expected"}, stream.results[1].Errors)
+	assert.True(t, stream.results[3].Skipped)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_GetDocumentSummary(t *testing.T) {
+	srv := new(testingdocuments.MockService)
+	h := documents.GRPCHandler(documents.ConfigService, documents.NewServiceRegistry(), nil, nil, srv, nil)
+	ctx := testingconfig.HandlerContext(documents.ConfigService)
+	id, version := utils.RandomSlice(32), utils.RandomSlice(32)
+
+	// invalid identifier
+	_, err := h.GetDocumentSummary(ctx, &documentpb.GetDocumentSummaryRequest{Identifier: "invalid", Version: hexutil.Encode(version)})
+	assert.Error(t, err)
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// invalid version
+	_, err = h.GetDocumentSummary(ctx, &documentpb.GetDocumentSummaryRequest{Identifier: hexutil.Encode(id), Version: "invalid"})
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// unknown format
+	req := &documentpb.GetDocumentSummaryRequest{Identifier: hexutil.Encode(id), Version: hexutil.Encode(version), Format: "pdf"}
+	_, err = h.GetDocumentSummary(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// version not found
+	req.Format = ""
+	srv.On("GetVersionSummary", id, version).Return(nil, errors.NewTypedError(documents.ErrDocumentVersionNotFound, errors.New("missing"))).Once()
+	_, err = h.GetDocumentSummary(ctx, req)
+	assert.Error(t, err)
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	summary := &documents.Summary{
+		DocumentID:    hexutil.Encode(id),
+		VersionID:     hexutil.Encode(version),
+		DocumentType:  "invoice",
+		Status:        "unpaid",
+		Fields:        []documents.SummaryField{{Name: "Currency", Value: "EUR"}},
+		Collaborators: []string{"0x010203"},
+		AnchorID:      "0x0a0b0c",
+		AnchorLink:    "https://explorer/0x0a0b0c",
+	}
+
+	// json is the default format
+	srv.On("GetVersionSummary", id, version).Return(summary, nil)
+	resp, err := h.GetDocumentSummary(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, summary.DocumentID, resp.DocumentId)
+	assert.Equal(t, "unpaid", resp.Status)
+	assert.Equal(t, []*documentpb.SummaryField{{Name: "Currency", Value: "EUR"}}, resp.Fields)
+	assert.Equal(t, summary.Collaborators, resp.Collaborators)
+	assert.Equal(t, summary.AnchorLink, resp.AnchorLink)
+	assert.Empty(t, resp.Html)
+
+	// html format
+	req.Format = "html"
+	resp, err = h.GetDocumentSummary(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, summary.AnchorID, resp.AnchorId)
+	assert.Contains(t, resp.Html, "<dt>Currency</dt><dd>EUR</dd>")
+	assert.Contains(t, resp.Html, `<a href="https://explorer/0x0a0b0c">0x0a0b0c</a>`)
+	srv.AssertExpectations(t)
+}
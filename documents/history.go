@@ -0,0 +1,356 @@
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/history"
+	"github.com/centrifuge/go-centrifuge/utils"
+)
+
+// lineagePrefix is the key prefix of the verified lineages of the documents
+const lineagePrefix = "doclineage_"
+
+// VersionRoot is the anchored document root of a document version.
+type VersionRoot struct {
+	Version         []byte `json:"version"`
+	PreviousVersion []byte `json:"previous_version,omitempty"`
+	DocumentRoot    []byte `json:"document_root"`
+}
+
+// Lineage is the verified chain of the versions of a document preceding the version the account joined the document with.
+// Versions are ordered from the latest to the oldest known version.
+type Lineage struct {
+	DocumentID []byte        `json:"document_id"`
+	Versions   []VersionRoot `json:"versions"`
+
+	// JoinedVersion is the version the account joined the document with, the collaborators share the prior versions
+	// for this version.
+	JoinedVersion []byte `json:"joined_version,omitempty"`
+}
+
+// JSON returns the json encoding of the lineage.
+func (l *Lineage) JSON() ([]byte, error) {
+	return json.Marshal(l)
+}
+
+// FromJSON loads the lineage from its json encoding.
+func (l *Lineage) FromJSON(data []byte) error {
+	return json.Unmarshal(data, l)
+}
+
+// Type returns the reflect type of the lineage.
+func (l *Lineage) Type() reflect.Type {
+	return reflect.TypeOf(l)
+}
+
+// version returns the root of the version, or nil if the version is not part of the lineage.
+func (l *Lineage) version(version []byte) *VersionRoot {
+	for i := range l.Versions {
+		if utils.IsSameByteSlice(l.Versions[i].Version, version) {
+			return &l.Versions[i]
+		}
+	}
+
+	return nil
+}
+
+// getLineageKey returns the key of the lineage of the document owned by accountID.
+func getLineageKey(accountID, documentID []byte) []byte {
+	prefix := append([]byte(lineagePrefix), accountID...)
+	return append(prefix, documentID...)
+}
+
+// VersionFetcher fetches the anchored versions of a document that are not stored locally.
+type VersionFetcher interface {
+	// FetchVersion returns the version of the document, verified against its anchored document root.
+	FetchVersion(ctx context.Context, documentID, version []byte) (Model, error)
+}
+
+// versionFetcher holds the fetcher registered with the document service.
+type versionFetcher struct {
+	mu      sync.RWMutex
+	fetcher VersionFetcher
+}
+
+// FetchVersion fetches the version with the registered fetcher.
+func (f *versionFetcher) FetchVersion(ctx context.Context, documentID, version []byte) (Model, error) {
+	if f == nil {
+		return nil, errors.New("version fetcher not initialised")
+	}
+
+	f.mu.RLock()
+	fetcher := f.fetcher
+	f.mu.RUnlock()
+	if fetcher == nil {
+		return nil, errors.New("version fetcher not registered")
+	}
+
+	return fetcher.FetchVersion(ctx, documentID, version)
+}
+
+// RegisterVersionFetcher registers the fetcher of the versions that are not stored locally.
+func (s service) RegisterVersionFetcher(fetcher VersionFetcher) error {
+	if s.fetcher == nil {
+		return errors.New("version fetcher not initialised")
+	}
+
+	if fetcher == nil {
+		return errors.New("nil version fetcher provided")
+	}
+
+	s.fetcher.mu.Lock()
+	defer s.fetcher.mu.Unlock()
+	s.fetcher.fetcher = fetcher
+	return nil
+}
+
+// historySync pulls the prior versions of the received documents the account joined late.
+type historySync struct {
+	repo       Repository
+	anchorRepo anchors.AnchorRepository
+	docSrv     Service
+	client     Client
+}
+
+// newHistorySync returns the history sync of the documents.
+func newHistorySync(repo Repository, anchorRepo anchors.AnchorRepository, docSrv Service, client Client) historySync {
+	return historySync{repo: repo, anchorRepo: anchorRepo, docSrv: docSrv, client: client}
+}
+
+// newHistorySyncHook returns the post-receive hook syncing the history of the received documents.
+func newHistorySyncHook(repo Repository, anchorRepo anchors.AnchorRepository, docSrv Service, client Client) Hook {
+	return newHistorySync(repo, anchorRepo, docSrv, client).sync
+}
+
+// sync requests the versions preceding the received model from its author, unless its previous version is known already.
+// The anchored roots of the prior versions are verified and stored as the lineage of the document. The prior versions
+// shared in full are stored as well, once their roots match the lineage.
+func (h historySync) sync(ctx context.Context, model Model) error {
+	prev := model.PreviousVersion()
+	if utils.IsEmptyByteSlice(prev) {
+		return nil
+	}
+
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return ErrDocumentConfigAccountID
+	}
+
+	if h.repo.Exists(did[:], prev) {
+		return nil
+	}
+
+	if l, err := h.repo.GetLineage(did[:], model.ID()); err == nil && l.version(prev) != nil {
+		return nil
+	}
+
+	res, err := h.client.GetDocumentHistory(ctx, model.Author(), model.ID(), model.CurrentVersion())
+	if err != nil {
+		return errors.NewTypedError(ErrDocumentHistory, err)
+	}
+
+	lineage, err := h.verifyLineage(model, res.Roots)
+	if err != nil {
+		return errors.NewTypedError(ErrDocumentHistory, err)
+	}
+
+	versions, err := h.verifyVersions(model, lineage, res)
+	if err != nil {
+		return errors.NewTypedError(ErrDocumentHistory, err)
+	}
+
+	err = h.repo.Atomic(func(repo Repository) error {
+		err := saveVersions(repo, did[:], versions)
+		if err != nil {
+			return err
+		}
+
+		return repo.SaveLineage(did[:], lineage)
+	})
+	if err != nil {
+		return errors.NewTypedError(ErrDocumentPersistence, err)
+	}
+
+	return nil
+}
+
+// FetchVersion fetches a version of the lineage of the document from the collaborators of the version the account
+// joined the document with, starting with its author. The data tree of the version is reconstructed from the received
+// core document and verified against the anchored root in the lineage. The verified versions are stored, so that
+// proofs can be created for them.
+func (h historySync) FetchVersion(ctx context.Context, documentID, version []byte) (Model, error) {
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, ErrDocumentConfigAccountID
+	}
+
+	lineage, err := h.repo.GetLineage(did[:], documentID)
+	if err != nil || lineage.version(version) == nil {
+		return nil, errors.NewTypedError(ErrDocumentVersionNotFound, errors.New("version %x is not part of the lineage of document %x", version, documentID))
+	}
+
+	joined, err := h.repo.Get(did[:], lineage.JoinedVersion)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentVersionNotFound, err)
+	}
+
+	cs, err := joined.GetCollaborators(did, joined.Author())
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentHistory, err)
+	}
+
+	err = errors.New("no collaborators to fetch version %x from", version)
+	for _, c := range append([]identity.DID{joined.Author()}, cs...) {
+		var m Model
+		m, err = h.fetchVersion(ctx, c, joined, lineage, version)
+		if err != nil {
+			continue
+		}
+
+		return m, nil
+	}
+
+	return nil, errors.NewTypedError(ErrDocumentHistory, err)
+}
+
+// fetchVersion requests the prior versions of the joined version from the collaborator, and stores the verified versions.
+func (h historySync) fetchVersion(ctx context.Context, collaborator identity.DID, joined Model, lineage *Lineage, version []byte) (Model, error) {
+	res, err := h.client.GetDocumentHistory(ctx, collaborator, joined.ID(), joined.CurrentVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := h.verifyVersions(joined, lineage, res)
+	if err != nil {
+		return nil, err
+	}
+
+	var model Model
+	for _, v := range versions {
+		if utils.IsSameByteSlice(v.CurrentVersion(), version) {
+			model = v
+		}
+	}
+
+	if model == nil {
+		return nil, errors.New("version %x not shared by %s", version, collaborator.String())
+	}
+
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, ErrDocumentConfigAccountID
+	}
+
+	err = h.repo.Atomic(func(repo Repository) error {
+		return saveVersions(repo, did[:], versions)
+	})
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentPersistence, err)
+	}
+
+	return model, nil
+}
+
+// saveVersions stores the verified prior versions that are not stored yet.
+func saveVersions(repo Repository, accountID []byte, versions []Model) error {
+	for _, v := range versions {
+		if repo.Exists(accountID, v.CurrentVersion()) {
+			continue
+		}
+
+		// prior versions are anchored already
+		v.SetStatus(StatusCommitted)
+		err := repo.Create(accountID, v.CurrentVersion(), v)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyLineage checks that the roots chain back from the previous version of the model and that every root is anchored.
+func (h historySync) verifyLineage(model Model, roots []*historypb.VersionRoot) (*Lineage, error) {
+	if len(roots) == 0 {
+		return nil, errors.New("no prior versions received for document %x", model.ID())
+	}
+
+	lineage := &Lineage{DocumentID: model.ID(), JoinedVersion: model.CurrentVersion()}
+	expected := model.PreviousVersion()
+	for _, r := range roots {
+		if r == nil || !utils.IsSameByteSlice(r.VersionIdentifier, expected) {
+			return nil, errors.New("expected version %x in the lineage of document %x", expected, model.ID())
+		}
+
+		anchorID, err := anchors.ToAnchorID(r.VersionIdentifier)
+		if err != nil {
+			return nil, errors.New("failed to get anchorID: %v", err)
+		}
+
+		err = checkAnchoredRoot(h.anchorRepo, anchorID, r.DocumentRoot)
+		if err != nil {
+			return nil, err
+		}
+
+		lineage.Versions = append(lineage.Versions, VersionRoot{
+			Version:         r.VersionIdentifier,
+			PreviousVersion: r.PreviousVersion,
+			DocumentRoot:    r.DocumentRoot,
+		})
+
+		expected = r.PreviousVersion
+		if utils.IsEmptyByteSlice(expected) {
+			break
+		}
+	}
+
+	return lineage, nil
+}
+
+// verifyVersions derives the prior versions shared in full and checks them against the lineage.
+func (h historySync) verifyVersions(model Model, lineage *Lineage, res *historypb.HistoryResponse) ([]Model, error) {
+	var versions []Model
+	for _, cd := range res.Documents {
+		if cd == nil {
+			continue
+		}
+
+		v, err := h.docSrv.DeriveFromCoreDocument(*cd)
+		if err != nil {
+			return nil, err
+		}
+
+		if !utils.IsSameByteSlice(v.ID(), model.ID()) {
+			return nil, errors.New("received version of document %x for document %x", v.ID(), model.ID())
+		}
+
+		root := lineage.version(v.CurrentVersion())
+		if root == nil {
+			return nil, errors.New("version %x is not part of the lineage of document %x", v.CurrentVersion(), model.ID())
+		}
+
+		dr, err := v.CalculateDocumentRoot()
+		if err != nil {
+			return nil, errors.New("failed to get document root: %v", err)
+		}
+
+		if !utils.IsSameByteSlice(dr, root.DocumentRoot) {
+			return nil, errors.NewTypedError(ErrDocumentAnchorRootMismatch, errors.New("version %x", v.CurrentVersion()))
+		}
+
+		if !utils.IsSameByteSlice(v.PreviousVersion(), root.PreviousVersion) {
+			return nil, errors.New("previous version of version %x doesn't match the lineage", v.CurrentVersion())
+		}
+
+		versions = append(versions, v)
+	}
+
+	return versions, nil
+}
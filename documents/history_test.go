@@ -0,0 +1,337 @@
+// +build unit
+
+package documents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/history"
+	"github.com/centrifuge/go-centrifuge/testingutils/anchors"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type historyDoc struct {
+	Model
+	DocID     []byte
+	Version   []byte
+	Previous  []byte
+	Next      []byte
+	Root      []byte
+	AuthorDID identity.DID
+	Status    Status
+	Time      time.Time
+
+	Collaborators []identity.DID
+}
+
+func (m *historyDoc) ID() []byte                                           { return m.DocID }
+func (m *historyDoc) CurrentVersion() []byte                               { return m.Version }
+func (m *historyDoc) PreviousVersion() []byte                              { return m.Previous }
+func (m *historyDoc) NextVersion() []byte                                  { return m.Next }
+func (m *historyDoc) Author() identity.DID                                 { return m.AuthorDID }
+func (m *historyDoc) CalculateDocumentRoot() ([]byte, error)               { return m.Root, nil }
+func (m *historyDoc) GetStatus() Status                                    { return m.Status }
+func (m *historyDoc) SetStatus(st Status)                                  { m.Status = st }
+func (m *historyDoc) LinkedDocuments() []*coredocumentextpb.LinkedDocument { return nil }
+func (m *historyDoc) NFTs() []*coredocumentpb.NFT                          { return nil }
+func (m *historyDoc) DocumentType() string                                 { return "history document" }
+func (m *historyDoc) Timestamp() (time.Time, error)                        { return m.Time, nil }
+
+func (m *historyDoc) GetCollaborators(filterIDs ...identity.DID) ([]identity.DID, error) {
+	return filterCollaborators(m.Collaborators, filterIDs...), nil
+}
+
+func (m *historyDoc) ChangedFields(updated Model) ([]ChangedField, error) {
+	n, ok := updated.(*historyDoc)
+	if !ok {
+		return nil, ErrDocumentInvalidType
+	}
+
+	if bytes.Equal(m.Root, n.Root) {
+		return nil, nil
+	}
+
+	return []ChangedField{{Name: "root", Old: m.Root, New: n.Root}}, nil
+}
+
+func (m *historyDoc) JSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *historyDoc) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+func (m *historyDoc) Type() reflect.Type {
+	return reflect.TypeOf(m)
+}
+
+func (m *historyDoc) versionRoot() *historypb.VersionRoot {
+	return &historypb.VersionRoot{VersionIdentifier: m.Version, PreviousVersion: m.Previous, DocumentRoot: m.Root}
+}
+
+// historyService derives the prior versions from their core documents by the current version.
+type historyService struct {
+	Service
+	versions map[string]Model
+}
+
+func (s historyService) DeriveFromCoreDocument(cd coredocumentpb.CoreDocument) (Model, error) {
+	m, ok := s.versions[string(cd.CurrentVersion)]
+	if !ok {
+		return nil, errors.New("unknown version")
+	}
+
+	return m, nil
+}
+
+// historyChain returns the versions of a document from the oldest to the latest version.
+func historyChain(n int) []*historyDoc {
+	id := utils.RandomSlice(32)
+	author := testingidentity.GenerateRandomDID()
+	var docs []*historyDoc
+	var prev []byte
+	version := id
+	for i := 0; i < n; i++ {
+		next := utils.RandomSlice(32)
+		docs = append(docs, &historyDoc{
+			DocID:     id,
+			Version:   version,
+			Previous:  prev,
+			Next:      next,
+			Root:      utils.RandomSlice(32),
+			AuthorDID: author,
+		})
+		prev, version = version, next
+	}
+
+	return docs
+}
+
+func anchorHistory(ar *testinganchors.MockAnchorRepo, docs ...*historyDoc) {
+	for _, d := range docs {
+		anchorID, _ := anchors.ToAnchorID(d.Version)
+		dr, _ := anchors.ToDocumentRoot(d.Root)
+		ar.On("GetAnchorData", anchorID).Return(dr, nil)
+	}
+}
+
+func TestHistorySync(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&historyDoc{})
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+
+	// first version of a document
+	client := new(p2pClient)
+	sync := newHistorySyncHook(repo, nil, nil, client)
+	docs := historyChain(3)
+	assert.NoError(t, sync(actx, docs[0]))
+
+	// previous version is known already
+	assert.NoError(t, repo.Create(did[:], docs[0].Version, docs[0]))
+	assert.NoError(t, sync(actx, docs[1]))
+	client.AssertExpectations(t)
+
+	// peer fails
+	docs = historyChain(3)
+	v1, v2, v3 := docs[0], docs[1], docs[2]
+	client.On("GetDocumentHistory", actx, v3.AuthorDID, v3.DocID, v3.Version).Return(nil, errors.New("peer unavailable")).Once()
+	err = sync(actx, v3)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentHistory, err))
+
+	// no prior versions
+	client.On("GetDocumentHistory", actx, v3.AuthorDID, v3.DocID, v3.Version).Return(&historypb.HistoryResponse{}, nil).Once()
+	err = sync(actx, v3)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentHistory, err))
+
+	// broken chain
+	client.On("GetDocumentHistory", actx, v3.AuthorDID, v3.DocID, v3.Version).Return(&historypb.HistoryResponse{
+		Roots: []*historypb.VersionRoot{v1.versionRoot()},
+	}, nil).Once()
+	err = sync(actx, v3)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentHistory, err))
+
+	// root not anchored
+	ar := new(testinganchors.MockAnchorRepo)
+	anchorHistory(ar, v1)
+	anchorID, err := anchors.ToAnchorID(v2.Version)
+	assert.NoError(t, err)
+	ar.On("GetAnchorData", anchorID).Return(anchors.RandomDocumentRoot(), nil).Once()
+	sync = newHistorySyncHook(repo, ar, nil, client)
+	res := &historypb.HistoryResponse{Roots: []*historypb.VersionRoot{v2.versionRoot(), v1.versionRoot()}}
+	client.On("GetDocumentHistory", actx, v3.AuthorDID, v3.DocID, v3.Version).Return(res, nil).Once()
+	err = sync(actx, v3)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentHistory, err))
+	assert.True(t, errors.IsOfType(ErrDocumentAnchorRootMismatch, err))
+
+	// only the roots are shared
+	anchorHistory(ar, v2)
+	client.On("GetDocumentHistory", actx, v3.AuthorDID, v3.DocID, v3.Version).Return(res, nil).Once()
+	assert.NoError(t, sync(actx, v3))
+	lineage, err := repo.GetLineage(did[:], v3.DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, []VersionRoot{
+		{Version: v2.Version, PreviousVersion: v2.Previous, DocumentRoot: v2.Root},
+		{Version: v1.Version, DocumentRoot: v1.Root},
+	}, lineage.Versions)
+	assert.False(t, repo.Exists(did[:], v2.Version))
+
+	// the lineage is synced already
+	assert.NoError(t, sync(actx, v3))
+	client.AssertExpectations(t)
+
+	// tampered version
+	docs = historyChain(2)
+	v1, v2 = docs[0], docs[1]
+	anchorHistory(ar, v1)
+	tampered := *v1
+	tampered.Root = utils.RandomSlice(32)
+	srv := historyService{versions: map[string]Model{string(v1.Version): &tampered}}
+	sync = newHistorySyncHook(repo, ar, srv, client)
+	res = &historypb.HistoryResponse{
+		Roots:     []*historypb.VersionRoot{v1.versionRoot()},
+		Documents: []*coredocumentpb.CoreDocument{{DocumentIdentifier: v1.DocID, CurrentVersion: v1.Version}},
+	}
+	client.On("GetDocumentHistory", actx, v2.AuthorDID, v2.DocID, v2.Version).Return(res, nil).Once()
+	err = sync(actx, v2)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentAnchorRootMismatch, err))
+	assert.False(t, repo.Exists(did[:], v1.Version))
+
+	// prior versions are shared in full
+	srv.versions[string(v1.Version)] = v1
+	client.On("GetDocumentHistory", actx, v2.AuthorDID, v2.DocID, v2.Version).Return(res, nil).Once()
+	assert.NoError(t, sync(actx, v2))
+	m, err := repo.Get(did[:], v1.Version)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.Root, m.(*historyDoc).Root)
+	assert.Equal(t, StatusCommitted, m.GetStatus())
+	client.AssertExpectations(t)
+	ar.AssertExpectations(t)
+}
+
+func TestHistorySync_FetchVersion(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&historyDoc{})
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+
+	docs := historyChain(3)
+	v1, v2, v3 := docs[0], docs[1], docs[2]
+	collaborator := testingidentity.GenerateRandomDID()
+	v3.Collaborators = []identity.DID{v3.AuthorDID, did, collaborator}
+	client := new(p2pClient)
+	srv := historyService{versions: map[string]Model{string(v1.Version): v1, string(v2.Version): v2}}
+	fetcher := newHistorySync(repo, nil, srv, client)
+
+	// no lineage
+	_, err = fetcher.FetchVersion(actx, v3.DocID, v1.Version)
+	assert.True(t, errors.IsOfType(ErrDocumentVersionNotFound, err))
+
+	// joined with v3, the prior versions are known by their roots
+	assert.NoError(t, repo.Create(did[:], v3.Version, v3))
+	assert.NoError(t, repo.SaveLineage(did[:], &Lineage{DocumentID: v3.DocID, JoinedVersion: v3.Version, Versions: []VersionRoot{
+		{Version: v2.Version, PreviousVersion: v2.Previous, DocumentRoot: v2.Root},
+		{Version: v1.Version, DocumentRoot: v1.Root},
+	}}))
+
+	// version not part of the lineage
+	_, err = fetcher.FetchVersion(actx, v3.DocID, utils.RandomSlice(32))
+	assert.True(t, errors.IsOfType(ErrDocumentVersionNotFound, err))
+
+	// the author is unavailable and the collaborator doesn't share v2
+	res := &historypb.HistoryResponse{
+		Roots:     []*historypb.VersionRoot{v2.versionRoot(), v1.versionRoot()},
+		Documents: []*coredocumentpb.CoreDocument{{DocumentIdentifier: v1.DocID, CurrentVersion: v1.Version}},
+	}
+	client.On("GetDocumentHistory", actx, v3.AuthorDID, v3.DocID, v3.Version).Return(nil, errors.New("peer unavailable")).Once()
+	client.On("GetDocumentHistory", actx, collaborator, v3.DocID, v3.Version).Return(res, nil).Once()
+	_, err = fetcher.FetchVersion(actx, v3.DocID, v2.Version)
+	assert.True(t, errors.IsOfType(ErrDocumentHistory, err))
+	assert.False(t, repo.Exists(did[:], v1.Version))
+
+	client.On("GetDocumentHistory", actx, v3.AuthorDID, v3.DocID, v3.Version).Return(res, nil).Once()
+	m, err := fetcher.FetchVersion(actx, v3.DocID, v1.Version)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.Version, m.CurrentVersion())
+	m, err = repo.Get(did[:], v1.Version)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.Root, m.(*historyDoc).Root)
+	assert.Equal(t, StatusCommitted, m.GetStatus())
+	client.AssertExpectations(t)
+}
+
+type versionFetcherFunc func(ctx context.Context, documentID, version []byte) (Model, error)
+
+func (f versionFetcherFunc) FetchVersion(ctx context.Context, documentID, version []byte) (Model, error) {
+	return f(ctx, documentID, version)
+}
+
+func TestService_RegisterVersionFetcher(t *testing.T) {
+	srv := DefaultService(getRepository(ctx), nil, nil, nil, 0, "", 0, nil, nil, nil, nil, nil)
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	id, version := utils.RandomSlice(32), utils.RandomSlice(32)
+
+	// no fetcher registered
+	_, err := srv.CreateProofsForVersion(actx, id, version, nil)
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+	assert.True(t, errors.IsOfType(ErrDocumentVersionNotFound, err))
+	assert.Contains(t, err.Error(), "version fetcher not registered")
+
+	assert.Error(t, srv.RegisterVersionFetcher(nil))
+	assert.Error(t, service{}.RegisterVersionFetcher(versionFetcherFunc(nil)))
+
+	// the fetched version is proven
+	assert.NoError(t, srv.RegisterVersionFetcher(versionFetcherFunc(func(ctx context.Context, documentID, v []byte) (Model, error) {
+		assert.Equal(t, id, documentID)
+		assert.Equal(t, version, v)
+		return nil, errors.New("version not shared")
+	})))
+	_, err = srv.CreateProofsForVersion(actx, id, version, nil)
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+	assert.Contains(t, err.Error(), "version not shared")
+}
+
+func TestRepo_Lineage(t *testing.T) {
+	repo := getRepository(ctx)
+	accountID, documentID := utils.RandomSlice(32), utils.RandomSlice(32)
+	_, err := repo.GetLineage(accountID, documentID)
+	assert.Error(t, err)
+
+	lineage := &Lineage{DocumentID: documentID, Versions: []VersionRoot{{Version: utils.RandomSlice(32), DocumentRoot: utils.RandomSlice(32)}}}
+	assert.NoError(t, repo.SaveLineage(accountID, lineage))
+	l, err := repo.GetLineage(accountID, documentID)
+	assert.NoError(t, err)
+	assert.Equal(t, lineage, l)
+
+	// batched lineages are saved on commit
+	lineage.Versions = append(lineage.Versions, VersionRoot{Version: utils.RandomSlice(32), DocumentRoot: utils.RandomSlice(32)})
+	assert.NoError(t, repo.Atomic(func(repo Repository) error {
+		return repo.SaveLineage(accountID, lineage)
+	}))
+	l, err = repo.GetLineage(accountID, documentID)
+	assert.NoError(t, err)
+	assert.Len(t, l.Versions, 2)
+	assert.Nil(t, l.version(utils.RandomSlice(32)))
+}
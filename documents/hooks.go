@@ -0,0 +1,92 @@
+package documents
+
+import (
+	"context"
+	"sync"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+)
+
+// HookEvent is a lifecycle event of a document hooks can be registered for.
+type HookEvent string
+
+const (
+	// HookPreCreate is triggered before a new document is validated and persisted.
+	// Hooks can enrich the model, e.g. assign an invoice number.
+	HookPreCreate HookEvent = "pre-create"
+
+	// HookPreAnchor is triggered before a document version is prepared for signatures and anchored.
+	// Changes to the model are part of the signed and anchored version.
+	HookPreAnchor HookEvent = "pre-anchor"
+
+	// HookPostReceive is triggered after an anchored document received from a collaborator is persisted.
+	// Errors of these hooks are logged and don't reject the received document.
+	HookPostReceive HookEvent = "post-receive"
+)
+
+// Hook is a callback for a document lifecycle event.
+// The context carries the account the event is triggered for.
+type Hook func(ctx context.Context, model Model) error
+
+// Hooks holds the hooks registered per lifecycle event.
+type Hooks struct {
+	hooks map[HookEvent][]Hook
+	mutex sync.RWMutex
+}
+
+// NewHooks returns an empty hooks registry.
+func NewHooks() *Hooks {
+	return &Hooks{hooks: make(map[HookEvent][]Hook)}
+}
+
+// Register adds the hook for the event. Hooks of an event run in the order of registration.
+func (h *Hooks) Register(event HookEvent, hook Hook) error {
+	switch event {
+	case HookPreCreate, HookPreAnchor, HookPostReceive:
+	default:
+		return errors.New("unknown hook event %q", event)
+	}
+
+	if hook == nil {
+		return errors.New("nil hook provided")
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.hooks[event] = append(h.hooks[event], hook)
+	return nil
+}
+
+// Run runs the hooks of the event for the model and stops at the first failing hook.
+func (h *Hooks) Run(ctx context.Context, event HookEvent, model Model) error {
+	if h == nil {
+		return nil
+	}
+
+	h.mutex.RLock()
+	hooks := h.hooks[event]
+	h.mutex.RUnlock()
+
+	for _, hook := range hooks {
+		err := hook(ctx, model)
+		if err != nil {
+			return errors.NewTypedError(ErrDocumentHook, errors.New("%s hook failed: %v", event, err))
+		}
+	}
+
+	return nil
+}
+
+// RegisterHook registers the hook for the lifecycle event of the documents.
+func (s service) RegisterHook(event HookEvent, hook Hook) error {
+	if s.hooks == nil {
+		return errors.New("hooks not initialised")
+	}
+
+	return s.hooks.Register(event, hook)
+}
+
+// RunHooks runs the hooks registered for the lifecycle event on the model.
+func (s service) RunHooks(ctx context.Context, event HookEvent, model Model) error {
+	return s.hooks.Run(ctx, event, model)
+}
@@ -0,0 +1,86 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHooks_Register(t *testing.T) {
+	h := NewHooks()
+	hook := func(ctx context.Context, model Model) error { return nil }
+
+	// unknown event
+	err := h.Register(HookEvent("post-anchor"), hook)
+	assert.Error(t, err)
+
+	// nil hook
+	err = h.Register(HookPreCreate, nil)
+	assert.Error(t, err)
+
+	// success
+	assert.NoError(t, h.Register(HookPreCreate, hook))
+	assert.NoError(t, h.Register(HookPreAnchor, hook))
+	assert.NoError(t, h.Register(HookPostReceive, hook))
+	assert.Len(t, h.hooks[HookPreCreate], 1)
+}
+
+func TestHooks_Run(t *testing.T) {
+	ctx := context.Background()
+	model := new(mockModel)
+
+	// nil hooks
+	var h *Hooks
+	assert.NoError(t, h.Run(ctx, HookPreCreate, model))
+
+	h = NewHooks()
+	var calls []string
+	assert.NoError(t, h.Register(HookPreCreate, func(ctx context.Context, m Model) error {
+		assert.Equal(t, model, m)
+		calls = append(calls, "first")
+		return nil
+	}))
+	assert.NoError(t, h.Register(HookPreCreate, func(ctx context.Context, m Model) error {
+		calls = append(calls, "second")
+		return nil
+	}))
+
+	// other events are not affected
+	assert.NoError(t, h.Run(ctx, HookPreAnchor, model))
+	assert.Empty(t, calls)
+
+	// hooks run in order
+	assert.NoError(t, h.Run(ctx, HookPreCreate, model))
+	assert.Equal(t, []string{"first", "second"}, calls)
+
+	// failing hook stops the run
+	calls = nil
+	assert.NoError(t, h.Register(HookPreAnchor, func(ctx context.Context, m Model) error {
+		return errors.New("invoice number exhausted")
+	}))
+	assert.NoError(t, h.Register(HookPreAnchor, func(ctx context.Context, m Model) error {
+		calls = append(calls, "anchor")
+		return nil
+	}))
+	err := h.Run(ctx, HookPreAnchor, model)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentHook, err))
+	assert.Contains(t, err.Error(), "invoice number exhausted")
+	assert.Empty(t, calls)
+}
+
+func TestService_RegisterHook(t *testing.T) {
+	// hooks not initialised
+	srv := service{}
+	hook := func(ctx context.Context, model Model) error { return nil }
+	assert.Error(t, srv.RegisterHook(HookPreCreate, hook))
+	assert.NoError(t, srv.RunHooks(context.Background(), HookPreCreate, new(mockModel)))
+
+	srv.hooks = NewHooks()
+	assert.NoError(t, srv.RegisterHook(HookPreCreate, hook))
+	assert.Len(t, srv.hooks.hooks[HookPreCreate], 1)
+}
@@ -0,0 +1,207 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/transactions"
+)
+
+// ImportFormat is the encoding of the rows of a batch import.
+type ImportFormat string
+
+const (
+	// ImportFormatCSV is a CSV file with a header row naming the columns
+	ImportFormatCSV ImportFormat = "csv"
+
+	// ImportFormatJSONLines is a file of one json object per line
+	ImportFormatJSONLines ImportFormat = "jsonl"
+)
+
+// maxImportRows is the maximum number of rows of a batch import
+const maxImportRows = 1000
+
+// utf8BOM is written at the start of CSV files by some spreadsheet applications
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// ImportResult is the outcome of the import of a row of a batch.
+type ImportResult struct {
+	// Row is the 1-based index of the row in the batch, the CSV header and blank lines excluded
+	Row int
+
+	DocumentID []byte
+	TxID       transactions.TxID
+
+	// Err is the reason the row was not imported, nil if the document was created
+	Err error
+}
+
+// importRow holds the client data fields of a row by their names.
+type importRow struct {
+	fields map[string]json.RawMessage
+	err    error
+}
+
+// Import derives a document of the scheme from every row of the batch and creates and anchors it.
+// Columns are renamed to client data fields by the import mapping of the scheme. A row that fails is reported in its
+// result and doesn't stop the import of the other rows.
+func (s service) Import(ctx context.Context, scheme string, format ImportFormat, collaborators []string, data []byte) ([]ImportResult, error) {
+	srv, err := s.registry.LocateSchemeService(scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := decodeImportRows(format, data, s.importMappings[scheme])
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentImport, err)
+	}
+
+	if len(rows) == 0 {
+		return nil, errors.NewTypedError(ErrDocumentImport, errors.New("batch has no rows"))
+	}
+
+	if len(rows) > maxImportRows {
+		return nil, errors.NewTypedError(ErrDocumentImport, errors.New("batch of %d rows exceeds the limit of %d rows", len(rows), maxImportRows))
+	}
+
+	results := make([]ImportResult, len(rows))
+	for i, row := range rows {
+		results[i] = s.importDocument(ctx, srv, collaborators, row)
+		results[i].Row = i + 1
+	}
+
+	return results, nil
+}
+
+// importDocument validates the client data of the row, derives the document and creates it.
+func (s service) importDocument(ctx context.Context, srv SchemeService, collaborators []string, row importRow) ImportResult {
+	if row.err != nil {
+		return ImportResult{Err: row.err}
+	}
+
+	data, err := json.Marshal(row.fields)
+	if err != nil {
+		return ImportResult{Err: err}
+	}
+
+	err = s.registry.ValidateClientData(srv.Scheme(), data)
+	if err != nil {
+		return ImportResult{Err: err}
+	}
+
+	model, err := srv.DeriveFromClientData(ctx, CollaboratorsAccess{ReadWriteCollaborators: collaborators}, data)
+	if err != nil {
+		return ImportResult{Err: err}
+	}
+
+	model, txID, _, err := srv.Create(ctx, model)
+	if err != nil {
+		return ImportResult{Err: err}
+	}
+
+	return ImportResult{DocumentID: model.ID(), TxID: txID}
+}
+
+func decodeImportRows(format ImportFormat, data []byte, mapping map[string]string) ([]importRow, error) {
+	switch format {
+	case ImportFormatCSV:
+		return decodeCSVRows(bytes.TrimPrefix(data, utf8BOM), mapping)
+	case ImportFormatJSONLines:
+		return decodeJSONLines(data, mapping), nil
+	default:
+		return nil, errors.New("unknown import format %q", format)
+	}
+}
+
+// importField returns the client data field of the column, the column itself if it is not mapped.
+func importField(column string, mapping map[string]string) string {
+	column = strings.TrimSpace(column)
+	if field, ok := mapping[strings.ToLower(column)]; ok {
+		return field
+	}
+
+	return column
+}
+
+// decodeCSVRows decodes the records following the header row. Empty cells are left out of the rows.
+func decodeCSVRows(data []byte, mapping map[string]string) ([]importRow, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, errors.New("malformed csv: %v", err)
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("csv header is missing")
+	}
+
+	fields := make([]string, len(records[0]))
+	seen := make(map[string]bool)
+	for i, column := range records[0] {
+		field := importField(column, mapping)
+		if seen[field] {
+			return nil, errors.New("more than one column is imported as field %s", field)
+		}
+
+		seen[field] = true
+		fields[i] = field
+	}
+
+	rows := make([]importRow, len(records)-1)
+	for i, record := range records[1:] {
+		if len(record) != len(fields) {
+			rows[i].err = errors.New("expected %d columns but got %d", len(fields), len(record))
+			continue
+		}
+
+		rows[i].fields = make(map[string]json.RawMessage)
+		for j, v := range record {
+			if v == "" {
+				continue
+			}
+
+			// strings always encode
+			rows[i].fields[fields[j]], _ = json.Marshal(v)
+		}
+	}
+
+	return rows, nil
+}
+
+// decodeJSONLines decodes the json object of every non blank line. Malformed lines are reported in their rows.
+func decodeJSONLines(data []byte, mapping map[string]string) []importRow {
+	var rows []importRow
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		var obj map[string]json.RawMessage
+		err := json.Unmarshal(line, &obj)
+		if err != nil {
+			rows = append(rows, importRow{err: errors.New("malformed json: %v", err)})
+			continue
+		}
+
+		row := importRow{fields: make(map[string]json.RawMessage)}
+		for k, v := range obj {
+			field := importField(k, mapping)
+			if _, ok := row.fields[field]; ok {
+				row = importRow{err: errors.New("more than one key is imported as field %s", field)}
+				break
+			}
+
+			row.fields[field] = v
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
@@ -0,0 +1,148 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/stretchr/testify/assert"
+)
+
+// importService derives history documents identified by the number field of the client data.
+type importService struct {
+	Service
+	collaborators []string
+}
+
+func (s *importService) Scheme() string { return "import" }
+
+func (s *importService) DeriveFromClientData(ctx context.Context, collaborators CollaboratorsAccess, data []byte) (Model, error) {
+	s.collaborators = collaborators.ReadWriteCollaborators
+	var fields map[string]string
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if fields["number"] == "" {
+		return nil, errors.New("number is required")
+	}
+
+	return &historyDoc{DocID: []byte(fields["number"])}, nil
+}
+
+func (s *importService) DeriveFromUpdateClientData(ctx context.Context, identifier string, collaborators CollaboratorsAccess, data []byte) (Model, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *importService) DeriveClientData(model Model) ([]byte, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *importService) Create(ctx context.Context, model Model) (Model, transactions.TxID, chan bool, error) {
+	if string(model.ID()) == "fail" {
+		return nil, transactions.NilTxID(), nil, errors.New("failed to anchor")
+	}
+
+	return model, transactions.NewTxID(), nil, nil
+}
+
+func TestDecodeImportRows(t *testing.T) {
+	mapping := map[string]string{"invoice no": "invoice_number"}
+
+	// csv
+	rows, err := decodeImportRows(ImportFormatCSV, []byte("\xef\xbb\xbfInvoice No,currency, comment\nINV-1,EUR,\"a, b\"\nINV-2,,\nINV-3\n"), mapping)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+	assert.Equal(t, map[string]json.RawMessage{
+		"invoice_number": json.RawMessage(`"INV-1"`),
+		"currency":       json.RawMessage(`"EUR"`),
+		"comment":        json.RawMessage(`"a, b"`),
+	}, rows[0].fields)
+	assert.Equal(t, map[string]json.RawMessage{"invoice_number": json.RawMessage(`"INV-2"`)}, rows[1].fields)
+	assert.Error(t, rows[2].err)
+
+	// columns imported as the same field
+	_, err = decodeImportRows(ImportFormatCSV, []byte("invoice_number,Invoice No\n"), mapping)
+	assert.Error(t, err)
+
+	// malformed csv
+	_, err = decodeImportRows(ImportFormatCSV, []byte("a,\"b\n"), nil)
+	assert.Error(t, err)
+
+	// missing header
+	_, err = decodeImportRows(ImportFormatCSV, nil, nil)
+	assert.Error(t, err)
+
+	// json lines
+	rows, err = decodeImportRows(ImportFormatJSONLines, []byte("{\"Invoice No\": \"INV-1\", \"tax_rate\": 19}\n\nnot json\n{\"invoice_number\": \"a\", \"invoice no\": \"b\"}\n"), mapping)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+	assert.Equal(t, map[string]json.RawMessage{
+		"invoice_number": json.RawMessage(`"INV-1"`),
+		"tax_rate":       json.RawMessage(`19`),
+	}, rows[0].fields)
+	assert.Error(t, rows[1].err)
+	assert.Error(t, rows[2].err)
+
+	// unknown format
+	_, err = decodeImportRows("xml", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestService_Import(t *testing.T) {
+	registry := NewServiceRegistry()
+	isrv := new(importService)
+	assert.NoError(t, registry.Register("import document", isrv))
+	srv := service{registry: registry, importMappings: map[string]map[string]string{"import": {"no": "number"}}}
+
+	// unknown scheme
+	_, err := srv.Import(context.Background(), "invoice", ImportFormatCSV, nil, []byte("no\n1\n"))
+	assert.True(t, errors.IsOfType(ErrDocumentSchemeUnknown, err))
+
+	// malformed batch
+	_, err = srv.Import(context.Background(), "import", ImportFormatCSV, nil, []byte("no,\"\n"))
+	assert.True(t, errors.IsOfType(ErrDocumentImport, err))
+
+	// no rows
+	_, err = srv.Import(context.Background(), "import", ImportFormatCSV, nil, []byte("no\n"))
+	assert.True(t, errors.IsOfType(ErrDocumentImport, err))
+
+	// failed rows don't stop the import
+	collaborators := []string{"0x010203040506"}
+	results, err := srv.Import(context.Background(), "import", ImportFormatCSV, collaborators, []byte("No,comment\n1,first\n,missing number\nfail,\n2\n4,last\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, collaborators, isrv.collaborators)
+	assert.Len(t, results, 5)
+	assert.Equal(t, []byte("1"), results[0].DocumentID)
+	assert.NotEqual(t, transactions.NilTxID(), results[0].TxID)
+	assert.Contains(t, results[1].Err.Error(), "number is required")
+	assert.Contains(t, results[2].Err.Error(), "failed to anchor")
+	assert.Error(t, results[3].Err)
+	assert.Equal(t, []byte("4"), results[4].DocumentID)
+	for i, r := range results {
+		assert.Equal(t, i+1, r.Row)
+	}
+
+	// rows not matching the JSON schema of the scheme
+	schema, err := ParseJSONSchema([]byte(`{"properties": {"comment": {"maxLength": 4}}}`))
+	assert.NoError(t, err)
+	assert.NoError(t, registry.RegisterJSONSchema("import", schema))
+	results, err = srv.Import(context.Background(), "import", ImportFormatCSV, nil, []byte("No,comment\n5,short\n6,ok\n"))
+	assert.NoError(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentSchemaInvalid, results[0].Err))
+	assert.Contains(t, results[0].Err.Error(), "comment must be at most 4 characters long")
+	assert.Equal(t, []byte("6"), results[1].DocumentID)
+
+	// too many rows
+	data := []byte("no\n")
+	for i := 0; i <= maxImportRows; i++ {
+		data = append(data, "1\n"...)
+	}
+	_, err = srv.Import(context.Background(), "import", ImportFormatCSV, nil, data)
+	assert.True(t, errors.IsOfType(ErrDocumentImport, err))
+}
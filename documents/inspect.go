@@ -0,0 +1,180 @@
+package documents
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/jsonpb"
+)
+
+// InspectOptions selects the stored document versions to inspect and the parts of them left out of the dump.
+type InspectOptions struct {
+	// AccountID limits the dump to the versions of the account, all the accounts if empty.
+	AccountID []byte
+
+	// DocumentID limits the dump to the versions of the document, all the documents if empty.
+	// The encrypted versions are left out since their document is not known.
+	DocumentID []byte
+
+	// RedactData leaves the document type specific data of the versions out.
+	RedactData bool
+
+	// RedactSignatures leaves the signature values and the public keys of the signers out.
+	RedactSignatures bool
+}
+
+// InspectedSignature is a signature of an inspected document version.
+type InspectedSignature struct {
+	SignerID  string `json:"signer_id"`
+	PublicKey string `json:"public_key,omitempty"`
+	Signature string `json:"signature,omitempty"`
+}
+
+// InspectedVersion is the human readable dump of a stored document version.
+// The fields that can't be read from the version are set to the error reading them.
+type InspectedVersion struct {
+	AccountID       string               `json:"account_id"`
+	DocumentID      string               `json:"document_id,omitempty"`
+	VersionID       string               `json:"version_id"`
+	PreviousVersion string               `json:"previous_version,omitempty"`
+	NextVersion     string               `json:"next_version,omitempty"`
+	DocumentType    string               `json:"document_type,omitempty"`
+	Status          string               `json:"status,omitempty"`
+	Author          string               `json:"author,omitempty"`
+	Timestamp       string               `json:"timestamp,omitempty"`
+	DataRoot        string               `json:"data_root,omitempty"`
+	SigningRoot     string               `json:"signing_root,omitempty"`
+	DocumentRoot    string               `json:"document_root,omitempty"`
+	Signatures      []InspectedSignature `json:"signatures,omitempty"`
+	Data            json.RawMessage      `json:"data,omitempty"`
+
+	// Encrypted is set for the versions encrypted at rest, which can't be decrypted offline.
+	// Only their account, version and model type are dumped.
+	Encrypted bool   `json:"encrypted,omitempty"`
+	ModelType string `json:"model_type,omitempty"`
+}
+
+// Inspect walks the document versions stored in db, ordered by account and version ID, and calls fn with the dump of
+// every version matching the options. The document models must be registered with db, the versions of the models
+// not registered are skipped. The iteration stops at the first error returned by fn.
+func Inspect(db storage.Repository, opts InspectOptions, fn func(v InspectedVersion) error) error {
+	iter := db.NewIterator(opts.AccountID)
+	defer iter.Release()
+	for iter.Next() {
+		key := iter.Key()
+		if len(key) <= identity.DIDLength {
+			continue
+		}
+
+		// the documents are stored at accountID+versionID, other models of the account share the prefix
+		var v InspectedVersion
+		switch m := iter.Model().(type) {
+		case Model:
+			if len(opts.DocumentID) > 0 && !bytes.Equal(opts.DocumentID, m.ID()) {
+				continue
+			}
+
+			v = inspectVersion(m, opts)
+		case *encryptedModel:
+			if len(opts.DocumentID) > 0 {
+				continue
+			}
+
+			v = InspectedVersion{Encrypted: true, ModelType: m.ModelType}
+		default:
+			continue
+		}
+
+		v.AccountID = hexutil.Encode(key[:identity.DIDLength])
+		v.VersionID = hexutil.Encode(key[identity.DIDLength:])
+		err := fn(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	return iter.Error()
+}
+
+// encodeOrError returns the hex encoding of the value, or the error getting it.
+func encodeOrError(value []byte, err error) string {
+	if err != nil {
+		return "error: " + err.Error()
+	}
+
+	return hexutil.Encode(value)
+}
+
+// encodeIfSet returns the hex encoding of the value, or an empty string if the value is not set.
+func encodeIfSet(value []byte) string {
+	if utils.IsEmptyByteSlice(value) {
+		return ""
+	}
+
+	return hexutil.Encode(value)
+}
+
+// inspectVersion returns the dump of the stored version.
+func inspectVersion(model Model, opts InspectOptions) InspectedVersion {
+	v := InspectedVersion{
+		DocumentID:      hexutil.Encode(model.ID()),
+		PreviousVersion: encodeIfSet(model.PreviousVersion()),
+		NextVersion:     encodeIfSet(model.NextVersion()),
+		DocumentType:    model.DocumentType(),
+		Status:          string(model.GetStatus()),
+		Author:          model.Author().String(),
+		DataRoot:        encodeOrError(model.CalculateDataRoot()),
+		SigningRoot:     encodeOrError(model.CalculateSigningRoot()),
+		DocumentRoot:    encodeOrError(model.CalculateDocumentRoot()),
+	}
+
+	if ts, err := model.Timestamp(); err == nil {
+		v.Timestamp = ts.UTC().Format(time.RFC3339)
+	}
+
+	for _, sig := range model.Signatures() {
+		is := InspectedSignature{SignerID: hexutil.Encode(sig.SignerId)}
+		if !opts.RedactSignatures {
+			is.PublicKey = hexutil.Encode(sig.PublicKey)
+			is.Signature = hexutil.Encode(sig.Signature)
+		}
+
+		v.Signatures = append(v.Signatures, is)
+	}
+
+	if !opts.RedactData {
+		v.Data = inspectData(model)
+	}
+
+	return v
+}
+
+// inspectData returns the document type specific data of the version as json.
+func inspectData(model Model) json.RawMessage {
+	cd, err := model.PackCoreDocument()
+	if err != nil {
+		return inspectError(err)
+	}
+
+	if cd.EmbeddedData == nil {
+		return nil
+	}
+
+	data, err := (&jsonpb.Marshaler{}).MarshalToString(cd.EmbeddedData)
+	if err != nil {
+		return inspectError(err)
+	}
+
+	return json.RawMessage(data)
+}
+
+// inspectError returns the error as a json string.
+func inspectError(err error) json.RawMessage {
+	data, _ := json.Marshal("error: " + err.Error())
+	return data
+}
@@ -0,0 +1,163 @@
+// +build unit
+
+package documents
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+type inspectDoc struct {
+	Model
+	DocID    []byte
+	Version  []byte
+	Previous []byte
+	Sigs     []coredocumentpb.Signature
+}
+
+func (m *inspectDoc) ID() []byte              { return m.DocID }
+func (m *inspectDoc) CurrentVersion() []byte  { return m.Version }
+func (m *inspectDoc) PreviousVersion() []byte { return m.Previous }
+func (m *inspectDoc) NextVersion() []byte     { return nil }
+func (m *inspectDoc) DocumentType() string    { return "inspect" }
+func (m *inspectDoc) GetStatus() Status       { return StatusCommitted }
+func (m *inspectDoc) Author() identity.DID {
+	return identity.NewDIDFromBytes(m.DocID[:identity.DIDLength])
+}
+func (m *inspectDoc) Timestamp() (time.Time, error) {
+	return time.Time{}, errors.New("not timestamped")
+}
+func (m *inspectDoc) CalculateDataRoot() ([]byte, error)     { return m.Version, nil }
+func (m *inspectDoc) CalculateDocumentRoot() ([]byte, error) { return m.Version, nil }
+func (m *inspectDoc) CalculateSigningRoot() ([]byte, error) {
+	return nil, errors.New("no signing root")
+}
+func (m *inspectDoc) Signatures() []coredocumentpb.Signature { return m.Sigs }
+
+func (m *inspectDoc) PackCoreDocument() (coredocumentpb.CoreDocument, error) {
+	data, err := ptypes.MarshalAny(ptypes.TimestampNow())
+	return coredocumentpb.CoreDocument{EmbeddedData: data}, err
+}
+
+func (m *inspectDoc) JSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *inspectDoc) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+func (m *inspectDoc) Type() reflect.Type {
+	return reflect.TypeOf(m)
+}
+
+func TestInspect(t *testing.T) {
+	db, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	ldb := leveldb.NewLevelDBRepository(db)
+	repo := NewDBRepository(ldb)
+	repo.Register(&inspectDoc{})
+
+	accountID, otherID := utils.RandomSlice(identity.DIDLength), utils.RandomSlice(identity.DIDLength)
+	docID, next := utils.RandomSlice(32), utils.RandomSlice(32)
+	sig := coredocumentpb.Signature{SignerId: accountID, PublicKey: utils.RandomSlice(32), Signature: utils.RandomSlice(64)}
+	d := &inspectDoc{DocID: docID, Version: docID, Sigs: []coredocumentpb.Signature{sig}}
+	assert.NoError(t, repo.Create(accountID, docID, d))
+	assert.NoError(t, repo.Create(accountID, next, &inspectDoc{DocID: docID, Version: next, Previous: docID}))
+	assert.NoError(t, repo.Create(otherID, docID, d))
+	encKey := append(otherID, utils.RandomSlice(32)...)
+	assert.NoError(t, ldb.Create(encKey, &encryptedModel{ModelType: "documents.inspectDoc"}))
+
+	inspect := func(opts InspectOptions) []InspectedVersion {
+		var versions []InspectedVersion
+		err := Inspect(ldb, opts, func(v InspectedVersion) error {
+			versions = append(versions, v)
+			return nil
+		})
+		assert.NoError(t, err)
+		return versions
+	}
+
+	// all the accounts, the index entries are skipped
+	versions := inspect(InspectOptions{})
+	assert.Len(t, versions, 4)
+
+	// account
+	versions = inspect(InspectOptions{AccountID: accountID})
+	assert.Len(t, versions, 2)
+	for _, v := range versions {
+		assert.Equal(t, hexutil.Encode(accountID), v.AccountID)
+		assert.Equal(t, hexutil.Encode(docID), v.DocumentID)
+		assert.Equal(t, "inspect", v.DocumentType)
+		assert.Equal(t, string(StatusCommitted), v.Status)
+		assert.Empty(t, v.Timestamp)
+		assert.Equal(t, v.VersionID, v.DataRoot)
+		assert.Equal(t, "error: no signing root", v.SigningRoot)
+		assert.Contains(t, string(v.Data), "google.protobuf.Timestamp")
+	}
+
+	v := versions[0]
+	if v.VersionID != hexutil.Encode(docID) {
+		v = versions[1]
+	}
+	assert.Empty(t, v.PreviousVersion)
+	assert.Equal(t, []InspectedSignature{{
+		SignerID:  hexutil.Encode(accountID),
+		PublicKey: hexutil.Encode(sig.PublicKey),
+		Signature: hexutil.Encode(sig.Signature),
+	}}, v.Signatures)
+
+	// encrypted versions are listed without content
+	versions = inspect(InspectOptions{AccountID: otherID})
+	assert.Len(t, versions, 2)
+	var encrypted []InspectedVersion
+	for _, v := range versions {
+		if v.Encrypted {
+			encrypted = append(encrypted, v)
+		}
+	}
+	assert.Equal(t, []InspectedVersion{{
+		AccountID: hexutil.Encode(otherID),
+		VersionID: hexutil.Encode(encKey[identity.DIDLength:]),
+		Encrypted: true,
+		ModelType: "documents.inspectDoc",
+	}}, encrypted)
+
+	// document, the encrypted versions are left out
+	versions = inspect(InspectOptions{DocumentID: docID})
+	assert.Len(t, versions, 3)
+	versions = inspect(InspectOptions{DocumentID: next})
+	assert.Len(t, versions, 0)
+
+	// redacted
+	versions = inspect(InspectOptions{AccountID: accountID, DocumentID: docID, RedactData: true, RedactSignatures: true})
+	assert.Len(t, versions, 2)
+	for _, v := range versions {
+		assert.Nil(t, v.Data)
+		for _, s := range v.Signatures {
+			assert.Empty(t, s.PublicKey)
+			assert.Empty(t, s.Signature)
+			assert.Equal(t, hexutil.Encode(accountID), s.SignerID)
+		}
+	}
+
+	// iteration stops at the first error
+	var count int
+	err = Inspect(ldb, InspectOptions{}, func(v InspectedVersion) error {
+		count++
+		return errors.New("stop")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, count)
+}
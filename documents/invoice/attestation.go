@@ -0,0 +1,156 @@
+package invoice
+
+import (
+	"context"
+	"sync"
+
+	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	clientinvoicepb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoice"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	logging "github.com/ipfs/go-log"
+)
+
+var log = logging.Logger("invoice")
+
+// attestationFields are the fields of the invoice proven by an attestation
+var attestationFields = []string{"invoice.invoice_status", "invoice.gross_amount_decimal", "invoice.currency", "invoice.due_date"}
+
+// attestationCache holds the attestation of the latest anchored version of the invoices per account.
+type attestationCache struct {
+	mutex        sync.RWMutex
+	attestations map[string]*clientinvoicepb.InvoiceAttestation
+}
+
+func newAttestationCache() *attestationCache {
+	return &attestationCache{attestations: make(map[string]*clientinvoicepb.InvoiceAttestation)}
+}
+
+func attestationKey(accountID identity.DID, documentID []byte) string {
+	return accountID.String() + hexutil.Encode(documentID)
+}
+
+// get returns the cached attestation of the invoice, nil if the cache is not initialised.
+func (c *attestationCache) get(accountID identity.DID, documentID []byte) *clientinvoicepb.InvoiceAttestation {
+	if c == nil {
+		return nil
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.attestations[attestationKey(accountID, documentID)]
+}
+
+func (c *attestationCache) put(accountID identity.DID, documentID []byte, att *clientinvoicepb.InvoiceAttestation) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.attestations[attestationKey(accountID, documentID)] = att
+}
+
+// GetAttestation returns the attestation of the latest anchored version of the invoice.
+// The attestations are renewed as versions are anchored or received, and generated on demand otherwise.
+func (s service) GetAttestation(ctx context.Context, documentID []byte) (*clientinvoicepb.InvoiceAttestation, error) {
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentConfigAccountID, err)
+	}
+
+	model, err := s.latestAnchoredVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	att := s.attestations.get(did, documentID)
+	if att != nil && att.VersionId == hexutil.Encode(model.CurrentVersion()) {
+		return att, nil
+	}
+
+	return s.attest(ctx, did, model)
+}
+
+// latestAnchoredVersion walks back from the current version of the document to its latest anchored version.
+func (s service) latestAnchoredVersion(ctx context.Context, documentID []byte) (documents.Model, error) {
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentNotFound, err)
+	}
+
+	for {
+		// the versions stored before the status was tracked are anchored
+		status := model.GetStatus()
+		if status == "" || status == documents.StatusCommitted {
+			return model, nil
+		}
+
+		prev := model.PreviousVersion()
+		if utils.IsEmptyByteSlice(prev) {
+			return nil, errors.NewTypedError(documents.ErrDocumentNotAnchored, errors.New("invoice %x", documentID))
+		}
+
+		model, err = s.GetVersion(ctx, documentID, prev)
+		if err != nil {
+			return nil, errors.NewTypedError(documents.ErrDocumentVersionNotFound, err)
+		}
+	}
+}
+
+// attest proves the status, amount and due date of the anchored invoice version and caches the attestation.
+func (s service) attest(ctx context.Context, accountID identity.DID, model documents.Model) (*clientinvoicepb.InvoiceAttestation, error) {
+	inv, ok := model.(*Invoice)
+	if !ok {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("unknown document type: %T", model))
+	}
+
+	proof, err := s.CreateProofsForVersion(ctx, inv.ID(), inv.CurrentVersion(), attestationFields)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorID, err := anchors.ToAnchorID(proof.VersionID)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentProof, err)
+	}
+
+	att := &clientinvoicepb.InvoiceAttestation{
+		DocumentId:    hexutil.Encode(proof.DocumentID),
+		VersionId:     hexutil.Encode(proof.VersionID),
+		AnchorId:      anchorID.String(),
+		DocumentRoot:  hexutil.Encode(proof.DocumentRoot),
+		InvoiceStatus: inv.InvoiceStatus,
+		GrossAmount:   documents.DecimalToString(inv.GrossAmount),
+		Currency:      inv.Currency,
+		DueDate:       inv.DueDate,
+		FieldProofs:   documents.ConvertProofsToClientFormat(proof.FieldProofs),
+	}
+
+	s.attestations.put(accountID, inv.ID(), att)
+	return att, nil
+}
+
+// renewAttestation renews the attestation of the invoices on each version anchored or received by the account.
+func (s service) renewAttestation(ctx context.Context, event documents.Event) {
+	if event.DocumentType != documenttypes.InvoiceDataTypeUrl ||
+		(event.EventType != documents.EventAnchored && event.EventType != documents.EventReceived) {
+		return
+	}
+
+	model, err := s.GetVersion(ctx, event.DocumentID, event.VersionID)
+	if err != nil {
+		log.Errorf("failed to renew attestation of invoice %x: %v", event.DocumentID, err)
+		return
+	}
+
+	_, err = s.attest(ctx, event.AccountID, model)
+	if err != nil {
+		log.Errorf("failed to renew attestation of invoice %x: %v", event.DocumentID, err)
+	}
+}
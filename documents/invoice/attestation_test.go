@@ -0,0 +1,87 @@
+// +build unit
+
+package invoice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_GetAttestation(t *testing.T) {
+	docSrv := new(testingdocuments.MockService)
+	srv := service{Service: docSrv, attestations: newAttestationCache()}
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	model, _ := createCDWithEmbeddedInvoice(t)
+	inv := model.(*Invoice)
+	id := inv.ID()
+
+	// missing account
+	_, err := srv.GetAttestation(context.Background(), id)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentConfigAccountID, err))
+
+	// no anchored version
+	docSrv.On("GetCurrentVersion", id).Return(inv, nil).Once()
+	_, err = srv.GetAttestation(ctxh, id)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentNotAnchored, err))
+
+	// the latest anchored version is attested
+	inv.SetStatus(documents.StatusCommitted)
+	next := new(Invoice)
+	assert.NoError(t, next.PrepareNewVersion(inv, inv.getClientData(), documents.CollaboratorsAccess{}))
+	proof := &documents.DocumentProof{DocumentID: id, VersionID: inv.CurrentVersion(), DocumentRoot: utils.RandomSlice(32)}
+	docSrv.On("GetCurrentVersion", id).Return(next, nil).Twice()
+	docSrv.On("GetVersion", id, inv.CurrentVersion()).Return(inv, nil).Twice()
+	docSrv.On("CreateProofsForVersion", id, inv.CurrentVersion(), attestationFields).Return(proof, nil).Once()
+	att, err := srv.GetAttestation(ctxh, id)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), att.DocumentId)
+	assert.Equal(t, hexutil.Encode(inv.CurrentVersion()), att.VersionId)
+	assert.Equal(t, hexutil.Encode(proof.DocumentRoot), att.DocumentRoot)
+	assert.Equal(t, inv.InvoiceStatus, att.InvoiceStatus)
+	assert.Equal(t, documents.DecimalToString(inv.GrossAmount), att.GrossAmount)
+	assert.Equal(t, inv.DueDate, att.DueDate)
+
+	// the attestation is not generated again until a new version is anchored
+	cached, err := srv.GetAttestation(ctxh, id)
+	assert.NoError(t, err)
+	assert.Equal(t, att, cached)
+	docSrv.AssertExpectations(t)
+}
+
+func TestService_renewAttestation(t *testing.T) {
+	docSrv := new(testingdocuments.MockService)
+	srv := service{Service: docSrv, attestations: newAttestationCache()}
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	model, _ := createCDWithEmbeddedInvoice(t)
+	inv := model.(*Invoice)
+	inv.SetStatus(documents.StatusCommitted)
+	id := inv.ID()
+
+	// other events and document types are ignored
+	event := documents.NewEvent(documents.EventVersionPrepared, inv)
+	event.AccountID = cid
+	srv.renewAttestation(ctxh, event)
+	event.EventType = documents.EventAnchored
+	event.DocumentType = "purchaseorder"
+	srv.renewAttestation(ctxh, event)
+	assert.Nil(t, srv.attestations.get(cid, id))
+
+	// anchored versions are attested
+	event.DocumentType = inv.DocumentType()
+	proof := &documents.DocumentProof{DocumentID: id, VersionID: inv.CurrentVersion(), DocumentRoot: utils.RandomSlice(32)}
+	docSrv.On("GetVersion", id, inv.CurrentVersion()).Return(inv, nil).Once()
+	docSrv.On("CreateProofsForVersion", id, inv.CurrentVersion(), attestationFields).Return(proof, nil).Once()
+	srv.renewAttestation(ctxh, event)
+	att := srv.attestations.get(cid, id)
+	assert.NotNil(t, att)
+	assert.Equal(t, hexutil.Encode(inv.CurrentVersion()), att.VersionId)
+	docSrv.AssertExpectations(t)
+}
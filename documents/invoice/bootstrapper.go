@@ -51,13 +51,24 @@ func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		return errors.New("config service not initialised")
 	}
 
+	cfg, ok := ctx[bootstrap.BootstrappedConfig].(config.Configuration)
+	if !ok {
+		return errors.New("config not initialised")
+	}
+
+	ublAttributes := cfg.GetUBLAttributeMapping()
+	err := validateUBLAttributes(ublAttributes)
+	if err != nil {
+		return errors.New("invalid UBL attribute mapping: %v", err)
+	}
+
 	// register service
 	srv := DefaultService(
 		docSrv,
 		repo,
-		queueSrv, txManager)
+		queueSrv, txManager, cfg.GetStrictCodeValidation(), ublAttributes)
 
-	err := registry.Register(documenttypes.InvoiceDataTypeUrl, srv)
+	err = registry.Register(documenttypes.InvoiceDataTypeUrl, srv)
 	if err != nil {
 		return errors.New("failed to register invoice service: %v", err)
 	}
@@ -2,9 +2,9 @@ package invoice
 
 import (
 	"github.com/centrifuge/go-centrifuge/config"
-	"github.com/centrifuge/go-centrifuge/contextutil"
 
 	"github.com/centrifuge/go-centrifuge/centerrors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
 	clientinvoicepb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoice"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	logging "github.com/ipfs/go-log"
@@ -31,20 +31,14 @@ func GRPCHandler(config config.Service, srv Service) clientinvoicepb.DocumentSer
 // Create handles the creation of the invoices and anchoring the documents on chain
 func (h *grpcHandler) Create(ctx context.Context, req *clientinvoicepb.InvoiceCreatePayload) (*clientinvoicepb.InvoiceResponse, error) {
 	apiLog.Debugf("Create request %v", req)
-	cctx, err := contextutil.Context(ctx, h.config)
-	if err != nil {
-		apiLog.Error(err)
-		return nil, err
-	}
-
-	doc, err := h.service.DeriveFromCreatePayload(cctx, req)
+	doc, err := h.service.DeriveFromCreatePayload(ctx, req)
 	if err != nil {
 		apiLog.Error(err)
 		return nil, centerrors.Wrap(err, "could not derive create payload")
 	}
 
 	// validate and persist
-	doc, txID, _, err := h.service.Create(cctx, doc)
+	doc, txID, _, err := h.service.Create(ctx, doc)
 	if err != nil {
 		apiLog.Error(err)
 		return nil, centerrors.Wrap(err, "could not create document")
@@ -63,19 +57,13 @@ func (h *grpcHandler) Create(ctx context.Context, req *clientinvoicepb.InvoiceCr
 // Update handles the document update and anchoring
 func (h *grpcHandler) Update(ctx context.Context, payload *clientinvoicepb.InvoiceUpdatePayload) (*clientinvoicepb.InvoiceResponse, error) {
 	apiLog.Debugf("Update request %v", payload)
-	ctxHeader, err := contextutil.Context(ctx, h.config)
-	if err != nil {
-		apiLog.Error(err)
-		return nil, err
-	}
-
-	doc, err := h.service.DeriveFromUpdatePayload(ctxHeader, payload)
+	doc, err := h.service.DeriveFromUpdatePayload(ctx, payload)
 	if err != nil {
 		apiLog.Error(err)
 		return nil, centerrors.Wrap(err, "could not derive update payload")
 	}
 
-	doc, txID, _, err := h.service.Update(ctxHeader, doc)
+	doc, txID, _, err := h.service.Update(ctx, doc)
 	if err != nil {
 		apiLog.Error(err)
 		return nil, centerrors.Wrap(err, "could not update document")
@@ -94,25 +82,19 @@ func (h *grpcHandler) Update(ctx context.Context, payload *clientinvoicepb.Invoi
 // GetVersion returns the requested version of the document
 func (h *grpcHandler) GetVersion(ctx context.Context, getVersionRequest *clientinvoicepb.GetVersionRequest) (*clientinvoicepb.InvoiceResponse, error) {
 	apiLog.Debugf("Get version request %v", getVersionRequest)
-	ctxHeader, err := contextutil.Context(ctx, h.config)
-	if err != nil {
-		apiLog.Error(err)
-		return nil, err
-	}
-
-	identifier, err := hexutil.Decode(getVersionRequest.Identifier)
+	identifier, err := identifiers.DecodeDocumentID(getVersionRequest.Identifier)
 	if err != nil {
 		apiLog.Error(err)
 		return nil, centerrors.Wrap(err, "identifier is invalid")
 	}
 
-	version, err := hexutil.Decode(getVersionRequest.Version)
+	version, err := identifiers.DecodeVersionID(getVersionRequest.Version)
 	if err != nil {
 		apiLog.Error(err)
 		return nil, centerrors.Wrap(err, "version is invalid")
 	}
 
-	model, err := h.service.GetVersion(ctxHeader, identifier, version)
+	model, err := h.service.GetVersion(ctx, identifier, version)
 	if err != nil {
 		apiLog.Error(err)
 		return nil, centerrors.Wrap(err, "document not found")
@@ -130,29 +112,94 @@ func (h *grpcHandler) GetVersion(ctx context.Context, getVersionRequest *clienti
 // Get returns the invoice the latest version of the document with given identifier
 func (h *grpcHandler) Get(ctx context.Context, getRequest *clientinvoicepb.GetRequest) (*clientinvoicepb.InvoiceResponse, error) {
 	apiLog.Debugf("Get request %v", getRequest)
-	ctxHeader, err := contextutil.Context(ctx, h.config)
+	identifier, err := identifiers.DecodeDocumentID(getRequest.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "identifier is an invalid hex string")
+	}
+
+	model, err := h.service.GetCurrentVersion(ctx, identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "document not found")
+	}
+
+	resp, err := h.service.DeriveInvoiceResponse(model)
 	if err != nil {
 		apiLog.Error(err)
-		return nil, err
+		return nil, centerrors.Wrap(err, "could not derive response")
 	}
 
-	identifier, err := hexutil.Decode(getRequest.Identifier)
+	return resp, nil
+}
+
+// ExportUBL returns the current version of the invoice as a UBL 2.1 invoice
+func (h *grpcHandler) ExportUBL(ctx context.Context, req *clientinvoicepb.ExportUBLRequest) (*clientinvoicepb.UBLResponse, error) {
+	apiLog.Debugf("Export UBL request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
 	if err != nil {
 		apiLog.Error(err)
 		return nil, centerrors.Wrap(err, "identifier is an invalid hex string")
 	}
 
-	model, err := h.service.GetCurrentVersion(ctxHeader, identifier)
+	model, err := h.service.GetCurrentVersion(ctx, identifier)
 	if err != nil {
 		apiLog.Error(err)
 		return nil, centerrors.Wrap(err, "document not found")
 	}
 
-	resp, err := h.service.DeriveInvoiceResponse(model)
+	ubl, err := h.service.DeriveUBL(model)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive UBL invoice")
+	}
+
+	return &clientinvoicepb.UBLResponse{
+		Identifier: hexutil.Encode(model.ID()),
+		Version:    hexutil.Encode(model.CurrentVersion()),
+		Ubl:        string(ubl),
+	}, nil
+}
+
+// GetAttestation returns the attestation of the status, amount and due date of the latest anchored version of the invoice
+func (h *grpcHandler) GetAttestation(ctx context.Context, req *clientinvoicepb.GetRequest) (*clientinvoicepb.InvoiceAttestation, error) {
+	apiLog.Debugf("Get attestation request %v", req)
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "identifier is an invalid hex string")
+	}
+
+	att, err := h.service.GetAttestation(ctx, identifier)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not attest invoice")
+	}
+
+	return att, nil
+}
+
+// ImportUBL handles the creation of an invoice from a UBL 2.1 invoice and anchoring the document on chain
+func (h *grpcHandler) ImportUBL(ctx context.Context, req *clientinvoicepb.UBLImportPayload) (*clientinvoicepb.InvoiceResponse, error) {
+	apiLog.Debugf("Import UBL request %v", req)
+	doc, err := h.service.DeriveFromUBLPayload(ctx, req)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not derive UBL payload")
+	}
+
+	doc, txID, _, err := h.service.Create(ctx, doc)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, centerrors.Wrap(err, "could not create document")
+	}
+
+	resp, err := h.service.DeriveInvoiceResponse(doc)
 	if err != nil {
 		apiLog.Error(err)
 		return nil, centerrors.Wrap(err, "could not derive response")
 	}
 
+	resp.Header.TransactionId = txID.String()
 	return resp, nil
 }
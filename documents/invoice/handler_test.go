@@ -71,6 +71,24 @@ func (m *mockService) DeriveFromUpdatePayload(ctx context.Context, payload *clie
 	return doc, args.Error(1)
 }
 
+func (m *mockService) DeriveFromUBLPayload(ctx context.Context, payload *clientinvoicepb.UBLImportPayload) (documents.Model, error) {
+	args := m.Called(ctx, payload)
+	doc, _ := args.Get(0).(documents.Model)
+	return doc, args.Error(1)
+}
+
+func (m *mockService) DeriveUBL(doc documents.Model) ([]byte, error) {
+	args := m.Called(doc)
+	ubl, _ := args.Get(0).([]byte)
+	return ubl, args.Error(1)
+}
+
+func (m *mockService) GetAttestation(ctx context.Context, documentID []byte) (*clientinvoicepb.InvoiceAttestation, error) {
+	args := m.Called(ctx, documentID)
+	att, _ := args.Get(0).(*clientinvoicepb.InvoiceAttestation)
+	return att, args.Error(1)
+}
+
 func getHandler() *grpcHandler {
 	return &grpcHandler{service: &mockService{}, config: configService}
 }
@@ -91,7 +109,7 @@ func TestGRPCHandler_Create_create_fail(t *testing.T) {
 	srv := h.service.(*mockService)
 	srv.On("DeriveFromCreatePayload", mock.Anything, mock.Anything).Return(new(Invoice), nil).Once()
 	srv.On("Create", mock.Anything, mock.Anything).Return(nil, transactions.NilTxID().String(), errors.New("create failed")).Once()
-	payload := &clientinvoicepb.InvoiceCreatePayload{Data: &clientinvoicepb.InvoiceData{GrossAmount: 300}}
+	payload := &clientinvoicepb.InvoiceCreatePayload{Data: &clientinvoicepb.InvoiceData{GrossAmount: "300"}}
 	_, err := h.Create(testingconfig.HandlerContext(configService), payload)
 	srv.AssertExpectations(t)
 	assert.Error(t, err, "must be non nil")
@@ -129,7 +147,7 @@ func TestGrpcHandler_Create(t *testing.T) {
 	srv := h.service.(*mockService)
 	model := new(Invoice)
 	txID := transactions.NewTxID()
-	payload := &clientinvoicepb.InvoiceCreatePayload{Data: &clientinvoicepb.InvoiceData{GrossAmount: 300}, Collaborators: []string{"0x010203040506"}}
+	payload := &clientinvoicepb.InvoiceCreatePayload{Data: &clientinvoicepb.InvoiceData{GrossAmount: "300"}, Collaborators: []string{"0x010203040506"}}
 	response := &clientinvoicepb.InvoiceResponse{Header: &clientinvoicepb.ResponseHeader{}}
 	srv.On("DeriveFromCreatePayload", mock.Anything, mock.Anything).Return(model, nil).Once()
 	srv.On("Create", mock.Anything, mock.Anything).Return(model, txID.String(), nil).Once()
@@ -142,7 +160,7 @@ func TestGrpcHandler_Create(t *testing.T) {
 }
 
 func TestGrpcHandler_Get_invalid_input(t *testing.T) {
-	identifier := "0x01010101"
+	identifier := "0x0101010101010101010101010101010101010101010101010101010101010101"
 	identifierBytes, _ := hexutil.Decode(identifier)
 	h := getHandler()
 	srv := h.service.(*mockService)
@@ -150,7 +168,7 @@ func TestGrpcHandler_Get_invalid_input(t *testing.T) {
 
 	res, err := h.Get(testingconfig.HandlerContext(configService), payload)
 	assert.Nil(t, res)
-	assert.EqualError(t, err, "identifier is an invalid hex string: hex string without 0x prefix")
+	assert.EqualError(t, err, "identifier is an invalid hex string: identifier must be a 0x prefixed hex string")
 
 	payload.Identifier = identifier
 	srv.On("GetCurrentVersion", mock.Anything, identifierBytes).Return(nil, errors.New("not found"))
@@ -161,7 +179,7 @@ func TestGrpcHandler_Get_invalid_input(t *testing.T) {
 }
 
 func TestGrpcHandler_Get(t *testing.T) {
-	identifier := "0x01010101"
+	identifier := "0x0101010101010101010101010101010101010101010101010101010101010101"
 	identifierBytes, _ := hexutil.Decode(identifier)
 	h := getHandler()
 	srv := h.service.(*mockService)
@@ -183,17 +201,20 @@ func TestGrpcHandler_GetVersion_invalid_input(t *testing.T) {
 	srv := h.service.(*mockService)
 	payload := &clientinvoicepb.GetVersionRequest{Identifier: "0x0x", Version: "0x00"}
 	res, err := h.GetVersion(testingconfig.HandlerContext(configService), payload)
-	assert.EqualError(t, err, "identifier is invalid: invalid hex string")
-	payload.Version = "0x0x"
+	assert.EqualError(t, err, "identifier is invalid: identifier must be a 0x prefixed hex string: invalid hex string")
 	payload.Identifier = "0x01"
 
 	res, err = h.GetVersion(testingconfig.HandlerContext(configService), payload)
-	assert.EqualError(t, err, "version is invalid: invalid hex string")
-	payload.Version = "0x00"
-	payload.Identifier = "0x01"
+	assert.EqualError(t, err, "identifier is invalid: identifier has invalid length: expected 32 bytes but got 1")
+	payload.Version = "0x0x"
+	payload.Identifier = "0x0101010101010101010101010101010101010101010101010101010101010101"
+
+	res, err = h.GetVersion(testingconfig.HandlerContext(configService), payload)
+	assert.EqualError(t, err, "version is invalid: identifier must be a 0x prefixed hex string: invalid hex string")
+	payload.Version = "0x0000000000000000000000000000000000000000000000000000000000000000"
 
 	mockErr := errors.New("not found")
-	srv.On("GetVersion", mock.Anything, []byte{0x01}, []byte{0x00}).Return(nil, mockErr)
+	srv.On("GetVersion", mock.Anything, hexutil.MustDecode(payload.Identifier), hexutil.MustDecode(payload.Version)).Return(nil, mockErr)
 	res, err = h.GetVersion(testingconfig.HandlerContext(configService), payload)
 	srv.AssertExpectations(t)
 	assert.EqualError(t, err, "document not found: not found")
@@ -204,10 +225,10 @@ func TestGrpcHandler_GetVersion(t *testing.T) {
 	h := getHandler()
 	srv := h.service.(*mockService)
 	model := new(mockModel)
-	payload := &clientinvoicepb.GetVersionRequest{Identifier: "0x01", Version: "0x00"}
+	payload := &clientinvoicepb.GetVersionRequest{Identifier: "0x0101010101010101010101010101010101010101010101010101010101010101", Version: "0x0000000000000000000000000000000000000000000000000000000000000000"}
 
 	response := &clientinvoicepb.InvoiceResponse{}
-	srv.On("GetVersion", mock.Anything, []byte{0x01}, []byte{0x00}).Return(model, nil)
+	srv.On("GetVersion", mock.Anything, hexutil.MustDecode(payload.Identifier), hexutil.MustDecode(payload.Version)).Return(model, nil)
 	srv.On("DeriveInvoiceResponse", model).Return(response, nil)
 	res, err := h.GetVersion(testingconfig.HandlerContext(configService), payload)
 	model.AssertExpectations(t)
@@ -276,3 +297,92 @@ func TestGrpcHandler_Update(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, resp, res)
 }
+
+func TestGrpcHandler_ExportUBL(t *testing.T) {
+	identifier := "0x0101010101010101010101010101010101010101010101010101010101010101"
+	identifierBytes, _ := hexutil.Decode(identifier)
+	h := getHandler()
+	srv := h.service.(*mockService)
+	payload := &clientinvoicepb.ExportUBLRequest{Identifier: "invalid"}
+
+	// invalid identifier
+	res, err := h.ExportUBL(testingconfig.HandlerContext(configService), payload)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+
+	// derive fails
+	payload.Identifier = identifier
+	model := new(mockModel)
+	srv.On("GetCurrentVersion", mock.Anything, identifierBytes).Return(model, nil)
+	srv.On("DeriveUBL", model).Return(nil, errors.New("derive UBL failed")).Once()
+	res, err = h.ExportUBL(testingconfig.HandlerContext(configService), payload)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "derive UBL failed")
+
+	// success
+	inv, _ := createCDWithEmbeddedInvoice(t)
+	srv = &mockService{}
+	h.service = srv
+	srv.On("GetCurrentVersion", mock.Anything, identifierBytes).Return(inv, nil)
+	srv.On("DeriveUBL", inv).Return([]byte("<Invoice/>"), nil).Once()
+	res, err = h.ExportUBL(testingconfig.HandlerContext(configService), payload)
+	srv.AssertExpectations(t)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(inv.ID()), res.Identifier)
+	assert.Equal(t, hexutil.Encode(inv.CurrentVersion()), res.Version)
+	assert.Equal(t, "<Invoice/>", res.Ubl)
+}
+
+func TestGrpcHandler_GetAttestation(t *testing.T) {
+	identifier := "0x0101010101010101010101010101010101010101010101010101010101010101"
+	identifierBytes, _ := hexutil.Decode(identifier)
+	h := getHandler()
+	srv := h.service.(*mockService)
+	payload := &clientinvoicepb.GetRequest{Identifier: "invalid"}
+
+	// invalid identifier
+	res, err := h.GetAttestation(testingconfig.HandlerContext(configService), payload)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+
+	// no anchored version
+	payload.Identifier = identifier
+	srv.On("GetAttestation", mock.Anything, identifierBytes).Return(nil, documents.ErrDocumentNotAnchored).Once()
+	res, err = h.GetAttestation(testingconfig.HandlerContext(configService), payload)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+
+	// success
+	att := &clientinvoicepb.InvoiceAttestation{DocumentId: identifier, InvoiceStatus: "unpaid"}
+	srv.On("GetAttestation", mock.Anything, identifierBytes).Return(att, nil).Once()
+	res, err = h.GetAttestation(testingconfig.HandlerContext(configService), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, att, res)
+	srv.AssertExpectations(t)
+}
+
+func TestGrpcHandler_ImportUBL(t *testing.T) {
+	h := getHandler()
+	srv := h.service.(*mockService)
+	payload := &clientinvoicepb.UBLImportPayload{Ubl: "<Invoice/>"}
+
+	// derive fails
+	srv.On("DeriveFromUBLPayload", mock.Anything, payload).Return(nil, errors.New("derive failed")).Once()
+	res, err := h.ImportUBL(testingconfig.HandlerContext(configService), payload)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "derive failed")
+
+	// success
+	model := new(Invoice)
+	txID := transactions.NewTxID()
+	response := &clientinvoicepb.InvoiceResponse{Header: &clientinvoicepb.ResponseHeader{}}
+	srv.On("DeriveFromUBLPayload", mock.Anything, payload).Return(model, nil).Once()
+	srv.On("Create", mock.Anything, model).Return(model, txID.String(), nil).Once()
+	srv.On("DeriveInvoiceResponse", model).Return(response, nil).Once()
+	res, err = h.ImportUBL(testingconfig.HandlerContext(configService), payload)
+	srv.AssertExpectations(t)
+	assert.NoError(t, err)
+	assert.Equal(t, response, res)
+}
@@ -0,0 +1,146 @@
+package invoice
+
+import (
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	clientinvoicepb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoice"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoiceext"
+)
+
+// LineItem is a single item of an invoice.
+// Every line item is a separate leaf group of the data tree, so fields such as invoice.line_items[0].amount can be proven.
+type LineItem struct {
+	ItemNumber  string
+	Description string
+	Quantity    *documents.Decimal
+	UnitPrice   *documents.Decimal
+	Amount      *documents.Decimal // quantity times unit price, excluding tax
+	TaxAmount   *documents.Decimal
+	TaxRate     *documents.Decimal
+}
+
+// lineItemsFromClientData parses the line items of the client data.
+func lineItemsFromClientData(items []*clientinvoicepb.LineItem) ([]*LineItem, error) {
+	var lis []*LineItem
+	for idx, item := range items {
+		li := &LineItem{
+			ItemNumber:  item.ItemNumber,
+			Description: item.Description,
+		}
+
+		decs := []struct {
+			name string
+			val  string
+			dec  **documents.Decimal
+		}{
+			{"quantity", item.Quantity, &li.Quantity},
+			{"unit price", item.UnitPrice, &li.UnitPrice},
+			{"amount", item.Amount, &li.Amount},
+			{"tax amount", item.TaxAmount, &li.TaxAmount},
+			{"tax rate", item.TaxRate, &li.TaxRate},
+		}
+
+		for _, d := range decs {
+			dec, err := documents.StringToDecimal(d.val)
+			if err != nil {
+				return nil, errors.NewTypedError(documents.ErrInvalidDecimal, errors.New("failed to parse %s of line item %d: %v", d.name, idx, err))
+			}
+
+			*d.dec = dec
+		}
+
+		lis = append(lis, li)
+	}
+
+	return lis, nil
+}
+
+// lineItemsToClientData returns the line items in the client format.
+func lineItemsToClientData(items []*LineItem) []*clientinvoicepb.LineItem {
+	var lis []*clientinvoicepb.LineItem
+	for _, item := range items {
+		lis = append(lis, &clientinvoicepb.LineItem{
+			ItemNumber:  item.ItemNumber,
+			Description: item.Description,
+			Quantity:    documents.DecimalToString(item.Quantity),
+			UnitPrice:   documents.DecimalToString(item.UnitPrice),
+			Amount:      documents.DecimalToString(item.Amount),
+			TaxAmount:   documents.DecimalToString(item.TaxAmount),
+			TaxRate:     documents.DecimalToString(item.TaxRate),
+		})
+	}
+
+	return lis
+}
+
+// lineItemsToP2PProtobuf returns the line items in the p2p protobuf format.
+func lineItemsToP2PProtobuf(items []*LineItem) ([]*invoiceextpb.LineItem, error) {
+	var lis []*invoiceextpb.LineItem
+	for idx, item := range items {
+		li := &invoiceextpb.LineItem{
+			ItemNumber:  item.ItemNumber,
+			Description: item.Description,
+		}
+
+		decs := []struct {
+			name string
+			dec  *documents.Decimal
+			val  *[]byte
+		}{
+			{"quantity", item.Quantity, &li.Quantity},
+			{"unit price", item.UnitPrice, &li.UnitPrice},
+			{"amount", item.Amount, &li.Amount},
+			{"tax amount", item.TaxAmount, &li.TaxAmount},
+			{"tax rate", item.TaxRate, &li.TaxRate},
+		}
+
+		for _, d := range decs {
+			b, err := documents.DecimalToBytes(d.dec)
+			if err != nil {
+				return nil, errors.New("failed to encode %s of line item %d: %v", d.name, idx, err)
+			}
+
+			*d.val = b
+		}
+
+		lis = append(lis, li)
+	}
+
+	return lis, nil
+}
+
+// lineItemsFromP2PProtobuf loads the line items from the p2p protobuf format.
+func lineItemsFromP2PProtobuf(items []*invoiceextpb.LineItem) ([]*LineItem, error) {
+	var lis []*LineItem
+	for idx, item := range items {
+		li := &LineItem{
+			ItemNumber:  item.ItemNumber,
+			Description: item.Description,
+		}
+
+		decs := []struct {
+			name string
+			val  []byte
+			dec  **documents.Decimal
+		}{
+			{"quantity", item.Quantity, &li.Quantity},
+			{"unit price", item.UnitPrice, &li.UnitPrice},
+			{"amount", item.Amount, &li.Amount},
+			{"tax amount", item.TaxAmount, &li.TaxAmount},
+			{"tax rate", item.TaxRate, &li.TaxRate},
+		}
+
+		for _, d := range decs {
+			dec, err := documents.BytesToDecimal(d.val)
+			if err != nil {
+				return nil, errors.New("failed to decode %s of line item %d: %v", d.name, idx, err)
+			}
+
+			*d.dec = dec
+		}
+
+		lis = append(lis, li)
+	}
+
+	return lis, nil
+}
@@ -2,7 +2,9 @@ package invoice
 
 import (
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
@@ -11,7 +13,9 @@ import (
 	"github.com/centrifuge/go-centrifuge/documents"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
 	clientinvoicepb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoice"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoiceext"
 	"github.com/centrifuge/precise-proofs/proofs"
 	"github.com/centrifuge/precise-proofs/proofs/proto"
 	"github.com/ethereum/go-ethereum/common"
@@ -23,9 +27,17 @@ import (
 
 const prefix string = "invoice"
 
+// Scheme is the name of invoices in the document api paths, /documents/invoice.
+const Scheme = "invoice"
+
 // tree prefixes for specific to documents use the second byte of a 4 byte slice by convention
 func compactPrefix() []byte { return []byte{0, 1, 0, 0} }
 
+// PropertyMappings returns the readable to compact property mappings of the invoice data tree.
+func PropertyMappings() ([]documents.PropertyMapping, error) {
+	return documents.GetPropertyMappings(new(invoicepb.InvoiceData), prefix, compactPrefix(), new(invoiceextpb.InvoiceDataExtension))
+}
+
 // Invoice implements the documents.Model keeps track of invoice related fields and state
 type Invoice struct {
 	*documents.CoreDocument
@@ -41,11 +53,11 @@ type Invoice struct {
 	RecipientStreet  string
 	RecipientCity    string
 	RecipientZipcode string
-	RecipientCountry string // country ISO code of the recipient of this invoice
-	Currency         string // country ISO code of the recipient of this invoice
-	GrossAmount      int64  // invoice amount including tax
-	NetAmount        int64  // invoice amount excluding tax
-	TaxAmount        int64
+	RecipientCountry string             // country ISO code of the recipient of this invoice
+	Currency         string             // country ISO code of the recipient of this invoice
+	GrossAmount      *documents.Decimal // invoice amount including tax
+	NetAmount        *documents.Decimal // invoice amount excluding tax
+	TaxAmount        *documents.Decimal
 	TaxRate          int64
 	Recipient        *identity.DID
 	Sender           *identity.DID
@@ -54,6 +66,8 @@ type Invoice struct {
 	DueDate          *timestamp.Timestamp
 	DateCreated      *timestamp.Timestamp
 	ExtraData        []byte
+	LineItems        []*LineItem
+	UnknownFields    []byte // serialised invoice data fields of newer schemas, preserved as is
 
 	InvoiceSalts *proofs.Salts
 }
@@ -94,9 +108,9 @@ func (i *Invoice) getClientData() *clientinvoicepb.InvoiceData {
 		RecipientZipcode: i.RecipientZipcode,
 		RecipientCountry: i.RecipientCountry,
 		Currency:         i.Currency,
-		GrossAmount:      i.GrossAmount,
-		NetAmount:        i.NetAmount,
-		TaxAmount:        i.TaxAmount,
+		GrossAmount:      documents.DecimalToString(i.GrossAmount),
+		NetAmount:        documents.DecimalToString(i.NetAmount),
+		TaxAmount:        documents.DecimalToString(i.TaxAmount),
 		TaxRate:          i.TaxRate,
 		Recipient:        recipient,
 		Sender:           sender,
@@ -105,12 +119,46 @@ func (i *Invoice) getClientData() *clientinvoicepb.InvoiceData {
 		DueDate:          i.DueDate,
 		DateCreated:      i.DateCreated,
 		ExtraData:        extraData,
+		LineItems:        lineItemsToClientData(i.LineItems),
 	}
 
 }
 
-// createP2PProtobuf returns centrifuge protobuf specific invoiceData
-func (i *Invoice) createP2PProtobuf() *invoicepb.InvoiceData {
+// createP2PProtobuf returns centrifuge protobuf specific invoiceData and the extension of the invoice data.
+// The extension is serialised into the unknown fields of the invoice data, ahead of the fields of newer schemas.
+func (i *Invoice) createP2PProtobuf() (*invoicepb.InvoiceData, *invoiceextpb.InvoiceDataExtension, error) {
+	grossAmount, err := documents.DecimalToBytes(i.GrossAmount)
+	if err != nil {
+		return nil, nil, errors.New("failed to encode gross amount: %v", err)
+	}
+
+	netAmount, err := documents.DecimalToBytes(i.NetAmount)
+	if err != nil {
+		return nil, nil, errors.New("failed to encode net amount: %v", err)
+	}
+
+	taxAmount, err := documents.DecimalToBytes(i.TaxAmount)
+	if err != nil {
+		return nil, nil, errors.New("failed to encode tax amount: %v", err)
+	}
+
+	lineItems, err := lineItemsToP2PProtobuf(i.LineItems)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ext := &invoiceextpb.InvoiceDataExtension{
+		GrossAmountDecimal: grossAmount,
+		NetAmountDecimal:   netAmount,
+		TaxAmountDecimal:   taxAmount,
+		LineItems:          lineItems,
+	}
+
+	extData, err := proto.Marshal(ext)
+	if err != nil {
+		return nil, nil, errors.New("failed to serialise the invoice data extension: %v", err)
+	}
+
 	var recipient, sender, payee []byte
 	if i.Recipient != nil {
 		recipient = i.Recipient[:]
@@ -138,9 +186,6 @@ func (i *Invoice) createP2PProtobuf() *invoicepb.InvoiceData {
 		RecipientZipcode: i.RecipientZipcode,
 		RecipientCountry: i.RecipientCountry,
 		Currency:         i.Currency,
-		GrossAmount:      i.GrossAmount,
-		NetAmount:        i.NetAmount,
-		TaxAmount:        i.TaxAmount,
 		TaxRate:          i.TaxRate,
 		Recipient:        recipient,
 		Sender:           sender,
@@ -149,7 +194,8 @@ func (i *Invoice) createP2PProtobuf() *invoicepb.InvoiceData {
 		DueDate:          i.DueDate,
 		DateCreated:      i.DateCreated,
 		ExtraData:        i.ExtraData,
-	}
+		XXX_unrecognized: append(extData, i.UnknownFields...),
+	}, ext, nil
 
 }
 
@@ -160,7 +206,7 @@ func (i *Invoice) InitInvoiceInput(payload *clientinvoicepb.InvoiceCreatePayload
 		return err
 	}
 
-	collaborators := append([]string{self}, payload.Collaborators...)
+	collaborators := documents.NewCollaboratorsAccess(append([]string{self}, payload.Collaborators...), payload.ReadAccess, payload.WriteAccess)
 	cd, err := documents.NewCoreDocumentWithCollaborators(collaborators, compactPrefix())
 	if err != nil {
 		return errors.New("failed to init core document: %v", err)
@@ -185,14 +231,32 @@ func (i *Invoice) initInvoiceFromData(data *clientinvoicepb.InvoiceData) error {
 	i.RecipientZipcode = data.RecipientZipcode
 	i.RecipientCountry = data.RecipientCountry
 	i.Currency = data.Currency
-	i.GrossAmount = data.GrossAmount
-	i.NetAmount = data.NetAmount
-	i.TaxAmount = data.TaxAmount
 	i.TaxRate = data.TaxRate
 	i.Comment = data.Comment
 	i.DueDate = data.DueDate
 	i.DateCreated = data.DateCreated
 
+	var err error
+	i.GrossAmount, err = documents.StringToDecimal(data.GrossAmount)
+	if err != nil {
+		return errors.NewTypedError(err, errors.New("failed to parse gross amount"))
+	}
+
+	i.NetAmount, err = documents.StringToDecimal(data.NetAmount)
+	if err != nil {
+		return errors.NewTypedError(err, errors.New("failed to parse net amount"))
+	}
+
+	i.TaxAmount, err = documents.StringToDecimal(data.TaxAmount)
+	if err != nil {
+		return errors.NewTypedError(err, errors.New("failed to parse tax amount"))
+	}
+
+	i.LineItems, err = lineItemsFromClientData(data.LineItems)
+	if err != nil {
+		return err
+	}
+
 	if data.Recipient != "" {
 		if recipient, err := identity.NewDIDFromString(data.Recipient); err == nil {
 			i.Recipient = &recipient
@@ -224,7 +288,34 @@ func (i *Invoice) initInvoiceFromData(data *clientinvoicepb.InvoiceData) error {
 }
 
 // loadFromP2PProtobuf  loads the invoice from centrifuge protobuf invoice data
-func (i *Invoice) loadFromP2PProtobuf(invoiceData *invoicepb.InvoiceData) {
+// The extension of the invoice data is read from its unknown fields, the remaining unknown fields are preserved as is.
+func (i *Invoice) loadFromP2PProtobuf(invoiceData *invoicepb.InvoiceData) error {
+	ext := new(invoiceextpb.InvoiceDataExtension)
+	err := proto.Unmarshal(invoiceData.XXX_unrecognized, ext)
+	if err != nil {
+		return errors.New("failed to decode the invoice data extension: %v", err)
+	}
+
+	grossAmount, err := documents.BytesToDecimal(ext.GrossAmountDecimal)
+	if err != nil {
+		return errors.New("failed to decode gross amount: %v", err)
+	}
+
+	netAmount, err := documents.BytesToDecimal(ext.NetAmountDecimal)
+	if err != nil {
+		return errors.New("failed to decode net amount: %v", err)
+	}
+
+	taxAmount, err := documents.BytesToDecimal(ext.TaxAmountDecimal)
+	if err != nil {
+		return errors.New("failed to decode tax amount: %v", err)
+	}
+
+	lineItems, err := lineItemsFromP2PProtobuf(ext.LineItems)
+	if err != nil {
+		return err
+	}
+
 	i.InvoiceNumber = invoiceData.InvoiceNumber
 	i.InvoiceStatus = invoiceData.InvoiceStatus
 	i.SenderName = invoiceData.SenderName
@@ -238,9 +329,9 @@ func (i *Invoice) loadFromP2PProtobuf(invoiceData *invoicepb.InvoiceData) {
 	i.RecipientZipcode = invoiceData.RecipientZipcode
 	i.RecipientCountry = invoiceData.RecipientCountry
 	i.Currency = invoiceData.Currency
-	i.GrossAmount = invoiceData.GrossAmount
-	i.NetAmount = invoiceData.NetAmount
-	i.TaxAmount = invoiceData.TaxAmount
+	i.GrossAmount = grossAmount
+	i.NetAmount = netAmount
+	i.TaxAmount = taxAmount
 	i.TaxRate = invoiceData.TaxRate
 
 	if invoiceData.Recipient != nil {
@@ -262,12 +353,15 @@ func (i *Invoice) loadFromP2PProtobuf(invoiceData *invoicepb.InvoiceData) {
 	i.DueDate = invoiceData.DueDate
 	i.DateCreated = invoiceData.DateCreated
 	i.ExtraData = invoiceData.ExtraData
+	i.LineItems = lineItems
+	i.UnknownFields = ext.XXX_unrecognized
+	return nil
 }
 
 // getInvoiceSalts returns the invoice salts. Initialises if not present
-func (i *Invoice) getInvoiceSalts(invoiceData *invoicepb.InvoiceData) (*proofs.Salts, error) {
+func (i *Invoice) getInvoiceSalts() (*proofs.Salts, error) {
 	if i.InvoiceSalts == nil {
-		invoiceSalts, err := documents.GenerateNewSalts(invoiceData, prefix, compactPrefix())
+		invoiceSalts, err := documents.GenerateNewSalts()
 		if err != nil {
 			return nil, errors.New("getInvoiceSalts error %v", err)
 		}
@@ -279,7 +373,11 @@ func (i *Invoice) getInvoiceSalts(invoiceData *invoicepb.InvoiceData) (*proofs.S
 
 // PackCoreDocument packs the Invoice into a CoreDocument.
 func (i *Invoice) PackCoreDocument() (cd coredocumentpb.CoreDocument, err error) {
-	invData := i.createP2PProtobuf()
+	invData, _, err := i.createP2PProtobuf()
+	if err != nil {
+		return cd, err
+	}
+
 	data, err := proto.Marshal(invData)
 	if err != nil {
 		return cd, errors.New("couldn't serialise InvoiceData: %v", err)
@@ -290,12 +388,12 @@ func (i *Invoice) PackCoreDocument() (cd coredocumentpb.CoreDocument, err error)
 		Value:   data,
 	}
 
-	salts, err := i.getInvoiceSalts(invData)
+	salts, err := i.getInvoiceSalts()
 	if err != nil {
 		return cd, errors.New("couldn't get InvoiceSalts: %v", err)
 	}
 
-	return i.CoreDocument.PackCoreDocument(embedData, documents.ConvertToProtoSalts(salts)), nil
+	return i.CoreDocument.PackCoreDocument(embedData, documents.ConvertToProtoSalts(salts))
 }
 
 // UnpackCoreDocument unpacks the core document into Invoice.
@@ -311,9 +409,14 @@ func (i *Invoice) UnpackCoreDocument(cd coredocumentpb.CoreDocument) error {
 		return err
 	}
 
-	i.loadFromP2PProtobuf(invoiceData)
+	err = i.loadFromP2PProtobuf(invoiceData)
+	if err != nil {
+		return err
+	}
+
+	documents.WarnUnknownFields(i.DocumentType(), "unpack", i.UnknownFields)
 	if cd.EmbeddedDataSalts == nil {
-		i.InvoiceSalts, err = i.getInvoiceSalts(invoiceData)
+		i.InvoiceSalts, err = i.getInvoiceSalts()
 		if err != nil {
 			return err
 		}
@@ -321,8 +424,8 @@ func (i *Invoice) UnpackCoreDocument(cd coredocumentpb.CoreDocument) error {
 		i.InvoiceSalts = documents.ConvertToProofSalts(cd.EmbeddedDataSalts)
 	}
 
-	i.CoreDocument = documents.NewCoreDocumentFromProtobuf(cd)
-	return nil
+	i.CoreDocument, err = documents.NewCoreDocumentFromProtobuf(cd)
+	return err
 }
 
 // JSON marshals Invoice into a json bytes
@@ -354,8 +457,12 @@ func (i *Invoice) CalculateDataRoot() ([]byte, error) {
 
 // getDocumentDataTree creates precise-proofs data tree for the model
 func (i *Invoice) getDocumentDataTree() (tree *proofs.DocumentTree, err error) {
-	invProto := i.createP2PProtobuf()
-	salts, err := i.getInvoiceSalts(invProto)
+	invProto, ext, err := i.createP2PProtobuf()
+	if err != nil {
+		return nil, err
+	}
+
+	salts, err := i.getInvoiceSalts()
 	if err != nil {
 		return nil, err
 	}
@@ -364,6 +471,11 @@ func (i *Invoice) getDocumentDataTree() (tree *proofs.DocumentTree, err error) {
 	if err != nil {
 		return nil, errors.New("getDocumentDataTree error %v", err)
 	}
+
+	err = t.AddLeavesFromDocument(ext)
+	if err != nil {
+		return nil, errors.New("getDocumentDataTree error %v", err)
+	}
 	err = t.Generate()
 	if err != nil {
 		return nil, errors.New("getDocumentDataTree error %v", err)
@@ -386,19 +498,38 @@ func (*Invoice) DocumentType() string {
 	return documenttypes.InvoiceDataTypeUrl
 }
 
+// DataTreePrefix returns the readable and compact prefix of the invoice data tree.
+func (*Invoice) DataTreePrefix() (string, []byte) {
+	return prefix, compactPrefix()
+}
+
+// DataTreeLeaves returns the number of leaves of the data tree of the invoice.
+func (i *Invoice) DataTreeLeaves() (int, error) {
+	t, err := i.getDocumentDataTree()
+	if err != nil {
+		return 0, err
+	}
+
+	return len(t.PropertyOrder()), nil
+}
+
 // PrepareNewVersion prepares new version from the old invoice.
-func (i *Invoice) PrepareNewVersion(old documents.Model, data *clientinvoicepb.InvoiceData, collaborators []string) error {
+func (i *Invoice) PrepareNewVersion(old documents.Model, data *clientinvoicepb.InvoiceData, collaborators documents.CollaboratorsAccess) error {
 	err := i.initInvoiceFromData(data)
 	if err != nil {
 		return err
 	}
 
-	oldCD := old.(*Invoice).CoreDocument
-	i.CoreDocument, err = oldCD.PrepareNewVersion(collaborators, true, compactPrefix())
+	oldInv := old.(*Invoice)
+	i.CoreDocument, err = oldInv.CoreDocument.PrepareNewVersion(collaborators, true, compactPrefix())
 	if err != nil {
 		return err
 	}
 
+	// the client data can't hold the fields of newer schemas, carry them over from the old version
+	i.UnknownFields = oldInv.UnknownFields
+	documents.WarnUnknownFields(i.DocumentType(), "new version", i.UnknownFields)
+
 	return nil
 }
 
@@ -413,6 +544,83 @@ func (i *Invoice) AddNFT(grantReadAccess bool, registry common.Address, tokenID
 	return nil
 }
 
+// LinkDocument links the anchored document root of another document to the Invoice.
+func (i *Invoice) LinkDocument(documentID, documentRoot []byte) error {
+	cd, err := i.CoreDocument.AddLink(documentID, documentRoot)
+	if err != nil {
+		return err
+	}
+
+	i.CoreDocument = cd
+	return nil
+}
+
+// AddAttributeSignature adds the signature of a third party over one of the attributes to the Invoice.
+func (i *Invoice) AddAttributeSignature(sig *coredocumentextpb.AttributeSignature) error {
+	cd, err := i.CoreDocument.AddAttributeSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	i.CoreDocument = cd
+	return nil
+}
+
+// AddTransferDetail adds the detail of a transfer against the Invoice, replacing an earlier detail of the transfer.
+func (i *Invoice) AddTransferDetail(detail *coredocumentextpb.TransferDetail) error {
+	cd, err := i.CoreDocument.AddTransferDetail(detail)
+	if err != nil {
+		return err
+	}
+
+	i.CoreDocument = cd
+	return nil
+}
+
+// AddAttachment adds the attachment to the Invoice, replacing an earlier attachment with the same name.
+func (i *Invoice) AddAttachment(attachment *coredocumentextpb.Attachment) error {
+	cd, err := i.CoreDocument.AddAttachment(attachment)
+	if err != nil {
+		return err
+	}
+
+	i.CoreDocument = cd
+	return nil
+}
+
+// UpdateTags adds and removes the tags of the Invoice on behalf of the account.
+func (i *Invoice) UpdateTags(account identity.DID, add, remove []string) error {
+	cd, err := i.CoreDocument.UpdateTags(account, i.DocumentType(), add, remove)
+	if err != nil {
+		return err
+	}
+
+	i.CoreDocument = cd
+	return nil
+}
+
+// Cancel cancels the Invoice, optionally superseded by the document with supersededBy.
+func (i *Invoice) Cancel(supersededBy []byte) error {
+	cd, err := i.CoreDocument.Cancel(supersededBy)
+	if err != nil {
+		return err
+	}
+
+	i.CoreDocument = cd
+	return nil
+}
+
+// RemoveCollaborators removes the collaborators from the read and transition rules of the next version of the Invoice.
+func (i *Invoice) RemoveCollaborators(collaborators []identity.DID) error {
+	cd, err := i.CoreDocument.RemoveCollaborators(collaborators)
+	if err != nil {
+		return err
+	}
+
+	i.CoreDocument = cd
+	return nil
+}
+
 // CalculateSigningRoot calculates the signing root of the document.
 func (i *Invoice) CalculateSigningRoot() ([]byte, error) {
 	return i.CoreDocument.CalculateSigningRoot(i.DocumentType())
@@ -431,31 +639,38 @@ func (i *Invoice) CreateNFTProofs(
 
 // CollaboratorCanUpdate checks if the collaborator can update the document.
 func (i *Invoice) CollaboratorCanUpdate(updated documents.Model, collaborator identity.DID) error {
+	cf, err := i.ChangedFields(updated)
+	if err != nil {
+		return err
+	}
+
+	rules := i.CoreDocument.TransitionRulesFor(collaborator)
+	return documents.ValidateTransitions(rules, cf)
+}
+
+// ChangedFields returns the core document and invoice fields changed in the updated invoice.
+func (i *Invoice) ChangedFields(updated documents.Model) ([]documents.ChangedField, error) {
 	newInv, ok := updated.(*Invoice)
 	if !ok {
-		return errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("expecting an invoice but got %T", updated))
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("expecting an invoice but got %T", updated))
 	}
 
-	// check the core document changes
-	err := i.CoreDocument.CollaboratorCanUpdate(newInv.CoreDocument, collaborator, i.DocumentType())
+	cf, err := i.CoreDocument.GetChangedFields(newInv.CoreDocument, i.DocumentType())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// check invoice specific changes
 	oldTree, err := i.getDocumentDataTree()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	newTree, err := newInv.getDocumentDataTree()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	rules := i.CoreDocument.TransitionRulesFor(collaborator)
-	cf := documents.GetChangedFields(oldTree, newTree, proofs.DefaultSaltsLengthSuffix)
-	return documents.ValidateTransitions(rules, cf)
+	return append(cf, documents.GetChangedFields(oldTree, newTree, proofs.DefaultSaltsLengthSuffix)...), nil
 }
 
 // AddUpdateLog adds a log to the model to persist an update related meta data such as author
@@ -472,3 +687,38 @@ func (i *Invoice) Author() identity.DID {
 func (i *Invoice) Timestamp() (time.Time, error) {
 	return i.CoreDocument.Timestamp()
 }
+
+// SummaryStatus returns the status of the invoice.
+func (i *Invoice) SummaryStatus() string {
+	return i.InvoiceStatus
+}
+
+// SummaryFields returns the key fields of the invoice.
+func (i *Invoice) SummaryFields() (fields []documents.SummaryField) {
+	fields = documents.AppendSummaryField(fields, "Invoice Number", i.InvoiceNumber)
+	fields = documents.AppendSummaryField(fields, "Sender", i.SenderName)
+	fields = documents.AppendSummaryField(fields, "Recipient", i.RecipientName)
+	fields = documents.AppendSummaryField(fields, "Gross Amount", strings.TrimSpace(fmt.Sprintf("%s %s", documents.DecimalToString(i.GrossAmount), i.Currency)))
+	fields = documents.AppendSummaryField(fields, "Due Date", documents.SummaryDate(i.DueDate))
+	return fields
+}
+
+// LedgerAmount returns the gross amount of the invoice.
+func (i *Invoice) LedgerAmount() documents.LedgerAmount {
+	return documents.LedgerAmount{
+		Number:   i.InvoiceNumber,
+		Currency: i.Currency,
+		Amount:   documents.DecimalToString(i.GrossAmount),
+	}
+}
+
+// SearchAttributes returns the searchable fields of the invoice.
+func (i *Invoice) SearchAttributes() map[string]string {
+	attrs := make(map[string]string)
+	documents.SetSearchAttribute(attrs, "invoice_number", i.InvoiceNumber)
+	documents.SetSearchAttribute(attrs, "invoice_status", i.InvoiceStatus)
+	documents.SetSearchAttribute(attrs, "sender_name", i.SenderName)
+	documents.SetSearchAttribute(attrs, "recipient_name", i.RecipientName)
+	documents.SetSearchAttribute(attrs, "currency", i.Currency)
+	return attrs
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/invoice"
 	"github.com/centrifuge/go-centrifuge/anchors"
 	"github.com/centrifuge/go-centrifuge/bootstrap"
 	"github.com/centrifuge/go-centrifuge/bootstrap/bootstrappers/testlogging"
@@ -27,6 +29,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/nft"
 	"github.com/centrifuge/go-centrifuge/p2p"
 	clientinvoicepb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoice"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoiceext"
 	"github.com/centrifuge/go-centrifuge/queue"
 	"github.com/centrifuge/go-centrifuge/storage/leveldb"
 	"github.com/centrifuge/go-centrifuge/testingutils/commons"
@@ -37,6 +40,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/transactions"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -93,6 +97,47 @@ func TestInvoice_PackCoreDocument(t *testing.T) {
 	assert.NotNil(t, cd.EmbeddedDataSalts)
 }
 
+func TestInvoice_UnknownFields(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(ctx)
+	assert.NoError(t, err)
+	inv := new(Invoice)
+	assert.NoError(t, inv.InitInvoiceInput(testingdocuments.CreateInvoicePayload(), did.String()))
+	cd, err := inv.PackCoreDocument()
+	assert.NoError(t, err)
+
+	// field 1000 of a newer schema
+	unknown := append(proto.EncodeVarint(1000<<3|2), proto.EncodeVarint(9)...)
+	unknown = append(unknown, []byte("new field")...)
+	cd.EmbeddedData.Value = append(cd.EmbeddedData.Value, unknown...)
+	inv = new(Invoice)
+	assert.NoError(t, inv.UnpackCoreDocument(cd))
+	assert.Equal(t, unknown, inv.UnknownFields)
+
+	// preserved in storage
+	data, err := inv.JSON()
+	assert.NoError(t, err)
+	inv = new(Invoice)
+	assert.NoError(t, inv.FromJSON(data))
+	assert.Equal(t, unknown, inv.UnknownFields)
+
+	// preserved when packed again
+	cd, err = inv.PackCoreDocument()
+	assert.NoError(t, err)
+	invData := new(invoicepb.InvoiceData)
+	assert.NoError(t, proto.Unmarshal(cd.EmbeddedData.Value, invData))
+	ext := new(invoiceextpb.InvoiceDataExtension)
+	assert.NoError(t, proto.Unmarshal(invData.XXX_unrecognized, ext))
+	assert.Equal(t, unknown, ext.XXX_unrecognized)
+	assert.NotEmpty(t, ext.GrossAmountDecimal)
+
+	// carried over to new versions
+	assert.NoError(t, documents.CalculateDraftRoots(inv))
+	ninv := new(Invoice)
+	assert.NoError(t, ninv.PrepareNewVersion(inv, testingdocuments.CreateInvoicePayload().Data, documents.CollaboratorsAccess{}))
+	assert.Equal(t, unknown, ninv.UnknownFields)
+}
+
 func TestInvoice_JSON(t *testing.T) {
 	inv := new(Invoice)
 	ctx := testingconfig.CreateAccountContext(t, cfg)
@@ -149,11 +194,11 @@ func TestInvoiceModel_UnpackCoreDocument(t *testing.T) {
 func TestInvoiceModel_getClientData(t *testing.T) {
 	invData := testingdocuments.CreateInvoiceData()
 	inv := new(Invoice)
-	inv.loadFromP2PProtobuf(&invData)
+	assert.NoError(t, inv.loadFromP2PProtobuf(&invData))
 
 	data := inv.getClientData()
 	assert.NotNil(t, data, "invoice data should not be nil")
-	assert.Equal(t, data.GrossAmount, data.GrossAmount, "gross amount must match")
+	assert.Equal(t, "42", data.GrossAmount, "gross amount must match")
 	assert.Equal(t, data.Recipient, hexutil.Encode(inv.Recipient[:]), "recipient should match")
 	assert.Equal(t, data.Sender, hexutil.Encode(inv.Sender[:]), "sender should match")
 	assert.Equal(t, data.Payee, hexutil.Encode(inv.Payee[:]), "payee should match")
@@ -224,6 +269,16 @@ func TestInvoiceModel_InitInvoiceInput(t *testing.T) {
 	assert.Equal(t, inv.Payee[:], payeeDID[:])
 	assert.Equal(t, inv.Recipient[:], recipientDID[:])
 	assert.Equal(t, inv.ExtraData[:], []byte{1, 2, 3, 2, 3, 1})
+
+	// collab1 can only read the invoice
+	err = inv.InitInvoiceInput(&clientinvoicepb.InvoiceCreatePayload{Data: data, ReadAccess: []string{collab1.String()}, WriteAccess: []string{collab2.String()}}, did.String())
+	assert.NoError(t, err)
+	cs, err := inv.GetSignerCollaborators()
+	assert.NoError(t, err)
+	assert.Equal(t, []identity.DID{did, collab2}, cs)
+	cs, err = inv.GetCollaborators(did, collab2)
+	assert.NoError(t, err)
+	assert.Equal(t, []identity.DID{collab1}, cs)
 }
 
 func TestInvoiceModel_calculateDataRoot(t *testing.T) {
@@ -299,7 +354,7 @@ func TestInvoice_CreateNFTProofs(t *testing.T) {
 	signerId := hexutil.Encode(append(defaultDID[:], keys[identity.KeyPurposeSigning.Name].PublicKey...))
 	signingRoot := fmt.Sprintf("%s.%s", documents.DRTreePrefix, documents.SigningRootField)
 	signatureSender := fmt.Sprintf("%s.signatures[%s].signature", documents.SignaturesTreePrefix, signerId)
-	proofFields := []string{"invoice.gross_amount", "invoice.currency", "invoice.due_date", "invoice.sender", "invoice.invoice_status", signingRoot, signatureSender, documents.CDTreePrefix + ".next_version"}
+	proofFields := []string{"invoice.gross_amount_decimal", "invoice.currency", "invoice.due_date", "invoice.sender", "invoice.invoice_status", signingRoot, signatureSender, documents.CDTreePrefix + ".next_version"}
 	proof, err := i.CreateProofs(proofFields)
 	assert.Nil(t, err)
 	assert.NotNil(t, proof)
@@ -340,7 +395,9 @@ func TestInvoiceModel_GetDocumentID(t *testing.T) {
 }
 
 func TestInvoiceModel_getDocumentDataTree(t *testing.T) {
-	i := Invoice{InvoiceNumber: "3213121", NetAmount: 2, GrossAmount: 2}
+	amount, err := documents.NewDecimal("2")
+	assert.NoError(t, err)
+	i := Invoice{InvoiceNumber: "3213121", NetAmount: amount, GrossAmount: amount}
 	tree, err := i.getDocumentDataTree()
 	assert.Nil(t, err, "tree should be generated without error")
 	_, leaf := tree.GetLeafByProperty("invoice.invoice_number")
@@ -348,6 +405,59 @@ func TestInvoiceModel_getDocumentDataTree(t *testing.T) {
 	assert.Equal(t, "invoice.invoice_number", leaf.Property.ReadableName())
 }
 
+func TestInvoice_LineItems(t *testing.T) {
+	payload := testingdocuments.CreateInvoicePayload()
+	payload.Data.LineItems = []*clientinvoicepb.LineItem{
+		{ItemNumber: "1", Description: "consulting", Quantity: "2.5", UnitPrice: "100", Amount: "250", TaxRate: "19", TaxAmount: "47.5"},
+		{ItemNumber: "2", Description: "travel", Quantity: "1", UnitPrice: "80.10", Amount: "80.10"},
+	}
+
+	// invalid decimal
+	payload.Data.LineItems[1].Quantity = "one"
+	i := new(Invoice)
+	err := i.InitInvoiceInput(payload, defaultDID.String())
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrInvalidDecimal, err))
+
+	payload.Data.LineItems[1].Quantity = "1"
+	assert.NoError(t, i.InitInvoiceInput(payload, defaultDID.String()))
+	assert.Len(t, i.LineItems, 2)
+	assert.Equal(t, "250", i.LineItems[0].Amount.String())
+	assert.Nil(t, i.LineItems[1].TaxAmount)
+	assert.Equal(t, payload.Data.LineItems[0], i.getClientData().LineItems[0])
+	assert.Equal(t, "80.1", i.getClientData().LineItems[1].UnitPrice)
+
+	// pack and unpack
+	cd, err := i.PackCoreDocument()
+	assert.NoError(t, err)
+	ni := new(Invoice)
+	assert.NoError(t, ni.UnpackCoreDocument(cd))
+	assert.Equal(t, i.getClientData(), ni.getClientData())
+
+	// prove the amount of a line item
+	_, err = i.CalculateDataRoot()
+	assert.NoError(t, err)
+	_, err = i.CalculateSigningRoot()
+	assert.NoError(t, err)
+	_, err = i.CalculateDocumentRoot()
+	assert.NoError(t, err)
+	proof, err := i.CreateProofs([]string{"invoice.line_items[0].amount", "invoice.line_items[1].description"})
+	assert.NoError(t, err)
+	assert.Len(t, proof, 2)
+	tree, err := i.CoreDocument.DocumentRootTree()
+	assert.NoError(t, err)
+	for _, p := range proof {
+		valid, err := tree.ValidateProof(p)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+	assert.Equal(t, testingdocuments.DecimalBytes("250"), proof[0].Value)
+
+	// line item does not exist
+	_, err = i.CreateProofs([]string{"invoice.line_items[2].amount"})
+	assert.Error(t, err)
+}
+
 func createInvoice(t *testing.T) *Invoice {
 	i := new(Invoice)
 	err := i.InitInvoiceInput(testingdocuments.CreateInvoicePayload(), defaultDID.String())
@@ -378,8 +488,8 @@ func TestInvoice_CollaboratorCanUpdate(t *testing.T) {
 	assert.NoError(t, err)
 	oldInv := model.(*Invoice)
 	data := oldInv.getClientData()
-	data.GrossAmount = 50
-	err = inv.PrepareNewVersion(inv, data, []string{id3.String()})
+	data.GrossAmount = "50"
+	err = inv.PrepareNewVersion(inv, data, documents.CollaboratorsAccess{ReadWriteCollaborators: []string{id3.String()}})
 	assert.NoError(t, err)
 
 	// id1 should have permission
@@ -390,7 +500,7 @@ func TestInvoice_CollaboratorCanUpdate(t *testing.T) {
 
 	// update the id3 rules to update only gross amount
 	inv.CoreDocument.Document.TransitionRules[3].MatchType = coredocumentpb.FieldMatchType_FIELD_MATCH_TYPE_EXACT
-	inv.CoreDocument.Document.TransitionRules[3].Field = append(compactPrefix(), 0, 0, 0, 14)
+	inv.CoreDocument.Document.TransitionRules[3].Field = append(compactPrefix(), 0, 0, 0, 25)
 	inv.CoreDocument.Document.DocumentRoot = utils.RandomSlice(32)
 	assert.NoError(t, testRepo().Create(id1[:], inv.CurrentVersion(), inv))
 
@@ -399,9 +509,9 @@ func TestInvoice_CollaboratorCanUpdate(t *testing.T) {
 	assert.NoError(t, err)
 	oldInv = model.(*Invoice)
 	data = oldInv.getClientData()
-	data.GrossAmount = 55
+	data.GrossAmount = "55"
 	data.Currency = "INR"
-	err = inv.PrepareNewVersion(inv, data, nil)
+	err = inv.PrepareNewVersion(inv, data, documents.CollaboratorsAccess{})
 	assert.NoError(t, err)
 
 	// id1 should have permission
@@ -416,3 +526,105 @@ func TestInvoice_CollaboratorCanUpdate(t *testing.T) {
 	assert.Equal(t, 1, errors.Len(err))
 	assert.Contains(t, err.Error(), "invoice.currency")
 }
+
+func TestInvoice_ChangedFields(t *testing.T) {
+	inv := createInvoice(t)
+
+	// wrong type
+	_, err := inv.ChangedFields(new(mockModel))
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalidType, err))
+
+	// same version
+	cf, err := inv.ChangedFields(inv)
+	assert.NoError(t, err)
+	assert.Empty(t, cf)
+
+	data := inv.getClientData()
+	data.Currency = "INR"
+	newInv := new(Invoice)
+	assert.NoError(t, newInv.PrepareNewVersion(inv, data, documents.CollaboratorsAccess{}))
+	cf, err = inv.ChangedFields(newInv)
+	assert.NoError(t, err)
+	changes := make(map[string]documents.ChangedField)
+	for _, f := range cf {
+		changes[f.Name] = f
+	}
+
+	// core document and invoice fields are listed
+	assert.Contains(t, changes, "cd_tree.current_version")
+	assert.Equal(t, []byte("INR"), changes["invoice.currency"].New)
+	assert.Equal(t, []byte(inv.Currency), changes["invoice.currency"].Old)
+}
+
+func TestPropertyMappings(t *testing.T) {
+	mappings, err := PropertyMappings()
+	assert.NoError(t, err)
+	names := make(map[string]string)
+	for _, m := range mappings {
+		names[m.ReadableName] = m.CompactName
+	}
+
+	// every leaf of the data tree must be described by the mappings
+	i := createInvoice(t)
+	tree, err := i.getDocumentDataTree()
+	assert.NoError(t, err)
+	for _, p := range tree.PropertyOrder() {
+		if strings.Contains(p.ReadableName(), "[") {
+			continue
+		}
+
+		assert.Equal(t, hexutil.Encode(p.CompactName()), names[p.ReadableName()], p.ReadableName())
+	}
+}
+
+func TestInvoice_Summary(t *testing.T) {
+	i := createInvoice(t)
+	i.InvoiceNumber = "INV-1"
+	i.InvoiceStatus = "unpaid"
+	i.DueDate = &timestamp.Timestamp{Seconds: 1546300800}
+	assert.Equal(t, "unpaid", i.SummaryStatus())
+	assert.Equal(t, []documents.SummaryField{
+		{Name: "Invoice Number", Value: "INV-1"},
+		{Name: "Gross Amount", Value: "42 EUR"},
+		{Name: "Due Date", Value: "2019-01-01"},
+	}, i.SummaryFields())
+
+	s, err := documents.NewSummary(i, "https://explorer/anchor/%s")
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(i.ID()), s.DocumentID)
+	assert.Equal(t, documenttypes.InvoiceDataTypeUrl, s.DocumentType)
+	assert.Equal(t, "unpaid", s.Status)
+	cs, err := i.GetCollaborators()
+	assert.NoError(t, err)
+	assert.Len(t, s.Collaborators, len(cs))
+	assert.Equal(t, "https://explorer/anchor/"+s.AnchorID, s.AnchorLink)
+
+	data, err := documents.RenderSummary(s, documents.SummaryFormatJSON)
+	assert.NoError(t, err)
+	var ds documents.Summary
+	assert.NoError(t, json.Unmarshal(data, &ds))
+	assert.Equal(t, *s, ds)
+
+	data, err = documents.RenderSummary(s, documents.SummaryFormatHTML)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "<dt>Invoice Number</dt><dd>INV-1</dd>")
+	assert.Contains(t, string(data), fmt.Sprintf(`<a href="%s">%s</a>`, s.AnchorLink, s.AnchorID))
+}
+
+func TestInvoice_LedgerAmount(t *testing.T) {
+	i := createInvoice(t)
+	i.InvoiceNumber = "INV-1"
+	assert.Equal(t, documents.LedgerAmount{Number: "INV-1", Currency: "EUR", Amount: "42"}, i.LedgerAmount())
+}
+
+func TestInvoice_SearchAttributes(t *testing.T) {
+	i := createInvoice(t)
+	i.InvoiceNumber = "INV-1"
+	i.InvoiceStatus = "unpaid"
+	assert.Equal(t, map[string]string{
+		"invoice_number": "INV-1",
+		"invoice_status": "unpaid",
+		"currency":       "EUR",
+	}, i.SearchAttributes())
+}
@@ -1,21 +1,24 @@
 package invoice
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
 	"github.com/centrifuge/go-centrifuge/contextutil"
 	"github.com/centrifuge/go-centrifuge/documents"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
 	clientinvoicepb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoice"
 	"github.com/centrifuge/go-centrifuge/queue"
 	"github.com/centrifuge/go-centrifuge/transactions"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/jsonpb"
 )
 
 // Service defines specific functions for invoice
 type Service interface {
-	documents.Service
+	documents.SchemeService
 
 	// DeriverFromPayload derives Invoice from clientPayload
 	DeriveFromCreatePayload(ctx context.Context, payload *clientinvoicepb.InvoiceCreatePayload) (documents.Model, error)
@@ -28,6 +31,15 @@ type Service interface {
 
 	// DeriveInvoiceResponse returns the invoice model in our standard client format
 	DeriveInvoiceResponse(inv documents.Model) (*clientinvoicepb.InvoiceResponse, error)
+
+	// DeriveFromUBLPayload derives invoice model from the UBL 2.1 invoice of the payload
+	DeriveFromUBLPayload(ctx context.Context, payload *clientinvoicepb.UBLImportPayload) (documents.Model, error)
+
+	// DeriveUBL returns the invoice model as a UBL 2.1 invoice
+	DeriveUBL(inv documents.Model) ([]byte, error)
+
+	// GetAttestation returns the proven status, amount and due date of the latest anchored version of the invoice
+	GetAttestation(ctx context.Context, documentID []byte) (*clientinvoicepb.InvoiceAttestation, error)
 }
 
 // service implements Service and handles all invoice related persistence and validations
@@ -37,6 +49,15 @@ type service struct {
 	repo      documents.Repository
 	queueSrv  queue.TaskQueuer
 	txManager transactions.Manager
+
+	// strictCodes requires currency and country codes to be assigned ISO codes
+	strictCodes bool
+
+	// ublAttributes maps the invoice fields without a UBL counterpart to UBL document types
+	ublAttributes map[string]string
+
+	// attestations holds the attestations renewed with each anchored or received version
+	attestations *attestationCache
 }
 
 // DefaultService returns the default implementation of the service.
@@ -45,13 +66,32 @@ func DefaultService(
 	repo documents.Repository,
 	queueSrv queue.TaskQueuer,
 	txManager transactions.Manager,
+	strictCodes bool,
+	ublAttributes map[string]string,
 ) Service {
-	return service{
-		repo:      repo,
-		queueSrv:  queueSrv,
-		txManager: txManager,
-		Service:   srv,
+	s := service{
+		repo:          repo,
+		queueSrv:      queueSrv,
+		txManager:     txManager,
+		Service:       srv,
+		strictCodes:   strictCodes,
+		ublAttributes: ublAttributes,
+		attestations:  newAttestationCache(),
 	}
+
+	// the attestations are renewed through the events of the documents
+	_ = srv.SubscribeEvents(s.renewAttestation)
+	return s
+}
+
+// PropertyMappings returns the readable to compact property mappings of the invoice data tree.
+func (s service) PropertyMappings() ([]documents.PropertyMapping, error) {
+	return PropertyMappings()
+}
+
+// ValidateFields validates the data fields of the invoice.
+func (s service) ValidateFields(model documents.Model) error {
+	return fieldValidator(s.strictCodes).Validate(nil, model)
 }
 
 // DeriveFromCoreDocument takes a core document model and returns an invoice
@@ -103,6 +143,12 @@ func (s service) validateAndPersist(ctx context.Context, old, new documents.Mode
 		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
 	}
 
+	// new versions are persisted as drafts, their roots let the next versions refer to them
+	err = documents.CalculateDraftRoots(inv)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
+	}
+
 	// we use CurrentVersion as the id since that will be unique across multiple versions of the same document
 	err = s.repo.Create(selfDID[:], inv.CurrentVersion(), inv)
 	if err != nil {
@@ -112,6 +158,37 @@ func (s service) validateAndPersist(ctx context.Context, old, new documents.Mode
 	return inv, nil
 }
 
+// CreateDraft validates and persists an invoice as a draft without anchoring it
+func (s service) CreateDraft(ctx context.Context, inv documents.Model) (documents.Model, error) {
+	err := s.RunHooks(ctx, documents.HookPreCreate, inv)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err = s.validateAndPersist(ctx, nil, inv, CreateValidator(s.strictCodes))
+	if err != nil {
+		return nil, err
+	}
+
+	s.EmitEvent(ctx, documents.NewEvent(documents.EventDocumentCreated, inv))
+	return inv, nil
+}
+
+// UpdateDraft validates and persists a new version of an invoice as a draft without anchoring it
+func (s service) UpdateDraft(ctx context.Context, new documents.Model) (documents.Model, error) {
+	old, err := s.GetCurrentVersion(ctx, new.ID())
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentNotFound, err)
+	}
+
+	return s.validateAndPersist(ctx, old, new, UpdateValidator(s.strictCodes))
+}
+
+// Commit anchors the current version of an invoice if it is a draft
+func (s service) Commit(ctx context.Context, documentID []byte) (documents.Model, transactions.TxID, chan bool, error) {
+	return documents.CommitDraft(ctx, s, s.txManager, s.queueSrv, documentID)
+}
+
 // Create takes and invoice model and does required validation checks, tries to persist to DB
 func (s service) Create(ctx context.Context, inv documents.Model) (documents.Model, transactions.TxID, chan bool, error) {
 	selfDID, err := contextutil.AccountDID(ctx)
@@ -119,7 +196,7 @@ func (s service) Create(ctx context.Context, inv documents.Model) (documents.Mod
 		return nil, transactions.NilTxID(), nil, errors.NewTypedError(documents.ErrDocumentConfigAccountID, err)
 	}
 
-	inv, err = s.validateAndPersist(ctx, nil, inv, CreateValidator())
+	inv, err = s.CreateDraft(ctx, inv)
 	if err != nil {
 		return nil, transactions.NilTxID(), nil, err
 	}
@@ -139,12 +216,7 @@ func (s service) Update(ctx context.Context, new documents.Model) (documents.Mod
 		return nil, transactions.NilTxID(), nil, errors.NewTypedError(documents.ErrDocumentConfigAccountID, err)
 	}
 
-	old, err := s.GetCurrentVersion(ctx, new.ID())
-	if err != nil {
-		return nil, transactions.NilTxID(), nil, errors.NewTypedError(documents.ErrDocumentNotFound, err)
-	}
-
-	new, err = s.validateAndPersist(ctx, old, new, UpdateValidator())
+	new, err = s.UpdateDraft(ctx, new)
 	if err != nil {
 		return nil, transactions.NilTxID(), nil, err
 	}
@@ -177,6 +249,7 @@ func (s service) DeriveInvoiceResponse(model documents.Model) (*clientinvoicepb.
 	h := &clientinvoicepb.ResponseHeader{
 		DocumentId:    hexutil.Encode(model.ID()),
 		VersionId:     hexutil.Encode(model.CurrentVersion()),
+		State:         string(model.GetStatus()),
 		Collaborators: css,
 	}
 
@@ -204,7 +277,7 @@ func (s service) DeriveFromUpdatePayload(ctx context.Context, payload *clientinv
 	}
 
 	// get latest old version of the document
-	id, err := hexutil.Decode(payload.Identifier)
+	id, err := identifiers.DecodeDocumentID(payload.Identifier)
 	if err != nil {
 		return nil, errors.NewTypedError(documents.ErrDocumentIdentifier, errors.New("failed to decode identifier: %v", err))
 	}
@@ -215,10 +288,102 @@ func (s service) DeriveFromUpdatePayload(ctx context.Context, payload *clientinv
 	}
 
 	inv := new(Invoice)
-	err = inv.PrepareNewVersion(old, payload.Data, payload.Collaborators)
+	err = inv.PrepareNewVersion(old, payload.Data, documents.NewCollaboratorsAccess(payload.Collaborators, payload.ReadAccess, payload.WriteAccess))
 	if err != nil {
 		return nil, errors.NewTypedError(documents.ErrDocumentPrepareCoreDocument, errors.New("failed to load invoice from data: %v", err))
 	}
 
 	return inv, nil
 }
+
+// DeriveFromUBLPayload derives a new invoice from the UBL 2.1 invoice of the payload
+func (s service) DeriveFromUBLPayload(ctx context.Context, payload *clientinvoicepb.UBLImportPayload) (documents.Model, error) {
+	if payload == nil || payload.Ubl == "" {
+		return nil, documents.ErrDocumentNil
+	}
+
+	data, err := fromUBL([]byte(payload.Ubl), s.ublAttributes)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentUBL, err)
+	}
+
+	return s.DeriveFromCreatePayload(ctx, &clientinvoicepb.InvoiceCreatePayload{
+		Collaborators: payload.Collaborators,
+		Data:          data,
+	})
+}
+
+// DeriveUBL returns the invoice as a UBL 2.1 invoice
+func (s service) DeriveUBL(doc documents.Model) ([]byte, error) {
+	data, err := s.DeriveInvoiceData(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	ubl, err := toUBL(data, s.ublAttributes)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentUBL, err)
+	}
+
+	return ubl, nil
+}
+
+// Scheme returns the scheme of the invoices in the document api
+func (s service) Scheme() string {
+	return Scheme
+}
+
+// DeriveFromClientData derives an invoice from the json encoded invoice data
+func (s service) DeriveFromClientData(ctx context.Context, collaborators documents.CollaboratorsAccess, data []byte) (documents.Model, error) {
+	d, err := unmarshalInvoiceData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DeriveFromCreatePayload(ctx, &clientinvoicepb.InvoiceCreatePayload{
+		Collaborators: collaborators.ReadWriteCollaborators,
+		ReadAccess:    collaborators.ReadCollaborators,
+		Data:          d,
+	})
+}
+
+// DeriveFromUpdateClientData derives the next version of the invoice from the json encoded invoice data
+func (s service) DeriveFromUpdateClientData(ctx context.Context, identifier string, collaborators documents.CollaboratorsAccess, data []byte) (documents.Model, error) {
+	d, err := unmarshalInvoiceData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DeriveFromUpdatePayload(ctx, &clientinvoicepb.InvoiceUpdatePayload{
+		Identifier:    identifier,
+		Collaborators: collaborators.ReadWriteCollaborators,
+		ReadAccess:    collaborators.ReadCollaborators,
+		Data:          d,
+	})
+}
+
+// DeriveClientData returns the json encoded invoice data of the model
+func (s service) DeriveClientData(doc documents.Model) ([]byte, error) {
+	data, err := s.DeriveInvoiceData(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = (&jsonpb.Marshaler{OrigName: true}).Marshal(&buf, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func unmarshalInvoiceData(data []byte) (*clientinvoicepb.InvoiceData, error) {
+	d := new(clientinvoicepb.InvoiceData)
+	err := jsonpb.Unmarshal(bytes.NewReader(data), d)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
+	}
+
+	return d, nil
+}
@@ -3,6 +3,7 @@
 package invoice
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -54,12 +55,12 @@ func getServiceWithMockedLayers() (testingcommons.MockIdentityService, Service)
 
 	repo := testRepo()
 	mockAnchor := &mockAnchorRepo{}
-	docSrv := documents.DefaultService(repo, mockAnchor, documents.NewServiceRegistry(), &idService)
+	docSrv := documents.DefaultService(repo, mockAnchor, documents.NewServiceRegistry(), &idService, 0, "", 0, nil, nil, nil, nil, nil)
 	return idService, DefaultService(
 		docSrv,
 		repo,
 		queueSrv,
-		ctx[transactions.BootstrappedService].(transactions.Manager))
+		ctx[transactions.BootstrappedService].(transactions.Manager), false, cfg.GetUBLAttributeMapping())
 }
 
 func TestService_Update(t *testing.T) {
@@ -85,7 +86,7 @@ func TestService_Update(t *testing.T) {
 	// success
 	data, err := invSrv.DeriveInvoiceData(model)
 	assert.Nil(t, err)
-	data.GrossAmount = 100
+	data.GrossAmount = "100"
 	data.ExtraData = hexutil.Encode(utils.RandomSlice(32))
 	collab := testingidentity.GenerateRandomDID().String()
 	newInv, err := invSrv.DeriveFromUpdatePayload(ctxh, &clientinvoicepb.InvoiceUpdatePayload{
@@ -149,7 +150,7 @@ func TestService_DeriveFromUpdatePayload(t *testing.T) {
 		Sender:      "0xed03fa80291ff5ddc284de6b51e716b130b05e20",
 		Recipient:   "0xea939d5c0494b072c51565b191ee59b5d34fbf79",
 		Payee:       "0x087d8ca6a16e6ce8d9ff55672e551a2828ab8e8c",
-		GrossAmount: 42,
+		GrossAmount: "42",
 		ExtraData:   "some data",
 		Currency:    "EUR",
 	}
@@ -231,7 +232,7 @@ func TestService_DeriveFromCoreDocument(t *testing.T) {
 	inv, ok := m.(*Invoice)
 	assert.True(t, ok, "must be true")
 	assert.Equal(t, inv.Recipient.String(), "0xEA939D5C0494b072c51565b191eE59B5D34fbf79")
-	assert.Equal(t, inv.GrossAmount, int64(42))
+	assert.Equal(t, "42", inv.GrossAmount.String())
 }
 
 func TestService_Create(t *testing.T) {
@@ -245,13 +246,81 @@ func TestService_Create(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "unknown document type")
 
-	// success
+	// pre-create hook fails
 	inv, err := invSrv.DeriveFromCreatePayload(ctxh, testingdocuments.CreateInvoicePayload())
 	assert.Nil(t, err)
+	hookErr := errors.New("numbering failed")
+	var failHook bool
+	err = invSrv.RegisterHook(documents.HookPreCreate, func(ctx context.Context, model documents.Model) error {
+		if failHook {
+			return hookErr
+		}
+
+		model.(*Invoice).InvoiceNumber = "INV-0001"
+		return nil
+	})
+	assert.NoError(t, err)
+	failHook = true
+	m, _, _, err = invSrv.Create(ctxh, inv)
+	assert.Nil(t, m)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentHook, err))
+	assert.False(t, testRepo().Exists(accountID, inv.CurrentVersion()))
+
+	// success
+	failHook = false
 	m, _, _, err = invSrv.Create(ctxh, inv)
 	assert.Nil(t, err)
 	assert.True(t, testRepo().Exists(accountID, m.ID()))
 	assert.True(t, testRepo().Exists(accountID, m.CurrentVersion()))
+	assert.Equal(t, "INV-0001", m.(*Invoice).InvoiceNumber)
+}
+
+func TestService_CreateDraft_Commit(t *testing.T) {
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	_, srv := getServiceWithMockedLayers()
+	invSrv := srv.(service)
+
+	// missing document
+	_, _, _, err := invSrv.Commit(ctxh, utils.RandomSlice(32))
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentNotFound, err))
+
+	// draft is persisted without anchoring
+	inv, err := invSrv.DeriveFromCreatePayload(ctxh, testingdocuments.CreateInvoicePayload())
+	assert.NoError(t, err)
+	m, err := invSrv.CreateDraft(ctxh, inv)
+	assert.NoError(t, err)
+	assert.Equal(t, documents.StatusDraft, m.GetStatus())
+	assert.True(t, testRepo().Exists(accountID, m.CurrentVersion()))
+
+	// draft is edited as a new draft version
+	data, err := invSrv.DeriveInvoiceData(m)
+	assert.NoError(t, err)
+	data.GrossAmount = "200"
+	newInv, err := invSrv.DeriveFromUpdatePayload(ctxh, &clientinvoicepb.InvoiceUpdatePayload{
+		Identifier: hexutil.Encode(m.ID()),
+		Data:       data,
+	})
+	assert.NoError(t, err)
+	m, err = invSrv.UpdateDraft(ctxh, newInv)
+	assert.NoError(t, err)
+	assert.Equal(t, documents.StatusDraft, m.GetStatus())
+	assert.True(t, testRepo().Exists(accountID, m.CurrentVersion()))
+	assert.True(t, testRepo().Exists(accountID, m.PreviousVersion()))
+
+	// commit anchors the current version
+	cm, _, done, err := invSrv.Commit(ctxh, m.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, m.CurrentVersion(), cm.CurrentVersion())
+	assert.NotNil(t, done)
+
+	// committed versions can't be committed again
+	cm.SetStatus(documents.StatusCommitted)
+	assert.NoError(t, testRepo().Update(accountID, cm.CurrentVersion(), cm))
+	_, _, _, err = invSrv.Commit(ctxh, m.ID())
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentNotDraft, err))
 }
 
 func TestService_DeriveInvoiceData(t *testing.T) {
@@ -291,6 +360,50 @@ func TestService_DeriveInvoiceResponse(t *testing.T) {
 	assert.Contains(t, r.Header.Collaborators, cid.String())
 }
 
+func TestService_DeriveUBL_DeriveFromUBLPayload(t *testing.T) {
+	invSrv := service{ublAttributes: testUBLAttributes}
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+
+	// some random model
+	_, err := invSrv.DeriveUBL(&mockModel{})
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalidType, err))
+
+	// empty payload
+	m, err := invSrv.DeriveFromUBLPayload(ctxh, &clientinvoicepb.UBLImportPayload{})
+	assert.Nil(t, m)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentNil, err))
+
+	// invalid UBL invoice
+	m, err = invSrv.DeriveFromUBLPayload(ctxh, &clientinvoicepb.UBLImportPayload{Ubl: "<Invoice>"})
+	assert.Nil(t, m)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentUBL, err))
+
+	// success
+	payload := &clientinvoicepb.InvoiceCreatePayload{Data: ublTestData(t)}
+	inv, err := invSrv.DeriveFromCreatePayload(ctxh, payload)
+	assert.NoError(t, err)
+	ubl, err := invSrv.DeriveUBL(inv)
+	assert.NoError(t, err)
+
+	collab := testingidentity.GenerateRandomDID()
+	m, err = invSrv.DeriveFromUBLPayload(ctxh, &clientinvoicepb.UBLImportPayload{
+		Collaborators: []string{collab.String()},
+		Ubl:           string(ubl),
+	})
+	assert.NoError(t, err)
+	cs, err := m.GetCollaborators()
+	assert.NoError(t, err)
+	assert.Contains(t, cs, collab)
+
+	// unmapped recipient is not exchanged
+	data := inv.(*Invoice).getClientData()
+	data.Recipient = ""
+	assert.Equal(t, data, m.(*Invoice).getClientData())
+}
+
 func TestService_GetCurrentVersion(t *testing.T) {
 	_, invSrv := getServiceWithMockedLayers()
 	doc, _ := createCDWithEmbeddedInvoice(t)
@@ -302,7 +415,7 @@ func TestService_GetCurrentVersion(t *testing.T) {
 	data := doc.(*Invoice).getClientData()
 	data.Currency = "INR"
 	doc2 := new(Invoice)
-	assert.NoError(t, doc2.PrepareNewVersion(doc, data, nil))
+	assert.NoError(t, doc2.PrepareNewVersion(doc, data, documents.CollaboratorsAccess{}))
 	assert.NoError(t, testRepo().Create(accountID, doc2.CurrentVersion(), doc2))
 
 	doc3, err := invSrv.GetCurrentVersion(ctxh, doc.ID())
@@ -364,7 +477,7 @@ func TestService_calculateDataRoot(t *testing.T) {
 	assert.Nil(t, err)
 	err = invSrv.repo.Create(accountID, inv.CurrentVersion(), inv)
 	assert.Nil(t, err)
-	inv, err = invSrv.validateAndPersist(ctxh, nil, inv, CreateValidator())
+	inv, err = invSrv.validateAndPersist(ctxh, nil, inv, CreateValidator(false))
 	assert.Nil(t, inv)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), storage.ErrRepositoryModelCreateKeyExists)
@@ -372,7 +485,7 @@ func TestService_calculateDataRoot(t *testing.T) {
 	// success
 	inv, err = invSrv.DeriveFromCreatePayload(ctxh, testingdocuments.CreateInvoicePayload())
 	assert.Nil(t, err)
-	inv, err = invSrv.validateAndPersist(ctxh, nil, inv, CreateValidator())
+	inv, err = invSrv.validateAndPersist(ctxh, nil, inv, CreateValidator(false))
 	assert.Nil(t, err)
 	assert.NotNil(t, inv)
 }
@@ -405,3 +518,30 @@ func createCDWithEmbeddedInvoice(t *testing.T) (documents.Model, coredocumentpb.
 	assert.NoError(t, err)
 	return i, cd
 }
+
+func TestService_ClientData(t *testing.T) {
+	srv := service{}
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	assert.Equal(t, Scheme, srv.Scheme())
+
+	// invalid json
+	_, err := srv.DeriveFromClientData(ctxh, documents.CollaboratorsAccess{}, []byte(`{"invoice_number": 1}`))
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalid, err))
+
+	// success
+	m, err := srv.DeriveFromClientData(ctxh, documents.CollaboratorsAccess{}, []byte(`{"invoice_number": "INV-1", "currency": "EUR", "net_amount": "10"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "INV-1", m.(*Invoice).getClientData().InvoiceNumber)
+
+	data, err := srv.DeriveClientData(m)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"invoice_number":"INV-1"`)
+
+	// unknown type
+	_, err = srv.DeriveClientData(&testingdocuments.MockModel{})
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalidType, err))
+
+	// invalid identifier
+	_, err = srv.DeriveFromUpdateClientData(ctxh, "some identifier", documents.CollaboratorsAccess{}, data)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentIdentifier, err))
+}
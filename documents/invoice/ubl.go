@@ -0,0 +1,544 @@
+package invoice
+
+import (
+	"bytes"
+	"encoding/xml"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	clientinvoicepb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoice"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// UBL 2.1 namespaces of the invoice documents and their components
+const (
+	ublInvoiceNamespace = "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	ublCACNamespace     = "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	ublCBCNamespace     = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+)
+
+const (
+	ublVersion    = "2.1"
+	ublDateFormat = "2006-01-02"
+
+	// ublCommercialInvoice is the UNCL1001 type code of a commercial invoice
+	ublCommercialInvoice = "380"
+
+	// ublUnitCode is the UN/ECE recommendation 20 code of the line item quantities, line items are counted in units
+	ublUnitCode = "C62"
+
+	ublTaxScheme = "VAT"
+)
+
+// ublPrefixes are the prefixes of the UBL namespaces in the element names of the UBL types.
+var ublPrefixes = map[string]string{
+	ublInvoiceNamespace: "",
+	ublCACNamespace:     "cac:",
+	ublCBCNamespace:     "cbc:",
+}
+
+// ublAttributes are the invoice fields without a UBL counterpart by their client field names.
+// They are exchanged as additional document references of the document types they are mapped to.
+var ublAttributes = map[string]func(data *clientinvoicepb.InvoiceData) *string{
+	"invoice_status": func(data *clientinvoicepb.InvoiceData) *string { return &data.InvoiceStatus },
+	"sender":         func(data *clientinvoicepb.InvoiceData) *string { return &data.Sender },
+	"recipient":      func(data *clientinvoicepb.InvoiceData) *string { return &data.Recipient },
+	"payee":          func(data *clientinvoicepb.InvoiceData) *string { return &data.Payee },
+	"extra_data":     func(data *clientinvoicepb.InvoiceData) *string { return &data.ExtraData },
+}
+
+// validateUBLAttributes checks that the mapped fields are invoice fields without a UBL counterpart
+// and that every field is mapped to a distinct document type.
+func validateUBLAttributes(attrs map[string]string) error {
+	types := make(map[string]string)
+	for name, typ := range attrs {
+		if _, ok := ublAttributes[name]; !ok {
+			return errors.New("invoice field %s can't be mapped to a UBL document reference", name)
+		}
+
+		if typ == "" {
+			return errors.New("invoice field %s is mapped to an empty document type", name)
+		}
+
+		if other, ok := types[typ]; ok {
+			return errors.New("invoice fields %s and %s are mapped to the same document type %s", other, name, typ)
+		}
+
+		types[typ] = name
+	}
+
+	return nil
+}
+
+// ublInvoice is the part of a UBL 2.1 invoice the invoice data maps to.
+// Element names carry the prefixes of their namespaces, see ublTokenReader for reading invoices declaring other prefixes.
+type ublInvoice struct {
+	XMLName      xml.Name `xml:"Invoice"`
+	Namespace    string   `xml:"xmlns,attr,omitempty"`
+	CACNamespace string   `xml:"xmlns:cac,attr,omitempty"`
+	CBCNamespace string   `xml:"xmlns:cbc,attr,omitempty"`
+
+	UBLVersionID                 string                 `xml:"cbc:UBLVersionID"`
+	ID                           string                 `xml:"cbc:ID"`
+	IssueDate                    string                 `xml:"cbc:IssueDate,omitempty"`
+	DueDate                      string                 `xml:"cbc:DueDate,omitempty"`
+	InvoiceTypeCode              string                 `xml:"cbc:InvoiceTypeCode,omitempty"`
+	Note                         string                 `xml:"cbc:Note,omitempty"`
+	DocumentCurrencyCode         string                 `xml:"cbc:DocumentCurrencyCode,omitempty"`
+	AdditionalDocumentReferences []ublDocumentReference `xml:"cac:AdditionalDocumentReference"`
+	Supplier                     ublParty               `xml:"cac:AccountingSupplierParty>cac:Party"`
+	Customer                     ublParty               `xml:"cac:AccountingCustomerParty>cac:Party"`
+	TaxTotal                     *ublTaxTotal           `xml:"cac:TaxTotal"`
+	MonetaryTotal                ublMonetaryTotal       `xml:"cac:LegalMonetaryTotal"`
+	Lines                        []ublInvoiceLine       `xml:"cac:InvoiceLine"`
+}
+
+// amounts returns the amounts of the invoice.
+func (inv *ublInvoice) amounts() []*ublAmount {
+	amounts := []*ublAmount{
+		inv.MonetaryTotal.LineExtensionAmount,
+		inv.MonetaryTotal.TaxExclusiveAmount,
+		inv.MonetaryTotal.TaxInclusiveAmount,
+		inv.MonetaryTotal.PayableAmount,
+	}
+
+	if inv.TaxTotal != nil {
+		amounts = append(amounts, inv.TaxTotal.amounts()...)
+	}
+
+	for _, l := range inv.Lines {
+		amounts = append(amounts, l.LineExtensionAmount, l.Price)
+		if l.TaxTotal != nil {
+			amounts = append(amounts, l.TaxTotal.amounts()...)
+		}
+	}
+
+	return amounts
+}
+
+type ublDocumentReference struct {
+	ID           string `xml:"cbc:ID"`
+	DocumentType string `xml:"cbc:DocumentType"`
+}
+
+type ublParty struct {
+	Name             string     `xml:"cac:PartyName>cbc:Name,omitempty"`
+	Address          ublAddress `xml:"cac:PostalAddress"`
+	RegistrationName string     `xml:"cac:PartyLegalEntity>cbc:RegistrationName,omitempty"`
+}
+
+// name returns the legal name of the party, or its trading name if the legal name is not given.
+func (p ublParty) name() string {
+	if p.RegistrationName != "" {
+		return p.RegistrationName
+	}
+
+	return p.Name
+}
+
+type ublAddress struct {
+	Street  string `xml:"cbc:StreetName,omitempty"`
+	City    string `xml:"cbc:CityName,omitempty"`
+	Zipcode string `xml:"cbc:PostalZone,omitempty"`
+	Country string `xml:"cac:Country>cbc:IdentificationCode,omitempty"`
+}
+
+type ublTaxTotal struct {
+	TaxAmount *ublAmount      `xml:"cbc:TaxAmount"`
+	Subtotal  *ublTaxSubtotal `xml:"cac:TaxSubtotal"`
+}
+
+// amounts returns the amounts of the tax total.
+func (t *ublTaxTotal) amounts() []*ublAmount {
+	amounts := []*ublAmount{t.TaxAmount}
+	if t.Subtotal != nil {
+		amounts = append(amounts, t.Subtotal.TaxableAmount, t.Subtotal.TaxAmount)
+	}
+
+	return amounts
+}
+
+type ublTaxSubtotal struct {
+	TaxableAmount *ublAmount      `xml:"cbc:TaxableAmount"`
+	TaxAmount     *ublAmount      `xml:"cbc:TaxAmount"`
+	Category      *ublTaxCategory `xml:"cac:TaxCategory"`
+}
+
+type ublTaxCategory struct {
+	Percent   string `xml:"cbc:Percent"`
+	TaxScheme string `xml:"cac:TaxScheme>cbc:ID"`
+}
+
+// newUBLTaxCategory returns the VAT category of the percentage, nil if the percentage is empty.
+func newUBLTaxCategory(percent string) *ublTaxCategory {
+	if percent == "" {
+		return nil
+	}
+
+	return &ublTaxCategory{Percent: percent, TaxScheme: ublTaxScheme}
+}
+
+// percent returns the percentage of the category, empty if c is nil.
+func (c *ublTaxCategory) percent() string {
+	if c == nil {
+		return ""
+	}
+
+	return c.Percent
+}
+
+type ublMonetaryTotal struct {
+	LineExtensionAmount *ublAmount `xml:"cbc:LineExtensionAmount"`
+	TaxExclusiveAmount  *ublAmount `xml:"cbc:TaxExclusiveAmount"`
+	TaxInclusiveAmount  *ublAmount `xml:"cbc:TaxInclusiveAmount"`
+	PayableAmount       *ublAmount `xml:"cbc:PayableAmount"`
+}
+
+type ublInvoiceLine struct {
+	ID                  string       `xml:"cbc:ID"`
+	Quantity            *ublQuantity `xml:"cbc:InvoicedQuantity"`
+	LineExtensionAmount *ublAmount   `xml:"cbc:LineExtensionAmount"`
+	TaxTotal            *ublTaxTotal `xml:"cac:TaxTotal"`
+	Item                ublItem      `xml:"cac:Item"`
+	Price               *ublAmount   `xml:"cac:Price>cbc:PriceAmount"`
+}
+
+type ublItem struct {
+	Description string          `xml:"cbc:Description,omitempty"`
+	Name        string          `xml:"cbc:Name"`
+	TaxCategory *ublTaxCategory `xml:"cac:ClassifiedTaxCategory"`
+}
+
+type ublAmount struct {
+	Currency string `xml:"currencyID,attr,omitempty"`
+	Value    string `xml:",chardata"`
+}
+
+// newUBLAmount returns the amount in the currency, nil if the amount is empty.
+func newUBLAmount(amount, currency string) *ublAmount {
+	if amount == "" {
+		return nil
+	}
+
+	return &ublAmount{Currency: currency, Value: amount}
+}
+
+// value returns the amount, empty if a is nil.
+func (a *ublAmount) value() string {
+	if a == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(a.Value)
+}
+
+type ublQuantity struct {
+	UnitCode string `xml:"unitCode,attr,omitempty"`
+	Value    string `xml:",chardata"`
+}
+
+// value returns the quantity, empty if q is nil.
+func (q *ublQuantity) value() string {
+	if q == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(q.Value)
+}
+
+// ublTokenReader reads the elements of the UBL namespaces with the prefixes of the UBL types,
+// whatever prefixes the invoice declares for them.
+type ublTokenReader struct {
+	d *xml.Decoder
+}
+
+// Token returns the next token with the UBL element names prefixed and the namespace declarations dropped.
+func (r ublTokenReader) Token() (xml.Token, error) {
+	t, err := r.d.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := t.(type) {
+	case xml.StartElement:
+		t.Name = ublName(t.Name)
+		var attrs []xml.Attr
+		for _, a := range t.Attr {
+			if a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns") {
+				continue
+			}
+
+			attrs = append(attrs, a)
+		}
+
+		t.Attr = attrs
+		return t, nil
+	case xml.EndElement:
+		t.Name = ublName(t.Name)
+		return t, nil
+	}
+
+	return t, nil
+}
+
+// ublName returns the name prefixed with the prefix of its UBL namespace. Names of other namespaces are returned as is.
+func ublName(n xml.Name) xml.Name {
+	prefix, ok := ublPrefixes[n.Space]
+	if !ok {
+		return n
+	}
+
+	return xml.Name{Local: prefix + n.Local}
+}
+
+// formatUBLDate returns the date of the timestamp, empty if the timestamp is nil.
+func formatUBLDate(ts *timestamp.Timestamp) (string, error) {
+	if ts == nil {
+		return "", nil
+	}
+
+	t, err := utils.FromTimestamp(ts)
+	if err != nil {
+		return "", err
+	}
+
+	return t.UTC().Format(ublDateFormat), nil
+}
+
+// parseUBLDate returns the timestamp of the date, nil if the date is empty.
+func parseUBLDate(date string) (*timestamp.Timestamp, error) {
+	date = strings.TrimSpace(date)
+	if date == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(ublDateFormat, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.ToTimestamp(t)
+}
+
+// parseUBLTaxRate returns the whole percentage of the tax rate, 0 if the rate is empty.
+func parseUBLTaxRate(percent string) (int64, error) {
+	percent = strings.TrimSpace(percent)
+	if percent == "" {
+		return 0, nil
+	}
+
+	if strings.Contains(percent, ".") {
+		percent = strings.TrimSuffix(strings.TrimRight(percent, "0"), ".")
+	}
+
+	return strconv.ParseInt(percent, 10, 64)
+}
+
+// toUBL returns the invoice data as a UBL 2.1 invoice. The invoice fields without a UBL counterpart are exchanged
+// as additional document references of the document types they are mapped to in attrs, unmapped ones are left out.
+func toUBL(data *clientinvoicepb.InvoiceData, attrs map[string]string) ([]byte, error) {
+	issueDate, err := formatUBLDate(data.DateCreated)
+	if err != nil {
+		return nil, errors.New("invalid date created: %v", err)
+	}
+
+	dueDate, err := formatUBLDate(data.DueDate)
+	if err != nil {
+		return nil, errors.New("invalid due date: %v", err)
+	}
+
+	cur := data.Currency
+	inv := ublInvoice{
+		Namespace:            ublInvoiceNamespace,
+		CACNamespace:         ublCACNamespace,
+		CBCNamespace:         ublCBCNamespace,
+		UBLVersionID:         ublVersion,
+		ID:                   data.InvoiceNumber,
+		IssueDate:            issueDate,
+		DueDate:              dueDate,
+		InvoiceTypeCode:      ublCommercialInvoice,
+		Note:                 data.Comment,
+		DocumentCurrencyCode: cur,
+		Supplier: ublParty{
+			Name:             data.SenderName,
+			RegistrationName: data.SenderName,
+			Address: ublAddress{
+				Street:  data.SenderStreet,
+				City:    data.SenderCity,
+				Zipcode: data.SenderZipcode,
+				Country: data.SenderCountry,
+			},
+		},
+		Customer: ublParty{
+			Name:             data.RecipientName,
+			RegistrationName: data.RecipientName,
+			Address: ublAddress{
+				Street:  data.RecipientStreet,
+				City:    data.RecipientCity,
+				Zipcode: data.RecipientZipcode,
+				Country: data.RecipientCountry,
+			},
+		},
+		MonetaryTotal: ublMonetaryTotal{
+			LineExtensionAmount: newUBLAmount(data.NetAmount, cur),
+			TaxExclusiveAmount:  newUBLAmount(data.NetAmount, cur),
+			TaxInclusiveAmount:  newUBLAmount(data.GrossAmount, cur),
+			PayableAmount:       newUBLAmount(data.GrossAmount, cur),
+		},
+	}
+
+	if data.TaxAmount != "" || data.TaxRate != 0 {
+		inv.TaxTotal = &ublTaxTotal{
+			TaxAmount: newUBLAmount(data.TaxAmount, cur),
+			Subtotal: &ublTaxSubtotal{
+				TaxableAmount: newUBLAmount(data.NetAmount, cur),
+				TaxAmount:     newUBLAmount(data.TaxAmount, cur),
+				Category:      newUBLTaxCategory(strconv.FormatInt(data.TaxRate, 10)),
+			},
+		}
+	}
+
+	for _, li := range data.LineItems {
+		l := ublInvoiceLine{
+			ID:                  li.ItemNumber,
+			LineExtensionAmount: newUBLAmount(li.Amount, cur),
+			Item:                ublItem{Name: li.Description, TaxCategory: newUBLTaxCategory(li.TaxRate)},
+			Price:               newUBLAmount(li.UnitPrice, cur),
+		}
+
+		if li.Quantity != "" {
+			l.Quantity = &ublQuantity{UnitCode: ublUnitCode, Value: li.Quantity}
+		}
+
+		if li.TaxAmount != "" {
+			l.TaxTotal = &ublTaxTotal{TaxAmount: newUBLAmount(li.TaxAmount, cur)}
+		}
+
+		inv.Lines = append(inv.Lines, l)
+	}
+
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		field, ok := ublAttributes[name]
+		if !ok {
+			continue
+		}
+
+		if v := *field(data); v != "" {
+			inv.AdditionalDocumentReferences = append(inv.AdditionalDocumentReferences, ublDocumentReference{
+				ID:           v,
+				DocumentType: attrs[name],
+			})
+		}
+	}
+
+	out, err := xml.MarshalIndent(inv, "", "  ")
+	if err != nil {
+		return nil, errors.New("failed to encode UBL invoice: %v", err)
+	}
+
+	return append([]byte(xml.Header), out...), nil
+}
+
+// fromUBL returns the invoice data of the UBL 2.1 invoice. Additional document references of the document types
+// mapped in attrs are read into the invoice fields they are mapped to, other references are ignored.
+func fromUBL(ubl []byte, attrs map[string]string) (*clientinvoicepb.InvoiceData, error) {
+	inv := new(ublInvoice)
+	d := xml.NewTokenDecoder(ublTokenReader{d: xml.NewDecoder(bytes.NewReader(ubl))})
+	err := d.Decode(inv)
+	if err != nil {
+		return nil, errors.New("failed to decode UBL invoice: %v", err)
+	}
+
+	cur := strings.TrimSpace(inv.DocumentCurrencyCode)
+	for _, a := range inv.amounts() {
+		if a != nil && a.Currency != "" && a.Currency != cur {
+			return nil, errors.New("amount %s is in %s instead of the invoice currency %s", a.value(), a.Currency, cur)
+		}
+	}
+
+	data := &clientinvoicepb.InvoiceData{
+		InvoiceNumber:    strings.TrimSpace(inv.ID),
+		SenderName:       inv.Supplier.name(),
+		SenderStreet:     inv.Supplier.Address.Street,
+		SenderCity:       inv.Supplier.Address.City,
+		SenderZipcode:    inv.Supplier.Address.Zipcode,
+		SenderCountry:    inv.Supplier.Address.Country,
+		RecipientName:    inv.Customer.name(),
+		RecipientStreet:  inv.Customer.Address.Street,
+		RecipientCity:    inv.Customer.Address.City,
+		RecipientZipcode: inv.Customer.Address.Zipcode,
+		RecipientCountry: inv.Customer.Address.Country,
+		Currency:         cur,
+		GrossAmount:      inv.MonetaryTotal.TaxInclusiveAmount.value(),
+		NetAmount:        inv.MonetaryTotal.TaxExclusiveAmount.value(),
+		Comment:          inv.Note,
+	}
+
+	data.DateCreated, err = parseUBLDate(inv.IssueDate)
+	if err != nil {
+		return nil, errors.New("invalid issue date: %v", err)
+	}
+
+	data.DueDate, err = parseUBLDate(inv.DueDate)
+	if err != nil {
+		return nil, errors.New("invalid due date: %v", err)
+	}
+
+	if inv.TaxTotal != nil {
+		data.TaxAmount = inv.TaxTotal.TaxAmount.value()
+		if inv.TaxTotal.Subtotal != nil {
+			data.TaxRate, err = parseUBLTaxRate(inv.TaxTotal.Subtotal.Category.percent())
+			if err != nil {
+				return nil, errors.New("tax rate is not a whole percentage: %v", err)
+			}
+		}
+	}
+
+	for _, l := range inv.Lines {
+		description := l.Item.Name
+		if description == "" {
+			description = l.Item.Description
+		}
+
+		li := &clientinvoicepb.LineItem{
+			ItemNumber:  strings.TrimSpace(l.ID),
+			Description: description,
+			Quantity:    l.Quantity.value(),
+			UnitPrice:   l.Price.value(),
+			Amount:      l.LineExtensionAmount.value(),
+			TaxRate:     strings.TrimSpace(l.Item.TaxCategory.percent()),
+		}
+
+		if l.TaxTotal != nil {
+			li.TaxAmount = l.TaxTotal.TaxAmount.value()
+		}
+
+		data.LineItems = append(data.LineItems, li)
+	}
+
+	types := make(map[string]string, len(attrs))
+	for name, typ := range attrs {
+		types[typ] = name
+	}
+
+	for _, ref := range inv.AdditionalDocumentReferences {
+		field, ok := ublAttributes[types[strings.TrimSpace(ref.DocumentType)]]
+		if !ok {
+			continue
+		}
+
+		*field(data) = strings.TrimSpace(ref.ID)
+	}
+
+	return data, nil
+}
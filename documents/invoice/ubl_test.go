@@ -0,0 +1,169 @@
+// +build unit
+
+package invoice
+
+import (
+	"testing"
+	"time"
+
+	clientinvoicepb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoice"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var testUBLAttributes = map[string]string{
+	"invoice_status": "InvoiceStatus",
+	"sender":         "SenderDID",
+	"payee":          "PayeeDID",
+}
+
+func ublTestData(t *testing.T) *clientinvoicepb.InvoiceData {
+	dateCreated, err := utils.ToTimestamp(time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	dueDate, err := utils.ToTimestamp(time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+
+	return &clientinvoicepb.InvoiceData{
+		InvoiceNumber:    "INV-1",
+		InvoiceStatus:    "unpaid",
+		SenderName:       "Sender & Co",
+		SenderStreet:     "Friedrichstrasse 1",
+		SenderCity:       "Berlin",
+		SenderZipcode:    "10115",
+		SenderCountry:    "DE",
+		RecipientName:    "Recipient",
+		RecipientCountry: "FR",
+		Currency:         "EUR",
+		GrossAmount:      "119.5",
+		NetAmount:        "100.42",
+		TaxAmount:        "19.08",
+		TaxRate:          19,
+		Sender:           "0xed03fa80291ff5ddc284de6b51e716b130b05e20",
+		Recipient:        "0xea939d5c0494b072c51565b191ee59b5d34fbf79",
+		Comment:          "delivered <on time>",
+		DueDate:          dueDate,
+		DateCreated:      dateCreated,
+		LineItems: []*clientinvoicepb.LineItem{
+			{
+				ItemNumber:  "1",
+				Description: "widget",
+				Quantity:    "2",
+				UnitPrice:   "50.21",
+				Amount:      "100.42",
+				TaxAmount:   "19.08",
+				TaxRate:     "19",
+			},
+		},
+	}
+}
+
+func TestUBL_roundTrip(t *testing.T) {
+	data := ublTestData(t)
+	ubl, err := toUBL(data, testUBLAttributes)
+	assert.NoError(t, err)
+	assert.Contains(t, string(ubl), "<cbc:UBLVersionID>2.1</cbc:UBLVersionID>")
+	assert.Contains(t, string(ubl), "<cbc:IssueDate>2019-01-31</cbc:IssueDate>")
+	assert.Contains(t, string(ubl), `<cbc:TaxInclusiveAmount currencyID="EUR">119.5</cbc:TaxInclusiveAmount>`)
+	assert.Contains(t, string(ubl), "<cbc:DocumentType>SenderDID</cbc:DocumentType>")
+
+	// unmapped fields are left out
+	assert.NotContains(t, string(ubl), data.Recipient)
+
+	got, err := fromUBL(ubl, testUBLAttributes)
+	assert.NoError(t, err)
+	data.Recipient = ""
+	assert.Equal(t, data, got)
+
+	// references of unmapped document types are ignored
+	got, err = fromUBL(ubl, map[string]string{"sender": "SenderDID"})
+	assert.NoError(t, err)
+	assert.Empty(t, got.InvoiceStatus)
+	assert.Equal(t, data.Sender, got.Sender)
+}
+
+func TestFromUBL(t *testing.T) {
+	// other prefixes and extensions
+	ubl := `<?xml version="1.0" encoding="UTF-8"?>
+<ubl:Invoice xmlns:ubl="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	xmlns:a="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	xmlns:b="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+	xmlns:ext="urn:oasis:names:specification:ubl:schema:xsd:CommonExtensionComponents-2">
+	<ext:UBLExtensions><ext:UBLExtension><ext:ID>ext</ext:ID></ext:UBLExtension></ext:UBLExtensions>
+	<b:ID>INV-2</b:ID>
+	<b:IssueDate>2019-01-31</b:IssueDate>
+	<b:DocumentCurrencyCode>EUR</b:DocumentCurrencyCode>
+	<a:AdditionalDocumentReference><b:ID>paid</b:ID><b:DocumentType>InvoiceStatus</b:DocumentType></a:AdditionalDocumentReference>
+	<a:AdditionalDocumentReference><b:ID>contract</b:ID><b:DocumentType>Contract</b:DocumentType></a:AdditionalDocumentReference>
+	<a:AccountingSupplierParty><a:Party><a:PartyName><b:Name>Supplier</b:Name></a:PartyName></a:Party></a:AccountingSupplierParty>
+	<a:TaxTotal>
+		<b:TaxAmount currencyID="EUR">0.70</b:TaxAmount>
+		<a:TaxSubtotal><a:TaxCategory><b:ID>S</b:ID><b:Percent>7.00</b:Percent></a:TaxCategory></a:TaxSubtotal>
+	</a:TaxTotal>
+	<a:LegalMonetaryTotal><b:TaxInclusiveAmount currencyID="EUR">10.70</b:TaxInclusiveAmount></a:LegalMonetaryTotal>
+	<a:InvoiceLine><b:ID>1</b:ID><a:Item><b:Description>service</b:Description></a:Item></a:InvoiceLine>
+</ubl:Invoice>`
+	data, err := fromUBL([]byte(ubl), testUBLAttributes)
+	assert.NoError(t, err)
+	assert.Equal(t, "INV-2", data.InvoiceNumber)
+	assert.Equal(t, "paid", data.InvoiceStatus)
+	assert.Equal(t, "Supplier", data.SenderName)
+	assert.Equal(t, "EUR", data.Currency)
+	assert.Equal(t, "0.70", data.TaxAmount)
+	assert.Equal(t, int64(7), data.TaxRate)
+	assert.Equal(t, "10.70", data.GrossAmount)
+	assert.Len(t, data.LineItems, 1)
+	assert.Equal(t, "service", data.LineItems[0].Description)
+
+	// not an invoice
+	_, err = fromUBL([]byte(`<CreditNote xmlns="urn:oasis:names:specification:ubl:schema:xsd:CreditNote-2"/>`), nil)
+	assert.Error(t, err)
+
+	// malformed
+	_, err = fromUBL([]byte(`<Invoice>`), nil)
+	assert.Error(t, err)
+
+	// amount in another currency
+	ubl = `<Invoice xmlns="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+	<cbc:DocumentCurrencyCode>EUR</cbc:DocumentCurrencyCode>
+	<cac:LegalMonetaryTotal><cbc:PayableAmount currencyID="USD">1</cbc:PayableAmount></cac:LegalMonetaryTotal>
+</Invoice>`
+	_, err = fromUBL([]byte(ubl), nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "USD")
+
+	// fractional tax rate
+	ubl = `<Invoice xmlns="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+	<cac:TaxTotal><cac:TaxSubtotal><cac:TaxCategory><cbc:Percent>5.5</cbc:Percent></cac:TaxCategory></cac:TaxSubtotal></cac:TaxTotal>
+</Invoice>`
+	_, err = fromUBL([]byte(ubl), nil)
+	assert.Error(t, err)
+
+	// invalid date
+	ubl = `<Invoice xmlns="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+	<cbc:IssueDate>31.01.2019</cbc:IssueDate>
+</Invoice>`
+	_, err = fromUBL([]byte(ubl), nil)
+	assert.Error(t, err)
+}
+
+func TestValidateUBLAttributes(t *testing.T) {
+	assert.NoError(t, validateUBLAttributes(nil))
+	assert.NoError(t, validateUBLAttributes(testUBLAttributes))
+
+	// field with a UBL counterpart
+	err := validateUBLAttributes(map[string]string{"invoice_number": "InvoiceNumber"})
+	assert.Error(t, err)
+
+	// empty document type
+	err = validateUBLAttributes(map[string]string{"sender": ""})
+	assert.Error(t, err)
+
+	// same document type
+	err = validateUBLAttributes(map[string]string{"sender": "DID", "payee": "DID"})
+	assert.Error(t, err)
+}
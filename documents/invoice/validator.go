@@ -5,8 +5,9 @@ import (
 	"github.com/centrifuge/go-centrifuge/errors"
 )
 
-// fieldValidateFunc validates the fields of the invoice model
-func fieldValidator() documents.Validator {
+// fieldValidateFunc validates the fields of the invoice model.
+// Currency and country codes must be assigned ISO codes in strict mode.
+func fieldValidator(strictCodes bool) documents.Validator {
 	return documents.ValidatorFunc(func(_, new documents.Model) error {
 		if new == nil {
 			return errors.New("nil document")
@@ -18,8 +19,16 @@ func fieldValidator() documents.Validator {
 		}
 
 		var err error
-		if !documents.IsCurrencyValid(inv.Currency) {
-			err = errors.AppendError(err, documents.NewError("inv_currency", "currency is invalid"))
+		if cerr := documents.ValidateCurrency(inv.Currency, strictCodes); cerr != nil {
+			err = errors.AppendError(err, documents.NewError("inv_currency", cerr.Error()))
+		}
+
+		if cerr := documents.ValidateCountry(inv.SenderCountry, strictCodes); cerr != nil {
+			err = errors.AppendError(err, documents.NewError("inv_sender_country", cerr.Error()))
+		}
+
+		if cerr := documents.ValidateCountry(inv.RecipientCountry, strictCodes); cerr != nil {
+			err = errors.AppendError(err, documents.NewError("inv_recipient_country", cerr.Error()))
 		}
 
 		return err
@@ -27,16 +36,16 @@ func fieldValidator() documents.Validator {
 }
 
 // CreateValidator returns a validator group that should be run before creating the invoice and persisting it to DB
-func CreateValidator() documents.ValidatorGroup {
+func CreateValidator(strictCodes bool) documents.ValidatorGroup {
 	return documents.ValidatorGroup{
-		fieldValidator(),
+		fieldValidator(strictCodes),
 	}
 }
 
 // UpdateValidator returns a validator group that should be run before updating the invoice
-func UpdateValidator() documents.ValidatorGroup {
+func UpdateValidator(strictCodes bool) documents.ValidatorGroup {
 	return documents.ValidatorGroup{
-		fieldValidator(),
+		fieldValidator(strictCodes),
 		documents.UpdateVersionValidator(),
 	}
 }
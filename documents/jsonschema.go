@@ -0,0 +1,308 @@
+package documents
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+)
+
+// jsonTypes are the type names of JSON Schema.
+var jsonTypes = map[string]bool{
+	"null":    true,
+	"boolean": true,
+	"object":  true,
+	"array":   true,
+	"number":  true,
+	"string":  true,
+	"integer": true,
+}
+
+// schemaTypes is the type keyword of a JSON Schema, a single type name or a list of them.
+type schemaTypes []string
+
+// UnmarshalJSON loads the type names of the keyword.
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*t = schemaTypes{name}
+		return nil
+	}
+
+	var names []string
+	err := json.Unmarshal(data, &names)
+	if err != nil {
+		return errors.New("type must be a string or a list of strings")
+	}
+
+	*t = names
+	return nil
+}
+
+// JSONSchema is a JSON Schema the client data of the documents of a scheme is validated against.
+// The type, enum, required, properties, additionalProperties, items, minItems, maxItems, minLength, maxLength,
+// pattern, minimum and maximum keywords are supported, other keywords are ignored.
+type JSONSchema struct {
+	Type                 schemaTypes            `json:"type,omitempty"`
+	Enum                 []interface{}          `json:"enum,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	MinItems             *int                   `json:"minItems,omitempty"`
+	MaxItems             *int                   `json:"maxItems,omitempty"`
+	MinLength            *int                   `json:"minLength,omitempty"`
+	MaxLength            *int                   `json:"maxLength,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	Minimum              *float64               `json:"minimum,omitempty"`
+	Maximum              *float64               `json:"maximum,omitempty"`
+
+	// reject is set for the false schema, that no value matches
+	reject bool
+
+	pattern *regexp.Regexp
+}
+
+// UnmarshalJSON loads the schema, the boolean schemas included, and checks its keywords.
+func (s *JSONSchema) UnmarshalJSON(data []byte) error {
+	switch string(bytes.TrimSpace(data)) {
+	case "true":
+		*s = JSONSchema{}
+		return nil
+	case "false":
+		*s = JSONSchema{reject: true}
+		return nil
+	}
+
+	type schema JSONSchema
+	var ss schema
+	err := json.Unmarshal(data, &ss)
+	if err != nil {
+		return err
+	}
+
+	*s = JSONSchema(ss)
+	for _, t := range s.Type {
+		if !jsonTypes[t] {
+			return errors.New("unknown type %q", t)
+		}
+	}
+
+	if s.Pattern != "" {
+		s.pattern, err = regexp.Compile(s.Pattern)
+		if err != nil {
+			return errors.New("invalid pattern %q: %v", s.Pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// ParseJSONSchema loads the JSON Schema from its json encoding.
+func ParseJSONSchema(data []byte) (*JSONSchema, error) {
+	s := new(JSONSchema)
+	err := json.Unmarshal(data, s)
+	if err != nil {
+		return nil, errors.New("invalid JSON schema: %v", err)
+	}
+
+	return s, nil
+}
+
+// LoadJSONSchema loads the JSON Schema from the file at path.
+func LoadJSONSchema(path string) (*JSONSchema, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseJSONSchema(data)
+}
+
+// Validate validates the json encoded client data against the schema.
+// Every violation is returned as an error of the list, prefixed with the path of the field, such as
+// line_items[0].amount.
+func (s *JSONSchema) Validate(data []byte) error {
+	var v interface{}
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return errors.New("malformed client data: %v", err)
+	}
+
+	return s.validate("", v)
+}
+
+// validate returns the violations of the schema by the value of the field at path.
+func (s *JSONSchema) validate(path string, v interface{}) error {
+	if s.reject {
+		return fieldError(path, "is not allowed")
+	}
+
+	if len(s.Type) > 0 && !s.hasType(v) {
+		return fieldError(path, fmt.Sprintf("must be of type %s", strings.Join(s.Type, " or ")))
+	}
+
+	if len(s.Enum) > 0 && !s.inEnum(v) {
+		return fieldError(path, "must be one of the enumerated values")
+	}
+
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return s.validateObject(path, val)
+	case []interface{}:
+		return s.validateArray(path, val)
+	case string:
+		return s.validateString(path, val)
+	case float64:
+		return s.validateNumber(path, val)
+	}
+
+	return nil
+}
+
+func (s *JSONSchema) hasType(v interface{}) bool {
+	for _, t := range s.Type {
+		switch val := v.(type) {
+		case nil:
+			if t == "null" {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		case map[string]interface{}:
+			if t == "object" {
+				return true
+			}
+		case []interface{}:
+			if t == "array" {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case float64:
+			if t == "number" || (t == "integer" && val == math.Trunc(val)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (s *JSONSchema) inEnum(v interface{}) bool {
+	for _, e := range s.Enum {
+		if reflect.DeepEqual(e, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *JSONSchema) validateObject(path string, obj map[string]interface{}) (err error) {
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			err = errors.AppendError(err, fieldError(joinFieldPath(path, name), "is required"))
+		}
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		fs, ok := s.Properties[name]
+		if !ok {
+			fs = s.AdditionalProperties
+		}
+
+		if fs == nil {
+			continue
+		}
+
+		err = errors.AppendError(err, fs.validate(joinFieldPath(path, name), obj[name]))
+	}
+
+	return err
+}
+
+func (s *JSONSchema) validateArray(path string, items []interface{}) (err error) {
+	if s.MinItems != nil && len(items) < *s.MinItems {
+		err = errors.AppendError(err, fieldError(path, fmt.Sprintf("must have at least %d items", *s.MinItems)))
+	}
+
+	if s.MaxItems != nil && len(items) > *s.MaxItems {
+		err = errors.AppendError(err, fieldError(path, fmt.Sprintf("must have at most %d items", *s.MaxItems)))
+	}
+
+	if s.Items == nil {
+		return err
+	}
+
+	for i, v := range items {
+		err = errors.AppendError(err, s.Items.validate(fmt.Sprintf("%s[%d]", path, i), v))
+	}
+
+	return err
+}
+
+func (s *JSONSchema) validateString(path, str string) (err error) {
+	l := utf8.RuneCountInString(str)
+	if s.MinLength != nil && l < *s.MinLength {
+		err = errors.AppendError(err, fieldError(path, fmt.Sprintf("must be at least %d characters long", *s.MinLength)))
+	}
+
+	if s.MaxLength != nil && l > *s.MaxLength {
+		err = errors.AppendError(err, fieldError(path, fmt.Sprintf("must be at most %d characters long", *s.MaxLength)))
+	}
+
+	if s.pattern != nil && !s.pattern.MatchString(str) {
+		err = errors.AppendError(err, fieldError(path, fmt.Sprintf("must match %q", s.Pattern)))
+	}
+
+	return err
+}
+
+func (s *JSONSchema) validateNumber(path string, n float64) (err error) {
+	if s.Minimum != nil && n < *s.Minimum {
+		err = errors.AppendError(err, fieldError(path, fmt.Sprintf("must be at least %v", *s.Minimum)))
+	}
+
+	if s.Maximum != nil && n > *s.Maximum {
+		err = errors.AppendError(err, fieldError(path, fmt.Sprintf("must be at most %v", *s.Maximum)))
+	}
+
+	return err
+}
+
+// joinFieldPath returns the path of the field of the object at path.
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// fieldError returns the violation of the schema by the field at path, the client data itself if path is empty.
+func fieldError(path, msg string) error {
+	if path == "" {
+		return errors.New("client data %s", msg)
+	}
+
+	return errors.New("%s %s", path, msg)
+}
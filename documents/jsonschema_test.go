@@ -0,0 +1,118 @@
+// +build unit
+
+package documents
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const testJSONSchema = `{
+	"type": "object",
+	"required": ["number", "amount"],
+	"properties": {
+		"number": {"type": "string", "pattern": "^INV-[0-9]+$"},
+		"amount": {"type": "number", "minimum": 0},
+		"currency": {"enum": ["EUR", "USD"]},
+		"comment": {"type": ["string", "null"], "maxLength": 8},
+		"line_items": {
+			"type": "array",
+			"minItems": 1,
+			"items": {
+				"type": "object",
+				"required": ["quantity"],
+				"properties": {"quantity": {"type": "integer", "maximum": 100}},
+				"additionalProperties": false
+			}
+		}
+	}
+}`
+
+func TestParseJSONSchema(t *testing.T) {
+	for _, s := range []string{
+		`[]`,
+		`{"type": "decimal"}`,
+		`{"type": 1}`,
+		`{"pattern": "["}`,
+		`{"properties": {"amount": {"type": "money"}}}`,
+	} {
+		_, err := ParseJSONSchema([]byte(s))
+		assert.Error(t, err, s)
+	}
+
+	s, err := ParseJSONSchema([]byte(testJSONSchema))
+	assert.NoError(t, err)
+	assert.Equal(t, schemaTypes{"object"}, s.Type)
+	assert.True(t, s.Properties["line_items"].Items.AdditionalProperties.reject)
+
+	// boolean schemas
+	s, err = ParseJSONSchema([]byte(`true`))
+	assert.NoError(t, err)
+	assert.NoError(t, s.Validate([]byte(`{"any": "value"}`)))
+	s, err = ParseJSONSchema([]byte(`false`))
+	assert.NoError(t, err)
+	assert.Error(t, s.Validate([]byte(`{}`)))
+}
+
+func TestLoadJSONSchema(t *testing.T) {
+	_, err := LoadJSONSchema("/non/existing/schema.json")
+	assert.Error(t, err)
+
+	f, err := ioutil.TempFile("", "schema")
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(testJSONSchema)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	s, err := LoadJSONSchema(f.Name())
+	assert.NoError(t, err)
+	assert.Len(t, s.Properties, 5)
+}
+
+func TestJSONSchema_Validate(t *testing.T) {
+	s, err := ParseJSONSchema([]byte(testJSONSchema))
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.Validate([]byte(`{"number": "INV-1", "amount": 10.5, "currency": "EUR", "comment": null, "line_items": [{"quantity": 2}]}`)))
+
+	// properties without a schema are not validated
+	assert.NoError(t, s.Validate([]byte(`{"number": "INV-1", "amount": 0, "due_date": 1}`)))
+
+	tests := []struct {
+		data   string
+		errors []string
+	}{
+		{`invalid`, []string{"malformed client data"}},
+		{`[]`, []string{"client data must be of type object"}},
+		{`{}`, []string{"number is required", "amount is required"}},
+		{`{"number": 1, "amount": "10"}`, []string{"amount must be of type number", "number must be of type string"}},
+		{`{"number": "1", "amount": -1}`, []string{"amount must be at least 0", `number must match "^INV-[0-9]+$"`}},
+		{`{"number": "INV-1", "amount": 1, "currency": "GBP", "comment": "too long comment"}`, []string{
+			"comment must be at most 8 characters long",
+			"currency must be one of the enumerated values",
+		}},
+		{`{"number": "INV-1", "amount": 1, "line_items": []}`, []string{"line_items must have at least 1 items"}},
+		{`{"number": "INV-1", "amount": 1, "line_items": [{"quantity": 1.5}, {"quantity": 101, "price": 1}, {}]}`, []string{
+			"line_items[0].quantity must be of type integer",
+			"line_items[1].price is not allowed",
+			"line_items[1].quantity must be at most 100",
+			"line_items[2].quantity is required",
+		}},
+	}
+
+	for _, c := range tests {
+		err := s.Validate([]byte(c.data))
+		assert.Error(t, err, c.data)
+		errs := errors.GetErrs(err)
+		assert.Len(t, errs, len(c.errors), c.data)
+		for i, msg := range c.errors {
+			if i < len(errs) {
+				assert.Contains(t, errs[i].Error(), msg, c.data)
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+)
+
+// latestVersionPrefix is the key prefix of the latest version index entries
+const latestVersionPrefix = "doclatest_"
+
+// latestVersion is the index entry of the latest locally known version of a document.
+type latestVersion struct {
+	Version []byte `json:"version"`
+}
+
+// JSON returns the json encoding of the entry.
+func (l *latestVersion) JSON() ([]byte, error) {
+	return json.Marshal(l)
+}
+
+// FromJSON loads the entry from its json encoding.
+func (l *latestVersion) FromJSON(data []byte) error {
+	return json.Unmarshal(data, l)
+}
+
+// Type returns the reflect type of the entry.
+func (l *latestVersion) Type() reflect.Type {
+	return reflect.TypeOf(l)
+}
+
+// getLatestVersionKey returns the key of the latest version entry of the document owned by accountID.
+func getLatestVersionKey(accountID, documentID []byte) []byte {
+	prefix := append([]byte(latestVersionPrefix), accountID...)
+	return append(prefix, documentID...)
+}
+
+// indexLatestVersion indexes the model as the latest version of its document, unless its next version is already stored.
+func indexLatestVersion(w indexWriter, accountID []byte, model Model) error {
+	if w.Exists(append(accountID, model.NextVersion()...)) {
+		return nil
+	}
+
+	return saveIndexEntry(w, getLatestVersionKey(accountID, model.ID()), &latestVersion{Version: model.CurrentVersion()})
+}
+
+// getLatestVersion returns the indexed latest version of the document owned by the account in the context.
+func (s service) getLatestVersion(ctx context.Context, documentID []byte) (Model, error) {
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, ErrDocumentConfigAccountID
+	}
+
+	model, err := s.repo.GetLatest(did[:], documentID)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentVersionNotFound, err)
+	}
+
+	return model, nil
+}
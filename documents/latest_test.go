@@ -0,0 +1,72 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// unindexedRepo serves the documents as if they were stored before the latest versions were indexed.
+type unindexedRepo struct {
+	Repository
+}
+
+func (r unindexedRepo) GetLatest(accountID, documentID []byte) (Model, error) {
+	return nil, errors.New("not indexed")
+}
+
+func TestService_GetCurrentVersion_latestIndex(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&searchDoc{})
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+
+	v1 := newSearchDoc("invoice", time.Now(), testingidentity.GenerateRandomDID(), nil)
+	v2, v3 := *v1, *v1
+	v2.Version, v2.Next = v1.Next, utils.RandomSlice(32)
+	v3.Version, v3.Next = v2.Next, utils.RandomSlice(32)
+	for _, v := range []*searchDoc{v1, &v2, &v3} {
+		assert.NoError(t, repo.Create(did[:], v.Version, v))
+	}
+
+	m, err := repo.GetLatest(did[:], v1.DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, v3.Version, m.CurrentVersion())
+
+	// updates of previous versions don't move the index
+	v1.Status = StatusFailed
+	assert.NoError(t, repo.Atomic(func(repo Repository) error {
+		return repo.Update(did[:], v1.Version, v1)
+	}))
+	m, err = repo.GetLatest(did[:], v1.DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, v3.Version, m.CurrentVersion())
+
+	// unknown document
+	_, err = repo.GetLatest(did[:], utils.RandomSlice(32))
+	assert.Error(t, err)
+
+	srv := service{repo: repo}
+	m, err = srv.GetCurrentVersion(actx, v1.DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, v3.Version, m.CurrentVersion())
+
+	_, err = srv.GetCurrentVersion(actx, utils.RandomSlice(32))
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+
+	// documents stored before the index are walked
+	srv = service{repo: unindexedRepo{repo}}
+	m, err = srv.GetCurrentVersion(actx, v1.DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, v3.Version, m.CurrentVersion())
+}
@@ -0,0 +1,241 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// LedgerFormat is the format the ledger entries are exported in.
+type LedgerFormat string
+
+const (
+	// LedgerFormatCSV exports the entries as a CSV file with a header row.
+	LedgerFormatCSV LedgerFormat = "csv"
+
+	// LedgerFormatJSON exports the entries as a json array.
+	LedgerFormatJSON LedgerFormat = "json"
+)
+
+// LedgerEventType is the business event of a document booked in the ledger.
+type LedgerEventType string
+
+const (
+	// LedgerEventCreated is booked for the first version of a document.
+	LedgerEventCreated LedgerEventType = "created"
+
+	// LedgerEventAccepted is booked for the version that sets the status of a document to accepted.
+	LedgerEventAccepted LedgerEventType = "accepted"
+
+	// LedgerEventPaid is booked for the version that sets the status of a document to paid.
+	LedgerEventPaid LedgerEventType = "paid"
+)
+
+// statusEvents are the ledger events booked when the summary status of a document changes to the status.
+var statusEvents = map[string]LedgerEventType{
+	"accepted": LedgerEventAccepted,
+	"paid":     LedgerEventPaid,
+}
+
+// LedgerAmount is the amount a document version books in the ledger.
+type LedgerAmount struct {
+	// Number is the number of the document in the books of its author, such as the invoice number
+	Number   string
+	Currency string
+	Amount   string
+}
+
+// LedgerBooker is implemented by the models that book an amount in the ledger.
+type LedgerBooker interface {
+	// LedgerAmount returns the amount of the document version.
+	LedgerAmount() LedgerAmount
+}
+
+// LedgerEvent is an event of an anchored document version in the ledger.
+type LedgerEvent struct {
+	// EventID identifies the event across exports
+	EventID string
+
+	Type         LedgerEventType
+	Timestamp    time.Time
+	DocumentID   []byte
+	Version      []byte
+	DocumentType string
+	Status       string
+	LedgerAmount
+}
+
+// ledgerEntry is the exported form of a ledger event.
+type ledgerEntry struct {
+	EventID      string `json:"event_id"`
+	EventType    string `json:"event_type"`
+	Timestamp    string `json:"timestamp"`
+	DocumentID   string `json:"document_id"`
+	VersionID    string `json:"version_id"`
+	DocumentType string `json:"document_type"`
+	Status       string `json:"status"`
+	Number       string `json:"number"`
+	Currency     string `json:"currency"`
+	Amount       string `json:"amount"`
+}
+
+// ledgerHeader names the columns of the CSV export in the order of ledgerEntry.record.
+var ledgerHeader = []string{"event_id", "event_type", "timestamp", "document_id", "version_id", "document_type", "status", "number", "currency", "amount"}
+
+func newLedgerEntry(e LedgerEvent) ledgerEntry {
+	return ledgerEntry{
+		EventID:      e.EventID,
+		EventType:    string(e.Type),
+		Timestamp:    e.Timestamp.UTC().Format(time.RFC3339),
+		DocumentID:   hexutil.Encode(e.DocumentID),
+		VersionID:    hexutil.Encode(e.Version),
+		DocumentType: e.DocumentType,
+		Status:       e.Status,
+		Number:       e.Number,
+		Currency:     e.Currency,
+		Amount:       e.Amount,
+	}
+}
+
+func (e ledgerEntry) record() []string {
+	return []string{e.EventID, e.EventType, e.Timestamp, e.DocumentID, e.VersionID, e.DocumentType, e.Status, e.Number, e.Currency, e.Amount}
+}
+
+// ledgerEventID returns the ID of the event of the version. The ID is the same for every export of the event.
+func ledgerEventID(version []byte, eventType LedgerEventType) string {
+	return fmt.Sprintf("%s-%s", hexutil.Encode(version), eventType)
+}
+
+// summaryStatus returns the lower cased summary status of the model, empty if the model has no status.
+func summaryStatus(model Model) string {
+	sm, ok := model.(Summarizer)
+	if !ok {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimSpace(sm.SummaryStatus()))
+}
+
+// ExportLedger returns the events of the anchored document versions owned by accountID timestamped within [from, to),
+// ordered by time. A zero from or to leaves the range open on that side.
+// The first version of a document books a created event, a version changing the status of the document to accepted or
+// paid books the event of the status. The status of a version whose previous version is not stored locally is taken as
+// changed.
+func (s service) ExportLedger(ctx context.Context, accountID identity.DID, from, to time.Time) ([]LedgerEvent, error) {
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return nil, errors.NewTypedError(ErrDocumentLedger, errors.New("start of the range must be before its end"))
+	}
+
+	accID := accountID[:]
+	var events []LedgerEvent
+	err := s.repo.Iterate(accID, func(model Model) error {
+		if model.GetStatus() != StatusCommitted {
+			return nil
+		}
+
+		ts, err := model.Timestamp()
+		if err != nil || (!from.IsZero() && ts.Before(from)) || (!to.IsZero() && !ts.Before(to)) {
+			return nil
+		}
+
+		var types []LedgerEventType
+		prev := model.PreviousVersion()
+		if utils.IsEmptyByteSlice(prev) {
+			types = append(types, LedgerEventCreated)
+		}
+
+		status := summaryStatus(model)
+		if et, ok := statusEvents[status]; ok {
+			changed := true
+			if !utils.IsEmptyByteSlice(prev) && s.repo.Exists(accID, prev) {
+				pm, err := s.repo.Get(accID, prev)
+				if err != nil {
+					return errors.NewTypedError(ErrDocumentVersionNotFound, err)
+				}
+
+				changed = summaryStatus(pm) != status
+			}
+
+			if changed {
+				types = append(types, et)
+			}
+		}
+
+		var amount LedgerAmount
+		if lb, ok := model.(LedgerBooker); ok {
+			amount = lb.LedgerAmount()
+		}
+
+		for _, et := range types {
+			events = append(events, LedgerEvent{
+				EventID:      ledgerEventID(model.CurrentVersion(), et),
+				Type:         et,
+				Timestamp:    ts,
+				DocumentID:   model.ID(),
+				Version:      model.CurrentVersion(),
+				DocumentType: model.DocumentType(),
+				Status:       status,
+				LedgerAmount: amount,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].Timestamp.Equal(events[j].Timestamp) {
+			return events[i].Timestamp.Before(events[j].Timestamp)
+		}
+
+		return events[i].EventID < events[j].EventID
+	})
+
+	return events, nil
+}
+
+// RenderLedger renders the ledger events in the given format.
+func RenderLedger(events []LedgerEvent, format LedgerFormat) ([]byte, error) {
+	entries := make([]ledgerEntry, len(events))
+	for i, e := range events {
+		entries[i] = newLedgerEntry(e)
+	}
+
+	switch format {
+	case LedgerFormatJSON:
+		data, err := json.Marshal(entries)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrDocumentLedger, err)
+		}
+
+		return data, nil
+	case LedgerFormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		records := [][]string{ledgerHeader}
+		for _, e := range entries {
+			records = append(records, e.record())
+		}
+
+		err := w.WriteAll(records)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrDocumentLedger, err)
+		}
+
+		return buf.Bytes(), nil
+	default:
+		return nil, errors.NewTypedError(ErrDocumentLedger, errors.New("unknown format %q", format))
+	}
+}
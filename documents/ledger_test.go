@@ -0,0 +1,170 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type ledgerModel struct {
+	Model
+	id, version, previous []byte
+	status                Status
+	summaryStatus         string
+	timestamp             time.Time
+	amount                LedgerAmount
+}
+
+func (m *ledgerModel) ID() []byte                    { return m.id }
+func (m *ledgerModel) CurrentVersion() []byte        { return m.version }
+func (m *ledgerModel) PreviousVersion() []byte       { return m.previous }
+func (m *ledgerModel) GetStatus() Status             { return m.status }
+func (m *ledgerModel) DocumentType() string          { return "ledger document" }
+func (m *ledgerModel) Timestamp() (time.Time, error) { return m.timestamp, nil }
+func (m *ledgerModel) SummaryStatus() string         { return m.summaryStatus }
+func (m *ledgerModel) SummaryFields() []SummaryField { return nil }
+func (m *ledgerModel) LedgerAmount() LedgerAmount    { return m.amount }
+
+// ledgerRepo serves the models in the given order
+type ledgerRepo struct {
+	Repository
+	models []*ledgerModel
+}
+
+func (r *ledgerRepo) find(id []byte) *ledgerModel {
+	for _, m := range r.models {
+		if string(m.version) == string(id) {
+			return m
+		}
+	}
+
+	return nil
+}
+
+func (r *ledgerRepo) Exists(accountID, id []byte) bool {
+	return r.find(id) != nil
+}
+
+func (r *ledgerRepo) Get(accountID, id []byte) (Model, error) {
+	m := r.find(id)
+	if m == nil {
+		return nil, errors.New("not found")
+	}
+
+	return m, nil
+}
+
+func (r *ledgerRepo) Iterate(accountID []byte, fn func(model Model) error) error {
+	for _, m := range r.models {
+		err := fn(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestService_ExportLedger(t *testing.T) {
+	day := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	amount := LedgerAmount{Number: "INV-1", Currency: "EUR", Amount: "42"}
+	models := []*ledgerModel{
+		// document 1 created, accepted and paid
+		{id: []byte{1}, version: []byte{1}, status: StatusCommitted, summaryStatus: "unpaid", timestamp: day.Add(time.Hour), amount: amount},
+		{id: []byte{1}, version: []byte{11}, previous: []byte{1}, status: StatusCommitted, summaryStatus: "Accepted", timestamp: day.Add(2 * time.Hour), amount: amount},
+		{id: []byte{1}, version: []byte{12}, previous: []byte{11}, status: StatusCommitted, summaryStatus: "accepted", timestamp: day.Add(3 * time.Hour), amount: amount},
+		{id: []byte{1}, version: []byte{13}, previous: []byte{12}, status: StatusCommitted, summaryStatus: "paid", timestamp: day.Add(25 * time.Hour), amount: amount},
+		// document 2 created as paid, its draft is not booked
+		{id: []byte{2}, version: []byte{2}, status: StatusCommitted, summaryStatus: "paid", timestamp: day.Add(time.Hour)},
+		{id: []byte{2}, version: []byte{21}, previous: []byte{2}, status: StatusDraft, summaryStatus: "accepted", timestamp: day.Add(2 * time.Hour)},
+		// document 3 joined late as accepted
+		{id: []byte{3}, version: []byte{31}, previous: []byte{30}, status: StatusCommitted, summaryStatus: "accepted", timestamp: day.Add(-time.Hour)},
+	}
+	srv := service{repo: &ledgerRepo{models: models}}
+	accountID := testingidentity.GenerateRandomDID()
+
+	// empty range
+	_, err := srv.ExportLedger(context.Background(), accountID, day, day)
+	assert.True(t, errors.IsOfType(ErrDocumentLedger, err))
+
+	// open range
+	events, err := srv.ExportLedger(context.Background(), accountID, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	var ids []string
+	for _, e := range events {
+		ids = append(ids, e.EventID)
+	}
+
+	assert.Equal(t, []string{
+		"0x1f-accepted",
+		"0x01-created",
+		"0x02-created",
+		"0x02-paid",
+		"0x0b-accepted",
+		"0x0d-paid",
+	}, ids)
+	assert.Equal(t, LedgerEvent{
+		EventID:      "0x0b-accepted",
+		Type:         LedgerEventAccepted,
+		Timestamp:    day.Add(2 * time.Hour),
+		DocumentID:   []byte{1},
+		Version:      []byte{11},
+		DocumentType: "ledger document",
+		Status:       "accepted",
+		LedgerAmount: amount,
+	}, events[4])
+
+	// first day
+	events, err = srv.ExportLedger(context.Background(), accountID, day, day.AddDate(0, 0, 1))
+	assert.NoError(t, err)
+	assert.Len(t, events, 4)
+	assert.Equal(t, "0x01-created", events[0].EventID)
+	assert.Equal(t, "0x0b-accepted", events[3].EventID)
+}
+
+func TestRenderLedger(t *testing.T) {
+	events := []LedgerEvent{{
+		EventID:      "0x0b-accepted",
+		Type:         LedgerEventAccepted,
+		Timestamp:    time.Date(2019, 1, 1, 2, 0, 0, 0, time.FixedZone("CET", 3600)),
+		DocumentID:   []byte{1},
+		Version:      []byte{11},
+		DocumentType: "invoice",
+		Status:       "accepted",
+		LedgerAmount: LedgerAmount{Number: "INV-1, A", Currency: "EUR", Amount: "42.5"},
+	}}
+
+	data, err := RenderLedger(events, LedgerFormatCSV)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"event_id,event_type,timestamp,document_id,version_id,document_type,status,number,currency,amount",
+		`0x0b-accepted,accepted,2019-01-01T01:00:00Z,0x01,0x0b,invoice,accepted,"INV-1, A",EUR,42.5`,
+		"",
+	}, "\n"), string(data))
+
+	data, err = RenderLedger(events, LedgerFormatJSON)
+	assert.NoError(t, err)
+	var entries []map[string]string
+	assert.NoError(t, json.Unmarshal(data, &entries))
+	assert.Len(t, entries, 1)
+	assert.Equal(t, hexutil.Encode([]byte{11}), entries[0]["version_id"])
+	assert.Equal(t, "2019-01-01T01:00:00Z", entries[0]["timestamp"])
+	assert.Equal(t, "42.5", entries[0]["amount"])
+
+	// no events
+	data, err = RenderLedger(nil, LedgerFormatJSON)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", string(data))
+
+	_, err = RenderLedger(events, LedgerFormat("xml"))
+	assert.True(t, errors.IsOfType(ErrDocumentLedger, err))
+}
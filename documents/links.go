@@ -0,0 +1,79 @@
+package documents
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// LinkedDocumentsField is the core document field holding the links to other documents
+const LinkedDocumentsField = "linked_documents"
+
+// AddLink returns the next version of the core document linking the document with documentID at its anchored documentRoot.
+// An existing link to the document is updated to the new root.
+// The link is part of the cd_tree, so it can be proven against the anchored root of the next version.
+func (cd *CoreDocument) AddLink(documentID, documentRoot []byte) (*CoreDocument, error) {
+	if len(documentID) != idSize {
+		return nil, errors.New("invalid linked document identifier length %d", len(documentID))
+	}
+
+	if len(documentRoot) != idSize {
+		return nil, errors.New("invalid linked document root length %d", len(documentRoot))
+	}
+
+	if bytes.Equal(documentID, cd.ID()) {
+		return nil, errors.New("document can't be linked to itself")
+	}
+
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{}, false, nil)
+	if err != nil {
+		return nil, errors.New("failed to prepare new version: %v", err)
+	}
+
+	// links are shared with the previous version, replace instead of updating in place
+	var links []*coredocumentextpb.LinkedDocument
+	var updated bool
+	for _, l := range ncd.Extension.LinkedDocuments {
+		if bytes.Equal(l.DocumentIdentifier, documentID) {
+			l = &coredocumentextpb.LinkedDocument{DocumentIdentifier: documentID, DocumentRoot: documentRoot}
+			updated = true
+		}
+
+		links = append(links, l)
+	}
+
+	if !updated {
+		links = append(links, &coredocumentextpb.LinkedDocument{DocumentIdentifier: documentID, DocumentRoot: documentRoot})
+	}
+
+	ncd.Extension.LinkedDocuments = links
+	return ncd, ncd.setSalts()
+}
+
+// LinkedDocuments returns the links of the document to other documents.
+func (cd *CoreDocument) LinkedDocuments() []*coredocumentextpb.LinkedDocument {
+	return cd.Extension.LinkedDocuments
+}
+
+// NFTs returns the NFTs minted on the document.
+func (cd *CoreDocument) NFTs() []*coredocumentpb.NFT {
+	return cd.Document.Nfts
+}
+
+// LinkProofFields returns the fields to request to prove the link to the document with documentID.
+func (cd *CoreDocument) LinkProofFields(documentID []byte) ([]string, error) {
+	for i, l := range cd.Extension.LinkedDocuments {
+		if !bytes.Equal(l.DocumentIdentifier, documentID) {
+			continue
+		}
+
+		prefix := fmt.Sprintf("%s.%s[%d]", CDTreePrefix, LinkedDocumentsField, i)
+		return []string{prefix + ".document_identifier", prefix + ".document_root"}, nil
+	}
+
+	return nil, errors.New("document %s is not linked", hexutil.Encode(documentID))
+}
@@ -0,0 +1,88 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/centrifuge/precise-proofs/proofs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoreDocument_AddLink(t *testing.T) {
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	linkedID, root := utils.RandomSlice(idSize), utils.RandomSlice(idSize)
+
+	// invalid links
+	_, err = cd.AddLink(utils.RandomSlice(20), root)
+	assert.Error(t, err)
+	_, err = cd.AddLink(linkedID, nil)
+	assert.Error(t, err)
+	_, err = cd.AddLink(cd.ID(), root)
+	assert.Error(t, err)
+	_, err = cd.LinkProofFields(linkedID)
+	assert.Error(t, err)
+
+	ncd, err := cd.AddLink(linkedID, root)
+	assert.NoError(t, err)
+	assert.Empty(t, cd.LinkedDocuments())
+	assert.Len(t, ncd.LinkedDocuments(), 1)
+	assert.Equal(t, cd.NextVersion(), ncd.CurrentVersion())
+	fields, err := ncd.LinkProofFields(linkedID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cd_tree.linked_documents[0].document_identifier", "cd_tree.linked_documents[0].document_root"}, fields)
+
+	// links are carried over to the next versions
+	ncd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	nncd, err := ncd.PrepareNewVersion(CollaboratorsAccess{}, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, ncd.LinkedDocuments(), nncd.LinkedDocuments())
+
+	// second link and update of the first one
+	secondID := utils.RandomSlice(idSize)
+	nncd, err = ncd.AddLink(secondID, root)
+	assert.NoError(t, err)
+	nncd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	newRoot := utils.RandomSlice(idSize)
+	nncd, err = nncd.AddLink(linkedID, newRoot)
+	assert.NoError(t, err)
+	assert.Len(t, nncd.LinkedDocuments(), 2)
+	assert.Equal(t, newRoot, nncd.LinkedDocuments()[0].DocumentRoot)
+	assert.Equal(t, secondID, nncd.LinkedDocuments()[1].DocumentIdentifier)
+
+	// previous versions are not changed
+	assert.Equal(t, root, ncd.LinkedDocuments()[0].DocumentRoot)
+}
+
+func TestCoreDocument_linkProof(t *testing.T) {
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	linkedID, root := utils.RandomSlice(idSize), utils.RandomSlice(idSize)
+	cd, err = cd.AddLink(linkedID, root)
+	assert.NoError(t, err)
+
+	dataTree := NewDefaultTreeWithPrefix(nil, "prefix", []byte{1, 0, 0, 0})
+	assert.NoError(t, dataTree.AddLeaf(proofs.LeafNode{Hash: utils.RandomSlice(32), Hashed: true, Property: NewLeafProperty("prefix.sample_field", []byte{1, 0, 0, 0, 0, 0, 0, 200})}))
+	assert.NoError(t, dataTree.Generate())
+	cd.Document.DataRoot = dataTree.RootHash()
+	_, err = cd.CalculateSigningRoot(documenttypes.InvoiceDataTypeUrl)
+	assert.NoError(t, err)
+	dr, err := cd.CalculateDocumentRoot()
+	assert.NoError(t, err)
+
+	fields, err := cd.LinkProofFields(linkedID)
+	assert.NoError(t, err)
+	prfs, err := cd.CreateProofs(documenttypes.InvoiceDataTypeUrl, dataTree, fields)
+	assert.NoError(t, err)
+	assert.Len(t, prfs, 2)
+	assert.Equal(t, linkedID, prfs[0].Value)
+	assert.Equal(t, root, prfs[1].Value)
+	for _, p := range prfs {
+		assert.NoError(t, ValidateProof(dr, p))
+	}
+}
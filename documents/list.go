@@ -0,0 +1,126 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+)
+
+const (
+	// DefaultPerPage is the number of documents in a page if no page size is requested.
+	DefaultPerPage = 20
+
+	// MaxPerPage is the maximum number of documents in a page.
+	MaxPerPage = 100
+)
+
+// DocumentItem describes a document held by the node through its latest locally known version.
+type DocumentItem struct {
+	DocumentID    []byte
+	DocumentType  string
+	Status        Status
+	LatestVersion []byte
+
+	// Cancelled is true if the latest version cancelled the document.
+	Cancelled bool
+
+	// SupersededBy is the ID of the document superseding the cancelled document.
+	SupersededBy []byte
+}
+
+// newDocumentItem returns the listing item of the document with model as its latest version.
+func newDocumentItem(model Model) DocumentItem {
+	item := DocumentItem{
+		DocumentID:    model.ID(),
+		DocumentType:  model.DocumentType(),
+		Status:        model.GetStatus(),
+		LatestVersion: model.CurrentVersion(),
+	}
+
+	if c, ok := model.(cancellable); ok {
+		item.Cancelled = c.Cancelled()
+		item.SupersededBy = c.SupersededBy()
+	}
+
+	return item
+}
+
+// DocumentPage is a page of the documents of an account, ordered by document ID.
+type DocumentPage struct {
+	Documents []DocumentItem
+	Page      int
+	PerPage   int
+
+	// Total is the number of documents of the account over all the pages.
+	Total int
+}
+
+// latestItem is a candidate for the latest version of a document during the listing.
+type latestItem struct {
+	item      DocumentItem
+	timestamp time.Time
+}
+
+// List returns the page of the documents owned by accountID. Pages start at 1.
+// perPage defaults to DefaultPerPage if 0 and must not exceed MaxPerPage.
+func (s service) List(ctx context.Context, accountID identity.DID, page, perPage int) (*DocumentPage, error) {
+	if perPage == 0 {
+		perPage = DefaultPerPage
+	}
+
+	if page < 1 || perPage < 0 || perPage > MaxPerPage {
+		return nil, errors.NewTypedError(ErrDocumentPagination, errors.New("page must be at least 1 and page size between 1 and %d", MaxPerPage))
+	}
+
+	accID := accountID[:]
+	latest := make(map[string]latestItem)
+	err := s.repo.Iterate(accID, func(model Model) error {
+		// versions followed by a locally known version are not the latest
+		if s.repo.Exists(accID, model.NextVersion()) {
+			return nil
+		}
+
+		// versions may be missing in between, the newest candidate is the latest version
+		ts, _ := model.Timestamp()
+		id := string(model.ID())
+		if l, ok := latest[id]; ok && l.timestamp.After(ts) {
+			return nil
+		}
+
+		latest[id] = latestItem{
+			item:      newDocumentItem(model),
+			timestamp: ts,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]DocumentItem, 0, len(latest))
+	for _, l := range latest {
+		items = append(items, l.item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return bytes.Compare(items[i].DocumentID, items[j].DocumentID) < 0
+	})
+
+	dp := &DocumentPage{Page: page, PerPage: perPage, Total: len(items)}
+	start := (page - 1) * perPage
+	if start >= len(items) {
+		return dp, nil
+	}
+
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	dp.Documents = items[start:end]
+	return dp, nil
+}
@@ -0,0 +1,112 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+type listModel struct {
+	Model
+	id, version, next []byte
+	status            Status
+	timestamp         time.Time
+}
+
+func (m *listModel) ID() []byte                    { return m.id }
+func (m *listModel) CurrentVersion() []byte        { return m.version }
+func (m *listModel) NextVersion() []byte           { return m.next }
+func (m *listModel) GetStatus() Status             { return m.status }
+func (m *listModel) DocumentType() string          { return "list document" }
+func (m *listModel) Timestamp() (time.Time, error) { return m.timestamp, nil }
+
+// listRepo serves the models in the given order
+type listRepo struct {
+	Repository
+	models []*listModel
+	err    error
+}
+
+func (r *listRepo) Exists(accountID, id []byte) bool {
+	for _, m := range r.models {
+		if string(m.version) == string(id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *listRepo) Iterate(accountID []byte, fn func(model Model) error) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	for _, m := range r.models {
+		err := fn(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestService_List(t *testing.T) {
+	now := time.Now()
+	models := []*listModel{
+		// document 2 with a missing version in between
+		{id: []byte{2}, version: []byte{2}, next: []byte{21}, status: StatusCommitted, timestamp: now},
+		{id: []byte{2}, version: []byte{22}, next: []byte{23}, status: StatusDraft, timestamp: now.Add(time.Minute)},
+		// document 1 with two versions
+		{id: []byte{1}, version: []byte{1}, next: []byte{11}, status: StatusCommitted, timestamp: now},
+		{id: []byte{1}, version: []byte{11}, next: []byte{12}, status: StatusCommitting, timestamp: now.Add(time.Minute)},
+		// document 3 with a single version
+		{id: []byte{3}, version: []byte{3}, next: []byte{31}, status: StatusCommitted, timestamp: now},
+	}
+	srv := service{repo: &listRepo{models: models}}
+	accountID := testingidentity.GenerateRandomDID()
+
+	// invalid pages
+	for _, c := range [][2]int{{0, 10}, {1, -1}, {1, MaxPerPage + 1}} {
+		_, err := srv.List(context.Background(), accountID, c[0], c[1])
+		assert.Error(t, err)
+		assert.True(t, errors.IsOfType(ErrDocumentPagination, err))
+	}
+
+	// all the documents with the default page size
+	dp, err := srv.List(context.Background(), accountID, 1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultPerPage, dp.PerPage)
+	assert.Equal(t, 3, dp.Total)
+	assert.Equal(t, []DocumentItem{
+		{DocumentID: []byte{1}, DocumentType: "list document", Status: StatusCommitting, LatestVersion: []byte{11}},
+		{DocumentID: []byte{2}, DocumentType: "list document", Status: StatusDraft, LatestVersion: []byte{22}},
+		{DocumentID: []byte{3}, DocumentType: "list document", Status: StatusCommitted, LatestVersion: []byte{3}},
+	}, dp.Documents)
+
+	// second page
+	dp, err = srv.List(context.Background(), accountID, 2, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, dp.Total)
+	assert.Len(t, dp.Documents, 1)
+	assert.Equal(t, []byte{3}, dp.Documents[0].DocumentID)
+
+	// page after the last one
+	dp, err = srv.List(context.Background(), accountID, 3, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, dp.Total)
+	assert.Empty(t, dp.Documents)
+
+	// iteration failure
+	srv = service{repo: &listRepo{err: errors.New("failed to iterate")}}
+	_, err = srv.List(context.Background(), accountID, 1, 0)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to iterate")
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
 	"github.com/centrifuge/go-centrifuge/storage"
 	"github.com/centrifuge/precise-proofs/proofs/proto"
 	"github.com/ethereum/go-ethereum/common"
@@ -83,6 +84,68 @@ type Model interface {
 	// Note: The Document should be anchored after successfully adding the NFT.
 	AddNFT(grantReadAccess bool, registry common.Address, tokenID []byte) error
 
+	// LinkDocument links the anchored document root of another document to the Document.
+	// Note: The Document should be anchored after successfully adding the link.
+	LinkDocument(documentID, documentRoot []byte) error
+
+	// RemoveCollaborators removes the collaborators from the read and transition rules of the Document.
+	// Note: The Document should be anchored after successfully removing the collaborators.
+	RemoveCollaborators(collaborators []identity.DID) error
+
+	// SetFieldRules restricts the collaborators of the rules to editing the data fields of their rules.
+	// Note: The rules can only be set on a draft.
+	SetFieldRules(mappings []PropertyMapping, rules []FieldRule) error
+
+	// LinkProofFields returns the fields to request to prove the link to the document with documentID.
+	LinkProofFields(documentID []byte) ([]string, error)
+
+	// AddAttributeSignature adds the signature of a third party over one of the attributes to the Document.
+	// Note: The Document should be anchored after successfully adding the signature.
+	AddAttributeSignature(sig *coredocumentextpb.AttributeSignature) error
+
+	// AttributeSignatureProofFields returns the fields to request to prove the signature of the signer over the attribute.
+	AttributeSignatureProofFields(signer identity.DID, property string) ([]string, error)
+
+	// AddTransferDetail adds the detail of a transfer against the Document, replacing an earlier detail of the transfer.
+	// Note: The Document should be anchored after successfully adding the detail.
+	AddTransferDetail(detail *coredocumentextpb.TransferDetail) error
+
+	// TransferDetails returns the details of the transfers recorded on the Document.
+	TransferDetails() []*coredocumentextpb.TransferDetail
+
+	// TransferDetail returns the detail of the transfer recorded on the Document.
+	TransferDetail(transferID []byte) (*coredocumentextpb.TransferDetail, error)
+
+	// TransferDetailProofFields returns the fields to request to prove the detail of the transfer.
+	TransferDetailProofFields(transferID []byte) ([]string, error)
+
+	// AddAttachment adds the attachment to the Document, replacing an earlier attachment with the same name.
+	// Note: The Document should be anchored after successfully adding the attachment.
+	AddAttachment(attachment *coredocumentextpb.Attachment) error
+
+	// Attachments returns the attachments of the Document.
+	Attachments() []*coredocumentextpb.Attachment
+
+	// Attachment returns the attachment of the Document with the name.
+	Attachment(name string) (*coredocumentextpb.Attachment, error)
+
+	// AttachmentProofFields returns the fields to request to prove the attachment with the name.
+	AttachmentProofFields(name string) ([]string, error)
+
+	// UpdateTags adds and removes the tags of the Document on behalf of the account, as allowed by its transition rules.
+	// Note: The Document should be anchored after successfully updating the tags.
+	UpdateTags(account identity.DID, add, remove []string) error
+
+	// Tags returns the tags of the Document.
+	Tags() []string
+
+	// TagProofFields returns the fields to request to prove the tags of the Document.
+	TagProofFields() []string
+
+	// Cancel cancels the Document, optionally superseded by the document with supersededBy.
+	// Note: The Document should be anchored after successfully cancelling it, no further versions are accepted.
+	Cancel(supersededBy []byte) error
+
 	// GetCollaborators returns the collaborators of this Document.
 	// filter ids should not be returned
 	// Note: returns all the collaborators with Read and Read_Sign permission
@@ -91,6 +154,9 @@ type Model interface {
 	// GetSignerCollaborators works like GetCollaborators except it returns only those with Read_Sign permission.
 	GetSignerCollaborators(filterIDs ...identity.DID) ([]identity.DID, error)
 
+	// CheckRequiredSignatures returns an error if the collaborators of a read sign rule added fewer signatures than the rule requires.
+	CheckRequiredSignatures() error
+
 	// AccountCanRead returns true if the account can read the document
 	AccountCanRead(account identity.DID) bool
 
@@ -100,6 +166,12 @@ type Model interface {
 	// ATGranteeCanRead returns error if the access token grantee cannot read the document.
 	ATGranteeCanRead(ctx context.Context, idSrv identity.ServiceDID, tokenID, docID []byte, grantee identity.DID) (err error)
 
+	// ATGranteeCanRequestProofs returns error if the access token grantee cannot request proofs for the fields of the document.
+	ATGranteeCanRequestProofs(ctx context.Context, idSrv identity.ServiceDID, tokenID, docID []byte, fields []string, grantee identity.DID) (err error)
+
+	// ActiveAccessTokens returns the access tokens of the document that have not expired at now.
+	ActiveAccessTokens(now time.Time) ([]AccessToken, error)
+
 	// AddUpdateLog adds a log to the model to persist an update related meta data such as author
 	AddUpdateLog(account identity.DID) error
 
@@ -109,8 +181,17 @@ type Model interface {
 	// Timestamp is the time of update in UTC of the document version represented by the model
 	Timestamp() (time.Time, error)
 
+	// GetStatus returns the lifecycle status of the document version.
+	GetStatus() Status
+
+	// SetStatus sets the lifecycle status of the document version.
+	SetStatus(status Status)
+
 	// CollaboratorCanUpdate returns an error if indicated identity does not have the capacity to update the document.
 	CollaboratorCanUpdate(updated Model, collaborator identity.DID) error
+
+	// ChangedFields returns the fields changed in the updated version of the document, core document fields included.
+	ChangedFields(updated Model) ([]ChangedField, error)
 }
 
 // TokenRegistry defines NFT related functions.
@@ -0,0 +1,153 @@
+package documents
+
+import (
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+)
+
+// MultiProof covers several fields of a document with a single set of sibling hashes.
+// The proofs of the fields of a document share most of their sibling hashes, the hashes of the upper levels of the
+// trees in particular, so every hash is included once and the fields refer to their sorted hashes by index.
+type MultiProof struct {
+	Hashes [][]byte
+	Fields []MultiProofField
+}
+
+// MultiProofField is the proof of a field of a multiproof.
+type MultiProofField struct {
+	// Property is the compact name of the field
+	Property []byte
+	Value    []byte
+	Salt     []byte
+	Hash     []byte
+
+	// HashIndexes are the indexes of the sorted hashes of the field in the hashes of the multiproof
+	HashIndexes []uint32
+}
+
+// NewMultiProof returns the multiproof covering the fields of the proofs.
+// The proofs must be of compact properties.
+func NewMultiProof(prfs []*proofspb.Proof) (*MultiProof, error) {
+	mp := new(MultiProof)
+	indexes := make(map[string]uint32)
+	for _, p := range prfs {
+		compact := p.GetCompactName()
+		if len(compact) == 0 {
+			return nil, errors.New("proof of %s has no compact name", p.GetReadableName())
+		}
+
+		f := MultiProofField{Property: compact, Value: p.Value, Salt: p.Salt, Hash: p.Hash}
+		for _, h := range p.SortedHashes {
+			i, ok := indexes[string(h)]
+			if !ok {
+				i = uint32(len(mp.Hashes))
+				indexes[string(h)] = i
+				mp.Hashes = append(mp.Hashes, h)
+			}
+
+			f.HashIndexes = append(f.HashIndexes, i)
+		}
+
+		mp.Fields = append(mp.Fields, f)
+	}
+
+	return mp, nil
+}
+
+// Proofs returns the proofs of the fields of the multiproof, in the order of the fields.
+func (mp *MultiProof) Proofs() ([]*proofspb.Proof, error) {
+	var prfs []*proofspb.Proof
+	for _, f := range mp.Fields {
+		p := &proofspb.Proof{
+			Property: &proofspb.Proof_CompactName{CompactName: f.Property},
+			Value:    f.Value,
+			Salt:     f.Salt,
+			Hash:     f.Hash,
+		}
+
+		for _, i := range f.HashIndexes {
+			if int(i) >= len(mp.Hashes) {
+				return nil, errors.New("hash index %d of property %x out of range", i, f.Property)
+			}
+
+			p.SortedHashes = append(p.SortedHashes, mp.Hashes[i])
+		}
+
+		prfs = append(prfs, p)
+	}
+
+	return prfs, nil
+}
+
+// ConvertMultiProofToClientFormat converts the multiproof to client api format.
+func ConvertMultiProofToClientFormat(mp *MultiProof) *documentpb.MultiProof {
+	cmp := &documentpb.MultiProof{Hashes: utils.SliceOfByteSlicesToHexStringSlice(mp.Hashes)}
+	for _, f := range mp.Fields {
+		cp := ConvertProofToClientFormat(&proofspb.Proof{
+			Property: &proofspb.Proof_CompactName{CompactName: f.Property},
+			Value:    f.Value,
+			Salt:     f.Salt,
+			Hash:     f.Hash,
+		})
+
+		cmp.Fields = append(cmp.Fields, &documentpb.MultiProofField{
+			Property:    cp.Property,
+			Value:       cp.Value,
+			Salt:        cp.Salt,
+			Hash:        cp.Hash,
+			HashIndexes: f.HashIndexes,
+		})
+	}
+
+	return cmp
+}
+
+// ConvertMultiProofFromClientFormat converts the multiproof in client api format.
+func ConvertMultiProofFromClientFormat(cmp *documentpb.MultiProof) (*MultiProof, error) {
+	mp := new(MultiProof)
+	for _, h := range cmp.Hashes {
+		b, err := decodeHex(h)
+		if err != nil {
+			return nil, errors.New("failed to decode multiproof hash: %v", err)
+		}
+
+		mp.Hashes = append(mp.Hashes, b)
+	}
+
+	for i, cf := range cmp.Fields {
+		p, err := ConvertProofFromClientFormat(&documentpb.Proof{Property: cf.Property, Value: cf.Value, Salt: cf.Salt, Hash: cf.Hash})
+		if err != nil {
+			return nil, errors.New("failed to convert multiproof field %d: %v", i, err)
+		}
+
+		mp.Fields = append(mp.Fields, MultiProofField{
+			Property:    p.GetCompactName(),
+			Value:       p.Value,
+			Salt:        p.Salt,
+			Hash:        p.Hash,
+			HashIndexes: cf.HashIndexes,
+		})
+	}
+
+	return mp, nil
+}
+
+// ConvertDocProofToMultiProofClientFormat converts the DocumentProof to client api format with the field proofs
+// replaced by a single multiproof.
+func ConvertDocProofToMultiProofClientFormat(proof *DocumentProof) (*documentpb.DocumentProof, error) {
+	mp, err := NewMultiProof(proof.FieldProofs)
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := ConvertDocProofToClientFormat(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	cp.FieldProofs = nil
+	cp.MultiProof = ConvertMultiProofToClientFormat(mp)
+	return cp, nil
+}
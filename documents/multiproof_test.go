@@ -0,0 +1,100 @@
+// +build unit
+
+package documents
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/centrifuge/precise-proofs/proofs"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// testMultiProofBundle returns the proof bundle of the first two leaves of a tree of four leaves.
+func testMultiProofBundle(t *testing.T) *DocumentProof {
+	tree := NewDefaultTreeWithPrefix(nil, "prefix", []byte{1, 0, 0, 0})
+	var props []proofs.Property
+	for i := byte(0); i < 4; i++ {
+		prop := NewLeafProperty(fmt.Sprintf("prefix.sample_field%d", i), []byte{1, 0, 0, 0, 0, 0, 0, i})
+		err := tree.AddLeaf(proofs.LeafNode{Hash: utils.RandomSlice(32), Hashed: true, Property: prop})
+		assert.NoError(t, err)
+		props = append(props, prop)
+	}
+
+	assert.NoError(t, tree.Generate())
+	proof := &DocumentProof{DocumentID: utils.RandomSlice(32), VersionID: utils.RandomSlice(32), DocumentRoot: tree.RootHash()}
+	for _, prop := range props[:2] {
+		p, err := tree.CreateProof(prop.ReadableName())
+		assert.NoError(t, err)
+		proof.FieldProofs = append(proof.FieldProofs, &p)
+	}
+
+	return proof
+}
+
+func TestNewMultiProof(t *testing.T) {
+	proof := testMultiProofBundle(t)
+	mp, err := NewMultiProof(proof.FieldProofs)
+	assert.NoError(t, err)
+	assert.Len(t, mp.Fields, 2)
+
+	// sibling hashes shared by the fields are included once
+	var hashes int
+	for _, p := range proof.FieldProofs {
+		hashes += len(p.SortedHashes)
+	}
+	assert.True(t, len(mp.Hashes) < hashes)
+
+	prfs, err := mp.Proofs()
+	assert.NoError(t, err)
+	assert.Len(t, prfs, 2)
+	for i, p := range prfs {
+		assert.Equal(t, proof.FieldProofs[i].GetCompactName(), p.GetCompactName())
+		assert.Equal(t, proof.FieldProofs[i].Hash, p.Hash)
+		assert.Equal(t, proof.FieldProofs[i].SortedHashes, p.SortedHashes)
+		assert.NoError(t, ValidateProof(proof.DocumentRoot, p))
+	}
+
+	// readable properties
+	_, err = NewMultiProof([]*proofspb.Proof{{Property: &proofspb.Proof_ReadableName{ReadableName: "prefix.sample_field"}}})
+	assert.Error(t, err)
+
+	// hash index out of range
+	mp.Fields[1].HashIndexes[0] = uint32(len(mp.Hashes))
+	_, err = mp.Proofs()
+	assert.Error(t, err)
+}
+
+func TestConvertMultiProof(t *testing.T) {
+	proof := testMultiProofBundle(t)
+	cp, err := ConvertDocProofToMultiProofClientFormat(proof)
+	assert.NoError(t, err)
+	assert.Empty(t, cp.FieldProofs)
+	assert.Len(t, cp.MultiProof.Fields, 2)
+	assert.Equal(t, hexutil.Encode(proof.FieldProofs[0].GetCompactName()), cp.MultiProof.Fields[0].Property)
+
+	// the fields of the multiproof are verified like the field proofs
+	dp, err := ConvertClientProofToDocProof(cp)
+	assert.NoError(t, err)
+	assert.Len(t, dp.FieldProofs, 2)
+	assert.NoError(t, VerifyProofBundle(dp))
+
+	// invalid hex
+	cp.MultiProof.Fields[0].Salt = "0xzz"
+	_, err = ConvertClientProofToDocProof(cp)
+	assert.Error(t, err)
+	cp.MultiProof.Hashes[0] = "0xzz"
+	_, err = ConvertMultiProofFromClientFormat(cp.MultiProof)
+	assert.Error(t, err)
+
+	// hash index out of range
+	_, err = ConvertClientProofToDocProof(&documentpb.DocumentProof{MultiProof: &documentpb.MultiProof{
+		Hashes: []string{hexutil.Encode(utils.RandomSlice(32))},
+		Fields: []*documentpb.MultiProofField{{Property: "0x01000000", HashIndexes: []uint32{1}}},
+	}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,202 @@
+package documents
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/notary"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/golang/protobuf/proto"
+)
+
+// ProofBundleMessage returns the message signed by the node and the notary for the proof bundle.
+// The message is the sha256 hash of the serialised bundle without the signatures.
+func ProofBundleMessage(proof *DocumentProof) ([]byte, error) {
+	data, err := proto.Marshal(&notarypb.ProofBundle{
+		DocumentId:   proof.DocumentID,
+		VersionId:    proof.VersionID,
+		DocumentRoot: proof.DocumentRoot,
+		FieldProofs:  proof.FieldProofs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.Sum256(data)
+	return h[:], nil
+}
+
+// validateFieldProofs checks that every field proof of the bundle resolves to the document root.
+// The proofs are verified with the verifier of the envelope version of the bundle.
+func validateFieldProofs(proof *DocumentProof) error {
+	verify, err := ProofVerifier(proof)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range proof.FieldProofs {
+		err = verify(proof.DocumentRoot, p)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConvertDocProofToProofBundle converts a DocumentProof to the p2p proof bundle.
+func ConvertDocProofToProofBundle(proof *DocumentProof) *notarypb.ProofBundle {
+	return &notarypb.ProofBundle{
+		DocumentId:   proof.DocumentID,
+		VersionId:    proof.VersionID,
+		DocumentRoot: proof.DocumentRoot,
+		FieldProofs:  proof.FieldProofs,
+		Signatures:   proof.Signatures,
+	}
+}
+
+// ConvertProofBundleToDocProof converts a p2p proof bundle to a DocumentProof.
+func ConvertProofBundleToDocProof(bundle *notarypb.ProofBundle) *DocumentProof {
+	return &DocumentProof{
+		DocumentID:   bundle.DocumentId,
+		VersionID:    bundle.VersionId,
+		DocumentRoot: bundle.DocumentRoot,
+		FieldProofs:  bundle.FieldProofs,
+		Signatures:   bundle.Signatures,
+	}
+}
+
+// ProofNotary requests co-signatures over proof bundles from the configured notary.
+type ProofNotary interface {
+	// Notarize signs the proof bundle and requests the co-signature of the notary.
+	// Both signatures are added to the bundle.
+	Notarize(ctx context.Context, proof *DocumentProof) error
+}
+
+// proofNotary implements ProofNotary
+type proofNotary struct {
+	notaryID  *identity.DID
+	p2pClient Client
+	idService identity.ServiceDID
+}
+
+// NewProofNotary returns the default implementation of ProofNotary.
+// Notarize fails with ErrNotaryNotConfigured if notaryID is nil.
+func NewProofNotary(notaryID *identity.DID, p2pClient Client, idService identity.ServiceDID) ProofNotary {
+	return proofNotary{
+		notaryID:  notaryID,
+		p2pClient: p2pClient,
+		idService: idService,
+	}
+}
+
+// Notarize signs the proof bundle and requests the co-signature of the notary.
+func (pn proofNotary) Notarize(ctx context.Context, proof *DocumentProof) error {
+	if pn.notaryID == nil {
+		return ErrNotaryNotConfigured
+	}
+
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		return ErrDocumentConfigAccountID
+	}
+
+	msg, err := ProofBundleMessage(proof)
+	if err != nil {
+		return errors.NewTypedError(ErrProofNotarization, err)
+	}
+
+	sig, err := acc.SignMsg(msg)
+	if err != nil {
+		return errors.NewTypedError(ErrProofNotarization, err)
+	}
+
+	proof.Signatures = []*coredocumentpb.Signature{sig}
+	notarySig, err := pn.p2pClient.GetNotarySignature(ctx, *pn.notaryID, proof)
+	if err != nil {
+		return errors.NewTypedError(ErrProofNotarization, err)
+	}
+
+	err = identity.ValidateDIDBytes(notarySig.SignerId, *pn.notaryID)
+	if err != nil {
+		return errors.NewTypedError(ErrProofNotarization, err)
+	}
+
+	err = pn.idService.ValidateSignature(*pn.notaryID, notarySig.PublicKey, notarySig.Signature, msg, time.Now().UTC())
+	if err != nil {
+		return errors.NewTypedError(ErrProofNotarization, errors.New("invalid notary signature: %v", err))
+	}
+
+	proof.Signatures = append(proof.Signatures, notarySig)
+	return nil
+}
+
+// NotarizeProofBundle verifies the proof bundle signed by the requester and co-signs it.
+// The document root of the bundle must be anchored for the version and every field proof must resolve to it.
+func (s service) NotarizeProofBundle(ctx context.Context, proof *DocumentProof, requester identity.DID) (*coredocumentpb.Signature, error) {
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		return nil, ErrDocumentConfigAccountID
+	}
+
+	if proof == nil {
+		return nil, errors.NewTypedError(ErrProofNotarization, errors.New("nil proof bundle"))
+	}
+
+	msg, err := ProofBundleMessage(proof)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrProofNotarization, err)
+	}
+
+	if len(proof.Signatures) != 1 {
+		return nil, errors.NewTypedError(ErrProofNotarization, errors.New("proof bundle must be signed by the requester only"))
+	}
+
+	sig := proof.Signatures[0]
+	err = identity.ValidateDIDBytes(sig.SignerId, requester)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrProofNotarization, err)
+	}
+
+	err = s.idService.ValidateSignature(requester, sig.PublicKey, sig.Signature, msg, time.Now().UTC())
+	if err != nil {
+		return nil, errors.NewTypedError(ErrProofNotarization, errors.New("invalid requester signature: %v", err))
+	}
+
+	anchorID, err := anchors.ToAnchorID(proof.VersionID)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrProofNotarization, errors.New("failed to get anchorID: %v", err))
+	}
+
+	docRoot, err := anchors.ToDocumentRoot(proof.DocumentRoot)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrProofNotarization, errors.New("failed to get document root: %v", err))
+	}
+
+	gotRoot, _, err := s.anchorRepository.GetAnchorData(anchorID)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrProofNotarization, errors.New("failed to get document root for anchor %s from chain: %v", anchorID.String(), err))
+	}
+
+	if !utils.IsSameByteSlice(docRoot[:], gotRoot[:]) {
+		return nil, errors.NewTypedError(ErrProofNotarization, errors.New("anchored document root mismatch"))
+	}
+
+	err = validateFieldProofs(proof)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrProofNotarization, err)
+	}
+
+	notarySig, err := acc.SignMsg(msg)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrProofNotarization, err)
+	}
+
+	return notarySig, nil
+}
@@ -0,0 +1,219 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/centrifuge/precise-proofs/proofs"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func testProofBundle(t *testing.T) *DocumentProof {
+	tree := NewDefaultTreeWithPrefix(nil, "prefix", []byte{1, 0, 0, 0})
+	props := []proofs.Property{NewLeafProperty("prefix.sample_field", []byte{1, 0, 0, 0, 0, 0, 0, 200}), NewLeafProperty("prefix.sample_field2", []byte{1, 0, 0, 0, 0, 0, 0, 202})}
+	for _, prop := range props {
+		err := tree.AddLeaf(proofs.LeafNode{Hash: utils.RandomSlice(32), Hashed: true, Property: prop})
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, tree.Generate())
+	var fieldProofs []*proofspb.Proof
+	for _, prop := range props {
+		p, err := tree.CreateProof(prop.ReadableName())
+		assert.NoError(t, err)
+		fieldProofs = append(fieldProofs, &p)
+	}
+
+	return &DocumentProof{
+		DocumentID:   utils.RandomSlice(32),
+		VersionID:    utils.RandomSlice(32),
+		DocumentRoot: tree.RootHash(),
+		FieldProofs:  fieldProofs,
+	}
+}
+
+func TestProofBundleMessage(t *testing.T) {
+	proof := testProofBundle(t)
+	msg, err := ProofBundleMessage(proof)
+	assert.NoError(t, err)
+	assert.Len(t, msg, 32)
+
+	// signatures are not part of the message
+	proof.Signatures = []*coredocumentpb.Signature{{Signature: utils.RandomSlice(64)}}
+	msg1, err := ProofBundleMessage(proof)
+	assert.NoError(t, err)
+	assert.Equal(t, msg, msg1)
+
+	// document root is part of the message
+	proof.DocumentRoot = utils.RandomSlice(32)
+	msg1, err = ProofBundleMessage(proof)
+	assert.NoError(t, err)
+	assert.NotEqual(t, msg, msg1)
+}
+
+func TestValidateFieldProofs(t *testing.T) {
+	proof := testProofBundle(t)
+	assert.NoError(t, validateFieldProofs(proof))
+
+	// proof without the leaf hash
+	proof.FieldProofs[0].Hash = nil
+	assert.Error(t, validateFieldProofs(proof))
+
+	// different root
+	proof = testProofBundle(t)
+	proof.DocumentRoot = utils.RandomSlice(32)
+	assert.Error(t, validateFieldProofs(proof))
+}
+
+func TestConvertProofBundle(t *testing.T) {
+	proof := testProofBundle(t)
+	proof.Signatures = []*coredocumentpb.Signature{{SignerId: utils.RandomSlice(20), Signature: utils.RandomSlice(64)}}
+	assert.Equal(t, proof, ConvertProofBundleToDocProof(ConvertDocProofToProofBundle(proof)))
+}
+
+func TestProofNotary_Notarize(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	acc, err := contextutil.Account(ctx)
+	assert.NoError(t, err)
+	notaryID := testingidentity.GenerateRandomDID()
+
+	// notary not configured
+	pn := NewProofNotary(nil, nil, nil)
+	err = pn.Notarize(ctx, testProofBundle(t))
+	assert.Error(t, err)
+	assert.Equal(t, ErrNotaryNotConfigured, err)
+
+	// missing account
+	client := new(p2pClient)
+	idService := new(testingcommons.MockIdentityService)
+	pn = NewProofNotary(&notaryID, client, idService)
+	err = pn.Notarize(context.Background(), testProofBundle(t))
+	assert.Error(t, err)
+	assert.Equal(t, ErrDocumentConfigAccountID, err)
+
+	// p2p failed
+	proof := testProofBundle(t)
+	client.On("GetNotarySignature", ctx, notaryID, proof).Return(nil, errors.New("failed to connect")).Once()
+	err = pn.Notarize(ctx, proof)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrProofNotarization, err))
+
+	// signer mismatch
+	msg, err := ProofBundleMessage(proof)
+	assert.NoError(t, err)
+	sig, err := acc.SignMsg(msg)
+	assert.NoError(t, err)
+	client.On("GetNotarySignature", ctx, notaryID, proof).Return(sig, nil).Once()
+	err = pn.Notarize(ctx, proof)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrProofNotarization, err))
+
+	// invalid notary signature
+	notarySig := &coredocumentpb.Signature{SignerId: notaryID[:], PublicKey: sig.PublicKey, Signature: sig.Signature}
+	client.On("GetNotarySignature", ctx, notaryID, proof).Return(notarySig, nil).Once()
+	idService.On("ValidateSignature", notaryID, notarySig.PublicKey, notarySig.Signature, msg, mock.Anything).Return(errors.New("invalid signature")).Once()
+	err = pn.Notarize(ctx, proof)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrProofNotarization, err))
+
+	// success
+	client.On("GetNotarySignature", ctx, notaryID, proof).Return(notarySig, nil).Once()
+	idService.On("ValidateSignature", notaryID, notarySig.PublicKey, notarySig.Signature, msg, mock.Anything).Return(nil).Once()
+	err = pn.Notarize(ctx, proof)
+	assert.NoError(t, err)
+	assert.Len(t, proof.Signatures, 2)
+	assert.Equal(t, sig.SignerId, proof.Signatures[0].SignerId)
+	assert.Equal(t, notarySig, proof.Signatures[1])
+	client.AssertExpectations(t)
+	idService.AssertExpectations(t)
+}
+
+func TestService_NotarizeProofBundle(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	acc, err := contextutil.Account(ctx)
+	assert.NoError(t, err)
+	idService := new(testingcommons.MockIdentityService)
+	anchorRepo := new(mockRepo)
+	srv := service{idService: idService, anchorRepository: anchorRepo}
+	requester := testingidentity.GenerateRandomDID()
+
+	// missing account
+	_, err = srv.NotarizeProofBundle(context.Background(), testProofBundle(t), requester)
+	assert.Error(t, err)
+	assert.Equal(t, ErrDocumentConfigAccountID, err)
+
+	// not signed by the requester
+	proof := testProofBundle(t)
+	_, err = srv.NotarizeProofBundle(ctx, proof, requester)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrProofNotarization, err))
+
+	// signer mismatch
+	msg, err := ProofBundleMessage(proof)
+	assert.NoError(t, err)
+	sig, err := acc.SignMsg(msg)
+	assert.NoError(t, err)
+	proof.Signatures = []*coredocumentpb.Signature{sig}
+	_, err = srv.NotarizeProofBundle(ctx, proof, requester)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrProofNotarization, err))
+
+	// invalid requester signature
+	requester = identity.NewDIDFromBytes(sig.SignerId)
+	idService.On("ValidateSignature", requester, sig.PublicKey, sig.Signature, msg, mock.Anything).Return(errors.New("invalid signature")).Once()
+	_, err = srv.NotarizeProofBundle(ctx, proof, requester)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrProofNotarization, err))
+
+	// anchor mismatch
+	anchorID, err := anchors.ToAnchorID(proof.VersionID)
+	assert.NoError(t, err)
+	docRoot, err := anchors.ToDocumentRoot(proof.DocumentRoot)
+	assert.NoError(t, err)
+	idService.On("ValidateSignature", requester, sig.PublicKey, sig.Signature, msg, mock.Anything).Return(nil).Once()
+	anchorRepo.On("GetAnchorData", anchorID).Return(anchors.RandomDocumentRoot(), nil, nil).Once()
+	_, err = srv.NotarizeProofBundle(ctx, proof, requester)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrProofNotarization, err))
+
+	// invalid field proof
+	proof.FieldProofs[0].SortedHashes = proof.FieldProofs[1].SortedHashes[:1]
+	msg, err = ProofBundleMessage(proof)
+	assert.NoError(t, err)
+	sig, err = acc.SignMsg(msg)
+	assert.NoError(t, err)
+	proof.Signatures = []*coredocumentpb.Signature{sig}
+	idService.On("ValidateSignature", requester, sig.PublicKey, sig.Signature, msg, mock.Anything).Return(nil).Once()
+	anchorRepo.On("GetAnchorData", anchorID).Return(docRoot, nil, nil).Once()
+	_, err = srv.NotarizeProofBundle(ctx, proof, requester)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrProofNotarization, err))
+
+	// success
+	proof.FieldProofs = proof.FieldProofs[1:]
+	msg, err = ProofBundleMessage(proof)
+	assert.NoError(t, err)
+	sig, err = acc.SignMsg(msg)
+	assert.NoError(t, err)
+	proof.Signatures = []*coredocumentpb.Signature{sig}
+	idService.On("ValidateSignature", requester, sig.PublicKey, sig.Signature, msg, mock.Anything).Return(nil).Once()
+	anchorRepo.On("GetAnchorData", anchorID).Return(docRoot, nil, nil).Once()
+	notarySig, err := srv.NotarizeProofBundle(ctx, proof, requester)
+	assert.NoError(t, err)
+	assert.Equal(t, sig.SignerId, notarySig.SignerId)
+	assert.Equal(t, sig.Signature, notarySig.Signature)
+	anchorRepo.AssertExpectations(t)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/contextutil"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/history"
 	"github.com/centrifuge/go-centrifuge/utils"
 )
 
@@ -18,6 +19,13 @@ type Config interface {
 	GetNetworkID() uint32
 	GetIdentityID() ([]byte, error)
 	GetP2PConnectionTimeout() time.Duration
+	GetNotaryID() string
+	GetAnchorTimeout() time.Duration
+	GetRecoveryFromBlock() uint64
+	GetRecoveryCollaborators() []string
+	GetRetentionKeepVersions() int
+	GetRetentionMaxAge() time.Duration
+	GetRetentionArchivePath() string
 }
 
 // Client defines methods that can be implemented by any type handling p2p communications.
@@ -28,6 +36,15 @@ type Client interface {
 
 	// after all signatures are collected the sender sends the document including the signatures
 	SendAnchoredDocument(ctx context.Context, receiverID identity.DID, in *p2ppb.AnchorDocumentRequest) (*p2ppb.AnchorDocumentResponse, error)
+
+	// GetNotarySignature requests the notary to co-sign the proof bundle
+	GetNotarySignature(ctx context.Context, notaryID identity.DID, proof *DocumentProof) (*coredocumentpb.Signature, error)
+
+	// GetDocumentHistory requests the collaborator for the versions of the document preceding the version
+	GetDocumentHistory(ctx context.Context, collaborator identity.DID, documentID, version []byte) (*historypb.HistoryResponse, error)
+
+	// GetDocument requests the collaborator for the latest version of the document
+	GetDocument(ctx context.Context, collaborator identity.DID, documentID []byte) (*p2ppb.GetDocumentResponse, error)
 }
 
 // defaultProcessor implements AnchorProcessor interface
@@ -36,16 +53,34 @@ type defaultProcessor struct {
 	p2pClient        Client
 	anchorRepository anchors.AnchorRepository
 	config           Config
+
+	// registry holds the validators registered per document type, the built-in validators are run only if nil
+	registry *ServiceRegistry
+
+	// hooks are notified at each stage of the anchoring
+	hooks *processorHooks
 }
 
 // DefaultProcessor returns the default implementation of CoreDocument AnchorProcessor
-func DefaultProcessor(idService identity.ServiceDID, p2pClient Client, repository anchors.AnchorRepository, config Config) AnchorProcessor {
+func DefaultProcessor(idService identity.ServiceDID, p2pClient Client, repository anchors.AnchorRepository, config Config, registry *ServiceRegistry) AnchorProcessor {
 	return defaultProcessor{
 		identityService:  idService,
 		p2pClient:        p2pClient,
 		anchorRepository: repository,
 		config:           config,
+		registry:         registry,
+		hooks:            new(processorHooks),
+	}
+}
+
+// withRegisteredValidators returns the validator group followed by the validators registered in the registry for the
+// document type of the model at the stage.
+func withRegisteredValidators(group ValidatorGroup, registry *ServiceRegistry, model Model, stage ValidationStage) ValidatorGroup {
+	if registry == nil || model == nil {
+		return group
 	}
+
+	return append(group, registry.Validators(model.DocumentType(), stage)...)
 }
 
 // Send sends the given defaultProcessor to the given recipient on the P2P layer
@@ -63,7 +98,7 @@ func (dp defaultProcessor) Send(ctx context.Context, cd coredocumentpb.CoreDocum
 	return nil
 }
 
-// PrepareForSignatureRequests gets the core document from the model, and adds the node's own signature
+// PrepareForSignatureRequests gets the core document from the model, and adds the node's own signatures
 func (dp defaultProcessor) PrepareForSignatureRequests(ctx context.Context, model Model) error {
 	self, err := contextutil.Account(ctx)
 	if err != nil {
@@ -91,12 +126,20 @@ func (dp defaultProcessor) PrepareForSignatureRequests(ctx context.Context, mode
 		return errors.New("failed to calculate signing root: %v", err)
 	}
 
-	sig, err := self.SignMsg(sr)
+	err = withRegisteredValidators(nil, dp.registry, model, ValidationStageSignature).Validate(nil, model)
+	if err != nil {
+		return errors.New("failed to validate model for signing: %v", err)
+	}
+
+	sigs, err := self.SignMsgWithApprovers(sr)
 	if err != nil {
 		return err
 	}
 
-	model.AppendSignatures(sig)
+	model.AppendSignatures(sigs...)
+	dp.notify("OnPrepared", model, func(hook ProcessorHook) error {
+		return hook.OnPrepared(ctx, model)
+	})
 
 	return nil
 }
@@ -117,6 +160,9 @@ func (dp defaultProcessor) RequestSignatures(ctx context.Context, model Model) e
 	}
 
 	model.AppendSignatures(signs...)
+	dp.notify("OnSigned", model, func(hook ProcessorHook) error {
+		return hook.OnSigned(ctx, model)
+	})
 	return nil
 }
 
@@ -149,11 +195,13 @@ func (dp defaultProcessor) PreAnchorDocument(ctx context.Context, model Model) e
 	}
 
 	log.Infof("Pre-anchoring document with identifiers: [document: %#x, current: %#x, next: %#x], signingRoot: %#x", model.ID(), model.CurrentVersion(), model.NextVersion(), sRoot)
-	done, err := dp.anchorRepository.PreCommitAnchor(ctx, anchorID, sRoot)
-
-	isDone := <-done
+	f, err := dp.anchorRepository.PreCommitAnchor(ctx, anchorID, sRoot)
+	if err != nil {
+		return errors.New("failed to pre-commit anchor: %v", err)
+	}
 
-	if !isDone {
+	err = f.Result(dp.config.GetAnchorTimeout())
+	if err != nil {
 		return errors.New("failed to pre-commit anchor: %v", err)
 	}
 
@@ -163,7 +211,7 @@ func (dp defaultProcessor) PreAnchorDocument(ctx context.Context, model Model) e
 
 // AnchorDocument validates the model, and anchors the document
 func (dp defaultProcessor) AnchorDocument(ctx context.Context, model Model) error {
-	pav := PreAnchorValidator(dp.identityService)
+	pav := withRegisteredValidators(PreAnchorValidator(dp.identityService), dp.registry, model, ValidationStagePreAnchor)
 	err := pav.Validate(nil, model)
 	if err != nil {
 		return errors.New("pre anchor validation failed: %v", err)
@@ -195,21 +243,26 @@ func (dp defaultProcessor) AnchorDocument(ctx context.Context, model Model) erro
 	}
 
 	log.Infof("Anchoring document with identifiers: [document: %#x, current: %#x, next: %#x], rootHash: %#x", model.ID(), model.CurrentVersion(), model.NextVersion(), dr)
-	done, err := dp.anchorRepository.CommitAnchor(ctx, anchorIDPreimage, rootHash, signingRootProofHashes)
-
-	isDone := <-done
+	f, err := dp.anchorRepository.CommitAnchor(ctx, anchorIDPreimage, rootHash, signingRootProofHashes)
+	if err != nil {
+		return errors.New("failed to commit anchor: %v", err)
+	}
 
-	if !isDone {
+	err = f.Result(dp.config.GetAnchorTimeout())
+	if err != nil {
 		return errors.New("failed to commit anchor: %v", err)
 	}
 
 	log.Infof("Anchored document with identifiers: [document: %#x, current: %#x, next: %#x], rootHash: %#x", model.ID(), model.CurrentVersion(), model.NextVersion(), dr)
+	dp.notify("OnAnchored", model, func(hook ProcessorHook) error {
+		return hook.OnAnchored(ctx, model)
+	})
 	return nil
 }
 
 // SendDocument does post anchor validations and sends the document to collaborators
 func (dp defaultProcessor) SendDocument(ctx context.Context, model Model) error {
-	av := PostAnchoredValidator(dp.identityService, dp.anchorRepository)
+	av := withRegisteredValidators(PostAnchoredValidator(dp.identityService, dp.anchorRepository), dp.registry, model, ValidationStagePostAnchored)
 	err := av.Validate(nil, model)
 	if err != nil {
 		return errors.New("post anchor validations failed: %v", err)
@@ -234,7 +287,15 @@ func (dp defaultProcessor) SendDocument(ctx context.Context, model Model) error
 		erri := dp.Send(ctx, cd, c)
 		if erri != nil {
 			err = errors.AppendError(err, erri)
+			dp.notify("OnReceiveFailed", model, func(hook ProcessorHook) error {
+				return hook.OnReceiveFailed(ctx, model, c, erri)
+			})
+			continue
 		}
+
+		dp.notify("OnSent", model, func(hook ProcessorHook) error {
+			return hook.OnSent(ctx, model, c)
+		})
 	}
 
 	return err
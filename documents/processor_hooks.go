@@ -0,0 +1,99 @@
+package documents
+
+import (
+	"context"
+	"sync"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+)
+
+// ProcessorHook is notified by the anchor processor at each stage of the anchoring of a document version, so that
+// integrations, such as syncing the documents to an ERP, don't need to patch the processor.
+// The context carries the account the document is anchored for. Errors of the hooks are logged and don't fail the
+// anchoring.
+type ProcessorHook interface {
+	// OnPrepared is called once the version is signed by the node and ready for the signature requests.
+	OnPrepared(ctx context.Context, model Model) error
+
+	// OnSigned is called once the signatures of the collaborators are collected.
+	OnSigned(ctx context.Context, model Model) error
+
+	// OnAnchored is called once the version is anchored, before its status is set to committed.
+	OnAnchored(ctx context.Context, model Model) error
+
+	// OnSent is called once the anchored version is received by the collaborator.
+	OnSent(ctx context.Context, model Model, collaborator identity.DID) error
+
+	// OnReceiveFailed is called when the collaborator fails to receive the anchored version.
+	OnReceiveFailed(ctx context.Context, model Model, collaborator identity.DID, err error) error
+}
+
+// BaseProcessorHook implements ProcessorHook without doing anything.
+// Hooks interested in some of the stages only can embed it.
+type BaseProcessorHook struct{}
+
+// OnPrepared does nothing.
+func (BaseProcessorHook) OnPrepared(ctx context.Context, model Model) error { return nil }
+
+// OnSigned does nothing.
+func (BaseProcessorHook) OnSigned(ctx context.Context, model Model) error { return nil }
+
+// OnAnchored does nothing.
+func (BaseProcessorHook) OnAnchored(ctx context.Context, model Model) error { return nil }
+
+// OnSent does nothing.
+func (BaseProcessorHook) OnSent(ctx context.Context, model Model, collaborator identity.DID) error {
+	return nil
+}
+
+// OnReceiveFailed does nothing.
+func (BaseProcessorHook) OnReceiveFailed(ctx context.Context, model Model, collaborator identity.DID, err error) error {
+	return nil
+}
+
+// ProcessorHookRegistry is implemented by the anchor processors that notify hooks.
+type ProcessorHookRegistry interface {
+	// RegisterProcessorHook registers the hook. Hooks are notified in the order of registration.
+	RegisterProcessorHook(hook ProcessorHook) error
+}
+
+// processorHooks holds the hooks registered with a processor.
+type processorHooks struct {
+	hooks []ProcessorHook
+	mutex sync.RWMutex
+}
+
+// RegisterProcessorHook registers the hook notified at each stage of the anchoring.
+func (dp defaultProcessor) RegisterProcessorHook(hook ProcessorHook) error {
+	if hook == nil {
+		return errors.New("nil processor hook provided")
+	}
+
+	if dp.hooks == nil {
+		return errors.New("processor hooks not initialised")
+	}
+
+	dp.hooks.mutex.Lock()
+	defer dp.hooks.mutex.Unlock()
+	dp.hooks.hooks = append(dp.hooks.hooks, hook)
+	return nil
+}
+
+// notify calls fn with every registered hook and logs the errors returned.
+func (dp defaultProcessor) notify(stage string, model Model, fn func(hook ProcessorHook) error) {
+	if dp.hooks == nil {
+		return
+	}
+
+	dp.hooks.mutex.RLock()
+	hooks := dp.hooks.hooks
+	dp.hooks.mutex.RUnlock()
+
+	for _, hook := range hooks {
+		err := fn(hook)
+		if err != nil {
+			log.Errorf("processor hook %s failed for document %x: %v", stage, model.ID(), err)
+		}
+	}
+}
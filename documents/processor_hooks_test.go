@@ -0,0 +1,78 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingHook records the stages it is notified at.
+type recordingHook struct {
+	BaseProcessorHook
+	calls []string
+	err   error
+}
+
+func (h *recordingHook) OnPrepared(ctx context.Context, model Model) error {
+	h.calls = append(h.calls, "OnPrepared")
+	return h.err
+}
+
+func (h *recordingHook) OnSent(ctx context.Context, model Model, collaborator identity.DID) error {
+	h.calls = append(h.calls, "OnSent "+collaborator.String())
+	return h.err
+}
+
+func (h *recordingHook) OnReceiveFailed(ctx context.Context, model Model, collaborator identity.DID, err error) error {
+	h.calls = append(h.calls, "OnReceiveFailed "+collaborator.String())
+	return h.err
+}
+
+func TestDefaultProcessor_RegisterProcessorHook(t *testing.T) {
+	dp := DefaultProcessor(nil, nil, nil, cfg, nil)
+	hr, ok := dp.(ProcessorHookRegistry)
+	assert.True(t, ok)
+
+	// nil hook
+	assert.Error(t, hr.RegisterProcessorHook(nil))
+
+	// hooks not initialised
+	assert.Error(t, defaultProcessor{}.RegisterProcessorHook(new(recordingHook)))
+
+	// success
+	assert.NoError(t, hr.RegisterProcessorHook(new(recordingHook)))
+	assert.Len(t, dp.(defaultProcessor).hooks.hooks, 1)
+}
+
+func TestDefaultProcessor_notify(t *testing.T) {
+	ctx := context.Background()
+	model := new(mockModel)
+	model.On("ID").Return(utils.RandomSlice(32))
+	notify := func(dp defaultProcessor) {
+		dp.notify("OnPrepared", model, func(hook ProcessorHook) error {
+			return hook.OnPrepared(ctx, model)
+		})
+	}
+
+	// no hooks
+	notify(defaultProcessor{})
+
+	// hooks are notified in order, failing hooks don't stop the others
+	dp := DefaultProcessor(nil, nil, nil, cfg, nil).(defaultProcessor)
+	var calls []string
+	failing := &recordingHook{err: errors.New("erp not reachable")}
+	other := new(recordingHook)
+	assert.NoError(t, dp.RegisterProcessorHook(failing))
+	assert.NoError(t, dp.RegisterProcessorHook(other))
+	assert.NoError(t, dp.RegisterProcessorHook(BaseProcessorHook{}))
+	notify(dp)
+	calls = append(calls, failing.calls...)
+	calls = append(calls, other.calls...)
+	assert.Equal(t, []string{"OnPrepared", "OnPrepared"}, calls)
+}
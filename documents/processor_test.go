@@ -14,6 +14,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/crypto"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/history"
 	"github.com/centrifuge/go-centrifuge/testingutils/commons"
 	"github.com/centrifuge/go-centrifuge/testingutils/config"
 	"github.com/centrifuge/go-centrifuge/testingutils/identity"
@@ -119,6 +120,11 @@ func (m *mockModel) Timestamp() (time.Time, error) {
 	return dr, args.Error(1)
 }
 
+func (m *mockModel) DocumentType() string {
+	args := m.Called()
+	return args.String(0)
+}
+
 func (m *mockModel) GetCollaborators(filterIDs ...identity.DID) ([]identity.DID, error) {
 	args := m.Called(filterIDs)
 	cids, _ := args.Get(0).([]identity.DID)
@@ -142,9 +148,14 @@ func (m *mockModel) CollaboratorCanUpdate(new Model, collaborator identity.DID)
 	return args.Error(0)
 }
 
+func (m *mockModel) CheckRequiredSignatures() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
 func TestDefaultProcessor_PrepareForSignatureRequests(t *testing.T) {
 	srv := &testingcommons.MockIdentityService{}
-	dp := DefaultProcessor(srv, nil, nil, cfg).(defaultProcessor)
+	dp := DefaultProcessor(srv, nil, nil, cfg, nil).(defaultProcessor)
 
 	ctxh := testingconfig.CreateAccountContext(t, cfg)
 
@@ -191,6 +202,44 @@ func TestDefaultProcessor_PrepareForSignatureRequests(t *testing.T) {
 	assert.True(t, crypto.VerifyMessage(keys[identity.KeyPurposeSigning.Name].PublicKey, sr, sig.Signature, crypto.CurveSecp256K1))
 }
 
+func TestDefaultProcessor_PrepareForSignatureRequests_registeredValidators(t *testing.T) {
+	registry := NewServiceRegistry()
+	var validated Model
+	limit := ValidatorFunc(func(old, new Model) error {
+		validated = new
+		return errors.New("amount exceeds the limit")
+	})
+	assert.NoError(t, registry.RegisterValidator("mock", ValidationStageSignature, limit))
+	dp := DefaultProcessor(&testingcommons.MockIdentityService{}, nil, nil, cfg, registry).(defaultProcessor)
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+
+	// the model is not signed if a registered validator fails
+	model := new(mockModel)
+	model.On("CalculateDataRoot").Return(utils.RandomSlice(32), nil).Once()
+	model.On("AddUpdateLog").Return(nil).Once()
+	model.On("CalculateSigningRoot").Return(utils.RandomSlice(32), nil).Once()
+	model.On("DocumentType").Return("mock").Once()
+	err := dp.PrepareForSignatureRequests(ctxh, model)
+	model.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "amount exceeds the limit")
+	assert.Equal(t, model, validated)
+	assert.Empty(t, model.sigs)
+
+	// validators of other document types are not run
+	validated = nil
+	model = new(mockModel)
+	model.On("CalculateDataRoot").Return(utils.RandomSlice(32), nil).Once()
+	model.On("AddUpdateLog").Return(nil).Once()
+	model.On("CalculateSigningRoot").Return(utils.RandomSlice(32), nil).Once()
+	model.On("DocumentType").Return("other").Once()
+	model.On("AppendSignatures", mock.Anything).Return().Once()
+	assert.NoError(t, dp.PrepareForSignatureRequests(ctxh, model))
+	model.AssertExpectations(t)
+	assert.Nil(t, validated)
+	assert.Len(t, model.sigs, 1)
+}
+
 type p2pClient struct {
 	mock.Mock
 	Client
@@ -208,9 +257,27 @@ func (p *p2pClient) SendAnchoredDocument(ctx context.Context, receiverID identit
 	return resp, args.Error(1)
 }
 
+func (p *p2pClient) GetNotarySignature(ctx context.Context, notaryID identity.DID, proof *DocumentProof) (*coredocumentpb.Signature, error) {
+	args := p.Called(ctx, notaryID, proof)
+	sig, _ := args.Get(0).(*coredocumentpb.Signature)
+	return sig, args.Error(1)
+}
+
+func (p *p2pClient) GetDocumentHistory(ctx context.Context, collaborator identity.DID, documentID, version []byte) (*historypb.HistoryResponse, error) {
+	args := p.Called(ctx, collaborator, documentID, version)
+	res, _ := args.Get(0).(*historypb.HistoryResponse)
+	return res, args.Error(1)
+}
+
+func (p *p2pClient) GetDocument(ctx context.Context, collaborator identity.DID, documentID []byte) (*p2ppb.GetDocumentResponse, error) {
+	args := p.Called(ctx, collaborator, documentID)
+	res, _ := args.Get(0).(*p2ppb.GetDocumentResponse)
+	return res, args.Error(1)
+}
+
 func TestDefaultProcessor_RequestSignatures(t *testing.T) {
 	srv := &testingcommons.MockIdentityService{}
-	dp := DefaultProcessor(srv, nil, nil, cfg).(defaultProcessor)
+	dp := DefaultProcessor(srv, nil, nil, cfg, nil).(defaultProcessor)
 	ctxh := testingconfig.CreateAccountContext(t, cfg)
 
 	self, err := contextutil.Account(ctxh)
@@ -298,7 +365,7 @@ func TestDefaultProcessor_RequestSignatures(t *testing.T) {
 
 func TestDefaultProcessor_PrepareForAnchoring(t *testing.T) {
 	srv := &testingcommons.MockIdentityService{}
-	dp := DefaultProcessor(srv, nil, nil, cfg).(defaultProcessor)
+	dp := DefaultProcessor(srv, nil, nil, cfg, nil).(defaultProcessor)
 
 	ctxh := testingconfig.CreateAccountContext(t, cfg)
 	self, err := contextutil.Account(ctxh)
@@ -357,10 +424,10 @@ type mockRepo struct {
 	anchors.AnchorRepository
 }
 
-func (m mockRepo) CommitAnchor(ctx context.Context, anchorID anchors.AnchorID, documentRoot anchors.DocumentRoot, documentProofs [][32]byte) (done chan bool, err error) {
+func (m mockRepo) CommitAnchor(ctx context.Context, anchorID anchors.AnchorID, documentRoot anchors.DocumentRoot, documentProofs [][32]byte) (anchors.Future, error) {
 	args := m.Called(anchorID, documentRoot, documentProofs)
-	c, _ := args.Get(0).(chan bool)
-	return c, args.Error(1)
+	f, _ := args.Get(0).(anchors.Future)
+	return f, args.Error(1)
 }
 
 func (m mockRepo) GetAnchorData(anchorID anchors.AnchorID) (docRoot anchors.DocumentRoot, anchoredTime time.Time, err error) {
@@ -372,7 +439,7 @@ func (m mockRepo) GetAnchorData(anchorID anchors.AnchorID) (docRoot anchors.Docu
 
 func TestDefaultProcessor_AnchorDocument(t *testing.T) {
 	srv := &testingcommons.MockIdentityService{}
-	dp := DefaultProcessor(srv, nil, nil, cfg).(defaultProcessor)
+	dp := DefaultProcessor(srv, nil, nil, cfg, nil).(defaultProcessor)
 	ctxh := testingconfig.CreateAccountContext(t, cfg)
 	self, err := contextutil.Account(ctxh)
 	assert.NoError(t, err)
@@ -392,6 +459,7 @@ func TestDefaultProcessor_AnchorDocument(t *testing.T) {
 	model.On("NextVersion").Return(next)
 	model.On("CalculateSigningRoot").Return(sr, nil)
 	model.On("Signatures").Return()
+	model.On("CheckRequiredSignatures").Return(nil)
 	model.On("CalculateDocumentRoot").Return(nil, errors.New("error"))
 	model.On("Author").Return(did1)
 	model.On("GetSignerCollaborators", mock.Anything).Return([]identity.DID{did1, testingidentity.GenerateRandomDID()}, nil)
@@ -416,6 +484,7 @@ func TestDefaultProcessor_AnchorDocument(t *testing.T) {
 	model.On("CalculateSigningRoot").Return(sr, nil)
 	model.On("GetSignaturesRootHash").Return(utils.RandomByte32(), nil)
 	model.On("Signatures").Return()
+	model.On("CheckRequiredSignatures").Return(nil)
 	model.On("CalculateDocumentRoot").Return(utils.RandomSlice(32), nil)
 	model.On("Author").Return(did1)
 	model.On("GetSignerCollaborators", mock.Anything).Return([]identity.DID{did1, testingidentity.GenerateRandomDID()}, nil)
@@ -424,22 +493,34 @@ func TestDefaultProcessor_AnchorDocument(t *testing.T) {
 	srv = &testingcommons.MockIdentityService{}
 	srv.On("ValidateSignature", identity.NewDIDFromBytes(did), sig.PublicKey, sig.Signature, sr, tm).Return(nil).Once()
 	dp.identityService = srv
+	anchorID, err := anchors.ToAnchorID(id)
+	assert.NoError(t, err)
 	repo := mockRepo{}
-	ch := make(chan bool, 1)
-	ch <- true
-	repo.On("CommitAnchor", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(ch, nil).Once()
+	repo.On("CommitAnchor", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(anchors.NewFinishedFuture(anchorID, nil), nil).Once()
 	dp.anchorRepository = repo
 	err = dp.AnchorDocument(ctxh, model)
 	model.AssertExpectations(t)
 	srv.AssertExpectations(t)
 	repo.AssertExpectations(t)
 	assert.Nil(t, err)
+
+	// commit failed
+	srv = &testingcommons.MockIdentityService{}
+	srv.On("ValidateSignature", identity.NewDIDFromBytes(did), sig.PublicKey, sig.Signature, sr, tm).Return(nil).Once()
+	dp.identityService = srv
+	repo = mockRepo{}
+	repo.On("CommitAnchor", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(anchors.NewFinishedFuture(anchorID, errors.New("tx failed")), nil).Once()
+	dp.anchorRepository = repo
+	err = dp.AnchorDocument(ctxh, model)
+	repo.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to commit anchor")
 }
 
 func TestDefaultProcessor_SendDocument(t *testing.T) {
 	srv := &testingcommons.MockIdentityService{}
 	srv.On("ValidateSignature", mock.Anything, mock.Anything).Return(nil).Once()
-	dp := DefaultProcessor(srv, nil, nil, cfg).(defaultProcessor)
+	dp := DefaultProcessor(srv, nil, nil, cfg, nil).(defaultProcessor)
 	ctxh := testingconfig.CreateAccountContext(t, cfg)
 	self, err := contextutil.Account(ctxh)
 	assert.NoError(t, err)
@@ -461,6 +542,7 @@ func TestDefaultProcessor_SendDocument(t *testing.T) {
 	model.On("NextVersion").Return(next)
 	model.On("CalculateSigningRoot").Return(sr, nil)
 	model.On("Signatures").Return()
+	model.On("CheckRequiredSignatures").Return(nil)
 	model.On("CalculateDocumentRoot").Return(utils.RandomSlice(32), nil)
 	model.On("Author").Return(did1)
 	model.On("GetSignerCollaborators", mock.Anything).Return([]identity.DID{did1, testingidentity.GenerateRandomDID()}, nil)
@@ -489,6 +571,7 @@ func TestDefaultProcessor_SendDocument(t *testing.T) {
 	model.On("NextVersion").Return(next)
 	model.On("CalculateSigningRoot").Return(sr, nil)
 	model.On("Signatures").Return()
+	model.On("CheckRequiredSignatures").Return(nil)
 	model.On("CalculateDocumentRoot").Return(dr[:], nil)
 	model.On("GetSignerCollaborators", mock.Anything).Return(nil, errors.New("error")).Once()
 	model.On("Author").Return(did1)
@@ -512,6 +595,7 @@ func TestDefaultProcessor_SendDocument(t *testing.T) {
 	model.On("NextVersion").Return(next)
 	model.On("CalculateSigningRoot").Return(sr, nil)
 	model.On("Signatures").Return()
+	model.On("CheckRequiredSignatures").Return(nil)
 	model.On("CalculateDocumentRoot").Return(dr[:], nil)
 	model.On("GetSignerCollaborators", mock.Anything).Return([]identity.DID{testingidentity.GenerateRandomDID()}, nil)
 	model.On("PackCoreDocument").Return(nil, errors.New("error")).Once()
@@ -539,6 +623,7 @@ func TestDefaultProcessor_SendDocument(t *testing.T) {
 	model.On("NextVersion").Return(next)
 	model.On("CalculateSigningRoot").Return(sr, nil)
 	model.On("Signatures").Return()
+	model.On("CheckRequiredSignatures").Return(nil)
 	model.On("CalculateDocumentRoot").Return(dr[:], nil)
 	model.On("GetSignerCollaborators", mock.Anything).Return([]identity.DID{did}, nil)
 	model.On("PackCoreDocument").Return(cd, nil).Once()
@@ -553,6 +638,8 @@ func TestDefaultProcessor_SendDocument(t *testing.T) {
 	client := new(p2pClient)
 	client.On("SendAnchoredDocument", mock.Anything, did, mock.Anything).Return(nil, errors.New("error")).Once()
 	dp.anchorRepository = repo
+	hook := new(recordingHook)
+	assert.NoError(t, dp.RegisterProcessorHook(hook))
 	dp.p2pClient = client
 	err = dp.SendDocument(ctxh, model)
 	model.AssertExpectations(t)
@@ -560,6 +647,7 @@ func TestDefaultProcessor_SendDocument(t *testing.T) {
 	repo.AssertExpectations(t)
 	client.AssertExpectations(t)
 	assert.Error(t, err)
+	assert.Equal(t, []string{"OnReceiveFailed " + did.String()}, hook.calls)
 
 	// successful
 	model = new(mockModel)
@@ -568,6 +656,7 @@ func TestDefaultProcessor_SendDocument(t *testing.T) {
 	model.On("NextVersion").Return(next)
 	model.On("CalculateSigningRoot").Return(sr, nil)
 	model.On("Signatures").Return()
+	model.On("CheckRequiredSignatures").Return(nil)
 	model.On("CalculateDocumentRoot").Return(dr[:], nil)
 	model.On("GetSignerCollaborators", mock.Anything).Return([]identity.DID{did}, nil)
 	model.On("PackCoreDocument").Return(cd, nil).Once()
@@ -582,6 +671,7 @@ func TestDefaultProcessor_SendDocument(t *testing.T) {
 	client = new(p2pClient)
 	client.On("SendAnchoredDocument", mock.Anything, did, mock.Anything).Return(&p2ppb.AnchorDocumentResponse{Accepted: true}, nil).Once()
 	dp.anchorRepository = repo
+	hook.calls = nil
 	dp.p2pClient = client
 	err = dp.SendDocument(ctxh, model)
 	model.AssertExpectations(t)
@@ -589,4 +679,5 @@ func TestDefaultProcessor_SendDocument(t *testing.T) {
 	repo.AssertExpectations(t)
 	client.AssertExpectations(t)
 	assert.NoError(t, err)
+	assert.Equal(t, []string{"OnSent " + did.String()}, hook.calls)
 }
@@ -0,0 +1,156 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sync"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/proofrequest"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+)
+
+// ProofRequester requests the field proofs of the documents shared with a proof-only access token from their granters.
+type ProofRequester interface {
+	// RequestProofs requests the granter of the access token for the field proofs of the latest version of the document.
+	RequestProofs(ctx context.Context, granter identity.DID, req *proofrequestpb.ProofRequest) (*proofrequestpb.ProofResponse, error)
+}
+
+// ProofRequest is a request for the field proofs of a document shared with an access token.
+type ProofRequest struct {
+	// Granter is the collaborator that issued the access token
+	Granter    identity.DID
+	DocumentID []byte
+
+	// DelegatingDocumentID is the document holding the access token
+	DelegatingDocumentID []byte
+	AccessTokenID        []byte
+
+	// Fields must be exactly the fields granted by a proof-only access token, in any order.
+	// The token only holds the hash of its fields, so a subset of them can't be matched against it.
+	Fields []string
+}
+
+// proofRequester holds the requester registered with the document service.
+type proofRequester struct {
+	mu        sync.RWMutex
+	requester ProofRequester
+}
+
+// RegisterProofRequester registers the requester of the field proofs shared by the collaborators.
+func (s service) RegisterProofRequester(requester ProofRequester) error {
+	if s.proofs == nil {
+		return errors.New("proof requester not initialised")
+	}
+
+	if requester == nil {
+		return errors.New("nil proof requester provided")
+	}
+
+	s.proofs.mu.Lock()
+	defer s.proofs.mu.Unlock()
+	s.proofs.requester = requester
+	return nil
+}
+
+// RequestProofs requests the granter for the field proofs of the document and verifies the received proofs against
+// the anchored document root.
+func (s service) RequestProofs(ctx context.Context, req ProofRequest) (*DocumentProof, error) {
+	if s.proofs == nil {
+		return nil, errors.New("proof requester not initialised")
+	}
+
+	s.proofs.mu.RLock()
+	requester := s.proofs.requester
+	s.proofs.mu.RUnlock()
+	if requester == nil {
+		return nil, errors.New("proof requester not registered")
+	}
+
+	res, err := requester.RequestProofs(ctx, req.Granter, &proofrequestpb.ProofRequest{
+		DocumentIdentifier:           req.DocumentID,
+		Fields:                       req.Fields,
+		DelegatingDocumentIdentifier: req.DelegatingDocumentID,
+		AccessTokenId:                req.AccessTokenID,
+	})
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentProof, err)
+	}
+
+	if !bytes.Equal(res.DocumentId, req.DocumentID) {
+		return nil, errors.NewTypedError(ErrInvalidProofBundle, errors.New("proofs of document %x received, requested %x", res.DocumentId, req.DocumentID))
+	}
+
+	err = checkProofFields(req.Fields, res.FieldProofs)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrInvalidProofBundle, err)
+	}
+
+	proof := &DocumentProof{
+		DocumentID:   res.DocumentId,
+		VersionID:    res.VersionId,
+		DocumentRoot: res.DocumentRoot,
+		FieldProofs:  res.FieldProofs,
+	}
+
+	pv, err := s.VerifyProof(ctx, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pv.Anchored {
+		return nil, errors.NewTypedError(ErrInvalidProofBundle, errors.New("%s", pv.AnchorError))
+	}
+
+	for _, f := range pv.Fields {
+		if !f.Valid {
+			return nil, errors.NewTypedError(ErrInvalidProofBundle, errors.New("proof of field %x: %s", f.Property, f.Error))
+		}
+	}
+
+	return proof, nil
+}
+
+// checkProofFields checks that the proofs prove exactly the requested fields, one proof per field.
+// The proofs of the compact trees hold the compact property only, which can't be mapped back to the readable field
+// without the schema of the document, so those are matched by count and uniqueness while the readable ones must be requested.
+func checkProofFields(fields []string, fieldProofs []*proofspb.Proof) error {
+	requested := make(map[string]bool)
+	for _, f := range fields {
+		if requested[f] {
+			return errors.New("field %s requested more than once", f)
+		}
+
+		requested[f] = true
+	}
+
+	if len(fieldProofs) != len(requested) {
+		return errors.New("%d proofs received for %d fields", len(fieldProofs), len(requested))
+	}
+
+	proven := make(map[string]bool)
+	for _, p := range fieldProofs {
+		if p == nil {
+			return errors.New("nil proof received")
+		}
+
+		key := p.GetReadableName()
+		if key != "" && !requested[key] {
+			return errors.New("proof of field %s not requested", key)
+		}
+
+		if key == "" {
+			key = hex.EncodeToString(p.GetCompactName())
+		}
+
+		if proven[key] {
+			return errors.New("field %s proven more than once", key)
+		}
+
+		proven[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/proofrequest"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+type proofRequesterFunc func(ctx context.Context, granter identity.DID, req *proofrequestpb.ProofRequest) (*proofrequestpb.ProofResponse, error)
+
+func (f proofRequesterFunc) RequestProofs(ctx context.Context, granter identity.DID, req *proofrequestpb.ProofRequest) (*proofrequestpb.ProofResponse, error) {
+	return f(ctx, granter, req)
+}
+
+func TestService_RequestProofs(t *testing.T) {
+	ctx := context.Background()
+	anchorRepo := new(mockRepo)
+	srv := service{anchorRepository: anchorRepo, proofs: new(proofRequester)}
+	proof := testProofBundle(t)
+	anchorID, err := anchors.ToAnchorID(proof.VersionID)
+	assert.NoError(t, err)
+	root, err := anchors.ToDocumentRoot(proof.DocumentRoot)
+	assert.NoError(t, err)
+	req := ProofRequest{
+		Granter:              testingidentity.GenerateRandomDID(),
+		DocumentID:           proof.DocumentID,
+		DelegatingDocumentID: utils.RandomSlice(32),
+		AccessTokenID:        utils.RandomSlice(32),
+		Fields:               []string{"prefix.sample_field", "prefix.sample_field2"},
+	}
+
+	// no requester registered
+	_, err = srv.RequestProofs(ctx, req)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "proof requester not registered")
+	assert.Error(t, srv.RegisterProofRequester(nil))
+	assert.Error(t, service{}.RegisterProofRequester(proofRequesterFunc(nil)))
+
+	var res *proofrequestpb.ProofResponse
+	var resErr error
+	assert.NoError(t, srv.RegisterProofRequester(proofRequesterFunc(func(ctx context.Context, granter identity.DID, r *proofrequestpb.ProofRequest) (*proofrequestpb.ProofResponse, error) {
+		assert.Equal(t, req.Granter, granter)
+		assert.Equal(t, req.DocumentID, r.DocumentIdentifier)
+		assert.Equal(t, req.DelegatingDocumentID, r.DelegatingDocumentIdentifier)
+		assert.Equal(t, req.AccessTokenID, r.AccessTokenId)
+		assert.Equal(t, req.Fields, r.Fields)
+		return res, resErr
+	})))
+
+	// granter refused the request
+	resErr = ErrAccessTokenFieldsNotGranted
+	_, err = srv.RequestProofs(ctx, req)
+	assert.True(t, errors.IsOfType(ErrDocumentProof, err))
+
+	// proofs of another document
+	resErr = nil
+	res = &proofrequestpb.ProofResponse{
+		DocumentId:   utils.RandomSlice(32),
+		VersionId:    proof.VersionID,
+		DocumentRoot: proof.DocumentRoot,
+		FieldProofs:  proof.FieldProofs,
+	}
+	_, err = srv.RequestProofs(ctx, req)
+	assert.True(t, errors.IsOfType(ErrInvalidProofBundle, err))
+
+	// proofs not covering the requested fields
+	res.DocumentId = proof.DocumentID
+	res.FieldProofs = proof.FieldProofs[:1]
+	_, err = srv.RequestProofs(ctx, req)
+	assert.True(t, errors.IsOfType(ErrInvalidProofBundle, err))
+
+	// received proofs are verified against the anchored root
+	res = &proofrequestpb.ProofResponse{
+		DocumentId:   proof.DocumentID,
+		VersionId:    proof.VersionID,
+		DocumentRoot: proof.DocumentRoot,
+		FieldProofs:  proof.FieldProofs,
+	}
+	anchorRepo.On("GetAnchorData", anchorID).Return(root, time.Now(), nil).Once()
+	got, err := srv.RequestProofs(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, proof.DocumentRoot, got.DocumentRoot)
+	assert.Equal(t, proof.FieldProofs, got.FieldProofs)
+
+	// root not anchored
+	anchorRepo.On("GetAnchorData", anchorID).Return(anchors.RandomDocumentRoot(), time.Now(), nil).Once()
+	_, err = srv.RequestProofs(ctx, req)
+	assert.True(t, errors.IsOfType(ErrInvalidProofBundle, err))
+
+	// tampered proof
+	proof.FieldProofs[1].SortedHashes[0] = utils.RandomSlice(32)
+	anchorRepo.On("GetAnchorData", anchorID).Return(root, time.Now(), nil).Once()
+	_, err = srv.RequestProofs(ctx, req)
+	assert.True(t, errors.IsOfType(ErrInvalidProofBundle, err))
+	anchorRepo.AssertExpectations(t)
+}
+
+func TestCheckProofFields(t *testing.T) {
+	proof := testProofBundle(t)
+	fields := []string{"prefix.sample_field", "prefix.sample_field2"}
+	assert.NoError(t, checkProofFields(fields, proof.FieldProofs))
+
+	// missing proof
+	assert.Error(t, checkProofFields(fields, proof.FieldProofs[:1]))
+
+	// extra proof
+	assert.Error(t, checkProofFields(fields[:1], proof.FieldProofs))
+
+	// same field proven twice
+	assert.Error(t, checkProofFields(fields, []*proofspb.Proof{proof.FieldProofs[0], proof.FieldProofs[0]}))
+
+	// same field requested twice
+	assert.Error(t, checkProofFields([]string{fields[0], fields[0]}, proof.FieldProofs))
+
+	// nil proof
+	assert.Error(t, checkProofFields(fields, []*proofspb.Proof{proof.FieldProofs[0], nil}))
+
+	// readable proofs must be requested
+	readable := []*proofspb.Proof{
+		{Property: &proofspb.Proof_ReadableName{ReadableName: "prefix.sample_field"}},
+		{Property: &proofspb.Proof_ReadableName{ReadableName: "prefix.other_field"}},
+	}
+	assert.Error(t, checkProofFields(fields, readable))
+	readable[1].Property = &proofspb.Proof_ReadableName{ReadableName: "prefix.sample_field2"}
+	assert.NoError(t, checkProofFields(fields, readable))
+}
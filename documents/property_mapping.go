@@ -0,0 +1,324 @@
+package documents
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/precise-proofs/proofs"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	godescriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+const (
+	// IndexPlaceholder marks the index of a repeated element in a property mapping.
+	// The readable index is decimal and the compact index is 8 bytes big endian.
+	IndexPlaceholder = "{index}"
+
+	// KeyPlaceholder marks the key of a mapped element in a property mapping.
+	// The compact key is the key left padded with zeros to the key_length of the field.
+	KeyPlaceholder = "{key}"
+)
+
+// PropertyMapping maps the readable name of a tree property to its hex encoded compact name.
+// Repeated and mapped elements are described once, with IndexPlaceholder or KeyPlaceholder
+// in both the readable and the compact name.
+type PropertyMapping struct {
+	ReadableName string
+	CompactName  string
+
+	// Deprecated is set if the field or any of its parents is marked as deprecated in the protobuf definition.
+	// Deprecated fields are still part of the tree, so their compact names remain reserved.
+	Deprecated bool
+}
+
+// DocumentPropertyMappings holds the property mappings of the data tree of a document type.
+type DocumentPropertyMappings struct {
+	DocumentType string
+	Properties   []PropertyMapping
+}
+
+// PropertyMapper is implemented by the document services that can describe the properties of their data tree.
+type PropertyMapper interface {
+	PropertyMappings() ([]PropertyMapping, error)
+}
+
+var (
+	bytesType     = reflect.TypeOf([]byte(nil))
+	timestampType = reflect.TypeOf((*timestamp.Timestamp)(nil))
+)
+
+// propertyNode is a tree property with its readable and compact (hex without 0x) names.
+type propertyNode struct {
+	readable, compact string
+	deprecated        bool
+}
+
+func (n propertyNode) field(name string, num proofs.FieldNum, deprecated bool) propertyNode {
+	readable := name
+	if n.readable != "" {
+		readable = fmt.Sprintf(proofs.SubFieldFormat, n.readable, name)
+	}
+
+	compact := make([]byte, 4)
+	binary.BigEndian.PutUint32(compact, num)
+	return propertyNode{
+		readable:   readable,
+		compact:    n.compact + hex.EncodeToString(compact),
+		deprecated: n.deprecated || deprecated,
+	}
+}
+
+func (n propertyNode) elem(placeholder string) propertyNode {
+	return propertyNode{
+		readable:   fmt.Sprintf(proofs.ElemFormat, n.readable, placeholder),
+		compact:    n.compact + placeholder,
+		deprecated: n.deprecated,
+	}
+}
+
+// length returns the length property of a repeated field, which shares the compact name of the field.
+func (n propertyNode) length() propertyNode {
+	return propertyNode{
+		readable:   fmt.Sprintf(proofs.SubFieldFormat, n.readable, proofs.DefaultSaltsLengthSuffix),
+		compact:    n.compact,
+		deprecated: n.deprecated,
+	}
+}
+
+func (n propertyNode) mapping() PropertyMapping {
+	return PropertyMapping{ReadableName: n.readable, CompactName: "0x" + n.compact, Deprecated: n.deprecated}
+}
+
+// propertyMapper walks protobuf message types the same way precise proofs flattens the messages into leaves.
+type propertyMapper struct {
+	mappings []PropertyMapping
+}
+
+func (m *propertyMapper) handleType(n propertyNode, t reflect.Type, fd *godescriptor.FieldDescriptorProto) error {
+	if t == bytesType || t == timestampType {
+		m.mappings = append(m.mappings, n.mapping())
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return m.handleType(n, t.Elem(), fd)
+	case reflect.Struct:
+		return m.handleStruct(n, t, fd)
+	case reflect.Slice:
+		m.mappings = append(m.mappings, n.length().mapping())
+		mappingKey := mappingKeyFrom(fd)
+		if mappingKey == "" {
+			return m.handleType(n.elem(IndexPlaceholder), t.Elem(), nil)
+		}
+
+		vt, err := mappedValueType(t.Elem(), generator.CamelCase(mappingKey))
+		if err != nil {
+			return err
+		}
+
+		return m.handleType(n.elem(KeyPlaceholder), vt, fd)
+	case reflect.Map:
+		m.mappings = append(m.mappings, n.length().mapping())
+		return m.handleType(n.elem(KeyPlaceholder), t.Elem(), fd)
+	default:
+		m.mappings = append(m.mappings, n.mapping())
+		return nil
+	}
+}
+
+func (m *propertyMapper) handleStruct(n propertyNode, t reflect.Type, fd *godescriptor.FieldDescriptorProto) error {
+	msg, ok := reflect.New(t).Interface().(descriptor.Message)
+	if !ok {
+		return errors.New("%s is not a protobuf message", t)
+	}
+
+	_, md := descriptor.ForMessage(msg)
+	mappingKey := generator.CamelCase(mappingKeyFrom(fd))
+	oneOfs := proto.GetProperties(t).OneofTypes
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Tag.Get("protobuf_oneof") == "" {
+			err := m.handleField(n, field, md, mappingKey)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// every option of the oneof may end up in the tree
+		for _, oot := range oneOfs {
+			if oot.Field != i {
+				continue
+			}
+
+			err := m.handleField(n, oot.Type.Elem().Field(0), md, mappingKey)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *propertyMapper) handleField(n propertyNode, field reflect.StructField, md *godescriptor.DescriptorProto, mappingKey string) error {
+	// ignore protobuf internals and the key of a mapped element
+	if strings.HasPrefix(field.Name, "XXX_") || field.Name == mappingKey {
+		return nil
+	}
+
+	name, num, err := proofs.ExtractFieldTags(field.Tag.Get("protobuf"))
+	if err != nil {
+		return errors.New("failed to extract protobuf tag info from %s: %v", field.Name, err)
+	}
+
+	var fd *godescriptor.FieldDescriptorProto
+	for _, f := range md.Field {
+		if f.GetNumber() == int32(num) {
+			fd = f
+			break
+		}
+	}
+
+	if boolOption(fd, proofspb.E_ExcludeFromTree) {
+		return nil
+	}
+
+	fn := n.field(name, num, fd.GetOptions().GetDeprecated())
+	if boolOption(fd, proofspb.E_HashedField) {
+		m.mappings = append(m.mappings, fn.mapping())
+		return nil
+	}
+
+	return m.handleType(fn, field.Type, fd)
+}
+
+// mappedValueType returns the type of the value stored in the tree for a repeated field with a mapping key.
+// Elements with a single field besides the key are stored as that field.
+func mappedValueType(elem reflect.Type, mappingKey string) (reflect.Type, error) {
+	st := elem.Elem()
+	if _, ok := st.FieldByName(mappingKey); !ok {
+		return nil, errors.New("%s does not have field %q", st, mappingKey)
+	}
+
+	msg, ok := reflect.New(st).Interface().(descriptor.Message)
+	if !ok {
+		return nil, errors.New("%s is not a protobuf message", st)
+	}
+
+	_, md := descriptor.ForMessage(msg)
+	if len(md.Field) != 2 {
+		return elem, nil
+	}
+
+	vf, ok := st.FieldByNameFunc(func(name string) bool {
+		return !strings.HasPrefix(name, "XXX_") && name != mappingKey
+	})
+	if !ok {
+		return nil, errors.New("could not find field in %s not called %q", st, mappingKey)
+	}
+
+	return vf.Type, nil
+}
+
+func boolOption(fd *godescriptor.FieldDescriptorProto, ext *proto.ExtensionDesc) bool {
+	if fd == nil || fd.Options == nil {
+		return false
+	}
+
+	v, err := proto.GetExtension(fd.Options, ext)
+	if err != nil {
+		return false
+	}
+
+	b, ok := v.(*bool)
+	return ok && *b
+}
+
+func mappingKeyFrom(fd *godescriptor.FieldDescriptorProto) string {
+	if fd == nil || fd.Options == nil {
+		return ""
+	}
+
+	v, err := proto.GetExtension(fd.Options, proofspb.E_MappingKey)
+	if err != nil {
+		return ""
+	}
+
+	s, ok := v.(*string)
+	if !ok {
+		return ""
+	}
+
+	return *s
+}
+
+func sortPropertyMappings(mappings []PropertyMapping) {
+	sort.Slice(mappings, func(i, j int) bool {
+		if mappings[i].CompactName == mappings[j].CompactName {
+			return mappings[i].ReadableName < mappings[j].ReadableName
+		}
+
+		return mappings[i].CompactName < mappings[j].CompactName
+	})
+}
+
+// GetPropertyMappings returns the property mappings of the tree generated from messages of the given type.
+// The leaves of the extensions are added to the same tree, so their mappings are returned as well.
+func GetPropertyMappings(data proto.Message, prefix string, compactPrefix []byte, extensions ...proto.Message) ([]PropertyMapping, error) {
+	m := new(propertyMapper)
+	for _, msg := range append([]proto.Message{data}, extensions...) {
+		err := m.handleType(propertyNode{readable: prefix, compact: hex.EncodeToString(compactPrefix)}, reflect.TypeOf(msg), nil)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrPropertyMappings, err)
+		}
+	}
+
+	sortPropertyMappings(m.mappings)
+	return m.mappings, nil
+}
+
+// CoreDocumentPropertyMappings returns the property mappings of the core document, signatures, signing and document root trees.
+func CoreDocumentPropertyMappings() ([]PropertyMapping, error) {
+	cdMappings, err := GetPropertyMappings(new(coredocumentpb.CoreDocument), CDTreePrefix, compactProperties(CDTreePrefix), new(coredocumentextpb.CoreDocumentExtension))
+	if err != nil {
+		return nil, err
+	}
+
+	sigMappings, err := GetPropertyMappings(new(coredocumentpb.SignatureData), SignaturesTreePrefix, compactProperties(SignaturesTreePrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	mappings := append(cdMappings, sigMappings...)
+
+	// leaves added to the trees by hand
+	for _, f := range [][2]string{
+		{CDTreePrefix, DocumentTypeField},
+		{SigningTreePrefix, DataRootField},
+		{SigningTreePrefix, CDRootField},
+		{DRTreePrefix, SigningRootField},
+		{DRTreePrefix, SignaturesRootField},
+	} {
+		mappings = append(mappings, PropertyMapping{
+			ReadableName: fmt.Sprintf(proofs.SubFieldFormat, f[0], f[1]),
+			CompactName:  "0x" + hex.EncodeToString(append(compactProperties(f[0]), compactProperties(f[1])...)),
+		})
+	}
+
+	sortPropertyMappings(mappings)
+	return mappings, nil
+}
@@ -0,0 +1,89 @@
+// +build unit
+
+package documents
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/precise-proofs/proofs"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/assert"
+)
+
+// hasPropertyMapping checks if the property is described by one of the mappings.
+func hasPropertyMapping(mappings []PropertyMapping, p proofs.Property) bool {
+	pattern := func(s string, index, key string) *regexp.Regexp {
+		s = regexp.QuoteMeta(s)
+		s = strings.Replace(s, regexp.QuoteMeta(IndexPlaceholder), index, -1)
+		s = strings.Replace(s, regexp.QuoteMeta(KeyPlaceholder), key, -1)
+		return regexp.MustCompile("^" + s + "$")
+	}
+
+	for _, m := range mappings {
+		if pattern(m.ReadableName, `[0-9]+`, `.+`).MatchString(p.ReadableName()) &&
+			pattern(m.CompactName, `[0-9a-f]{16}`, `[0-9a-f]+`).MatchString(hexutil.Encode(p.CompactName())) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestCoreDocumentPropertyMappings(t *testing.T) {
+	mappings, err := CoreDocumentPropertyMappings()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, mappings)
+
+	names := make(map[string]string)
+	for _, m := range mappings {
+		_, ok := names[m.ReadableName]
+		assert.False(t, ok, "duplicate mapping for %s", m.ReadableName)
+		names[m.ReadableName] = m.CompactName
+	}
+
+	assert.Equal(t, "0x0100000000000064", names["cd_tree.document_type"])
+	assert.Equal(t, "0x0200000000000005", names["signing_tree.data_root"])
+	assert.Equal(t, "0x0200000000000007", names["signing_tree.cd_root"])
+	assert.Equal(t, "0x040000000000000a", names["dr_tree.signing_root"])
+	assert.Equal(t, "0x0400000000000006", names["dr_tree.signatures_root"])
+
+	// every leaf of a core document tree must be described by the mappings
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: []string{testingidentity.GenerateRandomDID().String()}}, []byte{0, 1, 0, 0})
+	assert.NoError(t, err)
+	cd.Document.EmbeddedData = &any.Any{TypeUrl: documenttypes.InvoiceDataTypeUrl, Value: []byte{}}
+	assert.NoError(t, cd.setSalts())
+	tree, err := cd.documentTree(documenttypes.InvoiceDataTypeUrl)
+	assert.NoError(t, err)
+	props := tree.PropertyOrder()
+	assert.NotEmpty(t, props)
+	for _, p := range props {
+		assert.True(t, hasPropertyMapping(mappings, p), "missing mapping for %s", p.ReadableName())
+	}
+}
+
+func TestGetPropertyMappings_prefix(t *testing.T) {
+	mappings, err := CoreDocumentPropertyMappings()
+	assert.NoError(t, err)
+
+	prefixed, err := GetPropertyMappings(new(coredocumentpb.CoreDocument), "prefix", []byte{9, 0, 0, 0}, new(coredocumentextpb.CoreDocumentExtension))
+	assert.NoError(t, err)
+	for _, p := range prefixed {
+		assert.True(t, strings.HasPrefix(p.ReadableName, "prefix."))
+		assert.True(t, strings.HasPrefix(p.CompactName, "0x09000000"))
+	}
+
+	var cdCount int
+	for _, m := range mappings {
+		if strings.HasPrefix(m.ReadableName, CDTreePrefix+".") && m.ReadableName != CDTreePrefix+"."+DocumentTypeField {
+			cdCount++
+		}
+	}
+	assert.Equal(t, cdCount, len(prefixed))
+}
@@ -51,8 +51,13 @@ func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		return errors.New("config service not initialised")
 	}
 
+	cfg, ok := ctx[bootstrap.BootstrappedConfig].(config.Configuration)
+	if !ok {
+		return errors.New("config not initialised")
+	}
+
 	// register service
-	srv := DefaultService(docSrv, repo, queueSrv, txManager)
+	srv := DefaultService(docSrv, repo, queueSrv, txManager, cfg.GetStrictCodeValidation())
 	err := registry.Register(documenttypes.PurchaseOrderDataTypeUrl, srv)
 	if err != nil {
 		return errors.New("failed to register purchase order service")
@@ -187,7 +187,7 @@ func getHandler() *grpcHandler {
 }
 
 func TestGrpcHandler_Get(t *testing.T) {
-	identifier := "0x01010101"
+	identifier := "0x0101010101010101010101010101010101010101010101010101010101010101"
 	identifierBytes, _ := hexutil.Decode(identifier)
 	h := getHandler()
 	srv := h.service.(*mockService)
@@ -209,17 +209,20 @@ func TestGrpcHandler_GetVersion_invalid_input(t *testing.T) {
 	srv := h.service.(*mockService)
 	payload := &clientpopb.GetVersionRequest{Identifier: "0x0x", Version: "0x00"}
 	res, err := h.GetVersion(testingconfig.HandlerContext(configService), payload)
-	assert.EqualError(t, err, "identifier is invalid: invalid hex string")
-	payload.Version = "0x0x"
+	assert.EqualError(t, err, "identifier is invalid: identifier must be a 0x prefixed hex string: invalid hex string")
 	payload.Identifier = "0x01"
 
 	res, err = h.GetVersion(testingconfig.HandlerContext(configService), payload)
-	assert.EqualError(t, err, "version is invalid: invalid hex string")
-	payload.Version = "0x00"
-	payload.Identifier = "0x01"
+	assert.EqualError(t, err, "identifier is invalid: identifier has invalid length: expected 32 bytes but got 1")
+	payload.Version = "0x0x"
+	payload.Identifier = "0x0101010101010101010101010101010101010101010101010101010101010101"
+
+	res, err = h.GetVersion(testingconfig.HandlerContext(configService), payload)
+	assert.EqualError(t, err, "version is invalid: identifier must be a 0x prefixed hex string: invalid hex string")
+	payload.Version = "0x0000000000000000000000000000000000000000000000000000000000000000"
 
 	mockErr := errors.New("not found")
-	srv.On("GetVersion", mock.Anything, []byte{0x01}, []byte{0x00}).Return(nil, mockErr)
+	srv.On("GetVersion", mock.Anything, hexutil.MustDecode(payload.Identifier), hexutil.MustDecode(payload.Version)).Return(nil, mockErr)
 	res, err = h.GetVersion(testingconfig.HandlerContext(configService), payload)
 	srv.AssertExpectations(t)
 	assert.EqualError(t, err, "document not found: not found")
@@ -230,10 +233,10 @@ func TestGrpcHandler_GetVersion(t *testing.T) {
 	h := getHandler()
 	srv := h.service.(*mockService)
 	model := new(mockModel)
-	payload := &clientpopb.GetVersionRequest{Identifier: "0x01", Version: "0x00"}
+	payload := &clientpopb.GetVersionRequest{Identifier: "0x0101010101010101010101010101010101010101010101010101010101010101", Version: "0x0000000000000000000000000000000000000000000000000000000000000000"}
 
 	response := &clientpopb.PurchaseOrderResponse{}
-	srv.On("GetVersion", mock.Anything, []byte{0x01}, []byte{0x00}).Return(model, nil)
+	srv.On("GetVersion", mock.Anything, hexutil.MustDecode(payload.Identifier), hexutil.MustDecode(payload.Version)).Return(model, nil)
 	srv.On("DerivePurchaseOrderResponse", model).Return(response, nil)
 	res, err := h.GetVersion(testingconfig.HandlerContext(configService), payload)
 	model.AssertExpectations(t)
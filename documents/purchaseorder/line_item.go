@@ -0,0 +1,234 @@
+package purchaseorder
+
+import (
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	clientpurchaseorderpb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/purchaseorder"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/purchaseorderext"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// LineItem is a single ordered item of a purchase order.
+// Every line item is a separate leaf group of the data tree, so fields such as po.line_items[0].quantity can be proven.
+type LineItem struct {
+	ItemNumber    string
+	Description   string
+	Quantity      *documents.Decimal
+	UnitOfMeasure string // unit the quantity is measured in, like kg or pcs
+	UnitPrice     *documents.Decimal
+	Amount        *documents.Decimal // quantity times unit price
+}
+
+// DeliveryMilestone is a scheduled delivery of the ordered items.
+type DeliveryMilestone struct {
+	ItemNumber   string // item number of the delivered line item, empty if the milestone covers the whole order
+	Description  string
+	Quantity     *documents.Decimal
+	DeliveryDate *timestamp.Timestamp
+}
+
+// lineItemsFromClientData parses the line items of the client data.
+func lineItemsFromClientData(items []*clientpurchaseorderpb.LineItem) ([]*LineItem, error) {
+	var lis []*LineItem
+	for idx, item := range items {
+		li := &LineItem{
+			ItemNumber:    item.ItemNumber,
+			Description:   item.Description,
+			UnitOfMeasure: item.UnitOfMeasure,
+		}
+
+		decs := []struct {
+			name string
+			val  string
+			dec  **documents.Decimal
+		}{
+			{"quantity", item.Quantity, &li.Quantity},
+			{"unit price", item.UnitPrice, &li.UnitPrice},
+			{"amount", item.Amount, &li.Amount},
+		}
+
+		for _, d := range decs {
+			dec, err := documents.StringToDecimal(d.val)
+			if err != nil {
+				return nil, errors.NewTypedError(documents.ErrInvalidDecimal, errors.New("failed to parse %s of line item %d: %v", d.name, idx, err))
+			}
+
+			*d.dec = dec
+		}
+
+		lis = append(lis, li)
+	}
+
+	return lis, nil
+}
+
+// lineItemsToClientData returns the line items in the client format.
+func lineItemsToClientData(items []*LineItem) []*clientpurchaseorderpb.LineItem {
+	var lis []*clientpurchaseorderpb.LineItem
+	for _, item := range items {
+		lis = append(lis, &clientpurchaseorderpb.LineItem{
+			ItemNumber:    item.ItemNumber,
+			Description:   item.Description,
+			Quantity:      documents.DecimalToString(item.Quantity),
+			UnitOfMeasure: item.UnitOfMeasure,
+			UnitPrice:     documents.DecimalToString(item.UnitPrice),
+			Amount:        documents.DecimalToString(item.Amount),
+		})
+	}
+
+	return lis
+}
+
+// lineItemsToP2PProtobuf returns the line items in the p2p protobuf format.
+func lineItemsToP2PProtobuf(items []*LineItem) ([]*purchaseorderextpb.LineItem, error) {
+	var lis []*purchaseorderextpb.LineItem
+	for idx, item := range items {
+		li := &purchaseorderextpb.LineItem{
+			ItemNumber:    item.ItemNumber,
+			Description:   item.Description,
+			UnitOfMeasure: item.UnitOfMeasure,
+		}
+
+		decs := []struct {
+			name string
+			dec  *documents.Decimal
+			val  *[]byte
+		}{
+			{"quantity", item.Quantity, &li.Quantity},
+			{"unit price", item.UnitPrice, &li.UnitPrice},
+			{"amount", item.Amount, &li.Amount},
+		}
+
+		for _, d := range decs {
+			b, err := documents.DecimalToBytes(d.dec)
+			if err != nil {
+				return nil, errors.New("failed to encode %s of line item %d: %v", d.name, idx, err)
+			}
+
+			*d.val = b
+		}
+
+		lis = append(lis, li)
+	}
+
+	return lis, nil
+}
+
+// lineItemsFromP2PProtobuf loads the line items from the p2p protobuf format.
+func lineItemsFromP2PProtobuf(items []*purchaseorderextpb.LineItem) ([]*LineItem, error) {
+	var lis []*LineItem
+	for idx, item := range items {
+		li := &LineItem{
+			ItemNumber:    item.ItemNumber,
+			Description:   item.Description,
+			UnitOfMeasure: item.UnitOfMeasure,
+		}
+
+		decs := []struct {
+			name string
+			val  []byte
+			dec  **documents.Decimal
+		}{
+			{"quantity", item.Quantity, &li.Quantity},
+			{"unit price", item.UnitPrice, &li.UnitPrice},
+			{"amount", item.Amount, &li.Amount},
+		}
+
+		for _, d := range decs {
+			dec, err := documents.BytesToDecimal(d.val)
+			if err != nil {
+				return nil, errors.New("failed to decode %s of line item %d: %v", d.name, idx, err)
+			}
+
+			*d.dec = dec
+		}
+
+		lis = append(lis, li)
+	}
+
+	return lis, nil
+}
+
+// milestonesFromClientData parses the delivery milestones of the client data.
+func milestonesFromClientData(milestones []*clientpurchaseorderpb.DeliveryMilestone) ([]*DeliveryMilestone, error) {
+	var dms []*DeliveryMilestone
+	for idx, m := range milestones {
+		quantity, err := documents.StringToDecimal(m.Quantity)
+		if err != nil {
+			return nil, errors.NewTypedError(documents.ErrInvalidDecimal, errors.New("failed to parse quantity of delivery milestone %d: %v", idx, err))
+		}
+
+		dms = append(dms, &DeliveryMilestone{
+			ItemNumber:   m.ItemNumber,
+			Description:  m.Description,
+			Quantity:     quantity,
+			DeliveryDate: m.DeliveryDate,
+		})
+	}
+
+	return dms, nil
+}
+
+// milestonesToClientData returns the delivery milestones in the client format.
+func milestonesToClientData(milestones []*DeliveryMilestone) []*clientpurchaseorderpb.DeliveryMilestone {
+	var dms []*clientpurchaseorderpb.DeliveryMilestone
+	for _, m := range milestones {
+		dms = append(dms, &clientpurchaseorderpb.DeliveryMilestone{
+			ItemNumber:   m.ItemNumber,
+			Description:  m.Description,
+			Quantity:     documents.DecimalToString(m.Quantity),
+			DeliveryDate: m.DeliveryDate,
+		})
+	}
+
+	return dms
+}
+
+// milestonesToP2PProtobuf returns the delivery milestones in the p2p protobuf format.
+func milestonesToP2PProtobuf(milestones []*DeliveryMilestone) ([]*purchaseorderextpb.DeliveryMilestone, error) {
+	var dms []*purchaseorderextpb.DeliveryMilestone
+	for idx, m := range milestones {
+		quantity, err := documents.DecimalToBytes(m.Quantity)
+		if err != nil {
+			return nil, errors.New("failed to encode quantity of delivery milestone %d: %v", idx, err)
+		}
+
+		dms = append(dms, &purchaseorderextpb.DeliveryMilestone{
+			ItemNumber:   m.ItemNumber,
+			Description:  m.Description,
+			Quantity:     quantity,
+			DeliveryDate: copyTimestamp(m.DeliveryDate),
+		})
+	}
+
+	return dms, nil
+}
+
+// milestonesFromP2PProtobuf loads the delivery milestones from the p2p protobuf format.
+func milestonesFromP2PProtobuf(milestones []*purchaseorderextpb.DeliveryMilestone) ([]*DeliveryMilestone, error) {
+	var dms []*DeliveryMilestone
+	for idx, m := range milestones {
+		quantity, err := documents.BytesToDecimal(m.Quantity)
+		if err != nil {
+			return nil, errors.New("failed to decode quantity of delivery milestone %d: %v", idx, err)
+		}
+
+		dms = append(dms, &DeliveryMilestone{
+			ItemNumber:   m.ItemNumber,
+			Description:  m.Description,
+			Quantity:     quantity,
+			DeliveryDate: m.DeliveryDate,
+		})
+	}
+
+	return dms, nil
+}
+
+// copyTimestamp copies the timestamp, so that serialising the p2p protobuf leaves the delivery date of the milestone as it is.
+func copyTimestamp(ts *timestamp.Timestamp) *timestamp.Timestamp {
+	if ts == nil {
+		return nil
+	}
+
+	return &timestamp.Timestamp{Seconds: ts.Seconds, Nanos: ts.Nanos}
+}
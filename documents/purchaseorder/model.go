@@ -2,7 +2,9 @@ package purchaseorder
 
 import (
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
@@ -12,7 +14,9 @@ import (
 	"github.com/centrifuge/go-centrifuge/documents"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
 	clientpurchaseorderpb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/purchaseorder"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/purchaseorderext"
 	"github.com/centrifuge/precise-proofs/proofs"
 	"github.com/centrifuge/precise-proofs/proofs/proto"
 	"github.com/ethereum/go-ethereum/common"
@@ -24,9 +28,17 @@ import (
 
 const prefix string = "po"
 
+// Scheme is the name of purchase orders in the document api paths, /documents/purchase_order.
+const Scheme = "purchase_order"
+
 // tree prefixes for specific to documents use the second byte of a 4 byte slice by convention
 func compactPrefix() []byte { return []byte{0, 2, 0, 0} }
 
+// PropertyMappings returns the readable to compact property mappings of the purchase order data tree.
+func PropertyMappings() ([]documents.PropertyMapping, error) {
+	return documents.GetPropertyMappings(new(purchaseorderpb.PurchaseOrderData), prefix, compactPrefix(), new(purchaseorderextpb.PurchaseOrderDataExtension))
+}
+
 // PurchaseOrder implements the documents.Model keeps track of purchase order related fields and state
 type PurchaseOrder struct {
 	*documents.CoreDocument
@@ -41,11 +53,11 @@ type PurchaseOrder struct {
 	RecipientStreet    string
 	RecipientCity      string
 	RecipientZipcode   string
-	RecipientCountry   string // country ISO code of the recipient of this purchase order
-	Currency           string // ISO currency code
-	OrderAmount        int64  // ordering gross amount including tax
-	NetAmount          int64  // invoice amount excluding tax
-	TaxAmount          int64
+	RecipientCountry   string             // country ISO code of the recipient of this purchase order
+	Currency           string             // ISO currency code
+	OrderAmount        *documents.Decimal // ordering gross amount including tax
+	NetAmount          *documents.Decimal // invoice amount excluding tax
+	TaxAmount          *documents.Decimal
 	TaxRate            int64
 	Recipient          *identity.DID
 	Order              []byte
@@ -54,6 +66,9 @@ type PurchaseOrder struct {
 	DeliveryDate       *timestamp.Timestamp // requested delivery date
 	DateCreated        *timestamp.Timestamp // purchase order date
 	ExtraData          []byte
+	LineItems          []*LineItem
+	DeliveryMilestones []*DeliveryMilestone
+	UnknownFields      []byte // serialised purchase order data fields of newer schemas, preserved as is
 	PurchaseOrderSalts *proofs.Salts
 }
 
@@ -75,36 +90,77 @@ func (p *PurchaseOrder) getClientData() *clientpurchaseorderpb.PurchaseOrderData
 	}
 
 	return &clientpurchaseorderpb.PurchaseOrderData{
-		PoStatus:         p.Status,
-		PoNumber:         p.PoNumber,
-		OrderName:        p.OrderName,
-		OrderStreet:      p.OrderStreet,
-		OrderCity:        p.OrderCity,
-		OrderZipcode:     p.OrderZipcode,
-		OrderCountry:     p.OrderCountry,
-		RecipientName:    p.RecipientName,
-		RecipientStreet:  p.RecipientStreet,
-		RecipientCity:    p.RecipientCity,
-		RecipientZipcode: p.RecipientZipcode,
-		RecipientCountry: p.RecipientCountry,
-		Currency:         p.Currency,
-		OrderAmount:      p.OrderAmount,
-		NetAmount:        p.NetAmount,
-		TaxAmount:        p.TaxAmount,
-		TaxRate:          p.TaxRate,
-		Recipient:        recipient,
-		Order:            order,
-		OrderContact:     p.OrderContact,
-		Comment:          p.Comment,
-		DeliveryDate:     p.DeliveryDate,
-		DateCreated:      p.DateCreated,
-		ExtraData:        extraData,
+		PoStatus:           p.Status,
+		PoNumber:           p.PoNumber,
+		OrderName:          p.OrderName,
+		OrderStreet:        p.OrderStreet,
+		OrderCity:          p.OrderCity,
+		OrderZipcode:       p.OrderZipcode,
+		OrderCountry:       p.OrderCountry,
+		RecipientName:      p.RecipientName,
+		RecipientStreet:    p.RecipientStreet,
+		RecipientCity:      p.RecipientCity,
+		RecipientZipcode:   p.RecipientZipcode,
+		RecipientCountry:   p.RecipientCountry,
+		Currency:           p.Currency,
+		OrderAmount:        documents.DecimalToString(p.OrderAmount),
+		NetAmount:          documents.DecimalToString(p.NetAmount),
+		TaxAmount:          documents.DecimalToString(p.TaxAmount),
+		TaxRate:            p.TaxRate,
+		Recipient:          recipient,
+		Order:              order,
+		OrderContact:       p.OrderContact,
+		Comment:            p.Comment,
+		DeliveryDate:       p.DeliveryDate,
+		DateCreated:        p.DateCreated,
+		ExtraData:          extraData,
+		LineItems:          lineItemsToClientData(p.LineItems),
+		DeliveryMilestones: milestonesToClientData(p.DeliveryMilestones),
 	}
 
 }
 
-// createP2PProtobuf returns centrifuge protobuf specific purchaseOrderData
-func (p *PurchaseOrder) createP2PProtobuf() *purchaseorderpb.PurchaseOrderData {
+// createP2PProtobuf returns centrifuge protobuf specific purchaseOrderData and the extension of the purchase order data.
+// The extension is serialised into the unknown fields of the purchase order data, ahead of the fields of newer schemas.
+func (p *PurchaseOrder) createP2PProtobuf() (*purchaseorderpb.PurchaseOrderData, *purchaseorderextpb.PurchaseOrderDataExtension, error) {
+	orderAmount, err := documents.DecimalToBytes(p.OrderAmount)
+	if err != nil {
+		return nil, nil, errors.New("failed to encode order amount: %v", err)
+	}
+
+	netAmount, err := documents.DecimalToBytes(p.NetAmount)
+	if err != nil {
+		return nil, nil, errors.New("failed to encode net amount: %v", err)
+	}
+
+	taxAmount, err := documents.DecimalToBytes(p.TaxAmount)
+	if err != nil {
+		return nil, nil, errors.New("failed to encode tax amount: %v", err)
+	}
+
+	lineItems, err := lineItemsToP2PProtobuf(p.LineItems)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	milestones, err := milestonesToP2PProtobuf(p.DeliveryMilestones)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ext := &purchaseorderextpb.PurchaseOrderDataExtension{
+		OrderAmountDecimal: orderAmount,
+		NetAmountDecimal:   netAmount,
+		TaxAmountDecimal:   taxAmount,
+		LineItems:          lineItems,
+		DeliveryMilestones: milestones,
+	}
+
+	extData, err := proto.Marshal(ext)
+	if err != nil {
+		return nil, nil, errors.New("failed to serialise the purchase order data extension: %v", err)
+	}
+
 	var recipient []byte
 	if p.Recipient != nil {
 		recipient = p.Recipient[:]
@@ -124,9 +180,6 @@ func (p *PurchaseOrder) createP2PProtobuf() *purchaseorderpb.PurchaseOrderData {
 		RecipientZipcode: p.RecipientZipcode,
 		RecipientCountry: p.RecipientCountry,
 		Currency:         p.Currency,
-		OrderAmount:      p.OrderAmount,
-		NetAmount:        p.NetAmount,
-		TaxAmount:        p.TaxAmount,
 		TaxRate:          p.TaxRate,
 		Recipient:        recipient,
 		Order:            p.Order,
@@ -135,7 +188,8 @@ func (p *PurchaseOrder) createP2PProtobuf() *purchaseorderpb.PurchaseOrderData {
 		DeliveryDate:     p.DeliveryDate,
 		DateCreated:      p.DateCreated,
 		ExtraData:        p.ExtraData,
-	}
+		XXX_unrecognized: append(extData, p.UnknownFields...),
+	}, ext, nil
 
 }
 
@@ -146,7 +200,7 @@ func (p *PurchaseOrder) InitPurchaseOrderInput(payload *clientpurchaseorderpb.Pu
 		return err
 	}
 
-	collaborators := append([]string{self}, payload.Collaborators...)
+	collaborators := documents.NewCollaboratorsAccess(append([]string{self}, payload.Collaborators...), payload.ReadAccess, payload.WriteAccess)
 	cd, err := documents.NewCoreDocumentWithCollaborators(collaborators, compactPrefix())
 	if err != nil {
 		return errors.New("failed to init core document: %v", err)
@@ -171,11 +225,34 @@ func (p *PurchaseOrder) initPurchaseOrderFromData(data *clientpurchaseorderpb.Pu
 	p.RecipientZipcode = data.RecipientZipcode
 	p.RecipientCountry = data.RecipientCountry
 	p.Currency = data.Currency
-	p.OrderAmount = data.OrderAmount
-	p.NetAmount = data.NetAmount
-	p.TaxAmount = data.TaxAmount
 	p.TaxRate = data.TaxRate
 
+	var err error
+	p.OrderAmount, err = documents.StringToDecimal(data.OrderAmount)
+	if err != nil {
+		return centerrors.Wrap(err, "failed to parse order amount")
+	}
+
+	p.NetAmount, err = documents.StringToDecimal(data.NetAmount)
+	if err != nil {
+		return centerrors.Wrap(err, "failed to parse net amount")
+	}
+
+	p.TaxAmount, err = documents.StringToDecimal(data.TaxAmount)
+	if err != nil {
+		return centerrors.Wrap(err, "failed to parse tax amount")
+	}
+
+	p.LineItems, err = lineItemsFromClientData(data.LineItems)
+	if err != nil {
+		return err
+	}
+
+	p.DeliveryMilestones, err = milestonesFromClientData(data.DeliveryMilestones)
+	if err != nil {
+		return err
+	}
+
 	if data.Order != "" {
 		order, err := hexutil.Decode(data.Order)
 		if err != nil {
@@ -209,7 +286,39 @@ func (p *PurchaseOrder) initPurchaseOrderFromData(data *clientpurchaseorderpb.Pu
 }
 
 // loadFromP2PProtobuf loads the purcase order from centrifuge protobuf purchase order data
-func (p *PurchaseOrder) loadFromP2PProtobuf(data *purchaseorderpb.PurchaseOrderData) {
+// The extension of the purchase order data is read from its unknown fields, the remaining unknown fields are preserved as is.
+func (p *PurchaseOrder) loadFromP2PProtobuf(data *purchaseorderpb.PurchaseOrderData) error {
+	ext := new(purchaseorderextpb.PurchaseOrderDataExtension)
+	err := proto.Unmarshal(data.XXX_unrecognized, ext)
+	if err != nil {
+		return errors.New("failed to decode the purchase order data extension: %v", err)
+	}
+
+	orderAmount, err := documents.BytesToDecimal(ext.OrderAmountDecimal)
+	if err != nil {
+		return errors.New("failed to decode order amount: %v", err)
+	}
+
+	netAmount, err := documents.BytesToDecimal(ext.NetAmountDecimal)
+	if err != nil {
+		return errors.New("failed to decode net amount: %v", err)
+	}
+
+	taxAmount, err := documents.BytesToDecimal(ext.TaxAmountDecimal)
+	if err != nil {
+		return errors.New("failed to decode tax amount: %v", err)
+	}
+
+	lineItems, err := lineItemsFromP2PProtobuf(ext.LineItems)
+	if err != nil {
+		return err
+	}
+
+	milestones, err := milestonesFromP2PProtobuf(ext.DeliveryMilestones)
+	if err != nil {
+		return err
+	}
+
 	p.Status = data.PoStatus
 	p.PoNumber = data.PoNumber
 	p.OrderName = data.OrderName
@@ -223,9 +332,9 @@ func (p *PurchaseOrder) loadFromP2PProtobuf(data *purchaseorderpb.PurchaseOrderD
 	p.RecipientZipcode = data.RecipientZipcode
 	p.RecipientCountry = data.RecipientCountry
 	p.Currency = data.Currency
-	p.OrderAmount = data.OrderAmount
-	p.NetAmount = data.NetAmount
-	p.TaxAmount = data.TaxAmount
+	p.OrderAmount = orderAmount
+	p.NetAmount = netAmount
+	p.TaxAmount = taxAmount
 	p.TaxRate = data.TaxRate
 	p.Order = data.Order
 	p.OrderContact = data.OrderContact
@@ -233,17 +342,22 @@ func (p *PurchaseOrder) loadFromP2PProtobuf(data *purchaseorderpb.PurchaseOrderD
 	p.DeliveryDate = data.DeliveryDate
 	p.DateCreated = data.DateCreated
 	p.ExtraData = data.ExtraData
+	p.LineItems = lineItems
+	p.DeliveryMilestones = milestones
+	p.UnknownFields = ext.XXX_unrecognized
 
 	if data.Recipient != nil {
 		recipient := identity.NewDIDFromBytes(data.Recipient)
 		p.Recipient = &recipient
 	}
+
+	return nil
 }
 
 // getPurchaseOrderSalts returns the purchase oder salts. Initialises if not present
-func (p *PurchaseOrder) getPurchaseOrderSalts(purchaseOrderData *purchaseorderpb.PurchaseOrderData) (*proofs.Salts, error) {
+func (p *PurchaseOrder) getPurchaseOrderSalts() (*proofs.Salts, error) {
 	if p.PurchaseOrderSalts == nil {
-		poSalts, err := documents.GenerateNewSalts(purchaseOrderData, prefix, compactPrefix())
+		poSalts, err := documents.GenerateNewSalts()
 		if err != nil {
 			return nil, errors.New("getPOSalts error %v", err)
 		}
@@ -255,7 +369,11 @@ func (p *PurchaseOrder) getPurchaseOrderSalts(purchaseOrderData *purchaseorderpb
 
 // PackCoreDocument packs the PurchaseOrder into a Core Document
 func (p *PurchaseOrder) PackCoreDocument() (cd coredocumentpb.CoreDocument, err error) {
-	poData := p.createP2PProtobuf()
+	poData, _, err := p.createP2PProtobuf()
+	if err != nil {
+		return cd, err
+	}
+
 	data, err := proto.Marshal(poData)
 	if err != nil {
 		return cd, errors.New("failed to marshal po data: %v", err)
@@ -266,12 +384,12 @@ func (p *PurchaseOrder) PackCoreDocument() (cd coredocumentpb.CoreDocument, err
 		Value:   data,
 	}
 
-	salts, err := p.getPurchaseOrderSalts(poData)
+	salts, err := p.getPurchaseOrderSalts()
 	if err != nil {
 		return cd, errors.New("failed to get po salts: %v", err)
 	}
 
-	return p.CoreDocument.PackCoreDocument(embedData, documents.ConvertToProtoSalts(salts)), nil
+	return p.CoreDocument.PackCoreDocument(embedData, documents.ConvertToProtoSalts(salts))
 }
 
 // UnpackCoreDocument unpacks the core document into PurchaseOrder
@@ -287,9 +405,14 @@ func (p *PurchaseOrder) UnpackCoreDocument(cd coredocumentpb.CoreDocument) error
 		return err
 	}
 
-	p.loadFromP2PProtobuf(poData)
+	err = p.loadFromP2PProtobuf(poData)
+	if err != nil {
+		return err
+	}
+
+	documents.WarnUnknownFields(p.DocumentType(), "unpack", p.UnknownFields)
 	if cd.EmbeddedDataSalts == nil {
-		p.PurchaseOrderSalts, err = p.getPurchaseOrderSalts(poData)
+		p.PurchaseOrderSalts, err = p.getPurchaseOrderSalts()
 		if err != nil {
 			return err
 		}
@@ -297,7 +420,7 @@ func (p *PurchaseOrder) UnpackCoreDocument(cd coredocumentpb.CoreDocument) error
 		p.PurchaseOrderSalts = documents.ConvertToProofSalts(cd.EmbeddedDataSalts)
 	}
 
-	p.CoreDocument = documents.NewCoreDocumentFromProtobuf(cd)
+	p.CoreDocument, err = documents.NewCoreDocumentFromProtobuf(cd)
 	return err
 
 }
@@ -331,8 +454,12 @@ func (p *PurchaseOrder) CalculateDataRoot() ([]byte, error) {
 
 // getDocumentDataTree creates precise-proofs data tree for the model
 func (p *PurchaseOrder) getDocumentDataTree() (tree *proofs.DocumentTree, err error) {
-	poProto := p.createP2PProtobuf()
-	salts, err := p.getPurchaseOrderSalts(poProto)
+	poProto, ext, err := p.createP2PProtobuf()
+	if err != nil {
+		return nil, err
+	}
+
+	salts, err := p.getPurchaseOrderSalts()
 	if err != nil {
 		return nil, err
 	}
@@ -341,6 +468,11 @@ func (p *PurchaseOrder) getDocumentDataTree() (tree *proofs.DocumentTree, err er
 	if err != nil {
 		return nil, errors.New("getDocumentDataTree error %v", err)
 	}
+
+	err = t.AddLeavesFromDocument(ext)
+	if err != nil {
+		return nil, errors.New("getDocumentDataTree error %v", err)
+	}
 	err = t.Generate()
 	if err != nil {
 		return nil, errors.New("getDocumentDataTree error %v", err)
@@ -363,19 +495,38 @@ func (*PurchaseOrder) DocumentType() string {
 	return documenttypes.PurchaseOrderDataTypeUrl
 }
 
+// DataTreePrefix returns the readable and compact prefix of the po data tree.
+func (*PurchaseOrder) DataTreePrefix() (string, []byte) {
+	return prefix, compactPrefix()
+}
+
+// DataTreeLeaves returns the number of leaves of the data tree of the purchase order.
+func (p *PurchaseOrder) DataTreeLeaves() (int, error) {
+	t, err := p.getDocumentDataTree()
+	if err != nil {
+		return 0, err
+	}
+
+	return len(t.PropertyOrder()), nil
+}
+
 // PrepareNewVersion prepares new version from the old invoice.
-func (p *PurchaseOrder) PrepareNewVersion(old documents.Model, data *clientpurchaseorderpb.PurchaseOrderData, collaborators []string) error {
+func (p *PurchaseOrder) PrepareNewVersion(old documents.Model, data *clientpurchaseorderpb.PurchaseOrderData, collaborators documents.CollaboratorsAccess) error {
 	err := p.initPurchaseOrderFromData(data)
 	if err != nil {
 		return err
 	}
 
-	oldCD := old.(*PurchaseOrder).CoreDocument
-	p.CoreDocument, err = oldCD.PrepareNewVersion(collaborators, true, compactPrefix())
+	oldPO := old.(*PurchaseOrder)
+	p.CoreDocument, err = oldPO.CoreDocument.PrepareNewVersion(collaborators, true, compactPrefix())
 	if err != nil {
 		return err
 	}
 
+	// the client data can't hold the fields of newer schemas, carry them over from the old version
+	p.UnknownFields = oldPO.UnknownFields
+	documents.WarnUnknownFields(p.DocumentType(), "new version", p.UnknownFields)
+
 	return nil
 }
 
@@ -390,6 +541,83 @@ func (p *PurchaseOrder) AddNFT(grantReadAccess bool, registry common.Address, to
 	return nil
 }
 
+// LinkDocument links the anchored document root of another document to the PurchaseOrder.
+func (p *PurchaseOrder) LinkDocument(documentID, documentRoot []byte) error {
+	cd, err := p.CoreDocument.AddLink(documentID, documentRoot)
+	if err != nil {
+		return err
+	}
+
+	p.CoreDocument = cd
+	return nil
+}
+
+// AddAttributeSignature adds the signature of a third party over one of the attributes to the PurchaseOrder.
+func (p *PurchaseOrder) AddAttributeSignature(sig *coredocumentextpb.AttributeSignature) error {
+	cd, err := p.CoreDocument.AddAttributeSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	p.CoreDocument = cd
+	return nil
+}
+
+// AddTransferDetail adds the detail of a transfer against the PurchaseOrder, replacing an earlier detail of the transfer.
+func (p *PurchaseOrder) AddTransferDetail(detail *coredocumentextpb.TransferDetail) error {
+	cd, err := p.CoreDocument.AddTransferDetail(detail)
+	if err != nil {
+		return err
+	}
+
+	p.CoreDocument = cd
+	return nil
+}
+
+// AddAttachment adds the attachment to the PurchaseOrder, replacing an earlier attachment with the same name.
+func (p *PurchaseOrder) AddAttachment(attachment *coredocumentextpb.Attachment) error {
+	cd, err := p.CoreDocument.AddAttachment(attachment)
+	if err != nil {
+		return err
+	}
+
+	p.CoreDocument = cd
+	return nil
+}
+
+// UpdateTags adds and removes the tags of the PurchaseOrder on behalf of the account.
+func (p *PurchaseOrder) UpdateTags(account identity.DID, add, remove []string) error {
+	cd, err := p.CoreDocument.UpdateTags(account, p.DocumentType(), add, remove)
+	if err != nil {
+		return err
+	}
+
+	p.CoreDocument = cd
+	return nil
+}
+
+// Cancel cancels the PurchaseOrder, optionally superseded by the document with supersededBy.
+func (p *PurchaseOrder) Cancel(supersededBy []byte) error {
+	cd, err := p.CoreDocument.Cancel(supersededBy)
+	if err != nil {
+		return err
+	}
+
+	p.CoreDocument = cd
+	return nil
+}
+
+// RemoveCollaborators removes the collaborators from the read and transition rules of the next version of the PurchaseOrder.
+func (p *PurchaseOrder) RemoveCollaborators(collaborators []identity.DID) error {
+	cd, err := p.CoreDocument.RemoveCollaborators(collaborators)
+	if err != nil {
+		return err
+	}
+
+	p.CoreDocument = cd
+	return nil
+}
+
 // CalculateSigningRoot returns the signing root of the document.
 // Calculates it if not generated yet.
 func (p *PurchaseOrder) CalculateSigningRoot() ([]byte, error) {
@@ -409,31 +637,38 @@ func (p *PurchaseOrder) CreateNFTProofs(
 
 // CollaboratorCanUpdate checks if the account can update the document.
 func (p *PurchaseOrder) CollaboratorCanUpdate(updated documents.Model, collaborator identity.DID) error {
+	cf, err := p.ChangedFields(updated)
+	if err != nil {
+		return err
+	}
+
+	rules := p.CoreDocument.TransitionRulesFor(collaborator)
+	return documents.ValidateTransitions(rules, cf)
+}
+
+// ChangedFields returns the core document and purchase order fields changed in the updated purchase order.
+func (p *PurchaseOrder) ChangedFields(updated documents.Model) ([]documents.ChangedField, error) {
 	newPo, ok := updated.(*PurchaseOrder)
 	if !ok {
-		return errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("expecting a purchase order but got %T", updated))
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("expecting a purchase order but got %T", updated))
 	}
 
-	// check the core document changes
-	err := p.CoreDocument.CollaboratorCanUpdate(newPo.CoreDocument, collaborator, p.DocumentType())
+	cf, err := p.CoreDocument.GetChangedFields(newPo.CoreDocument, p.DocumentType())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// check purchase order specific changes
 	oldTree, err := p.getDocumentDataTree()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	newTree, err := newPo.getDocumentDataTree()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	rules := p.CoreDocument.TransitionRulesFor(collaborator)
-	cf := documents.GetChangedFields(oldTree, newTree, proofs.DefaultSaltsLengthSuffix)
-	return documents.ValidateTransitions(rules, cf)
+	return append(cf, documents.GetChangedFields(oldTree, newTree, proofs.DefaultSaltsLengthSuffix)...), nil
 }
 
 // AddUpdateLog adds a log to the model to persist an update related meta data such as author
@@ -450,3 +685,38 @@ func (p *PurchaseOrder) Author() identity.DID {
 func (p *PurchaseOrder) Timestamp() (time.Time, error) {
 	return p.CoreDocument.Timestamp()
 }
+
+// SummaryStatus returns the status of the purchase order.
+func (p *PurchaseOrder) SummaryStatus() string {
+	return p.Status
+}
+
+// SummaryFields returns the key fields of the purchase order.
+func (p *PurchaseOrder) SummaryFields() (fields []documents.SummaryField) {
+	fields = documents.AppendSummaryField(fields, "PO Number", p.PoNumber)
+	fields = documents.AppendSummaryField(fields, "Ordered By", p.OrderName)
+	fields = documents.AppendSummaryField(fields, "Recipient", p.RecipientName)
+	fields = documents.AppendSummaryField(fields, "Order Amount", strings.TrimSpace(fmt.Sprintf("%s %s", documents.DecimalToString(p.OrderAmount), p.Currency)))
+	fields = documents.AppendSummaryField(fields, "Delivery Date", documents.SummaryDate(p.DeliveryDate))
+	return fields
+}
+
+// LedgerAmount returns the order amount of the purchase order.
+func (p *PurchaseOrder) LedgerAmount() documents.LedgerAmount {
+	return documents.LedgerAmount{
+		Number:   p.PoNumber,
+		Currency: p.Currency,
+		Amount:   documents.DecimalToString(p.OrderAmount),
+	}
+}
+
+// SearchAttributes returns the searchable fields of the purchase order.
+func (p *PurchaseOrder) SearchAttributes() map[string]string {
+	attrs := make(map[string]string)
+	documents.SetSearchAttribute(attrs, "po_number", p.PoNumber)
+	documents.SetSearchAttribute(attrs, "po_status", p.Status)
+	documents.SetSearchAttribute(attrs, "order_name", p.OrderName)
+	documents.SetSearchAttribute(attrs, "recipient_name", p.RecipientName)
+	documents.SetSearchAttribute(attrs, "currency", p.Currency)
+	return attrs
+}
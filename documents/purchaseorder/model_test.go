@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
@@ -35,6 +36,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -146,11 +148,11 @@ func TestPO_UnpackCoreDocument(t *testing.T) {
 func TestPOModel_getClientData(t *testing.T) {
 	poData := testingdocuments.CreatePOData()
 	poModel := new(PurchaseOrder)
-	poModel.loadFromP2PProtobuf(&poData)
+	assert.NoError(t, poModel.loadFromP2PProtobuf(&poData))
 
 	data := poModel.getClientData()
 	assert.NotNil(t, data, "purchase order data should not be nil")
-	assert.Equal(t, data.OrderAmount, data.OrderAmount, "gross amount must match")
+	assert.Equal(t, "42", data.OrderAmount, "order amount must match")
 	assert.Equal(t, data.Recipient, hexutil.Encode(poModel.Recipient[:]), "recipient should match")
 }
 
@@ -251,7 +253,9 @@ func TestPOModel_createProofsFieldDoesNotExist(t *testing.T) {
 }
 
 func TestPOModel_getDocumentDataTree(t *testing.T) {
-	poModel := PurchaseOrder{PoNumber: "3213121", NetAmount: 2, OrderAmount: 2}
+	amount, err := documents.NewDecimal("2")
+	assert.NoError(t, err)
+	poModel := PurchaseOrder{PoNumber: "3213121", NetAmount: amount, OrderAmount: amount}
 	tree, err := poModel.getDocumentDataTree()
 	assert.Nil(t, err, "tree should be generated without error")
 	_, leaf := tree.GetLeafByProperty("po.po_number")
@@ -259,6 +263,69 @@ func TestPOModel_getDocumentDataTree(t *testing.T) {
 	assert.Equal(t, "po.po_number", leaf.Property.ReadableName())
 }
 
+func TestPurchaseOrder_LineItems(t *testing.T) {
+	payload := testingdocuments.CreatePOPayload()
+	payload.Data.LineItems = []*clientpurchaseorderpb.LineItem{
+		{ItemNumber: "1", Description: "steel", Quantity: "12.5", UnitOfMeasure: "t", UnitPrice: "400", Amount: "5000"},
+		{ItemNumber: "2", Description: "bolts", Quantity: "1000", UnitOfMeasure: "pcs", UnitPrice: "0.05", Amount: "50"},
+	}
+	payload.Data.DeliveryMilestones = []*clientpurchaseorderpb.DeliveryMilestone{
+		{ItemNumber: "1", Description: "first batch", Quantity: "10", DeliveryDate: &timestamp.Timestamp{Seconds: 1000}},
+		{Description: "remainder", DeliveryDate: &timestamp.Timestamp{Seconds: 2000}},
+	}
+
+	// invalid decimals
+	payload.Data.LineItems[1].UnitPrice = "five cents"
+	po := new(PurchaseOrder)
+	err := po.InitPurchaseOrderInput(payload, defaultDID.String())
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrInvalidDecimal, err))
+	payload.Data.LineItems[1].UnitPrice = "0.05"
+	payload.Data.DeliveryMilestones[0].Quantity = "ten"
+	err = po.InitPurchaseOrderInput(payload, defaultDID.String())
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(documents.ErrInvalidDecimal, err))
+
+	payload.Data.DeliveryMilestones[0].Quantity = "10"
+	assert.NoError(t, po.InitPurchaseOrderInput(payload, defaultDID.String()))
+	assert.Len(t, po.LineItems, 2)
+	assert.Len(t, po.DeliveryMilestones, 2)
+	assert.Equal(t, "12.5", po.LineItems[0].Quantity.String())
+	assert.Nil(t, po.DeliveryMilestones[1].Quantity)
+	assert.Equal(t, payload.Data.LineItems, po.getClientData().LineItems)
+	assert.Equal(t, payload.Data.DeliveryMilestones, po.getClientData().DeliveryMilestones)
+
+	// pack and unpack
+	cd, err := po.PackCoreDocument()
+	assert.NoError(t, err)
+	npo := new(PurchaseOrder)
+	assert.NoError(t, npo.UnpackCoreDocument(cd))
+	assert.Equal(t, po.getClientData(), npo.getClientData())
+
+	// prove the quantity of a line item and the date of a milestone
+	_, err = po.CalculateDataRoot()
+	assert.NoError(t, err)
+	_, err = po.CalculateSigningRoot()
+	assert.NoError(t, err)
+	_, err = po.CalculateDocumentRoot()
+	assert.NoError(t, err)
+	proof, err := po.CreateProofs([]string{"po.line_items[0].quantity", "po.delivery_milestones[1].delivery_date"})
+	assert.NoError(t, err)
+	assert.Len(t, proof, 2)
+	tree, err := po.CoreDocument.DocumentRootTree()
+	assert.NoError(t, err)
+	for _, p := range proof {
+		valid, err := tree.ValidateProof(p)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+	assert.Equal(t, testingdocuments.DecimalBytes("12.5"), proof[0].Value)
+
+	// milestone does not exist
+	_, err = po.CreateProofs([]string{"po.delivery_milestones[2].delivery_date"})
+	assert.Error(t, err)
+}
+
 func createPurchaseOrder(t *testing.T) *PurchaseOrder {
 	po := new(PurchaseOrder)
 	err := po.InitPurchaseOrderInput(testingdocuments.CreatePOPayload(), defaultDID.String())
@@ -289,8 +356,8 @@ func TestPurchaseOrder_CollaboratorCanUpdate(t *testing.T) {
 	assert.NoError(t, err)
 	oldPO := model.(*PurchaseOrder)
 	data := oldPO.getClientData()
-	data.OrderAmount = 50
-	err = po.PrepareNewVersion(po, data, []string{id3.String()})
+	data.OrderAmount = "50"
+	err = po.PrepareNewVersion(po, data, documents.CollaboratorsAccess{ReadWriteCollaborators: []string{id3.String()}})
 	assert.NoError(t, err)
 
 	// id1 should have permission
@@ -301,7 +368,7 @@ func TestPurchaseOrder_CollaboratorCanUpdate(t *testing.T) {
 
 	// update the id3 rules to update only order amount
 	po.CoreDocument.Document.TransitionRules[3].MatchType = coredocumentpb.FieldMatchType_FIELD_MATCH_TYPE_EXACT
-	po.CoreDocument.Document.TransitionRules[3].Field = append(compactPrefix(), 0, 0, 0, 13)
+	po.CoreDocument.Document.TransitionRules[3].Field = append(compactPrefix(), 0, 0, 0, 25)
 	po.CoreDocument.Document.DocumentRoot = utils.RandomSlice(32)
 	assert.NoError(t, testRepo().Create(id1[:], po.CurrentVersion(), po))
 
@@ -310,9 +377,9 @@ func TestPurchaseOrder_CollaboratorCanUpdate(t *testing.T) {
 	assert.NoError(t, err)
 	oldPO = model.(*PurchaseOrder)
 	data = oldPO.getClientData()
-	data.OrderAmount = 55
+	data.OrderAmount = "55"
 	data.Currency = "INR"
-	err = po.PrepareNewVersion(po, data, nil)
+	err = po.PrepareNewVersion(po, data, documents.CollaboratorsAccess{})
 	assert.NoError(t, err)
 
 	// id1 should have permission
@@ -327,3 +394,49 @@ func TestPurchaseOrder_CollaboratorCanUpdate(t *testing.T) {
 	assert.Equal(t, 1, errors.Len(err))
 	assert.Contains(t, err.Error(), "po.currency")
 }
+
+func TestPropertyMappings(t *testing.T) {
+	mappings, err := PropertyMappings()
+	assert.NoError(t, err)
+	names := make(map[string]string)
+	for _, m := range mappings {
+		names[m.ReadableName] = m.CompactName
+	}
+
+	// every leaf of the data tree must be described by the mappings
+	po := createPurchaseOrder(t)
+	tree, err := po.getDocumentDataTree()
+	assert.NoError(t, err)
+	for _, p := range tree.PropertyOrder() {
+		if strings.Contains(p.ReadableName(), "[") {
+			continue
+		}
+
+		assert.Equal(t, hexutil.Encode(p.CompactName()), names[p.ReadableName()], p.ReadableName())
+	}
+}
+
+func TestPurchaseOrder_Summary(t *testing.T) {
+	po := createPurchaseOrder(t)
+	po.PoNumber = "PO-1"
+	po.Status = "sent"
+	po.RecipientName = "Bob"
+	assert.Equal(t, "sent", po.SummaryStatus())
+	assert.Equal(t, []documents.SummaryField{
+		{Name: "PO Number", Value: "PO-1"},
+		{Name: "Recipient", Value: "Bob"},
+		{Name: "Order Amount", Value: "42 EUR"},
+	}, po.SummaryFields())
+
+	s, err := documents.NewSummary(po, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "sent", s.Status)
+	assert.Equal(t, po.SummaryFields(), s.Fields)
+	assert.Empty(t, s.AnchorLink)
+}
+
+func TestPurchaseOrder_LedgerAmount(t *testing.T) {
+	po := createPurchaseOrder(t)
+	po.PoNumber = "PO-1"
+	assert.Equal(t, documents.LedgerAmount{Number: "PO-1", Currency: "EUR", Amount: "42"}, po.LedgerAmount())
+}
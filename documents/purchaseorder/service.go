@@ -1,21 +1,24 @@
 package purchaseorder
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
 	"github.com/centrifuge/go-centrifuge/contextutil"
 	"github.com/centrifuge/go-centrifuge/documents"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
 	clientpopb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/purchaseorder"
 	"github.com/centrifuge/go-centrifuge/queue"
 	"github.com/centrifuge/go-centrifuge/transactions"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/jsonpb"
 )
 
 // Service defines specific functions for purchase order
 type Service interface {
-	documents.Service
+	documents.SchemeService
 
 	// DeriverFromPayload derives purchase order from clientPayload
 	DeriveFromCreatePayload(ctx context.Context, payload *clientpopb.PurchaseOrderCreatePayload) (documents.Model, error)
@@ -37,6 +40,9 @@ type service struct {
 	repo      documents.Repository
 	queueSrv  queue.TaskQueuer
 	txManager transactions.Manager
+
+	// strictCodes requires currency and country codes to be assigned ISO codes
+	strictCodes bool
 }
 
 // DefaultService returns the default implementation of the service
@@ -45,15 +51,27 @@ func DefaultService(
 	repo documents.Repository,
 	queueSrv queue.TaskQueuer,
 	txManager transactions.Manager,
+	strictCodes bool,
 ) Service {
 	return service{
-		repo:      repo,
-		queueSrv:  queueSrv,
-		txManager: txManager,
-		Service:   srv,
+		repo:        repo,
+		queueSrv:    queueSrv,
+		txManager:   txManager,
+		Service:     srv,
+		strictCodes: strictCodes,
 	}
 }
 
+// PropertyMappings returns the readable to compact property mappings of the purchase order data tree.
+func (s service) PropertyMappings() ([]documents.PropertyMapping, error) {
+	return PropertyMappings()
+}
+
+// ValidateFields validates the data fields of the purchase order.
+func (s service) ValidateFields(model documents.Model) error {
+	return fieldValidator(s.strictCodes).Validate(nil, model)
+}
+
 // DeriveFromCoreDocument takes a core document model and returns a purchase order
 func (s service) DeriveFromCoreDocument(cd coredocumentpb.CoreDocument) (documents.Model, error) {
 	po := new(PurchaseOrder)
@@ -83,6 +101,12 @@ func (s service) validateAndPersist(ctx context.Context, old, new documents.Mode
 		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
 	}
 
+	// new versions are persisted as drafts, their roots let the next versions refer to them
+	err = documents.CalculateDraftRoots(po)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
+	}
+
 	// we use CurrentVersion as the id since that will be unique across multiple versions of the same document
 	err = s.repo.Create(selfDID[:], po.CurrentVersion(), po)
 	if err != nil {
@@ -92,6 +116,37 @@ func (s service) validateAndPersist(ctx context.Context, old, new documents.Mode
 	return po, nil
 }
 
+// CreateDraft validates and persists a purchase order as a draft without anchoring it
+func (s service) CreateDraft(ctx context.Context, po documents.Model) (documents.Model, error) {
+	err := s.RunHooks(ctx, documents.HookPreCreate, po)
+	if err != nil {
+		return nil, err
+	}
+
+	po, err = s.validateAndPersist(ctx, nil, po, CreateValidator(s.strictCodes))
+	if err != nil {
+		return nil, err
+	}
+
+	s.EmitEvent(ctx, documents.NewEvent(documents.EventDocumentCreated, po))
+	return po, nil
+}
+
+// UpdateDraft validates and persists a new version of a purchase order as a draft without anchoring it
+func (s service) UpdateDraft(ctx context.Context, new documents.Model) (documents.Model, error) {
+	old, err := s.GetCurrentVersion(ctx, new.ID())
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentNotFound, err)
+	}
+
+	return s.validateAndPersist(ctx, old, new, UpdateValidator(s.strictCodes))
+}
+
+// Commit anchors the current version of a purchase order if it is a draft
+func (s service) Commit(ctx context.Context, documentID []byte) (documents.Model, transactions.TxID, chan bool, error) {
+	return documents.CommitDraft(ctx, s, s.txManager, s.queueSrv, documentID)
+}
+
 // Create validates, persists, and anchors a purchase order
 func (s service) Create(ctx context.Context, po documents.Model) (documents.Model, transactions.TxID, chan bool, error) {
 	selfDID, err := contextutil.AccountDID(ctx)
@@ -99,7 +154,7 @@ func (s service) Create(ctx context.Context, po documents.Model) (documents.Mode
 		return nil, transactions.NilTxID(), nil, errors.NewTypedError(documents.ErrDocumentConfigAccountID, err)
 	}
 
-	po, err = s.validateAndPersist(ctx, nil, po, CreateValidator())
+	po, err = s.CreateDraft(ctx, po)
 	if err != nil {
 		return nil, transactions.NilTxID(), nil, err
 	}
@@ -119,12 +174,7 @@ func (s service) Update(ctx context.Context, new documents.Model) (documents.Mod
 		return nil, transactions.NilTxID(), nil, errors.NewTypedError(documents.ErrDocumentConfigAccountID, err)
 	}
 
-	old, err := s.GetCurrentVersion(ctx, new.ID())
-	if err != nil {
-		return nil, transactions.NilTxID(), nil, errors.NewTypedError(documents.ErrDocumentNotFound, err)
-	}
-
-	new, err = s.validateAndPersist(ctx, old, new, UpdateValidator())
+	new, err = s.UpdateDraft(ctx, new)
 	if err != nil {
 		return nil, transactions.NilTxID(), nil, err
 	}
@@ -164,7 +214,7 @@ func (s service) DeriveFromUpdatePayload(ctx context.Context, payload *clientpop
 	}
 
 	// get latest old version of the document
-	id, err := hexutil.Decode(payload.Identifier)
+	id, err := identifiers.DecodeDocumentID(payload.Identifier)
 	if err != nil {
 		return nil, errors.NewTypedError(documents.ErrDocumentIdentifier, errors.New("failed to decode identifier: %v", err))
 	}
@@ -176,7 +226,7 @@ func (s service) DeriveFromUpdatePayload(ctx context.Context, payload *clientpop
 
 	// load purchase order data
 	po := new(PurchaseOrder)
-	err = po.PrepareNewVersion(old, payload.Data, payload.Collaborators)
+	err = po.PrepareNewVersion(old, payload.Data, documents.NewCollaboratorsAccess(payload.Collaborators, payload.ReadAccess, payload.WriteAccess))
 	if err != nil {
 		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, errors.New("failed to load purchase order from data: %v", err))
 	}
@@ -214,6 +264,7 @@ func (s service) DerivePurchaseOrderResponse(doc documents.Model) (*clientpopb.P
 	h := &clientpopb.ResponseHeader{
 		DocumentId:    hexutil.Encode(doc.ID()),
 		VersionId:     hexutil.Encode(doc.CurrentVersion()),
+		State:         string(doc.GetStatus()),
 		Collaborators: css,
 	}
 
@@ -222,3 +273,63 @@ func (s service) DerivePurchaseOrderResponse(doc documents.Model) (*clientpopb.P
 		Data:   data,
 	}, nil
 }
+
+// Scheme returns the scheme of the purchase orders in the document api
+func (s service) Scheme() string {
+	return Scheme
+}
+
+// DeriveFromClientData derives a purchase order from the json encoded purchase order data
+func (s service) DeriveFromClientData(ctx context.Context, collaborators documents.CollaboratorsAccess, data []byte) (documents.Model, error) {
+	d, err := unmarshalPurchaseOrderData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DeriveFromCreatePayload(ctx, &clientpopb.PurchaseOrderCreatePayload{
+		Collaborators: collaborators.ReadWriteCollaborators,
+		ReadAccess:    collaborators.ReadCollaborators,
+		Data:          d,
+	})
+}
+
+// DeriveFromUpdateClientData derives the next version of the purchase order from the json encoded purchase order data
+func (s service) DeriveFromUpdateClientData(ctx context.Context, identifier string, collaborators documents.CollaboratorsAccess, data []byte) (documents.Model, error) {
+	d, err := unmarshalPurchaseOrderData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DeriveFromUpdatePayload(ctx, &clientpopb.PurchaseOrderUpdatePayload{
+		Identifier:    identifier,
+		Collaborators: collaborators.ReadWriteCollaborators,
+		ReadAccess:    collaborators.ReadCollaborators,
+		Data:          d,
+	})
+}
+
+// DeriveClientData returns the json encoded purchase order data of the model
+func (s service) DeriveClientData(doc documents.Model) ([]byte, error) {
+	data, err := s.DerivePurchaseOrderData(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = (&jsonpb.Marshaler{OrigName: true}).Marshal(&buf, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func unmarshalPurchaseOrderData(data []byte) (*clientpopb.PurchaseOrderData, error) {
+	d := new(clientpopb.PurchaseOrderData)
+	err := jsonpb.Unmarshal(bytes.NewReader(data), d)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentInvalid, err)
+	}
+
+	return d, nil
+}
@@ -52,8 +52,8 @@ func getServiceWithMockedLayers() (*testingcommons.MockIdentityService, Service)
 	txManager := ctx[transactions.BootstrappedService].(transactions.Manager)
 	repo := testRepo()
 	mockAnchor := &mockAnchorRepo{}
-	docSrv := documents.DefaultService(repo, mockAnchor, documents.NewServiceRegistry(), idService)
-	return idService, DefaultService(docSrv, repo, queueSrv, txManager)
+	docSrv := documents.DefaultService(repo, mockAnchor, documents.NewServiceRegistry(), idService, 0, "", 0, nil, nil, nil, nil, nil)
+	return idService, DefaultService(docSrv, repo, queueSrv, txManager, false)
 }
 
 func TestService_Update(t *testing.T) {
@@ -70,7 +70,7 @@ func TestService_Update(t *testing.T) {
 	// success
 	data, err := poSrv.DerivePurchaseOrderData(po)
 	assert.Nil(t, err)
-	data.OrderAmount = 100
+	data.OrderAmount = "100"
 	data.ExtraData = hexutil.Encode(utils.RandomSlice(32))
 	collab := testingidentity.GenerateRandomDID().String()
 	newPO, err := poSrv.DeriveFromUpdatePayload(ctxh, &clientpurchaseorderpb.PurchaseOrderUpdatePayload{
@@ -213,7 +213,7 @@ func TestService_DeriveFromCoreDocument(t *testing.T) {
 	po, ok := m.(*PurchaseOrder)
 	assert.True(t, ok, "must be true")
 	assert.Equal(t, po.Recipient.String(), "0xEA939D5C0494b072c51565b191eE59B5D34fbf79")
-	assert.Equal(t, po.OrderAmount, int64(42))
+	assert.Equal(t, "42", po.OrderAmount.String())
 }
 
 func TestService_Create(t *testing.T) {
@@ -277,6 +277,7 @@ func TestService_DerivePurchaseOrderResponse(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, payload.Data, r.Data)
 	assert.Equal(t, []string{cid.String(), payload.Collaborators[0]}, r.Header.Collaborators)
+	assert.Equal(t, string(documents.StatusDraft), r.Header.State)
 }
 
 func TestService_GetCurrentVersion(t *testing.T) {
@@ -290,7 +291,7 @@ func TestService_GetCurrentVersion(t *testing.T) {
 	data := doc.(*PurchaseOrder).getClientData()
 	data.Currency = "INR"
 	doc2 := new(PurchaseOrder)
-	assert.NoError(t, doc2.PrepareNewVersion(doc, data, nil))
+	assert.NoError(t, doc2.PrepareNewVersion(doc, data, documents.CollaboratorsAccess{}))
 	assert.NoError(t, testRepo().Create(accountID, doc2.CurrentVersion(), doc2))
 
 	doc3, err := poSrv.GetCurrentVersion(ctxh, doc.ID())
@@ -352,7 +353,7 @@ func TestService_calculateDataRoot(t *testing.T) {
 	assert.Nil(t, err)
 	err = poSrv.repo.Create(accountID, po.CurrentVersion(), po)
 	assert.Nil(t, err)
-	po, err = poSrv.validateAndPersist(ctxh, nil, po, CreateValidator())
+	po, err = poSrv.validateAndPersist(ctxh, nil, po, CreateValidator(false))
 	assert.Nil(t, po)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), storage.ErrRepositoryModelCreateKeyExists)
@@ -360,7 +361,7 @@ func TestService_calculateDataRoot(t *testing.T) {
 	// success
 	po, err = poSrv.DeriveFromCreatePayload(ctxh, testingdocuments.CreatePOPayload())
 	assert.Nil(t, err)
-	po, err = poSrv.validateAndPersist(ctxh, nil, po, CreateValidator())
+	po, err = poSrv.validateAndPersist(ctxh, nil, po, CreateValidator(false))
 	assert.Nil(t, err)
 	assert.NotNil(t, po)
 }
@@ -393,3 +394,30 @@ func createCDWithEmbeddedPO(t *testing.T) (documents.Model, coredocumentpb.CoreD
 	assert.NoError(t, err)
 	return po, cd
 }
+
+func TestService_ClientData(t *testing.T) {
+	srv := service{}
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	assert.Equal(t, Scheme, srv.Scheme())
+
+	// invalid json
+	_, err := srv.DeriveFromClientData(ctxh, documents.CollaboratorsAccess{}, []byte(`{"po_number": 1}`))
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalid, err))
+
+	// success
+	m, err := srv.DeriveFromClientData(ctxh, documents.CollaboratorsAccess{}, []byte(`{"po_number": "PO-1", "currency": "EUR", "net_amount": "10"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "PO-1", m.(*PurchaseOrder).getClientData().PoNumber)
+
+	data, err := srv.DeriveClientData(m)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"po_number":"PO-1"`)
+
+	// unknown type
+	_, err = srv.DeriveClientData(&testingdocuments.MockModel{})
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalidType, err))
+
+	// invalid identifier
+	_, err = srv.DeriveFromUpdateClientData(ctxh, "some identifier", documents.CollaboratorsAccess{}, data)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentIdentifier, err))
+}
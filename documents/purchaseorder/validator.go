@@ -5,8 +5,9 @@ import (
 	"github.com/centrifuge/go-centrifuge/errors"
 )
 
-// fieldValidateFunc validates the fields of the purchase order model
-func fieldValidator() documents.Validator {
+// fieldValidateFunc validates the fields of the purchase order model.
+// Currency and country codes must be assigned ISO codes in strict mode.
+func fieldValidator(strictCodes bool) documents.Validator {
 	return documents.ValidatorFunc(func(_, new documents.Model) error {
 		if new == nil {
 			return errors.New("nil document")
@@ -18,8 +19,16 @@ func fieldValidator() documents.Validator {
 		}
 
 		var err error
-		if !documents.IsCurrencyValid(po.Currency) {
-			err = errors.AppendError(err, documents.NewError("po_currency", "currency is invalid"))
+		if cerr := documents.ValidateCurrency(po.Currency, strictCodes); cerr != nil {
+			err = errors.AppendError(err, documents.NewError("po_currency", cerr.Error()))
+		}
+
+		if cerr := documents.ValidateCountry(po.OrderCountry, strictCodes); cerr != nil {
+			err = errors.AppendError(err, documents.NewError("po_order_country", cerr.Error()))
+		}
+
+		if cerr := documents.ValidateCountry(po.RecipientCountry, strictCodes); cerr != nil {
+			err = errors.AppendError(err, documents.NewError("po_recipient_country", cerr.Error()))
 		}
 
 		return err
@@ -27,16 +36,16 @@ func fieldValidator() documents.Validator {
 }
 
 // CreateValidator returns a validator group that should be run before creating the purchase order and persisting it to DB
-func CreateValidator() documents.ValidatorGroup {
+func CreateValidator(strictCodes bool) documents.ValidatorGroup {
 	return documents.ValidatorGroup{
-		fieldValidator(),
+		fieldValidator(strictCodes),
 	}
 }
 
 // UpdateValidator returns a validator group that should be run before updating the purchase order
-func UpdateValidator() documents.ValidatorGroup {
+func UpdateValidator(strictCodes bool) documents.ValidatorGroup {
 	return documents.ValidatorGroup{
-		fieldValidator(),
+		fieldValidator(strictCodes),
 		documents.UpdateVersionValidator(),
 	}
 }
@@ -11,7 +11,7 @@ import (
 )
 
 func TestFieldValidator_Validate(t *testing.T) {
-	fv := fieldValidator()
+	fv := fieldValidator(false)
 
 	//  nil error
 	err := fv.Validate(nil, nil)
@@ -41,12 +41,52 @@ func TestFieldValidator_Validate(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestFieldValidator_Validate_codes(t *testing.T) {
+	// countries are not checked without strict mode
+	fv := fieldValidator(false)
+	err := fv.Validate(nil, &PurchaseOrder{
+		Currency:         "EUR",
+		OrderCountry:     "Germany",
+		RecipientCountry: "DEU",
+	})
+	assert.NoError(t, err)
+
+	// unknown codes are accepted without strict mode
+	err = fv.Validate(nil, &PurchaseOrder{
+		Currency:         "ABC",
+		OrderCountry:     "XX",
+		RecipientCountry: "de",
+	})
+	assert.NoError(t, err)
+
+	// strict mode
+	fv = fieldValidator(true)
+	err = fv.Validate(nil, &PurchaseOrder{
+		Currency:         "ABC",
+		OrderCountry:     "XX",
+		RecipientCountry: "de",
+	})
+	assert.Error(t, err)
+	errs := errors.GetErrs(err)
+	assert.Len(t, errs, 3)
+	assert.Contains(t, errs[0].Error(), "not an ISO 4217 code")
+	assert.Contains(t, errs[1].Error(), "not an ISO 3166-1 alpha-2 code")
+	assert.Contains(t, errs[2].Error(), `did you mean "DE"?`)
+
+	err = fv.Validate(nil, &PurchaseOrder{
+		Currency:         "EUR",
+		OrderCountry:     "DE",
+		RecipientCountry: "US",
+	})
+	assert.NoError(t, err)
+}
+
 func TestCreateValidator(t *testing.T) {
-	cv := CreateValidator()
+	cv := CreateValidator(false)
 	assert.Len(t, cv, 1)
 }
 
 func TestUpdateValidator(t *testing.T) {
-	uv := UpdateValidator()
+	uv := UpdateValidator(false)
 	assert.Len(t, uv, 2)
 }
@@ -3,25 +3,33 @@ package documents
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
 	"github.com/centrifuge/go-centrifuge/contextutil"
 	"github.com/centrifuge/go-centrifuge/crypto"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/centrifuge/precise-proofs/proofs/proto"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/timestamp"
 )
 
 // initReadRules initiates the read rules for a given CoreDocumentModel.
-// Collaborators are given Read_Sign action.
+// Collaborators are given Read_Sign action, requiring the number of signatures from them.
 // if the rules are created already, this is a no-op.
 // if collaborators are empty, it is a no-op
-func (cd *CoreDocument) initReadRules(collaborators []identity.DID) {
+func (cd *CoreDocument) initReadRules(collaborators []identity.DID, requiredSignatures uint32) {
 	if len(cd.Document.Roles) > 0 && len(cd.Document.ReadRules) > 0 {
 		return
 	}
@@ -30,28 +38,98 @@ func (cd *CoreDocument) initReadRules(collaborators []identity.DID) {
 		return
 	}
 
-	cd.addCollaboratorsToReadSignRules(collaborators)
+	cd.addCollaboratorsToReadSignRules(collaborators, requiredSignatures)
 }
 
 // addCollaboratorsToReadSignRules adds the given collaborators to a new read rule with READ_SIGN capability.
+// Every version must be signed by the collaborators with at least requiredSignatures signatures, 0 requires none.
 // The operation is no-op if no collaborators are provided.
 // The operation is not idempotent. So calling twice with same accounts will lead to read rules duplication.
-func (cd *CoreDocument) addCollaboratorsToReadSignRules(collaborators []identity.DID) {
+func (cd *CoreDocument) addCollaboratorsToReadSignRules(collaborators []identity.DID, requiredSignatures uint32) {
 	role := newRoleWithCollaborators(collaborators)
 	if role == nil {
 		return
 	}
 	cd.Document.Roles = append(cd.Document.Roles, role)
 	cd.addNewReadRule(role.RoleKey, coredocumentpb.Action_ACTION_READ_SIGN)
+	if requiredSignatures > 0 {
+		cd.Extension.ReadRuleSignatures = append(cd.Extension.ReadRuleSignatures, &coredocumentextpb.ReadRuleSignatures{
+			RoleKey:            role.RoleKey,
+			RequiredSignatures: requiredSignatures,
+		})
+	}
+}
+
+// addCollaboratorsToReadRules adds the given collaborators to a new read rule with READ capability.
+// The operation is no-op if no collaborators are provided.
+func (cd *CoreDocument) addCollaboratorsToReadRules(collaborators []identity.DID) {
+	role := newRoleWithCollaborators(collaborators)
+	if role == nil {
+		return
+	}
+	cd.Document.Roles = append(cd.Document.Roles, role)
+	cd.addNewReadRule(role.RoleKey, coredocumentpb.Action_ACTION_READ)
 }
 
 // addNewReadRule creates a new read rule as per the role and action.
-func (cd *CoreDocument) addNewReadRule(roleKey []byte, action coredocumentpb.Action) {
+func (cd *CoreDocument) addNewReadRule(roleKey []byte, action coredocumentpb.Action) *coredocumentpb.ReadRule {
 	rule := &coredocumentpb.ReadRule{
 		Action: action,
 		Roles:  [][]byte{roleKey},
 	}
 	cd.Document.ReadRules = append(cd.Document.ReadRules, rule)
+	return rule
+}
+
+// CheckRequiredSignatures checks that the collaborators of every read sign rule requiring signatures signed the version
+// with at least the required number of signatures. A collaborator can add more than one of them by signing with several
+// keys of the identity, such as the keys of the finance and the legal approvers. Signatures are counted once per signer and key.
+func (cd *CoreDocument) CheckRequiredSignatures() (err error) {
+	signers := make(map[int]map[identity.DID]bool)
+	findRole(cd.Document, func(rridx, _ int, role *coredocumentpb.Role) bool {
+		if signers[rridx] == nil {
+			signers[rridx] = make(map[identity.DID]bool)
+		}
+
+		for _, c := range role.Collaborators {
+			signers[rridx][identity.NewDIDFromBytes(c)] = true
+		}
+
+		return false
+	}, coredocumentpb.Action_ACTION_READ_SIGN)
+
+	for i, rule := range cd.Document.ReadRules {
+		required := cd.requiredSignatures(rule)
+		if rule.Action != coredocumentpb.Action_ACTION_READ_SIGN || required == 0 {
+			continue
+		}
+
+		keys := make(map[string]struct{})
+		for _, sig := range cd.Document.SignatureData.Signatures {
+			if signers[i][identity.NewDIDFromBytes(sig.SignerId)] {
+				keys[hexutil.Encode(sig.SignerId)+hexutil.Encode(sig.PublicKey)] = struct{}{}
+			}
+		}
+
+		if count := uint32(len(keys)); count < required {
+			err = errors.AppendError(err, errors.New("read rule %d requires %d signatures of its collaborators, found %d", i, required, count))
+		}
+	}
+
+	return err
+}
+
+// requiredSignatures returns the signatures required by the read rule from the core document extension, 0 if it requires none.
+func (cd *CoreDocument) requiredSignatures(rule *coredocumentpb.ReadRule) uint32 {
+	for _, rs := range cd.Extension.ReadRuleSignatures {
+		for _, rk := range rule.Roles {
+			if bytes.Equal(rs.RoleKey, rk) {
+				return rs.RequiredSignatures
+			}
+		}
+	}
+
+	return 0
 }
 
 // findRole calls OnRole for every role that matches the actions passed in
@@ -141,7 +219,7 @@ func (cd *CoreDocument) addNFTToReadRules(registry common.Address, tokenID []byt
 // AddNFT returns a new CoreDocument model with nft added to the Core Document. If grantReadAccess is true, the nft is added
 // to the read rules.
 func (cd *CoreDocument) AddNFT(grantReadAccess bool, registry common.Address, tokenID []byte) (*CoreDocument, error) {
-	ncd, err := cd.PrepareNewVersion(nil, false, nil)
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{}, false, nil)
 	if err != nil {
 		return nil, errors.New("failed to prepare new version: %v", err)
 	}
@@ -339,12 +417,12 @@ func getRole(key []byte, roles []*coredocumentpb.Role) (*coredocumentpb.Role, er
 	return nil, errors.New("role %d not found", key)
 }
 
-// validateAT validates that given access token against its signature
-func validateAT(publicKey []byte, token *coredocumentpb.AccessToken, requesterID []byte) error {
+// validateAT validates that given access token and its expiry against its signature
+func validateAT(publicKey []byte, token *coredocumentpb.AccessToken, expiresAt *timestamp.Timestamp, requesterID []byte) error {
 	// assemble token message from the token for validation
 	reqID := identity.NewDIDFromBytes(requesterID)
 	granterID := identity.NewDIDFromBytes(token.Granter)
-	tm, err := assembleTokenMessage(token.Identifier, granterID, reqID, token.RoleIdentifier, token.DocumentIdentifier)
+	tm, err := assembleTokenMessage(token.Identifier, granterID, reqID, token.RoleIdentifier, token.DocumentIdentifier, expiresAt)
 	if err != nil {
 		return err
 	}
@@ -365,19 +443,19 @@ func (cd *CoreDocument) findAT(tokenID []byte) (at *coredocumentpb.AccessToken,
 	return at, ErrAccessTokenNotFound
 }
 
-// ATGranteeCanRead checks that the grantee of the access token can read the document requested
-func (cd *CoreDocument) ATGranteeCanRead(ctx context.Context, idService identity.ServiceDID, tokenID, docID []byte, requesterID identity.DID) (err error) {
-	// find the access token
-	at, err := cd.findAT(tokenID)
-	if err != nil {
-		return err
-	}
+// validateATGrantee checks that the access token is valid for the requested document and the requester is its grantee.
+func (cd *CoreDocument) validateATGrantee(ctx context.Context, idService identity.ServiceDID, at *coredocumentpb.AccessToken, docID []byte, requesterID identity.DID) error {
 	granterID := identity.NewDIDFromBytes(at.Granter)
 	granteeID := identity.NewDIDFromBytes(at.Grantee)
 	// check that the peer requesting access is the same identity as the access token grantee
 	if !requesterID.Equal(granteeID) {
 		return ErrRequesterNotGrantee
 	}
+	// check that the access token has not expired
+	expiresAt := cd.atExpiry(at)
+	if isExpiredAT(expiresAt, time.Now().UTC()) {
+		return ErrAccessTokenExpired
+	}
 	// check that the granter of the access token is a collaborator on the document
 	verified := cd.AccountCanRead(granterID)
 	if !verified {
@@ -389,16 +467,94 @@ func (cd *CoreDocument) ATGranteeCanRead(ctx context.Context, idService identity
 	}
 	// validate that the public key of the granter is the public key that has been used to sign the access token
 	// TODO provide the time for validation here using the signature timestamp
-	err = idService.ValidateKey(ctx, granterID, at.Key, &(identity.KeyPurposeSigning.Value), nil)
+	err := idService.ValidateKey(ctx, granterID, at.Key, &(identity.KeyPurposeSigning.Value), nil)
+	if err != nil {
+		return err
+	}
+	return validateAT(at.Key, at, expiresAt, granteeID[:])
+}
+
+// atExpiry returns the expiry of the access token from the core document extension, nil if the token doesn't expire.
+func (cd *CoreDocument) atExpiry(at *coredocumentpb.AccessToken) *timestamp.Timestamp {
+	for _, exp := range cd.Extension.AccessTokenExpiries {
+		if bytes.Equal(exp.Identifier, at.Identifier) {
+			return exp.ExpiresAt
+		}
+	}
+
+	return nil
+}
+
+// isExpiredAT returns true if the access token expiry is set and not after now.
+func isExpiredAT(ts *timestamp.Timestamp, now time.Time) bool {
+	if ts == nil {
+		return false
+	}
+
+	expiresAt, err := utils.FromTimestamp(ts)
+	if err != nil {
+		// malformed expiry is treated as expired
+		return true
+	}
+
+	return !now.Before(expiresAt)
+}
+
+// isProofOnlyAT returns true if the access token only grants access to field proofs.
+// Proof-only tokens have a role with the token's role identifier and the grantee as collaborator.
+func (cd *CoreDocument) isProofOnlyAT(at *coredocumentpb.AccessToken) bool {
+	role, err := getRole(at.RoleIdentifier, cd.Document.Roles)
+	if err != nil {
+		return false
+	}
+
+	_, found := isDIDInRole(role, identity.NewDIDFromBytes(at.Grantee))
+	return found
+}
+
+// ATGranteeCanRead checks that the grantee of the access token can read the document requested
+func (cd *CoreDocument) ATGranteeCanRead(ctx context.Context, idService identity.ServiceDID, tokenID, docID []byte, requesterID identity.DID) (err error) {
+	// find the access token
+	at, err := cd.findAT(tokenID)
 	if err != nil {
 		return err
 	}
-	return validateAT(at.Key, at, granteeID[:])
+
+	if cd.isProofOnlyAT(at) {
+		return ErrAccessTokenProofOnly
+	}
+
+	return cd.validateATGrantee(ctx, idService, at, docID, requesterID)
+}
+
+// ATGranteeCanRequestProofs checks that the grantee of the access token can request proofs for the fields of the document.
+// Tokens granting read access to the document allow proofs for any field.
+func (cd *CoreDocument) ATGranteeCanRequestProofs(ctx context.Context, idService identity.ServiceDID, tokenID, docID []byte, fields []string, requesterID identity.DID) (err error) {
+	at, err := cd.findAT(tokenID)
+	if err != nil {
+		return err
+	}
+
+	if cd.isProofOnlyAT(at) && !bytes.Equal(at.RoleIdentifier, proofRoleKey(fields)) {
+		return ErrAccessTokenFieldsNotGranted
+	}
+
+	return cd.validateATGrantee(ctx, idService, at, docID, requesterID)
+}
+
+// proofRoleKey returns the role key of a proof-only access token for the fields.
+// The key is the sha256 hash of the sorted fields, which binds the signed token to the fields.
+func proofRoleKey(fields []string) []byte {
+	sorted := make([]string, len(fields))
+	copy(sorted, fields)
+	sort.Strings(sorted)
+	h := sha256.Sum256([]byte(strings.Join(sorted, "\n")))
+	return h[:]
 }
 
 // AddAccessToken adds the AccessToken to the document
 func (cd *CoreDocument) AddAccessToken(ctx context.Context, payload documentpb.AccessTokenParams) (*CoreDocument, error) {
-	ncd, err := cd.PrepareNewVersion(nil, false, nil)
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{}, false, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -409,6 +565,21 @@ func (cd *CoreDocument) AddAccessToken(ctx context.Context, payload documentpb.A
 	}
 
 	ncd.Document.AccessTokens = append(ncd.Document.AccessTokens, at)
+	if payload.ExpiresAt != nil {
+		ncd.Extension.AccessTokenExpiries = append(ncd.Extension.AccessTokenExpiries, &coredocumentextpb.AccessTokenExpiry{
+			Identifier: at.Identifier,
+			ExpiresAt:  payload.ExpiresAt,
+		})
+	}
+
+	if len(payload.ProofFields) > 0 {
+		// role without read rule marks the token as proof-only
+		ncd.Document.Roles = append(ncd.Document.Roles, &coredocumentpb.Role{
+			RoleKey:       at.RoleIdentifier,
+			Collaborators: [][]byte{at.Grantee},
+		})
+	}
+
 	return ncd, ncd.setSalts()
 }
 
@@ -424,19 +595,32 @@ func assembleAccessToken(ctx context.Context, payload documentpb.AccessTokenPara
 		return nil, err
 	}
 	granterID := identity.NewDIDFromBytes(id)
-	// TODO: this roleID will be specified later with field level read access
 	roleID := utils.RandomSlice(32)
-	granteeID, err := identity.NewDIDFromString(payload.Grantee)
+	if len(payload.ProofFields) > 0 {
+		roleID = proofRoleKey(payload.ProofFields)
+	}
+	granteeID, err := identifiers.DecodeDID(payload.Grantee)
 	if err != nil {
 		return nil, err
 	}
 	// assemble access token message to be signed
-	docID, err := hexutil.Decode(payload.DocumentIdentifier)
+	docID, err := identifiers.DecodeDocumentID(payload.DocumentIdentifier)
 	if err != nil {
 		return nil, err
 	}
 
-	tm, err := assembleTokenMessage(tokenIdentifier, granterID, granteeID, roleID[:], docID)
+	if payload.ExpiresAt != nil {
+		expiresAt, err := utils.FromTimestamp(payload.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if !expiresAt.After(time.Now().UTC()) {
+			return nil, ErrAccessTokenExpired
+		}
+	}
+
+	tm, err := assembleTokenMessage(tokenIdentifier, granterID, granteeID, roleID[:], docID, payload.ExpiresAt)
 	if err != nil {
 		return nil, err
 	}
@@ -466,8 +650,9 @@ func assembleAccessToken(ctx context.Context, payload documentpb.AccessTokenPara
 	return at, nil
 }
 
-// assembleTokenMessage assembles a token message
-func assembleTokenMessage(tokenIdentifier []byte, granterID identity.DID, granteeID identity.DID, roleID []byte, docID []byte) ([]byte, error) {
+// assembleTokenMessage assembles a token message.
+// The expiry is appended if set so that the signature of the granter covers it.
+func assembleTokenMessage(tokenIdentifier []byte, granterID identity.DID, granteeID identity.DID, roleID []byte, docID []byte, expiresAt *timestamp.Timestamp) ([]byte, error) {
 	ids := [][]byte{tokenIdentifier, roleID, docID}
 	for _, id := range ids {
 		if len(id) != idSize {
@@ -479,5 +664,12 @@ func assembleTokenMessage(tokenIdentifier []byte, granterID identity.DID, grante
 	tm = append(tm, granteeID[:]...)
 	tm = append(tm, roleID...)
 	tm = append(tm, docID...)
+	if expiresAt != nil {
+		exp := make([]byte, 12)
+		binary.BigEndian.PutUint64(exp, uint64(expiresAt.Seconds))
+		binary.BigEndian.PutUint32(exp[8:], uint32(expiresAt.Nanos))
+		tm = append(tm, exp...)
+	}
+
 	return tm, nil
 }
@@ -13,7 +13,6 @@ import (
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
-	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoice"
 	"github.com/centrifuge/go-centrifuge/testingutils/commons"
 	"github.com/centrifuge/go-centrifuge/testingutils/config"
 	"github.com/centrifuge/go-centrifuge/testingutils/identity"
@@ -28,26 +27,69 @@ import (
 func TestReadACLs_initReadRules(t *testing.T) {
 	cd, err := newCoreDocument()
 	assert.NoError(t, err)
-	cd.initReadRules(nil)
+	cd.initReadRules(nil, 0)
 	assert.Nil(t, cd.Document.Roles)
 	assert.Nil(t, cd.Document.ReadRules)
 
 	cs := []identity.DID{testingidentity.GenerateRandomDID()}
-	cd.initReadRules(cs)
+	cd.initReadRules(cs, 0)
 	assert.Len(t, cd.Document.ReadRules, 1)
 	assert.Len(t, cd.Document.Roles, 1)
 
-	cd.initReadRules(cs)
+	cd.initReadRules(cs, 0)
 	assert.Len(t, cd.Document.ReadRules, 1)
 	assert.Len(t, cd.Document.Roles, 1)
 }
 
+func TestCoreDocument_CheckRequiredSignatures(t *testing.T) {
+	author := testingidentity.GenerateRandomDID()
+	approver := testingidentity.GenerateRandomDID()
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{
+		ReadWriteCollaborators: []string{author.String()},
+	}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(32)
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{
+		ReadWriteCollaborators: []string{approver.String()},
+		RequiredSignatures:     2,
+	}, false, nil)
+	assert.NoError(t, err)
+	assert.Len(t, ncd.Document.ReadRules, 2)
+	assert.Equal(t, uint32(0), ncd.requiredSignatures(ncd.Document.ReadRules[0]))
+	assert.Equal(t, uint32(2), ncd.requiredSignatures(ncd.Document.ReadRules[1]))
+	assert.Len(t, ncd.Extension.ReadRuleSignatures, 1)
+	assert.Equal(t, ncd.Document.ReadRules[1].Roles[0], ncd.Extension.ReadRuleSignatures[0].RoleKey)
+	sig := func(did identity.DID) *coredocumentpb.Signature {
+		return &coredocumentpb.Signature{SignerId: did[:], PublicKey: utils.RandomSlice(32), Signature: utils.RandomSlice(65)}
+	}
+
+	// no signatures of the approver
+	ncd.AppendSignatures(sig(author))
+	err = ncd.CheckRequiredSignatures()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "read rule 1 requires 2 signatures of its collaborators, found 0")
+
+	// the same key counts once
+	finance := sig(approver)
+	ncd.AppendSignatures(finance, finance)
+	err = ncd.CheckRequiredSignatures()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "found 1")
+
+	// signed with the finance and the legal keys
+	ncd.AppendSignatures(sig(approver))
+	assert.NoError(t, ncd.CheckRequiredSignatures())
+
+	// rules without required signatures
+	assert.NoError(t, cd.CheckRequiredSignatures())
+}
+
 func TestReadAccessValidator_AccountCanRead(t *testing.T) {
 	cd, err := newCoreDocument()
 	assert.NoError(t, err)
 	account := testingidentity.GenerateRandomDID()
 	cd.Document.DocumentRoot = utils.RandomSlice(32)
-	ncd, err := cd.PrepareNewVersion([]string{account.String()}, false, nil)
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{ReadWriteCollaborators: []string{account.String()}}, false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, ncd.Document.ReadRules)
 	assert.NotNil(t, ncd.Document.Roles)
@@ -97,7 +139,7 @@ func TestCoreDocument_addNFTToReadRules(t *testing.T) {
 
 func TestCoreDocument_NFTOwnerCanRead(t *testing.T) {
 	account := testingidentity.GenerateRandomDID()
-	cd, err := NewCoreDocumentWithCollaborators([]string{account.String()}, nil)
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: []string{account.String()}}, nil)
 	assert.NoError(t, err)
 	registry := common.HexToAddress("0xf72855759a39fb75fc7341139f5d7a3974d4da08")
 
@@ -231,7 +273,7 @@ func TestCoreDocument_getRoleProofKey(t *testing.T) {
 	assert.Error(t, err)
 	assert.Empty(t, pf)
 
-	cd.initReadRules([]identity.DID{account})
+	cd.initReadRules([]identity.DID{account}, 0)
 	roleKey = cd.Document.Roles[0].RoleKey
 	pf, err = getRoleProofKey(cd.Document.Roles, roleKey, testingidentity.GenerateRandomDID())
 	assert.Error(t, err)
@@ -246,14 +288,13 @@ func TestCoreDocument_getRoleProofKey(t *testing.T) {
 func TestCoreDocumentModel_GetNFTProofs(t *testing.T) {
 	cd, err := newCoreDocument()
 	assert.NoError(t, err)
-	invData := &invoicepb.InvoiceData{}
-	dataSalts, err := GenerateNewSalts(invData, "invoice", []byte{1, 0, 0, 0})
+	dataSalts, err := GenerateNewSalts()
 	assert.NoError(t, err)
 
 	cd.Document.DataRoot = utils.RandomSlice(32)
 	cd.Document.EmbeddedData = &any.Any{Value: utils.RandomSlice(32), TypeUrl: documenttypes.InvoiceDataTypeUrl}
 	account := testingidentity.GenerateRandomDID()
-	cd.initReadRules([]identity.DID{account})
+	cd.initReadRules([]identity.DID{account}, 0)
 	registry := common.HexToAddress("0xf72855759a39fb75fc7341139f5d7a3974d4da08")
 	tokenID := utils.RandomSlice(32)
 	cd.Document.EmbeddedDataSalts = ConvertToProtoSalts(dataSalts)
@@ -347,7 +388,7 @@ func TestCoreDocumentModel_ATOwnerCanRead(t *testing.T) {
 	assert.NoError(t, err)
 	granterID := identity.NewDIDFromBytes(id)
 	assert.NoError(t, err)
-	cd, err := NewCoreDocumentWithCollaborators([]string{granterID.String()}, nil)
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: []string{granterID.String()}}, nil)
 	assert.NoError(t, err)
 	cd.Document.DocumentRoot = utils.RandomSlice(32)
 	payload := documentpb.AccessTokenParams{
@@ -386,6 +427,65 @@ func TestCoreDocumentModel_ATOwnerCanRead(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCoreDocumentModel_ATGranteeCanRequestProofs(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	account, _ := contextutil.Account(ctx)
+	srv := new(testingcommons.MockIdentityService)
+	id, err := account.GetIdentityID()
+	assert.NoError(t, err)
+	granteeID := testingidentity.GenerateRandomDID()
+	granterID := identity.NewDIDFromBytes(id)
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: []string{granterID.String()}}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(32)
+	fields := []string{"invoice.gross_amount_decimal", "invoice.currency"}
+	payload := documentpb.AccessTokenParams{
+		Grantee:            hexutil.Encode(granteeID[:]),
+		DocumentIdentifier: hexutil.Encode(cd.Document.DocumentIdentifier),
+		ProofFields:        fields,
+	}
+	ncd, err := cd.AddAccessToken(ctx, payload)
+	assert.NoError(t, err)
+	at := ncd.Document.AccessTokens[0]
+	assert.Equal(t, proofRoleKey(fields), at.RoleIdentifier)
+
+	// grantee is not a collaborator
+	collabs, err := ncd.GetCollaborators()
+	assert.NoError(t, err)
+	assert.NotContains(t, collabs, granteeID)
+
+	// proof-only token cannot read the document
+	err = ncd.ATGranteeCanRead(ctx, srv, at.Identifier, ncd.Document.DocumentIdentifier, granteeID)
+	assert.Error(t, err)
+	assert.Equal(t, ErrAccessTokenProofOnly, err)
+
+	// fields not granted
+	err = ncd.ATGranteeCanRequestProofs(ctx, srv, at.Identifier, ncd.Document.DocumentIdentifier, fields[:1], granteeID)
+	assert.Error(t, err)
+	assert.Equal(t, ErrAccessTokenFieldsNotGranted, err)
+
+	// requester is not the grantee
+	err = ncd.ATGranteeCanRequestProofs(ctx, srv, at.Identifier, ncd.Document.DocumentIdentifier, fields, granterID)
+	assert.Error(t, err)
+	assert.Equal(t, ErrRequesterNotGrantee, err)
+
+	// granted fields in any order
+	srv.On("ValidateKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = ncd.ATGranteeCanRequestProofs(ctx, srv, at.Identifier, ncd.Document.DocumentIdentifier, []string{fields[1], fields[0]}, granteeID)
+	assert.NoError(t, err)
+
+	// read tokens can request proofs for any field
+	payload.ProofFields = nil
+	ncd.Document.DocumentRoot = utils.RandomSlice(32)
+	ncd, err = ncd.AddAccessToken(ctx, payload)
+	assert.NoError(t, err)
+	at = ncd.Document.AccessTokens[1]
+	srv.On("ValidateKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = ncd.ATGranteeCanRequestProofs(ctx, srv, at.Identifier, ncd.Document.DocumentIdentifier, fields[:1], granteeID)
+	assert.NoError(t, err)
+	srv.AssertExpectations(t)
+}
+
 func TestCoreDocumentModel_AddAccessToken(t *testing.T) {
 	m, err := newCoreDocument()
 	assert.NoError(t, err)
@@ -405,7 +505,7 @@ func TestCoreDocumentModel_AddAccessToken(t *testing.T) {
 	}
 	_, err = m.AddAccessToken(ctx, payload)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to construct access token: malformed address provided")
+	assert.Contains(t, err.Error(), "failed to construct access token: identifier must be a 0x prefixed hex string")
 	// invalid centID length
 	invalidCentID := utils.RandomSlice(25)
 	payload = documentpb.AccessTokenParams{
@@ -414,7 +514,7 @@ func TestCoreDocumentModel_AddAccessToken(t *testing.T) {
 	}
 	_, err = m.AddAccessToken(ctx, payload)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to construct access token: malformed address provided")
+	assert.Contains(t, err.Error(), "failed to construct access token: identifier has invalid length")
 	// invalid docID length
 	id, err := account.GetIdentityID()
 	assert.NoError(t, err)
@@ -425,7 +525,7 @@ func TestCoreDocumentModel_AddAccessToken(t *testing.T) {
 	}
 
 	_, err = m.AddAccessToken(ctx, payload)
-	assert.Contains(t, err.Error(), "failed to construct access token: invalid identifier length")
+	assert.Contains(t, err.Error(), "failed to construct access token: identifier has invalid length")
 	// valid
 	payload = documentpb.AccessTokenParams{
 		Grantee:            hexutil.Encode(id),
@@ -0,0 +1,107 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/maintenance"
+)
+
+const (
+	// ReconcileAnchorsJobName is the name of the maintenance job reconciling the stored versions with their anchors.
+	ReconcileAnchorsJobName = "anchor-reconciliation"
+
+	// reconcilePageSize is the number of stored versions walked at a time
+	reconcilePageSize = 100
+)
+
+// reconcileAnchorsJob checks the stored versions of an account against their anchors on chain.
+// Versions left committing or failed whose anchor holds their document root, such as versions whose anchoring
+// timed out before the anchor was mined, are marked committed. Versions whose anchor holds another root, and
+// committed versions whose anchor is missing, are reported.
+type reconcileAnchorsJob struct {
+	repo       Repository
+	anchorRepo anchors.AnchorRepository
+}
+
+// newReconcileAnchorsJob returns the job reconciling the stored versions with their anchors.
+func newReconcileAnchorsJob(repo Repository, anchorRepo anchors.AnchorRepository) maintenance.Job {
+	return reconcileAnchorsJob{repo: repo, anchorRepo: anchorRepo}
+}
+
+func (reconcileAnchorsJob) JobName() string {
+	return ReconcileAnchorsJobName
+}
+
+// RunForAccount reconciles every stored version of the account that is not a draft with its anchor.
+// Versions that fail to be reconciled are reported, the other versions are reconciled regardless.
+func (j reconcileAnchorsJob) RunForAccount(ctx context.Context) error {
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return ErrDocumentConfigAccountID
+	}
+
+	var committed, total int
+	var failed []string
+	var cursor []byte
+	for {
+		var versions []Model
+		cursor, err = j.repo.IterateFrom(did[:], cursor, reconcilePageSize, func(m Model) error {
+			if m.GetStatus() != StatusDraft {
+				versions = append(versions, m)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return errors.NewTypedError(ErrDocumentAnchorReconciliation, err)
+		}
+
+		// the versions are updated once the page is walked
+		for _, m := range versions {
+			err = checkAnchor(j.anchorRepo, m)
+			if m.GetStatus() == StatusCommitted {
+				if err != nil {
+					failed = append(failed, fmt.Sprintf("%x: %v", m.CurrentVersion(), err))
+				}
+
+				continue
+			}
+
+			// versions not anchored yet are left as is
+			if errors.IsOfType(ErrDocumentAnchorNotMined, err) {
+				continue
+			}
+
+			if err != nil {
+				failed = append(failed, fmt.Sprintf("%x: %v", m.CurrentVersion(), err))
+				continue
+			}
+
+			m.SetStatus(StatusCommitted)
+			err = j.repo.Update(did[:], m.CurrentVersion(), m)
+			if err != nil {
+				failed = append(failed, fmt.Sprintf("%x: %v", m.CurrentVersion(), err))
+				continue
+			}
+
+			committed++
+		}
+
+		total += len(versions)
+		if cursor == nil {
+			break
+		}
+	}
+
+	srvLog.Infof("reconciled %d versions of the documents of %s, %d marked committed", total-len(failed), did.String(), committed)
+	if len(failed) > 0 {
+		return errors.NewTypedError(ErrDocumentAnchorReconciliation, errors.New("%d of %d versions not reconciled: %s", len(failed), total, strings.Join(failed, "; ")))
+	}
+
+	return nil
+}
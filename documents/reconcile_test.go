@@ -0,0 +1,63 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/anchors"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcileAnchorsJob_RunForAccount(t *testing.T) {
+	ldb, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	repo := NewDBRepository(leveldb.NewLevelDBRepository(ldb))
+	repo.Register(&historyDoc{})
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+
+	// v1 is committed, the anchoring of v2 timed out, v3 is not anchored yet and v4 is a draft
+	docs := historyChain(4)
+	v1, v2, v3, v4 := docs[0], docs[1], docs[2], docs[3]
+	v1.Status, v2.Status, v3.Status, v4.Status = StatusCommitted, StatusFailed, StatusCommitting, StatusDraft
+	for _, d := range docs {
+		assert.NoError(t, repo.Create(did[:], d.Version, d))
+	}
+
+	ar := new(testinganchors.MockAnchorRepo)
+	anchorHistory(ar, v1, v2)
+	v3AnchorID, err := anchors.ToAnchorID(v3.Version)
+	assert.NoError(t, err)
+	ar.On("GetAnchorData", v3AnchorID).Return(nil, errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor"))).Once()
+	job := newReconcileAnchorsJob(repo, ar)
+	assert.Equal(t, ReconcileAnchorsJobName, job.JobName())
+	assert.NoError(t, job.RunForAccount(actx))
+	for d, st := range map[*historyDoc]Status{v1: StatusCommitted, v2: StatusCommitted, v3: StatusCommitting, v4: StatusDraft} {
+		m, err := repo.Get(did[:], d.Version)
+		assert.NoError(t, err)
+		assert.Equal(t, st, m.GetStatus())
+	}
+	ar.AssertExpectations(t)
+
+	// the anchor of v3 holds another root and the anchor of the committed v1 is gone
+	ar = new(testinganchors.MockAnchorRepo)
+	anchorHistory(ar, v2)
+	v1AnchorID, err := anchors.ToAnchorID(v1.Version)
+	assert.NoError(t, err)
+	ar.On("GetAnchorData", v1AnchorID).Return(nil, errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor"))).Once()
+	ar.On("GetAnchorData", v3AnchorID).Return(anchors.RandomDocumentRoot(), nil).Once()
+	err = newReconcileAnchorsJob(repo, ar).RunForAccount(actx)
+	assert.True(t, errors.IsOfType(ErrDocumentAnchorReconciliation, err))
+	assert.Contains(t, err.Error(), "2 of 3 versions not reconciled")
+	m, err := repo.Get(did[:], v3.Version)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusCommitting, m.GetStatus())
+	ar.AssertExpectations(t)
+}
@@ -0,0 +1,279 @@
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/maintenance"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/utils"
+)
+
+const (
+	// RecoveryJobName is the name of the maintenance job recovering the documents anchored by the accounts.
+	RecoveryJobName = "document-recovery"
+
+	// recoveryPrefix is the key prefix of the skeleton index of the versions anchored by the accounts
+	recoveryPrefix = "docrecovery_"
+)
+
+// AnchoredVersion is a version anchored by an account, as committed to the anchor repository.
+// The anchored versions form the skeleton index of the documents of an account until they are recovered.
+type AnchoredVersion struct {
+	Version      []byte `json:"version"`
+	DocumentRoot []byte `json:"document_root"`
+	BlockNumber  uint64 `json:"block_number"`
+
+	// DocumentID and Recovered are set once the version is stored locally
+	DocumentID []byte `json:"document_id,omitempty"`
+	Recovered  bool   `json:"recovered"`
+
+	// Error is the reason the version was not recovered on the last run
+	Error string `json:"error,omitempty"`
+}
+
+// JSON returns the json encoding of the anchored version.
+func (v *AnchoredVersion) JSON() ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// FromJSON loads the anchored version from its json encoding.
+func (v *AnchoredVersion) FromJSON(data []byte) error {
+	return json.Unmarshal(data, v)
+}
+
+// Type returns the reflect type of the anchored version.
+func (v *AnchoredVersion) Type() reflect.Type {
+	return reflect.TypeOf(v)
+}
+
+// getAnchoredVersionKey returns the key of the version anchored by accountID.
+func getAnchoredVersionKey(accountID, version []byte) []byte {
+	prefix := append([]byte(recoveryPrefix), accountID...)
+	return append(prefix, version...)
+}
+
+// recoveryJob rebuilds the skeleton index of the documents of an account from the anchors it committed, and recovers
+// the documents that are not stored locally from the collaborators. Nodes started without the documents of their
+// accounts are recovered without a backup this way.
+type recoveryJob struct {
+	historySync
+	db            storage.Repository
+	fromBlock     uint64
+	collaborators []identity.DID
+}
+
+// newRecoveryJob registers the skeleton index and returns the job recovering the anchored documents of the accounts.
+func newRecoveryJob(db storage.Repository, h historySync, fromBlock uint64, collaborators []identity.DID) maintenance.Job {
+	db.Register(&AnchoredVersion{})
+	return recoveryJob{historySync: h, db: db, fromBlock: fromBlock, collaborators: collaborators}
+}
+
+func (recoveryJob) JobName() string {
+	return RecoveryJobName
+}
+
+// RunForAccount indexes the anchors committed by the account, and recovers the anchored versions not stored locally.
+// Collaborators are requested for the documents by their first version, which is the ID of the document. The later
+// versions of the documents are recovered with the history shared for the latest version. Versions of documents the
+// account joined later can't be requested by their version, they are recovered once the collaborators share the
+// document with the account again. Versions that are not recovered are retried on the next run.
+func (j recoveryJob) RunForAccount(ctx context.Context) error {
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return ErrDocumentConfigAccountID
+	}
+
+	index, err := j.index(ctx, did)
+	if err != nil {
+		return errors.NewTypedError(ErrDocumentRecovery, err)
+	}
+
+	var pending int
+	for _, v := range index {
+		if v.Recovered {
+			continue
+		}
+
+		if m, err := j.repo.Get(did[:], v.Version); err == nil {
+			j.markRecovered(did, index, m)
+			continue
+		}
+
+		versions, err := j.recoverDocument(ctx, did, v.Version)
+		for _, m := range versions {
+			j.markRecovered(did, index, m)
+		}
+
+		if v.Recovered {
+			continue
+		}
+
+		if err == nil {
+			err = errors.New("version not shared by the collaborators")
+		}
+
+		pending++
+		v.Error = err.Error()
+		err = j.db.Update(getAnchoredVersionKey(did[:], v.Version), v)
+		if err != nil {
+			return errors.NewTypedError(ErrDocumentRecovery, err)
+		}
+	}
+
+	if pending > 0 {
+		return errors.NewTypedError(ErrDocumentRecovery, errors.New("%d of %d anchored versions not recovered", pending, len(index)))
+	}
+
+	return nil
+}
+
+// index adds the anchors committed by the account since the configured block to the skeleton index of the account,
+// and returns the index ordered from the oldest to the latest anchor.
+func (j recoveryJob) index(ctx context.Context, did identity.DID) ([]*AnchoredVersion, error) {
+	committed, err := j.anchorRepo.CommittedAnchors(ctx, j.fromBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range committed {
+		key := getAnchoredVersionKey(did[:], a.AnchorID[:])
+		if j.db.Exists(key) {
+			continue
+		}
+
+		err = j.db.Create(key, &AnchoredVersion{Version: a.AnchorID[:], DocumentRoot: a.DocumentRoot[:], BlockNumber: a.BlockNumber})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	models, err := j.db.GetAllByPrefix(string(getAnchoredVersionKey(did[:], nil)))
+	if err != nil {
+		return nil, err
+	}
+
+	index := make([]*AnchoredVersion, len(models))
+	for i, m := range models {
+		index[i] = m.(*AnchoredVersion)
+	}
+
+	sort.SliceStable(index, func(i, k int) bool {
+		return index[i].BlockNumber < index[k].BlockNumber
+	})
+
+	return index, nil
+}
+
+// markRecovered marks the index entry of the stored version as recovered.
+// Failing to update the index is logged only, the version is marked again on the next run.
+func (j recoveryJob) markRecovered(did identity.DID, index []*AnchoredVersion, m Model) {
+	for _, v := range index {
+		if v.Recovered || !utils.IsSameByteSlice(v.Version, m.CurrentVersion()) {
+			continue
+		}
+
+		v.Recovered, v.DocumentID, v.Error = true, m.ID(), ""
+		err := j.db.Update(getAnchoredVersionKey(did[:], v.Version), v)
+		if err != nil {
+			srvLog.Warningf("failed to mark version %x as recovered: %v", v.Version, err)
+		}
+	}
+}
+
+// recoverDocument requests the latest version of the document from the collaborators in turn, until one of them
+// shares a version matching its anchored root. The verified versions are stored and returned.
+func (j recoveryJob) recoverDocument(ctx context.Context, did identity.DID, documentID []byte) ([]Model, error) {
+	err := errors.New("no recovery collaborators configured")
+	for _, c := range j.collaborators {
+		var versions []Model
+		versions, err = j.fetchDocument(ctx, did, c, documentID)
+		if err != nil {
+			srvLog.Debugf("failed to recover document %x from %s: %v", documentID, c.String(), err)
+			continue
+		}
+
+		return versions, nil
+	}
+
+	return nil, err
+}
+
+// fetchDocument requests the latest version of the document and its history from the collaborator. The latest version
+// is checked against its anchored root, and the history is verified like the history of a received document. The latest
+// version, the prior versions shared in full and the lineage of the document are stored.
+func (j recoveryJob) fetchDocument(ctx context.Context, did identity.DID, collaborator identity.DID, documentID []byte) ([]Model, error) {
+	res, err := j.client.GetDocument(ctx, collaborator, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Document == nil {
+		return nil, errors.New("no document received from %s", collaborator.String())
+	}
+
+	model, err := j.docSrv.DeriveFromCoreDocument(*res.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsSameByteSlice(model.ID(), documentID) {
+		return nil, errors.New("received document %x for document %x", model.ID(), documentID)
+	}
+
+	anchorID, err := anchors.ToAnchorID(model.CurrentVersion())
+	if err != nil {
+		return nil, errors.New("failed to get anchorID: %v", err)
+	}
+
+	dr, err := model.CalculateDocumentRoot()
+	if err != nil {
+		return nil, errors.New("failed to get document root: %v", err)
+	}
+
+	err = checkAnchoredRoot(j.anchorRepo, anchorID, dr)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []Model{model}
+	var lineage *Lineage
+	if !utils.IsEmptyByteSlice(model.PreviousVersion()) {
+		history, err := j.client.GetDocumentHistory(ctx, collaborator, model.ID(), model.CurrentVersion())
+		if err != nil {
+			return nil, err
+		}
+
+		lineage, err = j.verifyLineage(model, history.Roots)
+		if err != nil {
+			return nil, err
+		}
+
+		prior, err := j.verifyVersions(model, lineage, history)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, prior...)
+	}
+
+	err = j.repo.Atomic(func(repo Repository) error {
+		err := saveVersions(repo, did[:], versions)
+		if err != nil || lineage == nil {
+			return err
+		}
+
+		return repo.SaveLineage(did[:], lineage)
+	})
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentPersistence, err)
+	}
+
+	return versions, nil
+}
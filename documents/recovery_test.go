@@ -0,0 +1,117 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/history"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/testingutils/anchors"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func committedAnchor(t *testing.T, version, root []byte, block uint64) anchors.CommittedAnchor {
+	anchorID, err := anchors.ToAnchorID(version)
+	assert.NoError(t, err)
+	dr, err := anchors.ToDocumentRoot(root)
+	assert.NoError(t, err)
+	return anchors.CommittedAnchor{AnchorID: anchorID, DocumentRoot: dr, BlockNumber: block}
+}
+
+func TestRecoveryJob_RunForAccount(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&historyDoc{})
+	db := ctx[storage.BootstrappedDB].(storage.Repository)
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+
+	// v1 is shared in full with the latest version v3, v2 by its root only.
+	// other is a version of a document the account joined later.
+	docs := historyChain(3)
+	v1, v2, v3 := docs[0], docs[1], docs[2]
+	other := utils.RandomSlice(32)
+	ar := new(testinganchors.MockAnchorRepo)
+	anchorHistory(ar, v1, v2, v3)
+	ar.On("CommittedAnchors", actx, uint64(0)).Return([]anchors.CommittedAnchor{
+		committedAnchor(t, v3.Version, v3.Root, 3),
+		committedAnchor(t, v1.Version, v1.Root, 1),
+		committedAnchor(t, other, utils.RandomSlice(32), 4),
+		committedAnchor(t, v2.Version, v2.Root, 2),
+	}, nil)
+
+	// the first collaborator is unavailable
+	unavailable, collaborator := testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID()
+	client := new(p2pClient)
+	client.On("GetDocument", actx, unavailable, mock.Anything).Return(nil, errors.New("peer unavailable"))
+	client.On("GetDocument", actx, collaborator, v1.DocID).Return(&p2ppb.GetDocumentResponse{
+		Document: &coredocumentpb.CoreDocument{DocumentIdentifier: v3.DocID, CurrentVersion: v3.Version},
+	}, nil).Once()
+	client.On("GetDocument", actx, collaborator, mock.Anything).Return(nil, errors.New("unknown document"))
+	client.On("GetDocumentHistory", actx, collaborator, v3.DocID, v3.Version).Return(&historypb.HistoryResponse{
+		Roots:     []*historypb.VersionRoot{v2.versionRoot(), v1.versionRoot()},
+		Documents: []*coredocumentpb.CoreDocument{{DocumentIdentifier: v1.DocID, CurrentVersion: v1.Version}},
+	}, nil).Once()
+	srv := historyService{versions: map[string]Model{string(v1.Version): v1, string(v3.Version): v3}}
+	job := newRecoveryJob(db, newHistorySync(repo, ar, srv, client), 0, []identity.DID{unavailable, collaborator}).(recoveryJob)
+	assert.Equal(t, RecoveryJobName, job.JobName())
+
+	err = job.RunForAccount(actx)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentRecovery, err))
+	assert.Contains(t, err.Error(), "2 of 4 anchored versions not recovered")
+	for _, v := range []*historyDoc{v1, v3} {
+		m, err := repo.Get(did[:], v.Version)
+		assert.NoError(t, err)
+		assert.Equal(t, v.Root, m.(*historyDoc).Root)
+		assert.Equal(t, StatusCommitted, m.GetStatus())
+	}
+	assert.False(t, repo.Exists(did[:], v2.Version))
+	lineage, err := repo.GetLineage(did[:], v3.DocID)
+	assert.NoError(t, err)
+	assert.NotNil(t, lineage.version(v2.Version))
+
+	index, err := job.index(actx, did)
+	assert.NoError(t, err)
+	assert.Len(t, index, 4)
+	for i, v := range [][]byte{v1.Version, v2.Version, v3.Version, other} {
+		assert.Equal(t, v, index[i].Version)
+	}
+	assert.True(t, index[0].Recovered)
+	assert.Equal(t, v1.DocID, index[0].DocumentID)
+	assert.False(t, index[1].Recovered)
+	assert.Contains(t, index[1].Error, "unknown document")
+	assert.True(t, index[2].Recovered)
+	assert.False(t, index[3].Recovered)
+
+	// the recovered versions are not requested again
+	err = job.RunForAccount(actx)
+	assert.True(t, errors.IsOfType(ErrDocumentRecovery, err))
+	client.AssertExpectations(t)
+
+	// no collaborators to recover from
+	job = newRecoveryJob(db, newHistorySync(repo, ar, srv, client), 0, nil).(recoveryJob)
+	err = job.RunForAccount(actx)
+	assert.True(t, errors.IsOfType(ErrDocumentRecovery, err))
+	index, err = job.index(actx, did)
+	assert.NoError(t, err)
+	assert.Contains(t, index[3].Error, "no recovery collaborators configured")
+
+	// anchors can't be read
+	ar = new(testinganchors.MockAnchorRepo)
+	ar.On("CommittedAnchors", actx, uint64(0)).Return(nil, errors.New("node unavailable"))
+	job = newRecoveryJob(db, newHistorySync(repo, ar, srv, client), 0, nil).(recoveryJob)
+	err = job.RunForAccount(actx)
+	assert.True(t, errors.IsOfType(ErrDocumentRecovery, err))
+}
@@ -1,6 +1,7 @@
 package documents
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/centrifuge/go-centrifuge/errors"
@@ -8,14 +9,18 @@ import (
 
 //ServiceRegistry matches for a provided coreDocument the corresponding service
 type ServiceRegistry struct {
-	services map[string]Service
-	mutex    sync.RWMutex
+	services   map[string]Service
+	schemas    map[string]*JSONSchema
+	validators map[string]map[ValidationStage]ValidatorGroup
+	mutex      sync.RWMutex
 }
 
 // NewServiceRegistry returns a new instance of service registry
 func NewServiceRegistry() *ServiceRegistry {
 	return &ServiceRegistry{
-		services: make(map[string]Service),
+		services:   make(map[string]Service),
+		schemas:    make(map[string]*JSONSchema),
+		validators: make(map[string]map[ValidationStage]ValidatorGroup),
 	}
 }
 
@@ -28,6 +33,12 @@ func (s *ServiceRegistry) Register(serviceID string, service Service) error {
 		return errors.New("service with provided id already registered")
 	}
 
+	if ss, ok := service.(SchemeService); ok {
+		if _, ok := s.locateSchemeService(ss.Scheme()); ok {
+			return errors.New("service with provided scheme already registered")
+		}
+	}
+
 	s.services[serviceID] = service
 	return nil
 }
@@ -42,3 +53,121 @@ func (s *ServiceRegistry) LocateService(serviceID string) (Service, error) {
 	}
 	return s.services[serviceID], nil
 }
+
+// LocateSchemeService returns the registered service that serves the documents of the scheme
+func (s *ServiceRegistry) LocateSchemeService(scheme string) (SchemeService, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	srv, ok := s.locateSchemeService(scheme)
+	if !ok {
+		return nil, errors.NewTypedError(ErrDocumentSchemeUnknown, errors.New("%s", scheme))
+	}
+
+	return srv, nil
+}
+
+func (s *ServiceRegistry) locateSchemeService(scheme string) (SchemeService, bool) {
+	for _, srv := range s.services {
+		ss, ok := srv.(SchemeService)
+		if ok && ss.Scheme() == scheme {
+			return ss, true
+		}
+	}
+
+	return nil, false
+}
+
+// RegisterJSONSchema registers the JSON Schema the client data of the documents of the scheme is validated against
+func (s *ServiceRegistry) RegisterJSONSchema(scheme string, schema *JSONSchema) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.schemas[scheme]; ok {
+		return errors.New("JSON schema of the scheme %s already registered", scheme)
+	}
+
+	s.schemas[scheme] = schema
+	return nil
+}
+
+// ValidateClientData validates the json encoded client data of a document of the scheme against the JSON Schema
+// registered for the scheme. The client data of the schemes without a JSON Schema is not validated.
+func (s *ServiceRegistry) ValidateClientData(scheme string, data []byte) error {
+	s.mutex.RLock()
+	schema, ok := s.schemas[scheme]
+	s.mutex.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	err := schema.Validate(data)
+	if err != nil {
+		return errors.NewTypedError(ErrDocumentSchemaInvalid, err)
+	}
+
+	return nil
+}
+
+// RegisterValidator registers a validator run on the documents of the document type at the stage, after the built-in
+// validators of the stage. Deployments use it to enforce their business rules on the documents.
+func (s *ServiceRegistry) RegisterValidator(docType string, stage ValidationStage, validator Validator) error {
+	switch stage {
+	case ValidationStageSignature, ValidationStagePreAnchor, ValidationStagePostAnchored:
+	default:
+		return errors.New("unknown validation stage %q", stage)
+	}
+
+	if validator == nil {
+		return errors.New("no validator provided")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.validators[docType] == nil {
+		s.validators[docType] = make(map[ValidationStage]ValidatorGroup)
+	}
+
+	s.validators[docType][stage] = append(s.validators[docType][stage], validator)
+	return nil
+}
+
+// Validators returns the validators registered for the document type at the stage in the order of registration.
+func (s *ServiceRegistry) Validators(docType string, stage ValidationStage) ValidatorGroup {
+	if s == nil {
+		return nil
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return append(ValidatorGroup(nil), s.validators[docType][stage]...)
+}
+
+// PropertyMappings returns the property mappings of every registered service that implements PropertyMapper, ordered by document type.
+func (s *ServiceRegistry) PropertyMappings() ([]DocumentPropertyMappings, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var mappings []DocumentPropertyMappings
+	for docType, srv := range s.services {
+		pm, ok := srv.(PropertyMapper)
+		if !ok {
+			continue
+		}
+
+		props, err := pm.PropertyMappings()
+		if err != nil {
+			return nil, err
+		}
+
+		mappings = append(mappings, DocumentPropertyMappings{DocumentType: docType, Properties: props})
+	}
+
+	sort.Slice(mappings, func(i, j int) bool {
+		return mappings[i].DocumentType < mappings[j].DocumentType
+	})
+
+	return mappings, nil
+}
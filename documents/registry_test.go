@@ -7,6 +7,7 @@ import (
 
 	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
 	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/testingutils/documents"
 	"github.com/stretchr/testify/assert"
 )
@@ -42,3 +43,89 @@ func TestRegistry_LocateService_invalid(t *testing.T) {
 	_, err := registry.LocateService(documenttypes.InvoiceDataTypeUrl)
 	assert.Error(t, err, "should throw an error because no services is registered")
 }
+
+type mockPropertyMapperService struct {
+	testingdocuments.MockService
+}
+
+func (m *mockPropertyMapperService) PropertyMappings() ([]documents.PropertyMapping, error) {
+	args := m.Called()
+	mappings, _ := args.Get(0).([]documents.PropertyMapping)
+	return mappings, args.Error(1)
+}
+
+func TestRegistry_PropertyMappings(t *testing.T) {
+	registry := documents.NewServiceRegistry()
+	mapper := new(mockPropertyMapperService)
+	props := []documents.PropertyMapping{{ReadableName: "invoice.number", CompactName: "0x0001000000000001"}}
+	mapper.On("PropertyMappings").Return(props, nil).Once()
+	assert.NoError(t, registry.Register(documenttypes.InvoiceDataTypeUrl, mapper))
+	assert.NoError(t, registry.Register(documenttypes.PurchaseOrderDataTypeUrl, &testingdocuments.MockService{}))
+
+	mappings, err := registry.PropertyMappings()
+	assert.NoError(t, err)
+	mapper.AssertExpectations(t)
+	assert.Len(t, mappings, 1)
+	assert.Equal(t, documenttypes.InvoiceDataTypeUrl, mappings[0].DocumentType)
+	assert.Equal(t, props, mappings[0].Properties)
+
+	mapper.On("PropertyMappings").Return(nil, errors.New("failed")).Once()
+	_, err = registry.PropertyMappings()
+	assert.Error(t, err)
+	mapper.AssertExpectations(t)
+}
+
+func TestRegistry_LocateSchemeService(t *testing.T) {
+	registry := documents.NewServiceRegistry()
+	srv := new(mockSchemeService)
+	assert.NoError(t, registry.Register("mock document", srv))
+	assert.Error(t, registry.Register("other mock document", srv), "scheme is already registered")
+
+	s, err := registry.LocateSchemeService("mock")
+	assert.NoError(t, err)
+	assert.Equal(t, srv, s)
+
+	_, err = registry.LocateSchemeService("invoice")
+	assert.True(t, errors.IsOfType(documents.ErrDocumentSchemeUnknown, err))
+}
+
+func TestRegistry_ValidateClientData(t *testing.T) {
+	registry := documents.NewServiceRegistry()
+
+	// schemes without a schema are not validated
+	assert.NoError(t, registry.ValidateClientData("mock", []byte(`{"number": 1}`)))
+
+	schema, err := documents.ParseJSONSchema([]byte(`{"type": "object", "required": ["number"], "properties": {"number": {"type": "string"}}}`))
+	assert.NoError(t, err)
+	assert.NoError(t, registry.RegisterJSONSchema("mock", schema))
+	assert.Error(t, registry.RegisterJSONSchema("mock", schema))
+
+	assert.NoError(t, registry.ValidateClientData("mock", []byte(`{"number": "1"}`)))
+	assert.NoError(t, registry.ValidateClientData("invoice", []byte(`{"number": 1}`)))
+	err = registry.ValidateClientData("mock", []byte(`{"number": 1}`))
+	assert.True(t, errors.IsOfType(documents.ErrDocumentSchemaInvalid, err))
+	assert.Contains(t, err.Error(), "number must be of type string")
+}
+
+func TestRegistry_RegisterValidator(t *testing.T) {
+	registry := documents.NewServiceRegistry()
+	v1 := documents.ValidatorFunc(func(old, new documents.Model) error { return nil })
+	v2 := documents.ValidatorFunc(func(old, new documents.Model) error { return errors.New("amount exceeds the limit") })
+
+	assert.Error(t, registry.RegisterValidator(documenttypes.InvoiceDataTypeUrl, "before_send", v1))
+	assert.Error(t, registry.RegisterValidator(documenttypes.InvoiceDataTypeUrl, documents.ValidationStagePreAnchor, nil))
+	assert.Empty(t, registry.Validators(documenttypes.InvoiceDataTypeUrl, documents.ValidationStagePreAnchor))
+
+	assert.NoError(t, registry.RegisterValidator(documenttypes.InvoiceDataTypeUrl, documents.ValidationStagePreAnchor, v1))
+	assert.NoError(t, registry.RegisterValidator(documenttypes.InvoiceDataTypeUrl, documents.ValidationStagePreAnchor, v2))
+	assert.NoError(t, registry.RegisterValidator(documenttypes.PurchaseOrderDataTypeUrl, documents.ValidationStageSignature, v2))
+	validators := registry.Validators(documenttypes.InvoiceDataTypeUrl, documents.ValidationStagePreAnchor)
+	assert.Len(t, validators, 2)
+	assert.Error(t, validators.Validate(nil, nil))
+	assert.Empty(t, registry.Validators(documenttypes.InvoiceDataTypeUrl, documents.ValidationStageSignature))
+	assert.Len(t, registry.Validators(documenttypes.PurchaseOrderDataTypeUrl, documents.ValidationStageSignature), 1)
+
+	// the returned validators are a copy
+	validators[0] = v2
+	assert.NoError(t, registry.Validators(documenttypes.InvoiceDataTypeUrl, documents.ValidationStagePreAnchor)[0].Validate(nil, nil))
+}
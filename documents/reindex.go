@@ -0,0 +1,76 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/maintenance"
+)
+
+const (
+	// ReindexJobName is the name of the maintenance job rebuilding the index entries of the documents.
+	ReindexJobName = "document-reindex"
+
+	// reindexPageSize is the number of stored versions walked at a time
+	reindexPageSize = 100
+)
+
+// reindexJob rebuilds the latest version, link and search index entries of the stored versions of an account.
+// Documents stored before the indexes were introduced, or restored from a backup without them, are indexed this way.
+type reindexJob struct {
+	repo Repository
+}
+
+// newReindexJob returns the job rebuilding the index entries of the documents.
+func newReindexJob(repo Repository) maintenance.Job {
+	return reindexJob{repo: repo}
+}
+
+func (reindexJob) JobName() string {
+	return ReindexJobName
+}
+
+// RunForAccount rebuilds the index entries of every stored version of the account.
+// Versions that fail to be indexed are reported, the other versions are indexed regardless.
+func (j reindexJob) RunForAccount(ctx context.Context) error {
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return ErrDocumentConfigAccountID
+	}
+
+	var total int
+	var failed []string
+	var cursor []byte
+	for {
+		var versions [][]byte
+		cursor, err = j.repo.IterateFrom(did[:], cursor, reindexPageSize, func(m Model) error {
+			versions = append(versions, m.CurrentVersion())
+			return nil
+		})
+		if err != nil {
+			return errors.NewTypedError(ErrDocumentReindex, err)
+		}
+
+		for _, v := range versions {
+			err = j.repo.Reindex(did[:], v)
+			if err != nil {
+				failed = append(failed, fmt.Sprintf("%x: %v", v, err))
+			}
+		}
+
+		total += len(versions)
+		if cursor == nil {
+			break
+		}
+	}
+
+	srvLog.Infof("reindexed %d versions of the documents of %s", total-len(failed), did.String())
+	if len(failed) > 0 {
+		return errors.NewTypedError(ErrDocumentReindex, errors.New("%d of %d versions not reindexed: %s", len(failed), total, strings.Join(failed, "; ")))
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReindexJob_RunForAccount(t *testing.T) {
+	ldb, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	db := leveldb.NewLevelDBRepository(ldb)
+	repo := NewDBRepository(db)
+	repo.Register(&historyDoc{})
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+
+	// versions stored before the latest version was indexed
+	docs := historyChain(3)
+	for _, d := range docs {
+		assert.NoError(t, repo.Create(did[:], d.Version, d))
+	}
+	assert.NoError(t, db.Delete(getLatestVersionKey(did[:], docs[0].DocID)))
+	_, err = repo.GetLatest(did[:], docs[0].DocID)
+	assert.Error(t, err)
+
+	job := newReindexJob(repo)
+	assert.Equal(t, ReindexJobName, job.JobName())
+	assert.NoError(t, job.RunForAccount(actx))
+	m, err := repo.GetLatest(did[:], docs[0].DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, docs[2].Version, m.CurrentVersion())
+
+	// reindexing is idempotent
+	assert.NoError(t, job.RunForAccount(actx))
+	m, err = repo.GetLatest(did[:], docs[0].DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, docs[2].Version, m.CurrentVersion())
+}
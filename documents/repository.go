@@ -1,6 +1,11 @@
 package documents
 
 import (
+	"reflect"
+	"sync"
+
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/storage"
 )
 
@@ -21,17 +26,144 @@ type Repository interface {
 	// Will error out when the model doesn't exist in the DB.
 	Update(accountID, id []byte, model Model) error
 
+	// Delete removes the version owned by accountID.
+	// The indexes are left as is, so the latest version of a document must not be deleted.
+	Delete(accountID, id []byte) error
+
+	// Reindex rebuilds the latest version, link and search index entries of the version owned by accountID.
+	Reindex(accountID, id []byte) error
+
 	// Register registers the model so that the DB can return the document without knowing the type
 	Register(model Model)
+
+	// Iterate calls fn with every document version owned by accountID in the order of the version IDs.
+	// The iteration stops at the first error returned by fn.
+	Iterate(accountID []byte, fn func(model Model) error) error
+
+	// IterateFrom calls fn with at most limit document versions owned by accountID, following the version of the cursor
+	// in the order of the version IDs. A nil cursor starts at the first version and a zero limit visits every version.
+	// Returns the cursor to resume the iteration from, nil once every version is visited.
+	IterateFrom(accountID, cursor []byte, limit int, fn func(model Model) error) (next []byte, err error)
+
+	// GetLatest returns the latest locally known version of the document owned by accountID.
+	// The latest version is indexed on Create and Update, so the versions in between are not walked.
+	GetLatest(accountID, documentID []byte) (Model, error)
+
+	// Links returns the indexed links of the document owned by accountID.
+	// The links of a document are indexed when its versions, or the versions of the documents linking to it, are stored.
+	Links(accountID, documentID []byte) ([]*Link, error)
+
+	// Search returns the documents owned by accountID whose latest versions match the query.
+	// The documents are looked up in the search index maintained on Create and Update.
+	Search(accountID []byte, query SearchQuery) ([]DocumentItem, error)
+
+	// SaveLineage creates or updates the verified lineage of the document owned by accountID.
+	SaveLineage(accountID []byte, lineage *Lineage) error
+
+	// GetLineage returns the verified lineage of the document owned by accountID.
+	GetLineage(accountID, documentID []byte) (*Lineage, error)
+
+	// Atomic calls fn with a Repository whose writes are batched.
+	// The writes are committed together only if fn returns nil, otherwise none of them are persisted.
+	Atomic(fn func(repo Repository) error) error
 }
 
 // NewDBRepository creates an instance of the documents Repository
 func NewDBRepository(db storage.Repository) Repository {
-	return &repo{db: db}
+	registerIndexModels(db)
+	return &repo{db: db, types: &modelTypes{byName: make(map[string]reflect.Type)}}
+}
+
+// NewResidencyRepository creates an instance of the documents Repository that stores the documents
+// of accounts with a residency tag in the DB of the tag. Documents of accounts without a tag are stored in db.
+// Writes for a tag without a DB error out rather than falling back to db.
+func NewResidencyRepository(db storage.Repository, residencyDBs map[string]storage.Repository, accounts config.Service) Repository {
+	registerIndexModels(db)
+	for _, rdb := range residencyDBs {
+		registerIndexModels(rdb)
+	}
+
+	return &repo{db: db, residencyDBs: residencyDBs, accounts: accounts, types: &modelTypes{byName: make(map[string]reflect.Type)}}
+}
+
+// NewEncryptedRepository creates an instance of the documents Repository that stores the documents encrypted with the
+// data-encryption key of the owning account, stored in db wrapped with the keys of the account. Documents stored in
+// plaintext are still read. The index entries stored along with the documents are not encrypted.
+// residencyDBs can be empty if residency is not configured.
+func NewEncryptedRepository(db storage.Repository, residencyDBs map[string]storage.Repository, accounts config.Service) Repository {
+	r := NewResidencyRepository(db, residencyDBs, accounts).(*repo)
+	r.keys = &dataKeys{db: db, accounts: accounts}
+	return r
+}
+
+// registerIndexModels registers the models of the index entries and the encrypted documents stored along with the documents.
+func registerIndexModels(db storage.Repository) {
+	db.Register(&encryptedModel{})
+	db.Register(&wrappedDataKey{})
+	db.Register(&Link{})
+	db.Register(&searchRecord{})
+	db.Register(&searchPosting{})
+	db.Register(&Lineage{})
+	db.Register(&latestVersion{})
+}
+
+// index maintains the latest version, link and search indexes of the stored model.
+func index(w indexWriter, accountID []byte, model Model) error {
+	err := indexLatestVersion(w, accountID, model)
+	if err != nil {
+		return err
+	}
+
+	err = indexLinks(w, accountID, model)
+	if err != nil {
+		return err
+	}
+
+	return indexSearch(w, accountID, model)
 }
 
 type repo struct {
 	db storage.Repository
+
+	// residencyDBs are the DBs of the document payloads per residency tag, empty if residency is not configured
+	residencyDBs map[string]storage.Repository
+	accounts     config.Service
+
+	// keys holds the data-encryption keys of the accounts, nil if the documents are stored in plaintext
+	keys *dataKeys
+
+	// types are the registered model types, shared with the batched repositories
+	types *modelTypes
+
+	// batches are the batches of writes per DB, nil unless the repository is passed to the fn of Atomic
+	batches map[storage.Repository]storage.Batch
+}
+
+// modelTypes are the registered model types by name, to decrypt the encrypted documents into.
+type modelTypes struct {
+	mu     sync.RWMutex
+	byName map[string]reflect.Type
+}
+
+// store is where the documents and their index entries are read from and written to, either a DB or a batch of
+// writes to a DB.
+type store interface {
+	indexWriter
+	Get(key []byte) (storage.Model, error)
+	NewIterator(prefix []byte) storage.Iterator
+	NewIteratorFrom(prefix, start []byte) storage.Iterator
+	Delete(key []byte) error
+}
+
+// batchStore implements store by queuing the writes into the batch. The reads see the queued writes.
+type batchStore struct {
+	storage.Batch
+}
+
+// Delete queues the key to be deleted.
+func (b batchStore) Delete(key []byte) error {
+	b.Batch.Delete(key)
+	return nil
 }
 
 // getKey returns accountID+id
@@ -39,37 +171,438 @@ func (r *repo) getKey(accountID, id []byte) []byte {
 	return append(accountID, id...)
 }
 
+// getDB returns the store of the documents owned by accountID, the batch of writes to the DB of the account if the
+// repository is batched.
+func (r *repo) getDB(accountID []byte) (store, error) {
+	db, err := r.residencyDB(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.batches == nil {
+		return db, nil
+	}
+
+	batch, ok := r.batches[db]
+	if !ok {
+		batch = db.NewBatch()
+		r.batches[db] = batch
+	}
+
+	return batchStore{Batch: batch}, nil
+}
+
+// residencyDB returns the DB of the documents owned by accountID as per the residency tag of the account.
+func (r *repo) residencyDB(accountID []byte) (storage.Repository, error) {
+	if len(r.residencyDBs) == 0 {
+		return r.db, nil
+	}
+
+	acc, err := r.accounts.GetAccount(accountID)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentResidency, err)
+	}
+
+	tag := acc.GetResidency()
+	if tag == "" {
+		return r.db, nil
+	}
+
+	db, ok := r.residencyDBs[tag]
+	if !ok {
+		return nil, errors.NewTypedError(ErrDocumentResidency, errors.New("no storage configured for residency %s", tag))
+	}
+
+	return db, nil
+}
+
+// sealer returns the sealer of the documents owned by accountID, nil if the documents are stored in plaintext.
+func (r *repo) sealer(accountID []byte) (*sealer, error) {
+	if r.keys == nil {
+		return nil, nil
+	}
+
+	return r.keys.sealer(accountID)
+}
+
+// encode returns the model as stored at key, encrypted if the documents are encrypted at rest.
+func (r *repo) encode(accountID, key []byte, model Model) (storage.Model, error) {
+	s, err := r.sealer(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s == nil {
+		return model, nil
+	}
+
+	return s.seal(key, model)
+}
+
+// decode returns the document stored at key, decrypting it with the sealer if it is encrypted.
+func (r *repo) decode(key []byte, m storage.Model, s *sealer) (Model, error) {
+	em, ok := m.(*encryptedModel)
+	if !ok {
+		model, ok := m.(Model)
+		if !ok {
+			return nil, errors.New("model at %x is not a document", key)
+		}
+
+		return model, nil
+	}
+
+	if s == nil {
+		return nil, errors.NewTypedError(ErrDocumentEncryption, errors.New("document at %x is encrypted but encryption is disabled", key))
+	}
+
+	r.types.mu.RLock()
+	tp, ok := r.types.byName[em.ModelType]
+	r.types.mu.RUnlock()
+	if !ok {
+		return nil, errors.NewTypedError(storage.ErrModelTypeNotRegistered, errors.New("%s", em.ModelType))
+	}
+
+	model := reflect.New(tp).Interface().(Model)
+	err := s.open(key, em, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
 // Register registers the model so that the DB can return the document without knowing the type
 func (r *repo) Register(model Model) {
+	tp := model.Type()
+	for tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+
+	r.types.mu.Lock()
+	r.types.byName[modelTypeName(tp)] = tp
+	r.types.mu.Unlock()
 	r.db.Register(model)
+	for _, db := range r.residencyDBs {
+		db.Register(model)
+	}
 }
 
 // Exists checks if the id, owned by accountID, exists in DB
 func (r *repo) Exists(accountID, id []byte) bool {
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return false
+	}
+
 	key := r.getKey(accountID, id)
-	return r.db.Exists(key)
+	return db.Exists(key)
 }
 
 // Get returns the Model associated with ID, owned by accountID
 func (r *repo) Get(accountID, id []byte) (Model, error) {
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := r.sealer(accountID)
+	if err != nil {
+		return nil, err
+	}
+
 	key := r.getKey(accountID, id)
-	model, err := r.db.Get(key)
+	model, err := db.Get(key)
 	if err != nil {
 		return nil, err
 	}
-	return model.(Model), nil
+
+	return r.decode(key, model, s)
 }
 
 // Create creates the model if not present in the DB.
 // should error out if the document exists.
 func (r *repo) Create(accountID, id []byte, model Model) error {
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return err
+	}
+
 	key := r.getKey(accountID, id)
-	return r.db.Create(key, model)
+	stored, err := r.encode(accountID, key, model)
+	if err != nil {
+		return err
+	}
+
+	err = db.Create(key, stored)
+	if err != nil {
+		return err
+	}
+
+	return index(db, accountID, model)
 }
 
 // Update strictly updates the model.
 // Will error out when the model doesn't exist in the DB.
 func (r *repo) Update(accountID, id []byte, model Model) error {
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return err
+	}
+
 	key := r.getKey(accountID, id)
-	return r.db.Update(key, model)
+	stored, err := r.encode(accountID, key, model)
+	if err != nil {
+		return err
+	}
+
+	err = db.Update(key, stored)
+	if err != nil {
+		return err
+	}
+
+	return index(db, accountID, model)
+}
+
+// Delete removes the version owned by accountID.
+// The indexes are left as is, so the latest version of a document must not be deleted.
+func (r *repo) Delete(accountID, id []byte) error {
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return err
+	}
+
+	return db.Delete(r.getKey(accountID, id))
+}
+
+// Reindex rebuilds the index entries of the version owned by accountID.
+// The entries are written the same way as on Create and Update, so the entries of later versions are left as is.
+func (r *repo) Reindex(accountID, id []byte) error {
+	model, err := r.Get(accountID, id)
+	if err != nil {
+		return err
+	}
+
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return err
+	}
+
+	return index(db, accountID, model)
+}
+
+// Iterate calls fn with every document version owned by accountID in the order of the version IDs.
+// The iteration stops at the first error returned by fn.
+func (r *repo) Iterate(accountID []byte, fn func(model Model) error) error {
+	_, err := r.IterateFrom(accountID, nil, 0, fn)
+	return err
+}
+
+// IterateFrom calls fn with at most limit document versions owned by accountID, following the version of the cursor.
+// The cursor is the ID of the last visited version, so versions created or deleted in between iterations are
+// accounted for. The iteration stops at the first error returned by fn.
+func (r *repo) IterateFrom(accountID, cursor []byte, limit int, fn func(model Model) error) ([]byte, error) {
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := r.sealer(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var start []byte
+	if cursor != nil {
+		// the first key after the key of the cursor
+		start = append(r.getKey(accountID, cursor), 0)
+	}
+
+	iter := db.NewIteratorFrom(accountID, start)
+	defer iter.Release()
+	var last []byte
+	var visited int
+	for iter.Next() {
+		// other models of the account, such as transactions, share the prefix
+		switch iter.Model().(type) {
+		case Model, *encryptedModel:
+		default:
+			continue
+		}
+
+		if limit > 0 && visited == limit {
+			return last, nil
+		}
+
+		model, err := r.decode(iter.Key(), iter.Model(), s)
+		if err != nil {
+			return nil, err
+		}
+
+		err = fn(model)
+		if err != nil {
+			return nil, err
+		}
+
+		last = iter.Key()[len(accountID):]
+		visited++
+	}
+
+	return nil, iter.Error()
+}
+
+// GetLatest returns the latest locally known version of the document owned by accountID.
+func (r *repo) GetLatest(accountID, documentID []byte) (Model, error) {
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := db.Get(getLatestVersionKey(accountID, documentID))
+	if err != nil {
+		return nil, err
+	}
+
+	l, ok := m.(*latestVersion)
+	if !ok {
+		return nil, errors.New("latest version of document %x is of invalid type", documentID)
+	}
+
+	return r.Get(accountID, l.Version)
+}
+
+// Links returns the indexed links of the document owned by accountID in the key order.
+func (r *repo) Links(accountID, documentID []byte) ([]*Link, error) {
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	iter := db.NewIterator(getLinkPrefix(accountID, documentID))
+	defer iter.Release()
+	var links []*Link
+	for iter.Next() {
+		link, ok := iter.Model().(*Link)
+		if !ok {
+			continue
+		}
+
+		links = append(links, link)
+	}
+
+	return links, iter.Error()
+}
+
+// Search returns the documents owned by accountID whose latest versions match the query.
+// Candidates are read from the postings of the most selective term of the query, or from all the search records
+// if the query has no terms, and are then checked against every filter.
+func (r *repo) Search(accountID []byte, query SearchQuery) ([]DocumentItem, error) {
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := query.terms()
+	if len(terms) == 0 {
+		return r.searchRecords(db, accountID, query)
+	}
+
+	iter := db.NewIterator(getSearchTermPrefix(accountID, terms[0]))
+	defer iter.Release()
+	var items []DocumentItem
+	for iter.Next() {
+		p, ok := iter.Model().(*searchPosting)
+		if !ok {
+			continue
+		}
+
+		m, err := db.Get(getSearchRecordKey(accountID, p.DocumentID))
+		if err != nil {
+			return nil, err
+		}
+
+		sr, ok := m.(*searchRecord)
+		if ok && query.matches(sr) {
+			items = append(items, sr.item())
+		}
+	}
+
+	return items, iter.Error()
+}
+
+// searchRecords checks every search record of the documents owned by accountID against the query.
+func (r *repo) searchRecords(db store, accountID []byte, query SearchQuery) ([]DocumentItem, error) {
+	iter := db.NewIterator(getSearchRecordPrefix(accountID))
+	defer iter.Release()
+	var items []DocumentItem
+	for iter.Next() {
+		sr, ok := iter.Model().(*searchRecord)
+		if ok && query.matches(sr) {
+			items = append(items, sr.item())
+		}
+	}
+
+	return items, iter.Error()
+}
+
+// SaveLineage creates or updates the verified lineage of the document owned by accountID.
+func (r *repo) SaveLineage(accountID []byte, lineage *Lineage) error {
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return err
+	}
+
+	return saveIndexEntry(db, getLineageKey(accountID, lineage.DocumentID), lineage)
+}
+
+// GetLineage returns the verified lineage of the document owned by accountID.
+func (r *repo) GetLineage(accountID, documentID []byte) (*Lineage, error) {
+	db, err := r.getDB(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := db.Get(getLineageKey(accountID, documentID))
+	if err != nil {
+		return nil, err
+	}
+
+	l, ok := m.(*Lineage)
+	if !ok {
+		return nil, errors.New("lineage of document %x is of invalid type", documentID)
+	}
+
+	return l, nil
+}
+
+// Atomic calls fn with a Repository whose writes are batched.
+// The writes are committed together only if fn returns nil, otherwise none of them are persisted.
+// The reads of the Repository see the writes of fn. Writes to the DBs of different residency tags are committed per DB.
+// Atomic calls fn with the same batches if the repository is batched already.
+func (r *repo) Atomic(fn func(repo Repository) error) error {
+	if r.batches != nil {
+		return fn(r)
+	}
+
+	b := &repo{
+		db:           r.db,
+		residencyDBs: r.residencyDBs,
+		accounts:     r.accounts,
+		keys:         r.keys,
+		types:        r.types,
+		batches:      make(map[storage.Repository]storage.Batch),
+	}
+
+	err := fn(b)
+	if err != nil {
+		return err
+	}
+
+	for _, batch := range b.batches {
+		err = batch.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -3,12 +3,20 @@
 package documents
 
 import (
+	"bytes"
 	"encoding/json"
 	"reflect"
+	"sort"
 	"testing"
+	"time"
 
+	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
 	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,6 +30,10 @@ type doc struct {
 	SomeString string `json:"some_string"`
 }
 
+func (m *doc) ID() []byte             { return nil }
+func (m *doc) CurrentVersion() []byte { return nil }
+func (m *doc) NextVersion() []byte    { return nil }
+
 func (m *doc) JSON() ([]byte, error) {
 	return json.Marshal(m)
 }
@@ -98,3 +110,247 @@ func TestLevelDBRepo_Get_Create_Update(t *testing.T) {
 	nd = m.(*doc)
 	assert.Equal(t, d, nd, "must be equal")
 }
+
+func TestLevelDBRepo_Atomic(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&doc{})
+	d := &doc{SomeString: "Hello, World!"}
+	accountID, id1, id2 := utils.RandomSlice(32), utils.RandomSlice(32), utils.RandomSlice(32)
+
+	// second write fails, first one must not be persisted
+	err := repo.Atomic(func(repo Repository) error {
+		err := repo.Create(accountID, id1, d)
+		if err != nil {
+			return err
+		}
+
+		assert.True(t, repo.Exists(accountID, id1))
+		return repo.Update(accountID, id2, d)
+	})
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(storage.ErrRepositoryModelUpdateKeyNotFound, err))
+	assert.False(t, repo.Exists(accountID, id1))
+	assert.False(t, repo.Exists(accountID, id2))
+
+	// success
+	err = repo.Atomic(func(repo Repository) error {
+		err := repo.Create(accountID, id1, d)
+		if err != nil {
+			return err
+		}
+
+		return repo.Create(accountID, id2, d)
+	})
+	assert.Nil(t, err)
+	assert.True(t, repo.Exists(accountID, id1))
+	assert.True(t, repo.Exists(accountID, id2))
+	m, err := repo.Get(accountID, id2)
+	assert.Nil(t, err)
+	assert.Equal(t, d, m.(*doc))
+}
+
+func TestLevelDBRepo_Atomic_reads(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&searchDoc{})
+	accountID := utils.RandomSlice(32)
+	v1 := newSearchDoc("invoice", time.Now(), testingidentity.GenerateRandomDID(), nil)
+	v2 := *v1
+	v2.Version, v2.Next = v1.Next, utils.RandomSlice(32)
+	assert.NoError(t, repo.Create(accountID, v1.Version, v1))
+
+	err := repo.Atomic(func(batched Repository) error {
+		err := batched.Create(accountID, v2.Version, &v2)
+		if err != nil {
+			return err
+		}
+
+		// reads see the writes of the batch
+		m, err := batched.Get(accountID, v2.Version)
+		assert.NoError(t, err)
+		assert.Equal(t, v2.Version, m.CurrentVersion())
+		m, err = batched.GetLatest(accountID, v1.DocID)
+		assert.NoError(t, err)
+		assert.Equal(t, v2.Version, m.CurrentVersion())
+		var versions [][]byte
+		assert.NoError(t, batched.Iterate(accountID, func(m Model) error {
+			versions = append(versions, m.CurrentVersion())
+			return nil
+		}))
+		assert.Len(t, versions, 2)
+
+		// nested calls share the batch
+		assert.NoError(t, batched.Atomic(func(nested Repository) error {
+			assert.True(t, nested.Exists(accountID, v2.Version))
+			return nil
+		}))
+
+		// nothing is written until fn returns
+		assert.False(t, repo.Exists(accountID, v2.Version))
+		m, err = repo.GetLatest(accountID, v1.DocID)
+		assert.NoError(t, err)
+		assert.Equal(t, v1.Version, m.CurrentVersion())
+		return nil
+	})
+	assert.NoError(t, err)
+	m, err := repo.GetLatest(accountID, v1.DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, v2.Version, m.CurrentVersion())
+}
+
+func TestLevelDBRepo_Delete(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&doc{})
+	d := &doc{SomeString: "Hello, World!"}
+	accountID, id1, id2 := utils.RandomSlice(32), utils.RandomSlice(32), utils.RandomSlice(32)
+	assert.NoError(t, repo.Create(accountID, id1, d))
+	assert.NoError(t, repo.Create(accountID, id2, d))
+
+	assert.NoError(t, repo.Delete(accountID, id1))
+	assert.False(t, repo.Exists(accountID, id1))
+
+	// deletes are batched
+	err := repo.Atomic(func(repo Repository) error {
+		err := repo.Delete(accountID, id2)
+		if err != nil {
+			return err
+		}
+
+		return errors.New("failed")
+	})
+	assert.Error(t, err)
+	assert.True(t, repo.Exists(accountID, id2))
+	assert.NoError(t, repo.Atomic(func(repo Repository) error {
+		return repo.Delete(accountID, id2)
+	}))
+	assert.False(t, repo.Exists(accountID, id2))
+}
+
+func TestResidencyRepo(t *testing.T) {
+	db := ctx[storage.BootstrappedDB].(storage.Repository)
+	ldb, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	euDB := leveldb.NewLevelDBRepository(ldb)
+	accounts := new(configstore.MockService)
+	repo := NewResidencyRepository(db, map[string]storage.Repository{"eu": euDB}, accounts)
+	repo.Register(&doc{})
+	d := &doc{SomeString: "Hello, World!"}
+	euAccountID, accountID, id := utils.RandomSlice(32), utils.RandomSlice(32), utils.RandomSlice(32)
+	accounts.On("GetAccount", euAccountID).Return(&configstore.Account{Residency: "eu"}, nil)
+	accounts.On("GetAccount", accountID).Return(&configstore.Account{}, nil)
+
+	// documents of accounts with a residency tag are stored in the db of the tag
+	assert.NoError(t, repo.Create(euAccountID, id, d))
+	assert.True(t, repo.Exists(euAccountID, id))
+	assert.True(t, euDB.Exists(append(euAccountID, id...)))
+	assert.False(t, db.Exists(append(euAccountID, id...)))
+	m, err := repo.Get(euAccountID, id)
+	assert.NoError(t, err)
+	assert.Equal(t, d, m.(*doc))
+
+	// documents of accounts without a tag are stored in the default db
+	err = repo.Atomic(func(repo Repository) error {
+		err := repo.Create(accountID, id, d)
+		if err != nil {
+			return err
+		}
+
+		return repo.Update(euAccountID, id, d)
+	})
+	assert.NoError(t, err)
+	assert.True(t, db.Exists(append(accountID, id...)))
+	assert.False(t, euDB.Exists(append(accountID, id...)))
+
+	// tag without a db
+	unknownAccountID := utils.RandomSlice(32)
+	accounts.On("GetAccount", unknownAccountID).Return(&configstore.Account{Residency: "us"}, nil)
+	err = repo.Create(unknownAccountID, id, d)
+	assert.True(t, errors.IsOfType(ErrDocumentResidency, err))
+	assert.False(t, repo.Exists(unknownAccountID, id))
+	assert.False(t, db.Exists(append(unknownAccountID, id...)))
+
+	// unknown account
+	unknownAccountID = utils.RandomSlice(32)
+	accounts.On("GetAccount", unknownAccountID).Return(&configstore.Account{}, errors.New("account not found"))
+	_, err = repo.Get(unknownAccountID, id)
+	assert.True(t, errors.IsOfType(ErrDocumentResidency, err))
+}
+
+func TestLevelDBRepo_Iterate(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&doc{})
+	accountID, otherID := utils.RandomSlice(32), utils.RandomSlice(32)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, repo.Create(accountID, utils.RandomSlice(32), &doc{SomeString: "Hello, World!"}))
+	}
+	assert.NoError(t, repo.Create(otherID, utils.RandomSlice(32), &doc{SomeString: "Hello, Repo!"}))
+
+	var count int
+	err := repo.Iterate(accountID, func(model Model) error {
+		assert.Equal(t, "Hello, World!", model.(*doc).SomeString)
+		count++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	// iteration stops at the first error
+	count = 0
+	err = repo.Iterate(accountID, func(model Model) error {
+		count++
+		return errors.New("stop")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestLevelDBRepo_IterateFrom(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&doc{})
+	accountID := utils.RandomSlice(32)
+	var ids [][]byte
+	for i := 0; i < 5; i++ {
+		id := utils.RandomSlice(32)
+		ids = append(ids, id)
+		assert.NoError(t, repo.Create(accountID, id, &doc{SomeString: hexutil.Encode(id)}))
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i], ids[j]) < 0
+	})
+
+	// pages of two versions
+	var visited []string
+	visit := func(model Model) error {
+		visited = append(visited, model.(*doc).SomeString)
+		return nil
+	}
+	cursor, err := repo.IterateFrom(accountID, nil, 2, visit)
+	assert.NoError(t, err)
+	assert.Equal(t, ids[1], cursor)
+	cursor, err = repo.IterateFrom(accountID, cursor, 2, visit)
+	assert.NoError(t, err)
+	assert.Equal(t, ids[3], cursor)
+
+	// the iteration resumes after the cursor even if its version is deleted
+	assert.NoError(t, repo.Delete(accountID, ids[3]))
+	cursor, err = repo.IterateFrom(accountID, cursor, 2, visit)
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+	var expected []string
+	for _, id := range ids {
+		expected = append(expected, hexutil.Encode(id))
+	}
+	assert.Equal(t, expected, visited)
+
+	// no limit
+	visited = nil
+	cursor, err = repo.IterateFrom(accountID, ids[0], 0, visit)
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+	assert.Equal(t, []string{expected[1], expected[2], expected[4]}, visited)
+
+	// the iteration stops at the first error
+	_, err = repo.IterateFrom(accountID, nil, 2, func(model Model) error {
+		return errors.New("stop")
+	})
+	assert.Error(t, err)
+}
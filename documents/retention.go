@@ -0,0 +1,252 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/maintenance"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// RetentionJobName is the name of the maintenance job pruning the document versions as per the retention policy.
+	RetentionJobName = "document-retention"
+
+	// retentionPageSize is the number of stored versions walked at a time to look up the latest versions
+	retentionPageSize = 100
+)
+
+// RetentionPolicy defines the versions of the documents that are pruned. The latest version of a document is never
+// pruned. Older versions are pruned once they are outside of every configured limit.
+type RetentionPolicy struct {
+	// KeepVersions is the number of latest versions of a document that are retained, including the latest version.
+	KeepVersions int
+
+	// MaxAge is the age of the versions after which they are no longer retained.
+	MaxAge time.Duration
+
+	// ArchivePath is the directory the pruned versions are archived to. The versions are not archived if empty.
+	ArchivePath string
+}
+
+// enabled returns true if the policy limits the versions of the documents.
+func (p RetentionPolicy) enabled() bool {
+	return p.KeepVersions > 0 || p.MaxAge > 0
+}
+
+// retains returns true if the version, behind the latest version by the given number of versions, is retained at now.
+func (p RetentionPolicy) retains(behind int, timestamp, now time.Time) bool {
+	if behind == 0 || (p.KeepVersions > 0 && behind < p.KeepVersions) {
+		return true
+	}
+
+	return p.MaxAge > 0 && now.Sub(timestamp) < p.MaxAge
+}
+
+// retentionJob prunes the versions of the documents of an account that are no longer retained by the policy.
+// The anchored roots of the pruned versions are kept in the lineage of their document, so the pruned versions can be
+// fetched from the collaborators again, the same way as the versions preceding the version an account joined with.
+type retentionJob struct {
+	repo   Repository
+	policy RetentionPolicy
+}
+
+// newRetentionJob returns the job pruning the document versions as per the policy.
+func newRetentionJob(repo Repository, policy RetentionPolicy) maintenance.Job {
+	return retentionJob{repo: repo, policy: policy}
+}
+
+func (retentionJob) JobName() string {
+	return RetentionJobName
+}
+
+// RunForAccount prunes the versions of every document of the account that are no longer retained.
+// Documents that fail to be pruned are reported, the other documents are pruned regardless.
+func (j retentionJob) RunForAccount(ctx context.Context) error {
+	if !j.policy.enabled() {
+		return errors.NewTypedError(ErrDocumentRetention, errors.New("no retention policy configured"))
+	}
+
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return ErrDocumentConfigAccountID
+	}
+
+	// the latest versions are looked up from the stored versions, as documents stored before the indexes aren't indexed.
+	// The versions are walked in pages, so the documents are pruned without loading every version of the account.
+	now := time.Now().UTC()
+	var pruned, total int
+	var failed []string
+	var cursor []byte
+	for {
+		var latest []Model
+		cursor, err = j.repo.IterateFrom(did[:], cursor, retentionPageSize, func(m Model) error {
+			if !j.repo.Exists(did[:], m.NextVersion()) {
+				latest = append(latest, m)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return errors.NewTypedError(ErrDocumentRetention, err)
+		}
+
+		for _, m := range latest {
+			n, err := j.pruneDocument(did, m, now)
+			if err != nil {
+				failed = append(failed, fmt.Sprintf("%x: %v", m.ID(), err))
+				continue
+			}
+
+			pruned += n
+		}
+
+		total += len(latest)
+		if cursor == nil {
+			break
+		}
+	}
+
+	srvLog.Infof("pruned %d versions of the documents of %s", pruned, did.String())
+	if len(failed) > 0 {
+		return errors.NewTypedError(ErrDocumentRetention, errors.New("%d of %d documents not pruned: %s", len(failed), total, strings.Join(failed, "; ")))
+	}
+
+	return nil
+}
+
+// pruneDocument walks the stored versions of the document back from its latest version, and prunes the versions from
+// the first version that is not retained on. Versions that are not anchored stop the walk. Returns the number of
+// pruned versions.
+func (j retentionJob) pruneDocument(did identity.DID, latest Model, now time.Time) (int, error) {
+	oldest := latest
+	var versions []Model
+	var err error
+	m := latest
+	for behind := 1; !utils.IsEmptyByteSlice(m.PreviousVersion()); behind++ {
+		m, err = j.repo.Get(did[:], m.PreviousVersion())
+		if err != nil {
+			// the older versions are pruned already or known by their roots only
+			break
+		}
+
+		if st := m.GetStatus(); st != StatusCommitted && st != "" {
+			break
+		}
+
+		ts, err := m.Timestamp()
+		if len(versions) == 0 && (err != nil || j.policy.retains(behind, ts, now)) {
+			oldest = m
+			continue
+		}
+
+		versions = append(versions, m)
+	}
+
+	if len(versions) == 0 {
+		return 0, nil
+	}
+
+	lineage, err := j.lineage(did, oldest, versions)
+	if err != nil {
+		return 0, err
+	}
+
+	if j.policy.ArchivePath != "" {
+		err = archiveVersions(filepath.Join(j.policy.ArchivePath, did.String(), hexutil.Encode(latest.ID())), versions)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// documents stored before the latest version index aren't indexed, the latest version is indexed along with the
+	// pruning so the document stays reachable by its ID once its older versions are gone.
+	err = j.repo.Atomic(func(repo Repository) error {
+		err := repo.Reindex(did[:], latest.CurrentVersion())
+		if err != nil {
+			return err
+		}
+
+		for _, v := range versions {
+			err := repo.Delete(did[:], v.CurrentVersion())
+			if err != nil {
+				return err
+			}
+		}
+
+		return repo.SaveLineage(did[:], lineage)
+	})
+	if err != nil {
+		return 0, errors.NewTypedError(ErrDocumentPersistence, err)
+	}
+
+	return len(versions), nil
+}
+
+// lineage returns the lineage of the document with the roots of the pruned versions, followed by the versions of the
+// existing lineage. The oldest retained version becomes the version the collaborators share the prior versions for.
+func (j retentionJob) lineage(did identity.DID, oldest Model, versions []Model) (*Lineage, error) {
+	lineage := &Lineage{DocumentID: oldest.ID(), JoinedVersion: oldest.CurrentVersion()}
+	for _, v := range versions {
+		dr, err := v.CalculateDocumentRoot()
+		if err != nil {
+			return nil, errors.New("failed to get document root of version %x: %v", v.CurrentVersion(), err)
+		}
+
+		lineage.Versions = append(lineage.Versions, VersionRoot{
+			Version:         v.CurrentVersion(),
+			PreviousVersion: v.PreviousVersion(),
+			DocumentRoot:    dr,
+		})
+	}
+
+	existing, err := j.repo.GetLineage(did[:], oldest.ID())
+	if err != nil {
+		return lineage, nil
+	}
+
+	for _, r := range existing.Versions {
+		if lineage.version(r.Version) == nil {
+			lineage.Versions = append(lineage.Versions, r)
+		}
+	}
+
+	return lineage, nil
+}
+
+// archiveVersions writes the packed core documents of the versions to dir, one file per version.
+func archiveVersions(dir string, versions []Model) error {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return errors.New("failed to create archive directory: %v", err)
+	}
+
+	for _, v := range versions {
+		cd, err := v.PackCoreDocument()
+		if err != nil {
+			return err
+		}
+
+		data, err := proto.Marshal(&cd)
+		if err != nil {
+			return err
+		}
+
+		err = ioutil.WriteFile(filepath.Join(dir, hexutil.Encode(v.CurrentVersion())+".pb"), data, 0600)
+		if err != nil {
+			return errors.New("failed to archive version %x: %v", v.CurrentVersion(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// +build unit
+
+package documents
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func (m *historyDoc) PackCoreDocument() (coredocumentpb.CoreDocument, error) {
+	return coredocumentpb.CoreDocument{DocumentIdentifier: m.DocID, CurrentVersion: m.Version}, nil
+}
+
+func TestRetentionPolicy_retains(t *testing.T) {
+	now := time.Now()
+	old, recent := now.Add(-48*time.Hour), now.Add(-time.Hour)
+	tests := []struct {
+		policy   RetentionPolicy
+		behind   int
+		ts       time.Time
+		retained bool
+	}{
+		{RetentionPolicy{KeepVersions: 2}, 0, old, true},
+		{RetentionPolicy{KeepVersions: 2}, 1, old, true},
+		{RetentionPolicy{KeepVersions: 2}, 2, recent, false},
+		{RetentionPolicy{MaxAge: 24 * time.Hour}, 0, old, true},
+		{RetentionPolicy{MaxAge: 24 * time.Hour}, 5, recent, true},
+		{RetentionPolicy{MaxAge: 24 * time.Hour}, 1, old, false},
+		{RetentionPolicy{KeepVersions: 2, MaxAge: 24 * time.Hour}, 2, recent, true},
+		{RetentionPolicy{KeepVersions: 2, MaxAge: 24 * time.Hour}, 1, old, true},
+		{RetentionPolicy{KeepVersions: 2, MaxAge: 24 * time.Hour}, 2, old, false},
+	}
+
+	for _, c := range tests {
+		assert.Equal(t, c.retained, c.policy.retains(c.behind, c.ts, now))
+	}
+}
+
+func TestRetentionJob_RunForAccount(t *testing.T) {
+	ldb, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	db := leveldb.NewLevelDBRepository(ldb)
+	repo := NewDBRepository(db)
+	repo.Register(&historyDoc{})
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+	archive, err := ioutil.TempDir("", "retention")
+	assert.NoError(t, err)
+	defer os.RemoveAll(archive)
+
+	// no policy
+	err = newRetentionJob(repo, RetentionPolicy{}).RunForAccount(actx)
+	assert.True(t, errors.IsOfType(ErrDocumentRetention, err))
+
+	// v1 and v2 are at least a day old, v3 is 12 hours old
+	docs := historyChain(4)
+	v1, v2, v3, v4 := docs[0], docs[1], docs[2], docs[3]
+	for i, d := range docs {
+		d.Status = StatusCommitted
+		d.Time = time.Now().Add(time.Duration(i-3) * 12 * time.Hour)
+		assert.NoError(t, repo.Create(did[:], d.Version, d))
+	}
+
+	// documents stored before the latest version index are indexed along with the pruning
+	assert.NoError(t, db.Delete(getLatestVersionKey(did[:], v4.DocID)))
+
+	// the two latest versions are kept
+	job := newRetentionJob(repo, RetentionPolicy{KeepVersions: 2, ArchivePath: archive})
+	assert.Equal(t, RetentionJobName, job.JobName())
+	assert.NoError(t, job.RunForAccount(actx))
+	assert.True(t, repo.Exists(did[:], v4.Version))
+	assert.True(t, repo.Exists(did[:], v3.Version))
+	assert.False(t, repo.Exists(did[:], v2.Version))
+	assert.False(t, repo.Exists(did[:], v1.Version))
+	latest, err := repo.GetLatest(did[:], v4.DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, v4.Version, latest.CurrentVersion())
+	lineage, err := repo.GetLineage(did[:], v4.DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, v3.Version, lineage.JoinedVersion)
+	assert.Equal(t, []VersionRoot{
+		{Version: v2.Version, PreviousVersion: v2.Previous, DocumentRoot: v2.Root},
+		{Version: v1.Version, DocumentRoot: v1.Root},
+	}, lineage.Versions)
+
+	// pruned versions are archived
+	for _, d := range []*historyDoc{v1, v2} {
+		data, err := ioutil.ReadFile(filepath.Join(archive, did.String(), hexutil.Encode(d.DocID), hexutil.Encode(d.Version)+".pb"))
+		assert.NoError(t, err)
+		cd := new(coredocumentpb.CoreDocument)
+		assert.NoError(t, proto.Unmarshal(data, cd))
+		assert.Equal(t, d.Version, cd.CurrentVersion)
+	}
+
+	// versions younger than the max age are kept, the pruned versions stay in the lineage
+	assert.NoError(t, newRetentionJob(repo, RetentionPolicy{MaxAge: 30 * time.Hour}).RunForAccount(actx))
+	assert.True(t, repo.Exists(did[:], v3.Version))
+	assert.NoError(t, newRetentionJob(repo, RetentionPolicy{MaxAge: 6 * time.Hour}).RunForAccount(actx))
+	assert.True(t, repo.Exists(did[:], v4.Version))
+	assert.False(t, repo.Exists(did[:], v3.Version))
+	lineage, err = repo.GetLineage(did[:], v4.DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, v4.Version, lineage.JoinedVersion)
+	assert.Len(t, lineage.Versions, 3)
+	assert.NotNil(t, lineage.version(v1.Version))
+
+	// versions that are not anchored are not pruned
+	docs = historyChain(2)
+	docs[0].Status, docs[1].Status = StatusFailed, StatusDraft
+	for _, d := range docs {
+		assert.NoError(t, repo.Create(did[:], d.Version, d))
+	}
+	assert.NoError(t, newRetentionJob(repo, RetentionPolicy{KeepVersions: 1}).RunForAccount(actx))
+	assert.True(t, repo.Exists(did[:], docs[0].Version))
+}
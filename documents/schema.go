@@ -0,0 +1,74 @@
+package documents
+
+import (
+	"encoding/binary"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	logging "github.com/ipfs/go-log"
+)
+
+var schemaLog = logging.Logger("document-schema")
+
+// unknownFieldNumbers returns the field numbers of the serialised protobuf fields in order of appearance.
+func unknownFieldNumbers(data []byte) ([]uint64, error) {
+	var fields []uint64
+	seen := make(map[uint64]struct{})
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, errors.New("invalid field key")
+		}
+
+		data = data[n:]
+		num := key >> 3
+		var size int
+		switch wt := key & 7; wt {
+		case 0: // varint
+			_, size = binary.Uvarint(data)
+			if size <= 0 {
+				return nil, errors.New("invalid varint for field %d", num)
+			}
+		case 1: // fixed64
+			size = 8
+		case 2: // length delimited
+			l, n := binary.Uvarint(data)
+			if n <= 0 || l > uint64(len(data)) {
+				return nil, errors.New("invalid length for field %d", num)
+			}
+			size = n + int(l)
+		case 5: // fixed32
+			size = 4
+		default:
+			return nil, errors.New("unsupported wire type %d for field %d", wt, num)
+		}
+
+		if size > len(data) {
+			return nil, errors.New("truncated field %d", num)
+		}
+
+		data = data[size:]
+		if _, ok := seen[num]; !ok {
+			seen[num] = struct{}{}
+			fields = append(fields, num)
+		}
+	}
+
+	return fields, nil
+}
+
+// WarnUnknownFields logs a warning if the embedded data of the document holds fields unknown to the local schema.
+// Such fields come from collaborators running a newer schema. They are preserved as is,
+// but they are not part of the data tree built by this node.
+func WarnUnknownFields(docType, action string, unknown []byte) {
+	if len(unknown) == 0 {
+		return
+	}
+
+	fields, err := unknownFieldNumbers(unknown)
+	if err != nil {
+		schemaLog.Warningf("%s: embedded data of %s holds %d bytes unknown to the local schema: %v", action, docType, len(unknown), err)
+		return
+	}
+
+	schemaLog.Warningf("%s: embedded data of %s holds fields %v unknown to the local schema, they are preserved as is", action, docType, fields)
+}
@@ -0,0 +1,42 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnknownFieldNumbers(t *testing.T) {
+	var data []byte
+	data = append(data, proto.EncodeVarint(100<<3|0)...)
+	data = append(data, proto.EncodeVarint(300)...)
+	data = append(data, proto.EncodeVarint(101<<3|2)...)
+	data = append(data, proto.EncodeVarint(5)...)
+	data = append(data, []byte("value")...)
+	data = append(data, proto.EncodeVarint(102<<3|1)...)
+	data = append(data, make([]byte, 8)...)
+	data = append(data, proto.EncodeVarint(103<<3|5)...)
+	data = append(data, make([]byte, 4)...)
+	data = append(data, proto.EncodeVarint(100<<3|0)...)
+	data = append(data, proto.EncodeVarint(1)...)
+
+	fields, err := unknownFieldNumbers(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{100, 101, 102, 103}, fields)
+
+	// empty
+	fields, err = unknownFieldNumbers(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, fields)
+
+	// truncated
+	_, err = unknownFieldNumbers(data[:len(data)-5])
+	assert.Error(t, err)
+
+	// groups are not supported
+	_, err = unknownFieldNumbers(proto.EncodeVarint(104<<3 | 3))
+	assert.Error(t, err)
+}
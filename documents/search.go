@@ -0,0 +1,299 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/identity"
+)
+
+const (
+	// searchRecordPrefix is the key prefix of the search records of the documents
+	searchRecordPrefix = "docsearch_record_"
+
+	// searchTermPrefix is the key prefix of the search postings of the documents
+	searchTermPrefix = "docsearch_term_"
+)
+
+// Searchable is implemented by the models whose documents can be searched.
+type Searchable interface {
+	// SearchAttributes returns the searchable attributes of the document version by their client field names.
+	// Empty attributes are skipped.
+	SearchAttributes() map[string]string
+}
+
+// SetSearchAttribute sets the search attribute if the value is not empty.
+func SetSearchAttribute(attrs map[string]string, name, value string) {
+	if value == "" {
+		return
+	}
+
+	attrs[name] = value
+}
+
+// SearchQuery filters the documents of an account. The documents match if their latest versions match all the set filters.
+type SearchQuery struct {
+	DocumentType string
+	Collaborator *identity.DID
+
+	// From and To are the inclusive bounds of the timestamp of the latest version. Zero values are unbounded.
+	From, To time.Time
+
+	// Attributes are matched by their exact values.
+	Attributes map[string]string
+
+	// Tags must all be on the document.
+	Tags []string
+}
+
+// terms returns the search terms of the query, starting with the most selective ones.
+func (q SearchQuery) terms() [][]byte {
+	var terms [][]byte
+	names := make([]string, 0, len(q.Attributes))
+	for name := range q.Attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		terms = append(terms, attributeTerm(name, q.Attributes[name]))
+	}
+
+	for _, tag := range q.Tags {
+		terms = append(terms, tagTerm(tag))
+	}
+
+	if q.Collaborator != nil {
+		terms = append(terms, collaboratorTerm(*q.Collaborator))
+	}
+
+	if q.DocumentType != "" {
+		terms = append(terms, documentTypeTerm(q.DocumentType))
+	}
+
+	return terms
+}
+
+// matches checks the record against every filter of the query.
+func (q SearchQuery) matches(r *searchRecord) bool {
+	if q.DocumentType != "" && q.DocumentType != r.DocumentType {
+		return false
+	}
+
+	if q.Collaborator != nil && !r.hasCollaborator(*q.Collaborator) {
+		return false
+	}
+
+	if (!q.From.IsZero() && r.Timestamp.Before(q.From)) || (!q.To.IsZero() && r.Timestamp.After(q.To)) {
+		return false
+	}
+
+	for name, value := range q.Attributes {
+		if r.Attributes[name] != value {
+			return false
+		}
+	}
+
+	for _, tag := range q.Tags {
+		if !r.hasTag(tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// searchRecord is the indexed state of the latest version of a document.
+type searchRecord struct {
+	DocumentID    []byte            `json:"document_id"`
+	Version       []byte            `json:"version"`
+	DocumentType  string            `json:"document_type"`
+	Status        Status            `json:"status"`
+	Timestamp     time.Time         `json:"timestamp"`
+	Collaborators []identity.DID    `json:"collaborators"`
+	Attributes    map[string]string `json:"attributes"`
+	Tags          []string          `json:"tags,omitempty"`
+}
+
+// JSON returns the json encoding of the record.
+func (r *searchRecord) JSON() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// FromJSON loads the record from its json encoding.
+func (r *searchRecord) FromJSON(data []byte) error {
+	return json.Unmarshal(data, r)
+}
+
+// Type returns the reflect type of the record.
+func (r *searchRecord) Type() reflect.Type {
+	return reflect.TypeOf(r)
+}
+
+// hasCollaborator checks if the did is a collaborator of the document.
+func (r *searchRecord) hasCollaborator(did identity.DID) bool {
+	for _, c := range r.Collaborators {
+		if c.Equal(did) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasTag checks if the tag is on the document.
+func (r *searchRecord) hasTag(tag string) bool {
+	for _, t := range r.Tags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// item returns the listing item of the document.
+func (r *searchRecord) item() DocumentItem {
+	return DocumentItem{
+		DocumentID:    r.DocumentID,
+		DocumentType:  r.DocumentType,
+		Status:        r.Status,
+		LatestVersion: r.Version,
+	}
+}
+
+// searchPosting marks a document as a candidate for a search term.
+type searchPosting struct {
+	DocumentID []byte `json:"document_id"`
+}
+
+// JSON returns the json encoding of the posting.
+func (p *searchPosting) JSON() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// FromJSON loads the posting from its json encoding.
+func (p *searchPosting) FromJSON(data []byte) error {
+	return json.Unmarshal(data, p)
+}
+
+// Type returns the reflect type of the posting.
+func (p *searchPosting) Type() reflect.Type {
+	return reflect.TypeOf(p)
+}
+
+// documentTypeTerm returns the search term of the document type.
+func documentTypeTerm(docType string) []byte {
+	return []byte("type\x00" + docType)
+}
+
+// collaboratorTerm returns the search term of the collaborator.
+func collaboratorTerm(did identity.DID) []byte {
+	return append([]byte("collaborator\x00"), did[:]...)
+}
+
+// attributeTerm returns the search term of the attribute value.
+func attributeTerm(name, value string) []byte {
+	return []byte("attribute\x00" + name + "\x00" + value)
+}
+
+// tagTerm returns the search term of the tag.
+func tagTerm(tag string) []byte {
+	return []byte("tag\x00" + tag)
+}
+
+// getSearchRecordPrefix returns the key prefix of the search records of the documents owned by accountID.
+func getSearchRecordPrefix(accountID []byte) []byte {
+	return append([]byte(searchRecordPrefix), accountID...)
+}
+
+// getSearchRecordKey returns the key of the search record of the document owned by accountID.
+func getSearchRecordKey(accountID, documentID []byte) []byte {
+	return append(getSearchRecordPrefix(accountID), documentID...)
+}
+
+// getSearchTermPrefix returns the key prefix of the postings of the term for the documents owned by accountID.
+// Terms are hashed so that a term is never the prefix of another one.
+func getSearchTermPrefix(accountID, term []byte) []byte {
+	h := sha256.Sum256(term)
+	prefix := append([]byte(searchTermPrefix), accountID...)
+	return append(prefix, h[:]...)
+}
+
+// indexSearch indexes the model as the latest version of its document, unless its next version is already stored.
+// Postings of the previous versions are not removed, search results are checked against the search record instead.
+func indexSearch(w indexWriter, accountID []byte, model Model) error {
+	s, ok := model.(Searchable)
+	if !ok {
+		return nil
+	}
+
+	if w.Exists(append(accountID, model.NextVersion()...)) {
+		return nil
+	}
+
+	collaborators, err := model.GetCollaborators()
+	if err != nil {
+		return err
+	}
+
+	// drafts may not be timestamped yet
+	ts, _ := model.Timestamp()
+	r := &searchRecord{
+		DocumentID:    model.ID(),
+		Version:       model.CurrentVersion(),
+		DocumentType:  model.DocumentType(),
+		Status:        model.GetStatus(),
+		Timestamp:     ts,
+		Collaborators: collaborators,
+		Attributes:    s.SearchAttributes(),
+		Tags:          model.Tags(),
+	}
+
+	err = saveIndexEntry(w, getSearchRecordKey(accountID, r.DocumentID), r)
+	if err != nil {
+		return err
+	}
+
+	terms := [][]byte{documentTypeTerm(r.DocumentType)}
+	for _, c := range r.Collaborators {
+		terms = append(terms, collaboratorTerm(c))
+	}
+
+	for name, value := range r.Attributes {
+		terms = append(terms, attributeTerm(name, value))
+	}
+
+	for _, tag := range r.Tags {
+		terms = append(terms, tagTerm(tag))
+	}
+
+	for _, term := range terms {
+		key := append(getSearchTermPrefix(accountID, term), r.DocumentID...)
+		err = saveIndexEntry(w, key, &searchPosting{DocumentID: r.DocumentID})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Search returns the documents owned by accountID whose latest versions match the query, ordered by document ID.
+func (s service) Search(ctx context.Context, accountID identity.DID, query SearchQuery) ([]DocumentItem, error) {
+	items, err := s.repo.Search(accountID[:], query)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return bytes.Compare(items[i].DocumentID, items[j].DocumentID) < 0
+	})
+
+	return items, nil
+}
@@ -0,0 +1,146 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type searchDoc struct {
+	Model
+	DocID         []byte
+	Version       []byte
+	Next          []byte
+	DocType       string
+	Status        Status
+	Time          time.Time
+	Collaborators []identity.DID
+	Attributes    map[string]string
+	TagList       []string
+}
+
+func (m *searchDoc) ID() []byte                          { return m.DocID }
+func (m *searchDoc) CurrentVersion() []byte              { return m.Version }
+func (m *searchDoc) NextVersion() []byte                 { return m.Next }
+func (m *searchDoc) DocumentType() string                { return m.DocType }
+func (m *searchDoc) GetStatus() Status                   { return m.Status }
+func (m *searchDoc) Timestamp() (time.Time, error)       { return m.Time, nil }
+func (m *searchDoc) SearchAttributes() map[string]string { return m.Attributes }
+func (m *searchDoc) Tags() []string                      { return m.TagList }
+
+func (m *searchDoc) GetCollaborators(filterIDs ...identity.DID) ([]identity.DID, error) {
+	return m.Collaborators, nil
+}
+
+func (m *searchDoc) JSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *searchDoc) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+func (m *searchDoc) Type() reflect.Type {
+	return reflect.TypeOf(m)
+}
+
+func newSearchDoc(docType string, ts time.Time, collaborator identity.DID, attrs map[string]string) *searchDoc {
+	id := utils.RandomSlice(32)
+	return &searchDoc{
+		DocID:         id,
+		Version:       id,
+		Next:          utils.RandomSlice(32),
+		DocType:       docType,
+		Status:        StatusCommitted,
+		Time:          ts,
+		Collaborators: []identity.DID{collaborator},
+		Attributes:    attrs,
+	}
+}
+
+func searchIDs(items []DocumentItem) [][]byte {
+	var ids [][]byte
+	for _, i := range items {
+		ids = append(ids, i.DocumentID)
+	}
+
+	return ids
+}
+
+func TestService_Search(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&searchDoc{})
+	srv := service{repo: repo}
+	did := testingidentity.GenerateRandomDID()
+	c1, c2 := testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID()
+
+	inv1 := newSearchDoc("invoice", time.Date(2019, 1, 10, 0, 0, 0, 0, time.UTC), c1, map[string]string{"invoice_number": "INV-1"})
+	inv2 := newSearchDoc("invoice", time.Date(2019, 2, 10, 0, 0, 0, 0, time.UTC), c2, map[string]string{"invoice_number": "INV-2"})
+	po := newSearchDoc("purchaseorder", time.Date(2019, 3, 10, 0, 0, 0, 0, time.UTC), c1, map[string]string{"po_number": "PO-1"})
+	inv1.TagList, po.TagList = []string{"CC-100", "PRJ-7"}, []string{"CC-100"}
+	for _, d := range []*searchDoc{inv1, inv2, po} {
+		assert.NoError(t, repo.Create(did[:], d.Version, d))
+	}
+
+	// documents of other accounts are not found
+	items, err := srv.Search(context.Background(), testingidentity.GenerateRandomDID(), SearchQuery{})
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+
+	tests := []struct {
+		query  SearchQuery
+		result [][]byte
+	}{
+		{query: SearchQuery{}, result: [][]byte{inv1.DocID, inv2.DocID, po.DocID}},
+		{query: SearchQuery{DocumentType: "invoice"}, result: [][]byte{inv1.DocID, inv2.DocID}},
+		{query: SearchQuery{Collaborator: &c1}, result: [][]byte{inv1.DocID, po.DocID}},
+		{query: SearchQuery{DocumentType: "invoice", Collaborator: &c2}, result: [][]byte{inv2.DocID}},
+		{query: SearchQuery{From: time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC)}, result: [][]byte{inv2.DocID, po.DocID}},
+		{query: SearchQuery{From: time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2019, 2, 10, 0, 0, 0, 0, time.UTC)}, result: [][]byte{inv2.DocID}},
+		{query: SearchQuery{Attributes: map[string]string{"invoice_number": "INV-1"}}, result: [][]byte{inv1.DocID}},
+		{query: SearchQuery{Attributes: map[string]string{"invoice_number": "INV-1"}, Collaborator: &c2}},
+		{query: SearchQuery{Attributes: map[string]string{"invoice_number": "INV-3"}}},
+		{query: SearchQuery{DocumentType: "entity"}},
+		{query: SearchQuery{Tags: []string{"CC-100"}}, result: [][]byte{inv1.DocID, po.DocID}},
+		{query: SearchQuery{Tags: []string{"CC-100", "PRJ-7"}}, result: [][]byte{inv1.DocID}},
+		{query: SearchQuery{Tags: []string{"PRJ-7"}, DocumentType: "purchaseorder"}},
+	}
+
+	for _, c := range tests {
+		items, err := srv.Search(context.Background(), did, c.query)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, c.result, searchIDs(items))
+	}
+
+	// the search record follows the latest version
+	next := *inv1
+	next.Version, next.Next = inv1.Next, utils.RandomSlice(32)
+	next.Attributes = map[string]string{"invoice_number": "INV-3"}
+	assert.NoError(t, repo.Atomic(func(repo Repository) error {
+		return repo.Create(did[:], next.Version, &next)
+	}))
+
+	items, err = srv.Search(context.Background(), did, SearchQuery{Attributes: map[string]string{"invoice_number": "INV-1"}})
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+
+	items, err = srv.Search(context.Background(), did, SearchQuery{Attributes: map[string]string{"invoice_number": "INV-3"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []DocumentItem{{DocumentID: inv1.DocID, DocumentType: "invoice", Status: StatusCommitted, LatestVersion: next.Version}}, items)
+
+	// updates of previous versions are not indexed
+	inv1.Status = StatusFailed
+	assert.NoError(t, repo.Update(did[:], inv1.Version, inv1))
+	items, err = srv.Search(context.Background(), did, SearchQuery{Collaborator: &c1, DocumentType: "invoice"})
+	assert.NoError(t, err)
+	assert.Equal(t, []DocumentItem{{DocumentID: inv1.DocID, DocumentType: "invoice", Status: StatusCommitted, LatestVersion: next.Version}}, items)
+}
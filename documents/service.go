@@ -6,25 +6,32 @@ import (
 	"time"
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
-	"github.com/centrifuge/centrifuge-protobufs/gen/go/notification"
 	"github.com/centrifuge/go-centrifuge/anchors"
 	"github.com/centrifuge/go-centrifuge/contextutil"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/forensics"
+	"github.com/centrifuge/go-centrifuge/identifiers"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/notification"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
 	"github.com/centrifuge/go-centrifuge/transactions"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/centrifuge/precise-proofs/proofs/proto"
-	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common"
 	logging "github.com/ipfs/go-log"
 )
 
 // DocumentProof is a value to represent a document and its field proofs
 type DocumentProof struct {
-	DocumentID  []byte
-	VersionID   []byte
-	State       string
-	FieldProofs []*proofspb.Proof
+	DocumentID   []byte
+	VersionID    []byte
+	State        string
+	DocumentRoot []byte
+	FieldProofs  []*proofspb.Proof
+	Signatures   []*coredocumentpb.Signature
+
+	// Envelope records the parameters the field proofs were generated with, nil for bundles generated before envelopes
+	Envelope *ProofEnvelope
 }
 
 // Service provides an interface for functions common to all document types
@@ -48,17 +55,168 @@ type Service interface {
 	// CreateProofsForVersion creates proofs for a particular version of the document given the fields
 	CreateProofsForVersion(ctx context.Context, documentID, version []byte, fields []string) (*DocumentProof, error)
 
-	// RequestDocumentSignature Validates and Signs document received over the p2p layer
-	RequestDocumentSignature(ctx context.Context, model Model, collaborator identity.DID) (*coredocumentpb.Signature, error)
+	// VerifyProof verifies the field proofs of the bundle against its document root and the root against the anchor repository
+	VerifyProof(ctx context.Context, proof *DocumentProof) (*ProofVerification, error)
+
+	// CreateConsistencyProof proves that the fields of two documents have the same value and signs the assertion
+	CreateConsistencyProof(ctx context.Context, left, right FieldReference) (*ConsistencyProof, error)
+
+	// GetVersionSummary returns the summary of a particular version of the document
+	GetVersionSummary(ctx context.Context, documentID, version []byte) (*Summary, error)
+
+	// NotarizeProofBundle validates the proof bundle signed by the requester and co-signs it
+	NotarizeProofBundle(ctx context.Context, proof *DocumentProof, requester identity.DID) (*coredocumentpb.Signature, error)
+
+	// GetDocumentSize reports the storage footprint of the locally known versions of the document
+	GetDocumentSize(ctx context.Context, documentID []byte) (*DocumentSize, error)
+
+	// List returns the page of the documents owned by the account with their latest locally known versions
+	List(ctx context.Context, accountID identity.DID, page, perPage int) (*DocumentPage, error)
+
+	// GetDocumentGraph returns the documents linked to and from the document, and the NFTs minted on it
+	GetDocumentGraph(ctx context.Context, documentID []byte) (*DocumentGraph, error)
+
+	// GetTransitionGraph returns the roles of the document and the fields their transition rules allow to change
+	GetTransitionGraph(ctx context.Context, documentID []byte) (*TransitionGraph, error)
+
+	// GetVersionHistory returns the locally known versions of the document with their authors, timestamps and anchored roots
+	GetVersionHistory(ctx context.Context, documentID []byte) (*VersionHistory, error)
+
+	// ListAccessTokens returns the access tokens of the latest version of the document that have not expired
+	ListAccessTokens(ctx context.Context, documentID []byte) ([]AccessToken, error)
+
+	// GetVersionDiff returns the fields changed from one version of the document to the other
+	GetVersionDiff(ctx context.Context, documentID, fromVersion, toVersion []byte) (*VersionDiff, error)
+
+	// Import creates and anchors a document of the scheme from every row of the batch, reporting the outcome per row
+	Import(ctx context.Context, scheme string, format ImportFormat, collaborators []string, data []byte) ([]ImportResult, error)
+
+	// Search returns the documents owned by the account whose latest locally known versions match the query
+	Search(ctx context.Context, accountID identity.DID, query SearchQuery) ([]DocumentItem, error)
+
+	// ExportLedger returns the created, accepted and paid events of the anchored documents owned by the account timestamped within [from, to)
+	ExportLedger(ctx context.Context, accountID identity.DID, from, to time.Time) ([]LedgerEvent, error)
+
+	// ExportSignatureAudit returns the signatures of the account over the stored documents and their attributes timestamped within [from, to)
+	ExportSignatureAudit(ctx context.Context, accountID identity.DID, from, to time.Time) ([]AuditedSignature, error)
+
+	// RequestDocumentSignature Validates and Signs document received over the p2p layer with the signing and approver keys of the account
+	RequestDocumentSignature(ctx context.Context, model Model, collaborator identity.DID) ([]*coredocumentpb.Signature, error)
 
 	// ReceiveAnchoredDocument receives a new anchored document over the p2p layer, validates and updates the document in DB
 	ReceiveAnchoredDocument(ctx context.Context, model Model, collaborator identity.DID) error
 
+	// CheckAnchoredDocument checks that the anchor of the document received over the p2p layer is mined and holds its document root.
+	// Waits for the anchor to be mined during the grace period.
+	CheckAnchoredDocument(ctx context.Context, model Model, collaborator identity.DID) error
+
 	// Create validates and persists Model and returns a Updated model
 	Create(ctx context.Context, model Model) (Model, transactions.TxID, chan bool, error)
 
 	// Update validates and updates the model and return the updated model
 	Update(ctx context.Context, model Model) (Model, transactions.TxID, chan bool, error)
+
+	// CreateDraft validates and persists the model as a draft without anchoring it
+	CreateDraft(ctx context.Context, model Model) (Model, error)
+
+	// UpdateDraft validates and persists the new version of the model as a draft without anchoring it
+	UpdateDraft(ctx context.Context, model Model) (Model, error)
+
+	// Commit anchors the current version of the document if it is a draft or failed to be anchored
+	Commit(ctx context.Context, documentID []byte) (Model, transactions.TxID, chan bool, error)
+
+	// LinkDocument links the latest anchored version of the linked document to the document and anchors the new version
+	LinkDocument(ctx context.Context, documentID, linkedID []byte) (Model, transactions.TxID, chan bool, error)
+
+	// RemoveCollaborators removes the collaborators from the read and transition rules of the document and anchors the new version
+	RemoveCollaborators(ctx context.Context, documentID []byte, collaborators []identity.DID) (Model, transactions.TxID, chan bool, error)
+
+	// SignAttribute signs the current value of the attribute of the document with the signing key of the account
+	SignAttribute(ctx context.Context, documentID []byte, property string) (*coredocumentextpb.AttributeSignature, error)
+
+	// AddAttributeSignature adds the signature of a third party over an attribute to the document and anchors the new version
+	AddAttributeSignature(ctx context.Context, documentID []byte, sig *coredocumentextpb.AttributeSignature) (Model, transactions.TxID, chan bool, error)
+
+	// Cancel cancels the document, optionally superseded by another document, and anchors the final version
+	Cancel(ctx context.Context, documentID, supersededBy []byte) (Model, transactions.TxID, chan bool, error)
+
+	// AddNFT records an NFT minted outside of the node on the document and anchors the new version
+	AddNFT(ctx context.Context, documentID []byte, grantReadAccess bool, registry common.Address, tokenID []byte) (Model, transactions.TxID, chan bool, error)
+
+	// AddTransferDetail records a new transfer against the document and anchors the new version
+	AddTransferDetail(ctx context.Context, documentID []byte, detail *coredocumentextpb.TransferDetail) (Model, transactions.TxID, chan bool, error)
+
+	// UpdateTransferDetail replaces the detail of a transfer recorded on the document and anchors the new version
+	UpdateTransferDetail(ctx context.Context, documentID []byte, detail *coredocumentextpb.TransferDetail) (Model, transactions.TxID, chan bool, error)
+
+	// AddAttachment adds the attachment to the document, pinning the blob if set, and anchors the new version
+	AddAttachment(ctx context.Context, documentID []byte, attachment *coredocumentextpb.Attachment, blob []byte) (Model, transactions.TxID, chan bool, error)
+
+	// UpdateTags adds and removes the tags of the document and anchors the new version
+	UpdateTags(ctx context.Context, documentID []byte, add, remove []string) (Model, transactions.TxID, chan bool, error)
+
+	// SaveTemplate validates and stores the document template of the account, replacing the template with the same name
+	SaveTemplate(ctx context.Context, tpl *Template) error
+
+	// GetTemplate returns the document template of the account with the name
+	GetTemplate(ctx context.Context, name string) (*Template, error)
+
+	// ListTemplates returns the document templates of the account
+	ListTemplates(ctx context.Context) ([]*Template, error)
+
+	// DeleteTemplate removes the document template of the account with the name
+	DeleteTemplate(ctx context.Context, name string) error
+
+	// CreateFromTemplate creates and anchors a document from the client data and the document template with the name
+	CreateFromTemplate(ctx context.Context, name string, collaborators CollaboratorsAccess, data []byte) (Model, transactions.TxID, chan bool, error)
+
+	// SimulateAccess runs the read access checks of the collaborators for the account on the latest version of the document
+	SimulateAccess(ctx context.Context, documentID []byte, tokenRegistry TokenRegistry, sim AccessSimulation) (*AccessReport, error)
+
+	// RegisterHook registers a callback for a lifecycle event of the documents
+	RegisterHook(event HookEvent, hook Hook) error
+
+	// RunHooks runs the callbacks registered for the lifecycle event on the model
+	RunHooks(ctx context.Context, event HookEvent, model Model) error
+
+	// RegisterVersionFetcher registers the fetcher of the anchored versions that are not stored locally
+	RegisterVersionFetcher(fetcher VersionFetcher) error
+
+	// RegisterProofRequester registers the requester of the field proofs shared by the collaborators
+	RegisterProofRequester(requester ProofRequester) error
+
+	// RequestProofs requests the granter of an access token for the field proofs of the document and verifies them
+	RequestProofs(ctx context.Context, req ProofRequest) (*DocumentProof, error)
+
+	// EmitEvent appends the event of a state change of a document to the event log
+	EmitEvent(ctx context.Context, event Event)
+
+	// SubscribeEvents registers a subscriber to the events appended to the event log
+	SubscribeEvents(sub EventSubscriber) error
+
+	// ReplayEvents calls the subscriber with the persisted events of the account from the given sequence on
+	ReplayEvents(ctx context.Context, from uint64, sub EventSubscriber) error
+
+	// ValidateVersion runs the validation groups on the version of the document and reports the result of each group as it completes
+	ValidateVersion(ctx context.Context, documentID, version []byte, report ValidationReporter) error
+}
+
+// SchemeService is implemented by the document services that are served by the document API under /documents/{scheme}.
+// The client data is passed as the json encoding of the document type specific client data.
+type SchemeService interface {
+	Service
+
+	// Scheme returns the name of the document type used in the document API paths
+	Scheme() string
+
+	// DeriveFromClientData derives a new document from the json encoded client data
+	DeriveFromClientData(ctx context.Context, collaborators CollaboratorsAccess, data []byte) (Model, error)
+
+	// DeriveFromUpdateClientData derives the next version of the document from the json encoded client data
+	DeriveFromUpdateClientData(ctx context.Context, identifier string, collaborators CollaboratorsAccess, data []byte) (Model, error)
+
+	// DeriveClientData returns the json encoded client data of the document
+	DeriveClientData(model Model) ([]byte, error)
 }
 
 // service implements Service
@@ -68,8 +226,44 @@ type service struct {
 	anchorRepository anchors.AnchorRepository
 	registry         *ServiceRegistry
 	idService        identity.ServiceDID
+
+	// anchorGracePeriod is the time to wait for the anchor of a received document to be mined
+	anchorGracePeriod time.Duration
+
+	// anchorLinkFormat is the format of the link to an anchor in document summaries
+	anchorLinkFormat string
+
+	// anchorTimestampTolerance is how far the timestamp of a received document may be ahead of its anchor time
+	anchorTimestampTolerance time.Duration
+
+	// hooks are the callbacks registered for the document lifecycle events
+	hooks *Hooks
+
+	// importMappings are the column to field mappings of the batch imports by scheme
+	importMappings map[string]map[string]string
+
+	// fetcher fetches the versions not stored locally, it is registered once the p2p client is bootstrapped
+	fetcher *versionFetcher
+
+	// proofs requests the field proofs from the collaborators, it is registered once the p2p client is bootstrapped
+	proofs *proofRequester
+
+	// forensics records the received documents that fail the validation, nil if not recorded
+	forensics forensics.Service
+
+	// events is the log of the state changes of the documents
+	events *EventLog
+
+	// pinner pins the files of the attachments, nil if no IPFS node is configured
+	pinner BlobPinner
+
+	// templates stores the document templates of the accounts
+	templates *TemplateStore
 }
 
+// anchorCheckInterval is the time between the anchor checks of a received document during the grace period
+var anchorCheckInterval = time.Second
+
 var srvLog = logging.Logger("document-service")
 
 // DefaultService returns the default implementation of the service
@@ -77,14 +271,41 @@ func DefaultService(
 	repo Repository,
 	anchorRepo anchors.AnchorRepository,
 	registry *ServiceRegistry,
-	idService identity.ServiceDID) Service {
-	return service{
-		repo:             repo,
-		anchorRepository: anchorRepo,
-		notifier:         notification.NewWebhookSender(),
-		registry:         registry,
-		idService:        idService,
-	}
+	idService identity.ServiceDID,
+	anchorGracePeriod time.Duration,
+	anchorLinkFormat string,
+	anchorTimestampTolerance time.Duration,
+	importMappings map[string]map[string]string,
+	forensicsSrv forensics.Service,
+	events *EventLog,
+	pinner BlobPinner,
+	templates *TemplateStore) Service {
+	if events == nil {
+		events = NewEventLog(nil)
+	}
+
+	srv := service{
+		repo:                     repo,
+		anchorRepository:         anchorRepo,
+		notifier:                 notification.NewWebhookSender(),
+		registry:                 registry,
+		idService:                idService,
+		anchorGracePeriod:        anchorGracePeriod,
+		anchorLinkFormat:         anchorLinkFormat,
+		anchorTimestampTolerance: anchorTimestampTolerance,
+		hooks:                    NewHooks(),
+		importMappings:           importMappings,
+		fetcher:                  new(versionFetcher),
+		proofs:                   new(proofRequester),
+		forensics:                forensicsSrv,
+		events:                   events,
+		pinner:                   pinner,
+		templates:                templates,
+	}
+
+	// the webhook is notified of the received documents through the events
+	_ = events.Subscribe(srv.notifyReceived)
+	return srv
 }
 
 func (s service) searchVersion(ctx context.Context, m Model) (Model, error) {
@@ -103,9 +324,13 @@ func (s service) searchVersion(ctx context.Context, m Model) (Model, error) {
 }
 
 func (s service) GetCurrentVersion(ctx context.Context, documentID []byte) (Model, error) {
-	model, err := s.getVersion(ctx, documentID, documentID)
+	model, err := s.getLatestVersion(ctx, documentID)
 	if err != nil {
-		return nil, errors.NewTypedError(ErrDocumentNotFound, err)
+		// documents stored before the latest versions were indexed are walked from their first version
+		model, err = s.getVersion(ctx, documentID, documentID)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrDocumentNotFound, err)
+		}
 	}
 	return s.searchVersion(ctx, model)
 }
@@ -133,10 +358,17 @@ func (s service) createProofs(model Model, fields []string) (*DocumentProof, err
 		return nil, errors.NewTypedError(ErrDocumentProof, err)
 	}
 
+	docRoot, err := model.CalculateDocumentRoot()
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentProof, err)
+	}
+
 	return &DocumentProof{
-		DocumentID:  model.ID(),
-		VersionID:   model.CurrentVersion(),
-		FieldProofs: proofs,
+		DocumentID:   model.ID(),
+		VersionID:    model.CurrentVersion(),
+		DocumentRoot: docRoot,
+		FieldProofs:  proofs,
+		Envelope:     NewProofEnvelope(model),
 	}, nil
 
 }
@@ -144,12 +376,16 @@ func (s service) createProofs(model Model, fields []string) (*DocumentProof, err
 func (s service) CreateProofsForVersion(ctx context.Context, documentID, version []byte, fields []string) (*DocumentProof, error) {
 	model, err := s.getVersion(ctx, documentID, version)
 	if err != nil {
-		return nil, errors.NewTypedError(ErrDocumentNotFound, err)
+		// versions preceding the version the account joined the document with are known by their anchored roots only
+		model, err = s.fetcher.FetchVersion(ctx, documentID, version)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrDocumentNotFound, errors.NewTypedError(ErrDocumentVersionNotFound, err))
+		}
 	}
 	return s.createProofs(model, fields)
 }
 
-func (s service) RequestDocumentSignature(ctx context.Context, model Model, collaborator identity.DID) (*coredocumentpb.Signature, error) {
+func (s service) RequestDocumentSignature(ctx context.Context, model Model, collaborator identity.DID) ([]*coredocumentpb.Signature, error) {
 	acc, err := contextutil.Account(ctx)
 	if err != nil {
 		return nil, ErrDocumentConfigAccountID
@@ -172,7 +408,10 @@ func (s service) RequestDocumentSignature(ctx context.Context, model Model, coll
 		}
 	}
 
-	if err := RequestDocumentSignatureValidator(s.idService, collaborator).Validate(old, model); err != nil {
+	received := s.receivedCoreDocument(model)
+	rv := withRegisteredValidators(RequestDocumentSignatureValidator(s.idService, collaborator), s.registry, model, ValidationStageSignature)
+	if err := rv.Validate(old, model); err != nil {
+		s.recordValidationFailure(did, forensics.OperationRequestDocumentSignature, received, model, collaborator, "RequestDocumentSignatureValidator", err)
 		return nil, errors.NewTypedError(ErrDocumentInvalid, err)
 	}
 
@@ -183,28 +422,77 @@ func (s service) RequestDocumentSignature(ctx context.Context, model Model, coll
 
 	srvLog.Infof("document received %x with signing root %x", model.ID(), sr)
 
-	sig, err := acc.SignMsg(sr)
+	sigs, err := acc.SignMsgWithApprovers(sr)
 	if err != nil {
 		return nil, err
 	}
-	model.AppendSignatures(sig)
-
-	// Logic for receiving version n (n > 1) of the document for the first time
-	// TODO(ved): we should not save the new model with old identifier. We should sync from the peer.
-	if !s.repo.Exists(did[:], model.ID()) && !utils.IsSameByteSlice(model.ID(), model.CurrentVersion()) {
-		err = s.repo.Create(did[:], model.ID(), model)
-		if err != nil {
-			return nil, errors.NewTypedError(ErrDocumentPersistence, err)
+	model.AppendSignatures(sigs...)
+
+	// both the writes should either go through or none
+	err = s.repo.Atomic(func(repo Repository) error {
+		// Logic for receiving version n (n > 1) of the document for the first time
+		// TODO(ved): we should not save the new model with old identifier. We should sync from the peer.
+		if !repo.Exists(did[:], model.ID()) && !utils.IsSameByteSlice(model.ID(), model.CurrentVersion()) {
+			err := repo.Create(did[:], model.ID(), model)
+			if err != nil {
+				return err
+			}
 		}
-	}
 
-	err = s.repo.Create(did[:], model.CurrentVersion(), model)
+		return repo.Create(did[:], model.CurrentVersion(), model)
+	})
 	if err != nil {
 		return nil, errors.NewTypedError(ErrDocumentPersistence, err)
 	}
 
 	srvLog.Infof("signed document %x with version %x", model.ID(), model.CurrentVersion())
-	return sig, nil
+	return sigs, nil
+}
+
+// waitForAnchor checks the anchor of the model, retrying during the grace period while the anchor is not yet mined.
+func (s service) waitForAnchor(ctx context.Context, model Model) error {
+	deadline := time.Now().Add(s.anchorGracePeriod)
+	for {
+		err := checkAnchor(s.anchorRepository, model)
+		if err == nil || !errors.IsOfType(ErrDocumentAnchorNotMined, err) || !time.Now().Add(anchorCheckInterval).Before(deadline) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(anchorCheckInterval):
+		}
+	}
+}
+
+func (s service) CheckAnchoredDocument(ctx context.Context, model Model, collaborator identity.DID) error {
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		return ErrDocumentConfigAccountID
+	}
+
+	idBytes, err := acc.GetIdentityID()
+	if err != nil {
+		return err
+	}
+
+	if model == nil {
+		return ErrDocumentNil
+	}
+
+	received := s.receivedCoreDocument(model)
+	return s.checkReceivedAnchor(ctx, identity.NewDIDFromBytes(idBytes), received, model, collaborator)
+}
+
+// checkReceivedAnchor waits for the anchor of the received model and records the root mismatches.
+func (s service) checkReceivedAnchor(ctx context.Context, did identity.DID, received *coredocumentpb.CoreDocument, model Model, collaborator identity.DID) error {
+	err := s.waitForAnchor(ctx, model)
+	if err != nil && errors.IsOfType(ErrDocumentAnchorRootMismatch, err) {
+		s.recordValidationFailure(did, forensics.OperationReceiveAnchoredDocument, received, model, collaborator, "anchoredValidator", err)
+	}
+
+	return err
 }
 
 func (s service) ReceiveAnchoredDocument(ctx context.Context, model Model, collaborator identity.DID) error {
@@ -223,43 +511,46 @@ func (s service) ReceiveAnchoredDocument(ctx context.Context, model Model, colla
 		return ErrDocumentNil
 	}
 
+	// fast path to reject documents whose anchor is missing or holds a different root before the full validation
+	received := s.receivedCoreDocument(model)
+	err = s.checkReceivedAnchor(ctx, did, received, model, collaborator)
+	if err != nil {
+		return err
+	}
+
 	var old Model
 	// lets pick the old version of the document from the repo and pass this to the validator
 	if !utils.IsEmptyByteSlice(model.PreviousVersion()) {
 		old, err = s.repo.Get(did[:], model.PreviousVersion())
 		if err != nil {
-			// TODO(ved): we should pull the old document from the peer
+			// the prior versions are synced from the author by the post-receive hook
 			log.Infof("failed to fetch previous document: %v", err)
 		}
 	}
 
-	if err := ReceivedAnchoredDocumentValidator(s.idService, s.anchorRepository, collaborator).Validate(old, model); err != nil {
+	rv := withRegisteredValidators(ReceivedAnchoredDocumentValidator(s.idService, s.anchorRepository, collaborator, s.anchorTimestampTolerance), s.registry, model, ValidationStagePostAnchored)
+	if err := rv.Validate(old, model); err != nil {
+		s.recordValidationFailure(did, forensics.OperationReceiveAnchoredDocument, received, model, collaborator, "ReceivedAnchoredDocumentValidator", err)
 		return errors.NewTypedError(ErrDocumentInvalid, err)
 	}
 
+	// received versions are anchored already
+	model.SetStatus(StatusCommitted)
 	err = s.repo.Update(did[:], model.CurrentVersion(), model)
 	if err != nil {
 		return errors.NewTypedError(ErrDocumentPersistence, err)
 	}
 
-	ts, err := utils.ToTimestamp(time.Now().UTC())
-	if err != nil {
-		return errors.NewTypedError(ErrDocumentNotification, err)
-	}
+	event := NewEvent(EventReceived, model)
+	event.Collaborator = &collaborator
+	s.EmitEvent(ctx, event)
 
-	notificationMsg := &notificationpb.NotificationMessage{
-		EventType:    uint32(notification.ReceivedPayload),
-		AccountId:    did.String(),
-		FromId:       hexutil.Encode(collaborator[:]),
-		ToId:         did.String(),
-		Recorded:     ts,
-		DocumentType: model.DocumentType(),
-		DocumentId:   hexutil.Encode(model.ID()),
+	// the document is already persisted, failing hooks must not reject it
+	err = s.RunHooks(ctx, HookPostReceive, model)
+	if err != nil {
+		srvLog.Errorf("failed to run post-receive hooks for document %x: %v", model.ID(), err)
 	}
 
-	// Async until we add queuing
-	go s.notifier.Send(ctx, notificationMsg)
-
 	return nil
 }
 
@@ -327,6 +618,117 @@ func (s service) Update(ctx context.Context, model Model) (Model, transactions.T
 	return srv.Update(ctx, model)
 }
 
+func (s service) CreateDraft(ctx context.Context, model Model) (Model, error) {
+	srv, err := s.getService(model)
+	if err != nil {
+		return nil, errors.New("failed to get service: %v", err)
+	}
+
+	return srv.CreateDraft(ctx, model)
+}
+
+func (s service) UpdateDraft(ctx context.Context, model Model) (Model, error) {
+	srv, err := s.getService(model)
+	if err != nil {
+		return nil, errors.New("failed to get service: %v", err)
+	}
+
+	return srv.UpdateDraft(ctx, model)
+}
+
+func (s service) Commit(ctx context.Context, documentID []byte) (Model, transactions.TxID, chan bool, error) {
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	srv, err := s.getService(model)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.New("failed to get service: %v", err)
+	}
+
+	return srv.Commit(ctx, documentID)
+}
+
+// LinkDocument links the document root of the latest version of the linked document to the document.
+// Returns ErrDocumentAnchorNotMined if the latest version of the linked document is not anchored yet.
+func (s service) LinkDocument(ctx context.Context, documentID, linkedID []byte) (Model, transactions.TxID, chan bool, error) {
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	linked, err := s.GetCurrentVersion(ctx, linkedID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	err = checkAnchor(s.anchorRepository, linked)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	root, err := linked.CalculateDocumentRoot()
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.New("failed to get document root of the linked document: %v", err)
+	}
+
+	err = model.LinkDocument(linkedID, root)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return s.Update(ctx, model)
+}
+
+// RemoveCollaborators removes the collaborators from the read and transition rules of the next version of the document.
+// The account can't remove itself from the document.
+func (s service) RemoveCollaborators(ctx context.Context, documentID []byte, collaborators []identity.DID) (Model, transactions.TxID, chan bool, error) {
+	self, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentConfigAccountID, err)
+	}
+
+	for _, c := range collaborators {
+		if c.Equal(self) {
+			return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, errors.New("account can't remove itself from the document"))
+		}
+	}
+
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	err = model.RemoveCollaborators(collaborators)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return s.Update(ctx, model)
+}
+
+// AddNFT adds the NFT minted in the registry, with a contract other than the NFT service of the node, to the next version
+// of the document. If grantReadAccess is true, the owner of the token can read the document.
+func (s service) AddNFT(ctx context.Context, documentID []byte, grantReadAccess bool, registry common.Address, tokenID []byte) (Model, transactions.TxID, chan bool, error) {
+	err := identifiers.Validate(tokenID, identifiers.TokenIDLength)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	err = model.AddNFT(grantReadAccess, registry, tokenID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return s.Update(ctx, model)
+}
+
 func (s service) getService(model Model) (Service, error) {
 	return s.registry.LocateService(model.DocumentType())
 }
@@ -0,0 +1,209 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SignatureKind is what a signature of the account was produced over.
+type SignatureKind string
+
+const (
+	// SignatureKindDocument is a signature over the signing root of a document version.
+	SignatureKindDocument SignatureKind = "document"
+
+	// SignatureKindAttribute is a signature over a single attribute of a document.
+	SignatureKindAttribute SignatureKind = "attribute"
+)
+
+// AuditedSignature is a signature produced by the account over a document version or one of its attributes.
+type AuditedSignature struct {
+	Kind         SignatureKind
+	Timestamp    time.Time
+	DocumentID   []byte
+	Version      []byte
+	DocumentType string
+
+	// SigningRoot is set for the signatures of the document versions
+	SigningRoot []byte
+
+	// Property is set for the signatures of the attributes
+	Property string
+
+	PublicKey []byte
+	Signature []byte
+}
+
+// signatureAuditEntry is the exported form of an audited signature.
+type signatureAuditEntry struct {
+	Kind         string `json:"kind"`
+	Timestamp    string `json:"timestamp"`
+	DocumentID   string `json:"document_id"`
+	VersionID    string `json:"version_id"`
+	DocumentType string `json:"document_type"`
+	SigningRoot  string `json:"signing_root,omitempty"`
+	Property     string `json:"property,omitempty"`
+	PublicKey    string `json:"public_key"`
+	Signature    string `json:"signature"`
+}
+
+// signatureAuditHeader names the columns of the CSV export in the order of signatureAuditEntry.record.
+var signatureAuditHeader = []string{"kind", "timestamp", "document_id", "version_id", "document_type", "signing_root", "property", "public_key", "signature"}
+
+func newSignatureAuditEntry(s AuditedSignature) signatureAuditEntry {
+	e := signatureAuditEntry{
+		Kind:         string(s.Kind),
+		Timestamp:    s.Timestamp.UTC().Format(time.RFC3339),
+		DocumentID:   hexutil.Encode(s.DocumentID),
+		VersionID:    hexutil.Encode(s.Version),
+		DocumentType: s.DocumentType,
+		Property:     s.Property,
+		PublicKey:    hexutil.Encode(s.PublicKey),
+		Signature:    hexutil.Encode(s.Signature),
+	}
+
+	if len(s.SigningRoot) > 0 {
+		e.SigningRoot = hexutil.Encode(s.SigningRoot)
+	}
+
+	return e
+}
+
+func (e signatureAuditEntry) record() []string {
+	return []string{e.Kind, e.Timestamp, e.DocumentID, e.VersionID, e.DocumentType, e.SigningRoot, e.Property, e.PublicKey, e.Signature}
+}
+
+// ExportSignatureAudit returns the signatures produced by accountID over the stored document versions and their
+// attributes, timestamped within [from, to) and ordered by time. A zero from or to leaves the range open on that side.
+// The signatures of the document versions take the timestamp of the version. The signatures of the approver keys of the
+// account are part of the export. An attribute signature carried over by the later versions of the document is exported
+// once.
+func (s service) ExportSignatureAudit(ctx context.Context, accountID identity.DID, from, to time.Time) ([]AuditedSignature, error) {
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return nil, errors.NewTypedError(ErrSignatureAudit, errors.New("start of the range must be before its end"))
+	}
+
+	inRange := func(ts time.Time) bool {
+		return (from.IsZero() || !ts.Before(from)) && (to.IsZero() || ts.Before(to))
+	}
+
+	var sigs []AuditedSignature
+	seen := make(map[string]bool)
+	err := s.repo.Iterate(accountID[:], func(model Model) error {
+		versionTS, err := model.Timestamp()
+		if err != nil {
+			return nil
+		}
+
+		audited := func(kind SignatureKind, ts time.Time, publicKey, signature []byte) AuditedSignature {
+			return AuditedSignature{
+				Kind:         kind,
+				Timestamp:    ts,
+				DocumentID:   model.ID(),
+				Version:      model.CurrentVersion(),
+				DocumentType: model.DocumentType(),
+				PublicKey:    publicKey,
+				Signature:    signature,
+			}
+		}
+
+		for _, sig := range model.Signatures() {
+			if !identity.NewDIDFromBytes(sig.SignerId).Equal(accountID) || seen[string(sig.Signature)] {
+				continue
+			}
+
+			if !inRange(versionTS) {
+				continue
+			}
+
+			sr, err := model.CalculateSigningRoot()
+			if err != nil {
+				return errors.NewTypedError(ErrSignatureAudit, errors.New("failed to get signing root of %s: %v", hexutil.Encode(model.CurrentVersion()), err))
+			}
+
+			seen[string(sig.Signature)] = true
+			as := audited(SignatureKindDocument, versionTS, sig.PublicKey, sig.Signature)
+			as.SigningRoot = sr
+			sigs = append(sigs, as)
+		}
+
+		as, ok := model.(attributeSigner)
+		if !ok {
+			return nil
+		}
+
+		for _, sig := range as.AttributeSignatures() {
+			if !identity.NewDIDFromBytes(sig.SignerId).Equal(accountID) || seen[string(sig.Signature)] {
+				continue
+			}
+
+			ts, err := utils.FromTimestamp(sig.Timestamp)
+			if err != nil || !inRange(ts) {
+				continue
+			}
+
+			seen[string(sig.Signature)] = true
+			as := audited(SignatureKindAttribute, ts, sig.PublicKey, sig.Signature)
+			as.Property = sig.Property
+			sigs = append(sigs, as)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(sigs, func(i, j int) bool {
+		if !sigs[i].Timestamp.Equal(sigs[j].Timestamp) {
+			return sigs[i].Timestamp.Before(sigs[j].Timestamp)
+		}
+
+		return bytes.Compare(sigs[i].Signature, sigs[j].Signature) < 0
+	})
+
+	return sigs, nil
+}
+
+// RenderSignatureAudit renders the audited signatures in one of the ledger formats.
+func RenderSignatureAudit(sigs []AuditedSignature, format LedgerFormat) ([]byte, error) {
+	entries := make([]signatureAuditEntry, len(sigs))
+	for i, s := range sigs {
+		entries[i] = newSignatureAuditEntry(s)
+	}
+
+	switch format {
+	case LedgerFormatJSON:
+		data, err := json.Marshal(entries)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrSignatureAudit, err)
+		}
+
+		return data, nil
+	case LedgerFormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		records := [][]string{signatureAuditHeader}
+		for _, e := range entries {
+			records = append(records, e.record())
+		}
+
+		err := w.WriteAll(records)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrSignatureAudit, err)
+		}
+
+		return buf.Bytes(), nil
+	default:
+		return nil, errors.NewTypedError(ErrSignatureAudit, errors.New("unknown format %q", format))
+	}
+}
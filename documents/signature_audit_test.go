@@ -0,0 +1,150 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type auditModel struct {
+	Model
+	id, version, signingRoot []byte
+	timestamp                time.Time
+	signatures               []coredocumentpb.Signature
+	attributeSignatures      []*coredocumentextpb.AttributeSignature
+}
+
+func (m *auditModel) ID() []byte                             { return m.id }
+func (m *auditModel) CurrentVersion() []byte                 { return m.version }
+func (m *auditModel) DocumentType() string                   { return "audit document" }
+func (m *auditModel) Timestamp() (time.Time, error)          { return m.timestamp, nil }
+func (m *auditModel) Signatures() []coredocumentpb.Signature { return m.signatures }
+func (m *auditModel) CalculateSigningRoot() ([]byte, error)  { return m.signingRoot, nil }
+func (m *auditModel) AttributeSignatures() []*coredocumentextpb.AttributeSignature {
+	return m.attributeSignatures
+}
+
+type auditRepo struct {
+	Repository
+	models []*auditModel
+}
+
+func (r *auditRepo) Iterate(accountID []byte, fn func(model Model) error) error {
+	for _, m := range r.models {
+		err := fn(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestService_ExportSignatureAudit(t *testing.T) {
+	day := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	accountID := testingidentity.GenerateRandomDID()
+	other := testingidentity.GenerateRandomDID()
+	ts, err := utils.ToTimestamp(day.Add(5 * time.Hour))
+	assert.NoError(t, err)
+	attrSig := &coredocumentextpb.AttributeSignature{SignerId: accountID[:], PublicKey: []byte{3}, Property: "invoice.amount", Timestamp: ts, Signature: []byte{30}}
+	models := []*auditModel{
+		// signed by the account and its approver key, and by a collaborator
+		{id: []byte{1}, version: []byte{1}, signingRoot: []byte{100}, timestamp: day.Add(time.Hour), signatures: []coredocumentpb.Signature{
+			{SignerId: accountID[:], PublicKey: []byte{1}, Signature: []byte{10}},
+			{SignerId: accountID[:], PublicKey: []byte{2}, Signature: []byte{11}},
+			{SignerId: other[:], PublicKey: []byte{9}, Signature: []byte{90}},
+		}},
+		// attribute signed by the account, carried over by the next version
+		{id: []byte{1}, version: []byte{2}, signingRoot: []byte{101}, timestamp: day.Add(26 * time.Hour), attributeSignatures: []*coredocumentextpb.AttributeSignature{attrSig}},
+		{id: []byte{1}, version: []byte{3}, signingRoot: []byte{102}, timestamp: day.Add(27 * time.Hour), attributeSignatures: []*coredocumentextpb.AttributeSignature{attrSig}},
+		// signed by the collaborator only
+		{id: []byte{2}, version: []byte{4}, signingRoot: []byte{103}, timestamp: day, signatures: []coredocumentpb.Signature{
+			{SignerId: other[:], PublicKey: []byte{9}, Signature: []byte{91}},
+		}},
+	}
+	srv := service{repo: &auditRepo{models: models}}
+
+	// empty range
+	_, err = srv.ExportSignatureAudit(context.Background(), accountID, day, day)
+	assert.True(t, errors.IsOfType(ErrSignatureAudit, err))
+
+	// open range
+	sigs, err := srv.ExportSignatureAudit(context.Background(), accountID, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Len(t, sigs, 3)
+	assert.Equal(t, AuditedSignature{
+		Kind:         SignatureKindDocument,
+		Timestamp:    day.Add(time.Hour),
+		DocumentID:   []byte{1},
+		Version:      []byte{1},
+		DocumentType: "audit document",
+		SigningRoot:  []byte{100},
+		PublicKey:    []byte{1},
+		Signature:    []byte{10},
+	}, sigs[0])
+	assert.Equal(t, []byte{11}, sigs[1].Signature)
+	assert.Equal(t, SignatureKindAttribute, sigs[2].Kind)
+	assert.Equal(t, day.Add(5*time.Hour), sigs[2].Timestamp)
+	assert.Equal(t, "invoice.amount", sigs[2].Property)
+	assert.Empty(t, sigs[2].SigningRoot)
+
+	// second day
+	sigs, err = srv.ExportSignatureAudit(context.Background(), accountID, day.AddDate(0, 0, 1), day.AddDate(0, 0, 2))
+	assert.NoError(t, err)
+	assert.Empty(t, sigs)
+}
+
+func TestRenderSignatureAudit(t *testing.T) {
+	sigs := []AuditedSignature{{
+		Kind:         SignatureKindDocument,
+		Timestamp:    time.Date(2019, 1, 1, 2, 0, 0, 0, time.FixedZone("CET", 3600)),
+		DocumentID:   []byte{1},
+		Version:      []byte{11},
+		DocumentType: "invoice",
+		SigningRoot:  []byte{100},
+		PublicKey:    []byte{1},
+		Signature:    []byte{10},
+	}, {
+		Kind:         SignatureKindAttribute,
+		Timestamp:    time.Date(2019, 1, 1, 3, 0, 0, 0, time.UTC),
+		DocumentID:   []byte{1},
+		Version:      []byte{12},
+		DocumentType: "invoice",
+		Property:     "invoice.amount",
+		PublicKey:    []byte{1},
+		Signature:    []byte{20},
+	}}
+
+	data, err := RenderSignatureAudit(sigs, LedgerFormatCSV)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"kind,timestamp,document_id,version_id,document_type,signing_root,property,public_key,signature",
+		"document,2019-01-01T01:00:00Z,0x01,0x0b,invoice,0x64,,0x01,0x0a",
+		"attribute,2019-01-01T03:00:00Z,0x01,0x0c,invoice,,invoice.amount,0x01,0x14",
+		"",
+	}, "\n"), string(data))
+
+	data, err = RenderSignatureAudit(sigs, LedgerFormatJSON)
+	assert.NoError(t, err)
+	var entries []map[string]string
+	assert.NoError(t, json.Unmarshal(data, &entries))
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "0x64", entries[0]["signing_root"])
+	_, ok := entries[0]["property"]
+	assert.False(t, ok)
+	assert.Equal(t, "invoice.amount", entries[1]["property"])
+
+	_, err = RenderSignatureAudit(sigs, LedgerFormat("xml"))
+	assert.True(t, errors.IsOfType(ErrSignatureAudit, err))
+}
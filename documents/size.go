@@ -0,0 +1,155 @@
+package documents
+
+import (
+	"context"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/golang/protobuf/proto"
+)
+
+// VersionSize holds the storage footprint of a single document version.
+type VersionSize struct {
+	VersionID []byte
+
+	// Bytes is the size of the serialised core document, including the embedded data.
+	Bytes             int
+	EmbeddedDataBytes int
+	SignaturesBytes   int
+	SaltsBytes        int
+
+	// leaf counts are taken from the trees of the model, or from the salts listing a salt per leaf.
+	CoreDocumentLeaves int
+	EmbeddedDataLeaves int
+}
+
+// DocumentSize holds the storage footprint of the locally known versions of a document.
+type DocumentSize struct {
+	DocumentID []byte
+	TotalBytes int
+
+	// MaxMessageBytes is the maximum size of a p2p message.
+	MaxMessageBytes int
+
+	// Versions starting with the latest version.
+	Versions []VersionSize
+}
+
+// saltsSize returns the total bytes used by the salts.
+func saltsSize(salts []*coredocumentpb.DocumentSalt) (size int) {
+	for _, s := range salts {
+		size += len(s.Value) + len(s.Compact)
+	}
+
+	return size
+}
+
+// saltedLeaves returns the number of leaves the salts list a salt for.
+func saltedLeaves(salts []*coredocumentpb.DocumentSalt) int {
+	if saltSecret(ConvertToProofSalts(salts)) != nil {
+		return 0
+	}
+
+	return len(salts)
+}
+
+// coreDocumentLeavesCounter is implemented by the models embedding the core document.
+type coreDocumentLeavesCounter interface {
+	coreDocumentLeaves(docType string) (int, error)
+}
+
+// dataTreeLeavesCounter is implemented by the models that can count the leaves of their data tree.
+type dataTreeLeavesCounter interface {
+	DataTreeLeaves() (int, error)
+}
+
+// treeLeaves counts the leaves of the trees of the model. The salts derived from a salt secret don't list the leaves,
+// so the leaves are counted on the trees of the models that can build them.
+func treeLeaves(model Model, cd coredocumentpb.CoreDocument) (cdLeaves, dataLeaves int, err error) {
+	cdLeaves, dataLeaves = saltedLeaves(cd.CoredocumentSalts), saltedLeaves(cd.EmbeddedDataSalts)
+	if cl, ok := model.(coreDocumentLeavesCounter); ok {
+		cdLeaves, err = cl.coreDocumentLeaves(model.DocumentType())
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if dl, ok := model.(dataTreeLeavesCounter); ok {
+		dataLeaves, err = dl.DataTreeLeaves()
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return cdLeaves, dataLeaves, nil
+}
+
+// GetVersionSize calculates the storage footprint of the model.
+func GetVersionSize(model Model) (VersionSize, error) {
+	cd, err := model.PackCoreDocument()
+	if err != nil {
+		return VersionSize{}, errors.NewTypedError(ErrDocumentPackingCoreDocument, err)
+	}
+
+	cdLeaves, dataLeaves, err := treeLeaves(model, cd)
+	if err != nil {
+		return VersionSize{}, err
+	}
+
+	vs := VersionSize{
+		VersionID:          cd.CurrentVersion,
+		Bytes:              proto.Size(&cd),
+		SaltsBytes:         saltsSize(cd.CoredocumentSalts) + saltsSize(cd.EmbeddedDataSalts) + saltsSize(cd.SignatureDataSalts),
+		CoreDocumentLeaves: cdLeaves,
+		EmbeddedDataLeaves: dataLeaves,
+	}
+
+	if cd.EmbeddedData != nil {
+		vs.EmbeddedDataBytes = proto.Size(cd.EmbeddedData)
+	}
+
+	if cd.SignatureData != nil {
+		vs.SignaturesBytes = proto.Size(cd.SignatureData)
+	}
+
+	return vs, nil
+}
+
+// GetDocumentSize walks the locally known versions of the document, starting from the latest,
+// and reports the storage footprint of each version.
+func (s service) GetDocumentSize(ctx context.Context, documentID []byte) (*DocumentSize, error) {
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := &DocumentSize{
+		DocumentID:      model.ID(),
+		MaxMessageBytes: p2pcommon.MessageSizeMax,
+	}
+
+	for {
+		vs, err := GetVersionSize(model)
+		if err != nil {
+			return nil, err
+		}
+
+		ds.Versions = append(ds.Versions, vs)
+		ds.TotalBytes += vs.Bytes
+		prev := model.PreviousVersion()
+		if utils.IsEmptyByteSlice(prev) {
+			break
+		}
+
+		model, err = s.getVersion(ctx, documentID, prev)
+		if err != nil {
+			// older versions are not available locally
+			srvLog.Infof("failed to fetch version %x of document %x: %v", prev, documentID, err)
+			break
+		}
+	}
+
+	return ds, nil
+}
@@ -0,0 +1,29 @@
+package documents
+
+// Status is the lifecycle status of a document version stored in the repository.
+type Status string
+
+const (
+	// StatusDraft is the status of a version saved locally that is not anchored yet.
+	StatusDraft Status = "draft"
+
+	// StatusCommitting is the status of a version going through the anchoring stages.
+	StatusCommitting Status = "committing"
+
+	// StatusCommitted is the status of an anchored version.
+	StatusCommitted Status = "committed"
+
+	// StatusFailed is the status of a version that failed to be anchored.
+	StatusFailed Status = "failed"
+)
+
+// GetStatus returns the lifecycle status of the document version.
+// Versions stored before the status was tracked have an empty status.
+func (cd *CoreDocument) GetStatus() Status {
+	return cd.DocumentStatus
+}
+
+// SetStatus sets the lifecycle status of the document version.
+func (cd *CoreDocument) SetStatus(status Status) {
+	cd.DocumentStatus = status
+}
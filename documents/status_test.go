@@ -0,0 +1,39 @@
+// +build unit
+
+package documents
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoreDocument_Status(t *testing.T) {
+	cd, err := newCoreDocument()
+	assert.NoError(t, err)
+	assert.Equal(t, StatusDraft, cd.GetStatus())
+
+	cd.SetStatus(StatusCommitted)
+	assert.Equal(t, StatusCommitted, cd.GetStatus())
+
+	// status is persisted with the model
+	data, err := json.Marshal(cd)
+	assert.NoError(t, err)
+	ncd := new(CoreDocument)
+	assert.NoError(t, json.Unmarshal(data, ncd))
+	assert.Equal(t, StatusCommitted, ncd.GetStatus())
+
+	// new versions are drafts
+	cd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	ncd, err = cd.PrepareNewVersion(CollaboratorsAccess{}, false, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusDraft, ncd.GetStatus())
+	assert.Equal(t, StatusCommitted, cd.GetStatus())
+
+	// received documents have no status until stored
+	cd, err = NewCoreDocumentFromProtobuf(cd.Document)
+	assert.NoError(t, err)
+	assert.Empty(t, cd.GetStatus())
+}
@@ -0,0 +1,187 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// SummaryFormat is the format a document summary is rendered in.
+type SummaryFormat string
+
+const (
+	// SummaryFormatJSON renders the summary as a JSON object.
+	SummaryFormatJSON SummaryFormat = "json"
+
+	// SummaryFormatHTML renders the summary as an HTML fragment.
+	SummaryFormatHTML SummaryFormat = "html"
+)
+
+// SummaryField is a key field of a document shown in its summary.
+type SummaryField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Summarizer is implemented by the models that can describe their status and key fields.
+type Summarizer interface {
+	// SummaryStatus returns the status of the document version.
+	SummaryStatus() string
+
+	// SummaryFields returns the key fields of the document version in display order.
+	// Empty fields are skipped.
+	SummaryFields() []SummaryField
+}
+
+// AppendSummaryField appends the field to the summary fields if the value is not empty.
+func AppendSummaryField(fields []SummaryField, name, value string) []SummaryField {
+	if value == "" {
+		return fields
+	}
+
+	return append(fields, SummaryField{Name: name, Value: value})
+}
+
+// SummaryDate formats the date of a summary field. Returns an empty string if the date is not set.
+func SummaryDate(ts *timestamp.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+
+	t, err := utils.FromTimestamp(ts)
+	if err != nil {
+		return ""
+	}
+
+	return t.UTC().Format("2006-01-02")
+}
+
+// Summary is a compact description of a document version.
+type Summary struct {
+	DocumentID    string         `json:"document_id"`
+	VersionID     string         `json:"version_id"`
+	DocumentType  string         `json:"document_type"`
+	Status        string         `json:"status,omitempty"`
+	Author        string         `json:"author,omitempty"`
+	Timestamp     string         `json:"timestamp,omitempty"`
+	Fields        []SummaryField `json:"fields,omitempty"`
+	Collaborators []string       `json:"collaborators"`
+	AnchorID      string         `json:"anchor_id"`
+	AnchorLink    string         `json:"anchor_link,omitempty"`
+}
+
+var summaryTemplate = template.Must(template.New("summary").Parse(`<div class="document-summary">
+<h3>{{.DocumentType}}</h3>
+<dl>
+<dt>Document</dt><dd>{{.DocumentID}}</dd>
+<dt>Version</dt><dd>{{.VersionID}}</dd>
+{{- if .Status}}
+<dt>Status</dt><dd>{{.Status}}</dd>
+{{- end}}
+{{- if .Author}}
+<dt>Author</dt><dd>{{.Author}}</dd>
+{{- end}}
+{{- if .Timestamp}}
+<dt>Updated</dt><dd>{{.Timestamp}}</dd>
+{{- end}}
+{{- range .Fields}}
+<dt>{{.Name}}</dt><dd>{{.Value}}</dd>
+{{- end}}
+</dl>
+<ul class="collaborators">
+{{- range .Collaborators}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+<p class="anchor">{{if .AnchorLink}}<a href="{{.AnchorLink}}">{{.AnchorID}}</a>{{else}}{{.AnchorID}}{{end}}</p>
+</div>
+`))
+
+// NewSummary creates the summary of the model.
+// anchorLinkFormat is the format of the link to the anchor of the version, %s is replaced with the anchor ID.
+// The link is omitted if the format is empty.
+func NewSummary(model Model, anchorLinkFormat string) (*Summary, error) {
+	anchorID, err := anchors.ToAnchorID(model.CurrentVersion())
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentSummary, errors.New("failed to get anchorID: %v", err))
+	}
+
+	collaborators, err := model.GetCollaborators()
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentSummary, errors.New("failed to get collaborators: %v", err))
+	}
+
+	s := &Summary{
+		DocumentID:    hexutil.Encode(model.ID()),
+		VersionID:     hexutil.Encode(model.CurrentVersion()),
+		DocumentType:  model.DocumentType(),
+		Collaborators: make([]string, len(collaborators)),
+		AnchorID:      anchorID.String(),
+	}
+
+	for i, c := range collaborators {
+		s.Collaborators[i] = c.String()
+	}
+
+	if author := model.Author(); !utils.IsEmptyByteSlice(author[:]) {
+		s.Author = author.String()
+	}
+
+	// versions without an update log don't have a timestamp
+	if ts, err := model.Timestamp(); err == nil {
+		s.Timestamp = ts.UTC().Format(time.RFC3339)
+	}
+
+	if sm, ok := model.(Summarizer); ok {
+		s.Status = sm.SummaryStatus()
+		s.Fields = sm.SummaryFields()
+	}
+
+	if anchorLinkFormat != "" {
+		s.AnchorLink = fmt.Sprintf(anchorLinkFormat, s.AnchorID)
+	}
+
+	return s, nil
+}
+
+// RenderSummary renders the summary in the given format.
+func RenderSummary(s *Summary, format SummaryFormat) ([]byte, error) {
+	switch format {
+	case SummaryFormatJSON:
+		data, err := json.Marshal(s)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrDocumentSummary, err)
+		}
+
+		return data, nil
+	case SummaryFormatHTML:
+		var buf bytes.Buffer
+		err := summaryTemplate.Execute(&buf, s)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrDocumentSummary, err)
+		}
+
+		return buf.Bytes(), nil
+	default:
+		return nil, errors.NewTypedError(ErrDocumentSummary, errors.New("unknown format %q", format))
+	}
+}
+
+// GetVersionSummary returns the summary of a particular version of the document.
+func (s service) GetVersionSummary(ctx context.Context, documentID, version []byte) (*Summary, error) {
+	model, err := s.GetVersion(ctx, documentID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSummary(model, s.anchorLinkFormat)
+}
@@ -0,0 +1,114 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewSummary(t *testing.T) {
+	// invalid version
+	model := new(mockModel)
+	model.On("CurrentVersion").Return(nil).Once()
+	_, err := NewSummary(model, "")
+	model.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentSummary, err))
+
+	// failed collaborators
+	version := utils.RandomSlice(32)
+	model = new(mockModel)
+	model.On("CurrentVersion").Return(version).Once()
+	model.On("GetCollaborators", mock.Anything).Return(nil, errors.New("error")).Once()
+	_, err = NewSummary(model, "")
+	model.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentSummary, err))
+
+	// success without timestamp
+	id := utils.RandomSlice(32)
+	author := testingidentity.GenerateRandomDID()
+	collab := testingidentity.GenerateRandomDID()
+	model = new(mockModel)
+	model.On("ID").Return(id).Once()
+	model.On("CurrentVersion").Return(version)
+	model.On("DocumentType").Return("doc_type").Once()
+	model.On("GetCollaborators", mock.Anything).Return([]identity.DID{collab}, nil).Once()
+	model.On("Author").Return(author).Once()
+	model.On("Timestamp").Return(nil, errors.New("no timestamp")).Once()
+	s, err := NewSummary(model, "https://explorer/%s")
+	model.AssertExpectations(t)
+	assert.NoError(t, err)
+	assert.Equal(t, &Summary{
+		DocumentID:    hexutil.Encode(id),
+		VersionID:     hexutil.Encode(version),
+		DocumentType:  "doc_type",
+		Author:        author.String(),
+		Collaborators: []string{collab.String()},
+		AnchorID:      hexutil.Encode(version),
+		AnchorLink:    "https://explorer/" + hexutil.Encode(version),
+	}, s)
+
+	// success with timestamp
+	tm := time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC)
+	model = new(mockModel)
+	model.On("ID").Return(id).Once()
+	model.On("CurrentVersion").Return(version)
+	model.On("DocumentType").Return("doc_type").Once()
+	model.On("GetCollaborators", mock.Anything).Return(nil, nil).Once()
+	model.On("Author").Return(identity.DID{}).Once()
+	model.On("Timestamp").Return(tm, nil).Once()
+	s, err = NewSummary(model, "")
+	model.AssertExpectations(t)
+	assert.NoError(t, err)
+	assert.Empty(t, s.Author)
+	assert.Empty(t, s.AnchorLink)
+	assert.NotNil(t, s.Collaborators)
+	assert.Equal(t, "2019-01-01T12:00:00Z", s.Timestamp)
+}
+
+func TestRenderSummary(t *testing.T) {
+	s := &Summary{
+		DocumentID:   "0x01",
+		VersionID:    "0x02",
+		DocumentType: "doc_type",
+		Fields:       []SummaryField{{Name: "Name", Value: "<script>"}},
+		AnchorID:     "0x02",
+		AnchorLink:   "javascript:alert(1)",
+	}
+
+	// unknown format
+	_, err := RenderSummary(s, "pdf")
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentSummary, err))
+
+	data, err := RenderSummary(s, SummaryFormatJSON)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"document_id":"0x01","version_id":"0x02","document_type":"doc_type","fields":[{"name":"Name","value":"\u003cscript\u003e"}],"collaborators":null,"anchor_id":"0x02","anchor_link":"javascript:alert(1)"}`, string(data))
+
+	// values are escaped
+	data, err = RenderSummary(s, SummaryFormatHTML)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "<dt>Name</dt><dd>&lt;script&gt;</dd>")
+	assert.NotContains(t, string(data), "javascript:")
+}
+
+func TestSummaryFields(t *testing.T) {
+	fields := AppendSummaryField(nil, "empty", "")
+	assert.Empty(t, fields)
+	fields = AppendSummaryField(fields, "name", "value")
+	assert.Equal(t, []SummaryField{{Name: "name", Value: "value"}}, fields)
+
+	assert.Empty(t, SummaryDate(nil))
+	assert.Equal(t, "2019-01-01", SummaryDate(&timestamp.Timestamp{Seconds: 1546300800}))
+}
@@ -0,0 +1,139 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/transactions"
+)
+
+const (
+	// TagsField is the core document field holding the tags of the document
+	TagsField = "tags"
+
+	// MaxTagLength is the maximum length of a tag in bytes
+	MaxTagLength = 64
+)
+
+// validateTag checks that the tag is not empty, not padded with spaces and not longer than MaxTagLength.
+func validateTag(tag string) error {
+	if tag == "" {
+		return errors.New("empty tag")
+	}
+
+	if strings.TrimSpace(tag) != tag {
+		return errors.New("tag %q is padded with spaces", tag)
+	}
+
+	if len(tag) > MaxTagLength {
+		return errors.New("tag %q is longer than %d bytes", tag, MaxTagLength)
+	}
+
+	return nil
+}
+
+// UpdateTags returns the next version of the core document with the tags added and removed on behalf of the account.
+// Tags already on the document are not added again, tags that are not on the document can't be removed.
+// The changes must be allowed by the transition rules of the account, as they are checked by the collaborators.
+func (cd *CoreDocument) UpdateTags(account identity.DID, docType string, add, remove []string) (*CoreDocument, error) {
+	current := make(map[string]bool)
+	for _, tag := range cd.Extension.Tags {
+		current[tag] = true
+	}
+
+	removed := make(map[string]bool)
+	for _, tag := range remove {
+		if !current[tag] {
+			return nil, errors.New("tag %q is not on the document", tag)
+		}
+
+		removed[tag] = true
+	}
+
+	var tags []string
+	for _, tag := range cd.Extension.Tags {
+		if !removed[tag] {
+			tags = append(tags, tag)
+		}
+	}
+
+	for _, tag := range add {
+		err := validateTag(tag)
+		if err != nil {
+			return nil, err
+		}
+
+		if current[tag] && !removed[tag] {
+			continue
+		}
+
+		current[tag] = true
+		delete(removed, tag)
+		tags = append(tags, tag)
+	}
+
+	if len(removed) == 0 && len(tags) == len(cd.Extension.Tags) {
+		return nil, errors.New("tags are not changed")
+	}
+
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{}, false, nil)
+	if err != nil {
+		return nil, errors.New("failed to prepare new version: %v", err)
+	}
+
+	ncd.Extension.Tags = tags
+	err = ncd.setSalts()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cd.CollaboratorCanUpdate(ncd, account, docType)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentTransition, err)
+	}
+
+	return ncd, nil
+}
+
+// Tags returns the tags of the document.
+func (cd *CoreDocument) Tags() []string {
+	return cd.Extension.Tags
+}
+
+// TagProofFields returns the fields to request to prove the tags of the document.
+func (cd *CoreDocument) TagProofFields() []string {
+	fields := make([]string, len(cd.Extension.Tags))
+	for i := range cd.Extension.Tags {
+		fields[i] = fmt.Sprintf("%s.%s[%d]", CDTreePrefix, TagsField, i)
+	}
+
+	return fields
+}
+
+// UpdateTags adds and removes the tags of the document on behalf of the account and anchors the new version.
+func (s service) UpdateTags(ctx context.Context, documentID []byte, add, remove []string) (Model, transactions.TxID, chan bool, error) {
+	self, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentConfigAccountID, err)
+	}
+
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	err = model.UpdateTags(self, add, remove)
+	if err != nil {
+		if errors.IsOfType(ErrDocumentTransition, err) {
+			return nil, transactions.NilTxID(), nil, err
+		}
+
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return s.Update(ctx, model)
+}
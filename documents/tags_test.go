@@ -0,0 +1,69 @@
+// +build unit
+
+package documents
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTag(t *testing.T) {
+	assert.NoError(t, validateTag("CC-100"))
+	assert.NoError(t, validateTag("cost center 100"))
+	assert.NoError(t, validateTag(strings.Repeat("a", MaxTagLength)))
+	assert.Error(t, validateTag(""))
+	assert.Error(t, validateTag(" CC-100"))
+	assert.Error(t, validateTag("CC-100\n"))
+	assert.Error(t, validateTag(strings.Repeat("a", MaxTagLength+1)))
+}
+
+func TestCoreDocument_UpdateTags(t *testing.T) {
+	cd, id1, id2, docType := prepareDocument(t)
+	assert.Empty(t, cd.Tags())
+
+	// nothing to change
+	_, err := cd.UpdateTags(id1, docType, nil, nil)
+	assert.Error(t, err)
+
+	// tag not on the document
+	_, err = cd.UpdateTags(id1, docType, nil, []string{"CC-100"})
+	assert.Error(t, err)
+
+	// invalid tag
+	_, err = cd.UpdateTags(id1, docType, []string{" CC-100"}, nil)
+	assert.Error(t, err)
+
+	// id2 can't change the tags
+	_, err = cd.UpdateTags(id2, docType, []string{"CC-100"}, nil)
+	assert.True(t, errors.IsOfType(ErrDocumentTransition, err))
+
+	ncd, err := cd.UpdateTags(id1, docType, []string{"CC-100", "PRJ-7", "CC-100"}, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, cd.Tags())
+	assert.Equal(t, []string{"CC-100", "PRJ-7"}, ncd.Tags())
+	assert.Equal(t, cd.NextVersion(), ncd.CurrentVersion())
+	assert.Equal(t, []string{"cd_tree.tags[0]", "cd_tree.tags[1]"}, ncd.TagProofFields())
+
+	// tags already on the document are not changed
+	ncd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	_, err = ncd.UpdateTags(id1, docType, []string{"PRJ-7"}, nil)
+	assert.Error(t, err)
+
+	// tags are carried over to the next versions
+	nncd, err := ncd.PrepareNewVersion(CollaboratorsAccess{}, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, ncd.Tags(), nncd.Tags())
+
+	// remove and add in one version
+	nncd, err = ncd.UpdateTags(id1, docType, []string{"CC-200"}, []string{"CC-100"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PRJ-7", "CC-200"}, nncd.Tags())
+	assert.Equal(t, []string{"cd_tree.tags[0]", "cd_tree.tags[1]"}, nncd.TagProofFields())
+
+	// previous versions are not changed
+	assert.Equal(t, []string{"CC-100", "PRJ-7"}, ncd.Tags())
+}
@@ -0,0 +1,419 @@
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/transactions"
+)
+
+// templatePrefix is the key prefix of the document templates
+const templatePrefix = "doctemplate_"
+
+// templateNameRegex matches the names of the templates, they are used as path segments of the API
+var templateNameRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,63}$`)
+
+// TemplateAttributeType is the json type of the value of a template attribute.
+type TemplateAttributeType string
+
+const (
+	// TemplateAttributeString is a json string
+	TemplateAttributeString TemplateAttributeType = "string"
+
+	// TemplateAttributeNumber is a json number
+	TemplateAttributeNumber TemplateAttributeType = "number"
+
+	// TemplateAttributeBool is a json boolean
+	TemplateAttributeBool TemplateAttributeType = "bool"
+
+	// TemplateAttributeObject is a json object
+	TemplateAttributeObject TemplateAttributeType = "object"
+
+	// TemplateAttributeArray is a json array
+	TemplateAttributeArray TemplateAttributeType = "array"
+)
+
+// valueType returns the attribute type of the json value.
+func valueType(value json.RawMessage) (TemplateAttributeType, error) {
+	var v interface{}
+	err := json.Unmarshal(value, &v)
+	if err != nil {
+		return "", err
+	}
+
+	switch v.(type) {
+	case string:
+		return TemplateAttributeString, nil
+	case float64:
+		return TemplateAttributeNumber, nil
+	case bool:
+		return TemplateAttributeBool, nil
+	case map[string]interface{}:
+		return TemplateAttributeObject, nil
+	case []interface{}:
+		return TemplateAttributeArray, nil
+	default:
+		return "", errors.New("null value")
+	}
+}
+
+// TemplateAttribute is a top level field of the client data of the documents created from a template.
+type TemplateAttribute struct {
+	Name     string                `json:"name"`
+	Type     TemplateAttributeType `json:"type"`
+	Required bool                  `json:"required"`
+
+	// Default is the json encoded value of the attribute if it is not provided, empty if there is none
+	Default json.RawMessage `json:"default,omitempty"`
+}
+
+// validate checks that the attribute is named, of a known type and that the default value is of the type.
+func (a TemplateAttribute) validate() error {
+	if a.Name == "" {
+		return errors.New("attribute without name")
+	}
+
+	switch a.Type {
+	case TemplateAttributeString, TemplateAttributeNumber, TemplateAttributeBool, TemplateAttributeObject, TemplateAttributeArray:
+	default:
+		return errors.New("attribute %s has unknown type %q", a.Name, a.Type)
+	}
+
+	if len(a.Default) == 0 {
+		return nil
+	}
+
+	tp, err := valueType(a.Default)
+	if err != nil || tp != a.Type {
+		return errors.New("default value of attribute %s is not a %s", a.Name, a.Type)
+	}
+
+	return nil
+}
+
+// Template is a named attribute schema of a document scheme along with the default collaborators and field rules
+// of the documents created from it. Templates are stored per account.
+type Template struct {
+	Name       string              `json:"name"`
+	Scheme     string              `json:"scheme"`
+	Attributes []TemplateAttribute `json:"attributes"`
+
+	// Collaborators are added to every document created from the template
+	Collaborators CollaboratorsAccess `json:"collaborators"`
+
+	// FieldRules restrict the collaborators of the rules to editing the fields of their rules
+	FieldRules []FieldRule `json:"field_rules,omitempty"`
+}
+
+// JSON returns the json encoding of the template.
+func (t *Template) JSON() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+// FromJSON loads the template from its json encoding.
+func (t *Template) FromJSON(data []byte) error {
+	return json.Unmarshal(data, t)
+}
+
+// Type returns the reflect type of the template.
+func (t *Template) Type() reflect.Type {
+	return reflect.TypeOf(t)
+}
+
+// Validate checks the name, attributes, collaborators and field rules of the template.
+// The scheme is checked against the registered document types when the template is saved.
+func (t *Template) Validate() error {
+	if !templateNameRegex.MatchString(t.Name) {
+		return errors.New("invalid template name %q", t.Name)
+	}
+
+	if t.Scheme == "" {
+		return errors.New("template without scheme")
+	}
+
+	seen := make(map[string]bool)
+	for _, a := range t.Attributes {
+		err := a.validate()
+		if err != nil {
+			return err
+		}
+
+		if seen[a.Name] {
+			return errors.New("attribute %s is defined more than once", a.Name)
+		}
+
+		seen[a.Name] = true
+	}
+
+	_, _, err := t.Collaborators.dids()
+	if err != nil {
+		return errors.New("invalid collaborators: %v", err)
+	}
+
+	for _, r := range t.FieldRules {
+		if len(r.Collaborators) == 0 || len(r.Fields) == 0 {
+			return errors.New("field rules must have collaborators and fields")
+		}
+	}
+
+	return nil
+}
+
+// ClientData returns the client data with the default values of the attributes that are not provided.
+// Errors out if the data holds fields that are not attributes of the template, a required attribute is missing,
+// or a value is not of the type of its attribute.
+func (t *Template) ClientData(data []byte) ([]byte, error) {
+	fields := make(map[string]json.RawMessage)
+	if len(data) > 0 {
+		err := json.Unmarshal(data, &fields)
+		if err != nil {
+			return nil, errors.New("malformed client data: %v", err)
+		}
+	}
+
+	attributes := make(map[string]bool)
+	for _, a := range t.Attributes {
+		attributes[a.Name] = true
+		v, ok := fields[a.Name]
+		if !ok {
+			if len(a.Default) > 0 {
+				fields[a.Name] = a.Default
+				continue
+			}
+
+			if a.Required {
+				return nil, errors.New("required attribute %s is missing", a.Name)
+			}
+
+			continue
+		}
+
+		tp, err := valueType(v)
+		if err != nil || tp != a.Type {
+			return nil, errors.New("attribute %s is not a %s", a.Name, a.Type)
+		}
+	}
+
+	for name := range fields {
+		if !attributes[name] {
+			return nil, errors.New("%s is not an attribute of template %s", name, t.Name)
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+// collaborators returns the collaborators of the template along with the given collaborators.
+func (t *Template) collaborators(ca CollaboratorsAccess) CollaboratorsAccess {
+	return CollaboratorsAccess{
+		ReadCollaborators:      appendUnique(t.Collaborators.ReadCollaborators, ca.ReadCollaborators),
+		ReadWriteCollaborators: appendUnique(t.Collaborators.ReadWriteCollaborators, ca.ReadWriteCollaborators),
+	}
+}
+
+// appendUnique returns a copy of a with the elements of b that are not in a.
+func appendUnique(a, b []string) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, s := range append(append([]string(nil), a...), b...) {
+		if seen[s] {
+			continue
+		}
+
+		seen[s] = true
+		out = append(out, s)
+	}
+
+	return out
+}
+
+// TemplateStore persists the document templates of the accounts.
+type TemplateStore struct {
+	repo storage.Repository
+}
+
+// NewTemplateStore registers the template model and returns the store persisting the templates to repo.
+func NewTemplateStore(repo storage.Repository) *TemplateStore {
+	repo.Register(&Template{})
+	return &TemplateStore{repo: repo}
+}
+
+// accountTemplatePrefix returns the key prefix of the templates of the account.
+func accountTemplatePrefix(accountID identity.DID) []byte {
+	return []byte(fmt.Sprintf("%s%s_", templatePrefix, accountID.String()))
+}
+
+func getTemplateKey(accountID identity.DID, name string) []byte {
+	return append(accountTemplatePrefix(accountID), name...)
+}
+
+// Save creates or replaces the template of the account with the name of the template.
+func (s *TemplateStore) Save(accountID identity.DID, tpl *Template) error {
+	if s == nil {
+		return errors.New("template store not initialised")
+	}
+
+	key := getTemplateKey(accountID, tpl.Name)
+	if s.repo.Exists(key) {
+		return s.repo.Update(key, tpl)
+	}
+
+	return s.repo.Create(key, tpl)
+}
+
+// Get returns the template of the account with the name.
+func (s *TemplateStore) Get(accountID identity.DID, name string) (*Template, error) {
+	if s == nil {
+		return nil, errors.New("template store not initialised")
+	}
+
+	key := getTemplateKey(accountID, name)
+	if !s.repo.Exists(key) {
+		return nil, errors.NewTypedError(ErrTemplateNotFound, errors.New("template %s", name))
+	}
+
+	m, err := s.repo.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	tpl, ok := m.(*Template)
+	if !ok {
+		return nil, errors.New("unexpected model %T at %s", m, key)
+	}
+
+	return tpl, nil
+}
+
+// List returns the templates of the account in the order of their names.
+func (s *TemplateStore) List(accountID identity.DID) ([]*Template, error) {
+	if s == nil {
+		return nil, errors.New("template store not initialised")
+	}
+
+	var tpls []*Template
+	iter := s.repo.NewIterator(accountTemplatePrefix(accountID))
+	defer iter.Release()
+	for iter.Next() {
+		tpl, ok := iter.Model().(*Template)
+		if !ok {
+			return nil, errors.New("unexpected model %T at %s", iter.Model(), iter.Key())
+		}
+
+		tpls = append(tpls, tpl)
+	}
+
+	return tpls, iter.Error()
+}
+
+// Delete removes the template of the account with the name.
+func (s *TemplateStore) Delete(accountID identity.DID, name string) error {
+	if s == nil {
+		return errors.New("template store not initialised")
+	}
+
+	key := getTemplateKey(accountID, name)
+	if !s.repo.Exists(key) {
+		return errors.NewTypedError(ErrTemplateNotFound, errors.New("template %s", name))
+	}
+
+	return s.repo.Delete(key)
+}
+
+// SaveTemplate validates the template and stores it for the account, replacing the template with the same name.
+func (s service) SaveTemplate(ctx context.Context, tpl *Template) error {
+	self, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return errors.NewTypedError(ErrDocumentConfigAccountID, err)
+	}
+
+	if tpl == nil {
+		return errors.NewTypedError(ErrTemplateInvalid, errors.New("no template provided"))
+	}
+
+	err = tpl.Validate()
+	if err != nil {
+		return errors.NewTypedError(ErrTemplateInvalid, err)
+	}
+
+	_, err = s.registry.LocateSchemeService(tpl.Scheme)
+	if err != nil {
+		return errors.NewTypedError(ErrTemplateInvalid, err)
+	}
+
+	return s.templates.Save(self, tpl)
+}
+
+// GetTemplate returns the template of the account with the name.
+func (s service) GetTemplate(ctx context.Context, name string) (*Template, error) {
+	self, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentConfigAccountID, err)
+	}
+
+	return s.templates.Get(self, name)
+}
+
+// ListTemplates returns the templates of the account.
+func (s service) ListTemplates(ctx context.Context) ([]*Template, error) {
+	self, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentConfigAccountID, err)
+	}
+
+	return s.templates.List(self)
+}
+
+// DeleteTemplate removes the template of the account with the name. Documents created from it are not affected.
+func (s service) DeleteTemplate(ctx context.Context, name string) error {
+	self, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return errors.NewTypedError(ErrDocumentConfigAccountID, err)
+	}
+
+	return s.templates.Delete(self, name)
+}
+
+// CreateFromTemplate derives a document of the scheme of the template from the client data, completed with the
+// default values of the template, and creates and anchors it. The collaborators are added to the default
+// collaborators of the template, and the field rules of the template are set on the document.
+func (s service) CreateFromTemplate(ctx context.Context, name string, collaborators CollaboratorsAccess, data []byte) (Model, transactions.TxID, chan bool, error) {
+	tpl, err := s.GetTemplate(ctx, name)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	srv, err := s.registry.LocateSchemeService(tpl.Scheme)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	data, err = tpl.ClientData(data)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	err = s.registry.ValidateClientData(tpl.Scheme, data)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	model, err := srv.DeriveFromClientData(ctx, tpl.collaborators(collaborators), data)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	err = applyFieldRules(srv, model, tpl.FieldRules)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return srv.Create(ctx, model)
+}
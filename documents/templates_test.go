@@ -0,0 +1,189 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTemplate(name string) *Template {
+	return &Template{
+		Name:   name,
+		Scheme: "import",
+		Attributes: []TemplateAttribute{
+			{Name: "number", Type: TemplateAttributeString, Required: true},
+			{Name: "comment", Type: TemplateAttributeString, Default: json.RawMessage(`"monthly invoice"`)},
+			{Name: "amount", Type: TemplateAttributeNumber},
+		},
+		Collaborators: CollaboratorsAccess{ReadWriteCollaborators: []string{"0x0102030405060708090a0b0c0d0e0f1011121314"}},
+	}
+}
+
+func TestTemplate_Validate(t *testing.T) {
+	assert.NoError(t, newTemplate("monthly-invoice").Validate())
+
+	for _, change := range []func(tpl *Template){
+		func(tpl *Template) { tpl.Name = "" },
+		func(tpl *Template) { tpl.Name = "monthly invoice" },
+		func(tpl *Template) { tpl.Scheme = "" },
+		func(tpl *Template) { tpl.Attributes[0].Name = "" },
+		func(tpl *Template) { tpl.Attributes[0].Type = "date" },
+		func(tpl *Template) { tpl.Attributes[1].Default = json.RawMessage(`1`) },
+		func(tpl *Template) { tpl.Attributes[1].Default = json.RawMessage(`null`) },
+		func(tpl *Template) { tpl.Attributes[2].Name = "number" },
+		func(tpl *Template) { tpl.Collaborators.ReadCollaborators = []string{"invalid"} },
+		func(tpl *Template) { tpl.FieldRules = []FieldRule{{Fields: []string{"number"}}} },
+	} {
+		tpl := newTemplate("monthly-invoice")
+		change(tpl)
+		assert.Error(t, tpl.Validate())
+	}
+}
+
+func TestTemplate_ClientData(t *testing.T) {
+	tpl := newTemplate("monthly-invoice")
+
+	// defaults are set
+	data, err := tpl.ClientData([]byte(`{"number":"INV-1"}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"number":"INV-1","comment":"monthly invoice"}`, string(data))
+
+	// provided values are kept
+	data, err = tpl.ClientData([]byte(`{"number":"INV-1","comment":"one-off","amount":10.5}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"number":"INV-1","comment":"one-off","amount":10.5}`, string(data))
+
+	for _, data := range []string{
+		``,
+		`[]`,
+		`{"comment":"missing number"}`,
+		`{"number":1}`,
+		`{"number":"INV-1","amount":null}`,
+		`{"number":"INV-1","currency":"EUR"}`,
+	} {
+		_, err = tpl.ClientData([]byte(data))
+		assert.Error(t, err, data)
+	}
+}
+
+func TestTemplate_collaborators(t *testing.T) {
+	tpl := newTemplate("monthly-invoice")
+	assert.Equal(t, tpl.Collaborators, tpl.collaborators(CollaboratorsAccess{}))
+	assert.Equal(t, CollaboratorsAccess{
+		ReadCollaborators:      []string{"0x0a0b0c0d0e0f"},
+		ReadWriteCollaborators: []string{"0x0102030405060708090a0b0c0d0e0f1011121314", "0x14131211100f0e0d0c0b0a090807060504030201"},
+	}, tpl.collaborators(CollaboratorsAccess{
+		ReadCollaborators:      []string{"0x0a0b0c0d0e0f"},
+		ReadWriteCollaborators: []string{"0x14131211100f0e0d0c0b0a090807060504030201", "0x0102030405060708090a0b0c0d0e0f1011121314"},
+	}))
+}
+
+func TestTemplateStore(t *testing.T) {
+	db, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	s := NewTemplateStore(leveldb.NewLevelDBRepository(db))
+	did, other := testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID()
+
+	// nil store
+	var ns *TemplateStore
+	assert.Error(t, ns.Save(did, newTemplate("monthly-invoice")))
+	_, err = ns.Get(did, "monthly-invoice")
+	assert.Error(t, err)
+
+	_, err = s.Get(did, "monthly-invoice")
+	assert.True(t, errors.IsOfType(ErrTemplateNotFound, err))
+	assert.True(t, errors.IsOfType(ErrTemplateNotFound, s.Delete(did, "monthly-invoice")))
+
+	for _, name := range []string{"purchase", "monthly-invoice"} {
+		assert.NoError(t, s.Save(did, newTemplate(name)))
+	}
+
+	// replaced by name
+	tpl := newTemplate("monthly-invoice")
+	tpl.Scheme = "generic"
+	assert.NoError(t, s.Save(did, tpl))
+	got, err := s.Get(did, "monthly-invoice")
+	assert.NoError(t, err)
+	assert.Equal(t, tpl, got)
+
+	// templates are per account and listed by name
+	tpls, err := s.List(did)
+	assert.NoError(t, err)
+	assert.Len(t, tpls, 2)
+	assert.Equal(t, "monthly-invoice", tpls[0].Name)
+	assert.Equal(t, "purchase", tpls[1].Name)
+	tpls, err = s.List(other)
+	assert.NoError(t, err)
+	assert.Empty(t, tpls)
+
+	assert.NoError(t, s.Delete(did, "purchase"))
+	tpls, err = s.List(did)
+	assert.NoError(t, err)
+	assert.Len(t, tpls, 1)
+}
+
+func TestService_Templates(t *testing.T) {
+	db, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	registry := NewServiceRegistry()
+	isrv := new(importService)
+	assert.NoError(t, registry.Register("import document", isrv))
+	srv := service{registry: registry, templates: NewTemplateStore(leveldb.NewLevelDBRepository(db))}
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+
+	// missing account
+	assert.True(t, errors.IsOfType(ErrDocumentConfigAccountID, srv.SaveTemplate(context.Background(), newTemplate("monthly-invoice"))))
+
+	// invalid template
+	assert.True(t, errors.IsOfType(ErrTemplateInvalid, srv.SaveTemplate(ctx, nil)))
+	assert.True(t, errors.IsOfType(ErrTemplateInvalid, srv.SaveTemplate(ctx, &Template{Name: "monthly-invoice"})))
+
+	// unknown scheme
+	tpl := newTemplate("monthly-invoice")
+	tpl.Scheme = "invoice"
+	assert.True(t, errors.IsOfType(ErrTemplateInvalid, srv.SaveTemplate(ctx, tpl)))
+
+	tpl = newTemplate("monthly-invoice")
+	assert.NoError(t, srv.SaveTemplate(ctx, tpl))
+	got, err := srv.GetTemplate(ctx, tpl.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, tpl, got)
+	tpls, err := srv.ListTemplates(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, tpls, 1)
+
+	// unknown template
+	_, _, _, err = srv.CreateFromTemplate(ctx, "purchase", CollaboratorsAccess{}, []byte(`{"number":"1"}`))
+	assert.True(t, errors.IsOfType(ErrTemplateNotFound, err))
+
+	// data doesn't match the template
+	_, _, _, err = srv.CreateFromTemplate(ctx, tpl.Name, CollaboratorsAccess{}, []byte(`{"comment":"missing number"}`))
+	assert.True(t, errors.IsOfType(ErrDocumentInvalid, err))
+
+	// created with the collaborators of the template
+	model, txID, _, err := srv.CreateFromTemplate(ctx, tpl.Name, CollaboratorsAccess{ReadWriteCollaborators: []string{"0x14131211100f0e0d0c0b0a090807060504030201"}}, []byte(`{"number":"1"}`))
+	assert.NoError(t, err)
+	assert.NotEqual(t, transactions.NilTxID(), txID)
+	assert.Equal(t, []byte("1"), model.ID())
+	assert.Equal(t, []string{"0x0102030405060708090a0b0c0d0e0f1011121314", "0x14131211100f0e0d0c0b0a090807060504030201"}, isrv.collaborators)
+
+	// field rules are not supported by the scheme
+	tpl.FieldRules = []FieldRule{{Collaborators: []identity.DID{testingidentity.GenerateRandomDID()}, Fields: []string{"number"}}}
+	assert.NoError(t, srv.SaveTemplate(ctx, tpl))
+	_, _, _, err = srv.CreateFromTemplate(ctx, tpl.Name, CollaboratorsAccess{}, []byte(`{"number":"2"}`))
+	assert.True(t, errors.IsOfType(ErrDocumentInvalid, err))
+
+	assert.NoError(t, srv.DeleteTemplate(ctx, tpl.Name))
+	_, err = srv.GetTemplate(ctx, tpl.Name)
+	assert.True(t, errors.IsOfType(ErrTemplateNotFound, err))
+}
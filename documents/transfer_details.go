@@ -0,0 +1,187 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/centrifuge/go-centrifuge/utils"
+)
+
+// TransferDetailsField is the core document field holding the transfer details recorded on the document
+const TransferDetailsField = "transfer_details"
+
+// TransferStatus is the settlement status of a transfer.
+type TransferStatus string
+
+const (
+	// TransferStatusOpened is the status of a transfer that is initiated but not settled yet.
+	TransferStatusOpened TransferStatus = "opened"
+
+	// TransferStatusSettled is the status of a transfer that is settled at its settlement date.
+	TransferStatusSettled TransferStatus = "settled"
+
+	// TransferStatusFailed is the status of a transfer that failed to settle.
+	TransferStatusFailed TransferStatus = "failed"
+)
+
+// validateTransferDetail validates the fields of the transfer detail. Settled transfers must have a settlement date.
+func validateTransferDetail(detail *coredocumentextpb.TransferDetail) error {
+	if detail == nil {
+		return errors.New("no transfer detail provided")
+	}
+
+	err := identifiers.Validate(detail.TransferId, identifiers.TransferIDLength)
+	if err != nil {
+		return errors.New("invalid transfer ID: %v", err)
+	}
+
+	if detail.PaymentReference == "" {
+		return errors.New("transfer without payment reference")
+	}
+
+	if len(detail.Amount) == 0 {
+		return errors.New("transfer without amount")
+	}
+
+	if _, err := BytesToDecimal(detail.Amount); err != nil {
+		return errors.New("invalid transfer amount: %v", err)
+	}
+
+	if detail.Currency == "" {
+		return errors.New("transfer without currency")
+	}
+
+	switch TransferStatus(detail.Status) {
+	case TransferStatusOpened, TransferStatusFailed:
+	case TransferStatusSettled:
+		if detail.SettlementDate == nil {
+			return errors.New("settled transfer without settlement date")
+		}
+	default:
+		return errors.New("unknown transfer status %q", detail.Status)
+	}
+
+	return nil
+}
+
+// AddTransferDetail returns the next version of the core document with the transfer detail.
+// An earlier detail of the same transfer is replaced, so the status of a transfer is updated by adding it again.
+// The details are part of the cd_tree, so they can be proven against the anchored root of the next version.
+func (cd *CoreDocument) AddTransferDetail(detail *coredocumentextpb.TransferDetail) (*CoreDocument, error) {
+	err := validateTransferDetail(detail)
+	if err != nil {
+		return nil, err
+	}
+
+	ncd, err := cd.PrepareNewVersion(CollaboratorsAccess{}, false, nil)
+	if err != nil {
+		return nil, errors.New("failed to prepare new version: %v", err)
+	}
+
+	// details are shared with the previous version, replace instead of updating in place
+	details := make([]*coredocumentextpb.TransferDetail, len(ncd.Extension.TransferDetails))
+	copy(details, ncd.Extension.TransferDetails)
+	if i := transferDetailIndex(details, detail.TransferId); i >= 0 {
+		details[i] = detail
+	} else {
+		details = append(details, detail)
+	}
+
+	ncd.Extension.TransferDetails = details
+	return ncd, ncd.setSalts()
+}
+
+// transferDetailIndex returns the index of the detail of the transfer, or -1 if the transfer is not recorded.
+func transferDetailIndex(details []*coredocumentextpb.TransferDetail, transferID []byte) int {
+	for i, d := range details {
+		if bytes.Equal(d.TransferId, transferID) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// TransferDetails returns the transfer details recorded on the document, in the order they were first recorded.
+func (cd *CoreDocument) TransferDetails() []*coredocumentextpb.TransferDetail {
+	return cd.Extension.TransferDetails
+}
+
+// TransferDetail returns the detail of the transfer recorded on the document.
+func (cd *CoreDocument) TransferDetail(transferID []byte) (*coredocumentextpb.TransferDetail, error) {
+	i := transferDetailIndex(cd.Extension.TransferDetails, transferID)
+	if i < 0 {
+		return nil, errors.NewTypedError(ErrTransferDetailNotFound, errors.New("transfer %x", transferID))
+	}
+
+	return cd.Extension.TransferDetails[i], nil
+}
+
+// TransferDetailProofFields returns the fields to request to prove the detail of the transfer.
+func (cd *CoreDocument) TransferDetailProofFields(transferID []byte) ([]string, error) {
+	i := transferDetailIndex(cd.Extension.TransferDetails, transferID)
+	if i < 0 {
+		return nil, errors.NewTypedError(ErrTransferDetailNotFound, errors.New("transfer %x", transferID))
+	}
+
+	prefix := fmt.Sprintf("%s.%s[%d]", CDTreePrefix, TransferDetailsField, i)
+	return []string{
+		prefix + ".transfer_id",
+		prefix + ".payment_reference",
+		prefix + ".amount",
+		prefix + ".currency",
+		prefix + ".status",
+		prefix + ".settlement_date",
+	}, nil
+}
+
+// AddTransferDetail records a new transfer against the document and anchors the new version.
+// The transfer ID is generated and set on the detail.
+func (s service) AddTransferDetail(ctx context.Context, documentID []byte, detail *coredocumentextpb.TransferDetail) (Model, transactions.TxID, chan bool, error) {
+	if detail == nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, errors.New("no transfer detail provided"))
+	}
+
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	detail.TransferId = utils.RandomSlice(identifiers.TransferIDLength)
+	err = model.AddTransferDetail(detail)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return s.Update(ctx, model)
+}
+
+// UpdateTransferDetail replaces the detail of a transfer recorded on the document, such as to settle it, and anchors
+// the new version.
+func (s service) UpdateTransferDetail(ctx context.Context, documentID []byte, detail *coredocumentextpb.TransferDetail) (Model, transactions.TxID, chan bool, error) {
+	if detail == nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, errors.New("no transfer detail provided"))
+	}
+
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	_, err = model.TransferDetail(detail.TransferId)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, err
+	}
+
+	err = model.AddTransferDetail(detail)
+	if err != nil {
+		return nil, transactions.NilTxID(), nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return s.Update(ctx, model)
+}
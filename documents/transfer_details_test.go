@@ -0,0 +1,105 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTransferDetail(t *testing.T, status TransferStatus) *coredocumentextpb.TransferDetail {
+	amount, err := NewDecimal("100.25")
+	assert.NoError(t, err)
+	amountBytes, err := DecimalToBytes(amount)
+	assert.NoError(t, err)
+	return &coredocumentextpb.TransferDetail{
+		TransferId:       utils.RandomSlice(32),
+		PaymentReference: "SEPA-0001",
+		Amount:           amountBytes,
+		Currency:         "EUR",
+		Status:           string(status),
+	}
+}
+
+func TestValidateTransferDetail(t *testing.T) {
+	assert.Error(t, validateTransferDetail(nil))
+	assert.NoError(t, validateTransferDetail(newTransferDetail(t, TransferStatusOpened)))
+	assert.NoError(t, validateTransferDetail(newTransferDetail(t, TransferStatusFailed)))
+
+	for _, change := range []func(d *coredocumentextpb.TransferDetail){
+		func(d *coredocumentextpb.TransferDetail) { d.TransferId = utils.RandomSlice(20) },
+		func(d *coredocumentextpb.TransferDetail) { d.PaymentReference = "" },
+		func(d *coredocumentextpb.TransferDetail) { d.Amount = nil },
+		func(d *coredocumentextpb.TransferDetail) { d.Amount = utils.RandomSlice(3) },
+		func(d *coredocumentextpb.TransferDetail) { d.Currency = "" },
+		func(d *coredocumentextpb.TransferDetail) { d.Status = "pending" },
+
+		// settled without settlement date
+		func(d *coredocumentextpb.TransferDetail) { d.Status = string(TransferStatusSettled) },
+	} {
+		d := newTransferDetail(t, TransferStatusOpened)
+		change(d)
+		assert.Error(t, validateTransferDetail(d))
+	}
+
+	d := newTransferDetail(t, TransferStatusSettled)
+	d.SettlementDate, _ = utils.ToTimestamp(time.Now().UTC())
+	assert.NoError(t, validateTransferDetail(d))
+}
+
+func TestCoreDocument_AddTransferDetail(t *testing.T) {
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	cd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	detail := newTransferDetail(t, TransferStatusOpened)
+	_, err = cd.TransferDetail(detail.TransferId)
+	assert.True(t, errors.IsOfType(ErrTransferDetailNotFound, err))
+	_, err = cd.TransferDetailProofFields(detail.TransferId)
+	assert.True(t, errors.IsOfType(ErrTransferDetailNotFound, err))
+
+	// invalid detail
+	_, err = cd.AddTransferDetail(newTransferDetail(t, TransferStatusSettled))
+	assert.Error(t, err)
+
+	ncd, err := cd.AddTransferDetail(detail)
+	assert.NoError(t, err)
+	assert.Empty(t, cd.TransferDetails())
+	assert.Len(t, ncd.TransferDetails(), 1)
+	assert.Equal(t, cd.NextVersion(), ncd.CurrentVersion())
+	fields, err := ncd.TransferDetailProofFields(detail.TransferId)
+	assert.NoError(t, err)
+	assert.Len(t, fields, 6)
+	assert.Equal(t, "cd_tree.transfer_details[0].transfer_id", fields[0])
+
+	// details are carried over to the next versions
+	ncd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	nncd, err := ncd.PrepareNewVersion(CollaboratorsAccess{}, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, ncd.TransferDetails(), nncd.TransferDetails())
+
+	// another transfer and the settlement of the first one
+	other := newTransferDetail(t, TransferStatusOpened)
+	nncd, err = ncd.AddTransferDetail(other)
+	assert.NoError(t, err)
+	nncd.Document.DocumentRoot = utils.RandomSlice(idSize)
+	settled := newTransferDetail(t, TransferStatusSettled)
+	settled.TransferId = detail.TransferId
+	settled.SettlementDate, _ = utils.ToTimestamp(time.Now().UTC())
+	nncd, err = nncd.AddTransferDetail(settled)
+	assert.NoError(t, err)
+	assert.Equal(t, []*coredocumentextpb.TransferDetail{settled, other}, nncd.TransferDetails())
+	got, err := nncd.TransferDetail(detail.TransferId)
+	assert.NoError(t, err)
+	assert.Equal(t, string(TransferStatusSettled), got.Status)
+	fields, err = nncd.TransferDetailProofFields(other.TransferId)
+	assert.NoError(t, err)
+	assert.Equal(t, "cd_tree.transfer_details[1].transfer_id", fields[0])
+
+	// previous versions are not changed
+	assert.Equal(t, []*coredocumentextpb.TransferDetail{detail}, ncd.TransferDetails())
+}
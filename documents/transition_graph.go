@@ -0,0 +1,189 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TransitionRole is a role of the document with the collaborators and the owners of the NFTs holding it.
+type TransitionRole struct {
+	RoleKey       []byte
+	Collaborators []identity.DID
+
+	// NFTs are the registry and token IDs of the NFTs whose owners hold the role
+	NFTs [][]byte
+}
+
+// TransitionEdge is a transition rule allowing the roles to change the fields matching the field of the rule.
+type TransitionEdge struct {
+	RuleKey []byte
+	Roles   [][]byte
+
+	// MatchType is exact if only the field can be changed, prefix if every field under it can be changed
+	MatchType string
+
+	// Field is the readable path of the field, or the hex encoded compact property if the path is not known
+	Field  string
+	Action string
+}
+
+// TransitionGraph is the graph of the roles of the latest version of a document and the fields their transition
+// rules allow to change.
+type TransitionGraph struct {
+	DocumentID   []byte
+	VersionID    []byte
+	DocumentType string
+	Roles        []TransitionRole
+	Rules        []TransitionEdge
+}
+
+// DOT renders the graph in the Graphviz DOT language, with an edge from each role to each field it can change.
+func (g *TransitionGraph) DOT() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "digraph %q {\n", hexutil.Encode(g.DocumentID))
+	fmt.Fprintf(&b, "  label=%q;\n", g.DocumentType)
+	for _, r := range g.Roles {
+		label := []string{"role " + hexutil.Encode(r.RoleKey)}
+		for _, c := range r.Collaborators {
+			label = append(label, c.String())
+		}
+
+		for _, n := range r.NFTs {
+			label = append(label, "nft "+hexutil.Encode(n))
+		}
+
+		fmt.Fprintf(&b, "  %q [shape=box, label=%q];\n", hexutil.Encode(r.RoleKey), strings.Join(label, "\n"))
+	}
+
+	fields := make(map[string]bool)
+	for _, e := range g.Rules {
+		if !fields[e.Field] {
+			fields[e.Field] = true
+			fmt.Fprintf(&b, "  %q [shape=ellipse];\n", e.Field)
+		}
+
+		for _, rk := range e.Roles {
+			fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", hexutil.Encode(rk), e.Field, e.Action+" ("+e.MatchType+")")
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// GetTransitionGraph returns the roles of the current version of the document and the fields their transition
+// rules allow to change. The fields are named through the property mappings of the core document and the document type.
+func (s service) GetTransitionGraph(ctx context.Context, documentID []byte) (*TransitionGraph, error) {
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	cd, err := model.PackCoreDocument()
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentPackingCoreDocument, err)
+	}
+
+	mappings, err := CoreDocumentPropertyMappings()
+	if err != nil {
+		return nil, err
+	}
+
+	// the data fields are named if the document type describes its properties
+	if srv, err := s.getService(model); err == nil {
+		if pm, ok := srv.(PropertyMapper); ok {
+			docMappings, err := pm.PropertyMappings()
+			if err != nil {
+				return nil, err
+			}
+
+			mappings = append(mappings, docMappings...)
+		}
+	}
+
+	graph := &TransitionGraph{DocumentID: model.ID(), VersionID: model.CurrentVersion(), DocumentType: model.DocumentType()}
+	for _, role := range cd.Roles {
+		tr := TransitionRole{RoleKey: role.RoleKey, NFTs: role.Nfts}
+		for _, c := range role.Collaborators {
+			tr.Collaborators = append(tr.Collaborators, identity.NewDIDFromBytes(c))
+		}
+
+		graph.Roles = append(graph.Roles, tr)
+	}
+
+	for _, rule := range cd.TransitionRules {
+		graph.Rules = append(graph.Rules, TransitionEdge{
+			RuleKey:   rule.RuleKey,
+			Roles:     rule.Roles,
+			MatchType: matchTypeName(rule.MatchType),
+			Field:     fieldPath(mappings, rule.Field),
+			Action:    transitionActionName(rule.Action),
+		})
+	}
+
+	return graph, nil
+}
+
+func matchTypeName(mt coredocumentpb.FieldMatchType) string {
+	switch mt {
+	case coredocumentpb.FieldMatchType_FIELD_MATCH_TYPE_EXACT:
+		return "exact"
+	case coredocumentpb.FieldMatchType_FIELD_MATCH_TYPE_PREFIX:
+		return "prefix"
+	default:
+		return mt.String()
+	}
+}
+
+func transitionActionName(action coredocumentpb.TransitionAction) string {
+	if action == coredocumentpb.TransitionAction_TRANSITION_ACTION_EDIT {
+		return "edit"
+	}
+
+	return action.String()
+}
+
+// fieldPath returns the readable path of the compact property, or of the compact property prefix, through the
+// property mappings. Every name of a path is a 4 byte field number in the compact property.
+// Returns the hex encoded compact property if no mapping describes it.
+func fieldPath(mappings []PropertyMapping, compact []byte) string {
+	prefix := hex.EncodeToString(compact)
+	for _, m := range mappings {
+		cn := strings.TrimPrefix(m.CompactName, "0x")
+		if cn == prefix {
+			return m.ReadableName
+		}
+
+		if len(compact) == 0 || len(compact)%4 != 0 || !strings.HasPrefix(cn, prefix) {
+			continue
+		}
+
+		names := strings.Split(m.ReadableName, ".")
+		size := len(compact) / 4
+		if len(names) <= size {
+			continue
+		}
+
+		// elements of repeated and mapped fields can't be addressed by a prefix
+		path := strings.Join(names[:size], ".")
+		if i := strings.Index(path, "["); i >= 0 {
+			if i < len(path)-len(names[size-1]) {
+				continue
+			}
+
+			path = path[:i]
+		}
+
+		return path
+	}
+
+	return hexutil.Encode(compact)
+}
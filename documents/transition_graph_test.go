@@ -0,0 +1,115 @@
+// +build unit
+
+package documents
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type transitionDoc struct {
+	Model
+	CD coredocumentpb.CoreDocument
+}
+
+func (m *transitionDoc) ID() []byte             { return m.CD.DocumentIdentifier }
+func (m *transitionDoc) CurrentVersion() []byte { return m.CD.CurrentVersion }
+func (m *transitionDoc) NextVersion() []byte    { return nil }
+func (m *transitionDoc) DocumentType() string   { return "transition" }
+
+func (m *transitionDoc) PackCoreDocument() (coredocumentpb.CoreDocument, error) {
+	return m.CD, nil
+}
+
+func (m *transitionDoc) JSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *transitionDoc) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+func (m *transitionDoc) Type() reflect.Type {
+	return reflect.TypeOf(m)
+}
+
+// mappedService describes the properties of the data tree of its documents.
+type mappedService struct {
+	Service
+	mappings []PropertyMapping
+}
+
+func (s mappedService) PropertyMappings() ([]PropertyMapping, error) {
+	return s.mappings, nil
+}
+
+func TestFieldPath(t *testing.T) {
+	mappings := []PropertyMapping{
+		{ReadableName: "invoice.number", CompactName: "0x0001000000000001"},
+		{ReadableName: "invoice.line_items[{index}].amount", CompactName: "0x0001000000000002{index}00000003"},
+	}
+
+	assert.Equal(t, "invoice.number", fieldPath(mappings, []byte{0, 1, 0, 0, 0, 0, 0, 1}))
+	assert.Equal(t, "invoice", fieldPath(mappings, []byte{0, 1, 0, 0}))
+	assert.Equal(t, "invoice.line_items", fieldPath(mappings, []byte{0, 1, 0, 0, 0, 0, 0, 2}))
+
+	// unknown properties
+	assert.Equal(t, "0x00090000", fieldPath(mappings, []byte{0, 9, 0, 0}))
+	assert.Equal(t, "0x000100", fieldPath(mappings, []byte{0, 1, 0}))
+}
+
+func TestService_GetTransitionGraph(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&transitionDoc{})
+	registry := NewServiceRegistry()
+	mappings := []PropertyMapping{{ReadableName: "transition.number", CompactName: "0x0009000000000001"}}
+	assert.NoError(t, registry.Register("transition", mappedService{mappings: mappings}))
+	srv := service{repo: repo, registry: registry}
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: []string{did.String()}}, []byte{0, 9, 0, 0})
+	assert.NoError(t, err)
+	cd.addNewTransitionRule(cd.Document.Roles[1].RoleKey, coredocumentpb.FieldMatchType_FIELD_MATCH_TYPE_EXACT,
+		[]byte{0, 9, 0, 0, 0, 0, 0, 1}, coredocumentpb.TransitionAction_TRANSITION_ACTION_EDIT)
+	doc := &transitionDoc{CD: cd.Document}
+	assert.NoError(t, repo.Create(did[:], doc.CurrentVersion(), doc))
+
+	// unknown document
+	_, err = srv.GetTransitionGraph(actx, utils.RandomSlice(32))
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+
+	graph, err := srv.GetTransitionGraph(actx, doc.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, doc.ID(), graph.DocumentID)
+	assert.Equal(t, "transition", graph.DocumentType)
+
+	// the roles of the read rules are part of the graph
+	assert.Len(t, graph.Roles, 2)
+	assert.Equal(t, []identity.DID{did}, graph.Roles[1].Collaborators)
+	roleKey := graph.Roles[1].RoleKey
+	assert.Len(t, graph.Rules, 3)
+	for i, field := range []string{CDTreePrefix, "transition", "transition.number"} {
+		assert.Equal(t, field, graph.Rules[i].Field)
+		assert.Equal(t, [][]byte{roleKey}, graph.Rules[i].Roles)
+		assert.Equal(t, "edit", graph.Rules[i].Action)
+	}
+	assert.Equal(t, "prefix", graph.Rules[1].MatchType)
+	assert.Equal(t, "exact", graph.Rules[2].MatchType)
+
+	dot := graph.DOT()
+	assert.Contains(t, dot, "digraph")
+	assert.Contains(t, dot, did.String())
+	assert.Contains(t, dot, `"`+hexutil.Encode(roleKey)+`" -> "transition.number" [label="edit (exact)"];`)
+}
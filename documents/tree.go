@@ -1,27 +1,95 @@
 package documents
 
 import (
+	"bytes"
+	"crypto/rand"
 	"crypto/sha256"
+	"hash"
+	"io"
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/precise-proofs/proofs"
-	"github.com/gogo/protobuf/proto"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/hkdf"
 )
 
+// treeHashes are the constructors of the hash functions the document trees can be hashed with, by algorithm name.
+var treeHashes = map[string]func() hash.Hash{
+	HashAlgorithmSHA256:     sha256.New,
+	HashAlgorithmBlake2b256: newBlake2b256,
+}
+
+// treeHashAlgorithm is the hash algorithm of the document trees of the network, set when the documents are bootstrapped.
+var treeHashAlgorithm = HashAlgorithmSHA256
+
+// newBlake2b256 returns an unkeyed blake2b-256 hash, as used by Substrate.
+func newBlake2b256() hash.Hash {
+	// fails only for keys longer than 64 bytes
+	h, _ := blake2b.New256(nil)
+	return h
+}
+
+// TreeHash returns the constructor of the hash function of the algorithm.
+func TreeHash(algorithm string) (func() hash.Hash, error) {
+	newHash, ok := treeHashes[algorithm]
+	if !ok {
+		return nil, errors.New("unsupported tree hash algorithm %q", algorithm)
+	}
+
+	return newHash, nil
+}
+
+// SetTreeHashAlgorithm sets the hash algorithm the document trees are generated and their proofs validated with.
+func SetTreeHashAlgorithm(algorithm string) error {
+	if _, err := TreeHash(algorithm); err != nil {
+		return err
+	}
+
+	treeHashAlgorithm = algorithm
+	return nil
+}
+
+// TreeHashAlgorithm returns the name of the hash algorithm of the document trees.
+func TreeHashAlgorithm() string {
+	return treeHashAlgorithm
+}
+
+// newTreeHash returns a new hash of the document trees.
+func newTreeHash() hash.Hash {
+	return treeHashes[treeHashAlgorithm]()
+}
+
 // NewDefaultTree returns a DocumentTree with default opts
 func NewDefaultTree(salts *proofs.Salts) *proofs.DocumentTree {
 	return NewDefaultTreeWithPrefix(salts, "", nil)
 }
 
 // NewDefaultTreeWithPrefix returns a DocumentTree with default opts passing a prefix to the tree leaves
+// The salts of the leaves are derived from the salt secret if the salts hold one, else looked up in the salts.
 func NewDefaultTreeWithPrefix(salts *proofs.Salts, prefix string, compactPrefix []byte) *proofs.DocumentTree {
+	opts := defaultTreeOptions(prefix, compactPrefix)
+	if secret := saltSecret(salts); secret != nil {
+		opts.GetSalt = func(compact []byte) []byte {
+			return DeriveSalt(secret, compact)
+		}
+	} else {
+		opts.Salts = salts
+	}
+
+	t := proofs.NewDocumentTree(opts)
+	return &t
+}
+
+// defaultTreeOptions returns the default tree options with the prefix for the tree leaves
+func defaultTreeOptions(prefix string, compactPrefix []byte) proofs.TreeOptions {
 	var prop proofs.Property
 	if prefix != "" {
 		prop = NewLeafProperty(prefix, compactPrefix)
 	}
 
-	t := proofs.NewDocumentTree(proofs.TreeOptions{CompactProperties: true, EnableHashSorting: true, Hash: sha256.New(), ParentPrefix: prop, Salts: salts})
-	return &t
+	return proofs.TreeOptions{CompactProperties: true, EnableHashSorting: true, Hash: newTreeHash(), ParentPrefix: prop}
 }
 
 // NewLeafProperty returns a proof property with the literal and the compact
@@ -29,15 +97,38 @@ func NewLeafProperty(literal string, compact []byte) proofs.Property {
 	return proofs.NewProperty(literal, compact...)
 }
 
-// GenerateNewSalts generates salts for new Document
-func GenerateNewSalts(document proto.Message, prefix string, compactPrefix []byte) (*proofs.Salts, error) {
-	docSalts := new(proofs.Salts)
-	t := NewDefaultTreeWithPrefix(docSalts, prefix, compactPrefix)
-	err := t.AddLeavesFromDocument(document)
-	if err != nil {
-		return nil, err
+// saltLength is the length of a leaf salt and of the secret the salts are derived from
+const saltLength = 32
+
+// GenerateNewSalts generates the salts of a new document version.
+// The salts of the leaves are derived from a single random secret and the compact property of the leaf, so the salts
+// hold the secret only, stored as a salt without a compact property.
+func GenerateNewSalts() (*proofs.Salts, error) {
+	secret := make([]byte, saltLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.New("failed to generate salt secret: %v", err)
+	}
+
+	return &proofs.Salts{{Value: secret}}, nil
+}
+
+// saltSecret returns the secret the salts of the leaves are derived from, nil if the salts list a salt per leaf.
+// No leaf has an empty compact property, so the secret is never mistaken for the salt of a leaf.
+func saltSecret(salts *proofs.Salts) []byte {
+	if salts == nil || len(*salts) != 1 || len((*salts)[0].Compact) != 0 {
+		return nil
 	}
-	return docSalts, nil
+
+	return (*salts)[0].Value
+}
+
+// DeriveSalt derives the salt of the leaf with the compact property from the secret with HKDF-SHA256.
+// Salts of different leaves can't be linked to each other or to the secret, so a proof reveals the salt of its leaf only.
+func DeriveSalt(secret, compact []byte) []byte {
+	salt := make([]byte, saltLength)
+	// HKDF fails only when reading more than 255 hashes
+	_, _ = io.ReadFull(hkdf.New(sha256.New, secret, nil, compact), salt)
+	return salt
 }
 
 // ConvertToProtoSalts converts proofSalts into protocolSalts
@@ -67,3 +158,52 @@ func ConvertToProofSalts(protoSalts []*coredocumentpb.DocumentSalt) *proofs.Salt
 
 	return &proofSalts
 }
+
+// ValidateProof checks that the field proof resolves to the document root of a tree hashed with the tree hash algorithm.
+func ValidateProof(documentRoot []byte, p *proofspb.Proof) error {
+	return ValidateProofWithHash(documentRoot, p, newTreeHash)
+}
+
+// ValidateProofWithHash checks that the field proof resolves to the document root of a tree hashed with newHash.
+func ValidateProofWithHash(documentRoot []byte, p *proofspb.Proof, newHash func() hash.Hash) error {
+	leafHash := p.Hash
+	if len(leafHash) == 0 {
+		var err error
+		leafHash, err = proofs.CalculateHashForProofField(p, newHash())
+		if err != nil {
+			return err
+		}
+	}
+
+	valid, err := proofs.ValidateProofSortedHashes(leafHash, p.SortedHashes, documentRoot, newHash())
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		return errors.New("invalid proof for property %x", p.GetCompactName())
+	}
+
+	return nil
+}
+
+// ValidateDocumentTypeProof checks that the proof proves the document type of the document with the document root.
+// The proof reveals only the document type, the other fields of the core document are hashed siblings.
+func ValidateDocumentTypeProof(documentRoot []byte, docType string, p *proofspb.Proof) error {
+	if p == nil {
+		return errors.New("document type proof missing")
+	}
+
+	if !bytes.Equal(p.GetCompactName(), documentTypeCompact()) && p.GetReadableName() != DocumentTypeProofField {
+		return errors.New("proof is not a document type proof")
+	}
+
+	if string(p.Value) != docType {
+		return errors.New("document type mismatch: proven %q, expected %q", string(p.Value), docType)
+	}
+
+	// hash must be derived from the value to bind the proven document type
+	pc := *p
+	pc.Hash = nil
+	return ValidateProof(documentRoot, &pc)
+}
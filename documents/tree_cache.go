@@ -0,0 +1,72 @@
+package documents
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/centrifuge/precise-proofs/proofs"
+	"github.com/golang/protobuf/proto"
+)
+
+// cachedTree is a generated tree with the digest of the inputs it was generated from.
+type cachedTree struct {
+	digest [sha256.Size]byte
+	tree   *proofs.DocumentTree
+}
+
+// treeCache caches the trees generated from a core document by tree name.
+// The core document is mutated in place, so a cached tree is invalidated by any change of its inputs rather than by
+// the mutators: digesting the inputs is much cheaper than hashing every leaf of a large document again.
+type treeCache struct {
+	mu    sync.Mutex
+	trees map[string]cachedTree
+}
+
+// tree returns the cached tree of the name if it was generated from the inputs, else it generates and caches the tree.
+// The returned tree is shared and must not be modified.
+func (c *treeCache) tree(name string, inputs [][]byte, generate func() (*proofs.DocumentTree, error)) (*proofs.DocumentTree, error) {
+	digest := treeDigest(inputs)
+	c.mu.Lock()
+	ct, ok := c.trees[name]
+	c.mu.Unlock()
+	if ok && ct.digest == digest {
+		return ct.tree, nil
+	}
+
+	tree, err := generate()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.trees == nil {
+		c.trees = make(map[string]cachedTree)
+	}
+
+	c.trees[name] = cachedTree{digest: digest, tree: tree}
+	return tree, nil
+}
+
+// marshalInput serialises a clone of the tree input, since serialising a message caches its size in the message and
+// digesting the inputs must leave the core document as it is.
+func marshalInput(pb proto.Message) ([]byte, error) {
+	return proto.Marshal(proto.Clone(pb))
+}
+
+// treeDigest returns the digest of the inputs of a tree hashed with the tree hash algorithm.
+// The inputs are length prefixed, so moving bytes between inputs changes the digest.
+func treeDigest(inputs [][]byte) [sha256.Size]byte {
+	h := sha256.New()
+	var l [8]byte
+	for _, in := range append([][]byte{[]byte(treeHashAlgorithm)}, inputs...) {
+		binary.BigEndian.PutUint64(l[:], uint64(len(in)))
+		h.Write(l[:])
+		h.Write(in)
+	}
+
+	var digest [sha256.Size]byte
+	copy(digest[:], h.Sum(nil))
+	return digest
+}
@@ -0,0 +1,84 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoreDocument_TreeCache(t *testing.T) {
+	cd, err := newCoreDocument()
+	assert.NoError(t, err)
+	assert.NoError(t, cd.setSalts())
+	cd.Document.DataRoot = utils.RandomSlice(32)
+	_, err = cd.CalculateSigningRoot(documenttypes.InvoiceDataTypeUrl)
+	assert.NoError(t, err)
+	_, err = cd.CalculateDocumentRoot()
+	assert.NoError(t, err)
+
+	// unchanged documents reuse the generated trees
+	cdTree, err := cd.documentTree(documenttypes.InvoiceDataTypeUrl)
+	assert.NoError(t, err)
+	tree, err := cd.documentTree(documenttypes.InvoiceDataTypeUrl)
+	assert.NoError(t, err)
+	assert.True(t, cdTree == tree)
+	sigTree, err := cd.getSignatureDataTree()
+	assert.NoError(t, err)
+	tree, err = cd.getSignatureDataTree()
+	assert.NoError(t, err)
+	assert.True(t, sigTree == tree)
+	drTree, err := cd.DocumentRootTree()
+	assert.NoError(t, err)
+	tree, err = cd.DocumentRootTree()
+	assert.NoError(t, err)
+	assert.True(t, drTree == tree)
+
+	// digesting the inputs leaves the core document as it is
+	assert.Zero(t, cd.Document.XXX_sizecache)
+	assert.Zero(t, cd.Document.SignatureData.XXX_sizecache)
+
+	// cached trees match the generated ones
+	tree, err = cd.generateDocumentTree(documenttypes.InvoiceDataTypeUrl)
+	assert.NoError(t, err)
+	assert.Equal(t, tree.RootHash(), cdTree.RootHash())
+
+	// another document type
+	tree, err = cd.documentTree(documenttypes.PurchaseOrderDataTypeUrl)
+	assert.NoError(t, err)
+	assert.NotEqual(t, cdTree.RootHash(), tree.RootHash())
+
+	// mutated core document
+	cd.Document.NextVersion = utils.RandomSlice(32)
+	tree, err = cd.documentTree(documenttypes.InvoiceDataTypeUrl)
+	assert.NoError(t, err)
+	assert.NotEqual(t, cdTree.RootHash(), tree.RootHash())
+
+	// new signatures, salted again, invalidate the signatures and the document root trees
+	cd.Document.SignatureData.Signatures = append(cd.Document.SignatureData.Signatures, &coredocumentpb.Signature{
+		SignerId:    utils.RandomSlice(identity.DIDLength),
+		PublicKey:   utils.RandomSlice(32),
+		SignatureId: utils.RandomSlice(52),
+		Signature:   utils.RandomSlice(32),
+	})
+	cd.Document.SignatureDataSalts = nil
+	tree, err = cd.getSignatureDataTree()
+	assert.NoError(t, err)
+	assert.NotEqual(t, sigTree.RootHash(), tree.RootHash())
+	tree, err = cd.DocumentRootTree()
+	assert.NoError(t, err)
+	assert.NotEqual(t, drTree.RootHash(), tree.RootHash())
+
+	// so does another tree hash algorithm
+	drTree = tree
+	assert.NoError(t, SetTreeHashAlgorithm(HashAlgorithmBlake2b256))
+	defer SetTreeHashAlgorithm(HashAlgorithmSHA256)
+	tree, err = cd.DocumentRootTree()
+	assert.NoError(t, err)
+	assert.NotEqual(t, drTree.RootHash(), tree.RootHash())
+}
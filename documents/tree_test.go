@@ -3,15 +3,18 @@
 package documents
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"testing"
 
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/precise-proofs/proofs"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestConvertToProofAndProtoSalts(t *testing.T) {
-	cd, err := newCoreDocument()
-	assert.NoError(t, err)
-	salts, err := GenerateNewSalts(&cd.Document, "", nil)
+	salts, err := GenerateNewSalts()
 	assert.NoError(t, err)
 	assert.NotNil(t, salts)
 
@@ -31,3 +34,94 @@ func TestConvertToProofAndProtoSalts(t *testing.T) {
 	assert.Len(t, *cSalts, len(*salts))
 	assert.Equal(t, (*cSalts)[0].Value, (*salts)[0].Value)
 }
+
+func TestGenerateNewSalts(t *testing.T) {
+	cd := largeCoreDocument(t, 10)
+	salts, err := GenerateNewSalts()
+	assert.NoError(t, err)
+	secret := saltSecret(salts)
+	assert.Len(t, secret, saltLength)
+
+	// leaves are salted from the secret, the salts are not extended
+	tree := NewDefaultTreeWithPrefix(salts, CDTreePrefix, compactProperties(CDTreePrefix))
+	assert.NoError(t, tree.AddLeavesFromDocument(&cd.Document))
+	assert.NoError(t, tree.Generate())
+	assert.Len(t, *salts, 1)
+	prop := CDTreePrefix + ".document_identifier"
+	p, err := tree.CreateProof(prop)
+	assert.NoError(t, err)
+	assert.Equal(t, DeriveSalt(secret, p.GetCompactName()), p.Salt)
+	assert.NoError(t, ValidateProof(tree.RootHash(), &p))
+
+	// salts are unique per leaf and per secret
+	p2, err := tree.CreateProof(CDTreePrefix + ".current_version")
+	assert.NoError(t, err)
+	assert.NotEqual(t, p.Salt, p2.Salt)
+	nsalts, err := GenerateNewSalts()
+	assert.NoError(t, err)
+	assert.NotEqual(t, secret, saltSecret(nsalts))
+	assert.NotEqual(t, p.Salt, DeriveSalt(saltSecret(nsalts), p.GetCompactName()))
+
+	// salts listing a salt per leaf still salt the trees of older documents
+	listed, err := generateListedSalts(&cd.Document, CDTreePrefix, compactProperties(CDTreePrefix))
+	assert.NoError(t, err)
+	assert.True(t, len(*listed) > 1)
+	assert.Nil(t, saltSecret(listed))
+	tree = NewDefaultTreeWithPrefix(listed, CDTreePrefix, compactProperties(CDTreePrefix))
+	assert.NoError(t, tree.AddLeavesFromDocument(&cd.Document))
+	assert.NoError(t, tree.Generate())
+	p, err = tree.CreateProof(prop)
+	assert.NoError(t, err)
+	for _, salt := range *listed {
+		if bytes.Equal(salt.Compact, p.GetCompactName()) {
+			assert.Equal(t, salt.Value, p.Salt)
+		}
+	}
+}
+
+func TestSetTreeHashAlgorithm(t *testing.T) {
+	assert.Error(t, SetTreeHashAlgorithm("keccak256"))
+	assert.Equal(t, HashAlgorithmSHA256, TreeHashAlgorithm())
+
+	assert.NoError(t, SetTreeHashAlgorithm(HashAlgorithmBlake2b256))
+	defer SetTreeHashAlgorithm(HashAlgorithmSHA256)
+	assert.Equal(t, HashAlgorithmBlake2b256, TreeHashAlgorithm())
+
+	// proofs of blake2b-256 trees only resolve with blake2b-256
+	proof := testMultiProofBundle(t)
+	for _, p := range proof.FieldProofs {
+		assert.NoError(t, ValidateProof(proof.DocumentRoot, p))
+		assert.Error(t, ValidateProofWithHash(proof.DocumentRoot, p, sha256.New))
+	}
+
+	// the envelope records the algorithm, so the proofs are verified with it on sha256 nodes
+	proof.Envelope = NewProofEnvelope(new(mockModel))
+	assert.Equal(t, HashAlgorithmBlake2b256, proof.Envelope.HashAlgorithm)
+	assert.NoError(t, SetTreeHashAlgorithm(HashAlgorithmSHA256))
+	assert.NoError(t, validateFieldProofs(proof))
+	proof.Envelope = nil
+	assert.Error(t, validateFieldProofs(proof))
+}
+
+// largeCoreDocument returns a core document with the collaborators in the read and transition rules.
+func largeCoreDocument(t testing.TB, collaborators int) *CoreDocument {
+	var cs []string
+	for i := 0; i < collaborators; i++ {
+		cs = append(cs, testingidentity.GenerateRandomDID().String())
+	}
+
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: cs}, []byte{1, 0, 0, 0})
+	assert.NoError(t, err)
+	return cd
+}
+
+// generateListedSalts generates a salt per leaf, as documents were salted before the salts were derived from a secret.
+func generateListedSalts(document proto.Message, prefix string, compactPrefix []byte) (*proofs.Salts, error) {
+	docSalts := new(proofs.Salts)
+	t := NewDefaultTreeWithPrefix(docSalts, prefix, compactPrefix)
+	err := t.AddLeavesFromDocument(document)
+	if err != nil {
+		return nil, err
+	}
+	return docSalts, nil
+}
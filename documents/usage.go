@@ -0,0 +1,396 @@
+package documents
+
+import (
+	"expvar"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// BootstrappedStorageUsage is the key to the UsageTracker in the bootstrap context.
+	BootstrappedStorageUsage = "BootstrappedStorageUsage"
+
+	// usageMetricsName is the name of the expvar the storage usage is published as.
+	usageMetricsName = "document_storage"
+)
+
+// TypeStorageUsage is the storage used by the stored versions of the documents of a document type.
+type TypeStorageUsage struct {
+	DocumentType string
+	Versions     uint64
+	Bytes        uint64
+}
+
+// StorageUsage is the storage used by the stored document versions of an account.
+type StorageUsage struct {
+	AccountID  []byte
+	TotalBytes uint64
+
+	// QuotaBytes is the storage quota of the account, 0 if the storage is not limited.
+	QuotaBytes uint64
+
+	// DocumentTypes ordered by document type.
+	DocumentTypes []TypeStorageUsage
+}
+
+// usageChange is the change of the storage used by an account for a document type caused by a write.
+type usageChange struct {
+	accountID string
+	docType   string
+	versions  int64
+	bytes     int64
+}
+
+// typeUsage is the storage used by an account for a document type.
+type typeUsage struct {
+	versions int64
+	bytes    int64
+}
+
+// UsageTracker accounts for the storage used by the document versions per account and per document type, and
+// enforces the storage quota of the accounts, if any.
+// The usage of an account is loaded from the repository the first time the account is written to or its usage is
+// requested, and is kept up to date with the writes done through the Repository of the tracker.
+// The size of a version is the size of its json encoding, as stored in the DB, so the index entries and the
+// encryption overhead are not accounted for.
+type UsageTracker struct {
+	repo  Repository
+	quota uint64
+
+	mu       sync.Mutex
+	accounts map[string]map[string]*typeUsage
+}
+
+// NewUsageTracker returns a tracker of the storage used by the documents stored in repo.
+// A zero quota doesn't limit the storage of the accounts.
+func NewUsageTracker(repo Repository, quota uint64) *UsageTracker {
+	return &UsageTracker{repo: repo, quota: quota, accounts: make(map[string]map[string]*typeUsage)}
+}
+
+// Repository returns the repository of the tracker that accounts for the writes and rejects the writes exceeding
+// the storage quota of the account with ErrDocumentStorageQuota.
+func (t *UsageTracker) Repository() Repository {
+	return &usageRepo{Repository: t.repo, tracker: t}
+}
+
+// versionSize returns the size of the version as stored in the DB.
+func versionSize(model Model) (int64, error) {
+	data, err := model.JSON()
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(data)), nil
+}
+
+// account returns the usage of the account, loading it from the repository if the account is not accounted for yet.
+// Must be called with the lock held.
+func (t *UsageTracker) account(accountID []byte) (map[string]*typeUsage, error) {
+	usage, ok := t.accounts[string(accountID)]
+	if ok {
+		return usage, nil
+	}
+
+	usage = make(map[string]*typeUsage)
+	err := t.repo.Iterate(accountID, func(model Model) error {
+		size, err := versionSize(model)
+		if err != nil {
+			return err
+		}
+
+		tu, ok := usage[model.DocumentType()]
+		if !ok {
+			tu = new(typeUsage)
+			usage[model.DocumentType()] = tu
+		}
+
+		tu.versions++
+		tu.bytes += size
+		return nil
+	})
+	if err != nil {
+		return nil, errors.New("failed to load the storage usage of account %x: %v", accountID, err)
+	}
+
+	t.accounts[string(accountID)] = usage
+	return usage, nil
+}
+
+// check returns ErrDocumentStorageQuota if the changes take the storage used by the account over the quota.
+// Changes that don't grow the storage used are always accepted.
+func (t *UsageTracker) check(accountID []byte, changes []usageChange) error {
+	var delta int64
+	for _, c := range changes {
+		if c.accountID == string(accountID) {
+			delta += c.bytes
+		}
+	}
+
+	if t.quota == 0 || delta <= 0 {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	usage, err := t.account(accountID)
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	for _, tu := range usage {
+		total += tu.bytes
+	}
+
+	if total+delta > int64(t.quota) {
+		return errors.NewTypedError(ErrDocumentStorageQuota, errors.New("account %x uses %d of %d bytes, %d more bytes requested", accountID, total, t.quota, delta))
+	}
+
+	return nil
+}
+
+// apply accounts for the changes of the accounts whose usage is loaded.
+// The changes of the other accounts are part of their usage once it is loaded.
+func (t *UsageTracker) apply(changes []usageChange) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, c := range changes {
+		usage, ok := t.accounts[c.accountID]
+		if !ok {
+			continue
+		}
+
+		tu, ok := usage[c.docType]
+		if !ok {
+			tu = new(typeUsage)
+			usage[c.docType] = tu
+		}
+
+		tu.versions += c.versions
+		tu.bytes += c.bytes
+		if tu.versions <= 0 {
+			delete(usage, c.docType)
+		}
+	}
+}
+
+// Usage returns the storage used by the documents of the account.
+func (t *UsageTracker) Usage(accountID []byte) (*StorageUsage, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	usage, err := t.account(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.usage(accountID, usage), nil
+}
+
+// Accounts returns the storage used by the accounts whose usage is loaded, ordered by account.
+func (t *UsageTracker) Accounts() []StorageUsage {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var accounts []StorageUsage
+	for acc, usage := range t.accounts {
+		accounts = append(accounts, *t.usage([]byte(acc), usage))
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		return string(accounts[i].AccountID) < string(accounts[j].AccountID)
+	})
+	return accounts
+}
+
+func (t *UsageTracker) usage(accountID []byte, usage map[string]*typeUsage) *StorageUsage {
+	su := &StorageUsage{AccountID: accountID, QuotaBytes: t.quota}
+	for docType, tu := range usage {
+		su.TotalBytes += uint64(tu.bytes)
+		su.DocumentTypes = append(su.DocumentTypes, TypeStorageUsage{
+			DocumentType: docType,
+			Versions:     uint64(tu.versions),
+			Bytes:        uint64(tu.bytes),
+		})
+	}
+
+	sort.Slice(su.DocumentTypes, func(i, j int) bool {
+		return su.DocumentTypes[i].DocumentType < su.DocumentTypes[j].DocumentType
+	})
+	return su
+}
+
+// usageRepo implements Repository by accounting for the writes to the underlying repository.
+type usageRepo struct {
+	Repository
+	tracker *UsageTracker
+
+	// batch holds the changes of the batched writes, accounted for once the batch is committed.
+	// nil if the writes are not batched.
+	batch *usageBatch
+}
+
+// usageBatch holds the changes of the writes of a batch.
+type usageBatch struct {
+	changes []usageChange
+
+	// versions are the versions written in the batch by key, nil for the deleted versions.
+	// The batched writes are not readable from the underlying repository until the batch is committed.
+	versions map[string]Model
+}
+
+// stored returns the version of the account stored at id, if any.
+func (u *usageRepo) stored(accountID, id []byte) (Model, bool) {
+	if u.batch != nil {
+		if model, ok := u.batch.versions[string(accountID)+string(id)]; ok {
+			return model, model != nil
+		}
+	}
+
+	model, err := u.Repository.Get(accountID, id)
+	return model, err == nil
+}
+
+// write checks the quota for the changes, does the write and accounts for the changes.
+// model is the version stored at id after the write, nil if the version is deleted.
+func (u *usageRepo) write(accountID, id []byte, model Model, changes []usageChange, fn func() error) error {
+	var pending []usageChange
+	if u.batch != nil {
+		pending = append(pending, u.batch.changes...)
+	}
+
+	err := u.tracker.check(accountID, append(pending, changes...))
+	if err != nil {
+		return err
+	}
+
+	err = fn()
+	if err != nil {
+		return err
+	}
+
+	if u.batch != nil {
+		u.batch.changes = append(u.batch.changes, changes...)
+		u.batch.versions[string(accountID)+string(id)] = model
+		return nil
+	}
+
+	u.tracker.apply(changes)
+	return nil
+}
+
+// added returns the change of storing the version.
+func added(accountID []byte, model Model) (usageChange, error) {
+	size, err := versionSize(model)
+	if err != nil {
+		return usageChange{}, err
+	}
+
+	return usageChange{accountID: string(accountID), docType: model.DocumentType(), versions: 1, bytes: size}, nil
+}
+
+// removed returns the change of removing the stored version.
+func removed(accountID []byte, model Model) (usageChange, error) {
+	c, err := added(accountID, model)
+	c.versions, c.bytes = -c.versions, -c.bytes
+	return c, err
+}
+
+// Create creates the model and accounts for its size.
+func (u *usageRepo) Create(accountID, id []byte, model Model) error {
+	c, err := added(accountID, model)
+	if err != nil {
+		return err
+	}
+
+	return u.write(accountID, id, model, []usageChange{c}, func() error {
+		return u.Repository.Create(accountID, id, model)
+	})
+}
+
+// Update updates the model and accounts for the change of its size.
+func (u *usageRepo) Update(accountID, id []byte, model Model) error {
+	var changes []usageChange
+	if old, ok := u.stored(accountID, id); ok {
+		c, err := removed(accountID, old)
+		if err != nil {
+			return err
+		}
+
+		changes = append(changes, c)
+	}
+
+	c, err := added(accountID, model)
+	if err != nil {
+		return err
+	}
+
+	return u.write(accountID, id, model, append(changes, c), func() error {
+		return u.Repository.Update(accountID, id, model)
+	})
+}
+
+// Delete removes the version and accounts for its size.
+func (u *usageRepo) Delete(accountID, id []byte) error {
+	old, ok := u.stored(accountID, id)
+	if !ok {
+		// nothing to account for
+		return u.Repository.Delete(accountID, id)
+	}
+
+	c, err := removed(accountID, old)
+	if err != nil {
+		return err
+	}
+
+	return u.write(accountID, id, nil, []usageChange{c}, func() error {
+		return u.Repository.Delete(accountID, id)
+	})
+}
+
+// Atomic calls fn with a Repository whose writes are batched.
+// The writes are accounted for only once they are committed.
+func (u *usageRepo) Atomic(fn func(repo Repository) error) error {
+	if u.batch != nil {
+		return fn(u)
+	}
+
+	batch := &usageBatch{versions: make(map[string]Model)}
+	err := u.Repository.Atomic(func(repo Repository) error {
+		return fn(&usageRepo{Repository: repo, tracker: u.tracker, batch: batch})
+	})
+	if err != nil {
+		return err
+	}
+
+	u.tracker.apply(batch.changes)
+	return nil
+}
+
+var (
+	publishUsageOnce sync.Once
+	publishedUsage   atomic.Value
+)
+
+// PublishUsageMetrics publishes the storage usage of the tracker as the expvar "document_storage", keyed by account.
+// The expvar is served on /debug/vars if pprof is enabled. Later calls replace the published tracker.
+func PublishUsageMetrics(t *UsageTracker) {
+	publishedUsage.Store(t)
+	publishUsageOnce.Do(func() {
+		expvar.Publish(usageMetricsName, expvar.Func(func() interface{} {
+			t, _ := publishedUsage.Load().(*UsageTracker)
+			metrics := make(map[string]StorageUsage)
+			for _, su := range t.Accounts() {
+				metrics[hexutil.Encode(su.AccountID)] = su
+			}
+
+			return metrics
+		}))
+	})
+}
@@ -0,0 +1,170 @@
+// +build unit
+
+package documents
+
+import (
+	"encoding/json"
+	"expvar"
+	"reflect"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type typedDoc struct {
+	doc
+	DocType string `json:"doc_type"`
+}
+
+func (m *typedDoc) DocumentType() string { return m.DocType }
+
+func (m *typedDoc) JSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *typedDoc) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+func (m *typedDoc) Type() reflect.Type {
+	return reflect.TypeOf(m)
+}
+
+func newUsageTracker(t *testing.T, quota uint64) (*UsageTracker, Repository) {
+	db, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	base := NewDBRepository(leveldb.NewLevelDBRepository(db))
+	base.Register(&typedDoc{})
+	return NewUsageTracker(base, quota), base
+}
+
+func docSize(t *testing.T, d *typedDoc) uint64 {
+	size, err := versionSize(d)
+	assert.NoError(t, err)
+	return uint64(size)
+}
+
+func TestUsageTracker_Usage(t *testing.T) {
+	tracker, base := newUsageTracker(t, 0)
+	repo := tracker.Repository()
+	accountID, otherID := utils.RandomSlice(32), utils.RandomSlice(32)
+
+	// versions stored before the account is loaded are accounted for
+	inv := &typedDoc{doc: doc{SomeString: "invoice"}, DocType: "invoice"}
+	assert.NoError(t, base.Create(accountID, utils.RandomSlice(32), inv))
+
+	po := &typedDoc{doc: doc{SomeString: "po"}, DocType: "purchaseorder"}
+	poID := utils.RandomSlice(32)
+	assert.NoError(t, repo.Create(accountID, poID, po))
+	assert.NoError(t, repo.Create(otherID, utils.RandomSlice(32), po))
+	usage, err := tracker.Usage(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, accountID, usage.AccountID)
+	assert.Equal(t, docSize(t, inv)+docSize(t, po), usage.TotalBytes)
+	assert.Equal(t, uint64(0), usage.QuotaBytes)
+	assert.Equal(t, []TypeStorageUsage{
+		{DocumentType: "invoice", Versions: 1, Bytes: docSize(t, inv)},
+		{DocumentType: "purchaseorder", Versions: 1, Bytes: docSize(t, po)},
+	}, usage.DocumentTypes)
+
+	// update
+	po = &typedDoc{doc: doc{SomeString: "purchase order"}, DocType: "purchaseorder"}
+	assert.NoError(t, repo.Update(accountID, poID, po))
+	usage, err = tracker.Usage(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, TypeStorageUsage{DocumentType: "purchaseorder", Versions: 1, Bytes: docSize(t, po)}, usage.DocumentTypes[1])
+
+	// delete
+	assert.NoError(t, repo.Delete(accountID, poID))
+	usage, err = tracker.Usage(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, docSize(t, inv), usage.TotalBytes)
+	assert.Len(t, usage.DocumentTypes, 1)
+
+	// failed batch is not accounted for
+	err = repo.Atomic(func(repo Repository) error {
+		assert.NoError(t, repo.Create(accountID, poID, po))
+		return errors.New("failed")
+	})
+	assert.Error(t, err)
+	usage, err = tracker.Usage(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, docSize(t, inv), usage.TotalBytes)
+
+	// a version updated in the batch it is created in is accounted for once
+	err = repo.Atomic(func(repo Repository) error {
+		err := repo.Create(accountID, poID, inv)
+		if err != nil {
+			return err
+		}
+
+		return repo.Update(accountID, poID, po)
+	})
+	assert.NoError(t, err)
+	usage, err = tracker.Usage(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, docSize(t, inv)+docSize(t, po), usage.TotalBytes)
+	assert.Equal(t, TypeStorageUsage{DocumentType: "purchaseorder", Versions: 1, Bytes: docSize(t, po)}, usage.DocumentTypes[1])
+
+	// only the loaded accounts are listed
+	accounts := tracker.Accounts()
+	assert.Len(t, accounts, 1)
+	assert.Equal(t, accountID, accounts[0].AccountID)
+	_, err = tracker.Usage(otherID)
+	assert.NoError(t, err)
+	assert.Len(t, tracker.Accounts(), 2)
+}
+
+func TestUsageTracker_Quota(t *testing.T) {
+	d := &typedDoc{doc: doc{SomeString: "invoice"}, DocType: "invoice"}
+	tracker, _ := newUsageTracker(t, docSize(t, d)+1)
+	repo := tracker.Repository()
+	accountID, id := utils.RandomSlice(32), utils.RandomSlice(32)
+	assert.NoError(t, repo.Create(accountID, id, d))
+
+	// over the quota
+	err := repo.Create(accountID, utils.RandomSlice(32), d)
+	assert.True(t, errors.IsOfType(ErrDocumentStorageQuota, err))
+	big := &typedDoc{doc: doc{SomeString: "invoices"}, DocType: "invoice"}
+	err = repo.Update(accountID, id, &typedDoc{doc: doc{SomeString: "invoice 42"}, DocType: "invoice"})
+	assert.True(t, errors.IsOfType(ErrDocumentStorageQuota, err))
+
+	// growing within the quota and shrinking are accepted
+	assert.NoError(t, repo.Update(accountID, id, big))
+	assert.NoError(t, repo.Update(accountID, id, &typedDoc{DocType: "invoice"}))
+
+	// the writes of a batch are checked together
+	err = repo.Atomic(func(repo Repository) error {
+		err := repo.Update(accountID, id, d)
+		if err != nil {
+			return err
+		}
+
+		return repo.Create(accountID, utils.RandomSlice(32), &typedDoc{DocType: "invoice"})
+	})
+	assert.True(t, errors.IsOfType(ErrDocumentStorageQuota, err))
+
+	// other accounts have their own quota
+	assert.NoError(t, repo.Create(utils.RandomSlice(32), utils.RandomSlice(32), d))
+	usage, err := tracker.Usage(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, docSize(t, d)+1, usage.QuotaBytes)
+}
+
+func TestPublishUsageMetrics(t *testing.T) {
+	tracker, _ := newUsageTracker(t, 0)
+	accountID := utils.RandomSlice(32)
+	assert.NoError(t, tracker.Repository().Create(accountID, utils.RandomSlice(32), &typedDoc{DocType: "invoice"}))
+	_, err := tracker.Usage(accountID)
+	assert.NoError(t, err)
+
+	PublishUsageMetrics(tracker)
+	v := expvar.Get(usageMetricsName)
+	assert.NotNil(t, v)
+	var metrics map[string]StorageUsage
+	assert.NoError(t, json.Unmarshal([]byte(v.String()), &metrics))
+	assert.Len(t, metrics, 1)
+}
@@ -0,0 +1,132 @@
+package documents
+
+import (
+	"context"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/utils"
+)
+
+// ValidationGroup is a group of validators of a document version run on its own by ValidateVersion.
+type ValidationGroup string
+
+const (
+	// ValidationGroupFields validates the core document fields and the data fields of the document type
+	ValidationGroupFields ValidationGroup = "fields"
+
+	// ValidationGroupSignatures validates the signing root, the collaborator and attribute signatures
+	ValidationGroupSignatures ValidationGroup = "signatures"
+
+	// ValidationGroupAnchors validates the document root against the anchored root and the anchor time
+	ValidationGroupAnchors ValidationGroup = "anchors"
+
+	// ValidationGroupTransitions validates the changes from the previous version against the transition rules
+	ValidationGroupTransitions ValidationGroup = "transitions"
+)
+
+// ValidationGroups are the validation groups in the order they run.
+var ValidationGroups = []ValidationGroup{
+	ValidationGroupFields,
+	ValidationGroupSignatures,
+	ValidationGroupAnchors,
+	ValidationGroupTransitions,
+}
+
+// FieldsValidator is implemented by the document services that validate the data fields of their documents.
+type FieldsValidator interface {
+	// ValidateFields validates the data fields of the model.
+	ValidateFields(model Model) error
+}
+
+// ValidationResult is the outcome of a validation group on a document version.
+type ValidationResult struct {
+	Group ValidationGroup
+	Valid bool
+
+	// Skipped is true if the group doesn't apply to the version, the transitions of a first version
+	Skipped bool
+
+	// Errors are the failures of the validators of the group
+	Errors   []string
+	Duration time.Duration
+}
+
+// ValidationReporter is called with the result of each validation group as soon as the group completes.
+// Returning an error stops the validation.
+type ValidationReporter func(result ValidationResult) error
+
+// ValidateVersion runs the validation groups on the version of the document one after the other and reports the
+// result of each group as it completes. A failing group doesn't stop the following ones.
+// Returns an error if the version is not found, the context is done or the reporter fails.
+func (s service) ValidateVersion(ctx context.Context, documentID, version []byte, report ValidationReporter) error {
+	model, err := s.getVersion(ctx, documentID, version)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range ValidationGroups {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		start := time.Now()
+		skipped, verr := s.validateGroup(ctx, group, model)
+		res := ValidationResult{Group: group, Valid: verr == nil, Skipped: skipped, Duration: time.Since(start)}
+		for _, err := range errors.GetErrs(verr) {
+			res.Errors = append(res.Errors, err.Error())
+		}
+
+		if err := report(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateGroup runs the validators of the group on the model.
+// Returns true if the group doesn't apply to the model.
+func (s service) validateGroup(ctx context.Context, group ValidationGroup, model Model) (bool, error) {
+	switch group {
+	case ValidationGroupFields:
+		err := baseValidator().Validate(nil, model)
+		if srv, lerr := s.getService(model); lerr == nil {
+			if fv, ok := srv.(FieldsValidator); ok {
+				err = errors.AppendError(err, fv.ValidateFields(model))
+			}
+		}
+
+		return false, err
+	case ValidationGroupSignatures:
+		return false, ValidatorGroup{
+			signingRootValidator(),
+			signaturesValidator(s.idService),
+			requiredSignaturesValidator(),
+			attributeSignaturesValidator(s.idService),
+		}.Validate(nil, model)
+	case ValidationGroupAnchors:
+		return false, ValidatorGroup{
+			documentRootValidator(),
+			anchoredValidator(s.anchorRepository),
+			anchoredTimeValidator(s.anchorRepository, s.anchorTimestampTolerance),
+		}.Validate(nil, model)
+	case ValidationGroupTransitions:
+		prev := model.PreviousVersion()
+		if utils.IsEmptyByteSlice(prev) {
+			return true, nil
+		}
+
+		old, err := s.getVersion(ctx, model.ID(), prev)
+		if err != nil {
+			return false, errors.New("previous version %x not found: %v", prev, err)
+		}
+
+		return false, ValidatorGroup{
+			UpdateVersionValidator(),
+			transitionValidator(model.Author()),
+		}.Validate(old, model)
+	default:
+		return false, errors.New("unknown validation group %s", group)
+	}
+}
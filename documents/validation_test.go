@@ -0,0 +1,154 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type validationRepo struct {
+	Repository
+	models map[string]Model
+}
+
+func (r validationRepo) Get(accountID, id []byte) (Model, error) {
+	m, ok := r.models[string(id)]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	return m, nil
+}
+
+// fieldsService fails the field validation of every document.
+type fieldsService struct {
+	Service
+	err error
+}
+
+func (s fieldsService) ValidateFields(model Model) error {
+	return s.err
+}
+
+func validationModel(id, prev, current, next, docRoot []byte, ts time.Time) *mockModel {
+	m := new(mockModel)
+	m.On("ID").Return(id)
+	m.On("PreviousVersion").Return(prev)
+	m.On("CurrentVersion").Return(current)
+	m.On("NextVersion").Return(next)
+	m.On("DocumentType").Return("validated")
+	m.On("CalculateSigningRoot").Return(utils.RandomSlice(32), nil)
+	m.On("CalculateDocumentRoot").Return(docRoot, nil)
+	m.On("Timestamp").Return(ts, nil)
+	m.On("GetSignerCollaborators", mock.Anything).Return(nil, nil)
+	m.On("CheckRequiredSignatures").Return(nil)
+	m.On("Signatures")
+	return m
+}
+
+func TestService_ValidateVersion(t *testing.T) {
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	author := testingidentity.GenerateRandomDID()
+	ts := time.Now().UTC()
+	id, v1, v2, v3 := utils.RandomSlice(32), utils.RandomSlice(32), utils.RandomSlice(32), utils.RandomSlice(32)
+	dr1, dr2 := utils.RandomSlice(32), utils.RandomSlice(32)
+
+	// first version without signatures
+	first := validationModel(id, nil, v1, v2, dr1, ts)
+	first.On("Author").Return(author)
+	first.On("CollaboratorCanUpdate", mock.Anything, author).Return(nil)
+
+	// second version signed by the author, not anchored
+	second := validationModel(id, v1, v2, v3, dr2, ts)
+	second.On("Author").Return(author)
+	second.On("PreviousDocumentRoot").Return(dr1)
+	second.sigs = []*coredocumentpb.Signature{{SignerId: author[:], PublicKey: utils.RandomSlice(32), Signature: utils.RandomSlice(65)}}
+
+	idService := new(testingcommons.MockIdentityService)
+	idService.On("ValidateSignature", author, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	anchorRepo := new(mockRepo)
+	a1, err := anchors.ToAnchorID(v1)
+	assert.NoError(t, err)
+	a2, err := anchors.ToAnchorID(v2)
+	assert.NoError(t, err)
+	root1, err := anchors.ToDocumentRoot(dr1)
+	assert.NoError(t, err)
+	anchorRepo.On("GetAnchorData", a1).Return(root1, ts, nil)
+	anchorRepo.On("GetAnchorData", a2).Return(nil, nil, errors.New("anchor not found"))
+	registry := NewServiceRegistry()
+	assert.NoError(t, registry.Register("validated", fieldsService{err: NewError("currency", "invalid currency")}))
+	srv := service{
+		repo:                     validationRepo{models: map[string]Model{string(v1): first, string(v2): second}},
+		registry:                 registry,
+		idService:                idService,
+		anchorRepository:         anchorRepo,
+		anchorTimestampTolerance: time.Minute,
+	}
+
+	var results []ValidationResult
+	report := func(res ValidationResult) error {
+		results = append(results, res)
+		return nil
+	}
+
+	// unknown version
+	err = srv.ValidateVersion(actx, id, utils.RandomSlice(32), report)
+	assert.True(t, errors.IsOfType(ErrDocumentVersionNotFound, err))
+	assert.Empty(t, results)
+
+	err = srv.ValidateVersion(actx, id, v1, report)
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+	for i, group := range ValidationGroups {
+		assert.Equal(t, group, results[i].Group)
+	}
+	assert.False(t, results[0].Valid)
+	assert.Len(t, results[0].Errors, 1)
+	assert.Contains(t, results[0].Errors[0], "invalid currency")
+	assert.False(t, results[1].Valid)
+	assert.Contains(t, results[1].Errors[0], "atleast one signature expected")
+	assert.True(t, results[2].Valid)
+	assert.Empty(t, results[2].Errors)
+	assert.True(t, results[3].Valid)
+	assert.True(t, results[3].Skipped)
+
+	results = nil
+	err = srv.ValidateVersion(actx, id, v2, report)
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+	assert.False(t, results[0].Valid)
+	assert.True(t, results[1].Valid)
+	assert.False(t, results[2].Valid)
+	assert.Contains(t, results[2].Errors[0], "anchor not found")
+	assert.True(t, results[3].Valid)
+	assert.False(t, results[3].Skipped)
+
+	// the reporter stops the validation
+	results = nil
+	err = srv.ValidateVersion(actx, id, v2, func(res ValidationResult) error {
+		results = append(results, res)
+		return context.Canceled
+	})
+	assert.Equal(t, context.Canceled, err)
+	assert.Len(t, results, 1)
+
+	// done context
+	cctx, cancel := context.WithCancel(actx)
+	cancel()
+	results = nil
+	err = srv.ValidateVersion(cctx, id, v2, report)
+	assert.Equal(t, context.Canceled, err)
+	assert.Empty(t, results)
+}
@@ -20,6 +20,22 @@ type Validator interface {
 	Validate(oldState Model, newState Model) error
 }
 
+// ValidationStage is a stage of the lifecycle of a document at which additional validators can be registered.
+type ValidationStage string
+
+const (
+	// ValidationStageSignature runs before the document is signed, by the author before requesting the signatures
+	// of the collaborators and by the collaborators before signing the received document
+	ValidationStageSignature ValidationStage = "signature"
+
+	// ValidationStagePreAnchor runs before the document is anchored
+	ValidationStagePreAnchor ValidationStage = "pre_anchor"
+
+	// ValidationStagePostAnchored runs before the anchored document is sent to the collaborators and when an
+	// anchored document is received
+	ValidationStagePostAnchored ValidationStage = "post_anchored"
+)
+
 // ValidatorGroup implements Validator for validating a set of validators.
 type ValidatorGroup []Validator
 
@@ -49,12 +65,18 @@ func (vf ValidatorFunc) Validate(old, new Model) error {
 }
 
 // UpdateVersionValidator validates if the new core document is properly derived from old one
+// and that the old one didn't cancel the document
 func UpdateVersionValidator() Validator {
 	return ValidatorFunc(func(old, new Model) error {
 		if old == nil || new == nil {
 			return errors.New("need both the old and new model")
 		}
 
+		err := checkNotCancelled(old)
+		if err != nil {
+			return err
+		}
+
 		dr, err := old.CalculateDocumentRoot()
 		if err != nil {
 			return errors.New("failed to get previous version document root: %v", err)
@@ -261,6 +283,19 @@ func signaturesValidator(idService identity.ServiceDID) Validator {
 	})
 }
 
+// requiredSignaturesValidator checks that the collaborators of the read sign rules added the signatures the rules require.
+// should be called once the signatures are collected
+func requiredSignaturesValidator() Validator {
+	return ValidatorFunc(func(_, model Model) error {
+		err := model.CheckRequiredSignatures()
+		if err != nil {
+			return errors.New("required signatures missing: %v", err)
+		}
+
+		return nil
+	})
+}
+
 // anchoredValidator checks if the document root matches the one on chain with specific anchorID
 // assumes document root is generated and verified
 func anchoredValidator(repo anchors.AnchorRepository) Validator {
@@ -302,14 +337,91 @@ func anchoredValidator(repo anchors.AnchorRepository) Validator {
 	})
 }
 
+// anchoredTimeValidator checks that the document was not timestamped after the time it was anchored at.
+// tolerance allows for the clock drift between the node of the author and the chain. The check is disabled if tolerance is zero.
+// assumes the anchor is verified
+func anchoredTimeValidator(repo anchors.AnchorRepository, tolerance time.Duration) Validator {
+	return ValidatorFunc(func(_, model Model) error {
+		if tolerance == 0 {
+			return nil
+		}
+
+		anchorID, err := anchors.ToAnchorID(model.CurrentVersion())
+		if err != nil {
+			return errors.New("failed to get anchorID: %v", err)
+		}
+
+		_, anchoredAt, err := repo.GetAnchorData(anchorID)
+		if err != nil {
+			return errors.New("failed to get anchor %s from chain: %v", anchorID.String(), err)
+		}
+
+		tm, err := model.Timestamp()
+		if err != nil {
+			return errors.New("failed to get model update time: %v", err)
+		}
+
+		if tm.After(anchoredAt.Add(tolerance)) {
+			return errors.New("document timestamp %s is ahead of the anchor time %s of anchor %s", tm.UTC(), anchoredAt.UTC(), anchorID.String())
+		}
+
+		return nil
+	})
+}
+
+// checkAnchor is a fast path to verify that the anchor of the model exists on chain and holds the model's document root.
+// Returns ErrDocumentAnchorNotMined if the anchor doesn't exist yet and ErrDocumentAnchorRootMismatch if the roots differ.
+func checkAnchor(repo anchors.AnchorRepository, model Model) error {
+	anchorID, err := anchors.ToAnchorID(model.CurrentVersion())
+	if err != nil {
+		return errors.New("failed to get anchorID: %v", err)
+	}
+
+	dr, err := model.CalculateDocumentRoot()
+	if err != nil {
+		return errors.New("failed to get document root: %v", err)
+	}
+
+	return checkAnchoredRoot(repo, anchorID, dr)
+}
+
+// checkAnchoredRoot checks that the document root is anchored on chain with the anchorID.
+func checkAnchoredRoot(repo anchors.AnchorRepository, anchorID anchors.AnchorID, dr []byte) error {
+	docRoot, err := anchors.ToDocumentRoot(dr)
+	if err != nil {
+		return errors.New("failed to get document root: %v", err)
+	}
+
+	gotRoot, _, err := repo.GetAnchorData(anchorID)
+	if err != nil {
+		if errors.IsOfType(anchors.ErrAnchorNotFound, err) {
+			return errors.NewTypedError(ErrDocumentAnchorNotMined, errors.New("anchor %s", anchorID.String()))
+		}
+
+		return errors.New("failed to get document root for anchor %s from chain: %v", anchorID.String(), err)
+	}
+
+	if !utils.IsSameByteSlice(docRoot[:], gotRoot[:]) {
+		return errors.NewTypedError(ErrDocumentAnchorRootMismatch, errors.New("anchor %s", anchorID.String()))
+	}
+
+	return nil
+}
+
 // transitionValidator checks that the document changes are within the transition_rule capability of the
-// collaborator making the changes
+// collaborator making the changes and that the document was not cancelled
 func transitionValidator(collaborator identity.DID) Validator {
 	return ValidatorFunc(func(old, new Model) error {
 		if old == nil {
 			return nil
 		}
-		err := old.CollaboratorCanUpdate(new, collaborator)
+
+		err := checkNotCancelled(old)
+		if err != nil {
+			return err
+		}
+
+		err = old.CollaboratorCanUpdate(new, collaborator)
 		if err != nil {
 			return errors.New("invalid document state transition: %v", err)
 		}
@@ -336,11 +448,15 @@ func SignatureRequestValidator(sender identity.DID, idService identity.ServiceDI
 // signing root validator
 // document root validator
 // signatures validator
+// required signatures validator
+// attribute signatures validator
 // should be called before pre anchoring
 func PreAnchorValidator(idService identity.ServiceDID) ValidatorGroup {
 	return ValidatorGroup{
 		SignatureValidator(idService),
+		requiredSignaturesValidator(),
 		documentRootValidator(),
+		attributeSignaturesValidator(idService),
 	}
 }
 
@@ -358,13 +474,16 @@ func PostAnchoredValidator(idService identity.ServiceDID, repo anchors.AnchorRep
 // ReceivedAnchoredDocumentValidator is a validator group with following validators
 // transitionValidator
 // PostAnchoredValidator
+// anchoredTimeValidator
 func ReceivedAnchoredDocumentValidator(
 	idService identity.ServiceDID,
 	repo anchors.AnchorRepository,
-	collaborator identity.DID) ValidatorGroup {
+	collaborator identity.DID,
+	timestampTolerance time.Duration) ValidatorGroup {
 	return ValidatorGroup{
 		transitionValidator(collaborator),
 		PostAnchoredValidator(idService, repo),
+		anchoredTimeValidator(repo, timestampTolerance),
 	}
 }
 
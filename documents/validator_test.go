@@ -473,9 +473,27 @@ func TestValidator_signatureValidator(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestValidator_requiredSignaturesValidator(t *testing.T) {
+	rsv := requiredSignaturesValidator()
+
+	// missing signatures
+	model := new(mockModel)
+	model.On("CheckRequiredSignatures").Return(errors.New("read rule 0 requires 2 signatures of its collaborators, found 1")).Once()
+	err := rsv.Validate(nil, model)
+	model.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "required signatures missing")
+
+	// success
+	model = new(mockModel)
+	model.On("CheckRequiredSignatures").Return(nil).Once()
+	assert.NoError(t, rsv.Validate(nil, model))
+	model.AssertExpectations(t)
+}
+
 func TestPreAnchorValidator(t *testing.T) {
 	pav := PreAnchorValidator(nil)
-	assert.Len(t, pav, 2)
+	assert.Len(t, pav, 4)
 }
 
 func TestValidator_anchoredValidator(t *testing.T) {
@@ -565,11 +583,135 @@ func TestValidator_anchoredValidator(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCheckAnchor(t *testing.T) {
+	anchorID, err := anchors.ToAnchorID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+	docRoot, err := anchors.ToDocumentRoot(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	// failed anchorID
+	model := new(mockModel)
+	model.On("CurrentVersion").Return(nil).Once()
+	err = checkAnchor(mockRepo{}, model)
+	model.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get anchorID")
+
+	// anchor not mined yet
+	r := &mockRepo{}
+	r.On("GetAnchorData", anchorID).Return(nil, time.Now(), errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor"))).Once()
+	model = new(mockModel)
+	model.On("CurrentVersion").Return(anchorID[:]).Once()
+	model.On("CalculateDocumentRoot").Return(docRoot[:], nil).Once()
+	err = checkAnchor(r, model)
+	model.AssertExpectations(t)
+	r.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentAnchorNotMined, err))
+
+	// failed to get anchor from chain
+	r = &mockRepo{}
+	r.On("GetAnchorData", anchorID).Return(nil, time.Now(), errors.New("error")).Once()
+	model = new(mockModel)
+	model.On("CurrentVersion").Return(anchorID[:]).Once()
+	model.On("CalculateDocumentRoot").Return(docRoot[:], nil).Once()
+	err = checkAnchor(r, model)
+	model.AssertExpectations(t)
+	r.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.False(t, errors.IsOfType(ErrDocumentAnchorNotMined, err))
+	assert.Contains(t, err.Error(), "failed to get document root for anchor")
+
+	// root mismatch
+	r = &mockRepo{}
+	r.On("GetAnchorData", anchorID).Return(docRoot, time.Now(), nil).Once()
+	model = new(mockModel)
+	model.On("CurrentVersion").Return(anchorID[:]).Once()
+	model.On("CalculateDocumentRoot").Return(utils.RandomSlice(32), nil).Once()
+	err = checkAnchor(r, model)
+	model.AssertExpectations(t)
+	r.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentAnchorRootMismatch, err))
+
+	// success
+	r = &mockRepo{}
+	r.On("GetAnchorData", anchorID).Return(docRoot, time.Now(), nil).Once()
+	model = new(mockModel)
+	model.On("CurrentVersion").Return(anchorID[:]).Once()
+	model.On("CalculateDocumentRoot").Return(docRoot[:], nil).Once()
+	err = checkAnchor(r, model)
+	model.AssertExpectations(t)
+	r.AssertExpectations(t)
+	assert.NoError(t, err)
+}
+
+func TestValidator_anchoredTimeValidator(t *testing.T) {
+	anchorID, err := anchors.ToAnchorID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+	docRoot := anchors.RandomDocumentRoot()
+	anchoredAt := time.Now().UTC()
+
+	// disabled
+	model := new(mockModel)
+	err = anchoredTimeValidator(mockRepo{}, 0).Validate(nil, model)
+	model.AssertExpectations(t)
+	assert.NoError(t, err)
+
+	// failed anchorID
+	av := anchoredTimeValidator(mockRepo{}, time.Minute)
+	model = new(mockModel)
+	model.On("CurrentVersion").Return(nil).Once()
+	err = av.Validate(nil, model)
+	model.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get anchorID")
+
+	// failed to get anchor from chain
+	r := &mockRepo{}
+	r.On("GetAnchorData", anchorID).Return(nil, time.Time{}, errors.New("error")).Once()
+	model = new(mockModel)
+	model.On("CurrentVersion").Return(anchorID[:]).Once()
+	err = anchoredTimeValidator(r, time.Minute).Validate(nil, model)
+	model.AssertExpectations(t)
+	r.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get anchor")
+
+	// timestamped after the anchor time beyond the tolerance
+	r = &mockRepo{}
+	r.On("GetAnchorData", anchorID).Return(docRoot, anchoredAt, nil).Once()
+	model = new(mockModel)
+	model.On("CurrentVersion").Return(anchorID[:]).Once()
+	model.On("Timestamp").Return(anchoredAt.Add(time.Minute+time.Second), nil).Once()
+	err = anchoredTimeValidator(r, time.Minute).Validate(nil, model)
+	model.AssertExpectations(t)
+	r.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is ahead of the anchor time")
+
+	// within the tolerance
+	r = &mockRepo{}
+	r.On("GetAnchorData", anchorID).Return(docRoot, anchoredAt, nil).Once()
+	model = new(mockModel)
+	model.On("CurrentVersion").Return(anchorID[:]).Once()
+	model.On("Timestamp").Return(anchoredAt.Add(time.Minute), nil).Once()
+	err = anchoredTimeValidator(r, time.Minute).Validate(nil, model)
+	model.AssertExpectations(t)
+	r.AssertExpectations(t)
+	assert.NoError(t, err)
+}
+
 func TestPostAnchoredValidator(t *testing.T) {
 	pav := PostAnchoredValidator(nil, nil)
 	assert.Len(t, pav, 2)
 }
 
+func TestReceivedAnchoredDocumentValidator(t *testing.T) {
+	rav := ReceivedAnchoredDocumentValidator(nil, nil, testingidentity.GenerateRandomDID(), time.Minute)
+	assert.Len(t, rav, 3)
+}
+
 func TestSignatureRequestValidator(t *testing.T) {
 	srv := SignatureRequestValidator(testingidentity.GenerateRandomDID(), nil)
 	assert.Len(t, srv, 3)
@@ -0,0 +1,323 @@
+package documents
+
+import (
+	"context"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/crypto"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// VerifyProofBundle verifies the proof bundle without a node.
+// Every field proof must resolve to the document root and every bundle signature must be a valid signature
+// of its public key over the bundle. Whether the public keys belong to the signer identities is not verified.
+func VerifyProofBundle(proof *DocumentProof) error {
+	if len(proof.DocumentRoot) != anchors.DocumentRootLength {
+		return errors.NewTypedError(ErrInvalidProofBundle, errors.New("invalid document root length %d", len(proof.DocumentRoot)))
+	}
+
+	if len(proof.FieldProofs) == 0 {
+		return errors.NewTypedError(ErrInvalidProofBundle, errors.New("no field proofs"))
+	}
+
+	err := validateFieldProofs(proof)
+	if err != nil {
+		return errors.NewTypedError(ErrInvalidProofBundle, err)
+	}
+
+	if len(proof.Signatures) == 0 {
+		return nil
+	}
+
+	msg, err := ProofBundleMessage(proof)
+	if err != nil {
+		return errors.NewTypedError(ErrInvalidProofBundle, err)
+	}
+
+	for _, sig := range proof.Signatures {
+		if !crypto.VerifyMessage(sig.PublicKey, msg, sig.Signature, crypto.CurveSecp256K1) {
+			return errors.NewTypedError(ErrInvalidProofBundle, errors.New("invalid signature of %s", hexutil.Encode(sig.SignerId)))
+		}
+	}
+
+	return nil
+}
+
+// ValidateProofAnchor checks that the document root of the proof bundle is anchored for the version of the bundle.
+// anchoredRoot returns the document root anchored with the anchor ID, like anchors.GetAnchoredRoot.
+// Returns ErrDocumentAnchorNotMined if the anchor doesn't exist and ErrDocumentAnchorRootMismatch if the roots differ.
+func ValidateProofAnchor(proof *DocumentProof, anchoredRoot func(anchorID anchors.AnchorID) (anchors.DocumentRoot, error)) error {
+	anchorID, err := anchors.ToAnchorID(proof.VersionID)
+	if err != nil {
+		return errors.New("failed to get anchorID: %v", err)
+	}
+
+	gotRoot, err := anchoredRoot(anchorID)
+	if err != nil {
+		if errors.IsOfType(anchors.ErrAnchorNotFound, err) {
+			return errors.NewTypedError(ErrDocumentAnchorNotMined, errors.New("anchor %s", anchorID.String()))
+		}
+
+		return errors.New("failed to get document root for anchor %s from chain: %v", anchorID.String(), err)
+	}
+
+	if !utils.IsSameByteSlice(proof.DocumentRoot, gotRoot[:]) {
+		return errors.NewTypedError(ErrDocumentAnchorRootMismatch, errors.New("anchor %s", anchorID.String()))
+	}
+
+	return nil
+}
+
+// FieldProofResult is the outcome of the verification of a single field proof.
+type FieldProofResult struct {
+	// Property is the compact name of the proven field
+	Property []byte
+	Valid    bool
+
+	// Error is the reason the field proof is invalid
+	Error string
+}
+
+// ProofVerification is the outcome of the verification of a proof bundle against the anchor repository.
+type ProofVerification struct {
+	AnchorID     []byte
+	DocumentRoot []byte
+
+	// Anchored is true if the document root is the root anchored with the anchor ID
+	Anchored bool
+
+	// AnchorError is the reason the document root is not anchored
+	AnchorError string
+	Fields      []FieldProofResult
+}
+
+// Valid returns true if the document root is anchored and every field proof resolves to it.
+func (pv *ProofVerification) Valid() bool {
+	if !pv.Anchored {
+		return false
+	}
+
+	for _, f := range pv.Fields {
+		if !f.Valid {
+			return false
+		}
+	}
+
+	return true
+}
+
+// VerifyProof verifies every field proof of the bundle against its document root and checks the root against the
+// anchor repository. The version of the bundle is the anchor ID. If the bundle has no document root, the field proofs are
+// verified against the anchored root. Bundle signatures are not verified.
+func (s service) VerifyProof(ctx context.Context, proof *DocumentProof) (*ProofVerification, error) {
+	if len(proof.FieldProofs) == 0 {
+		return nil, errors.NewTypedError(ErrInvalidProofBundle, errors.New("no field proofs"))
+	}
+
+	anchorID, err := anchors.ToAnchorID(proof.VersionID)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrInvalidProofBundle, errors.New("failed to get anchorID: %v", err))
+	}
+
+	verify, err := ProofVerifier(proof)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrInvalidProofBundle, err)
+	}
+
+	pv := &ProofVerification{AnchorID: anchorID[:], DocumentRoot: proof.DocumentRoot}
+	if len(pv.DocumentRoot) > 0 && len(pv.DocumentRoot) != anchors.DocumentRootLength {
+		return nil, errors.NewTypedError(ErrInvalidProofBundle, errors.New("invalid document root length %d", len(pv.DocumentRoot)))
+	}
+
+	gotRoot, _, err := s.anchorRepository.GetAnchorData(anchorID)
+	switch {
+	case err == nil:
+		if len(pv.DocumentRoot) == 0 {
+			pv.DocumentRoot = gotRoot[:]
+		}
+
+		pv.Anchored = utils.IsSameByteSlice(pv.DocumentRoot, gotRoot[:])
+		if !pv.Anchored {
+			pv.AnchorError = ErrDocumentAnchorRootMismatch.Error()
+		}
+	case errors.IsOfType(anchors.ErrAnchorNotFound, err):
+		if len(pv.DocumentRoot) == 0 {
+			return nil, errors.NewTypedError(ErrDocumentAnchorNotMined, errors.New("anchor %s", anchorID.String()))
+		}
+
+		pv.AnchorError = ErrDocumentAnchorNotMined.Error()
+	default:
+		return nil, errors.New("failed to get document root for anchor %s from chain: %v", anchorID.String(), err)
+	}
+
+	for _, p := range proof.FieldProofs {
+		f := FieldProofResult{Property: p.GetCompactName(), Valid: true}
+		err = verify(pv.DocumentRoot, p)
+		if err != nil {
+			f.Valid = false
+			f.Error = err.Error()
+		}
+
+		pv.Fields = append(pv.Fields, f)
+	}
+
+	return pv, nil
+}
+
+// ConvertProofVerificationToClientFormat converts the verification of a proof bundle to client api format.
+func ConvertProofVerificationToClientFormat(pv *ProofVerification) *documentpb.VerifyProofResponse {
+	resp := &documentpb.VerifyProofResponse{
+		Valid:        pv.Valid(),
+		AnchorId:     hexutil.Encode(pv.AnchorID),
+		DocumentRoot: hexutil.Encode(pv.DocumentRoot),
+		Anchored:     pv.Anchored,
+		AnchorError:  pv.AnchorError,
+	}
+
+	for _, f := range pv.Fields {
+		resp.FieldProofs = append(resp.FieldProofs, &documentpb.FieldProofResult{
+			Property: hexutil.Encode(f.Property),
+			Valid:    f.Valid,
+			Error:    f.Error,
+		})
+	}
+
+	return resp
+}
+
+// ConvertClientProofToDocProof converts a proof bundle in client api format to a DocumentProof.
+func ConvertClientProofToDocProof(proof *documentpb.DocumentProof) (*DocumentProof, error) {
+	dp := new(DocumentProof)
+	var err error
+	if proof.Header != nil {
+		dp.DocumentID, err = decodeHex(proof.Header.DocumentId)
+		if err != nil {
+			return nil, errors.New("failed to decode document id: %v", err)
+		}
+
+		dp.VersionID, err = decodeHex(proof.Header.VersionId)
+		if err != nil {
+			return nil, errors.New("failed to decode version id: %v", err)
+		}
+
+		dp.State = proof.Header.State
+	}
+
+	dp.DocumentRoot, err = decodeHex(proof.DocumentRoot)
+	if err != nil {
+		return nil, errors.New("failed to decode document root: %v", err)
+	}
+
+	dp.FieldProofs, err = ConvertProofsFromClientFormat(proof.FieldProofs)
+	if err != nil {
+		return nil, err
+	}
+
+	// the fields of a multiproof are verified like the field proofs
+	if proof.MultiProof != nil {
+		mp, err := ConvertMultiProofFromClientFormat(proof.MultiProof)
+		if err != nil {
+			return nil, err
+		}
+
+		prfs, err := mp.Proofs()
+		if err != nil {
+			return nil, err
+		}
+
+		dp.FieldProofs = append(dp.FieldProofs, prfs...)
+	}
+
+	dp.Envelope, err = ConvertProofEnvelopeFromClientFormat(proof.Envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, sig := range proof.Signatures {
+		s := new(coredocumentpb.Signature)
+		for _, f := range []struct {
+			name string
+			val  string
+			b    *[]byte
+		}{
+			{"signer id", sig.SignerId, &s.SignerId},
+			{"public key", sig.PublicKey, &s.PublicKey},
+			{"signature", sig.Signature, &s.Signature},
+		} {
+			*f.b, err = decodeHex(f.val)
+			if err != nil {
+				return nil, errors.New("failed to decode %s of signature %d: %v", f.name, i, err)
+			}
+		}
+
+		dp.Signatures = append(dp.Signatures, s)
+	}
+
+	return dp, nil
+}
+
+// ConvertProofsFromClientFormat converts client protobuf proofs into precise proofs.
+func ConvertProofsFromClientFormat(clientProofs []*documentpb.Proof) ([]*proofspb.Proof, error) {
+	var converted []*proofspb.Proof
+	for i, cp := range clientProofs {
+		p, err := ConvertProofFromClientFormat(cp)
+		if err != nil {
+			return nil, errors.New("failed to convert proof %d: %v", i, err)
+		}
+
+		converted = append(converted, p)
+	}
+
+	return converted, nil
+}
+
+// ConvertProofFromClientFormat converts a client protobuf proof into a precise proof.
+// The property of the client proof is the hex encoded compact name of the field.
+func ConvertProofFromClientFormat(cp *documentpb.Proof) (*proofspb.Proof, error) {
+	p := new(proofspb.Proof)
+	compact, err := decodeHex(cp.Property)
+	if err != nil {
+		return nil, errors.New("failed to decode property: %v", err)
+	}
+
+	p.Property = &proofspb.Proof_CompactName{CompactName: compact}
+	for _, f := range []struct {
+		name string
+		val  string
+		b    *[]byte
+	}{
+		{"value", cp.Value, &p.Value},
+		{"salt", cp.Salt, &p.Salt},
+		{"hash", cp.Hash, &p.Hash},
+	} {
+		*f.b, err = decodeHex(f.val)
+		if err != nil {
+			return nil, errors.New("failed to decode %s: %v", f.name, err)
+		}
+	}
+
+	for _, h := range cp.SortedHashes {
+		b, err := decodeHex(h)
+		if err != nil {
+			return nil, errors.New("failed to decode sorted hash: %v", err)
+		}
+
+		p.SortedHashes = append(p.SortedHashes, b)
+	}
+
+	return p, nil
+}
+
+// decodeHex decodes the 0x prefixed hex string. Empty values are decoded to nil.
+func decodeHex(s string) ([]byte, error) {
+	if s == "" || s == "0x" {
+		return nil, nil
+	}
+
+	return hexutil.Decode(s)
+}
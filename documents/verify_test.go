@@ -0,0 +1,226 @@
+// +build unit
+
+package documents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyProofBundle(t *testing.T) {
+	proof := testProofBundle(t)
+	assert.NoError(t, VerifyProofBundle(proof))
+
+	// signed bundle
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	acc, err := contextutil.Account(ctx)
+	assert.NoError(t, err)
+	msg, err := ProofBundleMessage(proof)
+	assert.NoError(t, err)
+	sig, err := acc.SignMsg(msg)
+	assert.NoError(t, err)
+	proof.Signatures = []*coredocumentpb.Signature{sig}
+	assert.NoError(t, VerifyProofBundle(proof))
+
+	// signature of another bundle
+	proof.DocumentID = utils.RandomSlice(32)
+	err = VerifyProofBundle(proof)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrInvalidProofBundle, err))
+
+	// invalid field proof
+	proof = testProofBundle(t)
+	proof.FieldProofs[1].SortedHashes[0] = utils.RandomSlice(32)
+	err = VerifyProofBundle(proof)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrInvalidProofBundle, err))
+
+	// no proofs
+	proof.FieldProofs = nil
+	err = VerifyProofBundle(proof)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrInvalidProofBundle, err))
+
+	// invalid root
+	proof.DocumentRoot = utils.RandomSlice(20)
+	err = VerifyProofBundle(proof)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrInvalidProofBundle, err))
+}
+
+func TestConvertClientProofToDocProof(t *testing.T) {
+	proof := testProofBundle(t)
+	proof.Signatures = []*coredocumentpb.Signature{{SignerId: utils.RandomSlice(20), PublicKey: utils.RandomSlice(32), Signature: utils.RandomSlice(65)}}
+	cp, err := ConvertDocProofToClientFormat(proof)
+	assert.NoError(t, err)
+	dp, err := ConvertClientProofToDocProof(cp)
+	assert.NoError(t, err)
+	assert.Equal(t, proof.DocumentID, dp.DocumentID)
+	assert.Equal(t, proof.VersionID, dp.VersionID)
+	assert.Equal(t, proof.DocumentRoot, dp.DocumentRoot)
+	assert.Equal(t, proof.Signatures, dp.Signatures)
+	assert.Len(t, dp.FieldProofs, 2)
+	for i, p := range dp.FieldProofs {
+		assert.Equal(t, proof.FieldProofs[i].GetCompactName(), p.GetCompactName())
+		assert.Equal(t, proof.FieldProofs[i].Hash, p.Hash)
+		assert.Equal(t, proof.FieldProofs[i].SortedHashes, p.SortedHashes)
+	}
+	assert.NoError(t, validateFieldProofs(dp))
+
+	// invalid hex
+	cp.FieldProofs[0].SortedHashes[0] = "0xzz"
+	_, err = ConvertClientProofToDocProof(cp)
+	assert.Error(t, err)
+	cp.DocumentRoot = "root"
+	_, err = ConvertClientProofToDocProof(cp)
+	assert.Error(t, err)
+	_, err = ConvertClientProofToDocProof(&documentpb.DocumentProof{Header: &documentpb.ResponseHeader{VersionId: "0x1"}})
+	assert.Error(t, err)
+}
+
+func TestValidateProofAnchor(t *testing.T) {
+	proof := testProofBundle(t)
+	var root anchors.DocumentRoot
+	copy(root[:], proof.DocumentRoot)
+	var gotID anchors.AnchorID
+	anchored := func(anchorID anchors.AnchorID) (anchors.DocumentRoot, error) {
+		gotID = anchorID
+		return root, nil
+	}
+
+	assert.NoError(t, ValidateProofAnchor(proof, anchored))
+	assert.Equal(t, proof.VersionID, gotID[:])
+
+	// different root anchored
+	root = anchors.RandomDocumentRoot()
+	err := ValidateProofAnchor(proof, anchored)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentAnchorRootMismatch, err))
+
+	// not anchored
+	err = ValidateProofAnchor(proof, func(anchorID anchors.AnchorID) (anchors.DocumentRoot, error) {
+		return root, errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor"))
+	})
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentAnchorNotMined, err))
+
+	// missing version
+	proof.VersionID = nil
+	assert.Error(t, ValidateProofAnchor(proof, anchored))
+}
+
+func TestService_VerifyProof(t *testing.T) {
+	ctx := context.Background()
+	anchorRepo := new(mockRepo)
+	srv := service{anchorRepository: anchorRepo}
+	proof := testProofBundle(t)
+	anchorID, err := anchors.ToAnchorID(proof.VersionID)
+	assert.NoError(t, err)
+	root, err := anchors.ToDocumentRoot(proof.DocumentRoot)
+	assert.NoError(t, err)
+
+	// anchored
+	anchorRepo.On("GetAnchorData", anchorID).Return(root, time.Now(), nil).Once()
+	pv, err := srv.VerifyProof(ctx, proof)
+	assert.NoError(t, err)
+	assert.True(t, pv.Valid())
+	assert.True(t, pv.Anchored)
+	assert.Equal(t, proof.VersionID, pv.AnchorID)
+	assert.Len(t, pv.Fields, 2)
+	for i, f := range pv.Fields {
+		assert.True(t, f.Valid)
+		assert.Equal(t, proof.FieldProofs[i].GetCompactName(), f.Property)
+	}
+
+	// verified against the anchored root if the bundle has none
+	proof.FieldProofs[1].SortedHashes[0] = utils.RandomSlice(32)
+	proof.DocumentRoot = nil
+	anchorRepo.On("GetAnchorData", anchorID).Return(root, time.Now(), nil).Once()
+	pv, err = srv.VerifyProof(ctx, proof)
+	assert.NoError(t, err)
+	assert.False(t, pv.Valid())
+	assert.True(t, pv.Anchored)
+	assert.Equal(t, root[:], pv.DocumentRoot)
+	assert.True(t, pv.Fields[0].Valid)
+	assert.False(t, pv.Fields[1].Valid)
+	assert.NotEmpty(t, pv.Fields[1].Error)
+
+	// different root anchored
+	proof = testProofBundle(t)
+	proof.VersionID = anchorID[:]
+	anchorRepo.On("GetAnchorData", anchorID).Return(anchors.RandomDocumentRoot(), time.Now(), nil).Once()
+	pv, err = srv.VerifyProof(ctx, proof)
+	assert.NoError(t, err)
+	assert.False(t, pv.Valid())
+	assert.False(t, pv.Anchored)
+	assert.Equal(t, ErrDocumentAnchorRootMismatch.Error(), pv.AnchorError)
+	assert.True(t, pv.Fields[0].Valid)
+
+	// not anchored
+	notFound := errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor"))
+	anchorRepo.On("GetAnchorData", anchorID).Return(nil, time.Time{}, notFound).Once()
+	pv, err = srv.VerifyProof(ctx, proof)
+	assert.NoError(t, err)
+	assert.False(t, pv.Valid())
+	assert.Equal(t, ErrDocumentAnchorNotMined.Error(), pv.AnchorError)
+
+	// not anchored and no document root
+	proof.DocumentRoot = nil
+	anchorRepo.On("GetAnchorData", anchorID).Return(nil, time.Time{}, notFound).Once()
+	_, err = srv.VerifyProof(ctx, proof)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentAnchorNotMined, err))
+
+	// failed to read the anchor
+	anchorRepo.On("GetAnchorData", anchorID).Return(nil, time.Time{}, errors.New("failed")).Once()
+	_, err = srv.VerifyProof(ctx, proof)
+	assert.Error(t, err)
+	anchorRepo.AssertExpectations(t)
+
+	// invalid bundles
+	proof.DocumentRoot = utils.RandomSlice(20)
+	_, err = srv.VerifyProof(ctx, proof)
+	assert.True(t, errors.IsOfType(ErrInvalidProofBundle, err))
+	proof.VersionID = nil
+	_, err = srv.VerifyProof(ctx, proof)
+	assert.True(t, errors.IsOfType(ErrInvalidProofBundle, err))
+	proof.FieldProofs = nil
+	_, err = srv.VerifyProof(ctx, proof)
+	assert.True(t, errors.IsOfType(ErrInvalidProofBundle, err))
+}
+
+func TestConvertProofVerificationToClientFormat(t *testing.T) {
+	pv := &ProofVerification{
+		AnchorID:     utils.RandomSlice(32),
+		DocumentRoot: utils.RandomSlice(32),
+		Anchored:     true,
+		Fields: []FieldProofResult{
+			{Property: []byte{1, 0, 0, 0, 0, 0, 0, 1}, Valid: true},
+			{Property: []byte{1, 0, 0, 0, 0, 0, 0, 2}, Error: "invalid proof"},
+		},
+	}
+
+	resp := ConvertProofVerificationToClientFormat(pv)
+	assert.False(t, resp.Valid)
+	assert.True(t, resp.Anchored)
+	assert.Equal(t, hexutil.Encode(pv.AnchorID), resp.AnchorId)
+	assert.Equal(t, hexutil.Encode(pv.DocumentRoot), resp.DocumentRoot)
+	assert.Len(t, resp.FieldProofs, 2)
+	assert.Equal(t, "0x0100000000000001", resp.FieldProofs[0].Property)
+	assert.True(t, resp.FieldProofs[0].Valid)
+	assert.Equal(t, "invalid proof", resp.FieldProofs[1].Error)
+
+	pv.Fields = pv.Fields[:1]
+	assert.True(t, ConvertProofVerificationToClientFormat(pv).Valid)
+}
@@ -0,0 +1,127 @@
+package documents
+
+import (
+	"context"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+)
+
+// VersionEntry describes a locally known version of a document.
+type VersionEntry struct {
+	Version         []byte
+	PreviousVersion []byte
+	Author          identity.DID
+
+	// Timestamp is the time of update of the version, zero if the version is not timestamped yet.
+	Timestamp time.Time
+	Status    Status
+
+	// DocumentRoot is the anchored document root of the version, nil if the version is not committed.
+	DocumentRoot []byte
+}
+
+// VersionHistory holds the locally known versions of a document.
+type VersionHistory struct {
+	DocumentID   []byte
+	DocumentType string
+
+	// Versions are ordered from the oldest to the latest version.
+	Versions []VersionEntry
+}
+
+// newVersionEntry returns the history entry of the version.
+func newVersionEntry(model Model) (VersionEntry, error) {
+	// drafts may not be timestamped yet
+	ts, _ := model.Timestamp()
+	e := VersionEntry{
+		Version:         model.CurrentVersion(),
+		PreviousVersion: model.PreviousVersion(),
+		Author:          model.Author(),
+		Timestamp:       ts,
+		Status:          model.GetStatus(),
+	}
+
+	if e.Status != StatusCommitted {
+		return e, nil
+	}
+
+	dr, err := model.CalculateDocumentRoot()
+	if err != nil {
+		return e, errors.New("failed to get document root of version %x: %v", e.Version, err)
+	}
+
+	e.DocumentRoot = dr
+	return e, nil
+}
+
+// GetVersionHistory returns the locally known versions of the document, walking back from the latest version.
+// Versions preceding the first stored version, such as the ones before the account joined the document, are not listed.
+func (s service) GetVersionHistory(ctx context.Context, documentID []byte) (*VersionHistory, error) {
+	model, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	did, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentConfigAccountID, err)
+	}
+
+	var versions []VersionEntry
+	for {
+		e, err := newVersionEntry(model)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, e)
+		if utils.IsEmptyByteSlice(e.PreviousVersion) || !s.repo.Exists(did[:], e.PreviousVersion) {
+			break
+		}
+
+		model, err = s.repo.Get(did[:], e.PreviousVersion)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrDocumentVersionNotFound, err)
+		}
+	}
+
+	history := &VersionHistory{DocumentID: model.ID(), DocumentType: model.DocumentType()}
+	for i := len(versions) - 1; i >= 0; i-- {
+		history.Versions = append(history.Versions, versions[i])
+	}
+
+	return history, nil
+}
+
+// VersionDiff holds the fields changed between two versions of a document.
+type VersionDiff struct {
+	DocumentID  []byte
+	FromVersion []byte
+	ToVersion   []byte
+	Changes     []ChangedField
+}
+
+// GetVersionDiff returns the fields changed from one version of the document to the other, core document fields included.
+// Either version may come first in the version chain.
+func (s service) GetVersionDiff(ctx context.Context, documentID, fromVersion, toVersion []byte) (*VersionDiff, error) {
+	from, err := s.getVersion(ctx, documentID, fromVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.getVersion(ctx, documentID, toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	cf, err := from.ChangedFields(to)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	return &VersionDiff{DocumentID: documentID, FromVersion: fromVersion, ToVersion: toVersion, Changes: cf}, nil
+}
@@ -0,0 +1,109 @@
+// +build unit
+
+package documents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_GetVersionHistory(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&historyDoc{})
+	srv := service{repo: repo}
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+
+	// unknown document
+	_, err = srv.GetVersionHistory(actx, utils.RandomSlice(32))
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+
+	docs := historyChain(3)
+	ts := time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC)
+	for i, d := range docs {
+		d.Status = StatusCommitted
+		d.Time = ts.Add(time.Duration(i) * time.Hour)
+		assert.NoError(t, repo.Create(did[:], d.Version, d))
+	}
+
+	history, err := srv.GetVersionHistory(actx, docs[0].DocID)
+	assert.NoError(t, err)
+	assert.Equal(t, docs[0].DocID, history.DocumentID)
+	assert.Equal(t, "history document", history.DocumentType)
+	assert.Len(t, history.Versions, 3)
+	for i, d := range docs {
+		assert.Equal(t, VersionEntry{
+			Version:         d.Version,
+			PreviousVersion: d.Previous,
+			Author:          d.AuthorDID,
+			Timestamp:       d.Time,
+			Status:          StatusCommitted,
+			DocumentRoot:    d.Root,
+		}, history.Versions[i])
+	}
+
+	// versions before the account joined are not listed and drafts have no anchored root
+	docs = historyChain(3)
+	docs[1].Status = StatusCommitted
+	docs[2].Status = StatusDraft
+	for _, d := range docs[1:] {
+		assert.NoError(t, repo.Create(did[:], d.Version, d))
+	}
+
+	history, err = srv.GetVersionHistory(actx, docs[0].DocID)
+	assert.NoError(t, err)
+	assert.Len(t, history.Versions, 2)
+	assert.Equal(t, docs[1].Version, history.Versions[0].Version)
+	assert.Equal(t, docs[1].Root, history.Versions[0].DocumentRoot)
+	assert.Equal(t, docs[2].Version, history.Versions[1].Version)
+	assert.Nil(t, history.Versions[1].DocumentRoot)
+}
+
+func TestService_GetVersionDiff(t *testing.T) {
+	repo := getRepository(ctx)
+	repo.Register(&historyDoc{})
+	srv := service{repo: repo}
+	actx := testingconfig.CreateAccountContext(t, cfg)
+	did, err := contextutil.AccountDID(actx)
+	assert.NoError(t, err)
+
+	docs := historyChain(2)
+	for _, d := range docs {
+		assert.NoError(t, repo.Create(did[:], d.Version, d))
+	}
+
+	// unknown version
+	v1, v2 := docs[0], docs[1]
+	_, err = srv.GetVersionDiff(actx, v1.DocID, v1.Version, utils.RandomSlice(32))
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentVersionNotFound, err))
+
+	// version of another document
+	other := historyChain(1)[0]
+	assert.NoError(t, repo.Create(did[:], other.Version, other))
+	_, err = srv.GetVersionDiff(actx, v1.DocID, v1.Version, other.Version)
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentVersionNotFound, err))
+
+	diff, err := srv.GetVersionDiff(actx, v1.DocID, v1.Version, v2.Version)
+	assert.NoError(t, err)
+	assert.Equal(t, &VersionDiff{
+		DocumentID:  v1.DocID,
+		FromVersion: v1.Version,
+		ToVersion:   v2.Version,
+		Changes:     []ChangedField{{Name: "root", Old: v1.Root, New: v2.Root}},
+	}, diff)
+
+	// same version
+	diff, err = srv.GetVersionDiff(actx, v1.DocID, v2.Version, v2.Version)
+	assert.NoError(t, err)
+	assert.Empty(t, diff.Changes)
+}
@@ -2,6 +2,8 @@ package documents
 
 import (
 	"bytes"
+	"encoding/hex"
+	"strings"
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
 	"github.com/centrifuge/go-centrifuge/errors"
@@ -189,20 +191,29 @@ func isValidTransition(rule coredocumentpb.TransitionRule, cf ChangedField) bool
 	return true
 }
 
-// CollaboratorCanUpdate validates the changes made by the collaborator in the new document.
-// returns error if the transitions are not allowed for the collaborator.
-func (cd *CoreDocument) CollaboratorCanUpdate(ncd *CoreDocument, collaborator identity.DID, docType string) error {
+// GetChangedFields returns the core document fields changed in the new core document.
+func (cd *CoreDocument) GetChangedFields(ncd *CoreDocument, docType string) ([]ChangedField, error) {
 	oldTree, err := cd.documentTree(docType)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	newTree, err := ncd.documentTree(docType)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetChangedFields(oldTree, newTree, proofs.DefaultSaltsLengthSuffix), nil
+}
+
+// CollaboratorCanUpdate validates the changes made by the collaborator in the new document.
+// returns error if the transitions are not allowed for the collaborator.
+func (cd *CoreDocument) CollaboratorCanUpdate(ncd *CoreDocument, collaborator identity.DID, docType string) error {
+	cf, err := cd.GetChangedFields(ncd, docType)
 	if err != nil {
 		return err
 	}
 
-	cf := GetChangedFields(oldTree, newTree, proofs.DefaultSaltsLengthSuffix)
 	rules := cd.TransitionRulesFor(collaborator)
 	return ValidateTransitions(rules, cf)
 }
@@ -244,3 +255,148 @@ func (cd *CoreDocument) addNewTransitionRule(roleKey []byte, matchType coredocum
 	}
 	cd.Document.TransitionRules = append(cd.Document.TransitionRules, rule)
 }
+
+// FieldRule restricts the collaborators to editing the data fields under the field paths, instead of every field of
+// the document. The collaborators keep editing the core document fields.
+type FieldRule struct {
+	Collaborators []identity.DID
+
+	// Fields are readable paths of fields or messages of the data tree, such as invoice.invoice_status or invoice.line_items
+	Fields []string
+}
+
+// fieldPrefix returns the compact property prefix of the field path in the data tree described by the mappings.
+// Elements of repeated and mapped fields can't be addressed, the path covers every element of the field.
+func fieldPrefix(mappings []PropertyMapping, path string) ([]byte, error) {
+	if path == "" || strings.ContainsAny(path, "[]") {
+		return nil, errors.New("invalid field path %q", path)
+	}
+
+	// every name of the path is a 4 byte field number in the compact property
+	size := (strings.Count(path, ".") + 1) * 8
+	for _, m := range mappings {
+		if m.ReadableName != path && !strings.HasPrefix(m.ReadableName, path+".") && !strings.HasPrefix(m.ReadableName, path+"[") {
+			continue
+		}
+
+		compact := strings.TrimPrefix(m.CompactName, "0x")
+		if len(compact) < size {
+			continue
+		}
+
+		return hex.DecodeString(compact[:size])
+	}
+
+	return nil, errors.New("unknown field %s", path)
+}
+
+// applyFieldRules sets the field rules on the derived draft, translating the field paths through the property
+// mappings of the scheme service.
+func applyFieldRules(srv SchemeService, doc Model, rules []FieldRule) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	pm, ok := srv.(PropertyMapper)
+	if !ok {
+		return errors.New("document type does not support field rules")
+	}
+
+	mappings, err := pm.PropertyMappings()
+	if err != nil {
+		return err
+	}
+
+	return doc.SetFieldRules(mappings, rules)
+}
+
+// SetFieldRules restricts the collaborators of the rules to editing the data fields of their rules. Field paths are
+// translated to compact property prefixes through the property mappings of the data tree.
+// The rules replace the transition rules the collaborators had in the previous versions, the read rules are not changed.
+// The version must be a draft, the salts of the core document are renewed.
+func (cd *CoreDocument) SetFieldRules(mappings []PropertyMapping, rules []FieldRule) error {
+	if cd.DocumentStatus != StatusDraft {
+		return errors.New("field rules can only be set on a draft")
+	}
+
+	cs, err := cd.GetCollaborators()
+	if err != nil {
+		return err
+	}
+
+	restricted := make(map[identity.DID]bool)
+	fields := make([][][]byte, len(rules))
+	for i, rule := range rules {
+		if len(rule.Collaborators) == 0 || len(rule.Fields) == 0 {
+			return errors.New("field rule requires collaborators and fields")
+		}
+
+		if unknown := filterCollaborators(rule.Collaborators, cs...); len(unknown) > 0 {
+			return errors.New("%s is not a collaborator of the document", unknown[0].String())
+		}
+
+		for _, f := range rule.Fields {
+			prefix, err := fieldPrefix(mappings, f)
+			if err != nil {
+				return err
+			}
+
+			fields[i] = append(fields[i], prefix)
+		}
+
+		for _, c := range rule.Collaborators {
+			restricted[c] = true
+		}
+	}
+
+	// roles granting read access are kept, the collaborators can still read the document
+	readRoles := make(map[string]bool)
+	for _, rule := range cd.Document.ReadRules {
+		for _, rk := range rule.Roles {
+			readRoles[string(rk)] = true
+		}
+	}
+
+	transitionRoles := make(map[string]bool)
+	for _, rule := range cd.Document.TransitionRules {
+		for _, rk := range rule.Roles {
+			transitionRoles[string(rk)] = !readRoles[string(rk)]
+		}
+	}
+
+	// roles are shared with the previous version, replace instead of updating in place
+	var roles []*coredocumentpb.Role
+	for _, role := range cd.Document.Roles {
+		if !transitionRoles[string(role.RoleKey)] {
+			roles = append(roles, role)
+			continue
+		}
+
+		var rcs [][]byte
+		for _, c := range role.Collaborators {
+			if !restricted[identity.NewDIDFromBytes(c)] {
+				rcs = append(rcs, c)
+			}
+		}
+
+		if len(rcs) != len(role.Collaborators) {
+			role = &coredocumentpb.Role{RoleKey: role.RoleKey, Collaborators: rcs, Nfts: role.Nfts}
+		}
+
+		roles = append(roles, role)
+	}
+
+	cd.Document.Roles = roles
+	cd.Document.TransitionRules = append([]*coredocumentpb.TransitionRule(nil), cd.Document.TransitionRules...)
+	for i, rule := range rules {
+		role := newRoleWithCollaborators(rule.Collaborators)
+		cd.Document.Roles = append(cd.Document.Roles, role)
+		cd.addNewTransitionRule(role.RoleKey, coredocumentpb.FieldMatchType_FIELD_MATCH_TYPE_PREFIX, compactProperties(CDTreePrefix), coredocumentpb.TransitionAction_TRANSITION_ACTION_EDIT)
+		for _, f := range fields[i] {
+			cd.addNewTransitionRule(role.RoleKey, coredocumentpb.FieldMatchType_FIELD_MATCH_TYPE_PREFIX, f, coredocumentpb.TransitionAction_TRANSITION_ACTION_EDIT)
+		}
+	}
+
+	cd.Document.CoredocumentSalts = nil
+	return cd.setSalts()
+}
@@ -69,7 +69,7 @@ func TestWriteACLs_getChangedFields_with_core_document(t *testing.T) {
 	doc, err := newCoreDocument()
 	assert.NoError(t, err)
 	doc.Document.DocumentRoot = utils.RandomSlice(32)
-	ndoc, err := doc.PrepareNewVersion([]string{testingidentity.GenerateRandomDID().String()}, true, []byte("po"))
+	ndoc, err := doc.PrepareNewVersion(CollaboratorsAccess{ReadWriteCollaborators: []string{testingidentity.GenerateRandomDID().String()}}, true, []byte("po"))
 	assert.NoError(t, err)
 
 	// preparing new version would have changed the following properties
@@ -130,7 +130,7 @@ func TestWriteACLs_getChangedFields_with_core_document(t *testing.T) {
 	// next pre image
 	doc = ndoc
 	doc.Document.DocumentRoot = utils.RandomSlice(32)
-	ndoc, err = doc.PrepareNewVersion(nil, true, []byte("po"))
+	ndoc, err = doc.PrepareNewVersion(CollaboratorsAccess{}, true, []byte("po"))
 	assert.NoError(t, err)
 	oldTree = getTree(t, &doc.Document, "", nil)
 	newTree = getTree(t, &ndoc.Document, "", nil)
@@ -204,7 +204,7 @@ func TestWriteACLs_getChangedFields_with_core_document(t *testing.T) {
 	// read_rules
 	// transition_rules
 	ndoc.Document.DocumentRoot = utils.RandomSlice(32)
-	ndoc, err = ndoc.PrepareNewVersion([]string{testingidentity.GenerateRandomDID().String()}, true, []byte("po"))
+	ndoc, err = ndoc.PrepareNewVersion(CollaboratorsAccess{ReadWriteCollaborators: []string{testingidentity.GenerateRandomDID().String()}}, true, []byte("po"))
 	assert.NoError(t, err)
 	oldTree = getTree(t, &doc.Document, "", nil)
 	newTree = getTree(t, &ndoc.Document, "", nil)
@@ -398,7 +398,7 @@ func TestWriteACLs_validateTransitions_roles_read_rules(t *testing.T) {
 	doc, id1, id2, docType := prepareDocument(t)
 
 	// prepare a new version of the document with out collaborators
-	ndoc, err := doc.PrepareNewVersion(nil, true, []byte("invoice"))
+	ndoc, err := doc.PrepareNewVersion(CollaboratorsAccess{}, true, []byte("invoice"))
 	assert.NoError(t, err)
 
 	// if this was changed by the id1, everything should be fine
@@ -408,7 +408,7 @@ func TestWriteACLs_validateTransitions_roles_read_rules(t *testing.T) {
 	assert.NoError(t, doc.CollaboratorCanUpdate(ndoc, id2, docType))
 
 	// prepare the new document with a new collaborator, this will trigger read_rules and roles update
-	ndoc, err = doc.PrepareNewVersion([]string{testingidentity.GenerateRandomDID().String()}, true, []byte("invoice"))
+	ndoc, err = doc.PrepareNewVersion(CollaboratorsAccess{ReadWriteCollaborators: []string{testingidentity.GenerateRandomDID().String()}}, true, []byte("invoice"))
 	assert.NoError(t, err)
 
 	// should not error out if the change was done by id1
@@ -575,3 +575,82 @@ func TestWriteACLs_initTransitionRules(t *testing.T) {
 	assert.Len(t, cd.Document.TransitionRules, 2)
 	assert.Len(t, cd.Document.Roles, 1)
 }
+
+func testFieldMappings() []PropertyMapping {
+	return []PropertyMapping{
+		{ReadableName: "invoice.number", CompactName: "0x0001000000000001"},
+		{ReadableName: "invoice.status", CompactName: "0x0001000000000002"},
+		{ReadableName: "invoice.line_items.length", CompactName: "0x0001000000000003"},
+		{ReadableName: "invoice.line_items[{index}].amount", CompactName: "0x0001000000000003{index}00000002"},
+	}
+}
+
+func TestFieldPrefix(t *testing.T) {
+	mappings := testFieldMappings()
+	tests := []struct {
+		path   string
+		prefix []byte
+	}{
+		{"invoice", []byte{0, 1, 0, 0}},
+		{"invoice.status", []byte{0, 1, 0, 0, 0, 0, 0, 2}},
+		{"invoice.line_items", []byte{0, 1, 0, 0, 0, 0, 0, 3}},
+	}
+
+	for _, c := range tests {
+		prefix, err := fieldPrefix(mappings, c.path)
+		assert.NoError(t, err)
+		assert.Equal(t, c.prefix, prefix)
+	}
+
+	for _, path := range []string{"", "invoice.unknown", "invoice.stat", "invoice.line_items[0].amount"} {
+		_, err := fieldPrefix(mappings, path)
+		assert.Error(t, err)
+	}
+}
+
+func TestCoreDocument_SetFieldRules(t *testing.T) {
+	id1 := testingidentity.GenerateRandomDID()
+	id2 := testingidentity.GenerateRandomDID()
+	docPrefix := []byte{0, 1, 0, 0}
+	cd, err := NewCoreDocumentWithCollaborators(CollaboratorsAccess{ReadWriteCollaborators: []string{id1.String(), id2.String()}}, docPrefix)
+	assert.NoError(t, err)
+	roles := cd.Document.Roles
+	salts := cd.Document.CoredocumentSalts
+	mappings := testFieldMappings()
+
+	// missing fields
+	err = cd.SetFieldRules(mappings, []FieldRule{{Collaborators: []identity.DID{id2}}})
+	assert.Error(t, err)
+
+	// not a collaborator
+	err = cd.SetFieldRules(mappings, []FieldRule{{Collaborators: []identity.DID{testingidentity.GenerateRandomDID()}, Fields: []string{"invoice.status"}}})
+	assert.Error(t, err)
+
+	// unknown field
+	err = cd.SetFieldRules(mappings, []FieldRule{{Collaborators: []identity.DID{id2}, Fields: []string{"invoice.unknown"}}})
+	assert.Error(t, err)
+
+	err = cd.SetFieldRules(mappings, []FieldRule{{Collaborators: []identity.DID{id2}, Fields: []string{"invoice.status"}}})
+	assert.NoError(t, err)
+	assert.NotEqual(t, salts, cd.Document.CoredocumentSalts)
+
+	rules := cd.TransitionRulesFor(id1)
+	assert.Len(t, rules, 2)
+	assert.Equal(t, docPrefix, rules[1].Field)
+
+	rules = cd.TransitionRulesFor(id2)
+	assert.Len(t, rules, 2)
+	assert.Equal(t, compactProperties(CDTreePrefix), rules[0].Field)
+	assert.Equal(t, []byte{0, 1, 0, 0, 0, 0, 0, 2}, rules[1].Field)
+
+	// the read access and the roles of the previous version are kept
+	cs, err := cd.GetCollaborators()
+	assert.NoError(t, err)
+	assert.Contains(t, cs, id2)
+	assert.Len(t, roles, 2)
+	assert.Len(t, roles[1].Collaborators, 2)
+
+	cd.DocumentStatus = StatusCommitted
+	err = cd.SetFieldRules(mappings, []FieldRule{{Collaborators: []identity.DID{id2}, Fields: []string{"invoice.number"}}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,40 @@
+package ethereum
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-centrifuge/faults"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// faultyClient wraps a Client and injects latency and failures into the transaction submissions.
+type faultyClient struct {
+	Client
+	injector *faults.Injector
+}
+
+// newFaultyClient returns a Client that injects faults into the submissions of the client.
+func newFaultyClient(client Client, injector *faults.Injector) Client {
+	return &faultyClient{Client: client, injector: injector}
+}
+
+// SubmitTransactionWithRetries fails the submission or delays it before submitting it through the wrapped client.
+func (fc *faultyClient) SubmitTransactionWithRetries(contractMethod interface{}, opts *bind.TransactOpts, params ...interface{}) (*types.Transaction, error) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	err := fc.injector.Delay(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fc.injector.FailEthSubmission()
+	if err != nil {
+		return nil, err
+	}
+
+	return fc.Client.SubmitTransactionWithRetries(contractMethod, opts, params...)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/queue"
+	"github.com/centrifuge/go-centrifuge/retry"
 	"github.com/centrifuge/go-centrifuge/transactions"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -39,8 +40,7 @@ type Config interface {
 	GetEthereumGasLimit() uint64
 	GetEthereumNodeURL() string
 	GetEthereumAccount(accountName string) (account *config.AccountConfig, err error)
-	GetEthereumIntervalRetry() time.Duration
-	GetEthereumMaxRetries() int
+	GetRetryPolicy(class string) retry.Policy
 	GetTxPoolAccessEnabled() bool
 	GetEthereumContextReadWaitTimeout() time.Duration
 }
@@ -239,21 +239,16 @@ func (gc *gethClient) SubmitTransactionWithRetries(contractMethod interface{}, o
 	gc.txMu.Lock()
 	defer gc.txMu.Unlock()
 
-	var current int
 	f := reflect.ValueOf(contractMethod)
-	maxTries := gc.config.GetEthereumMaxRetries()
+	retries := retry.New(retry.ClassEthereum, gc.config.GetRetryPolicy(retry.ClassEthereum))
 
-	var err error
-	for {
-
-		if current >= maxTries {
-			return nil, errors.New("max concurrent transaction tries reached: %v", err)
-		}
-
-		current++
-		err = gc.incrementNonce(opts, gc.config.GetTxPoolAccessEnabled(), gc.client, gc.rpcClient)
+	var tx *types.Transaction
+	var concurrent bool
+	err := retries.Do(context.Background(), func(attempt int) error {
+		concurrent = false
+		err := gc.incrementNonce(opts, gc.config.GetTxPoolAccessEnabled(), gc.client, gc.rpcClient)
 		if err != nil {
-			return nil, errors.New("failed to increment nonce: %v", err)
+			return errors.New("failed to increment nonce: %v", err)
 		}
 
 		if opts.Nonce != nil {
@@ -267,7 +262,6 @@ func (gc *gethClient) SubmitTransactionWithRetries(contractMethod interface{}, o
 		}
 
 		result := f.Call(in)
-		var tx *types.Transaction
 		if !result[0].IsNil() {
 			tx = result[0].Interface().(*types.Transaction)
 		}
@@ -276,19 +270,22 @@ func (gc *gethClient) SubmitTransactionWithRetries(contractMethod interface{}, o
 			err = result[1].Interface().(error)
 		}
 
-		if err == nil {
-			return tx, nil
+		if err != nil && ((err.Error() == transactionUnderpriced.Error()) || (err.Error() == nonceTooLow.Error())) {
+			log.Warningf("Concurrent transaction identified [%d/%d]\n", attempt+1, retries.Policy().Attempts)
+			concurrent = true
 		}
 
-		if (err.Error() == transactionUnderpriced.Error()) || (err.Error() == nonceTooLow.Error()) {
-			log.Warningf("Concurrent transaction identified, trying again [%d/%d]\n", current, maxTries)
-			time.Sleep(gc.config.GetEthereumIntervalRetry())
-			continue
+		return err
+	}, func(error) bool { return concurrent })
+	if err != nil {
+		if concurrent {
+			return nil, errors.New("max concurrent transaction tries reached: %v", err)
 		}
 
 		return nil, err
 	}
 
+	return tx, nil
 }
 
 // GetGethCallOpts returns the Call options with default
@@ -32,7 +32,8 @@ func TestMain(m *testing.M) {
 	bootstrap.RunTestBootstrappers(ibootstappers, ctx)
 	cfg = ctx[bootstrap.BootstrappedConfig].(config.Configuration)
 	cfg.Set("ethereum.txPoolAccessEnabled", false)
-	cfg.Set("ethereum.intervalRetry", time.Millisecond*100)
+	cfg.Set("retries.ethereum.backoff", time.Millisecond*100)
+	cfg.Set("retries.ethereum.maxBackoff", time.Millisecond*100)
 	result := m.Run()
 	bootstrap.RunTestTeardown(ibootstappers)
 	os.Exit(result)
@@ -79,7 +80,7 @@ func TestInitTransactionWithRetries(t *testing.T) {
 	tx, err = gc.SubmitTransactionWithRetries(mockRequest.RegisterTransaction, &bind.TransactOpts{}, "otherError", "var2")
 	assert.EqualError(t, err, "Some other error", "Should error out")
 
-	mockRetries := testingutils.MockConfigOption(cfg, "ethereum.maxRetries", 10)
+	mockRetries := testingutils.MockConfigOption(cfg, "retries.ethereum.attempts", 10)
 	defer mockRetries()
 
 	mockRequest.count = 0
@@ -91,6 +91,11 @@ func (tst *TransactionStatusTask) TaskTypeName() string {
 	return EthTXStatusTaskName
 }
 
+// AccountIDParam returns TransactionAccountParam
+func (tst *TransactionStatusTask) AccountIDParam() string {
+	return TransactionAccountParam
+}
+
 // Copy returns a new instance of mintingConfirmationTask
 func (tst *TransactionStatusTask) Copy() (gocelery.CeleryTask, error) {
 	return &TransactionStatusTask{
@@ -0,0 +1,33 @@
+package faults
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/errors"
+)
+
+// Bootstrapper implements bootstrap.Bootstrapper.
+type Bootstrapper struct{}
+
+// Bootstrap initialises the fault Injector if fault injection is enabled.
+func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
+	cfg, ok := ctx[bootstrap.BootstrappedConfig].(Config)
+	if !ok {
+		return errors.New("fault injection config not initialised")
+	}
+
+	if !cfg.GetFaultInjectionEnabled() {
+		return nil
+	}
+
+	injector, err := NewInjector(cfg, rand.NewSource(time.Now().UnixNano()))
+	if err != nil {
+		return err
+	}
+
+	log.Warning("fault injection is enabled, the node must not be used in production")
+	ctx[BootstrappedInjector] = injector
+	return nil
+}
@@ -0,0 +1,119 @@
+package faults
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	logging "github.com/ipfs/go-log"
+)
+
+const (
+	// BootstrappedInjector is the key to the fault Injector in the bootstrap context.
+	// The key is only set if fault injection is enabled.
+	BootstrappedInjector = "BootstrappedFaultInjector"
+
+	// ErrP2PMessageDropped must be used when a p2p message is dropped by the injector.
+	ErrP2PMessageDropped = errors.Error("p2p message dropped by fault injection")
+
+	// ErrEthSubmissionFailed must be used when an ethereum submission is failed by the injector.
+	ErrEthSubmissionFailed = errors.Error("ethereum submission failed by fault injection")
+)
+
+var log = logging.Logger("faults")
+
+// Config defines the configuration required by the fault injection.
+type Config interface {
+	GetFaultInjectionEnabled() bool
+	GetFaultLatency() time.Duration
+	GetFaultLatencyRate() float64
+	GetFaultP2PDropRate() float64
+	GetFaultEthSubmitFailureRate() float64
+}
+
+// Injector injects latency, dropped p2p messages and failed ethereum submissions at the configured rates.
+// A nil Injector never injects a fault, so callers don't need to check if fault injection is enabled.
+type Injector struct {
+	latency        time.Duration
+	latencyRate    float64
+	p2pDropRate    float64
+	ethFailureRate float64
+
+	mu   sync.Mutex // mu to protect rand
+	rand *rand.Rand
+}
+
+// NewInjector returns an Injector for the rates of the config.
+// Rates must be between 0 and 1.
+func NewInjector(cfg Config, source rand.Source) (*Injector, error) {
+	rates := map[string]float64{
+		"latency":                 cfg.GetFaultLatencyRate(),
+		"p2p drop":                cfg.GetFaultP2PDropRate(),
+		"ethereum submit failure": cfg.GetFaultEthSubmitFailureRate(),
+	}
+
+	for name, rate := range rates {
+		if rate < 0 || rate > 1 {
+			return nil, errors.New("invalid %s rate %v: must be between 0 and 1", name, rate)
+		}
+	}
+
+	return &Injector{
+		latency:        cfg.GetFaultLatency(),
+		latencyRate:    cfg.GetFaultLatencyRate(),
+		p2pDropRate:    cfg.GetFaultP2PDropRate(),
+		ethFailureRate: cfg.GetFaultEthSubmitFailureRate(),
+		rand:           rand.New(source),
+	}, nil
+}
+
+// hit returns true with the probability of the rate.
+func (i *Injector) hit(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.rand.Float64() < rate
+}
+
+// Delay waits for the configured latency at the latency rate.
+// Returns the context error if the context is done before the latency elapsed.
+func (i *Injector) Delay(ctx context.Context) error {
+	if i == nil || i.latency <= 0 || !i.hit(i.latencyRate) {
+		return nil
+	}
+
+	log.Debugf("injecting latency of %s", i.latency)
+	t := time.NewTimer(i.latency)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// DropP2PMessage returns ErrP2PMessageDropped at the p2p drop rate.
+func (i *Injector) DropP2PMessage() error {
+	if i == nil || !i.hit(i.p2pDropRate) {
+		return nil
+	}
+
+	log.Debug("dropping p2p message")
+	return ErrP2PMessageDropped
+}
+
+// FailEthSubmission returns ErrEthSubmissionFailed at the ethereum submit failure rate.
+func (i *Injector) FailEthSubmission() error {
+	if i == nil || !i.hit(i.ethFailureRate) {
+		return nil
+	}
+
+	log.Debug("failing ethereum submission")
+	return ErrEthSubmissionFailed
+}
@@ -0,0 +1,94 @@
+// +build unit
+
+package faults
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockConfig struct {
+	enabled        bool
+	latency        time.Duration
+	latencyRate    float64
+	p2pDropRate    float64
+	ethFailureRate float64
+}
+
+func (m mockConfig) GetFaultInjectionEnabled() bool        { return m.enabled }
+func (m mockConfig) GetFaultLatency() time.Duration        { return m.latency }
+func (m mockConfig) GetFaultLatencyRate() float64          { return m.latencyRate }
+func (m mockConfig) GetFaultP2PDropRate() float64          { return m.p2pDropRate }
+func (m mockConfig) GetFaultEthSubmitFailureRate() float64 { return m.ethFailureRate }
+
+func TestNewInjector(t *testing.T) {
+	_, err := NewInjector(mockConfig{p2pDropRate: 1.5}, rand.NewSource(1))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid p2p drop rate")
+
+	_, err = NewInjector(mockConfig{ethFailureRate: -0.1}, rand.NewSource(1))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid ethereum submit failure rate")
+
+	i, err := NewInjector(mockConfig{latencyRate: 0.5, p2pDropRate: 0.5}, rand.NewSource(1))
+	assert.NoError(t, err)
+	assert.NotNil(t, i)
+}
+
+func TestInjector_nil(t *testing.T) {
+	var i *Injector
+	assert.NoError(t, i.Delay(context.Background()))
+	assert.NoError(t, i.DropP2PMessage())
+	assert.NoError(t, i.FailEthSubmission())
+}
+
+func TestInjector_rates(t *testing.T) {
+	i, err := NewInjector(mockConfig{p2pDropRate: 1}, rand.NewSource(1))
+	assert.NoError(t, err)
+	for j := 0; j < 10; j++ {
+		assert.Equal(t, ErrP2PMessageDropped, i.DropP2PMessage())
+		assert.NoError(t, i.FailEthSubmission())
+	}
+
+	i, err = NewInjector(mockConfig{ethFailureRate: 1}, rand.NewSource(1))
+	assert.NoError(t, err)
+	for j := 0; j < 10; j++ {
+		assert.NoError(t, i.DropP2PMessage())
+		assert.Equal(t, ErrEthSubmissionFailed, i.FailEthSubmission())
+	}
+
+	// roughly half the messages are dropped
+	i, err = NewInjector(mockConfig{p2pDropRate: 0.5}, rand.NewSource(1))
+	assert.NoError(t, err)
+	var dropped int
+	for j := 0; j < 1000; j++ {
+		if i.DropP2PMessage() != nil {
+			dropped++
+		}
+	}
+	assert.True(t, dropped > 400 && dropped < 600)
+}
+
+func TestInjector_Delay(t *testing.T) {
+	i, err := NewInjector(mockConfig{latency: 50 * time.Millisecond, latencyRate: 1}, rand.NewSource(1))
+	assert.NoError(t, err)
+	start := time.Now()
+	assert.NoError(t, i.Delay(context.Background()))
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+
+	// context is done before the latency elapsed
+	i, err = NewInjector(mockConfig{latency: time.Minute, latencyRate: 1}, rand.NewSource(1))
+	assert.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, i.Delay(ctx))
+
+	// latency is never added at rate 0
+	i, err = NewInjector(mockConfig{latency: time.Minute}, rand.NewSource(1))
+	assert.NoError(t, err)
+	assert.NoError(t, i.Delay(context.Background()))
+}
@@ -0,0 +1,11 @@
+// +build integration unit
+
+package faults
+
+func (b Bootstrapper) TestBootstrap(ctx map[string]interface{}) error {
+	return b.Bootstrap(ctx)
+}
+
+func (b Bootstrapper) TestTearDown() error {
+	return nil
+}
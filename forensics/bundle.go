@@ -0,0 +1,73 @@
+package forensics
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/identity"
+)
+
+const (
+	// OperationRequestDocumentSignature is the operation of a document received with a signature request.
+	OperationRequestDocumentSignature = "request_document_signature"
+
+	// OperationReceiveAnchoredDocument is the operation of an anchored document received from a collaborator.
+	OperationReceiveAnchoredDocument = "receive_anchored_document"
+)
+
+// Root compares a root computed from a received document with the root the document was expected to have.
+type Root struct {
+	Name     string
+	Computed []byte
+	Expected []byte
+
+	// Error is set if the root could not be computed or fetched.
+	Error string
+}
+
+// Matches returns true if the computed root is the expected root.
+func (r Root) Matches() bool {
+	return r.Error == "" && string(r.Computed) == string(r.Expected)
+}
+
+// Bundle holds what is needed to debug a document received from a collaborator that failed the validation,
+// so that both organizations can compare what was sent with what was received.
+type Bundle struct {
+	ID           string
+	AccountID    identity.DID
+	Collaborator identity.DID
+	Operation    string
+	DocumentID   []byte
+	VersionID    []byte
+	DocumentType string
+
+	// CoreDocument is the serialised core document as received, before the validators recalculated its roots.
+	CoreDocument []byte
+
+	// Model is the json of the model derived from the received core document.
+	Model []byte
+
+	// Validator is the name of the validator the document failed.
+	Validator string
+
+	// Failures are the errors of the failing validations.
+	Failures  []string
+	Roots     []Root
+	CreatedAt time.Time
+}
+
+// JSON returns the json of the bundle.
+func (b *Bundle) JSON() ([]byte, error) {
+	return json.Marshal(b)
+}
+
+// FromJSON loads the bundle from its json.
+func (b *Bundle) FromJSON(data []byte) error {
+	return json.Unmarshal(data, b)
+}
+
+// Type returns the reflect type of the bundle.
+func (b *Bundle) Type() reflect.Type {
+	return reflect.TypeOf(b)
+}
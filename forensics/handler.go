@@ -0,0 +1,114 @@
+package forensics
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/forensics"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+type grpcHandler struct {
+	srv Service
+}
+
+// GRPCHandler returns an implementation of forensicspb.ForensicsServiceServer
+func GRPCHandler(srv Service) forensicspb.ForensicsServiceServer {
+	return grpcHandler{srv: srv}
+}
+
+// ListBundles returns the forensic bundles of the account, latest first.
+// The received core documents and the derived models are returned by GetBundle only.
+func (h grpcHandler) ListBundles(ctx context.Context, _ *empty.Empty) (*forensicspb.ListBundlesResponse, error) {
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	bundles, err := h.srv.ListBundles(accountID)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	resp := new(forensicspb.ListBundlesResponse)
+	for _, b := range bundles {
+		pb, err := toProtoBundle(b, false)
+		if err != nil {
+			log.Error(err)
+			return nil, errors.NewHTTPError(http.StatusInternalServerError, err)
+		}
+
+		resp.Bundles = append(resp.Bundles, pb)
+	}
+
+	return resp, nil
+}
+
+// GetBundle returns the forensic bundle of the account with the received core document and the derived model.
+func (h grpcHandler) GetBundle(ctx context.Context, req *forensicspb.GetBundleRequest) (*forensicspb.Bundle, error) {
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	b, err := h.srv.GetBundle(accountID, req.Id)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	pb, err := toProtoBundle(b, true)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return pb, nil
+}
+
+// toProtoBundle converts the bundle, with its received core document and derived model if payload is true.
+func toProtoBundle(b *Bundle, payload bool) (*forensicspb.Bundle, error) {
+	createdAt, err := utils.ToTimestamp(b.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := &forensicspb.Bundle{
+		Id:           b.ID,
+		Collaborator: b.Collaborator.String(),
+		Operation:    b.Operation,
+		DocumentId:   hexutil.Encode(b.DocumentID),
+		VersionId:    hexutil.Encode(b.VersionID),
+		DocumentType: b.DocumentType,
+		Validator:    b.Validator,
+		Failures:     b.Failures,
+		CreatedAt:    createdAt,
+	}
+
+	for _, r := range b.Roots {
+		root := &forensicspb.Root{Name: r.Name, Matches: r.Matches(), Error: r.Error}
+		if len(r.Computed) > 0 {
+			root.Computed = hexutil.Encode(r.Computed)
+		}
+
+		if len(r.Expected) > 0 {
+			root.Expected = hexutil.Encode(r.Expected)
+		}
+
+		pb.Roots = append(pb.Roots, root)
+	}
+
+	if payload {
+		pb.CoreDocument = hexutil.Encode(b.CoreDocument)
+		pb.Model = string(b.Model)
+	}
+
+	return pb, nil
+}
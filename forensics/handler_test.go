@@ -0,0 +1,52 @@
+// +build unit
+
+package forensics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/forensics"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGRPCHandler(t *testing.T) {
+	srv := ctx[BootstrappedService].(Service)
+	h := GRPCHandler(srv)
+
+	// no account
+	_, err := h.ListBundles(context.Background(), nil)
+	assert.Error(t, err)
+
+	cctx := testingconfig.CreateAccountContext(t, cfg)
+	accountID, err := contextutil.AccountDID(cctx)
+	assert.NoError(t, err)
+	b := newBundle(accountID)
+	assert.NoError(t, srv.Record(b))
+
+	resp, err := h.ListBundles(cctx, nil)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Bundles, 1)
+	assert.Equal(t, b.ID, resp.Bundles[0].Id)
+	assert.Equal(t, hexutil.Encode(b.DocumentID), resp.Bundles[0].DocumentId)
+	assert.Empty(t, resp.Bundles[0].CoreDocument)
+	assert.Empty(t, resp.Bundles[0].Model)
+	assert.Len(t, resp.Bundles[0].Roots, 2)
+	assert.False(t, resp.Bundles[0].Roots[0].Matches)
+	assert.True(t, resp.Bundles[0].Roots[1].Matches)
+
+	// unknown bundle
+	_, err = h.GetBundle(cctx, &forensicspb.GetBundleRequest{Id: "unknown"})
+	assert.Error(t, err)
+
+	got, err := h.GetBundle(cctx, &forensicspb.GetBundleRequest{Id: b.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(b.CoreDocument), got.CoreDocument)
+	assert.Equal(t, string(b.Model), got.Model)
+	assert.Equal(t, b.Failures, got.Failures)
+	assert.Equal(t, b.Collaborator.String(), got.Collaborator)
+	assert.NotNil(t, got.CreatedAt)
+}
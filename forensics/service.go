@@ -0,0 +1,112 @@
+package forensics
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage"
+	logging "github.com/ipfs/go-log"
+	"github.com/satori/go.uuid"
+)
+
+const (
+	// BootstrappedService is the key to the forensics Service in the bootstrap context.
+	BootstrappedService = "BootstrappedForensicsService"
+
+	// ErrUnknownBundle must be used when no bundle exists for the given ID.
+	ErrUnknownBundle = errors.Error("unknown forensic bundle")
+
+	// maxBundles is the number of bundles kept per account. The oldest bundle is dropped first
+	// so that collaborators sending invalid documents can't fill the storage.
+	maxBundles = 100
+
+	bundlePrefix = "forensics_"
+)
+
+var log = logging.Logger("forensics")
+
+// Service persists the forensic bundles of the received documents that failed the validation.
+type Service interface {
+	// Record persists the bundle and assigns its ID and creation time.
+	Record(b *Bundle) error
+
+	// GetBundle returns the bundle of the account.
+	GetBundle(accountID identity.DID, id string) (*Bundle, error)
+
+	// ListBundles returns the bundles of the account, latest first.
+	ListBundles(accountID identity.DID) ([]*Bundle, error)
+}
+
+type service struct {
+	repo storage.Repository
+
+	// mu serialises the records so that the bundles of an account are pruned once
+	mu sync.Mutex
+}
+
+// DefaultService registers the bundle model and returns the default forensics service.
+func DefaultService(repo storage.Repository) Service {
+	repo.Register(&Bundle{})
+	return &service{repo: repo}
+}
+
+// accountPrefix returns the key prefix of the bundles of the account.
+func accountPrefix(accountID identity.DID) string {
+	return fmt.Sprintf("%s%s_", bundlePrefix, accountID.String())
+}
+
+func getKey(accountID identity.DID, id string) []byte {
+	return []byte(accountPrefix(accountID) + id)
+}
+
+func (s *service) Record(b *Bundle) error {
+	b.ID = uuid.Must(uuid.NewV4()).String()
+	b.CreatedAt = time.Now().UTC()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bundles, err := s.ListBundles(b.AccountID)
+	if err != nil {
+		return err
+	}
+
+	for i := maxBundles - 1; i < len(bundles); i++ {
+		err = s.repo.Delete(getKey(b.AccountID, bundles[i].ID))
+		if err != nil {
+			log.Warningf("failed to drop forensic bundle %s: %v", bundles[i].ID, err)
+		}
+	}
+
+	return s.repo.Create(getKey(b.AccountID, b.ID), b)
+}
+
+func (s *service) GetBundle(accountID identity.DID, id string) (*Bundle, error) {
+	m, err := s.repo.Get(getKey(accountID, id))
+	if err != nil {
+		return nil, errors.NewTypedError(ErrUnknownBundle, errors.New("%s", id))
+	}
+
+	return m.(*Bundle), nil
+}
+
+func (s *service) ListBundles(accountID identity.DID) ([]*Bundle, error) {
+	models, err := s.repo.GetAllByPrefix(accountPrefix(accountID))
+	if err != nil {
+		return nil, err
+	}
+
+	bundles := make([]*Bundle, len(models))
+	for i, m := range models {
+		bundles[i] = m.(*Bundle)
+	}
+
+	sort.Slice(bundles, func(i, j int) bool {
+		return bundles[i].CreatedAt.After(bundles[j].CreatedAt)
+	})
+
+	return bundles, nil
+}
@@ -0,0 +1,129 @@
+// +build unit
+
+package forensics
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/bootstrap/bootstrappers/testlogging"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var ctx = map[string]interface{}{}
+var cfg config.Configuration
+
+func TestMain(m *testing.M) {
+	ibootstappers := []bootstrap.TestBootstrapper{
+		&testlogging.TestLoggingBootstrapper{},
+		&config.Bootstrapper{},
+		&leveldb.Bootstrapper{},
+		Bootstrapper{},
+	}
+	bootstrap.RunTestBootstrappers(ibootstappers, ctx)
+	cfg = ctx[bootstrap.BootstrappedConfig].(config.Configuration)
+	result := m.Run()
+	bootstrap.RunTestTeardown(ibootstappers)
+	os.Exit(result)
+}
+
+func newBundle(accountID identity.DID) *Bundle {
+	return &Bundle{
+		AccountID:    accountID,
+		Collaborator: testingidentity.GenerateRandomDID(),
+		Operation:    OperationReceiveAnchoredDocument,
+		DocumentID:   utils.RandomSlice(32),
+		VersionID:    utils.RandomSlice(32),
+		DocumentType: "invoice",
+		CoreDocument: utils.RandomSlice(64),
+		Model:        []byte(`{"number":"1"}`),
+		Validator:    "ReceivedAnchoredDocumentValidator",
+		Failures:     []string{"signing root mismatch"},
+		Roots: []Root{
+			{Name: "signing_root", Computed: []byte{1}, Expected: []byte{2}},
+			{Name: "document_root", Computed: []byte{1}, Expected: []byte{1}},
+		},
+	}
+}
+
+func TestRoot_Matches(t *testing.T) {
+	assert.True(t, Root{Computed: []byte{1}, Expected: []byte{1}}.Matches())
+	assert.False(t, Root{Computed: []byte{1}, Expected: []byte{2}}.Matches())
+	assert.False(t, Root{Expected: []byte{1}}.Matches())
+	assert.False(t, Root{Computed: []byte{1}, Expected: []byte{1}, Error: "failed"}.Matches())
+}
+
+func TestService_Record(t *testing.T) {
+	srv := ctx[BootstrappedService].(Service)
+	accountID := testingidentity.GenerateRandomDID()
+
+	// unknown bundle
+	_, err := srv.GetBundle(accountID, "unknown")
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrUnknownBundle, err))
+
+	b := newBundle(accountID)
+	assert.NoError(t, srv.Record(b))
+	assert.NotEmpty(t, b.ID)
+	assert.False(t, b.CreatedAt.IsZero())
+
+	got, err := srv.GetBundle(accountID, b.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, b.Collaborator, got.Collaborator)
+	assert.Equal(t, b.CoreDocument, got.CoreDocument)
+	assert.Equal(t, b.Model, got.Model)
+	assert.Equal(t, b.Failures, got.Failures)
+	assert.Equal(t, b.Roots, got.Roots)
+
+	// bundles of another account
+	_, err = srv.GetBundle(testingidentity.GenerateRandomDID(), b.ID)
+	assert.Error(t, err)
+	bundles, err := srv.ListBundles(testingidentity.GenerateRandomDID())
+	assert.NoError(t, err)
+	assert.Len(t, bundles, 0)
+}
+
+func TestService_ListBundles(t *testing.T) {
+	srv := ctx[BootstrappedService].(Service)
+	accountID := testingidentity.GenerateRandomDID()
+	var ids []string
+	for i := 0; i < 3; i++ {
+		b := newBundle(accountID)
+		assert.NoError(t, srv.Record(b))
+		ids = append([]string{b.ID}, ids...)
+		time.Sleep(time.Millisecond)
+	}
+
+	bundles, err := srv.ListBundles(accountID)
+	assert.NoError(t, err)
+	assert.Len(t, bundles, 3)
+	for i, b := range bundles {
+		assert.Equal(t, ids[i], b.ID)
+	}
+}
+
+func TestService_Record_prune(t *testing.T) {
+	srv := ctx[BootstrappedService].(Service)
+	accountID := testingidentity.GenerateRandomDID()
+	first := newBundle(accountID)
+	assert.NoError(t, srv.Record(first))
+	for i := 0; i < maxBundles; i++ {
+		assert.NoError(t, srv.Record(newBundle(accountID)))
+	}
+
+	bundles, err := srv.ListBundles(accountID)
+	assert.NoError(t, err)
+	assert.Len(t, bundles, maxBundles)
+
+	// the oldest bundle is dropped
+	_, err = srv.GetBundle(accountID, first.ID)
+	assert.Error(t, err)
+}
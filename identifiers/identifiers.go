@@ -0,0 +1,109 @@
+// Package identifiers provides strict parsing and encoding of the identifiers exchanged over the API and p2p layers.
+package identifiers
+
+import (
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// DocumentIDLength is the length of a document identifier
+	DocumentIDLength = 32
+
+	// VersionIDLength is the length of a document version identifier
+	VersionIDLength = 32
+
+	// TokenIDLength is the length of an NFT token identifier
+	TokenIDLength = 32
+
+	// TransferIDLength is the length of the identifier of the transfer details on a document
+	TransferIDLength = 32
+
+	// DIDLength is the length of a DID
+	DIDLength = identity.DIDLength
+
+	// ErrInvalidHex must be used when the identifier is not a 0x prefixed hex string
+	ErrInvalidHex = errors.Error("identifier must be a 0x prefixed hex string")
+
+	// ErrInvalidLength must be used when the identifier doesn't have the expected length
+	ErrInvalidLength = errors.Error("identifier has invalid length")
+)
+
+// Decode strictly decodes a 0x prefixed, lower or upper case, hex string of the given byte length.
+func Decode(hexStr string, length int) ([]byte, error) {
+	if len(hexStr) < 2 || hexStr[0] != '0' || hexStr[1] != 'x' {
+		return nil, ErrInvalidHex
+	}
+
+	b, err := hexutil.Decode(hexStr)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrInvalidHex, err)
+	}
+
+	err = Validate(b, length)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Validate checks that the raw identifier has the given byte length.
+func Validate(id []byte, length int) error {
+	if len(id) != length {
+		return errors.NewTypedError(ErrInvalidLength, errors.New("expected %d bytes but got %d", length, len(id)))
+	}
+
+	return nil
+}
+
+// Encode returns the 0x prefixed hex encoding of the identifier.
+func Encode(id []byte) string {
+	return hexutil.Encode(id)
+}
+
+// DecodeDocumentID strictly decodes a hex encoded document identifier.
+func DecodeDocumentID(hexStr string) ([]byte, error) {
+	return Decode(hexStr, DocumentIDLength)
+}
+
+// DecodeVersionID strictly decodes a hex encoded document version identifier.
+func DecodeVersionID(hexStr string) ([]byte, error) {
+	return Decode(hexStr, VersionIDLength)
+}
+
+// DecodeTokenID strictly decodes a hex encoded NFT token identifier.
+func DecodeTokenID(hexStr string) ([]byte, error) {
+	return Decode(hexStr, TokenIDLength)
+}
+
+// DecodeTransferID strictly decodes a hex encoded transfer identifier.
+func DecodeTransferID(hexStr string) ([]byte, error) {
+	return Decode(hexStr, TransferIDLength)
+}
+
+// DecodeDID strictly decodes a hex encoded DID.
+func DecodeDID(hexStr string) (identity.DID, error) {
+	b, err := Decode(hexStr, DIDLength)
+	if err != nil {
+		return identity.DID{}, err
+	}
+
+	return identity.NewDIDFromBytes(b), nil
+}
+
+// DecodeDIDs strictly decodes hex encoded DIDs.
+func DecodeDIDs(hexStrs []string) ([]identity.DID, error) {
+	dids := make([]identity.DID, len(hexStrs))
+	for i, s := range hexStrs {
+		did, err := DecodeDID(s)
+		if err != nil {
+			return nil, err
+		}
+
+		dids[i] = did
+	}
+
+	return dids, nil
+}
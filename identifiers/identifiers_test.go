@@ -0,0 +1,99 @@
+// +build unit
+
+package identifiers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	id := utils.RandomSlice(DocumentIDLength)
+	tests := []struct {
+		hex    string
+		length int
+		result []byte
+		err    error
+	}{
+		// valid
+		{hex: Encode(id), length: DocumentIDLength, result: id},
+
+		// upper case
+		{hex: "0x" + strings.ToUpper(Encode(id)[2:]), length: DocumentIDLength, result: id},
+
+		// missing prefix
+		{hex: Encode(id)[2:], length: DocumentIDLength, err: ErrInvalidHex},
+
+		// upper case prefix
+		{hex: "0X" + Encode(id)[2:], length: DocumentIDLength, err: ErrInvalidHex},
+
+		// empty
+		{hex: "", length: DocumentIDLength, err: ErrInvalidHex},
+		{hex: "0x", length: DocumentIDLength, err: ErrInvalidLength},
+
+		// odd length
+		{hex: Encode(id) + "1", length: DocumentIDLength, err: ErrInvalidHex},
+
+		// invalid characters
+		{hex: "0x" + strings.Repeat("zz", DocumentIDLength), length: DocumentIDLength, err: ErrInvalidHex},
+
+		// short
+		{hex: Encode(id[:10]), length: DocumentIDLength, err: ErrInvalidLength},
+
+		// long
+		{hex: Encode(append(id, 1)), length: DocumentIDLength, err: ErrInvalidLength},
+	}
+
+	for _, c := range tests {
+		b, err := Decode(c.hex, c.length)
+		if c.err != nil {
+			assert.Error(t, err)
+			assert.True(t, errors.IsOfType(c.err, err), c.hex)
+			assert.Nil(t, b)
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, c.result, b)
+	}
+}
+
+func TestDecodeDID(t *testing.T) {
+	id := utils.RandomSlice(DIDLength)
+	did, err := DecodeDID(Encode(id))
+	assert.NoError(t, err)
+	assert.Equal(t, id, did[:])
+
+	_, err = DecodeDID(Encode(utils.RandomSlice(DocumentIDLength)))
+	assert.True(t, errors.IsOfType(ErrInvalidLength, err))
+
+	dids, err := DecodeDIDs([]string{Encode(id), did.String()})
+	assert.NoError(t, err)
+	assert.Len(t, dids, 2)
+	assert.Equal(t, did, dids[1])
+
+	_, err = DecodeDIDs([]string{Encode(id), "0x1"})
+	assert.Error(t, err)
+}
+
+func TestDecodeDocumentID_VersionID_TokenID(t *testing.T) {
+	id := utils.RandomSlice(32)
+	for _, f := range []func(string) ([]byte, error){DecodeDocumentID, DecodeVersionID, DecodeTokenID, DecodeTransferID} {
+		b, err := f(Encode(id))
+		assert.NoError(t, err)
+		assert.Equal(t, id, b)
+
+		_, err = f(Encode(id[:20]))
+		assert.True(t, errors.IsOfType(ErrInvalidLength, err))
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(utils.RandomSlice(32), DocumentIDLength))
+	assert.True(t, errors.IsOfType(ErrInvalidLength, Validate(utils.RandomSlice(31), DocumentIDLength)))
+	assert.True(t, errors.IsOfType(ErrInvalidLength, Validate(nil, DocumentIDLength)))
+}
@@ -24,6 +24,9 @@ const (
 	// BootstrappedDIDService stores the id of the service
 	BootstrappedDIDService string = "BootstrappedDIDService"
 
+	// BootstrappedKeyCache stores the cache of the identity key lookups, only set if caching is enabled
+	BootstrappedKeyCache string = "BootstrappedKeyCache"
+
 	// KeyTypeECDSA has the value one in the ERC725 identity contract
 	KeyTypeECDSA = 1
 
@@ -193,6 +196,12 @@ type Factory interface {
 	CalculateIdentityAddress(ctx context.Context) (*common.Address, error)
 }
 
+// KeyChangeNotifier notifies the key changes of the identities seen on chain.
+type KeyChangeNotifier interface {
+	// OnKeyChange registers fn to be called with the identity of every KeyAdded or KeyRevoked event seen.
+	OnKeyChange(fn func(did DID))
+}
+
 // ServiceDID interface contains the methods to interact with the identity contract
 type ServiceDID interface {
 	// AddKey adds a key to identity contract
@@ -12,6 +12,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/config"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/ethereum"
+	"github.com/centrifuge/go-centrifuge/storage"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -51,7 +52,15 @@ func (*Bootstrapper) Bootstrap(context map[string]interface{}) error {
 	factory := NewFactory(factoryContract, client, txManager, queueSrv, factoryAddress)
 	context[identity.BootstrappedDIDFactory] = factory
 
-	service := NewService(client, txManager, queueSrv)
+	// key lookups are cached only if enabled and the node DB is available, which is not the case when creating the config
+	var cache *keyCache
+	db, ok := context[storage.BootstrappedDB].(storage.Repository)
+	if ok && cfg.GetIdentityCacheSyncInterval() > 0 {
+		cache = newKeyCache(db, client.GetEthClient(), cfg.GetIdentityCacheSyncInterval())
+		context[identity.BootstrappedKeyCache] = cache
+	}
+
+	service := newCachedService(client, txManager, queueSrv, cache)
 	context[identity.BootstrappedDIDService] = service
 
 	return nil
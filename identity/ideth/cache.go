@@ -0,0 +1,379 @@
+package ideth
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	id "github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// cachePrefix is the prefix of the keys of the cached identities
+	cachePrefix = "identity_cache_"
+
+	// cacheSyncedKey is the key of the last block checked for key changes
+	cacheSyncedKey = "identity_cache_synced"
+
+	// maxFilterBlocks is the maximum number of blocks the key change events are filtered for at once
+	maxFilterBlocks = 5000
+)
+
+var (
+	// keyAddedTopic is the topic of the KeyAdded event of the identity contract
+	keyAddedTopic = common.HexToHash("0x480000bb1edad8ca1470381cc334b1917fbd51c6531f3a623ea8e0ec7e38a6e9")
+
+	// keyRevokedTopic is the topic of the KeyRevoked event of the identity contract
+	keyRevokedTopic = common.HexToHash("0x62db979b46b61a2c8ec127201e75b82b7a2dc57beb69834882857b7e9823d2fc")
+)
+
+// chainReader reads the blocks and logs of the chain, implemented by ethclient.Client.
+type chainReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// cachedKey is a key of an identity as returned by the keys by purpose lookup.
+type cachedKey struct {
+	Key       [32]byte
+	Type      *big.Int
+	RevokedAt uint32
+}
+
+// cachedIdentity holds the cached key lookups of an identity contract.
+type cachedIdentity struct {
+	Keys          map[string]*id.KeyResponse // by hex encoded key
+	KeysByPurpose map[string][]cachedKey     // by purpose
+}
+
+// Type returns the reflect type of the cachedIdentity.
+func (c *cachedIdentity) Type() reflect.Type {
+	return reflect.TypeOf(c)
+}
+
+// JSON returns the json representation of the cachedIdentity.
+func (c *cachedIdentity) JSON() ([]byte, error) {
+	return json.Marshal(c)
+}
+
+// FromJSON loads the cachedIdentity from the json.
+func (c *cachedIdentity) FromJSON(data []byte) error {
+	return json.Unmarshal(data, c)
+}
+
+// cacheSync holds the last block checked for key changes.
+type cacheSync struct {
+	Block uint64
+}
+
+// Type returns the reflect type of the cacheSync.
+func (c *cacheSync) Type() reflect.Type {
+	return reflect.TypeOf(c)
+}
+
+// JSON returns the json representation of the cacheSync.
+func (c *cacheSync) JSON() ([]byte, error) {
+	return json.Marshal(c)
+}
+
+// FromJSON loads the cacheSync from the json.
+func (c *cacheSync) FromJSON(data []byte) error {
+	return json.Unmarshal(data, c)
+}
+
+// keyCache caches the key lookups of identity contracts in the DB.
+// The cached lookups of an identity are dropped when a KeyAdded or KeyRevoked event of the identity contract is seen.
+// Lookups are cached only if they were made at or after the last block checked for events, so no change is missed.
+// The cache is bypassed if the events weren't checked for 3 sync intervals, e.g. when the ethereum node is unreachable.
+// A nil keyCache caches nothing.
+type keyCache struct {
+	db       storage.Repository
+	chain    chainReader
+	interval time.Duration
+
+	mu        sync.Mutex
+	synced    uint64         // last block checked for key changes
+	syncedAt  time.Time      // time of the last check
+	listeners []func(id.DID) // called with the identities with key changes
+}
+
+// newKeyCache returns a keyCache checking for key changes every interval.
+func newKeyCache(db storage.Repository, chain chainReader, interval time.Duration) *keyCache {
+	db.Register(new(cachedIdentity))
+	db.Register(new(cacheSync))
+	c := &keyCache{db: db, chain: chain, interval: interval}
+	m, err := db.Get([]byte(cacheSyncedKey))
+	if err == nil {
+		c.synced = m.(*cacheSync).Block
+	}
+
+	return c
+}
+
+// Name returns the name of the key cache sync service.
+func (c *keyCache) Name() string {
+	return "IdentityKeyCache"
+}
+
+// OnKeyChange registers fn to be called with the identities with key changes, once their cached lookups are dropped.
+func (c *keyCache) OnKeyChange(fn func(did id.DID)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners = append(c.listeners, fn)
+}
+
+// Start checks for key changes every interval until the context is done.
+func (c *keyCache) Start(ctx context.Context, wg *sync.WaitGroup, startupErr chan<- error) {
+	defer wg.Done()
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		err := c.sync(ctx)
+		if err != nil {
+			log.Warningf("failed to check identity key changes: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info("Shutting down identity key cache with context done")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sync drops the cached lookups of the identities with key changes since the last checked block.
+func (c *keyCache) sync(ctx context.Context) error {
+	head, err := c.chain.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return errors.New("failed to get the latest block: %v", err)
+	}
+
+	c.mu.Lock()
+	from := c.synced + 1
+	c.mu.Unlock()
+
+	// nothing can be cached before the first check
+	if from == 1 || head.Number.Uint64() < from {
+		return c.invalidate(nil, head.Number.Uint64())
+	}
+
+	for from <= head.Number.Uint64() {
+		to := from + maxFilterBlocks - 1
+		if to > head.Number.Uint64() {
+			to = head.Number.Uint64()
+		}
+
+		logs, err := c.chain.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Topics:    [][]common.Hash{{keyAddedTopic, keyRevokedTopic}},
+		})
+		if err != nil {
+			return errors.New("failed to filter key changes from block %d to %d: %v", from, to, err)
+		}
+
+		var dids []id.DID
+		for _, l := range logs {
+			dids = append(dids, id.NewDID(l.Address))
+		}
+
+		err = c.invalidate(dids, to)
+		if err != nil {
+			return err
+		}
+
+		c.notify(dids)
+		from = to + 1
+	}
+
+	return nil
+}
+
+// invalidate drops the cached lookups of the identities and marks the block as checked.
+func (c *keyCache) invalidate(dids []id.DID, block uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	batch := c.db.NewBatch()
+	for _, did := range dids {
+		batch.Delete(cacheKey(did))
+	}
+
+	if block > c.synced {
+		s := &cacheSync{Block: block}
+		var err error
+		if batch.Exists([]byte(cacheSyncedKey)) {
+			err = batch.Update([]byte(cacheSyncedKey), s)
+		} else {
+			err = batch.Create([]byte(cacheSyncedKey), s)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	err := batch.Commit()
+	if err != nil {
+		return errors.New("failed to drop cached identities: %v", err)
+	}
+
+	if block > c.synced {
+		c.synced = block
+	}
+
+	c.syncedAt = time.Now()
+	return nil
+}
+
+// notify calls the listeners with the identities with key changes.
+func (c *keyCache) notify(dids []id.DID) {
+	if len(dids) == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	listeners := c.listeners
+	c.mu.Unlock()
+	for _, fn := range listeners {
+		for _, did := range dids {
+			fn(did)
+		}
+	}
+}
+
+// fresh returns true if the key changes were checked recently. Must be called with the lock held.
+func (c *keyCache) fresh() bool {
+	return !c.syncedAt.IsZero() && time.Since(c.syncedAt) < 3*c.interval
+}
+
+// pin returns the latest block before the call so that the result can be cached.
+// The call reads the state at or after the block, the key changes after the block are dropped by the next checks.
+// Returns false if the result can't be cached.
+func (c *keyCache) pin(opts *bind.CallOpts) (block uint64, ok bool) {
+	if c == nil {
+		return 0, false
+	}
+
+	head, err := c.chain.HeaderByNumber(opts.Context, nil)
+	if err != nil {
+		log.Warningf("failed to get the latest block: %v", err)
+		return 0, false
+	}
+
+	return head.Number.Uint64(), true
+}
+
+// get returns the cached lookups of the identity. Must be called with the lock held.
+func (c *keyCache) get(did id.DID) *cachedIdentity {
+	m, err := c.db.Get(cacheKey(did))
+	if err != nil {
+		return &cachedIdentity{Keys: make(map[string]*id.KeyResponse), KeysByPurpose: make(map[string][]cachedKey)}
+	}
+
+	return m.(*cachedIdentity)
+}
+
+// update updates the cached lookups of the identity with a lookup made at the block.
+func (c *keyCache) update(did id.DID, block uint64, fn func(ci *cachedIdentity)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.fresh() || block < c.synced {
+		return
+	}
+
+	ci := c.get(did)
+	fn(ci)
+	key := cacheKey(did)
+	var err error
+	if c.db.Exists(key) {
+		err = c.db.Update(key, ci)
+	} else {
+		err = c.db.Create(key, ci)
+	}
+	if err != nil {
+		log.Warningf("failed to cache identity %s: %v", did.String(), err)
+	}
+}
+
+// getKey returns the cached key of the identity.
+func (c *keyCache) getKey(did id.DID, key [32]byte) (*id.KeyResponse, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.fresh() {
+		return nil, false
+	}
+
+	k, ok := c.get(did).Keys[hexutil.Encode(key[:])]
+	return k, ok
+}
+
+// putKey caches the response of the key lookup of the identity made at the block.
+func (c *keyCache) putKey(did id.DID, block uint64, key [32]byte, resp *id.KeyResponse) {
+	if c == nil {
+		return
+	}
+
+	c.update(did, block, func(ci *cachedIdentity) {
+		ci.Keys[hexutil.Encode(key[:])] = resp
+	})
+}
+
+// getKeysByPurpose returns the cached keys of the identity with the purpose.
+func (c *keyCache) getKeysByPurpose(did id.DID, purpose *big.Int) ([]id.KeyDID, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.fresh() {
+		return nil, false
+	}
+
+	cks, ok := c.get(did).KeysByPurpose[purpose.String()]
+	if !ok {
+		return nil, false
+	}
+
+	var keys []id.KeyDID
+	for _, k := range cks {
+		keys = append(keys, id.NewKey(k.Key, purpose, k.Type, k.RevokedAt))
+	}
+
+	return keys, true
+}
+
+// putKeysByPurpose caches the keys of the identity with the purpose looked up at the block.
+func (c *keyCache) putKeysByPurpose(did id.DID, block uint64, purpose *big.Int, keys []id.KeyDID) {
+	if c == nil {
+		return
+	}
+
+	cks := make([]cachedKey, 0, len(keys))
+	for _, k := range keys {
+		cks = append(cks, cachedKey{Key: k.GetKey(), Type: k.GetType(), RevokedAt: k.GetRevokedAt()})
+	}
+
+	c.update(did, block, func(ci *cachedIdentity) {
+		ci.KeysByPurpose[purpose.String()] = cks
+	})
+}
+
+// cacheKey returns the key of the cached lookups of the identity.
+func cacheKey(did id.DID) []byte {
+	return append([]byte(cachePrefix), did[:]...)
+}
@@ -0,0 +1,180 @@
+// +build unit
+
+package ideth
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	id "github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockChainReader struct {
+	mock.Mock
+}
+
+func (m *mockChainReader) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	args := m.Called(ctx, number)
+	h, _ := args.Get(0).(*types.Header)
+	return h, args.Error(1)
+}
+
+func (m *mockChainReader) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	args := m.Called(ctx, q)
+	logs, _ := args.Get(0).([]types.Log)
+	return logs, args.Error(1)
+}
+
+func head(block int64) *types.Header {
+	return &types.Header{Number: big.NewInt(block)}
+}
+
+func newTestRepo(t *testing.T) storage.Repository {
+	db, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	return leveldb.NewLevelDBRepository(db)
+}
+
+func TestKeyCache_nil(t *testing.T) {
+	var c *keyCache
+	did := id.NewDID(common.BytesToAddress(utils.RandomSlice(20)))
+	_, ok := c.getKey(did, utils.RandomByte32())
+	assert.False(t, ok)
+	_, ok = c.getKeysByPurpose(did, big.NewInt(1))
+	assert.False(t, ok)
+	_, ok = c.pin(new(bind.CallOpts))
+	assert.False(t, ok)
+	c.putKey(did, 10, utils.RandomByte32(), new(id.KeyResponse))
+}
+
+func TestKeyCache_putGet(t *testing.T) {
+	chain := new(mockChainReader)
+	c := newKeyCache(newTestRepo(t), chain, time.Minute)
+	did := id.NewDID(common.BytesToAddress(utils.RandomSlice(20)))
+	key := utils.RandomByte32()
+	resp := &id.KeyResponse{Key: key, Purposes: []*big.Int{big.NewInt(1)}}
+	purpose := big.NewInt(1)
+	keys := []id.KeyDID{id.NewKey(key, purpose, big.NewInt(1), 0)}
+
+	// not synced yet
+	c.putKey(did, 10, key, resp)
+	_, ok := c.getKey(did, key)
+	assert.False(t, ok)
+
+	// first sync marks the head as synced
+	chain.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(head(10), nil).Once()
+	assert.NoError(t, c.sync(context.Background()))
+	assert.Equal(t, uint64(10), c.synced)
+
+	// lookups before the synced block are not cached
+	c.putKey(did, 9, key, resp)
+	_, ok = c.getKey(did, key)
+	assert.False(t, ok)
+
+	c.putKey(did, 10, key, resp)
+	c.putKeysByPurpose(did, 10, purpose, keys)
+	k, ok := c.getKey(did, key)
+	assert.True(t, ok)
+	assert.Equal(t, resp, k)
+	_, ok = c.getKey(did, utils.RandomByte32())
+	assert.False(t, ok)
+	ks, ok := c.getKeysByPurpose(did, purpose)
+	assert.True(t, ok)
+	assert.Len(t, ks, 1)
+	assert.Equal(t, key, ks[0].GetKey())
+	assert.Equal(t, purpose, ks[0].GetPurpose())
+	_, ok = c.getKeysByPurpose(did, big.NewInt(2))
+	assert.False(t, ok)
+
+	// stale cache is bypassed
+	c.syncedAt = time.Now().Add(-3 * time.Minute)
+	_, ok = c.getKey(did, key)
+	assert.False(t, ok)
+	chain.AssertExpectations(t)
+}
+
+func TestKeyCache_sync(t *testing.T) {
+	chain := new(mockChainReader)
+	repo := newTestRepo(t)
+	c := newKeyCache(repo, chain, time.Minute)
+	did1 := id.NewDID(common.BytesToAddress(utils.RandomSlice(20)))
+	did2 := id.NewDID(common.BytesToAddress(utils.RandomSlice(20)))
+	key := utils.RandomByte32()
+	resp := &id.KeyResponse{Key: key}
+
+	var changed []id.DID
+	c.OnKeyChange(func(did id.DID) {
+		changed = append(changed, did)
+	})
+
+	chain.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(head(10), nil).Once()
+	assert.NoError(t, c.sync(context.Background()))
+	c.putKey(did1, 10, key, resp)
+	c.putKey(did2, 10, key, resp)
+
+	// failed filter keeps the cache
+	chain.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(head(20), nil).Once()
+	chain.On("FilterLogs", mock.Anything, mock.Anything).Return(nil, errors.New("failed")).Once()
+	assert.Error(t, c.sync(context.Background()))
+	assert.Equal(t, uint64(10), c.synced)
+	assert.Empty(t, changed)
+
+	// key change of did1 drops its cached lookups
+	chain.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(head(20), nil).Once()
+	chain.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+		FromBlock: big.NewInt(11),
+		ToBlock:   big.NewInt(20),
+		Topics:    [][]common.Hash{{keyAddedTopic, keyRevokedTopic}},
+	}).Return([]types.Log{{Address: did1.ToAddress()}}, nil).Once()
+	assert.NoError(t, c.sync(context.Background()))
+	assert.Equal(t, uint64(20), c.synced)
+	assert.Equal(t, []id.DID{did1}, changed)
+	_, ok := c.getKey(did1, key)
+	assert.False(t, ok)
+	_, ok = c.getKey(did2, key)
+	assert.True(t, ok)
+
+	// synced block is persisted
+	c = newKeyCache(repo, chain, time.Minute)
+	assert.Equal(t, uint64(20), c.synced)
+
+	// lookups are not cached until synced again
+	_, ok = c.getKey(did2, key)
+	assert.False(t, ok)
+
+	// large ranges are filtered in chunks
+	chain.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(head(20+maxFilterBlocks+1), nil).Once()
+	chain.On("FilterLogs", mock.Anything, mock.Anything).Return(nil, nil).Twice()
+	assert.NoError(t, c.sync(context.Background()))
+	assert.Equal(t, uint64(20+maxFilterBlocks+1), c.synced)
+	_, ok = c.getKey(did2, key)
+	assert.True(t, ok)
+	chain.AssertExpectations(t)
+}
+
+func TestKeyCache_pin(t *testing.T) {
+	chain := new(mockChainReader)
+	c := newKeyCache(newTestRepo(t), chain, time.Minute)
+	chain.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("failed")).Once()
+	_, ok := c.pin(new(bind.CallOpts))
+	assert.False(t, ok)
+
+	chain.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(head(15), nil).Once()
+	opts := new(bind.CallOpts)
+	block, ok := c.pin(opts)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(15), block)
+	chain.AssertExpectations(t)
+}
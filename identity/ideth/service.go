@@ -52,6 +52,9 @@ type service struct {
 	client    ethereum.Client
 	txManager transactions.Manager
 	queue     *queue.Server
+
+	// cache of the key lookups, nil if caching is disabled
+	cache *keyCache
 }
 
 func (i service) prepareTransaction(ctx context.Context, did id.DID) (contract, *bind.TransactOpts, error) {
@@ -102,6 +105,11 @@ func NewService(client ethereum.Client, txManager transactions.Manager, queue *q
 	return service{client: client, txManager: txManager, queue: queue}
 }
 
+// newCachedService creates a instance of the identity service caching the key lookups in the cache
+func newCachedService(client ethereum.Client, txManager transactions.Manager, queue *queue.Server, cache *keyCache) id.ServiceDID {
+	return service{client: client, txManager: txManager, queue: queue, cache: cache}
+}
+
 func logTxHash(tx *types.Transaction) {
 	log.Infof("Ethereum transaction created. Hash [%x] and Nonce [%v] and Check [%v]", tx.Hash(), tx.Nonce(), tx.CheckNonce())
 	log.Infof("Transfer pending: 0x%x\n", tx.Hash())
@@ -217,18 +225,28 @@ func (i service) ethereumTX(opts *bind.TransactOpts, contractMethod interface{},
 
 // GetKey return a key from the identity contract
 func (i service) GetKey(did id.DID, key [32]byte) (*id.KeyResponse, error) {
+	if k, ok := i.cache.getKey(did, key); ok {
+		return k, nil
+	}
+
 	contract, opts, _, err := i.prepareCall(did)
 	if err != nil {
 		return nil, err
 	}
 
+	block, cacheable := i.cache.pin(opts)
 	result, err := contract.GetKey(opts, key)
 
 	if err != nil {
 		return nil, errors.New("Could not call identity contract: %v", err)
 	}
 
-	return &id.KeyResponse{result.Key, result.Purposes, result.RevokedAt}, nil
+	k := &id.KeyResponse{result.Key, result.Purposes, result.RevokedAt}
+	if cacheable {
+		i.cache.putKey(did, block, key, k)
+	}
+
+	return k, nil
 
 }
 
@@ -267,11 +285,16 @@ func (i service) Execute(ctx context.Context, to common.Address, contractAbi, me
 }
 
 func (i service) GetKeysByPurpose(did id.DID, purpose *big.Int) ([]id.KeyDID, error) {
+	if keys, ok := i.cache.getKeysByPurpose(did, purpose); ok {
+		return keys, nil
+	}
+
 	contract, opts, _, err := i.prepareCall(did)
 	if err != nil {
 		return nil, err
 	}
 
+	block, cacheable := i.cache.pin(opts)
 	keyStruct, err := contract.GetKeysByPurpose(opts, purpose)
 	if err != nil {
 		return nil, err
@@ -281,6 +304,10 @@ func (i service) GetKeysByPurpose(did id.DID, purpose *big.Int) ([]id.KeyDID, er
 	for i, k := range keyStruct.KeysByPurpose {
 		keyResp = append(keyResp, id.NewKey(k, purpose, keyStruct.KeyTypes[i], keyStruct.KeysRevokedAt[i]))
 	}
+
+	if cacheable {
+		i.cache.putKeysByPurpose(did, block, purpose, keyResp)
+	}
 	return keyResp, nil
 
 }
@@ -327,17 +354,12 @@ func (i service) Exists(ctx context.Context, did id.DID) error {
 
 // ValidateKey checks if a given key is valid for the given centrifugeID.
 func (i service) ValidateKey(ctx context.Context, did id.DID, key []byte, purpose *big.Int, validateAt *time.Time) error {
-	contract, opts, _, err := i.prepareCall(did)
-	if err != nil {
-		return err
-	}
-
 	key32, err := utils.SliceToByte32(key)
 	if err != nil {
 		return err
 	}
 
-	ethKey, err := contract.GetKey(opts, key32)
+	ethKey, err := i.GetKey(did, key32)
 	if err != nil {
 		return err
 	}
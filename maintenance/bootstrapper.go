@@ -0,0 +1,38 @@
+package maintenance
+
+import (
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+)
+
+// Bootstrapper implements bootstrap.Bootstrapper.
+type Bootstrapper struct{}
+
+// Bootstrap initialises the maintenance service and registers the node-wide jobs.
+func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
+	cfg, ok := ctx[bootstrap.BootstrappedConfig].(Config)
+	if !ok {
+		return errors.New("maintenance config not initialised")
+	}
+
+	cfgService, ok := ctx[config.BootstrappedConfigStorage].(config.Service)
+	if !ok {
+		return errors.New("config storage not initialised")
+	}
+
+	idService, ok := ctx[identity.BootstrappedDIDService].(identity.ServiceDID)
+	if !ok {
+		return errors.New("identity service not initialised")
+	}
+
+	srv := DefaultService(cfgService, cfg.GetMaintenanceConcurrency())
+	err := srv.RegisterJob(NewKeyExpiryJob(idService))
+	if err != nil {
+		return err
+	}
+
+	ctx[BootstrappedService] = srv
+	return nil
+}
@@ -0,0 +1,82 @@
+package maintenance
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-centrifuge/centerrors"
+	"github.com/centrifuge/go-centrifuge/code"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/maintenance"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+type grpcHandler struct {
+	srv Service
+}
+
+// GRPCHandler returns an implementation of maintenancepb.MaintenanceServiceServer
+func GRPCHandler(srv Service) maintenancepb.MaintenanceServiceServer {
+	return grpcHandler{srv: srv}
+}
+
+// ListJobs returns the names of the registered maintenance jobs.
+func (h grpcHandler) ListJobs(ctx context.Context, _ *empty.Empty) (*maintenancepb.ListJobsResponse, error) {
+	return &maintenancepb.ListJobsResponse{Jobs: h.srv.Jobs()}, nil
+}
+
+// StartJob starts the job for all the accounts and returns the report of the new run.
+func (h grpcHandler) StartJob(ctx context.Context, req *maintenancepb.StartJobRequest) (*maintenancepb.JobReport, error) {
+	r, err := h.srv.StartJob(req.Job)
+	if err != nil {
+		log.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return toProtoReport(r)
+}
+
+// GetJobReport returns the consolidated report of the run.
+func (h grpcHandler) GetJobReport(ctx context.Context, req *maintenancepb.GetJobReportRequest) (*maintenancepb.JobReport, error) {
+	r, err := h.srv.GetReport(req.RunId)
+	if err != nil {
+		log.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return toProtoReport(r)
+}
+
+func toProtoReport(r Report) (*maintenancepb.JobReport, error) {
+	startedAt, err := utils.ToTimestamp(r.StartedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &maintenancepb.JobReport{
+		RunId:     r.RunID,
+		Job:       r.Job,
+		Status:    r.Status,
+		StartedAt: startedAt,
+		Error:     r.Error,
+		Succeeded: int32(r.Succeeded()),
+		Failed:    int32(r.Failed()),
+	}
+
+	if !r.FinishedAt.IsZero() {
+		resp.FinishedAt, err = utils.ToTimestamp(r.FinishedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, res := range r.Accounts {
+		resp.Accounts = append(resp.Accounts, &maintenancepb.AccountResult{
+			AccountId: res.AccountID,
+			Duration:  ptypes.DurationProto(res.Duration),
+			Error:     res.Error,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,51 @@
+// +build unit
+
+package maintenance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/maintenance"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGRPCHandler(t *testing.T) {
+	accSrv := new(configstore.MockService)
+	accSrv.On("GetAllAccounts").Return(accounts(t, 2))
+	srv := DefaultService(accSrv, 1)
+	assert.NoError(t, srv.RegisterJob(&mockJob{name: "job", runF: func(ctx context.Context) error {
+		return errors.New("failed")
+	}}))
+	h := GRPCHandler(srv)
+
+	jobs, err := h.ListJobs(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"job"}, jobs.Jobs)
+
+	// unknown job
+	_, err = h.StartJob(context.Background(), &maintenancepb.StartJobRequest{Job: "unknown"})
+	assert.Error(t, err)
+
+	resp, err := h.StartJob(context.Background(), &maintenancepb.StartJobRequest{Job: "job"})
+	assert.NoError(t, err)
+	assert.Equal(t, "job", resp.Job)
+	assert.NotNil(t, resp.StartedAt)
+
+	// unknown run
+	_, err = h.GetJobReport(context.Background(), &maintenancepb.GetJobReportRequest{RunId: "unknown"})
+	assert.Error(t, err)
+
+	waitForReport(t, srv, resp.RunId)
+	resp, err = h.GetJobReport(context.Background(), &maintenancepb.GetJobReportRequest{RunId: resp.RunId})
+	assert.NoError(t, err)
+	assert.Equal(t, StatusDone, resp.Status)
+	assert.NotNil(t, resp.FinishedAt)
+	assert.Equal(t, int32(0), resp.Succeeded)
+	assert.Equal(t, int32(2), resp.Failed)
+	assert.Len(t, resp.Accounts, 2)
+	assert.Equal(t, "failed", resp.Accounts[0].Error)
+	assert.NotNil(t, resp.Accounts[0].Duration)
+}
@@ -0,0 +1,66 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+)
+
+// KeyExpiryJobName is the name of the job checking the keys of the accounts.
+const KeyExpiryJobName = "key-expiry"
+
+// keyExpiryJob checks that the signing and p2p discovery keys of an account are still valid on its identity.
+type keyExpiryJob struct {
+	idService identity.ServiceDID
+}
+
+// NewKeyExpiryJob returns a job that checks for revoked or missing account keys.
+func NewKeyExpiryJob(idService identity.ServiceDID) Job {
+	return keyExpiryJob{idService: idService}
+}
+
+func (keyExpiryJob) JobName() string {
+	return KeyExpiryJobName
+}
+
+func (j keyExpiryJob) RunForAccount(ctx context.Context) error {
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := acc.GetIdentityID()
+	if err != nil {
+		return err
+	}
+
+	keys, err := acc.GetKeys()
+	if err != nil {
+		return err
+	}
+
+	did := identity.NewDIDFromBytes(id)
+	var invalid []string
+	for _, purpose := range []identity.Purpose{identity.KeyPurposeSigning, identity.KeyPurposeP2PDiscovery} {
+		key, ok := keys[purpose.Name]
+		if !ok {
+			invalid = append(invalid, fmt.Sprintf("%s: key missing", purpose.Name))
+			continue
+		}
+
+		err = j.idService.ValidateKey(ctx, did, key.PublicKey, &(purpose.Value), nil)
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", purpose.Name, err))
+		}
+	}
+
+	if len(invalid) > 0 {
+		return errors.New("invalid keys: %s", strings.Join(invalid, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	logging "github.com/ipfs/go-log"
+	"github.com/satori/go.uuid"
+)
+
+const (
+	// BootstrappedService is the key to the maintenance Service in the bootstrap context.
+	BootstrappedService = "BootstrappedMaintenanceService"
+
+	// ErrUnknownJob must be used when no job is registered with the given name.
+	ErrUnknownJob = errors.Error("unknown maintenance job")
+
+	// ErrUnknownRun must be used when no report exists for the given run ID.
+	ErrUnknownRun = errors.Error("unknown maintenance run")
+
+	// StatusRunning is the status of a run that is still processing accounts.
+	StatusRunning = "running"
+
+	// StatusDone is the status of a run that processed all the accounts.
+	StatusDone = "done"
+
+	// maxReports is the number of reports kept in memory. The oldest report is dropped first.
+	maxReports = 100
+)
+
+var log = logging.Logger("maintenance")
+
+// Config defines the configuration required by the maintenance service.
+type Config interface {
+	GetMaintenanceConcurrency() int
+}
+
+// Job is a node-wide maintenance task that runs once per account.
+type Job interface {
+	// JobName returns the unique name of the job.
+	JobName() string
+
+	// RunForAccount runs the job for the account in the context.
+	RunForAccount(ctx context.Context) error
+}
+
+// AccountResult is the result of a job run for a single account.
+type AccountResult struct {
+	AccountID string
+	Duration  time.Duration
+	Error     string
+}
+
+// Report is the consolidated report of a job run across all accounts.
+type Report struct {
+	RunID      string
+	Job        string
+	Status     string
+	StartedAt  time.Time
+	FinishedAt time.Time
+
+	// Error is set if the job could not run for the accounts.
+	Error    string
+	Accounts []AccountResult
+}
+
+// Succeeded returns the number of accounts the job succeeded for.
+func (r Report) Succeeded() int {
+	return len(r.Accounts) - r.Failed()
+}
+
+// Failed returns the number of accounts the job failed for.
+func (r Report) Failed() int {
+	var failed int
+	for _, res := range r.Accounts {
+		if res.Error != "" {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+// Service runs the registered jobs across all the accounts of the node.
+type Service interface {
+	// RegisterJob registers the job under its name.
+	RegisterJob(job Job) error
+
+	// Jobs returns the names of the registered jobs.
+	Jobs() []string
+
+	// StartJob starts a run of the job in the background and returns its initial report.
+	StartJob(name string) (Report, error)
+
+	// GetReport returns the latest report of the run.
+	GetReport(runID string) (Report, error)
+}
+
+type service struct {
+	accounts    config.Service
+	concurrency int
+
+	mu      sync.RWMutex
+	jobs    map[string]Job
+	reports map[string]*Report
+	runs    []string
+}
+
+// DefaultService returns the default maintenance service.
+// Accounts are processed by at most concurrency jobs at a time.
+func DefaultService(accounts config.Service, concurrency int) Service {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &service{
+		accounts:    accounts,
+		concurrency: concurrency,
+		jobs:        make(map[string]Job),
+		reports:     make(map[string]*Report),
+	}
+}
+
+func (s *service) RegisterJob(job Job) error {
+	if job == nil || job.JobName() == "" {
+		return errors.New("job name is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[job.JobName()]; ok {
+		return errors.New("job %s is already registered", job.JobName())
+	}
+
+	s.jobs[job.JobName()] = job
+	return nil
+}
+
+func (s *service) Jobs() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var names []string
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func (s *service) StartJob(name string) (Report, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[name]
+	if !ok {
+		return Report{}, errors.NewTypedError(ErrUnknownJob, errors.New("%s", name))
+	}
+
+	r := &Report{
+		RunID:     uuid.Must(uuid.NewV4()).String(),
+		Job:       name,
+		Status:    StatusRunning,
+		StartedAt: time.Now().UTC(),
+	}
+
+	s.reports[r.RunID] = r
+	s.runs = append(s.runs, r.RunID)
+	if len(s.runs) > maxReports {
+		delete(s.reports, s.runs[0])
+		s.runs = s.runs[1:]
+	}
+
+	go s.run(job, r.RunID)
+	return *r, nil
+}
+
+func (s *service) GetReport(runID string) (Report, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.reports[runID]
+	if !ok {
+		return Report{}, errors.NewTypedError(ErrUnknownRun, errors.New("%s", runID))
+	}
+
+	report := *r
+	report.Accounts = append([]AccountResult(nil), r.Accounts...)
+	return report, nil
+}
+
+// run runs the job for every account with at most s.concurrency accounts in parallel.
+func (s *service) run(job Job, runID string) {
+	accs, err := s.accounts.GetAllAccounts()
+	if err != nil {
+		log.Errorf("job %s: failed to load accounts: %v", job.JobName(), err)
+		s.finish(runID, nil, fmt.Sprintf("failed to load accounts: %v", err))
+		return
+	}
+
+	results := make([]AccountResult, len(accs))
+	sem := make(chan struct{}, s.concurrency)
+	var wg sync.WaitGroup
+	for i, acc := range accs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, acc config.Account) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			results[i] = runForAccount(job, acc)
+		}(i, acc)
+	}
+
+	wg.Wait()
+	s.finish(runID, results, "")
+}
+
+func (s *service) finish(runID string, results []AccountResult, errMsg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.reports[runID]
+	if !ok {
+		// report was dropped already
+		return
+	}
+
+	r.Accounts = results
+	r.Error = errMsg
+	r.Status = StatusDone
+	r.FinishedAt = time.Now().UTC()
+}
+
+// runForAccount runs the job for a single account.
+// A failing or panicking job only fails the result of this account.
+func runForAccount(job Job, acc config.Account) (res AccountResult) {
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			res.Error = fmt.Sprintf("job panicked: %v", r)
+		}
+
+		res.Duration = time.Since(start)
+		if res.Error != "" {
+			log.Warningf("job %s failed for account %s: %s", job.JobName(), res.AccountID, res.Error)
+		}
+	}()
+
+	id, err := acc.GetIdentityID()
+	if err != nil {
+		res.Error = fmt.Sprintf("failed to get account ID: %v", err)
+		return res
+	}
+
+	res.AccountID = hexutil.Encode(id)
+	ctx, err := contextutil.New(context.Background(), acc)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+
+	err = job.RunForAccount(ctx)
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res
+}
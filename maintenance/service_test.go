@@ -0,0 +1,216 @@
+// +build unit
+
+package maintenance
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/bootstrap/bootstrappers/testlogging"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var ctx = map[string]interface{}{}
+
+func TestMain(m *testing.M) {
+	ibootstappers := []bootstrap.TestBootstrapper{
+		&testlogging.TestLoggingBootstrapper{},
+		&config.Bootstrapper{},
+		&leveldb.Bootstrapper{},
+		&configstore.Bootstrapper{},
+	}
+	ctx[identity.BootstrappedDIDFactory] = &testingcommons.MockIdentityFactory{}
+	ctx[identity.BootstrappedDIDService] = &testingcommons.MockIdentityService{}
+	bootstrap.RunTestBootstrappers(ibootstappers, ctx)
+	result := m.Run()
+	bootstrap.RunTestTeardown(ibootstappers)
+	os.Exit(result)
+}
+
+type mockJob struct {
+	name    string
+	running int32
+	maxRuns int32
+	runF    func(ctx context.Context) error
+}
+
+func (j *mockJob) JobName() string {
+	return j.name
+}
+
+func (j *mockJob) RunForAccount(ctx context.Context) error {
+	n := atomic.AddInt32(&j.running, 1)
+	defer atomic.AddInt32(&j.running, -1)
+	for {
+		max := atomic.LoadInt32(&j.maxRuns)
+		if n <= max || atomic.CompareAndSwapInt32(&j.maxRuns, max, n) {
+			break
+		}
+	}
+
+	return j.runF(ctx)
+}
+
+func waitForReport(t *testing.T, srv Service, runID string) Report {
+	for i := 0; i < 100; i++ {
+		r, err := srv.GetReport(runID)
+		assert.NoError(t, err)
+		if r.Status == StatusDone {
+			return r
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("run %s did not finish", runID)
+	return Report{}
+}
+
+func accounts(t *testing.T, count int) []config.Account {
+	accs, err := ctx[config.BootstrappedConfigStorage].(config.Service).GetAllAccounts()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, accs)
+	var res []config.Account
+	for i := 0; i < count; i++ {
+		res = append(res, accs[0])
+	}
+
+	return res
+}
+
+func TestService_RegisterJob(t *testing.T) {
+	srv := DefaultService(ctx[config.BootstrappedConfigStorage].(config.Service), 2)
+	assert.Error(t, srv.RegisterJob(nil))
+	assert.Error(t, srv.RegisterJob(&mockJob{}))
+	assert.NoError(t, srv.RegisterJob(&mockJob{name: "b"}))
+	assert.NoError(t, srv.RegisterJob(&mockJob{name: "a"}))
+	err := srv.RegisterJob(&mockJob{name: "a"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "already registered")
+	assert.Equal(t, []string{"a", "b"}, srv.Jobs())
+}
+
+func TestService_StartJob(t *testing.T) {
+	accSrv := new(configstore.MockService)
+	accSrv.On("GetAllAccounts").Return(accounts(t, 6))
+	srv := DefaultService(accSrv, 2)
+
+	// unknown job
+	_, err := srv.StartJob("unknown")
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrUnknownJob, err))
+
+	// unknown run
+	_, err = srv.GetReport("unknown")
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(ErrUnknownRun, err))
+
+	// failing and panicking accounts are isolated
+	var calls int32
+	job := &mockJob{name: "job", runF: func(ctx context.Context) error {
+		_, err := contextutil.Account(ctx)
+		assert.NoError(t, err)
+		time.Sleep(10 * time.Millisecond)
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			return errors.New("account failed")
+		case 2:
+			panic("account panicked")
+		}
+
+		return nil
+	}}
+	assert.NoError(t, srv.RegisterJob(job))
+	r, err := srv.StartJob("job")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, r.RunID)
+	assert.Equal(t, "job", r.Job)
+	assert.Equal(t, StatusRunning, r.Status)
+
+	r = waitForReport(t, srv, r.RunID)
+	assert.Empty(t, r.Error)
+	assert.Len(t, r.Accounts, 6)
+	assert.Equal(t, 4, r.Succeeded())
+	assert.Equal(t, 2, r.Failed())
+	assert.False(t, r.FinishedAt.Before(r.StartedAt))
+	assert.Equal(t, int32(6), atomic.LoadInt32(&calls))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&job.maxRuns))
+	var errs []string
+	for _, res := range r.Accounts {
+		assert.NotEmpty(t, res.AccountID)
+		if res.Error != "" {
+			errs = append(errs, res.Error)
+		}
+	}
+	assert.Contains(t, errs, "account failed")
+	assert.Contains(t, errs, "job panicked: account panicked")
+}
+
+func TestService_StartJob_noAccounts(t *testing.T) {
+	accSrv := new(configstore.MockService)
+	accSrv.On("GetAllAccounts").Return(nil)
+	srv := DefaultService(accSrv, 0)
+	assert.NoError(t, srv.RegisterJob(&mockJob{name: "job"}))
+	r, err := srv.StartJob("job")
+	assert.NoError(t, err)
+	r = waitForReport(t, srv, r.RunID)
+	assert.Empty(t, r.Accounts)
+	assert.Equal(t, 0, r.Succeeded())
+}
+
+func TestService_reportsCapped(t *testing.T) {
+	accSrv := new(configstore.MockService)
+	accSrv.On("GetAllAccounts").Return(nil)
+	srv := DefaultService(accSrv, 1)
+	assert.NoError(t, srv.RegisterJob(&mockJob{name: "job"}))
+	first, err := srv.StartJob("job")
+	assert.NoError(t, err)
+	for i := 0; i < maxReports; i++ {
+		_, err = srv.StartJob("job")
+		assert.NoError(t, err)
+	}
+
+	_, err = srv.GetReport(first.RunID)
+	assert.True(t, errors.IsOfType(ErrUnknownRun, err))
+}
+
+func TestKeyExpiryJob_RunForAccount(t *testing.T) {
+	// the stored account points to the keys relative to the testing config
+	acc := accounts(t, 1)[0]
+	acc.(*configstore.Account).P2PKeyPair = configstore.NewKeyPair("../build/resources/p2pKey.pub.pem", "../build/resources/p2pKey.key.pem")
+	acc.(*configstore.Account).SigningKeyPair = configstore.NewKeyPair("../build/resources/signingKey.pub.pem", "../build/resources/signingKey.key.pem")
+	cctx, err := contextutil.New(context.Background(), acc)
+	assert.NoError(t, err)
+	idService := new(testingcommons.MockIdentityService)
+	job := NewKeyExpiryJob(idService)
+	assert.Equal(t, KeyExpiryJobName, job.JobName())
+
+	// missing account
+	err = job.RunForAccount(context.Background())
+	assert.Error(t, err)
+
+	// revoked signing key
+	idService.On("ValidateKey", cctx, mock.Anything, mock.Anything, &(identity.KeyPurposeSigning.Value)).Return(errors.New("key revoked")).Once()
+	idService.On("ValidateKey", cctx, mock.Anything, mock.Anything, &(identity.KeyPurposeP2PDiscovery.Value)).Return(nil).Once()
+	err = job.RunForAccount(cctx)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "SIGNING: key revoked")
+	assert.NotContains(t, err.Error(), "P2P_DISCOVERY")
+
+	// valid keys
+	idService.On("ValidateKey", cctx, mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+	assert.NoError(t, job.RunForAccount(cctx))
+	idService.AssertExpectations(t)
+}
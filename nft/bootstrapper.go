@@ -70,5 +70,6 @@ func (*Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 			return h.Number.Uint64(), nil
 		})
 	ctx[BootstrappedPayObService] = payOb
+	ctx[BootstrappedOracleService] = newEthereumOracle(cfg, idService, docSrv, txManager)
 	return nil
 }
@@ -6,10 +6,9 @@ import (
 	"github.com/centrifuge/go-centrifuge/centerrors"
 	"github.com/centrifuge/go-centrifuge/code"
 	"github.com/centrifuge/go-centrifuge/config"
-	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/identifiers"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/nft"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	logging "github.com/ipfs/go-log"
 )
 
@@ -18,28 +17,23 @@ var apiLog = logging.Logger("nft-api")
 type grpcHandler struct {
 	config  config.Service
 	service PaymentObligation
+	oracle  OracleService
 }
 
 // GRPCHandler returns an implementation of invoice.DocumentServiceServer
-func GRPCHandler(config config.Service, payOb PaymentObligation) nftpb.NFTServiceServer {
-	return &grpcHandler{config: config, service: payOb}
+func GRPCHandler(config config.Service, payOb PaymentObligation, oracle OracleService) nftpb.NFTServiceServer {
+	return &grpcHandler{config: config, service: payOb, oracle: oracle}
 }
 
 // MintNFT will be called from the client API to mint an NFT
 func (g grpcHandler) MintNFT(ctx context.Context, request *nftpb.NFTMintRequest) (*nftpb.NFTMintResponse, error) {
 	apiLog.Infof("Received request to Mint an NFT with  %s with proof fields %s", request.Identifier, request.ProofFields)
-	ctxHeader, err := contextutil.Context(ctx, g.config)
+	err := validateParameters(request)
 	if err != nil {
-		apiLog.Error(err)
 		return nil, err
 	}
 
-	err = validateParameters(request)
-	if err != nil {
-		return nil, err
-	}
-
-	identifier, err := hexutil.Decode(request.Identifier)
+	identifier, err := identifiers.DecodeDocumentID(request.Identifier)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +47,7 @@ func (g grpcHandler) MintNFT(ctx context.Context, request *nftpb.NFTMintRequest)
 		SubmitNFTReadAccessProof: request.SubmitNftOwnerAccessProof,
 		SubmitTokenProof:         request.SubmitTokenProof,
 	}
-	resp, _, err := g.service.MintNFT(ctxHeader, req)
+	resp, _, err := g.service.MintNFT(ctx, req)
 	if err != nil {
 		return nil, centerrors.New(code.Unknown, err.Error())
 	}
@@ -64,6 +58,35 @@ func (g grpcHandler) MintNFT(ctx context.Context, request *nftpb.NFTMintRequest)
 	}, nil
 }
 
+// PushToOracle will be called from the client API to push the proven fields of a document to an oracle
+func (g grpcHandler) PushToOracle(ctx context.Context, request *nftpb.PushToOracleRequest) (*nftpb.PushToOracleResponse, error) {
+	apiLog.Infof("Received request to push the fields %s of document %s to oracle %s", request.ProofFields, request.Identifier, request.OracleAddress)
+	req := PushToOracleRequest{ProofFields: request.ProofFields}
+	if request.OracleAddress != "" {
+		if !common.IsHexAddress(request.OracleAddress) {
+			return nil, centerrors.New(code.Unknown, "OracleAddress is not a valid Ethereum address")
+		}
+
+		req.OracleAddress = common.HexToAddress(request.OracleAddress)
+	}
+
+	identifier, err := identifiers.DecodeDocumentID(request.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	req.DocumentID = identifier
+	resp, _, err := g.oracle.PushToOracle(ctx, req)
+	if err != nil {
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return &nftpb.PushToOracleResponse{
+		Header:  &nftpb.ResponseHeader{TransactionId: resp.TransactionID},
+		Version: resp.VersionID,
+	}, nil
+}
+
 func validateParameters(request *nftpb.NFTMintRequest) error {
 	if !common.IsHexAddress(request.RegistryAddress) {
 		return centerrors.New(code.Unknown, "registryAddress is not a valid Ethereum address")
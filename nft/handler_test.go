@@ -43,7 +43,7 @@ func TestNFTMint_success(t *testing.T) {
 		ProofFields:     nftMintRequest.ProofFields,
 	}
 	mockService.On("MintNFT", mock.Anything, req).Return(nftResponse, nil)
-	handler := grpcHandler{mockConfigStore, mockService}
+	handler := grpcHandler{mockConfigStore, mockService, nil}
 	nftMintResponse, err := handler.MintNFT(testingconfig.HandlerContext(mockConfigStore), nftMintRequest)
 	mockService.AssertExpectations(t)
 	assert.Nil(t, err, "mint nft should be successful")
@@ -62,7 +62,7 @@ func TestNFTMint_InvalidIdentifier(t *testing.T) {
 	nftMintRequest.Identifier = "32321"
 	mockConfigStore := mockmockConfigStore()
 	mockConfigStore.On("GetAllAccounts").Return(testingconfig.HandlerContext(mockConfigStore))
-	handler := grpcHandler{mockConfigStore, &mockPaymentObligationService{}}
+	handler := grpcHandler{mockConfigStore, &mockPaymentObligationService{}, nil}
 	_, err := handler.MintNFT(testingconfig.HandlerContext(mockConfigStore), nftMintRequest)
 	assert.Error(t, err, "invalid identifier should throw an error")
 }
@@ -80,7 +80,7 @@ func TestNFTMint_ServiceError(t *testing.T) {
 
 	mockService.On("MintNFT", mock.Anything, req).Return(nil, errors.New("service error"))
 	mockConfigStore := mockmockConfigStore()
-	handler := grpcHandler{mockConfigStore, mockService}
+	handler := grpcHandler{mockConfigStore, mockService, nil}
 	_, err := handler.MintNFT(testingconfig.HandlerContext(mockConfigStore), nftMintRequest)
 	mockService.AssertExpectations(t)
 	assert.NotNil(t, err)
@@ -90,21 +90,64 @@ func TestNFTMint_InvalidAddresses(t *testing.T) {
 	nftMintRequest := getTestSetupData()
 	nftMintRequest.RegistryAddress = "0x1234"
 	mockConfigStore := mockmockConfigStore()
-	handler := grpcHandler{mockConfigStore, &mockPaymentObligationService{}}
+	handler := grpcHandler{mockConfigStore, &mockPaymentObligationService{}, nil}
 	_, err := handler.MintNFT(testingconfig.HandlerContext(mockConfigStore), nftMintRequest)
 	assert.Error(t, err, "invalid registry address should throw an error")
 
 	nftMintRequest = getTestSetupData()
 	nftMintRequest.DepositAddress = "abc"
-	handler = grpcHandler{mockConfigStore, &mockPaymentObligationService{}}
+	handler = grpcHandler{mockConfigStore, &mockPaymentObligationService{}, nil}
 	_, err = handler.MintNFT(testingconfig.HandlerContext(mockConfigStore), nftMintRequest)
 	assert.Error(t, err, "invalid deposit address should throw an error")
 }
 
 func getTestSetupData() *nftpb.NFTMintRequest {
 	return &nftpb.NFTMintRequest{
-		Identifier:      "0x12121212",
+		Identifier:      "0x1212121212121212121212121212121212121212121212121212121212121212",
 		RegistryAddress: "0xf72855759a39fb75fc7341139f5d7a3974d4da08",
 		ProofFields:     []string{"gross_amount", "due_date", "currency"},
 		DepositAddress:  "0xf72855759a39fb75fc7341139f5d7a3974d4da08"}
 }
+
+type mockOracleService struct {
+	mock.Mock
+}
+
+func (m *mockOracleService) PushToOracle(ctx context.Context, request PushToOracleRequest) (*PushToOracleResponse, chan bool, error) {
+	args := m.Called(ctx, request)
+	resp, _ := args.Get(0).(*PushToOracleResponse)
+	return resp, nil, args.Error(1)
+}
+
+func TestPushToOracle(t *testing.T) {
+	mockConfigStore := mockmockConfigStore()
+	request := &nftpb.PushToOracleRequest{
+		Identifier:  "0x1212121212121212121212121212121212121212121212121212121212121212",
+		ProofFields: []string{"invoice.status"},
+	}
+
+	// invalid oracle address
+	mockService := &mockOracleService{}
+	handler := grpcHandler{mockConfigStore, nil, mockService}
+	request.OracleAddress = "0x1234"
+	_, err := handler.PushToOracle(testingconfig.HandlerContext(mockConfigStore), request)
+	assert.Error(t, err)
+
+	// service error
+	request.OracleAddress = ""
+	docID, _ := hexutil.Decode(request.Identifier)
+	req := PushToOracleRequest{DocumentID: docID, ProofFields: request.ProofFields}
+	mockService.On("PushToOracle", mock.Anything, req).Return(nil, ErrOracleAddress).Once()
+	_, err = handler.PushToOracle(testingconfig.HandlerContext(mockConfigStore), request)
+	assert.Error(t, err)
+
+	// success
+	request.OracleAddress = "0xf72855759a39fb75fc7341139f5d7a3974d4da08"
+	req.OracleAddress = common.HexToAddress(request.OracleAddress)
+	mockService.On("PushToOracle", mock.Anything, req).Return(&PushToOracleResponse{VersionID: "0x42", TransactionID: "0x24"}, nil).Once()
+	resp, err := handler.PushToOracle(testingconfig.HandlerContext(mockConfigStore), request)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x42", resp.Version)
+	assert.Equal(t, "0x24", resp.Header.TransactionId)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,172 @@
+package nft
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// BootstrappedOracleService is the key to OracleService in bootstrap context.
+	BootstrappedOracleService = "BootstrappedOracleService"
+
+	// ErrOracleAddress error for a push without an oracle address
+	ErrOracleAddress = errors.Error("no oracle address provided or configured")
+
+	// ErrDocumentNotAnchored error for a push of a document whose latest version is not anchored
+	ErrDocumentNotAnchored = errors.Error("latest document version not anchored")
+
+	// OracleContractABI is the ABI of the update method of the oracle contracts.
+	// The oracle verifies the proofs of the values against the document root anchored with the anchor ID.
+	OracleContractABI = `[{"constant":false,"inputs":[{"name":"anchorId","type":"uint256"},{"name":"properties","type":"bytes[]"},{"name":"values","type":"bytes[]"},{"name":"salts","type":"bytes32[]"},{"name":"proofs","type":"bytes32[][]"}],"name":"update","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`
+)
+
+// PushToOracleRequest holds the fields of a document pushed to an oracle
+type PushToOracleRequest struct {
+	DocumentID []byte
+
+	// OracleAddress is the address of the oracle contract, the configured oracle if zero
+	OracleAddress common.Address
+	ProofFields   []string
+}
+
+// PushToOracleResponse holds the pushed version and the transaction ID.
+type PushToOracleResponse struct {
+	VersionID     string
+	TransactionID string
+}
+
+// OracleService pushes the values of document fields with their precise proofs to oracle contracts on chain
+type OracleService interface {
+	// PushToOracle pushes the fields of the latest anchored version of the document to the oracle
+	PushToOracle(ctx context.Context, request PushToOracleRequest) (*PushToOracleResponse, chan bool, error)
+}
+
+// ethereumOracle pushes document fields to oracle contracts on Ethereum
+type ethereumOracle struct {
+	cfg             Config
+	identityService identity.ServiceDID
+	docSrv          documents.Service
+	txManager       transactions.Manager
+}
+
+// newEthereumOracle creates ethereumOracle given the parameters
+func newEthereumOracle(cfg Config, identityService identity.ServiceDID, docSrv documents.Service, txManager transactions.Manager) *ethereumOracle {
+	return &ethereumOracle{
+		cfg:             cfg,
+		identityService: identityService,
+		docSrv:          docSrv,
+		txManager:       txManager,
+	}
+}
+
+// oracleAddress returns the oracle address of the request, or the configured one.
+func (s *ethereumOracle) oracleAddress(req PushToOracleRequest) (common.Address, error) {
+	if req.OracleAddress != (common.Address{}) {
+		return req.OracleAddress, nil
+	}
+
+	addr := s.cfg.GetEthereumOracleAddress()
+	if addr == "" {
+		return common.Address{}, ErrOracleAddress
+	}
+
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, errors.NewTypedError(ErrOracleAddress, errors.New("invalid configured oracle address %s", addr))
+	}
+
+	return common.HexToAddress(addr), nil
+}
+
+// PushToOracle proves the fields of the latest version of the document and pushes them to the oracle within a
+// transaction. The version must be anchored for the oracle to verify the proofs.
+func (s *ethereumOracle) PushToOracle(ctx context.Context, req PushToOracleRequest) (*PushToOracleResponse, chan bool, error) {
+	tc, err := contextutil.Account(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cidBytes, err := tc.GetIdentityID()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cid := identity.NewDIDFromBytes(cidBytes)
+	if len(req.ProofFields) == 0 {
+		return nil, nil, errors.New("no fields to push provided")
+	}
+
+	oracle, err := s.oracleAddress(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	model, err := s.docSrv.GetCurrentVersion(ctx, req.DocumentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the versions stored before the status was tracked are anchored
+	if status := model.GetStatus(); status != "" && status != documents.StatusCommitted {
+		return nil, nil, errors.NewTypedError(ErrDocumentNotAnchored, errors.New("version %x is %s", model.CurrentVersion(), status))
+	}
+
+	docProofs, err := s.docSrv.CreateProofs(ctx, req.DocumentID, req.ProofFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	anchorID, err := anchors.ToAnchorID(docProofs.VersionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := convertToProofData(docProofs.FieldProofs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// We use context.Background() for now so that the transaction is only limited by ethereum timeouts
+	txID, done, err := s.txManager.ExecuteWithinTX(context.Background(), cid, transactions.NilTxID(), "Pushing document fields to oracle",
+		s.pusher(ctx, req.DocumentID, oracle, anchorID, data))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &PushToOracleResponse{
+		VersionID:     hexutil.Encode(docProofs.VersionID),
+		TransactionID: txID.String(),
+	}, done, nil
+}
+
+func (s *ethereumOracle) pusher(ctx context.Context, documentID []byte, oracle common.Address, anchorID anchors.AnchorID, data *proofData) func(accountID identity.DID, txID transactions.TxID, txMan transactions.Manager, errOut chan<- error) {
+	return func(accountID identity.DID, txID transactions.TxID, txMan transactions.Manager, errOut chan<- error) {
+		// anchorId *big.Int, properties [][]byte, values [][]byte, salts [][32]byte, proofs [][][32]byte
+		utxID, done, err := s.identityService.Execute(ctx, oracle, OracleContractABI, "update", anchorID.BigInt(),
+			data.Props, data.Values, data.Salts, data.Proofs)
+		if err != nil {
+			errOut <- err
+			return
+		}
+
+		log.Infof("Sent off ethTX to push [document: %s, anchor: %s, props: %s] to oracle %s.",
+			hexutil.Encode(documentID), anchorID.String(), byteSlicetoString(data.Props), oracle.String())
+
+		isDone := <-done
+		if !isDone {
+			// some problem occurred in a child task
+			errOut <- errors.New("oracle push failed for document %s and transaction %s", hexutil.Encode(documentID), utxID)
+			return
+		}
+
+		log.Infof("Document %s pushed to oracle %s successfully within transaction %s", hexutil.Encode(documentID), oracle.String(), utxID)
+		errOut <- nil
+	}
+}
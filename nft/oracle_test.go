@@ -0,0 +1,94 @@
+// +build unit
+
+package nft
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/documents/invoice"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/testingutils/testingtx"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEthereumOracle_PushToOracle(t *testing.T) {
+	cd, err := documents.NewCoreDocumentWithCollaborators(documents.CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	proof := getDummyProof(&cd.Document)
+	docID := decodeHex("0x1212")
+	fields := []string{"invoice.status"}
+	oracle := common.HexToAddress("0xf72855759a39fb75fc7341139f5d7a3974d4da08")
+	cfg := &testingconfig.MockConfig{}
+	ctxh := testingconfig.CreateAccountContext(t, ctx[bootstrap.BootstrappedConfig].(config.Configuration))
+
+	// no fields
+	srv := newEthereumOracle(cfg, nil, nil, nil)
+	_, _, err = srv.PushToOracle(ctxh, PushToOracleRequest{DocumentID: docID, OracleAddress: oracle})
+	assert.Error(t, err)
+
+	// no oracle address
+	cfg.On("GetEthereumOracleAddress").Return("").Once()
+	_, _, err = srv.PushToOracle(ctxh, PushToOracleRequest{DocumentID: docID, ProofFields: fields})
+	assert.True(t, errors.IsOfType(ErrOracleAddress, err))
+
+	// invalid configured oracle address
+	cfg.On("GetEthereumOracleAddress").Return("0x1234").Once()
+	_, _, err = srv.PushToOracle(ctxh, PushToOracleRequest{DocumentID: docID, ProofFields: fields})
+	assert.True(t, errors.IsOfType(ErrOracleAddress, err))
+
+	// latest version not anchored
+	inv := &invoice.Invoice{CoreDocument: cd}
+	inv.SetStatus(documents.StatusCommitting)
+	docSrv := &testingdocuments.MockService{}
+	docSrv.On("GetCurrentVersion", docID).Return(inv, nil).Once()
+	srv = newEthereumOracle(cfg, nil, docSrv, nil)
+	_, _, err = srv.PushToOracle(ctxh, PushToOracleRequest{DocumentID: docID, OracleAddress: oracle, ProofFields: fields})
+	assert.True(t, errors.IsOfType(ErrDocumentNotAnchored, err))
+
+	// success with the configured oracle
+	inv.SetStatus(documents.StatusCommitted)
+	docSrv.On("GetCurrentVersion", docID).Return(inv, nil).Once()
+	docSrv.On("CreateProofs", docID, fields).Return(proof, nil).Once()
+	cfg.On("GetEthereumOracleAddress").Return(oracle.String()).Once()
+	var work func(accountID identity.DID, txID transactions.TxID, txMan transactions.Manager, errOut chan<- error)
+	txMan := &testingtx.MockTxManager{}
+	txMan.On("ExecuteWithinTX", mock.Anything, mock.Anything, transactions.NilTxID(), mock.Anything, mock.Anything).
+		Return(transactions.NilTxID(), make(chan bool), nil).Run(func(args mock.Arguments) {
+		work = args.Get(4).(func(accountID identity.DID, txID transactions.TxID, txMan transactions.Manager, errOut chan<- error))
+	}).Once()
+	idSrv := &testingcommons.MockIdentityService{}
+	srv = newEthereumOracle(cfg, idSrv, docSrv, txMan)
+	resp, _, err := srv.PushToOracle(ctxh, PushToOracleRequest{DocumentID: docID, ProofFields: fields})
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(proof.VersionID), resp.VersionID)
+	docSrv.AssertExpectations(t)
+	cfg.AssertExpectations(t)
+
+	// the fields are pushed to the oracle
+	done := make(chan bool, 1)
+	done <- true
+	idSrv.On("Execute", mock.Anything, oracle, OracleContractABI, "update", mock.Anything).Return(transactions.NilTxID(), done, nil).Once()
+	errOut := make(chan error, 1)
+	work(testingidentity.GenerateRandomDID(), transactions.NilTxID(), txMan, errOut)
+	assert.NoError(t, <-errOut)
+	idSrv.AssertExpectations(t)
+
+	// the ethereum transaction failed
+	done = make(chan bool, 1)
+	done <- false
+	idSrv.On("Execute", mock.Anything, oracle, OracleContractABI, "update", mock.Anything).Return(transactions.NilTxID(), done, nil).Once()
+	work(testingidentity.GenerateRandomDID(), transactions.NilTxID(), txMan, errOut)
+	assert.Error(t, <-errOut)
+}
@@ -4,14 +4,14 @@ import (
 	"context"
 	"math/big"
 
-	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // TokenIDLength is the length of an NFT token ID
-const TokenIDLength = 32
+const TokenIDLength = identifiers.TokenIDLength
 
 // TokenID is uint256 in Solidity (256 bits | max value is 2^256-1)
 // tokenID should be random 32 bytes (32 byte = 256 bits)
@@ -26,13 +26,10 @@ func NewTokenID() TokenID {
 
 // TokenIDFromString converts given hex string to a TokenID
 func TokenIDFromString(hexStr string) (TokenID, error) {
-	tokenIDBytes, err := hexutil.Decode(hexStr)
+	tokenIDBytes, err := identifiers.DecodeTokenID(hexStr)
 	if err != nil {
 		return NewTokenID(), err
 	}
-	if len(tokenIDBytes) != TokenIDLength {
-		return NewTokenID(), errors.New("the provided hex string doesn't match the TokenID representation length")
-	}
 	var tid [TokenIDLength]byte
 	copy(tid[:], tokenIDBytes)
 	return tid, nil
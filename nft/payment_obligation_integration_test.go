@@ -87,8 +87,8 @@ func prepareForNFTMinting(t *testing.T) (context.Context, []byte, common.Address
 			Sender:        did.String(),
 			InvoiceNumber: "2132131",
 			InvoiceStatus: "unpaid",
-			GrossAmount:   123,
-			NetAmount:     123,
+			GrossAmount:   "123",
+			NetAmount:     "123",
 			Currency:      "EUR",
 			DueDate:       tm,
 		},
@@ -143,7 +143,7 @@ func TestPaymentObligationService_mint_grant_read_access(t *testing.T) {
 		DocumentID:               id,
 		RegistryAddress:          registry,
 		DepositAddress:           common.HexToAddress(depositAddr),
-		ProofFields:              []string{"invoice.gross_amount", "invoice.currency", "invoice.due_date", "invoice.sender", "invoice.invoice_status", signingRoot, signatureSender, documents.CDTreePrefix + ".next_version"},
+		ProofFields:              []string{"invoice.gross_amount_decimal", "invoice.currency", "invoice.due_date", "invoice.sender", "invoice.invoice_status", signingRoot, signatureSender, documents.CDTreePrefix + ".next_version"},
 		GrantNFTReadAccess:       true,
 		SubmitNFTReadAccessProof: true,
 		SubmitTokenProof:         true,
@@ -172,7 +172,7 @@ func failMintNFT(t *testing.T, grantNFT, nftReadAccess bool) {
 		DocumentID:               id,
 		RegistryAddress:          registry,
 		DepositAddress:           common.HexToAddress(depositAddr),
-		ProofFields:              []string{"invoice.gross_amount", "invoice.currency", "invoice.due_date"},
+		ProofFields:              []string{"invoice.gross_amount_decimal", "invoice.currency", "invoice.due_date"},
 		GrantNFTReadAccess:       grantNFT,
 		SubmitNFTReadAccessProof: nftReadAccess,
 	}
@@ -203,7 +203,7 @@ func mintNFTWithProofs(t *testing.T, grantAccess, tokenProof, readAccessProof bo
 		DocumentID:               id,
 		RegistryAddress:          registry,
 		DepositAddress:           common.HexToAddress(depositAddr),
-		ProofFields:              []string{"invoice.gross_amount", "invoice.currency", "invoice.due_date", "invoice.sender", "invoice.invoice_status", signingRoot, signatureSender, documents.CDTreePrefix + ".next_version"},
+		ProofFields:              []string{"invoice.gross_amount_decimal", "invoice.currency", "invoice.due_date", "invoice.sender", "invoice.invoice_status", signingRoot, signatureSender, documents.CDTreePrefix + ".next_version"},
 		GrantNFTReadAccess:       grantAccess,
 		SubmitTokenProof:         tokenProof,
 		SubmitNFTReadAccessProof: readAccessProof,
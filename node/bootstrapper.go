@@ -6,7 +6,9 @@ import (
 	"os/signal"
 
 	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/connectors"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/storage"
 )
 
@@ -68,5 +70,16 @@ func GetServers(ctx map[string]interface{}) ([]Server, error) {
 
 	var servers []Server
 	servers = append(servers, p2pSrv.(Server), apiSrv.(Server), queueSrv.(Server))
+
+	// the identity key cache only runs if caching is enabled
+	if cacheSrv, ok := ctx[identity.BootstrappedKeyCache]; ok {
+		servers = append(servers, cacheSrv.(Server))
+	}
+
+	// connectors are only polled if polling is enabled
+	if pollSrv, ok := ctx[connectors.BootstrappedPoller]; ok {
+		servers = append(servers, pollSrv.(Server))
+	}
+
 	return servers, nil
 }
@@ -28,9 +28,16 @@ const (
 	Success         Status    = 1
 )
 
+// Message is the payload sent to the webhook.
+// It extends the notification with the JSON summary of the document version, if available.
+type Message struct {
+	*notificationpb.NotificationMessage
+	DocumentSummary json.RawMessage `json:"document_summary,omitempty"`
+}
+
 // Sender defines methods that can handle a notification.
 type Sender interface {
-	Send(ctx context.Context, notification *notificationpb.NotificationMessage) (Status, error)
+	Send(ctx context.Context, notification *Message) (Status, error)
 }
 
 // NewWebhookSender returns an implementation of a Sender that sends notifications through webhooks.
@@ -44,7 +51,7 @@ type webhookSender struct {
 }
 
 // Send sends notification to the defined webhook.
-func (wh webhookSender) Send(ctx context.Context, notification *notificationpb.NotificationMessage) (Status, error) {
+func (wh webhookSender) Send(ctx context.Context, notification *Message) (Status, error) {
 	tc, err := contextutil.Account(ctx)
 	if err != nil {
 		return Failure, err
@@ -68,6 +68,7 @@ func TestWebhookSender_Send(t *testing.T) {
 			Recorded     *timestamp.Timestamp `json:"recorded,omitempty"`
 			DocumentType string               `json:"document_type,omitempty"`
 			DocumentId   string               `json:"document_id,omitempty"`
+			Summary      json.RawMessage      `json:"document_summary,omitempty"`
 		}
 		defer request.Body.Close()
 		data, err := ioutil.ReadAll(request.Body)
@@ -79,6 +80,7 @@ func TestWebhookSender_Send(t *testing.T) {
 		assert.Equal(t, hexutil.Encode(docID), resp.DocumentId)
 		assert.Equal(t, hexutil.Encode(accountID), resp.AccountId)
 		assert.Equal(t, hexutil.Encode(senderID), resp.FromId)
+		assert.JSONEq(t, `{"status":"unpaid"}`, string(resp.Summary))
 		wg.Done()
 	})
 
@@ -87,14 +89,17 @@ func TestWebhookSender_Send(t *testing.T) {
 	defer server.Close()
 
 	wb := NewWebhookSender()
-	notif := &notificationpb.NotificationMessage{
-		DocumentId:   hexutil.Encode(docID),
-		DocumentType: documenttypes.InvoiceDataTypeUrl,
-		AccountId:    hexutil.Encode(accountID),
-		FromId:       hexutil.Encode(senderID),
-		ToId:         hexutil.Encode(accountID),
-		EventType:    uint32(ReceivedPayload),
-		Recorded:     ts,
+	notif := &Message{
+		NotificationMessage: &notificationpb.NotificationMessage{
+			DocumentId:   hexutil.Encode(docID),
+			DocumentType: documenttypes.InvoiceDataTypeUrl,
+			AccountId:    hexutil.Encode(accountID),
+			FromId:       hexutil.Encode(senderID),
+			ToId:         hexutil.Encode(accountID),
+			EventType:    uint32(ReceivedPayload),
+			Recorded:     ts,
+		},
+		DocumentSummary: json.RawMessage(`{"status":"unpaid"}`),
 	}
 
 	cfg.Set("notifications.endpoint", "http://localhost:8090/webhook")
@@ -0,0 +1,173 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-kad-dht"
+	ma "github.com/multiformats/go-multiaddr"
+	mh "github.com/multiformats/go-multihash"
+)
+
+// ErrInvalidAddress must be used when a configured p2p address is not a valid multiaddress.
+const ErrInvalidAddress = errors.Error("invalid p2p address")
+
+// addresses are the multiaddresses the host listens on and the ones advertised to the peers.
+// The advertised addresses are read by the address factory of the host, so that changes apply without a restart.
+type addresses struct {
+	mu       sync.RWMutex
+	listen   []ma.Multiaddr
+	external []ma.Multiaddr
+
+	// opened are the listen addresses the host listens on, including the removed ones since libp2p can't close
+	// a single listener
+	opened map[string]bool
+
+	// circuits are the addresses of the host through the relays, always advertised
+	circuits []ma.Multiaddr
+}
+
+// newAddresses returns the addresses of a host listening on the listen addresses.
+func newAddresses(listen, external, circuits []ma.Multiaddr) *addresses {
+	a := &addresses{opened: make(map[string]bool), circuits: circuits}
+	a.set(listen, external)
+	return a
+}
+
+// parseAddresses parses the listen and the external multiaddresses. The listen addresses default to all the interfaces
+// on the port, the external addresses to the external IP on the port if set.
+func parseAddresses(listen, external []string, externalIP string, port int) (listenAddrs, externalAddrs []ma.Multiaddr, err error) {
+	if len(listen) == 0 {
+		addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", port))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		listenAddrs = append(listenAddrs, addr)
+	}
+
+	for _, s := range listen {
+		addr, err := ma.NewMultiaddr(s)
+		if err != nil {
+			return nil, nil, errors.NewTypedError(ErrInvalidAddress, errors.New("listen address %s: %v", s, err))
+		}
+
+		listenAddrs = append(listenAddrs, addr)
+	}
+
+	if len(external) == 0 && externalIP != "" {
+		external = []string{fmt.Sprintf("/ip4/%s/tcp/%d", externalIP, port)}
+	}
+
+	for _, s := range external {
+		addr, err := ma.NewMultiaddr(s)
+		if err != nil {
+			return nil, nil, errors.NewTypedError(ErrInvalidAddress, errors.New("external address %s: %v", s, err))
+		}
+
+		externalAddrs = append(externalAddrs, addr)
+	}
+
+	return listenAddrs, externalAddrs, nil
+}
+
+// factory returns the external addresses in place of the addresses of the host if any, and the circuit addresses.
+func (a *addresses) factory(addrs []ma.Multiaddr) []ma.Multiaddr {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if len(a.external) > 0 {
+		addrs = a.external
+	}
+
+	return append(append([]ma.Multiaddr(nil), addrs...), a.circuits...)
+}
+
+// unopened returns the listen addresses the host doesn't listen on yet.
+func (a *addresses) unopened(listen []ma.Multiaddr) []ma.Multiaddr {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	var addrs []ma.Multiaddr
+	for _, addr := range listen {
+		if !a.opened[addr.String()] {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addrs
+}
+
+// set replaces the addresses and records the listen addresses as opened.
+func (a *addresses) set(listen, external []ma.Multiaddr) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.listen, a.external = listen, external
+	for _, addr := range listen {
+		a.opened[addr.String()] = true
+	}
+}
+
+// rendezvousTopic is the topic the nodes announce themselves under on the DHT.
+// Using the sha256 of our "topic" as our rendezvous value
+const rendezvousTopic = "centrifuge-dht"
+
+// rendezvous is the cid prefix of the rendezvous value.
+var rendezvous = cid.NewPrefixV1(cid.Raw, mh.SHA2_256)
+
+// announce announces the node with its current addresses on the DHT.
+func announce(ctx context.Context, dhtClient *dht.IpfsDHT) error {
+	cidPref, err := rendezvous.Sum([]byte(rendezvousTopic))
+	if err != nil {
+		return err
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	return dhtClient.Provide(tctx, cidPref, true)
+}
+
+// SetAddresses listens on the listen addresses not opened yet and advertises the external addresses, or the addresses
+// of the host if none, from now on. Empty addresses fall back to the configured port and external IP.
+// The node announces itself again on the DHT so that the peers looking up its p2p key find the new addresses. The p2p
+// key in the identity contract doesn't change with the addresses and needs no update.
+// The host keeps listening on the removed listen addresses until the node restarts, since libp2p can't close a single
+// listener. They are not advertised if external addresses are set.
+func (s *peer) SetAddresses(listen, external []string) error {
+	if s.host == nil || s.addrs == nil {
+		return errors.New("p2p host not started")
+	}
+
+	nc, err := s.config.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	listenAddrs, externalAddrs, err := parseAddresses(listen, external, nc.GetP2PExternalIP(), nc.GetP2PPort())
+	if err != nil {
+		return err
+	}
+
+	if addrs := s.addrs.unopened(listenAddrs); len(addrs) > 0 {
+		err = s.host.Network().Listen(addrs...)
+		if err != nil {
+			return errors.New("failed to listen on %v: %v", addrs, err)
+		}
+	}
+
+	s.addrs.set(listenAddrs, externalAddrs)
+	log.Infof("P2P addresses changed, listening on %v, advertising %s", listenAddrs, s.host.Addrs())
+	if s.dht == nil {
+		return nil
+	}
+
+	go func() {
+		if err := announce(context.Background(), s.dht); err != nil {
+			log.Warningf("failed to announce the new p2p addresses: %v", err)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// +build unit
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/crypto"
+	"github.com/centrifuge/go-centrifuge/errors"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+func multiaddrs(t *testing.T, addrs ...string) []ma.Multiaddr {
+	var mas []ma.Multiaddr
+	for _, a := range addrs {
+		addr, err := ma.NewMultiaddr(a)
+		assert.NoError(t, err)
+		mas = append(mas, addr)
+	}
+
+	return mas
+}
+
+func TestParseAddresses(t *testing.T) {
+	// defaults
+	listen, external, err := parseAddresses(nil, nil, "", 38202)
+	assert.NoError(t, err)
+	assert.Equal(t, multiaddrs(t, "/ip4/0.0.0.0/tcp/38202"), listen)
+	assert.Empty(t, external)
+
+	// external IP
+	listen, external, err = parseAddresses(nil, nil, "1.2.3.4", 38202)
+	assert.NoError(t, err)
+	assert.Equal(t, multiaddrs(t, "/ip4/0.0.0.0/tcp/38202"), listen)
+	assert.Equal(t, multiaddrs(t, "/ip4/1.2.3.4/tcp/38202"), external)
+
+	// configured addresses take precedence over the port and the external IP
+	listen, external, err = parseAddresses([]string{"/ip4/0.0.0.0/tcp/38300", "/ip6/::/tcp/38300"},
+		[]string{"/dns4/node.example.com/tcp/38300"}, "1.2.3.4", 38202)
+	assert.NoError(t, err)
+	assert.Equal(t, multiaddrs(t, "/ip4/0.0.0.0/tcp/38300", "/ip6/::/tcp/38300"), listen)
+	assert.Equal(t, multiaddrs(t, "/dns4/node.example.com/tcp/38300"), external)
+
+	// invalid addresses
+	_, _, err = parseAddresses([]string{"0.0.0.0:38300"}, nil, "", 38202)
+	assert.True(t, errors.IsOfType(ErrInvalidAddress, err))
+	assert.Contains(t, err.Error(), "listen address 0.0.0.0:38300")
+	_, _, err = parseAddresses(nil, []string{"/ip4/1.2.3.4/tcp/port"}, "", 38202)
+	assert.True(t, errors.IsOfType(ErrInvalidAddress, err))
+	assert.Contains(t, err.Error(), "external address /ip4/1.2.3.4/tcp/port")
+}
+
+func TestAddresses(t *testing.T) {
+	hostAddrs := multiaddrs(t, "/ip4/127.0.0.1/tcp/38202", "/ip4/10.0.0.2/tcp/38202")
+	a := newAddresses(multiaddrs(t, "/ip4/0.0.0.0/tcp/38202"), nil, nil)
+
+	// host addresses are advertised without external addresses
+	assert.Equal(t, hostAddrs, a.factory(hostAddrs))
+	assert.Empty(t, a.unopened(multiaddrs(t, "/ip4/0.0.0.0/tcp/38202")))
+
+	// external addresses replace the host addresses
+	a.set(multiaddrs(t, "/ip4/0.0.0.0/tcp/38300"), multiaddrs(t, "/ip4/1.2.3.4/tcp/38300"))
+	assert.Equal(t, multiaddrs(t, "/ip4/1.2.3.4/tcp/38300"), a.factory(hostAddrs))
+
+	// removed listen addresses stay opened
+	assert.Equal(t, multiaddrs(t, "/ip6/::/tcp/38300"), a.unopened(multiaddrs(t, "/ip4/0.0.0.0/tcp/38202", "/ip4/0.0.0.0/tcp/38300", "/ip6/::/tcp/38300")))
+
+	// circuit addresses are advertised with either
+	circuits := multiaddrs(t, "/ip4/5.6.7.8/tcp/38202/ipfs/QmTQxbwkuZYYDfuzTbxEAReTNCLozyy558vQngVvPMjLYk/p2p-circuit")
+	a = newAddresses(multiaddrs(t, "/ip4/0.0.0.0/tcp/38202"), nil, circuits)
+	assert.Equal(t, append(append([]ma.Multiaddr(nil), hostAddrs...), circuits...), a.factory(hostAddrs))
+	a.set(multiaddrs(t, "/ip4/0.0.0.0/tcp/38202"), multiaddrs(t, "/ip4/1.2.3.4/tcp/38202"))
+	assert.Equal(t, append(multiaddrs(t, "/ip4/1.2.3.4/tcp/38202"), circuits...), a.factory(hostAddrs))
+}
+
+func TestPeer_SetAddresses(t *testing.T) {
+	// host not started
+	p := &peer{config: cfg}
+	err := p.SetAddresses([]string{"/ip4/0.0.0.0/tcp/38300"}, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "p2p host not started")
+
+	c, err := cfg.GetConfig()
+	assert.NoError(t, err)
+	c = updateKeys(c)
+	priv, pub, err := crypto.ObtainP2PKeypair(c.GetP2PKeyPair())
+	assert.NoError(t, err)
+	p.addrs = newAddresses(multiaddrs(t, "/ip4/127.0.0.1/tcp/38310"), nil, nil)
+	p.host, err = makeBasicHost(priv, pub, p.addrs)
+	assert.NoError(t, err)
+	defer p.host.Close()
+
+	// invalid addresses leave the host unchanged
+	err = p.SetAddresses([]string{"/ip4/127.0.0.1/tcp/38311"}, []string{"1.2.3.4"})
+	assert.True(t, errors.IsOfType(ErrInvalidAddress, err))
+	assert.NotContains(t, p.host.Network().ListenAddresses(), multiaddrs(t, "/ip4/127.0.0.1/tcp/38311")[0])
+
+	// listens on the new address and advertises the external one
+	err = p.SetAddresses([]string{"/ip4/127.0.0.1/tcp/38311"}, []string{"/ip4/1.2.3.4/tcp/38311"})
+	assert.NoError(t, err)
+	assert.Contains(t, p.host.Network().ListenAddresses(), multiaddrs(t, "/ip4/127.0.0.1/tcp/38311")[0])
+	assert.Equal(t, multiaddrs(t, "/ip4/1.2.3.4/tcp/38311"), p.host.Addrs())
+}
@@ -6,9 +6,15 @@ import (
 	"github.com/centrifuge/go-centrifuge/config/configstore"
 	"github.com/centrifuge/go-centrifuge/documents"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/faults"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/nft"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/p2p/mailbox"
 	"github.com/centrifuge/go-centrifuge/p2p/receiver"
+	"github.com/centrifuge/go-centrifuge/p2p/traffic"
+	"github.com/centrifuge/go-centrifuge/retry"
+	"github.com/centrifuge/go-centrifuge/storage"
 )
 
 // Bootstrapper implements Bootstrapper with p2p details
@@ -41,8 +47,50 @@ func (b Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		return errors.New("token registry is not initialised")
 	}
 
-	ctx[bootstrap.BootstrappedPeer] = &peer{config: cfgService, idService: idService, handlerCreator: func() *receiver.Handler {
-		return receiver.New(cfgService, receiver.HandshakeValidator(cfg.GetNetworkID(), idService), docSrv, tokenRegistry, idService)
+	pins, err := p2pcommon.ParsePinnedPeers(cfg.GetP2PPinnedPeers())
+	if err != nil {
+		return err
+	}
+
+	relays, err := parseRelays(cfg.GetP2PRelayNodes())
+	if err != nil {
+		return err
+	}
+
+	if len(relays) > 0 && !cfg.GetP2PRelayEnabled() {
+		return errors.New("p2p relay nodes are configured but the relay transport is disabled")
+	}
+
+	// injector is nil unless fault injection is enabled
+	injector, _ := ctx[faults.BootstrappedInjector].(*faults.Injector)
+	receivePool := receiver.NewReceivePool(cfg.GetP2PReceiveWorkers(), cfg.GetP2PReceiveQueueSize())
+	recorder := traffic.NewRecorder()
+	traffic.PublishMetrics(recorder)
+	ctx[traffic.BootstrappedRecorder] = recorder
+	sessions := p2pcommon.NewSessions(cfg.GetP2PSessionTTL())
+	if sessions != nil {
+		// sessions with collaborators whose keys changed are revalidated, only known if the key lookups are cached
+		if notifier, ok := ctx[identity.BootstrappedKeyCache].(identity.KeyChangeNotifier); ok {
+			notifier.OnKeyChange(sessions.Close)
+		}
+	}
+	rateLimits := receiver.NewRateLimits(cfg.GetP2PRateLimits())
+
+	// the node holds envelopes for other nodes only if enabled
+	var mb *mailbox.Mailbox
+	if cfg.GetP2PMailboxServeEnabled() {
+		repo, ok := ctx[storage.BootstrappedDB].(storage.Repository)
+		if !ok {
+			return errors.New("storage not initialised")
+		}
+
+		mb = mailbox.New(repo)
+	}
+
+	p := &peer{config: cfgService, idService: idService, pins: pins, receivePool: receivePool, traffic: recorder, faults: injector, endpoints: newEndpointRefresher(cfg.GetP2PEndpointRefreshInterval()), sessions: sessions, retries: retry.New(retry.ClassP2P, cfg.GetRetryPolicy(retry.ClassP2P)), conns: newConnPool(cfg.GetP2PConnectionLowWater(), cfg.GetP2PConnectionHighWater(), cfg.GetP2PConnectionIdleTimeout()), breaker: newCircuitBreaker(cfg.GetP2PCircuitBreakerThreshold(), cfg.GetP2PCircuitBreakerCooldown()), relays: relays, handlerCreator: func() *receiver.Handler {
+		return receiver.New(cfgService, receiver.HandshakeValidator(cfg.GetNetworkID(), idService, pins), docSrv, tokenRegistry, idService, receivePool, recorder, sessions, mb, rateLimits)
 	}}
+	publishConnPoolMetrics(p)
+	ctx[bootstrap.BootstrappedPeer] = p
 	return nil
 }
@@ -33,7 +33,7 @@ func TestBootstrapper_Bootstrap(t *testing.T) {
 	cs.On("GetConfig").Return(&configstore.NodeConfig{}, nil)
 	ids := new(testingcommons.MockIdentityService)
 	m[identity.BootstrappedDIDService] = ids
-	m[documents.BootstrappedDocumentService] = documents.DefaultService(nil, nil, documents.NewServiceRegistry(), ids)
+	m[documents.BootstrappedDocumentService] = documents.DefaultService(nil, nil, documents.NewServiceRegistry(), ids, 0, "", 0, nil, nil, nil, nil, nil)
 	m[nft.BootstrappedPayObService] = new(testingdocuments.MockRegistry)
 
 	err = b.Bootstrap(m)
@@ -46,4 +46,6 @@ func TestBootstrapper_Bootstrap(t *testing.T) {
 	assert.NotNil(t, m[bootstrap.BootstrappedPeer])
 	_, ok = m[bootstrap.BootstrappedPeer].(documents.Client)
 	assert.True(t, ok)
+	_, ok = m[bootstrap.BootstrappedPeer].(documents.ProofRequester)
+	assert.True(t, ok)
 }
@@ -0,0 +1,186 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
+)
+
+const (
+	// CheckIdentity checks that the identity of the collaborator exists on chain.
+	CheckIdentity = "identity"
+
+	// CheckSigningKeys checks that the collaborator has an active signing key registered.
+	CheckSigningKeys = "signing_keys"
+
+	// CheckP2PEndpoint checks that the p2p endpoint of the collaborator is resolvable and reachable.
+	CheckP2PEndpoint = "p2p_endpoint"
+
+	// CheckProtocolVersion checks that the collaborator speaks the protocol version of this node.
+	CheckProtocolVersion = "protocol_version"
+)
+
+// Check is the result of a single onboarding check.
+type Check struct {
+	Name    string
+	Passed  bool
+	Message string
+}
+
+// Checklist is the result of the onboarding checks of a collaborator.
+type Checklist struct {
+	DID    identity.DID
+	Checks []Check
+}
+
+// Passed returns true if all the checks passed.
+func (cl Checklist) Passed() bool {
+	for _, check := range cl.Checks {
+		if !check.Passed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// add adds the result of the check. The message is replaced by the error if the check failed.
+func (cl *Checklist) add(name, msg string, err error) bool {
+	check := Check{Name: name, Passed: err == nil, Message: msg}
+	if err != nil {
+		check.Message = err.Error()
+	}
+
+	cl.Checks = append(cl.Checks, check)
+	return check.Passed
+}
+
+// skip adds the checks as failed since the checks they depend on failed.
+func (cl *Checklist) skip(names ...string) {
+	for _, name := range names {
+		cl.Checks = append(cl.Checks, Check{Name: name, Message: "skipped due to a failed check"})
+	}
+}
+
+// CollaboratorChecker checks if documents can be shared with a collaborator.
+type CollaboratorChecker interface {
+	// CheckCollaborator runs the onboarding checks for the collaborator.
+	CheckCollaborator(ctx context.Context, did identity.DID) (Checklist, error)
+
+	// SummarizeCollaborators summarizes the collaborators with the state of their identities and p2p endpoints.
+	SummarizeCollaborators(ctx context.Context, dids []identity.DID) []CollaboratorSummary
+}
+
+// CheckCollaborator runs the checks needed before sharing documents with the collaborator.
+// Checks depending on a failed check are skipped and reported as failed.
+// The session with the collaborator is closed, so the next exchange sees the same state as the checks.
+func (s *peer) CheckCollaborator(ctx context.Context, did identity.DID) (Checklist, error) {
+	s.sessions.Close(did)
+	cl := Checklist{DID: did}
+	nc, err := s.config.GetConfig()
+	if err != nil {
+		return cl, err
+	}
+
+	if !cl.add(CheckIdentity, "identity exists", s.idService.Exists(ctx, did)) {
+		cl.skip(CheckSigningKeys, CheckP2PEndpoint, CheckProtocolVersion)
+		return cl, nil
+	}
+
+	msg, err := s.checkSigningKeys(did)
+	cl.add(CheckSigningKeys, msg, err)
+
+	if _, err := s.config.GetAccount(did[:]); err == nil {
+		// local accounts are served by this node
+		cl.add(CheckP2PEndpoint, "local account", nil)
+		cl.add(CheckProtocolVersion, "local account", nil)
+		return cl, nil
+	}
+
+	var pid libp2pPeer.ID
+	if s.host == nil {
+		err = errors.New("p2p server not started")
+	} else {
+		pid, err = s.getPeerID(did)
+		if err == nil {
+			err = s.connect(ctx, pid, nc.GetP2PConnectionTimeout())
+		}
+	}
+
+	if !cl.add(CheckP2PEndpoint, fmt.Sprintf("connected to peer %s", pid.Pretty()), err) {
+		cl.skip(CheckProtocolVersion)
+		return cl, nil
+	}
+
+	cl.add(CheckProtocolVersion, fmt.Sprintf("peer supports %s", p2pcommon.CentrifugeProtocol), s.checkProtocol(pid, did))
+	return cl, nil
+}
+
+// checkSigningKeys checks that the collaborator has at least one signing key that is not revoked.
+func (s *peer) checkSigningKeys(did identity.DID) (string, error) {
+	active, err := s.activeSigningKeys(did)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d active signing keys registered", active), nil
+}
+
+// activeSigningKeys returns the number of signing keys of the collaborator that are not revoked.
+// Returns an error if there are none.
+func (s *peer) activeSigningKeys(did identity.DID) (int, error) {
+	keys, err := s.idService.GetKeysByPurpose(did, &(identity.KeyPurposeSigning.Value))
+	if err != nil {
+		return 0, err
+	}
+
+	var active int
+	for _, k := range keys {
+		if k.GetRevokedAt() == 0 {
+			active++
+		}
+	}
+
+	if active == 0 {
+		return 0, errors.New("no active signing key registered")
+	}
+
+	return active, nil
+}
+
+// connect connects to the peer with the addresses known to the peer store.
+func (s *peer) connect(ctx context.Context, pid libp2pPeer.ID, timeout time.Duration) error {
+	pi := s.host.Peerstore().PeerInfo(pid)
+	if len(pi.Addrs) == 0 {
+		return errors.New("no known addresses for peer %s", pid.Pretty())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := s.host.Connect(ctx, pi)
+	if err != nil {
+		return errors.New("failed to connect to peer %s: %v", pid.Pretty(), err)
+	}
+
+	return nil
+}
+
+// checkProtocol checks that the connected peer supports the protocol of this node for the collaborator.
+func (s *peer) checkProtocol(pid libp2pPeer.ID, did identity.DID) error {
+	p := string(p2pcommon.ProtocolForDID(&did))
+	supported, err := s.host.Peerstore().SupportsProtocols(pid, p)
+	if err != nil {
+		return err
+	}
+
+	if len(supported) == 0 {
+		return errors.New("peer does not support %s, protocol version might be incompatible", p)
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+// +build unit
+
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/collaborator"
+	"github.com/centrifuge/go-centrifuge/testingutils/commons"
+	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func checkNames(cl Checklist) []string {
+	var names []string
+	for _, c := range cl.Checks {
+		names = append(names, c.Name)
+	}
+
+	return names
+}
+
+func signingKeys(revoked ...uint32) []identity.KeyDID {
+	var keys []identity.KeyDID
+	for _, r := range revoked {
+		var k [32]byte
+		copy(k[:], utils.RandomSlice(32))
+		keys = append(keys, identity.NewKey(k, &(identity.KeyPurposeSigning.Value), nil, r))
+	}
+
+	return keys
+}
+
+func TestPeer_CheckCollaborator(t *testing.T) {
+	ctx := context.Background()
+	allChecks := []string{CheckIdentity, CheckSigningKeys, CheckP2PEndpoint, CheckProtocolVersion}
+
+	// identity missing
+	did := testingidentity.GenerateRandomDID()
+	idService := new(testingcommons.MockIdentityService)
+	idService.On("Exists", ctx, did).Return(errors.New("identity not found")).Once()
+	p := &peer{config: cfg, idService: idService}
+	cl, err := p.CheckCollaborator(ctx, did)
+	assert.NoError(t, err)
+	assert.False(t, cl.Passed())
+	assert.Equal(t, allChecks, checkNames(cl))
+	assert.Equal(t, "identity not found", cl.Checks[0].Message)
+	for _, c := range cl.Checks {
+		assert.False(t, c.Passed)
+	}
+
+	// remote collaborator without active signing keys and p2p server not started
+	idService.On("Exists", ctx, did).Return(nil).Once()
+	idService.On("GetKeysByPurpose", did, &(identity.KeyPurposeSigning.Value)).Return(signingKeys(10), nil).Once()
+	cl, err = p.CheckCollaborator(ctx, did)
+	assert.NoError(t, err)
+	assert.False(t, cl.Passed())
+	assert.Equal(t, allChecks, checkNames(cl))
+	assert.True(t, cl.Checks[0].Passed)
+	assert.False(t, cl.Checks[1].Passed)
+	assert.Equal(t, "no active signing key registered", cl.Checks[1].Message)
+	assert.False(t, cl.Checks[2].Passed)
+	assert.Equal(t, "p2p server not started", cl.Checks[2].Message)
+	assert.False(t, cl.Checks[3].Passed)
+
+	// local account
+	accs, err := cfg.GetAllAccounts()
+	assert.NoError(t, err)
+	accID, err := accs[0].GetIdentityID()
+	assert.NoError(t, err)
+	did = identity.NewDIDFromBytes(accID)
+	idService.On("Exists", ctx, did).Return(nil).Once()
+	idService.On("GetKeysByPurpose", did, &(identity.KeyPurposeSigning.Value)).Return(signingKeys(10, 0), nil).Once()
+	cl, err = p.CheckCollaborator(ctx, did)
+	assert.NoError(t, err)
+	assert.True(t, cl.Passed())
+	assert.Equal(t, allChecks, checkNames(cl))
+	assert.Equal(t, "1 active signing keys registered", cl.Checks[1].Message)
+	assert.Equal(t, "local account", cl.Checks[2].Message)
+	idService.AssertExpectations(t)
+}
+
+type mockChecker struct {
+	mock.Mock
+}
+
+func (m *mockChecker) CheckCollaborator(ctx context.Context, did identity.DID) (Checklist, error) {
+	args := m.Called(ctx, did)
+	cl, _ := args.Get(0).(Checklist)
+	return cl, args.Error(1)
+}
+
+func (m *mockChecker) SummarizeCollaborators(ctx context.Context, dids []identity.DID) []CollaboratorSummary {
+	args := m.Called(ctx, dids)
+	summaries, _ := args.Get(0).([]CollaboratorSummary)
+	return summaries
+}
+
+func TestGRPCHandler_GetChecklist(t *testing.T) {
+	ctx := context.Background()
+	checker := new(mockChecker)
+	h := GRPCHandler(checker, nil)
+
+	// invalid did
+	_, err := h.GetChecklist(ctx, &collaboratorpb.GetChecklistRequest{Did: "0x123"})
+	assert.Error(t, err)
+
+	// checks failed to run
+	did := testingidentity.GenerateRandomDID()
+	checker.On("CheckCollaborator", ctx, did).Return(nil, errors.New("failed")).Once()
+	_, err = h.GetChecklist(ctx, &collaboratorpb.GetChecklistRequest{Did: did.String()})
+	assert.Error(t, err)
+
+	checker.On("CheckCollaborator", ctx, did).Return(Checklist{DID: did, Checks: []Check{
+		{Name: CheckIdentity, Passed: true, Message: "identity exists"},
+		{Name: CheckSigningKeys, Message: "no active signing key registered"},
+	}}, nil).Once()
+	resp, err := h.GetChecklist(ctx, &collaboratorpb.GetChecklistRequest{Did: did.String()})
+	assert.NoError(t, err)
+	assert.Equal(t, did.String(), resp.Did)
+	assert.False(t, resp.Passed)
+	assert.Len(t, resp.Checks, 2)
+	assert.Equal(t, CheckSigningKeys, resp.Checks[1].Name)
+	assert.False(t, resp.Checks[1].Passed)
+	checker.AssertExpectations(t)
+}
+
+func TestPeer_SummarizeCollaborators(t *testing.T) {
+	ctx := context.Background()
+	idService := new(testingcommons.MockIdentityService)
+	p := &peer{config: cfg, idService: idService}
+
+	// identity missing
+	missing := testingidentity.GenerateRandomDID()
+	idService.On("Exists", ctx, missing).Return(errors.New("identity not found")).Once()
+
+	// remote collaborator without active signing keys and an invalid p2p key
+	remote := testingidentity.GenerateRandomDID()
+	idService.On("Exists", ctx, remote).Return(nil).Once()
+	idService.On("GetKeysByPurpose", remote, &(identity.KeyPurposeSigning.Value)).Return(signingKeys(10), nil).Once()
+	idService.On("CurrentP2PKey", remote).Return("invalid", nil).Once()
+
+	// local account
+	accs, err := cfg.GetAllAccounts()
+	assert.NoError(t, err)
+	accID, err := accs[0].GetIdentityID()
+	assert.NoError(t, err)
+	local := identity.NewDIDFromBytes(accID)
+	idService.On("Exists", ctx, local).Return(nil).Once()
+	idService.On("GetKeysByPurpose", local, &(identity.KeyPurposeSigning.Value)).Return(signingKeys(10, 0, 0), nil).Once()
+	idService.On("CurrentP2PKey", local).Return("QmTQxbwkuZYYDfuzTbxEAReTNCLozyy558vQngVvPMjLYk", nil).Once()
+
+	summaries := p.SummarizeCollaborators(ctx, []identity.DID{missing, remote, local})
+	assert.Len(t, summaries, 3)
+	assert.Equal(t, missing, summaries[0].DID)
+	assert.False(t, summaries[0].KeysHealthy)
+	assert.Equal(t, []string{"identity not found"}, summaries[0].Issues)
+
+	assert.False(t, summaries[1].Local)
+	assert.False(t, summaries[1].KeysHealthy)
+	assert.Equal(t, 0, summaries[1].ActiveSigningKeys)
+	assert.Equal(t, "/ipfs/invalid", summaries[1].P2PURL)
+	assert.Len(t, summaries[1].Issues, 2)
+	assert.Equal(t, "no active signing key registered", summaries[1].Issues[0])
+
+	assert.True(t, summaries[2].Local)
+	assert.True(t, summaries[2].KeysHealthy)
+	assert.Equal(t, 2, summaries[2].ActiveSigningKeys)
+	assert.Equal(t, "/ipfs/QmTQxbwkuZYYDfuzTbxEAReTNCLozyy558vQngVvPMjLYk", summaries[2].P2PURL)
+	assert.Empty(t, summaries[2].Issues)
+	assert.False(t, summaries[2].Connected)
+	assert.True(t, summaries[2].LastContact.IsZero())
+	idService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_GetDocumentCollaborators(t *testing.T) {
+	ctx := context.Background()
+	checker := new(mockChecker)
+	docSrv := new(testingdocuments.MockService)
+	h := GRPCHandler(checker, docSrv)
+
+	// invalid identifier
+	_, err := h.GetDocumentCollaborators(ctx, &collaboratorpb.GetDocumentCollaboratorsRequest{Identifier: "0x123"})
+	assert.Error(t, err)
+
+	// document missing
+	id := utils.RandomSlice(32)
+	req := &collaboratorpb.GetDocumentCollaboratorsRequest{Identifier: hexutil.Encode(id)}
+	docSrv.On("GetCurrentVersion", id).Return(new(testingdocuments.MockModel), documents.ErrDocumentNotFound).Once()
+	_, err = h.GetDocumentCollaborators(ctx, req)
+	assert.Error(t, err)
+
+	// success
+	dids := []identity.DID{testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID()}
+	model := new(testingdocuments.MockModel)
+	model.On("GetCollaborators", []identity.DID(nil)).Return(dids, nil).Once()
+	docSrv.On("GetCurrentVersion", id).Return(model, nil).Once()
+	lastContact := time.Now().UTC()
+	checker.On("SummarizeCollaborators", ctx, dids).Return([]CollaboratorSummary{
+		{DID: dids[0], Local: true, KeysHealthy: true, ActiveSigningKeys: 1, P2PURL: "/ipfs/QmTQxbwkuZYYDfuzTbxEAReTNCLozyy558vQngVvPMjLYk"},
+		{DID: dids[1], Issues: []string{"identity not found"}, LastContact: lastContact, NodeVersion: "0.0.3"},
+	}).Once()
+	resp, err := h.GetDocumentCollaborators(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(id), resp.DocumentId)
+	assert.Len(t, resp.Collaborators, 2)
+	assert.Equal(t, dids[0].String(), resp.Collaborators[0].Did)
+	assert.True(t, resp.Collaborators[0].Local)
+	assert.Equal(t, int32(1), resp.Collaborators[0].ActiveSigningKeys)
+	assert.Nil(t, resp.Collaborators[0].LastContact)
+	assert.Equal(t, []string{"identity not found"}, resp.Collaborators[1].Issues)
+	assert.Equal(t, lastContact.Unix(), resp.Collaborators[1].LastContact.Seconds)
+	assert.Equal(t, "0.0.3", resp.Collaborators[1].NodeVersion)
+	docSrv.AssertExpectations(t)
+	model.AssertExpectations(t)
+	checker.AssertExpectations(t)
+}
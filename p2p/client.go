@@ -14,6 +14,12 @@ import (
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/p2p/receiver"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/history"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/notary"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/p2pext"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/proofrequest"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
 	"github.com/centrifuge/go-centrifuge/version"
 	"github.com/golang/protobuf/proto"
 	libp2pPeer "github.com/libp2p/go-libp2p-peer"
@@ -30,7 +36,7 @@ func (s *peer) SendAnchoredDocument(ctx context.Context, receiverID identity.DID
 	peerCtx, cancel := context.WithTimeout(ctx, nc.GetP2PConnectionTimeout())
 	defer cancel()
 
-	tc, err := s.config.GetAccount(receiverID[:])
+	tc, err := s.localAccount(receiverID)
 	if err == nil {
 		// this is a local account
 		h := s.handlerCreator()
@@ -42,31 +48,98 @@ func (s *peer) SendAnchoredDocument(ctx context.Context, receiverID identity.DID
 		return h.SendAnchoredDocument(localCtx, in, receiverID)
 	}
 
-	err = s.idService.Exists(ctx, receiverID)
+	err = s.remoteIdentityExists(ctx, receiverID)
 	if err != nil {
 		return nil, err
 	}
 
 	// this is a remote account
-	pid, err := s.getPeerID(receiverID)
+	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeSendAnchoredDoc, in)
 	if err != nil {
 		return nil, err
 	}
 
-	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeSendAnchoredDoc, in)
+	recvEnvelope, err := s.send(ctx, receiverID, envelope)
+	if errors.IsOfType(ErrPeerUnreachable, err) {
+		// the receiver gets the document from the mailbox once it is back online
+		derr := s.deposit(ctx, receiverID, envelope)
+		if derr == nil {
+			log.Infof("deposited anchored document for %s in the mailbox", receiverID.String())
+			return &p2ppb.AnchorDocumentResponse{Accepted: true}, nil
+		}
+
+		if !errors.IsOfType(ErrNoMailbox, derr) {
+			log.Warningf("failed to deposit anchored document for %s: %v", receiverID.String(), derr)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// handle client error
+	if p2pcommon.MessageTypeError.Equals(recvEnvelope.Header.Type) {
+		return nil, convertClientError(recvEnvelope)
+	}
+
+	if !p2pcommon.MessageTypeSendAnchoredDocRep.Equals(recvEnvelope.Header.Type) {
+		return nil, errors.New("the received send anchored document response is incorrect")
+	}
+
+	r := new(p2ppb.AnchorDocumentResponse)
+	err = proto.Unmarshal(recvEnvelope.Body, r)
 	if err != nil {
 		return nil, err
 	}
 
-	recv, err := s.mes.SendMessage(
-		ctx, pid,
-		envelope,
-		p2pcommon.ProtocolForDID(&receiverID))
+	return r, nil
+}
+
+// GetNotarySignature requests the notary to co-sign the proof bundle
+func (s *peer) GetNotarySignature(ctx context.Context, notaryID identity.DID, proof *documents.DocumentProof) (*coredocumentpb.Signature, error) {
+	nc, err := s.config.GetConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	recvEnvelope, err := p2pcommon.ResolveDataEnvelope(recv)
+	peerCtx, cancel := context.WithTimeout(ctx, nc.GetP2PConnectionTimeout())
+	defer cancel()
+
+	req := &notarypb.NotarySignatureRequest{Bundle: documents.ConvertDocProofToProofBundle(proof)}
+	tc, err := s.localAccount(notaryID)
+	if err == nil {
+		// this is a local account
+		h := s.handlerCreator()
+		localCtx, err := contextutil.New(peerCtx, tc)
+		if err != nil {
+			return nil, err
+		}
+
+		selfDID, err := contextutil.AccountDID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := h.RequestNotarySignature(localCtx, req, selfDID)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.Signature, nil
+	}
+
+	err = s.remoteIdentityExists(ctx, notaryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// this is a remote account
+	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeRequestNotarySignature, req)
+	if err != nil {
+		return nil, err
+	}
+
+	recvEnvelope, err := s.send(peerCtx, notaryID, envelope)
 	if err != nil {
 		return nil, err
 	}
@@ -76,11 +149,202 @@ func (s *peer) SendAnchoredDocument(ctx context.Context, receiverID identity.DID
 		return nil, convertClientError(recvEnvelope)
 	}
 
-	if !p2pcommon.MessageTypeSendAnchoredDocRep.Equals(recvEnvelope.Header.Type) {
-		return nil, errors.New("the received send anchored document response is incorrect")
+	if !p2pcommon.MessageTypeRequestNotarySignatureRep.Equals(recvEnvelope.Header.Type) {
+		return nil, errors.New("the received notary signature response is incorrect")
 	}
 
-	r := new(p2ppb.AnchorDocumentResponse)
+	r := new(notarypb.NotarySignatureResponse)
+	err = proto.Unmarshal(recvEnvelope.Body, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Signature == nil {
+		return nil, errors.New("notary signature missing in the response")
+	}
+
+	return r.Signature, nil
+}
+
+// GetDocumentHistory requests the collaborator for the versions of the document preceding the version
+func (s *peer) GetDocumentHistory(ctx context.Context, collaborator identity.DID, documentID, version []byte) (*historypb.HistoryResponse, error) {
+	nc, err := s.config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	peerCtx, cancel := context.WithTimeout(ctx, nc.GetP2PConnectionTimeout())
+	defer cancel()
+
+	req := &historypb.HistoryRequest{DocumentIdentifier: documentID, VersionIdentifier: version}
+	tc, err := s.localAccount(collaborator)
+	if err == nil {
+		// this is a local account
+		h := s.handlerCreator()
+		localCtx, err := contextutil.New(peerCtx, tc)
+		if err != nil {
+			return nil, err
+		}
+
+		selfDID, err := contextutil.AccountDID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return h.GetDocumentHistory(localCtx, req, selfDID)
+	}
+
+	err = s.remoteIdentityExists(ctx, collaborator)
+	if err != nil {
+		return nil, err
+	}
+
+	// this is a remote account
+	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeGetDocHistory, req)
+	if err != nil {
+		return nil, err
+	}
+
+	recvEnvelope, err := s.send(peerCtx, collaborator, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	// handle client error
+	if p2pcommon.MessageTypeError.Equals(recvEnvelope.Header.Type) {
+		return nil, convertClientError(recvEnvelope)
+	}
+
+	if !p2pcommon.MessageTypeGetDocHistoryRep.Equals(recvEnvelope.Header.Type) {
+		return nil, errors.New("the received document history response is incorrect")
+	}
+
+	r := new(historypb.HistoryResponse)
+	err = proto.Unmarshal(recvEnvelope.Body, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// RequestProofs requests the granter of the access token for the field proofs of the latest version of the document.
+// The fields must be exactly the fields granted by a proof-only access token.
+func (s *peer) RequestProofs(ctx context.Context, granter identity.DID, req *proofrequestpb.ProofRequest) (*proofrequestpb.ProofResponse, error) {
+	nc, err := s.config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	peerCtx, cancel := context.WithTimeout(ctx, nc.GetP2PConnectionTimeout())
+	defer cancel()
+
+	tc, err := s.localAccount(granter)
+	if err == nil {
+		// this is a local account
+		h := s.handlerCreator()
+		localCtx, err := contextutil.New(peerCtx, tc)
+		if err != nil {
+			return nil, err
+		}
+
+		selfDID, err := contextutil.AccountDID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return h.RequestProofs(localCtx, req, selfDID)
+	}
+
+	err = s.remoteIdentityExists(ctx, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	// this is a remote account
+	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeRequestProofs, req)
+	if err != nil {
+		return nil, err
+	}
+
+	recvEnvelope, err := s.send(peerCtx, granter, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	// handle client error
+	if p2pcommon.MessageTypeError.Equals(recvEnvelope.Header.Type) {
+		return nil, convertClientError(recvEnvelope)
+	}
+
+	if !p2pcommon.MessageTypeRequestProofsRep.Equals(recvEnvelope.Header.Type) {
+		return nil, errors.New("the received proofs response is incorrect")
+	}
+
+	r := new(proofrequestpb.ProofResponse)
+	err = proto.Unmarshal(recvEnvelope.Body, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// GetDocument requests the collaborator for the latest version of the document.
+// The collaborator shares the document if the account is one of its collaborators.
+func (s *peer) GetDocument(ctx context.Context, collaborator identity.DID, documentID []byte) (*p2ppb.GetDocumentResponse, error) {
+	nc, err := s.config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	peerCtx, cancel := context.WithTimeout(ctx, nc.GetP2PConnectionTimeout())
+	defer cancel()
+
+	req := &p2ppb.GetDocumentRequest{DocumentIdentifier: documentID, AccessType: p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION}
+	tc, err := s.localAccount(collaborator)
+	if err == nil {
+		// this is a local account
+		h := s.handlerCreator()
+		localCtx, err := contextutil.New(peerCtx, tc)
+		if err != nil {
+			return nil, err
+		}
+
+		selfDID, err := contextutil.AccountDID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return h.GetDocument(localCtx, req, selfDID)
+	}
+
+	err = s.remoteIdentityExists(ctx, collaborator)
+	if err != nil {
+		return nil, err
+	}
+
+	// this is a remote account
+	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeGetDoc, req)
+	if err != nil {
+		return nil, err
+	}
+
+	recvEnvelope, err := s.send(peerCtx, collaborator, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	// handle client error
+	if p2pcommon.MessageTypeError.Equals(recvEnvelope.Header.Type) {
+		return nil, convertClientError(recvEnvelope)
+	}
+
+	if !p2pcommon.MessageTypeGetDocRep.Equals(recvEnvelope.Header.Type) {
+		return nil, errors.New("the received get document response is incorrect")
+	}
+
+	r := new(p2ppb.GetDocumentResponse)
 	err = proto.Unmarshal(recvEnvelope.Body, r)
 	if err != nil {
 		return nil, err
@@ -89,6 +353,43 @@ func (s *peer) SendAnchoredDocument(ctx context.Context, receiverID identity.DID
 	return r, nil
 }
 
+// sendMessage sends the envelope to the collaborator and returns the resolved response.
+// The traffic of the request and the response, and the node version of the collaborator are recorded.
+func (s *peer) sendMessage(ctx context.Context, collaborator identity.DID, pid libp2pPeer.ID, envelope *protocolpb.P2PEnvelope) (*p2ppb.Envelope, error) {
+	err := s.faults.Delay(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.faults.DropP2PMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.host != nil {
+		s.conns.sending(s.host.Network(), pid)
+	}
+
+	s.traffic.Sent(collaborator, proto.Size(envelope))
+	recv, err := s.mes.SendMessage(ctx, pid, envelope, p2pcommon.ProtocolForDID(&collaborator))
+	if err != nil {
+		if isConnectionError(ctx, err) {
+			return nil, errors.NewTypedError(ErrPeerUnreachable, err)
+		}
+
+		return nil, err
+	}
+
+	s.traffic.Received(collaborator, proto.Size(recv))
+	recvEnvelope, err := p2pcommon.ResolveDataEnvelope(recv)
+	if err != nil {
+		return nil, err
+	}
+
+	s.traffic.SeenVersion(collaborator, recvEnvelope.Header.NodeVersion)
+	return recvEnvelope, nil
+}
+
 // OpenClient returns P2PServiceClient to contact the remote peer
 func (s *peer) getPeerID(id identity.DID) (libp2pPeer.ID, error) {
 	lastB58Key, err := s.idService.CurrentP2PKey(id)
@@ -112,6 +413,11 @@ func (s *peer) getPeerID(id identity.DID) (libp2pPeer.ID, error) {
 		return "", err
 	}
 
+	err = s.pins.Check(id, peerID)
+	if err != nil {
+		return "", err
+	}
+
 	if !s.disablePeerStore {
 		// Decapsulate the /ipfs/<peerID> part from the target
 		// /ip4/<a.b.c.d>/ipfs/<peer> becomes /ip4/<a.b.c.d>
@@ -120,6 +426,7 @@ func (s *peer) getPeerID(id identity.DID) (libp2pPeer.ID, error) {
 		// We have a peer ID and a targetAddr so we add it to the peer store
 		// so LibP2P knows how to contact it
 		s.host.Peerstore().AddAddr(peerID, targetAddr, pstore.PermanentAddrTTL)
+		s.addRelayAddrs(peerID)
 	}
 
 	return peerID, nil
@@ -134,7 +441,7 @@ func (s *peer) getSignatureForDocument(ctx context.Context, cd coredocumentpb.Co
 
 	var resp *p2ppb.SignatureResponse
 	var header *p2ppb.Header
-	tc, err := s.config.GetAccount(id[:])
+	tc, err := s.localAccount(id)
 	if err == nil {
 		// this is a local account
 		h := s.handlerCreator()
@@ -153,25 +460,17 @@ func (s *peer) getSignatureForDocument(ctx context.Context, cd coredocumentpb.Co
 		header = &p2ppb.Header{NodeVersion: version.GetVersion().String()}
 	} else {
 		// this is a remote account
-		err = s.idService.Exists(ctx, id)
+		err = s.remoteIdentityExists(ctx, id)
 		if err != nil {
 			return nil, err
 		}
 
-		receiverPeer, err := s.getPeerID(id)
-		if err != nil {
-			return nil, err
-		}
 		envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeRequestSignature, &p2ppb.SignatureRequest{Document: &cd})
 		if err != nil {
 			return nil, err
 		}
-		log.Infof("Requesting signature from %s\n", receiverPeer)
-		recv, err := s.mes.SendMessage(ctx, receiverPeer, envelope, p2pcommon.ProtocolForDID(&id))
-		if err != nil {
-			return nil, err
-		}
-		recvEnvelope, err := p2pcommon.ResolveDataEnvelope(recv)
+		log.Infof("Requesting signature from %s\n", id.String())
+		recvEnvelope, err := s.send(ctx, id, envelope)
 		if err != nil {
 			return nil, err
 		}
@@ -256,18 +555,42 @@ func (s *peer) GetSignaturesForDocument(ctx context.Context, model documents.Mod
 			continue
 		}
 
-		signatures = append(signatures, resp.resp.Signature)
+		signatures = append(signatures, responseSignatures(resp.resp)...)
 	}
 
 	return signatures, signatureCollectionErrors, nil
 }
 
+// responseSignatures returns the signatures of the response from the signature response extension,
+// nodes signing with a single key only set the signature.
+func responseSignatures(resp *p2ppb.SignatureResponse) []*coredocumentpb.Signature {
+	ext := new(p2pextpb.SignatureResponseExtension)
+	err := proto.Unmarshal(resp.XXX_unrecognized, ext)
+	if err == nil && len(ext.Signatures) > 0 {
+		return ext.Signatures
+	}
+
+	return []*coredocumentpb.Signature{resp.Signature}
+}
+
 func convertClientError(recv *p2ppb.Envelope) error {
 	resp := new(errorspb.Error)
 	err := proto.Unmarshal(recv.Body, resp)
 	if err != nil {
 		return err
 	}
+
+	switch code.To(resp.Code) {
+	case code.ReceiverBusy:
+		return errors.NewTypedError(receiver.ErrReceiverBusy, errors.New("retry after %s", resp.Errors[receiver.RetryAfterKey]))
+	case code.Throttled:
+		return errors.NewTypedError(receiver.ErrThrottled, errors.New("retry after %s", resp.Errors[receiver.RetryAfterKey]))
+	case code.AnchorNotMined:
+		return errors.NewTypedError(documents.ErrDocumentAnchorNotMined, errors.New("%s", resp.Message))
+	case code.AnchorRootMismatch:
+		return errors.NewTypedError(documents.ErrDocumentAnchorRootMismatch, errors.New("%s", resp.Message))
+	}
+
 	return errors.New(resp.Message)
 }
 
@@ -281,9 +604,15 @@ func validateSignatureResp(
 		return version.IncompatibleVersionError(header.NodeVersion)
 	}
 
-	err := identity.ValidateDIDBytes(resp.Signature.SignerId, receiver)
-	if err != nil {
-		return centerrors.New(code.AuthenticationFailed, err.Error())
+	for _, sig := range responseSignatures(resp) {
+		if sig == nil {
+			return centerrors.New(code.AuthenticationFailed, "nil signature")
+		}
+
+		err := identity.ValidateDIDBytes(sig.SignerId, receiver)
+		if err != nil {
+			return centerrors.New(code.AuthenticationFailed, err.Error())
+		}
 	}
 	return nil
 }
@@ -7,13 +7,20 @@ import (
 	"testing"
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/errors"
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/code"
 	"github.com/centrifuge/go-centrifuge/contextutil"
 	"github.com/centrifuge/go-centrifuge/documents"
 	"github.com/centrifuge/go-centrifuge/documents/purchaseorder"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/p2p/receiver"
+	"github.com/centrifuge/go-centrifuge/p2p/traffic"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/p2pext"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/proofrequest"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
 	"github.com/centrifuge/go-centrifuge/testingutils/commons"
 	"github.com/centrifuge/go-centrifuge/testingutils/config"
@@ -21,6 +28,8 @@ import (
 	"github.com/centrifuge/go-centrifuge/testingutils/identity"
 	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/centrifuge/go-centrifuge/version"
+	"github.com/centrifuge/precise-proofs/proofs/proto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/protobuf/proto"
 	libp2pPeer "github.com/libp2p/go-libp2p-peer"
 	"github.com/libp2p/go-libp2p-protocol"
@@ -70,7 +79,7 @@ func TestGetSignatureForDocument_fail_version_check(t *testing.T) {
 	ctx := testingconfig.CreateAccountContext(t, c)
 	idService := getIDMocks(ctx, did)
 	m := &MockMessenger{}
-	testClient := &peer{config: cfg, idService: idService, mes: m, disablePeerStore: true}
+	testClient := &peer{config: cfg, idService: idService, mes: m, disablePeerStore: true, traffic: traffic.NewRecorder()}
 	cd, _ := createCDWithEmbeddedPO(t, ctx, did, nil)
 	_, err = p2pcommon.PrepareP2PEnvelope(ctx, c.GetNetworkID(), p2pcommon.MessageTypeRequestSignature, &p2ppb.SignatureRequest{Document: &cd})
 	assert.NoError(t, err, "signature request could not be created")
@@ -81,6 +90,20 @@ func TestGetSignatureForDocument_fail_version_check(t *testing.T) {
 	assert.Error(t, err, "must fail")
 	assert.Contains(t, err.Error(), "Incompatible version")
 	assert.Nil(t, resp, "must be nil")
+
+	// request and response are recorded for the collaborator
+	stats, ok := testClient.traffic.StatsFor(did)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), stats.Total.MessagesSent)
+	assert.Equal(t, uint64(1), stats.Total.MessagesReceived)
+	assert.True(t, stats.Total.BytesSent > 0)
+	assert.True(t, stats.Total.BytesReceived > 0)
+
+	// reported version is recorded for the advisories
+	advisories := testClient.traffic.VersionAdvisories()
+	assert.Len(t, advisories, 1)
+	assert.Equal(t, did, advisories[0].Collaborator)
+	assert.Equal(t, version.PeerIncompatible, advisories[0].Status)
 }
 
 func TestGetSignatureForDocument_fail_did(t *testing.T) {
@@ -108,6 +131,119 @@ func TestGetSignatureForDocument_fail_did(t *testing.T) {
 
 }
 
+// handlerMessenger delivers the proof requests to the handler of the receiving node.
+type handlerMessenger struct {
+	handler *receiver.Handler
+}
+
+func (hm handlerMessenger) Init(id ...protocol.ID) {}
+
+func (hm handlerMessenger) SendMessage(ctx context.Context, p libp2pPeer.ID, pmes *protocolpb.P2PEnvelope, protoc protocol.ID) (*protocolpb.P2PEnvelope, error) {
+	envelope, err := p2pcommon.ResolveDataEnvelope(pmes)
+	if err != nil {
+		return nil, err
+	}
+
+	return hm.handler.HandleRequestProofs(ctx, p, protoc, envelope)
+}
+
+func TestPeer_RequestProofs(t *testing.T) {
+	c, err := cfg.GetConfig()
+	assert.NoError(t, err)
+	c = updateKeys(c)
+	ctx := testingconfig.CreateAccountContext(t, c)
+	self, err := contextutil.AccountDID(ctx)
+	assert.NoError(t, err)
+
+	// the granter shares a proof-only token with the account
+	_, model := createCDWithEmbeddedPO(t, ctx, self, nil)
+	po := model.(*purchaseorder.PurchaseOrder)
+	fields := []string{"po.order_amount_decimal", "po.currency"}
+	po.CoreDocument, err = po.CoreDocument.AddAccessToken(ctx, documentpb.AccessTokenParams{
+		Grantee:            self.String(),
+		DocumentIdentifier: hexutil.Encode(po.ID()),
+		ProofFields:        fields,
+	})
+	assert.NoError(t, err)
+	at := po.CoreDocument.Document.AccessTokens[0]
+
+	idService := getIDMocks(ctx, did)
+	idService.On("ValidateKey", mock.Anything, self, at.Key, mock.Anything).Return(nil)
+	docSrv := new(testingdocuments.MockService)
+	docSrv.On("GetCurrentVersion", po.ID()).Return(po, nil)
+	proof := &documents.DocumentProof{
+		DocumentID:   po.ID(),
+		VersionID:    po.CurrentVersion(),
+		DocumentRoot: utils.RandomSlice(32),
+		FieldProofs:  []*proofspb.Proof{{Value: []byte("EUR"), Salt: utils.RandomSlice(32)}},
+	}
+	h := receiver.New(cfg, nil, docSrv, nil, idService, nil, nil, nil, nil, nil)
+	s := &peer{config: cfg, idService: idService, mes: handlerMessenger{handler: h}, disablePeerStore: true}
+
+	// granted fields in any order
+	req := &proofrequestpb.ProofRequest{
+		DocumentIdentifier:           po.ID(),
+		Fields:                       []string{fields[1], fields[0]},
+		DelegatingDocumentIdentifier: po.ID(),
+		AccessTokenId:                at.Identifier,
+	}
+	docSrv.On("CreateProofs", po.ID(), req.Fields).Return(proof, nil).Once()
+	res, err := s.RequestProofs(ctx, did, req)
+	assert.NoError(t, err)
+	assert.Equal(t, proof.DocumentID, res.DocumentId)
+	assert.Equal(t, proof.VersionID, res.VersionId)
+	assert.Equal(t, proof.DocumentRoot, res.DocumentRoot)
+	assert.Len(t, res.FieldProofs, 1)
+	assert.Equal(t, []byte("EUR"), res.FieldProofs[0].Value)
+
+	// a subset of the granted fields is not granted
+	req.Fields = fields[:1]
+	_, err = s.RequestProofs(ctx, did, req)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), documents.ErrAccessTokenFieldsNotGranted.Error())
+
+	// unknown token
+	req.Fields = fields
+	req.AccessTokenId = utils.RandomSlice(32)
+	_, err = s.RequestProofs(ctx, did, req)
+	assert.Error(t, err)
+	docSrv.AssertExpectations(t)
+}
+
+func TestValidateSignatureResp(t *testing.T) {
+	header := &p2ppb.Header{NodeVersion: version.GetVersion().String()}
+	finance := &coredocumentpb.Signature{SignerId: did[:], PublicKey: utils.RandomSlice(32)}
+	legal := &coredocumentpb.Signature{SignerId: did[:], PublicKey: utils.RandomSlice(32)}
+
+	// single signature
+	resp := &p2ppb.SignatureResponse{Signature: finance}
+	assert.NoError(t, validateSignatureResp(did, header, resp))
+	assert.Equal(t, []*coredocumentpb.Signature{finance}, responseSignatures(resp))
+
+	// signatures of every key
+	resp = signatureResponse(t, finance, legal)
+	assert.NoError(t, validateSignatureResp(did, header, resp))
+	sigs := responseSignatures(resp)
+	assert.Len(t, sigs, 2)
+	assert.Equal(t, finance.PublicKey, sigs[0].PublicKey)
+	assert.Equal(t, legal.PublicKey, sigs[1].PublicKey)
+
+	// signature of another identity
+	other := &coredocumentpb.Signature{SignerId: utils.RandomSlice(identity.DIDLength), PublicKey: utils.RandomSlice(32)}
+	resp = signatureResponse(t, finance, other)
+	assert.Error(t, validateSignatureResp(did, header, resp))
+
+	// no signature
+	assert.Error(t, validateSignatureResp(did, header, &p2ppb.SignatureResponse{}))
+}
+
+// signatureResponse returns the response of a node signing with every key, the signatures are in the extension.
+func signatureResponse(t *testing.T, sigs ...*coredocumentpb.Signature) *p2ppb.SignatureResponse {
+	extData, err := proto.Marshal(&p2pextpb.SignatureResponseExtension{Signatures: sigs})
+	assert.NoError(t, err)
+	return &p2ppb.SignatureResponse{Signature: sigs[0], XXX_unrecognized: extData}
+}
+
 func getIDMocks(ctx context.Context, did identity.DID) *testingcommons.MockIdentityService {
 	idService := &testingcommons.MockIdentityService{}
 	idService.On("CurrentP2PKey", did).Return("5dsgvJGnvAfiR3K6HCBc4hcokSfmjj", nil)
@@ -168,3 +304,28 @@ func createCDWithEmbeddedPO(t *testing.T, ctx context.Context, cid identity.DID,
 
 	return cd, po
 }
+
+func TestConvertClientError(t *testing.T) {
+	tests := []struct {
+		code code.Code
+		err  error
+	}{
+		{code.AnchorNotMined, documents.ErrDocumentAnchorNotMined},
+		{code.AnchorRootMismatch, documents.ErrDocumentAnchorRootMismatch},
+		{code.Throttled, receiver.ErrThrottled},
+	}
+
+	for _, c := range tests {
+		body, err := proto.Marshal(&errorspb.Error{Code: int32(c.code), Message: "anchor"})
+		assert.NoError(t, err)
+		err = convertClientError(&p2ppb.Envelope{Body: body})
+		assert.Error(t, err)
+		assert.True(t, errors.IsOfType(c.err, err))
+	}
+
+	body, err := proto.Marshal(&errorspb.Error{Code: int32(code.Unknown), Message: "some error"})
+	assert.NoError(t, err)
+	err = convertClientError(&p2ppb.Envelope{Body: body})
+	assert.Error(t, err)
+	assert.Equal(t, "some error", err.Error())
+}
@@ -0,0 +1,107 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/identity"
+	inet "github.com/libp2p/go-libp2p-net"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
+)
+
+// CollaboratorSummary describes a collaborator with the state of its identity and p2p endpoint as seen by this node.
+type CollaboratorSummary struct {
+	DID identity.DID
+
+	// Local is true if the collaborator is an account of this node.
+	Local bool
+
+	// P2PURL is the p2p url resolved from the current p2p key of the identity, empty if it can't be resolved.
+	P2PURL string
+
+	// Addresses are the addresses of the collaborator known to the peer store.
+	Addresses []string
+
+	// Connected is true if the node has an open connection to the collaborator.
+	Connected bool
+
+	// KeysHealthy is true if the identity has an active signing key and a current p2p key.
+	KeysHealthy       bool
+	ActiveSigningKeys int
+
+	// Issues are the problems found with the identity or the p2p endpoint of the collaborator.
+	Issues []string
+
+	// LastContact is the time of the last message exchanged with the collaborator, zero if none since the node started.
+	LastContact time.Time
+
+	// NodeVersion is the node version last reported by the collaborator.
+	NodeVersion string
+}
+
+// issue records a problem found with the collaborator.
+func (cs *CollaboratorSummary) issue(err error) {
+	cs.Issues = append(cs.Issues, err.Error())
+}
+
+// SummarizeCollaborators summarizes the collaborators with the state of their identities and p2p endpoints.
+// Unlike the checklist, no connection is opened to the collaborators, the summary only reflects what the node knows.
+func (s *peer) SummarizeCollaborators(ctx context.Context, dids []identity.DID) []CollaboratorSummary {
+	summaries := make([]CollaboratorSummary, len(dids))
+	for i, did := range dids {
+		summaries[i] = s.summarizeCollaborator(ctx, did)
+	}
+
+	return summaries
+}
+
+func (s *peer) summarizeCollaborator(ctx context.Context, did identity.DID) CollaboratorSummary {
+	cs := CollaboratorSummary{DID: did}
+	if _, err := s.config.GetAccount(did[:]); err == nil {
+		cs.Local = true
+	}
+
+	if stats, ok := s.traffic.StatsFor(did); ok {
+		cs.LastContact = stats.LastSeen
+		cs.NodeVersion = stats.NodeVersion
+	}
+
+	err := s.idService.Exists(ctx, did)
+	if err != nil {
+		cs.issue(err)
+		return cs
+	}
+
+	cs.KeysHealthy = true
+	cs.ActiveSigningKeys, err = s.activeSigningKeys(did)
+	if err != nil {
+		cs.KeysHealthy = false
+		cs.issue(err)
+	}
+
+	p2pKey, err := s.idService.CurrentP2PKey(did)
+	if err != nil {
+		cs.KeysHealthy = false
+		cs.issue(err)
+		return cs
+	}
+
+	cs.P2PURL = fmt.Sprintf("/ipfs/%s", p2pKey)
+	pid, err := libp2pPeer.IDB58Decode(p2pKey)
+	if err != nil {
+		cs.issue(err)
+		return cs
+	}
+
+	if s.host == nil || cs.Local {
+		return cs
+	}
+
+	for _, addr := range s.host.Peerstore().Addrs(pid) {
+		cs.Addresses = append(cs.Addresses, addr.String())
+	}
+
+	cs.Connected = s.host.Network().Connectedness(pid) == inet.Connected
+	return cs
+}
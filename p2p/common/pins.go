@@ -0,0 +1,40 @@
+package p2pcommon
+
+import (
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
+)
+
+// PinnedPeers holds the expected peer IDs of the DIDs with pinned p2p keys.
+type PinnedPeers map[identity.DID]libp2pPeer.ID
+
+// ParsePinnedPeers parses the pins of the hex encoded DIDs to the base58 encoded peer IDs.
+func ParsePinnedPeers(pins map[string]string) (PinnedPeers, error) {
+	pp := make(PinnedPeers)
+	for d, p := range pins {
+		did, err := identity.NewDIDFromString(d)
+		if err != nil {
+			return nil, errors.New("invalid pinned DID %s: %v", d, err)
+		}
+
+		pid, err := libp2pPeer.IDB58Decode(p)
+		if err != nil {
+			return nil, errors.New("invalid pinned peer ID %s of DID %s: %v", p, d, err)
+		}
+
+		pp[did] = pid
+	}
+
+	return pp, nil
+}
+
+// Check returns an error if the DID is pinned to a peer ID other than pid.
+func (pp PinnedPeers) Check(did identity.DID, pid libp2pPeer.ID) error {
+	expected, ok := pp[did]
+	if !ok || expected == pid {
+		return nil
+	}
+
+	return errors.New("peer %s doesn't match the pinned peer %s of %s", pid.Pretty(), expected.Pretty(), did.String())
+}
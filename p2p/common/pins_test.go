@@ -0,0 +1,58 @@
+// +build unit
+
+package p2pcommon
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/libp2p/go-libp2p-crypto"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomPeerID(t *testing.T) libp2pPeer.ID {
+	_, pub, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	assert.NoError(t, err)
+	pid, err := libp2pPeer.IDFromPublicKey(pub)
+	assert.NoError(t, err)
+	return pid
+}
+
+func TestParsePinnedPeers(t *testing.T) {
+	did := testingidentity.GenerateRandomDID()
+	pid := randomPeerID(t)
+
+	// invalid DID
+	_, err := ParsePinnedPeers(map[string]string{"0x01": pid.Pretty()})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid pinned DID")
+
+	// invalid peer ID
+	_, err = ParsePinnedPeers(map[string]string{did.String(): "invalid"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid pinned peer ID")
+
+	// success
+	pins, err := ParsePinnedPeers(map[string]string{did.String(): pid.Pretty()})
+	assert.NoError(t, err)
+	assert.Equal(t, PinnedPeers{did: pid}, pins)
+}
+
+func TestPinnedPeers_Check(t *testing.T) {
+	did := testingidentity.GenerateRandomDID()
+	pid := randomPeerID(t)
+	pins := PinnedPeers{did: pid}
+
+	// pinned peer
+	assert.NoError(t, pins.Check(did, pid))
+
+	// other peer
+	err := pins.Check(did, randomPeerID(t))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "doesn't match the pinned peer")
+
+	// DID not pinned
+	assert.NoError(t, pins.Check(testingidentity.GenerateRandomDID(), randomPeerID(t)))
+	assert.NoError(t, PinnedPeers(nil).Check(did, randomPeerID(t)))
+}
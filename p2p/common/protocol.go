@@ -11,6 +11,7 @@ import (
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
 	"github.com/centrifuge/go-centrifuge/contextutil"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
 	"github.com/centrifuge/go-centrifuge/version"
@@ -25,6 +26,9 @@ const (
 	// CentrifugeProtocol is the centrifuge wire protocol
 	CentrifugeProtocol protocol.ID = "/centrifuge/0.0.1"
 
+	// MessageSizeMax is a soft maximum for network messages.
+	MessageSizeMax = 1 << 25 // 32 MB
+
 	// MessageTypeError defines any protocol error
 	MessageTypeError MessageType = "MessageTypeError"
 	// MessageTypeInvalid defines invalid protocol type
@@ -41,18 +45,48 @@ const (
 	MessageTypeGetDoc MessageType = "MessageTypeGetDoc"
 	//MessageTypeGetDocRep defines GetAnchoredDoc response type
 	MessageTypeGetDocRep MessageType = "MessageTypeGetDocRep"
+	// MessageTypeRequestNotarySignature defines RequestNotarySignature type
+	MessageTypeRequestNotarySignature MessageType = "MessageTypeRequestNotarySignature"
+	// MessageTypeRequestNotarySignatureRep defines RequestNotarySignature response type
+	MessageTypeRequestNotarySignatureRep MessageType = "MessageTypeRequestNotarySignatureRep"
+	// MessageTypeRequestProofs defines RequestProofs type
+	MessageTypeRequestProofs MessageType = "MessageTypeRequestProofs"
+	// MessageTypeRequestProofsRep defines RequestProofs response type
+	MessageTypeRequestProofsRep MessageType = "MessageTypeRequestProofsRep"
+	// MessageTypeGetDocHistory defines GetDocumentHistory type
+	MessageTypeGetDocHistory MessageType = "MessageTypeGetDocHistory"
+	// MessageTypeGetDocHistoryRep defines GetDocumentHistory response type
+	MessageTypeGetDocHistoryRep MessageType = "MessageTypeGetDocHistoryRep"
+	// MessageTypeMailboxDeposit defines MailboxDeposit type
+	MessageTypeMailboxDeposit MessageType = "MessageTypeMailboxDeposit"
+	// MessageTypeMailboxDepositRep defines MailboxDeposit response type
+	MessageTypeMailboxDepositRep MessageType = "MessageTypeMailboxDepositRep"
+	// MessageTypeMailboxDrain defines MailboxDrain type
+	MessageTypeMailboxDrain MessageType = "MessageTypeMailboxDrain"
+	// MessageTypeMailboxDrainRep defines MailboxDrain response type
+	MessageTypeMailboxDrainRep MessageType = "MessageTypeMailboxDrainRep"
 )
 
 //MessageTypes map for MessageTypeFromString function
 var messageTypes = map[string]MessageType{
-	"MessageTypeError":               "MessageTypeError",
-	"MessageTypeInvalid":             "MessageTypeInvalid",
-	"MessageTypeRequestSignature":    "MessageTypeRequestSignature",
-	"MessageTypeRequestSignatureRep": "MessageTypeRequestSignatureRep",
-	"MessageTypeSendAnchoredDoc":     "MessageTypeSendAnchoredDoc",
-	"MessageTypeSendAnchoredDocRep":  "MessageTypeSendAnchoredDocRep",
-	"MessageTypeGetDoc":              "MessageTypeGetDoc",
-	"MessageTypeGetDocRep":           "MessageTypeGetDocRep",
+	"MessageTypeError":                     "MessageTypeError",
+	"MessageTypeInvalid":                   "MessageTypeInvalid",
+	"MessageTypeRequestSignature":          "MessageTypeRequestSignature",
+	"MessageTypeRequestSignatureRep":       "MessageTypeRequestSignatureRep",
+	"MessageTypeSendAnchoredDoc":           "MessageTypeSendAnchoredDoc",
+	"MessageTypeSendAnchoredDocRep":        "MessageTypeSendAnchoredDocRep",
+	"MessageTypeGetDoc":                    "MessageTypeGetDoc",
+	"MessageTypeGetDocRep":                 "MessageTypeGetDocRep",
+	"MessageTypeRequestNotarySignature":    "MessageTypeRequestNotarySignature",
+	"MessageTypeRequestNotarySignatureRep": "MessageTypeRequestNotarySignatureRep",
+	"MessageTypeRequestProofs":             "MessageTypeRequestProofs",
+	"MessageTypeRequestProofsRep":          "MessageTypeRequestProofsRep",
+	"MessageTypeGetDocHistory":             "MessageTypeGetDocHistory",
+	"MessageTypeGetDocHistoryRep":          "MessageTypeGetDocHistoryRep",
+	"MessageTypeMailboxDeposit":            "MessageTypeMailboxDeposit",
+	"MessageTypeMailboxDepositRep":         "MessageTypeMailboxDepositRep",
+	"MessageTypeMailboxDrain":              "MessageTypeMailboxDrain",
+	"MessageTypeMailboxDrainRep":           "MessageTypeMailboxDrainRep",
 }
 
 // Equals compares if string is of a particular MessageType
@@ -83,7 +117,7 @@ func ProtocolForDID(DID *identity.DID) protocol.ID {
 func ExtractDID(id protocol.ID) (identity.DID, error) {
 	parts := strings.Split(string(id), "/")
 	cidHexStr := parts[len(parts)-1]
-	return identity.NewDIDFromString(cidHexStr)
+	return identifiers.DecodeDID(cidHexStr)
 }
 
 // ResolveDataEnvelope unwraps Content Envelope out of p2pEnvelope
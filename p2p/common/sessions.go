@@ -0,0 +1,154 @@
+package p2pcommon
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/identity"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
+)
+
+// session is the state of the exchanges with a remote collaborator.
+type session struct {
+	// peer is the peer of the collaborator, resolved from or validated against its identity
+	peer libp2pPeer.ID
+
+	// handshake identifies the node version and network of the last handshake validated for the peer, empty if none
+	handshake string
+
+	expiresAt time.Time
+}
+
+// Sessions caches what was resolved and validated in the exchanges with the remote collaborators, so repeated
+// exchanges with the same collaborator skip the config and identity lookups.
+// A session expires after the TTL, it must be closed when the peer of the collaborator can't be reached or fails the
+// handshake validation. A nil Sessions opens no sessions.
+type Sessions struct {
+	ttl time.Duration
+
+	mu       sync.Mutex
+	sessions map[identity.DID]session
+}
+
+// NewSessions returns the Sessions expiring after the ttl, nil if the ttl is not positive.
+func NewSessions(ttl time.Duration) *Sessions {
+	if ttl <= 0 {
+		return nil
+	}
+
+	return &Sessions{ttl: ttl, sessions: make(map[identity.DID]session)}
+}
+
+// get returns the unexpired session of the collaborator.
+func (s *Sessions) get(did identity.DID) (session, bool) {
+	ss, ok := s.sessions[did]
+	if !ok {
+		return ss, false
+	}
+
+	if time.Now().After(ss.expiresAt) {
+		delete(s.sessions, did)
+		return ss, false
+	}
+
+	return ss, true
+}
+
+// Peer returns the peer of the collaborator if a session with the collaborator is open.
+func (s *Sessions) Peer(did identity.DID) (libp2pPeer.ID, bool) {
+	if s == nil {
+		return "", false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss, ok := s.get(did)
+	return ss.peer, ok
+}
+
+// Open opens a session with the collaborator on the peer resolved from its identity.
+// An open session on the same peer is kept with its validated handshake.
+func (s *Sessions) Open(did identity.DID, pid libp2pPeer.ID) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ss, ok := s.get(did); ok && ss.peer == pid {
+		return
+	}
+
+	s.sessions[did] = session{peer: pid, expiresAt: time.Now().Add(s.ttl)}
+}
+
+// Validated returns true if the handshake of the collaborator on the peer was validated in the open session.
+func (s *Sessions) Validated(did identity.DID, pid libp2pPeer.ID, header *p2ppb.Header) bool {
+	if s == nil || header == nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss, ok := s.get(did)
+	return ok && ss.peer == pid && ss.handshake == handshakeKey(header)
+}
+
+// SetValidated records the validated handshake of the collaborator on the peer, opening a session if none is open on the peer.
+func (s *Sessions) SetValidated(did identity.DID, pid libp2pPeer.ID, header *p2ppb.Header) {
+	if s == nil || header == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss, ok := s.get(did)
+	if !ok || ss.peer != pid {
+		ss = session{peer: pid, expiresAt: time.Now().Add(s.ttl)}
+	}
+
+	ss.handshake = handshakeKey(header)
+	s.sessions[did] = ss
+}
+
+// Close closes the session with the collaborator, the next exchange looks up its config and identity again.
+func (s *Sessions) Close(did identity.DID) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, did)
+}
+
+// CloseOnPeer closes the session with the collaborator only if it is open on the peer.
+// Used when the handshake fails, so a peer can't close the sessions of the collaborators it claims to be.
+func (s *Sessions) CloseOnPeer(did identity.DID, pid libp2pPeer.ID) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ss, ok := s.get(did); ok && ss.peer == pid {
+		delete(s.sessions, did)
+	}
+}
+
+// CloseAll closes the sessions with all the collaborators.
+func (s *Sessions) CloseAll() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = make(map[identity.DID]session)
+}
+
+func handshakeKey(header *p2ppb.Header) string {
+	return fmt.Sprintf("%s/%d", header.NodeVersion, header.NetworkIdentifier)
+}
@@ -0,0 +1,82 @@
+// +build unit
+
+package p2pcommon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessions(t *testing.T) {
+	did := testingidentity.GenerateRandomDID()
+	pid := randomPeerID(t)
+	header := &p2ppb.Header{NodeVersion: "0.0.5", NetworkIdentifier: 51}
+
+	// disabled
+	var s *Sessions
+	assert.Nil(t, NewSessions(0))
+	s.Open(did, pid)
+	s.SetValidated(did, pid, header)
+	_, ok := s.Peer(did)
+	assert.False(t, ok)
+	assert.False(t, s.Validated(did, pid, header))
+	s.Close(did)
+	s.CloseOnPeer(did, pid)
+	s.CloseAll()
+
+	s = NewSessions(time.Hour)
+	_, ok = s.Peer(did)
+	assert.False(t, ok)
+
+	// sessions opened by the client have no validated handshake
+	s.Open(did, pid)
+	got, ok := s.Peer(did)
+	assert.True(t, ok)
+	assert.Equal(t, pid, got)
+	assert.False(t, s.Validated(did, pid, header))
+
+	s.SetValidated(did, pid, header)
+	assert.True(t, s.Validated(did, pid, header))
+	assert.False(t, s.Validated(did, pid, nil))
+	assert.False(t, s.Validated(did, pid, &p2ppb.Header{NodeVersion: "0.0.6", NetworkIdentifier: 51}))
+	assert.False(t, s.Validated(did, randomPeerID(t), header))
+
+	// reopening on the same peer keeps the handshake, another peer drops it
+	s.Open(did, pid)
+	assert.True(t, s.Validated(did, pid, header))
+	pid2 := randomPeerID(t)
+	s.Open(did, pid2)
+	assert.False(t, s.Validated(did, pid, header))
+	got, _ = s.Peer(did)
+	assert.Equal(t, pid2, got)
+
+	// closed only on the peer of the session
+	s.CloseOnPeer(did, pid)
+	_, ok = s.Peer(did)
+	assert.True(t, ok)
+	s.CloseOnPeer(did, pid2)
+	_, ok = s.Peer(did)
+	assert.False(t, ok)
+
+	// closed
+	s.Open(did, pid2)
+	s.Close(did)
+	_, ok = s.Peer(did)
+	assert.False(t, ok)
+	s.SetValidated(did, pid, header)
+	s.CloseAll()
+	assert.False(t, s.Validated(did, pid, header))
+
+	// expired
+	s.Open(did, pid)
+	ss := s.sessions[did]
+	ss.expiresAt = time.Now().Add(-time.Second)
+	s.sessions[did] = ss
+	_, ok = s.Peer(did)
+	assert.False(t, ok)
+	assert.Len(t, s.sessions, 0)
+}
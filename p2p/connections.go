@@ -0,0 +1,274 @@
+package p2p
+
+import (
+	"context"
+	"expvar"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	inet "github.com/libp2p/go-libp2p-net"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
+)
+
+const (
+	// connPoolInterval is the interval the pooled connections are reopened and trimmed at.
+	connPoolInterval = 30 * time.Second
+
+	// connPoolMetricsName is the name of the expvar the connection pool stats are published as.
+	connPoolMetricsName = "p2p_connections"
+)
+
+// ConnPoolStats are the stats of the connections of the node.
+type ConnPoolStats struct {
+	// Open is the number of peers the node is connected to.
+	Open int `json:"open"`
+
+	// Warm is the number of collaborator peers kept connected since they were messaged within the idle timeout.
+	Warm int `json:"warm"`
+
+	// Reused is the number of messages sent over an open connection.
+	Reused uint64 `json:"reused"`
+
+	// Dialed is the number of messages that had to dial the peer.
+	Dialed uint64 `json:"dialed"`
+
+	// Reconnected is the number of dropped connections to warm peers reopened before the next message.
+	Reconnected uint64 `json:"reconnected"`
+
+	// Trimmed is the number of connections closed since they were idle or above the high watermark.
+	Trimmed uint64 `json:"trimmed"`
+}
+
+// connNetwork is the part of the libp2p network the pool manages.
+type connNetwork interface {
+	Peers() []libp2pPeer.ID
+	ConnsToPeer(p libp2pPeer.ID) []inet.Conn
+	ClosePeer(p libp2pPeer.ID) error
+}
+
+// connPool keeps the connections to the collaborators messaged within the idle timeout open, so that the messages
+// don't dial the peers. The connections of the node are trimmed down to the low watermark, the least recently used
+// first, once they exceed the high watermark. Connections to collaborators idle for the timeout are closed while the
+// node has more than the low watermark.
+type connPool struct {
+	lowWater    int
+	highWater   int
+	idleTimeout time.Duration
+
+	mu       sync.Mutex
+	lastUsed map[libp2pPeer.ID]time.Time
+	stats    ConnPoolStats
+}
+
+// newConnPool returns a connection pool with the watermarks and the idle timeout.
+// The pool is disabled if the high watermark is not positive.
+func newConnPool(lowWater, highWater int, idleTimeout time.Duration) *connPool {
+	if highWater <= 0 {
+		return nil
+	}
+
+	if lowWater > highWater {
+		lowWater = highWater
+	}
+
+	return &connPool{
+		lowWater:    lowWater,
+		highWater:   highWater,
+		idleTimeout: idleTimeout,
+		lastUsed:    make(map[libp2pPeer.ID]time.Time),
+	}
+}
+
+// sending records a message sent to the peer, over an open connection or not.
+func (p *connPool) sending(n connNetwork, pid libp2pPeer.ID) {
+	if p == nil {
+		return
+	}
+
+	open := len(n.ConnsToPeer(pid)) > 0
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastUsed[pid] = time.Now()
+	if open {
+		p.stats.Reused++
+		return
+	}
+
+	p.stats.Dialed++
+}
+
+// received records a message received from the peer.
+func (p *connPool) received(pid libp2pPeer.ID) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastUsed[pid] = time.Now()
+}
+
+// warmPeers returns the peers used within the idle timeout, and forgets the idle ones.
+func (p *connPool) warmPeers() (warm, idle []libp2pPeer.ID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	for pid, last := range p.lastUsed {
+		if now.Sub(last) < p.idleTimeout {
+			warm = append(warm, pid)
+			continue
+		}
+
+		idle = append(idle, pid)
+		delete(p.lastUsed, pid)
+	}
+
+	return warm, idle
+}
+
+// maintain reopens the dropped connections to the warm peers, closes the idle ones and trims the connections above the
+// high watermark. Protected peers are never closed.
+func (p *connPool) maintain(ctx context.Context, n connNetwork, connect func(ctx context.Context, pid libp2pPeer.ID) error, protected map[libp2pPeer.ID]bool) {
+	warm, idle := p.warmPeers()
+	for _, pid := range warm {
+		if len(n.ConnsToPeer(pid)) > 0 {
+			continue
+		}
+
+		err := connect(ctx, pid)
+		if err != nil {
+			log.Debugf("failed to reconnect to %s: %v", pid.Pretty(), err)
+			continue
+		}
+
+		p.record(func(s *ConnPoolStats) { s.Reconnected++ })
+	}
+
+	open := len(n.Peers())
+	for _, pid := range idle {
+		if open <= p.lowWater {
+			break
+		}
+
+		if protected[pid] || len(n.ConnsToPeer(pid)) == 0 {
+			continue
+		}
+
+		if p.close(n, pid) {
+			open--
+		}
+	}
+
+	peers := n.Peers()
+	if len(peers) <= p.highWater {
+		return
+	}
+
+	p.mu.Lock()
+	sort.Slice(peers, func(i, j int) bool { return p.lastUsed[peers[i]].Before(p.lastUsed[peers[j]]) })
+	p.mu.Unlock()
+	open = len(peers)
+	for _, pid := range peers {
+		if open <= p.lowWater {
+			break
+		}
+
+		if protected[pid] {
+			continue
+		}
+
+		if p.close(n, pid) {
+			open--
+		}
+	}
+}
+
+// close closes the connections to the peer and returns true if they were closed.
+func (p *connPool) close(n connNetwork, pid libp2pPeer.ID) bool {
+	err := n.ClosePeer(pid)
+	if err != nil {
+		log.Debugf("failed to close the connections to %s: %v", pid.Pretty(), err)
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.lastUsed, pid)
+	p.stats.Trimmed++
+	return true
+}
+
+// record updates the stats of the pool.
+func (p *connPool) record(update func(s *ConnPoolStats)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	update(&p.stats)
+}
+
+// snapshot returns the stats of the pool with the open connections of the network.
+func (p *connPool) snapshot(n connNetwork) ConnPoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	stats := p.stats
+	stats.Open = len(n.Peers())
+	now := time.Now()
+	for _, last := range p.lastUsed {
+		if now.Sub(last) < p.idleTimeout {
+			stats.Warm++
+		}
+	}
+
+	return stats
+}
+
+// maintainConnections maintains the pooled connections until the context is done.
+func (s *peer) maintainConnections(ctx context.Context) {
+	if s.conns == nil {
+		return
+	}
+
+	protected := make(map[libp2pPeer.ID]bool)
+	for _, relay := range s.relays {
+		protected[relay.ID] = true
+	}
+
+	connect := func(ctx context.Context, pid libp2pPeer.ID) error {
+		tctx, cancel := context.WithTimeout(ctx, time.Second*10)
+		defer cancel()
+		return s.host.Connect(tctx, s.host.Peerstore().PeerInfo(pid))
+	}
+
+	ticker := time.NewTicker(connPoolInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.conns.maintain(ctx, s.host.Network(), connect, protected)
+		}
+	}
+}
+
+var (
+	connPoolPublishOnce sync.Once
+	publishedPeer       atomic.Value
+)
+
+// publishConnPoolMetrics publishes the connection pool stats of the peer as the expvar "p2p_connections".
+// The expvar is served on /debug/vars if pprof is enabled. Later calls replace the published peer.
+func publishConnPoolMetrics(s *peer) {
+	publishedPeer.Store(s)
+	connPoolPublishOnce.Do(func() {
+		expvar.Publish(connPoolMetricsName, expvar.Func(func() interface{} {
+			s, _ := publishedPeer.Load().(*peer)
+			if s == nil || s.conns == nil || s.host == nil {
+				return ConnPoolStats{}
+			}
+
+			return s.conns.snapshot(s.host.Network())
+		}))
+	})
+}
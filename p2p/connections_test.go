@@ -0,0 +1,111 @@
+// +build unit
+
+package p2p
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	inet "github.com/libp2p/go-libp2p-net"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockNetwork struct {
+	connected map[libp2pPeer.ID]bool
+	closed    []libp2pPeer.ID
+}
+
+func (n *mockNetwork) Peers() []libp2pPeer.ID {
+	var peers []libp2pPeer.ID
+	for pid := range n.connected {
+		peers = append(peers, pid)
+	}
+
+	return peers
+}
+
+func (n *mockNetwork) ConnsToPeer(pid libp2pPeer.ID) []inet.Conn {
+	if n.connected[pid] {
+		return make([]inet.Conn, 1)
+	}
+
+	return nil
+}
+
+func (n *mockNetwork) ClosePeer(pid libp2pPeer.ID) error {
+	delete(n.connected, pid)
+	n.closed = append(n.closed, pid)
+	return nil
+}
+
+func TestNewConnPool(t *testing.T) {
+	assert.Nil(t, newConnPool(10, 0, time.Minute))
+	p := newConnPool(10, 5, time.Minute)
+	assert.Equal(t, 5, p.lowWater)
+
+	// disabled
+	var np *connPool
+	np.sending(&mockNetwork{}, libp2pPeer.ID("a"))
+	np.received(libp2pPeer.ID("a"))
+}
+
+func TestConnPool_sending(t *testing.T) {
+	p := newConnPool(1, 2, time.Minute)
+	n := &mockNetwork{connected: map[libp2pPeer.ID]bool{"a": true}}
+	p.sending(n, "a")
+	p.sending(n, "b")
+	p.received("c")
+	stats := p.snapshot(n)
+	assert.Equal(t, ConnPoolStats{Open: 1, Warm: 3, Reused: 1, Dialed: 1}, stats)
+}
+
+func TestConnPool_maintain(t *testing.T) {
+	p := newConnPool(2, 3, time.Minute)
+	n := &mockNetwork{connected: map[libp2pPeer.ID]bool{"dht1": true, "dht2": true, "relay": true, "idle": true}}
+	var connected []libp2pPeer.ID
+	connect := func(ctx context.Context, pid libp2pPeer.ID) error {
+		if pid == "unreachable" {
+			return errors.New("dial failed")
+		}
+
+		connected = append(connected, pid)
+		n.connected[pid] = true
+		return nil
+	}
+
+	p.received("warm")
+	p.received("unreachable")
+	p.lastUsed["idle"] = time.Now().Add(-time.Hour)
+
+	// dropped warm connections are reopened, idle ones closed, and the connections above the high watermark trimmed
+	// down to the low watermark without the protected and the recent peers
+	p.maintain(context.Background(), n, connect, map[libp2pPeer.ID]bool{"relay": true})
+	assert.Equal(t, []libp2pPeer.ID{"warm"}, connected)
+	assert.Equal(t, libp2pPeer.ID("idle"), n.closed[0])
+	assert.NotContains(t, p.lastUsed, libp2pPeer.ID("idle"))
+	assert.Len(t, n.connected, 2)
+	assert.True(t, n.connected["relay"])
+	assert.True(t, n.connected["warm"])
+
+	// below the high watermark
+	n.connected["dht3"] = true
+	p.maintain(context.Background(), n, connect, map[libp2pPeer.ID]bool{"relay": true})
+	assert.Len(t, n.connected, 3)
+
+	stats := p.snapshot(n)
+	assert.Equal(t, 3, stats.Open)
+	assert.Equal(t, uint64(1), stats.Reconnected)
+	assert.Equal(t, uint64(3), stats.Trimmed)
+}
+
+func TestPublishConnPoolMetrics(t *testing.T) {
+	publishConnPoolMetrics(&peer{})
+	var stats ConnPoolStats
+	assert.NoError(t, json.Unmarshal([]byte(expvar.Get(connPoolMetricsName).String()), &stats))
+	assert.Equal(t, ConnPoolStats{}, stats)
+}
@@ -0,0 +1,102 @@
+package p2p
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	ms "github.com/centrifuge/go-centrifuge/p2p/messenger"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
+)
+
+// ErrPeerUnreachable must be used when the connection to the peer of a collaborator fails.
+const ErrPeerUnreachable = errors.Error("peer unreachable")
+
+// endpointRefresher rate limits the refreshes of the p2p endpoints of the collaborators.
+type endpointRefresher struct {
+	interval time.Duration
+
+	mu          sync.Mutex
+	refreshedAt map[identity.DID]time.Time
+}
+
+// newEndpointRefresher returns a refresher allowing a refresh per collaborator within the interval.
+// The refresh is disabled if the interval is not positive.
+func newEndpointRefresher(interval time.Duration) *endpointRefresher {
+	if interval <= 0 {
+		return nil
+	}
+
+	return &endpointRefresher{interval: interval, refreshedAt: make(map[identity.DID]time.Time)}
+}
+
+// allow returns true and records the refresh if the endpoint of the collaborator was not refreshed within the interval.
+func (r *endpointRefresher) allow(collaborator identity.DID) bool {
+	if r == nil {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	if last, ok := r.refreshedAt[collaborator]; ok && now.Sub(last) < r.interval {
+		return false
+	}
+
+	r.refreshedAt[collaborator] = now
+	return true
+}
+
+// isConnectionError returns true if the message couldn't be delivered to the peer.
+// Cancelled requests and peers that are slow to respond don't count as connection errors.
+func isConnectionError(ctx context.Context, err error) bool {
+	return ctx.Err() == nil && !errors.IsOfType(ms.ErrReadTimeout, err)
+}
+
+// deliver resolves the peer of the remote collaborator and sends the envelope.
+// The peer of an open session with the collaborator is reused, a session is opened once the envelope is delivered.
+// If the peer can't be reached, the session is closed, the cached addresses of the peer are dropped, the p2p endpoint is
+// re-resolved from the identity contract and the envelope is sent once more. The endpoint of a collaborator is refreshed
+// at most once per refresh interval.
+func (s *peer) deliver(ctx context.Context, collaborator identity.DID, envelope *protocolpb.P2PEnvelope) (*p2ppb.Envelope, error) {
+	pid, err := s.resolvePeer(collaborator)
+	if err != nil {
+		return nil, err
+	}
+
+	recv, err := s.sendMessage(ctx, collaborator, pid, envelope)
+	if err == nil {
+		s.sessions.Open(collaborator, pid)
+		return recv, nil
+	}
+
+	if !errors.IsOfType(ErrPeerUnreachable, err) {
+		return nil, err
+	}
+
+	s.sessions.Close(collaborator)
+	if !s.endpoints.allow(collaborator) {
+		return nil, err
+	}
+
+	log.Warningf("failed to reach %s, refreshing its p2p endpoint: %v", collaborator.String(), err)
+	if !s.disablePeerStore {
+		s.host.Peerstore().ClearAddrs(pid)
+	}
+
+	pid, err = s.getPeerID(collaborator)
+	if err != nil {
+		return nil, err
+	}
+
+	recv, err = s.sendMessage(ctx, collaborator, pid, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	s.sessions.Open(collaborator, pid)
+	return recv, nil
+}
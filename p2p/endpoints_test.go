@@ -0,0 +1,115 @@
+// +build unit
+
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	ms "github.com/centrifuge/go-centrifuge/p2p/messenger"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEndpointRefresher_allow(t *testing.T) {
+	// disabled
+	var r *endpointRefresher
+	assert.False(t, r.allow(did))
+	assert.Nil(t, newEndpointRefresher(0))
+
+	r = newEndpointRefresher(time.Hour)
+	did2 := testingidentity.GenerateRandomDID()
+	assert.True(t, r.allow(did))
+	assert.False(t, r.allow(did))
+	assert.True(t, r.allow(did2))
+
+	// interval passed
+	r.refreshedAt[did] = time.Now().Add(-2 * time.Hour)
+	assert.True(t, r.allow(did))
+}
+
+func TestIsConnectionError(t *testing.T) {
+	assert.True(t, isConnectionError(context.Background(), errors.New("dial failed")))
+	assert.False(t, isConnectionError(context.Background(), ms.ErrReadTimeout))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, isConnectionError(ctx, errors.New("dial failed")))
+}
+
+func TestPeer_send(t *testing.T) {
+	c, err := cfg.GetConfig()
+	assert.NoError(t, err)
+	c = updateKeys(c)
+	ctx := testingconfig.CreateAccountContext(t, c)
+	idService := getIDMocks(ctx, did)
+	m := &MockMessenger{}
+	s := &peer{config: cfg, idService: idService, mes: m, disablePeerStore: true, endpoints: newEndpointRefresher(time.Hour)}
+	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, c.GetNetworkID(), p2pcommon.MessageTypeRequestSignature, &p2ppb.SignatureRequest{})
+	assert.NoError(t, err)
+	resp := s.createSignatureResp(version.GetVersion().String(), nil)
+
+	// peer slow to respond
+	m.On("SendMessage", ctx, mock.Anything, envelope, p2pcommon.ProtocolForDID(&did)).Return(nil, ms.ErrReadTimeout).Once()
+	_, err = s.send(ctx, did, envelope)
+	assert.True(t, errors.IsOfType(ms.ErrReadTimeout, err))
+	idService.AssertNumberOfCalls(t, "CurrentP2PKey", 1)
+
+	// endpoint refreshed after a connection error
+	m.On("SendMessage", ctx, mock.Anything, envelope, p2pcommon.ProtocolForDID(&did)).Return(nil, errors.New("dial failed")).Once()
+	m.On("SendMessage", ctx, mock.Anything, envelope, p2pcommon.ProtocolForDID(&did)).Return(resp, nil).Once()
+	recv, err := s.send(ctx, did, envelope)
+	assert.NoError(t, err)
+	assert.True(t, p2pcommon.MessageTypeRequestSignatureRep.Equals(recv.Header.Type))
+	idService.AssertNumberOfCalls(t, "CurrentP2PKey", 3)
+
+	// refreshed within the interval
+	m.On("SendMessage", ctx, mock.Anything, envelope, p2pcommon.ProtocolForDID(&did)).Return(nil, errors.New("dial failed")).Once()
+	_, err = s.send(ctx, did, envelope)
+	assert.True(t, errors.IsOfType(ErrPeerUnreachable, err))
+	idService.AssertNumberOfCalls(t, "CurrentP2PKey", 4)
+	m.AssertExpectations(t)
+}
+
+func TestPeer_send_sessions(t *testing.T) {
+	c, err := cfg.GetConfig()
+	assert.NoError(t, err)
+	c = updateKeys(c)
+	ctx := testingconfig.CreateAccountContext(t, c)
+	idService := getIDMocks(ctx, did)
+	m := &MockMessenger{}
+	s := &peer{config: cfg, idService: idService, mes: m, disablePeerStore: true, sessions: p2pcommon.NewSessions(time.Hour)}
+	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, c.GetNetworkID(), p2pcommon.MessageTypeRequestSignature, &p2ppb.SignatureRequest{})
+	assert.NoError(t, err)
+	resp := s.createSignatureResp(version.GetVersion().String(), nil)
+
+	// the peer resolved in the first exchange is reused
+	m.On("SendMessage", ctx, mock.Anything, envelope, p2pcommon.ProtocolForDID(&did)).Return(resp, nil).Twice()
+	_, err = s.send(ctx, did, envelope)
+	assert.NoError(t, err)
+	_, err = s.send(ctx, did, envelope)
+	assert.NoError(t, err)
+	idService.AssertNumberOfCalls(t, "CurrentP2PKey", 1)
+	_, err = s.localAccount(did)
+	assert.Error(t, err)
+	assert.NoError(t, s.remoteIdentityExists(ctx, did))
+
+	// unreachable peers close the session
+	m.On("SendMessage", ctx, mock.Anything, envelope, p2pcommon.ProtocolForDID(&did)).Return(nil, errors.New("dial failed")).Once()
+	_, err = s.send(ctx, did, envelope)
+	assert.True(t, errors.IsOfType(ErrPeerUnreachable, err))
+	_, ok := s.sessions.Peer(did)
+	assert.False(t, ok)
+	m.On("SendMessage", ctx, mock.Anything, envelope, p2pcommon.ProtocolForDID(&did)).Return(resp, nil).Once()
+	_, err = s.send(ctx, did, envelope)
+	assert.NoError(t, err)
+	idService.AssertNumberOfCalls(t, "CurrentP2PKey", 2)
+	m.AssertExpectations(t)
+}
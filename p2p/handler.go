@@ -0,0 +1,114 @@
+package p2p
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/centrifuge/go-centrifuge/centerrors"
+	"github.com/centrifuge/go-centrifuge/code"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/collaborator"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type grpcHandler struct {
+	checker CollaboratorChecker
+	docSrv  documents.Service
+}
+
+// GRPCHandler returns an implementation of collaboratorpb.CollaboratorServiceServer
+func GRPCHandler(checker CollaboratorChecker, docSrv documents.Service) collaboratorpb.CollaboratorServiceServer {
+	return grpcHandler{checker: checker, docSrv: docSrv}
+}
+
+// GetChecklist runs the onboarding checks for the collaborator.
+func (h grpcHandler) GetChecklist(ctx context.Context, req *collaboratorpb.GetChecklistRequest) (*collaboratorpb.Checklist, error) {
+	did, err := identity.NewDIDFromString(req.Did)
+	if err != nil {
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	cl, err := h.checker.CheckCollaborator(ctx, did)
+	if err != nil {
+		log.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	resp := &collaboratorpb.Checklist{Did: cl.DID.String(), Passed: cl.Passed()}
+	for _, c := range cl.Checks {
+		resp.Checks = append(resp.Checks, &collaboratorpb.Check{
+			Name:    c.Name,
+			Passed:  c.Passed,
+			Message: c.Message,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetDocumentCollaborators summarizes the collaborators of the latest version of the document.
+func (h grpcHandler) GetDocumentCollaborators(ctx context.Context, req *collaboratorpb.GetDocumentCollaboratorsRequest) (*collaboratorpb.DocumentCollaborators, error) {
+	identifier, err := identifiers.DecodeDocumentID(req.Identifier)
+	if err != nil {
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	model, err := h.docSrv.GetCurrentVersion(ctx, identifier)
+	if err != nil {
+		log.Error(err)
+		if errors.IsOfType(documents.ErrDocumentNotFound, err) {
+			return nil, errors.NewHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	dids, err := model.GetCollaborators()
+	if err != nil {
+		log.Error(err)
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	resp := &collaboratorpb.DocumentCollaborators{DocumentId: hexutil.Encode(identifier)}
+	for _, cs := range h.checker.SummarizeCollaborators(ctx, dids) {
+		summary, err := toProtoSummary(cs)
+		if err != nil {
+			log.Error(err)
+			return nil, centerrors.New(code.Unknown, err.Error())
+		}
+
+		resp.Collaborators = append(resp.Collaborators, summary)
+	}
+
+	return resp, nil
+}
+
+// toProtoSummary converts the collaborator summary to client api format.
+func toProtoSummary(cs CollaboratorSummary) (*collaboratorpb.CollaboratorSummary, error) {
+	summary := &collaboratorpb.CollaboratorSummary{
+		Did:               cs.DID.String(),
+		Local:             cs.Local,
+		P2PUrl:            cs.P2PURL,
+		Addresses:         cs.Addresses,
+		Connected:         cs.Connected,
+		KeysHealthy:       cs.KeysHealthy,
+		ActiveSigningKeys: int32(cs.ActiveSigningKeys),
+		Issues:            cs.Issues,
+		NodeVersion:       cs.NodeVersion,
+	}
+
+	if !cs.LastContact.IsZero() {
+		ts, err := utils.ToTimestamp(cs.LastContact)
+		if err != nil {
+			return nil, err
+		}
+
+		summary.LastContact = ts
+	}
+
+	return summary, nil
+}
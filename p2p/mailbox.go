@@ -0,0 +1,261 @@
+package p2p
+
+import (
+	"context"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/crypto/ed25519"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/p2p/mailbox"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/mailbox"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
+	"github.com/golang/protobuf/proto"
+	xed25519 "golang.org/x/crypto/ed25519"
+)
+
+// ErrNoMailbox must be used when the node has no mailbox configured.
+const ErrNoMailbox = errors.Error("no mailbox configured")
+
+// mailboxID returns the DID of the mailbox of the node.
+func mailboxID(nc config.Configuration) (identity.DID, error) {
+	if nc.GetP2PMailboxID() == "" {
+		return identity.DID{}, ErrNoMailbox
+	}
+
+	return identity.NewDIDFromString(nc.GetP2PMailboxID())
+}
+
+// deposit seals the envelope for the unreachable collaborator and deposits it in the mailbox of the node.
+// The collaborator receives it when it drains the mailbox, if it uses the same mailbox.
+func (s *peer) deposit(ctx context.Context, collaborator identity.DID, envelope *protocolpb.P2PEnvelope) error {
+	nc, err := s.config.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	mbID, err := mailboxID(nc)
+	if err != nil {
+		return err
+	}
+
+	// the envelope is sealed to the current p2p key of the collaborator
+	pid, err := s.getPeerID(collaborator)
+	if err != nil {
+		return err
+	}
+
+	pk, err := pid.ExtractPublicKey()
+	if err != nil {
+		return err
+	}
+
+	recipientKey, err := pk.Raw()
+	if err != nil {
+		return err
+	}
+
+	_, senderKey, err := ed25519.GetSigningKeyPair(nc.GetP2PKeyPair())
+	if err != nil {
+		return err
+	}
+
+	sealed, err := mailbox.Seal(collaborator, recipientKey, senderKey, envelope.Body)
+	if err != nil {
+		return err
+	}
+
+	peerCtx, cancel := context.WithTimeout(ctx, nc.GetP2PConnectionTimeout())
+	defer cancel()
+
+	req := &mailboxpb.DepositRequest{Envelope: sealed}
+	tc, err := s.localAccount(mbID)
+	if err == nil {
+		// the mailbox is a local account
+		h := s.handlerCreator()
+		localCtx, err := contextutil.New(peerCtx, tc)
+		if err != nil {
+			return err
+		}
+
+		selfDID, err := contextutil.AccountDID(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = h.DepositEnvelope(localCtx, req, selfDID)
+		return err
+	}
+
+	depositEnvelope, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeMailboxDeposit, req)
+	if err != nil {
+		return err
+	}
+
+	recvEnvelope, err := s.send(peerCtx, mbID, depositEnvelope)
+	if err != nil {
+		return err
+	}
+
+	// handle client error
+	if p2pcommon.MessageTypeError.Equals(recvEnvelope.Header.Type) {
+		return convertClientError(recvEnvelope)
+	}
+
+	if !p2pcommon.MessageTypeMailboxDepositRep.Equals(recvEnvelope.Header.Type) {
+		return errors.New("the received mailbox deposit response is incorrect")
+	}
+
+	return nil
+}
+
+// drainMailbox drains the mailbox of the accounts when the node starts, and then once per drain interval so that
+// the envelopes deposited while the node was offline are received once it is back online.
+func (s *peer) drainMailbox(ctx context.Context) {
+	nc, err := s.config.GetConfig()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	mbID, err := mailboxID(nc)
+	if err != nil {
+		return
+	}
+
+	_, key, err := ed25519.GetSigningKeyPair(nc.GetP2PKeyPair())
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	for {
+		s.drainAccounts(ctx, mbID, key)
+		if nc.GetP2PMailboxDrainInterval() <= 0 {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(nc.GetP2PMailboxDrainInterval()):
+		}
+	}
+}
+
+// drainAccounts drains the mailbox for each account of the node.
+func (s *peer) drainAccounts(ctx context.Context, mbID identity.DID, key xed25519.PrivateKey) {
+	accs, err := s.config.GetAllAccounts()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	for _, acc := range accs {
+		actx, err := contextutil.New(ctx, acc)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		received, err := s.drainAccount(actx, mbID, key)
+		if err != nil {
+			log.Warningf("failed to drain mailbox %s: %v", mbID.String(), err)
+		}
+
+		if received > 0 {
+			log.Infof("received %d envelopes from mailbox %s", received, mbID.String())
+		}
+	}
+}
+
+// drainAccount receives the envelopes deposited for the account of the context until the mailbox holds none.
+// Envelopes that can't be received are acknowledged as well, the mailbox would return them on every drain otherwise.
+func (s *peer) drainAccount(ctx context.Context, mbID identity.DID, key xed25519.PrivateKey) (received int, err error) {
+	h := s.handlerCreator()
+	req := new(mailboxpb.DrainRequest)
+	for {
+		resp, err := s.requestDrain(ctx, mbID, req)
+		if err != nil {
+			return received, err
+		}
+
+		if len(resp.Deposits) == 0 {
+			return received, nil
+		}
+
+		req = new(mailboxpb.DrainRequest)
+		for _, d := range resp.Deposits {
+			req.Acknowledged = append(req.Acknowledged, d.Id)
+			data, err := mailbox.Open(key, d.Envelope)
+			if err == nil {
+				err = h.ReceiveDeposited(ctx, d.Envelope.SenderKey, data)
+			}
+
+			if err != nil {
+				log.Warningf("failed to receive envelope deposited by %s: %v", identity.NewDIDFromBytes(d.Sender).String(), err)
+				continue
+			}
+
+			received++
+		}
+	}
+}
+
+// requestDrain requests the envelopes deposited for the account of the context from the mailbox.
+func (s *peer) requestDrain(ctx context.Context, mbID identity.DID, req *mailboxpb.DrainRequest) (*mailboxpb.DrainResponse, error) {
+	nc, err := s.config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	peerCtx, cancel := context.WithTimeout(ctx, nc.GetP2PConnectionTimeout())
+	defer cancel()
+
+	tc, err := s.localAccount(mbID)
+	if err == nil {
+		// the mailbox is a local account
+		h := s.handlerCreator()
+		localCtx, err := contextutil.New(peerCtx, tc)
+		if err != nil {
+			return nil, err
+		}
+
+		selfDID, err := contextutil.AccountDID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return h.DrainMailbox(localCtx, req, selfDID)
+	}
+
+	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeMailboxDrain, req)
+	if err != nil {
+		return nil, err
+	}
+
+	recvEnvelope, err := s.send(peerCtx, mbID, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	// handle client error
+	if p2pcommon.MessageTypeError.Equals(recvEnvelope.Header.Type) {
+		return nil, convertClientError(recvEnvelope)
+	}
+
+	if !p2pcommon.MessageTypeMailboxDrainRep.Equals(recvEnvelope.Header.Type) {
+		return nil, errors.New("the received mailbox drain response is incorrect")
+	}
+
+	r := new(mailboxpb.DrainResponse)
+	err = proto.Unmarshal(recvEnvelope.Body, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
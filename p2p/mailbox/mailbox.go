@@ -0,0 +1,197 @@
+package mailbox
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/mailbox"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// ErrNotRegistered must be used when an envelope is deposited for a recipient that never drained the mailbox.
+	ErrNotRegistered = errors.Error("recipient is not registered with the mailbox")
+
+	// ErrMailboxFull must be used when the mailbox holds the maximum number of deposits for the recipient.
+	ErrMailboxFull = errors.Error("mailbox of the recipient is full")
+
+	// maxDeposits is the number of deposits held per recipient, so that senders can't fill the storage of the mailbox.
+	maxDeposits = 1000
+
+	// drainSize is the number of deposits returned per drain.
+	drainSize = 50
+
+	registrationPrefix = "mailbox_registration_"
+	depositPrefix      = "mailbox_deposit_"
+)
+
+// registration records that the recipient uses the node as its mailbox.
+type registration struct {
+	Recipient    identity.DID
+	RegisteredAt time.Time
+}
+
+// JSON returns the json of the registration.
+func (r *registration) JSON() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// FromJSON loads the registration from its json.
+func (r *registration) FromJSON(data []byte) error {
+	return json.Unmarshal(data, r)
+}
+
+// Type returns the reflect type of the registration.
+func (r *registration) Type() reflect.Type {
+	return reflect.TypeOf(r)
+}
+
+// deposit is a sealed envelope held for its recipient.
+type deposit struct {
+	ID          []byte
+	Sender      identity.DID
+	Envelope    []byte
+	DepositedAt time.Time
+}
+
+// JSON returns the json of the deposit.
+func (d *deposit) JSON() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+// FromJSON loads the deposit from its json.
+func (d *deposit) FromJSON(data []byte) error {
+	return json.Unmarshal(data, d)
+}
+
+// Type returns the reflect type of the deposit.
+func (d *deposit) Type() reflect.Type {
+	return reflect.TypeOf(d)
+}
+
+// Mailbox holds the sealed envelopes deposited by the collaborators of the recipients using the node as their mailbox,
+// until the recipients drain them. The mailbox can't read the envelopes.
+type Mailbox struct {
+	repo storage.Repository
+
+	// mu serialises the deposits so that the deposits of a recipient are counted once
+	mu sync.Mutex
+
+	// last is the ID of the last deposit, IDs are increasing even if the clock isn't
+	last uint64
+}
+
+// New registers the mailbox models and returns the mailbox on the node storage.
+func New(repo storage.Repository) *Mailbox {
+	repo.Register(&registration{})
+	repo.Register(&deposit{})
+	return &Mailbox{repo: repo}
+}
+
+func registrationKey(recipient identity.DID) []byte {
+	return []byte(registrationPrefix + recipient.String())
+}
+
+// recipientPrefix returns the key prefix of the deposits of the recipient.
+func recipientPrefix(recipient identity.DID) []byte {
+	return []byte(fmt.Sprintf("%s%s_", depositPrefix, recipient.String()))
+}
+
+// depositKey returns the key of the deposit. The IDs are the deposit times in nanoseconds, so the keys are in the deposit order.
+func depositKey(recipient identity.DID, id []byte) []byte {
+	return append(recipientPrefix(recipient), hexutil.Encode(id)...)
+}
+
+// Deposit holds the sealed envelope of the sender for its recipient.
+func (m *Mailbox) Deposit(sender identity.DID, sealed *mailboxpb.SealedEnvelope) error {
+	if sealed == nil || len(sealed.Recipient) != identity.DIDLength {
+		return errors.NewTypedError(ErrInvalidSeal, errors.New("invalid recipient"))
+	}
+
+	recipient := identity.NewDIDFromBytes(sealed.Recipient)
+	if !m.repo.Exists(registrationKey(recipient)) {
+		return errors.NewTypedError(ErrNotRegistered, errors.New("recipient %s", recipient.String()))
+	}
+
+	data, err := proto.Marshal(sealed)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.count(recipient) >= maxDeposits {
+		return errors.NewTypedError(ErrMailboxFull, errors.New("recipient %s", recipient.String()))
+	}
+
+	now := time.Now().UTC()
+	m.last++
+	if seq := uint64(now.UnixNano()); seq > m.last {
+		m.last = seq
+	}
+
+	id := make([]byte, 8)
+	binary.BigEndian.PutUint64(id, m.last)
+	return m.repo.Create(depositKey(recipient, id), &deposit{ID: id, Sender: sender, Envelope: data, DepositedAt: now})
+}
+
+// count returns the number of deposits held for the recipient.
+func (m *Mailbox) count(recipient identity.DID) int {
+	it := m.repo.NewIterator(recipientPrefix(recipient))
+	defer it.Release()
+	var count int
+	for it.Next() {
+		count++
+	}
+
+	return count
+}
+
+// Drain registers the recipient with the mailbox, drops the acknowledged deposits, and returns the oldest deposits
+// held for the recipient.
+func (m *Mailbox) Drain(recipient identity.DID, acknowledged [][]byte) (*mailboxpb.DrainResponse, error) {
+	if !m.repo.Exists(registrationKey(recipient)) {
+		err := m.repo.Create(registrationKey(recipient), &registration{Recipient: recipient, RegisteredAt: time.Now().UTC()})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	batch := m.repo.NewBatch()
+	for _, id := range acknowledged {
+		batch.Delete(depositKey(recipient, id))
+	}
+
+	err := batch.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	it := m.repo.NewIterator(recipientPrefix(recipient))
+	defer it.Release()
+	resp := new(mailboxpb.DrainResponse)
+	for len(resp.Deposits) < drainSize && it.Next() {
+		d, ok := it.Model().(*deposit)
+		if !ok {
+			return nil, errors.New("invalid deposit at %s", it.Key())
+		}
+
+		sealed := new(mailboxpb.SealedEnvelope)
+		err = proto.Unmarshal(d.Envelope, sealed)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Deposits = append(resp.Deposits, &mailboxpb.Deposit{Id: d.ID, Sender: d.Sender[:], Envelope: sealed})
+	}
+
+	return resp, it.Error()
+}
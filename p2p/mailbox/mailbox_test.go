@@ -0,0 +1,90 @@
+// +build unit
+
+package mailbox
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/mailbox"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMailbox(t *testing.T) *Mailbox {
+	db, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	return New(leveldb.NewLevelDBRepository(db))
+}
+
+func sealedFor(recipient identity.DID) *mailboxpb.SealedEnvelope {
+	return &mailboxpb.SealedEnvelope{Recipient: recipient[:], Ciphertext: utils.RandomSlice(32)}
+}
+
+func TestMailbox_DepositDrain(t *testing.T) {
+	mb := newTestMailbox(t)
+	sender := testingidentity.GenerateRandomDID()
+	recipient := testingidentity.GenerateRandomDID()
+
+	// recipients are registered on their first drain
+	err := mb.Deposit(sender, sealedFor(recipient))
+	assert.True(t, errors.IsOfType(ErrNotRegistered, err))
+	resp, err := mb.Drain(recipient, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Deposits)
+
+	err = mb.Deposit(sender, &mailboxpb.SealedEnvelope{Recipient: utils.RandomSlice(3)})
+	assert.True(t, errors.IsOfType(ErrInvalidSeal, err))
+
+	var sealed []*mailboxpb.SealedEnvelope
+	for i := 0; i < drainSize+1; i++ {
+		s := sealedFor(recipient)
+		assert.NoError(t, mb.Deposit(sender, s))
+		sealed = append(sealed, s)
+	}
+
+	// other recipients don't see the deposits
+	resp, err = mb.Drain(testingidentity.GenerateRandomDID(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Deposits)
+
+	// deposits are drained in the deposit order until acknowledged
+	resp, err = mb.Drain(recipient, nil)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Deposits, drainSize)
+	for i, d := range resp.Deposits {
+		assert.Equal(t, sender[:], d.Sender)
+		assert.Equal(t, sealed[i].Ciphertext, d.Envelope.Ciphertext)
+	}
+
+	var acked [][]byte
+	for _, d := range resp.Deposits {
+		acked = append(acked, d.Id)
+	}
+
+	resp, err = mb.Drain(recipient, acked)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Deposits, 1)
+	assert.Equal(t, sealed[drainSize].Ciphertext, resp.Deposits[0].Envelope.Ciphertext)
+
+	resp, err = mb.Drain(recipient, [][]byte{resp.Deposits[0].Id})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Deposits)
+}
+
+func TestMailbox_Deposit_full(t *testing.T) {
+	mb := newTestMailbox(t)
+	sender := testingidentity.GenerateRandomDID()
+	recipient := testingidentity.GenerateRandomDID()
+	_, err := mb.Drain(recipient, nil)
+	assert.NoError(t, err)
+	for i := 0; i < maxDeposits; i++ {
+		assert.NoError(t, mb.Deposit(sender, sealedFor(recipient)))
+	}
+
+	err = mb.Deposit(sender, sealedFor(recipient))
+	assert.True(t, errors.IsOfType(ErrMailboxFull, err))
+}
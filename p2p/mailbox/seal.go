@@ -0,0 +1,169 @@
+package mailbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/sha512"
+	"io"
+	"math/big"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/mailbox"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// ErrInvalidSeal must be used when a sealed envelope can't be verified or decrypted.
+	ErrInvalidSeal = errors.Error("invalid sealed envelope")
+
+	// sealLabel labels the derivation of the content keys of the sealed envelopes
+	sealLabel = "centrifuge mailbox"
+)
+
+// fieldPrime is the prime 2^255 - 19 of the field of curve25519
+var fieldPrime = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+
+// Seal encrypts the envelope to the ed25519 p2p key of the recipient and signs it with the p2p key of the sender.
+// The content key is agreed between an ephemeral X25519 key and the X25519 form of the recipient key,
+// so only the node holding the p2p key of the recipient can open the envelope.
+func Seal(recipient identity.DID, recipientKey ed25519.PublicKey, senderKey ed25519.PrivateKey, envelope []byte) (*mailboxpb.SealedEnvelope, error) {
+	recipientX, err := publicToX25519(recipientKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var ephemeral, ephemeralPub [32]byte
+	copy(ephemeral[:], utils.RandomSlice(32))
+	curve25519.ScalarBaseMult(&ephemeralPub, &ephemeral)
+	aead, err := contentCipher(&ephemeral, &recipientX, ephemeralPub[:], recipientX[:])
+	if err != nil {
+		return nil, err
+	}
+
+	sealed := &mailboxpb.SealedEnvelope{
+		Recipient:    recipient[:],
+		SenderKey:    senderKey.Public().(ed25519.PublicKey),
+		EphemeralKey: ephemeralPub[:],
+		Nonce:        utils.RandomSlice(aead.NonceSize()),
+	}
+
+	sealed.Ciphertext = aead.Seal(nil, sealed.Nonce, envelope, sealed.Recipient)
+	sealed.Signature = ed25519.Sign(senderKey, signingPayload(sealed))
+	return sealed, nil
+}
+
+// Open verifies the signature of the sealed envelope and decrypts it with the p2p key of the recipient.
+// The sender key is only proven to have sealed the envelope, it must be validated against the identity of the sender.
+func Open(recipientKey ed25519.PrivateKey, sealed *mailboxpb.SealedEnvelope) ([]byte, error) {
+	if sealed == nil || len(sealed.SenderKey) != ed25519.PublicKeySize || len(sealed.EphemeralKey) != 32 {
+		return nil, errors.NewTypedError(ErrInvalidSeal, errors.New("malformed sealed envelope"))
+	}
+
+	if !ed25519.Verify(sealed.SenderKey, signingPayload(sealed), sealed.Signature) {
+		return nil, errors.NewTypedError(ErrInvalidSeal, errors.New("invalid signature"))
+	}
+
+	recipientX, err := publicToX25519(recipientKey.Public().(ed25519.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+
+	var ephemeralPub [32]byte
+	copy(ephemeralPub[:], sealed.EphemeralKey)
+	priv := privateToX25519(recipientKey)
+	aead, err := contentCipher(&priv, &ephemeralPub, ephemeralPub[:], recipientX[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed.Nonce) != aead.NonceSize() {
+		return nil, errors.NewTypedError(ErrInvalidSeal, errors.New("invalid nonce"))
+	}
+
+	envelope, err := aead.Open(nil, sealed.Nonce, sealed.Ciphertext, sealed.Recipient)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrInvalidSeal, err)
+	}
+
+	return envelope, nil
+}
+
+// signingPayload returns what the sender signs: the recipient, the ephemeral key, the nonce and the ciphertext.
+func signingPayload(sealed *mailboxpb.SealedEnvelope) []byte {
+	var payload []byte
+	for _, b := range [][]byte{sealed.Recipient, sealed.EphemeralKey, sealed.Nonce, sealed.Ciphertext} {
+		payload = append(payload, b...)
+	}
+
+	return payload
+}
+
+// contentCipher returns the AES-256-GCM cipher keyed with the HKDF-SHA256 of the X25519 shared secret.
+// The ephemeral and the recipient public keys salt the derivation.
+func contentCipher(priv, pub *[32]byte, ephemeralPub, recipientPub []byte) (cipher.AEAD, error) {
+	var shared [32]byte
+	curve25519.ScalarMult(&shared, priv, pub)
+	if utils.IsEmptyByteSlice(shared[:]) {
+		return nil, errors.NewTypedError(ErrInvalidSeal, errors.New("low order public key"))
+	}
+
+	key := make([]byte, 32)
+	kdf := hkdf.New(sha256.New, shared[:], append(append([]byte{}, ephemeralPub...), recipientPub...), []byte(sealLabel))
+	if _, err := io.ReadFull(kdf, key); err != nil {
+		return nil, errors.NewTypedError(ErrInvalidSeal, err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrInvalidSeal, err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// publicToX25519 converts the ed25519 public key to the X25519 public key of the same secret,
+// the Montgomery u-coordinate (1 + y) / (1 - y) of the Edwards point.
+func publicToX25519(pub ed25519.PublicKey) (x [32]byte, err error) {
+	if len(pub) != ed25519.PublicKeySize {
+		return x, errors.NewTypedError(ErrInvalidSeal, errors.New("invalid ed25519 public key"))
+	}
+
+	// the key is the little endian y-coordinate with the sign of x in the top bit
+	le := make([]byte, len(pub))
+	for i := range pub {
+		le[len(pub)-1-i] = pub[i]
+	}
+	le[0] &= 0x7f
+
+	y := new(big.Int).SetBytes(le)
+	den := new(big.Int).Sub(big.NewInt(1), y)
+	den.Mod(den, fieldPrime)
+	if den.Sign() == 0 {
+		return x, errors.NewTypedError(ErrInvalidSeal, errors.New("invalid ed25519 public key"))
+	}
+
+	u := new(big.Int).Add(big.NewInt(1), y)
+	u.Mul(u, den.ModInverse(den, fieldPrime))
+	u.Mod(u, fieldPrime)
+	be := u.Bytes()
+	for i := range be {
+		x[i] = be[len(be)-1-i]
+	}
+
+	return x, nil
+}
+
+// privateToX25519 converts the ed25519 private key to its X25519 private key, the clamped scalar of the seed.
+func privateToX25519(priv ed25519.PrivateKey) (x [32]byte) {
+	h := sha512.Sum512(priv[:ed25519.SeedSize])
+	copy(x[:], h[:32])
+	x[0] &= 248
+	x[31] &= 127
+	x[31] |= 64
+	return x
+}
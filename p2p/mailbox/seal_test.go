@@ -0,0 +1,71 @@
+// +build unit
+
+package mailbox
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestToX25519(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		pub, priv, err := ed25519.GenerateKey(nil)
+		assert.NoError(t, err)
+
+		// the converted private key derives the converted public key
+		x, err := publicToX25519(pub)
+		assert.NoError(t, err)
+		var derived [32]byte
+		xpriv := privateToX25519(priv)
+		curve25519.ScalarBaseMult(&derived, &xpriv)
+		assert.Equal(t, x, derived)
+	}
+
+	_, err := publicToX25519(utils.RandomSlice(31))
+	assert.True(t, errors.IsOfType(ErrInvalidSeal, err))
+}
+
+func TestSealOpen(t *testing.T) {
+	recipient := testingidentity.GenerateRandomDID()
+	recipientPub, recipientPriv, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	senderPub, senderPriv, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	envelope := utils.RandomSlice(128)
+
+	sealed, err := Seal(recipient, recipientPub, senderPriv, envelope)
+	assert.NoError(t, err)
+	assert.Equal(t, recipient[:], sealed.Recipient)
+	assert.Equal(t, []byte(senderPub), sealed.SenderKey)
+	assert.NotContains(t, string(sealed.Ciphertext), string(envelope))
+
+	opened, err := Open(recipientPriv, sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, envelope, opened)
+
+	// only the recipient can open the envelope
+	_, otherPriv, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	_, err = Open(otherPriv, sealed)
+	assert.True(t, errors.IsOfType(ErrInvalidSeal, err))
+
+	// the envelope can't be moved to another recipient
+	sealed.Recipient = utils.RandomSlice(20)
+	_, err = Open(recipientPriv, sealed)
+	assert.True(t, errors.IsOfType(ErrInvalidSeal, err))
+
+	// the envelope can't be altered without the key of the sender
+	sealed.Recipient = recipient[:]
+	sealed.Ciphertext[0] ^= 0xff
+	_, err = Open(recipientPriv, sealed)
+	assert.True(t, errors.IsOfType(ErrInvalidSeal, err))
+
+	_, err = Open(recipientPriv, nil)
+	assert.True(t, errors.IsOfType(ErrInvalidSeal, err))
+}
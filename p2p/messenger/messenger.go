@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
 	pb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
 	ggio "github.com/gogo/protobuf/io"
 	"github.com/golang/protobuf/proto"
@@ -22,7 +23,7 @@ import (
 const (
 
 	// MessageSizeMax is a soft maximum for network messages.
-	MessageSizeMax = 1 << 25 // 32 MB
+	MessageSizeMax = p2pcommon.MessageSizeMax
 
 	// ErrReadTimeout must be used when receiving timeout while reading
 	ErrReadTimeout = errors.Error("timed out reading response")
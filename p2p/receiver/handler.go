@@ -2,7 +2,9 @@ package receiver
 
 import (
 	"context"
+	"time"
 
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/errors"
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
 	"github.com/centrifuge/go-centrifuge/centerrors"
 	"github.com/centrifuge/go-centrifuge/code"
@@ -10,15 +12,26 @@ import (
 	"github.com/centrifuge/go-centrifuge/contextutil"
 	"github.com/centrifuge/go-centrifuge/documents"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identifiers"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/p2p/mailbox"
+	"github.com/centrifuge/go-centrifuge/p2p/traffic"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/history"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/notary"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/p2pext"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/proofrequest"
 	pb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
+	"github.com/centrifuge/go-centrifuge/utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/protobuf/proto"
+	logging "github.com/ipfs/go-log"
 	"github.com/libp2p/go-libp2p-peer"
 	"github.com/libp2p/go-libp2p-protocol"
 )
 
+var log = logging.Logger("p2p-receiver")
+
 // Handler implements protocol message handlers
 type Handler struct {
 	config             config.Service
@@ -26,6 +39,25 @@ type Handler struct {
 	docSrv             documents.Service
 	tokenRegistry      documents.TokenRegistry
 	srvDID             identity.ServiceDID
+
+	// receivePool processes the received anchored documents in the background.
+	// anchored documents are processed synchronously if nil.
+	receivePool *ReceivePool
+
+	// traffic records the messages exchanged with the collaborators.
+	traffic *traffic.Recorder
+
+	// sessions skips the validation of the handshakes validated before in the sessions with the collaborators.
+	// handshakes are validated on every message if nil.
+	sessions *p2pcommon.Sessions
+
+	// mailbox holds the envelopes deposited for the nodes using this node as their mailbox.
+	// deposits and drains are refused if nil.
+	mailbox *mailbox.Mailbox
+
+	// rateLimits refuses the messages of the collaborators sending more than their rate limit.
+	// messages are not limited if nil.
+	rateLimits RateLimits
 }
 
 // New returns an implementation of P2PServiceServer
@@ -34,13 +66,23 @@ func New(
 	handshakeValidator ValidatorGroup,
 	docSrv documents.Service,
 	tokenRegistry documents.TokenRegistry,
-	srvDID identity.ServiceDID) *Handler {
+	srvDID identity.ServiceDID,
+	receivePool *ReceivePool,
+	recorder *traffic.Recorder,
+	sessions *p2pcommon.Sessions,
+	mb *mailbox.Mailbox,
+	rateLimits RateLimits) *Handler {
 	return &Handler{
 		config:             config,
 		handshakeValidator: handshakeValidator,
 		docSrv:             docSrv,
 		tokenRegistry:      tokenRegistry,
 		srvDID:             srvDID,
+		receivePool:        receivePool,
+		traffic:            recorder,
+		sessions:           sessions,
+		mailbox:            mb,
+		rateLimits:         rateLimits,
 	}
 }
 
@@ -54,6 +96,13 @@ func (srv *Handler) HandleInterceptor(ctx context.Context, peer peer.ID, protoc
 		return convertToErrorEnvelop(err)
 	}
 
+	// throttle the peer before any lookup so that flooding peers cost as little as possible
+	mt := p2pcommon.MessageTypeFromString(envelope.Header.Type)
+	if ok, wait := srv.rateLimits.allowPeer(mt, peer); !ok {
+		log.Debugf("throttled %s from peer %s", envelope.Header.Type, peer.Pretty())
+		return throttledEnvelope(wait)
+	}
+
 	DID, err := p2pcommon.ExtractDID(protoc)
 	if err != nil {
 		return convertToErrorEnvelop(err)
@@ -68,12 +117,51 @@ func (srv *Handler) HandleInterceptor(ctx context.Context, peer peer.ID, protoc
 	if err != nil {
 		return convertToErrorEnvelop(err)
 	}
+
 	collaborator := identity.NewDIDFromBytes(envelope.Header.SenderId)
-	err = srv.handshakeValidator.Validate(envelope.Header, &collaborator, &peer)
+	err = srv.validateHandshake(envelope.Header, collaborator, peer)
 	if err != nil {
 		return convertToErrorEnvelop(err)
 	}
 
+	// the sender is authenticated by now. Incompatible versions fail the handshake, they are recorded from the
+	// responses of the collaborators instead, whose peers are resolved from their identities.
+	srv.traffic.SeenVersion(collaborator, envelope.Header.NodeVersion)
+
+	if ok, wait := srv.rateLimits.allowCollaborator(mt, collaborator); !ok {
+		log.Debugf("throttled %s from collaborator %s on peer %s", envelope.Header.Type, collaborator.String(), peer.Pretty())
+		return throttledEnvelope(wait)
+	}
+
+	srv.traffic.Received(collaborator, proto.Size(msg))
+	resp, err := srv.handle(ctx, peer, protoc, envelope)
+	if resp != nil {
+		srv.traffic.Sent(collaborator, proto.Size(resp))
+	}
+
+	return resp, err
+}
+
+// validateHandshake validates the handshake of the collaborator on the peer.
+// A handshake validated in the open session with the collaborator is not validated again, failed validations close the session.
+func (srv *Handler) validateHandshake(header *p2ppb.Header, collaborator identity.DID, peer peer.ID) error {
+	if srv.sessions.Validated(collaborator, peer, header) {
+		return nil
+	}
+
+	err := srv.handshakeValidator.Validate(header, &collaborator, &peer)
+	if err != nil {
+		// the sender id is not authenticated, only the session on the failing peer is closed
+		srv.sessions.CloseOnPeer(collaborator, peer)
+		return err
+	}
+
+	srv.sessions.SetValidated(collaborator, peer, header)
+	return nil
+}
+
+// handle routes the validated envelope to the handler of its message type.
+func (srv *Handler) handle(ctx context.Context, peer peer.ID, protoc protocol.ID, envelope *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
 	switch p2pcommon.MessageTypeFromString(envelope.Header.Type) {
 	case p2pcommon.MessageTypeRequestSignature:
 		return srv.HandleRequestDocumentSignature(ctx, peer, protoc, envelope)
@@ -81,10 +169,19 @@ func (srv *Handler) HandleInterceptor(ctx context.Context, peer peer.ID, protoc
 		return srv.HandleSendAnchoredDocument(ctx, peer, protoc, envelope)
 	case p2pcommon.MessageTypeGetDoc:
 		return srv.HandleGetDocument(ctx, peer, protoc, envelope)
+	case p2pcommon.MessageTypeRequestNotarySignature:
+		return srv.HandleRequestNotarySignature(ctx, peer, protoc, envelope)
+	case p2pcommon.MessageTypeRequestProofs:
+		return srv.HandleRequestProofs(ctx, peer, protoc, envelope)
+	case p2pcommon.MessageTypeGetDocHistory:
+		return srv.HandleGetDocumentHistory(ctx, peer, protoc, envelope)
+	case p2pcommon.MessageTypeMailboxDeposit:
+		return srv.HandleMailboxDeposit(ctx, peer, protoc, envelope)
+	case p2pcommon.MessageTypeMailboxDrain:
+		return srv.HandleMailboxDrain(ctx, peer, protoc, envelope)
 	default:
 		return convertToErrorEnvelop(errors.New("MessageType [%s] not found", envelope.Header.Type))
 	}
-
 }
 
 // HandleRequestDocumentSignature handles the RequestDocumentSignature message
@@ -114,7 +211,7 @@ func (srv *Handler) HandleRequestDocumentSignature(ctx context.Context, peer pee
 	return p2pEnv, nil
 }
 
-// RequestDocumentSignature signs the received document and returns the signature of the signingRoot
+// RequestDocumentSignature signs the received document and returns the signatures of the signingRoot
 // Document signing root will be recalculated and verified
 // Existing signatures on the document will be verified
 // Document will be stored to the repository for state management
@@ -128,12 +225,18 @@ func (srv *Handler) RequestDocumentSignature(ctx context.Context, sigReq *p2ppb.
 		return nil, errors.New("failed to derive from core doc: %v", err)
 	}
 
-	signature, err := srv.docSrv.RequestDocumentSignature(ctx, model, collaborator)
+	signatures, err := srv.docSrv.RequestDocumentSignature(ctx, model, collaborator)
 	if err != nil {
 		return nil, centerrors.New(code.Unknown, err.Error())
 	}
 
-	return &p2ppb.SignatureResponse{Signature: signature}, nil
+	// signature is still set for the nodes reading a single signature, the others read all of them from the extension
+	extData, err := proto.Marshal(&p2pextpb.SignatureResponseExtension{Signatures: signatures})
+	if err != nil {
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return &p2ppb.SignatureResponse{Signature: signatures[0], XXX_unrecognized: extData}, nil
 }
 
 // HandleSendAnchoredDocument handles the SendAnchoredDocument message
@@ -145,7 +248,29 @@ func (srv *Handler) HandleSendAnchoredDocument(ctx context.Context, peer peer.ID
 	}
 
 	collaborator := identity.NewDIDFromBytes(msg.Header.SenderId)
-	res, err := srv.SendAnchoredDocument(ctx, m, collaborator)
+	var res *p2ppb.AnchorDocumentResponse
+	if srv.receivePool == nil {
+		res, err = srv.SendAnchoredDocument(ctx, m, collaborator)
+	} else {
+		res, err = srv.sendAnchoredDocumentAsync(ctx, m, collaborator)
+	}
+
+	if errors.IsOfType(ErrReceiverBusy, err) {
+		return newErrorEnvelope(&errorspb.Error{
+			Code:    int32(code.ReceiverBusy),
+			Message: ErrReceiverBusy.Error(),
+			Errors:  map[string]string{RetryAfterKey: retryAfter.String()},
+		})
+	}
+
+	if errors.IsOfType(documents.ErrDocumentAnchorNotMined, err) {
+		return newErrorEnvelope(&errorspb.Error{Code: int32(code.AnchorNotMined), Message: err.Error()})
+	}
+
+	if errors.IsOfType(documents.ErrDocumentAnchorRootMismatch, err) {
+		return newErrorEnvelope(&errorspb.Error{Code: int32(code.AnchorRootMismatch), Message: err.Error()})
+	}
+
 	if err != nil {
 		return convertToErrorEnvelop(err)
 	}
@@ -176,9 +301,61 @@ func (srv *Handler) SendAnchoredDocument(ctx context.Context, docReq *p2ppb.Anch
 
 	err = srv.docSrv.ReceiveAnchoredDocument(ctx, model, collaborator)
 	if err != nil {
+		// anchor errors are returned as is so that the sender can tell them apart
+		if errors.IsOfType(documents.ErrDocumentAnchorNotMined, err) || errors.IsOfType(documents.ErrDocumentAnchorRootMismatch, err) {
+			return nil, err
+		}
+
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return &p2ppb.AnchorDocumentResponse{Accepted: true}, nil
+}
+
+// sendAnchoredDocumentAsync derives the model from the received anchored document, checks its anchor and
+// queues it for validation and storage. Returns ErrReceiverBusy when the receive pool is saturated.
+func (srv *Handler) sendAnchoredDocumentAsync(ctx context.Context, docReq *p2ppb.AnchorDocumentRequest, collaborator identity.DID) (*p2ppb.AnchorDocumentResponse, error) {
+	if docReq == nil || docReq.Document == nil {
+		return nil, errors.New("nil document provided")
+	}
+
+	model, err := srv.docSrv.DeriveFromCoreDocument(*docReq.Document)
+	if err != nil {
+		return nil, errors.New("failed to derive from core doc: %v", err)
+	}
+
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the anchor is checked before queueing so that the sender learns about missing or mismatching anchors
+	err = srv.docSrv.CheckAnchoredDocument(ctx, model, collaborator)
+	if err != nil {
+		if errors.IsOfType(documents.ErrDocumentAnchorNotMined, err) || errors.IsOfType(documents.ErrDocumentAnchorRootMismatch, err) {
+			return nil, err
+		}
+
 		return nil, centerrors.New(code.Unknown, err.Error())
 	}
 
+	err = srv.receivePool.Submit(func() {
+		// request context is done once the response is sent
+		ctx, err := contextutil.New(context.Background(), acc)
+		if err != nil {
+			log.Errorf("failed to create context for anchored document %x: %v", model.ID(), err)
+			return
+		}
+
+		err = srv.docSrv.ReceiveAnchoredDocument(ctx, model, collaborator)
+		if err != nil {
+			log.Errorf("failed to receive anchored document %x from %s: %v", model.ID(), collaborator.String(), err)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &p2ppb.AnchorDocumentResponse{Accepted: true}, nil
 }
 
@@ -212,12 +389,18 @@ func (srv *Handler) HandleGetDocument(ctx context.Context, peer peer.ID, protoc
 
 // GetDocument receives document identifier and retrieves the corresponding CoreDocument from the repository
 func (srv *Handler) GetDocument(ctx context.Context, docReq *p2ppb.GetDocumentRequest, requester identity.DID) (*p2ppb.GetDocumentResponse, error) {
+	err := identifiers.Validate(docReq.DocumentIdentifier, identifiers.DocumentIDLength)
+	if err != nil {
+		return nil, err
+	}
+
 	model, err := srv.docSrv.GetCurrentVersion(ctx, docReq.DocumentIdentifier)
 	if err != nil {
 		return nil, err
 	}
 
-	if srv.validateDocumentAccess(ctx, docReq, model, requester) != nil {
+	err = srv.validateDocumentAccess(ctx, docReq, model, requester)
+	if err != nil {
 		return nil, err
 	}
 
@@ -229,6 +412,198 @@ func (srv *Handler) GetDocument(ctx context.Context, docReq *p2ppb.GetDocumentRe
 	return &p2ppb.GetDocumentResponse{Document: &cd}, nil
 }
 
+// HandleRequestNotarySignature handles the RequestNotarySignature message
+func (srv *Handler) HandleRequestNotarySignature(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
+	m := new(notarypb.NotarySignatureRequest)
+	err := proto.Unmarshal(msg.Body, m)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	requester := identity.NewDIDFromBytes(msg.Header.SenderId)
+	res, err := srv.RequestNotarySignature(ctx, m, requester)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	nc, err := srv.config.GetConfig()
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	p2pEnv, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeRequestNotarySignatureRep, res)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	return p2pEnv, nil
+}
+
+// RequestNotarySignature validates the received proof bundle and returns the co-signature of the bundle
+func (srv *Handler) RequestNotarySignature(ctx context.Context, req *notarypb.NotarySignatureRequest, requester identity.DID) (*notarypb.NotarySignatureResponse, error) {
+	if req == nil || req.Bundle == nil {
+		return nil, errors.New("nil proof bundle provided")
+	}
+
+	sig, err := srv.docSrv.NotarizeProofBundle(ctx, documents.ConvertProofBundleToDocProof(req.Bundle), requester)
+	if err != nil {
+		return nil, centerrors.New(code.Unknown, err.Error())
+	}
+
+	return &notarypb.NotarySignatureResponse{Signature: sig}, nil
+}
+
+// HandleRequestProofs handles the RequestProofs message
+func (srv *Handler) HandleRequestProofs(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
+	m := new(proofrequestpb.ProofRequest)
+	err := proto.Unmarshal(msg.Body, m)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	requester := identity.NewDIDFromBytes(msg.Header.SenderId)
+	res, err := srv.RequestProofs(ctx, m, requester)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	nc, err := srv.config.GetConfig()
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	p2pEnv, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeRequestProofsRep, res)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	return p2pEnv, nil
+}
+
+// RequestProofs returns the field proofs of the latest document version if the access token of the request grants them
+func (srv *Handler) RequestProofs(ctx context.Context, req *proofrequestpb.ProofRequest, requester identity.DID) (*proofrequestpb.ProofResponse, error) {
+	if req == nil {
+		return nil, errors.New("nil proof request provided")
+	}
+
+	err := identifiers.Validate(req.DocumentIdentifier, identifiers.DocumentIDLength)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := srv.docSrv.GetCurrentVersion(ctx, req.DelegatingDocumentIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.ATGranteeCanRequestProofs(ctx, srv.srvDID, req.AccessTokenId, req.DocumentIdentifier, req.Fields, requester)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := srv.docSrv.CreateProofs(ctx, req.DocumentIdentifier, req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proofrequestpb.ProofResponse{
+		DocumentId:   proof.DocumentID,
+		VersionId:    proof.VersionID,
+		DocumentRoot: proof.DocumentRoot,
+		FieldProofs:  proof.FieldProofs,
+	}, nil
+}
+
+// HandleGetDocumentHistory handles the GetDocumentHistory message
+func (srv *Handler) HandleGetDocumentHistory(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
+	m := new(historypb.HistoryRequest)
+	err := proto.Unmarshal(msg.Body, m)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	requester := identity.NewDIDFromBytes(msg.Header.SenderId)
+	res, err := srv.GetDocumentHistory(ctx, m, requester)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	nc, err := srv.config.GetConfig()
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	p2pEnv, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeGetDocHistoryRep, res)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	return p2pEnv, nil
+}
+
+// GetDocumentHistory returns the anchored roots of the locally known versions preceding the requested version.
+// The prior versions are shared in full if the requester can read them, or if the node shares the history with
+// the collaborators of the later versions.
+func (srv *Handler) GetDocumentHistory(ctx context.Context, req *historypb.HistoryRequest, requester identity.DID) (*historypb.HistoryResponse, error) {
+	if req == nil {
+		return nil, errors.New("nil history request provided")
+	}
+
+	err := identifiers.Validate(req.DocumentIdentifier, identifiers.DocumentIDLength)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := srv.docSrv.GetVersion(ctx, req.DocumentIdentifier, req.VersionIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.AccountCanRead(requester) {
+		return nil, errors.New("requester does not have access")
+	}
+
+	nc, err := srv.config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(historypb.HistoryResponse)
+	prev := m.PreviousVersion()
+	for !utils.IsEmptyByteSlice(prev) {
+		v, err := srv.docSrv.GetVersion(ctx, req.DocumentIdentifier, prev)
+		if err != nil {
+			// the versions before the node joined the document are not known
+			break
+		}
+
+		root, err := v.CalculateDocumentRoot()
+		if err != nil {
+			return nil, err
+		}
+
+		prev = v.PreviousVersion()
+		res.Roots = append(res.Roots, &historypb.VersionRoot{
+			VersionIdentifier: v.CurrentVersion(),
+			PreviousVersion:   prev,
+			DocumentRoot:      root,
+		})
+
+		if !nc.GetShareDocumentHistory() && !v.AccountCanRead(requester) {
+			continue
+		}
+
+		cd, err := v.PackCoreDocument()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Documents = append(res.Documents, &cd)
+	}
+
+	return res, nil
+}
+
 // validateDocumentAccess validates the GetDocument request against the AccessType indicated in the request
 func (srv *Handler) validateDocumentAccess(ctx context.Context, docReq *p2ppb.GetDocumentRequest, m documents.Model, peer identity.DID) error {
 	// checks which access type is relevant for the request
@@ -268,6 +643,21 @@ func convertToErrorEnvelop(err error) (*pb.P2PEnvelope, error) {
 	if !ok {
 		return nil, err
 	}
+
+	return newErrorEnvelope(errPb)
+}
+
+// throttledEnvelope returns the error envelope asking the sender to retry after the wait.
+func throttledEnvelope(wait time.Duration) (*pb.P2PEnvelope, error) {
+	return newErrorEnvelope(&errorspb.Error{
+		Code:    int32(code.Throttled),
+		Message: ErrThrottled.Error(),
+		Errors:  map[string]string{RetryAfterKey: wait.String()},
+	})
+}
+
+// newErrorEnvelope wraps the protobuf error into an error envelope for the client
+func newErrorEnvelope(errPb proto.Message) (*pb.P2PEnvelope, error) {
 	errBytes, err := proto.Marshal(errPb)
 	if err != nil {
 		return nil, err
@@ -58,7 +58,7 @@ func TestMain(m *testing.M) {
 	anchorRepo = ctx[anchors.BootstrappedAnchorRepo].(anchors.AnchorRepository)
 	idService = ctx[identity.BootstrappedDIDService].(identity.ServiceDID)
 	idFactory = ctx[identity.BootstrappedDIDFactory].(identity.Factory)
-	handler = receiver.New(cfgService, receiver.HandshakeValidator(cfg.GetNetworkID(), idService), docSrv, new(testingdocuments.MockRegistry), idService)
+	handler = receiver.New(cfgService, receiver.HandshakeValidator(cfg.GetNetworkID(), idService, nil), docSrv, new(testingdocuments.MockRegistry), idService, nil, nil, nil, nil, nil)
 	defaultDID = createIdentity(&testing.T{})
 	result := m.Run()
 	testingbootstrap.TestFunctionalEthereumTearDown()
@@ -158,11 +158,10 @@ func TestHandler_SendAnchoredDocument_update_fail(t *testing.T) {
 	docRootTyped, err := anchors.ToDocumentRoot(cd.DocumentRoot)
 	assert.NoError(t, err)
 
-	anchorConfirmations, err := anchorRepo.CommitAnchor(ctx, anchorIDTyped, docRootTyped, [][anchors.DocumentProofLength]byte{utils.RandomByte32()})
+	f, err := anchorRepo.CommitAnchor(ctx, anchorIDTyped, docRootTyped, [][anchors.DocumentProofLength]byte{utils.RandomByte32()})
 	assert.Nil(t, err)
 
-	watchCommittedAnchor := <-anchorConfirmations
-	assert.True(t, watchCommittedAnchor, "No error should be thrown by context")
+	assert.NoError(t, f.Result(0))
 
 	anchorResp, err := handler.SendAnchoredDocument(ctx, &p2ppb.AnchorDocumentRequest{Document: &cd}, accDID)
 	assert.Error(t, err)
@@ -208,11 +207,10 @@ func TestHandler_SendAnchoredDocument(t *testing.T) {
 	docRootTyped, err := anchors.ToDocumentRoot(po.Document.DocumentRoot)
 	assert.NoError(t, err)
 
-	anchorConfirmations, err := anchorRepo.CommitAnchor(ctxh, anchorIDTyped, docRootTyped, [][anchors.DocumentProofLength]byte{utils.RandomByte32()})
+	f, err := anchorRepo.CommitAnchor(ctxh, anchorIDTyped, docRootTyped, [][anchors.DocumentProofLength]byte{utils.RandomByte32()})
 	assert.Nil(t, err)
 
-	watchCommittedAnchor := <-anchorConfirmations
-	assert.True(t, watchCommittedAnchor, "No error should be thrown by context")
+	assert.NoError(t, f.Result(0))
 	cd, err = po.PackCoreDocument()
 	assert.NoError(t, err)
 
@@ -238,11 +236,10 @@ func TestHandler_SendAnchoredDocument(t *testing.T) {
 	assert.NoError(t, err)
 	docRootTyped, err = anchors.ToDocumentRoot(npo.Document.DocumentRoot)
 	assert.NoError(t, err)
-	anchorConfirmations, err = anchorRepo.CommitAnchor(ctxh, anchorIDTyped, docRootTyped, [][anchors.DocumentProofLength]byte{utils.RandomByte32()})
+	f, err = anchorRepo.CommitAnchor(ctxh, anchorIDTyped, docRootTyped, [][anchors.DocumentProofLength]byte{utils.RandomByte32()})
 	assert.Nil(t, err)
 
-	watchCommittedAnchor = <-anchorConfirmations
-	assert.True(t, watchCommittedAnchor, "No error should be thrown by context")
+	assert.NoError(t, f.Result(0))
 	ncd, err = npo.PackCoreDocument()
 	assert.NoError(t, err)
 
@@ -328,7 +325,7 @@ func prepareDocumentForP2PHandler(t *testing.T, po *purchaseorder.PurchaseOrder)
 }
 
 func updateDocumentForP2Phandler(t *testing.T, po *purchaseorder.PurchaseOrder) (*purchaseorder.PurchaseOrder, coredocumentpb.CoreDocument) {
-	cd, err := po.CoreDocument.PrepareNewVersion(nil, true, nil)
+	cd, err := po.CoreDocument.PrepareNewVersion(documents.CollaboratorsAccess{}, true, nil)
 	assert.NoError(t, err)
 	po.CoreDocument = cd
 	return prepareDocumentForP2PHandler(t, po)
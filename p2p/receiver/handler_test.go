@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
 	"github.com/centrifuge/go-centrifuge/anchors"
 	"github.com/centrifuge/go-centrifuge/bootstrap"
@@ -18,8 +19,13 @@ import (
 	"github.com/centrifuge/go-centrifuge/documents"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/ethereum"
+	"github.com/centrifuge/go-centrifuge/identifiers"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/p2p/traffic"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/history"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/notary"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/proofrequest"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
 	"github.com/centrifuge/go-centrifuge/queue"
 	"github.com/centrifuge/go-centrifuge/storage/leveldb"
@@ -69,11 +75,11 @@ func TestMain(m *testing.M) {
 	cfg = ctx[bootstrap.BootstrappedConfig].(config.Configuration)
 	cfgService := ctx[config.BootstrappedConfigStorage].(config.Service)
 	registry = ctx[documents.BootstrappedRegistry].(*documents.ServiceRegistry)
-	docSrv := documents.DefaultService(nil, nil, registry, mockIDService)
+	docSrv := documents.DefaultService(nil, nil, registry, mockIDService, 0, "", 0, nil, nil, nil, nil, nil)
 	_, pub, _ := crypto.GenerateEd25519Key(rand.Reader)
 	defaultPID, _ = libp2pPeer.IDFromPublicKey(pub)
 	mockIDService.On("ValidateKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
-	handler = New(cfgService, HandshakeValidator(cfg.GetNetworkID(), mockIDService), docSrv, new(testingdocuments.MockRegistry), mockIDService)
+	handler = New(cfgService, HandshakeValidator(cfg.GetNetworkID(), mockIDService, nil), docSrv, new(testingdocuments.MockRegistry), mockIDService, nil, nil, nil, nil, nil)
 	result := m.Run()
 	bootstrap.RunTestTeardown(ibootstappers)
 	os.Exit(result)
@@ -87,6 +93,87 @@ func TestHandler_RequestDocumentSignature_nilDocument(t *testing.T) {
 	assert.Nil(t, resp, "must be nil")
 }
 
+func TestHandler_RequestNotarySignature_nilBundle(t *testing.T) {
+	id := testingidentity.GenerateRandomDID()
+	resp, err := handler.RequestNotarySignature(context.Background(), &notarypb.NotarySignatureRequest{}, id)
+	assert.Error(t, err, "must return error")
+	assert.Nil(t, resp, "must be nil")
+}
+
+func TestHandler_RequestProofs_invalidRequest(t *testing.T) {
+	id := testingidentity.GenerateRandomDID()
+	resp, err := handler.RequestProofs(context.Background(), nil, id)
+	assert.Error(t, err, "must return error")
+	assert.Nil(t, resp, "must be nil")
+
+	resp, err = handler.RequestProofs(context.Background(), &proofrequestpb.ProofRequest{DocumentIdentifier: []byte{1}}, id)
+	assert.Error(t, err, "must return error")
+	assert.Nil(t, resp, "must be nil")
+}
+
+type historyModel struct {
+	documents.Model
+	id, version, prev []byte
+	readers           []identity.DID
+}
+
+func (m *historyModel) ID() []byte                             { return m.id }
+func (m *historyModel) CurrentVersion() []byte                 { return m.version }
+func (m *historyModel) PreviousVersion() []byte                { return m.prev }
+func (m *historyModel) CalculateDocumentRoot() ([]byte, error) { return m.version, nil }
+
+func (m *historyModel) AccountCanRead(account identity.DID) bool {
+	for _, r := range m.readers {
+		if r.Equal(account) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *historyModel) PackCoreDocument() (coredocumentpb.CoreDocument, error) {
+	return coredocumentpb.CoreDocument{DocumentIdentifier: m.id, CurrentVersion: m.version}, nil
+}
+
+func TestHandler_GetDocumentHistory(t *testing.T) {
+	requester := testingidentity.GenerateRandomDID()
+	id := utils.RandomSlice(32)
+	v1 := &historyModel{id: id, version: id}
+	v2 := &historyModel{id: id, version: utils.RandomSlice(32), prev: v1.version, readers: []identity.DID{requester}}
+	v3 := &historyModel{id: id, version: utils.RandomSlice(32), prev: v2.version, readers: []identity.DID{requester}}
+	docSrv := new(testingdocuments.MockService)
+	h := New(handler.config, handler.handshakeValidator, docSrv, nil, nil, nil, nil, nil, nil, nil)
+
+	// nil request
+	_, err := h.GetDocumentHistory(context.Background(), nil, requester)
+	assert.Error(t, err)
+
+	// invalid document identifier
+	_, err = h.GetDocumentHistory(context.Background(), &historypb.HistoryRequest{DocumentIdentifier: []byte{1}}, requester)
+	assert.Error(t, err)
+
+	// no access to the version
+	req := &historypb.HistoryRequest{DocumentIdentifier: id, VersionIdentifier: v3.version}
+	docSrv.On("GetVersion", id, v3.version).Return(v3, nil)
+	_, err = h.GetDocumentHistory(context.Background(), req, testingidentity.GenerateRandomDID())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "requester does not have access")
+
+	// only the roots of the versions the requester can't read are shared
+	docSrv.On("GetVersion", id, v2.version).Return(v2, nil)
+	docSrv.On("GetVersion", id, v1.version).Return(v1, nil)
+	res, err := h.GetDocumentHistory(context.Background(), req, requester)
+	assert.NoError(t, err)
+	assert.Equal(t, []*historypb.VersionRoot{
+		{VersionIdentifier: v2.version, PreviousVersion: v1.version, DocumentRoot: v2.version},
+		{VersionIdentifier: v1.version, DocumentRoot: v1.version},
+	}, res.Roots)
+	assert.Len(t, res.Documents, 1)
+	assert.Equal(t, v2.version, res.Documents[0].CurrentVersion)
+	docSrv.AssertExpectations(t)
+}
+
 func TestHandler_HandleInterceptor_nilPayload(t *testing.T) {
 	resp, err := handler.HandleInterceptor(context.Background(), libp2pPeer.ID("SomePeer"), protocol.ID("protocolX"), nil)
 	assert.Error(t, err, "must return error")
@@ -107,7 +194,7 @@ func TestHandler_HandleInterceptor_CentIDNotHex(t *testing.T) {
 	assert.NoError(t, err)
 	resp, err := handler.HandleInterceptor(context.Background(), libp2pPeer.ID("SomePeer"), protocol.ID("protocolX"), p2pEnv)
 	assert.Error(t, err, "must return error")
-	assert.Equal(t, err, identity.ErrMalformedAddress)
+	assert.True(t, errors.IsOfType(identifiers.ErrInvalidHex, err))
 	assert.Nil(t, resp, "must be nil")
 }
 
@@ -149,6 +236,62 @@ func TestHandler_HandleInterceptor_HandshakeValidationFail(t *testing.T) {
 	assert.Nil(t, resp, "must be nil")
 }
 
+func TestHandler_HandleInterceptor_HandshakeValidationFail_versionNotSeen(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	p2pEnv, err := p2pcommon.PrepareP2PEnvelope(ctx, cfg.GetNetworkID(), p2pcommon.MessageTypeRequestSignature, &protocolpb.P2PEnvelope{})
+	assert.NoError(t, err)
+
+	dataEnv, _ := p2pcommon.ResolveDataEnvelope(p2pEnv)
+	dataEnv.Header.NodeVersion = "incompatible"
+	marshalledRequest, err := proto.Marshal(dataEnv)
+	assert.NoError(t, err)
+	p2pEnv = &protocolpb.P2PEnvelope{Body: marshalledRequest}
+
+	recorder := traffic.NewRecorder()
+	h := New(handler.config, handler.handshakeValidator, nil, nil, nil, nil, recorder, nil, nil, nil)
+	id, _ := cfg.GetIdentityID()
+	resp, err := h.HandleInterceptor(context.Background(), defaultPID, protocol.ID(hexutil.Encode(id)), p2pEnv)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Incompatible version")
+	assert.Nil(t, resp)
+
+	// the sender is not authenticated, nothing is recorded for it
+	_, ok := recorder.StatsFor(identity.NewDIDFromBytes(dataEnv.Header.SenderId))
+	assert.False(t, ok)
+}
+
+func TestHandler_HandleInterceptor_HandshakeValidationFail_sessions(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	p2pEnv, err := p2pcommon.PrepareP2PEnvelope(ctx, cfg.GetNetworkID(), p2pcommon.MessageTypeRequestSignature, &protocolpb.P2PEnvelope{})
+	assert.NoError(t, err)
+
+	dataEnv, _ := p2pcommon.ResolveDataEnvelope(p2pEnv)
+	dataEnv.Header.NodeVersion = "incompatible"
+	marshalledRequest, err := proto.Marshal(dataEnv)
+	assert.NoError(t, err)
+	p2pEnv = &protocolpb.P2PEnvelope{Body: marshalledRequest}
+
+	collaborator := identity.NewDIDFromBytes(dataEnv.Header.SenderId)
+	sessions := p2pcommon.NewSessions(time.Hour)
+	sessions.Open(collaborator, defaultPID)
+	h := New(handler.config, handler.handshakeValidator, nil, nil, nil, nil, nil, sessions, nil, nil)
+	id, _ := cfg.GetIdentityID()
+
+	// another peer claiming to be the collaborator doesn't close its session
+	_, pub, _ := crypto.GenerateEd25519Key(rand.Reader)
+	otherPID, _ := libp2pPeer.IDFromPublicKey(pub)
+	_, err = h.HandleInterceptor(context.Background(), otherPID, protocol.ID(hexutil.Encode(id)), p2pEnv)
+	assert.Error(t, err)
+	_, ok := sessions.Peer(collaborator)
+	assert.True(t, ok)
+
+	// failing on the peer of the session closes it
+	_, err = h.HandleInterceptor(context.Background(), defaultPID, protocol.ID(hexutil.Encode(id)), p2pEnv)
+	assert.Error(t, err)
+	_, ok = sessions.Peer(collaborator)
+	assert.False(t, ok)
+}
+
 func TestHandler_HandleInterceptor_UnsupportedMessageType(t *testing.T) {
 	ctx := testingconfig.CreateAccountContext(t, cfg)
 	p2pEnv, err := p2pcommon.PrepareP2PEnvelope(ctx, cfg.GetNetworkID(), p2pcommon.MessageTypeRequestSignature, &protocolpb.P2PEnvelope{})
@@ -182,7 +325,7 @@ func TestHandler_HandleInterceptor_NilDocument(t *testing.T) {
 
 func TestHandler_HandleInterceptor_getServiceAndModel_fail(t *testing.T) {
 	ctx := testingconfig.CreateAccountContext(t, cfg)
-	cd, err := documents.NewCoreDocumentWithCollaborators(nil, nil)
+	cd, err := documents.NewCoreDocumentWithCollaborators(documents.CollaboratorsAccess{}, nil)
 	assert.NoError(t, err)
 	req := &p2ppb.AnchorDocumentRequest{Document: &cd.Document}
 	p2pEnv, err := p2pcommon.PrepareP2PEnvelope(ctx, cfg.GetNetworkID(), p2pcommon.MessageTypeSendAnchoredDoc, req)
@@ -220,7 +363,7 @@ func TestP2PService_basicChecks(t *testing.T) {
 	id, _ := cfg.GetIdentityID()
 	centID := identity.NewDIDFromBytes(id)
 	for _, c := range tests {
-		err := HandshakeValidator(cfg.GetNetworkID(), mockIDService).Validate(c.header, &centID, &defaultPID)
+		err := HandshakeValidator(cfg.GetNetworkID(), mockIDService, nil).Validate(c.header, &centID, &defaultPID)
 		if err != nil {
 			if c.err == nil {
 				t.Fatalf("unexpected error: %v\n", err)
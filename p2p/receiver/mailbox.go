@@ -0,0 +1,142 @@
+package receiver
+
+import (
+	"context"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/crypto/ed25519"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/mailbox"
+	pb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-peer"
+	"github.com/libp2p/go-libp2p-protocol"
+)
+
+// ErrMailboxDisabled must be used when the node doesn't hold envelopes for other nodes.
+const ErrMailboxDisabled = errors.Error("node doesn't serve as a mailbox")
+
+// HandleMailboxDeposit handles the MailboxDeposit message
+func (srv *Handler) HandleMailboxDeposit(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
+	m := new(mailboxpb.DepositRequest)
+	err := proto.Unmarshal(msg.Body, m)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	sender := identity.NewDIDFromBytes(msg.Header.SenderId)
+	res, err := srv.DepositEnvelope(ctx, m, sender)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	nc, err := srv.config.GetConfig()
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	p2pEnv, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeMailboxDepositRep, res)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	return p2pEnv, nil
+}
+
+// DepositEnvelope holds the sealed envelope of the sender until its recipient drains the mailbox.
+func (srv *Handler) DepositEnvelope(ctx context.Context, req *mailboxpb.DepositRequest, sender identity.DID) (*mailboxpb.DepositResponse, error) {
+	if srv.mailbox == nil {
+		return nil, ErrMailboxDisabled
+	}
+
+	if req == nil || req.Envelope == nil {
+		return nil, errors.New("nil sealed envelope provided")
+	}
+
+	err := srv.mailbox.Deposit(sender, req.Envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mailboxpb.DepositResponse{Accepted: true}, nil
+}
+
+// HandleMailboxDrain handles the MailboxDrain message
+func (srv *Handler) HandleMailboxDrain(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
+	m := new(mailboxpb.DrainRequest)
+	err := proto.Unmarshal(msg.Body, m)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	requester := identity.NewDIDFromBytes(msg.Header.SenderId)
+	res, err := srv.DrainMailbox(ctx, m, requester)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	nc, err := srv.config.GetConfig()
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	p2pEnv, err := p2pcommon.PrepareP2PEnvelope(ctx, nc.GetNetworkID(), p2pcommon.MessageTypeMailboxDrainRep, res)
+	if err != nil {
+		return convertToErrorEnvelop(err)
+	}
+
+	return p2pEnv, nil
+}
+
+// DrainMailbox returns the envelopes deposited for the requester once the acknowledged deposits are dropped.
+// The requester is registered with the mailbox on the first drain.
+func (srv *Handler) DrainMailbox(ctx context.Context, req *mailboxpb.DrainRequest, requester identity.DID) (*mailboxpb.DrainResponse, error) {
+	if srv.mailbox == nil {
+		return nil, ErrMailboxDisabled
+	}
+
+	if req == nil {
+		return nil, errors.New("nil drain request provided")
+	}
+
+	return srv.mailbox.Drain(requester, req.Acknowledged)
+}
+
+// ReceiveDeposited handles an envelope drained from the mailbox of the account of the context.
+// The sender is validated against the p2p key the envelope was sealed with, as it is against the peer of a direct message.
+// Only anchored documents are deposited: their senders don't wait for a response.
+func (srv *Handler) ReceiveDeposited(ctx context.Context, senderKey []byte, data []byte) error {
+	envelope, err := p2pcommon.ResolveDataEnvelope(&pb.P2PEnvelope{Body: data})
+	if err != nil {
+		return err
+	}
+
+	var key [32]byte
+	copy(key[:], senderKey)
+	pid, err := ed25519.PublicKeyToP2PKey(key)
+	if err != nil {
+		return err
+	}
+
+	collaborator := identity.NewDIDFromBytes(envelope.Header.SenderId)
+	err = srv.handshakeValidator.Validate(envelope.Header, &collaborator, &pid)
+	if err != nil {
+		return err
+	}
+
+	if !p2pcommon.MessageTypeSendAnchoredDoc.Equals(envelope.Header.Type) {
+		return errors.New("MessageType [%s] can't be deposited", envelope.Header.Type)
+	}
+
+	m := new(p2ppb.AnchorDocumentRequest)
+	err = proto.Unmarshal(envelope.Body, m)
+	if err != nil {
+		return err
+	}
+
+	srv.traffic.Received(collaborator, len(data))
+	_, err = srv.SendAnchoredDocument(ctx, m, collaborator)
+	return err
+}
@@ -0,0 +1,82 @@
+// +build unit
+
+package receiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/p2p/mailbox"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/mailbox"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestHandler_DepositDrain(t *testing.T) {
+	sender := testingidentity.GenerateRandomDID()
+	recipient := testingidentity.GenerateRandomDID()
+	sealed := &mailboxpb.SealedEnvelope{Recipient: recipient[:], Ciphertext: utils.RandomSlice(32)}
+
+	// the node doesn't serve as a mailbox
+	_, err := handler.DepositEnvelope(context.Background(), &mailboxpb.DepositRequest{Envelope: sealed}, sender)
+	assert.True(t, errors.IsOfType(ErrMailboxDisabled, err))
+	_, err = handler.DrainMailbox(context.Background(), new(mailboxpb.DrainRequest), recipient)
+	assert.True(t, errors.IsOfType(ErrMailboxDisabled, err))
+
+	db, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	h := New(handler.config, handler.handshakeValidator, nil, nil, nil, nil, nil, nil, mailbox.New(leveldb.NewLevelDBRepository(db)), nil)
+
+	// nil requests
+	_, err = h.DepositEnvelope(context.Background(), &mailboxpb.DepositRequest{}, sender)
+	assert.Error(t, err)
+	_, err = h.DrainMailbox(context.Background(), nil, recipient)
+	assert.Error(t, err)
+
+	// the recipient must drain the mailbox before envelopes are deposited for it
+	_, err = h.DepositEnvelope(context.Background(), &mailboxpb.DepositRequest{Envelope: sealed}, sender)
+	assert.True(t, errors.IsOfType(mailbox.ErrNotRegistered, err))
+	resp, err := h.DrainMailbox(context.Background(), new(mailboxpb.DrainRequest), recipient)
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Deposits)
+
+	res, err := h.DepositEnvelope(context.Background(), &mailboxpb.DepositRequest{Envelope: sealed}, sender)
+	assert.NoError(t, err)
+	assert.True(t, res.Accepted)
+	resp, err = h.DrainMailbox(context.Background(), new(mailboxpb.DrainRequest), recipient)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Deposits, 1)
+	assert.Equal(t, sender[:], resp.Deposits[0].Sender)
+	assert.Equal(t, sealed.Ciphertext, resp.Deposits[0].Envelope.Ciphertext)
+}
+
+func TestHandler_ReceiveDeposited(t *testing.T) {
+	ctxh := testingconfig.CreateAccountContext(t, cfg)
+	senderKey, _, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+
+	// invalid envelope
+	err = handler.ReceiveDeposited(ctxh, senderKey, utils.RandomSlice(32))
+	assert.Error(t, err)
+
+	// only anchored documents are deposited
+	envelope, err := p2pcommon.PrepareP2PEnvelope(ctxh, cfg.GetNetworkID(), p2pcommon.MessageTypeGetDoc, &p2ppb.GetDocumentRequest{})
+	assert.NoError(t, err)
+	err = handler.ReceiveDeposited(ctxh, senderKey, envelope.Body)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "can't be deposited")
+
+	// the anchored document is received as if it was sent directly
+	envelope, err = p2pcommon.PrepareP2PEnvelope(ctxh, cfg.GetNetworkID(), p2pcommon.MessageTypeSendAnchoredDoc, &p2ppb.AnchorDocumentRequest{})
+	assert.NoError(t, err)
+	err = handler.ReceiveDeposited(ctxh, senderKey, envelope.Body)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "nil document provided")
+}
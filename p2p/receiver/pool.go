@@ -0,0 +1,75 @@
+package receiver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+)
+
+const (
+	// ErrReceiverBusy must be used when the receiver can't accept more requests for processing
+	ErrReceiverBusy = errors.Error("receiver is busy, retry later")
+
+	// RetryAfterKey is the key in the error details holding the duration after which the peer can retry
+	RetryAfterKey = "retry_after"
+
+	// retryAfter is the duration after which the peer is asked to retry when the receiver is busy
+	retryAfter = 5 * time.Second
+)
+
+// ReceivePool processes the received requests in the background using a bounded number of workers.
+// Requests wait in a bounded queue until a worker is available and are rejected once the queue is full.
+type ReceivePool struct {
+	workers int
+	jobs    chan func()
+}
+
+// NewReceivePool returns a ReceivePool with the given number of workers and queue size.
+func NewReceivePool(workers, queueSize int) *ReceivePool {
+	if workers < 1 {
+		workers = 1
+	}
+
+	if queueSize < 0 {
+		queueSize = 0
+	}
+
+	return &ReceivePool{
+		workers: workers,
+		jobs:    make(chan func(), queueSize),
+	}
+}
+
+// Start starts the workers and blocks until the context is done.
+func (p *ReceivePool) Start(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < p.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-p.jobs:
+					job()
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// Submit queues the job for processing.
+// Returns ErrReceiverBusy when the queue is full.
+func (p *ReceivePool) Submit(job func()) error {
+	select {
+	case p.jobs <- job:
+		return nil
+	default:
+		return ErrReceiverBusy
+	}
+}
@@ -0,0 +1,139 @@
+// +build unit
+
+package receiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/errors"
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/code"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReceivePool_Submit(t *testing.T) {
+	pool := NewReceivePool(1, 1)
+	done := make(chan bool)
+	assert.NoError(t, pool.Submit(func() { done <- true }))
+
+	// queue is full
+	assert.Equal(t, ErrReceiverBusy, pool.Submit(func() {}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go pool.Start(ctx)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job not processed")
+	}
+
+	// worker is free again
+	assert.NoError(t, pool.Submit(func() { done <- true }))
+	<-done
+	cancel()
+}
+
+func TestHandler_HandleSendAnchoredDocument_async(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	cd, err := documents.NewCoreDocumentWithCollaborators(documents.CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	req := &p2ppb.AnchorDocumentRequest{Document: &cd.Document}
+	body, err := proto.Marshal(req)
+	assert.NoError(t, err)
+	collaborator := testingidentity.GenerateRandomDID()
+	msg := &p2ppb.Envelope{Header: &p2ppb.Header{SenderId: collaborator[:]}, Body: body}
+
+	docSrv := new(testingdocuments.MockService)
+	model := new(testingdocuments.MockModel)
+	docSrv.On("DeriveFromCoreDocument", mock.Anything).Return(model, nil)
+	docSrv.On("CheckAnchoredDocument").Return(nil)
+	received := make(chan bool)
+	docSrv.On("ReceiveAnchoredDocument").Return(nil).Run(func(args mock.Arguments) { received <- true }).Once()
+	pool := NewReceivePool(1, 0)
+	h := New(handler.config, handler.handshakeValidator, docSrv, nil, nil, pool, nil, nil, nil, nil)
+
+	// no worker available
+	resp, err := h.HandleSendAnchoredDocument(ctx, defaultPID, "", msg)
+	assert.NoError(t, err)
+	env, err := p2pcommon.ResolveDataEnvelope(resp)
+	assert.NoError(t, err)
+	assert.Equal(t, p2pcommon.MessageTypeError.String(), env.Header.Type)
+	errPb := new(errorspb.Error)
+	assert.NoError(t, proto.Unmarshal(env.Body, errPb))
+	assert.Equal(t, code.ReceiverBusy, code.To(errPb.Code))
+	assert.Equal(t, retryAfter.String(), errPb.Errors[RetryAfterKey])
+
+	// processed in the background
+	cctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go pool.Start(cctx)
+	// wait for the worker to be ready
+	for i := 0; i < 100; i++ {
+		resp, err = h.HandleSendAnchoredDocument(ctx, defaultPID, "", msg)
+		assert.NoError(t, err)
+		env, err = p2pcommon.ResolveDataEnvelope(resp)
+		assert.NoError(t, err)
+		if p2pcommon.MessageTypeSendAnchoredDocRep.Equals(env.Header.Type) {
+			break
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	res := new(p2ppb.AnchorDocumentResponse)
+	assert.NoError(t, proto.Unmarshal(env.Body, res))
+	assert.True(t, res.Accepted)
+	<-received
+	docSrv.AssertExpectations(t)
+}
+
+func TestHandler_HandleSendAnchoredDocument_asyncAnchorErrors(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	cd, err := documents.NewCoreDocumentWithCollaborators(documents.CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	req := &p2ppb.AnchorDocumentRequest{Document: &cd.Document}
+	body, err := proto.Marshal(req)
+	assert.NoError(t, err)
+	collaborator := testingidentity.GenerateRandomDID()
+	msg := &p2ppb.Envelope{Header: &p2ppb.Header{SenderId: collaborator[:]}, Body: body}
+
+	tests := []struct {
+		err  error
+		code code.Code
+	}{
+		{errors.NewTypedError(documents.ErrDocumentAnchorNotMined, errors.New("anchor not found")), code.AnchorNotMined},
+		{errors.NewTypedError(documents.ErrDocumentAnchorRootMismatch, errors.New("different root")), code.AnchorRootMismatch},
+	}
+
+	for _, c := range tests {
+		docSrv := new(testingdocuments.MockService)
+		model := new(testingdocuments.MockModel)
+		docSrv.On("DeriveFromCoreDocument", mock.Anything).Return(model, nil)
+		docSrv.On("CheckAnchoredDocument").Return(c.err).Once()
+		pool := NewReceivePool(1, 1)
+		h := New(handler.config, handler.handshakeValidator, docSrv, nil, nil, pool, nil, nil, nil, nil)
+
+		resp, err := h.HandleSendAnchoredDocument(ctx, defaultPID, "", msg)
+		assert.NoError(t, err)
+		env, err := p2pcommon.ResolveDataEnvelope(resp)
+		assert.NoError(t, err)
+		assert.Equal(t, p2pcommon.MessageTypeError.String(), env.Header.Type)
+		errPb := new(errorspb.Error)
+		assert.NoError(t, proto.Unmarshal(env.Body, errPb))
+		assert.Equal(t, c.code, code.To(errPb.Code))
+
+		// nothing queued
+		assert.Len(t, pool.jobs, 0)
+		docSrv.AssertExpectations(t)
+	}
+}
@@ -0,0 +1,68 @@
+package receiver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/ratelimit"
+	"github.com/libp2p/go-libp2p-peer"
+)
+
+// ErrThrottled must be used when a collaborator sends more messages than its rate limit allows
+const ErrThrottled = errors.Error("too many messages, retry later")
+
+// rateLimitedMessages are the names of the rate limits of the received message types in the config.
+var rateLimitedMessages = map[p2pcommon.MessageType]string{
+	p2pcommon.MessageTypeRequestSignature:       "requestSignature",
+	p2pcommon.MessageTypeSendAnchoredDoc:        "sendAnchoredDocument",
+	p2pcommon.MessageTypeGetDoc:                 "getDocument",
+	p2pcommon.MessageTypeRequestNotarySignature: "requestNotarySignature",
+	p2pcommon.MessageTypeRequestProofs:          "requestProofs",
+	p2pcommon.MessageTypeGetDocHistory:          "getDocumentHistory",
+	p2pcommon.MessageTypeMailboxDeposit:         "mailboxDeposit",
+	p2pcommon.MessageTypeMailboxDrain:           "mailboxDrain",
+}
+
+// RateLimits holds the limiters of the received messages per peer and per collaborator, per message type.
+// Message types without a limiter are not limited, a nil RateLimits doesn't limit any message.
+type RateLimits map[p2pcommon.MessageType]*ratelimit.Limiter
+
+// NewRateLimits returns the limiters of the message types from their limits in the config.
+func NewRateLimits(limits map[string]ratelimit.Limit) RateLimits {
+	// config keys are case insensitive
+	byName := make(map[string]ratelimit.Limit)
+	for name, l := range limits {
+		byName[strings.ToLower(name)] = l
+	}
+
+	rl := make(RateLimits)
+	for mt, name := range rateLimitedMessages {
+		l, ok := byName[strings.ToLower(name)]
+		if !ok || l.Rate <= 0 {
+			continue
+		}
+
+		rl[mt] = ratelimit.New(l)
+	}
+
+	return rl
+}
+
+// allowPeer returns true if the peer can send a message of the type.
+// Returns false and the wait until the peer can send the message otherwise.
+// Peers are limited before the handshake is validated, as the peer ID is authenticated by the transport while the
+// sender DID in the header is not.
+func (rl RateLimits) allowPeer(mt p2pcommon.MessageType, peer peer.ID) (bool, time.Duration) {
+	return rl[mt].Allow(fmt.Sprintf("peer/%s", peer.Pretty()))
+}
+
+// allowCollaborator returns true if the collaborator can send a message of the type, across all its peers.
+// Returns false and the wait until the collaborator can send the message otherwise.
+// Collaborators are limited once their handshake is validated, so that a peer can't exhaust the limit of another collaborator.
+func (rl RateLimits) allowCollaborator(mt p2pcommon.MessageType, collaborator identity.DID) (bool, time.Duration) {
+	return rl[mt].Allow(fmt.Sprintf("did/%s", collaborator.String()))
+}
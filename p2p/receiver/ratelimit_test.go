@@ -0,0 +1,127 @@
+// +build unit
+
+package receiver
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/errors"
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/code"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/notary"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
+	"github.com/centrifuge/go-centrifuge/ratelimit"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-crypto"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
+	"github.com/libp2p/go-libp2p-protocol"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRateLimits(t *testing.T) {
+	rl := NewRateLimits(map[string]ratelimit.Limit{
+		"getdocument":        {Rate: 1, Burst: 1},
+		"requestSignature":   {Rate: 0, Burst: 10},
+		"unknownMessage":     {Rate: 1, Burst: 1},
+		"GetDocumentHistory": {Rate: 2, Burst: 2},
+	})
+
+	// keys are case insensitive, no limiter without a rate
+	assert.Len(t, rl, 2)
+	assert.NotNil(t, rl[p2pcommon.MessageTypeGetDoc])
+	assert.NotNil(t, rl[p2pcommon.MessageTypeGetDocHistory])
+	assert.Nil(t, rl[p2pcommon.MessageTypeRequestSignature])
+}
+
+func TestHandler_HandleInterceptor_throttled(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	rl := NewRateLimits(map[string]ratelimit.Limit{"getDocument": {Rate: 0.001, Burst: 1}})
+	h := New(handler.config, handler.handshakeValidator, nil, nil, nil, nil, nil, nil, nil, rl)
+	send := func(mt p2pcommon.MessageType, peer libp2pPeer.ID) (*p2ppb.Envelope, error) {
+		env, err := p2pcommon.PrepareP2PEnvelope(ctx, cfg.GetNetworkID(), mt, &p2ppb.GetDocumentRequest{})
+		assert.NoError(t, err)
+		resp, err := h.HandleInterceptor(context.Background(), peer, protocol.ID("protocolX"), env)
+		if resp == nil {
+			return nil, err
+		}
+
+		recv, err := p2pcommon.ResolveDataEnvelope(resp)
+		assert.NoError(t, err)
+		return recv, nil
+	}
+
+	// allowed through the limiter, fails on the protocol
+	recv, err := send(p2pcommon.MessageTypeGetDoc, "peer")
+	assert.Error(t, err)
+	assert.Nil(t, recv)
+
+	recv, err = send(p2pcommon.MessageTypeGetDoc, "peer")
+	assert.NoError(t, err)
+	assert.Equal(t, p2pcommon.MessageTypeError.String(), recv.Header.Type)
+	errPb := new(errorspb.Error)
+	assert.NoError(t, proto.Unmarshal(recv.Body, errPb))
+	assert.Equal(t, int32(code.Throttled), errPb.Code)
+	assert.Equal(t, ErrThrottled.Error(), errPb.Message)
+	wait, err := time.ParseDuration(errPb.Errors[RetryAfterKey])
+	assert.NoError(t, err)
+	assert.True(t, wait > 0)
+
+	// other peers and message types are not throttled
+	recv, err = send(p2pcommon.MessageTypeGetDoc, "other peer")
+	assert.Error(t, err)
+	assert.Nil(t, recv)
+	recv, err = send(p2pcommon.MessageTypeGetDocHistory, "peer")
+	assert.Error(t, err)
+	assert.Nil(t, recv)
+}
+
+func TestHandler_HandleInterceptor_throttledCollaborator(t *testing.T) {
+	ctx := testingconfig.CreateAccountContext(t, cfg)
+	rl := NewRateLimits(map[string]ratelimit.Limit{
+		"requestNotarySignature": {Rate: 0.001, Burst: 1},
+		"requestProofs":          {Rate: 0.001, Burst: 1},
+	})
+	h := New(handler.config, handler.handshakeValidator, handler.docSrv, nil, nil, nil, nil, nil, nil, rl)
+	id, err := cfg.GetIdentityID()
+	assert.NoError(t, err)
+	throttled := func(mt p2pcommon.MessageType, peer libp2pPeer.ID, nodeVersion string) bool {
+		env, err := p2pcommon.PrepareP2PEnvelope(ctx, cfg.GetNetworkID(), mt, &notarypb.NotarySignatureRequest{})
+		assert.NoError(t, err)
+		if nodeVersion != "" {
+			dataEnv, err := p2pcommon.ResolveDataEnvelope(env)
+			assert.NoError(t, err)
+			dataEnv.Header.NodeVersion = nodeVersion
+			body, err := proto.Marshal(dataEnv)
+			assert.NoError(t, err)
+			env = &protocolpb.P2PEnvelope{Body: body}
+		}
+
+		resp, _ := h.HandleInterceptor(context.Background(), peer, protocol.ID(hexutil.Encode(id)), env)
+		if resp == nil {
+			return false
+		}
+
+		recv, err := p2pcommon.ResolveDataEnvelope(resp)
+		assert.NoError(t, err)
+		errPb := new(errorspb.Error)
+		return p2pcommon.MessageTypeError.Equals(recv.Header.Type) && proto.Unmarshal(recv.Body, errPb) == nil && errPb.Code == int32(code.Throttled)
+	}
+
+	// the collaborator is limited across its peers once validated
+	assert.False(t, throttled(p2pcommon.MessageTypeRequestNotarySignature, defaultPID, ""))
+	_, pub, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	otherPID, err := libp2pPeer.IDFromPublicKey(pub)
+	assert.NoError(t, err)
+	assert.True(t, throttled(p2pcommon.MessageTypeRequestNotarySignature, otherPID, ""))
+
+	// messages failing the handshake don't count against the claimed collaborator
+	assert.False(t, throttled(p2pcommon.MessageTypeRequestProofs, libp2pPeer.ID("attacker"), "incompatible"))
+	assert.False(t, throttled(p2pcommon.MessageTypeRequestProofs, defaultPID, ""))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/code"
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
 	"github.com/centrifuge/go-centrifuge/version"
 	libp2pPeer "github.com/libp2p/go-libp2p-peer"
 )
@@ -93,12 +94,27 @@ func peerValidator(idService identity.ServiceDID) Validator {
 	})
 }
 
+// pinnedPeerValidator rejects the peers not matching the pinned peer of the DID.
+func pinnedPeerValidator(pins p2pcommon.PinnedPeers) Validator {
+	return ValidatorFunc(func(header *p2ppb.Header, centID *identity.DID, peerID *libp2pPeer.ID) error {
+		if centID == nil {
+			return errors.New("nil centID")
+		}
+		if peerID == nil {
+			return errors.New("nil peerID")
+		}
+
+		return pins.Check(*centID, *peerID)
+	})
+}
+
 // HandshakeValidator validates the p2p handshake details
-func HandshakeValidator(networkID uint32, idService identity.ServiceDID) ValidatorGroup {
+func HandshakeValidator(networkID uint32, idService identity.ServiceDID, pins p2pcommon.PinnedPeers) ValidatorGroup {
 	return ValidatorGroup{
 		versionValidator(),
 		networkValidator(networkID),
 		peerValidator(idService),
+		pinnedPeerValidator(pins),
 	}
 }
 
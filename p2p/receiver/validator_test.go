@@ -9,6 +9,8 @@ import (
 	"github.com/centrifuge/go-centrifuge/utils"
 
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
 	"github.com/centrifuge/go-centrifuge/errors"
@@ -107,11 +109,39 @@ func TestValidate_peerValidator(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestValidate_pinnedPeerValidator(t *testing.T) {
+	cID := identity.NewDIDFromBytes(id1)
+	pv := pinnedPeerValidator(p2pcommon.PinnedPeers{cID: defaultPID})
+
+	// Nil centID
+	err := pv.Validate(nil, nil, &defaultPID)
+	assert.Error(t, err)
+
+	// Nil peerID
+	err = pv.Validate(nil, &cID, nil)
+	assert.Error(t, err)
+
+	// Other peer
+	otherPID := libp2pPeer.ID("other")
+	err = pv.Validate(nil, &cID, &otherPID)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "doesn't match the pinned peer")
+
+	// DID not pinned
+	otherID := identity.NewDIDFromBytes(utils.RandomSlice(identity.DIDLength))
+	err = pv.Validate(nil, &otherID, &otherPID)
+	assert.NoError(t, err)
+
+	// Success
+	err = pv.Validate(nil, &cID, &defaultPID)
+	assert.NoError(t, err)
+}
+
 func TestValidate_handshakeValidator(t *testing.T) {
 	cID := identity.NewDIDFromBytes(id1)
 
 	idService := &testingcommons.MockIdentityService{}
-	hv := HandshakeValidator(cfg.GetNetworkID(), idService)
+	hv := HandshakeValidator(cfg.GetNetworkID(), idService, nil)
 	tm, err := utils.ToTimestamp(time.Now())
 	assert.NoError(t, err)
 
@@ -0,0 +1,121 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/ipfs/go-ipfs-addr"
+	"github.com/libp2p/go-libp2p"
+	circuit "github.com/libp2p/go-libp2p-circuit"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
+	pstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// ErrInvalidRelay must be used when a configured relay is not a valid multiaddress of a peer.
+const ErrInvalidRelay = errors.Error("invalid p2p relay")
+
+// relayReconnectInterval is the interval the connections to the relays are checked and reopened at.
+const relayReconnectInterval = time.Minute
+
+// relayOptions returns the options of the circuit relay transport. The hop option relays the connections of other nodes.
+// libp2p only adds the relay transport when it is enabled, so a disabled relay needs no option.
+func relayOptions(enabled, hop bool) []libp2p.Option {
+	if !enabled {
+		return nil
+	}
+
+	var opts []circuit.RelayOpt
+	if hop {
+		opts = append(opts, circuit.OptHop)
+	}
+
+	return []libp2p.Option{libp2p.EnableRelay(opts...)}
+}
+
+// parseRelays parses the multiaddresses of the relays, each including the ID of the relay peer.
+func parseRelays(nodes []string) ([]pstore.PeerInfo, error) {
+	var relays []pstore.PeerInfo
+	for _, node := range nodes {
+		iaddr, err := ipfsaddr.ParseString(node)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrInvalidRelay, errors.New("%s: %v", node, err))
+		}
+
+		pinfo, err := pstore.InfoFromP2pAddr(iaddr.Multiaddr())
+		if err != nil {
+			return nil, errors.NewTypedError(ErrInvalidRelay, errors.New("%s: %v", node, err))
+		}
+
+		relays = append(relays, *pinfo)
+	}
+
+	return relays, nil
+}
+
+// circuitAddrs returns the addresses of the node through the relays.
+func circuitAddrs(relays []pstore.PeerInfo) ([]ma.Multiaddr, error) {
+	var addrs []ma.Multiaddr
+	for _, relay := range relays {
+		circuitAddr, err := ma.NewMultiaddr(fmt.Sprintf("/ipfs/%s/p2p-circuit", relay.ID.Pretty()))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, addr := range relay.Addrs {
+			addrs = append(addrs, addr.Encapsulate(circuitAddr))
+		}
+	}
+
+	return addrs, nil
+}
+
+// addRelayAddrs adds the addresses of the peer through the relays of the node to the peer store, so that a peer that
+// can't be dialed directly is dialed through the relays it shares with the node.
+func (s *peer) addRelayAddrs(pid libp2pPeer.ID) {
+	for _, relay := range s.relays {
+		addr, err := ma.NewMultiaddr(fmt.Sprintf("/ipfs/%s/p2p-circuit", relay.ID.Pretty()))
+		if err != nil {
+			log.Warningf("failed to create the circuit address through %s: %v", relay.ID.Pretty(), err)
+			continue
+		}
+
+		s.host.Peerstore().AddAddr(pid, addr, pstore.TempAddrTTL)
+	}
+}
+
+// connectRelays keeps the connections to the relays open until the context is done,
+// so that the peers can reach the node through the relays at any time.
+func (s *peer) connectRelays(ctx context.Context) {
+	if len(s.relays) == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(relayReconnectInterval)
+	defer ticker.Stop()
+	for {
+		for _, relay := range s.relays {
+			if len(s.host.Network().ConnsToPeer(relay.ID)) > 0 {
+				continue
+			}
+
+			tctx, cancel := context.WithTimeout(ctx, time.Second*10)
+			err := s.host.Connect(tctx, relay)
+			cancel()
+			if err != nil {
+				log.Warningf("failed to connect to relay %s: %v", relay.ID.Pretty(), err)
+				continue
+			}
+
+			log.Infof("Connected to relay %s", relay.ID.Pretty())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
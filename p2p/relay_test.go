@@ -0,0 +1,76 @@
+// +build unit
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/crypto"
+	"github.com/centrifuge/go-centrifuge/errors"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	relayA = "QmTQxbwkuZYYDfuzTbxEAReTNCLozyy558vQngVvPMjLYk"
+	relayB = "QmVf6EN6mkqWejWKW2qPu16XpdG3kJo1T3mhahPB5Se5n1"
+)
+
+func TestRelayOptions(t *testing.T) {
+	assert.Len(t, relayOptions(false, true), 0)
+	assert.Len(t, relayOptions(true, false), 1)
+	assert.Len(t, relayOptions(true, true), 1)
+}
+
+func TestParseRelays(t *testing.T) {
+	relays, err := parseRelays(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, relays)
+
+	relays, err = parseRelays([]string{"/ip4/1.2.3.4/tcp/38202/ipfs/" + relayA, "/dns4/relay.example.com/tcp/38202/ipfs/" + relayB})
+	assert.NoError(t, err)
+	assert.Len(t, relays, 2)
+	assert.Equal(t, relayA, relays[0].ID.Pretty())
+	assert.Equal(t, multiaddrs(t, "/ip4/1.2.3.4/tcp/38202"), relays[0].Addrs)
+	assert.Equal(t, relayB, relays[1].ID.Pretty())
+
+	// peer ID missing
+	_, err = parseRelays([]string{"/ip4/1.2.3.4/tcp/38202"})
+	assert.True(t, errors.IsOfType(ErrInvalidRelay, err))
+
+	_, err = parseRelays([]string{"1.2.3.4:38202"})
+	assert.True(t, errors.IsOfType(ErrInvalidRelay, err))
+}
+
+func TestCircuitAddrs(t *testing.T) {
+	addrs, err := circuitAddrs(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, addrs)
+
+	relays, err := parseRelays([]string{"/ip4/1.2.3.4/tcp/38202/ipfs/" + relayA, "/ip4/5.6.7.8/tcp/38202/ipfs/" + relayB})
+	assert.NoError(t, err)
+	addrs, err = circuitAddrs(relays)
+	assert.NoError(t, err)
+	assert.Equal(t, multiaddrs(t,
+		"/ip4/1.2.3.4/tcp/38202/ipfs/"+relayA+"/p2p-circuit",
+		"/ip4/5.6.7.8/tcp/38202/ipfs/"+relayB+"/p2p-circuit"), addrs)
+}
+
+func TestPeer_addRelayAddrs(t *testing.T) {
+	c, err := cfg.GetConfig()
+	assert.NoError(t, err)
+	c = updateKeys(c)
+	priv, pub, err := crypto.ObtainP2PKeypair(c.GetP2PKeyPair())
+	assert.NoError(t, err)
+	relays, err := parseRelays([]string{"/ip4/1.2.3.4/tcp/38202/ipfs/" + relayA})
+	assert.NoError(t, err)
+	h, err := makeBasicHost(priv, pub, newAddresses(multiaddrs(t, "/ip4/127.0.0.1/tcp/38312"), nil, nil), relayOptions(true, false)...)
+	assert.NoError(t, err)
+	defer h.Close()
+	p := &peer{host: h, relays: relays}
+
+	pid, err := libp2pPeer.IDB58Decode(relayB)
+	assert.NoError(t, err)
+	p.addRelayAddrs(pid)
+	assert.Equal(t, multiaddrs(t, "/ipfs/"+relayA+"/p2p-circuit"), h.Peerstore().Addrs(pid))
+}
@@ -0,0 +1,106 @@
+package p2p
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
+)
+
+// ErrCircuitOpen must be used when the envelope is not sent since the recent sends to the collaborator failed.
+const ErrCircuitOpen = errors.Error("circuit open")
+
+// circuitBreaker stops the sends to the collaborators that were unreachable for a number of consecutive sends,
+// so that the sends to them fail at once instead of waiting for the connection timeout.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	failures map[identity.DID]int
+	openedAt map[identity.DID]time.Time
+}
+
+// newCircuitBreaker returns a circuit breaker opening after threshold consecutive failures for the cooldown.
+// The circuit breaker is disabled if the threshold is not positive.
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		failures:  make(map[identity.DID]int),
+		openedAt:  make(map[identity.DID]time.Time),
+	}
+}
+
+// allow returns an error if the circuit of the collaborator is open.
+// Once the cooldown passes, a single send probes the collaborator and the circuit stays open for the others.
+func (c *circuitBreaker) allow(collaborator identity.DID) error {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	openedAt, ok := c.openedAt[collaborator]
+	if !ok {
+		return nil
+	}
+
+	now := time.Now()
+	if until := openedAt.Add(c.cooldown); now.Before(until) {
+		return errors.NewTypedError(ErrPeerUnreachable, errors.NewTypedError(ErrCircuitOpen, errors.New("%s until %s", collaborator.String(), until.UTC().Format(time.RFC3339))))
+	}
+
+	c.openedAt[collaborator] = now
+	return nil
+}
+
+// record closes the circuit of the collaborator if the send succeeded, and counts the failure if the collaborator was
+// unreachable. Other errors mean the collaborator was reached.
+func (c *circuitBreaker) record(collaborator identity.DID, err error) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil || !errors.IsOfType(ErrPeerUnreachable, err) {
+		delete(c.failures, collaborator)
+		delete(c.openedAt, collaborator)
+		return
+	}
+
+	c.failures[collaborator]++
+	if c.failures[collaborator] >= c.threshold {
+		log.Warningf("opening the circuit of %s for %s after %d failed sends", collaborator.String(), c.cooldown, c.failures[collaborator])
+		c.openedAt[collaborator] = time.Now()
+	}
+}
+
+// send sends the envelope to the remote collaborator, retrying with backoff while the collaborator can't be reached.
+// The retries stop once the context is done. Envelopes to collaborators with an open circuit are not sent.
+func (s *peer) send(ctx context.Context, collaborator identity.DID, envelope *protocolpb.P2PEnvelope) (*p2ppb.Envelope, error) {
+	err := s.breaker.allow(collaborator)
+	if err != nil {
+		return nil, err
+	}
+
+	var recv *p2ppb.Envelope
+	err = s.retries.Do(ctx, func(attempt int) (err error) {
+		recv, err = s.deliver(ctx, collaborator, envelope)
+		return err
+	}, func(err error) bool {
+		return errors.IsOfType(ErrPeerUnreachable, err)
+	})
+
+	s.breaker.record(collaborator, err)
+	return recv, err
+}
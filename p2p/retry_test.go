@@ -0,0 +1,96 @@
+// +build unit
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/p2p/common"
+	"github.com/centrifuge/go-centrifuge/retry"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	// disabled
+	var c *circuitBreaker
+	assert.Nil(t, newCircuitBreaker(0, time.Minute))
+	c.record(did, ErrPeerUnreachable)
+	assert.NoError(t, c.allow(did))
+
+	c = newCircuitBreaker(2, time.Hour)
+	did2 := testingidentity.GenerateRandomDID()
+	c.record(did, ErrPeerUnreachable)
+	assert.NoError(t, c.allow(did))
+
+	// reached collaborators reset the failures
+	c.record(did, errors.New("invalid response"))
+	c.record(did, ErrPeerUnreachable)
+	assert.NoError(t, c.allow(did))
+
+	// open after consecutive failures
+	c.record(did, ErrPeerUnreachable)
+	err := c.allow(did)
+	assert.True(t, errors.IsOfType(ErrCircuitOpen, err))
+	assert.True(t, errors.IsOfType(ErrPeerUnreachable, err))
+	assert.NoError(t, c.allow(did2))
+
+	// a single probe once the cooldown passed
+	c.openedAt[did] = time.Now().Add(-2 * time.Hour)
+	assert.NoError(t, c.allow(did))
+	assert.True(t, errors.IsOfType(ErrCircuitOpen, c.allow(did)))
+
+	// failed probe opens the circuit again
+	c.record(did, ErrPeerUnreachable)
+	assert.True(t, errors.IsOfType(ErrCircuitOpen, c.allow(did)))
+
+	// successful probe closes the circuit
+	c.openedAt[did] = time.Now().Add(-2 * time.Hour)
+	assert.NoError(t, c.allow(did))
+	c.record(did, nil)
+	assert.NoError(t, c.allow(did))
+	assert.Empty(t, c.failures)
+}
+
+func TestPeer_send_retries(t *testing.T) {
+	c, err := cfg.GetConfig()
+	assert.NoError(t, err)
+	c = updateKeys(c)
+	ctx := testingconfig.CreateAccountContext(t, c)
+	idService := getIDMocks(ctx, did)
+	m := &MockMessenger{}
+	s := &peer{config: cfg, idService: idService, mes: m, disablePeerStore: true, retries: retry.New(retry.ClassP2P, retry.Policy{Attempts: 3, Backoff: time.Millisecond}), breaker: newCircuitBreaker(3, time.Hour)}
+	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, c.GetNetworkID(), p2pcommon.MessageTypeRequestSignature, &p2ppb.SignatureRequest{})
+	assert.NoError(t, err)
+	resp := s.createSignatureResp(version.GetVersion().String(), nil)
+
+	// reached on the second attempt
+	m.On("SendMessage", ctx, mock.Anything, envelope, p2pcommon.ProtocolForDID(&did)).Return(nil, errors.New("dial failed")).Once()
+	m.On("SendMessage", ctx, mock.Anything, envelope, p2pcommon.ProtocolForDID(&did)).Return(resp, nil).Once()
+	recv, err := s.send(ctx, did, envelope)
+	assert.NoError(t, err)
+	assert.True(t, p2pcommon.MessageTypeRequestSignatureRep.Equals(recv.Header.Type))
+
+	// unreachable for every attempt opens the circuit
+	m.On("SendMessage", ctx, mock.Anything, envelope, p2pcommon.ProtocolForDID(&did)).Return(nil, errors.New("dial failed")).Times(3)
+	_, err = s.send(ctx, did, envelope)
+	assert.True(t, errors.IsOfType(ErrPeerUnreachable, err))
+	assert.False(t, errors.IsOfType(ErrCircuitOpen, err))
+	m.On("SendMessage", ctx, mock.Anything, envelope, p2pcommon.ProtocolForDID(&did)).Return(nil, errors.New("dial failed")).Times(6)
+	_, err = s.send(ctx, did, envelope)
+	assert.True(t, errors.IsOfType(ErrPeerUnreachable, err))
+	_, err = s.send(ctx, did, envelope)
+	assert.True(t, errors.IsOfType(ErrPeerUnreachable, err))
+
+	// no message sent while the circuit is open
+	_, err = s.send(ctx, did, envelope)
+	assert.True(t, errors.IsOfType(ErrCircuitOpen, err))
+	m.AssertExpectations(t)
+	m.AssertNumberOfCalls(t, "SendMessage", 11)
+}
@@ -9,12 +9,14 @@ import (
 	"github.com/centrifuge/go-centrifuge/config"
 	crypto2 "github.com/centrifuge/go-centrifuge/crypto"
 	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/faults"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/p2p/common"
 	ms "github.com/centrifuge/go-centrifuge/p2p/messenger"
 	"github.com/centrifuge/go-centrifuge/p2p/receiver"
+	"github.com/centrifuge/go-centrifuge/p2p/traffic"
 	pb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/protocol"
-	"github.com/ipfs/go-cid"
+	"github.com/centrifuge/go-centrifuge/retry"
 	ds "github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-ipfs-addr"
 	logging "github.com/ipfs/go-log"
@@ -26,7 +28,6 @@ import (
 	pstore "github.com/libp2p/go-libp2p-peerstore"
 	"github.com/libp2p/go-libp2p-protocol"
 	ma "github.com/multiformats/go-multiaddr"
-	mh "github.com/multiformats/go-multihash"
 )
 
 var log = logging.Logger("p2p-server")
@@ -46,9 +47,37 @@ type peer struct {
 	disablePeerStore bool
 	config           config.Service
 	idService        identity.ServiceDID
+	pins             p2pcommon.PinnedPeers
 	host             host.Host
 	handlerCreator   func() *receiver.Handler
+	receivePool      *receiver.ReceivePool
 	mes              messenger
+	traffic          *traffic.Recorder
+	faults           *faults.Injector
+
+	// endpoints rate limits the refreshes of the p2p endpoints of unreachable collaborators, nil disables the refresh
+	endpoints *endpointRefresher
+
+	// sessions caches the peers of the remote collaborators, shared with the handlers. nil disables the sessions
+	sessions *p2pcommon.Sessions
+
+	// retries of the envelopes to unreachable collaborators, nil disables the retries
+	retries *retry.Retrier
+
+	// breaker stops the sends to collaborators that were unreachable for consecutive sends, nil disables it
+	breaker *circuitBreaker
+
+	// conns keeps the connections to the recent collaborators open and trims the others, nil disables it
+	conns *connPool
+
+	// addrs are the addresses the host listens on and advertises, set once the host starts
+	addrs *addresses
+
+	// dht announces the node to the peers, set once the host starts
+	dht *dht.IpfsDHT
+
+	// relays the node is reachable through and dials the peers through
+	relays []pstore.PeerInfo
 }
 
 // Name returns the P2PServer
@@ -78,21 +107,47 @@ func (s *peer) Start(ctx context.Context, wg *sync.WaitGroup, startupErr chan<-
 		startupErr <- err
 		return
 	}
-	s.host, err = makeBasicHost(priv, pub, nc.GetP2PExternalIP(), nc.GetP2PPort())
+	listenAddrs, externalAddrs, err := parseAddresses(nc.GetP2PListenAddresses(), nc.GetP2PExternalAddresses(), nc.GetP2PExternalIP(), nc.GetP2PPort())
 	if err != nil {
 		startupErr <- err
 		return
 	}
 
-	s.mes = ms.NewP2PMessenger(ctx, s.host, nc.GetP2PConnectionTimeout(), s.handlerCreator().HandleInterceptor)
+	circuits, err := circuitAddrs(s.relays)
+	if err != nil {
+		startupErr <- err
+		return
+	}
+
+	s.addrs = newAddresses(listenAddrs, externalAddrs, circuits)
+	s.host, err = makeBasicHost(priv, pub, s.addrs, relayOptions(nc.GetP2PRelayEnabled(), nc.GetP2PRelayHop())...)
+	if err != nil {
+		startupErr <- err
+		return
+	}
+
+	if s.receivePool != nil {
+		go s.receivePool.Start(ctx)
+	}
+
+	handler := s.handlerCreator().HandleInterceptor
+	s.mes = ms.NewP2PMessenger(ctx, s.host, nc.GetP2PConnectionTimeout(), func(ctx context.Context, pid libp2pPeer.ID, protoc protocol.ID, msg *pb.P2PEnvelope) (*pb.P2PEnvelope, error) {
+		s.conns.received(pid)
+		return handler(ctx, pid, protoc, msg)
+	})
 	err = s.initProtocols()
 	if err != nil {
 		startupErr <- err
 		return
 	}
 
+	go s.drainMailbox(ctx)
+	go s.connectRelays(ctx)
+	go s.maintainConnections(ctx)
+
 	// Start DHT and properly ignore errors :)
-	_ = runDHT(ctx, s.host, nc.GetBootstrapPeers())
+	s.dht = dht.NewDHT(ctx, s.host, ds.NewMapDatastore())
+	_ = runDHT(ctx, s.host, s.dht, nc.GetBootstrapPeers())
 	<-ctx.Done()
 
 }
@@ -120,8 +175,8 @@ func (s *peer) InitProtocolForDID(DID *identity.DID) {
 	s.mes.Init(p)
 }
 
-// makeBasicHost creates a LibP2P host with a peer ID listening on the given port
-func makeBasicHost(priv crypto.PrivKey, pub crypto.PubKey, externalIP string, listenPort int) (host.Host, error) {
+// makeBasicHost creates a LibP2P host with a peer ID listening on the listen addresses and advertising the external ones
+func makeBasicHost(priv crypto.PrivKey, pub crypto.PubKey, addrs *addresses, extra ...libp2p.Option) (host.Host, error) {
 	// Obtain Peer ID from public key
 	// We should be using the following method to get the ID, but looks like is not compatible with
 	// secio when adding the pub and pvt keys, fail as id+pub/pvt key is checked to match and method defaults to
@@ -149,30 +204,17 @@ func makeBasicHost(priv crypto.PrivKey, pub crypto.PubKey, externalIP string, li
 		return nil, err
 	}
 
-	var extMultiAddr ma.Multiaddr
-	if externalIP == "" {
-		log.Warning("External IP not defined, Peers might not be able to resolve this node if behind NAT\n")
-	} else {
-		extMultiAddr, err = ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", externalIP, listenPort))
-		if err != nil {
-			return nil, errors.New("failed to create multiaddr: %v", err)
-		}
-	}
-
-	addressFactory := func(addrs []ma.Multiaddr) []ma.Multiaddr {
-		if extMultiAddr != nil {
-			// We currently support a single protocol and transport, if we add more to support then we will need to adapt this code
-			addrs = []ma.Multiaddr{extMultiAddr}
-		}
-		return addrs
+	if len(addrs.external) == 0 {
+		log.Warning("External addresses not defined, Peers might not be able to resolve this node if behind NAT\n")
 	}
 
 	opts := []libp2p.Option{
-		libp2p.ListenAddrStrings(fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", listenPort)),
+		libp2p.ListenAddrs(addrs.listen...),
 		libp2p.Identity(priv),
 		libp2p.DefaultMuxers,
-		libp2p.AddrsFactory(addressFactory),
+		libp2p.AddrsFactory(addrs.factory),
 	}
+	opts = append(opts, extra...)
 
 	bhost, err := libp2p.New(context.Background(), opts...)
 	if err != nil {
@@ -188,9 +230,7 @@ func makeBasicHost(priv crypto.PrivKey, pub crypto.PubKey, externalIP string, li
 	return bhost, nil
 }
 
-func runDHT(ctx context.Context, h host.Host, bootstrapPeers []string) error {
-	// Run it as a Bootstrap Node
-	dhtClient := dht.NewDHT(ctx, h, ds.NewMapDatastore())
+func runDHT(ctx context.Context, h host.Host, dhtClient *dht.IpfsDHT, bootstrapPeers []string) error {
 	log.Infof("Bootstrapping %s\n", bootstrapPeers)
 
 	for _, addr := range bootstrapPeers {
@@ -201,21 +241,18 @@ func runDHT(ctx context.Context, h host.Host, bootstrapPeers []string) error {
 		}
 	}
 
-	// Using the sha256 of our "topic" as our rendezvous value
-	cidPref, _ := cid.NewPrefixV1(cid.Raw, mh.SHA2_256).Sum([]byte("centrifuge-dht"))
+	cidPref, _ := rendezvous.Sum([]byte(rendezvousTopic))
 
 	// First, announce ourselves as participating in this topic
 	log.Info("Announcing ourselves...")
-	tctx, cancel := context.WithTimeout(ctx, time.Second*10)
-	if err := dhtClient.Provide(tctx, cidPref, true); err != nil {
+	if err := announce(ctx, dhtClient); err != nil {
 		// Important to keep this as Non-Fatal error, otherwise it will fail for a node that behaves as well as bootstrap one
 		log.Infof("Error: %s\n", err.Error())
 	}
-	cancel()
 
 	// Now, look for others who have announced
 	log.Info("Searching for other peers ...")
-	tctx, cancel = context.WithTimeout(ctx, time.Second*10)
+	tctx, cancel := context.WithTimeout(ctx, time.Second*10)
 	peers, err := dhtClient.FindProviders(tctx, cidPref)
 	if err != nil {
 		log.Error(err)
@@ -17,6 +17,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/config/configstore"
 	"github.com/centrifuge/go-centrifuge/crypto"
 	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/ethereum"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/p2p/receiver"
@@ -69,7 +70,7 @@ func TestCentP2PServer_StartContextCancel(t *testing.T) {
 	cfgMock := mockmockConfigStore(n)
 	assert.NoError(t, err)
 	cp2p := &peer{config: cfgMock, handlerCreator: func() *receiver.Handler {
-		return receiver.New(cfgMock, receiver.HandshakeValidator(n.NetworkID, idService), nil, new(testingdocuments.MockRegistry), idService)
+		return receiver.New(cfgMock, receiver.HandshakeValidator(n.NetworkID, idService, nil), nil, new(testingdocuments.MockRegistry), idService, nil, nil, nil, nil, nil)
 	}}
 	ctx, canc := context.WithCancel(context.Background())
 	startErr := make(chan error, 1)
@@ -111,7 +112,9 @@ func TestCentP2PServer_makeBasicHostNoExternalIP(t *testing.T) {
 	listenPort := 38202
 	pu, pr := c.GetP2PKeyPair()
 	priv, pub, err := crypto.ObtainP2PKeypair(pu, pr)
-	h, err := makeBasicHost(priv, pub, "", listenPort)
+	listen, external, err := parseAddresses(nil, nil, "", listenPort)
+	assert.Nil(t, err)
+	h, err := makeBasicHost(priv, pub, newAddresses(listen, external, nil))
 	assert.Nil(t, err)
 	assert.NotNil(t, h)
 }
@@ -124,7 +127,9 @@ func TestCentP2PServer_makeBasicHostWithExternalIP(t *testing.T) {
 	listenPort := 38202
 	pu, pr := c.GetP2PKeyPair()
 	priv, pub, err := crypto.ObtainP2PKeypair(pu, pr)
-	h, err := makeBasicHost(priv, pub, externalIP, listenPort)
+	listen, external, err := parseAddresses(nil, nil, externalIP, listenPort)
+	assert.Nil(t, err)
+	h, err := makeBasicHost(priv, pub, newAddresses(listen, external, nil))
 	assert.Nil(t, err)
 	assert.NotNil(t, h)
 	addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", externalIP, listenPort))
@@ -134,16 +139,13 @@ func TestCentP2PServer_makeBasicHostWithExternalIP(t *testing.T) {
 }
 
 func TestCentP2PServer_makeBasicHostWithWrongExternalIP(t *testing.T) {
-	c, err := cfg.GetConfig()
-	assert.NoError(t, err)
-	c = updateKeys(c)
 	externalIP := "100.200.300.400"
 	listenPort := 38202
-	pu, pr := c.GetP2PKeyPair()
-	priv, pub, err := crypto.ObtainP2PKeypair(pu, pr)
-	h, err := makeBasicHost(priv, pub, externalIP, listenPort)
+	listen, external, err := parseAddresses(nil, nil, externalIP, listenPort)
 	assert.NotNil(t, err)
-	assert.Nil(t, h)
+	assert.True(t, errors.IsOfType(ErrInvalidAddress, err))
+	assert.Nil(t, listen)
+	assert.Nil(t, external)
 }
 
 func updateKeys(c config.Configuration) config.Configuration {
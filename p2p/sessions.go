@@ -0,0 +1,38 @@
+package p2p
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	libp2pPeer "github.com/libp2p/go-libp2p-peer"
+)
+
+// localAccount returns the account of the collaborator if it is an account of this node.
+// Collaborators with an open session are remote, their accounts are not looked up.
+func (s *peer) localAccount(collaborator identity.DID) (config.Account, error) {
+	if _, ok := s.sessions.Peer(collaborator); ok {
+		return nil, errors.New("%s is a remote collaborator", collaborator.String())
+	}
+
+	return s.config.GetAccount(collaborator[:])
+}
+
+// remoteIdentityExists checks that the identity of the remote collaborator exists, unless a session with it is open.
+func (s *peer) remoteIdentityExists(ctx context.Context, collaborator identity.DID) error {
+	if _, ok := s.sessions.Peer(collaborator); ok {
+		return nil
+	}
+
+	return s.idService.Exists(ctx, collaborator)
+}
+
+// resolvePeer returns the peer of the open session with the collaborator, else the peer resolved from its identity.
+func (s *peer) resolvePeer(collaborator identity.DID) (libp2pPeer.ID, error) {
+	if pid, ok := s.sessions.Peer(collaborator); ok {
+		return pid, nil
+	}
+
+	return s.getPeerID(collaborator)
+}
@@ -0,0 +1,90 @@
+package traffic
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-centrifuge/centerrors"
+	"github.com/centrifuge/go-centrifuge/code"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/traffic"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/centrifuge/go-centrifuge/version"
+)
+
+type grpcHandler struct {
+	recorder *Recorder
+}
+
+// GRPCHandler returns an implementation of trafficpb.TrafficServiceServer
+func GRPCHandler(recorder *Recorder) trafficpb.TrafficServiceServer {
+	return grpcHandler{recorder: recorder}
+}
+
+// GetTraffic returns the p2p traffic of the collaborator in the request or of all collaborators.
+func (h grpcHandler) GetTraffic(ctx context.Context, req *trafficpb.GetTrafficRequest) (*trafficpb.GetTrafficResponse, error) {
+	var stats []CollaboratorStats
+	if req.Collaborator == "" {
+		stats = h.recorder.Stats()
+	} else {
+		did, err := identity.NewDIDFromString(req.Collaborator)
+		if err != nil {
+			return nil, centerrors.New(code.Unknown, err.Error())
+		}
+
+		if s, ok := h.recorder.StatsFor(did); ok {
+			stats = append(stats, s)
+		}
+	}
+
+	resp := new(trafficpb.GetTrafficResponse)
+	for _, s := range stats {
+		lastSeen, err := utils.ToTimestamp(s.LastSeen)
+		if err != nil {
+			return nil, centerrors.New(code.Unknown, err.Error())
+		}
+
+		resp.Data = append(resp.Data, &trafficpb.CollaboratorTraffic{
+			Collaborator: s.Collaborator.String(),
+			LastMinute:   toProtoStats(s.LastMinute),
+			LastHour:     toProtoStats(s.LastHour),
+			Total:        toProtoStats(s.Total),
+			LastSeen:     lastSeen,
+			NodeVersion:  s.NodeVersion,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetVersionAdvisories returns the collaborators running a deprecated or incompatible node version.
+func (h grpcHandler) GetVersionAdvisories(ctx context.Context, req *trafficpb.GetVersionAdvisoriesRequest) (*trafficpb.GetVersionAdvisoriesResponse, error) {
+	resp := &trafficpb.GetVersionAdvisoriesResponse{
+		NodeVersion:        version.GetVersion().String(),
+		MinimumPeerVersion: version.MinimumPeerVersion,
+	}
+
+	for _, a := range h.recorder.VersionAdvisories() {
+		lastSeen, err := utils.ToTimestamp(a.LastSeen)
+		if err != nil {
+			return nil, centerrors.New(code.Unknown, err.Error())
+		}
+
+		resp.Data = append(resp.Data, &trafficpb.VersionAdvisory{
+			Collaborator: a.Collaborator.String(),
+			NodeVersion:  a.NodeVersion,
+			Status:       string(a.Status),
+			LastSeen:     lastSeen,
+		})
+	}
+
+	return resp, nil
+}
+
+func toProtoStats(s Stats) *trafficpb.TrafficStats {
+	return &trafficpb.TrafficStats{
+		BytesSent:        s.BytesSent,
+		BytesReceived:    s.BytesReceived,
+		MessagesSent:     s.MessagesSent,
+		MessagesReceived: s.MessagesReceived,
+	}
+}
@@ -0,0 +1,291 @@
+package traffic
+
+import (
+	"expvar"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/version"
+)
+
+const (
+	// BootstrappedRecorder is the key to the traffic Recorder in the bootstrap context.
+	BootstrappedRecorder = "BootstrappedP2PTraffic"
+
+	// metricsName is the name of the expvar the traffic is published as.
+	metricsName = "p2p_traffic"
+
+	// windowBuckets is the number of one minute buckets kept per collaborator.
+	windowBuckets = 60
+
+	// idleTimeout is the time after which the counters of an idle collaborator are dropped, once its hourly window is empty.
+	idleTimeout = windowBuckets * time.Minute
+)
+
+// Stats holds the traffic counters of a collaborator.
+type Stats struct {
+	BytesSent        uint64 `json:"bytes_sent"`
+	BytesReceived    uint64 `json:"bytes_received"`
+	MessagesSent     uint64 `json:"messages_sent"`
+	MessagesReceived uint64 `json:"messages_received"`
+}
+
+func (s *Stats) add(o Stats) {
+	s.BytesSent += o.BytesSent
+	s.BytesReceived += o.BytesReceived
+	s.MessagesSent += o.MessagesSent
+	s.MessagesReceived += o.MessagesReceived
+}
+
+// CollaboratorStats holds the traffic with a collaborator over the rolling windows.
+type CollaboratorStats struct {
+	Collaborator identity.DID `json:"-"`
+	LastMinute   Stats        `json:"last_minute"`
+	LastHour     Stats        `json:"last_hour"`
+
+	// Total is the traffic since the node started.
+	Total    Stats     `json:"total"`
+	LastSeen time.Time `json:"last_seen"`
+
+	// NodeVersion is the node version last reported by the collaborator.
+	NodeVersion string `json:"node_version"`
+}
+
+type bucket struct {
+	minute int64
+	stats  Stats
+}
+
+type counter struct {
+	buckets     [windowBuckets]bucket
+	total       Stats
+	lastSeen    time.Time
+	nodeVersion *string
+}
+
+// version returns the reported node version or an empty string if the collaborator never reported one.
+func (c *counter) version() string {
+	if c.nodeVersion == nil {
+		return ""
+	}
+
+	return *c.nodeVersion
+}
+
+func (c *counter) add(now time.Time, s Stats) {
+	minute := now.Unix() / 60
+	b := &c.buckets[minute%windowBuckets]
+	if b.minute != minute {
+		*b = bucket{minute: minute}
+	}
+
+	b.stats.add(s)
+	c.total.add(s)
+	c.lastSeen = now
+}
+
+// window returns the traffic of the last minutes including the current one.
+func (c *counter) window(now time.Time, minutes int64) Stats {
+	var s Stats
+	cur := now.Unix() / 60
+	for _, b := range c.buckets {
+		if b.minute > cur-minutes && b.minute <= cur {
+			s.add(b.stats)
+		}
+	}
+
+	return s
+}
+
+// Recorder records the p2p traffic per collaborator.
+// The counters of the collaborators idle for longer than the hourly window are dropped, along with their totals.
+// A nil Recorder ignores the traffic.
+type Recorder struct {
+	mu       sync.RWMutex
+	counters map[identity.DID]*counter
+	now      func() time.Time
+
+	// evicted is the last time the idle counters were dropped
+	evicted time.Time
+}
+
+// NewRecorder returns an empty traffic recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{counters: make(map[identity.DID]*counter), now: time.Now}
+}
+
+// Sent records a message of size bytes sent to the collaborator.
+func (r *Recorder) Sent(collaborator identity.DID, size int) {
+	r.record(collaborator, Stats{BytesSent: uint64(size), MessagesSent: 1})
+}
+
+// Received records a message of size bytes received from the collaborator.
+func (r *Recorder) Received(collaborator identity.DID, size int) {
+	r.record(collaborator, Stats{BytesReceived: uint64(size), MessagesReceived: 1})
+}
+
+func (r *Recorder) record(collaborator identity.DID, s Stats) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	r.evictIdle(now)
+	r.counter(collaborator).add(now, s)
+}
+
+// SeenVersion records the node version reported by the collaborator in the p2p header.
+func (r *Recorder) SeenVersion(collaborator identity.DID, nodeVersion string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	r.evictIdle(now)
+	c := r.counter(collaborator)
+	c.nodeVersion = &nodeVersion
+	c.lastSeen = now
+}
+
+// evictIdle drops the counters of the collaborators idle for longer than idleTimeout, at most once a minute.
+func (r *Recorder) evictIdle(now time.Time) {
+	if now.Sub(r.evicted) < time.Minute {
+		return
+	}
+
+	r.evicted = now
+	for did, c := range r.counters {
+		if now.Sub(c.lastSeen) > idleTimeout {
+			delete(r.counters, did)
+		}
+	}
+}
+
+func (r *Recorder) counter(collaborator identity.DID) *counter {
+	c, ok := r.counters[collaborator]
+	if !ok {
+		c = new(counter)
+		r.counters[collaborator] = c
+	}
+
+	return c
+}
+
+// StatsFor returns the traffic with the collaborator.
+// Returns false if no traffic was recorded for the collaborator.
+func (r *Recorder) StatsFor(collaborator identity.DID) (CollaboratorStats, bool) {
+	if r == nil {
+		return CollaboratorStats{}, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.counters[collaborator]
+	if !ok {
+		return CollaboratorStats{}, false
+	}
+
+	return r.stats(collaborator, c), true
+}
+
+// Stats returns the traffic of all the collaborators ordered by collaborator.
+func (r *Recorder) Stats() []CollaboratorStats {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var stats []CollaboratorStats
+	for did, c := range r.counters {
+		stats = append(stats, r.stats(did, c))
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Collaborator.String() < stats[j].Collaborator.String()
+	})
+	return stats
+}
+
+func (r *Recorder) stats(did identity.DID, c *counter) CollaboratorStats {
+	now := r.now()
+	return CollaboratorStats{
+		Collaborator: did,
+		LastMinute:   c.window(now, 1),
+		LastHour:     c.window(now, windowBuckets),
+		Total:        c.total,
+		LastSeen:     c.lastSeen,
+		NodeVersion:  c.version(),
+	}
+}
+
+// VersionAdvisory reports a collaborator running a deprecated or incompatible node version.
+type VersionAdvisory struct {
+	Collaborator identity.DID
+	NodeVersion  string
+	Status       version.PeerStatus
+	LastSeen     time.Time
+}
+
+// VersionAdvisories returns the collaborators whose last reported node version is deprecated or incompatible,
+// ordered by collaborator. Collaborators that never reported a version are not included.
+func (r *Recorder) VersionAdvisories() []VersionAdvisory {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var advisories []VersionAdvisory
+	for did, c := range r.counters {
+		if c.nodeVersion == nil {
+			continue
+		}
+
+		status := version.CheckPeerVersion(*c.nodeVersion)
+		if status == version.PeerCompatible {
+			continue
+		}
+
+		advisories = append(advisories, VersionAdvisory{
+			Collaborator: did,
+			NodeVersion:  *c.nodeVersion,
+			Status:       status,
+			LastSeen:     c.lastSeen,
+		})
+	}
+
+	sort.Slice(advisories, func(i, j int) bool {
+		return advisories[i].Collaborator.String() < advisories[j].Collaborator.String()
+	})
+	return advisories
+}
+
+var (
+	publishOnce sync.Once
+	published   atomic.Value
+)
+
+// PublishMetrics publishes the traffic of the recorder as the expvar "p2p_traffic", keyed by collaborator.
+// The expvar is served on /debug/vars if pprof is enabled. Later calls replace the published recorder.
+func PublishMetrics(r *Recorder) {
+	published.Store(r)
+	publishOnce.Do(func() {
+		expvar.Publish(metricsName, expvar.Func(func() interface{} {
+			r, _ := published.Load().(*Recorder)
+			metrics := make(map[string]CollaboratorStats)
+			for _, s := range r.Stats() {
+				metrics[s.Collaborator.String()] = s
+			}
+
+			return metrics
+		}))
+	})
+}
@@ -0,0 +1,193 @@
+// +build unit
+
+package traffic
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/traffic"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder_windows(t *testing.T) {
+	now := time.Unix(1000*60, 0)
+	r := NewRecorder()
+	r.now = func() time.Time { return now }
+	did := testingidentity.GenerateRandomDID()
+
+	_, ok := r.StatsFor(did)
+	assert.False(t, ok)
+
+	r.Sent(did, 100)
+	r.Received(did, 10)
+
+	// 30 minutes later
+	now = now.Add(30 * time.Minute)
+	r.Sent(did, 200)
+	s, ok := r.StatsFor(did)
+	assert.True(t, ok)
+	assert.Equal(t, Stats{BytesSent: 200, MessagesSent: 1}, s.LastMinute)
+	assert.Equal(t, Stats{BytesSent: 300, BytesReceived: 10, MessagesSent: 2, MessagesReceived: 1}, s.LastHour)
+	assert.Equal(t, s.LastHour, s.Total)
+	assert.Equal(t, now, s.LastSeen)
+
+	// first minute falls out of the hour
+	now = now.Add(30 * time.Minute)
+	s, _ = r.StatsFor(did)
+	assert.Equal(t, Stats{}, s.LastMinute)
+	assert.Equal(t, Stats{BytesSent: 200, MessagesSent: 1}, s.LastHour)
+	assert.Equal(t, uint64(300), s.Total.BytesSent)
+
+	// bucket of the same slot an hour later is reset
+	r.Received(did, 5)
+	s, _ = r.StatsFor(did)
+	assert.Equal(t, Stats{BytesReceived: 5, MessagesReceived: 1}, s.LastMinute)
+	assert.Equal(t, Stats{BytesSent: 200, BytesReceived: 5, MessagesSent: 1, MessagesReceived: 1}, s.LastHour)
+}
+
+func TestRecorder_evictIdle(t *testing.T) {
+	now := time.Unix(1000*60, 0)
+	r := NewRecorder()
+	r.now = func() time.Time { return now }
+	idle, active := testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID()
+	r.Sent(idle, 10)
+	r.SeenVersion(active, "1.0.0")
+
+	// active within the hour
+	now = now.Add(40 * time.Minute)
+	r.Received(active, 10)
+	now = now.Add(30 * time.Minute)
+	r.Sent(active, 10)
+	_, ok := r.StatsFor(idle)
+	assert.False(t, ok)
+	s, ok := r.StatsFor(active)
+	assert.True(t, ok)
+	assert.Equal(t, "1.0.0", s.NodeVersion)
+	assert.Equal(t, uint64(2), s.Total.MessagesSent+s.Total.MessagesReceived)
+
+	// idle collaborators are dropped at most once a minute
+	now = now.Add(59*time.Minute + 30*time.Second)
+	r.SeenVersion(idle, "1.0.0")
+	now = now.Add(40 * time.Second)
+	r.SeenVersion(idle, "1.0.0")
+	_, ok = r.StatsFor(active)
+	assert.True(t, ok)
+	now = now.Add(30 * time.Second)
+	r.SeenVersion(idle, "1.0.0")
+	_, ok = r.StatsFor(active)
+	assert.False(t, ok)
+	assert.Len(t, r.Stats(), 1)
+}
+
+func TestRecorder_Stats(t *testing.T) {
+	var r *Recorder
+	r.Sent(testingidentity.GenerateRandomDID(), 10)
+	assert.Nil(t, r.Stats())
+
+	r = NewRecorder()
+	did1, did2 := testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID()
+	r.Sent(did1, 10)
+	r.Received(did2, 20)
+	stats := r.Stats()
+	assert.Len(t, stats, 2)
+	assert.True(t, stats[0].Collaborator.String() < stats[1].Collaborator.String())
+
+	PublishMetrics(r)
+	var metrics map[string]CollaboratorStats
+	assert.NoError(t, json.Unmarshal([]byte(expvar.Get(metricsName).String()), &metrics))
+	assert.Equal(t, uint64(10), metrics[did1.String()].Total.BytesSent)
+	assert.Equal(t, uint64(20), metrics[did2.String()].Total.BytesReceived)
+}
+
+func TestGRPCHandler_GetTraffic(t *testing.T) {
+	r := NewRecorder()
+	did1, did2 := testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID()
+	r.Sent(did1, 10)
+	r.Received(did2, 20)
+	h := GRPCHandler(r)
+
+	resp, err := h.GetTraffic(context.Background(), &trafficpb.GetTrafficRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Data, 2)
+
+	// invalid collaborator
+	_, err = h.GetTraffic(context.Background(), &trafficpb.GetTrafficRequest{Collaborator: "0x123"})
+	assert.Error(t, err)
+
+	// unknown collaborator
+	resp, err = h.GetTraffic(context.Background(), &trafficpb.GetTrafficRequest{Collaborator: testingidentity.GenerateRandomDID().String()})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Data)
+
+	resp, err = h.GetTraffic(context.Background(), &trafficpb.GetTrafficRequest{Collaborator: did2.String()})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Data, 1)
+	assert.Equal(t, did2.String(), resp.Data[0].Collaborator)
+	assert.Equal(t, uint64(20), resp.Data[0].Total.BytesReceived)
+	assert.Equal(t, uint64(1), resp.Data[0].LastHour.MessagesReceived)
+	assert.NotNil(t, resp.Data[0].LastSeen)
+}
+
+func TestRecorder_VersionAdvisories(t *testing.T) {
+	var r *Recorder
+	r.SeenVersion(testingidentity.GenerateRandomDID(), "1.0.0")
+	assert.Nil(t, r.VersionAdvisories())
+
+	r = NewRecorder()
+	compatible, deprecated, incompatible, unknown := testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID(), testingidentity.GenerateRandomDID()
+	r.Received(compatible, 10)
+	r.SeenVersion(compatible, version.GetVersion().String())
+	r.Received(deprecated, 10)
+	r.SeenVersion(deprecated, "0.0.1-alpha1")
+	r.Received(incompatible, 10)
+	r.SeenVersion(incompatible, "1.0.0")
+	r.Sent(unknown, 10)
+
+	s, ok := r.StatsFor(incompatible)
+	assert.True(t, ok)
+	assert.Equal(t, "1.0.0", s.NodeVersion)
+
+	advisories := r.VersionAdvisories()
+	assert.Len(t, advisories, 2)
+	statuses := make(map[identity.DID]version.PeerStatus)
+	for _, a := range advisories {
+		statuses[a.Collaborator] = a.Status
+	}
+	assert.Equal(t, version.PeerDeprecated, statuses[deprecated])
+	assert.Equal(t, version.PeerIncompatible, statuses[incompatible])
+
+	// upgraded collaborator
+	r.SeenVersion(incompatible, version.GetVersion().String())
+	advisories = r.VersionAdvisories()
+	assert.Len(t, advisories, 1)
+	assert.Equal(t, deprecated, advisories[0].Collaborator)
+	assert.Equal(t, "0.0.1-alpha1", advisories[0].NodeVersion)
+}
+
+func TestGRPCHandler_GetVersionAdvisories(t *testing.T) {
+	r := NewRecorder()
+	h := GRPCHandler(r)
+	resp, err := h.GetVersionAdvisories(context.Background(), &trafficpb.GetVersionAdvisoriesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, version.GetVersion().String(), resp.NodeVersion)
+	assert.Equal(t, version.MinimumPeerVersion, resp.MinimumPeerVersion)
+	assert.Empty(t, resp.Data)
+
+	did := testingidentity.GenerateRandomDID()
+	r.Received(did, 10)
+	r.SeenVersion(did, "invalid")
+	resp, err = h.GetVersionAdvisories(context.Background(), &trafficpb.GetVersionAdvisoriesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Data, 1)
+	assert.Equal(t, did.String(), resp.Data[0].Collaborator)
+	assert.Equal(t, "invalid", resp.Data[0].NodeVersion)
+	assert.Equal(t, string(version.PeerIncompatible), resp.Data[0].Status)
+	assert.NotNil(t, resp.Data[0].LastSeen)
+}
@@ -37,7 +37,7 @@ func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
 func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountRequest) ProtoMessage()    {}
 func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_bc5abe13fa112146, []int{0}
+	return fileDescriptor_service_6a195e712e742933, []int{0}
 }
 func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
@@ -75,7 +75,7 @@ func (m *GetAllAccountResponse) Reset()         { *m = GetAllAccountResponse{} }
 func (m *GetAllAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAllAccountResponse) ProtoMessage()    {}
 func (*GetAllAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_bc5abe13fa112146, []int{1}
+	return fileDescriptor_service_6a195e712e742933, []int{1}
 }
 func (m *GetAllAccountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAllAccountResponse.Unmarshal(m, b)
@@ -114,7 +114,7 @@ func (m *UpdateAccountRequest) Reset()         { *m = UpdateAccountRequest{} }
 func (m *UpdateAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateAccountRequest) ProtoMessage()    {}
 func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_bc5abe13fa112146, []int{2}
+	return fileDescriptor_service_6a195e712e742933, []int{2}
 }
 func (m *UpdateAccountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateAccountRequest.Unmarshal(m, b)
@@ -161,7 +161,7 @@ func (m *EthereumAccount) Reset()         { *m = EthereumAccount{} }
 func (m *EthereumAccount) String() string { return proto.CompactTextString(m) }
 func (*EthereumAccount) ProtoMessage()    {}
 func (*EthereumAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_bc5abe13fa112146, []int{3}
+	return fileDescriptor_service_6a195e712e742933, []int{3}
 }
 func (m *EthereumAccount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EthereumAccount.Unmarshal(m, b)
@@ -214,7 +214,7 @@ func (m *KeyPair) Reset()         { *m = KeyPair{} }
 func (m *KeyPair) String() string { return proto.CompactTextString(m) }
 func (*KeyPair) ProtoMessage()    {}
 func (*KeyPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_bc5abe13fa112146, []int{4}
+	return fileDescriptor_service_6a195e712e742933, []int{4}
 }
 func (m *KeyPair) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyPair.Unmarshal(m, b)
@@ -255,16 +255,24 @@ type AccountData struct {
 	IdentityId                       string           `protobuf:"bytes,4,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
 	SigningKeyPair                   *KeyPair         `protobuf:"bytes,5,opt,name=signing_key_pair,json=signingKeyPair,proto3" json:"signing_key_pair,omitempty"`
 	P2PKeyPair                       *KeyPair         `protobuf:"bytes,7,opt,name=p2p_key_pair,json=p2pKeyPair,proto3" json:"p2p_key_pair,omitempty"`
-	XXX_NoUnkeyedLiteral             struct{}         `json:"-"`
-	XXX_unrecognized                 []byte           `json:"-"`
-	XXX_sizecache                    int32            `json:"-"`
+	// tier of the account, selects the queue lane of the account jobs
+	Tier string `protobuf:"bytes,8,opt,name=tier,proto3" json:"tier,omitempty"`
+	// residency tag of the account, documents are stored in the storage configured for the tag.
+	// changing the tag doesn't move the documents already stored.
+	Residency string `protobuf:"bytes,9,opt,name=residency,proto3" json:"residency,omitempty"`
+	// additional signing keys of the identity, such as the keys of the finance and the legal approvers.
+	// every version is signed with the signing key and each approver key.
+	ApproverKeyPairs     []*KeyPair `protobuf:"bytes,10,rep,name=approver_key_pairs,json=approverKeyPairs,proto3" json:"approver_key_pairs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *AccountData) Reset()         { *m = AccountData{} }
 func (m *AccountData) String() string { return proto.CompactTextString(m) }
 func (*AccountData) ProtoMessage()    {}
 func (*AccountData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_bc5abe13fa112146, []int{5}
+	return fileDescriptor_service_6a195e712e742933, []int{5}
 }
 func (m *AccountData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountData.Unmarshal(m, b)
@@ -326,6 +334,27 @@ func (m *AccountData) GetP2PKeyPair() *KeyPair {
 	return nil
 }
 
+func (m *AccountData) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+func (m *AccountData) GetResidency() string {
+	if m != nil {
+		return m.Residency
+	}
+	return ""
+}
+
+func (m *AccountData) GetApproverKeyPairs() []*KeyPair {
+	if m != nil {
+		return m.ApproverKeyPairs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetAccountRequest)(nil), "account.GetAccountRequest")
 	proto.RegisterType((*GetAllAccountResponse)(nil), "account.GetAllAccountResponse")
@@ -539,53 +568,55 @@ var _AccountService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "account/service.proto",
 }
 
-func init() { proto.RegisterFile("account/service.proto", fileDescriptor_service_bc5abe13fa112146) }
-
-var fileDescriptor_service_bc5abe13fa112146 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x51, 0x6b, 0x13, 0x4f,
-	0x10, 0xe7, 0xda, 0xfe, 0xff, 0x31, 0x73, 0x6d, 0x1a, 0x97, 0xb4, 0x1c, 0x57, 0xad, 0xc7, 0x09,
-	0x12, 0xaa, 0x4d, 0x20, 0x7d, 0x50, 0xfb, 0x20, 0xa6, 0x36, 0x14, 0x11, 0x4b, 0x89, 0xf8, 0xa0,
-	0x20, 0x71, 0x93, 0x9b, 0x5e, 0x96, 0x26, 0x7b, 0xeb, 0xed, 0x26, 0x25, 0x88, 0x3e, 0xf8, 0x11,
-	0xe2, 0xbb, 0x5f, 0xca, 0x4f, 0x20, 0xf8, 0x35, 0x04, 0xb9, 0xbb, 0xbd, 0xcb, 0xc5, 0x24, 0x88,
-	0x4f, 0xb7, 0x3b, 0x33, 0xbf, 0xf9, 0xcd, 0x6f, 0x76, 0xe6, 0x60, 0x87, 0xf6, 0x7a, 0xc1, 0x88,
-	0xab, 0xba, 0xc4, 0x70, 0xcc, 0x7a, 0x58, 0x13, 0x61, 0xa0, 0x02, 0x52, 0xd0, 0x66, 0xfb, 0x96,
-	0x1f, 0x04, 0xfe, 0x00, 0xeb, 0x54, 0xb0, 0x3a, 0xe5, 0x3c, 0x50, 0x54, 0xb1, 0x80, 0xcb, 0x24,
-	0xcc, 0xde, 0xd3, 0xde, 0xf8, 0xd6, 0x1d, 0x5d, 0xd6, 0x71, 0x28, 0xd4, 0x44, 0x3b, 0x1f, 0xc4,
-	0x9f, 0xde, 0xa1, 0x8f, 0xfc, 0x50, 0x5e, 0x53, 0xdf, 0xc7, 0xb0, 0x1e, 0x88, 0x18, 0xbe, 0x98,
-	0xca, 0x3d, 0x82, 0x9b, 0x67, 0xa8, 0x9a, 0x09, 0x6d, 0x1b, 0x3f, 0x8c, 0x50, 0x2a, 0xb2, 0x0f,
-	0xc0, 0x3c, 0xe4, 0x8a, 0x5d, 0x32, 0x0c, 0x2d, 0xc3, 0x31, 0xaa, 0xc5, 0x76, 0xce, 0xe2, 0x36,
-	0x61, 0x27, 0x02, 0x0d, 0x06, 0x19, 0x4e, 0x8a, 0x80, 0x4b, 0x24, 0x55, 0xd8, 0xf0, 0xa8, 0xa2,
-	0x96, 0xe1, 0xac, 0x57, 0xcd, 0x46, 0xa5, 0xa6, 0xe5, 0xd4, 0x74, 0xdc, 0x29, 0x55, 0xb4, 0x1d,
-	0x47, 0xb8, 0xef, 0xa1, 0xf2, 0x5a, 0x78, 0x54, 0xe1, 0xbf, 0x51, 0x67, 0x0c, 0x6b, 0x8e, 0xf1,
-	0x17, 0x86, 0x37, 0xb0, 0xdd, 0x52, 0x7d, 0x0c, 0x71, 0x34, 0xd4, 0x4e, 0x62, 0x41, 0x81, 0x7a,
-	0x5e, 0x88, 0x52, 0xea, 0xcc, 0xe9, 0x95, 0x94, 0x61, 0xfd, 0x0a, 0x27, 0x71, 0xd6, 0x62, 0x3b,
-	0x3a, 0x12, 0x1b, 0x6e, 0x08, 0x2a, 0xe5, 0x75, 0x10, 0x7a, 0xd6, 0x7a, 0x6c, 0xce, 0xee, 0xee,
-	0x21, 0x14, 0x5e, 0xe0, 0xe4, 0x82, 0xb2, 0x30, 0x02, 0x8a, 0x51, 0x57, 0xa7, 0x8b, 0x8e, 0xb1,
-	0x65, 0xac, 0xd2, 0x54, 0x62, 0xac, 0xdc, 0x1f, 0x6b, 0x60, 0xe6, 0xea, 0x23, 0x8f, 0xc1, 0x44,
-	0xd5, 0xef, 0xe8, 0xd2, 0x63, 0xac, 0xd9, 0xb0, 0x32, 0x29, 0x7f, 0x54, 0xdd, 0x06, 0x54, 0xfd,
-	0x54, 0xc1, 0x43, 0xb0, 0x22, 0xa8, 0x87, 0x97, 0x74, 0x34, 0x50, 0x69, 0x8a, 0x0e, 0xa7, 0x43,
-	0xd4, 0x8c, 0x3b, 0xa8, 0xfa, 0xa7, 0x89, 0x5b, 0x83, 0xce, 0xe9, 0x10, 0xc9, 0x4b, 0xb8, 0x1b,
-	0x62, 0x0f, 0xd9, 0x18, 0x3b, 0x38, 0xc6, 0x08, 0x12, 0x44, 0x1d, 0xed, 0xc5, 0xc3, 0xd0, 0x41,
-	0xee, 0x89, 0x80, 0x71, 0xa5, 0x95, 0x3a, 0x3a, 0xb4, 0x15, 0x45, 0x9e, 0xe7, 0x02, 0x5b, 0x3a,
-	0x8e, 0xdc, 0x01, 0x33, 0x79, 0x14, 0x35, 0xe9, 0x30, 0xcf, 0xda, 0xc8, 0xbf, 0x93, 0x9a, 0x3c,
-	0xf7, 0xc8, 0x31, 0x94, 0x25, 0xf3, 0x39, 0xe3, 0x7e, 0xe7, 0x0a, 0x27, 0x1d, 0x41, 0x59, 0x68,
-	0xfd, 0x17, 0x0b, 0x2d, 0x67, 0x42, 0x75, 0x0f, 0xdb, 0x25, 0x1d, 0x99, 0xf6, 0xb4, 0x01, 0x9b,
-	0xa2, 0x21, 0x66, 0xb8, 0xc2, 0x0a, 0x1c, 0x88, 0x86, 0xd0, 0xe7, 0xc6, 0xaf, 0x0d, 0x28, 0x69,
-	0xbd, 0xaf, 0x92, 0x95, 0x22, 0x1c, 0x60, 0x36, 0xda, 0xc4, 0xce, 0xe0, 0x0b, 0xf3, 0x6e, 0x2f,
-	0x1d, 0x23, 0xb7, 0x36, 0x6d, 0x6e, 0xd9, 0xe6, 0x19, 0x2a, 0x47, 0x5b, 0xbf, 0x7c, 0xff, 0xf9,
-	0x75, 0xcd, 0x22, 0xbb, 0x75, 0x1d, 0x2d, 0xeb, 0x1f, 0x67, 0x93, 0xf9, 0x89, 0x08, 0x28, 0xcd,
-	0x6d, 0x85, 0x24, 0xbb, 0xb5, 0x64, 0x51, 0x6b, 0xe9, 0xa2, 0xd6, 0x5a, 0xd1, 0xa2, 0xda, 0xfb,
-	0x73, 0xb5, 0x2c, 0xac, 0x91, 0x7b, 0x6f, 0xda, 0x24, 0x76, 0x39, 0x66, 0x1e, 0x0c, 0x52, 0x76,
-	0x19, 0xd3, 0x9b, 0xa4, 0x98, 0xd1, 0x93, 0x3e, 0x6c, 0x3d, 0x0b, 0x71, 0xb6, 0x44, 0x64, 0xa9,
-	0x90, 0x15, 0xf2, 0xee, 0x4f, 0x9b, 0x15, 0x9b, 0x24, 0x78, 0xe9, 0x50, 0x3e, 0xa7, 0xb2, 0xe4,
-	0xce, 0x68, 0x8e, 0x8d, 0x03, 0xf2, 0xcd, 0x80, 0xed, 0x33, 0xe4, 0x18, 0xe6, 0xc8, 0x56, 0xa9,
-	0x5b, 0x4e, 0xf7, 0x6e, 0xda, 0x7c, 0x6a, 0x3f, 0x49, 0x73, 0xe4, 0x09, 0x1d, 0x45, 0xaf, 0x18,
-	0xf7, 0x1d, 0x3d, 0xd4, 0xd2, 0xe9, 0x52, 0x89, 0x9e, 0x13, 0x70, 0x47, 0xf5, 0xd1, 0x19, 0x52,
-	0xc6, 0x1d, 0x9a, 0x2b, 0xad, 0xe2, 0x92, 0xd9, 0x03, 0xf8, 0x3a, 0x1f, 0xf9, 0x0c, 0x5b, 0x73,
-	0xff, 0x13, 0x72, 0x3b, 0xab, 0x62, 0xd9, 0x7f, 0x66, 0x45, 0x91, 0x8f, 0xe2, 0x9e, 0x24, 0x80,
-	0x85, 0x9e, 0xec, 0xd9, 0x2b, 0x5e, 0xfe, 0xd8, 0x38, 0x38, 0xa9, 0x82, 0xd9, 0x0b, 0x86, 0x69,
-	0xd2, 0x93, 0x4d, 0x3d, 0x84, 0x17, 0x51, 0x6b, 0x2e, 0x8c, 0xb7, 0x45, 0xed, 0x10, 0xdd, 0xee,
-	0xff, 0x71, 0xbb, 0x8e, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x78, 0xa6, 0xeb, 0x6c, 0x03, 0x06,
-	0x00, 0x00,
+func init() { proto.RegisterFile("account/service.proto", fileDescriptor_service_6a195e712e742933) }
+
+var fileDescriptor_service_6a195e712e742933 = []byte{
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x51, 0x6b, 0xdb, 0x48,
+	0x10, 0x46, 0xb1, 0xef, 0x1c, 0x8f, 0x12, 0xc7, 0xb7, 0x38, 0x41, 0x28, 0xb9, 0x9c, 0xd0, 0xc1,
+	0x61, 0x72, 0x17, 0x1b, 0x9c, 0x87, 0xbb, 0xcb, 0x43, 0x38, 0xe7, 0x62, 0xc2, 0x71, 0x34, 0x04,
+	0x97, 0x3e, 0xb4, 0x50, 0xdc, 0xb5, 0x34, 0x91, 0x45, 0xec, 0xd5, 0x56, 0xbb, 0x76, 0x30, 0xa5,
+	0x7d, 0xe8, 0x4f, 0x70, 0xdf, 0xfb, 0xa7, 0xf2, 0x17, 0xfa, 0x37, 0x0a, 0x45, 0xab, 0x95, 0x6c,
+	0xd7, 0x36, 0xa5, 0x4f, 0xd2, 0xce, 0xcc, 0x37, 0xdf, 0x7c, 0xb3, 0x3b, 0x03, 0xfb, 0xd4, 0xf3,
+	0xa2, 0x31, 0x93, 0x4d, 0x81, 0xf1, 0x24, 0xf4, 0xb0, 0xc1, 0xe3, 0x48, 0x46, 0xa4, 0xa4, 0xcd,
+	0xf6, 0x51, 0x10, 0x45, 0xc1, 0x10, 0x9b, 0x94, 0x87, 0x4d, 0xca, 0x58, 0x24, 0xa9, 0x0c, 0x23,
+	0x26, 0xd2, 0x30, 0xfb, 0x50, 0x7b, 0xd5, 0xa9, 0x3f, 0xbe, 0x6b, 0xe2, 0x88, 0xcb, 0xa9, 0x76,
+	0xfe, 0xa1, 0x3e, 0xde, 0x69, 0x80, 0xec, 0x54, 0x3c, 0xd0, 0x20, 0xc0, 0xb8, 0x19, 0x71, 0x05,
+	0x5f, 0x4d, 0xe5, 0x9e, 0xc1, 0x4f, 0xd7, 0x28, 0xdb, 0x29, 0x6d, 0x17, 0x5f, 0x8f, 0x51, 0x48,
+	0x72, 0x0c, 0x10, 0xfa, 0xc8, 0x64, 0x78, 0x17, 0x62, 0x6c, 0x19, 0x8e, 0x51, 0x2f, 0x77, 0x17,
+	0x2c, 0x6e, 0x1b, 0xf6, 0x13, 0xd0, 0x70, 0x98, 0xe3, 0x04, 0x8f, 0x98, 0x40, 0x52, 0x87, 0xa2,
+	0x4f, 0x25, 0xb5, 0x0c, 0xa7, 0x50, 0x37, 0x5b, 0xb5, 0x86, 0x96, 0xd3, 0xd0, 0x71, 0x57, 0x54,
+	0xd2, 0xae, 0x8a, 0x70, 0x5f, 0x41, 0xed, 0x19, 0xf7, 0xa9, 0xc4, 0xef, 0xa3, 0xce, 0x19, 0xb6,
+	0x1c, 0xe3, 0x1b, 0x0c, 0xcf, 0x61, 0xaf, 0x23, 0x07, 0x18, 0xe3, 0x78, 0xa4, 0x9d, 0xc4, 0x82,
+	0x12, 0xf5, 0xfd, 0x18, 0x85, 0xd0, 0x99, 0xb3, 0x23, 0xa9, 0x42, 0xe1, 0x1e, 0xa7, 0x2a, 0x6b,
+	0xb9, 0x9b, 0xfc, 0x12, 0x1b, 0xb6, 0x39, 0x15, 0xe2, 0x21, 0x8a, 0x7d, 0xab, 0xa0, 0xcc, 0xf9,
+	0xd9, 0x3d, 0x85, 0xd2, 0xff, 0x38, 0xbd, 0xa5, 0x61, 0x9c, 0x00, 0xf9, 0xb8, 0xaf, 0xd3, 0x25,
+	0xbf, 0xca, 0x32, 0x91, 0x59, 0x2a, 0x3e, 0x91, 0xee, 0x63, 0x01, 0xcc, 0x85, 0xfa, 0xc8, 0xdf,
+	0x60, 0xa2, 0x1c, 0xf4, 0x74, 0xe9, 0x0a, 0x6b, 0xb6, 0xac, 0x5c, 0xca, 0x57, 0x55, 0x77, 0x01,
+	0xe5, 0x20, 0x53, 0xf0, 0x27, 0x58, 0x09, 0xd4, 0xc7, 0x3b, 0x3a, 0x1e, 0xca, 0x2c, 0x45, 0x8f,
+	0xd1, 0x11, 0x6a, 0xc6, 0x7d, 0x94, 0x83, 0xab, 0xd4, 0xad, 0x41, 0x37, 0x74, 0x84, 0xe4, 0x09,
+	0xfc, 0x1a, 0xa3, 0x87, 0xe1, 0x04, 0x7b, 0x38, 0xc1, 0x04, 0x12, 0x25, 0x1d, 0xf5, 0xd4, 0x63,
+	0xe8, 0x21, 0xf3, 0x79, 0x14, 0x32, 0xa9, 0x95, 0x3a, 0x3a, 0xb4, 0x93, 0x44, 0xde, 0x2c, 0x04,
+	0x76, 0x74, 0x1c, 0xf9, 0x05, 0xcc, 0xf4, 0x52, 0xe4, 0xb4, 0x17, 0xfa, 0x56, 0x71, 0xf1, 0x9e,
+	0xe4, 0xf4, 0x3f, 0x9f, 0x9c, 0x43, 0x55, 0x84, 0x01, 0x0b, 0x59, 0xd0, 0xbb, 0xc7, 0x69, 0x8f,
+	0xd3, 0x30, 0xb6, 0x7e, 0x50, 0x42, 0xab, 0xb9, 0x50, 0xdd, 0xc3, 0x6e, 0x45, 0x47, 0x66, 0x3d,
+	0x6d, 0xc1, 0x0e, 0x6f, 0xf1, 0x39, 0xae, 0xb4, 0x01, 0x07, 0xbc, 0xc5, 0x33, 0x0c, 0x81, 0xa2,
+	0x4c, 0x5e, 0xcc, 0xb6, 0xaa, 0x44, 0xfd, 0x93, 0x23, 0x28, 0xc7, 0x28, 0x92, 0xa2, 0xbc, 0xa9,
+	0x55, 0x56, 0x8e, 0xb9, 0x81, 0x5c, 0x00, 0xa1, 0x9c, 0xc7, 0xd1, 0x04, 0xe3, 0x9c, 0x4a, 0x58,
+	0xe0, 0x14, 0xd6, 0x72, 0x55, 0xb3, 0x58, 0x6d, 0x10, 0xad, 0xcf, 0x45, 0xa8, 0xe8, 0x0e, 0x3f,
+	0x4d, 0x87, 0x98, 0x30, 0x80, 0xf9, 0x30, 0x11, 0x3b, 0x4f, 0xb2, 0x32, 0x61, 0xf6, 0xda, 0x87,
+	0xeb, 0x36, 0x66, 0xed, 0x5d, 0xdb, 0xbc, 0x46, 0xe9, 0x68, 0xeb, 0xfb, 0xc7, 0x4f, 0x1f, 0xb6,
+	0x2c, 0x72, 0xd0, 0xd4, 0xd1, 0xa2, 0xf9, 0x66, 0x3e, 0x0b, 0x6f, 0x09, 0x87, 0xca, 0xd2, 0x1c,
+	0x0a, 0x72, 0xd0, 0x48, 0x57, 0x43, 0x23, 0x5b, 0x0d, 0x8d, 0x4e, 0xb2, 0x1a, 0xec, 0xe3, 0xa5,
+	0x5a, 0x56, 0x06, 0xd7, 0xfd, 0x6d, 0xd6, 0x26, 0x76, 0x55, 0x31, 0x0f, 0x87, 0x19, 0xbb, 0x50,
+	0xf4, 0x26, 0x29, 0xe7, 0xf4, 0x64, 0x00, 0xbb, 0xff, 0xc6, 0x38, 0x1f, 0x5b, 0xb2, 0x56, 0xc8,
+	0x06, 0x79, 0xbf, 0xcf, 0xda, 0x35, 0x9b, 0xa4, 0x78, 0xe1, 0x50, 0xb6, 0xa4, 0xb2, 0xe2, 0xce,
+	0x69, 0xce, 0x8d, 0x13, 0xf2, 0xd1, 0x80, 0xbd, 0x6b, 0x64, 0x18, 0x2f, 0x90, 0x6d, 0x52, 0xb7,
+	0x9e, 0xee, 0xe5, 0xac, 0xfd, 0x8f, 0x7d, 0x91, 0xe5, 0x58, 0x24, 0x74, 0x24, 0xbd, 0x0f, 0x59,
+	0xe0, 0xe8, 0x31, 0x12, 0x4e, 0x9f, 0x0a, 0xf4, 0x9d, 0x88, 0x39, 0x72, 0x80, 0xce, 0x88, 0x86,
+	0xcc, 0xa1, 0x0b, 0xa5, 0xd5, 0x5c, 0x32, 0xbf, 0x80, 0x40, 0xe7, 0x23, 0xef, 0x60, 0x77, 0x69,
+	0x83, 0x91, 0x9f, 0xf3, 0x2a, 0xd6, 0x6d, 0xb6, 0x0d, 0x45, 0xfe, 0xa5, 0x7a, 0x92, 0x02, 0x56,
+	0x7a, 0x72, 0x68, 0x6f, 0xb8, 0xf9, 0x73, 0xe3, 0xe4, 0xb2, 0x0e, 0xa6, 0x17, 0x8d, 0xb2, 0xa4,
+	0x97, 0x3b, 0xfa, 0x11, 0xde, 0x26, 0xad, 0xb9, 0x35, 0x5e, 0x94, 0xb5, 0x83, 0xf7, 0xfb, 0x3f,
+	0xaa, 0x76, 0x9d, 0x7d, 0x19, 0x00, 0x6a, 0x48, 0x8f, 0x6b, 0x75, 0x06, 0x00, 0x00,
 }
@@ -52,6 +52,8 @@ type ConfigData struct {
 	SmartContractAddresses    map[string]string    `protobuf:"bytes,21,rep,name=smart_contract_addresses,json=smartContractAddresses,proto3" json:"smart_contract_addresses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	SmartContractBytecode     map[string]string    `protobuf:"bytes,23,rep,name=smart_contract_bytecode,json=smartContractBytecode,proto3" json:"smart_contract_bytecode,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	PprofEnabled              bool                 `protobuf:"varint,22,opt,name=pprof_enabled,json=pprofEnabled,proto3" json:"pprof_enabled,omitempty"`
+	P2PListenAddresses        []string             `protobuf:"bytes,24,rep,name=p2p_listen_addresses,json=p2pListenAddresses,proto3" json:"p2p_listen_addresses,omitempty"`
+	P2PExternalAddresses      []string             `protobuf:"bytes,25,rep,name=p2p_external_addresses,json=p2pExternalAddresses,proto3" json:"p2p_external_addresses,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}             `json:"-"`
 	XXX_unrecognized          []byte               `json:"-"`
 	XXX_sizecache             int32                `json:"-"`
@@ -61,7 +63,7 @@ func (m *ConfigData) Reset()         { *m = ConfigData{} }
 func (m *ConfigData) String() string { return proto.CompactTextString(m) }
 func (*ConfigData) ProtoMessage()    {}
 func (*ConfigData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_3fe01735a8c62984, []int{0}
+	return fileDescriptor_service_d4e434fc93485c57, []int{0}
 }
 func (m *ConfigData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigData.Unmarshal(m, b)
@@ -242,10 +244,73 @@ func (m *ConfigData) GetPprofEnabled() bool {
 	return false
 }
 
+func (m *ConfigData) GetP2PListenAddresses() []string {
+	if m != nil {
+		return m.P2PListenAddresses
+	}
+	return nil
+}
+
+func (m *ConfigData) GetP2PExternalAddresses() []string {
+	if m != nil {
+		return m.P2PExternalAddresses
+	}
+	return nil
+}
+
+type UpdateP2PAddressesRequest struct {
+	// multiaddresses the node listens on, all the interfaces on the configured port if empty
+	ListenAddresses []string `protobuf:"bytes,1,rep,name=listen_addresses,json=listenAddresses,proto3" json:"listen_addresses,omitempty"`
+	// multiaddresses advertised to the peers instead of the listen addresses, for nodes behind NAT, the configured external IP if empty
+	ExternalAddresses    []string `protobuf:"bytes,2,rep,name=external_addresses,json=externalAddresses,proto3" json:"external_addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateP2PAddressesRequest) Reset()         { *m = UpdateP2PAddressesRequest{} }
+func (m *UpdateP2PAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateP2PAddressesRequest) ProtoMessage()    {}
+func (*UpdateP2PAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_d4e434fc93485c57, []int{1}
+}
+func (m *UpdateP2PAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateP2PAddressesRequest.Unmarshal(m, b)
+}
+func (m *UpdateP2PAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateP2PAddressesRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateP2PAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateP2PAddressesRequest.Merge(dst, src)
+}
+func (m *UpdateP2PAddressesRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateP2PAddressesRequest.Size(m)
+}
+func (m *UpdateP2PAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateP2PAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateP2PAddressesRequest proto.InternalMessageInfo
+
+func (m *UpdateP2PAddressesRequest) GetListenAddresses() []string {
+	if m != nil {
+		return m.ListenAddresses
+	}
+	return nil
+}
+
+func (m *UpdateP2PAddressesRequest) GetExternalAddresses() []string {
+	if m != nil {
+		return m.ExternalAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConfigData)(nil), "config.ConfigData")
 	proto.RegisterMapType((map[string]string)(nil), "config.ConfigData.SmartContractAddressesEntry")
 	proto.RegisterMapType((map[string]string)(nil), "config.ConfigData.SmartContractBytecodeEntry")
+	proto.RegisterType((*UpdateP2PAddressesRequest)(nil), "config.UpdateP2PAddressesRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -261,6 +326,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ConfigServiceClient interface {
 	GetConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ConfigData, error)
+	UpdateP2PAddresses(ctx context.Context, in *UpdateP2PAddressesRequest, opts ...grpc.CallOption) (*ConfigData, error)
 }
 
 type configServiceClient struct {
@@ -280,9 +346,19 @@ func (c *configServiceClient) GetConfig(ctx context.Context, in *empty.Empty, op
 	return out, nil
 }
 
+func (c *configServiceClient) UpdateP2PAddresses(ctx context.Context, in *UpdateP2PAddressesRequest, opts ...grpc.CallOption) (*ConfigData, error) {
+	out := new(ConfigData)
+	err := c.cc.Invoke(ctx, "/config.ConfigService/UpdateP2PAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConfigServiceServer is the server API for ConfigService service.
 type ConfigServiceServer interface {
 	GetConfig(context.Context, *empty.Empty) (*ConfigData, error)
+	UpdateP2PAddresses(context.Context, *UpdateP2PAddressesRequest) (*ConfigData, error)
 }
 
 func RegisterConfigServiceServer(s *grpc.Server, srv ConfigServiceServer) {
@@ -307,6 +383,24 @@ func _ConfigService_GetConfig_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfigService_UpdateP2PAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateP2PAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).UpdateP2PAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/config.ConfigService/UpdateP2PAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).UpdateP2PAddresses(ctx, req.(*UpdateP2PAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ConfigService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "config.ConfigService",
 	HandlerType: (*ConfigServiceServer)(nil),
@@ -315,66 +409,80 @@ var _ConfigService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfig",
 			Handler:    _ConfigService_GetConfig_Handler,
 		},
+		{
+			MethodName: "UpdateP2PAddresses",
+			Handler:    _ConfigService_UpdateP2PAddresses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "config/service.proto",
 }
 
-func init() { proto.RegisterFile("config/service.proto", fileDescriptor_service_3fe01735a8c62984) }
-
-var fileDescriptor_service_3fe01735a8c62984 = []byte{
-	// 838 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xd6, 0x36, 0xcd, 0x8f, 0xc7, 0x76, 0x92, 0x4e, 0x9d, 0x74, 0xe2, 0x52, 0x58, 0x52, 0x51,
-	0x7c, 0x41, 0xd6, 0x92, 0xb9, 0x01, 0xee, 0x92, 0x34, 0x0a, 0x96, 0x28, 0x58, 0x5b, 0x50, 0x25,
-	0x40, 0x1a, 0x8d, 0x77, 0x4f, 0xbc, 0xab, 0xee, 0xce, 0x8c, 0x66, 0x8f, 0x13, 0xfb, 0x96, 0x47,
-	0x80, 0x87, 0xe1, 0x41, 0x78, 0x05, 0x1e, 0x04, 0xcd, 0x8f, 0x93, 0xb4, 0x29, 0x44, 0x5c, 0xd9,
-	0xfb, 0x7d, 0xdf, 0xf9, 0xce, 0x99, 0x73, 0x66, 0x0e, 0xe9, 0x65, 0x4a, 0x5e, 0x94, 0xb3, 0x61,
-	0x03, 0xe6, 0xb2, 0xcc, 0x20, 0xd1, 0x46, 0xa1, 0xa2, 0x1b, 0x1e, 0xed, 0xef, 0x89, 0x2c, 0x53,
-	0x73, 0x89, 0xef, 0xd2, 0xfd, 0x8f, 0x66, 0x4a, 0xcd, 0x2a, 0x18, 0x0a, 0x5d, 0x0e, 0x85, 0x94,
-	0x0a, 0x05, 0x96, 0x4a, 0x36, 0x81, 0xfd, 0x38, 0xb0, 0xee, 0x6b, 0x3a, 0xbf, 0x18, 0xe6, 0x73,
-	0xe3, 0x04, 0x81, 0x7f, 0xfa, 0x3e, 0x0f, 0xb5, 0xc6, 0x65, 0x20, 0xbf, 0x70, 0x3f, 0xd9, 0xd1,
-	0x0c, 0xe4, 0x51, 0x73, 0x25, 0x66, 0x33, 0x30, 0x43, 0xa5, 0x9d, 0xfd, 0xdd, 0x54, 0x87, 0x7f,
-	0x12, 0x42, 0x4e, 0x5d, 0xa9, 0x2f, 0x05, 0x0a, 0xfa, 0x29, 0xe9, 0x34, 0xa8, 0x8c, 0x98, 0x01,
-	0xd7, 0x02, 0x0b, 0x16, 0xc5, 0xd1, 0xa0, 0x95, 0xb6, 0x03, 0x36, 0x11, 0x58, 0xd0, 0x03, 0xb2,
-	0xa5, 0x47, 0x9a, 0x6b, 0x65, 0x90, 0x3d, 0x88, 0xa3, 0xc1, 0x7a, 0xba, 0xa9, 0x47, 0x7a, 0xa2,
-	0x0c, 0xd2, 0x17, 0x64, 0xc7, 0x52, 0xb0, 0x40, 0x30, 0x52, 0x54, 0xbc, 0xd4, 0x6c, 0xcd, 0x19,
-	0x74, 0xf5, 0x48, 0x9f, 0x05, 0x74, 0xac, 0xe9, 0x0f, 0x64, 0xdf, 0xea, 0x32, 0x25, 0x25, 0x64,
-	0xb6, 0x1a, 0x8e, 0x65, 0x0d, 0x6a, 0x8e, 0xec, 0x61, 0x1c, 0x0d, 0xda, 0xa3, 0x83, 0xc4, 0x1f,
-	0x30, 0x59, 0x1d, 0x30, 0x79, 0x19, 0x1a, 0x90, 0xf6, 0xf4, 0x48, 0x9f, 0x5e, 0xc7, 0xfd, 0xe8,
-	0xc3, 0xe8, 0x27, 0xa4, 0x6d, 0xfb, 0x0b, 0xc6, 0x97, 0xb5, 0xee, 0xca, 0x22, 0x1e, 0x72, 0x95,
-	0x7d, 0x46, 0xb6, 0x83, 0x40, 0xe4, 0xb9, 0x81, 0xa6, 0x61, 0x1b, 0xbe, 0x30, 0x8f, 0x1e, 0x7b,
-	0xd0, 0xfa, 0xc8, 0x79, 0xcd, 0xaf, 0x94, 0x79, 0x0b, 0xa6, 0x61, 0x9b, 0xde, 0x47, 0xce, 0xeb,
-	0x37, 0x1e, 0xa1, 0x47, 0xe4, 0xb1, 0x27, 0xf9, 0x95, 0x28, 0xd1, 0x95, 0xcd, 0xeb, 0x86, 0x6d,
-	0x39, 0xe1, 0xae, 0xa7, 0xde, 0x88, 0x12, 0x6d, 0x61, 0xaf, 0x1a, 0x1a, 0x93, 0x0e, 0x60, 0xc1,
-	0xa5, 0xca, 0x81, 0xcf, 0x4d, 0xc5, 0x5a, 0x2e, 0x29, 0x01, 0x2c, 0xbe, 0x57, 0x39, 0xfc, 0x64,
-	0x2a, 0xfa, 0x0b, 0x79, 0x66, 0x15, 0x99, 0x92, 0x08, 0x0b, 0xe4, 0x06, 0x44, 0x7e, 0x63, 0x6d,
-	0x3b, 0x42, 0xee, 0xeb, 0xc8, 0x01, 0x60, 0x71, 0xea, 0xc3, 0x53, 0x10, 0xf9, 0x2a, 0xbb, 0x6d,
-	0x4b, 0x4a, 0xd8, 0x6d, 0xf3, 0x77, 0x7c, 0xdb, 0xf7, 0xf9, 0xee, 0xdd, 0xf8, 0xde, 0xf6, 0x3c,
-	0x27, 0xd4, 0x7a, 0x96, 0x12, 0xc1, 0x5c, 0x8a, 0x8a, 0x1b, 0x40, 0xb3, 0x64, 0x9d, 0xfb, 0xdc,
-	0x76, 0x01, 0x8b, 0x71, 0x88, 0x49, 0x6d, 0x88, 0xbd, 0x2c, 0xd6, 0xa8, 0x16, 0x0b, 0xe7, 0x51,
-	0x42, 0xc3, 0xba, 0x71, 0x34, 0xe8, 0xa6, 0x5d, 0xc0, 0xe2, 0x95, 0x58, 0xa4, 0x1e, 0xa4, 0x87,
-	0xc4, 0x02, 0x7c, 0x26, 0x1a, 0xae, 0x4d, 0x99, 0x01, 0xdb, 0x8e, 0xa3, 0xc1, 0xc3, 0xb4, 0x0d,
-	0x58, 0x9c, 0x8b, 0x66, 0x62, 0xa1, 0xdb, 0x9a, 0xaa, 0xac, 0x4b, 0x64, 0x3b, 0xb7, 0x35, 0xdf,
-	0x59, 0xc8, 0xe6, 0xc3, 0x05, 0xd7, 0x4a, 0x55, 0x1c, 0xa4, 0x98, 0x56, 0x90, 0xb3, 0xdd, 0x38,
-	0x1a, 0x6c, 0xa5, 0x5d, 0x5c, 0x4c, 0x94, 0xaa, 0xce, 0x3c, 0x48, 0x19, 0xd9, 0x94, 0x80, 0x76,
-	0x94, 0xec, 0x91, 0x1b, 0xd7, 0xea, 0x93, 0x7e, 0x4e, 0x76, 0xa6, 0x4a, 0x61, 0x83, 0x46, 0x68,
-	0xae, 0xc1, 0xde, 0x10, 0x1a, 0xaf, 0x0d, 0x5a, 0xe9, 0xf6, 0x35, 0x3c, 0xb1, 0x28, 0x7d, 0x46,
-	0x48, 0x88, 0xe1, 0x65, 0xce, 0x1e, 0xbb, 0x53, 0xb5, 0x02, 0x32, 0xce, 0xe9, 0xd7, 0xa4, 0x5b,
-	0x8b, 0x52, 0xf2, 0x32, 0x07, 0x89, 0x25, 0x2e, 0x59, 0xcf, 0x75, 0xaf, 0x97, 0x84, 0x5d, 0x91,
-	0x1c, 0xfb, 0x5f, 0xfb, 0x22, 0xd3, 0x8e, 0x95, 0x8e, 0x83, 0x92, 0x16, 0x84, 0x35, 0xb5, 0x30,
-	0xe8, 0x66, 0x6a, 0x44, 0x86, 0xab, 0xfb, 0x0c, 0x0d, 0xdb, 0x8b, 0xd7, 0x06, 0xed, 0x51, 0x92,
-	0xf8, 0xcd, 0x93, 0xdc, 0xbc, 0xea, 0xe4, 0xb5, 0x0d, 0x39, 0x0d, 0x11, 0xc7, 0xab, 0x80, 0x33,
-	0x89, 0x66, 0x99, 0xee, 0x37, 0x1f, 0x24, 0x29, 0x90, 0x27, 0xef, 0x65, 0x9a, 0x2e, 0x11, 0x32,
-	0x95, 0x03, 0x7b, 0xe2, 0x12, 0x1d, 0xdd, 0x97, 0xe8, 0x24, 0xe8, 0x7d, 0x9e, 0xbd, 0xe6, 0x43,
-	0x1c, 0x7d, 0x4e, 0xba, 0x5a, 0x1b, 0x75, 0x71, 0x3d, 0x93, 0x7d, 0x37, 0x93, 0x8e, 0x03, 0xc3,
-	0x48, 0xfa, 0x63, 0xf2, 0xf4, 0x3f, 0x8e, 0x40, 0x77, 0xc9, 0xda, 0x5b, 0x58, 0x86, 0x5d, 0x65,
-	0xff, 0xd2, 0x1e, 0x59, 0xbf, 0x14, 0xd5, 0x1c, 0xdc, 0x82, 0x6a, 0xa5, 0xfe, 0xe3, 0x9b, 0x07,
-	0x5f, 0x45, 0xfd, 0x6f, 0x49, 0xff, 0xdf, 0x8b, 0xfc, 0x3f, 0x4e, 0xa3, 0x9a, 0x74, 0xfd, 0xc9,
-	0x5f, 0xfb, 0xcd, 0x4e, 0x7f, 0x25, 0xad, 0x73, 0x40, 0x8f, 0xd1, 0xfd, 0x3b, 0x4f, 0xe1, 0xcc,
-	0xee, 0xe8, 0x3e, 0xbd, 0xdb, 0xb5, 0xc3, 0xe7, 0xbf, 0x1f, 0x3f, 0xea, 0xef, 0x9c, 0x03, 0xc6,
-	0x76, 0x2b, 0xc4, 0x9e, 0xf9, 0xed, 0xaf, 0xbf, 0xff, 0x78, 0xd0, 0xa2, 0x9b, 0x43, 0xaf, 0x3f,
-	0x79, 0x41, 0x48, 0xa6, 0xea, 0x10, 0x7d, 0xd2, 0x09, 0x49, 0x27, 0xd6, 0x7d, 0x12, 0xfd, 0xbc,
-	0xe5, 0x71, 0x3d, 0x9d, 0x6e, 0xb8, 0x84, 0x5f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x6d,
-	0x0b, 0x1a, 0x97, 0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("config/service.proto", fileDescriptor_service_d4e434fc93485c57) }
+
+var fileDescriptor_service_d4e434fc93485c57 = []byte{
+	// 997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x72, 0xdb, 0x44,
+	0x14, 0x1e, 0x25, 0xcd, 0x8f, 0xd7, 0x76, 0x7e, 0xb6, 0x4e, 0xba, 0x71, 0x29, 0xa8, 0xe9, 0x50,
+	0x0c, 0x43, 0x6c, 0x46, 0x70, 0x01, 0xbd, 0x4b, 0xd2, 0x4c, 0x08, 0x93, 0x82, 0x47, 0xa5, 0xd3,
+	0x19, 0x60, 0x46, 0xb3, 0x96, 0x4e, 0x2c, 0x4d, 0xe5, 0xdd, 0x65, 0x75, 0x94, 0xd8, 0xb7, 0x3c,
+	0x42, 0x79, 0x00, 0x5e, 0x80, 0x47, 0xe0, 0x2d, 0x78, 0x05, 0x1e, 0x84, 0xd9, 0x5d, 0xd9, 0x71,
+	0xe3, 0x94, 0x0c, 0x57, 0xb6, 0xbe, 0x73, 0xbe, 0xef, 0x9c, 0xfd, 0x8e, 0xf6, 0x88, 0xb4, 0x62,
+	0x29, 0x2e, 0xb2, 0x61, 0xaf, 0x00, 0x7d, 0x99, 0xc5, 0xd0, 0x55, 0x5a, 0xa2, 0xa4, 0xab, 0x0e,
+	0x6d, 0xef, 0xf0, 0x38, 0x96, 0xa5, 0xc0, 0x77, 0xc3, 0xed, 0x0f, 0x86, 0x52, 0x0e, 0x73, 0xe8,
+	0x71, 0x95, 0xf5, 0xb8, 0x10, 0x12, 0x39, 0x66, 0x52, 0x14, 0x55, 0xf4, 0xc3, 0x2a, 0x6a, 0x9f,
+	0x06, 0xe5, 0x45, 0x2f, 0x29, 0xb5, 0x4d, 0xa8, 0xe2, 0x0f, 0x6f, 0xc6, 0x61, 0xa4, 0x70, 0x52,
+	0x05, 0x3f, 0xb7, 0x3f, 0xf1, 0xc1, 0x10, 0xc4, 0x41, 0x71, 0xc5, 0x87, 0x43, 0xd0, 0x3d, 0xa9,
+	0xac, 0xfc, 0x62, 0xa9, 0xfd, 0x3f, 0xea, 0x84, 0x1c, 0xdb, 0x56, 0x9f, 0x73, 0xe4, 0xf4, 0x31,
+	0x69, 0x14, 0x28, 0x35, 0x1f, 0x42, 0xa4, 0x38, 0xa6, 0xcc, 0xf3, 0xbd, 0x4e, 0x2d, 0xac, 0x57,
+	0x58, 0x9f, 0x63, 0x4a, 0xf7, 0xc8, 0xba, 0x0a, 0x54, 0xa4, 0xa4, 0x46, 0xb6, 0xe4, 0x7b, 0x9d,
+	0x95, 0x70, 0x4d, 0x05, 0xaa, 0x2f, 0x35, 0xd2, 0xa7, 0x64, 0xd3, 0x84, 0x60, 0x8c, 0xa0, 0x05,
+	0xcf, 0xa3, 0x4c, 0xb1, 0x65, 0x2b, 0xd0, 0x54, 0x81, 0x3a, 0xa9, 0xd0, 0x33, 0x45, 0x7f, 0x20,
+	0xbb, 0x26, 0x2f, 0x96, 0x42, 0x40, 0x6c, 0xba, 0x89, 0x30, 0x1b, 0x81, 0x2c, 0x91, 0xdd, 0xf3,
+	0xbd, 0x4e, 0x3d, 0xd8, 0xeb, 0xba, 0x03, 0x76, 0xa7, 0x07, 0xec, 0x3e, 0xaf, 0x0c, 0x08, 0x5b,
+	0x2a, 0x50, 0xc7, 0x33, 0xde, 0x8f, 0x8e, 0x46, 0x3f, 0x22, 0x75, 0xe3, 0x2f, 0x68, 0xd7, 0xd6,
+	0x8a, 0x6d, 0x8b, 0x38, 0xc8, 0x76, 0xf6, 0x31, 0xd9, 0xa8, 0x12, 0x78, 0x92, 0x68, 0x28, 0x0a,
+	0xb6, 0xea, 0x1a, 0x73, 0xe8, 0xa1, 0x03, 0x8d, 0x8e, 0x28, 0x47, 0xd1, 0x95, 0xd4, 0x6f, 0x40,
+	0x17, 0x6c, 0xcd, 0xe9, 0x88, 0x72, 0xf4, 0xda, 0x21, 0xf4, 0x80, 0xdc, 0x77, 0xc1, 0xe8, 0x8a,
+	0x67, 0x68, 0xdb, 0x8e, 0x46, 0x05, 0x5b, 0xb7, 0x89, 0x5b, 0x2e, 0xf4, 0x9a, 0x67, 0x68, 0x1a,
+	0x7b, 0x51, 0x50, 0x9f, 0x34, 0x00, 0xd3, 0x48, 0xc8, 0x04, 0xa2, 0x52, 0xe7, 0xac, 0x66, 0x8b,
+	0x12, 0xc0, 0xf4, 0x7b, 0x99, 0xc0, 0x2b, 0x9d, 0xd3, 0x9f, 0xc9, 0x23, 0x93, 0x11, 0x4b, 0x81,
+	0x30, 0xc6, 0x48, 0x03, 0x4f, 0xae, 0xa5, 0x8d, 0x23, 0xe4, 0x2e, 0x47, 0xf6, 0x00, 0xd3, 0x63,
+	0x47, 0x0f, 0x81, 0x27, 0xd3, 0xea, 0xc6, 0x96, 0x90, 0xb0, 0x79, 0xf1, 0x77, 0x74, 0xeb, 0x77,
+	0xe9, 0xee, 0x5c, 0xeb, 0xce, 0x6b, 0x9e, 0x12, 0x6a, 0x34, 0x33, 0x81, 0xa0, 0x2f, 0x79, 0x1e,
+	0x69, 0x40, 0x3d, 0x61, 0x8d, 0xbb, 0xd4, 0xb6, 0x00, 0xd3, 0xb3, 0x8a, 0x13, 0x1a, 0x8a, 0x79,
+	0x59, 0x8c, 0xd0, 0x88, 0x8f, 0xad, 0x46, 0x06, 0x05, 0x6b, 0xfa, 0x5e, 0xa7, 0x19, 0x36, 0x01,
+	0xd3, 0x17, 0x7c, 0x1c, 0x3a, 0x90, 0xee, 0x13, 0x03, 0x44, 0x43, 0x5e, 0x44, 0x4a, 0x67, 0x31,
+	0xb0, 0x0d, 0xdf, 0xeb, 0xdc, 0x0b, 0xeb, 0x80, 0xe9, 0x29, 0x2f, 0xfa, 0x06, 0x9a, 0xcf, 0xc9,
+	0xb3, 0x51, 0x86, 0x6c, 0x73, 0x3e, 0xe7, 0xdc, 0x40, 0xa6, 0x1e, 0x8e, 0x23, 0x25, 0x65, 0x1e,
+	0x81, 0xe0, 0x83, 0x1c, 0x12, 0xb6, 0xe5, 0x7b, 0x9d, 0xf5, 0xb0, 0x89, 0xe3, 0xbe, 0x94, 0xf9,
+	0x89, 0x03, 0x29, 0x23, 0x6b, 0x02, 0xd0, 0x8c, 0x92, 0x6d, 0xdb, 0x71, 0x4d, 0x1f, 0xe9, 0x27,
+	0x64, 0x73, 0x20, 0x25, 0x16, 0xa8, 0xb9, 0x8a, 0x14, 0x98, 0x37, 0x84, 0xfa, 0xcb, 0x9d, 0x5a,
+	0xb8, 0x31, 0x83, 0xfb, 0x06, 0xa5, 0x8f, 0x08, 0xa9, 0x38, 0x51, 0x96, 0xb0, 0xfb, 0xf6, 0x54,
+	0xb5, 0x0a, 0x39, 0x4b, 0xe8, 0x37, 0xa4, 0x39, 0xe2, 0x99, 0x88, 0xb2, 0x04, 0x04, 0x66, 0x38,
+	0x61, 0x2d, 0xeb, 0x5e, 0xab, 0x5b, 0xed, 0x8a, 0xee, 0xa1, 0xfb, 0x35, 0x37, 0x32, 0x6c, 0x98,
+	0xd4, 0xb3, 0x2a, 0x93, 0xa6, 0x84, 0x15, 0x23, 0xae, 0xd1, 0xce, 0x54, 0xf3, 0x18, 0xa7, 0xef,
+	0x33, 0x14, 0x6c, 0xc7, 0x5f, 0xee, 0xd4, 0x83, 0x6e, 0xd7, 0x6d, 0x9e, 0xee, 0xf5, 0xad, 0xee,
+	0xbe, 0x34, 0x94, 0xe3, 0x8a, 0x71, 0x38, 0x25, 0x9c, 0x08, 0xd4, 0x93, 0x70, 0xb7, 0xb8, 0x35,
+	0x48, 0x81, 0x3c, 0xb8, 0x51, 0x69, 0x30, 0x41, 0x88, 0x65, 0x02, 0xec, 0x81, 0x2d, 0x74, 0x70,
+	0x57, 0xa1, 0xa3, 0x2a, 0xdf, 0xd5, 0xd9, 0x29, 0x6e, 0x8b, 0xd1, 0x27, 0xa4, 0xa9, 0x94, 0x96,
+	0x17, 0xb3, 0x99, 0xec, 0xda, 0x99, 0x34, 0x2c, 0x38, 0x1d, 0xc9, 0x17, 0xc4, 0x5c, 0xfb, 0x28,
+	0xcf, 0x0a, 0x04, 0x31, 0x77, 0x62, 0x66, 0xdd, 0xa7, 0x2a, 0x50, 0xe7, 0x36, 0x74, 0xdd, 0xfd,
+	0x57, 0x6e, 0xc3, 0xcc, 0x36, 0xd1, 0x35, 0x67, 0xcf, 0x72, 0x5a, 0x73, 0x0b, 0x69, 0xc6, 0x6a,
+	0x9f, 0x91, 0x87, 0xff, 0x61, 0x15, 0xdd, 0x22, 0xcb, 0x6f, 0x60, 0x52, 0xed, 0x44, 0xf3, 0x97,
+	0xb6, 0xc8, 0xca, 0x25, 0xcf, 0x4b, 0xb0, 0x8b, 0xb0, 0x16, 0xba, 0x87, 0x67, 0x4b, 0x5f, 0x7b,
+	0xed, 0x6f, 0x49, 0xfb, 0xfd, 0x66, 0xfc, 0x1f, 0xa5, 0xfd, 0x92, 0xec, 0xbd, 0x52, 0x09, 0x47,
+	0xe8, 0x07, 0xfd, 0x59, 0x43, 0x21, 0xfc, 0x5a, 0x42, 0x81, 0xf4, 0x53, 0xb2, 0xb5, 0xe0, 0x8a,
+	0x67, 0x4f, 0xb8, 0x99, 0xdf, 0xb0, 0xe4, 0x80, 0xd0, 0x5b, 0xec, 0x58, 0xb2, 0xc9, 0xdb, 0x70,
+	0xd3, 0x8b, 0xe0, 0xcf, 0x25, 0xd2, 0x74, 0x93, 0x7d, 0xe9, 0xbe, 0x5c, 0xf4, 0x17, 0x52, 0x3b,
+	0x05, 0x74, 0x18, 0xdd, 0x5d, 0xb8, 0xea, 0x27, 0xe6, 0x1b, 0xd4, 0xa6, 0x8b, 0x6f, 0xc5, 0xfe,
+	0x93, 0xb7, 0x87, 0xdb, 0xed, 0xcd, 0x53, 0x40, 0xdf, 0x6c, 0x3d, 0xdf, 0x45, 0x7e, 0xfb, 0xfb,
+	0x9f, 0xdf, 0x97, 0x6a, 0x74, 0xad, 0xe7, 0xf2, 0xe9, 0x5f, 0x1e, 0xa1, 0x8b, 0xe7, 0xa4, 0x8f,
+	0xa7, 0x7a, 0xef, 0xf5, 0xe0, 0xd6, 0x92, 0xf2, 0xed, 0xe1, 0x79, 0xfb, 0x3b, 0xc7, 0xf1, 0x31,
+	0x05, 0x5f, 0x05, 0xca, 0x77, 0x8e, 0xf8, 0x5c, 0x24, 0xfe, 0xf4, 0xbc, 0xfe, 0xcc, 0x09, 0x5f,
+	0x5e, 0xd8, 0x44, 0xb3, 0xab, 0xfd, 0xab, 0x0c, 0x53, 0x59, 0xa2, 0xcf, 0x7d, 0x0d, 0x05, 0x72,
+	0x8d, 0xb6, 0xdb, 0x76, 0x7b, 0xa7, 0xea, 0xb6, 0xa7, 0x02, 0xd5, 0x9b, 0x51, 0x9f, 0x79, 0x9f,
+	0x1d, 0x3d, 0x25, 0x24, 0x96, 0xa3, 0xaa, 0x93, 0xa3, 0x46, 0xe5, 0x59, 0xdf, 0x98, 0xd3, 0xf7,
+	0x7e, 0x5a, 0x77, 0xb8, 0x1a, 0x0c, 0x56, 0xad, 0x5f, 0x5f, 0xfe, 0x3b, 0x00, 0x0c, 0x7f, 0xb7,
+	0x47, 0x36, 0x08, 0x00, 0x00,
 }
@@ -7,6 +7,9 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/centrifuge/precise-proofs/proofs/proto"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import _struct "github.com/golang/protobuf/ptypes/struct"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 
@@ -40,7 +43,7 @@ func (m *UpdateAccessTokenPayload) Reset()         { *m = UpdateAccessTokenPaylo
 func (m *UpdateAccessTokenPayload) String() string { return proto.CompactTextString(m) }
 func (*UpdateAccessTokenPayload) ProtoMessage()    {}
 func (*UpdateAccessTokenPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_6db8efe3c4a04f30, []int{0}
+	return fileDescriptor_service_5733569c1e228854, []int{0}
 }
 func (m *UpdateAccessTokenPayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateAccessTokenPayload.Unmarshal(m, b)
@@ -78,17 +81,21 @@ type AccessTokenParams struct {
 	// The identity being granted access to the document
 	Grantee string `protobuf:"bytes,4,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// Original identifier of the document
-	DocumentIdentifier   string   `protobuf:"bytes,2,opt,name=document_identifier,json=documentIdentifier,proto3" json:"document_identifier,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	DocumentIdentifier string `protobuf:"bytes,2,opt,name=document_identifier,json=documentIdentifier,proto3" json:"document_identifier,omitempty"`
+	// Fields the grantee can request proofs for, the token doesn't grant access to the full document if set
+	ProofFields []string `protobuf:"bytes,5,rep,name=proof_fields,json=proofFields,proto3" json:"proof_fields,omitempty"`
+	// Time the token expires at, the token doesn't expire if not set
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *AccessTokenParams) Reset()         { *m = AccessTokenParams{} }
 func (m *AccessTokenParams) String() string { return proto.CompactTextString(m) }
 func (*AccessTokenParams) ProtoMessage()    {}
 func (*AccessTokenParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_6db8efe3c4a04f30, []int{1}
+	return fileDescriptor_service_5733569c1e228854, []int{1}
 }
 func (m *AccessTokenParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessTokenParams.Unmarshal(m, b)
@@ -122,10 +129,28 @@ func (m *AccessTokenParams) GetDocumentIdentifier() string {
 	return ""
 }
 
+func (m *AccessTokenParams) GetProofFields() []string {
+	if m != nil {
+		return m.ProofFields
+	}
+	return nil
+}
+
+func (m *AccessTokenParams) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 type CreateDocumentProofRequest struct {
-	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Fields               []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Identifier string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Type       string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Fields     []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// request a co-signature over the proofs from the configured notary
+	Notarize bool `protobuf:"varint,4,opt,name=notarize,proto3" json:"notarize,omitempty"`
+	// add a proof of the document type, fields can be empty to only prove the document type
+	ProveDocumentType    bool     `protobuf:"varint,5,opt,name=prove_document_type,json=proveDocumentType,proto3" json:"prove_document_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -135,7 +160,7 @@ func (m *CreateDocumentProofRequest) Reset()         { *m = CreateDocumentProofR
 func (m *CreateDocumentProofRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateDocumentProofRequest) ProtoMessage()    {}
 func (*CreateDocumentProofRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_6db8efe3c4a04f30, []int{2}
+	return fileDescriptor_service_5733569c1e228854, []int{2}
 }
 func (m *CreateDocumentProofRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDocumentProofRequest.Unmarshal(m, b)
@@ -176,11 +201,31 @@ func (m *CreateDocumentProofRequest) GetFields() []string {
 	return nil
 }
 
+func (m *CreateDocumentProofRequest) GetNotarize() bool {
+	if m != nil {
+		return m.Notarize
+	}
+	return false
+}
+
+func (m *CreateDocumentProofRequest) GetProveDocumentType() bool {
+	if m != nil {
+		return m.ProveDocumentType
+	}
+	return false
+}
+
 // ResponseHeader contains a set of common fields for most documents
 type ResponseHeader struct {
-	DocumentId           string   `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
-	VersionId            string   `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	State                string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	DocumentId    string   `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	VersionId     string   `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	State         string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Collaborators []string `protobuf:"bytes,4,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	TransactionId string   `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// true if the document was cancelled, no further versions are accepted
+	Cancelled bool `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// ID of the document replacing the cancelled document, empty if not superseded
+	SupersededBy         string   `protobuf:"bytes,7,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -190,7 +235,7 @@ func (m *ResponseHeader) Reset()         { *m = ResponseHeader{} }
 func (m *ResponseHeader) String() string { return proto.CompactTextString(m) }
 func (*ResponseHeader) ProtoMessage()    {}
 func (*ResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_6db8efe3c4a04f30, []int{3}
+	return fileDescriptor_service_5733569c1e228854, []int{3}
 }
 func (m *ResponseHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResponseHeader.Unmarshal(m, b)
@@ -231,19 +276,55 @@ func (m *ResponseHeader) GetState() string {
 	return ""
 }
 
+func (m *ResponseHeader) GetCollaborators() []string {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+func (m *ResponseHeader) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *ResponseHeader) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *ResponseHeader) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
+	}
+	return ""
+}
+
 type DocumentProof struct {
-	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	FieldProofs          []*Proof        `protobuf:"bytes,2,rep,name=field_proofs,json=fieldProofs,proto3" json:"field_proofs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Header      *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	FieldProofs []*Proof        `protobuf:"bytes,2,rep,name=field_proofs,json=fieldProofs,proto3" json:"field_proofs,omitempty"`
+	// document root the field proofs are verified against
+	DocumentRoot string `protobuf:"bytes,3,opt,name=document_root,json=documentRoot,proto3" json:"document_root,omitempty"`
+	// signatures over the proof bundle by the node and the notary, only set if notarized
+	Signatures []*BundleSignature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// parameters the field proofs were generated with, proofs are verified by the envelope version
+	Envelope *ProofEnvelope `protobuf:"bytes,5,opt,name=envelope,proto3" json:"envelope,omitempty"`
+	// proofs of the fields sharing their sibling hashes, set instead of the field proofs if requested
+	MultiProof           *MultiProof `protobuf:"bytes,6,opt,name=multi_proof,json=multiProof,proto3" json:"multi_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *DocumentProof) Reset()         { *m = DocumentProof{} }
 func (m *DocumentProof) String() string { return proto.CompactTextString(m) }
 func (*DocumentProof) ProtoMessage()    {}
 func (*DocumentProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_6db8efe3c4a04f30, []int{4}
+	return fileDescriptor_service_5733569c1e228854, []int{4}
 }
 func (m *DocumentProof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DocumentProof.Unmarshal(m, b)
@@ -277,6 +358,88 @@ func (m *DocumentProof) GetFieldProofs() []*Proof {
 	return nil
 }
 
+func (m *DocumentProof) GetDocumentRoot() string {
+	if m != nil {
+		return m.DocumentRoot
+	}
+	return ""
+}
+
+func (m *DocumentProof) GetSignatures() []*BundleSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *DocumentProof) GetEnvelope() *ProofEnvelope {
+	if m != nil {
+		return m.Envelope
+	}
+	return nil
+}
+
+func (m *DocumentProof) GetMultiProof() *MultiProof {
+	if m != nil {
+		return m.MultiProof
+	}
+	return nil
+}
+
+type BundleSignature struct {
+	SignerId             string   `protobuf:"bytes,1,opt,name=signer_id,json=signerId,proto3" json:"signer_id,omitempty"`
+	PublicKey            string   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature            string   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BundleSignature) Reset()         { *m = BundleSignature{} }
+func (m *BundleSignature) String() string { return proto.CompactTextString(m) }
+func (*BundleSignature) ProtoMessage()    {}
+func (*BundleSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{5}
+}
+func (m *BundleSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BundleSignature.Unmarshal(m, b)
+}
+func (m *BundleSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BundleSignature.Marshal(b, m, deterministic)
+}
+func (dst *BundleSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleSignature.Merge(dst, src)
+}
+func (m *BundleSignature) XXX_Size() int {
+	return xxx_messageInfo_BundleSignature.Size(m)
+}
+func (m *BundleSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleSignature proto.InternalMessageInfo
+
+func (m *BundleSignature) GetSignerId() string {
+	if m != nil {
+		return m.SignerId
+	}
+	return ""
+}
+
+func (m *BundleSignature) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *BundleSignature) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
 type Proof struct {
 	Property string `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
 	Value    string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -293,7 +456,7 @@ func (m *Proof) Reset()         { *m = Proof{} }
 func (m *Proof) String() string { return proto.CompactTextString(m) }
 func (*Proof) ProtoMessage()    {}
 func (*Proof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_6db8efe3c4a04f30, []int{5}
+	return fileDescriptor_service_5733569c1e228854, []int{6}
 }
 func (m *Proof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Proof.Unmarshal(m, b)
@@ -349,10 +512,14 @@ func (m *Proof) GetSortedHashes() []string {
 }
 
 type CreateDocumentProofForVersionRequest struct {
-	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	Fields               []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Identifier string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Type       string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Version    string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Fields     []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	// request a co-signature over the proofs from the configured notary
+	Notarize bool `protobuf:"varint,5,opt,name=notarize,proto3" json:"notarize,omitempty"`
+	// add a proof of the document type, fields can be empty to only prove the document type
+	ProveDocumentType    bool     `protobuf:"varint,6,opt,name=prove_document_type,json=proveDocumentType,proto3" json:"prove_document_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -362,7 +529,7 @@ func (m *CreateDocumentProofForVersionRequest) Reset()         { *m = CreateDocu
 func (m *CreateDocumentProofForVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateDocumentProofForVersionRequest) ProtoMessage()    {}
 func (*CreateDocumentProofForVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_6db8efe3c4a04f30, []int{6}
+	return fileDescriptor_service_5733569c1e228854, []int{7}
 }
 func (m *CreateDocumentProofForVersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDocumentProofForVersionRequest.Unmarshal(m, b)
@@ -410,109 +577,6982 @@ func (m *CreateDocumentProofForVersionRequest) GetFields() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*UpdateAccessTokenPayload)(nil), "document.UpdateAccessTokenPayload")
-	proto.RegisterType((*AccessTokenParams)(nil), "document.AccessTokenParams")
-	proto.RegisterType((*CreateDocumentProofRequest)(nil), "document.CreateDocumentProofRequest")
-	proto.RegisterType((*ResponseHeader)(nil), "document.ResponseHeader")
-	proto.RegisterType((*DocumentProof)(nil), "document.DocumentProof")
-	proto.RegisterType((*Proof)(nil), "document.Proof")
-	proto.RegisterType((*CreateDocumentProofForVersionRequest)(nil), "document.CreateDocumentProofForVersionRequest")
+func (m *CreateDocumentProofForVersionRequest) GetNotarize() bool {
+	if m != nil {
+		return m.Notarize
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *CreateDocumentProofForVersionRequest) GetProveDocumentType() bool {
+	if m != nil {
+		return m.ProveDocumentType
+	}
+	return false
+}
 
-// DocumentServiceClient is the client API for DocumentService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DocumentServiceClient interface {
-	CreateDocumentProof(ctx context.Context, in *CreateDocumentProofRequest, opts ...grpc.CallOption) (*DocumentProof, error)
-	CreateDocumentProofForVersion(ctx context.Context, in *CreateDocumentProofForVersionRequest, opts ...grpc.CallOption) (*DocumentProof, error)
+type LinkDocumentRequest struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Type       string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// identifier of the document to link
+	LinkedIdentifier     string   `protobuf:"bytes,3,opt,name=linked_identifier,json=linkedIdentifier,proto3" json:"linked_identifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type documentServiceClient struct {
-	cc *grpc.ClientConn
+func (m *LinkDocumentRequest) Reset()         { *m = LinkDocumentRequest{} }
+func (m *LinkDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*LinkDocumentRequest) ProtoMessage()    {}
+func (*LinkDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{8}
+}
+func (m *LinkDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkDocumentRequest.Unmarshal(m, b)
+}
+func (m *LinkDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkDocumentRequest.Marshal(b, m, deterministic)
+}
+func (dst *LinkDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkDocumentRequest.Merge(dst, src)
+}
+func (m *LinkDocumentRequest) XXX_Size() int {
+	return xxx_messageInfo_LinkDocumentRequest.Size(m)
+}
+func (m *LinkDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkDocumentRequest.DiscardUnknown(m)
 }
 
-func NewDocumentServiceClient(cc *grpc.ClientConn) DocumentServiceClient {
-	return &documentServiceClient{cc}
+var xxx_messageInfo_LinkDocumentRequest proto.InternalMessageInfo
+
+func (m *LinkDocumentRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
 }
 
-func (c *documentServiceClient) CreateDocumentProof(ctx context.Context, in *CreateDocumentProofRequest, opts ...grpc.CallOption) (*DocumentProof, error) {
-	out := new(DocumentProof)
-	err := c.cc.Invoke(ctx, "/document.DocumentService/CreateDocumentProof", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LinkDocumentRequest) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return ""
 }
 
-func (c *documentServiceClient) CreateDocumentProofForVersion(ctx context.Context, in *CreateDocumentProofForVersionRequest, opts ...grpc.CallOption) (*DocumentProof, error) {
-	out := new(DocumentProof)
-	err := c.cc.Invoke(ctx, "/document.DocumentService/CreateDocumentProofForVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LinkDocumentRequest) GetLinkedIdentifier() string {
+	if m != nil {
+		return m.LinkedIdentifier
 	}
-	return out, nil
+	return ""
 }
 
-// DocumentServiceServer is the server API for DocumentService service.
-type DocumentServiceServer interface {
-	CreateDocumentProof(context.Context, *CreateDocumentProofRequest) (*DocumentProof, error)
-	CreateDocumentProofForVersion(context.Context, *CreateDocumentProofForVersionRequest) (*DocumentProof, error)
+type LinkDocumentResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// fields to request to prove the link against the new version
+	ProofFields          []string `protobuf:"bytes,2,rep,name=proof_fields,json=proofFields,proto3" json:"proof_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterDocumentServiceServer(s *grpc.Server, srv DocumentServiceServer) {
-	s.RegisterService(&_DocumentService_serviceDesc, srv)
+func (m *LinkDocumentResponse) Reset()         { *m = LinkDocumentResponse{} }
+func (m *LinkDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*LinkDocumentResponse) ProtoMessage()    {}
+func (*LinkDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{9}
+}
+func (m *LinkDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkDocumentResponse.Unmarshal(m, b)
+}
+func (m *LinkDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkDocumentResponse.Marshal(b, m, deterministic)
+}
+func (dst *LinkDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkDocumentResponse.Merge(dst, src)
+}
+func (m *LinkDocumentResponse) XXX_Size() int {
+	return xxx_messageInfo_LinkDocumentResponse.Size(m)
+}
+func (m *LinkDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkDocumentResponse.DiscardUnknown(m)
 }
 
-func _DocumentService_CreateDocumentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDocumentProofRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_LinkDocumentResponse proto.InternalMessageInfo
+
+func (m *LinkDocumentResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
 	}
-	if interceptor == nil {
-		return srv.(DocumentServiceServer).CreateDocumentProof(ctx, in)
+	return nil
+}
+
+func (m *LinkDocumentResponse) GetProofFields() []string {
+	if m != nil {
+		return m.ProofFields
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/document.DocumentService/CreateDocumentProof",
+	return nil
+}
+
+type GetDocumentSizeRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDocumentSizeRequest) Reset()         { *m = GetDocumentSizeRequest{} }
+func (m *GetDocumentSizeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDocumentSizeRequest) ProtoMessage()    {}
+func (*GetDocumentSizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{10}
+}
+func (m *GetDocumentSizeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDocumentSizeRequest.Unmarshal(m, b)
+}
+func (m *GetDocumentSizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDocumentSizeRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDocumentSizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDocumentSizeRequest.Merge(dst, src)
+}
+func (m *GetDocumentSizeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDocumentSizeRequest.Size(m)
+}
+func (m *GetDocumentSizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDocumentSizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDocumentSizeRequest proto.InternalMessageInfo
+
+func (m *GetDocumentSizeRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DocumentServiceServer).CreateDocumentProof(ctx, req.(*CreateDocumentProofRequest))
+	return ""
+}
+
+func (m *GetDocumentSizeRequest) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DocumentService_CreateDocumentProofForVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDocumentProofForVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type DocumentSize struct {
+	DocumentId string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// total bytes of all the versions listed
+	TotalBytes uint64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// maximum size of a p2p message, a version bigger than this can't be sent to collaborators
+	MaxMessageBytes uint64 `protobuf:"varint,3,opt,name=max_message_bytes,json=maxMessageBytes,proto3" json:"max_message_bytes,omitempty"`
+	// versions, starting with the latest version
+	Versions             []*VersionSize `protobuf:"bytes,4,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DocumentSize) Reset()         { *m = DocumentSize{} }
+func (m *DocumentSize) String() string { return proto.CompactTextString(m) }
+func (*DocumentSize) ProtoMessage()    {}
+func (*DocumentSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{11}
+}
+func (m *DocumentSize) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentSize.Unmarshal(m, b)
+}
+func (m *DocumentSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentSize.Marshal(b, m, deterministic)
+}
+func (dst *DocumentSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentSize.Merge(dst, src)
+}
+func (m *DocumentSize) XXX_Size() int {
+	return xxx_messageInfo_DocumentSize.Size(m)
+}
+func (m *DocumentSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentSize proto.InternalMessageInfo
+
+func (m *DocumentSize) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
 	}
-	if interceptor == nil {
-		return srv.(DocumentServiceServer).CreateDocumentProofForVersion(ctx, in)
+	return ""
+}
+
+func (m *DocumentSize) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/document.DocumentService/CreateDocumentProofForVersion",
+	return 0
+}
+
+func (m *DocumentSize) GetMaxMessageBytes() uint64 {
+	if m != nil {
+		return m.MaxMessageBytes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DocumentServiceServer).CreateDocumentProofForVersion(ctx, req.(*CreateDocumentProofForVersionRequest))
+	return 0
+}
+
+func (m *DocumentSize) GetVersions() []*VersionSize {
+	if m != nil {
+		return m.Versions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _DocumentService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "document.DocumentService",
-	HandlerType: (*DocumentServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
+type VersionSize struct {
+	VersionId string `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// size of the serialised core document, including the embedded data
+	Bytes                uint64   `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	EmbeddedDataBytes    uint64   `protobuf:"varint,3,opt,name=embedded_data_bytes,json=embeddedDataBytes,proto3" json:"embedded_data_bytes,omitempty"`
+	SignaturesBytes      uint64   `protobuf:"varint,4,opt,name=signatures_bytes,json=signaturesBytes,proto3" json:"signatures_bytes,omitempty"`
+	SaltsBytes           uint64   `protobuf:"varint,5,opt,name=salts_bytes,json=saltsBytes,proto3" json:"salts_bytes,omitempty"`
+	CoreDocumentLeaves   uint64   `protobuf:"varint,6,opt,name=core_document_leaves,json=coreDocumentLeaves,proto3" json:"core_document_leaves,omitempty"`
+	EmbeddedDataLeaves   uint64   `protobuf:"varint,7,opt,name=embedded_data_leaves,json=embeddedDataLeaves,proto3" json:"embedded_data_leaves,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionSize) Reset()         { *m = VersionSize{} }
+func (m *VersionSize) String() string { return proto.CompactTextString(m) }
+func (*VersionSize) ProtoMessage()    {}
+func (*VersionSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{12}
+}
+func (m *VersionSize) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionSize.Unmarshal(m, b)
+}
+func (m *VersionSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionSize.Marshal(b, m, deterministic)
+}
+func (dst *VersionSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionSize.Merge(dst, src)
+}
+func (m *VersionSize) XXX_Size() int {
+	return xxx_messageInfo_VersionSize.Size(m)
+}
+func (m *VersionSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionSize proto.InternalMessageInfo
+
+func (m *VersionSize) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *VersionSize) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *VersionSize) GetEmbeddedDataBytes() uint64 {
+	if m != nil {
+		return m.EmbeddedDataBytes
+	}
+	return 0
+}
+
+func (m *VersionSize) GetSignaturesBytes() uint64 {
+	if m != nil {
+		return m.SignaturesBytes
+	}
+	return 0
+}
+
+func (m *VersionSize) GetSaltsBytes() uint64 {
+	if m != nil {
+		return m.SaltsBytes
+	}
+	return 0
+}
+
+func (m *VersionSize) GetCoreDocumentLeaves() uint64 {
+	if m != nil {
+		return m.CoreDocumentLeaves
+	}
+	return 0
+}
+
+func (m *VersionSize) GetEmbeddedDataLeaves() uint64 {
+	if m != nil {
+		return m.EmbeddedDataLeaves
+	}
+	return 0
+}
+
+type PropertyMapping struct {
+	// readable name of the property, repeated elements and map values use {index} and {key} placeholders
+	ReadableName string `protobuf:"bytes,1,opt,name=readable_name,json=readableName,proto3" json:"readable_name,omitempty"`
+	// hex encoded compact name of the property, using the same placeholders as the readable name
+	CompactName string `protobuf:"bytes,2,opt,name=compact_name,json=compactName,proto3" json:"compact_name,omitempty"`
+	// deprecated is set if the property or any of its parents is marked as deprecated
+	Deprecated           bool     `protobuf:"varint,3,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PropertyMapping) Reset()         { *m = PropertyMapping{} }
+func (m *PropertyMapping) String() string { return proto.CompactTextString(m) }
+func (*PropertyMapping) ProtoMessage()    {}
+func (*PropertyMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{13}
+}
+func (m *PropertyMapping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PropertyMapping.Unmarshal(m, b)
+}
+func (m *PropertyMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PropertyMapping.Marshal(b, m, deterministic)
+}
+func (dst *PropertyMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PropertyMapping.Merge(dst, src)
+}
+func (m *PropertyMapping) XXX_Size() int {
+	return xxx_messageInfo_PropertyMapping.Size(m)
+}
+func (m *PropertyMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_PropertyMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PropertyMapping proto.InternalMessageInfo
+
+func (m *PropertyMapping) GetReadableName() string {
+	if m != nil {
+		return m.ReadableName
+	}
+	return ""
+}
+
+func (m *PropertyMapping) GetCompactName() string {
+	if m != nil {
+		return m.CompactName
+	}
+	return ""
+}
+
+func (m *PropertyMapping) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+type DocumentPropertyMappings struct {
+	DocumentType         string             `protobuf:"bytes,1,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	Properties           []*PropertyMapping `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DocumentPropertyMappings) Reset()         { *m = DocumentPropertyMappings{} }
+func (m *DocumentPropertyMappings) String() string { return proto.CompactTextString(m) }
+func (*DocumentPropertyMappings) ProtoMessage()    {}
+func (*DocumentPropertyMappings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{14}
+}
+func (m *DocumentPropertyMappings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentPropertyMappings.Unmarshal(m, b)
+}
+func (m *DocumentPropertyMappings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentPropertyMappings.Marshal(b, m, deterministic)
+}
+func (dst *DocumentPropertyMappings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentPropertyMappings.Merge(dst, src)
+}
+func (m *DocumentPropertyMappings) XXX_Size() int {
+	return xxx_messageInfo_DocumentPropertyMappings.Size(m)
+}
+func (m *DocumentPropertyMappings) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentPropertyMappings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentPropertyMappings proto.InternalMessageInfo
+
+func (m *DocumentPropertyMappings) GetDocumentType() string {
+	if m != nil {
+		return m.DocumentType
+	}
+	return ""
+}
+
+func (m *DocumentPropertyMappings) GetProperties() []*PropertyMapping {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+type PropertyMappingsResponse struct {
+	CoreDocument         []*PropertyMapping          `protobuf:"bytes,1,rep,name=core_document,json=coreDocument,proto3" json:"core_document,omitempty"`
+	Documents            []*DocumentPropertyMappings `protobuf:"bytes,2,rep,name=documents,proto3" json:"documents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *PropertyMappingsResponse) Reset()         { *m = PropertyMappingsResponse{} }
+func (m *PropertyMappingsResponse) String() string { return proto.CompactTextString(m) }
+func (*PropertyMappingsResponse) ProtoMessage()    {}
+func (*PropertyMappingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{15}
+}
+func (m *PropertyMappingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PropertyMappingsResponse.Unmarshal(m, b)
+}
+func (m *PropertyMappingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PropertyMappingsResponse.Marshal(b, m, deterministic)
+}
+func (dst *PropertyMappingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PropertyMappingsResponse.Merge(dst, src)
+}
+func (m *PropertyMappingsResponse) XXX_Size() int {
+	return xxx_messageInfo_PropertyMappingsResponse.Size(m)
+}
+func (m *PropertyMappingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PropertyMappingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PropertyMappingsResponse proto.InternalMessageInfo
+
+func (m *PropertyMappingsResponse) GetCoreDocument() []*PropertyMapping {
+	if m != nil {
+		return m.CoreDocument
+	}
+	return nil
+}
+
+func (m *PropertyMappingsResponse) GetDocuments() []*DocumentPropertyMappings {
+	if m != nil {
+		return m.Documents
+	}
+	return nil
+}
+
+type DocumentCreatePayload struct {
+	// scheme of the document type, such as generic
+	Scheme        string   `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Collaborators []string `protobuf:"bytes,2,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	// document type specific client data
+	Data *_struct.Struct `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// collaborators of the rules may only edit the fields of their rules, the other collaborators may edit every field
+	FieldRules []*FieldRule `protobuf:"bytes,4,rep,name=field_rules,json=fieldRules,proto3" json:"field_rules,omitempty"`
+	// collaborators that can only read the document, they don't sign it
+	ReadAccess []string `protobuf:"bytes,5,rep,name=read_access,json=readAccess,proto3" json:"read_access,omitempty"`
+	// collaborators that can read, sign and update the document, in addition to collaborators
+	WriteAccess []string `protobuf:"bytes,6,rep,name=write_access,json=writeAccess,proto3" json:"write_access,omitempty"`
+	// signatures the read and write collaborators of the request must add to every version, signing with several keys if they are fewer
+	RequiredSignatures   uint32   `protobuf:"varint,7,opt,name=required_signatures,json=requiredSignatures,proto3" json:"required_signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DocumentCreatePayload) Reset()         { *m = DocumentCreatePayload{} }
+func (m *DocumentCreatePayload) String() string { return proto.CompactTextString(m) }
+func (*DocumentCreatePayload) ProtoMessage()    {}
+func (*DocumentCreatePayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{16}
+}
+func (m *DocumentCreatePayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentCreatePayload.Unmarshal(m, b)
+}
+func (m *DocumentCreatePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentCreatePayload.Marshal(b, m, deterministic)
+}
+func (dst *DocumentCreatePayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentCreatePayload.Merge(dst, src)
+}
+func (m *DocumentCreatePayload) XXX_Size() int {
+	return xxx_messageInfo_DocumentCreatePayload.Size(m)
+}
+func (m *DocumentCreatePayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentCreatePayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentCreatePayload proto.InternalMessageInfo
+
+func (m *DocumentCreatePayload) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *DocumentCreatePayload) GetCollaborators() []string {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+func (m *DocumentCreatePayload) GetData() *_struct.Struct {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DocumentCreatePayload) GetFieldRules() []*FieldRule {
+	if m != nil {
+		return m.FieldRules
+	}
+	return nil
+}
+
+func (m *DocumentCreatePayload) GetReadAccess() []string {
+	if m != nil {
+		return m.ReadAccess
+	}
+	return nil
+}
+
+func (m *DocumentCreatePayload) GetWriteAccess() []string {
+	if m != nil {
+		return m.WriteAccess
+	}
+	return nil
+}
+
+func (m *DocumentCreatePayload) GetRequiredSignatures() uint32 {
+	if m != nil {
+		return m.RequiredSignatures
+	}
+	return 0
+}
+
+type DocumentUpdatePayload struct {
+	Scheme        string          `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Identifier    string          `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Collaborators []string        `protobuf:"bytes,3,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	Data          *_struct.Struct `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// replace the field rules of the collaborators of the rules
+	FieldRules []*FieldRule `protobuf:"bytes,5,rep,name=field_rules,json=fieldRules,proto3" json:"field_rules,omitempty"`
+	// collaborators that can only read the document, they don't sign it
+	ReadAccess []string `protobuf:"bytes,6,rep,name=read_access,json=readAccess,proto3" json:"read_access,omitempty"`
+	// collaborators that can read, sign and update the document, in addition to collaborators
+	WriteAccess []string `protobuf:"bytes,7,rep,name=write_access,json=writeAccess,proto3" json:"write_access,omitempty"`
+	// signatures the read and write collaborators of the request must add to every version, signing with several keys if they are fewer
+	RequiredSignatures   uint32   `protobuf:"varint,8,opt,name=required_signatures,json=requiredSignatures,proto3" json:"required_signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DocumentUpdatePayload) Reset()         { *m = DocumentUpdatePayload{} }
+func (m *DocumentUpdatePayload) String() string { return proto.CompactTextString(m) }
+func (*DocumentUpdatePayload) ProtoMessage()    {}
+func (*DocumentUpdatePayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{17}
+}
+func (m *DocumentUpdatePayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentUpdatePayload.Unmarshal(m, b)
+}
+func (m *DocumentUpdatePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentUpdatePayload.Marshal(b, m, deterministic)
+}
+func (dst *DocumentUpdatePayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentUpdatePayload.Merge(dst, src)
+}
+func (m *DocumentUpdatePayload) XXX_Size() int {
+	return xxx_messageInfo_DocumentUpdatePayload.Size(m)
+}
+func (m *DocumentUpdatePayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentUpdatePayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentUpdatePayload proto.InternalMessageInfo
+
+func (m *DocumentUpdatePayload) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *DocumentUpdatePayload) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *DocumentUpdatePayload) GetCollaborators() []string {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+func (m *DocumentUpdatePayload) GetData() *_struct.Struct {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DocumentUpdatePayload) GetFieldRules() []*FieldRule {
+	if m != nil {
+		return m.FieldRules
+	}
+	return nil
+}
+
+func (m *DocumentUpdatePayload) GetReadAccess() []string {
+	if m != nil {
+		return m.ReadAccess
+	}
+	return nil
+}
+
+func (m *DocumentUpdatePayload) GetWriteAccess() []string {
+	if m != nil {
+		return m.WriteAccess
+	}
+	return nil
+}
+
+func (m *DocumentUpdatePayload) GetRequiredSignatures() uint32 {
+	if m != nil {
+		return m.RequiredSignatures
+	}
+	return 0
+}
+
+type GetDocumentRequest struct {
+	Scheme     string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// only return the header, without the document data
+	HeaderOnly bool `protobuf:"varint,3,opt,name=header_only,json=headerOnly,proto3" json:"header_only,omitempty"`
+	// include the salts of the document fields
+	IncludeSalts bool `protobuf:"varint,4,opt,name=include_salts,json=includeSalts,proto3" json:"include_salts,omitempty"`
+	// include the signatures of the collaborators
+	IncludeSignatures    bool     `protobuf:"varint,5,opt,name=include_signatures,json=includeSignatures,proto3" json:"include_signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDocumentRequest) Reset()         { *m = GetDocumentRequest{} }
+func (m *GetDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDocumentRequest) ProtoMessage()    {}
+func (*GetDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{18}
+}
+func (m *GetDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDocumentRequest.Unmarshal(m, b)
+}
+func (m *GetDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDocumentRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDocumentRequest.Merge(dst, src)
+}
+func (m *GetDocumentRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDocumentRequest.Size(m)
+}
+func (m *GetDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDocumentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDocumentRequest proto.InternalMessageInfo
+
+func (m *GetDocumentRequest) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *GetDocumentRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *GetDocumentRequest) GetHeaderOnly() bool {
+	if m != nil {
+		return m.HeaderOnly
+	}
+	return false
+}
+
+func (m *GetDocumentRequest) GetIncludeSalts() bool {
+	if m != nil {
+		return m.IncludeSalts
+	}
+	return false
+}
+
+func (m *GetDocumentRequest) GetIncludeSignatures() bool {
+	if m != nil {
+		return m.IncludeSignatures
+	}
+	return false
+}
+
+type GetDocumentVersionRequest struct {
+	Scheme               string   `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Identifier           string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	HeaderOnly           bool     `protobuf:"varint,4,opt,name=header_only,json=headerOnly,proto3" json:"header_only,omitempty"`
+	IncludeSalts         bool     `protobuf:"varint,5,opt,name=include_salts,json=includeSalts,proto3" json:"include_salts,omitempty"`
+	IncludeSignatures    bool     `protobuf:"varint,6,opt,name=include_signatures,json=includeSignatures,proto3" json:"include_signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDocumentVersionRequest) Reset()         { *m = GetDocumentVersionRequest{} }
+func (m *GetDocumentVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDocumentVersionRequest) ProtoMessage()    {}
+func (*GetDocumentVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{19}
+}
+func (m *GetDocumentVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDocumentVersionRequest.Unmarshal(m, b)
+}
+func (m *GetDocumentVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDocumentVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDocumentVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDocumentVersionRequest.Merge(dst, src)
+}
+func (m *GetDocumentVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDocumentVersionRequest.Size(m)
+}
+func (m *GetDocumentVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDocumentVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDocumentVersionRequest proto.InternalMessageInfo
+
+func (m *GetDocumentVersionRequest) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *GetDocumentVersionRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *GetDocumentVersionRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *GetDocumentVersionRequest) GetHeaderOnly() bool {
+	if m != nil {
+		return m.HeaderOnly
+	}
+	return false
+}
+
+func (m *GetDocumentVersionRequest) GetIncludeSalts() bool {
+	if m != nil {
+		return m.IncludeSalts
+	}
+	return false
+}
+
+func (m *GetDocumentVersionRequest) GetIncludeSignatures() bool {
+	if m != nil {
+		return m.IncludeSignatures
+	}
+	return false
+}
+
+type DocumentResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// document type specific client data
+	Data *_struct.Struct `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// only set if requested
+	Salts []*DocumentSalt `protobuf:"bytes,3,rep,name=salts,proto3" json:"salts,omitempty"`
+	// only set if requested
+	Signatures           []*BundleSignature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DocumentResponse) Reset()         { *m = DocumentResponse{} }
+func (m *DocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*DocumentResponse) ProtoMessage()    {}
+func (*DocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{20}
+}
+func (m *DocumentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentResponse.Unmarshal(m, b)
+}
+func (m *DocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentResponse.Marshal(b, m, deterministic)
+}
+func (dst *DocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentResponse.Merge(dst, src)
+}
+func (m *DocumentResponse) XXX_Size() int {
+	return xxx_messageInfo_DocumentResponse.Size(m)
+}
+func (m *DocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentResponse proto.InternalMessageInfo
+
+func (m *DocumentResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DocumentResponse) GetData() *_struct.Struct {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DocumentResponse) GetSalts() []*DocumentSalt {
+	if m != nil {
+		return m.Salts
+	}
+	return nil
+}
+
+func (m *DocumentResponse) GetSignatures() []*BundleSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+type CreateProofRequest struct {
+	Scheme     string   `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Identifier string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Fields     []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// request a co-signature over the proofs from the configured notary
+	Notarize bool `protobuf:"varint,4,opt,name=notarize,proto3" json:"notarize,omitempty"`
+	// add a proof of the document type, fields can be empty to only prove the document type
+	ProveDocumentType bool `protobuf:"varint,5,opt,name=prove_document_type,json=proveDocumentType,proto3" json:"prove_document_type,omitempty"`
+	// return the proofs as a single multiproof sharing the sibling hashes of the fields
+	Multiproof           bool     `protobuf:"varint,6,opt,name=multiproof,proto3" json:"multiproof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateProofRequest) Reset()         { *m = CreateProofRequest{} }
+func (m *CreateProofRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateProofRequest) ProtoMessage()    {}
+func (*CreateProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{21}
+}
+func (m *CreateProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProofRequest.Unmarshal(m, b)
+}
+func (m *CreateProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProofRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProofRequest.Merge(dst, src)
+}
+func (m *CreateProofRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateProofRequest.Size(m)
+}
+func (m *CreateProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProofRequest proto.InternalMessageInfo
+
+func (m *CreateProofRequest) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *CreateProofRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *CreateProofRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *CreateProofRequest) GetNotarize() bool {
+	if m != nil {
+		return m.Notarize
+	}
+	return false
+}
+
+func (m *CreateProofRequest) GetProveDocumentType() bool {
+	if m != nil {
+		return m.ProveDocumentType
+	}
+	return false
+}
+
+func (m *CreateProofRequest) GetMultiproof() bool {
+	if m != nil {
+		return m.Multiproof
+	}
+	return false
+}
+
+type CreateProofForVersionRequest struct {
+	Scheme            string   `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Identifier        string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Version           string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Fields            []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Notarize          bool     `protobuf:"varint,5,opt,name=notarize,proto3" json:"notarize,omitempty"`
+	ProveDocumentType bool     `protobuf:"varint,6,opt,name=prove_document_type,json=proveDocumentType,proto3" json:"prove_document_type,omitempty"`
+	// return the proofs as a single multiproof sharing the sibling hashes of the fields
+	Multiproof           bool     `protobuf:"varint,7,opt,name=multiproof,proto3" json:"multiproof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateProofForVersionRequest) Reset()         { *m = CreateProofForVersionRequest{} }
+func (m *CreateProofForVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateProofForVersionRequest) ProtoMessage()    {}
+func (*CreateProofForVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{22}
+}
+func (m *CreateProofForVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProofForVersionRequest.Unmarshal(m, b)
+}
+func (m *CreateProofForVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProofForVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateProofForVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProofForVersionRequest.Merge(dst, src)
+}
+func (m *CreateProofForVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateProofForVersionRequest.Size(m)
+}
+func (m *CreateProofForVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProofForVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProofForVersionRequest proto.InternalMessageInfo
+
+func (m *CreateProofForVersionRequest) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *CreateProofForVersionRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *CreateProofForVersionRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CreateProofForVersionRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *CreateProofForVersionRequest) GetNotarize() bool {
+	if m != nil {
+		return m.Notarize
+	}
+	return false
+}
+
+func (m *CreateProofForVersionRequest) GetProveDocumentType() bool {
+	if m != nil {
+		return m.ProveDocumentType
+	}
+	return false
+}
+
+func (m *CreateProofForVersionRequest) GetMultiproof() bool {
+	if m != nil {
+		return m.Multiproof
+	}
+	return false
+}
+
+type ListDocumentsRequest struct {
+	// page to return, starting at 1, 1 if not set
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// number of documents in the page, 20 if not set, at most 100
+	PerPage              int32    `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDocumentsRequest) Reset()         { *m = ListDocumentsRequest{} }
+func (m *ListDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDocumentsRequest) ProtoMessage()    {}
+func (*ListDocumentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{23}
+}
+func (m *ListDocumentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDocumentsRequest.Unmarshal(m, b)
+}
+func (m *ListDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDocumentsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDocumentsRequest.Merge(dst, src)
+}
+func (m *ListDocumentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDocumentsRequest.Size(m)
+}
+func (m *ListDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDocumentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDocumentsRequest proto.InternalMessageInfo
+
+func (m *ListDocumentsRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListDocumentsRequest) GetPerPage() int32 {
+	if m != nil {
+		return m.PerPage
+	}
+	return 0
+}
+
+type DocumentListItem struct {
+	DocumentId   string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	DocumentType string `protobuf:"bytes,2,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	// lifecycle status of the latest version
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	LatestVersion        string   `protobuf:"bytes,4,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	Cancelled            bool     `protobuf:"varint,5,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	SupersededBy         string   `protobuf:"bytes,6,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DocumentListItem) Reset()         { *m = DocumentListItem{} }
+func (m *DocumentListItem) String() string { return proto.CompactTextString(m) }
+func (*DocumentListItem) ProtoMessage()    {}
+func (*DocumentListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{24}
+}
+func (m *DocumentListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentListItem.Unmarshal(m, b)
+}
+func (m *DocumentListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentListItem.Marshal(b, m, deterministic)
+}
+func (dst *DocumentListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentListItem.Merge(dst, src)
+}
+func (m *DocumentListItem) XXX_Size() int {
+	return xxx_messageInfo_DocumentListItem.Size(m)
+}
+func (m *DocumentListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentListItem proto.InternalMessageInfo
+
+func (m *DocumentListItem) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *DocumentListItem) GetDocumentType() string {
+	if m != nil {
+		return m.DocumentType
+	}
+	return ""
+}
+
+func (m *DocumentListItem) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DocumentListItem) GetLatestVersion() string {
+	if m != nil {
+		return m.LatestVersion
+	}
+	return ""
+}
+
+func (m *DocumentListItem) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *DocumentListItem) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
+	}
+	return ""
+}
+
+type ListDocumentsResponse struct {
+	Data    []*DocumentListItem `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Page    int32               `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage int32               `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	// number of documents over all the pages
+	Total                int32    `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDocumentsResponse) Reset()         { *m = ListDocumentsResponse{} }
+func (m *ListDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDocumentsResponse) ProtoMessage()    {}
+func (*ListDocumentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{25}
+}
+func (m *ListDocumentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDocumentsResponse.Unmarshal(m, b)
+}
+func (m *ListDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDocumentsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDocumentsResponse.Merge(dst, src)
+}
+func (m *ListDocumentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDocumentsResponse.Size(m)
+}
+func (m *ListDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDocumentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDocumentsResponse proto.InternalMessageInfo
+
+func (m *ListDocumentsResponse) GetData() []*DocumentListItem {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ListDocumentsResponse) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListDocumentsResponse) GetPerPage() int32 {
+	if m != nil {
+		return m.PerPage
+	}
+	return 0
+}
+
+func (m *ListDocumentsResponse) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type GetDocumentGraphRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDocumentGraphRequest) Reset()         { *m = GetDocumentGraphRequest{} }
+func (m *GetDocumentGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDocumentGraphRequest) ProtoMessage()    {}
+func (*GetDocumentGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{26}
+}
+func (m *GetDocumentGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDocumentGraphRequest.Unmarshal(m, b)
+}
+func (m *GetDocumentGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDocumentGraphRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDocumentGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDocumentGraphRequest.Merge(dst, src)
+}
+func (m *GetDocumentGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDocumentGraphRequest.Size(m)
+}
+func (m *GetDocumentGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDocumentGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDocumentGraphRequest proto.InternalMessageInfo
+
+func (m *GetDocumentGraphRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type DocumentGraphLink struct {
+	// document or nft
+	LinkType string `protobuf:"bytes,1,opt,name=link_type,json=linkType,proto3" json:"link_type,omitempty"`
+	// outgoing if the document links to the related document or NFT, incoming if the related document links to the document
+	Direction  string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	DocumentId string `protobuf:"bytes,3,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// type of the related document, empty if the document is not known to the node
+	DocumentType         string   `protobuf:"bytes,4,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	Registry             string   `protobuf:"bytes,5,opt,name=registry,proto3" json:"registry,omitempty"`
+	TokenId              string   `protobuf:"bytes,6,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DocumentGraphLink) Reset()         { *m = DocumentGraphLink{} }
+func (m *DocumentGraphLink) String() string { return proto.CompactTextString(m) }
+func (*DocumentGraphLink) ProtoMessage()    {}
+func (*DocumentGraphLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{27}
+}
+func (m *DocumentGraphLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentGraphLink.Unmarshal(m, b)
+}
+func (m *DocumentGraphLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentGraphLink.Marshal(b, m, deterministic)
+}
+func (dst *DocumentGraphLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentGraphLink.Merge(dst, src)
+}
+func (m *DocumentGraphLink) XXX_Size() int {
+	return xxx_messageInfo_DocumentGraphLink.Size(m)
+}
+func (m *DocumentGraphLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentGraphLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentGraphLink proto.InternalMessageInfo
+
+func (m *DocumentGraphLink) GetLinkType() string {
+	if m != nil {
+		return m.LinkType
+	}
+	return ""
+}
+
+func (m *DocumentGraphLink) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+func (m *DocumentGraphLink) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *DocumentGraphLink) GetDocumentType() string {
+	if m != nil {
+		return m.DocumentType
+	}
+	return ""
+}
+
+func (m *DocumentGraphLink) GetRegistry() string {
+	if m != nil {
+		return m.Registry
+	}
+	return ""
+}
+
+func (m *DocumentGraphLink) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+type DocumentGraph struct {
+	DocumentId           string               `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	DocumentType         string               `protobuf:"bytes,2,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	Links                []*DocumentGraphLink `protobuf:"bytes,3,rep,name=links,proto3" json:"links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DocumentGraph) Reset()         { *m = DocumentGraph{} }
+func (m *DocumentGraph) String() string { return proto.CompactTextString(m) }
+func (*DocumentGraph) ProtoMessage()    {}
+func (*DocumentGraph) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{28}
+}
+func (m *DocumentGraph) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentGraph.Unmarshal(m, b)
+}
+func (m *DocumentGraph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentGraph.Marshal(b, m, deterministic)
+}
+func (dst *DocumentGraph) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentGraph.Merge(dst, src)
+}
+func (m *DocumentGraph) XXX_Size() int {
+	return xxx_messageInfo_DocumentGraph.Size(m)
+}
+func (m *DocumentGraph) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentGraph.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentGraph proto.InternalMessageInfo
+
+func (m *DocumentGraph) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *DocumentGraph) GetDocumentType() string {
+	if m != nil {
+		return m.DocumentType
+	}
+	return ""
+}
+
+func (m *DocumentGraph) GetLinks() []*DocumentGraphLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+type GetVersionHistoryRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionHistoryRequest) Reset()         { *m = GetVersionHistoryRequest{} }
+func (m *GetVersionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVersionHistoryRequest) ProtoMessage()    {}
+func (*GetVersionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{29}
+}
+func (m *GetVersionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetVersionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionHistoryRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetVersionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionHistoryRequest.Merge(dst, src)
+}
+func (m *GetVersionHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetVersionHistoryRequest.Size(m)
+}
+func (m *GetVersionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionHistoryRequest proto.InternalMessageInfo
+
+func (m *GetVersionHistoryRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type VersionHistoryEntry struct {
+	VersionId         string               `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	PreviousVersionId string               `protobuf:"bytes,2,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	Author            string               `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Timestamp         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// lifecycle status of the version
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// anchored document root of the version, empty if the version is not committed
+	DocumentRoot         string   `protobuf:"bytes,6,opt,name=document_root,json=documentRoot,proto3" json:"document_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionHistoryEntry) Reset()         { *m = VersionHistoryEntry{} }
+func (m *VersionHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*VersionHistoryEntry) ProtoMessage()    {}
+func (*VersionHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{30}
+}
+func (m *VersionHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionHistoryEntry.Unmarshal(m, b)
+}
+func (m *VersionHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionHistoryEntry.Marshal(b, m, deterministic)
+}
+func (dst *VersionHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionHistoryEntry.Merge(dst, src)
+}
+func (m *VersionHistoryEntry) XXX_Size() int {
+	return xxx_messageInfo_VersionHistoryEntry.Size(m)
+}
+func (m *VersionHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionHistoryEntry proto.InternalMessageInfo
+
+func (m *VersionHistoryEntry) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *VersionHistoryEntry) GetPreviousVersionId() string {
+	if m != nil {
+		return m.PreviousVersionId
+	}
+	return ""
+}
+
+func (m *VersionHistoryEntry) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *VersionHistoryEntry) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *VersionHistoryEntry) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *VersionHistoryEntry) GetDocumentRoot() string {
+	if m != nil {
+		return m.DocumentRoot
+	}
+	return ""
+}
+
+type VersionHistoryResponse struct {
+	DocumentId   string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	DocumentType string `protobuf:"bytes,2,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	// versions ordered from the oldest to the latest locally known version
+	Versions             []*VersionHistoryEntry `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *VersionHistoryResponse) Reset()         { *m = VersionHistoryResponse{} }
+func (m *VersionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*VersionHistoryResponse) ProtoMessage()    {}
+func (*VersionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{31}
+}
+func (m *VersionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionHistoryResponse.Unmarshal(m, b)
+}
+func (m *VersionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionHistoryResponse.Marshal(b, m, deterministic)
+}
+func (dst *VersionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionHistoryResponse.Merge(dst, src)
+}
+func (m *VersionHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_VersionHistoryResponse.Size(m)
+}
+func (m *VersionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionHistoryResponse proto.InternalMessageInfo
+
+func (m *VersionHistoryResponse) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *VersionHistoryResponse) GetDocumentType() string {
+	if m != nil {
+		return m.DocumentType
+	}
+	return ""
+}
+
+func (m *VersionHistoryResponse) GetVersions() []*VersionHistoryEntry {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type GetVersionDiffRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	FromVersion          string   `protobuf:"bytes,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion            string   `protobuf:"bytes,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionDiffRequest) Reset()         { *m = GetVersionDiffRequest{} }
+func (m *GetVersionDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVersionDiffRequest) ProtoMessage()    {}
+func (*GetVersionDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{32}
+}
+func (m *GetVersionDiffRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionDiffRequest.Unmarshal(m, b)
+}
+func (m *GetVersionDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionDiffRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetVersionDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionDiffRequest.Merge(dst, src)
+}
+func (m *GetVersionDiffRequest) XXX_Size() int {
+	return xxx_messageInfo_GetVersionDiffRequest.Size(m)
+}
+func (m *GetVersionDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionDiffRequest proto.InternalMessageInfo
+
+func (m *GetVersionDiffRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *GetVersionDiffRequest) GetFromVersion() string {
+	if m != nil {
+		return m.FromVersion
+	}
+	return ""
+}
+
+func (m *GetVersionDiffRequest) GetToVersion() string {
+	if m != nil {
+		return m.ToVersion
+	}
+	return ""
+}
+
+type FieldChange struct {
+	// hex encoded compact property of the field
+	Property string `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	// readable property name of the field
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// hex encoded value in the from version, empty if the field was added
+	OldValue string `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// hex encoded value in the to version, empty if the field was removed
+	NewValue             string   `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldChange) Reset()         { *m = FieldChange{} }
+func (m *FieldChange) String() string { return proto.CompactTextString(m) }
+func (*FieldChange) ProtoMessage()    {}
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{33}
+}
+func (m *FieldChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldChange.Unmarshal(m, b)
+}
+func (m *FieldChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldChange.Marshal(b, m, deterministic)
+}
+func (dst *FieldChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldChange.Merge(dst, src)
+}
+func (m *FieldChange) XXX_Size() int {
+	return xxx_messageInfo_FieldChange.Size(m)
+}
+func (m *FieldChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldChange proto.InternalMessageInfo
+
+func (m *FieldChange) GetProperty() string {
+	if m != nil {
+		return m.Property
+	}
+	return ""
+}
+
+func (m *FieldChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FieldChange) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *FieldChange) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+type VersionDiffResponse struct {
+	DocumentId           string         `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	FromVersion          string         `protobuf:"bytes,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion            string         `protobuf:"bytes,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	Changes              []*FieldChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *VersionDiffResponse) Reset()         { *m = VersionDiffResponse{} }
+func (m *VersionDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*VersionDiffResponse) ProtoMessage()    {}
+func (*VersionDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{34}
+}
+func (m *VersionDiffResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionDiffResponse.Unmarshal(m, b)
+}
+func (m *VersionDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionDiffResponse.Marshal(b, m, deterministic)
+}
+func (dst *VersionDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionDiffResponse.Merge(dst, src)
+}
+func (m *VersionDiffResponse) XXX_Size() int {
+	return xxx_messageInfo_VersionDiffResponse.Size(m)
+}
+func (m *VersionDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionDiffResponse proto.InternalMessageInfo
+
+func (m *VersionDiffResponse) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *VersionDiffResponse) GetFromVersion() string {
+	if m != nil {
+		return m.FromVersion
+	}
+	return ""
+}
+
+func (m *VersionDiffResponse) GetToVersion() string {
+	if m != nil {
+		return m.ToVersion
+	}
+	return ""
+}
+
+func (m *VersionDiffResponse) GetChanges() []*FieldChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type ImportDocumentsRequest struct {
+	// scheme of the document type, such as invoice
+	Scheme string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// encoding of the batch, csv or jsonl
+	Format        string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Collaborators []string `protobuf:"bytes,3,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	// CSV file with a header row or one json object per line, columns are mapped to client data fields by the configured import mapping
+	Data                 string   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportDocumentsRequest) Reset()         { *m = ImportDocumentsRequest{} }
+func (m *ImportDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportDocumentsRequest) ProtoMessage()    {}
+func (*ImportDocumentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{35}
+}
+func (m *ImportDocumentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportDocumentsRequest.Unmarshal(m, b)
+}
+func (m *ImportDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportDocumentsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportDocumentsRequest.Merge(dst, src)
+}
+func (m *ImportDocumentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportDocumentsRequest.Size(m)
+}
+func (m *ImportDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportDocumentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportDocumentsRequest proto.InternalMessageInfo
+
+func (m *ImportDocumentsRequest) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *ImportDocumentsRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ImportDocumentsRequest) GetCollaborators() []string {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+func (m *ImportDocumentsRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type ImportRowResult struct {
+	// 1-based index of the row in the batch, the CSV header and blank lines excluded
+	Row           int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	DocumentId    string `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	TransactionId string `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// reason the row was not imported, empty if the document was created
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportRowResult) Reset()         { *m = ImportRowResult{} }
+func (m *ImportRowResult) String() string { return proto.CompactTextString(m) }
+func (*ImportRowResult) ProtoMessage()    {}
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{36}
+}
+func (m *ImportRowResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportRowResult.Unmarshal(m, b)
+}
+func (m *ImportRowResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportRowResult.Marshal(b, m, deterministic)
+}
+func (dst *ImportRowResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRowResult.Merge(dst, src)
+}
+func (m *ImportRowResult) XXX_Size() int {
+	return xxx_messageInfo_ImportRowResult.Size(m)
+}
+func (m *ImportRowResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRowResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRowResult proto.InternalMessageInfo
+
+func (m *ImportRowResult) GetRow() int32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportRowResult) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *ImportRowResult) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *ImportRowResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ImportDocumentsResponse struct {
+	Imported             int32              `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failed               int32              `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Results              []*ImportRowResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ImportDocumentsResponse) Reset()         { *m = ImportDocumentsResponse{} }
+func (m *ImportDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportDocumentsResponse) ProtoMessage()    {}
+func (*ImportDocumentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{37}
+}
+func (m *ImportDocumentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportDocumentsResponse.Unmarshal(m, b)
+}
+func (m *ImportDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportDocumentsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImportDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportDocumentsResponse.Merge(dst, src)
+}
+func (m *ImportDocumentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportDocumentsResponse.Size(m)
+}
+func (m *ImportDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportDocumentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportDocumentsResponse proto.InternalMessageInfo
+
+func (m *ImportDocumentsResponse) GetImported() int32 {
+	if m != nil {
+		return m.Imported
+	}
+	return 0
+}
+
+func (m *ImportDocumentsResponse) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ImportDocumentsResponse) GetResults() []*ImportRowResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type RemoveCollaboratorsRequest struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// collaborators to remove, they don't receive the next versions of the document
+	Collaborators        []string `protobuf:"bytes,2,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveCollaboratorsRequest) Reset()         { *m = RemoveCollaboratorsRequest{} }
+func (m *RemoveCollaboratorsRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveCollaboratorsRequest) ProtoMessage()    {}
+func (*RemoveCollaboratorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{38}
+}
+func (m *RemoveCollaboratorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveCollaboratorsRequest.Unmarshal(m, b)
+}
+func (m *RemoveCollaboratorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveCollaboratorsRequest.Marshal(b, m, deterministic)
+}
+func (dst *RemoveCollaboratorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCollaboratorsRequest.Merge(dst, src)
+}
+func (m *RemoveCollaboratorsRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveCollaboratorsRequest.Size(m)
+}
+func (m *RemoveCollaboratorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCollaboratorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveCollaboratorsRequest proto.InternalMessageInfo
+
+func (m *RemoveCollaboratorsRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *RemoveCollaboratorsRequest) GetCollaborators() []string {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+type RemoveCollaboratorsResponse struct {
+	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RemoveCollaboratorsResponse) Reset()         { *m = RemoveCollaboratorsResponse{} }
+func (m *RemoveCollaboratorsResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveCollaboratorsResponse) ProtoMessage()    {}
+func (*RemoveCollaboratorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{39}
+}
+func (m *RemoveCollaboratorsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveCollaboratorsResponse.Unmarshal(m, b)
+}
+func (m *RemoveCollaboratorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveCollaboratorsResponse.Marshal(b, m, deterministic)
+}
+func (dst *RemoveCollaboratorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCollaboratorsResponse.Merge(dst, src)
+}
+func (m *RemoveCollaboratorsResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveCollaboratorsResponse.Size(m)
+}
+func (m *RemoveCollaboratorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCollaboratorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveCollaboratorsResponse proto.InternalMessageInfo
+
+func (m *RemoveCollaboratorsResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type ExportLedgerRequest struct {
+	// encoding of the export, csv or json, csv if not set
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// first day of the range as YYYY-MM-DD, the range is open if not set
+	FromDate string `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	// last day of the range as YYYY-MM-DD, the range is open if not set
+	ToDate               string   `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportLedgerRequest) Reset()         { *m = ExportLedgerRequest{} }
+func (m *ExportLedgerRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportLedgerRequest) ProtoMessage()    {}
+func (*ExportLedgerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{40}
+}
+func (m *ExportLedgerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportLedgerRequest.Unmarshal(m, b)
+}
+func (m *ExportLedgerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportLedgerRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportLedgerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportLedgerRequest.Merge(dst, src)
+}
+func (m *ExportLedgerRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportLedgerRequest.Size(m)
+}
+func (m *ExportLedgerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportLedgerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportLedgerRequest proto.InternalMessageInfo
+
+func (m *ExportLedgerRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportLedgerRequest) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
+	}
+	return ""
+}
+
+func (m *ExportLedgerRequest) GetToDate() string {
+	if m != nil {
+		return m.ToDate
+	}
+	return ""
+}
+
+type ExportLedgerResponse struct {
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// number of exported events
+	Events int32 `protobuf:"varint,2,opt,name=events,proto3" json:"events,omitempty"`
+	// CSV file with a header row or json array of the events, ordered by time
+	Data                 string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportLedgerResponse) Reset()         { *m = ExportLedgerResponse{} }
+func (m *ExportLedgerResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportLedgerResponse) ProtoMessage()    {}
+func (*ExportLedgerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{41}
+}
+func (m *ExportLedgerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportLedgerResponse.Unmarshal(m, b)
+}
+func (m *ExportLedgerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportLedgerResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportLedgerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportLedgerResponse.Merge(dst, src)
+}
+func (m *ExportLedgerResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportLedgerResponse.Size(m)
+}
+func (m *ExportLedgerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportLedgerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportLedgerResponse proto.InternalMessageInfo
+
+func (m *ExportLedgerResponse) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportLedgerResponse) GetEvents() int32 {
+	if m != nil {
+		return m.Events
+	}
+	return 0
+}
+
+func (m *ExportLedgerResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type FieldRule struct {
+	Collaborators []string `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	// readable paths of the fields of the document data, such as invoice.invoice_status
+	Fields               []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldRule) Reset()         { *m = FieldRule{} }
+func (m *FieldRule) String() string { return proto.CompactTextString(m) }
+func (*FieldRule) ProtoMessage()    {}
+func (*FieldRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{42}
+}
+func (m *FieldRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldRule.Unmarshal(m, b)
+}
+func (m *FieldRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldRule.Marshal(b, m, deterministic)
+}
+func (dst *FieldRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldRule.Merge(dst, src)
+}
+func (m *FieldRule) XXX_Size() int {
+	return xxx_messageInfo_FieldRule.Size(m)
+}
+func (m *FieldRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldRule proto.InternalMessageInfo
+
+func (m *FieldRule) GetCollaborators() []string {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+func (m *FieldRule) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type ListAccessTokensRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAccessTokensRequest) Reset()         { *m = ListAccessTokensRequest{} }
+func (m *ListAccessTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAccessTokensRequest) ProtoMessage()    {}
+func (*ListAccessTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{43}
+}
+func (m *ListAccessTokensRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccessTokensRequest.Unmarshal(m, b)
+}
+func (m *ListAccessTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccessTokensRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAccessTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccessTokensRequest.Merge(dst, src)
+}
+func (m *ListAccessTokensRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAccessTokensRequest.Size(m)
+}
+func (m *ListAccessTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccessTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccessTokensRequest proto.InternalMessageInfo
+
+func (m *ListAccessTokensRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type AccessTokenEntry struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Granter    string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee    string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// read if the token grants access to the document, proofs if it only grants proofs of fields
+	Scope string `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	// empty if the token doesn't expire
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AccessTokenEntry) Reset()         { *m = AccessTokenEntry{} }
+func (m *AccessTokenEntry) String() string { return proto.CompactTextString(m) }
+func (*AccessTokenEntry) ProtoMessage()    {}
+func (*AccessTokenEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{44}
+}
+func (m *AccessTokenEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessTokenEntry.Unmarshal(m, b)
+}
+func (m *AccessTokenEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessTokenEntry.Marshal(b, m, deterministic)
+}
+func (dst *AccessTokenEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessTokenEntry.Merge(dst, src)
+}
+func (m *AccessTokenEntry) XXX_Size() int {
+	return xxx_messageInfo_AccessTokenEntry.Size(m)
+}
+func (m *AccessTokenEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessTokenEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessTokenEntry proto.InternalMessageInfo
+
+func (m *AccessTokenEntry) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *AccessTokenEntry) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *AccessTokenEntry) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *AccessTokenEntry) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+func (m *AccessTokenEntry) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type ListAccessTokensResponse struct {
+	DocumentId           string              `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Tokens               []*AccessTokenEntry `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListAccessTokensResponse) Reset()         { *m = ListAccessTokensResponse{} }
+func (m *ListAccessTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAccessTokensResponse) ProtoMessage()    {}
+func (*ListAccessTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{45}
+}
+func (m *ListAccessTokensResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccessTokensResponse.Unmarshal(m, b)
+}
+func (m *ListAccessTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccessTokensResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListAccessTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccessTokensResponse.Merge(dst, src)
+}
+func (m *ListAccessTokensResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAccessTokensResponse.Size(m)
+}
+func (m *ListAccessTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccessTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccessTokensResponse proto.InternalMessageInfo
+
+func (m *ListAccessTokensResponse) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *ListAccessTokensResponse) GetTokens() []*AccessTokenEntry {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type DocumentSalt struct {
+	// compact property of the salted field
+	Compact              string   `protobuf:"bytes,1,opt,name=compact,proto3" json:"compact,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DocumentSalt) Reset()         { *m = DocumentSalt{} }
+func (m *DocumentSalt) String() string { return proto.CompactTextString(m) }
+func (*DocumentSalt) ProtoMessage()    {}
+func (*DocumentSalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{46}
+}
+func (m *DocumentSalt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentSalt.Unmarshal(m, b)
+}
+func (m *DocumentSalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentSalt.Marshal(b, m, deterministic)
+}
+func (dst *DocumentSalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentSalt.Merge(dst, src)
+}
+func (m *DocumentSalt) XXX_Size() int {
+	return xxx_messageInfo_DocumentSalt.Size(m)
+}
+func (m *DocumentSalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentSalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentSalt proto.InternalMessageInfo
+
+func (m *DocumentSalt) GetCompact() string {
+	if m != nil {
+		return m.Compact
+	}
+	return ""
+}
+
+func (m *DocumentSalt) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type SignAttributeRequest struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// readable property of the attribute, such as invoice.gross_amount
+	Property             string   `protobuf:"bytes,2,opt,name=property,proto3" json:"property,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignAttributeRequest) Reset()         { *m = SignAttributeRequest{} }
+func (m *SignAttributeRequest) String() string { return proto.CompactTextString(m) }
+func (*SignAttributeRequest) ProtoMessage()    {}
+func (*SignAttributeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{47}
+}
+func (m *SignAttributeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignAttributeRequest.Unmarshal(m, b)
+}
+func (m *SignAttributeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignAttributeRequest.Marshal(b, m, deterministic)
+}
+func (dst *SignAttributeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignAttributeRequest.Merge(dst, src)
+}
+func (m *SignAttributeRequest) XXX_Size() int {
+	return xxx_messageInfo_SignAttributeRequest.Size(m)
+}
+func (m *SignAttributeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignAttributeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignAttributeRequest proto.InternalMessageInfo
+
+func (m *SignAttributeRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *SignAttributeRequest) GetProperty() string {
+	if m != nil {
+		return m.Property
+	}
+	return ""
+}
+
+type AttributeSignature struct {
+	SignerId  string `protobuf:"bytes,1,opt,name=signer_id,json=signerId,proto3" json:"signer_id,omitempty"`
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// readable property of the attribute
+	Property string `protobuf:"bytes,3,opt,name=property,proto3" json:"property,omitempty"`
+	// value of the attribute as it is proven against the document root
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// time of signing, the signing key must be valid at that time
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signature            string               `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AttributeSignature) Reset()         { *m = AttributeSignature{} }
+func (m *AttributeSignature) String() string { return proto.CompactTextString(m) }
+func (*AttributeSignature) ProtoMessage()    {}
+func (*AttributeSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{48}
+}
+func (m *AttributeSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttributeSignature.Unmarshal(m, b)
+}
+func (m *AttributeSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttributeSignature.Marshal(b, m, deterministic)
+}
+func (dst *AttributeSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeSignature.Merge(dst, src)
+}
+func (m *AttributeSignature) XXX_Size() int {
+	return xxx_messageInfo_AttributeSignature.Size(m)
+}
+func (m *AttributeSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeSignature proto.InternalMessageInfo
+
+func (m *AttributeSignature) GetSignerId() string {
+	if m != nil {
+		return m.SignerId
+	}
+	return ""
+}
+
+func (m *AttributeSignature) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *AttributeSignature) GetProperty() string {
+	if m != nil {
+		return m.Property
+	}
+	return ""
+}
+
+func (m *AttributeSignature) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *AttributeSignature) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *AttributeSignature) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type AddAttributeSignatureRequest struct {
+	Identifier           string              `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Signature            *AttributeSignature `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AddAttributeSignatureRequest) Reset()         { *m = AddAttributeSignatureRequest{} }
+func (m *AddAttributeSignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*AddAttributeSignatureRequest) ProtoMessage()    {}
+func (*AddAttributeSignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{49}
+}
+func (m *AddAttributeSignatureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddAttributeSignatureRequest.Unmarshal(m, b)
+}
+func (m *AddAttributeSignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddAttributeSignatureRequest.Marshal(b, m, deterministic)
+}
+func (dst *AddAttributeSignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddAttributeSignatureRequest.Merge(dst, src)
+}
+func (m *AddAttributeSignatureRequest) XXX_Size() int {
+	return xxx_messageInfo_AddAttributeSignatureRequest.Size(m)
+}
+func (m *AddAttributeSignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddAttributeSignatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddAttributeSignatureRequest proto.InternalMessageInfo
+
+func (m *AddAttributeSignatureRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *AddAttributeSignatureRequest) GetSignature() *AttributeSignature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type AddAttributeSignatureResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// fields to request to prove the signature against the new version
+	ProofFields          []string `protobuf:"bytes,2,rep,name=proof_fields,json=proofFields,proto3" json:"proof_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddAttributeSignatureResponse) Reset()         { *m = AddAttributeSignatureResponse{} }
+func (m *AddAttributeSignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*AddAttributeSignatureResponse) ProtoMessage()    {}
+func (*AddAttributeSignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{50}
+}
+func (m *AddAttributeSignatureResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddAttributeSignatureResponse.Unmarshal(m, b)
+}
+func (m *AddAttributeSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddAttributeSignatureResponse.Marshal(b, m, deterministic)
+}
+func (dst *AddAttributeSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddAttributeSignatureResponse.Merge(dst, src)
+}
+func (m *AddAttributeSignatureResponse) XXX_Size() int {
+	return xxx_messageInfo_AddAttributeSignatureResponse.Size(m)
+}
+func (m *AddAttributeSignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddAttributeSignatureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddAttributeSignatureResponse proto.InternalMessageInfo
+
+func (m *AddAttributeSignatureResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AddAttributeSignatureResponse) GetProofFields() []string {
+	if m != nil {
+		return m.ProofFields
+	}
+	return nil
+}
+
+type CancelDocumentRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	SupersededBy         string   `protobuf:"bytes,2,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelDocumentRequest) Reset()         { *m = CancelDocumentRequest{} }
+func (m *CancelDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelDocumentRequest) ProtoMessage()    {}
+func (*CancelDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{51}
+}
+func (m *CancelDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelDocumentRequest.Unmarshal(m, b)
+}
+func (m *CancelDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelDocumentRequest.Marshal(b, m, deterministic)
+}
+func (dst *CancelDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelDocumentRequest.Merge(dst, src)
+}
+func (m *CancelDocumentRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelDocumentRequest.Size(m)
+}
+func (m *CancelDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelDocumentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelDocumentRequest proto.InternalMessageInfo
+
+func (m *CancelDocumentRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *CancelDocumentRequest) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
+	}
+	return ""
+}
+
+type CancelDocumentResponse struct {
+	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CancelDocumentResponse) Reset()         { *m = CancelDocumentResponse{} }
+func (m *CancelDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelDocumentResponse) ProtoMessage()    {}
+func (*CancelDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{52}
+}
+func (m *CancelDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelDocumentResponse.Unmarshal(m, b)
+}
+func (m *CancelDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelDocumentResponse.Marshal(b, m, deterministic)
+}
+func (dst *CancelDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelDocumentResponse.Merge(dst, src)
+}
+func (m *CancelDocumentResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelDocumentResponse.Size(m)
+}
+func (m *CancelDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelDocumentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelDocumentResponse proto.InternalMessageInfo
+
+func (m *CancelDocumentResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type VerifyProofRequest struct {
+	Proof *DocumentProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	// hex encoded document root, overrides the document root of the proof bundle
+	DocumentRoot string `protobuf:"bytes,2,opt,name=document_root,json=documentRoot,proto3" json:"document_root,omitempty"`
+	// hex encoded anchor ID, overrides the version of the proof bundle
+	AnchorId             string   `protobuf:"bytes,3,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyProofRequest) Reset()         { *m = VerifyProofRequest{} }
+func (m *VerifyProofRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyProofRequest) ProtoMessage()    {}
+func (*VerifyProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{53}
+}
+func (m *VerifyProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyProofRequest.Unmarshal(m, b)
+}
+func (m *VerifyProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyProofRequest.Marshal(b, m, deterministic)
+}
+func (dst *VerifyProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyProofRequest.Merge(dst, src)
+}
+func (m *VerifyProofRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyProofRequest.Size(m)
+}
+func (m *VerifyProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyProofRequest proto.InternalMessageInfo
+
+func (m *VerifyProofRequest) GetProof() *DocumentProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *VerifyProofRequest) GetDocumentRoot() string {
+	if m != nil {
+		return m.DocumentRoot
+	}
+	return ""
+}
+
+func (m *VerifyProofRequest) GetAnchorId() string {
+	if m != nil {
+		return m.AnchorId
+	}
+	return ""
+}
+
+type FieldProofResult struct {
+	Property string `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Valid    bool   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// reason the field proof is invalid
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldProofResult) Reset()         { *m = FieldProofResult{} }
+func (m *FieldProofResult) String() string { return proto.CompactTextString(m) }
+func (*FieldProofResult) ProtoMessage()    {}
+func (*FieldProofResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{54}
+}
+func (m *FieldProofResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldProofResult.Unmarshal(m, b)
+}
+func (m *FieldProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldProofResult.Marshal(b, m, deterministic)
+}
+func (dst *FieldProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldProofResult.Merge(dst, src)
+}
+func (m *FieldProofResult) XXX_Size() int {
+	return xxx_messageInfo_FieldProofResult.Size(m)
+}
+func (m *FieldProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldProofResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldProofResult proto.InternalMessageInfo
+
+func (m *FieldProofResult) GetProperty() string {
+	if m != nil {
+		return m.Property
+	}
+	return ""
+}
+
+func (m *FieldProofResult) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *FieldProofResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type VerifyProofResponse struct {
+	// true if the document root is anchored and every field proof resolves to it
+	Valid        bool   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	AnchorId     string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	DocumentRoot string `protobuf:"bytes,3,opt,name=document_root,json=documentRoot,proto3" json:"document_root,omitempty"`
+	Anchored     bool   `protobuf:"varint,4,opt,name=anchored,proto3" json:"anchored,omitempty"`
+	// reason the document root is not anchored
+	AnchorError          string              `protobuf:"bytes,5,opt,name=anchor_error,json=anchorError,proto3" json:"anchor_error,omitempty"`
+	FieldProofs          []*FieldProofResult `protobuf:"bytes,6,rep,name=field_proofs,json=fieldProofs,proto3" json:"field_proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *VerifyProofResponse) Reset()         { *m = VerifyProofResponse{} }
+func (m *VerifyProofResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyProofResponse) ProtoMessage()    {}
+func (*VerifyProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{55}
+}
+func (m *VerifyProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyProofResponse.Unmarshal(m, b)
+}
+func (m *VerifyProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyProofResponse.Marshal(b, m, deterministic)
+}
+func (dst *VerifyProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyProofResponse.Merge(dst, src)
+}
+func (m *VerifyProofResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyProofResponse.Size(m)
+}
+func (m *VerifyProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyProofResponse proto.InternalMessageInfo
+
+func (m *VerifyProofResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *VerifyProofResponse) GetAnchorId() string {
+	if m != nil {
+		return m.AnchorId
+	}
+	return ""
+}
+
+func (m *VerifyProofResponse) GetDocumentRoot() string {
+	if m != nil {
+		return m.DocumentRoot
+	}
+	return ""
+}
+
+func (m *VerifyProofResponse) GetAnchored() bool {
+	if m != nil {
+		return m.Anchored
+	}
+	return false
+}
+
+func (m *VerifyProofResponse) GetAnchorError() string {
+	if m != nil {
+		return m.AnchorError
+	}
+	return ""
+}
+
+func (m *VerifyProofResponse) GetFieldProofs() []*FieldProofResult {
+	if m != nil {
+		return m.FieldProofs
+	}
+	return nil
+}
+
+type ProofEnvelope struct {
+	Version           int32  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	HashAlgorithm     string `protobuf:"bytes,2,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
+	CompactProperties bool   `protobuf:"varint,3,opt,name=compact_properties,json=compactProperties,proto3" json:"compact_properties,omitempty"`
+	HashSorting       bool   `protobuf:"varint,4,opt,name=hash_sorting,json=hashSorting,proto3" json:"hash_sorting,omitempty"`
+	// prefixes of the trees the properties of the proofs belong to
+	Prefixes []*TreePrefix `protobuf:"bytes,5,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	// version of the node that generated the proofs
+	SoftwareVersion      string   `protobuf:"bytes,6,opt,name=software_version,json=softwareVersion,proto3" json:"software_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProofEnvelope) Reset()         { *m = ProofEnvelope{} }
+func (m *ProofEnvelope) String() string { return proto.CompactTextString(m) }
+func (*ProofEnvelope) ProtoMessage()    {}
+func (*ProofEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{56}
+}
+func (m *ProofEnvelope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProofEnvelope.Unmarshal(m, b)
+}
+func (m *ProofEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProofEnvelope.Marshal(b, m, deterministic)
+}
+func (dst *ProofEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofEnvelope.Merge(dst, src)
+}
+func (m *ProofEnvelope) XXX_Size() int {
+	return xxx_messageInfo_ProofEnvelope.Size(m)
+}
+func (m *ProofEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofEnvelope proto.InternalMessageInfo
+
+func (m *ProofEnvelope) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ProofEnvelope) GetHashAlgorithm() string {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return ""
+}
+
+func (m *ProofEnvelope) GetCompactProperties() bool {
+	if m != nil {
+		return m.CompactProperties
+	}
+	return false
+}
+
+func (m *ProofEnvelope) GetHashSorting() bool {
+	if m != nil {
+		return m.HashSorting
+	}
+	return false
+}
+
+func (m *ProofEnvelope) GetPrefixes() []*TreePrefix {
+	if m != nil {
+		return m.Prefixes
+	}
+	return nil
+}
+
+func (m *ProofEnvelope) GetSoftwareVersion() string {
+	if m != nil {
+		return m.SoftwareVersion
+	}
+	return ""
+}
+
+type TreePrefix struct {
+	Readable string `protobuf:"bytes,1,opt,name=readable,proto3" json:"readable,omitempty"`
+	// hex encoded compact prefix
+	Compact              string   `protobuf:"bytes,2,opt,name=compact,proto3" json:"compact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TreePrefix) Reset()         { *m = TreePrefix{} }
+func (m *TreePrefix) String() string { return proto.CompactTextString(m) }
+func (*TreePrefix) ProtoMessage()    {}
+func (*TreePrefix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{57}
+}
+func (m *TreePrefix) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreePrefix.Unmarshal(m, b)
+}
+func (m *TreePrefix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreePrefix.Marshal(b, m, deterministic)
+}
+func (dst *TreePrefix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreePrefix.Merge(dst, src)
+}
+func (m *TreePrefix) XXX_Size() int {
+	return xxx_messageInfo_TreePrefix.Size(m)
+}
+func (m *TreePrefix) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreePrefix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreePrefix proto.InternalMessageInfo
+
+func (m *TreePrefix) GetReadable() string {
+	if m != nil {
+		return m.Readable
+	}
+	return ""
+}
+
+func (m *TreePrefix) GetCompact() string {
+	if m != nil {
+		return m.Compact
+	}
+	return ""
+}
+
+type MultiProof struct {
+	// sibling hashes of the fields, every hash is included once
+	Hashes               []string           `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	Fields               []*MultiProofField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *MultiProof) Reset()         { *m = MultiProof{} }
+func (m *MultiProof) String() string { return proto.CompactTextString(m) }
+func (*MultiProof) ProtoMessage()    {}
+func (*MultiProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{58}
+}
+func (m *MultiProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiProof.Unmarshal(m, b)
+}
+func (m *MultiProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiProof.Marshal(b, m, deterministic)
+}
+func (dst *MultiProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiProof.Merge(dst, src)
+}
+func (m *MultiProof) XXX_Size() int {
+	return xxx_messageInfo_MultiProof.Size(m)
+}
+func (m *MultiProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiProof proto.InternalMessageInfo
+
+func (m *MultiProof) GetHashes() []string {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func (m *MultiProof) GetFields() []*MultiProofField {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type MultiProofField struct {
+	Property string `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Value    string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Salt     string `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	// hash is filled if value & salt are not available
+	Hash string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// indexes of the sorted hashes of the field in the hashes of the multiproof
+	HashIndexes          []uint32 `protobuf:"varint,5,rep,packed,name=hash_indexes,json=hashIndexes,proto3" json:"hash_indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiProofField) Reset()         { *m = MultiProofField{} }
+func (m *MultiProofField) String() string { return proto.CompactTextString(m) }
+func (*MultiProofField) ProtoMessage()    {}
+func (*MultiProofField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{59}
+}
+func (m *MultiProofField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiProofField.Unmarshal(m, b)
+}
+func (m *MultiProofField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiProofField.Marshal(b, m, deterministic)
+}
+func (dst *MultiProofField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiProofField.Merge(dst, src)
+}
+func (m *MultiProofField) XXX_Size() int {
+	return xxx_messageInfo_MultiProofField.Size(m)
+}
+func (m *MultiProofField) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiProofField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiProofField proto.InternalMessageInfo
+
+func (m *MultiProofField) GetProperty() string {
+	if m != nil {
+		return m.Property
+	}
+	return ""
+}
+
+func (m *MultiProofField) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *MultiProofField) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *MultiProofField) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MultiProofField) GetHashIndexes() []uint32 {
+	if m != nil {
+		return m.HashIndexes
+	}
+	return nil
+}
+
+type AddNFTRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	RegistryAddress      string   `protobuf:"bytes,2,opt,name=registry_address,json=registryAddress,proto3" json:"registry_address,omitempty"`
+	TokenId              string   `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	GrantNftAccess       bool     `protobuf:"varint,4,opt,name=grant_nft_access,json=grantNftAccess,proto3" json:"grant_nft_access,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddNFTRequest) Reset()         { *m = AddNFTRequest{} }
+func (m *AddNFTRequest) String() string { return proto.CompactTextString(m) }
+func (*AddNFTRequest) ProtoMessage()    {}
+func (*AddNFTRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{60}
+}
+func (m *AddNFTRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddNFTRequest.Unmarshal(m, b)
+}
+func (m *AddNFTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddNFTRequest.Marshal(b, m, deterministic)
+}
+func (dst *AddNFTRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddNFTRequest.Merge(dst, src)
+}
+func (m *AddNFTRequest) XXX_Size() int {
+	return xxx_messageInfo_AddNFTRequest.Size(m)
+}
+func (m *AddNFTRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddNFTRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddNFTRequest proto.InternalMessageInfo
+
+func (m *AddNFTRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *AddNFTRequest) GetRegistryAddress() string {
+	if m != nil {
+		return m.RegistryAddress
+	}
+	return ""
+}
+
+func (m *AddNFTRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *AddNFTRequest) GetGrantNftAccess() bool {
+	if m != nil {
+		return m.GrantNftAccess
+	}
+	return false
+}
+
+type AddNFTResponse struct {
+	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *AddNFTResponse) Reset()         { *m = AddNFTResponse{} }
+func (m *AddNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*AddNFTResponse) ProtoMessage()    {}
+func (*AddNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{61}
+}
+func (m *AddNFTResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddNFTResponse.Unmarshal(m, b)
+}
+func (m *AddNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddNFTResponse.Marshal(b, m, deterministic)
+}
+func (dst *AddNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddNFTResponse.Merge(dst, src)
+}
+func (m *AddNFTResponse) XXX_Size() int {
+	return xxx_messageInfo_AddNFTResponse.Size(m)
+}
+func (m *AddNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddNFTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddNFTResponse proto.InternalMessageInfo
+
+func (m *AddNFTResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type SimulateAccessRequest struct {
+	Identifier                   string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Account                      string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	AccessTokenId                string   `protobuf:"bytes,3,opt,name=access_token_id,json=accessTokenId,proto3" json:"access_token_id,omitempty"`
+	DelegatingDocumentIdentifier string   `protobuf:"bytes,4,opt,name=delegating_document_identifier,json=delegatingDocumentIdentifier,proto3" json:"delegating_document_identifier,omitempty"`
+	NftRegistryAddress           string   `protobuf:"bytes,5,opt,name=nft_registry_address,json=nftRegistryAddress,proto3" json:"nft_registry_address,omitempty"`
+	NftTokenId                   string   `protobuf:"bytes,6,opt,name=nft_token_id,json=nftTokenId,proto3" json:"nft_token_id,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *SimulateAccessRequest) Reset()         { *m = SimulateAccessRequest{} }
+func (m *SimulateAccessRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateAccessRequest) ProtoMessage()    {}
+func (*SimulateAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{62}
+}
+func (m *SimulateAccessRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateAccessRequest.Unmarshal(m, b)
+}
+func (m *SimulateAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateAccessRequest.Marshal(b, m, deterministic)
+}
+func (dst *SimulateAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateAccessRequest.Merge(dst, src)
+}
+func (m *SimulateAccessRequest) XXX_Size() int {
+	return xxx_messageInfo_SimulateAccessRequest.Size(m)
+}
+func (m *SimulateAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateAccessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateAccessRequest proto.InternalMessageInfo
+
+func (m *SimulateAccessRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *SimulateAccessRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *SimulateAccessRequest) GetAccessTokenId() string {
+	if m != nil {
+		return m.AccessTokenId
+	}
+	return ""
+}
+
+func (m *SimulateAccessRequest) GetDelegatingDocumentIdentifier() string {
+	if m != nil {
+		return m.DelegatingDocumentIdentifier
+	}
+	return ""
+}
+
+func (m *SimulateAccessRequest) GetNftRegistryAddress() string {
+	if m != nil {
+		return m.NftRegistryAddress
+	}
+	return ""
+}
+
+func (m *SimulateAccessRequest) GetNftTokenId() string {
+	if m != nil {
+		return m.NftTokenId
+	}
+	return ""
+}
+
+type AccessCheck struct {
+	AccessType           string   `protobuf:"bytes,1,opt,name=access_type,json=accessType,proto3" json:"access_type,omitempty"`
+	Granted              bool     `protobuf:"varint,2,opt,name=granted,proto3" json:"granted,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessCheck) Reset()         { *m = AccessCheck{} }
+func (m *AccessCheck) String() string { return proto.CompactTextString(m) }
+func (*AccessCheck) ProtoMessage()    {}
+func (*AccessCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{63}
+}
+func (m *AccessCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessCheck.Unmarshal(m, b)
+}
+func (m *AccessCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessCheck.Marshal(b, m, deterministic)
+}
+func (dst *AccessCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessCheck.Merge(dst, src)
+}
+func (m *AccessCheck) XXX_Size() int {
+	return xxx_messageInfo_AccessCheck.Size(m)
+}
+func (m *AccessCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessCheck proto.InternalMessageInfo
+
+func (m *AccessCheck) GetAccessType() string {
+	if m != nil {
+		return m.AccessType
+	}
+	return ""
+}
+
+func (m *AccessCheck) GetGranted() bool {
+	if m != nil {
+		return m.Granted
+	}
+	return false
+}
+
+func (m *AccessCheck) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type SimulateAccessResponse struct {
+	DocumentId           string         `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	VersionId            string         `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Granted              bool           `protobuf:"varint,3,opt,name=granted,proto3" json:"granted,omitempty"`
+	Checks               []*AccessCheck `protobuf:"bytes,4,rep,name=checks,proto3" json:"checks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SimulateAccessResponse) Reset()         { *m = SimulateAccessResponse{} }
+func (m *SimulateAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateAccessResponse) ProtoMessage()    {}
+func (*SimulateAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{64}
+}
+func (m *SimulateAccessResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateAccessResponse.Unmarshal(m, b)
+}
+func (m *SimulateAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateAccessResponse.Marshal(b, m, deterministic)
+}
+func (dst *SimulateAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateAccessResponse.Merge(dst, src)
+}
+func (m *SimulateAccessResponse) XXX_Size() int {
+	return xxx_messageInfo_SimulateAccessResponse.Size(m)
+}
+func (m *SimulateAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateAccessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateAccessResponse proto.InternalMessageInfo
+
+func (m *SimulateAccessResponse) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *SimulateAccessResponse) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *SimulateAccessResponse) GetGranted() bool {
+	if m != nil {
+		return m.Granted
+	}
+	return false
+}
+
+func (m *SimulateAccessResponse) GetChecks() []*AccessCheck {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+type TransferDetail struct {
+	TransferId           string               `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	PaymentReference     string               `protobuf:"bytes,2,opt,name=payment_reference,json=paymentReference,proto3" json:"payment_reference,omitempty"`
+	Amount               string               `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency             string               `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status               string               `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	SettlementDate       *timestamp.Timestamp `protobuf:"bytes,6,opt,name=settlement_date,json=settlementDate,proto3" json:"settlement_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TransferDetail) Reset()         { *m = TransferDetail{} }
+func (m *TransferDetail) String() string { return proto.CompactTextString(m) }
+func (*TransferDetail) ProtoMessage()    {}
+func (*TransferDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{65}
+}
+func (m *TransferDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferDetail.Unmarshal(m, b)
+}
+func (m *TransferDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferDetail.Marshal(b, m, deterministic)
+}
+func (dst *TransferDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferDetail.Merge(dst, src)
+}
+func (m *TransferDetail) XXX_Size() int {
+	return xxx_messageInfo_TransferDetail.Size(m)
+}
+func (m *TransferDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferDetail proto.InternalMessageInfo
+
+func (m *TransferDetail) GetTransferId() string {
+	if m != nil {
+		return m.TransferId
+	}
+	return ""
+}
+
+func (m *TransferDetail) GetPaymentReference() string {
+	if m != nil {
+		return m.PaymentReference
+	}
+	return ""
+}
+
+func (m *TransferDetail) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *TransferDetail) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *TransferDetail) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TransferDetail) GetSettlementDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.SettlementDate
+	}
+	return nil
+}
+
+type AddTransferDetailRequest struct {
+	Identifier           string          `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Data                 *TransferDetail `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *AddTransferDetailRequest) Reset()         { *m = AddTransferDetailRequest{} }
+func (m *AddTransferDetailRequest) String() string { return proto.CompactTextString(m) }
+func (*AddTransferDetailRequest) ProtoMessage()    {}
+func (*AddTransferDetailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{66}
+}
+func (m *AddTransferDetailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddTransferDetailRequest.Unmarshal(m, b)
+}
+func (m *AddTransferDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddTransferDetailRequest.Marshal(b, m, deterministic)
+}
+func (dst *AddTransferDetailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTransferDetailRequest.Merge(dst, src)
+}
+func (m *AddTransferDetailRequest) XXX_Size() int {
+	return xxx_messageInfo_AddTransferDetailRequest.Size(m)
+}
+func (m *AddTransferDetailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTransferDetailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddTransferDetailRequest proto.InternalMessageInfo
+
+func (m *AddTransferDetailRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *AddTransferDetailRequest) GetData() *TransferDetail {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type UpdateTransferDetailRequest struct {
+	Identifier           string          `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	TransferId           string          `protobuf:"bytes,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Data                 *TransferDetail `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UpdateTransferDetailRequest) Reset()         { *m = UpdateTransferDetailRequest{} }
+func (m *UpdateTransferDetailRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTransferDetailRequest) ProtoMessage()    {}
+func (*UpdateTransferDetailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{67}
+}
+func (m *UpdateTransferDetailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTransferDetailRequest.Unmarshal(m, b)
+}
+func (m *UpdateTransferDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTransferDetailRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateTransferDetailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTransferDetailRequest.Merge(dst, src)
+}
+func (m *UpdateTransferDetailRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateTransferDetailRequest.Size(m)
+}
+func (m *UpdateTransferDetailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTransferDetailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTransferDetailRequest proto.InternalMessageInfo
+
+func (m *UpdateTransferDetailRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *UpdateTransferDetailRequest) GetTransferId() string {
+	if m != nil {
+		return m.TransferId
+	}
+	return ""
+}
+
+func (m *UpdateTransferDetailRequest) GetData() *TransferDetail {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type TransferDetailProof struct {
+	Data                 *TransferDetail `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ProofFields          []string        `protobuf:"bytes,2,rep,name=proof_fields,json=proofFields,proto3" json:"proof_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TransferDetailProof) Reset()         { *m = TransferDetailProof{} }
+func (m *TransferDetailProof) String() string { return proto.CompactTextString(m) }
+func (*TransferDetailProof) ProtoMessage()    {}
+func (*TransferDetailProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{68}
+}
+func (m *TransferDetailProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferDetailProof.Unmarshal(m, b)
+}
+func (m *TransferDetailProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferDetailProof.Marshal(b, m, deterministic)
+}
+func (dst *TransferDetailProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferDetailProof.Merge(dst, src)
+}
+func (m *TransferDetailProof) XXX_Size() int {
+	return xxx_messageInfo_TransferDetailProof.Size(m)
+}
+func (m *TransferDetailProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferDetailProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferDetailProof proto.InternalMessageInfo
+
+func (m *TransferDetailProof) GetData() *TransferDetail {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TransferDetailProof) GetProofFields() []string {
+	if m != nil {
+		return m.ProofFields
+	}
+	return nil
+}
+
+type TransferDetailResponse struct {
+	Header               *ResponseHeader      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Detail               *TransferDetailProof `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TransferDetailResponse) Reset()         { *m = TransferDetailResponse{} }
+func (m *TransferDetailResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferDetailResponse) ProtoMessage()    {}
+func (*TransferDetailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{69}
+}
+func (m *TransferDetailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferDetailResponse.Unmarshal(m, b)
+}
+func (m *TransferDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferDetailResponse.Marshal(b, m, deterministic)
+}
+func (dst *TransferDetailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferDetailResponse.Merge(dst, src)
+}
+func (m *TransferDetailResponse) XXX_Size() int {
+	return xxx_messageInfo_TransferDetailResponse.Size(m)
+}
+func (m *TransferDetailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferDetailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferDetailResponse proto.InternalMessageInfo
+
+func (m *TransferDetailResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TransferDetailResponse) GetDetail() *TransferDetailProof {
+	if m != nil {
+		return m.Detail
+	}
+	return nil
+}
+
+type ListTransferDetailsRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTransferDetailsRequest) Reset()         { *m = ListTransferDetailsRequest{} }
+func (m *ListTransferDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTransferDetailsRequest) ProtoMessage()    {}
+func (*ListTransferDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{70}
+}
+func (m *ListTransferDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTransferDetailsRequest.Unmarshal(m, b)
+}
+func (m *ListTransferDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTransferDetailsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListTransferDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTransferDetailsRequest.Merge(dst, src)
+}
+func (m *ListTransferDetailsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTransferDetailsRequest.Size(m)
+}
+func (m *ListTransferDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTransferDetailsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTransferDetailsRequest proto.InternalMessageInfo
+
+func (m *ListTransferDetailsRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type ListTransferDetailsResponse struct {
+	Header               *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Details              []*TransferDetailProof `protobuf:"bytes,2,rep,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ListTransferDetailsResponse) Reset()         { *m = ListTransferDetailsResponse{} }
+func (m *ListTransferDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTransferDetailsResponse) ProtoMessage()    {}
+func (*ListTransferDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{71}
+}
+func (m *ListTransferDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTransferDetailsResponse.Unmarshal(m, b)
+}
+func (m *ListTransferDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTransferDetailsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListTransferDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTransferDetailsResponse.Merge(dst, src)
+}
+func (m *ListTransferDetailsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTransferDetailsResponse.Size(m)
+}
+func (m *ListTransferDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTransferDetailsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTransferDetailsResponse proto.InternalMessageInfo
+
+func (m *ListTransferDetailsResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ListTransferDetailsResponse) GetDetails() []*TransferDetailProof {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+type Attachment struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Checksum             string   `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Cid                  string   `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Size                 uint64   `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Attachment) Reset()         { *m = Attachment{} }
+func (m *Attachment) String() string { return proto.CompactTextString(m) }
+func (*Attachment) ProtoMessage()    {}
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{72}
+}
+func (m *Attachment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Attachment.Unmarshal(m, b)
+}
+func (m *Attachment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Attachment.Marshal(b, m, deterministic)
+}
+func (dst *Attachment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attachment.Merge(dst, src)
+}
+func (m *Attachment) XXX_Size() int {
+	return xxx_messageInfo_Attachment.Size(m)
+}
+func (m *Attachment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attachment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attachment proto.InternalMessageInfo
+
+func (m *Attachment) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Attachment) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *Attachment) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *Attachment) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type AddAttachmentRequest struct {
+	Identifier           string      `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Attachment           *Attachment `protobuf:"bytes,2,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Data                 []byte      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *AddAttachmentRequest) Reset()         { *m = AddAttachmentRequest{} }
+func (m *AddAttachmentRequest) String() string { return proto.CompactTextString(m) }
+func (*AddAttachmentRequest) ProtoMessage()    {}
+func (*AddAttachmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{73}
+}
+func (m *AddAttachmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddAttachmentRequest.Unmarshal(m, b)
+}
+func (m *AddAttachmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddAttachmentRequest.Marshal(b, m, deterministic)
+}
+func (dst *AddAttachmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddAttachmentRequest.Merge(dst, src)
+}
+func (m *AddAttachmentRequest) XXX_Size() int {
+	return xxx_messageInfo_AddAttachmentRequest.Size(m)
+}
+func (m *AddAttachmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddAttachmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddAttachmentRequest proto.InternalMessageInfo
+
+func (m *AddAttachmentRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *AddAttachmentRequest) GetAttachment() *Attachment {
+	if m != nil {
+		return m.Attachment
+	}
+	return nil
+}
+
+func (m *AddAttachmentRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type AttachmentProof struct {
+	Data                 *Attachment `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ProofFields          []string    `protobuf:"bytes,2,rep,name=proof_fields,json=proofFields,proto3" json:"proof_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *AttachmentProof) Reset()         { *m = AttachmentProof{} }
+func (m *AttachmentProof) String() string { return proto.CompactTextString(m) }
+func (*AttachmentProof) ProtoMessage()    {}
+func (*AttachmentProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{74}
+}
+func (m *AttachmentProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttachmentProof.Unmarshal(m, b)
+}
+func (m *AttachmentProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttachmentProof.Marshal(b, m, deterministic)
+}
+func (dst *AttachmentProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachmentProof.Merge(dst, src)
+}
+func (m *AttachmentProof) XXX_Size() int {
+	return xxx_messageInfo_AttachmentProof.Size(m)
+}
+func (m *AttachmentProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachmentProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachmentProof proto.InternalMessageInfo
+
+func (m *AttachmentProof) GetData() *Attachment {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *AttachmentProof) GetProofFields() []string {
+	if m != nil {
+		return m.ProofFields
+	}
+	return nil
+}
+
+type AttachmentResponse struct {
+	Header               *ResponseHeader  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Attachment           *AttachmentProof `protobuf:"bytes,2,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AttachmentResponse) Reset()         { *m = AttachmentResponse{} }
+func (m *AttachmentResponse) String() string { return proto.CompactTextString(m) }
+func (*AttachmentResponse) ProtoMessage()    {}
+func (*AttachmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{75}
+}
+func (m *AttachmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttachmentResponse.Unmarshal(m, b)
+}
+func (m *AttachmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttachmentResponse.Marshal(b, m, deterministic)
+}
+func (dst *AttachmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachmentResponse.Merge(dst, src)
+}
+func (m *AttachmentResponse) XXX_Size() int {
+	return xxx_messageInfo_AttachmentResponse.Size(m)
+}
+func (m *AttachmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachmentResponse proto.InternalMessageInfo
+
+func (m *AttachmentResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AttachmentResponse) GetAttachment() *AttachmentProof {
+	if m != nil {
+		return m.Attachment
+	}
+	return nil
+}
+
+type ListAttachmentsRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAttachmentsRequest) Reset()         { *m = ListAttachmentsRequest{} }
+func (m *ListAttachmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAttachmentsRequest) ProtoMessage()    {}
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{76}
+}
+func (m *ListAttachmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAttachmentsRequest.Unmarshal(m, b)
+}
+func (m *ListAttachmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAttachmentsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAttachmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAttachmentsRequest.Merge(dst, src)
+}
+func (m *ListAttachmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAttachmentsRequest.Size(m)
+}
+func (m *ListAttachmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAttachmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAttachmentsRequest proto.InternalMessageInfo
+
+func (m *ListAttachmentsRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type ListAttachmentsResponse struct {
+	Header               *ResponseHeader    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Attachments          []*AttachmentProof `protobuf:"bytes,2,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListAttachmentsResponse) Reset()         { *m = ListAttachmentsResponse{} }
+func (m *ListAttachmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAttachmentsResponse) ProtoMessage()    {}
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{77}
+}
+func (m *ListAttachmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAttachmentsResponse.Unmarshal(m, b)
+}
+func (m *ListAttachmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAttachmentsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListAttachmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAttachmentsResponse.Merge(dst, src)
+}
+func (m *ListAttachmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAttachmentsResponse.Size(m)
+}
+func (m *ListAttachmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAttachmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAttachmentsResponse proto.InternalMessageInfo
+
+func (m *ListAttachmentsResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ListAttachmentsResponse) GetAttachments() []*AttachmentProof {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+type UpdateTagsRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Tags                 []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateTagsRequest) Reset()         { *m = UpdateTagsRequest{} }
+func (m *UpdateTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTagsRequest) ProtoMessage()    {}
+func (*UpdateTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{78}
+}
+func (m *UpdateTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTagsRequest.Unmarshal(m, b)
+}
+func (m *UpdateTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTagsRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTagsRequest.Merge(dst, src)
+}
+func (m *UpdateTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateTagsRequest.Size(m)
+}
+func (m *UpdateTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTagsRequest proto.InternalMessageInfo
+
+func (m *UpdateTagsRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *UpdateTagsRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type TagsResponse struct {
+	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Tags                 []string        `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	ProofFields          []string        `protobuf:"bytes,3,rep,name=proof_fields,json=proofFields,proto3" json:"proof_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TagsResponse) Reset()         { *m = TagsResponse{} }
+func (m *TagsResponse) String() string { return proto.CompactTextString(m) }
+func (*TagsResponse) ProtoMessage()    {}
+func (*TagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{79}
+}
+func (m *TagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagsResponse.Unmarshal(m, b)
+}
+func (m *TagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagsResponse.Marshal(b, m, deterministic)
+}
+func (dst *TagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagsResponse.Merge(dst, src)
+}
+func (m *TagsResponse) XXX_Size() int {
+	return xxx_messageInfo_TagsResponse.Size(m)
+}
+func (m *TagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagsResponse proto.InternalMessageInfo
+
+func (m *TagsResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TagsResponse) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *TagsResponse) GetProofFields() []string {
+	if m != nil {
+		return m.ProofFields
+	}
+	return nil
+}
+
+type DocumentTemplate struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scheme               string               `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Attributes           []*TemplateAttribute `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Collaborators        []string             `protobuf:"bytes,4,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	ReadAccess           []string             `protobuf:"bytes,5,rep,name=read_access,json=readAccess,proto3" json:"read_access,omitempty"`
+	FieldRules           []*FieldRule         `protobuf:"bytes,6,rep,name=field_rules,json=fieldRules,proto3" json:"field_rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DocumentTemplate) Reset()         { *m = DocumentTemplate{} }
+func (m *DocumentTemplate) String() string { return proto.CompactTextString(m) }
+func (*DocumentTemplate) ProtoMessage()    {}
+func (*DocumentTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{80}
+}
+func (m *DocumentTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentTemplate.Unmarshal(m, b)
+}
+func (m *DocumentTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentTemplate.Marshal(b, m, deterministic)
+}
+func (dst *DocumentTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentTemplate.Merge(dst, src)
+}
+func (m *DocumentTemplate) XXX_Size() int {
+	return xxx_messageInfo_DocumentTemplate.Size(m)
+}
+func (m *DocumentTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentTemplate proto.InternalMessageInfo
+
+func (m *DocumentTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DocumentTemplate) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *DocumentTemplate) GetAttributes() []*TemplateAttribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *DocumentTemplate) GetCollaborators() []string {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+func (m *DocumentTemplate) GetReadAccess() []string {
+	if m != nil {
+		return m.ReadAccess
+	}
+	return nil
+}
+
+func (m *DocumentTemplate) GetFieldRules() []*FieldRule {
+	if m != nil {
+		return m.FieldRules
+	}
+	return nil
+}
+
+type TemplateAttribute struct {
+	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string         `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Required             bool           `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	DefaultValue         *_struct.Value `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TemplateAttribute) Reset()         { *m = TemplateAttribute{} }
+func (m *TemplateAttribute) String() string { return proto.CompactTextString(m) }
+func (*TemplateAttribute) ProtoMessage()    {}
+func (*TemplateAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{81}
+}
+func (m *TemplateAttribute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TemplateAttribute.Unmarshal(m, b)
+}
+func (m *TemplateAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TemplateAttribute.Marshal(b, m, deterministic)
+}
+func (dst *TemplateAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateAttribute.Merge(dst, src)
+}
+func (m *TemplateAttribute) XXX_Size() int {
+	return xxx_messageInfo_TemplateAttribute.Size(m)
+}
+func (m *TemplateAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateAttribute proto.InternalMessageInfo
+
+func (m *TemplateAttribute) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TemplateAttribute) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TemplateAttribute) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *TemplateAttribute) GetDefaultValue() *_struct.Value {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+type GetTemplateRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTemplateRequest) Reset()         { *m = GetTemplateRequest{} }
+func (m *GetTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTemplateRequest) ProtoMessage()    {}
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{82}
+}
+func (m *GetTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTemplateRequest.Unmarshal(m, b)
+}
+func (m *GetTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTemplateRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTemplateRequest.Merge(dst, src)
+}
+func (m *GetTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTemplateRequest.Size(m)
+}
+func (m *GetTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTemplateRequest proto.InternalMessageInfo
+
+func (m *GetTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ListTemplatesResponse struct {
+	Templates            []*DocumentTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListTemplatesResponse) Reset()         { *m = ListTemplatesResponse{} }
+func (m *ListTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTemplatesResponse) ProtoMessage()    {}
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{83}
+}
+func (m *ListTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTemplatesResponse.Unmarshal(m, b)
+}
+func (m *ListTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTemplatesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTemplatesResponse.Merge(dst, src)
+}
+func (m *ListTemplatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTemplatesResponse.Size(m)
+}
+func (m *ListTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTemplatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTemplatesResponse proto.InternalMessageInfo
+
+func (m *ListTemplatesResponse) GetTemplates() []*DocumentTemplate {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+type CreateFromTemplateRequest struct {
+	Name                 string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Collaborators        []string        `protobuf:"bytes,2,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	Data                 *_struct.Struct `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	ReadAccess           []string        `protobuf:"bytes,4,rep,name=read_access,json=readAccess,proto3" json:"read_access,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CreateFromTemplateRequest) Reset()         { *m = CreateFromTemplateRequest{} }
+func (m *CreateFromTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateFromTemplateRequest) ProtoMessage()    {}
+func (*CreateFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{84}
+}
+func (m *CreateFromTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateFromTemplateRequest.Unmarshal(m, b)
+}
+func (m *CreateFromTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateFromTemplateRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateFromTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFromTemplateRequest.Merge(dst, src)
+}
+func (m *CreateFromTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateFromTemplateRequest.Size(m)
+}
+func (m *CreateFromTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFromTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateFromTemplateRequest proto.InternalMessageInfo
+
+func (m *CreateFromTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateFromTemplateRequest) GetCollaborators() []string {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+func (m *CreateFromTemplateRequest) GetData() *_struct.Struct {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *CreateFromTemplateRequest) GetReadAccess() []string {
+	if m != nil {
+		return m.ReadAccess
+	}
+	return nil
+}
+
+type FieldReference struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// version of the document, the latest version if empty
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// field to prove, such as invoice.gross_amount
+	Field                string   `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldReference) Reset()         { *m = FieldReference{} }
+func (m *FieldReference) String() string { return proto.CompactTextString(m) }
+func (*FieldReference) ProtoMessage()    {}
+func (*FieldReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{85}
+}
+func (m *FieldReference) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldReference.Unmarshal(m, b)
+}
+func (m *FieldReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldReference.Marshal(b, m, deterministic)
+}
+func (dst *FieldReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldReference.Merge(dst, src)
+}
+func (m *FieldReference) XXX_Size() int {
+	return xxx_messageInfo_FieldReference.Size(m)
+}
+func (m *FieldReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldReference proto.InternalMessageInfo
+
+func (m *FieldReference) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *FieldReference) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *FieldReference) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+type CreateConsistencyProofRequest struct {
+	Left                 *FieldReference `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Right                *FieldReference `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CreateConsistencyProofRequest) Reset()         { *m = CreateConsistencyProofRequest{} }
+func (m *CreateConsistencyProofRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateConsistencyProofRequest) ProtoMessage()    {}
+func (*CreateConsistencyProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{86}
+}
+func (m *CreateConsistencyProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateConsistencyProofRequest.Unmarshal(m, b)
+}
+func (m *CreateConsistencyProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateConsistencyProofRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateConsistencyProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateConsistencyProofRequest.Merge(dst, src)
+}
+func (m *CreateConsistencyProofRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateConsistencyProofRequest.Size(m)
+}
+func (m *CreateConsistencyProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateConsistencyProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateConsistencyProofRequest proto.InternalMessageInfo
+
+func (m *CreateConsistencyProofRequest) GetLeft() *FieldReference {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *CreateConsistencyProofRequest) GetRight() *FieldReference {
+	if m != nil {
+		return m.Right
+	}
+	return nil
+}
+
+type ConsistencyProof struct {
+	Left  *DocumentProof `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Right *DocumentProof `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
+	// message signed by the node, the sha256 hash of the messages of the left and the right proof bundles
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// signature of the node asserting that the values of the fields are equal
+	Signature            *BundleSignature `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ConsistencyProof) Reset()         { *m = ConsistencyProof{} }
+func (m *ConsistencyProof) String() string { return proto.CompactTextString(m) }
+func (*ConsistencyProof) ProtoMessage()    {}
+func (*ConsistencyProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{87}
+}
+func (m *ConsistencyProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsistencyProof.Unmarshal(m, b)
+}
+func (m *ConsistencyProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsistencyProof.Marshal(b, m, deterministic)
+}
+func (dst *ConsistencyProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsistencyProof.Merge(dst, src)
+}
+func (m *ConsistencyProof) XXX_Size() int {
+	return xxx_messageInfo_ConsistencyProof.Size(m)
+}
+func (m *ConsistencyProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsistencyProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsistencyProof proto.InternalMessageInfo
+
+func (m *ConsistencyProof) GetLeft() *DocumentProof {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *ConsistencyProof) GetRight() *DocumentProof {
+	if m != nil {
+		return m.Right
+	}
+	return nil
+}
+
+func (m *ConsistencyProof) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ConsistencyProof) GetSignature() *BundleSignature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type StorageUsage struct {
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// bytes of the document versions stored for the account
+	TotalBytes uint64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// storage quota of the account in bytes, 0 if the storage is not limited
+	QuotaBytes uint64 `protobuf:"varint,3,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	// usage per document type, ordered by document type
+	DocumentTypes        []*DocumentTypeStorageUsage `protobuf:"bytes,4,rep,name=document_types,json=documentTypes,proto3" json:"document_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *StorageUsage) Reset()         { *m = StorageUsage{} }
+func (m *StorageUsage) String() string { return proto.CompactTextString(m) }
+func (*StorageUsage) ProtoMessage()    {}
+func (*StorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{88}
+}
+func (m *StorageUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageUsage.Unmarshal(m, b)
+}
+func (m *StorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageUsage.Marshal(b, m, deterministic)
+}
+func (dst *StorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageUsage.Merge(dst, src)
+}
+func (m *StorageUsage) XXX_Size() int {
+	return xxx_messageInfo_StorageUsage.Size(m)
+}
+func (m *StorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageUsage proto.InternalMessageInfo
+
+func (m *StorageUsage) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+func (m *StorageUsage) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *StorageUsage) GetQuotaBytes() uint64 {
+	if m != nil {
+		return m.QuotaBytes
+	}
+	return 0
+}
+
+func (m *StorageUsage) GetDocumentTypes() []*DocumentTypeStorageUsage {
+	if m != nil {
+		return m.DocumentTypes
+	}
+	return nil
+}
+
+type DocumentTypeStorageUsage struct {
+	DocumentType string `protobuf:"bytes,1,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	// number of the stored versions of the documents of the type
+	Versions             uint64   `protobuf:"varint,2,opt,name=versions,proto3" json:"versions,omitempty"`
+	Bytes                uint64   `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DocumentTypeStorageUsage) Reset()         { *m = DocumentTypeStorageUsage{} }
+func (m *DocumentTypeStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*DocumentTypeStorageUsage) ProtoMessage()    {}
+func (*DocumentTypeStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{89}
+}
+func (m *DocumentTypeStorageUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentTypeStorageUsage.Unmarshal(m, b)
+}
+func (m *DocumentTypeStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentTypeStorageUsage.Marshal(b, m, deterministic)
+}
+func (dst *DocumentTypeStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentTypeStorageUsage.Merge(dst, src)
+}
+func (m *DocumentTypeStorageUsage) XXX_Size() int {
+	return xxx_messageInfo_DocumentTypeStorageUsage.Size(m)
+}
+func (m *DocumentTypeStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentTypeStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentTypeStorageUsage proto.InternalMessageInfo
+
+func (m *DocumentTypeStorageUsage) GetDocumentType() string {
+	if m != nil {
+		return m.DocumentType
+	}
+	return ""
+}
+
+func (m *DocumentTypeStorageUsage) GetVersions() uint64 {
+	if m != nil {
+		return m.Versions
+	}
+	return 0
+}
+
+func (m *DocumentTypeStorageUsage) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+type GetTransitionGraphRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransitionGraphRequest) Reset()         { *m = GetTransitionGraphRequest{} }
+func (m *GetTransitionGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransitionGraphRequest) ProtoMessage()    {}
+func (*GetTransitionGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{90}
+}
+func (m *GetTransitionGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransitionGraphRequest.Unmarshal(m, b)
+}
+func (m *GetTransitionGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransitionGraphRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetTransitionGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransitionGraphRequest.Merge(dst, src)
+}
+func (m *GetTransitionGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTransitionGraphRequest.Size(m)
+}
+func (m *GetTransitionGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransitionGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransitionGraphRequest proto.InternalMessageInfo
+
+func (m *GetTransitionGraphRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type TransitionGraphRole struct {
+	RoleKey       string   `protobuf:"bytes,1,opt,name=role_key,json=roleKey,proto3" json:"role_key,omitempty"`
+	Collaborators []string `protobuf:"bytes,2,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	// registry and token IDs of the NFTs whose owners hold the role
+	Nfts                 []string `protobuf:"bytes,3,rep,name=nfts,proto3" json:"nfts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransitionGraphRole) Reset()         { *m = TransitionGraphRole{} }
+func (m *TransitionGraphRole) String() string { return proto.CompactTextString(m) }
+func (*TransitionGraphRole) ProtoMessage()    {}
+func (*TransitionGraphRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{91}
+}
+func (m *TransitionGraphRole) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransitionGraphRole.Unmarshal(m, b)
+}
+func (m *TransitionGraphRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransitionGraphRole.Marshal(b, m, deterministic)
+}
+func (dst *TransitionGraphRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransitionGraphRole.Merge(dst, src)
+}
+func (m *TransitionGraphRole) XXX_Size() int {
+	return xxx_messageInfo_TransitionGraphRole.Size(m)
+}
+func (m *TransitionGraphRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransitionGraphRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransitionGraphRole proto.InternalMessageInfo
+
+func (m *TransitionGraphRole) GetRoleKey() string {
+	if m != nil {
+		return m.RoleKey
+	}
+	return ""
+}
+
+func (m *TransitionGraphRole) GetCollaborators() []string {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+func (m *TransitionGraphRole) GetNfts() []string {
+	if m != nil {
+		return m.Nfts
+	}
+	return nil
+}
+
+type TransitionGraphRule struct {
+	RuleKey string `protobuf:"bytes,1,opt,name=rule_key,json=ruleKey,proto3" json:"rule_key,omitempty"`
+	// role keys the rule applies to
+	Roles []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	// exact or prefix
+	MatchType string `protobuf:"bytes,3,opt,name=match_type,json=matchType,proto3" json:"match_type,omitempty"`
+	// readable path of the field, the hex encoded compact property if the path is not known
+	Field                string   `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	Action               string   `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransitionGraphRule) Reset()         { *m = TransitionGraphRule{} }
+func (m *TransitionGraphRule) String() string { return proto.CompactTextString(m) }
+func (*TransitionGraphRule) ProtoMessage()    {}
+func (*TransitionGraphRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{92}
+}
+func (m *TransitionGraphRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransitionGraphRule.Unmarshal(m, b)
+}
+func (m *TransitionGraphRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransitionGraphRule.Marshal(b, m, deterministic)
+}
+func (dst *TransitionGraphRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransitionGraphRule.Merge(dst, src)
+}
+func (m *TransitionGraphRule) XXX_Size() int {
+	return xxx_messageInfo_TransitionGraphRule.Size(m)
+}
+func (m *TransitionGraphRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransitionGraphRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransitionGraphRule proto.InternalMessageInfo
+
+func (m *TransitionGraphRule) GetRuleKey() string {
+	if m != nil {
+		return m.RuleKey
+	}
+	return ""
+}
+
+func (m *TransitionGraphRule) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *TransitionGraphRule) GetMatchType() string {
+	if m != nil {
+		return m.MatchType
+	}
+	return ""
+}
+
+func (m *TransitionGraphRule) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *TransitionGraphRule) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type TransitionGraph struct {
+	DocumentId   string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	VersionId    string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	DocumentType string                 `protobuf:"bytes,3,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	Roles        []*TransitionGraphRole `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	Rules        []*TransitionGraphRule `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+	// graph in the Graphviz DOT language
+	Dot                  string   `protobuf:"bytes,6,opt,name=dot,proto3" json:"dot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransitionGraph) Reset()         { *m = TransitionGraph{} }
+func (m *TransitionGraph) String() string { return proto.CompactTextString(m) }
+func (*TransitionGraph) ProtoMessage()    {}
+func (*TransitionGraph) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{93}
+}
+func (m *TransitionGraph) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransitionGraph.Unmarshal(m, b)
+}
+func (m *TransitionGraph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransitionGraph.Marshal(b, m, deterministic)
+}
+func (dst *TransitionGraph) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransitionGraph.Merge(dst, src)
+}
+func (m *TransitionGraph) XXX_Size() int {
+	return xxx_messageInfo_TransitionGraph.Size(m)
+}
+func (m *TransitionGraph) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransitionGraph.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransitionGraph proto.InternalMessageInfo
+
+func (m *TransitionGraph) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *TransitionGraph) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *TransitionGraph) GetDocumentType() string {
+	if m != nil {
+		return m.DocumentType
+	}
+	return ""
+}
+
+func (m *TransitionGraph) GetRoles() []*TransitionGraphRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *TransitionGraph) GetRules() []*TransitionGraphRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *TransitionGraph) GetDot() string {
+	if m != nil {
+		return m.Dot
+	}
+	return ""
+}
+
+type ExportSignatureAuditRequest struct {
+	// encoding of the export, csv or json, csv if not set
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// first day of the range as YYYY-MM-DD, the range is open if not set
+	FromDate string `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	// last day of the range as YYYY-MM-DD, the range is open if not set
+	ToDate               string   `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportSignatureAuditRequest) Reset()         { *m = ExportSignatureAuditRequest{} }
+func (m *ExportSignatureAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportSignatureAuditRequest) ProtoMessage()    {}
+func (*ExportSignatureAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{94}
+}
+func (m *ExportSignatureAuditRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportSignatureAuditRequest.Unmarshal(m, b)
+}
+func (m *ExportSignatureAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportSignatureAuditRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportSignatureAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportSignatureAuditRequest.Merge(dst, src)
+}
+func (m *ExportSignatureAuditRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportSignatureAuditRequest.Size(m)
+}
+func (m *ExportSignatureAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportSignatureAuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportSignatureAuditRequest proto.InternalMessageInfo
+
+func (m *ExportSignatureAuditRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportSignatureAuditRequest) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
+	}
+	return ""
+}
+
+func (m *ExportSignatureAuditRequest) GetToDate() string {
+	if m != nil {
+		return m.ToDate
+	}
+	return ""
+}
+
+type ExportSignatureAuditResponse struct {
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// number of exported signatures
+	Signatures int32 `protobuf:"varint,2,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	// CSV file with a header row or json array of the signatures, ordered by time
+	Data string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// DID of the account signing the export
+	SignerId string `protobuf:"bytes,4,opt,name=signer_id,json=signerId,proto3" json:"signer_id,omitempty"`
+	// public signing key of the account
+	PublicKey string `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// secp256k1 signature of the account over the data
+	Signature            string   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportSignatureAuditResponse) Reset()         { *m = ExportSignatureAuditResponse{} }
+func (m *ExportSignatureAuditResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportSignatureAuditResponse) ProtoMessage()    {}
+func (*ExportSignatureAuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{95}
+}
+func (m *ExportSignatureAuditResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportSignatureAuditResponse.Unmarshal(m, b)
+}
+func (m *ExportSignatureAuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportSignatureAuditResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportSignatureAuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportSignatureAuditResponse.Merge(dst, src)
+}
+func (m *ExportSignatureAuditResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportSignatureAuditResponse.Size(m)
+}
+func (m *ExportSignatureAuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportSignatureAuditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportSignatureAuditResponse proto.InternalMessageInfo
+
+func (m *ExportSignatureAuditResponse) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportSignatureAuditResponse) GetSignatures() int32 {
+	if m != nil {
+		return m.Signatures
+	}
+	return 0
+}
+
+func (m *ExportSignatureAuditResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ExportSignatureAuditResponse) GetSignerId() string {
+	if m != nil {
+		return m.SignerId
+	}
+	return ""
+}
+
+func (m *ExportSignatureAuditResponse) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *ExportSignatureAuditResponse) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type ValidateDocumentRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateDocumentRequest) Reset()         { *m = ValidateDocumentRequest{} }
+func (m *ValidateDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateDocumentRequest) ProtoMessage()    {}
+func (*ValidateDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{96}
+}
+func (m *ValidateDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateDocumentRequest.Unmarshal(m, b)
+}
+func (m *ValidateDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateDocumentRequest.Marshal(b, m, deterministic)
+}
+func (dst *ValidateDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateDocumentRequest.Merge(dst, src)
+}
+func (m *ValidateDocumentRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateDocumentRequest.Size(m)
+}
+func (m *ValidateDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateDocumentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateDocumentRequest proto.InternalMessageInfo
+
+func (m *ValidateDocumentRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *ValidateDocumentRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type ValidationResult struct {
+	// validation group, fields, signatures, anchors or transitions
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Valid bool   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// true if the group doesn't apply to the version, the transitions of a first version
+	Skipped bool `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// failures of the validators of the group
+	Errors []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	// time taken by the group in milliseconds
+	DurationMs           int32    `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidationResult) Reset()         { *m = ValidationResult{} }
+func (m *ValidationResult) String() string { return proto.CompactTextString(m) }
+func (*ValidationResult) ProtoMessage()    {}
+func (*ValidationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{97}
+}
+func (m *ValidationResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidationResult.Unmarshal(m, b)
+}
+func (m *ValidationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidationResult.Marshal(b, m, deterministic)
+}
+func (dst *ValidationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationResult.Merge(dst, src)
+}
+func (m *ValidationResult) XXX_Size() int {
+	return xxx_messageInfo_ValidationResult.Size(m)
+}
+func (m *ValidationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidationResult proto.InternalMessageInfo
+
+func (m *ValidationResult) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ValidationResult) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ValidationResult) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+func (m *ValidationResult) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *ValidationResult) GetDurationMs() int32 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+type RequestDocumentProofRequest struct {
+	// Document identifier
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// identity of the collaborator that issued the access token
+	GranterId string `protobuf:"bytes,2,opt,name=granter_id,json=granterId,proto3" json:"granter_id,omitempty"`
+	// document holding the access token
+	DelegatingDocumentIdentifier string `protobuf:"bytes,3,opt,name=delegating_document_identifier,json=delegatingDocumentIdentifier,proto3" json:"delegating_document_identifier,omitempty"`
+	AccessTokenId                string `protobuf:"bytes,4,opt,name=access_token_id,json=accessTokenId,proto3" json:"access_token_id,omitempty"`
+	// fields to prove, exactly the fields granted by a proof-only access token
+	Fields               []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestDocumentProofRequest) Reset()         { *m = RequestDocumentProofRequest{} }
+func (m *RequestDocumentProofRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestDocumentProofRequest) ProtoMessage()    {}
+func (*RequestDocumentProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{98}
+}
+func (m *RequestDocumentProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestDocumentProofRequest.Unmarshal(m, b)
+}
+func (m *RequestDocumentProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestDocumentProofRequest.Marshal(b, m, deterministic)
+}
+func (dst *RequestDocumentProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestDocumentProofRequest.Merge(dst, src)
+}
+func (m *RequestDocumentProofRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestDocumentProofRequest.Size(m)
+}
+func (m *RequestDocumentProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestDocumentProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestDocumentProofRequest proto.InternalMessageInfo
+
+func (m *RequestDocumentProofRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *RequestDocumentProofRequest) GetGranterId() string {
+	if m != nil {
+		return m.GranterId
+	}
+	return ""
+}
+
+func (m *RequestDocumentProofRequest) GetDelegatingDocumentIdentifier() string {
+	if m != nil {
+		return m.DelegatingDocumentIdentifier
+	}
+	return ""
+}
+
+func (m *RequestDocumentProofRequest) GetAccessTokenId() string {
+	if m != nil {
+		return m.AccessTokenId
+	}
+	return ""
+}
+
+func (m *RequestDocumentProofRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type GetDocumentSummaryRequest struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// format of the summary, json or html, json if not set
+	Format               string   `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDocumentSummaryRequest) Reset()         { *m = GetDocumentSummaryRequest{} }
+func (m *GetDocumentSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDocumentSummaryRequest) ProtoMessage()    {}
+func (*GetDocumentSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{99}
+}
+func (m *GetDocumentSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDocumentSummaryRequest.Unmarshal(m, b)
+}
+func (m *GetDocumentSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDocumentSummaryRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDocumentSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDocumentSummaryRequest.Merge(dst, src)
+}
+func (m *GetDocumentSummaryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDocumentSummaryRequest.Size(m)
+}
+func (m *GetDocumentSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDocumentSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDocumentSummaryRequest proto.InternalMessageInfo
+
+func (m *GetDocumentSummaryRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *GetDocumentSummaryRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *GetDocumentSummaryRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type DocumentSummary struct {
+	DocumentId   string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	VersionId    string `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	DocumentType string `protobuf:"bytes,3,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	// status of the document, if the document type has one
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Author    string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Timestamp string `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// key fields of the document in display order
+	Fields        []*SummaryField `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
+	Collaborators []string        `protobuf:"bytes,8,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	AnchorId      string          `protobuf:"bytes,9,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// link to the anchor, if an anchor link format is configured
+	AnchorLink string `protobuf:"bytes,10,opt,name=anchor_link,json=anchorLink,proto3" json:"anchor_link,omitempty"`
+	// summary rendered as an HTML fragment, set if the html format is requested
+	Html                 string   `protobuf:"bytes,11,opt,name=html,proto3" json:"html,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DocumentSummary) Reset()         { *m = DocumentSummary{} }
+func (m *DocumentSummary) String() string { return proto.CompactTextString(m) }
+func (*DocumentSummary) ProtoMessage()    {}
+func (*DocumentSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{100}
+}
+func (m *DocumentSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentSummary.Unmarshal(m, b)
+}
+func (m *DocumentSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentSummary.Marshal(b, m, deterministic)
+}
+func (dst *DocumentSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentSummary.Merge(dst, src)
+}
+func (m *DocumentSummary) XXX_Size() int {
+	return xxx_messageInfo_DocumentSummary.Size(m)
+}
+func (m *DocumentSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentSummary proto.InternalMessageInfo
+
+func (m *DocumentSummary) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *DocumentSummary) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *DocumentSummary) GetDocumentType() string {
+	if m != nil {
+		return m.DocumentType
+	}
+	return ""
+}
+
+func (m *DocumentSummary) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DocumentSummary) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *DocumentSummary) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *DocumentSummary) GetFields() []*SummaryField {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *DocumentSummary) GetCollaborators() []string {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+func (m *DocumentSummary) GetAnchorId() string {
+	if m != nil {
+		return m.AnchorId
+	}
+	return ""
+}
+
+func (m *DocumentSummary) GetAnchorLink() string {
+	if m != nil {
+		return m.AnchorLink
+	}
+	return ""
+}
+
+func (m *DocumentSummary) GetHtml() string {
+	if m != nil {
+		return m.Html
+	}
+	return ""
+}
+
+type SummaryField struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SummaryField) Reset()         { *m = SummaryField{} }
+func (m *SummaryField) String() string { return proto.CompactTextString(m) }
+func (*SummaryField) ProtoMessage()    {}
+func (*SummaryField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_5733569c1e228854, []int{101}
+}
+func (m *SummaryField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SummaryField.Unmarshal(m, b)
+}
+func (m *SummaryField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SummaryField.Marshal(b, m, deterministic)
+}
+func (dst *SummaryField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SummaryField.Merge(dst, src)
+}
+func (m *SummaryField) XXX_Size() int {
+	return xxx_messageInfo_SummaryField.Size(m)
+}
+func (m *SummaryField) XXX_DiscardUnknown() {
+	xxx_messageInfo_SummaryField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SummaryField proto.InternalMessageInfo
+
+func (m *SummaryField) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SummaryField) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*UpdateAccessTokenPayload)(nil), "document.UpdateAccessTokenPayload")
+	proto.RegisterType((*AccessTokenParams)(nil), "document.AccessTokenParams")
+	proto.RegisterType((*CreateDocumentProofRequest)(nil), "document.CreateDocumentProofRequest")
+	proto.RegisterType((*ResponseHeader)(nil), "document.ResponseHeader")
+	proto.RegisterType((*DocumentProof)(nil), "document.DocumentProof")
+	proto.RegisterType((*BundleSignature)(nil), "document.BundleSignature")
+	proto.RegisterType((*Proof)(nil), "document.Proof")
+	proto.RegisterType((*CreateDocumentProofForVersionRequest)(nil), "document.CreateDocumentProofForVersionRequest")
+	proto.RegisterType((*LinkDocumentRequest)(nil), "document.LinkDocumentRequest")
+	proto.RegisterType((*LinkDocumentResponse)(nil), "document.LinkDocumentResponse")
+	proto.RegisterType((*GetDocumentSizeRequest)(nil), "document.GetDocumentSizeRequest")
+	proto.RegisterType((*DocumentSize)(nil), "document.DocumentSize")
+	proto.RegisterType((*VersionSize)(nil), "document.VersionSize")
+	proto.RegisterType((*PropertyMapping)(nil), "document.PropertyMapping")
+	proto.RegisterType((*DocumentPropertyMappings)(nil), "document.DocumentPropertyMappings")
+	proto.RegisterType((*PropertyMappingsResponse)(nil), "document.PropertyMappingsResponse")
+	proto.RegisterType((*DocumentCreatePayload)(nil), "document.DocumentCreatePayload")
+	proto.RegisterType((*DocumentUpdatePayload)(nil), "document.DocumentUpdatePayload")
+	proto.RegisterType((*GetDocumentRequest)(nil), "document.GetDocumentRequest")
+	proto.RegisterType((*GetDocumentVersionRequest)(nil), "document.GetDocumentVersionRequest")
+	proto.RegisterType((*DocumentResponse)(nil), "document.DocumentResponse")
+	proto.RegisterType((*CreateProofRequest)(nil), "document.CreateProofRequest")
+	proto.RegisterType((*CreateProofForVersionRequest)(nil), "document.CreateProofForVersionRequest")
+	proto.RegisterType((*ListDocumentsRequest)(nil), "document.ListDocumentsRequest")
+	proto.RegisterType((*DocumentListItem)(nil), "document.DocumentListItem")
+	proto.RegisterType((*ListDocumentsResponse)(nil), "document.ListDocumentsResponse")
+	proto.RegisterType((*GetDocumentGraphRequest)(nil), "document.GetDocumentGraphRequest")
+	proto.RegisterType((*DocumentGraphLink)(nil), "document.DocumentGraphLink")
+	proto.RegisterType((*DocumentGraph)(nil), "document.DocumentGraph")
+	proto.RegisterType((*GetVersionHistoryRequest)(nil), "document.GetVersionHistoryRequest")
+	proto.RegisterType((*VersionHistoryEntry)(nil), "document.VersionHistoryEntry")
+	proto.RegisterType((*VersionHistoryResponse)(nil), "document.VersionHistoryResponse")
+	proto.RegisterType((*GetVersionDiffRequest)(nil), "document.GetVersionDiffRequest")
+	proto.RegisterType((*FieldChange)(nil), "document.FieldChange")
+	proto.RegisterType((*VersionDiffResponse)(nil), "document.VersionDiffResponse")
+	proto.RegisterType((*ImportDocumentsRequest)(nil), "document.ImportDocumentsRequest")
+	proto.RegisterType((*ImportRowResult)(nil), "document.ImportRowResult")
+	proto.RegisterType((*ImportDocumentsResponse)(nil), "document.ImportDocumentsResponse")
+	proto.RegisterType((*RemoveCollaboratorsRequest)(nil), "document.RemoveCollaboratorsRequest")
+	proto.RegisterType((*RemoveCollaboratorsResponse)(nil), "document.RemoveCollaboratorsResponse")
+	proto.RegisterType((*ExportLedgerRequest)(nil), "document.ExportLedgerRequest")
+	proto.RegisterType((*ExportLedgerResponse)(nil), "document.ExportLedgerResponse")
+	proto.RegisterType((*FieldRule)(nil), "document.FieldRule")
+	proto.RegisterType((*ListAccessTokensRequest)(nil), "document.ListAccessTokensRequest")
+	proto.RegisterType((*AccessTokenEntry)(nil), "document.AccessTokenEntry")
+	proto.RegisterType((*ListAccessTokensResponse)(nil), "document.ListAccessTokensResponse")
+	proto.RegisterType((*DocumentSalt)(nil), "document.DocumentSalt")
+	proto.RegisterType((*SignAttributeRequest)(nil), "document.SignAttributeRequest")
+	proto.RegisterType((*AttributeSignature)(nil), "document.AttributeSignature")
+	proto.RegisterType((*AddAttributeSignatureRequest)(nil), "document.AddAttributeSignatureRequest")
+	proto.RegisterType((*AddAttributeSignatureResponse)(nil), "document.AddAttributeSignatureResponse")
+	proto.RegisterType((*CancelDocumentRequest)(nil), "document.CancelDocumentRequest")
+	proto.RegisterType((*CancelDocumentResponse)(nil), "document.CancelDocumentResponse")
+	proto.RegisterType((*VerifyProofRequest)(nil), "document.VerifyProofRequest")
+	proto.RegisterType((*FieldProofResult)(nil), "document.FieldProofResult")
+	proto.RegisterType((*VerifyProofResponse)(nil), "document.VerifyProofResponse")
+	proto.RegisterType((*ProofEnvelope)(nil), "document.ProofEnvelope")
+	proto.RegisterType((*TreePrefix)(nil), "document.TreePrefix")
+	proto.RegisterType((*MultiProof)(nil), "document.MultiProof")
+	proto.RegisterType((*MultiProofField)(nil), "document.MultiProofField")
+	proto.RegisterType((*AddNFTRequest)(nil), "document.AddNFTRequest")
+	proto.RegisterType((*AddNFTResponse)(nil), "document.AddNFTResponse")
+	proto.RegisterType((*SimulateAccessRequest)(nil), "document.SimulateAccessRequest")
+	proto.RegisterType((*AccessCheck)(nil), "document.AccessCheck")
+	proto.RegisterType((*SimulateAccessResponse)(nil), "document.SimulateAccessResponse")
+	proto.RegisterType((*TransferDetail)(nil), "document.TransferDetail")
+	proto.RegisterType((*AddTransferDetailRequest)(nil), "document.AddTransferDetailRequest")
+	proto.RegisterType((*UpdateTransferDetailRequest)(nil), "document.UpdateTransferDetailRequest")
+	proto.RegisterType((*TransferDetailProof)(nil), "document.TransferDetailProof")
+	proto.RegisterType((*TransferDetailResponse)(nil), "document.TransferDetailResponse")
+	proto.RegisterType((*ListTransferDetailsRequest)(nil), "document.ListTransferDetailsRequest")
+	proto.RegisterType((*ListTransferDetailsResponse)(nil), "document.ListTransferDetailsResponse")
+	proto.RegisterType((*Attachment)(nil), "document.Attachment")
+	proto.RegisterType((*AddAttachmentRequest)(nil), "document.AddAttachmentRequest")
+	proto.RegisterType((*AttachmentProof)(nil), "document.AttachmentProof")
+	proto.RegisterType((*AttachmentResponse)(nil), "document.AttachmentResponse")
+	proto.RegisterType((*ListAttachmentsRequest)(nil), "document.ListAttachmentsRequest")
+	proto.RegisterType((*ListAttachmentsResponse)(nil), "document.ListAttachmentsResponse")
+	proto.RegisterType((*UpdateTagsRequest)(nil), "document.UpdateTagsRequest")
+	proto.RegisterType((*TagsResponse)(nil), "document.TagsResponse")
+	proto.RegisterType((*DocumentTemplate)(nil), "document.DocumentTemplate")
+	proto.RegisterType((*TemplateAttribute)(nil), "document.TemplateAttribute")
+	proto.RegisterType((*GetTemplateRequest)(nil), "document.GetTemplateRequest")
+	proto.RegisterType((*ListTemplatesResponse)(nil), "document.ListTemplatesResponse")
+	proto.RegisterType((*CreateFromTemplateRequest)(nil), "document.CreateFromTemplateRequest")
+	proto.RegisterType((*FieldReference)(nil), "document.FieldReference")
+	proto.RegisterType((*CreateConsistencyProofRequest)(nil), "document.CreateConsistencyProofRequest")
+	proto.RegisterType((*ConsistencyProof)(nil), "document.ConsistencyProof")
+	proto.RegisterType((*StorageUsage)(nil), "document.StorageUsage")
+	proto.RegisterType((*DocumentTypeStorageUsage)(nil), "document.DocumentTypeStorageUsage")
+	proto.RegisterType((*GetTransitionGraphRequest)(nil), "document.GetTransitionGraphRequest")
+	proto.RegisterType((*TransitionGraphRole)(nil), "document.TransitionGraphRole")
+	proto.RegisterType((*TransitionGraphRule)(nil), "document.TransitionGraphRule")
+	proto.RegisterType((*TransitionGraph)(nil), "document.TransitionGraph")
+	proto.RegisterType((*ExportSignatureAuditRequest)(nil), "document.ExportSignatureAuditRequest")
+	proto.RegisterType((*ExportSignatureAuditResponse)(nil), "document.ExportSignatureAuditResponse")
+	proto.RegisterType((*ValidateDocumentRequest)(nil), "document.ValidateDocumentRequest")
+	proto.RegisterType((*ValidationResult)(nil), "document.ValidationResult")
+	proto.RegisterType((*RequestDocumentProofRequest)(nil), "document.RequestDocumentProofRequest")
+	proto.RegisterType((*GetDocumentSummaryRequest)(nil), "document.GetDocumentSummaryRequest")
+	proto.RegisterType((*DocumentSummary)(nil), "document.DocumentSummary")
+	proto.RegisterType((*SummaryField)(nil), "document.SummaryField")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DocumentServiceClient is the client API for DocumentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DocumentServiceClient interface {
+	CreateDocumentProof(ctx context.Context, in *CreateDocumentProofRequest, opts ...grpc.CallOption) (*DocumentProof, error)
+	CreateDocumentProofForVersion(ctx context.Context, in *CreateDocumentProofForVersionRequest, opts ...grpc.CallOption) (*DocumentProof, error)
+	GetDocumentSize(ctx context.Context, in *GetDocumentSizeRequest, opts ...grpc.CallOption) (*DocumentSize, error)
+	LinkDocument(ctx context.Context, in *LinkDocumentRequest, opts ...grpc.CallOption) (*LinkDocumentResponse, error)
+	GetPropertyMappings(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PropertyMappingsResponse, error)
+	CreateDocument(ctx context.Context, in *DocumentCreatePayload, opts ...grpc.CallOption) (*DocumentResponse, error)
+	UpdateDocument(ctx context.Context, in *DocumentUpdatePayload, opts ...grpc.CallOption) (*DocumentResponse, error)
+	GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*DocumentResponse, error)
+	GetDocumentVersion(ctx context.Context, in *GetDocumentVersionRequest, opts ...grpc.CallOption) (*DocumentResponse, error)
+	CreateProof(ctx context.Context, in *CreateProofRequest, opts ...grpc.CallOption) (*DocumentProof, error)
+	CreateProofForVersion(ctx context.Context, in *CreateProofForVersionRequest, opts ...grpc.CallOption) (*DocumentProof, error)
+	ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error)
+	GetDocumentGraph(ctx context.Context, in *GetDocumentGraphRequest, opts ...grpc.CallOption) (*DocumentGraph, error)
+	GetVersionHistory(ctx context.Context, in *GetVersionHistoryRequest, opts ...grpc.CallOption) (*VersionHistoryResponse, error)
+	GetVersionDiff(ctx context.Context, in *GetVersionDiffRequest, opts ...grpc.CallOption) (*VersionDiffResponse, error)
+	ImportDocuments(ctx context.Context, in *ImportDocumentsRequest, opts ...grpc.CallOption) (*ImportDocumentsResponse, error)
+	RemoveCollaborators(ctx context.Context, in *RemoveCollaboratorsRequest, opts ...grpc.CallOption) (*RemoveCollaboratorsResponse, error)
+	ExportLedger(ctx context.Context, in *ExportLedgerRequest, opts ...grpc.CallOption) (*ExportLedgerResponse, error)
+	ListAccessTokens(ctx context.Context, in *ListAccessTokensRequest, opts ...grpc.CallOption) (*ListAccessTokensResponse, error)
+	SignAttribute(ctx context.Context, in *SignAttributeRequest, opts ...grpc.CallOption) (*AttributeSignature, error)
+	AddAttributeSignature(ctx context.Context, in *AddAttributeSignatureRequest, opts ...grpc.CallOption) (*AddAttributeSignatureResponse, error)
+	CancelDocument(ctx context.Context, in *CancelDocumentRequest, opts ...grpc.CallOption) (*CancelDocumentResponse, error)
+	VerifyProof(ctx context.Context, in *VerifyProofRequest, opts ...grpc.CallOption) (*VerifyProofResponse, error)
+	AddNFT(ctx context.Context, in *AddNFTRequest, opts ...grpc.CallOption) (*AddNFTResponse, error)
+	SimulateAccess(ctx context.Context, in *SimulateAccessRequest, opts ...grpc.CallOption) (*SimulateAccessResponse, error)
+	AddTransferDetail(ctx context.Context, in *AddTransferDetailRequest, opts ...grpc.CallOption) (*TransferDetailResponse, error)
+	UpdateTransferDetail(ctx context.Context, in *UpdateTransferDetailRequest, opts ...grpc.CallOption) (*TransferDetailResponse, error)
+	ListTransferDetails(ctx context.Context, in *ListTransferDetailsRequest, opts ...grpc.CallOption) (*ListTransferDetailsResponse, error)
+	AddAttachment(ctx context.Context, in *AddAttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	AddTags(ctx context.Context, in *UpdateTagsRequest, opts ...grpc.CallOption) (*TagsResponse, error)
+	RemoveTags(ctx context.Context, in *UpdateTagsRequest, opts ...grpc.CallOption) (*TagsResponse, error)
+	SaveTemplate(ctx context.Context, in *DocumentTemplate, opts ...grpc.CallOption) (*DocumentTemplate, error)
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*DocumentTemplate, error)
+	ListTemplates(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	DeleteTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*DocumentTemplate, error)
+	CreateFromTemplate(ctx context.Context, in *CreateFromTemplateRequest, opts ...grpc.CallOption) (*DocumentResponse, error)
+	CreateConsistencyProof(ctx context.Context, in *CreateConsistencyProofRequest, opts ...grpc.CallOption) (*ConsistencyProof, error)
+	GetStorageUsage(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StorageUsage, error)
+	GetTransitionGraph(ctx context.Context, in *GetTransitionGraphRequest, opts ...grpc.CallOption) (*TransitionGraph, error)
+	ExportSignatureAudit(ctx context.Context, in *ExportSignatureAuditRequest, opts ...grpc.CallOption) (*ExportSignatureAuditResponse, error)
+	ValidateDocument(ctx context.Context, in *ValidateDocumentRequest, opts ...grpc.CallOption) (DocumentService_ValidateDocumentClient, error)
+	RequestDocumentProof(ctx context.Context, in *RequestDocumentProofRequest, opts ...grpc.CallOption) (*DocumentProof, error)
+	GetDocumentSummary(ctx context.Context, in *GetDocumentSummaryRequest, opts ...grpc.CallOption) (*DocumentSummary, error)
+}
+
+type documentServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDocumentServiceClient(cc *grpc.ClientConn) DocumentServiceClient {
+	return &documentServiceClient{cc}
+}
+
+func (c *documentServiceClient) CreateDocumentProof(ctx context.Context, in *CreateDocumentProofRequest, opts ...grpc.CallOption) (*DocumentProof, error) {
+	out := new(DocumentProof)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/CreateDocumentProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) CreateDocumentProofForVersion(ctx context.Context, in *CreateDocumentProofForVersionRequest, opts ...grpc.CallOption) (*DocumentProof, error) {
+	out := new(DocumentProof)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/CreateDocumentProofForVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetDocumentSize(ctx context.Context, in *GetDocumentSizeRequest, opts ...grpc.CallOption) (*DocumentSize, error) {
+	out := new(DocumentSize)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/GetDocumentSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) LinkDocument(ctx context.Context, in *LinkDocumentRequest, opts ...grpc.CallOption) (*LinkDocumentResponse, error) {
+	out := new(LinkDocumentResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/LinkDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetPropertyMappings(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PropertyMappingsResponse, error) {
+	out := new(PropertyMappingsResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/GetPropertyMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) CreateDocument(ctx context.Context, in *DocumentCreatePayload, opts ...grpc.CallOption) (*DocumentResponse, error) {
+	out := new(DocumentResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/CreateDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) UpdateDocument(ctx context.Context, in *DocumentUpdatePayload, opts ...grpc.CallOption) (*DocumentResponse, error) {
+	out := new(DocumentResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/UpdateDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*DocumentResponse, error) {
+	out := new(DocumentResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/GetDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetDocumentVersion(ctx context.Context, in *GetDocumentVersionRequest, opts ...grpc.CallOption) (*DocumentResponse, error) {
+	out := new(DocumentResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/GetDocumentVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) CreateProof(ctx context.Context, in *CreateProofRequest, opts ...grpc.CallOption) (*DocumentProof, error) {
+	out := new(DocumentProof)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/CreateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) CreateProofForVersion(ctx context.Context, in *CreateProofForVersionRequest, opts ...grpc.CallOption) (*DocumentProof, error) {
+	out := new(DocumentProof)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/CreateProofForVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error) {
+	out := new(ListDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/ListDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetDocumentGraph(ctx context.Context, in *GetDocumentGraphRequest, opts ...grpc.CallOption) (*DocumentGraph, error) {
+	out := new(DocumentGraph)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/GetDocumentGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetVersionHistory(ctx context.Context, in *GetVersionHistoryRequest, opts ...grpc.CallOption) (*VersionHistoryResponse, error) {
+	out := new(VersionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/GetVersionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetVersionDiff(ctx context.Context, in *GetVersionDiffRequest, opts ...grpc.CallOption) (*VersionDiffResponse, error) {
+	out := new(VersionDiffResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/GetVersionDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ImportDocuments(ctx context.Context, in *ImportDocumentsRequest, opts ...grpc.CallOption) (*ImportDocumentsResponse, error) {
+	out := new(ImportDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/ImportDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) RemoveCollaborators(ctx context.Context, in *RemoveCollaboratorsRequest, opts ...grpc.CallOption) (*RemoveCollaboratorsResponse, error) {
+	out := new(RemoveCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/RemoveCollaborators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ExportLedger(ctx context.Context, in *ExportLedgerRequest, opts ...grpc.CallOption) (*ExportLedgerResponse, error) {
+	out := new(ExportLedgerResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/ExportLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ListAccessTokens(ctx context.Context, in *ListAccessTokensRequest, opts ...grpc.CallOption) (*ListAccessTokensResponse, error) {
+	out := new(ListAccessTokensResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/ListAccessTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) SignAttribute(ctx context.Context, in *SignAttributeRequest, opts ...grpc.CallOption) (*AttributeSignature, error) {
+	out := new(AttributeSignature)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/SignAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) AddAttributeSignature(ctx context.Context, in *AddAttributeSignatureRequest, opts ...grpc.CallOption) (*AddAttributeSignatureResponse, error) {
+	out := new(AddAttributeSignatureResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/AddAttributeSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) CancelDocument(ctx context.Context, in *CancelDocumentRequest, opts ...grpc.CallOption) (*CancelDocumentResponse, error) {
+	out := new(CancelDocumentResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/CancelDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) VerifyProof(ctx context.Context, in *VerifyProofRequest, opts ...grpc.CallOption) (*VerifyProofResponse, error) {
+	out := new(VerifyProofResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/VerifyProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) AddNFT(ctx context.Context, in *AddNFTRequest, opts ...grpc.CallOption) (*AddNFTResponse, error) {
+	out := new(AddNFTResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/AddNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) SimulateAccess(ctx context.Context, in *SimulateAccessRequest, opts ...grpc.CallOption) (*SimulateAccessResponse, error) {
+	out := new(SimulateAccessResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/SimulateAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) AddTransferDetail(ctx context.Context, in *AddTransferDetailRequest, opts ...grpc.CallOption) (*TransferDetailResponse, error) {
+	out := new(TransferDetailResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/AddTransferDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) UpdateTransferDetail(ctx context.Context, in *UpdateTransferDetailRequest, opts ...grpc.CallOption) (*TransferDetailResponse, error) {
+	out := new(TransferDetailResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/UpdateTransferDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ListTransferDetails(ctx context.Context, in *ListTransferDetailsRequest, opts ...grpc.CallOption) (*ListTransferDetailsResponse, error) {
+	out := new(ListTransferDetailsResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/ListTransferDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) AddAttachment(ctx context.Context, in *AddAttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error) {
+	out := new(AttachmentResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/AddAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/ListAttachments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) AddTags(ctx context.Context, in *UpdateTagsRequest, opts ...grpc.CallOption) (*TagsResponse, error) {
+	out := new(TagsResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/AddTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) RemoveTags(ctx context.Context, in *UpdateTagsRequest, opts ...grpc.CallOption) (*TagsResponse, error) {
+	out := new(TagsResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/RemoveTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) SaveTemplate(ctx context.Context, in *DocumentTemplate, opts ...grpc.CallOption) (*DocumentTemplate, error) {
+	out := new(DocumentTemplate)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/SaveTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*DocumentTemplate, error) {
+	out := new(DocumentTemplate)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/GetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ListTemplates(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/ListTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) DeleteTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*DocumentTemplate, error) {
+	out := new(DocumentTemplate)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/DeleteTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) CreateFromTemplate(ctx context.Context, in *CreateFromTemplateRequest, opts ...grpc.CallOption) (*DocumentResponse, error) {
+	out := new(DocumentResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/CreateFromTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) CreateConsistencyProof(ctx context.Context, in *CreateConsistencyProofRequest, opts ...grpc.CallOption) (*ConsistencyProof, error) {
+	out := new(ConsistencyProof)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/CreateConsistencyProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetStorageUsage(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StorageUsage, error) {
+	out := new(StorageUsage)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/GetStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetTransitionGraph(ctx context.Context, in *GetTransitionGraphRequest, opts ...grpc.CallOption) (*TransitionGraph, error) {
+	out := new(TransitionGraph)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/GetTransitionGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ExportSignatureAudit(ctx context.Context, in *ExportSignatureAuditRequest, opts ...grpc.CallOption) (*ExportSignatureAuditResponse, error) {
+	out := new(ExportSignatureAuditResponse)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/ExportSignatureAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ValidateDocument(ctx context.Context, in *ValidateDocumentRequest, opts ...grpc.CallOption) (DocumentService_ValidateDocumentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DocumentService_serviceDesc.Streams[0], "/document.DocumentService/ValidateDocument", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &documentServiceValidateDocumentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DocumentService_ValidateDocumentClient interface {
+	Recv() (*ValidationResult, error)
+	grpc.ClientStream
+}
+
+type documentServiceValidateDocumentClient struct {
+	grpc.ClientStream
+}
+
+func (x *documentServiceValidateDocumentClient) Recv() (*ValidationResult, error) {
+	m := new(ValidationResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *documentServiceClient) RequestDocumentProof(ctx context.Context, in *RequestDocumentProofRequest, opts ...grpc.CallOption) (*DocumentProof, error) {
+	out := new(DocumentProof)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/RequestDocumentProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetDocumentSummary(ctx context.Context, in *GetDocumentSummaryRequest, opts ...grpc.CallOption) (*DocumentSummary, error) {
+	out := new(DocumentSummary)
+	err := c.cc.Invoke(ctx, "/document.DocumentService/GetDocumentSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DocumentServiceServer is the server API for DocumentService service.
+type DocumentServiceServer interface {
+	CreateDocumentProof(context.Context, *CreateDocumentProofRequest) (*DocumentProof, error)
+	CreateDocumentProofForVersion(context.Context, *CreateDocumentProofForVersionRequest) (*DocumentProof, error)
+	GetDocumentSize(context.Context, *GetDocumentSizeRequest) (*DocumentSize, error)
+	LinkDocument(context.Context, *LinkDocumentRequest) (*LinkDocumentResponse, error)
+	GetPropertyMappings(context.Context, *empty.Empty) (*PropertyMappingsResponse, error)
+	CreateDocument(context.Context, *DocumentCreatePayload) (*DocumentResponse, error)
+	UpdateDocument(context.Context, *DocumentUpdatePayload) (*DocumentResponse, error)
+	GetDocument(context.Context, *GetDocumentRequest) (*DocumentResponse, error)
+	GetDocumentVersion(context.Context, *GetDocumentVersionRequest) (*DocumentResponse, error)
+	CreateProof(context.Context, *CreateProofRequest) (*DocumentProof, error)
+	CreateProofForVersion(context.Context, *CreateProofForVersionRequest) (*DocumentProof, error)
+	ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error)
+	GetDocumentGraph(context.Context, *GetDocumentGraphRequest) (*DocumentGraph, error)
+	GetVersionHistory(context.Context, *GetVersionHistoryRequest) (*VersionHistoryResponse, error)
+	GetVersionDiff(context.Context, *GetVersionDiffRequest) (*VersionDiffResponse, error)
+	ImportDocuments(context.Context, *ImportDocumentsRequest) (*ImportDocumentsResponse, error)
+	RemoveCollaborators(context.Context, *RemoveCollaboratorsRequest) (*RemoveCollaboratorsResponse, error)
+	ExportLedger(context.Context, *ExportLedgerRequest) (*ExportLedgerResponse, error)
+	ListAccessTokens(context.Context, *ListAccessTokensRequest) (*ListAccessTokensResponse, error)
+	SignAttribute(context.Context, *SignAttributeRequest) (*AttributeSignature, error)
+	AddAttributeSignature(context.Context, *AddAttributeSignatureRequest) (*AddAttributeSignatureResponse, error)
+	CancelDocument(context.Context, *CancelDocumentRequest) (*CancelDocumentResponse, error)
+	VerifyProof(context.Context, *VerifyProofRequest) (*VerifyProofResponse, error)
+	AddNFT(context.Context, *AddNFTRequest) (*AddNFTResponse, error)
+	SimulateAccess(context.Context, *SimulateAccessRequest) (*SimulateAccessResponse, error)
+	AddTransferDetail(context.Context, *AddTransferDetailRequest) (*TransferDetailResponse, error)
+	UpdateTransferDetail(context.Context, *UpdateTransferDetailRequest) (*TransferDetailResponse, error)
+	ListTransferDetails(context.Context, *ListTransferDetailsRequest) (*ListTransferDetailsResponse, error)
+	AddAttachment(context.Context, *AddAttachmentRequest) (*AttachmentResponse, error)
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	AddTags(context.Context, *UpdateTagsRequest) (*TagsResponse, error)
+	RemoveTags(context.Context, *UpdateTagsRequest) (*TagsResponse, error)
+	SaveTemplate(context.Context, *DocumentTemplate) (*DocumentTemplate, error)
+	GetTemplate(context.Context, *GetTemplateRequest) (*DocumentTemplate, error)
+	ListTemplates(context.Context, *empty.Empty) (*ListTemplatesResponse, error)
+	DeleteTemplate(context.Context, *GetTemplateRequest) (*DocumentTemplate, error)
+	CreateFromTemplate(context.Context, *CreateFromTemplateRequest) (*DocumentResponse, error)
+	CreateConsistencyProof(context.Context, *CreateConsistencyProofRequest) (*ConsistencyProof, error)
+	GetStorageUsage(context.Context, *empty.Empty) (*StorageUsage, error)
+	GetTransitionGraph(context.Context, *GetTransitionGraphRequest) (*TransitionGraph, error)
+	ExportSignatureAudit(context.Context, *ExportSignatureAuditRequest) (*ExportSignatureAuditResponse, error)
+	ValidateDocument(*ValidateDocumentRequest, DocumentService_ValidateDocumentServer) error
+	RequestDocumentProof(context.Context, *RequestDocumentProofRequest) (*DocumentProof, error)
+	GetDocumentSummary(context.Context, *GetDocumentSummaryRequest) (*DocumentSummary, error)
+}
+
+func RegisterDocumentServiceServer(s *grpc.Server, srv DocumentServiceServer) {
+	s.RegisterService(&_DocumentService_serviceDesc, srv)
+}
+
+func _DocumentService_CreateDocumentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDocumentProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).CreateDocumentProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/CreateDocumentProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).CreateDocumentProof(ctx, req.(*CreateDocumentProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_CreateDocumentProofForVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDocumentProofForVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).CreateDocumentProofForVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/CreateDocumentProofForVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).CreateDocumentProofForVersion(ctx, req.(*CreateDocumentProofForVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetDocumentSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetDocumentSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/GetDocumentSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetDocumentSize(ctx, req.(*GetDocumentSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_LinkDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).LinkDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/LinkDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).LinkDocument(ctx, req.(*LinkDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetPropertyMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetPropertyMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/GetPropertyMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetPropertyMappings(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_CreateDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentCreatePayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).CreateDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/CreateDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).CreateDocument(ctx, req.(*DocumentCreatePayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_UpdateDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentUpdatePayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).UpdateDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/UpdateDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).UpdateDocument(ctx, req.(*DocumentUpdatePayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/GetDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetDocument(ctx, req.(*GetDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetDocumentVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetDocumentVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/GetDocumentVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetDocumentVersion(ctx, req.(*GetDocumentVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_CreateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).CreateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/CreateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).CreateProof(ctx, req.(*CreateProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_CreateProofForVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProofForVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).CreateProofForVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/CreateProofForVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).CreateProofForVersion(ctx, req.(*CreateProofForVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ListDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ListDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/ListDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ListDocuments(ctx, req.(*ListDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetDocumentGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetDocumentGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/GetDocumentGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetDocumentGraph(ctx, req.(*GetDocumentGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetVersionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetVersionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/GetVersionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetVersionHistory(ctx, req.(*GetVersionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetVersionDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetVersionDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/GetVersionDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetVersionDiff(ctx, req.(*GetVersionDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ImportDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ImportDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/ImportDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ImportDocuments(ctx, req.(*ImportDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_RemoveCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).RemoveCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/RemoveCollaborators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).RemoveCollaborators(ctx, req.(*RemoveCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ExportLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ExportLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/ExportLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ExportLedger(ctx, req.(*ExportLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ListAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ListAccessTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/ListAccessTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ListAccessTokens(ctx, req.(*ListAccessTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_SignAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).SignAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/SignAttribute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).SignAttribute(ctx, req.(*SignAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_AddAttributeSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAttributeSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).AddAttributeSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/AddAttributeSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).AddAttributeSignature(ctx, req.(*AddAttributeSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_CancelDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).CancelDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/CancelDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).CancelDocument(ctx, req.(*CancelDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_VerifyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).VerifyProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/VerifyProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).VerifyProof(ctx, req.(*VerifyProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_AddNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).AddNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/AddNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).AddNFT(ctx, req.(*AddNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_SimulateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).SimulateAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/SimulateAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).SimulateAccess(ctx, req.(*SimulateAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_AddTransferDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransferDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).AddTransferDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/AddTransferDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).AddTransferDetail(ctx, req.(*AddTransferDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_UpdateTransferDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransferDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).UpdateTransferDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/UpdateTransferDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).UpdateTransferDetail(ctx, req.(*UpdateTransferDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ListTransferDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransferDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ListTransferDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/ListTransferDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ListTransferDetails(ctx, req.(*ListTransferDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_AddAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).AddAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/AddAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).AddAttachment(ctx, req.(*AddAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/ListAttachments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_AddTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).AddTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/AddTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).AddTags(ctx, req.(*UpdateTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_RemoveTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).RemoveTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/RemoveTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).RemoveTags(ctx, req.(*UpdateTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_SaveTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).SaveTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/SaveTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).SaveTemplate(ctx, req.(*DocumentTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/GetTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/ListTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ListTemplates(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/DeleteTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).DeleteTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_CreateFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).CreateFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/CreateFromTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).CreateFromTemplate(ctx, req.(*CreateFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_CreateConsistencyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConsistencyProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).CreateConsistencyProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/CreateConsistencyProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).CreateConsistencyProof(ctx, req.(*CreateConsistencyProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/GetStorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetStorageUsage(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetTransitionGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransitionGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetTransitionGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/GetTransitionGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetTransitionGraph(ctx, req.(*GetTransitionGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ExportSignatureAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSignatureAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ExportSignatureAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/ExportSignatureAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ExportSignatureAudit(ctx, req.(*ExportSignatureAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ValidateDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidateDocumentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DocumentServiceServer).ValidateDocument(m, &documentServiceValidateDocumentServer{stream})
+}
+
+type DocumentService_ValidateDocumentServer interface {
+	Send(*ValidationResult) error
+	grpc.ServerStream
+}
+
+type documentServiceValidateDocumentServer struct {
+	grpc.ServerStream
+}
+
+func (x *documentServiceValidateDocumentServer) Send(m *ValidationResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DocumentService_RequestDocumentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDocumentProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).RequestDocumentProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/RequestDocumentProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).RequestDocumentProof(ctx, req.(*RequestDocumentProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetDocumentSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetDocumentSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/document.DocumentService/GetDocumentSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetDocumentSummary(ctx, req.(*GetDocumentSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DocumentService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "document.DocumentService",
+	HandlerType: (*DocumentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
 			MethodName: "CreateDocumentProof",
 			Handler:    _DocumentService_CreateDocumentProof_Handler,
 		},
@@ -520,57 +7560,613 @@ var _DocumentService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateDocumentProofForVersion",
 			Handler:    _DocumentService_CreateDocumentProofForVersion_Handler,
 		},
+		{
+			MethodName: "GetDocumentSize",
+			Handler:    _DocumentService_GetDocumentSize_Handler,
+		},
+		{
+			MethodName: "LinkDocument",
+			Handler:    _DocumentService_LinkDocument_Handler,
+		},
+		{
+			MethodName: "GetPropertyMappings",
+			Handler:    _DocumentService_GetPropertyMappings_Handler,
+		},
+		{
+			MethodName: "CreateDocument",
+			Handler:    _DocumentService_CreateDocument_Handler,
+		},
+		{
+			MethodName: "UpdateDocument",
+			Handler:    _DocumentService_UpdateDocument_Handler,
+		},
+		{
+			MethodName: "GetDocument",
+			Handler:    _DocumentService_GetDocument_Handler,
+		},
+		{
+			MethodName: "GetDocumentVersion",
+			Handler:    _DocumentService_GetDocumentVersion_Handler,
+		},
+		{
+			MethodName: "CreateProof",
+			Handler:    _DocumentService_CreateProof_Handler,
+		},
+		{
+			MethodName: "CreateProofForVersion",
+			Handler:    _DocumentService_CreateProofForVersion_Handler,
+		},
+		{
+			MethodName: "ListDocuments",
+			Handler:    _DocumentService_ListDocuments_Handler,
+		},
+		{
+			MethodName: "GetDocumentGraph",
+			Handler:    _DocumentService_GetDocumentGraph_Handler,
+		},
+		{
+			MethodName: "GetVersionHistory",
+			Handler:    _DocumentService_GetVersionHistory_Handler,
+		},
+		{
+			MethodName: "GetVersionDiff",
+			Handler:    _DocumentService_GetVersionDiff_Handler,
+		},
+		{
+			MethodName: "ImportDocuments",
+			Handler:    _DocumentService_ImportDocuments_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborators",
+			Handler:    _DocumentService_RemoveCollaborators_Handler,
+		},
+		{
+			MethodName: "ExportLedger",
+			Handler:    _DocumentService_ExportLedger_Handler,
+		},
+		{
+			MethodName: "ListAccessTokens",
+			Handler:    _DocumentService_ListAccessTokens_Handler,
+		},
+		{
+			MethodName: "SignAttribute",
+			Handler:    _DocumentService_SignAttribute_Handler,
+		},
+		{
+			MethodName: "AddAttributeSignature",
+			Handler:    _DocumentService_AddAttributeSignature_Handler,
+		},
+		{
+			MethodName: "CancelDocument",
+			Handler:    _DocumentService_CancelDocument_Handler,
+		},
+		{
+			MethodName: "VerifyProof",
+			Handler:    _DocumentService_VerifyProof_Handler,
+		},
+		{
+			MethodName: "AddNFT",
+			Handler:    _DocumentService_AddNFT_Handler,
+		},
+		{
+			MethodName: "SimulateAccess",
+			Handler:    _DocumentService_SimulateAccess_Handler,
+		},
+		{
+			MethodName: "AddTransferDetail",
+			Handler:    _DocumentService_AddTransferDetail_Handler,
+		},
+		{
+			MethodName: "UpdateTransferDetail",
+			Handler:    _DocumentService_UpdateTransferDetail_Handler,
+		},
+		{
+			MethodName: "ListTransferDetails",
+			Handler:    _DocumentService_ListTransferDetails_Handler,
+		},
+		{
+			MethodName: "AddAttachment",
+			Handler:    _DocumentService_AddAttachment_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _DocumentService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "AddTags",
+			Handler:    _DocumentService_AddTags_Handler,
+		},
+		{
+			MethodName: "RemoveTags",
+			Handler:    _DocumentService_RemoveTags_Handler,
+		},
+		{
+			MethodName: "SaveTemplate",
+			Handler:    _DocumentService_SaveTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _DocumentService_GetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _DocumentService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _DocumentService_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "CreateFromTemplate",
+			Handler:    _DocumentService_CreateFromTemplate_Handler,
+		},
+		{
+			MethodName: "CreateConsistencyProof",
+			Handler:    _DocumentService_CreateConsistencyProof_Handler,
+		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _DocumentService_GetStorageUsage_Handler,
+		},
+		{
+			MethodName: "GetTransitionGraph",
+			Handler:    _DocumentService_GetTransitionGraph_Handler,
+		},
+		{
+			MethodName: "ExportSignatureAudit",
+			Handler:    _DocumentService_ExportSignatureAudit_Handler,
+		},
+		{
+			MethodName: "RequestDocumentProof",
+			Handler:    _DocumentService_RequestDocumentProof_Handler,
+		},
+		{
+			MethodName: "GetDocumentSummary",
+			Handler:    _DocumentService_GetDocumentSummary_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ValidateDocument",
+			Handler:       _DocumentService_ValidateDocument_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "document/service.proto",
 }
 
-func init() { proto.RegisterFile("document/service.proto", fileDescriptor_service_6db8efe3c4a04f30) }
-
-var fileDescriptor_service_6db8efe3c4a04f30 = []byte{
-	// 699 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0x13, 0x49,
-	0x10, 0xd6, 0xd8, 0xf9, 0x2d, 0x3b, 0x1b, 0xa5, 0xb3, 0xca, 0x8e, 0xbc, 0x49, 0xb6, 0x77, 0x36,
-	0xda, 0xb5, 0xb2, 0xc4, 0x46, 0xe6, 0xc6, 0x2d, 0x21, 0x42, 0x89, 0xb8, 0x58, 0x86, 0x80, 0xc4,
-	0x01, 0xab, 0x33, 0x53, 0x1e, 0x0f, 0x4c, 0xa6, 0x87, 0xee, 0xb6, 0x91, 0x15, 0x71, 0x80, 0x03,
-	0x17, 0x38, 0x85, 0x17, 0xe0, 0x05, 0x78, 0x1a, 0x2e, 0x3c, 0x00, 0x4f, 0xc1, 0x09, 0x4d, 0x77,
-	0x8f, 0xc7, 0xc6, 0x49, 0x38, 0xe4, 0x34, 0x5d, 0xd5, 0x5f, 0x57, 0x7f, 0xdf, 0x57, 0x3d, 0x05,
-	0x1b, 0x01, 0xf7, 0x07, 0x67, 0x98, 0xa8, 0xa6, 0x44, 0x31, 0x8c, 0x7c, 0x6c, 0xa4, 0x82, 0x2b,
-	0x4e, 0x96, 0xf2, 0x7c, 0x6d, 0x33, 0xe4, 0x3c, 0x8c, 0xb1, 0xc9, 0xd2, 0xa8, 0xc9, 0x92, 0x84,
-	0x2b, 0xa6, 0x22, 0x9e, 0x48, 0x83, 0xab, 0xfd, 0x97, 0x0a, 0xf4, 0x23, 0x89, 0x7b, 0xa9, 0xe0,
-	0xbc, 0x27, 0x9b, 0xc5, 0x47, 0x71, 0x13, 0x58, 0xe0, 0x2d, 0xfd, 0xf1, 0xf7, 0x42, 0x4c, 0xf6,
-	0xe4, 0x2b, 0x16, 0x86, 0x28, 0x9a, 0x3c, 0xd5, 0xa5, 0x66, 0xcb, 0x7a, 0x9f, 0x1d, 0x70, 0x4f,
-	0xd2, 0x80, 0x29, 0xdc, 0xf7, 0x7d, 0x94, 0xf2, 0x11, 0x7f, 0x81, 0x49, 0x9b, 0x8d, 0x62, 0xce,
-	0x02, 0x72, 0x08, 0xdb, 0x01, 0xc6, 0x18, 0x32, 0x15, 0x25, 0x61, 0x37, 0x27, 0xda, 0x8d, 0x02,
-	0x4c, 0x54, 0xd4, 0x8b, 0x50, 0xb8, 0x0e, 0x75, 0xea, 0xcb, 0x9d, 0xcd, 0x02, 0x75, 0x68, 0x41,
-	0xc7, 0x63, 0x0c, 0x79, 0x00, 0xeb, 0x4c, 0xd7, 0xee, 0xaa, 0xac, 0x78, 0x37, 0x65, 0x82, 0x9d,
-	0x49, 0xb7, 0x44, 0x9d, 0x7a, 0xa5, 0xf5, 0x67, 0x23, 0x2f, 0xdb, 0x98, 0x22, 0x90, 0x41, 0x3a,
-	0x6b, 0xec, 0xe7, 0x94, 0xf7, 0x0c, 0xd6, 0x66, 0x70, 0xc4, 0x85, 0xc5, 0x50, 0xb0, 0x44, 0x21,
-	0xba, 0x73, 0x9a, 0x50, 0x1e, 0x92, 0x26, 0xac, 0x5f, 0x46, 0xbb, 0xa4, 0x51, 0x24, 0x98, 0x21,
-	0xeb, 0xf5, 0xa1, 0x76, 0x4f, 0x20, 0x53, 0x98, 0x0b, 0x69, 0x67, 0xd6, 0x76, 0xf0, 0xe5, 0x00,
-	0xa5, 0x22, 0xdb, 0x00, 0x33, 0xe2, 0x27, 0x32, 0x84, 0xc0, 0x9c, 0x1a, 0xa5, 0x68, 0xeb, 0xeb,
-	0x35, 0xd9, 0x80, 0x85, 0x5e, 0x84, 0x71, 0x20, 0xdd, 0x32, 0x2d, 0xd7, 0x97, 0x3b, 0x36, 0xf2,
-	0x7a, 0xf0, 0x5b, 0x07, 0x65, 0xca, 0x13, 0x89, 0x47, 0xc8, 0x02, 0x14, 0xe4, 0x2f, 0xa8, 0x4c,
-	0x90, 0xcd, 0xcb, 0x17, 0x24, 0xc9, 0x16, 0xc0, 0x10, 0x85, 0x8c, 0x78, 0x92, 0xed, 0x9b, 0x4b,
-	0x96, 0x6d, 0xe6, 0x38, 0x20, 0xbf, 0xc3, 0xbc, 0x54, 0x4c, 0xa1, 0x5b, 0xd6, 0x3b, 0x26, 0xf0,
-	0x06, 0xb0, 0x32, 0xa5, 0x85, 0xdc, 0x86, 0x85, 0xbe, 0xbe, 0x50, 0xdf, 0x50, 0x69, 0xb9, 0x45,
-	0x0b, 0xa6, 0x09, 0x75, 0x2c, 0x8e, 0xb4, 0xa0, 0xaa, 0x49, 0x77, 0xcd, 0xa3, 0x73, 0x4b, 0xb4,
-	0x5c, 0xaf, 0xb4, 0x56, 0x8b, 0x73, 0xc6, 0xa4, 0x8a, 0x06, 0xe9, 0xb5, 0xf4, 0xde, 0x39, 0x30,
-	0x6f, 0xee, 0xab, 0xc1, 0x52, 0x2a, 0x78, 0x8a, 0x42, 0x8d, 0xac, 0xa6, 0x71, 0x9c, 0x51, 0x1e,
-	0xb2, 0x78, 0x90, 0x3b, 0x66, 0x82, 0xcc, 0x46, 0xc9, 0x62, 0x65, 0x75, 0xe8, 0x75, 0x96, 0xeb,
-	0x33, 0xd9, 0xb7, 0x0d, 0xd6, 0x6b, 0xf2, 0x0f, 0xac, 0x48, 0x2e, 0x14, 0x06, 0xdd, 0x2c, 0x44,
-	0xe9, 0xce, 0x6b, 0x87, 0xab, 0x26, 0x79, 0xa4, 0x73, 0xde, 0x07, 0x07, 0x76, 0x2e, 0x69, 0xe9,
-	0x7d, 0x2e, 0x1e, 0x1b, 0xe7, 0x6e, 0xd2, 0x5c, 0x17, 0x16, 0xad, 0xff, 0x96, 0x6c, 0x1e, 0x4e,
-	0xb4, 0x7d, 0x6e, 0xb2, 0xed, 0xad, 0xef, 0x65, 0x58, 0xcd, 0x89, 0x3c, 0x34, 0x93, 0x80, 0x7c,
-	0x75, 0x60, 0xfd, 0x12, 0x8a, 0x64, 0xa7, 0x70, 0xf8, 0xea, 0x47, 0x59, 0xfb, 0xa3, 0x40, 0x4d,
-	0xed, 0x7b, 0x6f, 0x9c, 0x8b, 0xfd, 0x27, 0xb5, 0x13, 0x73, 0x54, 0x52, 0x46, 0xe3, 0x48, 0x2a,
-	0xca, 0x7b, 0xd4, 0x8e, 0x12, 0x6a, 0xda, 0x49, 0x7b, 0x5c, 0x50, 0xd5, 0x47, 0x2a, 0x53, 0xf4,
-	0x33, 0xa9, 0x01, 0x35, 0x5c, 0x33, 0x68, 0x96, 0xcf, 0xcb, 0xd3, 0x30, 0x1a, 0x62, 0x42, 0x4f,
-	0x47, 0xf4, 0xf8, 0xf0, 0xed, 0x97, 0x6f, 0x1f, 0x4b, 0x7f, 0x7b, 0x9b, 0xcd, 0xf1, 0x58, 0x3b,
-	0x2f, 0xac, 0x7a, 0x6d, 0x06, 0xd2, 0x5d, 0x67, 0x97, 0xbc, 0x2f, 0xc1, 0xd6, 0xb5, 0xee, 0x93,
-	0xc6, 0xb5, 0x22, 0x67, 0xda, 0x74, 0xb5, 0xdc, 0x4f, 0xce, 0xc5, 0x7e, 0x5c, 0x7b, 0x7e, 0x63,
-	0xb9, 0x46, 0xa5, 0xed, 0xe3, 0x2f, 0x3d, 0xf8, 0xdf, 0xfb, 0xf7, 0x0a, 0x0f, 0xce, 0x6d, 0x89,
-	0xc2, 0x8d, 0x83, 0x5d, 0xa8, 0xfa, 0xfc, 0x6c, 0x2c, 0xe0, 0xa0, 0x6a, 0x5f, 0x40, 0x3b, 0x9b,
-	0xc5, 0x6d, 0xe7, 0xe9, 0xf8, 0x67, 0x4f, 0x4f, 0x4f, 0x17, 0xf4, 0x80, 0xbe, 0xf3, 0x23, 0x00,
-	0x00, 0xff, 0xff, 0x17, 0xaa, 0x39, 0x7f, 0x39, 0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("document/service.proto", fileDescriptor_service_5733569c1e228854) }
+
+var fileDescriptor_service_5733569c1e228854 = []byte{
+	// 6878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3c, 0x6b, 0x8c, 0x1c, 0x49,
+	0x79, 0xaa, 0xd9, 0x9d, 0x7d, 0x7c, 0xbb, 0xeb, 0xb5, 0x6b, 0xd7, 0xeb, 0xb9, 0xde, 0xb5, 0xdd,
+	0x6e, 0xee, 0xe1, 0xbb, 0x5b, 0x7b, 0xef, 0x7c, 0x3c, 0xee, 0x91, 0xa0, 0x8c, 0xed, 0xf3, 0xdd,
+	0x72, 0x2f, 0x67, 0xd6, 0x67, 0xc2, 0x25, 0x61, 0xe8, 0x9d, 0xae, 0x99, 0x6d, 0xdc, 0xd3, 0x3d,
+	0xd7, 0x5d, 0xb3, 0xeb, 0x3d, 0x73, 0x21, 0x9c, 0x04, 0x42, 0xe1, 0x72, 0x90, 0x81, 0x08, 0x08,
+	0x21, 0x09, 0x21, 0x82, 0xa0, 0x04, 0x11, 0x09, 0x14, 0xa2, 0x08, 0x29, 0x20, 0xe5, 0x07, 0x12,
+	0x49, 0x84, 0xc4, 0x1f, 0x44, 0xa4, 0x84, 0x10, 0xfe, 0x44, 0x81, 0x24, 0x0a, 0x08, 0x45, 0xf9,
+	0x93, 0xa8, 0x5e, 0xdd, 0xd5, 0xaf, 0x99, 0xf1, 0xda, 0xc7, 0xaf, 0x9d, 0xaa, 0xfa, 0xba, 0xfa,
+	0xfb, 0xbe, 0xfa, 0xea, 0x7b, 0xf7, 0xc2, 0x8a, 0x13, 0xb4, 0xfa, 0x5d, 0xe2, 0xd3, 0x8d, 0x88,
+	0x84, 0xbb, 0x6e, 0x8b, 0x9c, 0xed, 0x85, 0x01, 0x0d, 0xf0, 0x8c, 0x9a, 0x37, 0xd6, 0x3a, 0x41,
+	0xd0, 0xf1, 0xc8, 0x86, 0xdd, 0x73, 0x37, 0x6c, 0xdf, 0x0f, 0xa8, 0x4d, 0xdd, 0xc0, 0x8f, 0x04,
+	0x9c, 0xb1, 0x2a, 0x57, 0xf9, 0x68, 0xbb, 0xdf, 0xde, 0x20, 0xdd, 0x1e, 0xdd, 0x97, 0x8b, 0x6b,
+	0xd9, 0xc5, 0x88, 0x86, 0xfd, 0x16, 0x95, 0xab, 0x27, 0xb3, 0xab, 0xd4, 0xed, 0x92, 0x88, 0xda,
+	0xdd, 0x9e, 0x04, 0xb8, 0xa7, 0x17, 0x92, 0x96, 0x1b, 0x91, 0x33, 0xbd, 0x30, 0x08, 0xda, 0xd1,
+	0x46, 0xf2, 0x87, 0x06, 0x62, 0x20, 0x01, 0xd7, 0xf9, 0x9f, 0xd6, 0x99, 0x0e, 0xf1, 0xcf, 0x44,
+	0x7b, 0x76, 0xa7, 0x43, 0xc2, 0x8d, 0xa0, 0xc7, 0xd1, 0xcc, 0xa3, 0x6c, 0x7d, 0x11, 0x41, 0xed,
+	0xf9, 0x9e, 0x63, 0x53, 0x52, 0x6f, 0xb5, 0x48, 0x14, 0x5d, 0x09, 0xae, 0x11, 0xff, 0xb2, 0xbd,
+	0xef, 0x05, 0xb6, 0x83, 0x2f, 0xc2, 0x09, 0x87, 0x78, 0xa4, 0x63, 0x53, 0xd7, 0xef, 0x34, 0x15,
+	0x13, 0x9a, 0xae, 0x43, 0x7c, 0xea, 0xb6, 0x5d, 0x12, 0xd6, 0x90, 0x89, 0x4e, 0xcf, 0x36, 0xd6,
+	0x12, 0xa8, 0x8b, 0x12, 0x68, 0x33, 0x86, 0xc1, 0x4f, 0xc1, 0x92, 0xcd, 0xf7, 0x6e, 0x52, 0xb6,
+	0x79, 0xb3, 0x67, 0x87, 0x76, 0x37, 0xaa, 0x55, 0x4c, 0x74, 0x7a, 0xee, 0xdc, 0xea, 0x59, 0xb5,
+	0xed, 0xd9, 0x14, 0x02, 0x0c, 0xa4, 0x71, 0xc4, 0xce, 0x4e, 0x59, 0x5f, 0x43, 0x70, 0x24, 0x07,
+	0x88, 0x6b, 0x30, 0xdd, 0x09, 0x6d, 0x9f, 0x12, 0x52, 0x9b, 0xe4, 0x18, 0xa9, 0x21, 0xde, 0x80,
+	0xa5, 0x22, 0xbc, 0x2b, 0x1c, 0x0a, 0x3b, 0x79, 0x6c, 0x4f, 0xc1, 0x3c, 0xe7, 0x66, 0xb3, 0xed,
+	0x12, 0xcf, 0x89, 0x6a, 0x55, 0x73, 0xe2, 0xf4, 0x6c, 0x63, 0x8e, 0xcf, 0x5d, 0xe2, 0x53, 0xf8,
+	0x11, 0x00, 0x72, 0xbd, 0xe7, 0x86, 0x24, 0x6a, 0xda, 0xb4, 0x36, 0xc5, 0xe9, 0x30, 0xce, 0x8a,
+	0x03, 0x3c, 0xab, 0x0e, 0xf0, 0xec, 0x15, 0x75, 0x80, 0x8d, 0x59, 0x09, 0x5d, 0xa7, 0xd6, 0x57,
+	0x10, 0x18, 0x17, 0x42, 0x62, 0x53, 0xa2, 0x18, 0x75, 0x99, 0x6d, 0xdc, 0x20, 0x2f, 0xf6, 0x49,
+	0x44, 0xf1, 0x09, 0x80, 0x1c, 0x73, 0xb5, 0x19, 0x8c, 0x61, 0x92, 0xee, 0xf7, 0x88, 0x44, 0x9f,
+	0xff, 0xc6, 0x2b, 0x30, 0x25, 0x51, 0x9d, 0xe0, 0xa8, 0xca, 0x11, 0x36, 0x60, 0x86, 0x1d, 0x76,
+	0xe8, 0xbe, 0x24, 0x98, 0x32, 0xd3, 0x88, 0xc7, 0xf8, 0x2c, 0x2c, 0xf5, 0xc2, 0x60, 0x97, 0x24,
+	0x67, 0xca, 0xb7, 0xad, 0x72, 0xb0, 0x23, 0x7c, 0x49, 0xe1, 0x77, 0x65, 0xbf, 0x47, 0xac, 0x9f,
+	0x21, 0x38, 0xd4, 0x20, 0x51, 0x2f, 0xf0, 0x23, 0xf2, 0x24, 0xb1, 0x1d, 0x12, 0xe2, 0x93, 0x30,
+	0xa7, 0x31, 0x56, 0xe1, 0x9a, 0x30, 0x14, 0x1f, 0x07, 0xd8, 0x25, 0x61, 0xe4, 0x06, 0x3e, 0x5b,
+	0x17, 0x18, 0xcf, 0xca, 0x99, 0x4d, 0x07, 0x2f, 0x43, 0x35, 0xa2, 0x36, 0x25, 0xb5, 0x09, 0xbe,
+	0x22, 0x06, 0xf8, 0x4e, 0x58, 0x68, 0x05, 0x9e, 0x67, 0x6f, 0x07, 0xa1, 0x4d, 0x83, 0x30, 0xaa,
+	0x4d, 0x72, 0x9a, 0xd2, 0x93, 0xf8, 0x2e, 0x38, 0x44, 0x43, 0xdb, 0x8f, 0xec, 0x16, 0x95, 0xdb,
+	0x57, 0xf9, 0x26, 0x0b, 0xda, 0xec, 0xa6, 0x83, 0xd7, 0x60, 0xb6, 0x65, 0xfb, 0x2d, 0xe2, 0x79,
+	0xc4, 0xe1, 0xc7, 0x34, 0xd3, 0x48, 0x26, 0xf0, 0x1b, 0x60, 0x21, 0xea, 0xf7, 0x48, 0x18, 0x11,
+	0x87, 0x38, 0xcd, 0xed, 0xfd, 0xda, 0x34, 0xdf, 0x63, 0x3e, 0x99, 0x3c, 0xbf, 0x6f, 0x7d, 0xa3,
+	0x02, 0x0b, 0xa9, 0x93, 0xc2, 0x0f, 0xc0, 0xd4, 0x0e, 0xe7, 0x00, 0x27, 0x79, 0xee, 0x5c, 0x2d,
+	0x11, 0xe0, 0x34, 0x87, 0x1a, 0x12, 0x0e, 0x9f, 0x83, 0x79, 0x7e, 0x24, 0x4d, 0x71, 0x65, 0x6b,
+	0x15, 0x73, 0xe2, 0xf4, 0xdc, 0xb9, 0xc5, 0xe4, 0x39, 0x21, 0x02, 0x73, 0x1c, 0x88, 0xff, 0x8e,
+	0x18, 0x72, 0x31, 0x77, 0xc3, 0x20, 0xa0, 0x92, 0x4b, 0xf3, 0x6a, 0xb2, 0x11, 0x04, 0x94, 0xc9,
+	0x61, 0xe4, 0x76, 0x7c, 0x9b, 0xf6, 0x43, 0x22, 0x38, 0x35, 0x77, 0xee, 0x8e, 0x64, 0xdb, 0xf3,
+	0x7d, 0xdf, 0xf1, 0xc8, 0x96, 0x82, 0x68, 0x68, 0xc0, 0xf8, 0x21, 0x98, 0x21, 0xfe, 0x2e, 0xf1,
+	0x02, 0x79, 0xea, 0x73, 0xe7, 0x8e, 0x65, 0xf0, 0x79, 0x5c, 0x2e, 0x37, 0x62, 0x40, 0xfc, 0x26,
+	0x98, 0xeb, 0xf6, 0x3d, 0xea, 0x0a, 0x42, 0xa4, 0xe0, 0x2f, 0x27, 0xcf, 0x3d, 0xc3, 0x16, 0x05,
+	0x31, 0xd0, 0x8d, 0x7f, 0x5b, 0xd7, 0x60, 0x31, 0x83, 0x0a, 0x5e, 0x85, 0x59, 0x86, 0x0c, 0x09,
+	0x13, 0xd1, 0x99, 0x11, 0x13, 0x42, 0x70, 0x7a, 0xfd, 0x6d, 0xcf, 0x6d, 0x35, 0xaf, 0x91, 0x7d,
+	0x25, 0x38, 0x62, 0xe6, 0x29, 0xb2, 0xcf, 0x4e, 0x35, 0x26, 0x44, 0xb2, 0x25, 0x99, 0xb0, 0x3e,
+	0x80, 0xa0, 0x2a, 0x0e, 0xca, 0x80, 0x99, 0x5e, 0x18, 0xf4, 0x48, 0x48, 0xf7, 0xd5, 0x2b, 0xd4,
+	0x98, 0x09, 0xdf, 0xae, 0xed, 0xf5, 0xd5, 0x45, 0x12, 0x03, 0x76, 0xbb, 0x22, 0xdb, 0x53, 0xbc,
+	0xe6, 0xbf, 0xd9, 0xdc, 0x8e, 0x1d, 0xed, 0x48, 0xb5, 0xc2, 0x7f, 0x73, 0xc9, 0x09, 0x42, 0x4a,
+	0x9c, 0x26, 0x1b, 0x12, 0xa5, 0x23, 0xe6, 0xc5, 0xe4, 0x93, 0x7c, 0xce, 0xfa, 0x2e, 0x82, 0x3b,
+	0x0b, 0x6e, 0xfa, 0xa5, 0x20, 0xbc, 0x2a, 0xee, 0xc0, 0xad, 0xdc, 0xf9, 0x1a, 0x4c, 0xcb, 0x9b,
+	0x24, 0x91, 0x55, 0x43, 0x4d, 0x1b, 0x4c, 0x96, 0x6a, 0x83, 0xea, 0x78, 0xda, 0x60, 0xaa, 0x4c,
+	0x1b, 0xec, 0xc2, 0xd2, 0xd3, 0xae, 0x7f, 0x4d, 0xcd, 0xdd, 0x0a, 0x21, 0xf7, 0xc3, 0x11, 0xcf,
+	0xf5, 0xaf, 0x11, 0x47, 0x57, 0xce, 0x82, 0xa4, 0xc3, 0x62, 0x21, 0x51, 0xcd, 0xd6, 0x35, 0x58,
+	0x4e, 0xbf, 0x57, 0x5c, 0xb7, 0x03, 0x5c, 0xc9, 0xac, 0x92, 0xaf, 0xe4, 0x94, 0xbc, 0xf5, 0x34,
+	0xac, 0x3c, 0x41, 0xa8, 0x7a, 0xd7, 0x96, 0xfb, 0x12, 0xb9, 0x05, 0x3a, 0xad, 0x2f, 0x21, 0x98,
+	0xd7, 0xf7, 0x1a, 0xad, 0x3e, 0x4f, 0xc2, 0x1c, 0x0d, 0xa8, 0xed, 0x35, 0xb7, 0xf7, 0x29, 0x11,
+	0xd6, 0x72, 0xb2, 0x01, 0x7c, 0xea, 0x3c, 0x9b, 0xc1, 0xf7, 0xc1, 0x91, 0xae, 0x7d, 0xbd, 0xd9,
+	0x25, 0x51, 0x64, 0x77, 0x88, 0x04, 0x9b, 0xe0, 0x60, 0x8b, 0x5d, 0xfb, 0xfa, 0x33, 0x62, 0x5e,
+	0xc0, 0x3e, 0x08, 0x33, 0x52, 0x40, 0x94, 0x9e, 0x38, 0x9a, 0xf0, 0x48, 0xca, 0x23, 0x27, 0x31,
+	0x06, 0xb3, 0xfe, 0xb8, 0x02, 0x73, 0xda, 0x4a, 0x46, 0x9d, 0xa3, 0x02, 0x75, 0xae, 0x23, 0x2a,
+	0x06, 0x4c, 0xb2, 0x48, 0x77, 0x9b, 0x38, 0x4c, 0xc3, 0x3a, 0x36, 0xb5, 0x53, 0x58, 0x1e, 0x51,
+	0x4b, 0x17, 0x6d, 0x6a, 0x0b, 0x3c, 0xef, 0x85, 0xc3, 0x89, 0x92, 0x92, 0xc0, 0x93, 0x82, 0xa4,
+	0x64, 0x5e, 0x80, 0x9e, 0x84, 0x39, 0x76, 0x41, 0x15, 0x54, 0x55, 0xf0, 0x87, 0x4f, 0x09, 0x80,
+	0x07, 0x60, 0xb9, 0x15, 0x84, 0x9a, 0x50, 0x7b, 0xc4, 0xde, 0x25, 0x11, 0x17, 0xeb, 0xc9, 0x06,
+	0x66, 0x6b, 0xea, 0x44, 0x9e, 0xe6, 0x2b, 0xec, 0x89, 0x34, 0xb6, 0xf2, 0x89, 0x69, 0xf1, 0x84,
+	0x8e, 0xae, 0x78, 0xc2, 0xda, 0x87, 0xc5, 0xcb, 0x52, 0xa7, 0x3c, 0x63, 0xf7, 0x7a, 0xae, 0xdf,
+	0x61, 0xca, 0x21, 0x24, 0xb6, 0x63, 0x6f, 0x7b, 0xa4, 0xe9, 0xdb, 0x5d, 0x22, 0x59, 0x35, 0xaf,
+	0x26, 0x9f, 0xb5, 0xbb, 0x84, 0xc9, 0x5f, 0x2b, 0xe8, 0xf6, 0xec, 0x16, 0x15, 0x30, 0x42, 0x54,
+	0xe6, 0xe4, 0x1c, 0x07, 0x39, 0x01, 0xe0, 0x10, 0xe6, 0xf3, 0xd9, 0x94, 0x38, 0x9c, 0x63, 0x33,
+	0x0d, 0x6d, 0xc6, 0x7a, 0x09, 0x6a, 0x9a, 0x62, 0xd1, 0x51, 0x48, 0x5b, 0x0f, 0x2e, 0x8a, 0x28,
+	0x6d, 0x3d, 0xd8, 0x2d, 0x66, 0xd6, 0x43, 0xea, 0x43, 0x97, 0x28, 0xa3, 0x74, 0x47, 0xca, 0x08,
+	0xe8, 0x9b, 0x36, 0x34, 0x60, 0xeb, 0xf7, 0x11, 0xd4, 0xb2, 0x2f, 0x8d, 0x6f, 0xe3, 0x5b, 0x61,
+	0x21, 0xc5, 0xf7, 0x1a, 0x1a, 0xb5, 0xf5, 0xbc, 0x7e, 0x16, 0xf8, 0x97, 0x60, 0x56, 0x41, 0x2a,
+	0xb4, 0xac, 0xe4, 0xd9, 0x32, 0x9a, 0x1b, 0xc9, 0x43, 0xd6, 0x17, 0x2b, 0x70, 0x54, 0xc1, 0x09,
+	0x15, 0xac, 0x1c, 0xda, 0x15, 0x98, 0x8a, 0x5a, 0x3b, 0x24, 0x3e, 0x15, 0x39, 0xca, 0xbb, 0x1d,
+	0x95, 0x22, 0xb7, 0xe3, 0x7e, 0x98, 0x64, 0x62, 0x51, 0x9b, 0x90, 0x06, 0x33, 0xeb, 0xf1, 0x6d,
+	0x71, 0x87, 0xbe, 0xc1, 0x81, 0xf0, 0x1b, 0x41, 0x18, 0xf4, 0x66, 0xd8, 0xf7, 0x62, 0xeb, 0xbc,
+	0x94, 0x10, 0xc2, 0xd5, 0x4c, 0xa3, 0xef, 0x91, 0x06, 0xb4, 0xd5, 0x4f, 0x2e, 0xd5, 0x4c, 0x50,
+	0x9a, 0xc2, 0xf1, 0x95, 0x86, 0x05, 0xd8, 0x94, 0x70, 0x7a, 0x99, 0xe4, 0xec, 0x85, 0x2e, 0x25,
+	0x0a, 0x62, 0x4a, 0x68, 0x2e, 0x3e, 0x27, 0x41, 0x36, 0x60, 0x29, 0x24, 0x2f, 0xf6, 0xdd, 0x90,
+	0x38, 0x4d, 0xcd, 0x3f, 0x60, 0x52, 0xbc, 0xd0, 0xc0, 0x6a, 0x29, 0x36, 0xc6, 0x91, 0xf5, 0xf7,
+	0x1a, 0xbf, 0x44, 0x2c, 0x30, 0x8a, 0x5f, 0x69, 0x15, 0x58, 0xc9, 0xa9, 0xc0, 0x1c, 0x3f, 0x27,
+	0x86, 0xf1, 0x73, 0xf2, 0x00, 0xfc, 0xac, 0x1e, 0x88, 0x9f, 0x53, 0x23, 0xf9, 0x39, 0x3d, 0x36,
+	0x3f, 0x67, 0x4a, 0xf9, 0xf9, 0x0d, 0x04, 0x58, 0xb3, 0x1d, 0xca, 0x6e, 0x1c, 0x94, 0x99, 0x27,
+	0x61, 0x4e, 0x98, 0xad, 0x66, 0xe0, 0x7b, 0xfb, 0x4a, 0x15, 0x88, 0xa9, 0xe7, 0x7c, 0x6f, 0x9f,
+	0x5d, 0x77, 0xd7, 0x6f, 0x79, 0x7d, 0x87, 0x34, 0xb9, 0xfe, 0x93, 0xee, 0xfe, 0xbc, 0x9c, 0xdc,
+	0x62, 0x73, 0xf8, 0x0c, 0xe0, 0x18, 0x28, 0x21, 0x42, 0x7a, 0xfc, 0x0a, 0x32, 0xa1, 0xe1, 0x07,
+	0x08, 0xee, 0xd0, 0x68, 0xc8, 0xf8, 0x2c, 0x07, 0x25, 0xa5, 0xdc, 0x6f, 0xc9, 0x10, 0x39, 0x39,
+	0x9a, 0xc8, 0xea, 0xd8, 0x44, 0x4e, 0x95, 0x11, 0xf9, 0x3d, 0x04, 0x87, 0x6f, 0x83, 0x37, 0xa1,
+	0xe4, 0xb8, 0x32, 0x8e, 0x1c, 0xaf, 0x43, 0x55, 0xe0, 0x3f, 0xc1, 0x25, 0x78, 0x25, 0xaf, 0xda,
+	0x18, 0x29, 0x0d, 0x01, 0x74, 0x0b, 0x2e, 0xbe, 0xf5, 0x6d, 0x04, 0x58, 0x6a, 0x3f, 0x3d, 0xc4,
+	0x3c, 0xe8, 0xd1, 0xfd, 0x1c, 0xc2, 0x4c, 0x86, 0x03, 0x8f, 0x1b, 0x92, 0xf8, 0x62, 0xa6, 0xa1,
+	0xcd, 0x58, 0x3f, 0x45, 0xb0, 0xa6, 0x91, 0x94, 0xf7, 0xa5, 0x6f, 0xbf, 0x5c, 0xfe, 0x1c, 0xfc,
+	0xe9, 0x0c, 0xd9, 0xd3, 0x39, 0xb2, 0x1f, 0x67, 0x7e, 0x6f, 0x14, 0xdf, 0xc5, 0x48, 0x51, 0x8b,
+	0x61, 0xb2, 0x67, 0x77, 0x04, 0xad, 0xd5, 0x06, 0xff, 0x8d, 0xef, 0x80, 0x99, 0x1e, 0x09, 0x9b,
+	0x7c, 0xbe, 0xc2, 0xe7, 0xa7, 0x7b, 0x24, 0xbc, 0x6c, 0x77, 0x48, 0x4a, 0xda, 0xd9, 0x7e, 0x9b,
+	0x94, 0x74, 0x47, 0xfb, 0xa1, 0x39, 0x5f, 0xa2, 0x52, 0xe0, 0x4b, 0x30, 0xbe, 0x53, 0x9b, 0xf6,
+	0x23, 0xc9, 0x3e, 0x39, 0x62, 0x81, 0xba, 0x67, 0x53, 0x12, 0xd1, 0xa6, 0x62, 0xaf, 0x88, 0xa3,
+	0x16, 0xc4, 0xac, 0x3c, 0xbd, 0x74, 0xa0, 0x5e, 0x1d, 0x19, 0xa8, 0x4f, 0x15, 0x04, 0xea, 0xaf,
+	0x22, 0x38, 0x9a, 0x61, 0x92, 0xbc, 0xcf, 0x67, 0xe5, 0xed, 0x14, 0x6e, 0x88, 0x91, 0xbf, 0x6f,
+	0x8a, 0x17, 0xf2, 0x82, 0x2a, 0xae, 0x56, 0x4a, 0xb8, 0x3a, 0x91, 0xe2, 0x2a, 0x73, 0x7c, 0xb9,
+	0x53, 0xce, 0x29, 0xab, 0x36, 0xc4, 0xc0, 0x7a, 0x04, 0x8e, 0x69, 0xda, 0xf3, 0x89, 0xd0, 0xee,
+	0xed, 0x8c, 0x19, 0x3e, 0x58, 0xdf, 0x42, 0x70, 0x24, 0xf5, 0x20, 0x8b, 0x79, 0x58, 0xc4, 0xcc,
+	0xe2, 0x21, 0xdd, 0x9d, 0x9b, 0x61, 0x13, 0x9c, 0xfd, 0x6b, 0x30, 0xeb, 0xb8, 0x21, 0xe1, 0x79,
+	0x0f, 0x15, 0x30, 0xc7, 0x13, 0xd9, 0x23, 0x9e, 0x18, 0x7d, 0xc4, 0x93, 0x05, 0x47, 0x6c, 0xc0,
+	0x4c, 0x48, 0x3a, 0x6e, 0x44, 0xc3, 0x7d, 0x99, 0x6d, 0x89, 0xc7, 0x8c, 0x3d, 0x22, 0xb5, 0xe7,
+	0x3a, 0xf2, 0x70, 0xa6, 0xf9, 0x78, 0xd3, 0xb1, 0x3e, 0x88, 0x60, 0x21, 0x45, 0xcd, 0x6d, 0x92,
+	0xb8, 0x07, 0xa1, 0xca, 0xc8, 0x57, 0x6a, 0x74, 0x35, 0x7f, 0xac, 0x31, 0xef, 0x1a, 0x02, 0xd2,
+	0x7a, 0x14, 0x6a, 0x4f, 0x10, 0x25, 0x73, 0x4f, 0xba, 0x11, 0x0d, 0xc2, 0xfd, 0x71, 0x0f, 0xe5,
+	0x27, 0x08, 0x96, 0xd2, 0x4f, 0x3e, 0xee, 0x33, 0xca, 0x47, 0x44, 0x45, 0x5c, 0x13, 0x90, 0x5d,
+	0x37, 0xe8, 0x47, 0xcd, 0x5c, 0x32, 0xec, 0x88, 0x5a, 0xba, 0x1a, 0xc3, 0xaf, 0xc0, 0x94, 0xdd,
+	0xa7, 0x3b, 0x81, 0x8a, 0x81, 0xe5, 0x08, 0x3f, 0x0c, 0xb3, 0x71, 0x3e, 0xb8, 0x36, 0x39, 0x3a,
+	0xe1, 0x18, 0x03, 0x6b, 0x37, 0xb3, 0x9a, 0xba, 0x99, 0xb9, 0x04, 0xd3, 0x54, 0x3e, 0xc1, 0x64,
+	0x7d, 0x12, 0xc1, 0x4a, 0x96, 0x5f, 0xf2, 0x56, 0xdd, 0x9e, 0x53, 0x7c, 0x44, 0x8b, 0x4b, 0xc5,
+	0x41, 0x1e, 0xcf, 0xc5, 0xa5, 0x3a, 0xbf, 0xb5, 0xf8, 0x74, 0x1f, 0x8e, 0x26, 0xa7, 0x79, 0xd1,
+	0x6d, 0x8f, 0x9d, 0x43, 0x3d, 0x05, 0xf3, 0xed, 0x30, 0xe8, 0xc6, 0x1a, 0x49, 0xc6, 0x5e, 0x6c,
+	0x4e, 0xe9, 0xa3, 0xe3, 0x00, 0x34, 0x68, 0xa6, 0x2d, 0xc2, 0x2c, 0x0d, 0xe4, 0xb2, 0xb5, 0x07,
+	0x73, 0xdc, 0xdb, 0xbc, 0xb0, 0x63, 0xfb, 0x1d, 0x32, 0x34, 0xd1, 0x84, 0x61, 0x52, 0x0b, 0xf0,
+	0xf8, 0x6f, 0x76, 0x95, 0x03, 0xcf, 0x69, 0x8a, 0x04, 0x94, 0xd8, 0x7c, 0x26, 0xf0, 0x9c, 0xab,
+	0x6c, 0xcc, 0x16, 0x7d, 0xb2, 0x27, 0x17, 0xc5, 0x3d, 0x9c, 0xf1, 0xc9, 0x1e, 0x5f, 0xb4, 0xbe,
+	0x90, 0x48, 0xa1, 0xa0, 0x78, 0xdc, 0xc3, 0xb8, 0x65, 0x9a, 0xf1, 0x06, 0x4c, 0xb7, 0x38, 0xb9,
+	0x05, 0x09, 0x04, 0x8d, 0x19, 0x0d, 0x05, 0x65, 0xbd, 0x82, 0x60, 0x65, 0xb3, 0xdb, 0x0b, 0xc2,
+	0xbc, 0xdd, 0x2a, 0xb3, 0xd2, 0xcc, 0xd6, 0x06, 0x61, 0xd7, 0xa6, 0x12, 0x3f, 0x39, 0x1a, 0x33,
+	0x9a, 0xc0, 0x5a, 0x34, 0x31, 0x2b, 0x74, 0xb9, 0xf5, 0x3e, 0x04, 0x8b, 0x02, 0x89, 0x46, 0xb0,
+	0xd7, 0x20, 0x51, 0xdf, 0xa3, 0xf8, 0x30, 0x4c, 0x84, 0xc1, 0x9e, 0x34, 0x9a, 0xec, 0x67, 0x96,
+	0x7d, 0x95, 0x1c, 0xfb, 0xf2, 0xf9, 0xe6, 0x89, 0xa2, 0x7c, 0xf3, 0x32, 0x54, 0x49, 0x18, 0x06,
+	0xa1, 0x44, 0x41, 0x0c, 0x18, 0x23, 0x8e, 0xe5, 0x18, 0x21, 0x0f, 0xce, 0x80, 0x19, 0x97, 0x2f,
+	0x11, 0x47, 0x22, 0x14, 0x8f, 0x39, 0x37, 0x6c, 0xd7, 0x23, 0x02, 0xa1, 0x6a, 0x43, 0x8e, 0xf0,
+	0x43, 0x30, 0x1d, 0x72, 0x4a, 0xd4, 0x95, 0xd1, 0xfc, 0xc1, 0x0c, 0xad, 0x0d, 0x05, 0x69, 0x6d,
+	0x83, 0xd1, 0x20, 0xdd, 0x60, 0x97, 0x5c, 0xd0, 0x79, 0x36, 0xee, 0x95, 0x19, 0x2b, 0x3c, 0xb6,
+	0x9e, 0x83, 0xd5, 0xc2, 0x77, 0x1c, 0xd4, 0xaf, 0xb6, 0x5a, 0xb0, 0xf4, 0xf8, 0x75, 0x46, 0xd0,
+	0xd3, 0xc4, 0xe9, 0x90, 0x50, 0x13, 0x1f, 0x29, 0x26, 0x28, 0x25, 0x26, 0xab, 0x30, 0xcb, 0x85,
+	0xdc, 0xb1, 0xa9, 0xba, 0x70, 0x33, 0x6c, 0xe2, 0xa2, 0x4d, 0x09, 0x3e, 0x06, 0xd3, 0x34, 0x10,
+	0x4b, 0x52, 0xb5, 0xd2, 0x80, 0x2d, 0x58, 0x2f, 0xc0, 0x72, 0xfa, 0x25, 0x12, 0xdd, 0xb2, 0xb7,
+	0xac, 0xc0, 0x14, 0xd9, 0x95, 0xb9, 0x09, 0x7e, 0x2c, 0x62, 0x14, 0x8b, 0xdf, 0x84, 0x26, 0x7e,
+	0x9b, 0x30, 0x1b, 0x87, 0xa5, 0x79, 0x26, 0xa2, 0x22, 0x29, 0x4e, 0xfc, 0xcd, 0x8a, 0xee, 0x6f,
+	0x32, 0x87, 0x82, 0xf9, 0x29, 0x5a, 0xe9, 0x6b, 0xdc, 0xd3, 0xb3, 0xbe, 0x8a, 0xe0, 0xb0, 0xf6,
+	0x9c, 0x30, 0x5c, 0xa3, 0x8e, 0x3c, 0xae, 0xa8, 0x29, 0x77, 0x59, 0x0d, 0x93, 0x15, 0xc5, 0x49,
+	0x35, 0xe4, 0x25, 0x9d, 0x56, 0x10, 0xfb, 0x0f, 0x62, 0x90, 0xa9, 0x96, 0x55, 0x6f, 0xa6, 0x5a,
+	0x16, 0x40, 0x2d, 0x4f, 0xf4, 0xb8, 0x3a, 0xef, 0x1c, 0x4c, 0x71, 0x27, 0x44, 0x25, 0x91, 0x8c,
+	0xc2, 0x4a, 0xa3, 0x30, 0x2b, 0x12, 0xd2, 0x7a, 0xab, 0x96, 0xa5, 0x65, 0xd9, 0xff, 0x1a, 0x4c,
+	0xcb, 0x9c, 0x9c, 0x7c, 0x81, 0x1a, 0x16, 0x57, 0x10, 0xac, 0x06, 0x2c, 0xb3, 0x60, 0xac, 0x4e,
+	0x69, 0xe8, 0x6e, 0xf7, 0xe9, 0xd8, 0x29, 0x63, 0xdd, 0x84, 0x54, 0xd2, 0x26, 0x84, 0xb9, 0xed,
+	0x38, 0xde, 0xf0, 0xf6, 0x94, 0x50, 0xf4, 0xd7, 0x4d, 0x94, 0x95, 0x46, 0x26, 0xf5, 0xd2, 0x48,
+	0xca, 0x01, 0xa9, 0xde, 0x8c, 0x03, 0x92, 0x2a, 0xd7, 0x4c, 0x65, 0xcb, 0x35, 0x2f, 0xc1, 0x5a,
+	0xdd, 0x71, 0xf2, 0xe4, 0x8d, 0xcb, 0xb8, 0x47, 0xf5, 0xdd, 0x45, 0xfc, 0xbd, 0xa6, 0x9d, 0x73,
+	0x7e, 0x5f, 0xed, 0xdd, 0x14, 0x8e, 0x97, 0xbc, 0xfb, 0xf5, 0xac, 0x2b, 0xfc, 0x1a, 0x1c, 0xbd,
+	0xc0, 0x43, 0x9b, 0x9b, 0x2d, 0x9f, 0xe4, 0xc2, 0xa0, 0x4a, 0x41, 0x18, 0xf4, 0x36, 0x58, 0xc9,
+	0xee, 0x7e, 0x60, 0xf5, 0xfb, 0x7e, 0x04, 0xf8, 0x2a, 0x09, 0xdd, 0xf6, 0x7e, 0x2a, 0x81, 0x70,
+	0x06, 0xaa, 0x22, 0x50, 0x45, 0xd9, 0xba, 0x61, 0xba, 0xa4, 0x2d, 0xa0, 0xf2, 0x8e, 0x66, 0xa5,
+	0xa0, 0x92, 0xb9, 0x0a, 0xb3, 0xb6, 0xdf, 0xda, 0x09, 0xc2, 0xc4, 0xb6, 0xce, 0x88, 0x89, 0x4d,
+	0xc7, 0x7a, 0x01, 0x0e, 0x5f, 0x8a, 0x4b, 0xa3, 0xd2, 0x88, 0x8f, 0x2e, 0xee, 0x49, 0x43, 0x3e,
+	0xd3, 0x10, 0x83, 0xc4, 0x38, 0x4f, 0xe8, 0xc6, 0xf9, 0xdf, 0x85, 0x47, 0x95, 0xd0, 0x28, 0xb9,
+	0x15, 0xef, 0x81, 0xf4, 0x3d, 0x52, 0x68, 0x56, 0xd2, 0x68, 0x8e, 0x57, 0xb2, 0x35, 0x40, 0x3e,
+	0x40, 0x1c, 0x95, 0x2d, 0x51, 0x63, 0x26, 0x3c, 0x72, 0x77, 0x81, 0xa8, 0x70, 0xd8, 0xe7, 0xc4,
+	0xdc, 0xe3, 0x6c, 0x0a, 0xff, 0x62, 0xa6, 0x94, 0x3c, 0x95, 0xd5, 0x6c, 0x59, 0x46, 0xa5, 0xaa,
+	0xca, 0xd6, 0xff, 0x21, 0x58, 0x48, 0x15, 0x77, 0xf5, 0xc4, 0x87, 0xf0, 0x3f, 0xd4, 0x90, 0xf9,
+	0x3c, 0xac, 0xba, 0xd9, 0xb4, 0xbd, 0x4e, 0x10, 0xba, 0x74, 0xa7, 0x2b, 0x09, 0x5e, 0x60, 0xb3,
+	0x75, 0x35, 0xc9, 0x32, 0x6e, 0xaa, 0x92, 0xa1, 0x55, 0x13, 0x44, 0x8e, 0xf2, 0x88, 0x5c, 0xb9,
+	0x1c, 0x2f, 0x30, 0x1a, 0xf9, 0xae, 0x51, 0x10, 0xb2, 0x6e, 0x11, 0xc9, 0x83, 0x39, 0x36, 0xb7,
+	0x25, 0xa6, 0xf0, 0x03, 0xec, 0x68, 0x49, 0xdb, 0xbd, 0x1e, 0x67, 0x79, 0xb5, 0x12, 0xf3, 0x95,
+	0x90, 0x90, 0xcb, 0x7c, 0xb5, 0x11, 0x43, 0xf1, 0xaa, 0x51, 0xd0, 0xa6, 0x7b, 0x76, 0x48, 0x62,
+	0x07, 0x56, 0x68, 0x9a, 0x45, 0x35, 0xaf, 0x5c, 0xf7, 0xf3, 0x00, 0xc9, 0x16, 0x22, 0xa6, 0x15,
+	0x65, 0x19, 0x25, 0x45, 0x6a, 0xac, 0xab, 0xfe, 0x4a, 0x4a, 0xf5, 0x5b, 0x6f, 0x07, 0x48, 0x2a,
+	0xdd, 0xcc, 0x60, 0xcb, 0x2a, 0xb0, 0xb0, 0xe7, 0x72, 0x84, 0x1f, 0x4c, 0x19, 0xf2, 0x94, 0x97,
+	0x96, 0x3c, 0x2d, 0xbc, 0x03, 0x65, 0xe3, 0x5f, 0x43, 0xb0, 0x98, 0x59, 0x7b, 0x1d, 0xab, 0xd8,
+	0xea, 0x28, 0x5c, 0xdf, 0x21, 0x8a, 0xd7, 0x0b, 0xe2, 0x28, 0x36, 0xc5, 0x94, 0xf5, 0x87, 0x08,
+	0x16, 0xea, 0x8e, 0xf3, 0xec, 0xa5, 0x2b, 0xe3, 0x2a, 0xa9, 0x7b, 0xe1, 0xb0, 0xca, 0x0a, 0x34,
+	0x6d, 0xc7, 0x09, 0x59, 0x4a, 0x5d, 0x60, 0xb7, 0xa8, 0xe6, 0xeb, 0x62, 0x3a, 0x95, 0x34, 0x98,
+	0x48, 0x25, 0x0d, 0xf0, 0x69, 0x38, 0xcc, 0x7d, 0x8a, 0xa6, 0xdf, 0xa6, 0x2a, 0x31, 0x2f, 0x24,
+	0xe5, 0x10, 0x9f, 0x7f, 0xb6, 0x2d, 0x7d, 0x02, 0xeb, 0x3c, 0x1c, 0x52, 0x08, 0x1e, 0x58, 0xcf,
+	0x7d, 0xb4, 0x02, 0x47, 0xb7, 0xdc, 0x6e, 0xdf, 0x8b, 0x9b, 0xa0, 0xc6, 0xa5, 0xb6, 0x06, 0xd3,
+	0x76, 0xab, 0x15, 0xf4, 0xfd, 0x58, 0x46, 0xe4, 0x10, 0xdf, 0x0d, 0x8b, 0xa9, 0x9e, 0xa7, 0x24,
+	0x64, 0xd0, 0x5a, 0x9a, 0x36, 0xc7, 0xe9, 0xb0, 0x9a, 0x1c, 0xa3, 0xc3, 0xea, 0x01, 0x58, 0x66,
+	0x9c, 0xca, 0x71, 0x5e, 0x68, 0x10, 0xec, 0xb7, 0x69, 0x23, 0xc3, 0x7c, 0x13, 0xe6, 0xd9, 0x13,
+	0x99, 0xac, 0x0d, 0xf8, 0x6d, 0x2a, 0x31, 0xb3, 0xde, 0x05, 0x73, 0x82, 0x19, 0x17, 0x76, 0x48,
+	0xeb, 0x1a, 0x73, 0xb7, 0x14, 0x41, 0x49, 0x06, 0x0a, 0x24, 0x31, 0xb2, 0x25, 0x81, 0x9f, 0x0d,
+	0x51, 0x7a, 0x57, 0x0d, 0xd9, 0x0d, 0x09, 0x89, 0x1d, 0xc5, 0x51, 0xa5, 0x1c, 0x59, 0x9f, 0x41,
+	0xb0, 0x92, 0xe5, 0xfb, 0xb8, 0xce, 0xdd, 0x88, 0xe6, 0x22, 0x0d, 0x99, 0x89, 0x34, 0x32, 0x67,
+	0x60, 0xaa, 0xc5, 0x08, 0x2a, 0x08, 0x63, 0x35, 0x72, 0x1b, 0x12, 0xc8, 0xfa, 0x4f, 0x04, 0x87,
+	0xae, 0xb0, 0x20, 0xaf, 0x4d, 0xc2, 0x8b, 0x84, 0xda, 0xae, 0xc7, 0x70, 0xa3, 0x72, 0x46, 0xc3,
+	0x4d, 0x4d, 0x6d, 0x3a, 0xac, 0xa7, 0xa1, 0x67, 0xef, 0x0b, 0x3b, 0x40, 0xda, 0x24, 0x24, 0x7e,
+	0x4b, 0x5d, 0xd1, 0xc3, 0x72, 0xa1, 0xa1, 0xe6, 0x79, 0xc6, 0xa7, 0xcb, 0x25, 0x48, 0x65, 0x7c,
+	0xf8, 0x88, 0xdd, 0xfb, 0x56, 0x3f, 0x64, 0x30, 0xfb, 0x2a, 0x0d, 0xa0, 0xc6, 0xa5, 0x39, 0x9d,
+	0x0b, 0xb0, 0x18, 0x11, 0x4a, 0x3d, 0xc2, 0xdf, 0xcd, 0x63, 0x9d, 0xd1, 0xcd, 0x69, 0x87, 0x92,
+	0x47, 0x78, 0x3c, 0xb4, 0x03, 0xb5, 0xba, 0xe3, 0xa4, 0x69, 0x1e, 0xf7, 0x3e, 0xac, 0xa7, 0x0a,
+	0x21, 0x35, 0x5d, 0x6d, 0xa7, 0xb6, 0x13, 0xd1, 0xd1, 0xab, 0x08, 0x56, 0x45, 0xb9, 0xf1, 0x60,
+	0x6f, 0xcb, 0x1c, 0x44, 0x25, 0x77, 0x10, 0xeb, 0xa9, 0x7a, 0xed, 0x28, 0x74, 0xda, 0xb0, 0x94,
+	0x9e, 0x17, 0xfa, 0x7d, 0x3d, 0x4e, 0x1f, 0x8f, 0xb1, 0xc9, 0x38, 0x0e, 0xe0, 0xfb, 0x10, 0xac,
+	0x64, 0x09, 0x3e, 0xb0, 0xc3, 0xf9, 0x26, 0x98, 0x72, 0xf8, 0x1e, 0x92, 0xe7, 0xc7, 0xcb, 0xf0,
+	0x13, 0x3e, 0x81, 0x04, 0xb6, 0x7e, 0x01, 0x0c, 0x16, 0x58, 0xa5, 0x41, 0xc6, 0x0e, 0x28, 0x3f,
+	0x88, 0x60, 0xb5, 0xf0, 0xf1, 0x03, 0x93, 0xf1, 0x16, 0x98, 0x16, 0x98, 0x29, 0x6b, 0x39, 0x82,
+	0x0e, 0x05, 0x6d, 0x6d, 0x03, 0xd4, 0x29, 0xb5, 0x5b, 0x3b, 0x0c, 0x34, 0xce, 0xb6, 0x21, 0x2d,
+	0xdb, 0xc6, 0x2e, 0x12, 0xbf, 0xcb, 0x7d, 0xe5, 0xc1, 0xc4, 0x63, 0x96, 0x0a, 0x6a, 0xc5, 0x9a,
+	0x99, 0xfd, 0xe4, 0xc6, 0x53, 0x55, 0xb2, 0x26, 0x1b, 0xfc, 0xb7, 0xf5, 0x9b, 0x08, 0x96, 0x45,
+	0xa0, 0x20, 0xdf, 0x33, 0xae, 0x80, 0xbe, 0x11, 0xc0, 0x8e, 0x1f, 0x92, 0x07, 0xb4, 0x9c, 0x8a,
+	0x4e, 0xd4, 0x86, 0x1a, 0x5c, 0x2a, 0x91, 0x30, 0x2f, 0x65, 0xf3, 0x9d, 0xb0, 0x98, 0x40, 0x0b,
+	0xb9, 0x3c, 0x9d, 0x92, 0xcb, 0xe2, 0x6d, 0x6f, 0x46, 0x26, 0xb1, 0x4e, 0xdf, 0x81, 0x0f, 0xf2,
+	0x91, 0x02, 0x92, 0xef, 0x28, 0xc2, 0x4d, 0x9c, 0xa3, 0x06, 0x6c, 0x3d, 0x0c, 0x2b, 0x3c, 0xd8,
+	0x8f, 0x67, 0x6e, 0x46, 0x1e, 0x8f, 0xe5, 0x1e, 0x3d, 0x30, 0x09, 0x8f, 0xc1, 0x5c, 0x82, 0x55,
+	0x81, 0xf7, 0x96, 0xa5, 0x41, 0x87, 0xb6, 0x9e, 0x80, 0x23, 0x52, 0xa5, 0xd9, 0x9d, 0xe8, 0x66,
+	0x1a, 0xc6, 0xec, 0x8e, 0x3a, 0x18, 0xfe, 0xdb, 0xda, 0x83, 0x79, 0xb1, 0xc5, 0x81, 0xe9, 0x28,
+	0xd8, 0x35, 0x27, 0x0a, 0x13, 0x79, 0x51, 0xf8, 0x1f, 0xad, 0x4a, 0x78, 0x85, 0x74, 0x7b, 0xcc,
+	0x3a, 0x17, 0x5e, 0xac, 0x24, 0x8b, 0x5b, 0x49, 0x65, 0x71, 0x1f, 0xe3, 0x22, 0x20, 0x62, 0xea,
+	0x82, 0xf2, 0x8c, 0xda, 0x33, 0xc9, 0x91, 0x68, 0xe0, 0x63, 0xf6, 0xff, 0x8e, 0xec, 0x92, 0xc9,
+	0x34, 0x8b, 0x4c, 0x8d, 0xd5, 0x2c, 0x62, 0x7d, 0x0c, 0xc1, 0x91, 0x1c, 0x7a, 0x85, 0xb4, 0x17,
+	0xb5, 0x32, 0xf2, 0x60, 0x42, 0xf4, 0x82, 0x48, 0xa7, 0x23, 0x1e, 0xe3, 0xc7, 0x60, 0xc1, 0x21,
+	0x6d, 0xbb, 0xef, 0x51, 0x2d, 0xb3, 0xcf, 0x8a, 0xff, 0x59, 0xbb, 0xcc, 0xf3, 0xfc, 0x8d, 0x79,
+	0x09, 0xcc, 0x47, 0xd6, 0x69, 0xde, 0x4d, 0xa2, 0x10, 0xd3, 0x8a, 0xbf, 0x59, 0xb4, 0xac, 0x5f,
+	0x16, 0x35, 0x50, 0x05, 0x9a, 0x48, 0x0f, 0x4b, 0xdf, 0xa8, 0xc9, 0xf2, 0x42, 0x68, 0xfc, 0x8a,
+	0x04, 0xd8, 0xfa, 0x2c, 0x82, 0x3b, 0x44, 0xc9, 0xfd, 0x52, 0x18, 0x74, 0xc7, 0x40, 0xe2, 0xf5,
+	0xe8, 0xa5, 0xca, 0x9c, 0xf7, 0x64, 0xf6, 0xbc, 0xad, 0x77, 0xc1, 0x21, 0x71, 0xa4, 0xb1, 0x5f,
+	0x35, 0x86, 0xeb, 0x9e, 0x2e, 0x86, 0xa8, 0x21, 0x8b, 0xaa, 0xb8, 0x4c, 0xa8, 0x44, 0x01, 0x1f,
+	0x58, 0x2f, 0xc3, 0x71, 0xc1, 0x86, 0x0b, 0x81, 0x1f, 0xb9, 0x11, 0x65, 0x8e, 0x58, 0x2a, 0x2d,
+	0xb2, 0x0e, 0x93, 0x1e, 0x69, 0xd3, 0xfc, 0xf5, 0x4c, 0x23, 0xd6, 0xe0, 0x50, 0xf8, 0x2c, 0x54,
+	0x43, 0xb7, 0xb3, 0x43, 0x6b, 0x95, 0x11, 0xe0, 0x02, 0x8c, 0xb5, 0x14, 0x1d, 0xce, 0xbe, 0x99,
+	0xf1, 0x50, 0x7b, 0x65, 0x69, 0x22, 0x46, 0xbc, 0xf1, 0x4c, 0xfa, 0x8d, 0xe5, 0x69, 0x1b, 0x0e,
+	0xc5, 0xf8, 0x23, 0x3b, 0x4b, 0x55, 0x70, 0x26, 0x87, 0xf8, 0x2d, 0x7a, 0xca, 0x6d, 0xd2, 0x44,
+	0xc3, 0x3b, 0x52, 0x12, 0x58, 0xeb, 0xaf, 0x11, 0xcc, 0x6f, 0xd1, 0x20, 0xb4, 0x3b, 0xe4, 0x79,
+	0xbe, 0xd3, 0x71, 0x00, 0x19, 0x2f, 0x69, 0xc5, 0x53, 0x39, 0x33, 0x4e, 0x07, 0xec, 0x49, 0x98,
+	0x7b, 0xb1, 0x1f, 0x64, 0xba, 0x4a, 0x81, 0x4f, 0x09, 0x80, 0x4d, 0x38, 0x94, 0xaa, 0x41, 0x2a,
+	0xa7, 0xbf, 0xa0, 0x9f, 0x90, 0xc5, 0x30, 0x3a, 0x72, 0x8d, 0x05, 0xbd, 0x50, 0x19, 0x59, 0x2f,
+	0x26, 0xed, 0x96, 0x59, 0xd0, 0xf1, 0xda, 0x2d, 0x0d, 0xad, 0xd4, 0x29, 0x48, 0x89, 0xc7, 0x49,
+	0xf3, 0xec, 0x84, 0xd6, 0x3c, 0x6b, 0x3d, 0xc6, 0x3b, 0xb0, 0xb8, 0xfb, 0xe3, 0x52, 0x37, 0xf0,
+	0x6f, 0xaa, 0x8b, 0xe0, 0xdd, 0xb0, 0x94, 0x7d, 0x32, 0xf0, 0x78, 0x23, 0x43, 0x18, 0x78, 0x84,
+	0xa7, 0x85, 0x65, 0x46, 0x9b, 0x8d, 0x59, 0x52, 0x78, 0xbc, 0x7b, 0xcb, 0x6e, 0x7c, 0x9b, 0x2a,
+	0xcb, 0xc1, 0x7f, 0x5b, 0x1f, 0x45, 0xf9, 0x97, 0xf5, 0xe5, 0xcb, 0xfa, 0x99, 0x97, 0xf5, 0xc5,
+	0xcb, 0x96, 0xa1, 0xca, 0xde, 0xab, 0x5e, 0x22, 0x06, 0x4c, 0x20, 0xba, 0x36, 0x6d, 0xed, 0x08,
+	0x2e, 0xca, 0x1a, 0x24, 0x9f, 0xe1, 0x2c, 0x8c, 0x6f, 0xe6, 0xa4, 0x76, 0x33, 0x79, 0x04, 0x25,
+	0x3a, 0x1f, 0x64, 0x34, 0x24, 0x46, 0xd6, 0x7f, 0x23, 0x58, 0xcc, 0x60, 0x75, 0xcb, 0x71, 0x65,
+	0xee, 0xa4, 0x27, 0x0a, 0x4e, 0xfa, 0x21, 0x45, 0xdb, 0x64, 0xa1, 0x2b, 0x9b, 0x3e, 0x11, 0x45,
+	0x3a, 0x7b, 0x48, 0x6b, 0x6c, 0x1c, 0xf2, 0x50, 0x9f, 0x3f, 0xc4, 0x60, 0x99, 0xff, 0xea, 0xc4,
+	0xa5, 0x7b, 0xf6, 0xd3, 0xba, 0x06, 0xab, 0xa2, 0x9a, 0x15, 0xdf, 0xc0, 0x7a, 0xdf, 0x71, 0xe9,
+	0xeb, 0x53, 0x3a, 0xfb, 0x5b, 0x04, 0x6b, 0xc5, 0x6f, 0x1b, 0x51, 0x43, 0x3b, 0x91, 0xea, 0x6a,
+	0x13, 0x75, 0x34, 0x6d, 0xa6, 0xa8, 0x96, 0x96, 0xae, 0x77, 0x4c, 0x0e, 0xad, 0x77, 0x54, 0x87,
+	0x7e, 0x32, 0x92, 0xab, 0x41, 0x6c, 0xc1, 0xb1, 0xab, 0x2c, 0xbd, 0xab, 0x7d, 0xaa, 0x71, 0x13,
+	0x09, 0xa0, 0x62, 0x2b, 0x62, 0xfd, 0x0e, 0x82, 0xc3, 0x72, 0x57, 0xde, 0x9f, 0xc6, 0xb3, 0xd6,
+	0xcb, 0x50, 0xed, 0x84, 0x41, 0xbf, 0x27, 0x77, 0x12, 0x83, 0x92, 0x7c, 0x75, 0x0d, 0xa6, 0xa3,
+	0x6b, 0x6e, 0xaf, 0x97, 0xa4, 0x30, 0xe4, 0x90, 0x71, 0x95, 0x27, 0x88, 0xe3, 0x96, 0x34, 0x31,
+	0xe2, 0xc2, 0xdd, 0x0f, 0xf9, 0xfb, 0x9a, 0x5d, 0x91, 0x1b, 0xa8, 0x36, 0x40, 0x4d, 0x3d, 0xc3,
+	0x7b, 0x3a, 0x57, 0x25, 0x65, 0x07, 0xfa, 0xfa, 0xec, 0x38, 0x80, 0x2c, 0x02, 0x6a, 0x97, 0x43,
+	0xce, 0x8c, 0x95, 0xcb, 0x9a, 0x18, 0x23, 0x97, 0x55, 0x90, 0x39, 0x9b, 0x2c, 0xca, 0x9c, 0x25,
+	0x85, 0xd2, 0x6a, 0xaa, 0x50, 0xda, 0x4d, 0xf5, 0xad, 0x6e, 0xf5, 0xbb, 0x5d, 0x7b, 0xec, 0x36,
+	0x9f, 0x21, 0x5e, 0x41, 0x22, 0xca, 0x13, 0xba, 0x28, 0x5b, 0x3f, 0xaa, 0xc0, 0x62, 0xe6, 0x65,
+	0x3f, 0x1f, 0x2d, 0x93, 0x24, 0x81, 0x26, 0x53, 0x49, 0xa0, 0xa4, 0x85, 0xa8, 0x9a, 0x6a, 0x21,
+	0x5a, 0xd3, 0x2b, 0x78, 0xf2, 0x0e, 0xc4, 0x13, 0xf8, 0x6c, 0xcc, 0xcd, 0xe9, 0x6c, 0x5b, 0xaa,
+	0xa4, 0x2a, 0x95, 0xaa, 0xce, 0x1b, 0x8b, 0x99, 0x22, 0x63, 0x91, 0xaa, 0x97, 0xcc, 0x66, 0xea,
+	0x25, 0x2c, 0xa3, 0x28, 0x16, 0x59, 0x7b, 0x56, 0x0d, 0x64, 0x46, 0x91, 0x4f, 0xf1, 0x96, 0x37,
+	0x96, 0xb4, 0xa6, 0x5d, 0xaf, 0x36, 0x27, 0x93, 0xd6, 0xb4, 0xeb, 0x59, 0x0f, 0xc3, 0xbc, 0x8e,
+	0x4f, 0xa1, 0x03, 0x5a, 0x98, 0x16, 0x3f, 0xf7, 0xcd, 0x2d, 0xed, 0xa0, 0xc4, 0xd7, 0xbd, 0xf8,
+	0xbb, 0x08, 0x96, 0x0a, 0xbe, 0xd1, 0xc2, 0x77, 0x26, 0xd4, 0x97, 0x7f, 0xac, 0x69, 0x94, 0xb9,
+	0x50, 0xd6, 0xfb, 0xd0, 0xa0, 0xfe, 0x76, 0xe3, 0x79, 0xf1, 0x68, 0x64, 0xda, 0xa6, 0xe7, 0x46,
+	0xd4, 0x0c, 0xda, 0xa6, 0xfc, 0x84, 0xd7, 0x14, 0xd5, 0x1b, 0xb3, 0x1d, 0x84, 0x26, 0xdd, 0x21,
+	0x66, 0xd4, 0x23, 0x2d, 0x26, 0x7f, 0x8e, 0x29, 0xb8, 0xcb, 0x40, 0xd9, 0xbc, 0xda, 0xde, 0xec,
+	0xb8, 0xbb, 0xc4, 0x37, 0xb7, 0xf7, 0xcd, 0xcd, 0x8b, 0xaf, 0x7c, 0xe7, 0x87, 0x1f, 0xad, 0x9c,
+	0xb2, 0xd6, 0x36, 0xd4, 0xe2, 0xc6, 0x8d, 0x44, 0x7e, 0x5f, 0x16, 0x1f, 0x02, 0x3f, 0x8a, 0xee,
+	0xc3, 0x1f, 0xaa, 0x28, 0x87, 0xb5, 0xe4, 0xf3, 0x33, 0x7c, 0x76, 0x28, 0x91, 0xb9, 0xde, 0xda,
+	0x72, 0x72, 0xff, 0x08, 0x0d, 0xea, 0x9e, 0xf1, 0xee, 0x5b, 0x26, 0x57, 0x50, 0x29, 0xaf, 0xc1,
+	0x48, 0x1e, 0xdc, 0x6f, 0xdd, 0x5d, 0xc2, 0x83, 0x1b, 0x72, 0x0b, 0x8d, 0x1b, 0x7f, 0x87, 0x60,
+	0x31, 0xf3, 0x35, 0x17, 0x36, 0x13, 0x7a, 0x8a, 0x3f, 0xf4, 0x32, 0x8a, 0x7a, 0xb3, 0x59, 0x42,
+	0xe8, 0xbd, 0x83, 0xfa, 0x3b, 0x8c, 0xb7, 0x37, 0x08, 0xb3, 0x7c, 0x91, 0x20, 0x49, 0xb8, 0x83,
+	0x66, 0x3b, 0x08, 0x68, 0x2f, 0x74, 0x7d, 0x4e, 0x3e, 0xb1, 0x5b, 0x3b, 0xa6, 0x17, 0xb4, 0x6c,
+	0xcf, 0xdb, 0x37, 0xaf, 0xf9, 0xc1, 0xde, 0xf8, 0xc4, 0x1d, 0xc7, 0xab, 0x25, 0xc4, 0xb1, 0x8c,
+	0x14, 0xfe, 0x37, 0x04, 0xf3, 0xfa, 0x97, 0x70, 0x58, 0x73, 0x17, 0x0a, 0xbe, 0xcc, 0x33, 0x4e,
+	0x94, 0x2d, 0x0b, 0x3b, 0x6d, 0x7d, 0x12, 0x0d, 0xea, 0x81, 0xd1, 0x65, 0x6b, 0x82, 0x1e, 0xd1,
+	0x9e, 0x6b, 0xaa, 0xd2, 0xa4, 0x8e, 0xb7, 0xed, 0x07, 0x74, 0x87, 0x84, 0x09, 0xee, 0x34, 0x28,
+	0xa5, 0xc5, 0xb4, 0x7d, 0x47, 0x6e, 0x22, 0xf6, 0xf5, 0xc9, 0x9e, 0xda, 0x6b, 0x84, 0x20, 0xf3,
+	0x96, 0x4d, 0x76, 0x74, 0xdf, 0x47, 0xb0, 0xf4, 0x04, 0xc9, 0x7f, 0xe3, 0x94, 0x8f, 0x9d, 0x1f,
+	0x67, 0x1f, 0xdb, 0x1b, 0x56, 0xe9, 0x77, 0x46, 0x71, 0x38, 0x6c, 0xbd, 0x8a, 0x06, 0xf5, 0xae,
+	0x71, 0x8d, 0xc5, 0xca, 0x02, 0x2f, 0x55, 0xf5, 0x63, 0xc4, 0xc8, 0x32, 0x9f, 0xa9, 0xca, 0x6c,
+	0x26, 0x53, 0x2a, 0x66, 0x57, 0xec, 0xa1, 0x4e, 0xae, 0x15, 0x84, 0x1a, 0xc9, 0x8c, 0x4c, 0xb2,
+	0x4b, 0xc2, 0x7d, 0x53, 0x94, 0x60, 0x08, 0xe3, 0x59, 0xbc, 0xca, 0x54, 0x38, 0xa7, 0x76, 0x05,
+	0x2f, 0x27, 0xd4, 0x26, 0xd5, 0x52, 0xfc, 0x65, 0x04, 0x87, 0xd2, 0x57, 0x10, 0x9f, 0xcc, 0x8b,
+	0x5e, 0xea, 0x4b, 0x26, 0xa3, 0x20, 0x7c, 0x8f, 0xc9, 0x73, 0x06, 0xf5, 0x0b, 0x46, 0x3d, 0xb9,
+	0x8f, 0x31, 0x26, 0x59, 0xb1, 0x63, 0x98, 0xe9, 0x28, 0xc7, 0x37, 0x94, 0x67, 0x72, 0x38, 0xce,
+	0x35, 0x6b, 0x29, 0xc6, 0x39, 0xda, 0xb8, 0x21, 0x56, 0x5e, 0x66, 0x07, 0xf3, 0x75, 0x04, 0x87,
+	0x44, 0xae, 0x6b, 0x18, 0xd6, 0xa9, 0xef, 0x89, 0x86, 0x62, 0xfd, 0x22, 0xc7, 0x5a, 0xc0, 0xdf,
+	0x2a, 0xd6, 0x77, 0x19, 0x66, 0x01, 0xd6, 0x29, 0x09, 0x63, 0x24, 0x7c, 0x0b, 0xc1, 0x9c, 0x76,
+	0xf7, 0xf1, 0x5a, 0xa1, 0x4a, 0x50, 0xb7, 0x68, 0x18, 0xf2, 0x4c, 0xe5, 0x5f, 0x35, 0xae, 0x3c,
+	0x41, 0xa8, 0x10, 0x0f, 0x5e, 0xaa, 0xa1, 0xa9, 0x7b, 0x73, 0x4b, 0x04, 0x59, 0x78, 0x24, 0x41,
+	0xf8, 0x07, 0xe9, 0xcf, 0x8e, 0x94, 0x9e, 0x7f, 0x43, 0x21, 0x51, 0x19, 0xe5, 0x3e, 0x8c, 0xb6,
+	0xdf, 0x42, 0x83, 0xfa, 0xf3, 0xc6, 0x16, 0xa3, 0xcd, 0x56, 0xca, 0xbb, 0x75, 0xfb, 0x48, 0x5b,
+	0xc7, 0xf7, 0x8d, 0x22, 0x2d, 0x51, 0xe9, 0xf8, 0xa7, 0x08, 0xe6, 0xb4, 0x4f, 0x40, 0xf4, 0x23,
+	0xcb, 0x7f, 0xec, 0x52, 0x6e, 0xb3, 0xbe, 0x88, 0x06, 0xf5, 0xeb, 0xc6, 0xee, 0x2d, 0xd9, 0xac,
+	0x5b, 0x24, 0xdb, 0xba, 0x67, 0x24, 0xd9, 0x02, 0x09, 0x26, 0xa9, 0x5f, 0xa8, 0xc0, 0xd1, 0xc2,
+	0x2f, 0x5f, 0xf0, 0xdd, 0x85, 0x0c, 0xb8, 0x09, 0xf3, 0xfd, 0x0f, 0x68, 0x50, 0x7f, 0x0d, 0x19,
+	0x1f, 0x42, 0xb7, 0xdf, 0x80, 0xdf, 0x1a, 0x87, 0xde, 0x6c, 0x3d, 0x38, 0xbe, 0x60, 0x68, 0xbc,
+	0xfa, 0x0a, 0x82, 0x85, 0xd4, 0xa7, 0x20, 0x38, 0x65, 0xff, 0xf2, 0x1f, 0xd2, 0x18, 0x27, 0x4b,
+	0xd7, 0xe5, 0x15, 0xd8, 0x1e, 0xd4, 0x9f, 0x31, 0x9e, 0x4a, 0xec, 0x45, 0x8c, 0x96, 0xa2, 0x4b,
+	0x66, 0xad, 0xcc, 0x3d, 0x97, 0xee, 0xb0, 0x09, 0x37, 0x54, 0x36, 0xb4, 0xd0, 0x01, 0x88, 0x38,
+	0x81, 0xf3, 0x18, 0x12, 0x02, 0xf1, 0x77, 0x10, 0x1c, 0xce, 0x7e, 0x33, 0x82, 0x4f, 0x15, 0x5e,
+	0x5e, 0x3d, 0x13, 0x54, 0x74, 0xb0, 0x7c, 0xdd, 0x7a, 0x05, 0x0d, 0xea, 0xbf, 0x6a, 0xbc, 0xa3,
+	0x08, 0x6b, 0xf1, 0x69, 0xbd, 0x49, 0x03, 0x6e, 0xba, 0x58, 0xa4, 0x3f, 0xdc, 0x86, 0xb3, 0xc5,
+	0x67, 0x2f, 0x5d, 0x89, 0xcc, 0xae, 0xeb, 0x53, 0xe2, 0x98, 0x81, 0x6f, 0xba, 0x94, 0xd3, 0x70,
+	0x02, 0x97, 0x59, 0xf0, 0x0e, 0x27, 0xe0, 0x67, 0x08, 0x8e, 0xe4, 0xbe, 0xba, 0xc0, 0x56, 0x8a,
+	0xac, 0xc2, 0x4f, 0x32, 0x0c, 0xb3, 0xec, 0x4b, 0x80, 0xf8, 0x54, 0x7e, 0x0f, 0x0d, 0xea, 0x3d,
+	0xc3, 0x4f, 0x08, 0x2c, 0xe6, 0xf5, 0x30, 0x6f, 0x4b, 0x3f, 0x30, 0x11, 0x28, 0x45, 0xeb, 0x66,
+	0x1c, 0x16, 0x45, 0x9a, 0x03, 0x43, 0x1c, 0x33, 0x0c, 0x02, 0x2a, 0x4e, 0xee, 0x14, 0x3e, 0x59,
+	0x42, 0xb5, 0x7a, 0x29, 0xf3, 0x5b, 0x0e, 0xa5, 0x3f, 0x50, 0xd0, 0xcd, 0x63, 0xe1, 0xa7, 0x0b,
+	0x46, 0xfe, 0xe3, 0x07, 0xbd, 0xcd, 0xdf, 0xfa, 0x6d, 0x34, 0xa8, 0x3f, 0x65, 0x6c, 0x26, 0xf4,
+	0xca, 0xeb, 0x27, 0x5a, 0xee, 0x1d, 0x73, 0x9b, 0xd0, 0x3d, 0x42, 0x7c, 0x93, 0xee, 0x05, 0x63,
+	0x11, 0xcf, 0x49, 0x79, 0x04, 0xbf, 0xa5, 0x84, 0x14, 0xc7, 0x6d, 0xb7, 0x37, 0x6e, 0xe8, 0xdf,
+	0x0d, 0xbc, 0xbc, 0x71, 0x23, 0xf9, 0x46, 0xe0, 0x65, 0xfc, 0x8f, 0x71, 0x77, 0x7d, 0x72, 0xd5,
+	0xcc, 0x6c, 0x33, 0x7a, 0xee, 0xb2, 0x9d, 0x1a, 0x02, 0x21, 0x09, 0x65, 0x92, 0xfb, 0x82, 0xf1,
+	0x2b, 0xb1, 0x42, 0xd2, 0xbc, 0xc8, 0xbc, 0x4a, 0x11, 0x7a, 0x41, 0x08, 0xb1, 0x74, 0xc2, 0x82,
+	0x3d, 0xa1, 0x7d, 0x2e, 0x6c, 0x5d, 0x35, 0x83, 0xd0, 0x7c, 0xdb, 0xd6, 0x73, 0xcf, 0x9e, 0xf1,
+	0x5c, 0x9f, 0x44, 0xe6, 0x36, 0x4b, 0x26, 0x72, 0xba, 0x4f, 0x5a, 0x46, 0x91, 0x76, 0x11, 0xed,
+	0xf7, 0x4c, 0x8d, 0x7c, 0xa4, 0x02, 0x4b, 0x05, 0xfd, 0xec, 0x7a, 0x70, 0x58, 0xde, 0x52, 0x6f,
+	0xdc, 0x35, 0x02, 0x4a, 0x52, 0xfa, 0x67, 0x68, 0x50, 0x0f, 0x8d, 0x9e, 0x00, 0x89, 0xcc, 0x54,
+	0xf8, 0x9c, 0xdc, 0x4b, 0xe6, 0x9e, 0x8a, 0x7b, 0x18, 0xe7, 0x02, 0x4d, 0x9e, 0x01, 0x1c, 0x2a,
+	0xda, 0xa3, 0x9c, 0xef, 0x07, 0xac, 0xfb, 0x4b, 0x4e, 0x3e, 0x85, 0xc6, 0x46, 0xc8, 0x91, 0x63,
+	0x2c, 0xf9, 0x27, 0x04, 0xf3, 0x7a, 0xb3, 0xbc, 0x1e, 0x77, 0x14, 0x74, 0xea, 0x1b, 0x27, 0xca,
+	0x96, 0x25, 0xf5, 0xaf, 0x09, 0xea, 0xc5, 0x9a, 0x40, 0xb2, 0xc5, 0xcf, 0xdc, 0x59, 0x67, 0x1a,
+	0x95, 0xf4, 0x28, 0x11, 0x74, 0xf7, 0x6c, 0x97, 0x7b, 0xd8, 0xba, 0xc6, 0x55, 0xb7, 0x52, 0xd3,
+	0xc5, 0xbb, 0x24, 0x64, 0x02, 0x62, 0x53, 0x62, 0x86, 0xec, 0x4a, 0x70, 0xab, 0x22, 0x55, 0x33,
+	0x73, 0xde, 0xa3, 0xfd, 0x88, 0x92, 0xae, 0xb8, 0xc2, 0x4b, 0xf8, 0x88, 0x76, 0xfe, 0x9e, 0xa0,
+	0xe7, 0x47, 0x08, 0x0e, 0x67, 0x3b, 0xce, 0x75, 0x1d, 0x5c, 0xd2, 0x82, 0x6f, 0x58, 0xc3, 0x40,
+	0x24, 0xb1, 0x1f, 0x41, 0x83, 0xba, 0x6d, 0x34, 0x93, 0xdb, 0x2b, 0xf2, 0x59, 0xa6, 0x68, 0x3d,
+	0x57, 0x64, 0x49, 0xab, 0x31, 0x46, 0xa0, 0x68, 0xd2, 0x1d, 0x9b, 0x9a, 0x3b, 0xf6, 0x2e, 0x31,
+	0xfd, 0x80, 0x9a, 0xa2, 0x6b, 0xde, 0xe1, 0xb4, 0xdd, 0x8d, 0xef, 0x2c, 0x39, 0x59, 0x3d, 0xb5,
+	0x16, 0xe1, 0x1f, 0x23, 0x58, 0x48, 0xf5, 0xab, 0xeb, 0x96, 0xb2, 0xa8, 0x91, 0xdd, 0x18, 0xda,
+	0x5c, 0x6d, 0x7d, 0x1a, 0x0d, 0xea, 0xae, 0xd1, 0x61, 0x13, 0x51, 0xda, 0x0f, 0x66, 0x29, 0x18,
+	0x11, 0x3d, 0x9a, 0x71, 0xb5, 0x77, 0x2c, 0xbd, 0x6c, 0xb2, 0x3c, 0x2d, 0x3b, 0xbb, 0x6b, 0x64,
+	0x3f, 0x63, 0x6c, 0x47, 0xa4, 0x01, 0xe2, 0xf7, 0x44, 0x1b, 0x6c, 0x0f, 0x26, 0xbf, 0xac, 0xd3,
+	0xaf, 0xb0, 0xe5, 0x5b, 0xf7, 0xa2, 0x86, 0xf5, 0xa3, 0x1b, 0xf7, 0x8c, 0x84, 0x93, 0xa7, 0xfd,
+	0xa7, 0x22, 0xa4, 0xae, 0x3b, 0x4e, 0x14, 0x93, 0xc1, 0x21, 0x84, 0x66, 0xa2, 0x3b, 0x6e, 0xc8,
+	0xc4, 0x9a, 0xc5, 0x97, 0x42, 0x6c, 0x75, 0xc6, 0xd0, 0xe0, 0xf5, 0xb8, 0xd5, 0xf1, 0xfe, 0xda,
+	0xb7, 0xf1, 0x8c, 0x2b, 0x3f, 0x61, 0xe1, 0x67, 0xaa, 0x69, 0x5c, 0xb7, 0x54, 0x85, 0xcd, 0xea,
+	0x86, 0x59, 0x0e, 0x20, 0x19, 0xf0, 0x69, 0x71, 0xb7, 0xc5, 0x6a, 0x54, 0x4a, 0xcf, 0xba, 0x29,
+	0xfe, 0x37, 0x1d, 0xb7, 0xdb, 0x49, 0x2b, 0x3b, 0x5b, 0xb4, 0xcd, 0x90, 0xf4, 0x3c, 0xbb, 0x45,
+	0xf8, 0x33, 0xea, 0xe1, 0xf5, 0x1c, 0x07, 0xda, 0xae, 0x6f, 0x7b, 0x29, 0x1e, 0x58, 0xd6, 0xf1,
+	0x32, 0xcd, 0xc6, 0xd1, 0x61, 0x54, 0xff, 0x10, 0xc1, 0x9c, 0xd6, 0xf9, 0xad, 0x07, 0x12, 0xf9,
+	0xa6, 0x77, 0xe3, 0x78, 0xc9, 0xaa, 0x24, 0xf6, 0xe3, 0x82, 0x58, 0xbe, 0xe4, 0x12, 0xcd, 0x38,
+	0x2b, 0xd7, 0x99, 0x1f, 0x3a, 0xff, 0x6d, 0x6e, 0xf3, 0x0a, 0xa9, 0x69, 0x77, 0x6c, 0xd7, 0x8f,
+	0xa8, 0xe9, 0xd2, 0x28, 0x61, 0x4c, 0x18, 0x04, 0x34, 0x76, 0xb8, 0xc4, 0x40, 0x82, 0x25, 0x2a,
+	0x8f, 0x71, 0x25, 0x88, 0x5c, 0xe6, 0x09, 0x71, 0x62, 0xd7, 0xac, 0x63, 0xa9, 0xac, 0x02, 0xfb,
+	0xaf, 0x80, 0xbb, 0x1c, 0x47, 0x46, 0xe6, 0x37, 0x11, 0x4c, 0x89, 0x0e, 0x59, 0x7c, 0x2c, 0x25,
+	0xbb, 0x49, 0x53, 0xaf, 0x51, 0xcb, 0x2f, 0x68, 0xa1, 0xdf, 0xbb, 0x8c, 0x77, 0x72, 0x29, 0xb6,
+	0x7d, 0xe6, 0x02, 0xc6, 0x1e, 0x60, 0x9f, 0x46, 0xae, 0x13, 0xdf, 0x61, 0x3f, 0x70, 0xc8, 0x6d,
+	0xcb, 0x04, 0x45, 0xe9, 0x33, 0x63, 0x35, 0x46, 0x46, 0xca, 0xe7, 0x2a, 0x70, 0x28, 0xdd, 0x2f,
+	0xaa, 0xcb, 0x69, 0x61, 0x07, 0xaf, 0x61, 0x96, 0x03, 0x48, 0x12, 0xbf, 0x8d, 0x06, 0xf5, 0x4f,
+	0x21, 0xe3, 0x13, 0xa8, 0xd1, 0xf7, 0x23, 0xcd, 0xda, 0x72, 0x28, 0x53, 0xb4, 0x8a, 0x25, 0x59,
+	0x9f, 0x94, 0x79, 0x66, 0xc6, 0xc5, 0xbc, 0xc8, 0x64, 0x58, 0x57, 0xe5, 0x66, 0x10, 0x72, 0x46,
+	0x05, 0x7b, 0x3e, 0x09, 0xcd, 0xc0, 0x1f, 0x22, 0xfa, 0xb1, 0x92, 0x13, 0x1d, 0xb1, 0x29, 0xb3,
+	0xe0, 0x46, 0xa6, 0x43, 0x7c, 0x97, 0x38, 0xa3, 0xd4, 0x1c, 0x07, 0xdf, 0x88, 0x24, 0x75, 0x8c,
+	0x51, 0xff, 0xcb, 0xfe, 0x47, 0x62, 0xb6, 0x87, 0x53, 0xf7, 0xb9, 0xcb, 0x1a, 0x3c, 0x75, 0x76,
+	0x15, 0xb7, 0x28, 0x5a, 0x7f, 0x20, 0x54, 0x7c, 0x83, 0xb4, 0x82, 0x90, 0x09, 0x05, 0x3f, 0x48,
+	0xd5, 0x73, 0xb9, 0x6e, 0x46, 0xfd, 0xd6, 0x8e, 0x69, 0xb3, 0x79, 0xd9, 0xe9, 0xba, 0x9e, 0x92,
+	0xe0, 0x03, 0x89, 0x86, 0x1e, 0x29, 0xa7, 0x69, 0x57, 0xef, 0x6d, 0xca, 0x76, 0x40, 0x46, 0xfc,
+	0x67, 0x2a, 0xb0, 0x5c, 0xd4, 0x55, 0x8a, 0x35, 0x8f, 0x6c, 0x48, 0xd7, 0xe9, 0x18, 0x2c, 0xf8,
+	0x1b, 0x34, 0xa8, 0xbf, 0xc7, 0x78, 0x49, 0x65, 0xaa, 0x38, 0x5d, 0x1c, 0x42, 0xaa, 0x76, 0xf9,
+	0x94, 0x19, 0x72, 0x1e, 0x89, 0x68, 0xa9, 0x5c, 0x06, 0x14, 0xc7, 0x98, 0x1e, 0x48, 0x5a, 0x71,
+	0xd7, 0x47, 0x72, 0xe5, 0x51, 0xe3, 0x4d, 0x63, 0x72, 0x65, 0xe3, 0x06, 0x4d, 0xda, 0x64, 0x79,
+	0xde, 0xeb, 0xd5, 0x0a, 0x2c, 0x15, 0x34, 0x70, 0xea, 0xae, 0x6d, 0x79, 0x7b, 0xa8, 0x71, 0xd7,
+	0x08, 0x28, 0xc9, 0xa6, 0xcf, 0xa3, 0x41, 0xbd, 0x6f, 0x44, 0x89, 0xbf, 0x13, 0x33, 0x46, 0xe2,
+	0x95, 0x63, 0xd0, 0x4d, 0xf8, 0x3e, 0xf1, 0xcd, 0x91, 0x21, 0x10, 0x0d, 0x4c, 0xfe, 0x7f, 0x35,
+	0xd8, 0x5c, 0x97, 0xf3, 0xe7, 0x5e, 0x3c, 0xae, 0xd4, 0xe0, 0x0f, 0x54, 0xf8, 0x67, 0x0e, 0x5a,
+	0x23, 0xe9, 0x89, 0xac, 0x99, 0x4f, 0x77, 0x7e, 0x66, 0xdc, 0xa0, 0x4c, 0xdb, 0xa4, 0xf5, 0x97,
+	0x68, 0x50, 0x7f, 0x3f, 0x32, 0x5e, 0x41, 0x4a, 0x6f, 0x26, 0x1d, 0x82, 0x07, 0xd5, 0x91, 0x67,
+	0xcd, 0xe7, 0x7b, 0x2c, 0x83, 0xca, 0x73, 0x2e, 0xcc, 0xf1, 0xb7, 0x43, 0x62, 0xf6, 0x5c, 0xdf,
+	0x17, 0x51, 0x3c, 0x83, 0xde, 0xbc, 0x7c, 0x69, 0x4b, 0xe8, 0x61, 0x4d, 0x27, 0x73, 0x56, 0xdc,
+	0x63, 0x59, 0xe5, 0x2e, 0x81, 0x44, 0x8c, 0xdf, 0x9d, 0x1f, 0x23, 0x58, 0xcc, 0x34, 0x52, 0xea,
+	0x01, 0x5d, 0x71, 0x7b, 0xa6, 0x71, 0x6a, 0x08, 0x84, 0xe4, 0xc8, 0xc7, 0xd0, 0xa0, 0xde, 0x31,
+	0x88, 0xe6, 0xfb, 0x26, 0x40, 0x07, 0xf0, 0x7c, 0x47, 0x9f, 0xfe, 0x9d, 0x78, 0x0c, 0x92, 0x99,
+	0x0f, 0x30, 0xcd, 0x74, 0x21, 0x6b, 0x8d, 0x5c, 0xcd, 0xa9, 0x87, 0xa4, 0x83, 0x53, 0xaf, 0x04,
+	0xe9, 0x5d, 0x99, 0xd6, 0x67, 0xd1, 0xa0, 0xfe, 0x92, 0x71, 0x3d, 0xf6, 0xf2, 0x58, 0x93, 0xe5,
+	0x41, 0x8f, 0x78, 0x9d, 0xeb, 0x4d, 0xcf, 0x0b, 0xf6, 0x84, 0xfb, 0x13, 0x5f, 0x99, 0x82, 0x78,
+	0x4f, 0xf7, 0x80, 0x4d, 0xab, 0xac, 0x56, 0xc4, 0xb0, 0x91, 0x0e, 0x1e, 0x88, 0x08, 0xf3, 0xe0,
+	0x94, 0x7e, 0x09, 0x0d, 0xea, 0xef, 0x35, 0x5e, 0x56, 0x81, 0x6a, 0x4c, 0xec, 0xe8, 0xdc, 0xd1,
+	0x6d, 0x26, 0xb7, 0x5c, 0x98, 0x19, 0x3e, 0x5a, 0xb0, 0xfa, 0x57, 0xac, 0xdd, 0xcc, 0xde, 0x25,
+	0x71, 0x13, 0xeb, 0x90, 0x8e, 0x47, 0x63, 0xc8, 0x9a, 0xd5, 0x1b, 0xd4, 0x9f, 0x34, 0x2e, 0xa9,
+	0x64, 0x44, 0x10, 0x2a, 0xb7, 0x34, 0xe3, 0xd4, 0xaa, 0x9e, 0xc9, 0xb2, 0x94, 0x20, 0xaf, 0x23,
+	0x89, 0xd4, 0x83, 0x91, 0xa4, 0x1e, 0x36, 0xd4, 0x63, 0xd1, 0xc6, 0x0d, 0x06, 0xc0, 0xf5, 0xf3,
+	0xe7, 0x44, 0x5d, 0x22, 0xc6, 0x3c, 0x5d, 0x97, 0xc8, 0x34, 0x61, 0x0e, 0xc5, 0xfd, 0xd7, 0x07,
+	0xf5, 0x87, 0x8d, 0x37, 0xab, 0xb2, 0xc4, 0x01, 0x70, 0x5d, 0xc3, 0x43, 0x70, 0xc5, 0xbf, 0x2b,
+	0x53, 0xad, 0xea, 0x7d, 0xe5, 0x65, 0xb9, 0x4c, 0x8a, 0x35, 0xd7, 0xa2, 0x6a, 0x3d, 0x35, 0xa8,
+	0x9f, 0x31, 0xee, 0xcf, 0x27, 0x2b, 0x63, 0x5c, 0x0b, 0xa5, 0xe1, 0x28, 0x5e, 0x2a, 0x40, 0x0f,
+	0x7f, 0x0f, 0xc1, 0xa1, 0x8b, 0xc4, 0x23, 0x94, 0xdc, 0x06, 0x1e, 0xb2, 0xb4, 0xdb, 0x8e, 0xd1,
+	0x16, 0xfb, 0x1d, 0xe4, 0xd0, 0xd7, 0xb5, 0x1c, 0x85, 0xcc, 0x6f, 0x88, 0x7b, 0xe3, 0x52, 0xae,
+	0xc7, 0xfd, 0x80, 0x9a, 0x76, 0xbb, 0x4d, 0x5a, 0x54, 0x7a, 0x7b, 0x6b, 0xf7, 0x0d, 0x63, 0xfa,
+	0x7f, 0xc5, 0xff, 0xd8, 0x4b, 0x6f, 0xc9, 0xd5, 0xeb, 0x3c, 0xa5, 0x0d, 0xbb, 0x43, 0xeb, 0x3c,
+	0x9f, 0x40, 0x83, 0x7a, 0xdb, 0x70, 0x0a, 0xea, 0x86, 0xf1, 0x25, 0x4f, 0x42, 0x54, 0x1e, 0xd1,
+	0x47, 0x71, 0xac, 0x22, 0x1b, 0x96, 0xf3, 0x09, 0xa9, 0x98, 0x41, 0x79, 0xd1, 0xba, 0xd7, 0xba,
+	0xb3, 0x9c, 0xca, 0x78, 0x85, 0x6b, 0xb0, 0xff, 0xa8, 0xc0, 0x4a, 0x71, 0xfb, 0x2d, 0xbe, 0x27,
+	0x4b, 0x76, 0x49, 0x83, 0xae, 0x4e, 0x7a, 0x16, 0xc4, 0x7a, 0xad, 0x32, 0xa8, 0xff, 0x2b, 0x32,
+	0xbe, 0x8f, 0x2e, 0x87, 0x52, 0xbd, 0xd9, 0xd4, 0xb4, 0x65, 0x08, 0x97, 0x2e, 0x64, 0x90, 0x17,
+	0xfb, 0xb6, 0x17, 0xa5, 0x16, 0x33, 0x15, 0xf1, 0xc4, 0xa7, 0xe3, 0x3a, 0x2d, 0xa0, 0xb6, 0xf0,
+	0x0c, 0x7d, 0xd3, 0xf5, 0x77, 0x03, 0xb7, 0x45, 0x62, 0xae, 0xb1, 0x56, 0x81, 0x96, 0xdb, 0x13,
+	0xeb, 0xcc, 0x01, 0x6c, 0xf7, 0x7d, 0x87, 0x25, 0x3b, 0xec, 0x4e, 0x48, 0xa4, 0x1f, 0x18, 0xf3,
+	0x2d, 0x89, 0x24, 0xb7, 0x03, 0xba, 0xa3, 0x4c, 0x9f, 0xda, 0x2a, 0x95, 0x5f, 0x88, 0x23, 0x32,
+	0x9e, 0x5a, 0x60, 0x23, 0x8e, 0xb5, 0x4b, 0xf7, 0xf3, 0x55, 0x77, 0x19, 0x31, 0xb6, 0x12, 0x96,
+	0x30, 0x86, 0xff, 0xb3, 0x68, 0x98, 0x48, 0xb5, 0xb9, 0x96, 0x5d, 0x6d, 0xbd, 0x57, 0x48, 0x83,
+	0xb7, 0x3e, 0x85, 0x06, 0xf5, 0xdf, 0x30, 0xde, 0xa3, 0x94, 0x8f, 0xea, 0x91, 0xe8, 0x47, 0x89,
+	0xc2, 0x8f, 0x68, 0x2a, 0x85, 0x97, 0xcb, 0x5a, 0xcb, 0xeb, 0xb4, 0x6e, 0xf6, 0x52, 0x1d, 0x07,
+	0xfb, 0x3d, 0xb2, 0x9e, 0x50, 0x2e, 0xf7, 0xe5, 0x7d, 0xbf, 0x45, 0x3a, 0x62, 0x19, 0x63, 0x2d,
+	0xb4, 0x94, 0xe0, 0xf8, 0xe3, 0x15, 0xd1, 0x56, 0x9f, 0xe9, 0x10, 0x4d, 0x57, 0x4b, 0x8b, 0x9b,
+	0x6f, 0x8d, 0x3b, 0x4a, 0xbb, 0x33, 0xad, 0xaf, 0xa3, 0x41, 0x7d, 0x80, 0x8c, 0x0f, 0x23, 0x3d,
+	0xa9, 0xc9, 0x5b, 0x3c, 0x47, 0xe6, 0x6a, 0x75, 0x87, 0x86, 0x17, 0x24, 0x72, 0xe6, 0x90, 0x9b,
+	0x4b, 0xde, 0xa3, 0xc0, 0x33, 0xfe, 0xc2, 0x84, 0x9a, 0xbc, 0xd0, 0x62, 0xba, 0x3e, 0xcf, 0x73,
+	0xf3, 0x9d, 0x5c, 0xe1, 0x4f, 0x5f, 0x7c, 0xee, 0x8a, 0xe9, 0xd9, 0x7e, 0xa7, 0x6f, 0x77, 0xc8,
+	0x08, 0xaf, 0x28, 0x79, 0x55, 0x84, 0x3f, 0x51, 0x51, 0xff, 0x12, 0x25, 0xdd, 0xd6, 0xa9, 0x47,
+	0x50, 0x43, 0x9a, 0x4c, 0x8d, 0xbb, 0x47, 0x81, 0x49, 0x7d, 0xf3, 0x17, 0x68, 0x50, 0xff, 0x30,
+	0x32, 0x5e, 0x4d, 0xb1, 0x2a, 0x49, 0x4d, 0x65, 0x55, 0x6a, 0x2c, 0xcb, 0x89, 0x32, 0x95, 0x5c,
+	0x73, 0xc3, 0x44, 0x31, 0x15, 0x64, 0x81, 0xd7, 0xf9, 0xa6, 0xc4, 0x11, 0xdc, 0xda, 0xdb, 0x09,
+	0x3c, 0xa2, 0xe4, 0x4f, 0xed, 0xcd, 0x43, 0x79, 0x86, 0x9c, 0xc8, 0x0d, 0x1f, 0xc3, 0x47, 0x75,
+	0x89, 0x89, 0x51, 0xc2, 0x5f, 0xad, 0xc4, 0x5d, 0x9d, 0x49, 0xd7, 0x83, 0xe6, 0xff, 0x96, 0xf4,
+	0x91, 0x1a, 0x46, 0x0e, 0x24, 0x6e, 0x0a, 0xb5, 0xfe, 0x05, 0x0d, 0xea, 0x7f, 0x8e, 0x8c, 0x2f,
+	0x24, 0x09, 0x88, 0xdd, 0x18, 0xc4, 0xe4, 0x1d, 0xa2, 0x79, 0xd1, 0x89, 0x9d, 0x64, 0x9f, 0x98,
+	0x76, 0x9b, 0x4a, 0xc6, 0x70, 0x35, 0xb4, 0x2e, 0x65, 0x68, 0x5d, 0x63, 0xe5, 0x7a, 0x52, 0x3e,
+	0x49, 0x15, 0x11, 0x22, 0x71, 0x8b, 0x22, 0x1a, 0x12, 0xbb, 0x2b, 0x45, 0x95, 0x23, 0x15, 0xb7,
+	0x2f, 0xf1, 0xf7, 0x8b, 0xf0, 0x94, 0x37, 0xc6, 0x70, 0x13, 0x28, 0x32, 0x12, 0xf8, 0xde, 0x91,
+	0xfd, 0x57, 0x92, 0x10, 0xf2, 0x00, 0xc2, 0x5f, 0xae, 0xc0, 0x72, 0x51, 0xef, 0x29, 0x4e, 0x15,
+	0x4a, 0x4a, 0x7b, 0x53, 0xcb, 0xcb, 0xd7, 0xdf, 0x43, 0x83, 0xfa, 0xe7, 0x91, 0xf1, 0x27, 0x48,
+	0x42, 0x46, 0xb9, 0x4c, 0x8d, 0xd0, 0xe6, 0x6e, 0x14, 0xf5, 0x89, 0x93, 0x4b, 0xbc, 0xc7, 0x85,
+	0xe7, 0x44, 0xbd, 0x6a, 0xf7, 0x70, 0xd4, 0x95, 0xdd, 0xd5, 0x12, 0x7e, 0xdd, 0x82, 0x5c, 0x9d,
+	0xae, 0xdb, 0xc2, 0x20, 0xa0, 0x79, 0xbb, 0x97, 0xaf, 0xf7, 0x6f, 0x84, 0x82, 0x10, 0x99, 0xb0,
+	0xc6, 0xf9, 0x6e, 0xd6, 0x92, 0x96, 0x8e, 0x74, 0xaf, 0xab, 0xae, 0xa4, 0x32, 0x10, 0xd6, 0xd7,
+	0xd0, 0xa0, 0xfe, 0x41, 0x64, 0xbc, 0x1f, 0x35, 0x08, 0xed, 0x87, 0x52, 0xea, 0x22, 0xb1, 0x58,
+	0x26, 0x6b, 0xeb, 0xdc, 0x40, 0xb1, 0x2c, 0x7c, 0x2c, 0x5f, 0xbc, 0xe1, 0x74, 0x3d, 0x93, 0x15,
+	0x4b, 0x5c, 0xfe, 0x75, 0x33, 0x24, 0xbe, 0xc3, 0xab, 0xfb, 0x76, 0x64, 0x3e, 0x79, 0xe5, 0x99,
+	0xa7, 0x4d, 0x57, 0x72, 0x97, 0xf7, 0xce, 0x9a, 0x6e, 0x64, 0xb2, 0xa6, 0x4e, 0xce, 0x94, 0xfb,
+	0xf0, 0xe9, 0x91, 0xe2, 0x24, 0x31, 0x3c, 0x7f, 0x1f, 0xff, 0xef, 0xd9, 0x31, 0x79, 0xe7, 0xe7,
+	0x65, 0x3f, 0xe7, 0x65, 0x66, 0x97, 0x2e, 0xa3, 0x17, 0xe2, 0x56, 0xdb, 0xde, 0xf6, 0xf6, 0x14,
+	0x37, 0x56, 0x0f, 0xfd, 0xff, 0x00, 0x43, 0x17, 0x17, 0xe9, 0xdb, 0x65, 0x00, 0x00,
 }
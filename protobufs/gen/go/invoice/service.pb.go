@@ -6,6 +6,7 @@ package invoicepb
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import document "github.com/centrifuge/go-centrifuge/protobufs/gen/go/document"
 import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
@@ -37,7 +38,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_114606e088e3c0a1, []int{0}
+	return fileDescriptor_service_e59db58491f6e838, []int{0}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
@@ -76,7 +77,7 @@ func (m *GetVersionRequest) Reset()         { *m = GetVersionRequest{} }
 func (m *GetVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetVersionRequest) ProtoMessage()    {}
 func (*GetVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_114606e088e3c0a1, []int{1}
+	return fileDescriptor_service_e59db58491f6e838, []int{1}
 }
 func (m *GetVersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetVersionRequest.Unmarshal(m, b)
@@ -111,18 +112,22 @@ func (m *GetVersionRequest) GetVersion() string {
 }
 
 type InvoiceCreatePayload struct {
-	Collaborators        []string     `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
-	Data                 *InvoiceData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Collaborators []string     `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	Data          *InvoiceData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// collaborators that can only read the document, they don't sign it
+	ReadAccess []string `protobuf:"bytes,3,rep,name=read_access,json=readAccess,proto3" json:"read_access,omitempty"`
+	// collaborators that can read, sign and update the document, in addition to collaborators
+	WriteAccess          []string `protobuf:"bytes,4,rep,name=write_access,json=writeAccess,proto3" json:"write_access,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InvoiceCreatePayload) Reset()         { *m = InvoiceCreatePayload{} }
 func (m *InvoiceCreatePayload) String() string { return proto.CompactTextString(m) }
 func (*InvoiceCreatePayload) ProtoMessage()    {}
 func (*InvoiceCreatePayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_114606e088e3c0a1, []int{2}
+	return fileDescriptor_service_e59db58491f6e838, []int{2}
 }
 func (m *InvoiceCreatePayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvoiceCreatePayload.Unmarshal(m, b)
@@ -156,20 +161,38 @@ func (m *InvoiceCreatePayload) GetData() *InvoiceData {
 	return nil
 }
 
+func (m *InvoiceCreatePayload) GetReadAccess() []string {
+	if m != nil {
+		return m.ReadAccess
+	}
+	return nil
+}
+
+func (m *InvoiceCreatePayload) GetWriteAccess() []string {
+	if m != nil {
+		return m.WriteAccess
+	}
+	return nil
+}
+
 type InvoiceUpdatePayload struct {
-	Identifier           string       `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Collaborators        []string     `protobuf:"bytes,2,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
-	Data                 *InvoiceData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Identifier    string       `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Collaborators []string     `protobuf:"bytes,2,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	Data          *InvoiceData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// collaborators that can only read the document, they don't sign it
+	ReadAccess []string `protobuf:"bytes,4,rep,name=read_access,json=readAccess,proto3" json:"read_access,omitempty"`
+	// collaborators that can read, sign and update the document, in addition to collaborators
+	WriteAccess          []string `protobuf:"bytes,5,rep,name=write_access,json=writeAccess,proto3" json:"write_access,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InvoiceUpdatePayload) Reset()         { *m = InvoiceUpdatePayload{} }
 func (m *InvoiceUpdatePayload) String() string { return proto.CompactTextString(m) }
 func (*InvoiceUpdatePayload) ProtoMessage()    {}
 func (*InvoiceUpdatePayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_114606e088e3c0a1, []int{3}
+	return fileDescriptor_service_e59db58491f6e838, []int{3}
 }
 func (m *InvoiceUpdatePayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvoiceUpdatePayload.Unmarshal(m, b)
@@ -210,6 +233,20 @@ func (m *InvoiceUpdatePayload) GetData() *InvoiceData {
 	return nil
 }
 
+func (m *InvoiceUpdatePayload) GetReadAccess() []string {
+	if m != nil {
+		return m.ReadAccess
+	}
+	return nil
+}
+
+func (m *InvoiceUpdatePayload) GetWriteAccess() []string {
+	if m != nil {
+		return m.WriteAccess
+	}
+	return nil
+}
+
 type InvoiceResponse struct {
 	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Data                 *InvoiceData    `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -222,7 +259,7 @@ func (m *InvoiceResponse) Reset()         { *m = InvoiceResponse{} }
 func (m *InvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*InvoiceResponse) ProtoMessage()    {}
 func (*InvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_114606e088e3c0a1, []int{4}
+	return fileDescriptor_service_e59db58491f6e838, []int{4}
 }
 func (m *InvoiceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvoiceResponse.Unmarshal(m, b)
@@ -272,7 +309,7 @@ func (m *ResponseHeader) Reset()         { *m = ResponseHeader{} }
 func (m *ResponseHeader) String() string { return proto.CompactTextString(m) }
 func (*ResponseHeader) ProtoMessage()    {}
 func (*ResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_114606e088e3c0a1, []int{5}
+	return fileDescriptor_service_e59db58491f6e838, []int{5}
 }
 func (m *ResponseHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResponseHeader.Unmarshal(m, b)
@@ -349,10 +386,10 @@ type InvoiceData struct {
 	// ISO currency code
 	Currency string `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`
 	// invoice amount including tax
-	GrossAmount int64 `protobuf:"varint,14,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	GrossAmount string `protobuf:"bytes,14,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
 	// invoice amount excluding tax
-	NetAmount            int64                `protobuf:"varint,15,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
-	TaxAmount            int64                `protobuf:"varint,16,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	NetAmount            string               `protobuf:"bytes,15,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	TaxAmount            string               `protobuf:"bytes,16,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
 	TaxRate              int64                `protobuf:"varint,17,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
 	Recipient            string               `protobuf:"bytes,18,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Sender               string               `protobuf:"bytes,19,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -361,6 +398,7 @@ type InvoiceData struct {
 	DueDate              *timestamp.Timestamp `protobuf:"bytes,22,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
 	DateCreated          *timestamp.Timestamp `protobuf:"bytes,23,opt,name=date_created,json=dateCreated,proto3" json:"date_created,omitempty"`
 	ExtraData            string               `protobuf:"bytes,24,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
+	LineItems            []*LineItem          `protobuf:"bytes,26,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -370,7 +408,7 @@ func (m *InvoiceData) Reset()         { *m = InvoiceData{} }
 func (m *InvoiceData) String() string { return proto.CompactTextString(m) }
 func (*InvoiceData) ProtoMessage()    {}
 func (*InvoiceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_114606e088e3c0a1, []int{6}
+	return fileDescriptor_service_e59db58491f6e838, []int{6}
 }
 func (m *InvoiceData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvoiceData.Unmarshal(m, b)
@@ -481,25 +519,25 @@ func (m *InvoiceData) GetCurrency() string {
 	return ""
 }
 
-func (m *InvoiceData) GetGrossAmount() int64 {
+func (m *InvoiceData) GetGrossAmount() string {
 	if m != nil {
 		return m.GrossAmount
 	}
-	return 0
+	return ""
 }
 
-func (m *InvoiceData) GetNetAmount() int64 {
+func (m *InvoiceData) GetNetAmount() string {
 	if m != nil {
 		return m.NetAmount
 	}
-	return 0
+	return ""
 }
 
-func (m *InvoiceData) GetTaxAmount() int64 {
+func (m *InvoiceData) GetTaxAmount() string {
 	if m != nil {
 		return m.TaxAmount
 	}
-	return 0
+	return ""
 }
 
 func (m *InvoiceData) GetTaxRate() int64 {
@@ -558,6 +596,346 @@ func (m *InvoiceData) GetExtraData() string {
 	return ""
 }
 
+func (m *InvoiceData) GetLineItems() []*LineItem {
+	if m != nil {
+		return m.LineItems
+	}
+	return nil
+}
+
+// LineItem is a single item of the invoice, amounts and rates are decimals like 100.25
+type LineItem struct {
+	ItemNumber  string `protobuf:"bytes,1,opt,name=item_number,json=itemNumber,proto3" json:"item_number,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity    string `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice   string `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// quantity times unit price, excluding tax
+	Amount               string   `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	TaxAmount            string   `protobuf:"bytes,6,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	TaxRate              string   `protobuf:"bytes,7,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LineItem) Reset()         { *m = LineItem{} }
+func (m *LineItem) String() string { return proto.CompactTextString(m) }
+func (*LineItem) ProtoMessage()    {}
+func (*LineItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_e59db58491f6e838, []int{7}
+}
+func (m *LineItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LineItem.Unmarshal(m, b)
+}
+func (m *LineItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LineItem.Marshal(b, m, deterministic)
+}
+func (dst *LineItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LineItem.Merge(dst, src)
+}
+func (m *LineItem) XXX_Size() int {
+	return xxx_messageInfo_LineItem.Size(m)
+}
+func (m *LineItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_LineItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LineItem proto.InternalMessageInfo
+
+func (m *LineItem) GetItemNumber() string {
+	if m != nil {
+		return m.ItemNumber
+	}
+	return ""
+}
+
+func (m *LineItem) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *LineItem) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *LineItem) GetUnitPrice() string {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return ""
+}
+
+func (m *LineItem) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *LineItem) GetTaxAmount() string {
+	if m != nil {
+		return m.TaxAmount
+	}
+	return ""
+}
+
+func (m *LineItem) GetTaxRate() string {
+	if m != nil {
+		return m.TaxRate
+	}
+	return ""
+}
+
+type ExportUBLRequest struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportUBLRequest) Reset()         { *m = ExportUBLRequest{} }
+func (m *ExportUBLRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportUBLRequest) ProtoMessage()    {}
+func (*ExportUBLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_e59db58491f6e838, []int{8}
+}
+func (m *ExportUBLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportUBLRequest.Unmarshal(m, b)
+}
+func (m *ExportUBLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportUBLRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportUBLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportUBLRequest.Merge(dst, src)
+}
+func (m *ExportUBLRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportUBLRequest.Size(m)
+}
+func (m *ExportUBLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportUBLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportUBLRequest proto.InternalMessageInfo
+
+func (m *ExportUBLRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type UBLResponse struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// UBL 2.1 invoice XML
+	Ubl                  string   `protobuf:"bytes,3,opt,name=ubl,proto3" json:"ubl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UBLResponse) Reset()         { *m = UBLResponse{} }
+func (m *UBLResponse) String() string { return proto.CompactTextString(m) }
+func (*UBLResponse) ProtoMessage()    {}
+func (*UBLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_e59db58491f6e838, []int{9}
+}
+func (m *UBLResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UBLResponse.Unmarshal(m, b)
+}
+func (m *UBLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UBLResponse.Marshal(b, m, deterministic)
+}
+func (dst *UBLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UBLResponse.Merge(dst, src)
+}
+func (m *UBLResponse) XXX_Size() int {
+	return xxx_messageInfo_UBLResponse.Size(m)
+}
+func (m *UBLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UBLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UBLResponse proto.InternalMessageInfo
+
+func (m *UBLResponse) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *UBLResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *UBLResponse) GetUbl() string {
+	if m != nil {
+		return m.Ubl
+	}
+	return ""
+}
+
+type UBLImportPayload struct {
+	Collaborators []string `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	// UBL 2.1 invoice XML
+	Ubl                  string   `protobuf:"bytes,2,opt,name=ubl,proto3" json:"ubl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UBLImportPayload) Reset()         { *m = UBLImportPayload{} }
+func (m *UBLImportPayload) String() string { return proto.CompactTextString(m) }
+func (*UBLImportPayload) ProtoMessage()    {}
+func (*UBLImportPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_e59db58491f6e838, []int{10}
+}
+func (m *UBLImportPayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UBLImportPayload.Unmarshal(m, b)
+}
+func (m *UBLImportPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UBLImportPayload.Marshal(b, m, deterministic)
+}
+func (dst *UBLImportPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UBLImportPayload.Merge(dst, src)
+}
+func (m *UBLImportPayload) XXX_Size() int {
+	return xxx_messageInfo_UBLImportPayload.Size(m)
+}
+func (m *UBLImportPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_UBLImportPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UBLImportPayload proto.InternalMessageInfo
+
+func (m *UBLImportPayload) GetCollaborators() []string {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+func (m *UBLImportPayload) GetUbl() string {
+	if m != nil {
+		return m.Ubl
+	}
+	return ""
+}
+
+// InvoiceAttestation proves the status, amount and due date of the latest anchored version of an invoice
+type InvoiceAttestation struct {
+	DocumentId string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	VersionId  string `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// anchor ID the document root of the version is anchored with
+	AnchorId      string               `protobuf:"bytes,3,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	DocumentRoot  string               `protobuf:"bytes,4,opt,name=document_root,json=documentRoot,proto3" json:"document_root,omitempty"`
+	InvoiceStatus string               `protobuf:"bytes,5,opt,name=invoice_status,json=invoiceStatus,proto3" json:"invoice_status,omitempty"`
+	GrossAmount   string               `protobuf:"bytes,6,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	Currency      string               `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	DueDate       *timestamp.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// proofs of the status, amount, currency and due date against the document root
+	FieldProofs          []*document.Proof `protobuf:"bytes,9,rep,name=field_proofs,json=fieldProofs,proto3" json:"field_proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvoiceAttestation) Reset()         { *m = InvoiceAttestation{} }
+func (m *InvoiceAttestation) String() string { return proto.CompactTextString(m) }
+func (*InvoiceAttestation) ProtoMessage()    {}
+func (*InvoiceAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_e59db58491f6e838, []int{11}
+}
+func (m *InvoiceAttestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvoiceAttestation.Unmarshal(m, b)
+}
+func (m *InvoiceAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvoiceAttestation.Marshal(b, m, deterministic)
+}
+func (dst *InvoiceAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvoiceAttestation.Merge(dst, src)
+}
+func (m *InvoiceAttestation) XXX_Size() int {
+	return xxx_messageInfo_InvoiceAttestation.Size(m)
+}
+func (m *InvoiceAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvoiceAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvoiceAttestation proto.InternalMessageInfo
+
+func (m *InvoiceAttestation) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *InvoiceAttestation) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *InvoiceAttestation) GetAnchorId() string {
+	if m != nil {
+		return m.AnchorId
+	}
+	return ""
+}
+
+func (m *InvoiceAttestation) GetDocumentRoot() string {
+	if m != nil {
+		return m.DocumentRoot
+	}
+	return ""
+}
+
+func (m *InvoiceAttestation) GetInvoiceStatus() string {
+	if m != nil {
+		return m.InvoiceStatus
+	}
+	return ""
+}
+
+func (m *InvoiceAttestation) GetGrossAmount() string {
+	if m != nil {
+		return m.GrossAmount
+	}
+	return ""
+}
+
+func (m *InvoiceAttestation) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *InvoiceAttestation) GetDueDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.DueDate
+	}
+	return nil
+}
+
+func (m *InvoiceAttestation) GetFieldProofs() []*document.Proof {
+	if m != nil {
+		return m.FieldProofs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetRequest)(nil), "invoice.GetRequest")
 	proto.RegisterType((*GetVersionRequest)(nil), "invoice.GetVersionRequest")
@@ -566,6 +944,11 @@ func init() {
 	proto.RegisterType((*InvoiceResponse)(nil), "invoice.InvoiceResponse")
 	proto.RegisterType((*ResponseHeader)(nil), "invoice.ResponseHeader")
 	proto.RegisterType((*InvoiceData)(nil), "invoice.InvoiceData")
+	proto.RegisterType((*LineItem)(nil), "invoice.LineItem")
+	proto.RegisterType((*ExportUBLRequest)(nil), "invoice.ExportUBLRequest")
+	proto.RegisterType((*UBLResponse)(nil), "invoice.UBLResponse")
+	proto.RegisterType((*UBLImportPayload)(nil), "invoice.UBLImportPayload")
+	proto.RegisterType((*InvoiceAttestation)(nil), "invoice.InvoiceAttestation")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -584,6 +967,9 @@ type DocumentServiceClient interface {
 	Update(ctx context.Context, in *InvoiceUpdatePayload, opts ...grpc.CallOption) (*InvoiceResponse, error)
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	ExportUBL(ctx context.Context, in *ExportUBLRequest, opts ...grpc.CallOption) (*UBLResponse, error)
+	ImportUBL(ctx context.Context, in *UBLImportPayload, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	GetAttestation(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*InvoiceAttestation, error)
 }
 
 type documentServiceClient struct {
@@ -630,12 +1016,42 @@ func (c *documentServiceClient) Get(ctx context.Context, in *GetRequest, opts ..
 	return out, nil
 }
 
+func (c *documentServiceClient) ExportUBL(ctx context.Context, in *ExportUBLRequest, opts ...grpc.CallOption) (*UBLResponse, error) {
+	out := new(UBLResponse)
+	err := c.cc.Invoke(ctx, "/invoice.DocumentService/ExportUBL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ImportUBL(ctx context.Context, in *UBLImportPayload, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, "/invoice.DocumentService/ImportUBL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetAttestation(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*InvoiceAttestation, error) {
+	out := new(InvoiceAttestation)
+	err := c.cc.Invoke(ctx, "/invoice.DocumentService/GetAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DocumentServiceServer is the server API for DocumentService service.
 type DocumentServiceServer interface {
 	Create(context.Context, *InvoiceCreatePayload) (*InvoiceResponse, error)
 	Update(context.Context, *InvoiceUpdatePayload) (*InvoiceResponse, error)
 	GetVersion(context.Context, *GetVersionRequest) (*InvoiceResponse, error)
 	Get(context.Context, *GetRequest) (*InvoiceResponse, error)
+	ExportUBL(context.Context, *ExportUBLRequest) (*UBLResponse, error)
+	ImportUBL(context.Context, *UBLImportPayload) (*InvoiceResponse, error)
+	GetAttestation(context.Context, *GetRequest) (*InvoiceAttestation, error)
 }
 
 func RegisterDocumentServiceServer(s *grpc.Server, srv DocumentServiceServer) {
@@ -714,6 +1130,60 @@ func _DocumentService_Get_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_ExportUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ExportUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoice.DocumentService/ExportUBL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ExportUBL(ctx, req.(*ExportUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ImportUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UBLImportPayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ImportUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoice.DocumentService/ImportUBL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ImportUBL(ctx, req.(*UBLImportPayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoice.DocumentService/GetAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetAttestation(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DocumentService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "invoice.DocumentService",
 	HandlerType: (*DocumentServiceServer)(nil),
@@ -734,72 +1204,114 @@ var _DocumentService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _DocumentService_Get_Handler,
 		},
+		{
+			MethodName: "ExportUBL",
+			Handler:    _DocumentService_ExportUBL_Handler,
+		},
+		{
+			MethodName: "ImportUBL",
+			Handler:    _DocumentService_ImportUBL_Handler,
+		},
+		{
+			MethodName: "GetAttestation",
+			Handler:    _DocumentService_GetAttestation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "invoice/service.proto",
 }
 
-func init() { proto.RegisterFile("invoice/service.proto", fileDescriptor_service_114606e088e3c0a1) }
-
-var fileDescriptor_service_114606e088e3c0a1 = []byte{
-	// 939 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
-	0x1b, 0x96, 0x93, 0xd4, 0x3f, 0xaf, 0xed, 0xa4, 0x99, 0x3a, 0xcd, 0x66, 0xd5, 0x7e, 0xd9, 0xfa,
-	0x2b, 0xc8, 0x94, 0xd6, 0x96, 0x82, 0x10, 0x02, 0x89, 0x83, 0x34, 0x95, 0x42, 0x0e, 0xa8, 0xa2,
-	0x0d, 0x70, 0xd0, 0x13, 0x6b, 0xbc, 0xfb, 0xda, 0x1d, 0xc9, 0x3b, 0xb3, 0xcc, 0x8c, 0x43, 0xdc,
-	0xaa, 0x27, 0x08, 0x71, 0x01, 0x81, 0x3b, 0xe1, 0x52, 0xb8, 0x05, 0xae, 0x81, 0x63, 0x34, 0x3f,
-	0xeb, 0xdf, 0x28, 0x81, 0xa3, 0xd5, 0x3c, 0xef, 0xf3, 0xfe, 0x3c, 0xf3, 0xf3, 0x2c, 0xec, 0x31,
-	0x7e, 0x29, 0x58, 0x82, 0x3d, 0x85, 0xf2, 0x92, 0x25, 0xd8, 0xcd, 0xa5, 0xd0, 0x82, 0x54, 0x3c,
-	0x1c, 0x3e, 0x1a, 0x09, 0x31, 0x1a, 0x63, 0x8f, 0xe6, 0xac, 0x47, 0x39, 0x17, 0x9a, 0x6a, 0x26,
-	0xb8, 0x72, 0xb4, 0xf0, 0xd0, 0x47, 0xed, 0x6a, 0x30, 0x19, 0xf6, 0x34, 0xcb, 0x50, 0x69, 0x9a,
-	0xe5, 0x9e, 0xf0, 0xdc, 0x7e, 0x92, 0x17, 0x23, 0xe4, 0x2f, 0xd4, 0x4f, 0x74, 0x34, 0x42, 0xd9,
-	0x13, 0xb9, 0x2d, 0xb1, 0x5e, 0xae, 0xfd, 0x1c, 0xe0, 0x14, 0x75, 0x8c, 0x3f, 0x4e, 0x50, 0x69,
-	0xf2, 0x3f, 0x00, 0x96, 0x22, 0xd7, 0x6c, 0xc8, 0x50, 0x06, 0xa5, 0xa8, 0xd4, 0xa9, 0xc5, 0x0b,
-	0x48, 0xfb, 0x5b, 0xd8, 0x3d, 0x45, 0xfd, 0x03, 0x4a, 0xc5, 0x04, 0xff, 0x97, 0x49, 0x24, 0x80,
-	0xca, 0xa5, 0xcb, 0x08, 0x36, 0x6c, 0xb0, 0x58, 0xb6, 0x87, 0xd0, 0x3a, 0x73, 0xa2, 0x4f, 0x24,
-	0x52, 0x8d, 0xe7, 0x74, 0x3a, 0x16, 0x34, 0x25, 0x4f, 0xa1, 0x99, 0x88, 0xf1, 0x98, 0x0e, 0x84,
-	0xa4, 0x5a, 0x48, 0x15, 0x94, 0xa2, 0xcd, 0x4e, 0x2d, 0x5e, 0x06, 0x49, 0x07, 0xb6, 0x52, 0xaa,
-	0xa9, 0x2d, 0x5a, 0x3f, 0x6a, 0x75, 0xfd, 0xfe, 0x75, 0x7d, 0xc9, 0x57, 0x54, 0xd3, 0xd8, 0x32,
-	0xda, 0xbf, 0x96, 0x66, 0x8d, 0xbe, 0xcf, 0xd3, 0x85, 0x46, 0x77, 0x8d, 0xbe, 0x36, 0xc8, 0xc6,
-	0x6d, 0x83, 0x6c, 0xde, 0x39, 0xc8, 0x18, 0x76, 0x3c, 0x18, 0xa3, 0xca, 0x05, 0x57, 0x48, 0x7a,
-	0x50, 0x7e, 0x8b, 0x34, 0xf5, 0xed, 0xeb, 0x47, 0xfb, 0xb3, 0xf4, 0x82, 0xf2, 0x8d, 0x0d, 0xc7,
-	0x9e, 0xf6, 0x1f, 0x64, 0xff, 0x51, 0x82, 0xed, 0xe5, 0x22, 0xe4, 0x10, 0xea, 0xa9, 0x48, 0x26,
-	0x19, 0x72, 0xdd, 0x67, 0x69, 0xa1, 0xb8, 0x80, 0xce, 0x52, 0xf2, 0x18, 0xc0, 0x9f, 0x8e, 0x89,
-	0xbb, 0xf3, 0xaa, 0x79, 0xe4, 0x2c, 0x25, 0x2d, 0xb8, 0xa7, 0x34, 0xd5, 0x68, 0xb5, 0xd6, 0x62,
-	0xb7, 0x58, 0xdf, 0xa6, 0xad, 0x9b, 0xb6, 0xe9, 0x23, 0xd8, 0xd6, 0x92, 0x72, 0x45, 0x13, 0xed,
-	0xcb, 0xdf, 0xb3, 0x45, 0x9a, 0x0b, 0xe8, 0x59, 0xda, 0xfe, 0xbb, 0x0c, 0xf5, 0x05, 0x2d, 0x26,
-	0xcd, 0x4b, 0xec, 0x9b, 0x6e, 0x13, 0x15, 0x1c, 0xb8, 0x34, 0x8f, 0x5e, 0x58, 0x70, 0x91, 0xc6,
-	0x27, 0xd9, 0x60, 0x76, 0x9c, 0x05, 0xed, 0xb5, 0x05, 0xcd, 0x06, 0x28, 0xe4, 0x29, 0xca, 0x3e,
-	0xa7, 0x59, 0x21, 0x03, 0x1c, 0xf4, 0x9a, 0x66, 0x48, 0xfe, 0x0f, 0x4d, 0x4f, 0x50, 0x5a, 0x22,
-	0xea, 0x60, 0xcb, 0x52, 0x1a, 0x0e, 0xbc, 0xb0, 0xd8, 0x42, 0x95, 0x84, 0xe9, 0xa9, 0xd7, 0xe1,
-	0xab, 0x9c, 0x30, 0x3d, 0x35, 0xd3, 0x78, 0xc2, 0x3b, 0x96, 0x27, 0x22, 0xc5, 0xa0, 0xec, 0xa6,
-	0x71, 0xe8, 0x1b, 0x07, 0x2e, 0xd0, 0x12, 0x31, 0xe1, 0x5a, 0x4e, 0x83, 0xca, 0x22, 0xed, 0xc4,
-	0x81, 0x86, 0x26, 0x31, 0x61, 0x39, 0x33, 0xc7, 0x66, 0xe7, 0xae, 0x3a, 0xda, 0x0c, 0xb5, 0xa3,
-	0x7f, 0x02, 0xf7, 0xe7, 0x34, 0x3f, 0x7d, 0xcd, 0x12, 0x77, 0x66, 0xb8, 0x17, 0xb0, 0x54, 0xd1,
-	0x6a, 0x80, 0x95, 0x8a, 0x56, 0xc6, 0xa7, 0xb0, 0x3b, 0xa7, 0x15, 0x4a, 0xea, 0x96, 0x39, 0x6f,
-	0x55, 0x88, 0x59, 0x22, 0x17, 0x7a, 0x1a, 0x2b, 0xe4, 0x42, 0x52, 0x08, 0xd5, 0x64, 0x22, 0x25,
-	0xf2, 0x64, 0x1a, 0x34, 0x2d, 0x67, 0xb6, 0x26, 0x4f, 0xa0, 0x31, 0x92, 0x42, 0xa9, 0x3e, 0xcd,
-	0x0c, 0x3b, 0xd8, 0x8e, 0x4a, 0x9d, 0xcd, 0xb8, 0x6e, 0xb1, 0x63, 0x0b, 0x99, 0x6b, 0xca, 0x51,
-	0x17, 0x84, 0x1d, 0x4b, 0xa8, 0x71, 0xd4, 0xf3, 0xb0, 0xa6, 0x57, 0x45, 0xf8, 0xbe, 0x0b, 0x6b,
-	0x7a, 0xe5, 0xc3, 0x07, 0x50, 0x35, 0x61, 0x69, 0x2e, 0xf2, 0xae, 0x0d, 0x56, 0x34, 0xbd, 0x8a,
-	0xcd, 0x55, 0x7e, 0x04, 0xb5, 0xd9, 0xac, 0x01, 0x71, 0xd7, 0x7f, 0x06, 0x90, 0x87, 0x50, 0x76,
-	0x27, 0x13, 0x3c, 0xb0, 0x21, 0xbf, 0x32, 0xcf, 0x22, 0xa7, 0x53, 0xc4, 0xa0, 0xe5, 0x9e, 0x85,
-	0x5d, 0x18, 0xe3, 0x4b, 0x44, 0x66, 0x1e, 0x56, 0xb0, 0xe7, 0x8c, 0xcf, 0x2f, 0xc9, 0xe7, 0x50,
-	0x4d, 0x27, 0xd8, 0x37, 0x56, 0x14, 0x3c, 0xb4, 0xef, 0x38, 0xec, 0x3a, 0x5f, 0xef, 0x16, 0xbe,
-	0xde, 0xfd, 0xae, 0xf0, 0xf5, 0xb8, 0x92, 0x4e, 0xcc, 0x53, 0x40, 0xf2, 0x35, 0x34, 0x4c, 0x4a,
-	0x3f, 0xb1, 0x6e, 0x99, 0x06, 0xfb, 0x77, 0xa6, 0xd6, 0x0d, 0xdf, 0x99, 0xab, 0x7d, 0xdb, 0x78,
-	0xa5, 0x25, 0xed, 0x5b, 0xff, 0x08, 0x9c, 0x38, 0x8b, 0x98, 0x87, 0x76, 0xf4, 0xcb, 0x16, 0xec,
-	0xbc, 0xf2, 0x4e, 0x70, 0xe1, 0x7e, 0x4d, 0x24, 0x83, 0xb2, 0xcb, 0x26, 0x8f, 0x57, 0x8d, 0x66,
-	0xc9, 0xb2, 0xc3, 0x60, 0x35, 0x5c, 0x18, 0x4f, 0xfb, 0xd9, 0xf5, 0x71, 0x2b, 0x24, 0x8e, 0xad,
-	0x22, 0xca, 0x23, 0x4f, 0xfc, 0xf9, 0xcf, 0xbf, 0x7e, 0xdb, 0x68, 0xb6, 0xab, 0x3d, 0xbf, 0xfe,
-	0xaa, 0xf4, 0x8c, 0xbc, 0x83, 0xb2, 0x33, 0xe8, 0xf5, 0x76, 0x4b, 0xc6, 0x7d, 0x4b, 0xbb, 0x2f,
-	0x6c, 0x3b, 0xc7, 0x5e, 0x6b, 0x17, 0x86, 0x7b, 0x45, 0xbb, 0xde, 0xfb, 0xb9, 0xcf, 0x7f, 0x30,
-	0xbd, 0x7f, 0x2f, 0xd9, 0x5f, 0xa1, 0xff, 0xb9, 0x91, 0x70, 0xd6, 0x61, 0xed, 0x8f, 0x77, 0x4b,
-	0xf7, 0xf3, 0xeb, 0xe3, 0x8f, 0xc3, 0xa7, 0xa7, 0xa8, 0x23, 0x1a, 0xa9, 0x1c, 0x13, 0x36, 0x64,
-	0x49, 0xe4, 0xed, 0x33, 0x12, 0xc3, 0xd5, 0x79, 0x9e, 0x90, 0xc3, 0x1b, 0xe7, 0xe9, 0xbd, 0xf7,
-	0x39, 0x1f, 0x88, 0x80, 0xcd, 0x53, 0xd4, 0xe4, 0xc1, 0xe2, 0x38, 0x77, 0xcf, 0xf1, 0xe5, 0xf5,
-	0xf1, 0x41, 0xb8, 0x6f, 0xe6, 0xd0, 0x6f, 0x31, 0x72, 0x2f, 0x4b, 0x2f, 0xb5, 0xde, 0x27, 0x37,
-	0x6f, 0xc5, 0xcb, 0x0e, 0xd4, 0x13, 0x91, 0x15, 0x95, 0x5f, 0x36, 0xfc, 0x55, 0x38, 0x37, 0x97,
-	0xeb, 0xbc, 0xf4, 0xa6, 0xe6, 0x03, 0xf9, 0x60, 0x50, 0xb6, 0x17, 0xee, 0xb3, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x0c, 0x8d, 0xd7, 0x8a, 0xd1, 0x08, 0x00, 0x00,
+func init() { proto.RegisterFile("invoice/service.proto", fileDescriptor_service_e59db58491f6e838) }
+
+var fileDescriptor_service_e59db58491f6e838 = []byte{
+	// 1424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4b, 0x6f, 0x1c, 0xc5,
+	0x16, 0x56, 0xfb, 0x31, 0x9e, 0xa9, 0xf1, 0xb3, 0xe2, 0xc4, 0xed, 0x4e, 0x72, 0xd3, 0xe9, 0xe4,
+	0x5e, 0x39, 0x89, 0x33, 0x93, 0x3b, 0x57, 0x57, 0x08, 0x24, 0x16, 0xe3, 0x04, 0x99, 0x41, 0x26,
+	0xb2, 0x26, 0x18, 0x89, 0x48, 0x68, 0x54, 0xee, 0x3e, 0xe3, 0x94, 0x34, 0xdd, 0xd5, 0xa9, 0xaa,
+	0x4e, 0xec, 0x84, 0x6c, 0xf8, 0x01, 0x2c, 0x0c, 0x3b, 0x96, 0x6c, 0x22, 0xb6, 0xfc, 0x0b, 0xb6,
+	0xec, 0x58, 0x23, 0xb1, 0xe2, 0x3f, 0xa0, 0x7a, 0x74, 0x4f, 0xcf, 0x03, 0xdb, 0x81, 0x95, 0xa7,
+	0xbe, 0xf3, 0xd5, 0xa9, 0x73, 0x4e, 0x9f, 0xf3, 0x55, 0x19, 0x5d, 0xa6, 0xc9, 0x0b, 0x46, 0x43,
+	0x68, 0x0a, 0xe0, 0x2f, 0x68, 0x08, 0x8d, 0x94, 0x33, 0xc9, 0xf0, 0x82, 0x85, 0xbd, 0x2b, 0x11,
+	0x0b, 0xb3, 0x18, 0x12, 0x39, 0x4a, 0xf0, 0xae, 0x1d, 0x31, 0x76, 0x34, 0x80, 0x26, 0x49, 0x69,
+	0x93, 0x24, 0x09, 0x93, 0x44, 0x52, 0x96, 0x08, 0x6b, 0xbd, 0x61, 0xad, 0x7a, 0x75, 0x98, 0xf5,
+	0x9b, 0x92, 0xc6, 0x20, 0x24, 0x89, 0x53, 0x4b, 0xd8, 0xd6, 0x7f, 0xc2, 0xfb, 0x47, 0x90, 0xdc,
+	0x17, 0x2f, 0xc9, 0xd1, 0x11, 0xf0, 0x26, 0x4b, 0xb5, 0x8b, 0x49, 0x77, 0xc1, 0x36, 0x42, 0xbb,
+	0x20, 0xbb, 0xf0, 0x3c, 0x03, 0x21, 0xf1, 0xbf, 0x10, 0xa2, 0x11, 0x24, 0x92, 0xf6, 0x29, 0x70,
+	0xd7, 0xf1, 0x9d, 0xad, 0x5a, 0xb7, 0x84, 0x04, 0x9f, 0xa2, 0xb5, 0x5d, 0x90, 0x9f, 0x03, 0x17,
+	0x94, 0x25, 0x17, 0xdc, 0x84, 0x5d, 0xb4, 0xf0, 0xc2, 0xec, 0x70, 0x67, 0xb4, 0x31, 0x5f, 0x06,
+	0x3f, 0x3a, 0x68, 0xbd, 0x63, 0xaa, 0xf1, 0x90, 0x03, 0x91, 0xb0, 0x4f, 0x4e, 0x06, 0x8c, 0x44,
+	0xf8, 0x36, 0x5a, 0x0a, 0xd9, 0x60, 0x40, 0x0e, 0x19, 0x27, 0x92, 0x71, 0xe1, 0x3a, 0xfe, 0xec,
+	0x56, 0xad, 0x3b, 0x0a, 0xe2, 0x2d, 0x34, 0x17, 0x11, 0x49, 0xb4, 0xd7, 0x7a, 0x6b, 0xbd, 0x61,
+	0x0b, 0xdb, 0xb0, 0x2e, 0x1f, 0x11, 0x49, 0xba, 0x9a, 0x81, 0x6f, 0xa0, 0x3a, 0x07, 0x12, 0xf5,
+	0x48, 0x18, 0x82, 0x10, 0xee, 0xac, 0xf6, 0x86, 0x14, 0xd4, 0xd6, 0x08, 0xbe, 0x89, 0x16, 0x5f,
+	0x72, 0x2a, 0x21, 0x67, 0xcc, 0x69, 0x46, 0x5d, 0x63, 0x86, 0x12, 0xfc, 0x3c, 0x0c, 0xf6, 0x20,
+	0x8d, 0x4a, 0xc1, 0x9e, 0x97, 0xff, 0x44, 0x32, 0x33, 0x67, 0x25, 0x33, 0xfb, 0xae, 0xc9, 0xcc,
+	0x9d, 0x9b, 0xcc, 0xfc, 0x64, 0x32, 0x03, 0xb4, 0x62, 0x1d, 0x77, 0x41, 0xa4, 0x2c, 0x11, 0x80,
+	0x9b, 0xa8, 0xf2, 0x0c, 0x48, 0x64, 0x53, 0xa8, 0xb7, 0x36, 0x8a, 0x10, 0x72, 0xca, 0xc7, 0xda,
+	0xdc, 0xb5, 0xb4, 0x8b, 0x97, 0x3f, 0xf8, 0xc9, 0x41, 0xcb, 0xa3, 0x4e, 0x54, 0x12, 0x79, 0xfb,
+	0xf7, 0x68, 0x94, 0x57, 0x2d, 0x87, 0x3a, 0x11, 0xbe, 0x8e, 0x90, 0x6d, 0x13, 0x65, 0x37, 0x8d,
+	0x53, 0xb3, 0x48, 0x27, 0xc2, 0xeb, 0x68, 0x5e, 0x48, 0x22, 0x41, 0xd7, 0xab, 0xd6, 0x35, 0x8b,
+	0xc9, 0x52, 0xcf, 0x4d, 0x2b, 0xf5, 0xbf, 0xd1, 0xb2, 0xe4, 0x24, 0x11, 0x24, 0x94, 0xd6, 0xfd,
+	0xbc, 0x76, 0xb2, 0x54, 0x42, 0x3b, 0x51, 0xf0, 0x76, 0x01, 0xd5, 0x4b, 0xb9, 0xa8, 0x6d, 0x36,
+	0xc5, 0x9e, 0x3a, 0x2d, 0x13, 0xee, 0xa6, 0xd9, 0x66, 0xd1, 0x27, 0x1a, 0x2c, 0xd3, 0x92, 0x2c,
+	0x3e, 0x2c, 0x5a, 0x22, 0xa7, 0x3d, 0xd6, 0xa0, 0x2a, 0x80, 0x80, 0x24, 0x02, 0xde, 0x4b, 0x48,
+	0x9c, 0xa7, 0x81, 0x0c, 0xf4, 0x98, 0xc4, 0x80, 0x6f, 0xa1, 0x25, 0x4b, 0x10, 0x92, 0x03, 0x48,
+	0x77, 0x4e, 0x53, 0x16, 0x0d, 0xf8, 0x44, 0x63, 0x25, 0x2f, 0x21, 0x95, 0x27, 0xee, 0x7c, 0xd9,
+	0xcb, 0x43, 0x2a, 0x4f, 0x54, 0x34, 0x96, 0xf0, 0x8a, 0xa6, 0x21, 0x8b, 0xc0, 0xad, 0x98, 0x68,
+	0x0c, 0xfa, 0xd4, 0x80, 0x25, 0x5a, 0xc8, 0xb2, 0x44, 0xf2, 0x13, 0x77, 0xa1, 0x4c, 0x7b, 0x68,
+	0x40, 0x45, 0xe3, 0x10, 0xd2, 0x94, 0xaa, 0xcf, 0xa6, 0xe3, 0xae, 0x1a, 0x5a, 0x81, 0xea, 0xd0,
+	0xef, 0xa0, 0xd5, 0x21, 0xcd, 0x46, 0x5f, 0xd3, 0xc4, 0x95, 0x02, 0xb7, 0x09, 0x8c, 0x78, 0xd4,
+	0x39, 0xa0, 0x31, 0x8f, 0x3a, 0x8d, 0x7b, 0x68, 0x6d, 0x48, 0xcb, 0x33, 0xa9, 0x6b, 0xe6, 0xf0,
+	0xa8, 0x3c, 0x99, 0x11, 0x72, 0x9e, 0xcf, 0xe2, 0x18, 0x39, 0x4f, 0xc9, 0x43, 0xd5, 0x30, 0xe3,
+	0x1c, 0x92, 0xf0, 0xc4, 0x5d, 0xd2, 0x9c, 0x62, 0xad, 0x06, 0xe9, 0x88, 0x33, 0x21, 0x7a, 0x24,
+	0x56, 0x6c, 0x77, 0x59, 0xdb, 0xeb, 0x1a, 0x6b, 0x6b, 0x48, 0xb5, 0x69, 0x02, 0x32, 0x27, 0xac,
+	0x98, 0x36, 0x4d, 0x40, 0x0e, 0xcd, 0x92, 0x1c, 0xe7, 0xe6, 0x55, 0x63, 0x96, 0xe4, 0xd8, 0x9a,
+	0x37, 0x51, 0x55, 0x99, 0xb9, 0x6a, 0xe4, 0x35, 0xdf, 0xd9, 0x9a, 0xed, 0x2e, 0x48, 0x72, 0xdc,
+	0x55, 0xad, 0x7c, 0x0d, 0xd5, 0x8a, 0x58, 0x5d, 0x6c, 0x36, 0x16, 0x00, 0xbe, 0x82, 0x2a, 0xe6,
+	0xcb, 0xb8, 0x97, 0xb4, 0xc9, 0xae, 0xd4, 0x58, 0xa4, 0xe4, 0x04, 0xc0, 0x5d, 0x37, 0x63, 0xa1,
+	0x17, 0x4a, 0x81, 0x43, 0x16, 0xab, 0xc1, 0x72, 0x2f, 0x1b, 0x05, 0xb6, 0x4b, 0xfc, 0x7f, 0x54,
+	0x8d, 0x32, 0xe8, 0x29, 0x39, 0x73, 0xaf, 0xe8, 0x39, 0xf6, 0x1a, 0xe6, 0x82, 0x69, 0xe4, 0x17,
+	0x4c, 0xe3, 0xb3, 0xfc, 0x82, 0xe9, 0x2e, 0x44, 0x99, 0x1a, 0x05, 0xc0, 0x1f, 0xa2, 0x45, 0xb5,
+	0xa5, 0x17, 0x6a, 0xd5, 0x8e, 0xdc, 0x8d, 0x73, 0xb7, 0xd6, 0x15, 0xdf, 0x88, 0xbc, 0x9e, 0x6d,
+	0x38, 0x96, 0x9c, 0xf4, 0xb4, 0x7e, 0xb8, 0x26, 0x39, 0x8d, 0xe8, 0x41, 0x7b, 0x80, 0xd0, 0x80,
+	0x26, 0xd0, 0xa3, 0x12, 0x62, 0xe1, 0x7a, 0xfe, 0xec, 0x56, 0xbd, 0xb5, 0x56, 0xc8, 0xcb, 0x1e,
+	0x4d, 0xa0, 0x23, 0x21, 0xee, 0xd6, 0x06, 0xf6, 0x97, 0x08, 0x7e, 0x75, 0x50, 0x35, 0xc7, 0xd5,
+	0x4c, 0xa8, 0x9d, 0xa3, 0xd3, 0x87, 0x14, 0x64, 0x47, 0xcf, 0x47, 0xf5, 0x08, 0x44, 0xc8, 0xa9,
+	0xbe, 0x15, 0xad, 0xb6, 0x94, 0x21, 0xd5, 0x14, 0xcf, 0x33, 0x92, 0x48, 0xd5, 0x8f, 0x66, 0x32,
+	0x8b, 0xb5, 0x0a, 0x3e, 0x4b, 0xa8, 0xec, 0xa5, 0x9c, 0x86, 0x60, 0x87, 0xb2, 0xa6, 0x90, 0x7d,
+	0x05, 0xa8, 0x2f, 0x63, 0xbf, 0xb6, 0x19, 0x46, 0xbb, 0x1a, 0xeb, 0x84, 0xca, 0x59, 0x9d, 0x60,
+	0x46, 0x2f, 0xef, 0x84, 0xa0, 0x85, 0x56, 0x3f, 0x3a, 0x4e, 0x19, 0x97, 0x07, 0x3b, 0x7b, 0x17,
+	0xbd, 0xa8, 0xbf, 0x40, 0x75, 0xcd, 0xb6, 0xda, 0xfe, 0xb7, 0xaf, 0x68, 0xbc, 0x8a, 0x66, 0xb3,
+	0xc3, 0x81, 0x2d, 0x82, 0xfa, 0x19, 0x7c, 0x82, 0x56, 0x0f, 0x76, 0xf6, 0x3a, 0xb1, 0x8a, 0xe8,
+	0xdd, 0xee, 0x6b, 0xeb, 0x6b, 0x66, 0xe8, 0xeb, 0xf7, 0x19, 0x84, 0xad, 0xc4, 0xb6, 0xa5, 0x54,
+	0xad, 0xa2, 0xcb, 0xff, 0x4f, 0x2f, 0x87, 0xab, 0xa8, 0x46, 0x92, 0xf0, 0x19, 0xe3, 0xca, 0x6a,
+	0xbf, 0x9f, 0x01, 0x3a, 0x91, 0xd2, 0xd5, 0xc2, 0x39, 0x67, 0xac, 0xd0, 0xd5, 0x1c, 0xec, 0x32,
+	0x26, 0xa7, 0x68, 0xfd, 0xfc, 0x34, 0xad, 0x1f, 0x17, 0x88, 0xca, 0xa4, 0x40, 0x94, 0xf5, 0x65,
+	0x61, 0x4c, 0x5f, 0xca, 0xd3, 0x57, 0xbd, 0xf8, 0xf4, 0xb5, 0xd0, 0x62, 0x9f, 0xc2, 0x20, 0xea,
+	0xa5, 0x9c, 0xb1, 0xbe, 0x70, 0x6b, 0x7a, 0x42, 0x56, 0x1a, 0x79, 0x06, 0x8d, 0x7d, 0x85, 0x77,
+	0xeb, 0x9a, 0xa4, 0x7f, 0x8b, 0xd6, 0x0f, 0x55, 0xb4, 0xf2, 0xc8, 0xda, 0x9f, 0x98, 0xe7, 0x26,
+	0x8e, 0x51, 0xc5, 0x4c, 0x24, 0xbe, 0x3e, 0x7e, 0x79, 0x8f, 0x3c, 0xc7, 0x3c, 0x77, 0xdc, 0x9c,
+	0x37, 0x56, 0x70, 0xf7, 0xb4, 0xbd, 0xee, 0x61, 0xc3, 0x16, 0x3e, 0x49, 0x7c, 0x4b, 0xfc, 0xfa,
+	0x97, 0xdf, 0xbe, 0x9d, 0x59, 0x0a, 0xaa, 0x4d, 0xbb, 0xfe, 0xc0, 0xb9, 0x8b, 0x5f, 0xa1, 0x8a,
+	0x79, 0x38, 0x4d, 0x1e, 0x37, 0xf2, 0xa0, 0x3a, 0xe3, 0xb8, 0xf7, 0xf4, 0x71, 0x86, 0x3d, 0x71,
+	0x9c, 0xe7, 0x5d, 0xce, 0x8f, 0x6b, 0xbe, 0x1e, 0x36, 0xf7, 0x1b, 0x75, 0xf6, 0x77, 0x0e, 0x42,
+	0xc3, 0x97, 0x2b, 0xf6, 0x8a, 0x13, 0x26, 0x9e, 0xb3, 0x67, 0x9c, 0xbe, 0x7f, 0xda, 0xfe, 0x8f,
+	0x77, 0x7b, 0x17, 0xa4, 0x4f, 0x7c, 0x91, 0x42, 0x48, 0xfb, 0x34, 0xf4, 0x6d, 0xd7, 0xf9, 0xac,
+	0x3f, 0x1e, 0xcf, 0x4d, 0x7c, 0x63, 0x6a, 0x3c, 0xcd, 0xd7, 0x76, 0xcf, 0x1b, 0xcc, 0xd0, 0xec,
+	0x2e, 0x48, 0x7c, 0xa9, 0x1c, 0xce, 0xf9, 0x71, 0xbc, 0x7f, 0xda, 0xde, 0xf4, 0x36, 0x54, 0x1c,
+	0xf2, 0x19, 0xf8, 0xa6, 0x9b, 0xe4, 0xc8, 0xd1, 0x1b, 0x78, 0x7a, 0x29, 0xf0, 0xf7, 0x0e, 0xaa,
+	0x15, 0x62, 0x82, 0x37, 0x8b, 0x23, 0xc6, 0x05, 0xc6, 0x1b, 0x3e, 0xe7, 0x4a, 0x3a, 0x12, 0x7c,
+	0x79, 0xda, 0x6e, 0x79, 0x0f, 0x0c, 0x59, 0x4c, 0x3b, 0xdd, 0x27, 0xc2, 0x27, 0xfe, 0xc1, 0xce,
+	0x9e, 0xdf, 0x6a, 0xfc, 0x77, 0x24, 0xa4, 0x00, 0xfb, 0xd3, 0xab, 0x01, 0xda, 0x5b, 0x33, 0x3b,
+	0x1c, 0xe0, 0x6f, 0x1c, 0x54, 0xeb, 0xc4, 0x93, 0xd1, 0x8d, 0xeb, 0xcd, 0x19, 0xb5, 0xd9, 0x3b,
+	0x6d, 0xdf, 0xf3, 0xee, 0x4c, 0x36, 0xa4, 0xdf, 0xe7, 0x2c, 0xfe, 0x8b, 0xd0, 0xdc, 0xe0, 0x52,
+	0x11, 0x1a, 0x8d, 0xf3, 0x68, 0x54, 0xdb, 0xfc, 0xe1, 0xa0, 0xe5, 0x5d, 0x90, 0x65, 0x6d, 0x9a,
+	0xfa, 0xad, 0xae, 0x8e, 0xc7, 0x53, 0xda, 0x11, 0xbc, 0x75, 0x4e, 0xdb, 0x5f, 0x79, 0xaf, 0xf2,
+	0xef, 0x45, 0x86, 0x26, 0xd5, 0x34, 0x0a, 0x32, 0x12, 0xb3, 0xed, 0x1b, 0x15, 0xf1, 0x49, 0x12,
+	0xf9, 0x51, 0x06, 0xbe, 0xea, 0xf2, 0x9c, 0x32, 0x50, 0xf9, 0x48, 0xdf, 0xa8, 0x18, 0x44, 0xd3,
+	0xdb, 0x6e, 0xdb, 0xef, 0x33, 0xee, 0x0f, 0x20, 0x89, 0x68, 0x72, 0xe4, 0x9b, 0xff, 0x03, 0xd9,
+	0x40, 0xe8, 0x24, 0x6f, 0xe1, 0x9b, 0xd3, 0xeb, 0x5f, 0x8a, 0x67, 0x67, 0x0b, 0xd5, 0x43, 0x16,
+	0xe7, 0xc9, 0xec, 0x2c, 0x5a, 0xa5, 0xd8, 0x57, 0x9e, 0xf6, 0x9d, 0xa7, 0x35, 0x6b, 0x48, 0x0f,
+	0x0f, 0x2b, 0xda, 0xfb, 0xff, 0xfe, 0x1c, 0x00, 0xe9, 0x4d, 0x6f, 0x3d, 0xe5, 0x0e, 0x00, 0x00,
 }
@@ -36,7 +36,7 @@ func (m *ResponseHeader) Reset()         { *m = ResponseHeader{} }
 func (m *ResponseHeader) String() string { return proto.CompactTextString(m) }
 func (*ResponseHeader) ProtoMessage()    {}
 func (*ResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_10e4a4ecba67c7da, []int{0}
+	return fileDescriptor_service_8005e93a07f0093a, []int{0}
 }
 func (m *ResponseHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResponseHeader.Unmarshal(m, b)
@@ -85,7 +85,7 @@ func (m *NFTMintRequest) Reset()         { *m = NFTMintRequest{} }
 func (m *NFTMintRequest) String() string { return proto.CompactTextString(m) }
 func (*NFTMintRequest) ProtoMessage()    {}
 func (*NFTMintRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_10e4a4ecba67c7da, []int{1}
+	return fileDescriptor_service_8005e93a07f0093a, []int{1}
 }
 func (m *NFTMintRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NFTMintRequest.Unmarshal(m, b)
@@ -166,7 +166,7 @@ func (m *NFTMintResponse) Reset()         { *m = NFTMintResponse{} }
 func (m *NFTMintResponse) String() string { return proto.CompactTextString(m) }
 func (*NFTMintResponse) ProtoMessage()    {}
 func (*NFTMintResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_10e4a4ecba67c7da, []int{2}
+	return fileDescriptor_service_8005e93a07f0093a, []int{2}
 }
 func (m *NFTMintResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NFTMintResponse.Unmarshal(m, b)
@@ -200,10 +200,116 @@ func (m *NFTMintResponse) GetTokenId() string {
 	return ""
 }
 
+type PushToOracleRequest struct {
+	// Document identifier
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// The contract address of the oracle, the oracle of the node config if empty
+	OracleAddress string `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3" json:"oracle_address,omitempty"`
+	// fields to push, such as invoice.status
+	ProofFields          []string `protobuf:"bytes,3,rep,name=proof_fields,json=proofFields,proto3" json:"proof_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushToOracleRequest) Reset()         { *m = PushToOracleRequest{} }
+func (m *PushToOracleRequest) String() string { return proto.CompactTextString(m) }
+func (*PushToOracleRequest) ProtoMessage()    {}
+func (*PushToOracleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_8005e93a07f0093a, []int{3}
+}
+func (m *PushToOracleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushToOracleRequest.Unmarshal(m, b)
+}
+func (m *PushToOracleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushToOracleRequest.Marshal(b, m, deterministic)
+}
+func (dst *PushToOracleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushToOracleRequest.Merge(dst, src)
+}
+func (m *PushToOracleRequest) XXX_Size() int {
+	return xxx_messageInfo_PushToOracleRequest.Size(m)
+}
+func (m *PushToOracleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushToOracleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushToOracleRequest proto.InternalMessageInfo
+
+func (m *PushToOracleRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *PushToOracleRequest) GetOracleAddress() string {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return ""
+}
+
+func (m *PushToOracleRequest) GetProofFields() []string {
+	if m != nil {
+		return m.ProofFields
+	}
+	return nil
+}
+
+type PushToOracleResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// version of the document the fields are pushed from
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushToOracleResponse) Reset()         { *m = PushToOracleResponse{} }
+func (m *PushToOracleResponse) String() string { return proto.CompactTextString(m) }
+func (*PushToOracleResponse) ProtoMessage()    {}
+func (*PushToOracleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_8005e93a07f0093a, []int{4}
+}
+func (m *PushToOracleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushToOracleResponse.Unmarshal(m, b)
+}
+func (m *PushToOracleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushToOracleResponse.Marshal(b, m, deterministic)
+}
+func (dst *PushToOracleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushToOracleResponse.Merge(dst, src)
+}
+func (m *PushToOracleResponse) XXX_Size() int {
+	return xxx_messageInfo_PushToOracleResponse.Size(m)
+}
+func (m *PushToOracleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushToOracleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushToOracleResponse proto.InternalMessageInfo
+
+func (m *PushToOracleResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *PushToOracleResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ResponseHeader)(nil), "nft.ResponseHeader")
 	proto.RegisterType((*NFTMintRequest)(nil), "nft.NFTMintRequest")
 	proto.RegisterType((*NFTMintResponse)(nil), "nft.NFTMintResponse")
+	proto.RegisterType((*PushToOracleRequest)(nil), "nft.PushToOracleRequest")
+	proto.RegisterType((*PushToOracleResponse)(nil), "nft.PushToOracleResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -219,6 +325,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type NFTServiceClient interface {
 	MintNFT(ctx context.Context, in *NFTMintRequest, opts ...grpc.CallOption) (*NFTMintResponse, error)
+	PushToOracle(ctx context.Context, in *PushToOracleRequest, opts ...grpc.CallOption) (*PushToOracleResponse, error)
 }
 
 type nFTServiceClient struct {
@@ -238,9 +345,19 @@ func (c *nFTServiceClient) MintNFT(ctx context.Context, in *NFTMintRequest, opts
 	return out, nil
 }
 
+func (c *nFTServiceClient) PushToOracle(ctx context.Context, in *PushToOracleRequest, opts ...grpc.CallOption) (*PushToOracleResponse, error) {
+	out := new(PushToOracleResponse)
+	err := c.cc.Invoke(ctx, "/nft.NFTService/PushToOracle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NFTServiceServer is the server API for NFTService service.
 type NFTServiceServer interface {
 	MintNFT(context.Context, *NFTMintRequest) (*NFTMintResponse, error)
+	PushToOracle(context.Context, *PushToOracleRequest) (*PushToOracleResponse, error)
 }
 
 func RegisterNFTServiceServer(s *grpc.Server, srv NFTServiceServer) {
@@ -265,6 +382,24 @@ func _NFTService_MintNFT_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NFTService_PushToOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushToOracleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NFTServiceServer).PushToOracle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nft.NFTService/PushToOracle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NFTServiceServer).PushToOracle(ctx, req.(*PushToOracleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NFTService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nft.NFTService",
 	HandlerType: (*NFTServiceServer)(nil),
@@ -273,44 +408,57 @@ var _NFTService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MintNFT",
 			Handler:    _NFTService_MintNFT_Handler,
 		},
+		{
+			MethodName: "PushToOracle",
+			Handler:    _NFTService_PushToOracle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nft/service.proto",
 }
 
-func init() { proto.RegisterFile("nft/service.proto", fileDescriptor_service_10e4a4ecba67c7da) }
-
-var fileDescriptor_service_10e4a4ecba67c7da = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x6e, 0x13, 0x3d,
-	0x10, 0x80, 0x95, 0xe4, 0x6f, 0x93, 0x3a, 0xfd, 0x93, 0x60, 0x38, 0xa4, 0x11, 0xa0, 0x25, 0x12,
-	0x10, 0xa0, 0xcd, 0x4a, 0xe5, 0x80, 0xc4, 0x89, 0x14, 0x14, 0xd1, 0x03, 0x4b, 0xb4, 0xe4, 0x02,
-	0x97, 0x95, 0xb3, 0x1e, 0x2f, 0x16, 0xcd, 0x78, 0xb1, 0x27, 0x44, 0x5c, 0x91, 0x78, 0x01, 0x78,
-	0x22, 0x9e, 0x81, 0x57, 0xe0, 0x41, 0xd0, 0xda, 0xdb, 0xaa, 0x11, 0xa7, 0xd5, 0x7e, 0xf3, 0xcd,
-	0xd8, 0x9e, 0x19, 0x76, 0x03, 0x15, 0xc5, 0x0e, 0xec, 0x17, 0x9d, 0xc3, 0xb4, 0xb4, 0x86, 0x0c,
-	0x6f, 0xa1, 0xa2, 0xd1, 0xed, 0xc2, 0x98, 0xe2, 0x02, 0x62, 0x51, 0xea, 0x58, 0x20, 0x1a, 0x12,
-	0xa4, 0x0d, 0xba, 0xa0, 0x8c, 0x8e, 0xfd, 0x27, 0x3f, 0x29, 0x00, 0x4f, 0xdc, 0x56, 0x14, 0x05,
-	0xd8, 0xd8, 0x94, 0xde, 0xf8, 0xd7, 0x1e, 0x3f, 0x63, 0xbd, 0x14, 0x5c, 0x69, 0xd0, 0xc1, 0x6b,
-	0x10, 0x12, 0x2c, 0xbf, 0xcf, 0x7a, 0x64, 0x05, 0x3a, 0x91, 0x57, 0x5e, 0xa6, 0xe5, 0x70, 0x2f,
-	0x6a, 0x4c, 0x0e, 0xd2, 0xff, 0xaf, 0xd1, 0x73, 0x39, 0xfe, 0xd5, 0x64, 0xbd, 0x64, 0xbe, 0x7c,
-	0xa3, 0x91, 0x52, 0xf8, 0xbc, 0x01, 0x47, 0xfc, 0x2e, 0x63, 0x5a, 0x02, 0x92, 0x56, 0x1a, 0xec,
-	0xb0, 0xe1, 0xb3, 0xae, 0x11, 0xfe, 0x88, 0x0d, 0x2c, 0x14, 0xda, 0x91, 0xfd, 0x9a, 0x09, 0x29,
-	0x2d, 0x38, 0x37, 0x6c, 0x7a, 0xab, 0x7f, 0xc9, 0x67, 0x01, 0xf3, 0x87, 0xac, 0x2f, 0xa1, 0x34,
-	0x4e, 0xd3, 0x95, 0xd9, 0xf2, 0x66, 0xaf, 0xc6, 0x97, 0xe2, 0x3d, 0x76, 0x58, 0x5a, 0x63, 0x54,
-	0xa6, 0x34, 0x5c, 0x48, 0x37, 0xfc, 0x2f, 0x6a, 0x4d, 0x0e, 0xd2, 0xae, 0x67, 0x73, 0x8f, 0xf8,
-	0x31, 0xe3, 0x6e, 0xb3, 0x5a, 0x6b, 0xca, 0xc8, 0x7c, 0x02, 0xcc, 0x7c, 0xcc, 0x3f, 0xaa, 0x93,
-	0x0e, 0x42, 0x64, 0x59, 0x05, 0x16, 0x15, 0xe7, 0x2f, 0xd8, 0x9d, 0xda, 0x46, 0x45, 0x99, 0xd9,
-	0x22, 0xd8, 0x4c, 0xe4, 0x39, 0x38, 0x57, 0x27, 0xb6, 0x7d, 0xe2, 0x51, 0x90, 0x12, 0x45, 0x6f,
-	0x2b, 0x65, 0xe6, 0x8d, 0x50, 0x61, 0xc2, 0x06, 0x85, 0x15, 0x18, 0x0a, 0x84, 0xd4, 0x61, 0xc7,
-	0x27, 0xf5, 0x3c, 0x4f, 0x14, 0x05, 0x7d, 0xfc, 0x9e, 0xf5, 0xaf, 0x5a, 0x18, 0x66, 0xc0, 0x9f,
-	0xb0, 0xfd, 0x8f, 0x7e, 0x0e, 0xbe, 0x7f, 0xdd, 0xd3, 0x9b, 0x53, 0x54, 0x34, 0xdd, 0x1d, 0x51,
-	0x5a, 0x2b, 0xfc, 0x88, 0x75, 0xc2, 0x93, 0xb4, 0xac, 0x1b, 0xd9, 0xf6, 0xff, 0xe7, 0xf2, 0xf4,
-	0x7b, 0x83, 0xb1, 0x64, 0xbe, 0x7c, 0x17, 0xb6, 0x87, 0x6f, 0x59, 0xbb, 0x3a, 0x26, 0x99, 0x2f,
-	0x79, 0xa8, 0xb8, 0x3b, 0xba, 0xd1, 0xad, 0x5d, 0x18, 0x4e, 0x1b, 0xcf, 0x7e, 0xcc, 0x26, 0xa3,
-	0x07, 0x15, 0x8a, 0x04, 0x46, 0xc9, 0x7c, 0x19, 0x29, 0x6b, 0xd6, 0x91, 0x88, 0x5e, 0x02, 0x92,
-	0xd5, 0x6a, 0x53, 0x40, 0xf4, 0xca, 0xe4, 0x9b, 0x35, 0x20, 0x7d, 0xfb, 0xfd, 0xe7, 0x67, 0x73,
-	0x30, 0xee, 0xc6, 0xfe, 0x06, 0xf1, 0x5a, 0x23, 0x3d, 0x6f, 0x3c, 0x3e, 0x8b, 0x58, 0x3b, 0x37,
-	0xeb, 0xaa, 0xfa, 0xd9, 0x61, 0x7d, 0x99, 0x45, 0xb5, 0x78, 0x8b, 0xc6, 0x87, 0x3d, 0x54, 0x54,
-	0xae, 0x56, 0xfb, 0x7e, 0x11, 0x9f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x7e, 0xdb, 0x53, 0x5a,
-	0xee, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("nft/service.proto", fileDescriptor_service_8005e93a07f0093a) }
+
+var fileDescriptor_service_8005e93a07f0093a = []byte{
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x51, 0x4f, 0x13, 0x4d,
+	0x14, 0xcd, 0xb6, 0x1f, 0x14, 0x06, 0x28, 0xfd, 0x06, 0x1e, 0x96, 0xe6, 0xfb, 0xcc, 0xb8, 0x09,
+	0x5a, 0x11, 0x68, 0x82, 0x0f, 0x26, 0x3c, 0x59, 0x34, 0x8d, 0x3c, 0x58, 0x9a, 0xda, 0x17, 0x4d,
+	0xcc, 0x66, 0xd8, 0xbd, 0xbb, 0x9d, 0xd8, 0xce, 0xac, 0x33, 0xb7, 0x34, 0xc4, 0x07, 0x13, 0x7f,
+	0x02, 0x26, 0xfe, 0x1f, 0x7f, 0x83, 0xaf, 0x3e, 0xf2, 0x43, 0xcc, 0xcc, 0x2c, 0x84, 0x0a, 0x26,
+	0xc6, 0xa7, 0x76, 0xce, 0x3d, 0xf7, 0xce, 0x3d, 0xe7, 0xec, 0x2e, 0xf9, 0x57, 0x66, 0xd8, 0x36,
+	0xa0, 0xcf, 0x44, 0x02, 0xfb, 0x85, 0x56, 0xa8, 0x68, 0x55, 0x66, 0xd8, 0xfc, 0x2f, 0x57, 0x2a,
+	0x1f, 0x43, 0x9b, 0x17, 0xa2, 0xcd, 0xa5, 0x54, 0xc8, 0x51, 0x28, 0x69, 0x3c, 0xa5, 0xb9, 0xeb,
+	0x7e, 0x92, 0xbd, 0x1c, 0xe4, 0x9e, 0x99, 0xf1, 0x3c, 0x07, 0xdd, 0x56, 0x85, 0x63, 0xdc, 0x66,
+	0x47, 0x4f, 0x49, 0x7d, 0x00, 0xa6, 0x50, 0xd2, 0xc0, 0x4b, 0xe0, 0x29, 0x68, 0xba, 0x4d, 0xea,
+	0xa8, 0xb9, 0x34, 0x3c, 0xb1, 0xbc, 0x58, 0xa4, 0xe1, 0x02, 0x0b, 0x5a, 0xcb, 0x83, 0xb5, 0x1b,
+	0xe8, 0x71, 0x1a, 0x7d, 0xab, 0x90, 0x7a, 0xaf, 0x3b, 0x7c, 0x25, 0x24, 0x0e, 0xe0, 0xc3, 0x14,
+	0x0c, 0xd2, 0x7b, 0x84, 0x88, 0x14, 0x24, 0x8a, 0x4c, 0x80, 0x0e, 0x03, 0xd7, 0x75, 0x03, 0xa1,
+	0x8f, 0x48, 0x43, 0x43, 0x2e, 0x0c, 0xea, 0xf3, 0x98, 0xa7, 0xa9, 0x06, 0x63, 0xc2, 0x8a, 0x63,
+	0xad, 0x5f, 0xe1, 0x1d, 0x0f, 0xd3, 0x87, 0x64, 0x3d, 0x85, 0x42, 0x19, 0x81, 0xd7, 0xcc, 0xaa,
+	0x63, 0xd6, 0x4b, 0xf8, 0x8a, 0x78, 0x9f, 0xac, 0x16, 0x5a, 0xa9, 0x2c, 0xce, 0x04, 0x8c, 0x53,
+	0x13, 0xfe, 0xc3, 0xaa, 0xad, 0xe5, 0xc1, 0x8a, 0xc3, 0xba, 0x0e, 0xa2, 0xbb, 0x84, 0x9a, 0xe9,
+	0xe9, 0x44, 0x60, 0x8c, 0xea, 0x3d, 0xc8, 0xd8, 0xd5, 0x9c, 0xa8, 0xa5, 0x41, 0xc3, 0x57, 0x86,
+	0xb6, 0xd0, 0xb7, 0x38, 0x7d, 0x46, 0xfe, 0x2f, 0xd9, 0x32, 0xc3, 0x58, 0xcd, 0x24, 0xe8, 0x98,
+	0x27, 0x09, 0x18, 0x53, 0x36, 0xd6, 0x5c, 0xe3, 0x96, 0x27, 0xf5, 0x32, 0x3c, 0xb1, 0x94, 0x8e,
+	0x63, 0xf8, 0x09, 0x2d, 0xd2, 0xc8, 0x35, 0x97, 0x7e, 0x80, 0x6f, 0x0d, 0x97, 0x5c, 0x53, 0xdd,
+	0xe1, 0xbd, 0x0c, 0x3d, 0x3d, 0x7a, 0x43, 0xd6, 0xaf, 0x2d, 0xf4, 0x19, 0xd0, 0xc7, 0x64, 0x71,
+	0xe4, 0x72, 0x70, 0xfe, 0xad, 0x1c, 0x6c, 0xec, 0xcb, 0x0c, 0xf7, 0xe7, 0x23, 0x1a, 0x94, 0x14,
+	0xba, 0x45, 0x96, 0xbc, 0x24, 0x91, 0x96, 0x46, 0xd6, 0xdc, 0xf9, 0x38, 0x8d, 0x3e, 0x91, 0x8d,
+	0xfe, 0xd4, 0x8c, 0x86, 0xea, 0x44, 0xf3, 0x64, 0x0c, 0x7f, 0x1a, 0xd1, 0x36, 0xa9, 0x2b, 0xd7,
+	0xf0, 0x4b, 0x40, 0x6b, 0x1e, 0xfd, 0x9d, 0xeb, 0xd5, 0x5b, 0xae, 0x47, 0xef, 0xc8, 0xe6, 0xfc,
+	0x02, 0x7f, 0x23, 0x30, 0x24, 0xb5, 0x33, 0xd0, 0x46, 0x28, 0x79, 0xa5, 0xaf, 0x3c, 0x1e, 0x5c,
+	0x56, 0x08, 0xe9, 0x75, 0x87, 0xaf, 0xfd, 0xdb, 0x41, 0x67, 0xa4, 0x66, 0x6d, 0xec, 0x75, 0x87,
+	0xd4, 0x0f, 0x9c, 0x7f, 0x34, 0x9b, 0x9b, 0xf3, 0xa0, 0xbf, 0x2c, 0xea, 0x5c, 0x74, 0x5a, 0xcd,
+	0x07, 0x16, 0x62, 0x5c, 0xb2, 0x5e, 0x77, 0xc8, 0x32, 0xad, 0x26, 0x8c, 0xb3, 0xe7, 0x20, 0x51,
+	0x8b, 0x6c, 0x9a, 0x03, 0x7b, 0xa1, 0x92, 0xe9, 0x04, 0x24, 0x7e, 0xfe, 0x7e, 0xf9, 0xa5, 0xd2,
+	0x88, 0x56, 0xda, 0xce, 0xe1, 0xf6, 0x44, 0x48, 0x3c, 0x0c, 0x76, 0xe8, 0x8f, 0x80, 0xac, 0xde,
+	0xd4, 0x49, 0x43, 0x77, 0xd3, 0x1d, 0xde, 0x37, 0xb7, 0xee, 0xa8, 0x94, 0x8b, 0x7c, 0x0d, 0x2e,
+	0x3a, 0x1f, 0x9b, 0xe7, 0xb6, 0xc6, 0x70, 0x04, 0xec, 0x8c, 0x8f, 0xa7, 0x60, 0x98, 0xca, 0x98,
+	0x37, 0xd8, 0xfe, 0xb3, 0xf8, 0x98, 0x23, 0x18, 0xbb, 0x6c, 0x32, 0x52, 0x1a, 0x52, 0x56, 0xba,
+	0x61, 0xcb, 0x77, 0x2e, 0xcd, 0x66, 0x02, 0xdd, 0x44, 0xa1, 0x99, 0x0b, 0xc7, 0x30, 0x54, 0x56,
+	0xab, 0x8f, 0x93, 0x25, 0x4a, 0xa2, 0xe6, 0x89, 0x17, 0x47, 0xa3, 0xb5, 0x52, 0x9c, 0xaf, 0x1e,
+	0x06, 0x3b, 0x47, 0x8c, 0xd4, 0x12, 0x35, 0xb1, 0x8b, 0x1f, 0xad, 0x96, 0x5e, 0xf7, 0xb5, 0x42,
+	0xd5, 0x0f, 0xde, 0x2e, 0xc8, 0x0c, 0x8b, 0xd3, 0xd3, 0x45, 0xf7, 0x1d, 0x79, 0xf2, 0x73, 0x00,
+	0x59, 0x82, 0xd9, 0xf5, 0xad, 0x04, 0x00, 0x00,
 }
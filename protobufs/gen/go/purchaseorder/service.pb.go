@@ -37,7 +37,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_393a7cd179a9d71e, []int{0}
+	return fileDescriptor_service_bcfc4b5c45317321, []int{0}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
@@ -76,7 +76,7 @@ func (m *GetVersionRequest) Reset()         { *m = GetVersionRequest{} }
 func (m *GetVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetVersionRequest) ProtoMessage()    {}
 func (*GetVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_393a7cd179a9d71e, []int{1}
+	return fileDescriptor_service_bcfc4b5c45317321, []int{1}
 }
 func (m *GetVersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetVersionRequest.Unmarshal(m, b)
@@ -111,18 +111,22 @@ func (m *GetVersionRequest) GetVersion() string {
 }
 
 type PurchaseOrderCreatePayload struct {
-	Collaborators        []string           `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
-	Data                 *PurchaseOrderData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Collaborators []string           `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	Data          *PurchaseOrderData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// collaborators that can only read the document, they don't sign it
+	ReadAccess []string `protobuf:"bytes,3,rep,name=read_access,json=readAccess,proto3" json:"read_access,omitempty"`
+	// collaborators that can read, sign and update the document, in addition to collaborators
+	WriteAccess          []string `protobuf:"bytes,4,rep,name=write_access,json=writeAccess,proto3" json:"write_access,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PurchaseOrderCreatePayload) Reset()         { *m = PurchaseOrderCreatePayload{} }
 func (m *PurchaseOrderCreatePayload) String() string { return proto.CompactTextString(m) }
 func (*PurchaseOrderCreatePayload) ProtoMessage()    {}
 func (*PurchaseOrderCreatePayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_393a7cd179a9d71e, []int{2}
+	return fileDescriptor_service_bcfc4b5c45317321, []int{2}
 }
 func (m *PurchaseOrderCreatePayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurchaseOrderCreatePayload.Unmarshal(m, b)
@@ -156,20 +160,38 @@ func (m *PurchaseOrderCreatePayload) GetData() *PurchaseOrderData {
 	return nil
 }
 
+func (m *PurchaseOrderCreatePayload) GetReadAccess() []string {
+	if m != nil {
+		return m.ReadAccess
+	}
+	return nil
+}
+
+func (m *PurchaseOrderCreatePayload) GetWriteAccess() []string {
+	if m != nil {
+		return m.WriteAccess
+	}
+	return nil
+}
+
 type PurchaseOrderUpdatePayload struct {
-	Identifier           string             `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Collaborators        []string           `protobuf:"bytes,2,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
-	Data                 *PurchaseOrderData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Identifier    string             `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Collaborators []string           `protobuf:"bytes,2,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	Data          *PurchaseOrderData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// collaborators that can only read the document, they don't sign it
+	ReadAccess []string `protobuf:"bytes,4,rep,name=read_access,json=readAccess,proto3" json:"read_access,omitempty"`
+	// collaborators that can read, sign and update the document, in addition to collaborators
+	WriteAccess          []string `protobuf:"bytes,5,rep,name=write_access,json=writeAccess,proto3" json:"write_access,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PurchaseOrderUpdatePayload) Reset()         { *m = PurchaseOrderUpdatePayload{} }
 func (m *PurchaseOrderUpdatePayload) String() string { return proto.CompactTextString(m) }
 func (*PurchaseOrderUpdatePayload) ProtoMessage()    {}
 func (*PurchaseOrderUpdatePayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_393a7cd179a9d71e, []int{3}
+	return fileDescriptor_service_bcfc4b5c45317321, []int{3}
 }
 func (m *PurchaseOrderUpdatePayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurchaseOrderUpdatePayload.Unmarshal(m, b)
@@ -210,6 +232,20 @@ func (m *PurchaseOrderUpdatePayload) GetData() *PurchaseOrderData {
 	return nil
 }
 
+func (m *PurchaseOrderUpdatePayload) GetReadAccess() []string {
+	if m != nil {
+		return m.ReadAccess
+	}
+	return nil
+}
+
+func (m *PurchaseOrderUpdatePayload) GetWriteAccess() []string {
+	if m != nil {
+		return m.WriteAccess
+	}
+	return nil
+}
+
 type PurchaseOrderResponse struct {
 	Header               *ResponseHeader    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Data                 *PurchaseOrderData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -222,7 +258,7 @@ func (m *PurchaseOrderResponse) Reset()         { *m = PurchaseOrderResponse{} }
 func (m *PurchaseOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*PurchaseOrderResponse) ProtoMessage()    {}
 func (*PurchaseOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_393a7cd179a9d71e, []int{4}
+	return fileDescriptor_service_bcfc4b5c45317321, []int{4}
 }
 func (m *PurchaseOrderResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurchaseOrderResponse.Unmarshal(m, b)
@@ -272,7 +308,7 @@ func (m *ResponseHeader) Reset()         { *m = ResponseHeader{} }
 func (m *ResponseHeader) String() string { return proto.CompactTextString(m) }
 func (*ResponseHeader) ProtoMessage()    {}
 func (*ResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_393a7cd179a9d71e, []int{5}
+	return fileDescriptor_service_bcfc4b5c45317321, []int{5}
 }
 func (m *ResponseHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResponseHeader.Unmarshal(m, b)
@@ -349,10 +385,10 @@ type PurchaseOrderData struct {
 	// ISO currency code
 	Currency string `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
 	// ordering gross amount including tax
-	OrderAmount int64 `protobuf:"varint,13,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	OrderAmount string `protobuf:"bytes,13,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
 	// invoice amount excluding tax
-	NetAmount int64  `protobuf:"varint,14,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
-	TaxAmount int64  `protobuf:"varint,15,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	NetAmount string `protobuf:"bytes,14,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	TaxAmount string `protobuf:"bytes,15,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
 	TaxRate   int64  `protobuf:"varint,16,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
 	Recipient string `protobuf:"bytes,17,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Order     string `protobuf:"bytes,18,opt,name=order,proto3" json:"order,omitempty"`
@@ -364,6 +400,8 @@ type PurchaseOrderData struct {
 	// purchase order date
 	DateCreated          *timestamp.Timestamp `protobuf:"bytes,22,opt,name=date_created,json=dateCreated,proto3" json:"date_created,omitempty"`
 	ExtraData            string               `protobuf:"bytes,23,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
+	LineItems            []*LineItem          `protobuf:"bytes,25,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	DeliveryMilestones   []*DeliveryMilestone `protobuf:"bytes,26,rep,name=delivery_milestones,json=deliveryMilestones,proto3" json:"delivery_milestones,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -373,7 +411,7 @@ func (m *PurchaseOrderData) Reset()         { *m = PurchaseOrderData{} }
 func (m *PurchaseOrderData) String() string { return proto.CompactTextString(m) }
 func (*PurchaseOrderData) ProtoMessage()    {}
 func (*PurchaseOrderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_393a7cd179a9d71e, []int{6}
+	return fileDescriptor_service_bcfc4b5c45317321, []int{6}
 }
 func (m *PurchaseOrderData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurchaseOrderData.Unmarshal(m, b)
@@ -484,25 +522,25 @@ func (m *PurchaseOrderData) GetCurrency() string {
 	return ""
 }
 
-func (m *PurchaseOrderData) GetOrderAmount() int64 {
+func (m *PurchaseOrderData) GetOrderAmount() string {
 	if m != nil {
 		return m.OrderAmount
 	}
-	return 0
+	return ""
 }
 
-func (m *PurchaseOrderData) GetNetAmount() int64 {
+func (m *PurchaseOrderData) GetNetAmount() string {
 	if m != nil {
 		return m.NetAmount
 	}
-	return 0
+	return ""
 }
 
-func (m *PurchaseOrderData) GetTaxAmount() int64 {
+func (m *PurchaseOrderData) GetTaxAmount() string {
 	if m != nil {
 		return m.TaxAmount
 	}
-	return 0
+	return ""
 }
 
 func (m *PurchaseOrderData) GetTaxRate() int64 {
@@ -561,6 +599,166 @@ func (m *PurchaseOrderData) GetExtraData() string {
 	return ""
 }
 
+func (m *PurchaseOrderData) GetLineItems() []*LineItem {
+	if m != nil {
+		return m.LineItems
+	}
+	return nil
+}
+
+func (m *PurchaseOrderData) GetDeliveryMilestones() []*DeliveryMilestone {
+	if m != nil {
+		return m.DeliveryMilestones
+	}
+	return nil
+}
+
+// LineItem is a single ordered item of the purchase order, quantities and amounts are decimals like 100.25
+type LineItem struct {
+	ItemNumber  string `protobuf:"bytes,1,opt,name=item_number,json=itemNumber,proto3" json:"item_number,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity    string `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// unit the quantity is measured in, like kg or pcs
+	UnitOfMeasure string `protobuf:"bytes,4,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty"`
+	UnitPrice     string `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// quantity times unit price
+	Amount               string   `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LineItem) Reset()         { *m = LineItem{} }
+func (m *LineItem) String() string { return proto.CompactTextString(m) }
+func (*LineItem) ProtoMessage()    {}
+func (*LineItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_bcfc4b5c45317321, []int{7}
+}
+func (m *LineItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LineItem.Unmarshal(m, b)
+}
+func (m *LineItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LineItem.Marshal(b, m, deterministic)
+}
+func (dst *LineItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LineItem.Merge(dst, src)
+}
+func (m *LineItem) XXX_Size() int {
+	return xxx_messageInfo_LineItem.Size(m)
+}
+func (m *LineItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_LineItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LineItem proto.InternalMessageInfo
+
+func (m *LineItem) GetItemNumber() string {
+	if m != nil {
+		return m.ItemNumber
+	}
+	return ""
+}
+
+func (m *LineItem) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *LineItem) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *LineItem) GetUnitOfMeasure() string {
+	if m != nil {
+		return m.UnitOfMeasure
+	}
+	return ""
+}
+
+func (m *LineItem) GetUnitPrice() string {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return ""
+}
+
+func (m *LineItem) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// DeliveryMilestone is a scheduled delivery of the ordered items
+type DeliveryMilestone struct {
+	// item number of the delivered line item, empty if the milestone covers the whole order
+	ItemNumber  string `protobuf:"bytes,1,opt,name=item_number,json=itemNumber,proto3" json:"item_number,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// delivered quantity, decimal like 10.5
+	Quantity             string               `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	DeliveryDate         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=delivery_date,json=deliveryDate,proto3" json:"delivery_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeliveryMilestone) Reset()         { *m = DeliveryMilestone{} }
+func (m *DeliveryMilestone) String() string { return proto.CompactTextString(m) }
+func (*DeliveryMilestone) ProtoMessage()    {}
+func (*DeliveryMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_bcfc4b5c45317321, []int{8}
+}
+func (m *DeliveryMilestone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeliveryMilestone.Unmarshal(m, b)
+}
+func (m *DeliveryMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeliveryMilestone.Marshal(b, m, deterministic)
+}
+func (dst *DeliveryMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveryMilestone.Merge(dst, src)
+}
+func (m *DeliveryMilestone) XXX_Size() int {
+	return xxx_messageInfo_DeliveryMilestone.Size(m)
+}
+func (m *DeliveryMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveryMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveryMilestone proto.InternalMessageInfo
+
+func (m *DeliveryMilestone) GetItemNumber() string {
+	if m != nil {
+		return m.ItemNumber
+	}
+	return ""
+}
+
+func (m *DeliveryMilestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DeliveryMilestone) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *DeliveryMilestone) GetDeliveryDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.DeliveryDate
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetRequest)(nil), "purchaseorder.GetRequest")
 	proto.RegisterType((*GetVersionRequest)(nil), "purchaseorder.GetVersionRequest")
@@ -569,6 +767,8 @@ func init() {
 	proto.RegisterType((*PurchaseOrderResponse)(nil), "purchaseorder.PurchaseOrderResponse")
 	proto.RegisterType((*ResponseHeader)(nil), "purchaseorder.ResponseHeader")
 	proto.RegisterType((*PurchaseOrderData)(nil), "purchaseorder.PurchaseOrderData")
+	proto.RegisterType((*LineItem)(nil), "purchaseorder.LineItem")
+	proto.RegisterType((*DeliveryMilestone)(nil), "purchaseorder.DeliveryMilestone")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -743,70 +943,82 @@ var _DocumentService_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("purchaseorder/service.proto", fileDescriptor_service_393a7cd179a9d71e)
-}
-
-var fileDescriptor_service_393a7cd179a9d71e = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xd7, 0xe5, 0x8f, 0x63, 0xaf, 0xed, 0xa4, 0xde, 0xb6, 0x70, 0xbd, 0x34, 0xf4, 0x30, 0xad,
-	0x48, 0xdb, 0xc4, 0x96, 0x42, 0xe1, 0x01, 0x09, 0xa1, 0x34, 0x91, 0x42, 0x1e, 0x28, 0xd1, 0x05,
-	0x78, 0xa8, 0x90, 0xac, 0xf5, 0xde, 0xc4, 0x39, 0xc9, 0x77, 0x7b, 0xec, 0xad, 0x83, 0x4d, 0xd5,
-	0x17, 0xc4, 0x17, 0x20, 0xbc, 0x80, 0x90, 0xf8, 0x10, 0xf0, 0x51, 0xf8, 0x0a, 0x7c, 0x05, 0xde,
-	0xd1, 0xce, 0xee, 0xd9, 0x3e, 0xbb, 0x38, 0x09, 0x4f, 0xa7, 0xfd, 0xcd, 0x6f, 0xe6, 0x7e, 0x33,
-	0xb3, 0x3b, 0x43, 0x36, 0xd3, 0x81, 0xe4, 0xe7, 0x2c, 0x03, 0x21, 0x43, 0x90, 0xed, 0x0c, 0xe4,
-	0x45, 0xc4, 0xa1, 0x95, 0x4a, 0xa1, 0x04, 0xad, 0x17, 0x8c, 0xde, 0xfd, 0x9e, 0x10, 0xbd, 0x3e,
-	0xb4, 0x59, 0x1a, 0xb5, 0x59, 0x92, 0x08, 0xc5, 0x54, 0x24, 0x92, 0xcc, 0x90, 0xbd, 0x07, 0xd6,
-	0x8a, 0xa7, 0xee, 0xe0, 0xac, 0xad, 0xa2, 0x18, 0x32, 0xc5, 0xe2, 0xd4, 0x12, 0x76, 0xf0, 0xc3,
-	0x77, 0x7b, 0x90, 0xec, 0x66, 0xdf, 0xb1, 0x5e, 0x0f, 0x64, 0x5b, 0xa4, 0x18, 0x62, 0x3e, 0x5c,
-	0x73, 0x87, 0x90, 0x23, 0x50, 0x01, 0x7c, 0x3b, 0x80, 0x4c, 0xd1, 0x77, 0x08, 0x89, 0x42, 0x48,
-	0x54, 0x74, 0x16, 0x81, 0x74, 0x1d, 0xdf, 0xd9, 0xae, 0x04, 0x53, 0x48, 0xf3, 0x73, 0xd2, 0x38,
-	0x02, 0xf5, 0x35, 0xc8, 0x2c, 0x12, 0xc9, 0x35, 0x9d, 0xa8, 0x4b, 0xd6, 0x2e, 0x8c, 0x87, 0xbb,
-	0x84, 0xc6, 0xfc, 0xd8, 0x1c, 0x12, 0xef, 0xc4, 0xa6, 0xfe, 0x85, 0x4e, 0xfd, 0x40, 0x02, 0x53,
-	0x70, 0xc2, 0x46, 0x7d, 0xc1, 0x42, 0xfa, 0x90, 0xd4, 0xb9, 0xe8, 0xf7, 0x59, 0x57, 0x48, 0xa6,
-	0x84, 0xcc, 0x5c, 0xc7, 0x5f, 0xde, 0xae, 0x04, 0x45, 0x90, 0x3e, 0x23, 0x2b, 0x21, 0x53, 0x0c,
-	0x43, 0x57, 0xf7, 0xfc, 0x56, 0xa1, 0x96, 0xad, 0x42, 0xf8, 0x43, 0xa6, 0x58, 0x80, 0xec, 0xe6,
-	0x2f, 0xce, 0xcc, 0xaf, 0xbf, 0x4a, 0xc3, 0xa9, 0x5f, 0x5f, 0x95, 0xd2, 0x9c, 0xb4, 0xa5, 0x45,
-	0xd2, 0x96, 0x6f, 0x24, 0xed, 0x47, 0x87, 0xdc, 0x2d, 0xd8, 0x02, 0xc8, 0x52, 0x91, 0x64, 0x40,
-	0x3f, 0x24, 0xa5, 0x73, 0x60, 0xa1, 0x55, 0x54, 0xdd, 0xdb, 0x9a, 0x89, 0x98, 0x13, 0x3f, 0x43,
-	0x52, 0x60, 0xc9, 0xff, 0xb3, 0x42, 0x7f, 0x3a, 0x64, 0xbd, 0x18, 0x90, 0x3e, 0x20, 0xd5, 0x50,
-	0xf0, 0x41, 0x0c, 0x89, 0xea, 0x44, 0x61, 0x5e, 0x96, 0x1c, 0x3a, 0x0e, 0xe9, 0x16, 0x21, 0xb6,
-	0xb5, 0xda, 0x6e, 0x9a, 0x5d, 0xb1, 0xc8, 0x71, 0x48, 0xef, 0x90, 0xd5, 0x4c, 0x31, 0x05, 0x58,
-	0x90, 0x4a, 0x60, 0x0e, 0xf3, 0xb5, 0x5c, 0x79, 0x53, 0x2d, 0x1f, 0x91, 0x75, 0x25, 0x59, 0x92,
-	0x31, 0xae, 0x6c, 0xf8, 0x55, 0x0c, 0x52, 0x9f, 0x42, 0x8f, 0xc3, 0xe6, 0x3f, 0x25, 0xd2, 0x98,
-	0xcb, 0x88, 0x6e, 0x92, 0x4a, 0x2a, 0x3a, 0xfa, 0x77, 0x83, 0xcc, 0x75, 0xd1, 0xaf, 0x9c, 0x8a,
-	0x53, 0x3c, 0x5b, 0x63, 0x32, 0x88, 0xbb, 0xe3, 0x56, 0x97, 0x53, 0xf1, 0x02, 0xcf, 0x3a, 0x23,
-	0x2c, 0x53, 0x27, 0x61, 0x31, 0xe4, 0x19, 0x21, 0xf2, 0x82, 0xc5, 0x40, 0xdf, 0x25, 0x35, 0x63,
-	0xce, 0x94, 0x04, 0x50, 0x36, 0xb1, 0x2a, 0x62, 0xa7, 0x08, 0x4d, 0x22, 0xf0, 0x48, 0x8d, 0xdc,
-	0x95, 0xa9, 0x08, 0x07, 0x91, 0x1a, 0xd1, 0xf7, 0x48, 0xdd, 0x98, 0xbf, 0x8f, 0x52, 0x2e, 0x42,
-	0xb0, 0x69, 0x99, 0xb0, 0x2f, 0x0d, 0x36, 0x21, 0x71, 0x31, 0x48, 0x94, 0x1c, 0xb9, 0xa5, 0x29,
-	0xd2, 0x81, 0xc1, 0x74, 0x85, 0x24, 0xf0, 0x28, 0x8d, 0x74, 0x7b, 0x50, 0xee, 0x9a, 0xa9, 0xd0,
-	0x18, 0x45, 0xc9, 0x8f, 0xc9, 0xad, 0x09, 0xcd, 0xca, 0x2e, 0x23, 0x71, 0x63, 0x8c, 0x5b, 0xe9,
-	0x85, 0x88, 0x28, 0xbf, 0x32, 0x13, 0x11, 0x53, 0x78, 0x4a, 0x1a, 0x13, 0x5a, 0x9e, 0x06, 0x41,
-	0xe6, 0xe4, 0x57, 0x79, 0x2a, 0x05, 0x72, 0x9e, 0x4e, 0x75, 0x86, 0x9c, 0xa7, 0xe4, 0x91, 0x32,
-	0x1f, 0x48, 0x09, 0x09, 0x1f, 0xb9, 0x35, 0xd3, 0x99, 0xfc, 0x3c, 0x29, 0x3d, 0x8b, 0x35, 0xdb,
-	0xad, 0xfb, 0xce, 0xf6, 0xb2, 0x2d, 0xfd, 0x3e, 0x42, 0xba, 0xf4, 0x09, 0xa8, 0x9c, 0xb0, 0x8e,
-	0x84, 0x4a, 0x02, 0x6a, 0x62, 0x56, 0x6c, 0x98, 0x9b, 0x37, 0x8c, 0x59, 0xb1, 0xa1, 0x35, 0xdf,
-	0x23, 0x65, 0x6d, 0x96, 0xfa, 0xc2, 0xde, 0x42, 0xe3, 0x9a, 0x62, 0xc3, 0x40, 0x5f, 0xd9, 0xfb,
-	0xa4, 0x32, 0xd6, 0xea, 0x36, 0x4c, 0x4b, 0xc7, 0x80, 0xbe, 0xe6, 0xa8, 0xc2, 0xa5, 0xe6, 0x9a,
-	0xe3, 0x61, 0xba, 0x87, 0x89, 0x62, 0x5c, 0xb9, 0xb7, 0x0b, 0x3d, 0x44, 0x4c, 0x8f, 0x4a, 0x2e,
-	0x62, 0xfd, 0x9a, 0xdc, 0x3b, 0x66, 0x54, 0xda, 0x23, 0xfd, 0x94, 0xd4, 0x43, 0xe8, 0x47, 0x17,
-	0x20, 0x47, 0x1d, 0x3d, 0xa9, 0xdc, 0xbb, 0xf8, 0x9a, 0xbd, 0x96, 0x59, 0x07, 0xad, 0x7c, 0x1d,
-	0xb4, 0xbe, 0xcc, 0xd7, 0x41, 0x50, 0xcb, 0x1d, 0x0e, 0xb5, 0xe6, 0x4f, 0x48, 0x4d, 0xfb, 0x75,
-	0x38, 0xce, 0xd8, 0xd0, 0x7d, 0xeb, 0x4a, 0xff, 0xaa, 0xe6, 0x9b, 0x91, 0x8c, 0x4f, 0x1b, 0x86,
-	0x4a, 0xb2, 0x0e, 0x8e, 0x92, 0xb7, 0x4d, 0xce, 0x88, 0xe8, 0x17, 0xb6, 0xf7, 0xeb, 0x2a, 0xd9,
-	0x38, 0xb4, 0x83, 0xe0, 0xd4, 0x2c, 0x37, 0xfa, 0x93, 0x43, 0x4a, 0xc6, 0x9d, 0x3e, 0x5e, 0x34,
-	0x74, 0x0a, 0x53, 0xdf, 0x7b, 0xb8, 0x88, 0x9a, 0x0f, 0xa4, 0xe6, 0x47, 0x97, 0xfb, 0x9e, 0xe7,
-	0x1a, 0xcf, 0xcc, 0x67, 0x7e, 0xee, 0xe3, 0xa3, 0xd3, 0x0f, 0x7f, 0xfd, 0xfd, 0xf3, 0xd2, 0xed,
-	0xe6, 0x7a, 0xbb, 0x10, 0xea, 0x63, 0xe7, 0x09, 0xfd, 0xdd, 0x21, 0x25, 0x33, 0xea, 0x17, 0x6b,
-	0x2a, 0xac, 0x83, 0x6b, 0x6a, 0x3a, 0x40, 0x4d, 0xc6, 0xf3, 0x3f, 0x34, 0xf9, 0xde, 0x66, 0x51,
-	0x53, 0xfb, 0xd5, 0x64, 0xab, 0xbc, 0xd6, 0x02, 0xff, 0x70, 0x70, 0x21, 0xdb, 0x15, 0x4b, 0x67,
-	0xa7, 0xf5, 0xdc, 0xf6, 0xbd, 0xa6, 0xb6, 0x6f, 0x2e, 0xf7, 0x77, 0xbc, 0x27, 0x47, 0xa0, 0x7c,
-	0xe6, 0x67, 0x29, 0xf0, 0xe8, 0x2c, 0xe2, 0xbe, 0x9d, 0xcc, 0xbe, 0x38, 0x7b, 0xb3, 0xda, 0xf7,
-	0xe9, 0xa3, 0x05, 0x6a, 0xdb, 0xaf, 0xac, 0xff, 0x6b, 0xfa, 0x9b, 0x43, 0x96, 0x8f, 0x40, 0xd1,
-	0x7b, 0xf3, 0x6a, 0x6f, 0x26, 0xf3, 0xf4, 0x72, 0x7f, 0xd7, 0x7b, 0xaa, 0x65, 0xaa, 0x73, 0xf0,
-	0xcd, 0x5b, 0x57, 0x57, 0xea, 0xdc, 0xa2, 0x8b, 0xaa, 0xfa, 0xfc, 0x19, 0x69, 0x70, 0x11, 0x17,
-	0xff, 0xff, 0xbc, 0x66, 0x6f, 0xe9, 0x89, 0xbe, 0xf7, 0x27, 0xce, 0xcb, 0x8d, 0x82, 0x39, 0xed,
-	0x76, 0x4b, 0xf8, 0x22, 0x3e, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0xf9, 0xa0, 0xe0, 0x99, 0xba,
-	0x09, 0x00, 0x00,
+	proto.RegisterFile("purchaseorder/service.proto", fileDescriptor_service_bcfc4b5c45317321)
+}
+
+var fileDescriptor_service_bcfc4b5c45317321 = []byte{
+	// 1165 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xd6, 0xc6, 0x8e, 0x63, 0x8f, 0xe3, 0xa4, 0x9e, 0xf4, 0x67, 0xb3, 0x69, 0xe8, 0x62, 0x5a,
+	0x48, 0xdb, 0xc4, 0x96, 0x42, 0xe9, 0x05, 0x12, 0x42, 0x69, 0x22, 0x85, 0x48, 0xa4, 0x0d, 0x1b,
+	0xe0, 0xa2, 0x42, 0xb2, 0x26, 0xbb, 0xc7, 0xc9, 0x48, 0xde, 0x9d, 0xed, 0xcc, 0x38, 0x8d, 0xa9,
+	0x2a, 0x21, 0xc4, 0x0b, 0x10, 0xae, 0x10, 0x12, 0x0f, 0x01, 0xb7, 0x3c, 0x00, 0xf7, 0xdc, 0x72,
+	0xc9, 0x83, 0xa0, 0xf9, 0x59, 0xdb, 0x6b, 0x07, 0x27, 0x05, 0x71, 0x65, 0xcf, 0x77, 0xbe, 0x73,
+	0xf6, 0x3b, 0x67, 0xce, 0x99, 0x19, 0xb4, 0x92, 0xf6, 0x78, 0x78, 0x42, 0x04, 0x30, 0x1e, 0x01,
+	0x6f, 0x09, 0xe0, 0xa7, 0x34, 0x84, 0x66, 0xca, 0x99, 0x64, 0xb8, 0x96, 0x33, 0x7a, 0xb7, 0x8f,
+	0x19, 0x3b, 0xee, 0x42, 0x8b, 0xa4, 0xb4, 0x45, 0x92, 0x84, 0x49, 0x22, 0x29, 0x4b, 0x84, 0x21,
+	0x7b, 0x77, 0xac, 0x55, 0xaf, 0x8e, 0x7a, 0x9d, 0x96, 0xa4, 0x31, 0x08, 0x49, 0xe2, 0xd4, 0x12,
+	0xd6, 0xf5, 0x4f, 0xb8, 0x71, 0x0c, 0xc9, 0x86, 0x78, 0x49, 0x8e, 0x8f, 0x81, 0xb7, 0x58, 0xaa,
+	0x43, 0x4c, 0x86, 0x6b, 0xac, 0x23, 0xb4, 0x0b, 0x32, 0x80, 0x17, 0x3d, 0x10, 0x12, 0xbf, 0x85,
+	0x10, 0x8d, 0x20, 0x91, 0xb4, 0x43, 0x81, 0xbb, 0x8e, 0xef, 0xac, 0x55, 0x82, 0x11, 0xa4, 0xb1,
+	0x8f, 0xea, 0xbb, 0x20, 0xbf, 0x04, 0x2e, 0x28, 0x4b, 0xae, 0xe8, 0x84, 0x5d, 0x34, 0x77, 0x6a,
+	0x3c, 0xdc, 0x19, 0x6d, 0xcc, 0x96, 0x8d, 0xdf, 0x1c, 0xe4, 0x1d, 0xd8, 0xdc, 0x9f, 0xa9, 0xdc,
+	0xb7, 0x39, 0x10, 0x09, 0x07, 0xa4, 0xdf, 0x65, 0x24, 0xc2, 0x77, 0x51, 0x2d, 0x64, 0xdd, 0x2e,
+	0x39, 0x62, 0x9c, 0x48, 0xc6, 0x85, 0xeb, 0xf8, 0x85, 0xb5, 0x4a, 0x90, 0x07, 0xf1, 0x23, 0x54,
+	0x8c, 0x88, 0x24, 0x3a, 0x76, 0x75, 0xd3, 0x6f, 0xe6, 0x8a, 0xd9, 0xcc, 0x85, 0xdf, 0x21, 0x92,
+	0x04, 0x9a, 0x8d, 0xef, 0xa0, 0x2a, 0x07, 0x12, 0xb5, 0x49, 0x18, 0x82, 0x10, 0x6e, 0x41, 0x47,
+	0x46, 0x0a, 0xda, 0xd2, 0x08, 0x7e, 0x1b, 0xcd, 0xbf, 0xe4, 0x54, 0x42, 0xc6, 0x28, 0x6a, 0x46,
+	0x55, 0x63, 0x86, 0xd2, 0xf8, 0x73, 0x5c, 0xfe, 0x17, 0x69, 0x34, 0x22, 0xff, 0xb2, 0xba, 0x4c,
+	0xa4, 0x37, 0x33, 0x2d, 0xbd, 0xc2, 0x7f, 0x49, 0xaf, 0x78, 0x69, 0x7a, 0xb3, 0x93, 0xe9, 0x7d,
+	0xe7, 0xa0, 0x1b, 0xb9, 0xf8, 0x01, 0x88, 0x94, 0x25, 0x02, 0xf0, 0x07, 0xa8, 0x74, 0x02, 0x24,
+	0xb2, 0x59, 0x55, 0x37, 0x57, 0xc7, 0x54, 0x65, 0xc4, 0x4f, 0x34, 0x29, 0xb0, 0xe4, 0x7f, 0xb7,
+	0x53, 0x8d, 0x5f, 0x1d, 0xb4, 0x90, 0x0f, 0xa8, 0xb2, 0x8b, 0x58, 0xd8, 0x8b, 0x21, 0x91, 0x6d,
+	0x1a, 0x65, 0xa5, 0xcd, 0xa0, 0xbd, 0x08, 0xaf, 0x22, 0x64, 0x7b, 0x4c, 0xd9, 0x4d, 0xd7, 0x55,
+	0x2c, 0xb2, 0x17, 0xe1, 0xeb, 0x68, 0x56, 0x48, 0x22, 0x41, 0x17, 0xb5, 0x12, 0x98, 0xc5, 0xe4,
+	0x7e, 0x14, 0x2f, 0xda, 0x8f, 0x7b, 0x68, 0x41, 0x72, 0x92, 0x08, 0x12, 0x4a, 0x1b, 0x7e, 0x56,
+	0x07, 0xa9, 0x8d, 0xa0, 0x7b, 0x51, 0xe3, 0x9b, 0x32, 0xaa, 0x4f, 0x64, 0x84, 0x57, 0x50, 0x25,
+	0x65, 0x6d, 0xf5, 0xb9, 0x9e, 0x70, 0x5d, 0xed, 0x57, 0x4e, 0xd9, 0xa1, 0x5e, 0x5b, 0x63, 0xd2,
+	0x8b, 0x8f, 0x06, 0xed, 0x52, 0x4e, 0xd9, 0x53, 0xbd, 0x56, 0x19, 0xe9, 0x32, 0xb5, 0x13, 0x12,
+	0x43, 0x96, 0x91, 0x46, 0x9e, 0x92, 0x18, 0xd4, 0x76, 0x1a, 0xb3, 0x90, 0x1c, 0x40, 0xda, 0xc4,
+	0xaa, 0x1a, 0x3b, 0xd4, 0xd0, 0x30, 0x42, 0x48, 0x65, 0xdf, 0x2d, 0x8e, 0x44, 0xd8, 0xa6, 0xb2,
+	0x8f, 0xdf, 0x41, 0x35, 0x63, 0xfe, 0x9a, 0xa6, 0x21, 0x8b, 0xc0, 0xa6, 0x65, 0xc2, 0x3e, 0x37,
+	0xd8, 0x90, 0x14, 0xb2, 0x5e, 0x22, 0x79, 0xdf, 0x2d, 0x8d, 0x90, 0xb6, 0x0d, 0xa6, 0x2a, 0xc4,
+	0x21, 0xa4, 0x29, 0x55, 0xdb, 0xa3, 0xe5, 0xce, 0x99, 0x0a, 0x0d, 0x50, 0x2d, 0xf9, 0x3e, 0xba,
+	0x36, 0xa4, 0x59, 0xd9, 0x65, 0x4d, 0x5c, 0x1c, 0xe0, 0x56, 0x7a, 0x2e, 0xa2, 0x96, 0x5f, 0x19,
+	0x8b, 0xa8, 0x53, 0x78, 0x88, 0xea, 0x43, 0x5a, 0x96, 0x06, 0xd2, 0xcc, 0xe1, 0xa7, 0xb2, 0x54,
+	0x72, 0xe4, 0x2c, 0x9d, 0xea, 0x18, 0x39, 0x4b, 0xc9, 0x43, 0xe5, 0xb0, 0xc7, 0x39, 0x24, 0x61,
+	0xdf, 0x9d, 0x37, 0x3b, 0x93, 0xad, 0x87, 0xa5, 0x27, 0xb1, 0x62, 0xbb, 0xb5, 0x91, 0xd2, 0x6f,
+	0x69, 0x48, 0x95, 0x3e, 0x01, 0x99, 0x11, 0x16, 0x4c, 0xe9, 0x13, 0x90, 0x43, 0xb3, 0x24, 0x67,
+	0x99, 0x79, 0xd1, 0x98, 0x25, 0x39, 0xb3, 0xe6, 0x65, 0x54, 0x56, 0x66, 0xae, 0x1a, 0xf6, 0x9a,
+	0xef, 0xac, 0x15, 0x82, 0x39, 0x49, 0xce, 0x02, 0xd5, 0xb2, 0xb7, 0x51, 0x65, 0xa0, 0xd5, 0xad,
+	0x1b, 0xc7, 0x01, 0xa0, 0xda, 0x5c, 0xab, 0x70, 0xb1, 0x69, 0x73, 0xbd, 0x18, 0xdd, 0xc3, 0x44,
+	0x92, 0x50, 0xba, 0x4b, 0xb9, 0x3d, 0xd4, 0x98, 0x3a, 0xb3, 0x43, 0x16, 0xab, 0x69, 0x72, 0xaf,
+	0x9b, 0x33, 0xdb, 0x2e, 0xf1, 0xc7, 0xa8, 0x16, 0x41, 0x97, 0x9e, 0x02, 0xef, 0xb7, 0x23, 0x25,
+	0xe9, 0x86, 0x9e, 0x66, 0xaf, 0x69, 0xee, 0xa5, 0x66, 0x76, 0x2f, 0x35, 0x3f, 0xcf, 0xee, 0xa5,
+	0x60, 0x3e, 0x73, 0xd8, 0x51, 0x9a, 0x3f, 0x42, 0xf3, 0xca, 0xaf, 0x1d, 0xea, 0xb3, 0x3e, 0x72,
+	0x6f, 0x5e, 0xea, 0x5f, 0x55, 0x7c, 0x73, 0x35, 0xe8, 0xd1, 0x86, 0x33, 0xc9, 0x49, 0x5b, 0x1f,
+	0x25, 0xb7, 0x4c, 0xce, 0x1a, 0xd1, 0x13, 0xf6, 0x18, 0xa1, 0x2e, 0x4d, 0xa0, 0x4d, 0x25, 0xc4,
+	0xc2, 0x5d, 0xf6, 0x0b, 0x6b, 0xd5, 0xcd, 0x5b, 0x63, 0x27, 0xcd, 0xa7, 0x34, 0x81, 0x3d, 0x09,
+	0x71, 0x50, 0xe9, 0xda, 0x7f, 0x02, 0x7f, 0x86, 0x96, 0x06, 0x69, 0xc5, 0xb4, 0x0b, 0x42, 0xb2,
+	0x04, 0x84, 0xeb, 0xf9, 0x85, 0x0b, 0x8e, 0xaa, 0x1d, 0xcb, 0xdc, 0xcf, 0x88, 0x01, 0x8e, 0xc6,
+	0x21, 0xd1, 0xf8, 0xdd, 0x41, 0xe5, 0xec, 0x53, 0xea, 0xc8, 0x52, 0x92, 0xf2, 0xe3, 0x8d, 0x14,
+	0x64, 0x07, 0xdc, 0x47, 0xd5, 0x08, 0x44, 0xc8, 0xa9, 0xbe, 0xaa, 0xed, 0x84, 0x8f, 0x42, 0xaa,
+	0x09, 0x5f, 0xf4, 0x48, 0x22, 0x55, 0xff, 0x9b, 0xf9, 0x1e, 0xac, 0xf1, 0xbb, 0x68, 0xb1, 0x97,
+	0x50, 0xd9, 0x66, 0x9d, 0x76, 0x0c, 0x44, 0xf4, 0x38, 0xd8, 0x09, 0xaf, 0x29, 0xf8, 0x59, 0x67,
+	0xdf, 0x80, 0xaa, 0x7a, 0x9a, 0x97, 0x72, 0x1a, 0x66, 0x23, 0x5e, 0x51, 0xc8, 0x81, 0x02, 0xf0,
+	0x4d, 0x54, 0xb2, 0x5d, 0x68, 0x06, 0xdb, 0xae, 0xd4, 0x19, 0x5c, 0x9f, 0x48, 0xfa, 0xff, 0xce,
+	0x69, 0xa2, 0xd3, 0x8a, 0x6f, 0xd6, 0x69, 0x9b, 0x3f, 0xce, 0xa2, 0xc5, 0x1d, 0x7b, 0x29, 0x1c,
+	0x9a, 0x17, 0x17, 0xfe, 0xde, 0x41, 0x25, 0xd3, 0x4a, 0xf8, 0xfe, 0xb4, 0x0b, 0x28, 0xf7, 0x12,
+	0xf1, 0xee, 0x4e, 0xa3, 0x66, 0x97, 0x53, 0xe3, 0xf1, 0xf9, 0x96, 0xe7, 0xb9, 0xc6, 0x53, 0xf8,
+	0xc4, 0xcf, 0x7c, 0x7c, 0xed, 0xf4, 0xed, 0x1f, 0x7f, 0xfd, 0x30, 0xb3, 0xd4, 0x58, 0x68, 0xe5,
+	0x42, 0x7d, 0xe8, 0x3c, 0xc0, 0x3f, 0x3b, 0xa8, 0x64, 0x9e, 0x0e, 0xd3, 0x35, 0xe5, 0x9e, 0x17,
+	0x57, 0xd4, 0xb4, 0xad, 0x35, 0x19, 0xcf, 0x7f, 0xd0, 0xe4, 0x7b, 0x2b, 0x79, 0x4d, 0xad, 0x57,
+	0xc3, 0x57, 0xca, 0x6b, 0x25, 0xf0, 0x17, 0x07, 0xa1, 0xe1, 0xbb, 0x0f, 0x8f, 0x8f, 0xc3, 0xc4,
+	0x93, 0xf0, 0x8a, 0xda, 0xbe, 0x3a, 0xdf, 0x5a, 0xf7, 0x1e, 0xec, 0x82, 0xf4, 0x89, 0x2f, 0x52,
+	0x08, 0x69, 0x87, 0x86, 0xbe, 0xbd, 0xa5, 0x7d, 0xd6, 0xb9, 0x58, 0xed, 0x7b, 0xf8, 0xde, 0x14,
+	0xb5, 0xad, 0x57, 0xd6, 0xff, 0x35, 0xfe, 0xc9, 0x41, 0x85, 0x5d, 0x90, 0x78, 0x79, 0x52, 0xed,
+	0x9b, 0xc9, 0x3c, 0x3c, 0xdf, 0xda, 0xf0, 0x1e, 0x2a, 0x99, 0xf2, 0x04, 0x7c, 0x73, 0xee, 0xcb,
+	0x4b, 0x75, 0xae, 0xe2, 0x69, 0x55, 0x7d, 0xf2, 0x08, 0xd5, 0x43, 0x16, 0xe7, 0xbf, 0xff, 0x64,
+	0xde, 0x76, 0xe9, 0x81, 0xea, 0xec, 0x03, 0xe7, 0xf9, 0x62, 0xce, 0x9c, 0x1e, 0x1d, 0x95, 0x74,
+	0xcf, 0xbf, 0xff, 0xf7, 0x00, 0xd8, 0x17, 0x25, 0xd3, 0x4f, 0x0c, 0x00, 0x00,
 }
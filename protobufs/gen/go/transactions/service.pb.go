@@ -37,7 +37,7 @@ func (m *TransactionStatusRequest) Reset()         { *m = TransactionStatusReque
 func (m *TransactionStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*TransactionStatusRequest) ProtoMessage()    {}
 func (*TransactionStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_3dc77ed74e902ae6, []int{0}
+	return fileDescriptor_service_f0beb44bfd767040, []int{0}
 }
 func (m *TransactionStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionStatusRequest.Unmarshal(m, b)
@@ -69,6 +69,8 @@ type TransactionStatusResponse struct {
 	Status               string               `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	Message              string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	LastUpdated          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Description          string               `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -78,7 +80,7 @@ func (m *TransactionStatusResponse) Reset()         { *m = TransactionStatusResp
 func (m *TransactionStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*TransactionStatusResponse) ProtoMessage()    {}
 func (*TransactionStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_service_3dc77ed74e902ae6, []int{1}
+	return fileDescriptor_service_f0beb44bfd767040, []int{1}
 }
 func (m *TransactionStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionStatusResponse.Unmarshal(m, b)
@@ -126,9 +128,165 @@ func (m *TransactionStatusResponse) GetLastUpdated() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *TransactionStatusResponse) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *TransactionStatusResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListTransactionsRequest struct {
+	// only transactions with the status (pending, success or failed) if set
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// only transactions whose description contains the text, case insensitive, if set
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// only transactions created at or after the time if set
+	CreatedAfter *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// only transactions created before the time if set
+	CreatedBefore *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// order of the creation time, either desc (default, newest first) or asc
+	Order string `protobuf:"bytes,5,opt,name=order,proto3" json:"order,omitempty"`
+	// next_cursor of the previous page, empty for the first page
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// maximum number of transactions in the page, 20 if not set, at most 100
+	Limit                int32    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTransactionsRequest) Reset()         { *m = ListTransactionsRequest{} }
+func (m *ListTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTransactionsRequest) ProtoMessage()    {}
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_f0beb44bfd767040, []int{2}
+}
+func (m *ListTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTransactionsRequest.Unmarshal(m, b)
+}
+func (m *ListTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTransactionsRequest.Merge(dst, src)
+}
+func (m *ListTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTransactionsRequest.Size(m)
+}
+func (m *ListTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTransactionsRequest proto.InternalMessageInfo
+
+func (m *ListTransactionsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ListTransactionsRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ListTransactionsRequest) GetCreatedAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return nil
+}
+
+func (m *ListTransactionsRequest) GetCreatedBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
+func (m *ListTransactionsRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *ListTransactionsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListTransactionsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListTransactionsResponse struct {
+	Data []*TransactionStatusResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// cursor of the next page, empty if this is the last page
+	NextCursor           string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTransactionsResponse) Reset()         { *m = ListTransactionsResponse{} }
+func (m *ListTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTransactionsResponse) ProtoMessage()    {}
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_f0beb44bfd767040, []int{3}
+}
+func (m *ListTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTransactionsResponse.Unmarshal(m, b)
+}
+func (m *ListTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTransactionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTransactionsResponse.Merge(dst, src)
+}
+func (m *ListTransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTransactionsResponse.Size(m)
+}
+func (m *ListTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTransactionsResponse proto.InternalMessageInfo
+
+func (m *ListTransactionsResponse) GetData() []*TransactionStatusResponse {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ListTransactionsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TransactionStatusRequest)(nil), "transactions.TransactionStatusRequest")
 	proto.RegisterType((*TransactionStatusResponse)(nil), "transactions.TransactionStatusResponse")
+	proto.RegisterType((*ListTransactionsRequest)(nil), "transactions.ListTransactionsRequest")
+	proto.RegisterType((*ListTransactionsResponse)(nil), "transactions.ListTransactionsResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -144,6 +302,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type TransactionServiceClient interface {
 	GetTransactionStatus(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatusResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
 }
 
 type transactionServiceClient struct {
@@ -163,9 +322,19 @@ func (c *transactionServiceClient) GetTransactionStatus(ctx context.Context, in
 	return out, nil
 }
 
+func (c *transactionServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/transactions.TransactionService/ListTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServiceServer is the server API for TransactionService service.
 type TransactionServiceServer interface {
 	GetTransactionStatus(context.Context, *TransactionStatusRequest) (*TransactionStatusResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
 }
 
 func RegisterTransactionServiceServer(s *grpc.Server, srv TransactionServiceServer) {
@@ -190,6 +359,24 @@ func _TransactionService_GetTransactionStatus_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/transactions.TransactionService/ListTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TransactionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "transactions.TransactionService",
 	HandlerType: (*TransactionServiceServer)(nil),
@@ -198,35 +385,54 @@ var _TransactionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionStatus",
 			Handler:    _TransactionService_GetTransactionStatus_Handler,
 		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _TransactionService_ListTransactions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "transactions/service.proto",
 }
 
-func init() { proto.RegisterFile("transactions/service.proto", fileDescriptor_service_3dc77ed74e902ae6) }
-
-var fileDescriptor_service_3dc77ed74e902ae6 = []byte{
-	// 348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x4a, 0xeb, 0x40,
-	0x14, 0xc6, 0x99, 0xde, 0x4b, 0x2f, 0x77, 0x5a, 0x45, 0x06, 0x29, 0x63, 0x10, 0x0d, 0x05, 0xb5,
-	0x0b, 0x9b, 0x40, 0x5d, 0xbb, 0x68, 0x37, 0xc5, 0x5d, 0xa9, 0x75, 0xe3, 0xa6, 0x4c, 0x93, 0x63,
-	0x08, 0x34, 0x99, 0x31, 0x67, 0xa2, 0x0b, 0x71, 0xe3, 0x23, 0xe8, 0x5b, 0xb8, 0xf5, 0x09, 0x7c,
-	0x06, 0x5f, 0xc1, 0x07, 0x91, 0xce, 0xa4, 0x38, 0xa5, 0x8a, 0xae, 0xc2, 0x39, 0xdf, 0x97, 0xf3,
-	0xe7, 0x77, 0x86, 0x7a, 0xba, 0x10, 0x39, 0x8a, 0x48, 0xa7, 0x32, 0xc7, 0x10, 0xa1, 0xb8, 0x49,
-	0x23, 0x08, 0x54, 0x21, 0xb5, 0x64, 0x4d, 0x57, 0xf3, 0x76, 0x13, 0x29, 0x93, 0x39, 0x84, 0x42,
-	0xa5, 0xa1, 0xc8, 0x73, 0xa9, 0x85, 0xc9, 0x5b, 0xaf, 0xb7, 0x5f, 0xa9, 0x26, 0x9a, 0x95, 0x57,
-	0xa1, 0x4e, 0x33, 0x40, 0x2d, 0x32, 0x55, 0x19, 0x8e, 0xcd, 0x27, 0xea, 0x26, 0x90, 0x77, 0xf1,
-	0x56, 0x24, 0x09, 0x14, 0xa1, 0x54, 0xb6, 0xed, 0x5a, 0xb9, 0x76, 0x9f, 0xf2, 0xc9, 0x67, 0xf3,
-	0x73, 0x2d, 0x74, 0x89, 0x63, 0xb8, 0x2e, 0x01, 0x35, 0x3b, 0xa0, 0x9b, 0xce, 0x60, 0xd3, 0x34,
-	0xe6, 0xc4, 0x27, 0x9d, 0xff, 0xe3, 0x0d, 0x27, 0x7b, 0x16, 0xb7, 0x5f, 0x08, 0xdd, 0xf9, 0xa2,
-	0x06, 0x2a, 0x99, 0x23, 0xfc, 0xb2, 0x08, 0x6b, 0xd1, 0x3a, 0x9a, 0x1f, 0x79, 0xcd, 0xc8, 0x55,
-	0xc4, 0x38, 0xfd, 0x97, 0x01, 0xa2, 0x48, 0x80, 0xff, 0x31, 0xc2, 0x32, 0x64, 0xa7, 0xb4, 0x39,
-	0x17, 0xa8, 0xa7, 0xa5, 0x8a, 0x85, 0x86, 0x98, 0xff, 0xf5, 0x49, 0xa7, 0xd1, 0xf3, 0x02, 0xcb,
-	0x27, 0x58, 0xf2, 0x09, 0x26, 0x4b, 0x3e, 0xe3, 0xc6, 0xc2, 0x7f, 0x61, 0xed, 0xbd, 0x57, 0x42,
-	0x99, 0x3b, 0xb5, 0x3d, 0x08, 0x7b, 0x26, 0x74, 0x7b, 0x08, 0x7a, 0x6d, 0x1f, 0x76, 0x18, 0xb8,
-	0x47, 0x0a, 0xbe, 0x83, 0xe6, 0x1d, 0xfd, 0xe8, 0xb3, 0x60, 0xda, 0xfd, 0xc7, 0x3e, 0xf7, 0x5a,
-	0x43, 0xd0, 0xbe, 0xe3, 0xf1, 0xad, 0xe9, 0xe1, 0xed, 0xfd, 0xa9, 0xe6, 0xb3, 0xbd, 0x70, 0xe5,
-	0xd1, 0xdc, 0xad, 0x82, 0xbc, 0x1f, 0xf4, 0xe8, 0x56, 0x24, 0xb3, 0x95, 0x86, 0x83, 0x66, 0xb5,
-	0xc9, 0x68, 0xb1, 0xff, 0x88, 0x5c, 0xba, 0xf4, 0x51, 0xcd, 0x66, 0x75, 0x03, 0xe6, 0xe4, 0x23,
-	0x00, 0x00, 0xff, 0xff, 0x1f, 0xcd, 0x20, 0xdd, 0x90, 0x02, 0x00, 0x00,
+func init() {
+	proto.RegisterFile("transactions/service.proto", fileDescriptor_service_f0beb44bfd767040)
+}
+
+var fileDescriptor_service_f0beb44bfd767040 = []byte{
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x95, 0x9d, 0x26, 0x55, 0x26, 0x49, 0x29, 0x4b, 0x55, 0x16, 0x0b, 0x51, 0x2b, 0x52, 0x4b,
+	0x24, 0xa8, 0x2d, 0x85, 0x13, 0x42, 0x08, 0x39, 0x1c, 0x2a, 0x24, 0x0e, 0x95, 0x29, 0x17, 0x2e,
+	0xd1, 0xc6, 0xde, 0x58, 0x96, 0x12, 0xaf, 0xd9, 0x1d, 0x43, 0x25, 0xc4, 0x85, 0x0f, 0x40, 0xa8,
+	0x1c, 0xf9, 0x03, 0x3e, 0x07, 0x7e, 0x81, 0x0f, 0x41, 0x5e, 0xdb, 0xad, 0xdd, 0xb4, 0x4a, 0x4e,
+	0xd6, 0xcc, 0xbc, 0x7d, 0xf3, 0xf6, 0x79, 0x66, 0xc1, 0x42, 0xc9, 0x12, 0xc5, 0x02, 0x8c, 0x45,
+	0xa2, 0x5c, 0xc5, 0xe5, 0xa7, 0x38, 0xe0, 0x4e, 0x2a, 0x05, 0x0a, 0xd2, 0xaf, 0xd7, 0xac, 0x87,
+	0x91, 0x10, 0xd1, 0x82, 0xbb, 0x2c, 0x8d, 0x5d, 0x96, 0x24, 0x02, 0x99, 0xce, 0x17, 0x58, 0xeb,
+	0xa0, 0xac, 0xea, 0x68, 0x96, 0xcd, 0x5d, 0x8c, 0x97, 0x5c, 0x21, 0x5b, 0xa6, 0x25, 0xe0, 0xa9,
+	0xfe, 0x04, 0xc7, 0x11, 0x4f, 0x8e, 0xd5, 0x67, 0x16, 0x45, 0x5c, 0xba, 0x22, 0x2d, 0xda, 0xae,
+	0xd0, 0x0d, 0x3d, 0xa0, 0x67, 0x57, 0xcd, 0xdf, 0x21, 0xc3, 0x4c, 0xf9, 0xfc, 0x63, 0xc6, 0x15,
+	0x92, 0x43, 0xd8, 0xa9, 0x09, 0x9b, 0xc6, 0x21, 0x35, 0x6c, 0x63, 0xd4, 0xf5, 0x07, 0xb5, 0xec,
+	0x9b, 0x70, 0xf8, 0xc3, 0x84, 0x07, 0x37, 0x70, 0xa8, 0x54, 0x24, 0x8a, 0x6f, 0x48, 0x42, 0xf6,
+	0xa1, 0xa3, 0xf4, 0x41, 0x6a, 0xea, 0x72, 0x19, 0x11, 0x0a, 0xdb, 0x4b, 0xae, 0x14, 0x8b, 0x38,
+	0x6d, 0xe9, 0x42, 0x15, 0x92, 0x97, 0xd0, 0x5f, 0x30, 0x85, 0xd3, 0x2c, 0x0d, 0x19, 0xf2, 0x90,
+	0x6e, 0xd9, 0xc6, 0xa8, 0x37, 0xb6, 0x9c, 0xc2, 0x1f, 0xa7, 0xf2, 0xc7, 0x39, 0xab, 0xfc, 0xf1,
+	0x7b, 0x39, 0xfe, 0x7d, 0x01, 0x27, 0x36, 0xf4, 0x42, 0xae, 0x02, 0x19, 0x6b, 0x6b, 0x68, 0x5b,
+	0x93, 0xd7, 0x53, 0xe4, 0x39, 0x40, 0x20, 0x79, 0x0e, 0x9e, 0x32, 0xa4, 0x9d, 0xb5, 0xf4, 0xdd,
+	0x12, 0xed, 0xe1, 0xf0, 0x97, 0x09, 0xf7, 0xdf, 0xc6, 0x0a, 0x6b, 0xb6, 0x5c, 0xba, 0x7a, 0x75,
+	0x53, 0xa3, 0x71, 0xd3, 0x6b, 0x82, 0xcc, 0x55, 0x41, 0xaf, 0x60, 0x70, 0x29, 0x68, 0x8e, 0x5c,
+	0xd2, 0xd6, 0x5a, 0x4d, 0xfd, 0x4a, 0x53, 0x8e, 0x27, 0x1e, 0xec, 0x54, 0x04, 0x33, 0x3e, 0x17,
+	0x92, 0x6f, 0x60, 0x5a, 0xd5, 0x72, 0xa2, 0x0f, 0x90, 0x3d, 0x68, 0x0b, 0x19, 0x72, 0x59, 0x1a,
+	0x56, 0x04, 0xf9, 0x9d, 0x82, 0x4c, 0x2a, 0x21, 0xb5, 0x4d, 0x5d, 0xbf, 0x8c, 0x72, 0xf4, 0x22,
+	0x5e, 0xc6, 0x48, 0xb7, 0x6d, 0x63, 0xd4, 0xf6, 0x8b, 0x60, 0x78, 0x0e, 0x74, 0xd5, 0x9c, 0x72,
+	0x5c, 0x5e, 0xc0, 0x56, 0xc8, 0x90, 0x51, 0xc3, 0x6e, 0x8d, 0x7a, 0xe3, 0xc7, 0x4e, 0x7d, 0x33,
+	0x9c, 0x5b, 0xa7, 0xcc, 0xd7, 0x87, 0xc8, 0x01, 0xf4, 0x12, 0x7e, 0x8e, 0xd3, 0x52, 0x4b, 0x61,
+	0x21, 0xe4, 0xa9, 0xd7, 0x3a, 0x33, 0xfe, 0x63, 0x02, 0xa9, 0x93, 0x14, 0x5b, 0x48, 0x7e, 0x1b,
+	0xb0, 0x77, 0xc2, 0x71, 0x85, 0x9e, 0x1c, 0xad, 0xed, 0xaf, 0xff, 0xa9, 0xb5, 0xa9, 0xce, 0xa1,
+	0x77, 0xe1, 0x51, 0x6b, 0xff, 0x84, 0xa3, 0x5d, 0xc3, 0xd8, 0x05, 0xe8, 0xdb, 0xdf, 0x7f, 0x3f,
+	0x4d, 0x9b, 0x3c, 0x72, 0x1b, 0x2f, 0xc5, 0x97, 0xe6, 0xf6, 0x7c, 0x25, 0xdf, 0x0d, 0xd8, 0xbd,
+	0x6e, 0x1f, 0x39, 0x6c, 0x0a, 0xb8, 0x65, 0xf6, 0xac, 0xa3, 0x75, 0xb0, 0x52, 0xe6, 0x93, 0x0b,
+	0xef, 0x9e, 0x75, 0x37, 0x2f, 0xd7, 0x75, 0x16, 0x0a, 0xef, 0x90, 0x41, 0x43, 0xe1, 0x64, 0x0c,
+	0xbb, 0x81, 0x58, 0x36, 0x98, 0x27, 0xfd, 0xd2, 0xda, 0x53, 0x29, 0x50, 0x9c, 0x1a, 0x1f, 0xea,
+	0x6f, 0x80, 0x4a, 0x67, 0xb3, 0x8e, 0x9e, 0xb4, 0x67, 0xff, 0x07, 0x00, 0x2f, 0xe2, 0x7f, 0xf4,
+	0x16, 0x05, 0x00, 0x00,
 }
@@ -5,9 +5,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/config"
 	"github.com/centrifuge/go-centrifuge/errors"
-
+	"github.com/centrifuge/go-centrifuge/retry"
 	"github.com/centrifuge/gocelery"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	logging "github.com/ipfs/go-log"
 )
 
@@ -16,6 +19,9 @@ const (
 	TimeoutParam string = "Timeout"
 )
 
+// DefaultLane is the lane of the jobs that are not run on a dedicated lane of an account tier.
+const DefaultLane = config.AccountTierStandard
+
 var log = logging.Logger("queue-server")
 
 // Config is an interface for queue specific configurations
@@ -24,12 +30,15 @@ type Config interface {
 	// GetNumWorkers gets the number of background workers to initiate
 	GetNumWorkers() int
 
-	// GetTaskRetries returns the number of retries allowed for a queued task
-	GetTaskRetries() int
+	// GetRetryPolicy returns the retry policy of the operation class, the queue class for the queued tasks
+	GetRetryPolicy(class string) retry.Policy
 
 	// GetWorkerWaitTime gets the worker wait time for a task to be available while polling
 	// increasing this may slow down task execution while reducing it may consume a lot of CPU cycles
 	GetWorkerWaitTimeMS() int
+
+	// GetQueueLanes returns the number of workers of the dedicated lanes per account tier
+	GetQueueLanes() map[string]int
 }
 
 // TaskType is a task to be queued in the centrifuge node to be completed asynchronously
@@ -39,6 +48,15 @@ type TaskType interface {
 	TaskTypeName() string
 }
 
+// AccountTaskType is a task whose jobs are run on behalf of an account.
+// Jobs of account tasks are run on the lane of the account tier.
+type AccountTaskType interface {
+	TaskType
+
+	// AccountIDParam returns the name of the job param holding the hex encoded account ID
+	AccountIDParam() string
+}
+
 // TaskResult represents a result from a queued task execution
 type TaskResult interface {
 
@@ -47,11 +65,18 @@ type TaskResult interface {
 }
 
 // Server represents the queue server currently implemented based on gocelery
+// Each lane is a separate queue with its own workers so that the backlog of a lane doesn't hold up the others.
 type Server struct {
 	config    Config
 	lock      sync.RWMutex
-	queue     *gocelery.CeleryClient
+	lanes     map[string]*gocelery.CeleryClient
 	taskTypes []TaskType
+
+	// retries spends the retry budget of the queue class on the retries of the tasks
+	retries *retry.Retrier
+
+	// accounts resolves the tier of the account jobs, all jobs are run on the default lane if nil
+	accounts config.Service
 }
 
 // Name of the queue server
@@ -63,27 +88,56 @@ func (qs *Server) Name() string {
 func (qs *Server) Start(ctx context.Context, wg *sync.WaitGroup, startupErr chan<- error) {
 	defer wg.Done()
 	qs.lock.Lock()
-	var err error
-	qs.queue, err = gocelery.NewCeleryClient(
-		gocelery.NewInMemoryBroker(),
-		gocelery.NewInMemoryBackend(),
-		qs.config.GetNumWorkers(),
-		qs.config.GetWorkerWaitTimeMS(),
-	)
-	if err != nil {
-		startupErr <- err
+	if nodeObjReg, ok := ctx.Value(bootstrap.NodeObjRegistry).(map[string]interface{}); ok {
+		qs.accounts, _ = nodeObjReg[config.BootstrappedConfigStorage].(config.Service)
 	}
-	for _, task := range qs.taskTypes {
-		qs.queue.Register(task.TaskTypeName(), task)
+
+	lanes := map[string]int{DefaultLane: qs.config.GetNumWorkers()}
+	for tier, workers := range qs.config.GetQueueLanes() {
+		if tier == DefaultLane {
+			continue
+		}
+
+		lanes[tier] = workers
+	}
+
+	qs.retries = retry.New(retry.ClassQueue, qs.config.GetRetryPolicy(retry.ClassQueue))
+	qs.lanes = make(map[string]*gocelery.CeleryClient)
+	for lane, workers := range lanes {
+		queue, err := gocelery.NewCeleryClient(
+			gocelery.NewInMemoryBroker(),
+			gocelery.NewInMemoryBackend(),
+			workers,
+			qs.config.GetWorkerWaitTimeMS(),
+		)
+		if err != nil {
+			qs.lock.Unlock()
+			startupErr <- err
+			return
+		}
+
+		for _, task := range qs.taskTypes {
+			if ct, ok := task.(gocelery.CeleryTask); ok {
+				queue.Register(task.TaskTypeName(), budgetedTask{CeleryTask: ct, retries: qs.retries})
+				continue
+			}
+
+			queue.Register(task.TaskTypeName(), task)
+		}
+
+		// start the workers
+		queue.StartWorker()
+		qs.lanes[lane] = queue
+		log.Infof("Started queue lane %s with %d workers", lane, workers)
 	}
-	// start the workers
-	qs.queue.StartWorker()
 	qs.lock.Unlock()
 
 	<-ctx.Done()
 	log.Info("Shutting down Queue server with context done")
 	qs.lock.Lock()
-	qs.queue.StopWorker()
+	for _, queue := range qs.lanes {
+		queue.StopWorker()
+	}
 	qs.lock.Unlock()
 	log.Info("Queue server stopped")
 }
@@ -101,23 +155,62 @@ func (qs *Server) EnqueueJob(taskName string, params map[string]interface{}) (Ta
 	defer qs.lock.RUnlock()
 
 	return qs.enqueueJob(taskName, params, &gocelery.TaskSettings{
-		MaxTries: uint(qs.config.GetTaskRetries()),
+		MaxTries: uint(qs.config.GetRetryPolicy(retry.ClassQueue).Attempts),
 		Delay:    time.Now().UTC(),
 	})
 }
 
 func (qs *Server) enqueueJob(name string, params map[string]interface{}, settings *gocelery.TaskSettings) (TaskResult, error) {
-	if qs.queue == nil {
+	if qs.lanes == nil {
 		return nil, errors.New("queue hasn't been initialised")
 	}
 
-	return qs.queue.Delay(gocelery.Task{
+	return qs.lanes[qs.laneFor(name, params)].Delay(gocelery.Task{
 		Name:     name,
 		Kwargs:   params,
 		Settings: settings,
 	})
 }
 
+// laneFor returns the lane of the job.
+// Jobs of account tasks are run on the lane of the account tier if the tier has a dedicated lane.
+func (qs *Server) laneFor(name string, params map[string]interface{}) string {
+	if qs.accounts == nil {
+		return DefaultLane
+	}
+
+	for _, task := range qs.taskTypes {
+		at, ok := task.(AccountTaskType)
+		if !ok || at.TaskTypeName() != name {
+			continue
+		}
+
+		accID, ok := params[at.AccountIDParam()].(string)
+		if !ok {
+			return DefaultLane
+		}
+
+		id, err := hexutil.Decode(accID)
+		if err != nil {
+			return DefaultLane
+		}
+
+		acc, err := qs.accounts.GetAccount(id)
+		if err != nil {
+			log.Warningf("failed to get account %s for job %s: %v", accID, name, err)
+			return DefaultLane
+		}
+
+		if _, ok := qs.lanes[acc.GetTier()]; !ok {
+			return DefaultLane
+		}
+
+		return acc.GetTier()
+	}
+
+	return DefaultLane
+}
+
 // EnqueueJobWithMaxTries enqueues a job on the queue server for the given taskTypeName with maximum tries
 func (qs *Server) EnqueueJobWithMaxTries(taskName string, params map[string]interface{}) (TaskResult, error) {
 	qs.lock.RLock()
@@ -128,6 +221,34 @@ func (qs *Server) EnqueueJobWithMaxTries(taskName string, params map[string]inte
 	})
 }
 
+// budgetedTask spends the retry budget of the queue class on the retries of the task.
+// A task failing with a retryable error once the budget is spent fails for good, like a task out of tries.
+type budgetedTask struct {
+	gocelery.CeleryTask
+	retries *retry.Retrier
+}
+
+// Copy copies the task with the budget.
+func (t budgetedTask) Copy() (gocelery.CeleryTask, error) {
+	ct, err := t.CeleryTask.Copy()
+	if err != nil {
+		return nil, err
+	}
+
+	return budgetedTask{CeleryTask: ct, retries: t.retries}, nil
+}
+
+// RunTask runs the task and refuses the retry if the budget is spent.
+func (t budgetedTask) RunTask() (interface{}, error) {
+	resp, err := t.CeleryTask.RunTask()
+	if err != gocelery.ErrTaskRetryable || t.retries.AllowRetry() {
+		return resp, err
+	}
+
+	t.retries.Failed()
+	return resp, errors.NewTypedError(retry.ErrBudgetExhausted, err)
+}
+
 // GetDuration parses key parameter to time.Duration type
 func GetDuration(key interface{}) (time.Duration, error) {
 	f64, ok := key.(float64)
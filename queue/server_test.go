@@ -0,0 +1,162 @@
+// +build unit
+
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/retry"
+	"github.com/centrifuge/gocelery"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockConfig struct {
+	lanes map[string]int
+}
+
+func (m mockConfig) GetNumWorkers() int {
+	return 1
+}
+
+func (m mockConfig) GetRetryPolicy(class string) retry.Policy {
+	return retry.Policy{Attempts: 1}
+}
+
+func (m mockConfig) GetWorkerWaitTimeMS() int {
+	return 1
+}
+
+func (m mockConfig) GetQueueLanes() map[string]int {
+	return m.lanes
+}
+
+type mockAccounts struct {
+	config.Service
+	mock.Mock
+}
+
+func (m *mockAccounts) GetAccount(identifier []byte) (config.Account, error) {
+	args := m.Called(identifier)
+	acc, _ := args.Get(0).(config.Account)
+	return acc, args.Error(1)
+}
+
+type mockTask struct {
+	name string
+}
+
+func (t mockTask) TaskTypeName() string {
+	return t.name
+}
+
+type mockAccountTask struct {
+	mockTask
+}
+
+func (t mockAccountTask) AccountIDParam() string {
+	return "account"
+}
+
+func TestServer_laneFor(t *testing.T) {
+	accID := "0x010203"
+	id, err := hexutil.Decode(accID)
+	assert.NoError(t, err)
+	qs := &Server{
+		taskTypes: []TaskType{mockTask{name: "task"}, mockAccountTask{mockTask{name: "accountTask"}}},
+		lanes:     map[string]*gocelery.CeleryClient{DefaultLane: nil, config.AccountTierPremium: nil},
+	}
+	params := map[string]interface{}{"account": accID}
+
+	// no account service
+	assert.Equal(t, DefaultLane, qs.laneFor("accountTask", params))
+
+	// not an account task
+	accounts := new(mockAccounts)
+	qs.accounts = accounts
+	assert.Equal(t, DefaultLane, qs.laneFor("task", params))
+
+	// missing account param
+	assert.Equal(t, DefaultLane, qs.laneFor("accountTask", map[string]interface{}{}))
+
+	// invalid account param
+	assert.Equal(t, DefaultLane, qs.laneFor("accountTask", map[string]interface{}{"account": "not hex"}))
+
+	// unknown account
+	accounts.On("GetAccount", id).Return(nil, errors.New("account not found")).Once()
+	assert.Equal(t, DefaultLane, qs.laneFor("accountTask", params))
+
+	// standard account
+	accounts.On("GetAccount", id).Return(&configstore.Account{}, nil).Once()
+	assert.Equal(t, DefaultLane, qs.laneFor("accountTask", params))
+
+	// tier without a lane
+	accounts.On("GetAccount", id).Return(&configstore.Account{Tier: "gold"}, nil).Once()
+	assert.Equal(t, DefaultLane, qs.laneFor("accountTask", params))
+
+	// premium account
+	accounts.On("GetAccount", id).Return(&configstore.Account{Tier: config.AccountTierPremium}, nil).Once()
+	assert.Equal(t, config.AccountTierPremium, qs.laneFor("accountTask", params))
+	accounts.AssertExpectations(t)
+}
+
+func TestServer_Start_lanes(t *testing.T) {
+	accounts := new(mockAccounts)
+	qs := &Server{config: mockConfig{lanes: map[string]int{config.AccountTierPremium: 2, DefaultLane: 4}}}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), bootstrap.NodeObjRegistry, map[string]interface{}{
+		config.BootstrappedConfigStorage: accounts,
+	}))
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go qs.Start(ctx, &wg, make(chan error))
+	time.Sleep(100 * time.Millisecond)
+
+	qs.lock.RLock()
+	assert.Len(t, qs.lanes, 2)
+	assert.Contains(t, qs.lanes, DefaultLane)
+	assert.Contains(t, qs.lanes, config.AccountTierPremium)
+	assert.Equal(t, accounts, qs.accounts)
+	qs.lock.RUnlock()
+
+	cancel()
+	wg.Wait()
+}
+
+type retryableTask struct {
+	gocelery.CeleryTask
+	runs *int
+}
+
+func (t retryableTask) Copy() (gocelery.CeleryTask, error) {
+	return t, nil
+}
+
+func (t retryableTask) RunTask() (interface{}, error) {
+	*t.runs++
+	return nil, gocelery.ErrTaskRetryable
+}
+
+func TestBudgetedTask(t *testing.T) {
+	var runs int
+	task := budgetedTask{CeleryTask: retryableTask{runs: &runs}, retries: retry.New("test queue", retry.Policy{Budget: 1})}
+	ct, err := task.Copy()
+	assert.NoError(t, err)
+	assert.IsType(t, budgetedTask{}, ct)
+
+	// retried within the budget
+	_, err = ct.RunTask()
+	assert.Equal(t, gocelery.ErrTaskRetryable, err)
+
+	// failed once the budget is spent
+	_, err = ct.RunTask()
+	assert.True(t, errors.IsOfType(retry.ErrBudgetExhausted, err))
+	assert.Equal(t, 2, runs)
+}
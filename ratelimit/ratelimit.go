@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval is the minimum time between two drops of the idle buckets.
+const sweepInterval = time.Minute
+
+// Limit is the rate of events allowed per key and the burst allowed above the rate.
+// The zero limit allows every event.
+type Limit struct {
+	// Rate is the number of events per second allowed per key on average. 0 means no limit.
+	Rate float64
+
+	// Burst is the number of events allowed at once per key after a quiet period. At least 1 event is allowed.
+	Burst int
+}
+
+// burst returns the size of the buckets.
+func (l Limit) burst() float64 {
+	if l.Burst < 1 {
+		return 1
+	}
+
+	return float64(l.Burst)
+}
+
+// bucket holds the tokens left to a key at the time of its last event.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens earned since the last event, up to the burst.
+func (b *bucket) refill(now time.Time, l Limit) {
+	b.tokens += now.Sub(b.last).Seconds() * l.Rate
+	if b.tokens > l.burst() {
+		b.tokens = l.burst()
+	}
+
+	b.last = now
+}
+
+// Limiter is a token bucket per key. Each key starts with a full bucket of burst tokens refilled at the rate of the
+// limit, an event spends a token. The buckets back to full are dropped once in a while.
+// A nil Limiter allows every event.
+type Limiter struct {
+	limit Limit
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// New returns a Limiter of the limit.
+func New(limit Limit) *Limiter {
+	return &Limiter{
+		limit:     limit,
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
+
+// Allow spends a token of the bucket of the key and returns true. Returns false and the wait until the next token
+// if the bucket is empty.
+func (l *Limiter) Allow(key string) (ok bool, wait time.Duration) {
+	if l == nil || l.limit.Rate <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.sweep(now)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.limit.burst(), last: now}
+		l.buckets[key] = b
+	}
+
+	b.refill(now, l.limit)
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+
+	return false, time.Duration((1 - b.tokens) / l.limit.Rate * float64(time.Second))
+}
+
+// sweep drops the buckets back to full, a new bucket is full anyway.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.limit.Rate >= l.limit.burst() {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.lastSweep = now
+}
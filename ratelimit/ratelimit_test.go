@@ -0,0 +1,88 @@
+// +build unit
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Now()
+	l := New(Limit{Rate: 2, Burst: 3})
+	l.now = func() time.Time { return now }
+
+	// burst
+	for i := 0; i < 3; i++ {
+		ok, _ := l.Allow("peer")
+		assert.True(t, ok)
+	}
+
+	ok, wait := l.Allow("peer")
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, wait)
+
+	// other keys have their own bucket
+	ok, _ = l.Allow("other")
+	assert.True(t, ok)
+
+	// refilled at the rate
+	now = now.Add(250 * time.Millisecond)
+	ok, wait = l.Allow("peer")
+	assert.False(t, ok)
+	assert.Equal(t, 250*time.Millisecond, wait)
+	now = now.Add(250 * time.Millisecond)
+	ok, _ = l.Allow("peer")
+	assert.True(t, ok)
+
+	// up to the burst
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		ok, _ = l.Allow("peer")
+		assert.True(t, ok)
+	}
+
+	ok, _ = l.Allow("peer")
+	assert.False(t, ok)
+}
+
+func TestLimiter_Allow_noLimit(t *testing.T) {
+	var l *Limiter
+	ok, _ := l.Allow("peer")
+	assert.True(t, ok)
+
+	l = New(Limit{Burst: 1})
+	for i := 0; i < 10; i++ {
+		ok, _ = l.Allow("peer")
+		assert.True(t, ok)
+	}
+
+	// at least one event per bucket
+	l = New(Limit{Rate: 1})
+	ok, _ = l.Allow("peer")
+	assert.True(t, ok)
+	ok, _ = l.Allow("peer")
+	assert.False(t, ok)
+}
+
+func TestLimiter_sweep(t *testing.T) {
+	now := time.Now()
+	l := New(Limit{Rate: 0.1, Burst: 10})
+	l.now = func() time.Time { return now }
+	l.lastSweep = now
+	for i := 0; i < 10; i++ {
+		l.Allow("busy")
+	}
+
+	l.Allow("idle")
+	assert.Len(t, l.buckets, 2)
+
+	// idle refilled, busy still short of tokens
+	now = now.Add(sweepInterval)
+	l.Allow("busy")
+
+	assert.Len(t, l.buckets, 1)
+	assert.Contains(t, l.buckets, "busy")
+}
@@ -69,7 +69,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _goCentrifugeBuildConfigsDefault_configYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x59\x73\x1b\x37\x12\x7e\xe7\xaf\xe8\xa2\x5f\x92\xaa\x1d\x6a\xee\x83\x55\xa9\x2d\x9d\xb6\x62\x59\xa1\x24\x2a\x8a\xf5\xb2\xc6\x00\x3d\x1c\x58\x43\x60\x0c\x60\x78\xf8\xd7\x6f\x01\x33\x64\x24\xcb\x52\x76\x93\xca\xae\x5e\x34\x04\xd0\x8d\x3e\xbe\xfe\xd0\xc0\x1b\x38\xc1\x8a\x74\x8d\x01\x86\x2b\x6c\x64\xbb\x44\x61\xc0\xa0\x36\x02\x0d\x90\x05\xe1\x42\x1b\x50\x5c\x3c\x60\xb9\x1d\x51\x14\x46\xf1\xaa\x5b\xe0\x25\x9a\xb5\x54\x0f\x53\x50\x9d\xd6\x9c\x88\x9a\x37\xcd\xc8\x29\xe3\x02\xc1\xd4\x08\x6c\xd0\x2b\xfa\x95\x1a\x4c\x4d\x0c\x1c\xef\x35\xc0\x92\x70\x61\xac\xfe\xd1\x6e\xc9\x74\x04\xf0\x06\x2e\x24\x25\x8d\x33\x81\x8b\x05\x50\x29\x8c\x22\xd4\x00\x61\x4c\xa1\xd6\xa8\x41\x20\x32\x30\x12\x4a\x04\x8d\x06\xd6\xdc\xd4\x80\x62\x05\x2b\xa2\x38\x29\x1b\xd4\x93\x11\xec\xe4\xad\x4a\x00\xce\xa6\x10\x45\x91\xfb\x46\x53\xa3\xc2\x6e\x39\x78\x70\xce\xa6\x90\x47\x79\x3f\x57\x4a\x69\xb4\x51\xa4\x9d\x21\x2a\xdd\xcb\x7a\x30\x3e\xe0\x6d\x7c\x10\x84\xd9\xc4\x9f\xf8\x93\xe0\xc0\xd0\xf6\x20\xca\x43\x3f\x3c\xe0\x6d\xa5\x0f\xae\x96\xf3\xab\x4d\xb9\x7e\xe8\xee\x3f\x7e\x3c\xa9\xba\xaf\xf3\x72\x73\x7a\x78\x8d\xf3\xcb\xe3\x0b\xf9\x75\xbb\x4d\x92\x7c\x75\x25\x16\xbf\xae\x66\x1f\x3e\x5f\x7c\x7c\x18\xff\x81\xd2\x68\xa7\xf4\xd7\x2a\x3d\xbd\x4c\x97\x0f\x5f\xee\xf0\xf3\xdd\xfb\xbb\xf0\xcb\xac\x0b\xd2\xdf\x5a\xf6\x36\x7a\xf8\x59\x06\xf3\x68\x59\x93\x7a\x76\x94\xdc\x60\x22\x82\x5e\xe9\x2e\x54\x87\xbb\x48\xf5\x0e\x58\xf7\x51\x18\x6e\xb6\x67\x84\x1a\xa9\xb6\x53\x18\x8f\x87\x19\x22\x68\x2d\xd5\x35\xb6\x52\xf3\x6f\xa6\x5a\xb2\xb5\x58\xf8\xa5\x6c\xf8\x82\x18\x2e\x85\x9b\x73\x19\xfa\x40\xb8\xf8\x2e\x5e\x86\x44\xc2\x0f\xd7\x3d\x60\x7e\x1c\xc1\x63\x80\xf4\xf6\xbc\x81\xcb\x6e\x89\x8a\x53\x38\x3f\x01\x59\x39\xb0\x3c\x82\xc5\xa0\x63\x9f\xb7\x24\x18\xa4\x8e\x76\xc9\x81\x86\x6b\x63\x25\x85\x64\xf8\x1c\x57\xad\x92\x2b\xee\x26\xa4\xd3\xfd\xc8\x80\x9d\xa1\x7f\x98\xec\x28\x99\x84\x61\x32\x09\x7d\x7f\x12\x87\xdf\x26\x3c\x08\x4f\xa2\xf7\x52\xde\x5d\x70\x4e\xaf\x7e\x5d\xcf\xeb\xf9\xd1\xc7\x74\xf3\x9e\xce\xe4\x45\x95\x5e\x5f\x7d\xfc\xf9\xac\x5d\x57\x81\xca\x92\xf5\xc5\x26\xbc\xbf\x8e\xda\x63\x16\x8c\xbf\xa7\x3e\x4f\x27\x61\xe0\xbf\xa4\xfe\xea\xfe\xc3\x61\xfe\x76\xf6\x4e\xad\x4e\xef\x8f\x8a\x35\x7b\x90\xb7\xf4\xf0\x70\x79\x7c\xff\xae\x2d\x70\xbb\xbd\x8f\x6f\x4e\xf3\xc5\x99\x8a\xea\xf9\xe5\x6f\xe3\x21\x46\xa7\x03\xb8\xf7\x99\x38\x3f\x01\x0f\x86\x6c\xbc\x04\xff\x78\x10\xbe\x20\x36\x3c\xc0\xb0\x6d\xe4\x16\x19\xdc\x2c\x89\x32\x70\x3c\xa0\x4a\x43\x25\x95\x0b\xe8\x82\xaf\x50\x3c\x09\xe5\x73\xe4\xc1\x8b\xd0\xf3\x37\x65\xe8\x57\x09\x32\xdf\xcf\x8a\x98\xfa\x94\x52\x9a\xf8\x79\x19\xb0\xa2\x22\x79\x1e\x96\x69\x14\x90\xa8\xaa\xd2\xe0\x15\x90\xfa\x9b\x30\xf4\x7d\x96\xd3\x22\x08\x93\x24\xa0\x94\xd1\xaa\x48\x7d\x16\xf9\x61\x15\x05\x39\x8b\x90\x62\xca\xa2\x22\x29\x5e\x83\xb3\xbf\xf1\x03\x42\xa3\xa0\x08\xca\x2c\x0d\x31\xf1\xb3\x90\xd2\x30\xc1\x2a\xa1\x04\x19\x06\x09\x09\xb2\x3c\xf6\x49\x5e\x0c\xc0\x7f\x2f\x57\xa4\xf7\xfc\x11\x4c\x4b\x54\x82\x34\x35\xf2\x45\x6d\x06\x18\xbd\x79\xf3\x66\x88\x69\x2f\x71\x76\x78\x35\xfc\xf6\xe0\xce\xd2\x15\x17\x55\xa7\x08\x6c\x65\x07\x0b\xcb\xb3\x02\x50\x29\xa9\x2c\x40\xe6\x35\xd7\xa0\xf0\x4b\x67\x77\xe1\x1a\x84\x34\xa0\xbb\xb6\x95\xca\x20\x83\x12\x29\xe9\x34\x5a\x49\xe5\xf0\x6f\x97\xa8\x4e\x08\xcb\x95\x8e\x09\xb5\x21\xc6\x16\x41\x67\x87\x26\x70\xdd\x89\x7e\xdc\xf3\x86\xb1\x9f\x88\xa2\x35\x5f\xe1\x64\xfc\x8f\xc1\x28\x80\xb5\xad\x21\x23\x81\xc9\x7f\x3a\x09\x02\x8d\x63\xe1\x96\x28\x6e\xb6\xfd\x46\x4e\xcb\x83\xf3\x07\x17\xd3\xfe\xe7\xa7\x61\x81\xe7\xd1\x9a\x70\xf1\x53\x3f\xed\x79\xd6\xda\x9f\x22\x3f\xf2\x63\xf0\xbc\x35\x51\xed\xf0\xcf\x2b\x89\x52\x1c\x15\x24\x69\xee\xfb\xbe\x0f\x9e\x27\xa4\x47\x04\xe5\x28\x8c\x57\x36\x92\x3e\xe8\x7e\x4c\xa3\x5a\xa1\xd7\xd8\xa0\x82\xe7\x2d\xc9\xc6\x6b\x6d\x99\x42\x98\x58\x21\x2d\x48\xab\x6b\x69\x86\x41\x37\xb6\xe4\xe2\xc9\x4f\x6b\x33\xa1\x86\xaf\x10\x3c\xcf\xc2\xd3\x86\x48\x56\xd5\xf3\x48\x80\xe7\xb1\xd2\xa3\x72\xd9\xda\xf5\x52\x80\xd6\xcc\xba\x44\x68\x8d\x9e\xe6\x5f\x11\x62\xbf\x48\xc1\xf3\x3e\x6b\x29\x54\x4b\xbd\x5a\x6a\xa3\x81\x34\xcd\xa3\x31\x2e\x0c\xaa\x8a\x50\xb4\xe3\x9f\x9e\xa6\xfb\x79\x30\xbf\x97\xf9\x23\xeb\x3e\x32\x5b\x4d\x02\x7b\x43\x8c\x84\x3b\x2c\x6f\xec\xb8\xd1\xe0\x62\xa2\xa0\x52\x72\x09\x9d\x30\xaa\xd3\x16\x12\x52\xf1\x05\x17\x53\x98\x4c\xc6\x2f\xe6\xd3\x96\xed\xb3\x5c\x7e\xf2\xbc\x4e\x68\x52\xa1\x87\x9b\x56\x6a\xfc\x04\x55\x43\x16\xdf\x00\xf8\xbf\xe3\xea\xf0\x2f\x72\xf5\x93\x5a\xfa\x8f\xd9\x3a\xf0\xe3\x49\x90\xc4\x93\x20\x9f\x24\xcf\x8e\xe7\x1d\x9d\xce\x74\xca\x09\xde\x76\x67\xf7\x97\x5d\xf0\x76\xb3\xd2\xdb\xa3\xf9\x8d\x9a\xeb\x62\x65\x8e\xd2\xd2\x7c\x38\x14\xef\xce\xe4\xc5\xe7\xf2\xe1\xeb\x31\x19\x7f\x47\x7d\x32\x09\xf2\x64\x12\x46\xd9\x8b\x1b\x1c\xbf\xa5\x6b\x3e\xff\x2c\xdf\xdf\xbd\xab\x8e\x48\x9c\x87\xb7\x33\x43\xf0\x76\x73\x79\xb1\x66\xf9\xd7\x52\x1c\x05\x37\xd9\x1a\x0f\xef\x6f\x37\xf7\xaf\xf3\xb5\x23\x8d\x17\xd9\x3a\xfc\x1b\xe8\xfa\x15\xb6\x8e\x69\x1e\xfb\x45\xe1\xd3\x04\x8b\xb4\x8a\x69\x1c\x27\x79\x9c\xa7\x2c\x8e\x69\x9a\x23\xcb\xb0\x48\xd0\x67\x49\xf8\x2a\x5b\xa7\x61\x52\x16\x09\x8b\x33\x3f\x61\x59\x42\xe3\x3c\x61\x41\x96\x45\x34\x0b\xfd\x80\x64\x51\x1c\xa5\x71\x84\x41\x50\xbd\xce\xd6\x79\x55\x86\x58\x95\x59\x56\x86\x2c\x67\x7e\x41\xb2\x22\x2a\x59\x14\x44\x58\xd2\x3c\xf2\x49\x86\x99\x5f\xf8\x65\x36\xb0\xf5\xb5\x6c\xb5\xc1\x67\x7c\xcd\xe4\xa2\x25\x86\xd6\x7f\xae\x1b\x89\xfe\x22\xc2\x77\xbb\xc3\x0f\xf3\x5f\x4e\x7e\x01\xaa\xd0\xd2\xb5\x1a\x4c\xb5\x28\x77\x7a\x7e\x7c\x11\xf4\x7f\x7b\x93\xf2\xff\x6b\x53\xfa\x20\xbc\x04\xfc\xe8\x7f\x8b\xfb\xa0\x24\x41\x5e\xa6\x41\x14\x65\x15\x09\xc2\x20\x8a\x8a\x28\x2a\xca\x24\x89\xb3\xc8\xa7\x3e\x66\x7e\x59\x90\x3c\xa0\xaf\xe2\xbe\xaa\x92\x2a\x4a\xaa\xb4\x8a\x8a\xc0\x47\x96\xa6\x24\x8c\xcb\x14\x93\x24\x89\x43\x4c\xd3\x32\x4f\xf3\x38\x48\x49\xf4\x3a\xee\xe3\xdc\x76\x25\x59\x1a\x15\x98\xe7\x39\xa6\x69\x56\x85\xb6\xd7\x29\x8b\x34\x4d\x22\x86\x7e\x12\x87\x49\xc0\xf2\xf1\xc8\x5e\xc1\x88\x21\x70\x63\xa4\x22\x0b\x1c\xe9\xfe\x7f\x7f\xb1\x9a\x11\x53\xbb\xe8\x34\xb6\x75\x3f\x39\x82\x8a\x37\x38\xb2\x9b\x9a\x7a\x0a\x07\x66\xd9\x1e\xfc\x7e\xc1\xfb\x17\x23\x86\x4c\xdc\x4a\x56\x5a\xbd\xc7\x52\x54\x7c\xd1\x29\x67\xd6\x7e\x03\xea\x46\x6f\xfe\xfc\x36\xbd\x82\x67\xbb\x1d\x52\x2a\x3b\x61\x34\x3c\xe0\x16\x06\x2f\x46\x64\x18\xb4\xfb\x3c\xe0\xd6\x0e\xe3\xa0\x71\x37\x65\x65\xcf\xf7\x27\xf1\xda\x82\xc8\x81\xe1\x70\x76\x0e\x44\x30\x98\x85\x33\xb8\xe9\x8f\x51\x5b\xb7\x28\x6c\x61\x8e\x6c\xc9\xbd\x93\xda\x08\xb2\xc4\x29\xf8\xee\x4a\xe6\x8f\xde\xc0\x4c\x2a\x33\x28\xb1\x0a\xbe\x2f\x68\x17\x4d\x21\xf7\xf3\xd0\x6e\x6e\x2b\xd5\x33\xd2\x75\x22\x40\x1f\xc7\x4c\x8f\xda\xb0\xed\x43\x74\xd3\x22\xe5\xd5\x16\x4e\x37\xc6\x1d\x78\x70\x3e\x7b\x64\xab\x3b\xa1\x29\x11\xf6\x82\xab\xd0\x36\x21\x0c\x88\x01\x5e\x41\x89\x35\x17\x0c\x2e\x0f\xe7\x56\x0d\x0e\xd2\xe7\xb3\x29\xac\x27\x9b\xc9\x76\xf2\xb5\x4f\x80\xb5\xba\xd3\xc8\xf6\xa5\x60\xbd\x6e\xc8\x16\x95\x4d\x83\x33\xd7\x15\xb2\x5b\x3d\xe7\x4b\x94\x9d\x73\x53\x80\x6c\x51\x0c\xb7\xee\xa1\x05\x71\xc4\xe5\xda\xaa\x11\xec\x86\x07\x91\x29\x8c\x23\x5f\x3b\xd0\x5d\x75\xd8\xe1\x37\xee\xba\xdd\x89\xde\x0a\x5a\x2b\x29\x64\xa7\x2d\x17\x52\xd4\x9a\x8b\xc5\xe8\x8b\x15\xe8\x83\xd1\xbf\x19\xe8\xde\xf5\x6e\x59\xa2\xb2\x6c\x6a\xab\x1e\x95\x3e\xa0\x52\x68\x4b\xd0\x03\xb3\xae\xed\x2d\xae\x74\x3d\x96\xa4\xc4\xf4\x91\xd1\x86\x28\xd3\xb5\x23\xb0\xf2\x77\xbd\xe0\x14\x7a\xf7\xce\x14\xa2\x86\xae\x85\xe3\xd9\x2d\xd0\x2d\x6d\x50\xf7\xae\xf6\x1b\xd8\xf6\x79\x4d\xb8\x7b\x6a\xb0\xf6\xe2\x0a\x2d\x8a\x60\x98\xbe\x23\xdc\x79\xfb\xe1\x66\x0a\xc1\x68\x38\x2d\x06\x0b\x15\x1a\xc5\xd1\xb5\x81\x72\x3d\x04\x9b\x80\x21\xda\x9e\x16\xf6\xdf\x75\xbf\x60\x0a\x81\x6f\x63\xb4\x27\x3d\xed\xb2\xcf\xe9\xd3\x78\x8d\x76\x94\x37\x40\x04\x1b\xb4\x6c\xb6\xae\x39\xad\xf7\x74\x08\x03\xce\x6d\x52\xec\x35\x60\x38\xb0\xa4\x8d\xdf\x70\xd2\x30\xe0\x7d\xbf\x47\x3b\x6d\xe4\x72\xd8\x64\x57\x84\xc3\xab\xcc\x50\x5e\x97\x0e\xef\xe3\x25\xe1\x62\xbc\x7f\x7b\x71\xf5\x3d\x28\xde\xef\x4b\x1b\xdb\xa1\xf7\xd0\xfc\x61\x8d\xee\x82\xc2\x15\xc2\x5a\x83\x54\xc0\x5b\x3a\x3c\xc8\x90\xb2\x41\xfb\x49\xdd\x19\xd7\x47\xd3\x9e\x65\x56\xf0\xf6\xfa\x62\x0a\xb5\x31\xed\xf4\xe0\xc0\x75\xc4\xb6\x8d\x9e\x16\x49\x9c\xec\x70\xe0\x1e\x8c\x16\xc4\xfa\xc2\xa9\x35\x77\x41\xf4\xcc\x7e\xda\x18\xee\xfe\x9e\x2d\x6e\xf8\x92\x9b\x7e\xf1\x85\xfd\x9c\x42\x9c\x05\x61\x94\xe7\x4f\xf0\x6d\xa4\x4b\x74\x9f\x26\xf1\xbb\x67\x46\x11\xa1\xc9\xbe\xdd\xb6\x3e\x30\xd6\x3f\x30\x11\x70\x37\x12\x47\x1c\xbd\x2b\x60\x14\x5f\x2c\x50\x21\xeb\xab\xc1\xe0\xc6\xec\x30\xd2\x57\x44\xea\xdb\x92\x78\x69\x63\x85\x84\x81\x14\xcd\xd6\x56\xda\xae\x4e\x76\xaf\x6c\x3b\x93\x7e\x57\x7d\x8d\x84\x3d\x55\x1f\x24\x83\xf6\x4b\x9b\x89\xc7\xb6\xb7\x52\x36\xb0\x24\x9b\x3d\x2e\x8d\x04\x8d\x82\x59\x4c\x3e\x5a\x26\x57\x8e\x05\x96\x64\xb3\x87\x67\x38\xc4\xf4\xfb\x2a\xdd\xbd\x66\x45\x1a\xa7\x77\xdb\xd7\x0e\xb1\x06\xd2\x4e\x29\xf7\xf8\xf3\x48\xa2\x26\x1a\x4a\x44\x01\x0c\x0d\x52\xe3\xc2\xb4\x53\x60\xf7\xb3\xa7\x62\x38\x78\x70\xc2\xb5\x43\x8b\xd3\xa8\xe5\xf2\x19\xda\x34\x30\xf9\xf8\xfa\x0b\x66\xe3\x2c\x22\x2d\xb7\x15\xb6\x99\x49\xd9\x1c\x52\xcb\x28\xa7\xc2\x6a\x62\x53\x30\xaa\x43\x5b\x6b\x44\x6c\x81\x61\xd9\x2d\x16\x03\x9b\xd9\x12\x70\xdc\xb1\x90\x60\x37\x19\xb9\xd9\xbe\xd4\xda\x56\xc9\xca\xa5\x67\x2f\x62\x79\xd2\x8e\x4e\xa1\x22\x8d\xc6\xd1\xa8\x3f\xdd\x87\x07\xc5\x56\x21\x95\x4b\x87\x34\xb7\xe1\xbf\x03\x00\x00\xff\xff\xd3\xea\x79\x07\x45\x15\x00\x00")
+var _goCentrifugeBuildConfigsDefault_configYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x3b\x69\x6f\xdb\x48\x96\xdf\xf5\x2b\x0a\x32\x16\x3b\x03\x58\x32\x75\xcb\x06\x06\x0b\x3b\x4e\x3a\xe9\x76\x1c\xc5\x76\x92\xe9\x2c\x1a\xdd\x45\xb2\x28\x31\xa6\x58\x6c\x1e\x96\x95\xc1\xfc\xf7\x79\x47\x55\xb1\x24\xd9\x99\x4c\x03\xb3\x3b\x7d\xc0\x12\x59\xf5\xea\xdd\x57\x3d\x1d\x89\x4b\x95\xc8\x26\xab\x45\xac\x1e\x54\xa6\x8b\xb5\xca\x6b\x51\xab\xaa\xce\x55\x2d\xe4\x52\xa6\x79\x55\x8b\x32\xcd\xef\x55\xb8\xed\x44\xf0\xb2\x4c\x93\x66\xa9\xae\x55\xbd\xd1\xe5\xfd\x99\x28\x9b\xaa\x4a\x65\xbe\x4a\xb3\xac\x73\x84\xc0\xd2\x5c\x89\x7a\xa5\x00\x1e\xc3\xcd\x79\x65\x05\x0f\x65\x2d\x5e\x38\x08\x62\x0d\xb0\x6b\x84\xdf\xb1\x4b\xce\x3a\x42\x1c\x89\x2b\x1d\xc9\x8c\x50\x48\xf3\xa5\x88\x34\x6c\x90\x11\xe0\x12\xc7\xa5\xaa\x2a\x55\x01\x44\x15\x8b\x5a\x8b\x50\x89\x0a\x90\xdc\xa4\xf5\x4a\xa8\xfc\x41\x3c\xc8\x32\x95\x61\xa6\xaa\x3e\xc0\x31\xfb\x11\xa4\x10\x69\x7c\x26\x46\xa3\x11\x7d\xae\x4b\xa5\x5e\xcb\x6a\x75\x9e\x2d\x75\x09\x5b\xd7\x67\xa2\x5a\xc9\xe1\x64\x4a\x6f\x15\xa0\x5e\xaa\x66\x6d\xe8\x7b\x03\x1b\xe7\xa3\x39\xef\x0c\xb5\xae\x2b\x40\xa6\x58\x28\x55\x56\x0c\xb9\x27\xba\x27\x69\x31\x3e\x19\x0c\x67\xfd\x00\xfe\x1d\x9c\xd4\x51\x71\x32\x9a\x0f\x83\x21\x3c\x4f\xaa\x93\xf7\xeb\xbb\xf7\x8f\xe1\xe6\xbe\xf9\xfc\xf3\xcf\x97\x49\xf3\xf5\x2e\x7c\x7c\x79\x7e\xa3\xee\xae\x5f\x5c\xe9\xaf\xdb\xed\x64\x32\x7f\x78\x9f\x2f\x3f\x3e\x2c\xde\x7e\xb9\xfa\xf9\xbe\xfb\x4f\x80\x8e\x2c\xd0\x8f\xc9\xf4\xe5\xf5\x74\x7d\xff\xfb\x27\xf5\xe5\xd3\x4f\x9f\x86\xbf\x2f\x9a\xc1\xf4\xaf\x45\xfc\xc3\xe8\xfe\x47\x3d\xb8\x1b\xad\x57\x72\xb5\xb8\x98\xdc\xaa\x49\x3e\x60\xa0\x96\x91\xe7\x96\x8f\x4c\x00\x32\x07\x64\x92\xd6\xdb\x57\xf0\x52\x97\xdb\x33\xd1\xed\x9a\x37\x32\x8f\x56\xba\xbc\x51\x85\xae\xd2\xbd\x57\x85\xdc\xa2\xa6\xbc\x0b\xb3\x74\x29\xeb\x54\xe7\xf4\x8e\xe4\xf7\x16\x64\xfa\xa4\x36\x19\x31\x8b\x3f\xdd\xb0\x3a\xfd\x19\x96\x7b\xea\xc3\xf8\x1c\x89\xeb\x66\xad\xca\x34\x12\x6f\x2e\x85\x4e\x48\x95\x3c\xa5\x31\x30\x9c\x54\x27\x03\xb3\x0b\x45\x2a\xa4\x95\xa9\xdd\x19\xeb\xa8\x61\x1c\x40\xea\xd5\xb1\x91\xb4\xd0\xa5\x08\x33\x79\xaf\x86\x61\xcf\x0a\xfe\xdb\x6a\x71\x24\x2e\xac\xf0\x45\x96\x82\x45\x00\xfc\x5c\xc7\xea\x50\xab\x8b\x52\x3f\xa4\xf4\x42\x13\x06\x1e\x81\x96\x11\xff\x54\x99\x46\x93\xfe\x70\x08\xff\x07\x41\x7f\x3c\xdc\x57\xa8\xc1\xf0\x72\xf4\x93\xd6\x9f\xae\xd2\x34\x7a\xff\x71\x73\xb7\xba\xbb\xf8\x79\xfa\xf8\x53\xb4\xd0\x57\xc9\xf4\xe6\xfd\xcf\x3f\xbe\x2a\x36\xc9\xa0\x9c\x4d\x36\x57\x8f\xc3\xcf\x37\xa3\xe2\x45\x3c\xe8\x3e\x05\x7e\x3e\xed\x0f\x07\xc1\x73\xe0\xdf\x7f\x7e\x7b\x3e\xff\x61\xf1\xba\x7c\x78\xf9\xf9\xe2\x74\x13\xdf\xeb\x0f\xd1\xf9\xf9\xfa\xc5\xe7\xd7\xc5\xa9\xda\x6e\x3f\x8f\x6f\x5f\xce\x97\xaf\xca\xd1\xea\xee\xfa\xaf\x5d\xc3\xa3\x97\xc6\x78\x9c\xa4\x41\x84\x3d\x61\xa4\xfd\x9c\x79\x8d\xcd\xe6\x2b\x89\xec\x01\xc5\x29\x32\xbd\x05\x03\xbf\x5d\xcb\x12\x38\x6b\xb4\xb6\x12\x09\x08\x0d\x19\xba\x4c\x1f\x54\xbe\xc3\xca\x43\xcd\x16\xcf\xaa\x76\xf0\x18\x0e\x83\x64\xa2\xe2\x20\x98\x9d\x8e\xa3\x20\x82\x7f\x26\xc1\x3c\x1c\xc4\xa7\x89\x9c\xcf\x87\xe1\x74\x34\x90\xa3\x24\x99\x0e\xbe\x61\x04\xc1\xe3\x10\x64\x13\xcf\xa3\xd3\xc1\x70\x32\x19\x44\x51\x1c\x25\xa7\xd3\x20\x1e\x05\xc3\x64\x34\x98\xc7\x23\x15\xa9\x69\x3c\x3a\x9d\x9c\x7e\xcb\x5c\x82\xc7\x60\x20\xa3\xd1\xe0\x74\x10\xce\xa6\x43\x35\x09\x66\xc3\x28\x1a\x4e\x54\x32\x89\xa4\x8a\xd5\x60\x22\x07\xb3\xf9\x38\x90\xf3\x53\x63\x58\x3f\xe9\x07\xc9\x94\x7b\x66\x10\xaa\x32\x97\xd9\x4a\xa5\xcb\x55\x6d\xd4\xe8\xe8\xe8\xc8\xf0\x94\x77\xbc\x3a\x7f\x6f\xbe\xf7\xc4\x27\x74\x96\x69\x9e\x34\xa5\x14\x5b\xdd\x88\x25\x7a\xf9\x5c\xa8\xb2\x04\xf6\x82\x82\xdc\xad\xd2\x4a\x94\xea\xf7\x06\x4f\x81\x8f\xb9\xae\x45\xd5\x14\x85\x2e\x6b\x90\x49\xa8\x22\xd9\x54\x0a\x77\x96\xa4\xff\xb8\xa4\x6c\xf2\x1c\x3d\x35\xf9\xe1\xaa\x06\x31\x82\x11\x34\xf8\xa8\x2f\x6e\x9a\x9c\x9f\xf7\x7a\xe6\xd9\x5f\x64\x19\xad\x40\x84\xfd\xee\xb1\x41\x4a\x88\x0d\xda\x10\xd8\x4b\xac\xff\x87\x76\x48\x91\x51\x0c\x28\xc0\xa1\xd7\x5b\x3e\x88\xa0\xdc\x13\x3d\x6a\x79\xc6\x5f\x7f\x33\x0b\x7a\xbd\x68\x05\x4e\xe7\x2f\xfc\x1a\x8e\x02\x6c\xff\x32\x0a\x46\xc1\x18\xbe\x6c\x64\x59\x98\x3f\xbd\x50\x96\x65\xaa\x4a\x31\x99\xce\x03\xf8\x07\x1e\xe7\xba\x07\x02\x4e\x41\x36\xbd\x10\x0e\x85\x30\x45\xcf\x2a\x55\x3e\xa8\x5e\x86\x4c\x85\x07\x6b\xf9\xd8\x2b\xd0\x4c\xc5\x70\x82\x9b\xaa\x5c\x16\xd5\x4a\xd7\xe6\x21\x3d\x5b\xa7\xf9\xce\x57\xc4\x19\xb4\x0e\x28\x85\x6f\xa8\x9e\xc8\x22\x9d\x24\x87\x9c\x80\x27\x71\xd8\x8b\xf4\xba\xc0\xf5\x3a\x17\x55\x15\x23\x49\x32\x5a\xa9\x5e\x95\x7e\x55\x62\x1c\x9c\x4e\xe1\xc9\x97\x4a\xe7\x65\x11\xf5\x56\xba\x02\x7b\x90\xe0\x50\xda\x67\x10\x4a\x55\x99\xc8\x48\xe1\xf3\xdf\x76\xc5\x7d\xc8\xcc\xa7\x24\x7f\x81\xe4\x83\x8c\xc1\x9a\x72\xc5\x88\x80\x48\x3e\xa9\xf0\x16\x9f\xc3\x81\xc4\x93\x52\x24\xa5\x5e\x8b\x06\x2c\xae\xa9\x50\x25\xc0\x59\x2e\x53\x50\xe7\x7e\xbf\xfb\xac\x3c\xd1\x6c\x0f\x64\xf9\x5b\xaf\xd7\xe4\x95\x4c\x54\x4f\x3d\x82\x6d\xa9\xdf\x44\x92\xc9\xe5\x9e\x02\xff\x6b\xb1\x60\xf8\x1f\x1e\x0b\x76\x6c\xf5\xbb\xa3\xc1\x20\x18\xf7\x07\x13\xf8\x7f\xde\x9f\x0c\x9e\x73\xd7\x8b\x6a\x9a\x4a\xf5\xa1\x79\xf5\xf9\xba\x19\xfc\xf0\xf8\x50\x6d\x2f\xee\x6e\xcb\xbb\xea\xf4\xa1\xbe\x98\x86\xf5\xdb\xf3\xfc\xf5\x2b\x7d\xf5\x25\xbc\xff\xfa\x42\x76\x9f\x00\x3f\x01\xf0\x10\x16\x46\xb3\x67\x0f\x78\xf1\x43\xb4\x49\xef\xbe\xe8\x9f\x3e\xbd\x4e\x2e\xe4\x78\x3e\xfc\xb0\xa8\xe1\xc4\xc7\xeb\xab\x4d\x3c\xff\x1a\xe6\x17\x83\xdb\xd9\x46\x9d\x7f\xfe\xf0\xf8\xf9\xdb\xf1\x80\x9c\xd2\xb3\xd1\x60\xf8\x6f\x08\x07\xdf\x88\x06\xe3\x08\x5c\xec\xe9\x69\x10\x4d\xd4\xe9\x34\x19\x47\xe3\xf1\x64\x3e\x9e\x4f\xe3\xf1\x38\x9a\xce\x55\x3c\x53\xa7\x13\x15\xc4\x93\xe1\x37\xa3\xc1\x74\x38\x09\x4f\x27\xf1\x78\x16\x4c\xe2\xd9\x24\x1a\xcf\x27\xf1\x60\x36\x1b\x45\xb3\x21\x78\xf8\xd9\x68\x3c\x9a\x8e\x47\x6a\x30\x48\xbe\x1d\x0d\xe6\x49\x38\x54\x49\x38\x9b\x85\xc3\x78\x1e\x07\xa7\x72\x76\x3a\x0a\xe3\xd1\x60\xa4\xc2\x68\x3e\x0a\xe4\x4c\xcd\x82\xd3\x20\x9c\x99\x68\x70\xa3\x0b\x30\xc0\x83\x78\x10\xeb\x65\x21\xeb\x68\xf5\xc7\xb2\xa9\xd1\x7f\xb8\x05\x59\xea\xc4\x9f\xee\xde\x5d\xbe\x13\x51\xa9\x30\xdc\x94\x86\x15\x68\x45\x04\xe7\xcf\xcf\x1a\xd5\xbf\x3d\xc9\xfa\xff\x4b\xb3\x98\x09\xcf\x19\xd6\xe8\xff\xd6\xae\x06\xa1\x1c\xcc\xc3\xe9\x60\x34\x9a\x25\x72\x30\x84\xbf\xa7\xf0\x5f\x38\x99\x8c\x67\xa3\x20\x0a\x40\x95\xc3\x53\x39\x1f\x44\xdf\xb4\xab\x24\x99\x24\xa3\x49\x32\x4d\x46\xa7\x83\x40\xc5\xd3\xa9\x1c\x8e\xc3\xa9\x9a\x00\x94\xa1\x9a\x4e\xc3\xf9\x74\x3e\x1e\x4c\xe5\xe8\xdb\x76\x35\x9e\x63\x56\x35\x9b\x8e\x4e\xd5\x7c\x3e\x87\x7d\xb3\x64\x88\xb9\x5a\x78\x3a\x9d\x4e\x46\xb1\x0a\x00\xda\x64\x10\xcf\xc1\xae\xa0\x80\x95\xb5\x14\xb7\x80\x81\x5c\xaa\x4e\xc5\x7f\xb9\x2c\x5d\x48\x08\x5a\xc8\x9d\x0c\x4b\x9b\xcb\x0b\x91\xa4\x99\xea\xe0\xa1\xf5\xea\x4c\x9c\xd4\xeb\xe2\xa4\x2d\x8f\x7f\x8d\x01\x4e\x9f\x56\xc6\xa1\xdb\xde\x72\xd7\x87\x51\x1d\x98\x17\xd0\x91\x69\x19\xd3\x0b\x19\x45\x1a\xa2\x6d\xc5\x41\x53\x42\x6e\x56\x21\xbf\xa3\xad\xa8\xe5\xf2\x58\x14\x10\x91\xe1\x43\x9f\xce\xb8\x34\x00\x0e\x37\xea\x06\x82\x3d\x2e\x14\xb2\x84\xaa\x19\xe8\x02\xd1\xa7\x36\x32\x23\xe0\x50\x3f\xa8\x63\x23\x06\x48\x2e\x72\xa8\xb0\x4b\x09\xf1\x99\x32\x81\x8a\xb6\xc9\x6c\x23\xb7\x95\xdd\x4d\x2a\xc6\xe7\x3a\x9c\x98\x53\xa0\x7f\xcd\xd3\x1c\xf9\x55\x35\x8e\x29\xc0\x6c\xd0\xba\x24\x5d\x42\x2a\x4a\xd9\x86\xe5\x7a\x44\x4f\x6f\xff\x38\xef\x19\xc0\xae\x08\xe0\xb4\x73\xcb\x90\x7b\xb5\x15\x46\xb4\x1d\xcb\x25\x3c\x07\x9e\x13\x6d\x06\xa2\x7d\xd5\x31\x6e\x14\xa2\xf7\x0e\x5f\xef\x55\x51\x43\x22\x64\xd2\x26\x12\x0f\xf0\x24\x2d\x09\x8e\x40\x01\xaa\xf8\xd8\x48\x5b\x62\x13\x45\x21\x8e\xd9\x56\x40\x06\x1d\xb7\x60\x90\xb3\x0e\x0a\x35\x5c\x04\x30\x03\xd6\x30\x6f\xcf\x9f\x5c\x07\x2b\x80\xb0\x92\x60\x89\x34\x11\x01\x2e\xb6\x6f\x5f\x60\xe6\x78\x0b\x89\xe3\x99\x60\x8f\xfb\x24\xfa\x0e\x16\x1c\x08\xc9\x19\xa4\x8d\x2a\x43\xb8\x90\xc1\x97\x48\x57\x9d\x66\x84\x3c\xe5\xa1\x98\xe7\x27\x4d\x96\xf5\x9f\xc7\x27\x49\x4b\x20\xd2\xc7\x07\x52\xee\xf5\x87\x02\x84\x1c\x35\x65\x49\xea\x21\xe6\x28\x88\x37\x2e\x57\xdd\xa0\x0e\xd1\x29\xe7\x8b\x37\xa4\x74\x8b\xe1\x42\xdc\x72\xa2\x89\x91\x41\xe5\xe8\xfa\x3b\xe8\xd4\x5f\x43\xd6\x9b\xcb\x35\x10\x15\x50\x53\x24\x00\x48\x0b\x48\xf4\x0d\x10\x04\xf0\xf4\x46\x5c\x04\x27\x07\xf3\x21\x1e\x8e\xb1\xa0\x57\x6b\xca\xd5\x45\xe4\x2b\x60\xd5\x29\x86\x05\xeb\xdb\x6d\xa1\xa2\x34\xd9\x8a\x97\x8f\x35\xa5\x6c\xe2\xcd\xc2\xc3\x95\x72\xd8\x08\x72\xe7\x10\x42\x8f\x42\xf6\x80\xe0\x6a\x24\x3b\x54\xab\x14\x88\xb8\x3e\xbf\x43\x30\xca\xec\x7e\xb3\x80\x7a\xa5\xff\xd8\xdf\xf6\xbf\xb2\x36\x23\xd6\xa4\x04\xd6\x1d\x20\xd5\x99\xdc\xaa\x12\x75\x9a\xd0\xa5\x50\xc1\xed\x94\x26\xab\xd3\xb6\xf9\xe5\x10\xb0\x54\xea\xbc\x6f\x7b\x78\x14\x26\xb1\x2e\xc0\x45\xae\x22\xc0\x25\x6c\xe8\x78\x2e\x19\x3a\xe2\xaa\xd6\x45\xbd\x25\x1d\x63\x48\x5e\xf5\xfc\xbf\x1c\xc0\x0c\x9f\xdb\xd0\xd5\x3d\xa6\xd0\x36\x3d\x39\x3b\xf3\x1e\xfe\x02\x30\x0e\x41\xfc\xf2\x14\xf2\x32\x06\xf9\xd4\x69\xc5\xfd\x3b\xc2\x89\x4a\x26\x6c\x32\x2a\x19\x5b\x47\xc8\xd0\xda\x8e\xdf\x31\x31\x8a\x33\x84\x96\xc5\xc6\xe7\x79\xa4\xe3\x5e\xe5\x89\xec\x9f\xd0\x6d\x97\x1e\x52\x6e\xa4\xb5\x47\x79\x9c\x57\xe3\x13\xc4\xa2\xaf\x1e\xe5\xba\xc8\x54\x1f\x8a\xb6\x3d\x46\x3c\x05\x93\x59\x71\x97\xae\x15\xba\x61\xd0\x23\xb0\xf0\x42\xe5\xa6\xbb\x69\x8a\x2d\x22\x80\xb8\xd1\x11\xf6\xb1\xd9\x02\x51\x6c\x14\x54\xdd\x3d\x43\xc6\xc0\x8e\xbc\x83\x14\x09\x64\x5c\x21\x34\xea\x37\x81\x97\x81\xd0\x1d\x1b\x57\x0e\x1f\x6c\x58\x41\xc0\xe6\xed\x27\xde\xcb\xcd\x17\x1f\xe8\x37\x76\x83\x39\xa7\xd4\x91\x45\x59\x48\x73\x3c\x88\x03\xbe\x2a\x4f\x94\x14\x28\xaa\x7b\x96\x70\xa9\x6a\xf0\x51\x19\xa4\x1c\x65\x7b\xf8\xfb\x46\x35\xc6\x3d\x0d\x82\x80\x10\x78\xf9\x08\x06\x87\xd5\x24\x58\x20\xc1\x81\x94\xa6\xa2\xd8\x76\xd9\xe6\xad\x91\xce\x32\x90\x22\x18\x2b\x44\xa8\x3e\x06\x0f\x9f\x75\xda\x54\xa6\x52\x14\x29\xbc\x88\x69\x27\x22\x53\xaa\x2f\x04\x9b\x0e\x4a\x13\x87\x2a\x66\x4d\xb1\x56\x55\xfe\xdf\xb5\x58\x53\x4e\x49\x6f\xd2\xfc\x18\x3d\x22\xe8\x5e\x6a\x6b\x60\x3a\x7c\xa5\xa2\x7b\xd7\x0f\xa7\x95\x80\x2a\x46\x12\x83\x9d\x4d\x82\x5c\x9a\xc4\xea\xc9\xc8\xb8\x04\x14\x83\x23\x35\x7e\x82\x21\xb6\x27\x82\x49\x30\x0d\x66\xc1\x1c\xf2\x7a\x19\x84\x90\x17\xc5\x81\x0a\x92\x41\x30\x18\x60\xca\x34\x18\x77\x41\xf4\x7f\x34\x15\x05\xe3\x4b\xf3\x74\x0d\x69\x62\x0d\x6a\x04\x82\xaa\x37\x4a\xe5\x46\x47\x12\x50\xce\x55\x9b\x79\x20\x2d\x2a\x8f\x0b\x0d\x4e\x83\x82\xc4\x0e\xb3\x39\x2b\x07\x87\x07\x9c\x6a\x5d\x1e\xd3\x77\x87\x26\x67\x77\x52\xeb\xa8\x07\xa0\x75\x86\x3a\x44\xf2\x78\x92\x37\x9c\x5f\xc0\x9b\x35\x68\x2e\x84\x61\xdc\x59\x61\xe6\x03\xb1\x02\x1e\x82\x46\xf5\x45\x20\xe2\xb4\xa2\x76\xbe\x8f\x33\x9e\x6a\xcf\xbb\xe1\x47\x14\x4e\x1e\x64\x86\xbc\x5a\x77\x9d\xad\x01\x11\x15\xda\x85\xce\x6d\xea\xb4\x43\x13\xe1\x6a\x9d\xf0\xd9\x21\x17\x1c\x15\x16\xd5\x15\x7c\x80\x1a\xe6\x5e\x09\x38\x2b\x85\xe4\x82\x43\x27\x95\xb2\x47\x60\xf7\x11\x2c\x58\x2a\x97\x07\xec\x9e\x86\x8a\x88\xad\xb4\x4c\x6b\x34\x8d\xa6\x30\x91\xde\x85\x59\x70\xe2\xb7\x8c\x2d\x9b\x50\x94\x69\xc4\x8d\x9c\x85\xd3\x58\x2b\x02\x93\x74\x71\xe4\x41\xc5\x97\x69\x56\x3d\x8d\x64\x8a\xe1\x61\x8f\x95\x86\x2d\x74\x4d\x62\x3e\xdf\xdd\x5d\x21\xfb\x02\xc3\x3f\x4c\xbe\x54\x0f\x60\xf5\x80\x39\x10\xc6\x63\xbc\xac\xc9\x42\xfd\xe8\x39\x62\x52\x0a\x44\x55\x27\x49\x86\x97\x3e\xd8\xf3\x01\xbe\x13\x58\xb3\xdc\x16\xa1\x9e\x15\x73\x12\xd9\xd3\x39\xed\xa1\x48\xb6\xd2\x59\x6c\xdd\x97\x51\x88\xea\x49\xa3\x07\x76\x40\xde\x07\xcf\xa9\x31\xc9\xdd\x47\x73\x7a\xdf\x9c\x74\xe7\xc3\xa0\x5c\x57\xc9\xcc\x0b\x37\xbb\x46\x0b\x1e\x29\x2d\xb0\xf9\x27\x2a\x7e\x6f\xc9\x64\x4e\x97\x18\x93\xe0\xf1\xda\x42\x7f\xb1\x83\x8d\x67\x16\x24\x0c\x0f\xb1\x98\x0b\x19\x93\x10\x3a\x84\x28\x67\x36\x4e\x88\x73\x37\x92\x07\x64\x35\xf6\xe4\x27\xe9\x00\x6c\x9b\x9c\x8e\x40\x21\xee\xf1\xc4\xaa\x96\x39\x12\xb5\xd2\x0b\x72\x5c\xd7\x77\x6d\xe9\x78\xb7\xef\x0a\xe2\x12\x3d\x9a\xe5\x87\x45\x82\x8f\x37\xbc\x48\xb1\xd7\x08\xfc\xa1\xa5\xbe\x4e\x12\xa5\x94\x2e\x56\x7c\x14\xad\x38\x30\x47\xea\x27\x80\x88\x77\xe5\xdb\xa2\x6b\x93\x20\x4f\xad\xec\x51\x2d\x43\x65\x65\x79\xe9\xf3\x89\x5a\x92\x67\xa0\xff\x59\xc5\x46\xb1\x2b\x20\xb4\x0b\x13\x65\x2b\x15\x35\xd4\x8c\x05\x37\x13\xb7\x4c\x43\x87\x24\x29\xf2\x78\x09\xaf\x06\x64\xf5\x06\xb3\x62\x4e\x3e\x5a\xf1\x91\x92\xac\x09\x2e\xaa\x3b\x7a\x2b\x44\x24\x4a\xcb\xa8\x49\xeb\x0b\x10\x11\xc4\x44\xaf\xf3\x62\xa2\xaa\x7f\x3e\xee\x85\xe3\x18\x0d\x9b\x06\xd0\xb1\x0c\xe4\xc0\x5a\xcd\x73\x11\x32\x74\x26\xbc\x5e\xa1\xe7\x03\x3c\xcf\xc4\xc4\x14\xe7\x8c\xb4\xe7\x04\xf7\x02\xe4\xa1\x35\x19\xba\xe2\xb6\x32\x41\xb6\x50\x59\x83\x88\x91\xe7\x2b\x15\x7e\x64\xa5\x8a\x4b\x5d\x14\x58\xd3\x90\xb1\xc8\x7a\x47\xa0\x74\x64\xac\xd1\x18\xe2\x54\x66\x6d\x46\xc0\xca\xe4\x27\x3a\x46\xd9\x58\xae\x98\x3c\x94\xe9\x7a\x4d\xa9\xc6\x86\xe2\x6d\xa6\x37\x9f\x30\x65\x78\xbe\x78\xa2\x5a\xe3\x98\x8e\xa4\x88\x41\xe6\x04\x2e\x18\x2f\x86\x57\xe9\x72\x45\xdb\x91\x93\xee\xcb\x2e\x4f\x0b\xe0\x56\xbf\xcd\xb3\x00\xab\x05\x3c\x61\xc1\xd9\xd3\xa1\x78\x0a\xe8\x81\x83\x01\xbc\x0e\x7c\x3b\xaa\x77\xe8\xa2\xdc\xfb\x20\xc6\x78\xdc\x4c\x10\x0d\xa2\x08\x09\x32\x7c\xb3\x36\x9a\xa9\x36\xd9\x73\x6e\xf8\x85\x11\x7d\xa9\x32\x2c\xbc\x23\x59\x42\x36\xb5\xef\x25\xf7\xd3\x62\x57\xef\x53\xda\x6b\xfc\x37\x5e\xc6\x50\x06\x06\x80\x9c\x53\x46\x31\xa1\x8c\xa1\x24\x44\x2c\x77\x72\xd1\x55\xa9\x9b\xe5\xca\x29\x1f\x63\xc0\xc8\xaa\x1c\x19\x09\x9a\x57\x97\x8d\x32\xb0\x6e\xf0\xfd\x1e\x43\x08\x3b\x8d\x2e\x8f\x71\xe4\xdd\x2b\x5d\xb4\x06\xeb\xb6\x7a\x75\xcd\xbd\x52\x45\x65\xc1\xb0\xe7\x26\x24\x6d\xe9\x50\x39\xa4\xfc\xa2\x88\xd0\x6d\x35\x93\x73\x51\xf6\xca\xb0\xf2\x21\x95\xbe\x1f\x27\x74\x9e\x4f\xf6\xed\x1d\x3b\x61\x86\xa9\xdb\x9b\x4b\xca\xed\x85\xb0\x1b\x39\xa3\xbf\xc1\xde\x63\x96\xae\xd3\xba\xf2\x02\x0a\xe7\xcf\x4e\x42\x98\xc4\xda\x2c\xa7\xde\x16\xd8\x63\x29\x8a\x2c\x65\xc2\x08\x3f\x9b\x8c\x02\x95\xc7\xac\xce\x08\xb0\x8d\xe4\xa0\x45\x2e\xe3\x60\x9d\xb7\x3b\x77\x94\x0d\x62\x6c\x5f\xbc\xa4\xc7\x50\x9a\xa2\x7f\x11\x61\x53\xb6\x9a\x56\x59\x8f\x75\x8c\xb9\x54\x9a\x65\x5c\xb5\x96\x48\xc4\x0e\xb6\xe0\xaa\x74\x1e\xf7\xc5\x5b\xa7\x64\x0f\x94\xbe\x1f\x99\xda\x6c\x9d\xd6\x26\xb1\x4e\xc8\x18\x4d\x76\x85\x32\xa8\x6b\xf2\xb7\x7c\xa7\x54\xab\x2c\xb3\xce\x0d\xd1\x01\xd0\x1c\x3a\x4c\x5d\xd0\x17\xe7\x7c\x3a\xf0\x2e\x10\x54\x54\xac\x53\x2e\x64\x0c\x3e\x7c\x28\x30\x6d\xd7\x7a\x09\x05\x52\x67\xd8\x7d\x45\xfc\x67\x9d\x36\xf7\x96\xb7\xe9\x32\x97\x75\x53\x2a\xdb\xa0\xc4\x75\xd6\x51\x0a\xe6\x8a\xb3\x6e\xc4\xec\xdc\x14\x3b\xb6\x7f\xf6\x3d\xfb\x96\xaa\x7e\x7a\xf9\x20\xd8\x5d\x6f\x7c\x86\xc1\xed\x5a\x43\xbc\xdc\xfe\x4b\x18\x9a\x9d\x8b\x52\xeb\xa4\xfa\xae\xb3\x3c\xdc\x5e\xa7\x15\xb5\x53\xbf\xe3\x1c\x13\x59\x2f\x39\x30\x7f\xd7\x41\x76\x0b\x86\xeb\xbd\x0d\x7b\xeb\xb1\xf5\xc2\xb4\x9b\xf6\x89\xee\x55\xc0\x04\xab\x1f\x05\x12\x07\x8b\xf3\x38\xe3\x7e\x85\x21\xba\x93\xd3\x1e\x2e\x9d\xbc\x2c\x92\x1f\xf7\xc5\x62\x67\x9f\x2b\x51\xe8\x35\x54\x98\x6d\x2e\xd4\x71\x89\x50\xa7\xed\x93\xee\xf7\x7f\x5c\xad\xcb\xe7\xbd\xcb\x31\x24\xb2\x73\x84\x54\x00\xd0\x05\x80\x6f\x6e\xdf\x89\xf1\x70\x30\x13\xb6\xa9\x45\xde\x09\x9f\x8e\x06\xd3\x69\x6f\x00\xb9\x52\xb1\x92\xbd\xa1\xa0\x36\x59\x89\x35\x8f\xf1\x7d\x06\x20\xa2\x0f\x5e\x79\x2d\x6b\x97\xe3\x5a\x48\xb4\x74\xc7\xde\x29\xec\xb2\xee\x53\xc1\x55\x41\xac\x8c\xea\x17\xb0\xee\xa3\xcb\xef\xfd\xfc\xe7\x76\x25\x6d\x35\x5e\xa6\x60\x52\x60\xb9\x95\x75\xc5\xb2\x6d\x30\x3f\x59\xa4\x60\x7b\x26\x36\x45\x0d\xd7\xec\x76\xbb\x4b\xf9\x00\x45\xaa\x94\xa1\x7e\x81\xa7\xe4\xa6\x58\xbf\xf6\xe8\x73\xdd\x83\x12\xaf\x60\x2c\x9d\x0e\x19\x03\xc9\xa5\xd8\x94\xa7\x23\xe6\x87\xf4\xe2\x53\xab\xc3\x1e\x9d\x2f\xf3\xa8\xdc\x16\x9c\x81\x98\x7e\xb4\xd7\xa8\x60\x87\x84\xfd\xdf\x9e\xe2\x85\x48\x84\x97\xf7\x43\xa2\x81\x9a\x67\x7a\xa1\xc7\x16\xc4\xa6\x94\x98\xe0\xb4\xec\x31\x0b\xa8\xa3\xbb\xdf\x62\x37\x7b\x4c\x03\x84\x7c\x6e\x8d\x43\x3e\x5c\x21\xc7\x9c\xf6\xe0\x99\x54\x69\x5a\xd0\x36\x5d\x11\x56\xfb\x4d\xf2\xeb\x15\x26\x00\xc9\x9e\x9b\x72\x5a\xc5\xa5\x25\x44\x07\xfa\x80\xbb\x42\x8d\x24\xda\x7a\x19\xa2\x44\xb5\x73\x83\xe0\x58\x6e\x01\x51\xf9\x10\x23\x5b\xb9\x80\x26\xa6\x9c\x43\x05\x8d\xc6\x69\xe2\xf8\x11\xf7\x4f\xad\xa8\xd2\x24\x8d\xb8\x31\x5f\x60\xec\x42\x2d\xc7\x1e\xab\xbb\x4d\x68\xe0\x2f\xd8\x18\x2d\x69\x03\xaf\xcc\xb7\x3a\xf7\xaa\xe0\x8a\x15\x12\xaa\xde\xdc\x92\xea\xb4\x10\x95\x83\x73\xb8\x1d\xe5\xb4\x4a\x67\x34\x22\xdc\xfa\x1c\x41\x3e\x73\x2f\xc6\x65\xa0\x76\x3d\xd6\x29\x56\xef\x10\x5b\x54\xda\x63\x87\xaf\x7f\x32\xf2\xa0\x68\xc2\x2c\x8d\x3e\x7a\x74\xfa\xfa\xf5\xe1\xe2\xca\xbb\xed\x84\x08\xe4\x38\x9a\xe6\x0f\x3a\x05\xa6\x27\xa9\xca\x62\xff\x72\x05\xb7\x0c\xfb\x03\xb6\x7b\x55\x16\x50\x16\xd9\x3a\x8a\x24\x6f\x36\x20\x3b\x6c\xb3\x20\xc6\xb2\xc6\x76\x9a\x64\x66\x52\x68\xcb\x1d\x95\x28\x74\x0b\xee\xe8\x4a\x19\x4c\xc0\x40\xf1\x30\x83\x09\x14\x28\x4d\x7e\x78\x46\xa6\x12\x50\x8f\x86\x9c\x0c\xae\xc6\x21\x0b\x53\xa8\x19\x43\x35\xfb\x7f\xc5\x91\x9d\x06\x6f\x73\x29\x46\x1f\xb7\x45\xf1\x31\x5e\x44\x29\x16\xbd\x7a\xac\x4b\x49\x57\x2a\xb6\xf3\xcd\x47\xd2\xbd\x43\x98\x9d\xd7\xe0\x98\xc2\xa6\xb6\x97\x82\xbb\xb0\xc1\xef\xbe\xe1\x07\xb7\xf4\xbd\xeb\x22\x2f\x26\xd5\xdd\x5b\xfa\x00\xde\xbd\x6b\xe2\x9d\x39\x1f\x5e\xdd\xd8\xcf\xee\x2d\xa1\x04\x6f\x16\xf8\xd7\x3c\xa5\xba\xaf\x59\x53\x6a\x41\x2c\x20\xe4\xc0\x4e\x9c\xd4\x42\x72\x5b\xe9\x9a\x78\x80\x2a\xe5\xd8\x5c\x81\x36\xad\x55\xdf\x40\xa0\x0d\x2f\x6e\x3f\x9a\x8b\x39\xa4\x9c\x6e\x73\xe0\xe9\x8f\xb7\xef\xae\x7b\xd8\x6a\xa8\xf8\x25\x9d\xcb\x79\x10\x5e\x4b\xb8\x16\xc3\xae\x28\xac\x8b\xcf\xa8\xcb\x80\xfc\xf3\xc4\x15\x99\x33\x11\x0a\xe3\xc6\x2a\x91\x56\x2d\x3e\xdc\x9a\x8c\x64\xa5\x7a\x50\xa8\xab\x1c\x42\x33\x24\x98\x19\x79\x5c\xde\xf3\xd6\xd0\xba\xc3\x79\x77\x37\x6b\xd4\x35\xd7\xc0\x33\x2b\x95\x9c\x2a\xd3\xee\xde\x92\x98\x02\x77\x17\xff\xfc\xca\x17\xeb\xb1\x5d\x12\x37\xed\xeb\x86\xae\xf2\x94\x91\x46\x53\x82\x2a\x57\xea\x57\x5d\xc6\xb6\xf2\xc5\x2b\x0c\x3e\xaf\xd0\x7b\x47\xd1\xaa\xe7\x0e\xb2\x77\xa4\x86\x65\xc8\x6f\x71\x8b\xd2\x91\x46\x1a\x4f\x89\xed\x6e\x97\xb9\xfb\x57\xa9\x26\xf0\xf1\x6a\x1b\x18\x2a\x06\x6a\x9c\x6b\x1b\x6b\x6d\x77\x37\x45\xbf\x6a\x67\x0b\x50\x05\x9a\x02\x17\x1c\xfb\xcd\x6e\x87\x48\x8a\xad\x8c\x32\x75\xfd\x4e\xce\x4c\x71\x2c\x8b\x71\x07\xe5\xff\xdb\xdf\xcd\x5c\xec\x23\xb5\x63\xc3\x6d\xcd\x36\xbd\xef\xf1\x5c\x4c\xc1\x2c\xdc\xc5\xa6\x00\x63\x22\xf5\x0e\x31\xd3\x05\x8b\xa3\xee\x1c\x85\xaf\x43\x9f\x49\x4e\x71\xa3\x1b\xec\xb6\x40\x54\x70\x59\xbb\x75\x9f\x98\xca\xd3\x83\xdf\x1b\x48\x91\x38\x34\x71\x7b\x9c\x59\xd9\x50\x9d\xe2\x9d\x4f\xf4\xef\x78\x42\xee\xd5\x96\x0f\x7c\xeb\x77\xe2\x38\x7d\x62\xae\x54\x39\x4d\xa1\x8f\xef\xf1\x90\x33\xc1\x3d\xfe\x1b\x55\x63\x17\x18\xf6\xec\x5f\x77\x5b\xf2\xdb\xd2\x28\xdc\xee\xac\xe8\x95\x6e\x2f\x0d\x8d\x83\xc1\x61\xdc\xfb\xa2\x43\xc6\x3a\xe3\x99\x06\xcb\x04\x14\xf9\xae\x2f\xc5\x2e\x12\xde\x97\xd2\x60\x22\xd2\xfa\x2e\x8b\x95\x97\x1d\xa1\x01\xf2\x2b\xaf\x9f\x80\x0f\x29\xce\xae\x59\xe8\x32\xa7\xda\xf4\xa3\xdd\x64\x4a\xee\xda\x21\xc0\xea\x64\x90\x40\xbe\x69\x3a\x85\x76\xae\xe5\xe3\xf9\x12\x8b\x3f\x53\x40\x51\xcd\x03\x68\x41\x16\x80\x22\x67\x3a\x9e\x4e\x9b\x0c\x62\x3b\xc8\x62\x5b\xc1\x05\x5d\x2b\xe5\xad\x75\xcd\x89\xaa\xf9\x52\x92\x7a\xcc\xae\x0d\xbf\x7b\x81\x42\xed\x00\xc3\x56\x36\x5c\x9f\x3a\x96\x9a\x30\x78\xe3\x1c\x45\xd5\x75\x7d\x03\xf0\xd0\x98\x90\x3d\x8b\x9d\x19\x6c\xe4\xda\x1d\x8a\x4a\x69\x06\x0b\x4b\xcf\x30\xfb\xe2\xa3\xbf\x05\x15\xdc\x6d\xdb\x6d\x60\x9a\xc7\x0b\xba\xeb\xef\xb2\xa7\x80\x78\x03\x95\x2e\x5b\x38\x9d\x48\x99\xab\x1b\x12\xb2\xc6\xcf\x7a\x94\x42\x79\xbd\x78\x75\x4b\xcd\x3e\xd4\x06\x2c\x94\x7d\x00\x4d\xc1\x97\xf5\x76\xbf\x6b\x4c\x99\x9b\x24\x7e\x4c\xe7\x12\x9c\x76\x86\xd1\xdd\x5c\x83\x8a\x15\x49\x75\x0d\x2f\x3e\xdc\x5c\x1d\x0b\xd5\x5f\xf6\x45\x77\x55\xd7\xc5\xd9\xc9\x09\x8d\x5f\xe2\xcc\xe6\xd9\x24\x08\x06\xdd\xbe\xf8\x50\xf0\x74\x87\x7f\x3b\xb5\x43\xb3\x6c\x91\x33\x4e\xbd\x05\xee\x9a\xb9\x80\xdc\xde\xfd\x60\x87\x67\xef\x3d\xf3\xa0\xe1\x5c\x6a\xb0\xf2\xb4\x80\x73\x03\x6d\x07\xae\xe3\x59\xd4\xd9\x73\xb3\x02\x12\x81\x21\x2c\x9e\x60\x89\xec\x95\x7e\x9d\x61\x61\x65\x96\xed\x5c\xf4\x8f\x11\x1b\x88\xde\x9a\xce\x3d\xf0\xca\x4e\xd3\x8d\xa5\xbb\x93\xc8\x5d\xb7\x8d\x65\x93\x8c\x9b\x04\x0e\x04\xe9\x40\x1c\x13\x69\xdc\x16\xee\x1c\xf9\xae\xc2\x9c\xb9\xe7\x29\x3a\xf6\x05\x13\x49\xd3\xae\x5e\xad\x02\x82\xb0\xa3\x47\xe4\xe0\xc0\x92\x72\xeb\xd2\x6d\x83\xda\x0e\xc7\x44\x7a\x0d\x26\x5c\x1f\x62\x6f\x04\x8f\x75\x11\xcf\x57\x91\xf3\xa1\xb9\x62\x38\x14\x61\xd1\xb1\xd6\x23\x5e\xe2\x25\xe7\x93\xf7\x1c\x3b\x35\x94\xc7\x35\x52\x18\x2a\x8c\x0d\x6e\xd4\xc3\xf4\x76\x52\x6f\xaa\xe3\x7a\xbf\x08\x0b\x0e\x78\x79\xb3\x10\xd5\x16\x36\xad\xc1\x18\x9b\x6a\x85\xc1\xa3\x85\x0a\x6c\xf2\xf5\x3e\x8f\x4d\x17\xcb\xd4\xe6\xc0\x74\xce\xe2\x4c\x18\xac\x3a\x91\x03\xce\xbc\xb4\x1d\xff\x9d\x8b\x85\x02\xd0\xf2\xa8\x73\xe8\x14\x0d\x77\x85\xda\xf3\xad\x7f\x82\x83\x5a\x44\xfb\x3e\x09\xd6\x41\x20\x4c\x36\x95\x80\x06\x25\xb2\xec\x89\xbb\xbf\x9b\x97\xb7\x77\xfe\x81\x20\x23\xcc\xcf\xfa\x5e\xe9\x46\xb6\xcd\xb0\xe8\x70\xfc\x0c\xa6\xe5\xd1\xce\xc1\x0d\x51\xdf\xa8\x70\xa5\xf5\xfd\x01\x25\xc7\xa6\x06\xe3\x24\xe2\x6f\x5d\x47\x4f\x17\x64\xd0\xef\xf7\x7f\xf9\xfb\x31\xc1\x33\x09\x4e\xab\x44\xac\x2b\x18\xc5\x7d\xa6\x1a\x9c\x2a\xd3\xf1\x64\x8e\x03\x4e\xec\xa6\xc1\x77\x74\x8e\xa8\xf7\x5a\x16\xe6\x93\x33\x3b\xf3\x4b\x82\xf6\xdf\xae\x5d\x60\xc8\x3a\x63\x4f\x54\x81\x2b\x82\xed\x3b\xb3\x0b\xed\xd0\x54\x1b\xc7\xdd\x76\x87\xc3\xf7\x01\xa8\x4c\x6a\x0f\xdb\x5f\xd1\x0f\xbf\xd2\xfc\x8b\xe9\x8c\x23\xed\x68\x8c\x4b\x33\xe9\xa5\xf2\x87\xb4\xd4\xb9\xb1\x63\xb2\x19\xa0\x99\x6a\xce\x2d\xf5\x06\x53\x93\x7b\x3b\x63\x4e\x79\x14\x71\xa3\xcb\x0c\x4a\x0e\x9c\x2b\xc1\xa1\x57\x4d\xcd\x1e\xd3\x8f\xa6\x39\xa6\x52\xc7\x0d\x9d\xd9\xef\xf0\x58\xc8\x59\xc7\x6b\x58\xb7\xf5\x1d\x4e\x41\x52\xef\xc6\x8f\x1b\xe0\x65\x96\x1a\x75\x13\x8b\xf1\xb6\x6b\x8a\xd5\x8f\x9d\xb8\xac\x9a\x90\x7a\x94\xd8\x2d\x12\x14\xf6\xc9\xdb\x75\x87\x66\x3c\xe3\x86\x64\x69\xed\x20\xa0\xcd\x03\x0c\x0f\x9b\x55\x0a\x69\xbc\xd9\x40\xa5\x6a\x4c\xe3\x61\xcf\x9c\x89\x91\x92\x6f\x5b\x9e\x3d\x9f\x6e\x92\x5a\x24\x6e\x28\xa9\x26\xbb\x18\x16\x97\xb0\xb7\x7d\x00\xbb\x6f\x71\x5f\xfd\x0a\x76\x34\xa5\xb2\x6f\x80\x8f\xb7\x00\x1d\xef\xf8\xe8\xe7\x0a\x34\x60\x47\xbf\x15\xf0\x7e\xbd\x05\xa1\xd2\x9b\x09\x34\x37\xf9\xa9\xbd\x5c\x85\x84\x36\x93\xb5\xab\xd2\xf1\x37\x10\x3c\x8e\x22\xe8\x17\x19\x7b\x93\x86\xe4\x60\x2e\x2f\x8e\xe1\xe0\xca\x73\x39\x10\x41\x2b\x9b\xba\xb8\x8e\x32\x15\xd5\x79\x4b\x39\xcd\xdd\x88\x37\xed\xf9\x1a\x6b\x58\xf5\x48\xe3\xc3\x1e\x78\xbe\x94\x35\x46\xc7\x3d\xff\xef\x52\x97\x8a\x39\xf1\x84\xbe\xe0\xc4\xda\xc1\x00\x03\x5e\xe4\x37\x45\xd5\xb1\xa3\x0d\xdf\xf0\x84\xd4\xae\xe0\xc0\x89\x1d\x1e\x2e\xfd\xab\xbd\xb9\x11\xa4\x88\x52\x8a\x88\x6f\xf5\x71\xa5\x39\x83\x4b\xf6\x9f\xda\x07\xed\xed\x25\xaf\x35\xfe\x90\xbe\xdd\x6e\xf3\xc8\x77\x8a\x13\xb6\x48\x9a\xb9\xd9\x6b\x77\xf2\x20\x4f\x05\x1b\x56\x60\x8c\xba\xf1\x47\x89\x3a\xbf\xe3\x86\x33\x3b\x61\x45\x55\x2f\xb1\x78\x7f\xfc\xe8\x04\xef\x36\x71\xc4\xdc\xdc\xdc\x6e\x4c\x0b\x4c\x66\xa8\x49\x35\xdf\x31\x50\x28\x6f\x0a\x80\x06\xfb\xdd\xec\x11\x8f\xb7\xbd\xc2\x51\x72\x6c\x28\xbe\x58\x7c\x10\xd1\x36\xc2\x42\x8f\xda\x8d\x66\xc0\x28\xdd\x1d\x3c\x02\xc5\xe4\x49\x26\x7e\xfd\x09\x5e\x61\x16\xf4\xf6\x96\x5b\xce\x88\x6d\x9c\xf2\xc1\x99\xcc\xcd\x8d\x86\x4d\x7b\x6a\xfc\xed\x8f\x37\xa8\x79\x40\x0d\xb3\xfa\x47\x4c\x9c\x9e\x9e\xa0\x8d\x77\xa0\x53\x0a\x62\x94\xcf\xfe\xdc\x95\x9e\xd3\x19\x2d\xad\x3e\x78\xc2\x8a\xb3\xba\xa2\x54\xeb\xb4\x59\x23\x27\x3a\xde\x54\x77\x45\xc3\x87\x69\xb4\x2b\xae\x8e\xb5\x04\x33\xa1\xa8\x32\x85\xe3\xda\xec\x57\x9c\x95\x38\x4a\x35\x4d\x17\xd8\x76\x27\x31\x82\x47\xe9\x9d\x31\x46\x60\x13\x10\xfb\xf8\x10\x3b\x50\x6b\xa8\x30\x43\x9e\xd7\x34\x6e\xd9\xc5\x4c\xaa\xeb\x7e\x9a\xeb\x97\x70\xee\x5c\x53\x82\x93\x05\xfe\x69\xc3\x59\x0a\x94\x09\x62\x53\x61\xd7\x2f\x2d\x22\xf3\x7b\x5d\x9a\x5f\x80\x8f\xdc\x46\x66\x61\xe2\xb0\x7e\x6e\x93\xdb\x83\x9c\xf9\x74\x32\x9e\xf8\x83\x7e\x62\x89\x15\x45\x99\x46\x88\x2e\x7c\x5e\xe0\x47\x9a\x24\x33\xff\x1c\x2c\xa6\x82\x8b\x17\xd3\x65\x11\x24\xa7\xb3\xc1\x70\x34\x9f\x73\x25\xc1\x97\x88\x8e\x55\x60\xdd\x34\x62\x61\xec\xdc\x5c\x4e\xe0\x2c\xbc\xab\x26\xbd\x2e\x1b\x66\x53\x1c\x40\xec\x64\x19\x3c\x70\xbf\x9f\x5b\x49\x9c\x5e\xb0\x30\xcd\x75\x25\xeb\xd8\xc2\xa4\x61\x86\x51\xe8\x5d\xf7\xd6\x59\x39\xb9\x1f\xe3\x79\x05\x02\xaf\x34\xa8\xbb\xa2\xc8\x4e\x18\x02\x36\x68\x32\x6c\xdf\x9e\x0b\xf5\x06\xba\xad\x38\x6c\xf8\x93\x9c\xa5\x72\xb0\x21\xa9\xe0\xad\xfc\x72\x09\x1b\x63\xbe\x27\xaf\xd5\x63\x6d\xad\x8d\x6b\x8e\x69\x60\x87\x12\x9f\x3a\x98\x1a\xfb\xe4\xa3\x35\x98\xa0\xf1\x38\xb6\xc3\x62\x51\x6a\x41\xdf\xc0\xf2\x5d\xf0\xe4\xba\xb8\xde\xa4\xfb\x00\xf6\x0a\x95\x5e\x1f\xa8\x1d\x64\xdb\xda\xff\xa1\xa2\xa8\x1f\xf1\x82\x5f\xc8\x22\xc5\x1f\x82\x3f\xe2\xdd\x3e\x68\x34\xb0\xea\xe5\xce\xd5\x35\x55\x28\x78\x45\x03\x89\x52\x1a\xa5\xc6\x59\x38\x74\x01\xba\x44\x71\x1d\x61\x3d\x86\xac\xa7\xab\x99\x5d\x2f\x48\x03\x4f\x24\x3b\xb7\x8b\x7f\x11\x69\x7b\x45\x4b\xce\xa3\x9b\xe2\x18\xf2\x80\xbd\x81\x36\xca\x73\x10\x7e\x08\x05\x32\xfe\x34\xd0\x80\x27\x1e\x7a\xbd\x26\x9e\xac\xa6\x3b\xd3\x63\x20\xb5\xa1\xf0\xe5\x26\xc0\x79\xc6\x12\x1c\x28\x70\x1f\xaa\xf5\x0b\x86\x85\x60\xbf\x60\x91\x02\x92\x00\xa1\xea\x35\xdd\x9c\xd3\xad\x31\x83\xb7\x5b\xe8\x80\xd2\x68\x05\x50\x94\xb6\x7d\x05\x5f\x72\x76\x94\xa6\xd6\x4b\xe2\xbe\x8b\x41\x4c\x46\xec\x5e\x10\x3d\x4d\x8c\xbf\x2e\x34\xe4\xac\x4d\xdf\x2b\xf7\xa6\x4c\x39\xc5\xa3\xbb\xf1\x34\x6f\xb0\xcd\x16\x95\xba\xe2\xf5\xde\xa9\xae\x7b\x0a\x82\xc0\x4e\xd8\x5a\x81\x06\xa3\x49\x99\x2b\x60\x03\x88\x5d\xe2\xcb\xa7\xf4\x7c\x3f\x61\xa2\x2b\x97\x9c\x06\x35\x6c\x0d\xbb\xb3\xde\x9c\x48\x23\x59\xc6\x99\x76\xda\xdf\xd3\xb0\xdf\xb6\xea\x80\x37\xa7\xdc\x25\x31\x02\x74\x89\xa0\xf0\x24\xe1\x3d\x64\x81\xe0\x00\x3b\x5f\x8f\x32\x9f\x6c\x39\xf8\xd6\x1b\x33\x8a\x9e\x1b\x34\x6b\xe7\x2f\xbd\x3b\x17\xf7\x43\xa0\x30\x4b\x0b\x37\xd4\xca\x53\x4a\xb9\x29\x24\x31\xa8\x53\x6e\xb8\x4f\xc3\x68\x8f\x82\x49\x10\xac\x9f\x22\x62\x72\x40\xc4\x70\x87\x88\xa9\x71\xbd\x94\x6a\x60\xfb\xb1\xba\x6f\x59\xee\x7e\xbc\x02\xba\x4a\xc6\x4c\x23\x02\xdc\xf5\xa2\x54\x03\x8b\x1c\xa4\xaa\xb1\xe6\xc1\xfb\xa1\x94\x56\x59\x72\xec\xcc\xa2\x32\x23\x46\xd8\x28\x24\x4f\xe8\xf2\x14\x9f\xa4\xc1\xbe\x54\x82\xa7\x08\x0a\xf6\x09\xda\x93\x09\x1a\x7e\xbe\x85\x90\x18\x36\xcb\xa5\x19\x23\xc3\x50\x49\x29\xce\x52\x0b\x54\x89\x0e\xbd\x65\xfd\x2b\x20\x4e\x24\xe4\xfb\xdc\x16\xe4\x38\x3e\x75\xa9\xa4\x93\x05\x6e\x29\xb0\xbc\x59\x53\x44\x72\x57\x70\x3c\x58\xe4\xf9\x51\xaf\x45\x41\xbd\x11\x57\x9f\xb6\x4d\x59\x72\xe5\x6b\x1a\x98\x33\x5e\x83\xbb\x4a\x88\x33\x0e\x94\x19\x07\x4a\x89\xe2\x57\x55\xea\x7e\x3b\x8b\xfd\x03\x58\xbe\x5a\x40\xe1\xa5\x63\xc7\x11\xc8\xc9\x76\xae\xa7\x41\x80\xf7\x3c\x85\x63\x11\x49\xbd\x60\x08\xc9\xdf\x5a\xa2\x11\x1e\x8b\xff\xaa\xb8\xa1\x5c\x64\x00\xd4\xbf\x45\xe5\x5d\xd8\x76\xbb\xd6\x0c\xce\x0f\x66\xf8\x80\x4f\xdc\x89\x64\xbb\x61\x0c\x99\xd5\x63\x6e\x09\xea\x40\xd1\x27\x03\x79\x2f\xb0\x41\xba\x9a\x42\x14\xde\xf7\xc0\x7c\x65\xdd\x17\x9f\x48\x8f\xf0\x1d\x76\x84\x3d\x9e\xd4\x87\x13\x58\xaf\xf5\x06\x44\xc7\x52\x20\x5f\x5f\x43\xed\xfb\x9c\x20\xd6\x72\x4b\x41\x75\xe5\xfd\x94\x81\x66\xaf\x01\xd3\x8d\xf4\x6f\x27\xc1\xc7\x62\x82\xbc\xb1\x59\x6d\x44\xc1\x37\x86\xfa\xf7\x19\x71\xb9\xb3\xef\x74\x06\xde\x11\x7b\x75\x06\xcb\x7f\x00\x8e\xd5\xf6\xc0\xc1\x45\x00\x00")
 
 func goCentrifugeBuildConfigsDefault_configYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -84,7 +84,7 @@ func goCentrifugeBuildConfigsDefault_configYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "go-centrifuge/build/configs/default_config.yaml", size: 5445, mode: os.FileMode(420), modTime: time.Unix(1552665458, 0)}
+	info := bindataFileInfo{name: "go-centrifuge/build/configs/default_config.yaml", size: 17857, mode: os.FileMode(420), modTime: time.Unix(1792118328, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,238 @@
+package retry
+
+import (
+	"context"
+	"expvar"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	logging "github.com/ipfs/go-log"
+)
+
+const (
+	// ClassEthereum is the class of the ethereum transaction submissions.
+	ClassEthereum = "ethereum"
+
+	// ClassP2P is the class of the p2p message sends.
+	ClassP2P = "p2p"
+
+	// ClassQueue is the class of the queued tasks.
+	ClassQueue = "queue"
+
+	// ErrBudgetExhausted must be used when an operation isn't retried since the retry budget of its class is spent.
+	ErrBudgetExhausted = errors.Error("retry budget exhausted")
+
+	// metricsName is the name of the expvar the retries are published as.
+	metricsName = "retries"
+
+	// budgetWindow is the window the retry budget of a class is spent over.
+	budgetWindow = time.Minute
+)
+
+// Classes are the operation classes with a configurable retry policy.
+var Classes = []string{ClassEthereum, ClassP2P, ClassQueue}
+
+var log = logging.Logger("retry")
+
+// Policy is the number of attempts of an operation and the waits between them.
+// The zero policy runs the operation once.
+type Policy struct {
+	// Attempts is the maximum number of times the operation is run, the first one included.
+	Attempts int
+
+	// Backoff is the wait before the first retry, doubled on every retry up to MaxBackoff.
+	Backoff time.Duration
+
+	// MaxBackoff caps the wait between two retries if positive.
+	MaxBackoff time.Duration
+
+	// Jitter randomises each wait by up to the fraction of it in both directions, so that the operations failing
+	// together are not retried together.
+	Jitter float64
+
+	// Budget is the maximum number of retries per minute across the operations of the class. 0 means no limit.
+	Budget int
+}
+
+// Wait returns the wait before the retry, the backoff doubled on every retry up to the max backoff, with jitter.
+func (p Policy) Wait(retry int) time.Duration {
+	wait := p.Backoff
+	for i := 0; i < retry && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+
+	if p.Jitter <= 0 {
+		return wait
+	}
+
+	return wait + time.Duration((rand.Float64()*2-1)*p.Jitter*float64(wait))
+}
+
+// Stats are the retry metrics of a class.
+type Stats struct {
+	Class string `json:"class"`
+
+	// Attempts is the number of times the operations were run, retries included.
+	Attempts uint64 `json:"attempts"`
+
+	// Retries is the number of retries.
+	Retries uint64 `json:"retries"`
+
+	// Successes and Failures are the number of operations that succeeded and failed in the end.
+	Successes uint64 `json:"successes"`
+	Failures  uint64 `json:"failures"`
+
+	// BudgetExhausted is the number of retries refused since the budget of the class was spent.
+	BudgetExhausted uint64 `json:"budget_exhausted"`
+}
+
+// class holds the budget and the metrics shared by the retriers of a class.
+type class struct {
+	mu          sync.Mutex
+	stats       Stats
+	windowStart time.Time
+	spent       int
+}
+
+var (
+	classesMu   sync.Mutex
+	classes     = make(map[string]*class)
+	publishOnce sync.Once
+)
+
+// getClass returns the shared state of the class, created on first use.
+func getClass(name string) *class {
+	classesMu.Lock()
+	defer classesMu.Unlock()
+	c, ok := classes[name]
+	if !ok {
+		c = &class{stats: Stats{Class: name}}
+		classes[name] = c
+	}
+
+	return c
+}
+
+// Retrier runs the operations of a class with the retry policy of the class.
+// Retriers of the same class share the retry budget and the metrics.
+type Retrier struct {
+	policy Policy
+	class  *class
+}
+
+// New returns a retrier of the class with the policy, and publishes the retry metrics of all the classes as the
+// expvar "retries". The expvar is served on /debug/vars if pprof is enabled.
+func New(class string, policy Policy) *Retrier {
+	publishOnce.Do(func() {
+		expvar.Publish(metricsName, expvar.Func(func() interface{} {
+			metrics := make(map[string]Stats)
+			for _, s := range AllStats() {
+				metrics[s.Class] = s
+			}
+
+			return metrics
+		}))
+	})
+
+	return &Retrier{policy: policy, class: getClass(class)}
+}
+
+// Policy returns the retry policy of the retrier.
+func (r *Retrier) Policy() Policy {
+	return r.policy
+}
+
+// Do runs the operation until it succeeds, fails with an error that isn't retryable, or the attempts or the retry
+// budget run out. The retries stop once the context is done. The last error of the operation is returned, typed
+// ErrBudgetExhausted if the budget ran out. A nil retrier runs the operation once.
+func (r *Retrier) Do(ctx context.Context, op func(attempt int) error, retryable func(err error) bool) error {
+	if r == nil {
+		return op(0)
+	}
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		r.class.record(func(s *Stats) { s.Attempts++ })
+		err = op(attempt)
+		if err == nil {
+			r.class.record(func(s *Stats) { s.Successes++ })
+			return nil
+		}
+
+		if !retryable(err) || attempt+1 >= r.policy.Attempts {
+			break
+		}
+
+		if !r.AllowRetry() {
+			err = errors.NewTypedError(ErrBudgetExhausted, err)
+			break
+		}
+
+		wait := r.policy.Wait(attempt)
+		log.Warningf("%s operation failed, retrying in %s [%d/%d]: %v", r.class.stats.Class, wait, attempt+1, r.policy.Attempts, err)
+		select {
+		case <-ctx.Done():
+			r.class.record(func(s *Stats) { s.Failures++ })
+			return err
+		case <-time.After(wait):
+		}
+	}
+
+	r.class.record(func(s *Stats) { s.Failures++ })
+	return err
+}
+
+// AllowRetry spends a retry of the budget of the class and returns true, or returns false if the budget is spent.
+// Operations retried by others, like the queued tasks, must call it before scheduling a retry.
+func (r *Retrier) AllowRetry() bool {
+	c := r.class
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if now.Sub(c.windowStart) >= budgetWindow {
+		c.windowStart, c.spent = now, 0
+	}
+
+	if r.policy.Budget > 0 && c.spent >= r.policy.Budget {
+		c.stats.BudgetExhausted++
+		return false
+	}
+
+	c.spent++
+	c.stats.Retries++
+	return true
+}
+
+// Failed records an operation retried by others that failed in the end.
+func (r *Retrier) Failed() {
+	r.class.record(func(s *Stats) { s.Failures++ })
+}
+
+// record updates the metrics of the class.
+func (c *class) record(update func(s *Stats)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	update(&c.stats)
+}
+
+// AllStats returns the retry metrics of the classes used so far, sorted by class.
+func AllStats() []Stats {
+	classesMu.Lock()
+	defer classesMu.Unlock()
+	var stats []Stats
+	for _, c := range classes {
+		c.mu.Lock()
+		stats = append(stats, c.stats)
+		c.mu.Unlock()
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Class < stats[j].Class })
+	return stats
+}
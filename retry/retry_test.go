@@ -0,0 +1,143 @@
+// +build unit
+
+package retry
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var errRetryable = errors.New("retryable")
+
+func isRetryable(err error) bool {
+	return err == errRetryable
+}
+
+func TestPolicy_Wait(t *testing.T) {
+	p := Policy{Attempts: 5, Backoff: 100 * time.Millisecond, MaxBackoff: 500 * time.Millisecond}
+	assert.Equal(t, 100*time.Millisecond, p.Wait(0))
+	assert.Equal(t, 200*time.Millisecond, p.Wait(1))
+	assert.Equal(t, 400*time.Millisecond, p.Wait(2))
+	assert.Equal(t, 500*time.Millisecond, p.Wait(3))
+	assert.Equal(t, 500*time.Millisecond, p.Wait(100))
+
+	// no max backoff
+	p.MaxBackoff = 0
+	assert.Equal(t, 800*time.Millisecond, p.Wait(3))
+
+	// jitter
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		wait := p.Wait(0)
+		assert.True(t, wait >= 50*time.Millisecond && wait <= 150*time.Millisecond)
+	}
+}
+
+func TestRetrier_Do(t *testing.T) {
+	r := New("test do", Policy{Attempts: 3, Backoff: time.Millisecond})
+	assert.Equal(t, 3, r.Policy().Attempts)
+
+	// success at first
+	var attempts int
+	err := r.Do(context.Background(), func(attempt int) error {
+		attempts++
+		return nil
+	}, isRetryable)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+
+	// success after retries
+	attempts = 0
+	err = r.Do(context.Background(), func(attempt int) error {
+		attempts++
+		if attempt < 2 {
+			return errRetryable
+		}
+		return nil
+	}, isRetryable)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	// attempts run out
+	attempts = 0
+	err = r.Do(context.Background(), func(attempt int) error {
+		attempts++
+		return errRetryable
+	}, isRetryable)
+	assert.Equal(t, errRetryable, err)
+	assert.Equal(t, 3, attempts)
+
+	// not retryable
+	attempts = 0
+	err = r.Do(context.Background(), func(attempt int) error {
+		attempts++
+		return errors.New("fatal")
+	}, isRetryable)
+	assert.EqualError(t, err, "fatal")
+	assert.Equal(t, 1, attempts)
+
+	// context done
+	r = New("test do", Policy{Attempts: 3, Backoff: time.Hour})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	attempts = 0
+	err = r.Do(ctx, func(attempt int) error {
+		attempts++
+		return errRetryable
+	}, isRetryable)
+	assert.Equal(t, errRetryable, err)
+	assert.Equal(t, 1, attempts)
+
+	// nil retrier
+	attempts = 0
+	err = (*Retrier)(nil).Do(context.Background(), func(attempt int) error {
+		attempts++
+		return errRetryable
+	}, isRetryable)
+	assert.Equal(t, errRetryable, err)
+	assert.Equal(t, 1, attempts)
+
+	stats := getClass("test do").stats
+	assert.Equal(t, uint64(9), stats.Attempts)
+	assert.Equal(t, uint64(5), stats.Retries)
+	assert.Equal(t, uint64(2), stats.Successes)
+	assert.Equal(t, uint64(3), stats.Failures)
+}
+
+func TestRetrier_budget(t *testing.T) {
+	r := New("test budget", Policy{Attempts: 5, Budget: 2})
+	var attempts int
+	err := r.Do(context.Background(), func(attempt int) error {
+		attempts++
+		return errRetryable
+	}, isRetryable)
+	assert.True(t, errors.IsOfType(ErrBudgetExhausted, err))
+	assert.Equal(t, 3, attempts)
+
+	// budget shared by the retriers of the class
+	assert.False(t, New("test budget", Policy{Budget: 2}).AllowRetry())
+
+	// unlimited
+	assert.True(t, New("test budget", Policy{}).AllowRetry())
+
+	// budget renewed after the window
+	c := getClass("test budget")
+	c.windowStart = time.Now().Add(-budgetWindow)
+	assert.True(t, r.AllowRetry())
+	r.Failed()
+	assert.Equal(t, uint64(2), c.stats.BudgetExhausted)
+	assert.Equal(t, uint64(2), c.stats.Failures)
+}
+
+func TestMetrics(t *testing.T) {
+	New("test metrics", Policy{}).Failed()
+	var metrics map[string]Stats
+	assert.NoError(t, json.Unmarshal([]byte(expvar.Get(metricsName).String()), &metrics))
+	assert.Equal(t, Stats{Class: "test metrics", Failures: 1}, metrics["test metrics"])
+}
@@ -0,0 +1,212 @@
+package sandbox
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/identity/ideth"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// anchorPrefix is the prefix of the keys of the anchors
+	anchorPrefix = "sandbox_anchor_"
+
+	// preCommitPrefix is the prefix of the keys of the pre-commits
+	preCommitPrefix = "sandbox_precommit_"
+
+	// preCommitExpiration is the time after which a pre-commit is no longer valid
+	preCommitExpiration = time.Hour
+)
+
+// anchorState is a committed anchor.
+type anchorState struct {
+	AnchorID     anchors.AnchorID
+	DocumentRoot anchors.DocumentRoot
+	Identity     identity.DID
+	Block        block
+}
+
+// Type returns the reflect type of the anchorState.
+func (a *anchorState) Type() reflect.Type {
+	return reflect.TypeOf(a)
+}
+
+// JSON returns the json representation of the anchorState.
+func (a *anchorState) JSON() ([]byte, error) {
+	return json.Marshal(a)
+}
+
+// FromJSON loads the anchorState from the json.
+func (a *anchorState) FromJSON(data []byte) error {
+	return json.Unmarshal(data, a)
+}
+
+// preCommitState is a pre-commit of an anchor.
+type preCommitState struct {
+	SigningRoot anchors.DocumentRoot
+	Identity    identity.DID
+	Block       block
+}
+
+// Type returns the reflect type of the preCommitState.
+func (p *preCommitState) Type() reflect.Type {
+	return reflect.TypeOf(p)
+}
+
+// JSON returns the json representation of the preCommitState.
+func (p *preCommitState) JSON() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// FromJSON loads the preCommitState from the json.
+func (p *preCommitState) FromJSON(data []byte) error {
+	return json.Unmarshal(data, p)
+}
+
+// valid returns true if the pre-commit has not expired at now.
+func (p *preCommitState) valid(now time.Time) bool {
+	return now.Before(p.Block.Time.Add(preCommitExpiration))
+}
+
+func anchorKey(anchorID anchors.AnchorID) []byte {
+	return []byte(anchorPrefix + hexutil.Encode(anchorID[:]))
+}
+
+func preCommitKey(anchorID anchors.AnchorID) []byte {
+	return []byte(preCommitPrefix + hexutil.Encode(anchorID[:]))
+}
+
+// anchorRepository implements anchors.AnchorRepository on the fake chain.
+// Anchor transactions are confirmed instantly, so the returned futures have always finished and none are in flight.
+type anchorRepository struct {
+	chain *chain
+}
+
+func newAnchorRepository(chain *chain) anchors.AnchorRepository {
+	return &anchorRepository{chain: chain}
+}
+
+// PreCommitAnchor pre-commits the signing root of the anchor.
+// Fails if the anchor has already been committed or has a valid pre-commit.
+func (r *anchorRepository) PreCommitAnchor(ctx context.Context, anchorID anchors.AnchorID, signingRoot anchors.DocumentRoot) (anchors.Future, error) {
+	did, err := ideth.NewDIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Add Anchor to Pre-commit %s from did:%s", anchorID.String(), did.String())
+	_, err = r.chain.submit(payload("preCommit", anchorID[:], signingRoot[:], did[:]), func(b block, h *head, batch storage.Batch) error {
+		if batch.Exists(anchorKey(anchorID)) {
+			return errors.New("anchor %s already exists", anchorID.String())
+		}
+
+		key := preCommitKey(anchorID)
+		pc := &preCommitState{SigningRoot: signingRoot, Identity: did, Block: b}
+		if !batch.Exists(key) {
+			return batch.Create(key, pc)
+		}
+
+		if r.hasValidPreCommit(anchorID, b.Time) {
+			return errors.New("anchor %s has a valid pre-commit", anchorID.String())
+		}
+
+		return batch.Update(key, pc)
+	})
+
+	return anchors.NewFinishedFuture(anchorID, err), nil
+}
+
+// CommitAnchor commits the document root under the sha256 hash of the anchor ID preimage, like the anchor repository contract.
+// Fails if the anchor has already been committed.
+func (r *anchorRepository) CommitAnchor(ctx context.Context, anchorIDPreimage anchors.AnchorID, documentRoot anchors.DocumentRoot, documentProofs [][32]byte) (anchors.Future, error) {
+	did, err := ideth.NewDIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorID := anchors.AnchorID(sha256.Sum256(anchorIDPreimage[:]))
+	log.Infof("Add Anchor to Commit %s from did:%s", anchorID.String(), did.String())
+	_, err = r.chain.submit(payload("commit", anchorIDPreimage[:], documentRoot[:], did[:]), func(b block, h *head, batch storage.Batch) error {
+		key := anchorKey(anchorID)
+		if batch.Exists(key) {
+			return errors.New("anchor %s already exists", anchorID.String())
+		}
+
+		return batch.Create(key, &anchorState{AnchorID: anchorID, DocumentRoot: documentRoot, Identity: did, Block: b})
+	})
+
+	return anchors.NewFinishedFuture(anchorIDPreimage, err), nil
+}
+
+// InFlight always returns false as anchor transactions are confirmed instantly.
+func (r *anchorRepository) InFlight(anchorID anchors.AnchorID) (anchors.Future, bool) {
+	return nil, false
+}
+
+// GetAnchorData returns the document root of the anchor and the time of the block it was committed in.
+// Returns ErrAnchorNotFound if the anchor was not committed.
+func (r *anchorRepository) GetAnchorData(anchorID anchors.AnchorID) (docRoot anchors.DocumentRoot, anchoredTime time.Time, err error) {
+	m, err := r.chain.db.Get(anchorKey(anchorID))
+	if err != nil {
+		return docRoot, anchoredTime, errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor %s", anchorID.String()))
+	}
+
+	a := m.(*anchorState)
+	return a.DocumentRoot, a.Block.Time, nil
+}
+
+// CommittedAnchors returns the anchors committed by the identity in ctx since fromBlock, oldest first.
+func (r *anchorRepository) CommittedAnchors(ctx context.Context, fromBlock uint64) ([]anchors.CommittedAnchor, error) {
+	did, err := ideth.NewDIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	models, err := r.chain.db.GetAllByPrefix(anchorPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var committed []anchors.CommittedAnchor
+	for _, m := range models {
+		a := m.(*anchorState)
+		if !a.Identity.Equal(did) || a.Block.Number < fromBlock {
+			continue
+		}
+
+		committed = append(committed, anchors.CommittedAnchor{
+			AnchorID:     a.AnchorID,
+			DocumentRoot: a.DocumentRoot,
+			BlockNumber:  a.Block.Number,
+		})
+	}
+
+	sort.Slice(committed, func(i, j int) bool {
+		return committed[i].BlockNumber < committed[j].BlockNumber
+	})
+
+	return committed, nil
+}
+
+// HasValidPreCommit checks if the given anchorID has a valid pre-commit
+func (r *anchorRepository) HasValidPreCommit(anchorID anchors.AnchorID) bool {
+	return r.hasValidPreCommit(anchorID, time.Now())
+}
+
+func (r *anchorRepository) hasValidPreCommit(anchorID anchors.AnchorID, now time.Time) bool {
+	m, err := r.chain.db.Get(preCommitKey(anchorID))
+	if err != nil {
+		return false
+	}
+
+	return m.(*preCommitState).valid(now)
+}
@@ -0,0 +1,123 @@
+// +build unit
+
+package sandbox
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAccountContext(t *testing.T) context.Context {
+	did := testingidentity.GenerateRandomDID()
+	ctx, err := contextutil.New(context.Background(), &configstore.Account{IdentityID: did[:]})
+	assert.NoError(t, err)
+	return ctx
+}
+
+func TestAnchorRepository(t *testing.T) {
+	c, err := newChain(newTestRepo(t))
+	assert.NoError(t, err)
+	repo := newAnchorRepository(c)
+	ctx := newAccountContext(t)
+	preimage, err := anchors.ToAnchorID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+	anchorID := anchors.AnchorID(sha256.Sum256(preimage[:]))
+	signingRoot, err := anchors.ToDocumentRoot(utils.RandomSlice(32))
+	assert.NoError(t, err)
+	docRoot, err := anchors.ToDocumentRoot(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	// missing account
+	_, err = repo.PreCommitAnchor(context.Background(), anchorID, signingRoot)
+	assert.Error(t, err)
+
+	// pre-commit
+	assert.False(t, repo.HasValidPreCommit(anchorID))
+	f, err := repo.PreCommitAnchor(ctx, anchorID, signingRoot)
+	assert.NoError(t, err)
+	assert.Equal(t, anchors.StatusSuccess, f.Status())
+	assert.NoError(t, f.Result(0))
+	assert.True(t, repo.HasValidPreCommit(anchorID))
+	f, err = repo.PreCommitAnchor(ctx, anchorID, signingRoot)
+	assert.NoError(t, err)
+	assert.Error(t, f.Result(0))
+
+	// commit
+	_, _, err = repo.GetAnchorData(anchorID)
+	assert.True(t, errors.IsOfType(anchors.ErrAnchorNotFound, err))
+	f, err = repo.CommitAnchor(ctx, preimage, docRoot, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Result(0))
+	_, ok := repo.InFlight(preimage)
+	assert.False(t, ok)
+	gotRoot, anchoredAt, err := repo.GetAnchorData(anchorID)
+	assert.NoError(t, err)
+	assert.Equal(t, docRoot, gotRoot)
+	assert.Equal(t, c.latest().Block.Time, anchoredAt)
+
+	// already anchored
+	f, err = repo.CommitAnchor(ctx, preimage, docRoot, nil)
+	assert.NoError(t, err)
+	assert.Error(t, f.Result(0))
+	f, err = repo.PreCommitAnchor(ctx, anchorID, signingRoot)
+	assert.NoError(t, err)
+	assert.Error(t, f.Result(0))
+}
+
+func TestPreCommitState_valid(t *testing.T) {
+	pc := &preCommitState{Block: block{Time: time.Now()}}
+	assert.True(t, pc.valid(time.Now()))
+	assert.False(t, pc.valid(time.Now().Add(preCommitExpiration)))
+}
+
+func TestAnchorRepository_CommittedAnchors(t *testing.T) {
+	c, err := newChain(newTestRepo(t))
+	assert.NoError(t, err)
+	repo := newAnchorRepository(c)
+	ctx := newAccountContext(t)
+
+	// missing account
+	_, err = repo.CommittedAnchors(context.Background(), 0)
+	assert.Error(t, err)
+
+	var committed []anchors.CommittedAnchor
+	for i := 0; i < 3; i++ {
+		preimage, err := anchors.ToAnchorID(utils.RandomSlice(32))
+		assert.NoError(t, err)
+		docRoot, err := anchors.ToDocumentRoot(utils.RandomSlice(32))
+		assert.NoError(t, err)
+		f, err := repo.CommitAnchor(ctx, preimage, docRoot, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, f.Result(0))
+		committed = append(committed, anchors.CommittedAnchor{
+			AnchorID:     anchors.AnchorID(sha256.Sum256(preimage[:])),
+			DocumentRoot: docRoot,
+			BlockNumber:  c.latest().Block.Number,
+		})
+	}
+
+	// anchors of another identity
+	preimage, err := anchors.ToAnchorID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+	f, err := repo.CommitAnchor(newAccountContext(t), preimage, anchors.DocumentRoot{}, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Result(0))
+
+	got, err := repo.CommittedAnchors(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, committed, got)
+
+	got, err = repo.CommittedAnchors(ctx, committed[1].BlockNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, committed[1:], got)
+}
@@ -0,0 +1,56 @@
+package sandbox
+
+import (
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/ethereum/go-ethereum/common"
+	logging "github.com/ipfs/go-log"
+)
+
+var log = logging.Logger("sandbox")
+
+// Config defines the configuration required by the sandbox.
+type Config interface {
+	GetSandboxEnabled() bool
+	GetContractAddress(contractName config.ContractName) common.Address
+}
+
+// Bootstrapper implements bootstrap.Bootstrapper.
+// It must run after the identity and anchor bootstrappers and before their dependents, so that it can replace them.
+type Bootstrapper struct{}
+
+// Bootstrap replaces the identity factory, identity service and anchor repository with their simulations on the
+// fake chain if sandbox mode is enabled.
+func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
+	cfg, ok := ctx[bootstrap.BootstrappedConfig].(Config)
+	if !ok {
+		return errors.New("sandbox config not initialised")
+	}
+
+	if !cfg.GetSandboxEnabled() {
+		return nil
+	}
+
+	db, ok := ctx[storage.BootstrappedDB].(storage.Repository)
+	if !ok {
+		return errors.New("storage not initialised")
+	}
+
+	chain, err := newChain(db)
+	if err != nil {
+		return err
+	}
+
+	log.Warning("sandbox mode is enabled, anchors and identities are simulated and not on chain")
+	ctx[identity.BootstrappedDIDFactory] = newFactory(chain, cfg.GetContractAddress(config.IdentityFactory))
+	ctx[identity.BootstrappedDIDService] = newService(chain)
+	ctx[anchors.BootstrappedAnchorRepo] = newAnchorRepository(chain)
+
+	// there is no chain to check for key changes of the cached key lookups
+	delete(ctx, identity.BootstrappedKeyCache)
+	return nil
+}
@@ -0,0 +1,44 @@
+// +build unit
+
+package sandbox
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockConfig struct {
+	enabled bool
+}
+
+func (m mockConfig) GetSandboxEnabled() bool { return m.enabled }
+
+func (m mockConfig) GetContractAddress(contractName config.ContractName) common.Address {
+	return common.Address{}
+}
+
+func TestBootstrapper_Bootstrap(t *testing.T) {
+	assert.Error(t, Bootstrapper{}.Bootstrap(map[string]interface{}{}))
+
+	// disabled
+	ctx := map[string]interface{}{bootstrap.BootstrappedConfig: mockConfig{}}
+	assert.NoError(t, Bootstrapper{}.Bootstrap(ctx))
+	assert.Len(t, ctx, 1)
+
+	// enabled
+	ctx = map[string]interface{}{bootstrap.BootstrappedConfig: mockConfig{enabled: true}, identity.BootstrappedKeyCache: struct{}{}}
+	assert.Error(t, Bootstrapper{}.Bootstrap(ctx))
+	ctx[storage.BootstrappedDB] = newTestRepo(t)
+	assert.NoError(t, Bootstrapper{}.Bootstrap(ctx))
+	assert.IsType(t, new(factory), ctx[identity.BootstrappedDIDFactory])
+	assert.IsType(t, new(service), ctx[identity.BootstrappedDIDService])
+	assert.IsType(t, new(anchorRepository), ctx[anchors.BootstrappedAnchorRepo])
+	assert.NotContains(t, ctx, identity.BootstrappedKeyCache)
+}
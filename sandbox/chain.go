@@ -0,0 +1,134 @@
+package sandbox
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// headKey is the key of the head of the fake chain
+const headKey = "sandbox_head"
+
+// block is a block of the fake chain. Every block holds a single transaction.
+type block struct {
+	Number uint64
+	Time   time.Time
+	TxHash common.Hash
+}
+
+// head is the latest block of the fake chain and the number of identities created so far.
+type head struct {
+	Block      block
+	Identities uint64
+}
+
+// Type returns the reflect type of the head.
+func (h *head) Type() reflect.Type {
+	return reflect.TypeOf(h)
+}
+
+// JSON returns the json representation of the head.
+func (h *head) JSON() ([]byte, error) {
+	return json.Marshal(h)
+}
+
+// FromJSON loads the head from the json.
+func (h *head) FromJSON(data []byte) error {
+	return json.Unmarshal(data, h)
+}
+
+// stateChange applies the state changes of a transaction mined in the block to the batch.
+type stateChange func(b block, h *head, batch storage.Batch) error
+
+// chain is an in-process fake chain persisted in the node DB.
+// A transaction is mined into a block of its own as soon as it is submitted. The hash of the transaction is the keccak
+// hash of the previous transaction hash and the payload, so the same transactions always result in the same hashes.
+type chain struct {
+	db storage.Repository
+
+	mu   sync.Mutex
+	head *head
+}
+
+// newChain loads the fake chain from the DB, or starts a new one at block 0.
+func newChain(db storage.Repository) (*chain, error) {
+	db.Register(new(head))
+	db.Register(new(identityState))
+	db.Register(new(anchorState))
+	db.Register(new(preCommitState))
+	c := &chain{db: db, head: new(head)}
+	if !db.Exists([]byte(headKey)) {
+		return c, nil
+	}
+
+	m, err := db.Get([]byte(headKey))
+	if err != nil {
+		return nil, errors.New("failed to load the sandbox chain: %v", err)
+	}
+
+	c.head = m.(*head)
+	return c, nil
+}
+
+// submit mines the transaction into a new block. The state change is persisted together with the new head, or not at all.
+func (c *chain) submit(payload []byte, change stateChange) (block, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	h := *c.head
+	h.Block = block{
+		Number: c.head.Block.Number + 1,
+		Time:   time.Unix(time.Now().Unix(), 0).UTC(),
+		TxHash: crypto.Keccak256Hash(c.head.Block.TxHash[:], payload),
+	}
+
+	batch := c.db.NewBatch()
+	err := change(h.Block, &h, batch)
+	if err != nil {
+		return block{}, err
+	}
+
+	if batch.Exists([]byte(headKey)) {
+		err = batch.Update([]byte(headKey), &h)
+	} else {
+		err = batch.Create([]byte(headKey), &h)
+	}
+	if err != nil {
+		return block{}, err
+	}
+
+	err = batch.Commit()
+	if err != nil {
+		return block{}, errors.New("failed to mine sandbox block %d: %v", h.Block.Number, err)
+	}
+
+	c.head = &h
+	log.Infof("sandbox transaction %s mined in block %d", h.Block.TxHash.Hex(), h.Block.Number)
+	return h.Block, nil
+}
+
+// latest returns the latest block and the number of identities created so far.
+func (c *chain) latest() head {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return *c.head
+}
+
+// payload concatenates the method name and the parameters of a transaction.
+func payload(method string, params ...[]byte) []byte {
+	p := []byte(method)
+	for _, param := range params {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(param)))
+		p = append(p, l[:]...)
+		p = append(p, param...)
+	}
+
+	return p
+}
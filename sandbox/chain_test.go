@@ -0,0 +1,72 @@
+// +build unit
+
+package sandbox
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRepo(t *testing.T) storage.Repository {
+	db, err := leveldb.NewLevelDBStorage(leveldb.GetRandomTestStoragePath())
+	assert.NoError(t, err)
+	return leveldb.NewLevelDBRepository(db)
+}
+
+func noChange(b block, h *head, batch storage.Batch) error {
+	return nil
+}
+
+func TestChain_submit(t *testing.T) {
+	db := newTestRepo(t)
+	c, err := newChain(db)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), c.latest().Block.Number)
+
+	b1, err := c.submit(payload("test", []byte{1}), noChange)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), b1.Number)
+	b2, err := c.submit(payload("test", []byte{1}), noChange)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), b2.Number)
+	assert.NotEqual(t, b1.TxHash, b2.TxHash)
+
+	// failed state changes are not mined
+	_, err = c.submit(payload("test", []byte{2}), func(b block, h *head, batch storage.Batch) error {
+		h.Identities++
+		return errors.New("reverted")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, head{Block: b2}, c.latest())
+
+	// state changes are persisted with the head
+	_, err = c.submit(payload("test", []byte{3}), func(b block, h *head, batch storage.Batch) error {
+		h.Identities++
+		return batch.Create([]byte(identityPrefix+"test"), new(identityState))
+	})
+	assert.NoError(t, err)
+	c, err = newChain(db)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), c.latest().Block.Number)
+	assert.Equal(t, uint64(1), c.latest().Identities)
+	assert.True(t, db.Exists([]byte(identityPrefix+"test")))
+
+	// same transactions result in the same hashes
+	c2, err := newChain(newTestRepo(t))
+	assert.NoError(t, err)
+	b, err := c2.submit(payload("test", []byte{1}), noChange)
+	assert.NoError(t, err)
+	assert.Equal(t, b1.TxHash, b.TxHash)
+	b, err = c2.submit(payload("test", []byte{1}), noChange)
+	assert.NoError(t, err)
+	assert.Equal(t, b2.TxHash, b.TxHash)
+}
+
+func TestPayload(t *testing.T) {
+	assert.NotEqual(t, payload("test", []byte{1, 2}, []byte{3}), payload("test", []byte{1}, []byte{2, 3}))
+	assert.Equal(t, payload("test", []byte{1}), payload("test", []byte{1}))
+}
@@ -0,0 +1,390 @@
+package sandbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/crypto"
+	"github.com/centrifuge/go-centrifuge/crypto/ed25519"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/identity/ideth"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// identityPrefix is the prefix of the keys of the identities
+	identityPrefix = "sandbox_identity_"
+
+	// ErrNotSimulated must be used for identity contract calls that are not simulated in sandbox mode.
+	ErrNotSimulated = errors.Error("not simulated in sandbox mode")
+)
+
+// identityKey is a key of an identity.
+type identityKey struct {
+	Key       [32]byte
+	Purposes  []*big.Int
+	Type      *big.Int
+	RevokedAt uint32 // block number, 0 if not revoked
+
+	// RevokedTime is the time of the block the key was revoked in
+	RevokedTime time.Time
+}
+
+// hasPurpose returns true if the key has the purpose.
+func (k *identityKey) hasPurpose(purpose *big.Int) bool {
+	for _, p := range k.Purposes {
+		if p.Cmp(purpose) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// identityState holds the keys of an identity in the order they were added.
+type identityState struct {
+	Keys []*identityKey
+}
+
+// Type returns the reflect type of the identityState.
+func (s *identityState) Type() reflect.Type {
+	return reflect.TypeOf(s)
+}
+
+// JSON returns the json representation of the identityState.
+func (s *identityState) JSON() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// FromJSON loads the identityState from the json.
+func (s *identityState) FromJSON(data []byte) error {
+	return json.Unmarshal(data, s)
+}
+
+// key returns the key of the identity, nil if the identity doesn't have it.
+func (s *identityState) key(key [32]byte) *identityKey {
+	for _, k := range s.Keys {
+		if k.Key == key {
+			return k
+		}
+	}
+
+	return nil
+}
+
+func identityStateKey(did identity.DID) []byte {
+	return []byte(identityPrefix + did.String())
+}
+
+// loadIdentity loads the identity from the DB.
+func loadIdentity(db storage.Repository, did identity.DID) (*identityState, error) {
+	m, err := db.Get(identityStateKey(did))
+	if err != nil {
+		return nil, errors.New("identity %s not found", did.String())
+	}
+
+	return m.(*identityState), nil
+}
+
+// factory implements identity.Factory on the fake chain.
+// Identity addresses are calculated from the configured factory address and the number of identities created, like the
+// identity factory contract.
+type factory struct {
+	chain   *chain
+	address common.Address
+}
+
+func newFactory(chain *chain, address common.Address) identity.Factory {
+	return &factory{chain: chain, address: address}
+}
+
+// CreateIdentity creates a new identity without any keys.
+func (f *factory) CreateIdentity(ctx context.Context) (*identity.DID, error) {
+	var did identity.DID
+	_, err := f.chain.submit(payload("createIdentity", f.address[:]), func(b block, h *head, batch storage.Batch) error {
+		h.Identities++
+		did = identity.NewDID(ideth.CalculateCreatedAddress(f.address, h.Identities))
+		return batch.Create(identityStateKey(did), new(identityState))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("ID Created with address: %s", did.String())
+	return &did, nil
+}
+
+// IdentityExists returns true if the identity was created.
+func (f *factory) IdentityExists(did *identity.DID) (exists bool, err error) {
+	return f.chain.db.Exists(identityStateKey(*did)), nil
+}
+
+// CalculateIdentityAddress returns the address of the next identity to be created.
+func (f *factory) CalculateIdentityAddress(ctx context.Context) (*common.Address, error) {
+	address := ideth.CalculateCreatedAddress(f.address, f.chain.latest().Identities+1)
+	return &address, nil
+}
+
+// service implements identity.ServiceDID on the fake chain.
+// Executing calls on other contracts through the identity is not simulated.
+type service struct {
+	chain *chain
+}
+
+func newService(chain *chain) identity.ServiceDID {
+	return &service{chain: chain}
+}
+
+// update applies the change to the identity of the account in a new transaction.
+func (s *service) update(ctx context.Context, method string, key [32]byte, change func(b block, st *identityState) error) error {
+	did, err := ideth.NewDIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.chain.submit(payload(method, did[:], key[:]), func(b block, h *head, batch storage.Batch) error {
+		st, err := loadIdentity(s.chain.db, did)
+		if err != nil {
+			return err
+		}
+
+		err = change(b, st)
+		if err != nil {
+			return err
+		}
+
+		return batch.Update(identityStateKey(did), st)
+	})
+	return err
+}
+
+// AddKey adds a key to identity contract
+func (s *service) AddKey(ctx context.Context, key identity.KeyDID) error {
+	return s.AddMultiPurposeKey(ctx, key.GetKey(), []*big.Int{key.GetPurpose()}, key.GetType())
+}
+
+// AddMultiPurposeKey adds a key with multiple purposes
+// The purposes are added to the key if the identity already has it.
+func (s *service) AddMultiPurposeKey(ctx context.Context, key [32]byte, purposes []*big.Int, keyType *big.Int) error {
+	return s.update(ctx, "addKey", key, func(b block, st *identityState) error {
+		k := st.key(key)
+		if k == nil {
+			k = &identityKey{Key: key, Type: keyType}
+			st.Keys = append(st.Keys, k)
+		}
+
+		if k.RevokedAt != 0 {
+			return errors.New("key %x has been revoked", key)
+		}
+
+		for _, p := range purposes {
+			if !k.hasPurpose(p) {
+				k.Purposes = append(k.Purposes, p)
+			}
+		}
+
+		return nil
+	})
+}
+
+// RevokeKey revokes an existing key in the smart contract
+func (s *service) RevokeKey(ctx context.Context, key [32]byte) error {
+	return s.update(ctx, "revokeKey", key, func(b block, st *identityState) error {
+		k := st.key(key)
+		if k == nil {
+			return errors.New("key %x not found", key)
+		}
+
+		if k.RevokedAt != 0 {
+			return errors.New("key %x has already been revoked", key)
+		}
+
+		k.RevokedAt = uint32(b.Number)
+		k.RevokedTime = b.Time
+		return nil
+	})
+}
+
+// AddKeysForAccount adds the keys from the config to the identity
+func (s *service) AddKeysForAccount(acc config.Account) error {
+	tctx, err := contextutil.New(context.Background(), acc)
+	if err != nil {
+		return err
+	}
+
+	accKeys, err := acc.GetKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []string{identity.KeyPurposeAction.Name, identity.KeyPurposeP2PDiscovery.Name, identity.KeyPurposeSigning.Name} {
+		pk32, err := utils.SliceToByte32(accKeys[name].PublicKey)
+		if err != nil {
+			return err
+		}
+
+		purpose := identity.GetPurposeByName(name).Value
+		err = s.AddKey(tctx, identity.NewKey(pk32, &purpose, big.NewInt(identity.KeyTypeECDSA), 0))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetKey return a key from the identity
+// Like the identity contract, an empty key is returned if the identity doesn't have the key.
+func (s *service) GetKey(did identity.DID, key [32]byte) (*identity.KeyResponse, error) {
+	st, err := loadIdentity(s.chain.db, did)
+	if err != nil {
+		return nil, err
+	}
+
+	k := st.key(key)
+	if k == nil {
+		return &identity.KeyResponse{}, nil
+	}
+
+	return &identity.KeyResponse{Key: k.Key, Purposes: k.Purposes, RevokedAt: k.RevokedAt}, nil
+}
+
+// GetKeysByPurpose returns the keys of the identity with the purpose in the order they were added.
+func (s *service) GetKeysByPurpose(did identity.DID, purpose *big.Int) ([]identity.KeyDID, error) {
+	st, err := loadIdentity(s.chain.db, did)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []identity.KeyDID
+	for _, k := range st.Keys {
+		if k.hasPurpose(purpose) {
+			keys = append(keys, identity.NewKey(k.Key, purpose, k.Type, k.RevokedAt))
+		}
+	}
+
+	return keys, nil
+}
+
+// RawExecute is not simulated in sandbox mode.
+func (s *service) RawExecute(ctx context.Context, to common.Address, data []byte) (txID identity.IDTX, done chan bool, err error) {
+	return transactions.NilTxID(), nil, errors.NewTypedError(ErrNotSimulated, errors.New("execute on %s", to.String()))
+}
+
+// Execute is not simulated in sandbox mode.
+func (s *service) Execute(ctx context.Context, to common.Address, contractAbi, methodName string, args ...interface{}) (txID identity.IDTX, done chan bool, err error) {
+	return s.RawExecute(ctx, to, nil)
+}
+
+// CurrentP2PKey returns the latest P2P key
+func (s *service) CurrentP2PKey(did identity.DID) (ret string, err error) {
+	keys, err := s.GetKeysByPurpose(did, &(identity.KeyPurposeP2PDiscovery.Value))
+	if err != nil {
+		return ret, err
+	}
+
+	if len(keys) == 0 {
+		return "", errors.New("identity %s doesn't have a p2p key", did.String())
+	}
+
+	lastKey := keys[len(keys)-1]
+	if lastKey.GetRevokedAt() != 0 {
+		return "", errors.New("current p2p key has been revoked")
+	}
+
+	p2pID, err := ed25519.PublicKeyToP2PKey(lastKey.GetKey())
+	if err != nil {
+		return ret, err
+	}
+
+	return p2pID.Pretty(), nil
+}
+
+// GetClientP2PURL returns the p2p url associated with the did
+func (s *service) GetClientP2PURL(did identity.DID) (string, error) {
+	p2pID, err := s.CurrentP2PKey(did)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("/ipfs/%s", p2pID), nil
+}
+
+// GetClientsP2PURLs returns p2p urls associated with each centIDs
+// will error out at first failure
+func (s *service) GetClientsP2PURLs(dids []*identity.DID) ([]string, error) {
+	urls := make([]string, len(dids))
+	for idx, did := range dids {
+		url, err := s.GetClientP2PURL(*did)
+		if err != nil {
+			return nil, err
+		}
+
+		urls[idx] = url
+	}
+
+	return urls, nil
+}
+
+// Exists checks if the identity was created
+func (s *service) Exists(ctx context.Context, did identity.DID) error {
+	_, err := loadIdentity(s.chain.db, did)
+	return err
+}
+
+// ValidateKey checks if a given key is valid for the given centrifugeID.
+func (s *service) ValidateKey(ctx context.Context, did identity.DID, key []byte, purpose *big.Int, validateAt *time.Time) error {
+	key32, err := utils.SliceToByte32(key)
+	if err != nil {
+		return err
+	}
+
+	st, err := loadIdentity(s.chain.db, did)
+	if err != nil {
+		return err
+	}
+
+	k := st.key(key32)
+	if k == nil || !k.hasPurpose(purpose) {
+		return errors.New("identity contract doesn't have a key with requested purpose")
+	}
+
+	if k.RevokedAt == 0 {
+		return nil
+	}
+
+	// if a specific time for validation is provided then we validate if a revoked key was revoked before the provided time
+	if validateAt == nil {
+		return errors.New("the given key [%x] for purpose [%s] has been revoked and not valid anymore", key, purpose.String())
+	}
+
+	if validateAt.Unix() > k.RevokedTime.Unix() {
+		return errors.New("the given key [%x] for purpose [%s] has been revoked before provided time %s", key, purpose.String(), validateAt.String())
+	}
+
+	return nil
+}
+
+// ValidateSignature validates a signature on a message based on identity data
+func (s *service) ValidateSignature(did identity.DID, pubKey []byte, signature []byte, message []byte, timestamp time.Time) error {
+	err := s.ValidateKey(context.Background(), did, pubKey, &(identity.KeyPurposeSigning.Value), &timestamp)
+	if err != nil {
+		return err
+	}
+
+	if !crypto.VerifyMessage(pubKey, message, signature, crypto.CurveSecp256K1) {
+		return errors.New("error when validating signature")
+	}
+
+	return nil
+}
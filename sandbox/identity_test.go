@@ -0,0 +1,110 @@
+// +build unit
+
+package sandbox
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/config/configstore"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/identity/ideth"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFactory(t *testing.T) {
+	c, err := newChain(newTestRepo(t))
+	assert.NoError(t, err)
+	factoryAddr := common.BytesToAddress(utils.RandomSlice(20))
+	f := newFactory(c, factoryAddr)
+
+	addr, err := f.CalculateIdentityAddress(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, ideth.CalculateCreatedAddress(factoryAddr, 1), *addr)
+	did := identity.NewDID(*addr)
+	exists, err := f.IdentityExists(&did)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	created, err := f.CreateIdentity(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, did, *created)
+	exists, err = f.IdentityExists(&did)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NoError(t, newService(c).Exists(context.Background(), did))
+
+	addr, err = f.CalculateIdentityAddress(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, ideth.CalculateCreatedAddress(factoryAddr, 2), *addr)
+}
+
+func TestService_keys(t *testing.T) {
+	c, err := newChain(newTestRepo(t))
+	assert.NoError(t, err)
+	did, err := newFactory(c, common.Address{}).CreateIdentity(context.Background())
+	assert.NoError(t, err)
+	ctx, err := contextutil.New(context.Background(), &configstore.Account{IdentityID: did[:]})
+	assert.NoError(t, err)
+	srv := newService(c)
+
+	// unknown identity
+	assert.Error(t, srv.AddKey(newAccountContext(t), identity.NewKey(utils.RandomByte32(), &(identity.KeyPurposeSigning.Value), big.NewInt(identity.KeyTypeECDSA), 0)))
+	_, err = srv.GetKey(identity.NewDID(common.Address{}), utils.RandomByte32())
+	assert.Error(t, err)
+
+	// p2p keys
+	_, err = srv.CurrentP2PKey(*did)
+	assert.Error(t, err)
+	p2pKey1, p2pKey2 := utils.RandomByte32(), utils.RandomByte32()
+	p2pPurpose := &(identity.KeyPurposeP2PDiscovery.Value)
+	assert.NoError(t, srv.AddKey(ctx, identity.NewKey(p2pKey1, p2pPurpose, big.NewInt(identity.KeyTypeECDSA), 0)))
+	assert.NoError(t, srv.AddKey(ctx, identity.NewKey(p2pKey2, p2pPurpose, big.NewInt(identity.KeyTypeECDSA), 0)))
+	keys, err := srv.GetKeysByPurpose(*did, p2pPurpose)
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.Equal(t, p2pKey2, keys[1].GetKey())
+	urls, err := srv.GetClientsP2PURLs([]*identity.DID{did})
+	assert.NoError(t, err)
+	p2pID, err := srv.CurrentP2PKey(*did)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/ipfs/" + p2pID}, urls)
+
+	// multi purpose key
+	key := utils.RandomByte32()
+	signingPurpose := &(identity.KeyPurposeSigning.Value)
+	actionPurpose := &(identity.KeyPurposeAction.Value)
+	assert.NoError(t, srv.AddMultiPurposeKey(ctx, key, []*big.Int{signingPurpose, actionPurpose}, big.NewInt(identity.KeyTypeECDSA)))
+	k, err := srv.GetKey(*did, key)
+	assert.NoError(t, err)
+	assert.Equal(t, &identity.KeyResponse{Key: key, Purposes: []*big.Int{signingPurpose, actionPurpose}}, k)
+	assert.NoError(t, srv.ValidateKey(context.Background(), *did, key[:], signingPurpose, nil))
+	assert.Error(t, srv.ValidateKey(context.Background(), *did, key[:], p2pPurpose, nil))
+	assert.Error(t, srv.ValidateKey(context.Background(), *did, utils.RandomSlice(32), signingPurpose, nil))
+
+	// revoked key
+	before := time.Now().Add(-time.Minute)
+	assert.NoError(t, srv.RevokeKey(ctx, key))
+	assert.Error(t, srv.RevokeKey(ctx, key))
+	assert.Error(t, srv.AddKey(ctx, identity.NewKey(key, signingPurpose, big.NewInt(identity.KeyTypeECDSA), 0)))
+	k, err = srv.GetKey(*did, key)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(c.latest().Block.Number), k.RevokedAt)
+	assert.Error(t, srv.ValidateKey(context.Background(), *did, key[:], signingPurpose, nil))
+	assert.NoError(t, srv.ValidateKey(context.Background(), *did, key[:], signingPurpose, &before))
+	after := time.Now().Add(time.Minute)
+	assert.Error(t, srv.ValidateKey(context.Background(), *did, key[:], signingPurpose, &after))
+}
+
+func TestService_Execute(t *testing.T) {
+	c, err := newChain(newTestRepo(t))
+	assert.NoError(t, err)
+	_, _, err = newService(c).RawExecute(newAccountContext(t), common.Address{}, nil)
+	assert.True(t, errors.IsOfType(ErrNotSimulated, err))
+}
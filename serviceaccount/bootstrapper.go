@@ -0,0 +1,20 @@
+package serviceaccount
+
+import (
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage"
+)
+
+// Bootstrapper implements bootstrap.Bootstrapper.
+type Bootstrapper struct{}
+
+// Bootstrap initialises the service account service on the node storage.
+func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
+	repo, ok := ctx[storage.BootstrappedDB].(storage.Repository)
+	if !ok {
+		return errors.New("storage not initialised")
+	}
+
+	ctx[BootstrappedService] = DefaultService(repo)
+	return nil
+}
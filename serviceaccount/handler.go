@@ -0,0 +1,171 @@
+package serviceaccount
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/serviceaccount"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+type grpcHandler struct {
+	srv Service
+}
+
+// GRPCHandler returns an implementation of serviceaccountpb.ServiceAccountServiceServer
+func GRPCHandler(srv Service) serviceaccountpb.ServiceAccountServiceServer {
+	return grpcHandler{srv: srv}
+}
+
+// CreateServiceAccount creates a service account of the account and returns its credential.
+func (h grpcHandler) CreateServiceAccount(ctx context.Context, req *serviceaccountpb.CreateServiceAccountRequest) (*serviceaccountpb.ServiceAccountCredential, error) {
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	sa, credential, err := h.srv.Create(accountID, req.Name, req.DocumentTypes, req.Operations)
+	if err != nil {
+		log.Error(err)
+		return nil, serviceAccountHTTPError(err)
+	}
+
+	return toProtoCredential(sa, credential)
+}
+
+// ListServiceAccounts returns the service accounts of the account, without their credentials.
+func (h grpcHandler) ListServiceAccounts(ctx context.Context, _ *empty.Empty) (*serviceaccountpb.ListServiceAccountsResponse, error) {
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	sas, err := h.srv.List(accountID)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	resp := new(serviceaccountpb.ListServiceAccountsResponse)
+	for _, sa := range sas {
+		pb, err := toProtoServiceAccount(sa)
+		if err != nil {
+			log.Error(err)
+			return nil, errors.NewHTTPError(http.StatusInternalServerError, err)
+		}
+
+		resp.ServiceAccounts = append(resp.ServiceAccounts, pb)
+	}
+
+	return resp, nil
+}
+
+// RotateServiceAccount replaces the credential of the service account and returns the new one.
+func (h grpcHandler) RotateServiceAccount(ctx context.Context, req *serviceaccountpb.ServiceAccountRequest) (*serviceaccountpb.ServiceAccountCredential, error) {
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	sa, credential, err := h.srv.Rotate(accountID, req.Id)
+	if err != nil {
+		log.Error(err)
+		return nil, serviceAccountHTTPError(err)
+	}
+
+	return toProtoCredential(sa, credential)
+}
+
+// DeleteServiceAccount deletes the service account and its usage log.
+func (h grpcHandler) DeleteServiceAccount(ctx context.Context, req *serviceaccountpb.ServiceAccountRequest) (*empty.Empty, error) {
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	err = h.srv.Delete(accountID, req.Id)
+	if err != nil {
+		log.Error(err)
+		return nil, serviceAccountHTTPError(err)
+	}
+
+	return new(empty.Empty), nil
+}
+
+// GetServiceAccountUsage returns the usage log of the service account, latest first.
+func (h grpcHandler) GetServiceAccountUsage(ctx context.Context, req *serviceaccountpb.ServiceAccountRequest) (*serviceaccountpb.ServiceAccountUsageResponse, error) {
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	usages, err := h.srv.Usage(accountID, req.Id)
+	if err != nil {
+		log.Error(err)
+		return nil, serviceAccountHTTPError(err)
+	}
+
+	resp := new(serviceaccountpb.ServiceAccountUsageResponse)
+	for _, u := range usages {
+		t, err := utils.ToTimestamp(u.Time)
+		if err != nil {
+			log.Error(err)
+			return nil, errors.NewHTTPError(http.StatusInternalServerError, err)
+		}
+
+		resp.Usages = append(resp.Usages, &serviceaccountpb.Usage{Method: u.Method, Allowed: u.Allowed, Time: t})
+	}
+
+	return resp, nil
+}
+
+// serviceAccountHTTPError maps the service account errors to their http status.
+func serviceAccountHTTPError(err error) error {
+	switch {
+	case errors.IsOfType(ErrInvalidScope, err):
+		return errors.NewHTTPError(http.StatusBadRequest, err)
+	case errors.IsOfType(ErrUnknownServiceAccount, err):
+		return errors.NewHTTPError(http.StatusNotFound, err)
+	default:
+		return errors.NewHTTPError(http.StatusInternalServerError, err)
+	}
+}
+
+func toProtoCredential(sa *ServiceAccount, credential string) (*serviceaccountpb.ServiceAccountCredential, error) {
+	pb, err := toProtoServiceAccount(sa)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return &serviceaccountpb.ServiceAccountCredential{ServiceAccount: pb, Credential: credential}, nil
+}
+
+func toProtoServiceAccount(sa *ServiceAccount) (*serviceaccountpb.ServiceAccount, error) {
+	createdAt, err := utils.ToTimestamp(sa.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	rotatedAt, err := utils.ToTimestamp(sa.RotatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serviceaccountpb.ServiceAccount{
+		Id:            sa.ID,
+		Name:          sa.Name,
+		DocumentTypes: sa.DocumentTypes,
+		Operations:    sa.Operations,
+		CreatedAt:     createdAt,
+		RotatedAt:     rotatedAt,
+	}, nil
+}
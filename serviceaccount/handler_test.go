@@ -0,0 +1,68 @@
+// +build unit
+
+package serviceaccount
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/serviceaccount"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGRPCHandler(t *testing.T) {
+	srv := ctx[BootstrappedService].(Service)
+	h := GRPCHandler(srv)
+
+	// no account
+	_, err := h.ListServiceAccounts(context.Background(), nil)
+	assert.Error(t, err)
+
+	cctx := testingconfig.CreateAccountContext(t, cfg)
+
+	// invalid scope
+	_, err = h.CreateServiceAccount(cctx, &serviceaccountpb.CreateServiceAccountRequest{Name: "erp", DocumentTypes: []string{"invoice"}})
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	created, err := h.CreateServiceAccount(cctx, &serviceaccountpb.CreateServiceAccountRequest{
+		Name:          "erp",
+		DocumentTypes: []string{"invoice", "purchaseorder"},
+		Operations:    []string{OperationRead},
+	})
+	assert.NoError(t, err)
+	assert.True(t, IsCredential(created.Credential))
+	assert.Equal(t, []string{"invoice", "purchaseorder"}, created.ServiceAccount.DocumentTypes)
+	assert.NotNil(t, created.ServiceAccount.CreatedAt)
+
+	list, err := h.ListServiceAccounts(cctx, nil)
+	assert.NoError(t, err)
+	assert.Len(t, list.ServiceAccounts, 1)
+	assert.Equal(t, created.ServiceAccount.Id, list.ServiceAccounts[0].Id)
+
+	// unknown service account
+	_, err = h.RotateServiceAccount(cctx, &serviceaccountpb.ServiceAccountRequest{Id: "unknown"})
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	rotated, err := h.RotateServiceAccount(cctx, &serviceaccountpb.ServiceAccountRequest{Id: created.ServiceAccount.Id})
+	assert.NoError(t, err)
+	assert.NotEqual(t, created.Credential, rotated.Credential)
+
+	_, err = srv.Authenticate(rotated.Credential, "/invoice.DocumentService/Update")
+	assert.Error(t, err)
+	usage, err := h.GetServiceAccountUsage(cctx, &serviceaccountpb.ServiceAccountRequest{Id: created.ServiceAccount.Id})
+	assert.NoError(t, err)
+	assert.Len(t, usage.Usages, 1)
+	assert.Equal(t, "/invoice.DocumentService/Update", usage.Usages[0].Method)
+	assert.False(t, usage.Usages[0].Allowed)
+
+	_, err = h.DeleteServiceAccount(cctx, &serviceaccountpb.ServiceAccountRequest{Id: created.ServiceAccount.Id})
+	assert.NoError(t, err)
+	_, err = h.DeleteServiceAccount(cctx, &serviceaccountpb.ServiceAccountRequest{Id: created.ServiceAccount.Id})
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+}
@@ -0,0 +1,75 @@
+package serviceaccount
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/utils"
+)
+
+// ServiceAccount is a credential of an account for machine-to-machine integrations, like ERP connectors.
+// It can only call the APIs of its document types and operations.
+type ServiceAccount struct {
+	ID        string
+	AccountID identity.DID
+	Name      string
+
+	// DocumentTypes are the document types the service account can use, like invoice.
+	DocumentTypes []string
+
+	// Operations are the operations the service account can run on its document types, like read.
+	Operations []string
+
+	// SecretHash is the sha256 of the secret of the credential. The secret itself is never stored.
+	SecretHash []byte
+
+	CreatedAt time.Time
+	RotatedAt time.Time
+}
+
+// Allows returns true if the service account can run the operation on the document type.
+func (sa *ServiceAccount) Allows(documentType, operation string) bool {
+	return utils.ContainsString(sa.DocumentTypes, documentType) && utils.ContainsString(sa.Operations, operation)
+}
+
+// JSON returns the json of the service account.
+func (sa *ServiceAccount) JSON() ([]byte, error) {
+	return json.Marshal(sa)
+}
+
+// FromJSON loads the service account from its json.
+func (sa *ServiceAccount) FromJSON(data []byte) error {
+	return json.Unmarshal(data, sa)
+}
+
+// Type returns the reflect type of the service account.
+func (sa *ServiceAccount) Type() reflect.Type {
+	return reflect.TypeOf(sa)
+}
+
+// Usage is an API call made with the credential of a service account.
+type Usage struct {
+	ServiceAccountID string
+	Method           string
+
+	// Allowed is false if the call was refused since it is out of the scope of the service account.
+	Allowed bool
+	Time    time.Time
+}
+
+// JSON returns the json of the usage.
+func (u *Usage) JSON() ([]byte, error) {
+	return json.Marshal(u)
+}
+
+// FromJSON loads the usage from its json.
+func (u *Usage) FromJSON(data []byte) error {
+	return json.Unmarshal(data, u)
+}
+
+// Type returns the reflect type of the usage.
+func (u *Usage) Type() reflect.Type {
+	return reflect.TypeOf(u)
+}
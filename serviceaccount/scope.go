@@ -0,0 +1,58 @@
+package serviceaccount
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// OperationCreate creates documents, imported ones included.
+	OperationCreate = "create"
+
+	// OperationUpdate creates new versions of documents.
+	OperationUpdate = "update"
+
+	// OperationRead reads and exports documents.
+	OperationRead = "read"
+)
+
+// Operations are the operations a service account can be scoped to.
+var Operations = []string{OperationCreate, OperationUpdate, OperationRead}
+
+// DocumentTypes are the document types a service account can be scoped to, named after their APIs.
+var DocumentTypes = []string{"invoice", "purchaseorder", "generic", "entity"}
+
+// documentMethods are the operations of the methods of the document type APIs.
+var documentMethods = map[string]string{
+	"Create":         OperationCreate,
+	"ImportUBL":      OperationCreate,
+	"Update":         OperationUpdate,
+	"Get":            OperationRead,
+	"GetVersion":     OperationRead,
+	"ExportUBL":      OperationRead,
+	"GetAttestation": OperationRead,
+}
+
+// unscopedMethods can be called by any service account, so that it can follow the transactions of its documents.
+var unscopedMethods = []string{"/transactions.TransactionService/GetTransactionStatus"}
+
+// methodScope returns the document type and the operation of the grpc method. Returns false if the method isn't
+// open to service accounts, like the account, config and key APIs.
+func methodScope(method string) (documentType, operation string, ok bool) {
+	// methods are named /<document type>.DocumentService/<method>
+	parts := strings.Split(strings.TrimPrefix(method, "/"), "/")
+	if len(parts) != 2 {
+		return "", "", false
+	}
+
+	for _, dt := range DocumentTypes {
+		if parts[0] != fmt.Sprintf("%s.DocumentService", dt) {
+			continue
+		}
+
+		operation, ok = documentMethods[parts[1]]
+		return dt, operation, ok
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,330 @@
+package serviceaccount
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/utils"
+	logging "github.com/ipfs/go-log"
+)
+
+const (
+	// BootstrappedService is the key to the service account Service in the bootstrap context.
+	BootstrappedService = "BootstrappedServiceAccountService"
+
+	// ErrUnknownServiceAccount must be used when no service account of the account exists with the given ID.
+	ErrUnknownServiceAccount = errors.Error("unknown service account")
+
+	// ErrInvalidScope must be used when a service account is created with unknown or no document types or operations.
+	ErrInvalidScope = errors.Error("invalid service account scope")
+
+	// ErrInvalidCredential must be used when a credential doesn't match a service account.
+	ErrInvalidCredential = errors.Error("invalid service account credential")
+
+	// ErrOutOfScope must be used when a service account calls a method outside of its document types and operations.
+	ErrOutOfScope = errors.Error("method out of the service account scope")
+
+	// CredentialPrefix is the prefix of the service account credentials, passed in place of the account identifier.
+	CredentialPrefix = "sa_"
+
+	// idLength and secretLength are the number of random bytes of the ID and the secret of a service account.
+	idLength     = 8
+	secretLength = 32
+
+	// maxUsage is the number of usages kept per service account, the oldest are dropped first.
+	maxUsage = 1000
+
+	// usagePruneInterval is the number of usages recorded between two prunings of a service account.
+	usagePruneInterval = 100
+
+	accountPrefix = "serviceaccount_"
+	usagePrefix   = "serviceaccountusage_"
+)
+
+var log = logging.Logger("serviceaccount")
+
+// Service manages the service accounts of the accounts and authenticates their credentials.
+type Service interface {
+	// Create creates a service account of the account scoped to the document types and operations.
+	// The credential is returned once, only its hash is stored.
+	Create(accountID identity.DID, name string, documentTypes, operations []string) (sa *ServiceAccount, credential string, err error)
+
+	// List returns the service accounts of the account, oldest first.
+	List(accountID identity.DID) ([]*ServiceAccount, error)
+
+	// Rotate replaces the secret of the service account. The previous credential stops working.
+	Rotate(accountID identity.DID, id string) (sa *ServiceAccount, credential string, err error)
+
+	// Delete deletes the service account and its usages.
+	Delete(accountID identity.DID, id string) error
+
+	// Usage returns the usages of the service account, latest first.
+	Usage(accountID identity.DID, id string) ([]*Usage, error)
+
+	// Authenticate returns the service account of the credential if the method is in its scope.
+	// The call is recorded in the usages of the service account, allowed or not.
+	Authenticate(credential, method string) (*ServiceAccount, error)
+}
+
+type service struct {
+	repo storage.Repository
+
+	// mu serialises the usages so that the usages of a service account are pruned once
+	mu       sync.Mutex
+	recorded map[string]int
+}
+
+// DefaultService registers the service account models and returns the default service account service.
+func DefaultService(repo storage.Repository) Service {
+	repo.Register(&ServiceAccount{})
+	repo.Register(&Usage{})
+	return &service{repo: repo, recorded: make(map[string]int)}
+}
+
+// IsCredential returns true if the authorization value is a service account credential.
+func IsCredential(value string) bool {
+	return strings.HasPrefix(value, CredentialPrefix)
+}
+
+func getKey(id string) []byte {
+	return []byte(accountPrefix + id)
+}
+
+// serviceAccountUsagePrefix returns the key prefix of the usages of the service account.
+func serviceAccountUsagePrefix(id string) string {
+	return fmt.Sprintf("%s%s_", usagePrefix, id)
+}
+
+func getUsageKey(id string, t time.Time) []byte {
+	return []byte(fmt.Sprintf("%s%020d", serviceAccountUsagePrefix(id), t.UnixNano()))
+}
+
+// newSecret returns a new secret with its hash, and the credential of the service account with the secret.
+func newSecret(id string) (credential string, hash []byte) {
+	secret := hex.EncodeToString(utils.RandomSlice(secretLength))
+	return fmt.Sprintf("%s%s_%s", CredentialPrefix, id, secret), hashSecret(secret)
+}
+
+func hashSecret(secret string) []byte {
+	h := sha256.Sum256([]byte(secret))
+	return h[:]
+}
+
+// validateScope returns an error if the document types or the operations are empty or unknown.
+func validateScope(documentTypes, operations []string) error {
+	if len(documentTypes) == 0 || len(operations) == 0 {
+		return errors.NewTypedError(ErrInvalidScope, errors.New("document types and operations required"))
+	}
+
+	for _, dt := range documentTypes {
+		if !utils.ContainsString(DocumentTypes, dt) {
+			return errors.NewTypedError(ErrInvalidScope, errors.New("unknown document type %s", dt))
+		}
+	}
+
+	for _, op := range operations {
+		if !utils.ContainsString(Operations, op) {
+			return errors.NewTypedError(ErrInvalidScope, errors.New("unknown operation %s", op))
+		}
+	}
+
+	return nil
+}
+
+func (s *service) Create(accountID identity.DID, name string, documentTypes, operations []string) (*ServiceAccount, string, error) {
+	err := validateScope(documentTypes, operations)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sa := &ServiceAccount{
+		ID:            hex.EncodeToString(utils.RandomSlice(idLength)),
+		AccountID:     accountID,
+		Name:          name,
+		DocumentTypes: documentTypes,
+		Operations:    operations,
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	credential, hash := newSecret(sa.ID)
+	sa.SecretHash = hash
+	sa.RotatedAt = sa.CreatedAt
+	err = s.repo.Create(getKey(sa.ID), sa)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return sa, credential, nil
+}
+
+func (s *service) List(accountID identity.DID) ([]*ServiceAccount, error) {
+	models, err := s.repo.GetAllByPrefix(accountPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var sas []*ServiceAccount
+	for _, m := range models {
+		sa := m.(*ServiceAccount)
+		if sa.AccountID.Equal(accountID) {
+			sas = append(sas, sa)
+		}
+	}
+
+	sort.Slice(sas, func(i, j int) bool {
+		return sas[i].CreatedAt.Before(sas[j].CreatedAt)
+	})
+
+	return sas, nil
+}
+
+// get returns the service account of the account.
+func (s *service) get(accountID identity.DID, id string) (*ServiceAccount, error) {
+	m, err := s.repo.Get(getKey(id))
+	if err != nil || !m.(*ServiceAccount).AccountID.Equal(accountID) {
+		return nil, errors.NewTypedError(ErrUnknownServiceAccount, errors.New("%s", id))
+	}
+
+	return m.(*ServiceAccount), nil
+}
+
+func (s *service) Rotate(accountID identity.DID, id string) (*ServiceAccount, string, error) {
+	sa, err := s.get(accountID, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	credential, hash := newSecret(sa.ID)
+	sa.SecretHash = hash
+	sa.RotatedAt = time.Now().UTC()
+	err = s.repo.Update(getKey(sa.ID), sa)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return sa, credential, nil
+}
+
+func (s *service) Delete(accountID identity.DID, id string) error {
+	sa, err := s.get(accountID, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.Delete(getKey(sa.ID))
+	if err != nil {
+		return err
+	}
+
+	usages, err := s.listUsage(sa.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, u := range usages {
+		err = s.repo.Delete(getUsageKey(sa.ID, u.Time))
+		if err != nil {
+			log.Warningf("failed to drop the usage of service account %s: %v", sa.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *service) Usage(accountID identity.DID, id string) ([]*Usage, error) {
+	sa, err := s.get(accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listUsage(sa.ID)
+}
+
+// listUsage returns the usages of the service account, latest first.
+func (s *service) listUsage(id string) ([]*Usage, error) {
+	models, err := s.repo.GetAllByPrefix(serviceAccountUsagePrefix(id))
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]*Usage, len(models))
+	for i, m := range models {
+		usages[i] = m.(*Usage)
+	}
+
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].Time.After(usages[j].Time)
+	})
+
+	return usages, nil
+}
+
+func (s *service) Authenticate(credential, method string) (*ServiceAccount, error) {
+	// credentials are sa_<id>_<secret>
+	parts := strings.Split(strings.TrimPrefix(credential, CredentialPrefix), "_")
+	if !IsCredential(credential) || len(parts) != 2 {
+		return nil, ErrInvalidCredential
+	}
+
+	m, err := s.repo.Get(getKey(parts[0]))
+	if err != nil {
+		return nil, ErrInvalidCredential
+	}
+
+	sa := m.(*ServiceAccount)
+	if subtle.ConstantTimeCompare(sa.SecretHash, hashSecret(parts[1])) != 1 {
+		return nil, ErrInvalidCredential
+	}
+
+	allowed := utils.ContainsString(unscopedMethods, method)
+	if !allowed {
+		documentType, operation, ok := methodScope(method)
+		allowed = ok && sa.Allows(documentType, operation)
+	}
+
+	s.record(&Usage{ServiceAccountID: sa.ID, Method: method, Allowed: allowed, Time: time.Now().UTC()})
+	if !allowed {
+		return nil, errors.NewTypedError(ErrOutOfScope, errors.New("%s", method))
+	}
+
+	return sa, nil
+}
+
+// record persists the usage, and drops the oldest usages of the service account above maxUsage once in a while.
+// Failures are logged only, so that they don't fail the call.
+func (s *service) record(u *Usage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := s.repo.Create(getUsageKey(u.ServiceAccountID, u.Time), u)
+	if err != nil {
+		log.Warningf("failed to record the usage of service account %s: %v", u.ServiceAccountID, err)
+		return
+	}
+
+	s.recorded[u.ServiceAccountID]++
+	if s.recorded[u.ServiceAccountID]%usagePruneInterval != 0 {
+		return
+	}
+
+	usages, err := s.listUsage(u.ServiceAccountID)
+	if err != nil {
+		log.Warningf("failed to list the usage of service account %s: %v", u.ServiceAccountID, err)
+		return
+	}
+
+	for i := maxUsage; i < len(usages); i++ {
+		err = s.repo.Delete(getUsageKey(u.ServiceAccountID, usages[i].Time))
+		if err != nil {
+			log.Warningf("failed to drop the usage of service account %s: %v", u.ServiceAccountID, err)
+		}
+	}
+}
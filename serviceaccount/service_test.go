@@ -0,0 +1,198 @@
+// +build unit
+
+package serviceaccount
+
+import (
+	"os"
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/bootstrap/bootstrappers/testlogging"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+var ctx = map[string]interface{}{}
+var cfg config.Configuration
+
+func TestMain(m *testing.M) {
+	ibootstappers := []bootstrap.TestBootstrapper{
+		&testlogging.TestLoggingBootstrapper{},
+		&config.Bootstrapper{},
+		&leveldb.Bootstrapper{},
+		Bootstrapper{},
+	}
+	bootstrap.RunTestBootstrappers(ibootstappers, ctx)
+	cfg = ctx[bootstrap.BootstrappedConfig].(config.Configuration)
+	result := m.Run()
+	bootstrap.RunTestTeardown(ibootstappers)
+	os.Exit(result)
+}
+
+func TestMethodScope(t *testing.T) {
+	dt, op, ok := methodScope("/invoice.DocumentService/Create")
+	assert.True(t, ok)
+	assert.Equal(t, "invoice", dt)
+	assert.Equal(t, OperationCreate, op)
+
+	dt, op, ok = methodScope("/purchaseorder.DocumentService/ExportUBL")
+	assert.True(t, ok)
+	assert.Equal(t, "purchaseorder", dt)
+	assert.Equal(t, OperationRead, op)
+
+	// not a document method
+	_, _, ok = methodScope("/invoice.DocumentService/Delete")
+	assert.False(t, ok)
+
+	// account, config and key APIs
+	for _, m := range []string{
+		"/account.AccountService/GenerateAccount",
+		"/config.ConfigService/GetConfig",
+		"/serviceaccount.ServiceAccountService/CreateServiceAccount",
+		"invalid",
+	} {
+		_, _, ok = methodScope(m)
+		assert.False(t, ok, m)
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	srv := ctx[BootstrappedService].(Service)
+	accountID := testingidentity.GenerateRandomDID()
+
+	// invalid scopes
+	for _, c := range []struct {
+		documentTypes, operations []string
+	}{
+		{nil, []string{OperationRead}},
+		{[]string{"invoice"}, nil},
+		{[]string{"unknown"}, []string{OperationRead}},
+		{[]string{"invoice"}, []string{"delete"}},
+	} {
+		_, _, err := srv.Create(accountID, "erp", c.documentTypes, c.operations)
+		assert.Error(t, err)
+		assert.True(t, errors.IsOfType(ErrInvalidScope, err))
+	}
+
+	sa, credential, err := srv.Create(accountID, "erp", []string{"invoice"}, []string{OperationCreate, OperationRead})
+	assert.NoError(t, err)
+	assert.True(t, IsCredential(credential))
+	assert.False(t, sa.CreatedAt.IsZero())
+
+	// other accounts don't see it
+	sas, err := srv.List(testingidentity.GenerateRandomDID())
+	assert.NoError(t, err)
+	assert.Len(t, sas, 0)
+
+	sas, err = srv.List(accountID)
+	assert.NoError(t, err)
+	assert.Len(t, sas, 1)
+	assert.Equal(t, sa.ID, sas[0].ID)
+	assert.Equal(t, "erp", sas[0].Name)
+}
+
+func TestService_Authenticate(t *testing.T) {
+	srv := ctx[BootstrappedService].(Service)
+	accountID := testingidentity.GenerateRandomDID()
+	sa, credential, err := srv.Create(accountID, "erp", []string{"invoice"}, []string{OperationRead})
+	assert.NoError(t, err)
+
+	// invalid credentials
+	for _, c := range []string{"", "sa_", "sa_" + sa.ID, "sa_unknown_secret", credential + "0", accountID.String()} {
+		_, err = srv.Authenticate(c, "/invoice.DocumentService/Get")
+		assert.Equal(t, ErrInvalidCredential, err, c)
+	}
+
+	got, err := srv.Authenticate(credential, "/invoice.DocumentService/Get")
+	assert.NoError(t, err)
+	assert.Equal(t, sa.ID, got.ID)
+	assert.Equal(t, accountID, got.AccountID)
+
+	_, err = srv.Authenticate(credential, "/transactions.TransactionService/GetTransactionStatus")
+	assert.NoError(t, err)
+
+	// out of scope
+	for _, m := range []string{
+		"/invoice.DocumentService/Create",
+		"/purchaseorder.DocumentService/Get",
+		"/account.AccountService/GenerateAccount",
+		"/serviceaccount.ServiceAccountService/RotateServiceAccount",
+	} {
+		_, err = srv.Authenticate(credential, m)
+		assert.True(t, errors.IsOfType(ErrOutOfScope, err), m)
+	}
+
+	usages, err := srv.Usage(accountID, sa.ID)
+	assert.NoError(t, err)
+	assert.Len(t, usages, 6)
+	assert.Equal(t, "/serviceaccount.ServiceAccountService/RotateServiceAccount", usages[0].Method)
+	assert.False(t, usages[0].Allowed)
+	assert.Equal(t, "/invoice.DocumentService/Get", usages[5].Method)
+	assert.True(t, usages[5].Allowed)
+
+	// other accounts can't read the usage
+	_, err = srv.Usage(testingidentity.GenerateRandomDID(), sa.ID)
+	assert.True(t, errors.IsOfType(ErrUnknownServiceAccount, err))
+}
+
+func TestService_Rotate(t *testing.T) {
+	srv := ctx[BootstrappedService].(Service)
+	accountID := testingidentity.GenerateRandomDID()
+	sa, credential, err := srv.Create(accountID, "erp", []string{"generic"}, []string{OperationUpdate})
+	assert.NoError(t, err)
+
+	_, _, err = srv.Rotate(testingidentity.GenerateRandomDID(), sa.ID)
+	assert.True(t, errors.IsOfType(ErrUnknownServiceAccount, err))
+
+	rotated, newCredential, err := srv.Rotate(accountID, sa.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, sa.ID, rotated.ID)
+	assert.NotEqual(t, credential, newCredential)
+	assert.False(t, rotated.RotatedAt.Before(rotated.CreatedAt))
+
+	_, err = srv.Authenticate(credential, "/generic.DocumentService/Update")
+	assert.Equal(t, ErrInvalidCredential, err)
+	_, err = srv.Authenticate(newCredential, "/generic.DocumentService/Update")
+	assert.NoError(t, err)
+}
+
+func TestService_Delete(t *testing.T) {
+	srv := ctx[BootstrappedService].(Service)
+	accountID := testingidentity.GenerateRandomDID()
+	sa, credential, err := srv.Create(accountID, "erp", []string{"entity"}, []string{OperationRead})
+	assert.NoError(t, err)
+	_, err = srv.Authenticate(credential, "/entity.DocumentService/Get")
+	assert.NoError(t, err)
+
+	err = srv.Delete(testingidentity.GenerateRandomDID(), sa.ID)
+	assert.True(t, errors.IsOfType(ErrUnknownServiceAccount, err))
+
+	assert.NoError(t, srv.Delete(accountID, sa.ID))
+	_, err = srv.Authenticate(credential, "/entity.DocumentService/Get")
+	assert.Equal(t, ErrInvalidCredential, err)
+
+	repo := ctx[storage.BootstrappedDB].(storage.Repository)
+	usages, err := repo.GetAllByPrefix(serviceAccountUsagePrefix(sa.ID))
+	assert.NoError(t, err)
+	assert.Len(t, usages, 0)
+}
+
+func TestService_Record_Prune(t *testing.T) {
+	srv := ctx[BootstrappedService].(Service)
+	accountID := testingidentity.GenerateRandomDID()
+	sa, credential, err := srv.Create(accountID, "erp", []string{"invoice"}, []string{OperationRead})
+	assert.NoError(t, err)
+
+	for i := 0; i < maxUsage+usagePruneInterval; i++ {
+		_, err = srv.Authenticate(credential, "/invoice.DocumentService/Get")
+		assert.NoError(t, err)
+	}
+
+	usages, err := srv.Usage(accountID, sa.ID)
+	assert.NoError(t, err)
+	assert.Len(t, usages, maxUsage)
+}
@@ -20,4 +20,7 @@ const (
 
 	// ErrModelTypeNotRegistered must be used when model hasn't been registered in db
 	ErrModelTypeNotRegistered = errors.Error("type not registered")
+
+	// ErrRepositoryBatchCommit must be used when db repository can not commit a batch of writes
+	ErrRepositoryBatchCommit = errors.Error("db repository could not commit the batch")
 )
@@ -0,0 +1,251 @@
+package leveldb
+
+import (
+	"bytes"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// levelDBBatch implements storage.Batch using leveldb write batches.
+// The reads of the batch see the writes queued in it.
+type levelDBBatch struct {
+	repo  *levelDBRepo
+	batch *leveldb.Batch
+
+	// writes holds the values of the keys created/updated by the batch, in the key order.
+	writes *memdb.DB
+
+	// state holds the existence of the keys touched by the batch.
+	// true if the key is created/updated, false if the key is deleted.
+	state map[string]bool
+
+	// checks holds the existence in the db of the keys created/updated by the batch, as checked when queued.
+	// The checks are repeated on commit since the db may have changed in between.
+	checks map[string]bool
+}
+
+func newLevelDBBatch(repo *levelDBRepo) *levelDBBatch {
+	return &levelDBBatch{
+		repo:   repo,
+		batch:  new(leveldb.Batch),
+		writes: memdb.New(comparer.DefaultComparer, 0),
+		state:  make(map[string]bool),
+		checks: make(map[string]bool),
+	}
+}
+
+// Exists checks whether the key exists either in the batch or in the db.
+func (b *levelDBBatch) Exists(key []byte) bool {
+	if exists, ok := b.state[string(key)]; ok {
+		return exists
+	}
+
+	return b.repo.Exists(key)
+}
+
+// Get retrieves the model by key either from the batch or from the db.
+func (b *levelDBBatch) Get(key []byte) (storage.Model, error) {
+	exists, ok := b.state[string(key)]
+	if !ok {
+		return b.repo.Get(key)
+	}
+
+	if !exists {
+		return nil, errors.NewTypedError(storage.ErrModelRepositoryNotFound, errors.New("key %x deleted in the batch", key))
+	}
+
+	data, err := b.writes.Get(key)
+	if err != nil {
+		return nil, errors.NewTypedError(storage.ErrModelRepositoryNotFound, err)
+	}
+
+	b.repo.mu.RLock()
+	defer b.repo.mu.RUnlock()
+	return b.repo.parseModel(data)
+}
+
+// NewIterator returns an iterator over the models whose keys match the prefix, either in the batch or in the db.
+func (b *levelDBBatch) NewIterator(prefix []byte) storage.Iterator {
+	return b.newIterator(util.BytesPrefix(prefix))
+}
+
+// NewIteratorFrom returns an iterator over the models whose keys match the prefix, starting at start, either in the
+// batch or in the db.
+func (b *levelDBBatch) NewIteratorFrom(prefix, start []byte) storage.Iterator {
+	r := util.BytesPrefix(prefix)
+	if bytes.Compare(start, r.Start) > 0 {
+		r.Start = start
+	}
+
+	return b.newIterator(r)
+}
+
+func (b *levelDBBatch) newIterator(r *util.Range) storage.Iterator {
+	return &levelDBIterator{repo: b.repo, iter: &batchIterator{
+		db:     b.repo.db.NewIterator(r, nil),
+		writes: b.writes.NewIterator(r),
+		state:  b.state,
+	}}
+}
+
+// check records the existence of the key in the db the first time the key is written in the batch.
+func (b *levelDBBatch) check(key []byte, exists bool) {
+	if _, ok := b.state[string(key)]; ok {
+		return
+	}
+
+	b.checks[string(key)] = exists
+}
+
+func (b *levelDBBatch) put(key []byte, model storage.Model) error {
+	data, err := marshal(model)
+	if err != nil {
+		return err
+	}
+
+	b.batch.Put(key, data)
+	err = b.writes.Put(key, data)
+	if err != nil {
+		return err
+	}
+
+	b.state[string(key)] = true
+	return nil
+}
+
+// Create queues the model to be created.
+// errors out if key already exists
+func (b *levelDBBatch) Create(key []byte, model storage.Model) error {
+	if b.Exists(key) {
+		return storage.ErrRepositoryModelCreateKeyExists
+	}
+
+	b.check(key, false)
+	return b.put(key, model)
+}
+
+// Update queues the model to be updated.
+// errors out if key doesn't exists
+func (b *levelDBBatch) Update(key []byte, model storage.Model) error {
+	if !b.Exists(key) {
+		return storage.ErrRepositoryModelUpdateKeyNotFound
+	}
+
+	b.check(key, true)
+	return b.put(key, model)
+}
+
+// Delete queues the key to be deleted.
+func (b *levelDBBatch) Delete(key []byte) {
+	b.batch.Delete(key)
+	// the key is not in the writes if it was not written in the batch
+	_ = b.writes.Delete(key)
+	b.state[string(key)] = false
+}
+
+// Len returns the number of writes queued in the batch.
+func (b *levelDBBatch) Len() int {
+	return b.batch.Len()
+}
+
+// Commit writes the batch to the db atomically.
+// Errors out without writing anything if a key created by the batch was created in the db since, or a key updated
+// by the batch was deleted from the db since.
+func (b *levelDBBatch) Commit() error {
+	b.repo.wmu.Lock()
+	defer b.repo.wmu.Unlock()
+	for key, exists := range b.checks {
+		if b.repo.Exists([]byte(key)) == exists {
+			continue
+		}
+
+		if exists {
+			return errors.NewTypedError(storage.ErrRepositoryModelUpdateKeyNotFound, errors.New("key %x deleted before the batch commit", key))
+		}
+
+		return errors.NewTypedError(storage.ErrRepositoryModelCreateKeyExists, errors.New("key %x created before the batch commit", key))
+	}
+
+	err := b.repo.db.Write(b.batch, nil)
+	if err != nil {
+		return errors.NewTypedError(storage.ErrRepositoryBatchCommit, err)
+	}
+
+	b.batch.Reset()
+	b.writes.Reset()
+	b.state = make(map[string]bool)
+	b.checks = make(map[string]bool)
+	return nil
+}
+
+// batchIterator iterates over the values of the db overlaid with the writes of a batch, in the key order.
+// The keys touched by the batch are read from the writes only, so the deleted keys are skipped.
+type batchIterator struct {
+	db, writes iterator.Iterator
+	state      map[string]bool
+
+	started, dbValid, writesValid bool
+	key, value                    []byte
+}
+
+// Next moves to the next key either in the db or in the writes.
+func (i *batchIterator) Next() bool {
+	if !i.started {
+		i.dbValid, i.writesValid = i.db.Next(), i.writes.Next()
+		i.started = true
+	}
+
+	for i.dbValid || i.writesValid {
+		if i.writesValid && (!i.dbValid || bytes.Compare(i.writes.Key(), i.db.Key()) <= 0) {
+			i.key = append([]byte(nil), i.writes.Key()...)
+			i.value = append([]byte(nil), i.writes.Value()...)
+			i.writesValid = i.writes.Next()
+			return true
+		}
+
+		_, touched := i.state[string(i.db.Key())]
+		if !touched {
+			i.key = append([]byte(nil), i.db.Key()...)
+			i.value = append([]byte(nil), i.db.Value()...)
+		}
+
+		i.dbValid = i.db.Next()
+		if !touched {
+			return true
+		}
+	}
+
+	i.key, i.value = nil, nil
+	return false
+}
+
+// Key returns the key of the current value.
+func (i *batchIterator) Key() []byte {
+	return i.key
+}
+
+// Value returns the current value.
+func (i *batchIterator) Value() []byte {
+	return i.value
+}
+
+// Release releases both the iterators.
+func (i *batchIterator) Release() {
+	i.db.Release()
+	i.writes.Release()
+}
+
+// Error returns the error of either of the iterators.
+func (i *batchIterator) Error() error {
+	if err := i.db.Error(); err != nil {
+		return err
+	}
+
+	return i.writes.Error()
+}
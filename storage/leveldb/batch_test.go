@@ -0,0 +1,181 @@
+// +build unit
+
+package leveldb
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevelDBBatch_Create(t *testing.T) {
+	repo, _, err := getRandomRepository()
+	assert.Nil(t, err)
+	repo.Register(&doc{})
+	id1, id2 := utils.RandomSlice(32), utils.RandomSlice(32)
+	d := &doc{SomeString: "Hello, Batch!"}
+
+	b := repo.NewBatch()
+	assert.Nil(t, b.Create(id1, d))
+	assert.True(t, b.Exists(id1))
+
+	// key created in the batch
+	err = b.Create(id1, d)
+	assert.True(t, errors.IsOfType(storage.ErrRepositoryModelCreateKeyExists, err))
+
+	assert.Nil(t, b.Create(id2, d))
+	assert.Equal(t, 2, b.Len())
+
+	// nothing written until commit
+	assert.False(t, repo.Exists(id1))
+	assert.False(t, repo.Exists(id2))
+
+	assert.Nil(t, b.Commit())
+	assert.Equal(t, 0, b.Len())
+	m, err := repo.Get(id1)
+	assert.Nil(t, err)
+	assert.Equal(t, d.SomeString, m.(*doc).SomeString)
+	assert.True(t, repo.Exists(id2))
+
+	// key exists in db
+	b = repo.NewBatch()
+	err = b.Create(id1, d)
+	assert.True(t, errors.IsOfType(storage.ErrRepositoryModelCreateKeyExists, err))
+}
+
+func TestLevelDBBatch_Update_Delete(t *testing.T) {
+	repo, _, err := getRandomRepository()
+	assert.Nil(t, err)
+	repo.Register(&doc{})
+	id := utils.RandomSlice(32)
+	d := &doc{SomeString: "Hello, Batch!"}
+
+	b := repo.NewBatch()
+	err = b.Update(id, d)
+	assert.True(t, errors.IsOfType(storage.ErrRepositoryModelUpdateKeyNotFound, err))
+	assert.Equal(t, 0, b.Len())
+
+	assert.Nil(t, repo.Create(id, d))
+	d.SomeString = "Hello, Repo!"
+	assert.Nil(t, b.Update(id, d))
+	b.Delete(id)
+	assert.False(t, b.Exists(id))
+	err = b.Update(id, d)
+	assert.True(t, errors.IsOfType(storage.ErrRepositoryModelUpdateKeyNotFound, err))
+
+	// still present until commit
+	assert.True(t, repo.Exists(id))
+	assert.Nil(t, b.Commit())
+	assert.False(t, repo.Exists(id))
+}
+
+func TestLevelDBBatch_Get(t *testing.T) {
+	repo, _, err := getRandomRepository()
+	assert.Nil(t, err)
+	repo.Register(&doc{})
+	id1, id2 := utils.RandomSlice(32), utils.RandomSlice(32)
+	assert.Nil(t, repo.Create(id1, &doc{SomeString: "db"}))
+
+	b := repo.NewBatch()
+	m, err := b.Get(id1)
+	assert.Nil(t, err)
+	assert.Equal(t, "db", m.(*doc).SomeString)
+
+	// queued writes are read from the batch
+	assert.Nil(t, b.Update(id1, &doc{SomeString: "batch"}))
+	assert.Nil(t, b.Create(id2, &doc{SomeString: "created"}))
+	m, err = b.Get(id1)
+	assert.Nil(t, err)
+	assert.Equal(t, "batch", m.(*doc).SomeString)
+	m, err = b.Get(id2)
+	assert.Nil(t, err)
+	assert.Equal(t, "created", m.(*doc).SomeString)
+	_, err = repo.Get(id2)
+	assert.True(t, errors.IsOfType(storage.ErrModelRepositoryNotFound, err))
+
+	b.Delete(id1)
+	_, err = b.Get(id1)
+	assert.True(t, errors.IsOfType(storage.ErrModelRepositoryNotFound, err))
+}
+
+func TestLevelDBBatch_NewIterator(t *testing.T) {
+	repo, _, err := getRandomRepository()
+	assert.Nil(t, err)
+	repo.Register(&doc{})
+	prefix := utils.RandomSlice(8)
+	key := func(i byte) []byte {
+		return append(append([]byte(nil), prefix...), i)
+	}
+
+	for _, i := range []byte{1, 3, 5} {
+		assert.Nil(t, repo.Create(key(i), &doc{SomeString: "db"}))
+	}
+
+	b := repo.NewBatch()
+	assert.Nil(t, b.Create(key(2), &doc{SomeString: "batch"}))
+	assert.Nil(t, b.Update(key(3), &doc{SomeString: "batch"}))
+	b.Delete(key(5))
+	assert.Nil(t, b.Create(key(6), &doc{SomeString: "batch"}))
+	assert.Nil(t, b.Create(utils.RandomSlice(9), &doc{SomeString: "other prefix"}))
+
+	read := func(iter storage.Iterator) (keys [][]byte, values []string) {
+		defer iter.Release()
+		for iter.Next() {
+			keys = append(keys, iter.Key())
+			values = append(values, iter.Model().(*doc).SomeString)
+		}
+		assert.Nil(t, iter.Error())
+		return keys, values
+	}
+
+	keys, values := read(b.NewIterator(prefix))
+	assert.Equal(t, [][]byte{key(1), key(2), key(3), key(6)}, keys)
+	assert.Equal(t, []string{"db", "batch", "batch", "batch"}, values)
+
+	keys, _ = read(b.NewIteratorFrom(prefix, key(3)))
+	assert.Equal(t, [][]byte{key(3), key(6)}, keys)
+
+	// the db is untouched until commit
+	keys, _ = read(repo.NewIterator(prefix))
+	assert.Equal(t, [][]byte{key(1), key(3), key(5)}, keys)
+}
+
+func TestLevelDBBatch_Commit_concurrentWrites(t *testing.T) {
+	repo, _, err := getRandomRepository()
+	assert.Nil(t, err)
+	repo.Register(&doc{})
+	id1, id2 := utils.RandomSlice(32), utils.RandomSlice(32)
+	d := &doc{SomeString: "Hello, Batch!"}
+
+	// key created by another writer after it was queued
+	b := repo.NewBatch()
+	assert.Nil(t, b.Create(id1, d))
+	assert.Nil(t, b.Create(id2, d))
+	assert.Nil(t, repo.Create(id1, &doc{SomeString: "Hello, Repo!"}))
+	err = b.Commit()
+	assert.True(t, errors.IsOfType(storage.ErrRepositoryModelCreateKeyExists, err))
+	assert.False(t, repo.Exists(id2))
+	m, err := repo.Get(id1)
+	assert.Nil(t, err)
+	assert.Equal(t, "Hello, Repo!", m.(*doc).SomeString)
+
+	// key deleted by another writer after it was queued
+	b = repo.NewBatch()
+	assert.Nil(t, b.Update(id1, d))
+	assert.Nil(t, b.Create(id2, d))
+	assert.Nil(t, repo.Delete(id1))
+	err = b.Commit()
+	assert.True(t, errors.IsOfType(storage.ErrRepositoryModelUpdateKeyNotFound, err))
+	assert.False(t, repo.Exists(id1))
+	assert.False(t, repo.Exists(id2))
+
+	// keys written twice in the batch are checked against the db state when first queued
+	b = repo.NewBatch()
+	assert.Nil(t, b.Create(id1, d))
+	assert.Nil(t, b.Update(id1, d))
+	assert.Nil(t, b.Commit())
+	assert.True(t, repo.Exists(id1))
+}
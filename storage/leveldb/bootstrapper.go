@@ -9,6 +9,7 @@ import (
 // Config holds configuration data for storage package
 type Config interface {
 	GetStoragePath() string
+	GetResidencyStoragePaths() map[string]string
 	GetConfigStoragePath() string
 	SetDefault(key string, value interface{})
 }
@@ -34,5 +35,17 @@ func (*Bootstrapper) Bootstrap(context map[string]interface{}) error {
 		return errors.New("failed to init level db: %v", err)
 	}
 	context[storage.BootstrappedDB] = NewLevelDBRepository(levelDB)
+
+	residencyDBs := make(map[string]storage.Repository)
+	for tag, path := range cfg.GetResidencyStoragePaths() {
+		db, err := NewLevelDBStorage(path)
+		if err != nil {
+			return errors.New("failed to init level db of residency %s: %v", tag, err)
+		}
+
+		residencyDBs[tag] = NewLevelDBRepository(db)
+	}
+
+	context[storage.BootstrappedResidencyDBs] = residencyDBs
 	return nil
 }
@@ -0,0 +1,64 @@
+package leveldb
+
+import (
+	"github.com/centrifuge/go-centrifuge/storage"
+)
+
+// rawIterator iterates over the serialised values of a key range, such as a leveldb iterator.
+type rawIterator interface {
+	Next() bool
+	Key() []byte
+	Value() []byte
+	Release()
+	Error() error
+}
+
+// levelDBIterator implements storage.Iterator using leveldb iterators.
+type levelDBIterator struct {
+	repo  *levelDBRepo
+	iter  rawIterator
+	key   []byte
+	model storage.Model
+}
+
+// Next moves to the next model.
+// Models that can't be parsed are skipped with a warning, same as in GetAllByPrefix.
+func (i *levelDBIterator) Next() bool {
+	for i.iter.Next() {
+		i.repo.mu.RLock()
+		model, err := i.repo.parseModel(i.iter.Value())
+		i.repo.mu.RUnlock()
+		if err != nil {
+			log.Warningf("Error parsing model: %v", err)
+			continue
+		}
+
+		// the key is only valid until the next call to the leveldb iterator
+		i.key = append([]byte(nil), i.iter.Key()...)
+		i.model = model
+		return true
+	}
+
+	i.key, i.model = nil, nil
+	return false
+}
+
+// Key returns the key of the current model.
+func (i *levelDBIterator) Key() []byte {
+	return i.key
+}
+
+// Model returns the current model.
+func (i *levelDBIterator) Model() storage.Model {
+	return i.model
+}
+
+// Release releases the leveldb iterator.
+func (i *levelDBIterator) Release() {
+	i.iter.Release()
+}
+
+// Error returns the error of the leveldb iterator.
+func (i *levelDBIterator) Error() error {
+	return i.iter.Error()
+}
@@ -1,6 +1,7 @@
 package leveldb
 
 import (
+	"bytes"
 	"encoding/json"
 	"reflect"
 	"sync"
@@ -10,6 +11,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/errors"
 	logging "github.com/ipfs/go-log"
 	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
@@ -24,11 +26,20 @@ func NewLevelDBStorage(path string) (*leveldb.DB, error) {
 	return i, nil
 }
 
+// NewLevelDBReadOnlyStorage opens the existing database at path without writing to it, such as for inspecting the
+// storage of a node offline. Errors out if there is no database at path or it is in use by a running node.
+func NewLevelDBReadOnlyStorage(path string) (*leveldb.DB, error) {
+	return leveldb.OpenFile(path, &opt.Options{ReadOnly: true, ErrorIfMissing: true})
+}
+
 // levelDBRepo implements Repository using LevelDB as storage layer
 type levelDBRepo struct {
 	db     *leveldb.DB
 	models map[string]reflect.Type
 	mu     sync.RWMutex // to protect the models
+
+	// wmu serialises the writes that check the existence of their keys first
+	wmu sync.Mutex
 }
 
 // value is an internal representation of how levelDb stores the model.
@@ -124,10 +135,26 @@ func (l *levelDBRepo) GetAllByPrefix(prefix string) ([]storage.Model, error) {
 	return models, iter.Error()
 }
 
-func (l *levelDBRepo) save(key []byte, model storage.Model) error {
+// NewIterator returns an iterator over the models whose keys match the prefix.
+func (l *levelDBRepo) NewIterator(prefix []byte) storage.Iterator {
+	return &levelDBIterator{repo: l, iter: l.db.NewIterator(util.BytesPrefix(prefix), nil)}
+}
+
+// NewIteratorFrom returns an iterator over the models whose keys match the prefix, starting at start.
+func (l *levelDBRepo) NewIteratorFrom(prefix, start []byte) storage.Iterator {
+	r := util.BytesPrefix(prefix)
+	if bytes.Compare(start, r.Start) > 0 {
+		r.Start = start
+	}
+
+	return &levelDBIterator{repo: l, iter: l.db.NewIterator(r, nil)}
+}
+
+// marshal returns the serialised value of the model as stored in the db.
+func marshal(model storage.Model) ([]byte, error) {
 	data, err := model.JSON()
 	if err != nil {
-		return errors.NewTypedError(storage.ErrModelRepositorySerialisation, errors.New("failed to marshall model: %v", err))
+		return nil, errors.NewTypedError(storage.ErrModelRepositorySerialisation, errors.New("failed to marshall model: %v", err))
 	}
 
 	tp := getTypeIndirect(model.Type())
@@ -138,7 +165,16 @@ func (l *levelDBRepo) save(key []byte, model storage.Model) error {
 
 	data, err = json.Marshal(v)
 	if err != nil {
-		return errors.NewTypedError(storage.ErrModelRepositorySerialisation, errors.New("failed to marshall value: %v", err))
+		return nil, errors.NewTypedError(storage.ErrModelRepositorySerialisation, errors.New("failed to marshall value: %v", err))
+	}
+
+	return data, nil
+}
+
+func (l *levelDBRepo) save(key []byte, model storage.Model) error {
+	data, err := marshal(model)
+	if err != nil {
+		return err
 	}
 
 	err = l.db.Put(key, data, nil)
@@ -152,6 +188,8 @@ func (l *levelDBRepo) save(key []byte, model storage.Model) error {
 // Create creates a model indexed by the key provided
 // errors out if key already exists
 func (l *levelDBRepo) Create(key []byte, model storage.Model) error {
+	l.wmu.Lock()
+	defer l.wmu.Unlock()
 	if l.Exists(key) {
 		return storage.ErrRepositoryModelCreateKeyExists
 	}
@@ -161,6 +199,8 @@ func (l *levelDBRepo) Create(key []byte, model storage.Model) error {
 // Update updates a model indexed by the key provided
 // errors out if key doesn't exists
 func (l *levelDBRepo) Update(key []byte, model storage.Model) error {
+	l.wmu.Lock()
+	defer l.wmu.Unlock()
 	if !l.Exists(key) {
 		return storage.ErrRepositoryModelUpdateKeyNotFound
 	}
@@ -172,6 +212,11 @@ func (l *levelDBRepo) Delete(key []byte) error {
 	return l.db.Delete(key, nil)
 }
 
+// NewBatch returns a new batch of writes backed by the db.
+func (l *levelDBRepo) NewBatch() storage.Batch {
+	return newLevelDBBatch(l)
+}
+
 // Close closes the database
 func (l *levelDBRepo) Close() error {
 	return l.db.Close()
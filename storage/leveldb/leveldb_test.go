@@ -54,6 +54,34 @@ func TestNewLevelDBRepository(t *testing.T) {
 	assert.NotNil(t, repo)
 }
 
+func TestNewLevelDBReadOnlyStorage(t *testing.T) {
+	// missing database
+	_, err := NewLevelDBReadOnlyStorage(GetRandomTestStoragePath())
+	assert.Error(t, err)
+
+	path := GetRandomTestStoragePath()
+	db, err := NewLevelDBStorage(path)
+	assert.NoError(t, err)
+	repo := NewLevelDBRepository(db)
+	repo.Register(&doc{})
+	assert.NoError(t, repo.Create([]byte("key"), &doc{SomeString: "Hello, World!"}))
+
+	// database in use
+	_, err = NewLevelDBReadOnlyStorage(path)
+	assert.Error(t, err)
+	assert.NoError(t, db.Close())
+
+	db, err = NewLevelDBReadOnlyStorage(path)
+	assert.NoError(t, err)
+	defer db.Close()
+	repo = NewLevelDBRepository(db)
+	repo.Register(&doc{})
+	m, err := repo.Get([]byte("key"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello, World!", m.(*doc).SomeString)
+	assert.Error(t, repo.Update([]byte("key"), &doc{}))
+}
+
 func TestLevelDBRepo_Register(t *testing.T) {
 	repo, _, err := getRandomRepository()
 	assert.Nil(t, err)
@@ -129,6 +157,69 @@ func TestLevelDBRepo_GetAllByPrefix(t *testing.T) {
 	assert.Equal(t, 2, len(models))
 }
 
+func TestLevelDBRepo_NewIterator(t *testing.T) {
+	prefix := []byte("prefix-")
+	repo, _, err := getRandomRepository()
+	assert.Nil(t, err)
+	repo.Register(&doc{})
+
+	// No match
+	iter := repo.NewIterator(prefix)
+	assert.False(t, iter.Next())
+	assert.Nil(t, iter.Error())
+	iter.Release()
+
+	id1 := append([]byte("prefix-"), 2)
+	id2 := append([]byte("prefix-"), 1)
+	assert.Nil(t, repo.Create(id1, &doc{SomeString: "Hello, Repo1!"}))
+	assert.Nil(t, repo.Create(id2, &doc{SomeString: "Hello, Repo2!"}))
+	assert.Nil(t, repo.Create([]byte("other-1"), &doc{SomeString: "Hello, Other!"}))
+
+	// models in the key order
+	iter = repo.NewIterator(prefix)
+	defer iter.Release()
+	assert.True(t, iter.Next())
+	assert.Equal(t, id2, iter.Key())
+	assert.Equal(t, "Hello, Repo2!", iter.Model().(*doc).SomeString)
+	assert.True(t, iter.Next())
+	assert.Equal(t, id1, iter.Key())
+	assert.Equal(t, "Hello, Repo1!", iter.Model().(*doc).SomeString)
+	assert.False(t, iter.Next())
+	assert.Nil(t, iter.Error())
+}
+
+func TestLevelDBRepo_NewIteratorFrom(t *testing.T) {
+	prefix := []byte("prefix-")
+	repo, _, err := getRandomRepository()
+	assert.Nil(t, err)
+	repo.Register(&doc{})
+	for i := byte(1); i <= 3; i++ {
+		assert.Nil(t, repo.Create(append([]byte("prefix-"), i), &doc{SomeString: string('0' + i)}))
+	}
+	assert.Nil(t, repo.Create([]byte("other-1"), &doc{SomeString: "Hello, Other!"}))
+
+	// start within the prefix
+	iter := repo.NewIteratorFrom(prefix, append([]byte("prefix-"), 2))
+	assert.True(t, iter.Next())
+	assert.Equal(t, "2", iter.Model().(*doc).SomeString)
+	assert.True(t, iter.Next())
+	assert.Equal(t, "3", iter.Model().(*doc).SomeString)
+	assert.False(t, iter.Next())
+	assert.Nil(t, iter.Error())
+	iter.Release()
+
+	// start before the prefix
+	iter = repo.NewIteratorFrom(prefix, []byte("other-1"))
+	assert.True(t, iter.Next())
+	assert.Equal(t, "1", iter.Model().(*doc).SomeString)
+	iter.Release()
+
+	// start after the prefix
+	iter = repo.NewIteratorFrom(prefix, []byte("q"))
+	assert.False(t, iter.Next())
+	iter.Release()
+}
+
 func TestLevelDBRepo_Create(t *testing.T) {
 	repo, _, err := getRandomRepository()
 	assert.Nil(t, err)
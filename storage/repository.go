@@ -9,6 +9,8 @@ const (
 	BootstrappedDB string = "BootstrappedDB"
 	// BootstrappedConfigDB is a key mapped to DB for configs at boot
 	BootstrappedConfigDB string = "BootstrappedConfigDB"
+	// BootstrappedResidencyDBs is a key mapped to the DBs of the document payloads per residency tag at boot
+	BootstrappedResidencyDBs string = "BootstrappedResidencyDBs"
 )
 
 // Model is an interface to abstract away storage model specificness
@@ -29,8 +31,70 @@ type Repository interface {
 	Exists(key []byte) bool
 	Get(key []byte) (Model, error)
 	GetAllByPrefix(prefix string) ([]Model, error)
+
+	// NewIterator returns an Iterator over the models whose keys match the prefix.
+	NewIterator(prefix []byte) Iterator
+
+	// NewIteratorFrom returns an Iterator over the models whose keys match the prefix, starting at the first key
+	// that is not before start.
+	NewIteratorFrom(prefix, start []byte) Iterator
 	Create(key []byte, model Model) error
 	Update(key []byte, model Model) error
 	Delete(key []byte) error
+
+	// NewBatch returns a Batch that accumulates writes until it is committed.
+	NewBatch() Batch
 	Close() error
 }
+
+// Batch accumulates writes so that they can be committed to the storage atomically.
+// Either all the writes in the batch are persisted or none of them are.
+type Batch interface {
+	// Exists checks whether the key exists either in the batch or in the underlying storage.
+	Exists(key []byte) bool
+
+	// Get retrieves the model by key either from the batch or from the underlying storage.
+	Get(key []byte) (Model, error)
+
+	// NewIterator returns an Iterator over the models whose keys match the prefix, either in the batch or in the
+	// underlying storage. The keys deleted in the batch are skipped.
+	NewIterator(prefix []byte) Iterator
+
+	// NewIteratorFrom is NewIterator starting at the first key that is not before start.
+	NewIteratorFrom(prefix, start []byte) Iterator
+
+	// Create queues the model to be created. Errors out if the key already exists.
+	Create(key []byte, model Model) error
+
+	// Update queues the model to be updated. Errors out if the key doesn't exist.
+	Update(key []byte, model Model) error
+
+	// Delete queues the key to be deleted.
+	Delete(key []byte)
+
+	// Len returns the number of writes queued in the batch.
+	Len() int
+
+	// Commit writes the queued writes atomically.
+	// Errors out without writing anything if the existence of a created or updated key changed since it was queued.
+	Commit() error
+}
+
+// Iterator iterates over the models of a key range in the key order.
+// Release must be called once the iteration is done.
+type Iterator interface {
+	// Next moves to the next model. Returns false if there are no more models or the iteration failed.
+	Next() bool
+
+	// Key returns the key of the current model.
+	Key() []byte
+
+	// Model returns the current model.
+	Model() Model
+
+	// Release releases the resources of the iterator.
+	Release()
+
+	// Error returns the error of the iteration, if any.
+	Error() error
+}
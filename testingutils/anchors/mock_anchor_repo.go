@@ -3,6 +3,7 @@
 package testinganchors
 
 import (
+	"context"
 	"time"
 
 	"github.com/centrifuge/go-centrifuge/anchors"
@@ -17,5 +18,15 @@ type MockAnchorRepo struct {
 func (r *MockAnchorRepo) GetAnchorData(anchorID anchors.AnchorID) (docRoot anchors.DocumentRoot, anchoredTime time.Time, err error) {
 	args := r.Called(anchorID)
 	docRoot, _ = args.Get(0).(anchors.DocumentRoot)
+	if len(args) > 2 {
+		anchoredTime, _ = args.Get(2).(time.Time)
+	}
+
 	return docRoot, anchoredTime, args.Error(1)
 }
+
+func (r *MockAnchorRepo) CommittedAnchors(ctx context.Context, fromBlock uint64) ([]anchors.CommittedAnchor, error) {
+	args := r.Called(ctx, fromBlock)
+	committed, _ := args.Get(0).([]anchors.CommittedAnchor)
+	return committed, args.Error(1)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/centrifuge/go-centrifuge/config/configstore"
 	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/retry"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/centrifuge/go-centrifuge/config"
@@ -29,6 +30,11 @@ func (m *MockConfig) GetStoragePath() string {
 	return args.Get(0).(string)
 }
 
+func (m *MockConfig) GetResidencyStoragePaths() map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]string)
+}
+
 func (m *MockConfig) GetP2PPort() int {
 	args := m.Called()
 	return args.Get(0).(int)
@@ -44,6 +50,16 @@ func (m *MockConfig) GetP2PConnectionTimeout() time.Duration {
 	return args.Get(0).(time.Duration)
 }
 
+func (m *MockConfig) GetP2PMailboxID() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
+func (m *MockConfig) GetNotaryID() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
 func (m *MockConfig) GetReceiveEventNotificationEndpoint() string {
 	args := m.Called()
 	return args.Get(0).(string)
@@ -69,6 +85,11 @@ func (m *MockConfig) GetWorkerWaitTimeMS() int {
 	return args.Get(0).(int)
 }
 
+func (m *MockConfig) GetQueueLanes() map[string]int {
+	args := m.Called()
+	return args.Get(0).(map[string]int)
+}
+
 func (m *MockConfig) GetEthereumNodeURL() string {
 	args := m.Called()
 	return args.Get(0).(string)
@@ -94,6 +115,11 @@ func (m *MockConfig) GetEthereumMaxRetries() int {
 	return args.Get(0).(int)
 }
 
+func (m *MockConfig) GetRetryPolicy(class string) retry.Policy {
+	args := m.Called(class)
+	return args.Get(0).(retry.Policy)
+}
+
 func (m *MockConfig) GetEthereumGasPrice() *big.Int {
 	args := m.Called()
 	return args.Get(0).(*big.Int)
@@ -104,6 +130,11 @@ func (m *MockConfig) GetEthereumGasLimit() uint64 {
 	return args.Get(0).(uint64)
 }
 
+func (m *MockConfig) GetEthereumOracleAddress() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
 func (m *MockConfig) GetEthereumDefaultAccountName() string {
 	args := m.Called()
 	return args.Get(0).(string)
@@ -119,6 +150,126 @@ func (m *MockConfig) GetTxPoolAccessEnabled() bool {
 	return args.Get(0).(bool)
 }
 
+func (m *MockConfig) GetAnchorGracePeriod() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *MockConfig) GetAnchorLinkFormat() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
+func (m *MockConfig) GetAnchorTimeout() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *MockConfig) GetAnchorTimestampTolerance() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *MockConfig) GetStrictCodeValidation() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *MockConfig) GetShareDocumentHistory() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *MockConfig) GetDocumentEncryptionEnabled() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *MockConfig) GetPublicVerificationEnabled() bool {
+	args := m.Called()
+	return args.Get(0).(bool)
+}
+
+func (m *MockConfig) GetUBLAttributeMapping() map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]string)
+}
+
+func (m *MockConfig) GetImportMappings() map[string]map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]map[string]string)
+}
+
+func (m *MockConfig) GetJSONSchemas() map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]string)
+}
+
+func (m *MockConfig) GetDocumentStorageQuota() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *MockConfig) GetRetentionKeepVersions() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *MockConfig) GetRetentionMaxAge() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *MockConfig) GetRetentionArchivePath() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
+func (m *MockConfig) GetAttachmentsIPFSNodeURL() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
+func (m *MockConfig) GetAttachmentsPinTimeout() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *MockConfig) GetMaintenanceConcurrency() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *MockConfig) GetRecoveryFromBlock() uint64 {
+	args := m.Called()
+	return args.Get(0).(uint64)
+}
+
+func (m *MockConfig) GetRecoveryCollaborators() []string {
+	args := m.Called()
+	return args.Get(0).([]string)
+}
+
+func (m *MockConfig) GetAccountsCacheSize() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *MockConfig) GetAccountsWarmUpConcurrency() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+
+func (m *MockConfig) GetConnectorPollInterval() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *MockConfig) GetRESTConnectors() map[string]map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]map[string]string)
+}
+
 func (m *MockConfig) GetNetworkString() string {
 	args := m.Called()
 	return args.Get(0).(string)
@@ -149,6 +300,11 @@ func (m *MockConfig) GetNetworkID() uint32 {
 	return args.Get(0).(uint32)
 }
 
+func (m *MockConfig) GetTreeHashAlgorithm() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+
 func (m *MockConfig) GetIdentityID() ([]byte, error) {
 	args := m.Called()
 	return args.Get(0).([]byte), args.Error(1)
@@ -182,10 +338,13 @@ func CreateTenantContextWithContext(t *testing.T, ctx context.Context, cfg confi
 	return contextHeader
 }
 
+// HandlerContext returns the context of an api request for the first account of the service,
+// as it is passed to the handlers by the api server.
 func HandlerContext(service config.Service) context.Context {
 	tcs, _ := service.GetAllAccounts()
 	cid, _ := tcs[0].GetIdentityID()
 	cidHex := hexutil.Encode(cid)
 	ctx := context.WithValue(context.Background(), config.AccountHeaderKey, cidHex)
+	ctx, _ = contextutil.New(ctx, tcs[0])
 	return ctx
 }
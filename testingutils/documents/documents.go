@@ -4,10 +4,13 @@ package testingdocuments
 
 import (
 	"context"
+	"time"
 
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
 	"github.com/centrifuge/go-centrifuge/documents"
 	"github.com/centrifuge/go-centrifuge/identity"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/coredocumentext"
+	"github.com/centrifuge/go-centrifuge/transactions"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/mock"
 )
@@ -37,14 +40,197 @@ func (m *MockService) CreateProofsForVersion(ctx context.Context, documentID, ve
 	return args.Get(0).(*documents.DocumentProof), args.Error(1)
 }
 
+func (m *MockService) VerifyProof(ctx context.Context, proof *documents.DocumentProof) (*documents.ProofVerification, error) {
+	args := m.Called(proof)
+	pv, _ := args.Get(0).(*documents.ProofVerification)
+	return pv, args.Error(1)
+}
+
+func (m *MockService) CreateConsistencyProof(ctx context.Context, left, right documents.FieldReference) (*documents.ConsistencyProof, error) {
+	args := m.Called(left, right)
+	proof, _ := args.Get(0).(*documents.ConsistencyProof)
+	return proof, args.Error(1)
+}
+
+func (m *MockService) GetDocumentSize(ctx context.Context, documentID []byte) (*documents.DocumentSize, error) {
+	args := m.Called(documentID)
+	return args.Get(0).(*documents.DocumentSize), args.Error(1)
+}
+
+func (m *MockService) List(ctx context.Context, accountID identity.DID, page, perPage int) (*documents.DocumentPage, error) {
+	args := m.Called(accountID, page, perPage)
+	dp, _ := args.Get(0).(*documents.DocumentPage)
+	return dp, args.Error(1)
+}
+
+func (m *MockService) GetDocumentGraph(ctx context.Context, documentID []byte) (*documents.DocumentGraph, error) {
+	args := m.Called(documentID)
+	graph, _ := args.Get(0).(*documents.DocumentGraph)
+	return graph, args.Error(1)
+}
+
+func (m *MockService) GetTransitionGraph(ctx context.Context, documentID []byte) (*documents.TransitionGraph, error) {
+	args := m.Called(documentID)
+	graph, _ := args.Get(0).(*documents.TransitionGraph)
+	return graph, args.Error(1)
+}
+
+func (m *MockService) GetVersionHistory(ctx context.Context, documentID []byte) (*documents.VersionHistory, error) {
+	args := m.Called(documentID)
+	history, _ := args.Get(0).(*documents.VersionHistory)
+	return history, args.Error(1)
+}
+
+func (m *MockService) ListAccessTokens(ctx context.Context, documentID []byte) ([]documents.AccessToken, error) {
+	args := m.Called(documentID)
+	tokens, _ := args.Get(0).([]documents.AccessToken)
+	return tokens, args.Error(1)
+}
+
+func (m *MockService) GetVersionDiff(ctx context.Context, documentID, fromVersion, toVersion []byte) (*documents.VersionDiff, error) {
+	args := m.Called(documentID, fromVersion, toVersion)
+	diff, _ := args.Get(0).(*documents.VersionDiff)
+	return diff, args.Error(1)
+}
+
+func (m *MockService) Import(ctx context.Context, scheme string, format documents.ImportFormat, collaborators []string, data []byte) ([]documents.ImportResult, error) {
+	args := m.Called(scheme, format, collaborators, data)
+	results, _ := args.Get(0).([]documents.ImportResult)
+	return results, args.Error(1)
+}
+
+func (m *MockService) Search(ctx context.Context, accountID identity.DID, query documents.SearchQuery) ([]documents.DocumentItem, error) {
+	args := m.Called(accountID, query)
+	items, _ := args.Get(0).([]documents.DocumentItem)
+	return items, args.Error(1)
+}
+
+func (m *MockService) ExportLedger(ctx context.Context, accountID identity.DID, from, to time.Time) ([]documents.LedgerEvent, error) {
+	args := m.Called(accountID, from, to)
+	events, _ := args.Get(0).([]documents.LedgerEvent)
+	return events, args.Error(1)
+}
+
+func (m *MockService) ExportSignatureAudit(ctx context.Context, accountID identity.DID, from, to time.Time) ([]documents.AuditedSignature, error) {
+	args := m.Called(accountID, from, to)
+	sigs, _ := args.Get(0).([]documents.AuditedSignature)
+	return sigs, args.Error(1)
+}
+
+func (m *MockService) LinkDocument(ctx context.Context, documentID, linkedID []byte) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(documentID, linkedID)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Get(1).(transactions.TxID), nil, args.Error(2)
+}
+
+func (m *MockService) SignAttribute(ctx context.Context, documentID []byte, property string) (*coredocumentextpb.AttributeSignature, error) {
+	args := m.Called(documentID, property)
+	sig, _ := args.Get(0).(*coredocumentextpb.AttributeSignature)
+	return sig, args.Error(1)
+}
+
+func (m *MockService) AddAttributeSignature(ctx context.Context, documentID []byte, sig *coredocumentextpb.AttributeSignature) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(documentID, sig)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Get(1).(transactions.TxID), nil, args.Error(2)
+}
+
+func (m *MockService) Cancel(ctx context.Context, documentID, supersededBy []byte) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(documentID, supersededBy)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Get(1).(transactions.TxID), nil, args.Error(2)
+}
+
+func (m *MockService) AddNFT(ctx context.Context, documentID []byte, grantReadAccess bool, registry common.Address, tokenID []byte) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(documentID, grantReadAccess, registry, tokenID)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Get(1).(transactions.TxID), nil, args.Error(2)
+}
+
+func (m *MockService) AddTransferDetail(ctx context.Context, documentID []byte, detail *coredocumentextpb.TransferDetail) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(documentID, detail)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Get(1).(transactions.TxID), nil, args.Error(2)
+}
+
+func (m *MockService) UpdateTransferDetail(ctx context.Context, documentID []byte, detail *coredocumentextpb.TransferDetail) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(documentID, detail)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Get(1).(transactions.TxID), nil, args.Error(2)
+}
+
+func (m *MockService) AddAttachment(ctx context.Context, documentID []byte, attachment *coredocumentextpb.Attachment, blob []byte) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(documentID, attachment, blob)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Get(1).(transactions.TxID), nil, args.Error(2)
+}
+
+func (m *MockService) UpdateTags(ctx context.Context, documentID []byte, add, remove []string) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(documentID, add, remove)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Get(1).(transactions.TxID), nil, args.Error(2)
+}
+
+func (m *MockService) SaveTemplate(ctx context.Context, tpl *documents.Template) error {
+	args := m.Called(tpl)
+	return args.Error(0)
+}
+
+func (m *MockService) GetTemplate(ctx context.Context, name string) (*documents.Template, error) {
+	args := m.Called(name)
+	tpl, _ := args.Get(0).(*documents.Template)
+	return tpl, args.Error(1)
+}
+
+func (m *MockService) ListTemplates(ctx context.Context) ([]*documents.Template, error) {
+	args := m.Called()
+	tpls, _ := args.Get(0).([]*documents.Template)
+	return tpls, args.Error(1)
+}
+
+func (m *MockService) DeleteTemplate(ctx context.Context, name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+func (m *MockService) CreateFromTemplate(ctx context.Context, name string, collaborators documents.CollaboratorsAccess, data []byte) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(name, collaborators, data)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Get(1).(transactions.TxID), nil, args.Error(2)
+}
+
+func (m *MockService) SimulateAccess(ctx context.Context, documentID []byte, tokenRegistry documents.TokenRegistry, sim documents.AccessSimulation) (*documents.AccessReport, error) {
+	args := m.Called(documentID, sim)
+	report, _ := args.Get(0).(*documents.AccessReport)
+	return report, args.Error(1)
+}
+
+func (m *MockService) RemoveCollaborators(ctx context.Context, documentID []byte, collaborators []identity.DID) (documents.Model, transactions.TxID, chan bool, error) {
+	args := m.Called(documentID, collaborators)
+	model, _ := args.Get(0).(documents.Model)
+	return model, args.Get(1).(transactions.TxID), nil, args.Error(2)
+}
+
+func (m *MockService) GetVersionSummary(ctx context.Context, documentID, version []byte) (*documents.Summary, error) {
+	args := m.Called(documentID, version)
+	summary, _ := args.Get(0).(*documents.Summary)
+	return summary, args.Error(1)
+}
+
 func (m *MockService) DeriveFromCoreDocument(cd coredocumentpb.CoreDocument) (documents.Model, error) {
 	args := m.Called(cd)
 	return args.Get(0).(documents.Model), args.Error(1)
 }
 
-func (m *MockService) RequestDocumentSignature(ctx context.Context, model documents.Model, collaborator identity.DID) (*coredocumentpb.Signature, error) {
+func (m *MockService) RequestDocumentSignature(ctx context.Context, model documents.Model, collaborator identity.DID) ([]*coredocumentpb.Signature, error) {
 	args := m.Called()
-	return args.Get(0).(*coredocumentpb.Signature), args.Error(1)
+	return args.Get(0).([]*coredocumentpb.Signature), args.Error(1)
+}
+
+func (m *MockService) NotarizeProofBundle(ctx context.Context, proof *documents.DocumentProof, requester identity.DID) (*coredocumentpb.Signature, error) {
+	args := m.Called(proof, requester)
+	sig, _ := args.Get(0).(*coredocumentpb.Signature)
+	return sig, args.Error(1)
 }
 
 func (m *MockService) ReceiveAnchoredDocument(ctx context.Context, model documents.Model, collaborator identity.DID) error {
@@ -52,6 +238,63 @@ func (m *MockService) ReceiveAnchoredDocument(ctx context.Context, model documen
 	return args.Error(0)
 }
 
+func (m *MockService) CheckAnchoredDocument(ctx context.Context, model documents.Model, collaborator identity.DID) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockService) RegisterHook(event documents.HookEvent, hook documents.Hook) error {
+	args := m.Called(event, hook)
+	return args.Error(0)
+}
+
+func (m *MockService) RunHooks(ctx context.Context, event documents.HookEvent, model documents.Model) error {
+	args := m.Called(ctx, event, model)
+	return args.Error(0)
+}
+
+func (m *MockService) RegisterVersionFetcher(fetcher documents.VersionFetcher) error {
+	args := m.Called(fetcher)
+	return args.Error(0)
+}
+
+func (m *MockService) RegisterProofRequester(requester documents.ProofRequester) error {
+	args := m.Called(requester)
+	return args.Error(0)
+}
+
+func (m *MockService) RequestProofs(ctx context.Context, req documents.ProofRequest) (*documents.DocumentProof, error) {
+	args := m.Called(req)
+	proof, _ := args.Get(0).(*documents.DocumentProof)
+	return proof, args.Error(1)
+}
+
+func (m *MockService) EmitEvent(ctx context.Context, event documents.Event) {
+	m.Called(ctx, event)
+}
+
+func (m *MockService) SubscribeEvents(sub documents.EventSubscriber) error {
+	args := m.Called(sub)
+	return args.Error(0)
+}
+
+func (m *MockService) ReplayEvents(ctx context.Context, from uint64, sub documents.EventSubscriber) error {
+	args := m.Called(ctx, from, sub)
+	return args.Error(0)
+}
+
+func (m *MockService) ValidateVersion(ctx context.Context, documentID, version []byte, report documents.ValidationReporter) error {
+	args := m.Called(documentID, version)
+	results, _ := args.Get(0).([]documents.ValidationResult)
+	for _, res := range results {
+		if err := report(res); err != nil {
+			return err
+		}
+	}
+
+	return args.Error(1)
+}
+
 func (m *MockService) Exists(ctx context.Context, documentID []byte) bool {
 	args := m.Called()
 	return args.Get(0).(bool)
@@ -60,6 +303,20 @@ func (m *MockService) Exists(ctx context.Context, documentID []byte) bool {
 type MockModel struct {
 	documents.Model
 	mock.Mock
+	status documents.Status
+}
+
+func (m *MockModel) GetStatus() documents.Status {
+	return m.status
+}
+
+func (m *MockModel) SetStatus(status documents.Status) {
+	m.status = status
+}
+
+func (m *MockModel) ID() []byte {
+	args := m.Called()
+	return args.Get(0).([]byte)
 }
 
 func (m *MockModel) PreviousVersion() []byte {
@@ -72,6 +329,23 @@ func (m *MockModel) CurrentVersion() []byte {
 	return args.Get(0).([]byte)
 }
 
+func (m *MockModel) DocumentType() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *MockModel) CalculateDocumentRoot() ([]byte, error) {
+	args := m.Called()
+	root, _ := args.Get(0).([]byte)
+	return root, args.Error(1)
+}
+
+func (m *MockModel) Signatures() []coredocumentpb.Signature {
+	args := m.Called()
+	sigs, _ := args.Get(0).([]coredocumentpb.Signature)
+	return sigs
+}
+
 func (m *MockModel) CurrentVersionPreimage() []byte {
 	args := m.Called()
 	id, _ := args.Get(0).([]byte)
@@ -95,6 +369,72 @@ func (m *MockModel) JSON() ([]byte, error) {
 	return data, args.Error(1)
 }
 
+func (m *MockModel) LinkProofFields(documentID []byte) ([]string, error) {
+	args := m.Called(documentID)
+	fields, _ := args.Get(0).([]string)
+	return fields, args.Error(1)
+}
+
+func (m *MockModel) GetCollaborators(filterIDs ...identity.DID) ([]identity.DID, error) {
+	args := m.Called(filterIDs)
+	dids, _ := args.Get(0).([]identity.DID)
+	return dids, args.Error(1)
+}
+
+func (m *MockModel) AttributeSignatureProofFields(signer identity.DID, property string) ([]string, error) {
+	args := m.Called(signer, property)
+	fields, _ := args.Get(0).([]string)
+	return fields, args.Error(1)
+}
+
+func (m *MockModel) TransferDetails() []*coredocumentextpb.TransferDetail {
+	args := m.Called()
+	details, _ := args.Get(0).([]*coredocumentextpb.TransferDetail)
+	return details
+}
+
+func (m *MockModel) TransferDetail(transferID []byte) (*coredocumentextpb.TransferDetail, error) {
+	args := m.Called(transferID)
+	detail, _ := args.Get(0).(*coredocumentextpb.TransferDetail)
+	return detail, args.Error(1)
+}
+
+func (m *MockModel) TransferDetailProofFields(transferID []byte) ([]string, error) {
+	args := m.Called(transferID)
+	fields, _ := args.Get(0).([]string)
+	return fields, args.Error(1)
+}
+
+func (m *MockModel) Attachments() []*coredocumentextpb.Attachment {
+	args := m.Called()
+	attachments, _ := args.Get(0).([]*coredocumentextpb.Attachment)
+	return attachments
+}
+
+func (m *MockModel) Attachment(name string) (*coredocumentextpb.Attachment, error) {
+	args := m.Called(name)
+	attachment, _ := args.Get(0).(*coredocumentextpb.Attachment)
+	return attachment, args.Error(1)
+}
+
+func (m *MockModel) AttachmentProofFields(name string) ([]string, error) {
+	args := m.Called(name)
+	fields, _ := args.Get(0).([]string)
+	return fields, args.Error(1)
+}
+
+func (m *MockModel) Tags() []string {
+	args := m.Called()
+	tags, _ := args.Get(0).([]string)
+	return tags
+}
+
+func (m *MockModel) TagProofFields() []string {
+	args := m.Called()
+	fields, _ := args.Get(0).([]string)
+	return fields
+}
+
 type MockRegistry struct {
 	mock.Mock
 }
@@ -104,3 +444,18 @@ func (m MockRegistry) OwnerOf(registry common.Address, tokenID []byte) (common.A
 	addr, _ := args.Get(0).(common.Address)
 	return addr, args.Error(1)
 }
+
+// DecimalBytes returns the p2p protobuf encoding of the decimal string s.
+func DecimalBytes(s string) []byte {
+	d, err := documents.NewDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+
+	b, err := d.Bytes()
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
@@ -5,18 +5,26 @@ package testingdocuments
 import (
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/invoice"
 	clientinvoicepb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoice"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/invoiceext"
 	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/golang/protobuf/proto"
 )
 
 func CreateInvoiceData() invoicepb.InvoiceData {
 	recipient := testingidentity.GenerateRandomDID()
 	sender := testingidentity.GenerateRandomDID()
 	payee := testingidentity.GenerateRandomDID()
+	extData, err := proto.Marshal(&invoiceextpb.InvoiceDataExtension{GrossAmountDecimal: DecimalBytes("42")})
+	if err != nil {
+		panic(err)
+	}
+
 	return invoicepb.InvoiceData{
-		Recipient:   recipient[:],
-		Sender:      sender[:],
-		Payee:       payee[:],
-		GrossAmount: 42,
+		Recipient:        recipient[:],
+		Sender:           sender[:],
+		Payee:            payee[:],
+		GrossAmount:      42,
+		XXX_unrecognized: extData,
 	}
 }
 
@@ -26,7 +34,7 @@ func CreateInvoicePayload() *clientinvoicepb.InvoiceCreatePayload {
 			Sender:      "0xed03fa80291ff5ddc284de6b51e716b130b05e20",
 			Recipient:   "0xea939d5c0494b072c51565b191ee59b5d34fbf79",
 			Payee:       "0x087d8ca6a16e6ce8d9ff55672e551a2828ab8e8c",
-			GrossAmount: 42,
+			GrossAmount: "42",
 			ExtraData:   "0x01020302010203",
 			Currency:    "EUR",
 		},
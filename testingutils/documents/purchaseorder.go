@@ -5,14 +5,22 @@ package testingdocuments
 import (
 	"github.com/centrifuge/centrifuge-protobufs/gen/go/purchaseorder"
 	clientpurchaseorderpb "github.com/centrifuge/go-centrifuge/protobufs/gen/go/purchaseorder"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/purchaseorderext"
 	"github.com/centrifuge/go-centrifuge/testingutils/identity"
+	"github.com/golang/protobuf/proto"
 )
 
 func CreatePOData() purchaseorderpb.PurchaseOrderData {
 	recipient := testingidentity.GenerateRandomDID()
+	extData, err := proto.Marshal(&purchaseorderextpb.PurchaseOrderDataExtension{OrderAmountDecimal: DecimalBytes("42")})
+	if err != nil {
+		panic(err)
+	}
+
 	return purchaseorderpb.PurchaseOrderData{
-		Recipient:   recipient[:],
-		OrderAmount: 42,
+		Recipient:        recipient[:],
+		OrderAmount:      42,
+		XXX_unrecognized: extData,
 	}
 }
 
@@ -20,7 +28,7 @@ func CreatePOPayload() *clientpurchaseorderpb.PurchaseOrderCreatePayload {
 	return &clientpurchaseorderpb.PurchaseOrderCreatePayload{
 		Data: &clientpurchaseorderpb.PurchaseOrderData{
 			Recipient:   "0xea939d5c0494b072c51565b191ee59b5d34fbf79",
-			OrderAmount: 42,
+			OrderAmount: "42",
 			ExtraData:   "0x01020302010203",
 			Currency:    "EUR",
 		},
@@ -41,6 +41,10 @@ func (MockTxManager) GetTransactionStatus(accountID identity.DID, id transaction
 	panic("implement me")
 }
 
+func (MockTxManager) ListTransactions(accountID identity.DID, query transactions.Query) (*transactions.Page, error) {
+	panic("implement me")
+}
+
 func (MockTxManager) WaitForTransaction(accountID identity.DID, txID transactions.TxID) error {
 	panic("implement me")
 }
@@ -129,11 +129,11 @@ func defaultProofPayload(documentType string) map[string]interface{} {
 
 		return map[string]interface{}{
 			"type":   "http://github.com/centrifuge/centrifuge-protobufs/invoice/#invoice.InvoiceData",
-			"fields": []string{"invoice.net_amount", "invoice.currency"},
+			"fields": []string{"invoice.net_amount_decimal", "invoice.currency"},
 		}
 	}
 	return map[string]interface{}{
 		"type":   "http://github.com/centrifuge/centrifuge-protobufs/purchaseorder/#purchaseorder.PurchaseOrderData",
-		"fields": []string{"po.net_amount", "po.currency"},
+		"fields": []string{"po.net_amount_decimal", "po.currency"},
 	}
 }
@@ -47,12 +47,12 @@ func proofWithMultipleFields_successful(t *testing.T, documentType string) {
 
 func checkProof(objProof *httpexpect.Object, documentType string, docIdentifier string) {
 	compactPrefix := "0x00010000" // invoice prefix
-	prop1 := "0000000f"           // invoice.net_amount
+	prop1 := "0000001a"           // invoice.net_amount_decimal
 	prop2 := "0000000d"           // invoice.currency
 
 	if documentType == typePO {
 		compactPrefix = "0x00020000" // po prefix
-		prop1 = "0000000e"           // po.net_amount
+		prop1 = "0000001a"           // po.net_amount_decimal
 		prop2 = "0000000c"           // po.currency
 	}
 
@@ -12,4 +12,10 @@ const (
 
 	// ErrKeyConstructionFailed error when the key construction failed.
 	ErrKeyConstructionFailed = errors.Error("failed to construct transaction key")
+
+	// ErrInvalidQuery error when the transactions query is invalid.
+	ErrInvalidQuery = errors.Error("invalid transactions query")
+
+	// ErrInvalidCursor error when the cursor of a transactions query is invalid.
+	ErrInvalidCursor = errors.Error("invalid transactions cursor")
 )
@@ -150,6 +150,56 @@ type TXValue struct {
 	Value  []byte
 }
 
+// Order is the order of listed transactions by their creation time.
+type Order string
+
+const (
+	// OrderDesc lists the newest transactions first.
+	OrderDesc Order = "desc"
+
+	// OrderAsc lists the oldest transactions first.
+	OrderAsc Order = "asc"
+
+	// DefaultListLimit is the number of transactions in a page if the query has no limit.
+	DefaultListLimit = 20
+
+	// MaxListLimit is the maximum number of transactions in a page.
+	MaxListLimit = 100
+)
+
+// Query filters and paginates the transactions of an account.
+// Zero values of the filters match all the transactions.
+type Query struct {
+	// Status matches the transactions with the status
+	Status Status
+
+	// Description matches the transactions whose description contains it, case insensitive
+	Description string
+
+	// CreatedAfter matches the transactions created at or after the time
+	CreatedAfter time.Time
+
+	// CreatedBefore matches the transactions created before the time
+	CreatedBefore time.Time
+
+	// Order of the transactions, OrderDesc if empty
+	Order Order
+
+	// Cursor is the NextCursor of the previous page, empty for the first page
+	Cursor string
+
+	// Limit is the maximum number of transactions in the page, DefaultListLimit if zero
+	Limit int
+}
+
+// Page is a page of the transactions matching a Query.
+type Page struct {
+	Transactions []*Transaction
+
+	// NextCursor is the cursor of the next page, empty if this is the last page
+	NextCursor string
+}
+
 // Config is the config interface for transactions package
 type Config interface {
 	GetEthereumContextWaitTimeout() time.Duration
@@ -163,6 +213,7 @@ type Manager interface {
 	UpdateTransactionWithValue(accountID identity.DID, id TxID, key string, value []byte) error
 	UpdateTaskStatus(accountID identity.DID, id TxID, status Status, taskName, message string) error
 	GetTransactionStatus(accountID identity.DID, id TxID) (*transactionspb.TransactionStatusResponse, error)
+	ListTransactions(accountID identity.DID, query Query) (*Page, error)
 	WaitForTransaction(accountID identity.DID, txID TxID) error
 	GetDefaultTaskTimeout() time.Duration
 }
@@ -171,4 +222,7 @@ type Manager interface {
 type Repository interface {
 	Get(cid identity.DID, id TxID) (*Transaction, error)
 	Save(transaction *Transaction) error
+
+	// List returns the page of the transactions of the identity matching the query.
+	List(cid identity.DID, query Query) (*Page, error)
 }
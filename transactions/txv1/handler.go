@@ -9,6 +9,7 @@ import (
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/transactions"
 	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/centrifuge/go-centrifuge/utils"
 	logging "github.com/ipfs/go-log"
 )
 
@@ -33,18 +34,12 @@ type grpcHandler struct {
 
 // GetTransactionStatus returns transaction status of the given transaction id.
 func (h grpcHandler) GetTransactionStatus(ctx context.Context, req *transactionspb.TransactionStatusRequest) (*transactionspb.TransactionStatusResponse, error) {
-	ctxHeader, err := contextutil.Context(ctx, h.configService)
-	if err != nil {
-		apiLog.Error(err)
-		return nil, err
-	}
-
 	id, err := transactions.FromString(req.TransactionId)
 	if err != nil {
 		return nil, errors.NewTypedError(ErrInvalidTransactionID, err)
 	}
 
-	tc, err := contextutil.Account(ctxHeader)
+	tc, err := contextutil.Account(ctx)
 	if err != nil {
 		return nil, ErrInvalidAccountID
 	}
@@ -56,3 +51,79 @@ func (h grpcHandler) GetTransactionStatus(ctx context.Context, req *transactions
 
 	return h.srv.GetTransactionStatus(identity.NewDIDFromBytes(accID), id)
 }
+
+// ListTransactions returns the page of the transactions of the account matching the filters of the request.
+func (h grpcHandler) ListTransactions(ctx context.Context, req *transactionspb.ListTransactionsRequest) (*transactionspb.ListTransactionsResponse, error) {
+	query, err := toQuery(req)
+	if err != nil {
+		return nil, errors.NewTypedError(transactions.ErrInvalidQuery, err)
+	}
+
+	tc, err := contextutil.Account(ctx)
+	if err != nil {
+		return nil, ErrInvalidAccountID
+	}
+
+	accID, err := tc.GetIdentityID()
+	if err != nil {
+		return nil, ErrInvalidAccountID
+	}
+
+	page, err := h.srv.ListTransactions(identity.NewDIDFromBytes(accID), query)
+	if err != nil {
+		apiLog.Error(err)
+		return nil, err
+	}
+
+	resp := &transactionspb.ListTransactionsResponse{NextCursor: page.NextCursor}
+	for _, tx := range page.Transactions {
+		status, err := toStatusResponse(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Data = append(resp.Data, status)
+	}
+
+	return resp, nil
+}
+
+// toQuery validates the list request and converts it to a query.
+func toQuery(req *transactionspb.ListTransactionsRequest) (query transactions.Query, err error) {
+	query.Status = transactions.Status(req.Status)
+	switch query.Status {
+	case "", transactions.Pending, transactions.Success, transactions.Failed:
+	default:
+		return query, errors.New("unknown status %s", req.Status)
+	}
+
+	query.Order = transactions.Order(req.Order)
+	switch query.Order {
+	case "", transactions.OrderDesc, transactions.OrderAsc:
+	default:
+		return query, errors.New("unknown order %s", req.Order)
+	}
+
+	if req.Limit < 0 || req.Limit > transactions.MaxListLimit {
+		return query, errors.New("limit must be between 0 and %d", transactions.MaxListLimit)
+	}
+
+	if req.CreatedAfter != nil {
+		query.CreatedAfter, err = utils.FromTimestamp(req.CreatedAfter)
+		if err != nil {
+			return query, err
+		}
+	}
+
+	if req.CreatedBefore != nil {
+		query.CreatedBefore, err = utils.FromTimestamp(req.CreatedBefore)
+		if err != nil {
+			return query, err
+		}
+	}
+
+	query.Description = req.Description
+	query.Cursor = req.Cursor
+	query.Limit = int(req.Limit)
+	return query, nil
+}
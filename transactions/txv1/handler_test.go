@@ -4,6 +4,7 @@ package txv1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/centrifuge/go-centrifuge/config"
 	"github.com/centrifuge/go-centrifuge/errors"
@@ -53,3 +54,47 @@ func TestGRPCHandler_GetTransactionStatus(t *testing.T) {
 	assert.Equal(t, tx.ID.String(), res.TransactionId)
 	assert.Equal(t, string(tx.Status), res.Status)
 }
+
+func TestGRPCHandler_ListTransactions(t *testing.T) {
+	cService := ctx[config.BootstrappedConfigStorage].(config.Service)
+	h := GRPCHandler(ctx[transactions.BootstrappedService].(transactions.Manager), cService)
+	ctxl := testingconfig.HandlerContext(cService)
+
+	// invalid queries
+	for _, req := range []*transactionspb.ListTransactionsRequest{
+		{Status: "unknown"},
+		{Order: "random"},
+		{Limit: transactions.MaxListLimit + 1},
+		{Limit: -1},
+	} {
+		res, err := h.ListTransactions(ctxl, req)
+		assert.Nil(t, res)
+		assert.Error(t, err)
+		assert.True(t, errors.IsOfType(transactions.ErrInvalidQuery, err))
+	}
+
+	tcs, _ := cService.GetAllAccounts()
+	accID, _ := tcs[0].GetIdentityID()
+	cid := identity.NewDIDFromBytes(accID)
+	desc := "list " + transactions.NewTxID().String()
+	repo := ctx[transactions.BootstrappedRepo].(transactions.Repository)
+	tx1 := transactions.NewTransaction(cid, desc)
+	tx1.CreatedAt = tx1.CreatedAt.Add(-time.Second)
+	assert.NoError(t, repo.Save(tx1))
+	tx2 := transactions.NewTransaction(cid, desc)
+	assert.NoError(t, repo.Save(tx2))
+
+	// success
+	res, err := h.ListTransactions(ctxl, &transactionspb.ListTransactionsRequest{Description: desc, Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, res.Data, 1)
+	assert.Equal(t, tx2.ID.String(), res.Data[0].TransactionId)
+	assert.Equal(t, desc, res.Data[0].Description)
+	assert.NotEmpty(t, res.NextCursor)
+
+	res, err = h.ListTransactions(ctxl, &transactionspb.ListTransactionsRequest{Description: desc, Limit: 1, Cursor: res.NextCursor})
+	assert.NoError(t, err)
+	assert.Len(t, res.Data, 1)
+	assert.Equal(t, tx1.ID.String(), res.Data[0].TransactionId)
+	assert.Empty(t, res.NextCursor)
+}
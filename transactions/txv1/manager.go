@@ -166,6 +166,16 @@ func (s *manager) GetTransactionStatus(accountID identity.DID, id transactions.T
 		return nil, err
 	}
 
+	return toStatusResponse(tx)
+}
+
+// ListTransactions returns the page of the transactions of the identity matching the query.
+func (s *manager) ListTransactions(accountID identity.DID, query transactions.Query) (*transactions.Page, error) {
+	return s.repo.List(accountID, query)
+}
+
+// toStatusResponse converts the transaction to its status response.
+func toStatusResponse(tx *transactions.Transaction) (*transactionspb.TransactionStatusResponse, error) {
 	var msg string
 	lastUpdated := tx.CreatedAt.UTC()
 	if len(tx.Logs) > 0 {
@@ -179,10 +189,17 @@ func (s *manager) GetTransactionStatus(accountID identity.DID, id transactions.T
 		return nil, err
 	}
 
+	createdAt, err := utils.ToTimestamp(tx.CreatedAt.UTC())
+	if err != nil {
+		return nil, err
+	}
+
 	return &transactionspb.TransactionStatusResponse{
 		TransactionId: tx.ID.String(),
 		Status:        string(tx.Status),
 		Message:       msg,
 		LastUpdated:   tm,
+		Description:   tx.Description,
+		CreatedAt:     createdAt,
 	}, nil
 }
@@ -1,10 +1,16 @@
 package txv1
 
 import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"strings"
+
 	"github.com/centrifuge/go-centrifuge/errors"
 	"github.com/centrifuge/go-centrifuge/identity"
 	"github.com/centrifuge/go-centrifuge/storage"
 	"github.com/centrifuge/go-centrifuge/transactions"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // txRepository implements Repository.
@@ -57,3 +63,123 @@ func (r *txRepository) Save(tx *transactions.Transaction) error {
 
 	return r.repo.Create(key, tx)
 }
+
+// List returns the page of the transactions of the identity matching the query.
+// Transactions are ordered by their creation time, ties are broken by their IDs so that cursors are stable.
+func (r *txRepository) List(cid identity.DID, query transactions.Query) (*transactions.Page, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = transactions.DefaultListLimit
+	}
+
+	var after *cursor
+	if query.Cursor != "" {
+		c, err := decodeCursor(query.Cursor)
+		if err != nil {
+			return nil, errors.NewTypedError(transactions.ErrInvalidCursor, err)
+		}
+		after = &c
+	}
+
+	models, err := r.repo.GetAllByPrefix(string(cid[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	desc := query.Order != transactions.OrderAsc
+	var txs []*transactions.Transaction
+	for _, m := range models {
+		// documents of the identity share the prefix
+		tx, ok := m.(*transactions.Transaction)
+		if !ok || !matches(tx, query) {
+			continue
+		}
+
+		if after != nil && !after.before(newCursor(tx), desc) {
+			continue
+		}
+
+		txs = append(txs, tx)
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		return newCursor(txs[i]).before(newCursor(txs[j]), desc)
+	})
+
+	page := new(transactions.Page)
+	if len(txs) > limit {
+		txs = txs[:limit]
+		page.NextCursor = newCursor(txs[limit-1]).encode()
+	}
+
+	page.Transactions = txs
+	return page, nil
+}
+
+// matches checks if the transaction matches the filters of the query.
+func matches(tx *transactions.Transaction, query transactions.Query) bool {
+	if query.Status != "" && tx.Status != query.Status {
+		return false
+	}
+
+	if query.Description != "" && !strings.Contains(strings.ToLower(tx.Description), strings.ToLower(query.Description)) {
+		return false
+	}
+
+	if !query.CreatedAfter.IsZero() && tx.CreatedAt.Before(query.CreatedAfter) {
+		return false
+	}
+
+	if !query.CreatedBefore.IsZero() && !tx.CreatedAt.Before(query.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// cursor is the position of a transaction in a listing.
+type cursor struct {
+	createdAt int64
+	id        []byte
+}
+
+func newCursor(tx *transactions.Transaction) cursor {
+	return cursor{createdAt: tx.CreatedAt.UnixNano(), id: tx.ID.Bytes()}
+}
+
+// before checks if c comes before o in the listing.
+func (c cursor) before(o cursor, desc bool) bool {
+	cmp := bytes.Compare(c.id, o.id)
+	if c.createdAt != o.createdAt {
+		cmp = 1
+		if c.createdAt < o.createdAt {
+			cmp = -1
+		}
+	}
+
+	if desc {
+		return cmp > 0
+	}
+
+	return cmp < 0
+}
+
+// encode returns the hex encoded creation time in nanoseconds followed by the transaction ID.
+func (c cursor) encode() string {
+	data := make([]byte, 8, 8+len(c.id))
+	binary.BigEndian.PutUint64(data, uint64(c.createdAt))
+	return hexutil.Encode(append(data, c.id...))
+}
+
+func decodeCursor(s string) (cursor, error) {
+	data, err := hexutil.Decode(s)
+	if err != nil {
+		return cursor{}, err
+	}
+
+	if len(data) != 8+len(transactions.NilTxID()) {
+		return cursor{}, errors.New("cursor must be %d bytes", 8+len(transactions.NilTxID()))
+	}
+
+	return cursor{createdAt: int64(binary.BigEndian.Uint64(data[:8])), id: data[8:]}, nil
+}
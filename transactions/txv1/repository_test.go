@@ -3,8 +3,10 @@
 package txv1
 
 import (
+	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/centrifuge/go-centrifuge/testingutils/identity"
 
@@ -81,3 +83,75 @@ func TestRepository(t *testing.T) {
 	assert.Equal(t, cid, tx.DID)
 	assert.Equal(t, transactions.Success, tx.Status)
 }
+
+func TestRepository_List(t *testing.T) {
+	cid := testingidentity.GenerateRandomDID()
+	repo := ctx[transactions.BootstrappedRepo].(transactions.Repository)
+	now := time.Now().UTC()
+	var txs []*transactions.Transaction
+	for i := 0; i < 5; i++ {
+		tx := transactions.NewTransaction(cid, fmt.Sprintf("Anchor document %d", i))
+		tx.CreatedAt = now.Add(time.Duration(i) * time.Minute)
+		if i%2 == 1 {
+			tx.Status = transactions.Success
+		}
+		assert.NoError(t, repo.Save(tx))
+		txs = append(txs, tx)
+	}
+
+	// transaction of another identity
+	assert.NoError(t, repo.Save(transactions.NewTransaction(testingidentity.GenerateRandomDID(), "Anchor document")))
+
+	// newest first by default
+	page, err := repo.List(cid, transactions.Query{})
+	assert.NoError(t, err)
+	assert.Empty(t, page.NextCursor)
+	assert.Len(t, page.Transactions, 5)
+	for i, tx := range page.Transactions {
+		assert.Equal(t, txs[4-i].ID, tx.ID)
+	}
+
+	// paginated oldest first
+	var ids []transactions.TxID
+	query := transactions.Query{Order: transactions.OrderAsc, Limit: 2}
+	for {
+		page, err = repo.List(cid, query)
+		assert.NoError(t, err)
+		assert.True(t, len(page.Transactions) <= 2)
+		for _, tx := range page.Transactions {
+			ids = append(ids, tx.ID)
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+		query.Cursor = page.NextCursor
+	}
+	assert.Len(t, ids, 5)
+	for i, id := range ids {
+		assert.Equal(t, txs[i].ID, id)
+	}
+
+	// filters
+	page, err = repo.List(cid, transactions.Query{Status: transactions.Success})
+	assert.NoError(t, err)
+	assert.Len(t, page.Transactions, 2)
+	assert.Equal(t, txs[3].ID, page.Transactions[0].ID)
+	assert.Equal(t, txs[1].ID, page.Transactions[1].ID)
+
+	page, err = repo.List(cid, transactions.Query{Description: "document 2"})
+	assert.NoError(t, err)
+	assert.Len(t, page.Transactions, 1)
+	assert.Equal(t, txs[2].ID, page.Transactions[0].ID)
+
+	page, err = repo.List(cid, transactions.Query{CreatedAfter: txs[1].CreatedAt, CreatedBefore: txs[3].CreatedAt})
+	assert.NoError(t, err)
+	assert.Len(t, page.Transactions, 2)
+	assert.Equal(t, txs[2].ID, page.Transactions[0].ID)
+	assert.Equal(t, txs[1].ID, page.Transactions[1].ID)
+
+	// invalid cursor
+	_, err = repo.List(cid, transactions.Query{Cursor: "0x01"})
+	assert.Error(t, err)
+	assert.True(t, errors.IsOfType(transactions.ErrInvalidCursor, err))
+}
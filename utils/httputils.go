@@ -17,3 +17,13 @@ func SendPOSTRequest(url string, contentType string, payload []byte) (statusCode
 
 	return resp.StatusCode(), nil
 }
+
+// SendGETRequest sends get to given URL and returns the body of the response.
+func SendGETRequest(url string) (statusCode int, body []byte, err error) {
+	resp, err := resty.R().Get(url)
+	if err != nil {
+		return statusCode, nil, err
+	}
+
+	return resp.StatusCode(), resp.Body(), nil
+}
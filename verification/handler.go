@@ -0,0 +1,116 @@
+package verification
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/verification"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+type grpcHandler struct {
+	srv Service
+}
+
+// GRPCHandler returns an implementation of verificationpb.VerificationServiceServer
+func GRPCHandler(srv Service) verificationpb.VerificationServiceServer {
+	return grpcHandler{srv: srv}
+}
+
+// CreateShareToken creates a share token for the document version of the account.
+func (h grpcHandler) CreateShareToken(ctx context.Context, req *verificationpb.CreateShareTokenRequest) (*verificationpb.ShareToken, error) {
+	documentID, err := hexutil.Decode(req.DocumentId)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	versionID, err := hexutil.Decode(req.VersionId)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	st, err := h.srv.Share(ctx, documentID, versionID)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	createdAt, err := utils.ToTimestamp(st.CreatedAt)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return &verificationpb.ShareToken{
+		Token:        st.Token,
+		DocumentId:   hexutil.Encode(st.DocumentID),
+		VersionId:    hexutil.Encode(st.VersionID),
+		AnchorId:     st.AnchorID.String(),
+		DocumentRoot: hexutil.Encode(st.DocumentRoot),
+		CreatedAt:    createdAt,
+	}, nil
+}
+
+// RevokeShareToken revokes the share token of the account.
+func (h grpcHandler) RevokeShareToken(ctx context.Context, req *verificationpb.RevokeShareTokenRequest) (*empty.Empty, error) {
+	err := h.srv.Revoke(ctx, req.Token)
+	if err != nil {
+		log.Error(err)
+		return nil, errors.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	return new(empty.Empty), nil
+}
+
+// Verify checks the anchor of the version shared with the token or the document root.
+// It doesn't require an account, and returns what is anchored on chain only.
+func (h grpcHandler) Verify(ctx context.Context, req *verificationpb.VerifyRequest) (*verificationpb.VerifyResponse, error) {
+	var root []byte
+	if req.DocumentRoot != "" {
+		var err error
+		root, err = hexutil.Decode(req.DocumentRoot)
+		if err != nil {
+			return nil, errors.NewHTTPError(http.StatusBadRequest, err)
+		}
+	}
+
+	res, err := h.srv.Verify(req.ShareToken, root)
+	if err != nil {
+		return nil, verifyHTTPError(err)
+	}
+
+	var anchoredAt *timestamp.Timestamp
+	if res.Anchored {
+		anchoredAt, err = utils.ToTimestamp(res.AnchoredAt)
+		if err != nil {
+			log.Error(err)
+			return nil, errors.NewHTTPError(http.StatusInternalServerError, err)
+		}
+	}
+
+	return &verificationpb.VerifyResponse{
+		DocumentRoot: hexutil.Encode(res.DocumentRoot),
+		AnchorId:     res.AnchorID.String(),
+		Anchored:     res.Anchored,
+		AnchoredAt:   anchoredAt,
+	}, nil
+}
+
+// verifyHTTPError returns the http error of a failed verification.
+func verifyHTTPError(err error) error {
+	switch {
+	case errors.IsOfType(ErrNothingToVerify, err):
+		return errors.NewHTTPError(http.StatusBadRequest, err)
+	case errors.IsOfType(ErrUnknownShare, err):
+		return errors.NewHTTPError(http.StatusNotFound, err)
+	default:
+		log.Error(err)
+		return errors.NewHTTPError(http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,118 @@
+// +build unit
+
+package verification
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/protobufs/gen/go/verification"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGRPCHandler(t *testing.T) {
+	s := newShared(t)
+	srv, anchorRepo := newTestService(t, s)
+	h := GRPCHandler(srv)
+	cctx := testingconfig.CreateAccountContext(t, cfg)
+
+	// invalid ids
+	_, err := h.CreateShareToken(cctx, &verificationpb.CreateShareTokenRequest{DocumentId: "0xinvalid", VersionId: hexutil.Encode(s.versionID)})
+	assert.Error(t, err)
+
+	st, err := h.CreateShareToken(cctx, &verificationpb.CreateShareTokenRequest{DocumentId: hexutil.Encode(s.docID), VersionId: hexutil.Encode(s.versionID)})
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(s.root), st.DocumentRoot)
+	assert.Equal(t, s.anchorID.String(), st.AnchorId)
+	assert.NotNil(t, st.CreatedAt)
+
+	// nothing to verify
+	_, err = h.Verify(context.Background(), &verificationpb.VerifyRequest{})
+	code, _ := errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// invalid root
+	_, err = h.Verify(context.Background(), &verificationpb.VerifyRequest{DocumentRoot: "root"})
+	assert.Error(t, err)
+
+	// no account needed to verify
+	root, err := anchors.ToDocumentRoot(s.root)
+	assert.NoError(t, err)
+	anchorRepo.On("GetAnchorData", s.anchorID).Return(root, nil, time.Now()).Once()
+	resp, err := h.Verify(context.Background(), &verificationpb.VerifyRequest{ShareToken: st.Token})
+	assert.NoError(t, err)
+	assert.True(t, resp.Anchored)
+	assert.NotNil(t, resp.AnchoredAt)
+	assert.Equal(t, st.DocumentRoot, resp.DocumentRoot)
+
+	// not anchored
+	anchorRepo.On("GetAnchorData", s.anchorID).Return(nil, errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor"))).Once()
+	resp, err = h.Verify(context.Background(), &verificationpb.VerifyRequest{DocumentRoot: st.DocumentRoot})
+	assert.NoError(t, err)
+	assert.False(t, resp.Anchored)
+	assert.Nil(t, resp.AnchoredAt)
+
+	_, err = h.RevokeShareToken(cctx, &verificationpb.RevokeShareTokenRequest{Token: st.Token})
+	assert.NoError(t, err)
+	_, err = h.Verify(context.Background(), &verificationpb.VerifyRequest{ShareToken: st.Token})
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+	_, err = h.RevokeShareToken(cctx, &verificationpb.RevokeShareTokenRequest{Token: st.Token})
+	code, _ = errors.GetHTTPDetails(err)
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestPageHandler(t *testing.T) {
+	s := newShared(t)
+	srv, anchorRepo := newTestService(t, s)
+	st, err := srv.Share(testingconfig.CreateAccountContext(t, cfg), s.docID, s.versionID)
+	assert.NoError(t, err)
+	h := PageHandler(srv)
+	get := func(query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, PagePath+query, nil))
+		return w
+	}
+
+	// nothing to verify and invalid root
+	assert.Equal(t, http.StatusBadRequest, get("").Code)
+	assert.Equal(t, http.StatusBadRequest, get("?document_root=root").Code)
+
+	// unknown share
+	w := get("?share_token=unknown")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "Unknown document")
+
+	// anchored
+	root, err := anchors.ToDocumentRoot(s.root)
+	assert.NoError(t, err)
+	anchorRepo.On("GetAnchorData", s.anchorID).Return(root, nil, time.Unix(1500000000, 0)).Once()
+	w = get("?share_token=" + st.Token)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "Verified by Centrifuge")
+	assert.Contains(t, w.Body.String(), "2017-07-14T02:40:00Z")
+	assert.Contains(t, w.Body.String(), hexutil.Encode(s.root))
+
+	// not anchored
+	anchorRepo.On("GetAnchorData", s.anchorID).Return(nil, errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor"))).Once()
+	w = get("?document_root=" + hexutil.Encode(s.root))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Not anchored")
+
+	// chain not reachable
+	anchorRepo.On("GetAnchorData", s.anchorID).Return(nil, errors.New("connection refused")).Once()
+	assert.Equal(t, http.StatusInternalServerError, get("?share_token="+st.Token).Code)
+
+	// only GET
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, PagePath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
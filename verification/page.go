@@ -0,0 +1,114 @@
+package verification
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// PagePath is the path of the public verification page. The links of the page take the share token as the share_token
+// query parameter, or the hex encoded document root as the document_root query parameter.
+const PagePath = "/verify"
+
+// page is what the verification page shows.
+type page struct {
+	Found        bool
+	DocumentRoot string
+	AnchorID     string
+	Anchored     bool
+	AnchoredAt   string
+}
+
+var pageTemplate = template.Must(template.New("verification").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Centrifuge document verification</title>
+</head>
+<body>
+<div class="document-verification">
+{{- if not .Found}}
+<h3>Unknown document</h3>
+<p>No document is shared with this link.</p>
+{{- else if .Anchored}}
+<h3>Verified by Centrifuge</h3>
+<p>The document is anchored on chain since {{.AnchoredAt}}.</p>
+{{- else}}
+<h3>Not anchored</h3>
+<p>The document is not anchored on chain yet.</p>
+{{- end}}
+{{- if .Found}}
+<dl>
+<dt>Document root</dt><dd>{{.DocumentRoot}}</dd>
+<dt>Anchor</dt><dd>{{.AnchorID}}</dd>
+</dl>
+{{- end}}
+</div>
+</body>
+</html>
+`))
+
+// PageHandler returns the handler of the public verification page.
+// The page shows whether the shared document version is anchored and when, without the document.
+func PageHandler(srv Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		var root []byte
+		if v := r.URL.Query().Get("document_root"); v != "" {
+			var err error
+			root, err = hexutil.Decode(v)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		code := http.StatusOK
+		var p page
+		res, err := srv.Verify(r.URL.Query().Get("share_token"), root)
+		switch {
+		case err == nil:
+			p = page{
+				Found:        true,
+				DocumentRoot: hexutil.Encode(res.DocumentRoot),
+				AnchorID:     res.AnchorID.String(),
+				Anchored:     res.Anchored,
+			}
+
+			if res.Anchored {
+				p.AnchoredAt = res.AnchoredAt.Format(time.RFC3339)
+			}
+		case errors.IsOfType(ErrUnknownShare, err):
+			code = http.StatusNotFound
+		case errors.IsOfType(ErrNothingToVerify, err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		default:
+			log.Error(err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		var buf bytes.Buffer
+		err = pageTemplate.Execute(&buf, p)
+		if err != nil {
+			log.Error(err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(code)
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			log.Infof("Failed to write response: %v", err)
+		}
+	})
+}
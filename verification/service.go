@@ -0,0 +1,198 @@
+package verification
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/contextutil"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	logging "github.com/ipfs/go-log"
+)
+
+const (
+	// BootstrappedService is the key to the verification Service in the bootstrap context.
+	BootstrappedService = "BootstrappedVerificationService"
+
+	// ErrUnknownShare must be used when no share token exists for the given token or document root.
+	ErrUnknownShare = errors.Error("unknown share token or document root")
+
+	// ErrNothingToVerify must be used when neither a share token nor a document root is given.
+	ErrNothingToVerify = errors.Error("share token or document root required")
+
+	// tokenLength is the number of random bytes of a share token.
+	tokenLength = 16
+
+	tokenPrefix = "verification_token_"
+	rootPrefix  = "verification_root_"
+)
+
+var log = logging.Logger("verification")
+
+// Service shares the anchors of document versions, so that anyone with the share token or the document root can
+// check that the version is anchored and when, without seeing the document.
+type Service interface {
+	// Share creates a share token for the document version of the account in ctx.
+	Share(ctx context.Context, documentID, versionID []byte) (*ShareToken, error)
+
+	// Revoke deletes the share token of the account in ctx.
+	Revoke(ctx context.Context, token string) error
+
+	// Verify checks the anchor of the version shared with the token or the document root.
+	// If both are given, they must belong to the same share.
+	Verify(token string, documentRoot []byte) (*Result, error)
+}
+
+type service struct {
+	repo       storage.Repository
+	docSrv     documents.Service
+	anchorRepo anchors.AnchorRepository
+}
+
+// DefaultService registers the share token model and returns the default verification service.
+func DefaultService(repo storage.Repository, docSrv documents.Service, anchorRepo anchors.AnchorRepository) Service {
+	repo.Register(&ShareToken{})
+	return &service{repo: repo, docSrv: docSrv, anchorRepo: anchorRepo}
+}
+
+func getTokenKey(token string) []byte {
+	return []byte(tokenPrefix + token)
+}
+
+// documentRootPrefix returns the key prefix of the share tokens of the document root.
+func documentRootPrefix(documentRoot []byte) string {
+	return fmt.Sprintf("%s%s_", rootPrefix, hexutil.Encode(documentRoot))
+}
+
+func getRootKey(documentRoot []byte, token string) []byte {
+	return []byte(documentRootPrefix(documentRoot) + token)
+}
+
+func (s *service) Share(ctx context.Context, documentID, versionID []byte) (*ShareToken, error) {
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := s.docSrv.GetVersion(ctx, documentID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorID, err := anchors.ToAnchorID(model.CurrentVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := model.CalculateDocumentRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	st := &ShareToken{
+		Token:        hex.EncodeToString(utils.RandomSlice(tokenLength)),
+		AccountID:    accountID,
+		DocumentID:   model.ID(),
+		VersionID:    model.CurrentVersion(),
+		AnchorID:     anchorID,
+		DocumentRoot: root,
+		CreatedAt:    time.Now().UTC(),
+	}
+
+	err = s.repo.Create(getTokenKey(st.Token), st)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.Create(getRootKey(st.DocumentRoot, st.Token), st)
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+func (s *service) Revoke(ctx context.Context, token string) error {
+	accountID, err := contextutil.AccountDID(ctx)
+	if err != nil {
+		return err
+	}
+
+	st, err := s.getShareToken(token)
+	if err != nil || !st.AccountID.Equal(accountID) {
+		return errors.NewTypedError(ErrUnknownShare, errors.New("%s", token))
+	}
+
+	err = s.repo.Delete(getRootKey(st.DocumentRoot, st.Token))
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(getTokenKey(st.Token))
+}
+
+func (s *service) Verify(token string, documentRoot []byte) (*Result, error) {
+	st, err := s.findShareToken(token, documentRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{DocumentRoot: st.DocumentRoot, AnchorID: st.AnchorID}
+	anchored, anchoredAt, err := s.anchorRepo.GetAnchorData(st.AnchorID)
+	if err != nil {
+		if errors.IsOfType(anchors.ErrAnchorNotFound, err) {
+			return res, nil
+		}
+
+		log.Error(err)
+		return nil, err
+	}
+
+	res.Anchored = utils.IsSameByteSlice(anchored[:], st.DocumentRoot)
+	if res.Anchored {
+		res.AnchoredAt = anchoredAt.UTC()
+	}
+
+	return res, nil
+}
+
+func (s *service) getShareToken(token string) (*ShareToken, error) {
+	m, err := s.repo.Get(getTokenKey(token))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.(*ShareToken), nil
+}
+
+// findShareToken returns the share token of the token or the document root.
+func (s *service) findShareToken(token string, documentRoot []byte) (*ShareToken, error) {
+	switch {
+	case token != "":
+		st, err := s.getShareToken(token)
+		if err != nil || (len(documentRoot) > 0 && !utils.IsSameByteSlice(st.DocumentRoot, documentRoot)) {
+			return nil, errors.NewTypedError(ErrUnknownShare, errors.New("%s", token))
+		}
+
+		return st, nil
+	case len(documentRoot) > 0:
+		models, err := s.repo.GetAllByPrefix(documentRootPrefix(documentRoot))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(models) == 0 {
+			return nil, errors.NewTypedError(ErrUnknownShare, errors.New("%s", hexutil.Encode(documentRoot)))
+		}
+
+		return models[0].(*ShareToken), nil
+	default:
+		return nil, ErrNothingToVerify
+	}
+}
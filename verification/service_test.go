@@ -0,0 +1,170 @@
+// +build unit
+
+package verification
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/bootstrap"
+	"github.com/centrifuge/go-centrifuge/bootstrap/bootstrappers/testlogging"
+	"github.com/centrifuge/go-centrifuge/config"
+	"github.com/centrifuge/go-centrifuge/documents"
+	"github.com/centrifuge/go-centrifuge/errors"
+	"github.com/centrifuge/go-centrifuge/storage"
+	"github.com/centrifuge/go-centrifuge/storage/leveldb"
+	"github.com/centrifuge/go-centrifuge/testingutils/anchors"
+	"github.com/centrifuge/go-centrifuge/testingutils/config"
+	"github.com/centrifuge/go-centrifuge/testingutils/documents"
+	"github.com/centrifuge/go-centrifuge/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var ctx = map[string]interface{}{}
+var cfg config.Configuration
+
+func TestMain(m *testing.M) {
+	ibootstappers := []bootstrap.TestBootstrapper{
+		&testlogging.TestLoggingBootstrapper{},
+		&config.Bootstrapper{},
+		&leveldb.Bootstrapper{},
+	}
+	bootstrap.RunTestBootstrappers(ibootstappers, ctx)
+	cfg = ctx[bootstrap.BootstrappedConfig].(config.Configuration)
+	result := m.Run()
+	bootstrap.RunTestTeardown(ibootstappers)
+	os.Exit(result)
+}
+
+type shared struct {
+	docID, versionID, root []byte
+	anchorID               anchors.AnchorID
+}
+
+func newShared(t *testing.T) shared {
+	s := shared{docID: utils.RandomSlice(32), versionID: utils.RandomSlice(32), root: utils.RandomSlice(32)}
+	var err error
+	s.anchorID, err = anchors.ToAnchorID(s.versionID)
+	assert.NoError(t, err)
+	return s
+}
+
+// newTestService returns a service sharing the version s of the document.
+func newTestService(t *testing.T, s shared) (Service, *testinganchors.MockAnchorRepo) {
+	model := new(testingdocuments.MockModel)
+	model.On("ID").Return(s.docID)
+	model.On("CurrentVersion").Return(s.versionID)
+	model.On("CalculateDocumentRoot").Return(s.root, nil)
+	docSrv := new(testingdocuments.MockService)
+	docSrv.On("GetVersion", s.docID, s.versionID).Return(model, nil)
+	docSrv.On("GetVersion", mock.Anything, mock.Anything).Return(model, documents.ErrDocumentVersionNotFound)
+	anchorRepo := new(testinganchors.MockAnchorRepo)
+	return DefaultService(ctx[storage.BootstrappedDB].(storage.Repository), docSrv, anchorRepo), anchorRepo
+}
+
+func TestService_Share(t *testing.T) {
+	s := newShared(t)
+	srv, _ := newTestService(t, s)
+
+	// no account
+	_, err := srv.Share(context.Background(), s.docID, s.versionID)
+	assert.Error(t, err)
+
+	// unknown version
+	cctx := testingconfig.CreateAccountContext(t, cfg)
+	_, err = srv.Share(cctx, s.docID, utils.RandomSlice(32))
+	assert.Error(t, err)
+
+	st, err := srv.Share(cctx, s.docID, s.versionID)
+	assert.NoError(t, err)
+	assert.Len(t, st.Token, 2*tokenLength)
+	assert.Equal(t, s.root, st.DocumentRoot)
+	assert.Equal(t, s.anchorID, st.AnchorID)
+	assert.False(t, st.CreatedAt.IsZero())
+
+	// tokens are unique
+	st2, err := srv.Share(cctx, s.docID, s.versionID)
+	assert.NoError(t, err)
+	assert.NotEqual(t, st.Token, st2.Token)
+}
+
+func TestService_Verify(t *testing.T) {
+	s := newShared(t)
+	srv, anchorRepo := newTestService(t, s)
+	cctx := testingconfig.CreateAccountContext(t, cfg)
+	st, err := srv.Share(cctx, s.docID, s.versionID)
+	assert.NoError(t, err)
+
+	// nothing to verify
+	_, err = srv.Verify("", nil)
+	assert.True(t, errors.IsOfType(ErrNothingToVerify, err))
+
+	// unknown token and root
+	_, err = srv.Verify("unknown", nil)
+	assert.True(t, errors.IsOfType(ErrUnknownShare, err))
+	_, err = srv.Verify("", utils.RandomSlice(32))
+	assert.True(t, errors.IsOfType(ErrUnknownShare, err))
+
+	// root of another share
+	_, err = srv.Verify(st.Token, utils.RandomSlice(32))
+	assert.True(t, errors.IsOfType(ErrUnknownShare, err))
+
+	// not anchored yet
+	anchorRepo.On("GetAnchorData", s.anchorID).Return(nil, errors.NewTypedError(anchors.ErrAnchorNotFound, errors.New("anchor"))).Once()
+	res, err := srv.Verify(st.Token, nil)
+	assert.NoError(t, err)
+	assert.False(t, res.Anchored)
+	assert.Equal(t, s.root, res.DocumentRoot)
+
+	// anchored with another root
+	anchorRepo.On("GetAnchorData", s.anchorID).Return(anchors.DocumentRoot(utils.RandomByte32()), nil, time.Now()).Once()
+	res, err = srv.Verify(st.Token, nil)
+	assert.NoError(t, err)
+	assert.False(t, res.Anchored)
+	assert.True(t, res.AnchoredAt.IsZero())
+
+	// anchored, by token and by root
+	root, err := anchors.ToDocumentRoot(s.root)
+	assert.NoError(t, err)
+	anchoredAt := time.Unix(1500000000, 0).UTC()
+	anchorRepo.On("GetAnchorData", s.anchorID).Return(root, nil, anchoredAt).Twice()
+	res, err = srv.Verify(st.Token, s.root)
+	assert.NoError(t, err)
+	assert.True(t, res.Anchored)
+	assert.Equal(t, anchoredAt, res.AnchoredAt)
+	assert.Equal(t, s.anchorID, res.AnchorID)
+	res, err = srv.Verify("", s.root)
+	assert.NoError(t, err)
+	assert.True(t, res.Anchored)
+
+	// chain not reachable
+	anchorRepo.On("GetAnchorData", s.anchorID).Return(nil, errors.New("connection refused")).Once()
+	_, err = srv.Verify(st.Token, nil)
+	assert.EqualError(t, err, "connection refused")
+	anchorRepo.AssertExpectations(t)
+}
+
+func TestService_Revoke(t *testing.T) {
+	s := newShared(t)
+	srv, _ := newTestService(t, s)
+	cctx := testingconfig.CreateAccountContext(t, cfg)
+	st, err := srv.Share(cctx, s.docID, s.versionID)
+	assert.NoError(t, err)
+
+	// no account
+	assert.Error(t, srv.Revoke(context.Background(), st.Token))
+
+	// unknown token
+	err = srv.Revoke(cctx, "unknown")
+	assert.True(t, errors.IsOfType(ErrUnknownShare, err))
+
+	assert.NoError(t, srv.Revoke(cctx, st.Token))
+	_, err = srv.Verify(st.Token, nil)
+	assert.True(t, errors.IsOfType(ErrUnknownShare, err))
+	_, err = srv.Verify("", s.root)
+	assert.True(t, errors.IsOfType(ErrUnknownShare, err))
+}
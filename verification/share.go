@@ -0,0 +1,48 @@
+package verification
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/centrifuge/go-centrifuge/anchors"
+	"github.com/centrifuge/go-centrifuge/identity"
+)
+
+// ShareToken lets anyone holding it check the anchor of a document version, without the document.
+type ShareToken struct {
+	Token        string
+	AccountID    identity.DID
+	DocumentID   []byte
+	VersionID    []byte
+	AnchorID     anchors.AnchorID
+	DocumentRoot []byte
+	CreatedAt    time.Time
+}
+
+// JSON returns the json of the share token.
+func (s *ShareToken) JSON() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// FromJSON loads the share token from its json.
+func (s *ShareToken) FromJSON(data []byte) error {
+	return json.Unmarshal(data, s)
+}
+
+// Type returns the reflect type of the share token.
+func (s *ShareToken) Type() reflect.Type {
+	return reflect.TypeOf(s)
+}
+
+// Result is the outcome of a verification. It holds what is anchored on chain only.
+type Result struct {
+	DocumentRoot []byte
+	AnchorID     anchors.AnchorID
+
+	// Anchored is true if the document root is anchored on chain with the anchor ID.
+	Anchored bool
+
+	// AnchoredAt is the time of the block the anchor was committed in. Zero if not anchored.
+	AnchoredAt time.Time
+}
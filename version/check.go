@@ -35,3 +35,39 @@ func CheckVersion(peerVersion string) bool {
 func IncompatibleVersionError(nodeVersion string) error {
 	return centerrors.New(code.VersionMismatch, fmt.Sprintf("Incompatible version: node version: %s, client version: %s", GetVersion(), nodeVersion))
 }
+
+// MinimumPeerVersion is the lowest peer version supported by the next breaking protocol change.
+// Peers running an older version are reported as deprecated, raise it before the breaking change is rolled out.
+const MinimumPeerVersion = "0.0.1"
+
+// PeerStatus is the compatibility of a peer version with the node.
+type PeerStatus string
+
+const (
+	// PeerCompatible is the status of peers running a supported version.
+	PeerCompatible PeerStatus = "compatible"
+
+	// PeerDeprecated is the status of peers running a version that the next breaking protocol change drops.
+	PeerDeprecated PeerStatus = "deprecated"
+
+	// PeerIncompatible is the status of peers running a version rejected by the node.
+	PeerIncompatible PeerStatus = "incompatible"
+)
+
+// CheckPeerVersion returns the compatibility status of the peer node version.
+func CheckPeerVersion(peerVersion string) PeerStatus {
+	if !CheckVersion(peerVersion) {
+		return PeerIncompatible
+	}
+
+	v, err := semver.NewVersion(peerVersion)
+	if err != nil {
+		return PeerIncompatible
+	}
+
+	if v.LessThan(semver.MustParse(MinimumPeerVersion)) {
+		return PeerDeprecated
+	}
+
+	return PeerCompatible
+}